@@ -152,6 +152,10 @@ config.yaml: |
     {{- end }}
     qps: {{ required ".Values.config.shootClientConnection.qps is required" .Values.config.shootClientConnection.qps }}
     burst: {{ required ".Values.config.shootClientConnection.burst is required" .Values.config.shootClientConnection.burst }}
+    {{- if .Values.config.shootClientConnection.adaptiveRateLimiting }}
+    adaptiveRateLimiting:
+{{ toYaml .Values.config.shootClientConnection.adaptiveRateLimiting | trim | indent 6 }}
+    {{- end }}
   controllers:
     backupBucket:
       concurrentSyncs: {{ required ".Values.config.controllers.backupBucket.concurrentSyncs is required" .Values.config.controllers.backupBucket.concurrentSyncs }}
@@ -205,6 +209,14 @@ config.yaml: |
       {{- if .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
       dnsEntryTTLSeconds: {{ .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
       {{- end }}
+      {{- if .Values.config.controllers.shoot.sharding }}
+      sharding:
+{{ toYaml .Values.config.controllers.shoot.sharding | indent 8 }}
+      {{- end }}
+      {{- if .Values.config.controllers.shoot.migration }}
+      migration:
+{{ toYaml .Values.config.controllers.shoot.migration | indent 8 }}
+      {{- end }}
     shootCare:
       concurrentSyncs: {{ required ".Values.config.controllers.shootCare.concurrentSyncs is required" .Values.config.controllers.shootCare.concurrentSyncs }}
       syncPeriod: {{ required ".Values.config.controllers.shootCare.syncPeriod is required" .Values.config.controllers.shootCare.syncPeriod }}
@@ -223,17 +235,48 @@ config.yaml: |
 {{ toYaml .Values.config.controllers.shootCare.conditionThresholds | indent 6 }}
       {{- end }}
       webhookRemediatorEnabled: {{ required ".Values.config.controllers.shootCare.webhookRemediatorEnabled is required" .Values.config.controllers.shootCare.webhookRemediatorEnabled }}
+      {{- if .Values.config.controllers.shootCare.deprecatedAPIUsageReporterEnabled }}
+      deprecatedAPIUsageReporterEnabled: {{ .Values.config.controllers.shootCare.deprecatedAPIUsageReporterEnabled }}
+      {{- end }}
+      {{- if .Values.config.controllers.shootCare.backupFreshnessThresholds }}
+      backupFreshnessThresholds:
+{{ toYaml .Values.config.controllers.shootCare.backupFreshnessThresholds | indent 8 }}
+      {{- end }}
     seedCare:
       syncPeriod: {{ required ".Values.config.controllers.seedCare.syncPeriod is required" .Values.config.controllers.seedCare.syncPeriod }}
       conditionThresholds:
       {{- if .Values.config.controllers.seedCare.conditionThresholds }}
 {{ toYaml .Values.config.controllers.seedCare.conditionThresholds | indent 6 }}
       {{- end }}
+      {{- if .Values.config.controllers.seedCare.conformanceChecks }}
+      conformanceChecks:
+{{ toYaml .Values.config.controllers.seedCare.conformanceChecks | indent 8 }}
+      {{- end }}
     {{- if .Values.config.controllers.shootState }}
     shootState:
       concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
       syncPeriod: {{ required ".Values.config.controllers.shootState.syncPeriod is required" .Values.config.controllers.shootState.syncPeriod }}
     {{- end }}
+    {{- if .Values.config.controllers.shootNamespaceJanitor }}
+    shootNamespaceJanitor:
+      {{- if .Values.config.controllers.shootNamespaceJanitor.concurrentSyncs }}
+      concurrentSyncs: {{ .Values.config.controllers.shootNamespaceJanitor.concurrentSyncs }}
+      {{- end }}
+      {{- if .Values.config.controllers.shootNamespaceJanitor.syncPeriod }}
+      syncPeriod: {{ .Values.config.controllers.shootNamespaceJanitor.syncPeriod }}
+      {{- end }}
+      {{- if .Values.config.controllers.shootNamespaceJanitor.staleThreshold }}
+      staleThreshold: {{ .Values.config.controllers.shootNamespaceJanitor.staleThreshold }}
+      {{- end }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootMetering }}
+    shootMetering:
+{{ toYaml .Values.config.controllers.shootMetering | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.controllers.seedIngressCertificate }}
+    seedIngressCertificate:
+{{ toYaml .Values.config.controllers.seedIngressCertificate | indent 6 }}
+    {{- end }}
     {{- if .Values.config.controllers.managedSeed }}
     managedSeed:
       concurrentSyncs: {{ required ".Values.config.controllers.managedSeed.concurrentSyncs is required" .Values.config.controllers.managedSeed.concurrentSyncs }}
@@ -255,6 +298,9 @@ config.yaml: |
       additionalNamespaceSelectors:
 {{ toYaml .Values.config.controllers.networkPolicy.additionalNamespaceSelectors | indent 6 }}
       {{- end }}
+      {{- if .Values.config.controllers.networkPolicy.extensionsAccessToShootAPIServers }}
+      extensionsAccessToShootAPIServers: {{ .Values.config.controllers.networkPolicy.extensionsAccessToShootAPIServers }}
+      {{- end }}
     {{- end }}
     tokenRequestor:
       concurrentSyncs: {{ required ".Values.config.controllers.tokenRequestor.concurrentSyncs is required" .Values.config.controllers.tokenRequestor.concurrentSyncs }}
@@ -325,6 +371,18 @@ config.yaml: |
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
   {{- end}}
+  {{- if .Values.config.autonomy }}
+  autonomy:
+{{ toYaml .Values.config.autonomy | trim | indent 4 }}
+  {{- end }}
+  {{- if .Values.config.continuousProfiling }}
+  continuousProfiling:
+{{ toYaml .Values.config.continuousProfiling | trim | indent 4 }}
+  {{- end }}
+  {{- if .Values.config.imageVectorOverwrite }}
+  imageVectorOverwrite:
+{{ toYaml .Values.config.imageVectorOverwrite | trim | indent 4 }}
+  {{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
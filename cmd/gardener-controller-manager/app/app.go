@@ -168,6 +168,7 @@ func addAllFieldIndexes(ctx context.Context, i client.FieldIndexer) error {
 		indexer.AddProjectNamespace,
 		indexer.AddShootSeedName,
 		indexer.AddShootStatusSeedName,
+		indexer.AddShootSecretBindingName,
 		indexer.AddBackupBucketSeedName,
 		indexer.AddBackupEntrySeedName,
 		indexer.AddControllerInstallationSeedRefName,
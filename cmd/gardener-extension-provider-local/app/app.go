@@ -45,6 +45,7 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
 	"github.com/gardener/gardener/extensions/pkg/controller/heartbeat"
 	extensionsheartbeatcmd "github.com/gardener/gardener/extensions/pkg/controller/heartbeat/cmd"
+	extensionsleaderelectioncmd "github.com/gardener/gardener/extensions/pkg/leaderelection/cmd"
 	extensionscmdwebhook "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -95,7 +96,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			MetricsBindAddress:      ":8080",
 			HealthBindAddress:       ":8081",
 		}
-		generalOpts = &extensionscmdcontroller.GeneralOptions{}
+		generalOpts        = &extensionscmdcontroller.GeneralOptions{}
+		leaderElectionOpts = &extensionsleaderelectioncmd.Options{
+			NodeName: os.Getenv("NODE_NAME"),
+		}
 
 		// options for the health care controller
 		healthCheckCtrlOpts = &extensionscmdcontroller.ControllerOptions{
@@ -169,6 +173,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		aggOption = extensionscmdcontroller.NewOptionAggregator(
 			restOpts,
 			mgrOpts,
+			leaderElectionOpts,
 			generalOpts,
 			extensionscmdcontroller.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			extensionscmdcontroller.PrefixOption("dnsrecord-", dnsRecordCtrlOpts),
@@ -200,7 +205,12 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 				return err
 			}
 
-			mgr, err := manager.New(restOpts.Completed().Config, mgrOpts.Completed().Options())
+			mgrOptions := mgrOpts.Completed().Options()
+			if err := leaderElectionOpts.Completed().Apply(restOpts.Completed().Config, &mgrOptions); err != nil {
+				return fmt.Errorf("could not apply leader election options: %w", err)
+			}
+
+			mgr, err := manager.New(restOpts.Completed().Config, mgrOptions)
 			if err != nil {
 				return fmt.Errorf("could not instantiate manager: %w", err)
 			}
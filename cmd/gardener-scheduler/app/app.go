@@ -73,6 +73,8 @@ func NewCommand() *cobra.Command {
 	verflag.AddFlags(flags)
 	opts.addFlags(flags)
 
+	cmd.AddCommand(newSimulateCommand())
+
 	return cmd
 }
 
@@ -0,0 +1,160 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	cmdutils "github.com/gardener/gardener/cmd/utils"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+const (
+	outputFormatYAML = "yaml"
+	outputFormatJSON = "json"
+)
+
+// newSimulateCommand creates a new cobra.Command for simulating the scheduling of a shoot.
+func newSimulateCommand() *cobra.Command {
+	opts := &simulateOptions{output: outputFormatYAML}
+
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate the scheduling of a shoot without creating it",
+		Long: `Simulate the scheduling of a hypothetical shoot with the given scheduler configuration. The shoot is neither
+created nor bound to a seed. The result contains the ranked candidate seeds and the reason for every rejected seed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log, err := cmdutils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return simulate(cmd.Context(), log, opts, cmd.OutOrStdout())
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+type simulateOptions struct {
+	options
+
+	shootFile string
+	output    string
+	shoot     *gardencorev1beta1.Shoot
+}
+
+var _ cmdutils.Options = &simulateOptions{}
+
+func (o *simulateOptions) addFlags(fs *pflag.FlagSet) {
+	o.options.addFlags(fs)
+	fs.StringVar(&o.shootFile, "shoot", o.shootFile, "Path to the manifest of the shoot to simulate the scheduling for.")
+	fs.StringVarP(&o.output, "output", "o", o.output, "Output format of the result, one of 'yaml' or 'json'.")
+}
+
+func (o *simulateOptions) Complete() error {
+	if err := o.options.Complete(); err != nil {
+		return err
+	}
+
+	if len(o.shootFile) == 0 {
+		return fmt.Errorf("missing shoot file")
+	}
+
+	data, err := os.ReadFile(o.shootFile)
+	if err != nil {
+		return fmt.Errorf("error reading shoot file: %w", err)
+	}
+
+	o.shoot = &gardencorev1beta1.Shoot{}
+	if err := runtime.DecodeInto(kubernetes.GardenCodec.UniversalDecoder(gardencorev1beta1.SchemeGroupVersion), data, o.shoot); err != nil {
+		return fmt.Errorf("error decoding shoot: %w", err)
+	}
+
+	return nil
+}
+
+func (o *simulateOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+
+	if o.config.Schedulers.Shoot == nil {
+		return fmt.Errorf("shoot scheduler is not configured")
+	}
+	if o.output != outputFormatYAML && o.output != outputFormatJSON {
+		return fmt.Errorf("unsupported output format %q, must be one of %q or %q", o.output, outputFormatYAML, outputFormatJSON)
+	}
+	return nil
+}
+
+func simulate(ctx context.Context, log logr.Logger, opts *simulateOptions, out io.Writer) error {
+	cfg := opts.config
+
+	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
+		cfg.ClientConnection.Kubeconfig = kubeconfig
+	}
+
+	restCfg, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.ClientConnection, nil, kubernetes.AuthTokenFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restCfg, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return err
+	}
+
+	reconciler := &shoot.Reconciler{
+		Client:          c,
+		Config:          cfg.Schedulers.Shoot,
+		GardenNamespace: v1beta1constants.GardenNamespace,
+	}
+
+	result, err := reconciler.Simulate(ctx, log, opts.shoot)
+	if err != nil {
+		return fmt.Errorf("failed simulating scheduling of shoot: %w", err)
+	}
+
+	var data []byte
+	if opts.output == outputFormatJSON {
+		data, err = json.MarshalIndent(result, "", "  ")
+		data = append(data, '\n')
+	} else {
+		data, err = yaml.Marshal(result)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(data)
+	return err
+}
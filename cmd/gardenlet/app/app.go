@@ -61,8 +61,10 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/operations"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/features"
@@ -276,6 +278,11 @@ func (g *garden) Start(ctx context.Context) error {
 				&corev1.ServiceAccount{}: {
 					Namespaces: map[string]cache.Config{seedNamespace: {}},
 				},
+				// Gardenlet should watch only the Gardenlet resource named after the seed it is responsible for.
+				&seedmanagementv1alpha1.Gardenlet{}: {
+					Namespaces: map[string]cache.Config{v1beta1constants.GardenNamespace: {}},
+					Field:      fields.SelectorFromSet(fields.Set{metav1.ObjectNameField: g.config.SeedConfig.SeedTemplate.Name}),
+				},
 			}
 
 			return kubernetes.AggregatorCacheFunc(
@@ -386,12 +393,27 @@ func (g *garden) Start(ctx context.Context) error {
 		return err
 	}
 
+	var shootRateLimiters *ratelimiter.Registry
+	if cfg := g.config.ShootClientConnection.AdaptiveRateLimiting; cfg != nil && cfg.Enabled {
+		opts := ratelimiter.Options{
+			QPS:              g.config.ShootClientConnection.QPS,
+			Burst:            int(g.config.ShootClientConnection.Burst),
+			MinQPS:           *cfg.MinQPS,
+			LatencyThreshold: cfg.LatencyThreshold.Duration,
+		}
+		if cfg.LoadShedding != nil && cfg.LoadShedding.Enabled {
+			opts.SaturationWindow = cfg.LoadShedding.SaturationWindow.Duration
+		}
+		shootRateLimiters = ratelimiter.NewRegistry(clock.RealClock{}, opts)
+	}
+
 	log.Info("Setting up shoot client map")
 	shootClientMap, err := clientmapbuilder.
 		NewShootClientMapBuilder().
 		WithGardenClient(gardenCluster.GetClient()).
 		WithSeedClient(g.mgr.GetClient()).
 		WithClientConnectionConfig(&g.config.ShootClientConnection.ClientConnectionConfiguration).
+		WithRateLimiters(shootRateLimiters).
 		Build(log)
 	if err != nil {
 		return fmt.Errorf("failed to build shoot ClientMap: %w", err)
@@ -426,6 +448,7 @@ func (g *garden) Start(ctx context.Context) error {
 		gardenCluster,
 		g.mgr,
 		shootClientMap,
+		shootRateLimiters,
 		g.config,
 		g.healthManager,
 	); err != nil {
@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils/mapper"
@@ -41,6 +42,9 @@ const (
 type AddArgs struct {
 	// Actuator is a BackupEntry actuator.
 	Actuator Actuator
+	// ActuatorMiddlewares are applied to all operations of the actuator, see
+	// extensionscontroller.DefaultActuatorMiddlewares.
+	ActuatorMiddlewares []extensionscontroller.ActuatorMiddleware
 	// ControllerOptions are the controller options used for creating a controller.
 	// The options.Reconciler is always overridden with a reconciler created from the
 	// given actuator.
@@ -64,7 +68,7 @@ func DefaultPredicates(ignoreOperationAnnotation bool) []predicate.Predicate {
 // Add creates a new BackupEntry Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(ctx context.Context, mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, WithMiddlewares(args.Actuator, args.ActuatorMiddlewares...))
 	predicates := extensionspredicate.AddTypePredicate(args.Predicates, args.Type)
 	return add(ctx, mgr, args, predicates)
 }
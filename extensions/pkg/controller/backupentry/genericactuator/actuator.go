@@ -83,6 +83,20 @@ func (a *actuator) deployEtcdBackupSecret(ctx context.Context, log logr.Logger,
 		backupSecretData = map[string][]byte{}
 	}
 	backupSecretData[v1beta1constants.DataKeyBackupBucketName] = []byte(be.Spec.BucketName)
+
+	if be.Spec.EncryptionSecretRef != nil {
+		encryptionSecret, err := kubernetesutils.GetSecretByReference(ctx, a.client, be.Spec.EncryptionSecretRef)
+		if err != nil {
+			log.Error(err, "Failed to read backup encryption secret")
+			return err
+		}
+
+		backupSecretData[v1beta1constants.DataKeyEtcdBackupEncryptionKey] = encryptionSecret.Data[v1beta1constants.DataKeyBackupEncryptionKey]
+		if previousKey, ok := encryptionSecret.Data[v1beta1constants.DataKeyBackupPreviousEncryptionKey]; ok {
+			backupSecretData[v1beta1constants.DataKeyEtcdBackupPreviousEncryptionKey] = previousKey
+		}
+	}
+
 	etcdSecretData, err := a.backupEntryDelegate.GetETCDSecretData(ctx, log, be, backupSecretData)
 	if err != nil {
 		return err
@@ -143,6 +143,34 @@ var _ = Describe("Actuator", func() {
 				etcdBackupSecret.ResourceVersion = "1"
 				Expect(actual).To(Equal(etcdBackupSecret))
 			})
+
+			It("should pass the encryption keys to the delegate", func() {
+				encryptionSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "entry-encryption",
+						Namespace: providerSecretNamespace,
+					},
+					Data: map[string][]byte{
+						"key":         []byte("new-key"),
+						"previousKey": []byte("old-key"),
+					},
+				}
+				backupEntry.Spec.EncryptionSecretRef = &corev1.SecretReference{Name: encryptionSecret.Name, Namespace: encryptionSecret.Namespace}
+				backupProviderSecretData["encryptionKey"] = []byte("new-key")
+				backupProviderSecretData["previousEncryptionKey"] = []byte("old-key")
+
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(seedNamespace, backupEntrySecret, encryptionSecret).Build()
+				mgr.EXPECT().GetClient().Return(fakeClient)
+				backupEntryDelegate.EXPECT().GetETCDSecretData(ctx, gomock.AssignableToTypeOf(logr.Logger{}), backupEntry, backupProviderSecretData).Return(backupProviderSecretData, nil)
+
+				a = genericactuator.NewActuator(mgr, backupEntryDelegate)
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				actual := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, etcdBackupSecretKey, actual)).To(Succeed())
+				Expect(actual.Data).To(HaveKeyWithValue("encryptionKey", []byte("new-key")))
+				Expect(actual.Data).To(HaveKeyWithValue("previousEncryptionKey", []byte("old-key")))
+			})
 		})
 
 		Context("seed namespace does not exist", func() {
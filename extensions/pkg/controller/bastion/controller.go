@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
@@ -36,6 +37,9 @@ const (
 type AddArgs struct {
 	// Actuator is a Bastion actuator.
 	Actuator Actuator
+	// ActuatorMiddlewares are applied to all operations of the actuator, see
+	// extensionscontroller.DefaultActuatorMiddlewares.
+	ActuatorMiddlewares []extensionscontroller.ActuatorMiddleware
 	// ConfigValidator is a bastion config validator.
 	ConfigValidator ConfigValidator
 	// ControllerOptions are the controller options used for creating a controller.
@@ -57,7 +61,7 @@ func DefaultPredicates(ignoreOperationAnnotation bool) []predicate.Predicate {
 // Add creates a new Bastion Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ConfigValidator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, WithMiddlewares(args.Actuator, args.ActuatorMiddlewares...), args.ConfigValidator)
 	predicates := extensionspredicate.AddTypePredicate(args.Predicates, args.Type)
 	return add(mgr, args, predicates)
 }
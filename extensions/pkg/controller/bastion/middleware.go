@@ -0,0 +1,63 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bastion
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// WithMiddlewares returns an Actuator which executes all operations of the given actuator wrapped by the given
+// middlewares. If no middlewares are given, the actuator is returned unchanged.
+func WithMiddlewares(actuator Actuator, middlewares ...extensionscontroller.ActuatorMiddleware) Actuator {
+	if len(middlewares) == 0 {
+		return actuator
+	}
+	return &actuatorWithMiddlewares{actuator: actuator, middlewares: middlewares}
+}
+
+type actuatorWithMiddlewares struct {
+	actuator    Actuator
+	middlewares extensionscontroller.ActuatorMiddlewares
+}
+
+func (a *actuatorWithMiddlewares) run(ctx context.Context, log logr.Logger, operation string, bastion *extensionsv1alpha1.Bastion, fn extensionscontroller.ActuatorOperation) error {
+	return a.middlewares.Run(ctx, log, extensionscontroller.ActuatorOperationInfo{Kind: extensionsv1alpha1.BastionResource, Operation: operation, Object: bastion}, fn)
+}
+
+// Reconcile implements Actuator.
+func (a *actuatorWithMiddlewares) Reconcile(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationReconcile, bastion, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Reconcile(ctx, log, bastion, cluster)
+	})
+}
+
+// Delete implements Actuator.
+func (a *actuatorWithMiddlewares) Delete(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationDelete, bastion, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Delete(ctx, log, bastion, cluster)
+	})
+}
+
+// ForceDelete implements Actuator.
+func (a *actuatorWithMiddlewares) ForceDelete(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationForceDelete, bastion, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.ForceDelete(ctx, log, bastion, cluster)
+	})
+}
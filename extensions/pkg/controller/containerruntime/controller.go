@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils/mapper"
@@ -40,6 +41,9 @@ const (
 type AddArgs struct {
 	// Actuator is an ContainerRuntime resource actuator.
 	Actuator Actuator
+	// ActuatorMiddlewares are applied to all operations of the actuator, see
+	// extensionscontroller.DefaultActuatorMiddlewares.
+	ActuatorMiddlewares []extensionscontroller.ActuatorMiddleware
 	// FinalizerSuffix is the suffix for the finalizer name.
 	FinalizerSuffix string
 	// ControllerOptions are the controller options used for creating a controller.
@@ -60,7 +64,7 @@ type AddArgs struct {
 
 // Add adds an ContainerRuntime controller to the given manager using the given AddArgs.
 func Add(ctx context.Context, mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, WithMiddlewares(args.Actuator, args.ActuatorMiddlewares...))
 	return add(ctx, mgr, args)
 }
 
@@ -0,0 +1,77 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// WithMiddlewares returns an Actuator which executes all operations of the given actuator wrapped by the given
+// middlewares. If no middlewares are given, the actuator is returned unchanged.
+func WithMiddlewares(actuator Actuator, middlewares ...extensionscontroller.ActuatorMiddleware) Actuator {
+	if len(middlewares) == 0 {
+		return actuator
+	}
+	return &actuatorWithMiddlewares{actuator: actuator, middlewares: middlewares}
+}
+
+type actuatorWithMiddlewares struct {
+	actuator    Actuator
+	middlewares extensionscontroller.ActuatorMiddlewares
+}
+
+func (a *actuatorWithMiddlewares) run(ctx context.Context, log logr.Logger, operation string, dns *extensionsv1alpha1.DNSRecord, fn extensionscontroller.ActuatorOperation) error {
+	return a.middlewares.Run(ctx, log, extensionscontroller.ActuatorOperationInfo{Kind: extensionsv1alpha1.DNSRecordResource, Operation: operation, Object: dns}, fn)
+}
+
+// Reconcile implements Actuator.
+func (a *actuatorWithMiddlewares) Reconcile(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationReconcile, dns, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Reconcile(ctx, log, dns, cluster)
+	})
+}
+
+// Delete implements Actuator.
+func (a *actuatorWithMiddlewares) Delete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationDelete, dns, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Delete(ctx, log, dns, cluster)
+	})
+}
+
+// ForceDelete implements Actuator.
+func (a *actuatorWithMiddlewares) ForceDelete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationForceDelete, dns, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.ForceDelete(ctx, log, dns, cluster)
+	})
+}
+
+// Restore implements Actuator.
+func (a *actuatorWithMiddlewares) Restore(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationRestore, dns, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Restore(ctx, log, dns, cluster)
+	})
+}
+
+// Migrate implements Actuator.
+func (a *actuatorWithMiddlewares) Migrate(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationMigrate, dns, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Migrate(ctx, log, dns, cluster)
+	})
+}
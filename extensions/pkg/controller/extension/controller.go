@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils/mapper"
@@ -38,6 +39,9 @@ const (
 type AddArgs struct {
 	// Actuator is an Extension resource actuator.
 	Actuator Actuator
+	// ActuatorMiddlewares are applied to all operations of the actuator, see
+	// extensionscontroller.DefaultActuatorMiddlewares.
+	ActuatorMiddlewares []extensionscontroller.ActuatorMiddleware
 	// Name is the name of the controller.
 	Name string
 	// FinalizerSuffix is the suffix for the finalizer name.
@@ -60,6 +64,7 @@ type AddArgs struct {
 
 // Add adds an Extension controller to the given manager using the given AddArgs.
 func Add(ctx context.Context, mgr manager.Manager, args AddArgs) error {
+	args.Actuator = WithMiddlewares(args.Actuator, args.ActuatorMiddlewares...)
 	args.ControllerOptions.Reconciler = NewReconciler(mgr, args)
 	return add(ctx, mgr, args)
 }
@@ -36,3 +36,11 @@ type Actuator interface {
 	// Migrate deletes the terraform k8s resources without deleting the corresponding resources in the IaaS provider
 	Migrate(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
 }
+
+// Planner is an optional interface which can be implemented by an Actuator in order to support computing a preview
+// of the changes a reconciliation would perform on the provider's infrastructure without applying them. It is invoked
+// when the Infrastructure is annotated with `gardener.cloud/operation=plan`.
+type Planner interface {
+	// Plan computes the changes which would be performed when reconciling the Infrastructure config.
+	Plan(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (*extensionsv1alpha1.InfrastructurePlan, error)
+}
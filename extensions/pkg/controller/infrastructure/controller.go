@@ -40,6 +40,9 @@ const (
 type AddArgs struct {
 	// Actuator is an infrastructure actuator.
 	Actuator Actuator
+	// ActuatorMiddlewares are applied to all operations of the actuator, see
+	// extensionscontroller.DefaultActuatorMiddlewares.
+	ActuatorMiddlewares []extensionscontroller.ActuatorMiddleware
 	// ConfigValidator is an infrastructure config validator.
 	ConfigValidator ConfigValidator
 	// ControllerOptions are the controller options used for creating a controller.
@@ -67,7 +70,7 @@ func DefaultPredicates(ctx context.Context, mgr manager.Manager, ignoreOperation
 // Add creates a new Infrastructure Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(ctx context.Context, mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ConfigValidator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, WithMiddlewares(args.Actuator, args.ActuatorMiddlewares...), args.ConfigValidator)
 	return add(ctx, mgr, args)
 }
 
@@ -0,0 +1,99 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// WithMiddlewares returns an Actuator which executes all operations of the given actuator wrapped by the given
+// middlewares. If no middlewares are given, the actuator is returned unchanged.
+func WithMiddlewares(actuator Actuator, middlewares ...extensionscontroller.ActuatorMiddleware) Actuator {
+	if len(middlewares) == 0 {
+		return actuator
+	}
+
+	a := &actuatorWithMiddlewares{actuator: actuator, middlewares: middlewares}
+	if planner, ok := actuator.(Planner); ok {
+		return &plannerWithMiddlewares{actuatorWithMiddlewares: a, planner: planner}
+	}
+	return a
+}
+
+type actuatorWithMiddlewares struct {
+	actuator    Actuator
+	middlewares extensionscontroller.ActuatorMiddlewares
+}
+
+func (a *actuatorWithMiddlewares) run(ctx context.Context, log logr.Logger, operation string, infra *extensionsv1alpha1.Infrastructure, fn extensionscontroller.ActuatorOperation) error {
+	return a.middlewares.Run(ctx, log, extensionscontroller.ActuatorOperationInfo{Kind: extensionsv1alpha1.InfrastructureResource, Operation: operation, Object: infra}, fn)
+}
+
+// Reconcile implements Actuator.
+func (a *actuatorWithMiddlewares) Reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationReconcile, infra, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Reconcile(ctx, log, infra, cluster)
+	})
+}
+
+// Delete implements Actuator.
+func (a *actuatorWithMiddlewares) Delete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationDelete, infra, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Delete(ctx, log, infra, cluster)
+	})
+}
+
+// ForceDelete implements Actuator.
+func (a *actuatorWithMiddlewares) ForceDelete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationForceDelete, infra, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.ForceDelete(ctx, log, infra, cluster)
+	})
+}
+
+// Restore implements Actuator.
+func (a *actuatorWithMiddlewares) Restore(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationRestore, infra, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Restore(ctx, log, infra, cluster)
+	})
+}
+
+// Migrate implements Actuator.
+func (a *actuatorWithMiddlewares) Migrate(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.run(ctx, log, extensionscontroller.OperationMigrate, infra, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Migrate(ctx, log, infra, cluster)
+	})
+}
+
+// plannerWithMiddlewares additionally implements Planner for actuators supporting it.
+type plannerWithMiddlewares struct {
+	*actuatorWithMiddlewares
+	planner Planner
+}
+
+// Plan implements Planner.
+func (a *plannerWithMiddlewares) Plan(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*extensionsv1alpha1.InfrastructurePlan, error) {
+	var plan *extensionsv1alpha1.InfrastructurePlan
+	err := a.run(ctx, log, extensionscontroller.OperationPlan, infra, func(ctx context.Context, log logr.Logger) error {
+		var err error
+		plan, err = a.planner.Plan(ctx, log, infra, cluster)
+		return err
+	})
+	return plan, err
+}
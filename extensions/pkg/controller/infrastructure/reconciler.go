@@ -94,6 +94,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.delete(ctx, log.WithValues("operation", "delete"), infrastructure, cluster)
 	case operationType == gardencorev1beta1.LastOperationTypeRestore:
 		return r.restore(ctx, log.WithValues("operation", "restore"), infrastructure, cluster)
+	case infrastructure.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan:
+		return r.plan(ctx, log.WithValues("operation", "plan"), infrastructure, cluster)
 	default:
 		return r.reconcile(ctx, log.WithValues("operation", "reconcile"), infrastructure, cluster, operationType)
 	}
@@ -138,6 +140,40 @@ func (r *reconciler) reconcile(
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) plan(
+	ctx context.Context,
+	log logr.Logger,
+	infrastructure *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster,
+) (
+	reconcile.Result,
+	error,
+) {
+	var plan *extensionsv1alpha1.InfrastructurePlan
+
+	if planner, ok := r.actuator.(Planner); !ok {
+		log.Info("Actuator does not support planning, resetting plan")
+	} else {
+		if err := r.validateConfig(ctx, infrastructure); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		log.Info("Starting the planning of infrastructure")
+		var err error
+		if plan, err = planner.Plan(ctx, log, infrastructure, cluster); err != nil {
+			return reconcilerutils.ReconcileErr(err)
+		}
+	}
+
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.Plan = plan
+	if err := r.client.Status().Patch(ctx, infrastructure, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error updating plan in Infrastructure status: %w", err)
+	}
+
+	return reconcile.Result{}, r.removeAnnotation(ctx, log, infrastructure)
+}
+
 func (r *reconciler) delete(
 	ctx context.Context,
 	log logr.Logger,
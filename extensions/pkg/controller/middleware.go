@@ -0,0 +1,204 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// OperationReconcile is the name of the Reconcile operation of an actuator.
+	OperationReconcile = "Reconcile"
+	// OperationDelete is the name of the Delete operation of an actuator.
+	OperationDelete = "Delete"
+	// OperationForceDelete is the name of the ForceDelete operation of an actuator.
+	OperationForceDelete = "ForceDelete"
+	// OperationRestore is the name of the Restore operation of an actuator.
+	OperationRestore = "Restore"
+	// OperationMigrate is the name of the Migrate operation of an actuator.
+	OperationMigrate = "Migrate"
+	// OperationPlan is the name of the Plan operation of an actuator.
+	OperationPlan = "Plan"
+
+	metricsNamespace = "gardener_extension"
+	metricsSubsystem = "actuator"
+
+	// ResultSuccess is the value of the 'result' label for successful actuator operations.
+	ResultSuccess = "success"
+	// ResultError is the value of the 'result' label for failed actuator operations.
+	ResultError = "error"
+)
+
+// ActuatorOperation is a single operation of an extension actuator, e.g., Reconcile or Delete. Results other than the
+// error are expected to be captured by the function itself.
+type ActuatorOperation func(ctx context.Context, log logr.Logger) error
+
+// ActuatorOperationInfo contains information about an actuator operation which is passed to middlewares.
+type ActuatorOperationInfo struct {
+	// Kind is the kind of the extension resource, e.g., "Infrastructure".
+	Kind string
+	// Operation is the name of the operation, e.g., "Reconcile".
+	Operation string
+	// Object is the extension resource the operation is executed for.
+	Object client.Object
+}
+
+// ActuatorMiddleware decorates an actuator operation with cross-cutting functionality.
+type ActuatorMiddleware func(info ActuatorOperationInfo, next ActuatorOperation) ActuatorOperation
+
+// ActuatorMiddlewares is a chain of middlewares which are applied to all operations of an actuator. The first
+// middleware is the outermost one, i.e., it is executed first and returns last.
+type ActuatorMiddlewares []ActuatorMiddleware
+
+// Run executes the given actuator operation wrapped by all middlewares of the chain.
+func (m ActuatorMiddlewares) Run(ctx context.Context, log logr.Logger, info ActuatorOperationInfo, operation ActuatorOperation) error {
+	for i := len(m) - 1; i >= 0; i-- {
+		operation = m[i](info, operation)
+	}
+	return operation(ctx, log)
+}
+
+// DefaultActuatorMiddlewares returns the recommended chain of actuator middlewares: panics are recovered, operations
+// are logged and metrics are recorded. If the given timeout is positive, operations are also limited to it.
+func DefaultActuatorMiddlewares(timeout time.Duration) ActuatorMiddlewares {
+	middlewares := ActuatorMiddlewares{
+		RecoverPanicMiddleware(),
+		LoggingMiddleware(),
+		MetricsMiddleware(),
+	}
+
+	if timeout > 0 {
+		middlewares = append(middlewares, TimeoutMiddleware(timeout))
+	}
+
+	return middlewares
+}
+
+// RecoverPanicMiddleware returns a middleware which recovers panics of the actuator operation and returns them as
+// error. This way, the panic is reported in the `.status.lastError` of the extension resource instead of crashing the
+// extension. The `RecoverPanic` option of the controller-runtime manager only requeues the request and does not update
+// the status of the extension resource.
+func RecoverPanicMiddleware() ActuatorMiddleware {
+	return func(info ActuatorOperationInfo, next ActuatorOperation) ActuatorOperation {
+		return func(ctx context.Context, log logr.Logger) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Error(fmt.Errorf("%v", r), "Recovered from panic in actuator operation", "kind", info.Kind, "operation", info.Operation, "stacktrace", string(debug.Stack()))
+					err = fmt.Errorf("panic during %s of %s: %v", info.Operation, info.Kind, r)
+				}
+			}()
+
+			return next(ctx, log)
+		}
+	}
+}
+
+// TimeoutMiddleware returns a middleware which limits the duration of the actuator operation to the given timeout.
+func TimeoutMiddleware(timeout time.Duration) ActuatorMiddleware {
+	return func(info ActuatorOperationInfo, next ActuatorOperation) ActuatorOperation {
+		return func(ctx context.Context, log logr.Logger) error {
+			timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			err := next(timeoutCtx, log)
+			if err != nil && errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+				return fmt.Errorf("%s of %s did not finish within %s: %w", info.Operation, info.Kind, timeout, err)
+			}
+			return err
+		}
+	}
+}
+
+// LoggingMiddleware returns a middleware which logs the start and the result of the actuator operation. The logger
+// passed to the operation is enriched with the kind and the operation.
+func LoggingMiddleware() ActuatorMiddleware {
+	return func(info ActuatorOperationInfo, next ActuatorOperation) ActuatorOperation {
+		return func(ctx context.Context, log logr.Logger) error {
+			log = log.WithValues("kind", info.Kind, "operation", info.Operation)
+			start := time.Now()
+
+			log.V(1).Info("Starting actuator operation")
+			if err := next(ctx, log); err != nil {
+				log.Info("Actuator operation failed", "duration", time.Since(start).String(), "error", err.Error())
+				return err
+			}
+
+			log.V(1).Info("Actuator operation finished successfully", "duration", time.Since(start).String())
+			return nil
+		}
+	}
+}
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	metricOperationsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "operations_total",
+			Help:      "Total number of operations executed by extension actuators.",
+		},
+		[]string{
+			"kind",
+			"operation",
+			"result",
+		},
+	)
+
+	metricOperationDuration = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "operation_duration_seconds",
+			Help:      "Histogram of the duration of operations executed by extension actuators.",
+			// Start with 100ms with the last bucket being [~30m, Inf)
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 15),
+		},
+		[]string{
+			"kind",
+			"operation",
+		},
+	)
+)
+
+// MetricsMiddleware returns a middleware which records the number and the duration of actuator operations.
+func MetricsMiddleware() ActuatorMiddleware {
+	return func(info ActuatorOperationInfo, next ActuatorOperation) ActuatorOperation {
+		return func(ctx context.Context, log logr.Logger) error {
+			start := time.Now()
+			err := next(ctx, log)
+
+			result := ResultSuccess
+			if err != nil {
+				result = ResultError
+			}
+
+			metricOperationsTotal.WithLabelValues(info.Kind, info.Operation, result).Inc()
+			metricOperationDuration.WithLabelValues(info.Kind, info.Operation).Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
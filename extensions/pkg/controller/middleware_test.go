@@ -0,0 +1,511 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
+	mockbackupbucket "github.com/gardener/gardener/extensions/pkg/controller/backupbucket/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
+	mockbackupentry "github.com/gardener/gardener/extensions/pkg/controller/backupentry/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
+	mockbastion "github.com/gardener/gardener/extensions/pkg/controller/bastion/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/containerruntime"
+	mockcontainerruntime "github.com/gardener/gardener/extensions/pkg/controller/containerruntime/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	mockcontrolplane "github.com/gardener/gardener/extensions/pkg/controller/controlplane/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
+	mockdnsrecord "github.com/gardener/gardener/extensions/pkg/controller/dnsrecord/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/extension"
+	mockextension "github.com/gardener/gardener/extensions/pkg/controller/extension/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	mockinfrastructure "github.com/gardener/gardener/extensions/pkg/controller/infrastructure/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/network"
+	mocknetwork "github.com/gardener/gardener/extensions/pkg/controller/network/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
+	mockoperatingsystemconfig "github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig/mock"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	mockworker "github.com/gardener/gardener/extensions/pkg/controller/worker/mock"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Middleware", func() {
+	var (
+		ctx  = context.TODO()
+		log  = logr.Discard()
+		info ActuatorOperationInfo
+	)
+
+	BeforeEach(func() {
+		info = ActuatorOperationInfo{
+			Kind:      extensionsv1alpha1.InfrastructureResource,
+			Operation: OperationReconcile,
+			Object:    &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}},
+		}
+	})
+
+	Describe("ActuatorMiddlewares", func() {
+		It("should run the operation if there are no middlewares", func() {
+			var executed bool
+
+			Expect(ActuatorMiddlewares{}.Run(ctx, log, info, func(context.Context, logr.Logger) error {
+				executed = true
+				return nil
+			})).To(Succeed())
+			Expect(executed).To(BeTrue())
+		})
+
+		It("should apply the middlewares in the correct order", func() {
+			var calls []string
+
+			recordingMiddleware := func(name string) ActuatorMiddleware {
+				return func(_ ActuatorOperationInfo, next ActuatorOperation) ActuatorOperation {
+					return func(ctx context.Context, log logr.Logger) error {
+						calls = append(calls, name+"-before")
+						err := next(ctx, log)
+						calls = append(calls, name+"-after")
+						return err
+					}
+				}
+			}
+
+			Expect(ActuatorMiddlewares{recordingMiddleware("first"), recordingMiddleware("second")}.Run(ctx, log, info, func(context.Context, logr.Logger) error {
+				calls = append(calls, "operation")
+				return nil
+			})).To(Succeed())
+			Expect(calls).To(Equal([]string{"first-before", "second-before", "operation", "second-after", "first-after"}))
+		})
+
+		It("should return the error of the operation", func() {
+			Expect(DefaultActuatorMiddlewares(time.Minute).Run(ctx, log, info, func(context.Context, logr.Logger) error {
+				return errors.New("fake")
+			})).To(MatchError("fake"))
+		})
+	})
+
+	Describe("#RecoverPanicMiddleware", func() {
+		It("should return the panic as error", func() {
+			Expect(ActuatorMiddlewares{RecoverPanicMiddleware()}.Run(ctx, log, info, func(context.Context, logr.Logger) error {
+				panic("boom")
+			})).To(MatchError("panic during Reconcile of Infrastructure: boom"))
+		})
+	})
+
+	Describe("#TimeoutMiddleware", func() {
+		It("should limit the duration of the operation", func() {
+			err := ActuatorMiddlewares{TimeoutMiddleware(10 * time.Millisecond)}.Run(ctx, log, info, func(ctx context.Context, _ logr.Logger) error {
+				<-ctx.Done()
+				return ctx.Err()
+			})
+			Expect(err).To(MatchError(ContainSubstring("Reconcile of Infrastructure did not finish within 10ms")))
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+
+		It("should pass a context with deadline to the operation", func() {
+			Expect(ActuatorMiddlewares{TimeoutMiddleware(time.Minute)}.Run(ctx, log, info, func(ctx context.Context, _ logr.Logger) error {
+				_, ok := ctx.Deadline()
+				Expect(ok).To(BeTrue())
+				return nil
+			})).To(Succeed())
+		})
+	})
+
+	Describe("#MetricsMiddleware", func() {
+		It("should not change the result of the operation", func() {
+			Expect(ActuatorMiddlewares{MetricsMiddleware()}.Run(ctx, log, info, func(context.Context, logr.Logger) error { return nil })).To(Succeed())
+			Expect(ActuatorMiddlewares{MetricsMiddleware()}.Run(ctx, log, info, func(context.Context, logr.Logger) error { return errors.New("fake") })).To(MatchError("fake"))
+		})
+	})
+
+	Describe("#WithMiddlewares", func() {
+		var (
+			ctrl    *gomock.Controller
+			cluster = &Cluster{}
+			errFake = errors.New("fake")
+
+			backupBucket  = &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+			backupEntry   = &extensionsv1alpha1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+			bastionObj    = &extensionsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			runtime       = &extensionsv1alpha1.ContainerRuntime{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			controlPlane  = &extensionsv1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			dnsRecord     = &extensionsv1alpha1.DNSRecord{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			extensionObj  = &extensionsv1alpha1.Extension{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			infra         = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			networkObj    = &extensionsv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			osc           = &extensionsv1alpha1.OperatingSystemConfig{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			workerObj     = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			inChain       bool
+			recorded      []ActuatorOperationInfo
+			middleware    ActuatorMiddleware
+			calledInChain gomock.Matcher
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			inChain = false
+			recorded = nil
+			middleware = func(info ActuatorOperationInfo, next ActuatorOperation) ActuatorOperation {
+				return func(ctx context.Context, log logr.Logger) error {
+					recorded = append(recorded, info)
+					inChain = true
+					defer func() { inChain = false }()
+					return next(ctx, log)
+				}
+			}
+			calledInChain = inChainMatcher{inChain: &inChain}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		// Each entry creates a mocked actuator of a kind and wraps it with the given middlewares. It returns the mocked
+		// and the wrapped actuator as well as functions which expect and execute each operation of the wrapped actuator.
+		// The mocked actuator returns errFake, and other results are verified to be passed through.
+		DescribeTable("should run all operations of the actuator through the middleware chain",
+			func(kind string, obj client.Object, newActuator func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error)) {
+				actuator, wrapped, _ := newActuator()
+				Expect(wrapped).To(BeIdenticalTo(actuator))
+
+				_, _, operations := newActuator(middleware)
+				for operation, call := range operations {
+					recorded = nil
+					Expect(call()).To(MatchError(errFake), operation)
+					Expect(recorded).To(Equal([]ActuatorOperationInfo{{Kind: kind, Operation: operation, Object: obj}}), operation)
+				}
+			},
+
+			Entry("BackupBucket", extensionsv1alpha1.BackupBucketResource, backupBucket, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockbackupbucket.NewMockActuator(ctrl)
+				wrapped := backupbucket.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), backupBucket).Return(errFake)
+						return wrapped.Reconcile(ctx, log, backupBucket)
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), backupBucket).Return(errFake)
+						return wrapped.Delete(ctx, log, backupBucket)
+					},
+				}
+			}),
+			Entry("BackupEntry", extensionsv1alpha1.BackupEntryResource, backupEntry, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockbackupentry.NewMockActuator(ctrl)
+				wrapped := backupentry.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), backupEntry).Return(errFake)
+						return wrapped.Reconcile(ctx, log, backupEntry)
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), backupEntry).Return(errFake)
+						return wrapped.Delete(ctx, log, backupEntry)
+					},
+					OperationRestore: func() error {
+						actuator.EXPECT().Restore(calledInChain, gomock.Any(), backupEntry).Return(errFake)
+						return wrapped.Restore(ctx, log, backupEntry)
+					},
+					OperationMigrate: func() error {
+						actuator.EXPECT().Migrate(calledInChain, gomock.Any(), backupEntry).Return(errFake)
+						return wrapped.Migrate(ctx, log, backupEntry)
+					},
+				}
+			}),
+			Entry("Bastion", extensionsv1alpha1.BastionResource, bastionObj, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockbastion.NewMockActuator(ctrl)
+				wrapped := bastion.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), bastionObj, cluster).Return(errFake)
+						return wrapped.Reconcile(ctx, log, bastionObj, cluster)
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), bastionObj, cluster).Return(errFake)
+						return wrapped.Delete(ctx, log, bastionObj, cluster)
+					},
+					OperationForceDelete: func() error {
+						actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), bastionObj, cluster).Return(errFake)
+						return wrapped.ForceDelete(ctx, log, bastionObj, cluster)
+					},
+				}
+			}),
+			Entry("ContainerRuntime", extensionsv1alpha1.ContainerRuntimeResource, runtime, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockcontainerruntime.NewMockActuator(ctrl)
+				wrapped := containerruntime.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), runtime, cluster).Return(errFake)
+						return wrapped.Reconcile(ctx, log, runtime, cluster)
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), runtime, cluster).Return(errFake)
+						return wrapped.Delete(ctx, log, runtime, cluster)
+					},
+					OperationForceDelete: func() error {
+						actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), runtime, cluster).Return(errFake)
+						return wrapped.ForceDelete(ctx, log, runtime, cluster)
+					},
+					OperationRestore: func() error {
+						actuator.EXPECT().Restore(calledInChain, gomock.Any(), runtime, cluster).Return(errFake)
+						return wrapped.Restore(ctx, log, runtime, cluster)
+					},
+					OperationMigrate: func() error {
+						actuator.EXPECT().Migrate(calledInChain, gomock.Any(), runtime, cluster).Return(errFake)
+						return wrapped.Migrate(ctx, log, runtime, cluster)
+					},
+				}
+			}),
+			Entry("ControlPlane", extensionsv1alpha1.ControlPlaneResource, controlPlane, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockcontrolplane.NewMockActuator(ctrl)
+				wrapped := controlplane.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), controlPlane, cluster).Return(true, errFake)
+						requeue, err := wrapped.Reconcile(ctx, log, controlPlane, cluster)
+						Expect(requeue).To(BeTrue())
+						return err
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), controlPlane, cluster).Return(errFake)
+						return wrapped.Delete(ctx, log, controlPlane, cluster)
+					},
+					OperationForceDelete: func() error {
+						actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), controlPlane, cluster).Return(errFake)
+						return wrapped.ForceDelete(ctx, log, controlPlane, cluster)
+					},
+					OperationRestore: func() error {
+						actuator.EXPECT().Restore(calledInChain, gomock.Any(), controlPlane, cluster).Return(true, errFake)
+						requeue, err := wrapped.Restore(ctx, log, controlPlane, cluster)
+						Expect(requeue).To(BeTrue())
+						return err
+					},
+					OperationMigrate: func() error {
+						actuator.EXPECT().Migrate(calledInChain, gomock.Any(), controlPlane, cluster).Return(errFake)
+						return wrapped.Migrate(ctx, log, controlPlane, cluster)
+					},
+				}
+			}),
+			Entry("DNSRecord", extensionsv1alpha1.DNSRecordResource, dnsRecord, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockdnsrecord.NewMockActuator(ctrl)
+				wrapped := dnsrecord.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), dnsRecord, cluster).Return(errFake)
+						return wrapped.Reconcile(ctx, log, dnsRecord, cluster)
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), dnsRecord, cluster).Return(errFake)
+						return wrapped.Delete(ctx, log, dnsRecord, cluster)
+					},
+					OperationForceDelete: func() error {
+						actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), dnsRecord, cluster).Return(errFake)
+						return wrapped.ForceDelete(ctx, log, dnsRecord, cluster)
+					},
+					OperationRestore: func() error {
+						actuator.EXPECT().Restore(calledInChain, gomock.Any(), dnsRecord, cluster).Return(errFake)
+						return wrapped.Restore(ctx, log, dnsRecord, cluster)
+					},
+					OperationMigrate: func() error {
+						actuator.EXPECT().Migrate(calledInChain, gomock.Any(), dnsRecord, cluster).Return(errFake)
+						return wrapped.Migrate(ctx, log, dnsRecord, cluster)
+					},
+				}
+			}),
+			Entry("Extension", extensionsv1alpha1.ExtensionResource, extensionObj, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockextension.NewMockActuator(ctrl)
+				wrapped := extension.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), extensionObj).Return(errFake)
+						return wrapped.Reconcile(ctx, log, extensionObj)
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), extensionObj).Return(errFake)
+						return wrapped.Delete(ctx, log, extensionObj)
+					},
+					OperationForceDelete: func() error {
+						actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), extensionObj).Return(errFake)
+						return wrapped.ForceDelete(ctx, log, extensionObj)
+					},
+					OperationRestore: func() error {
+						actuator.EXPECT().Restore(calledInChain, gomock.Any(), extensionObj).Return(errFake)
+						return wrapped.Restore(ctx, log, extensionObj)
+					},
+					OperationMigrate: func() error {
+						actuator.EXPECT().Migrate(calledInChain, gomock.Any(), extensionObj).Return(errFake)
+						return wrapped.Migrate(ctx, log, extensionObj)
+					},
+				}
+			}),
+			Entry("Infrastructure", extensionsv1alpha1.InfrastructureResource, infra, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockinfrastructure.NewMockActuator(ctrl)
+				wrapped := infrastructure.WithMiddlewares(actuator, middlewares...)
+				_, isPlanner := wrapped.(infrastructure.Planner)
+				Expect(isPlanner).To(BeFalse())
+				return actuator, wrapped, infrastructureOperations(ctx, log, actuator, wrapped, infra, cluster, calledInChain, errFake)
+			}),
+			Entry("Infrastructure with Planner", extensionsv1alpha1.InfrastructureResource, infra, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := &infrastructurePlanner{MockActuator: mockinfrastructure.NewMockActuator(ctrl), MockPlanner: mockinfrastructure.NewMockPlanner(ctrl)}
+				wrapped := infrastructure.WithMiddlewares(actuator, middlewares...)
+				operations := infrastructureOperations(ctx, log, actuator.MockActuator, wrapped, infra, cluster, calledInChain, errFake)
+				operations[OperationPlan] = func() error {
+					actuator.MockPlanner.EXPECT().Plan(calledInChain, gomock.Any(), infra, cluster).Return(&extensionsv1alpha1.InfrastructurePlan{}, errFake)
+					plan, err := wrapped.(infrastructure.Planner).Plan(ctx, log, infra, cluster)
+					Expect(plan).To(Equal(&extensionsv1alpha1.InfrastructurePlan{}))
+					return err
+				}
+				return actuator, wrapped, operations
+			}),
+			Entry("Network", extensionsv1alpha1.NetworkResource, networkObj, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mocknetwork.NewMockActuator(ctrl)
+				wrapped := network.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), networkObj, cluster).Return(errFake)
+						return wrapped.Reconcile(ctx, log, networkObj, cluster)
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), networkObj, cluster).Return(errFake)
+						return wrapped.Delete(ctx, log, networkObj, cluster)
+					},
+					OperationForceDelete: func() error {
+						actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), networkObj, cluster).Return(errFake)
+						return wrapped.ForceDelete(ctx, log, networkObj, cluster)
+					},
+					OperationRestore: func() error {
+						actuator.EXPECT().Restore(calledInChain, gomock.Any(), networkObj, cluster).Return(errFake)
+						return wrapped.Restore(ctx, log, networkObj, cluster)
+					},
+					OperationMigrate: func() error {
+						actuator.EXPECT().Migrate(calledInChain, gomock.Any(), networkObj, cluster).Return(errFake)
+						return wrapped.Migrate(ctx, log, networkObj, cluster)
+					},
+				}
+			}),
+			Entry("OperatingSystemConfig", extensionsv1alpha1.OperatingSystemConfigResource, osc, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockoperatingsystemconfig.NewMockActuator(ctrl)
+				wrapped := operatingsystemconfig.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), osc).Return([]byte("user-data"), nil, nil, nil, nil, nil, errFake)
+						userData, _, _, _, _, _, err := wrapped.Reconcile(ctx, log, osc)
+						Expect(userData).To(Equal([]byte("user-data")))
+						return err
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), osc).Return(errFake)
+						return wrapped.Delete(ctx, log, osc)
+					},
+					OperationForceDelete: func() error {
+						actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), osc).Return(errFake)
+						return wrapped.ForceDelete(ctx, log, osc)
+					},
+					OperationRestore: func() error {
+						actuator.EXPECT().Restore(calledInChain, gomock.Any(), osc).Return([]byte("user-data"), nil, nil, nil, nil, nil, errFake)
+						userData, _, _, _, _, _, err := wrapped.Restore(ctx, log, osc)
+						Expect(userData).To(Equal([]byte("user-data")))
+						return err
+					},
+					OperationMigrate: func() error {
+						actuator.EXPECT().Migrate(calledInChain, gomock.Any(), osc).Return(errFake)
+						return wrapped.Migrate(ctx, log, osc)
+					},
+				}
+			}),
+			Entry("Worker", extensionsv1alpha1.WorkerResource, workerObj, func(middlewares ...ActuatorMiddleware) (any, any, map[string]func() error) {
+				actuator := mockworker.NewMockActuator(ctrl)
+				wrapped := worker.WithMiddlewares(actuator, middlewares...)
+				return actuator, wrapped, map[string]func() error{
+					OperationReconcile: func() error {
+						actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), workerObj, cluster).Return(errFake)
+						return wrapped.Reconcile(ctx, log, workerObj, cluster)
+					},
+					OperationDelete: func() error {
+						actuator.EXPECT().Delete(calledInChain, gomock.Any(), workerObj, cluster).Return(errFake)
+						return wrapped.Delete(ctx, log, workerObj, cluster)
+					},
+					OperationForceDelete: func() error {
+						actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), workerObj, cluster).Return(errFake)
+						return wrapped.ForceDelete(ctx, log, workerObj, cluster)
+					},
+					OperationRestore: func() error {
+						actuator.EXPECT().Restore(calledInChain, gomock.Any(), workerObj, cluster).Return(errFake)
+						return wrapped.Restore(ctx, log, workerObj, cluster)
+					},
+					OperationMigrate: func() error {
+						actuator.EXPECT().Migrate(calledInChain, gomock.Any(), workerObj, cluster).Return(errFake)
+						return wrapped.Migrate(ctx, log, workerObj, cluster)
+					},
+				}
+			}),
+		)
+	})
+})
+
+func infrastructureOperations(ctx context.Context, log logr.Logger, actuator *mockinfrastructure.MockActuator, wrapped infrastructure.Actuator, infra *extensionsv1alpha1.Infrastructure, cluster *Cluster, calledInChain gomock.Matcher, errFake error) map[string]func() error {
+	return map[string]func() error{
+		OperationReconcile: func() error {
+			actuator.EXPECT().Reconcile(calledInChain, gomock.Any(), infra, cluster).Return(errFake)
+			return wrapped.Reconcile(ctx, log, infra, cluster)
+		},
+		OperationDelete: func() error {
+			actuator.EXPECT().Delete(calledInChain, gomock.Any(), infra, cluster).Return(errFake)
+			return wrapped.Delete(ctx, log, infra, cluster)
+		},
+		OperationForceDelete: func() error {
+			actuator.EXPECT().ForceDelete(calledInChain, gomock.Any(), infra, cluster).Return(errFake)
+			return wrapped.ForceDelete(ctx, log, infra, cluster)
+		},
+		OperationRestore: func() error {
+			actuator.EXPECT().Restore(calledInChain, gomock.Any(), infra, cluster).Return(errFake)
+			return wrapped.Restore(ctx, log, infra, cluster)
+		},
+		OperationMigrate: func() error {
+			actuator.EXPECT().Migrate(calledInChain, gomock.Any(), infra, cluster).Return(errFake)
+			return wrapped.Migrate(ctx, log, infra, cluster)
+		},
+	}
+}
+
+// infrastructurePlanner is an infrastructure actuator which also implements Planner.
+type infrastructurePlanner struct {
+	*mockinfrastructure.MockActuator
+	*mockinfrastructure.MockPlanner
+}
+
+// inChainMatcher matches any argument as long as the actuator is called within the middleware chain.
+type inChainMatcher struct {
+	inChain *bool
+}
+
+func (m inChainMatcher) Matches(any) bool {
+	return *m.inChain
+}
+
+func (m inChainMatcher) String() string {
+	return "is passed within the middleware chain"
+}
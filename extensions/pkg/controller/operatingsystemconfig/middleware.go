@@ -0,0 +1,99 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// WithMiddlewares returns an Actuator which executes all operations of the given actuator wrapped by the given
+// middlewares. If no middlewares are given, the actuator is returned unchanged.
+func WithMiddlewares(actuator Actuator, middlewares ...extensionscontroller.ActuatorMiddleware) Actuator {
+	if len(middlewares) == 0 {
+		return actuator
+	}
+	return &actuatorWithMiddlewares{actuator: actuator, middlewares: middlewares}
+}
+
+type actuatorWithMiddlewares struct {
+	actuator    Actuator
+	middlewares extensionscontroller.ActuatorMiddlewares
+}
+
+func (a *actuatorWithMiddlewares) run(ctx context.Context, log logr.Logger, operation string, osc *extensionsv1alpha1.OperatingSystemConfig, fn extensionscontroller.ActuatorOperation) error {
+	return a.middlewares.Run(ctx, log, extensionscontroller.ActuatorOperationInfo{Kind: extensionsv1alpha1.OperatingSystemConfigResource, Operation: operation, Object: osc}, fn)
+}
+
+// Reconcile implements Actuator.
+func (a *actuatorWithMiddlewares) Reconcile(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, *string, []string, []string, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	var (
+		userData       []byte
+		command        *string
+		unitNames      []string
+		fileNames      []string
+		extensionUnits []extensionsv1alpha1.Unit
+		extensionFiles []extensionsv1alpha1.File
+	)
+	err := a.run(ctx, log, extensionscontroller.OperationReconcile, osc, func(ctx context.Context, log logr.Logger) error {
+		var err error
+		userData, command, unitNames, fileNames, extensionUnits, extensionFiles, err = a.actuator.Reconcile(ctx, log, osc)
+		return err
+	})
+	return userData, command, unitNames, fileNames, extensionUnits, extensionFiles, err
+}
+
+// Delete implements Actuator.
+func (a *actuatorWithMiddlewares) Delete(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	return a.run(ctx, log, extensionscontroller.OperationDelete, osc, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Delete(ctx, log, osc)
+	})
+}
+
+// ForceDelete implements Actuator.
+func (a *actuatorWithMiddlewares) ForceDelete(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	return a.run(ctx, log, extensionscontroller.OperationForceDelete, osc, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.ForceDelete(ctx, log, osc)
+	})
+}
+
+// Restore implements Actuator.
+func (a *actuatorWithMiddlewares) Restore(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, *string, []string, []string, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	var (
+		userData       []byte
+		command        *string
+		unitNames      []string
+		fileNames      []string
+		extensionUnits []extensionsv1alpha1.Unit
+		extensionFiles []extensionsv1alpha1.File
+	)
+	err := a.run(ctx, log, extensionscontroller.OperationRestore, osc, func(ctx context.Context, log logr.Logger) error {
+		var err error
+		userData, command, unitNames, fileNames, extensionUnits, extensionFiles, err = a.actuator.Restore(ctx, log, osc)
+		return err
+	})
+	return userData, command, unitNames, fileNames, extensionUnits, extensionFiles, err
+}
+
+// Migrate implements Actuator.
+func (a *actuatorWithMiddlewares) Migrate(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	return a.run(ctx, log, extensionscontroller.OperationMigrate, osc, func(ctx context.Context, log logr.Logger) error {
+		return a.actuator.Migrate(ctx, log, osc)
+	})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig/oscommon"
 	oscommoncmd "github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig/oscommon/cmd"
 	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	extensionsleaderelectioncmd "github.com/gardener/gardener/extensions/pkg/leaderelection/cmd"
 	"github.com/gardener/gardener/extensions/pkg/util"
 )
 
@@ -41,6 +42,9 @@ func NewControllerCommand(ctrlName string, osTypes []string, generator generator
 			LeaderElectionID:        extensionscmdcontroller.LeaderElectionNameID(ctrlName),
 			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
 		}
+		leaderElectionOpts = &extensionsleaderelectioncmd.Options{
+			NodeName: os.Getenv("NODE_NAME"),
+		}
 		ctrlOpts = &extensionscmdcontroller.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
@@ -58,6 +62,7 @@ func NewControllerCommand(ctrlName string, osTypes []string, generator generator
 		aggOption = extensionscmdcontroller.NewOptionAggregator(
 			restOpts,
 			mgrOpts,
+			leaderElectionOpts,
 			ctrlOpts,
 			extensionscmdcontroller.PrefixOption("heartbeat-", heartbeatCtrlOpts),
 			reconcileOpts,
@@ -85,7 +90,12 @@ func NewControllerCommand(ctrlName string, osTypes []string, generator generator
 				Burst: 130,
 			}, restOpts.Completed().Config)
 
-			mgr, err := manager.New(restOpts.Completed().Config, mgrOpts.Completed().Options())
+			mgrOptions := mgrOpts.Completed().Options()
+			if err := leaderElectionOpts.Completed().Apply(restOpts.Completed().Config, &mgrOptions); err != nil {
+				return fmt.Errorf("could not apply leader election options: %w", err)
+			}
+
+			mgr, err := manager.New(restOpts.Completed().Config, mgrOptions)
 			if err != nil {
 				return fmt.Errorf("could not instantiate manager: %w", err)
 			}
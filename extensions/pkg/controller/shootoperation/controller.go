@@ -0,0 +1,94 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "shoot-operation"
+
+// DefaultAddOptions are the default AddOptions for AddToManager.
+var DefaultAddOptions = AddOptions{
+	MaxConcurrentOperations: 5,
+	RequeueInterval:         30 * time.Second,
+}
+
+// AddOptions are options to apply when adding the shoot operation controller to the manager.
+type AddOptions struct {
+	// Operations are the shoot-level operations which are fanned out to the extension-owned objects.
+	Operations []Operation
+	// ObjectLists are the lists of the types of the extension-owned objects to which the operations are fanned out.
+	ObjectLists []client.ObjectList
+	// ObjectSelector selects the extension-owned objects to which the operations are fanned out. All objects of the
+	// given types are selected if it is nil.
+	ObjectSelector labels.Selector
+	// MaxConcurrentOperations is the maximum number of objects in a shoot namespace with an operation in progress.
+	MaxConcurrentOperations int
+	// RequeueInterval is the interval after which the progress of requested operations is checked again.
+	RequeueInterval time.Duration
+}
+
+// AddToManager adds the shoot operation controller with the default Options to the manager.
+func AddToManager(_ context.Context, mgr manager.Manager) error {
+	return Add(mgr, AddArgs{
+		Operations:              DefaultAddOptions.Operations,
+		ObjectLists:             DefaultAddOptions.ObjectLists,
+		ObjectSelector:          DefaultAddOptions.ObjectSelector,
+		MaxConcurrentOperations: DefaultAddOptions.MaxConcurrentOperations,
+		RequeueInterval:         DefaultAddOptions.RequeueInterval,
+	})
+}
+
+// AddArgs are arguments for adding a shoot operation controller to a manager.
+type AddArgs struct {
+	// ControllerOptions are the controller.Options.
+	ControllerOptions controller.Options
+	// Operations are the shoot-level operations which are fanned out to the extension-owned objects.
+	Operations []Operation
+	// ObjectLists are the lists of the types of the extension-owned objects to which the operations are fanned out.
+	ObjectLists []client.ObjectList
+	// ObjectSelector selects the extension-owned objects to which the operations are fanned out. All objects of the
+	// given types are selected if it is nil.
+	ObjectSelector labels.Selector
+	// MaxConcurrentOperations is the maximum number of objects in a shoot namespace with an operation in progress.
+	MaxConcurrentOperations int
+	// RequeueInterval is the interval after which the progress of requested operations is checked again.
+	RequeueInterval time.Duration
+}
+
+// Add creates a new shoot operation controller and adds it to the given manager.
+func Add(mgr manager.Manager, args AddArgs) error {
+	args.ControllerOptions.Reconciler = NewReconciler(mgr.GetClient(), args)
+
+	ctrl, err := controller.New(ControllerName, mgr, args.ControllerOptions)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.Watch(source.Kind(mgr.GetCache(), &extensionsv1alpha1.Cluster{}), &handler.EnqueueRequestForObject{}, predicate.GenerationChangedPredicate{})
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// AnnotationOperation is the annotation which is set on extension-owned objects to request an operation. Its value
+	// is the name of the requested operation. The extension removes the annotation once it has handled the operation.
+	AnnotationOperation = "extensions.gardener.cloud/operation"
+	// AnnotationOperationIDPrefix is the prefix of the annotations which contain the ID of the last instance of an
+	// operation which was requested for an extension-owned object. The name of the operation is appended to the prefix.
+	AnnotationOperationIDPrefix = "extensions.gardener.cloud/operation-id-"
+
+	// OperationNameRotateCredentials is the name of the operation requesting extensions to rotate the credentials they
+	// manage for a shoot.
+	OperationNameRotateCredentials = "rotate-credentials"
+	// OperationNameRestart is the name of the operation requesting extensions to restart the components they manage for
+	// a shoot.
+	OperationNameRestart = "restart"
+)
+
+// Operation is a shoot-level operation which is fanned out to the extension-owned objects in the shoot namespace.
+type Operation struct {
+	// Name is the name of the operation. It is used as value of the AnnotationOperation annotation.
+	Name string
+	// ID returns the ID of the currently requested instance of the operation and true if the operation is requested for
+	// the given shoot. Each instance of an operation is fanned out only once per object.
+	ID func(shoot *gardencorev1beta1.Shoot) (string, bool)
+}
+
+// OperationRotateCredentials is requested while the rotation of the certificate authorities of the shoot is being
+// prepared.
+var OperationRotateCredentials = Operation{
+	Name: OperationNameRotateCredentials,
+	ID: func(shoot *gardencorev1beta1.Shoot) (string, bool) {
+		if v1beta1helper.GetShootCARotationPhase(shoot.Status.Credentials) != gardencorev1beta1.RotationPreparing {
+			return "", false
+		}
+
+		lastInitiationTime := shoot.Status.Credentials.Rotation.CertificateAuthorities.LastInitiationTime
+		if lastInitiationTime == nil {
+			return "", false
+		}
+		return lastInitiationTime.UTC().Format(time.RFC3339), true
+	},
+}
+
+// OperationRestart is requested while the shoot has the task for restarting its control plane pods.
+var OperationRestart = Operation{
+	Name: OperationNameRestart,
+	ID: func(shoot *gardencorev1beta1.Shoot) (string, bool) {
+		if !controllerutils.HasTask(shoot.Annotations, v1beta1constants.ShootTaskRestartControlPlanePods) {
+			return "", false
+		}
+		return strconv.FormatInt(shoot.Generation, 10), true
+	},
+}
+
+// IsOperationRequested returns true if the operation with the given name is requested for the given object.
+func IsOperationRequested(obj metav1.Object, name string) bool {
+	return obj.GetAnnotations()[AnnotationOperation] == name
+}
+
+// CompleteOperation removes the AnnotationOperation annotation from the given object to signal that the requested
+// operation has been handled.
+func CompleteOperation(ctx context.Context, c client.Client, obj client.Object) error {
+	return extensionscontroller.RemoveAnnotation(ctx, c, obj, AnnotationOperation)
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/shootoperation"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Operation", func() {
+	var shoot *gardencorev1beta1.Shoot
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Generation: 3}}
+	})
+
+	Describe("#OperationRotateCredentials", func() {
+		It("should not be requested if no rotation was started", func() {
+			_, requested := OperationRotateCredentials.ID(shoot)
+			Expect(requested).To(BeFalse())
+		})
+
+		It("should not be requested if the rotation is not being prepared", func() {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPrepared, LastInitiationTime: &metav1.Time{Time: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)}},
+			}}
+
+			_, requested := OperationRotateCredentials.ID(shoot)
+			Expect(requested).To(BeFalse())
+		})
+
+		It("should be requested with the initiation time as ID if the rotation is being prepared", func() {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPreparing, LastInitiationTime: &metav1.Time{Time: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)}},
+			}}
+
+			id, requested := OperationRotateCredentials.ID(shoot)
+			Expect(requested).To(BeTrue())
+			Expect(id).To(Equal("2023-10-01T12:00:00Z"))
+		})
+	})
+
+	Describe("#OperationRestart", func() {
+		It("should not be requested if the shoot does not have the restart task", func() {
+			_, requested := OperationRestart.ID(shoot)
+			Expect(requested).To(BeFalse())
+		})
+
+		It("should be requested with the generation as ID if the shoot has the restart task", func() {
+			shoot.Annotations = map[string]string{v1beta1constants.ShootTasks: v1beta1constants.ShootTaskRestartControlPlanePods}
+
+			id, requested := OperationRestart.ID(shoot)
+			Expect(requested).To(BeTrue())
+			Expect(id).To(Equal("3"))
+		})
+	})
+
+	Describe("#IsOperationRequested", func() {
+		It("should return whether the operation is requested", func() {
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationOperation: "restart"}}}
+
+			Expect(IsOperationRequested(obj, "restart")).To(BeTrue())
+			Expect(IsOperationRequested(obj, "rotate-credentials")).To(BeFalse())
+		})
+	})
+
+	Describe("#CompleteOperation", func() {
+		It("should remove the operation annotation", func() {
+			ctx := context.Background()
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar", Annotations: map[string]string{
+				AnnotationOperation:                     "restart",
+				AnnotationOperationIDPrefix + "restart": "3",
+			}}}
+			Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+
+			Expect(CompleteOperation(ctx, fakeClient, obj)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+			Expect(obj.Annotations).To(Equal(map[string]string{AnnotationOperationIDPrefix + "restart": "3"}))
+		})
+	})
+})
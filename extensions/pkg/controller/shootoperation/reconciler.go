@@ -0,0 +1,174 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+type reconciler struct {
+	client                  client.Client
+	operations              []Operation
+	objectLists             []client.ObjectList
+	objectSelector          labels.Selector
+	maxConcurrentOperations int
+	requeueInterval         time.Duration
+}
+
+// NewReconciler creates a new reconciler that fans out the requested shoot-level operations to the extension-owned
+// objects in the shoot namespace.
+func NewReconciler(c client.Client, args AddArgs) reconcile.Reconciler {
+	objectSelector := args.ObjectSelector
+	if objectSelector == nil {
+		objectSelector = labels.Everything()
+	}
+
+	return &reconciler{
+		client:                  c,
+		operations:              args.Operations,
+		objectLists:             args.ObjectLists,
+		objectSelector:          objectSelector,
+		maxConcurrentOperations: args.MaxConcurrentOperations,
+		requeueInterval:         args.RequeueInterval,
+	}
+}
+
+// Reconcile fans out the operations requested for the shoot of the given Cluster to the extension-owned objects in the
+// shoot namespace and requeues until all objects have handled them.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, request.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if cluster.Shoot == nil || cluster.Shoot.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	objects, err := r.listObjects(ctx, request.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var pending bool
+	for _, operation := range r.operations {
+		id, requested := operation.ID(cluster.Shoot)
+		if !requested {
+			continue
+		}
+
+		completed, err := r.fanOut(ctx, operation.Name, id, objects)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed fanning out operation %q: %w", operation.Name, err)
+		}
+
+		if !completed {
+			log.Info("Operation is in progress", "operation", operation.Name, "id", id)
+			pending = true
+			continue
+		}
+		log.V(1).Info("Operation has been completed for all objects", "operation", operation.Name, "id", id)
+	}
+
+	if pending {
+		return reconcile.Result{RequeueAfter: r.requeueInterval}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) listObjects(ctx context.Context, namespace string) ([]client.Object, error) {
+	var objects []client.Object
+
+	for _, objectList := range r.objectLists {
+		list := objectList.DeepCopyObject().(client.ObjectList)
+		if err := r.client.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: r.objectSelector}); err != nil {
+			return nil, err
+		}
+
+		if err := meta.EachListItem(list, func(obj runtime.Object) error {
+			object, ok := obj.(client.Object)
+			if !ok {
+				return fmt.Errorf("unexpected object type %T", obj)
+			}
+			objects = append(objects, object)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return objects, nil
+}
+
+// fanOut requests the operation with the given name and ID for all objects which did not receive it yet, while
+// respecting the maximum number of concurrent operations. It returns true if all objects have handled the operation.
+func (r *reconciler) fanOut(ctx context.Context, name, id string, objects []client.Object) (bool, error) {
+	var (
+		idAnnotation = AnnotationOperationIDPrefix + name
+		inProgress   int
+		outstanding  int
+	)
+
+	for _, obj := range objects {
+		if _, ok := obj.GetAnnotations()[AnnotationOperation]; ok {
+			inProgress++
+		}
+	}
+
+	for _, obj := range objects {
+		annotations := obj.GetAnnotations()
+
+		if annotations[idAnnotation] == id {
+			if IsOperationRequested(obj, name) {
+				outstanding++
+			}
+			continue
+		}
+
+		outstanding++
+		if _, ok := annotations[AnnotationOperation]; ok || inProgress >= r.maxConcurrentOperations {
+			continue
+		}
+
+		patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+		kubernetesutils.SetMetaDataAnnotation(obj, AnnotationOperation, name)
+		kubernetesutils.SetMetaDataAnnotation(obj, idAnnotation, id)
+		if err := r.client.Patch(ctx, obj, patch); err != nil {
+			return false, err
+		}
+		inProgress++
+	}
+
+	return outstanding == 0, nil
+}
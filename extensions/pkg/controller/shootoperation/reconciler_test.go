@@ -0,0 +1,186 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/shootoperation"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace       = "shoot--foo--bar"
+		requeueInterval = 30 * time.Second
+	)
+
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler reconcile.Reconciler
+		request    = reconcile.Request{NamespacedName: types.NamespacedName{Name: namespace}}
+
+		shoot   *gardencorev1beta1.Shoot
+		cluster *extensionsv1alpha1.Cluster
+
+		createConfigMaps = func(names ...string) {
+			for _, name := range names {
+				ExpectWithOffset(1, fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    map[string]string{"owned-by": "extension"},
+				}})).To(Succeed())
+			}
+		}
+		getAnnotations = func(name string) map[string]string {
+			configMap := &corev1.ConfigMap{}
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, configMap)).To(Succeed())
+			return configMap.Annotations
+		}
+		completeOperation = func(name string) {
+			configMap := &corev1.ConfigMap{}
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, configMap)).To(Succeed())
+			ExpectWithOffset(1, CompleteOperation(ctx, fakeClient, configMap)).To(Succeed())
+		}
+		createCluster = func() {
+			raw, err := json.Marshal(shoot)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			cluster.Spec.Shoot = runtime.RawExtension{Raw: raw}
+			ExpectWithOffset(1, fakeClient.Create(ctx, cluster)).To(Succeed())
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		reconciler = NewReconciler(fakeClient, AddArgs{
+			Operations:              []Operation{OperationRestart, OperationRotateCredentials},
+			ObjectLists:             []client.ObjectList{&corev1.ConfigMapList{}},
+			ObjectSelector:          labels.SelectorFromSet(labels.Set{"owned-by": "extension"}),
+			MaxConcurrentOperations: 2,
+			RequeueInterval:         requeueInterval,
+		})
+
+		shoot = &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				Generation:  2,
+				Annotations: map[string]string{v1beta1constants.ShootTasks: v1beta1constants.ShootTaskRestartControlPlanePods},
+			},
+		}
+		cluster = &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+	})
+
+	It("should do nothing if the cluster does not exist", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if no operation is requested", func() {
+		shoot.Annotations = nil
+		createCluster()
+		createConfigMaps("foo")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getAnnotations("foo")).To(BeEmpty())
+	})
+
+	It("should not request operations for objects which are not selected", func() {
+		createCluster()
+		Expect(fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace}})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getAnnotations("other")).To(BeEmpty())
+	})
+
+	It("should fan out the operation with rate limiting and track its completion", func() {
+		createCluster()
+		createConfigMaps("cm1", "cm2", "cm3")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: requeueInterval}))
+		Expect(getAnnotations("cm1")).To(Equal(map[string]string{AnnotationOperation: "restart", AnnotationOperationIDPrefix + "restart": "2"}))
+		Expect(getAnnotations("cm2")).To(Equal(map[string]string{AnnotationOperation: "restart", AnnotationOperationIDPrefix + "restart": "2"}))
+		Expect(getAnnotations("cm3")).To(BeEmpty())
+
+		completeOperation("cm1")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: requeueInterval}))
+		Expect(getAnnotations("cm1")).To(Equal(map[string]string{AnnotationOperationIDPrefix + "restart": "2"}))
+		Expect(getAnnotations("cm3")).To(Equal(map[string]string{AnnotationOperation: "restart", AnnotationOperationIDPrefix + "restart": "2"}))
+
+		completeOperation("cm2")
+		completeOperation("cm3")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		for _, name := range []string{"cm1", "cm2", "cm3"} {
+			Expect(getAnnotations(name)).To(Equal(map[string]string{AnnotationOperationIDPrefix + "restart": "2"}))
+		}
+	})
+
+	It("should request a new instance of the operation", func() {
+		createCluster()
+		createConfigMaps("cm1")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: requeueInterval}))
+		completeOperation("cm1")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		shoot.Generation = 5
+		raw, err := json.Marshal(shoot)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Spec.Shoot = runtime.RawExtension{Raw: raw}
+		Expect(fakeClient.Update(ctx, cluster)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: requeueInterval}))
+		Expect(getAnnotations("cm1")).To(Equal(map[string]string{AnnotationOperation: "restart", AnnotationOperationIDPrefix + "restart": "5"}))
+	})
+
+	It("should not overwrite an operation which is still in progress", func() {
+		shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+			CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPreparing, LastInitiationTime: &metav1.Time{Time: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)}},
+		}}
+		createCluster()
+		createConfigMaps("cm1")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: requeueInterval}))
+		Expect(getAnnotations("cm1")).To(Equal(map[string]string{AnnotationOperation: "restart", AnnotationOperationIDPrefix + "restart": "2"}))
+
+		completeOperation("cm1")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: requeueInterval}))
+		Expect(getAnnotations("cm1")).To(Equal(map[string]string{
+			AnnotationOperation:                                "rotate-credentials",
+			AnnotationOperationIDPrefix + "restart":            "2",
+			AnnotationOperationIDPrefix + "rotate-credentials": "2023-10-01T12:00:00Z",
+		}))
+	})
+})
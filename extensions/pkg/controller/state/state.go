@@ -0,0 +1,240 @@
+// Copyright 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// DefaultMaxSize is the default maximum size in bytes of the encoded state. The state of all extension resources of a
+	// shoot is persisted in the ShootState resource in the garden cluster, hence, it must be kept small.
+	DefaultMaxSize = 256 * 1024
+	// DefaultCompressionThreshold is the default size in bytes of the serialized state above which it gets compressed.
+	DefaultCompressionThreshold = 4 * 1024
+)
+
+// ConversionFunc converts the serialized state data of a certain schema version into the data of a newer schema
+// version. It returns the new version and the converted data.
+type ConversionFunc func(data []byte) (string, []byte, error)
+
+// envelope is the format of the state persisted in the `.status.state` field of extension resources.
+type envelope struct {
+	// SchemaVersion is the version of the schema of the state data.
+	SchemaVersion string `json:"schemaVersion"`
+	// Data is the serialized state if it is not compressed.
+	Data json.RawMessage `json:"data,omitempty"`
+	// CompressedData is the gzip-compressed serialized state.
+	CompressedData []byte `json:"compressedData,omitempty"`
+}
+
+// Store encodes extension-specific state into the `.status.state` field of extension resources and decodes it from
+// there. Gardener persists this field in the ShootState resource in the garden cluster and restores it before the
+// `restore` operation, so that the state survives the control plane migration of a shoot.
+type Store struct {
+	// SchemaVersion is the current version of the state schema. It is persisted together with the state.
+	SchemaVersion string
+	// Conversions contains functions converting state of older schema versions, keyed by the version they convert
+	// from. The empty version is used for states which were not persisted with this Store (e.g., states which were
+	// directly written as JSON into the `.status.state` field).
+	Conversions map[string]ConversionFunc
+	// MaxSize is the maximum size in bytes of the encoded state. Defaults to DefaultMaxSize.
+	MaxSize int
+	// CompressionThreshold is the size in bytes of the serialized state above which it gets compressed. Defaults to
+	// DefaultCompressionThreshold. A negative value disables the compression.
+	CompressionThreshold int
+}
+
+// Encode serializes the given state and returns it in the format to be persisted in the `.status.state` field.
+func (s *Store) Encode(state any) (*runtime.RawExtension, error) {
+	if s.SchemaVersion == "" {
+		return nil, fmt.Errorf("schema version must not be empty")
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling state: %w", err)
+	}
+
+	env := envelope{SchemaVersion: s.SchemaVersion, Data: data}
+
+	if threshold := s.compressionThreshold(); threshold >= 0 && len(data) > threshold {
+		compressed, err := compress(data)
+		if err != nil {
+			return nil, err
+		}
+		env.Data, env.CompressedData = nil, compressed
+	}
+
+	raw, err := json.Marshal(env)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling state envelope: %w", err)
+	}
+
+	if maxSize := s.maxSize(); len(raw) > maxSize {
+		return nil, fmt.Errorf("encoded state has %d bytes which exceeds the maximum size of %d bytes", len(raw), maxSize)
+	}
+
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// Decode deserializes the given state into the given object. If the state was persisted with an older schema version
+// then it is converted to the current schema version first. It returns false if there is no state.
+func (s *Store) Decode(raw *runtime.RawExtension, into any) (bool, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return false, nil
+	}
+
+	env := envelope{}
+	if err := json.Unmarshal(raw.Raw, &env); err != nil || env.SchemaVersion == "" {
+		// The state was not persisted with a Store, hence, it is treated as unversioned state.
+		env = envelope{Data: raw.Raw}
+	}
+
+	data := []byte(env.Data)
+	if len(env.CompressedData) > 0 {
+		var err error
+		if data, err = decompress(env.CompressedData); err != nil {
+			return false, err
+		}
+	}
+
+	data, err := s.convert(env.SchemaVersion, data)
+	if err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal(data, into); err != nil {
+		return false, fmt.Errorf("failed unmarshalling state of schema version %q: %w", s.SchemaVersion, err)
+	}
+
+	return true, nil
+}
+
+// Persist encodes the given state and writes it into the `.status.state` field of the given extension object. The
+// object is only patched if the state has changed.
+func (s *Store) Persist(ctx context.Context, c client.StatusClient, obj extensionsv1alpha1.Object, state any) error {
+	raw, err := s.Encode(state)
+	if err != nil {
+		return err
+	}
+
+	if current := obj.GetExtensionStatus().GetState(); current != nil && equalJSON(current.Raw, raw.Raw) {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	obj.GetExtensionStatus().SetState(raw)
+	return c.Status().Patch(ctx, obj, patch)
+}
+
+// Restore decodes the state from the `.status.state` field of the given extension object into the given object. It is
+// meant to be called in the `Restore` method of actuators. It returns false if the extension object has no state.
+func (s *Store) Restore(obj extensionsv1alpha1.Object, into any) (bool, error) {
+	return s.Decode(obj.GetExtensionStatus().GetState(), into)
+}
+
+func (s *Store) convert(version string, data []byte) ([]byte, error) {
+	visited := make(map[string]struct{})
+
+	for version != s.SchemaVersion {
+		if _, ok := visited[version]; ok {
+			return nil, fmt.Errorf("conversion of state schema version %q results in a cycle", version)
+		}
+		visited[version] = struct{}{}
+
+		conversion, ok := s.Conversions[version]
+		if !ok {
+			return nil, fmt.Errorf("no conversion from state schema version %q to %q registered", version, s.SchemaVersion)
+		}
+
+		newVersion, newData, err := conversion(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed converting state from schema version %q: %w", version, err)
+		}
+		version, data = newVersion, newData
+	}
+
+	return data, nil
+}
+
+func (s *Store) maxSize() int {
+	if s.MaxSize > 0 {
+		return s.MaxSize
+	}
+	return DefaultMaxSize
+}
+
+func (s *Store) compressionThreshold() int {
+	if s.CompressionThreshold != 0 {
+		return s.CompressionThreshold
+	}
+	return DefaultCompressionThreshold
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writer, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating gzip writer for compressing state: %w", err)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, fmt.Errorf("failed writing state to gzip writer: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed closing gzip writer after compressing state: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating gzip reader for decompressing state: %w", err)
+	}
+	defer reader.Close()
+
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading decompressed state: %w", err)
+	}
+
+	return decompressed, nil
+}
+
+// equalJSON compares the given JSON documents semantically since the API server does not preserve the order of keys.
+func equalJSON(a, b []byte) bool {
+	var objA, objB any
+	if err := json.Unmarshal(a, &objA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &objB); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(objA, objB)
+}
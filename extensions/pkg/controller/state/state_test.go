@@ -0,0 +1,188 @@
+// Copyright 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/state"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+type testState struct {
+	Resources []string `json:"resources,omitempty"`
+	Owner     string   `json:"owner,omitempty"`
+}
+
+var _ = Describe("Store", func() {
+	var store *Store
+
+	BeforeEach(func() {
+		store = &Store{SchemaVersion: "v2"}
+	})
+
+	Describe("#Encode", func() {
+		It("should encode small states without compression", func() {
+			raw, err := store.Encode(&testState{Resources: []string{"foo"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw.Raw)).To(Equal(`{"schemaVersion":"v2","data":{"resources":["foo"]}}`))
+		})
+
+		It("should compress large states", func() {
+			state := &testState{Resources: []string{strings.Repeat("a", 10*1024)}}
+
+			raw, err := store.Encode(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw.Raw)).To(ContainSubstring(`"compressedData":`))
+			Expect(len(raw.Raw)).To(BeNumerically("<", 1024))
+
+			decoded := &testState{}
+			Expect(store.Decode(raw, decoded)).To(BeTrue())
+			Expect(decoded).To(Equal(state))
+		})
+
+		It("should not compress large states if compression is disabled", func() {
+			store.CompressionThreshold = -1
+
+			raw, err := store.Encode(&testState{Resources: []string{strings.Repeat("a", 10*1024)}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw.Raw)).NotTo(ContainSubstring(`"compressedData":`))
+		})
+
+		It("should fail if the encoded state exceeds the maximum size", func() {
+			store.MaxSize = 10
+
+			_, err := store.Encode(&testState{Resources: []string{"foo"}})
+			Expect(err).To(MatchError(ContainSubstring("exceeds the maximum size of 10 bytes")))
+		})
+
+		It("should fail if the schema version is not set", func() {
+			store.SchemaVersion = ""
+
+			_, err := store.Encode(&testState{})
+			Expect(err).To(MatchError("schema version must not be empty"))
+		})
+	})
+
+	Describe("#Decode", func() {
+		BeforeEach(func() {
+			store.Conversions = map[string]ConversionFunc{
+				"": func(data []byte) (string, []byte, error) {
+					return "v1", data, nil
+				},
+				"v1": func(data []byte) (string, []byte, error) {
+					old := map[string]string{}
+					if err := json.Unmarshal(data, &old); err != nil {
+						return "", nil, err
+					}
+					newData, err := json.Marshal(&testState{Resources: []string{old["resource"]}, Owner: "converted"})
+					return "v2", newData, err
+				},
+			}
+		})
+
+		It("should return false if there is no state", func() {
+			Expect(store.Decode(nil, &testState{})).To(BeFalse())
+			Expect(store.Decode(&runtime.RawExtension{}, &testState{})).To(BeFalse())
+		})
+
+		It("should decode a state of the current schema version", func() {
+			decoded := &testState{}
+			Expect(store.Decode(&runtime.RawExtension{Raw: []byte(`{"schemaVersion":"v2","data":{"resources":["foo"],"owner":"bar"}}`)}, decoded)).To(BeTrue())
+			Expect(decoded).To(Equal(&testState{Resources: []string{"foo"}, Owner: "bar"}))
+		})
+
+		It("should convert a state of an older schema version", func() {
+			decoded := &testState{}
+			Expect(store.Decode(&runtime.RawExtension{Raw: []byte(`{"schemaVersion":"v1","data":{"resource":"foo"}}`)}, decoded)).To(BeTrue())
+			Expect(decoded).To(Equal(&testState{Resources: []string{"foo"}, Owner: "converted"}))
+		})
+
+		It("should convert an unversioned state", func() {
+			decoded := &testState{}
+			Expect(store.Decode(&runtime.RawExtension{Raw: []byte(`{"resource":"foo"}`)}, decoded)).To(BeTrue())
+			Expect(decoded).To(Equal(&testState{Resources: []string{"foo"}, Owner: "converted"}))
+		})
+
+		It("should fail if there is no conversion for the schema version", func() {
+			_, err := store.Decode(&runtime.RawExtension{Raw: []byte(`{"schemaVersion":"v0","data":{}}`)}, &testState{})
+			Expect(err).To(MatchError(`no conversion from state schema version "v0" to "v2" registered`))
+		})
+
+		It("should fail if the conversions result in a cycle", func() {
+			store.Conversions["v1"] = func(data []byte) (string, []byte, error) { return "", data, nil }
+
+			_, err := store.Decode(&runtime.RawExtension{Raw: []byte(`{"schemaVersion":"v1","data":{}}`)}, &testState{})
+			Expect(err).To(MatchError(`conversion of state schema version "v1" results in a cycle`))
+		})
+
+		It("should fail if a conversion fails", func() {
+			store.Conversions["v1"] = func([]byte) (string, []byte, error) { return "", nil, fmt.Errorf("fake") }
+
+			_, err := store.Decode(&runtime.RawExtension{Raw: []byte(`{"schemaVersion":"v1","data":{}}`)}, &testState{})
+			Expect(err).To(MatchError(`failed converting state from schema version "v1": fake`))
+		})
+	})
+
+	Describe("#Persist and #Restore", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			extension  *extensionsv1alpha1.Extension
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Extension{}).Build()
+
+			extension = &extensionsv1alpha1.Extension{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "shoot--foo--bar"}}
+			Expect(fakeClient.Create(ctx, extension)).To(Succeed())
+		})
+
+		It("should persist the state in the status and restore it", func() {
+			Expect(store.Persist(ctx, fakeClient, extension, &testState{Resources: []string{"foo"}})).To(Succeed())
+
+			restored := &extensionsv1alpha1.Extension{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(extension), restored)).To(Succeed())
+			Expect(restored.Status.State.Raw).To(MatchJSON(`{"schemaVersion":"v2","data":{"resources":["foo"]}}`))
+
+			decoded := &testState{}
+			Expect(store.Restore(restored, decoded)).To(BeTrue())
+			Expect(decoded).To(Equal(&testState{Resources: []string{"foo"}}))
+		})
+
+		It("should not patch the object if the state did not change", func() {
+			Expect(store.Persist(ctx, fakeClient, extension, &testState{Resources: []string{"foo"}})).To(Succeed())
+			resourceVersion := extension.ResourceVersion
+
+			Expect(store.Persist(ctx, fakeClient, extension, &testState{Resources: []string{"foo"}})).To(Succeed())
+			Expect(extension.ResourceVersion).To(Equal(resourceVersion))
+		})
+
+		It("should return false if the object has no state", func() {
+			Expect(store.Restore(extension, &testState{})).To(BeFalse())
+		})
+	})
+})
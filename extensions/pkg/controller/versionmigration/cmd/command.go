@@ -0,0 +1,84 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	extensionscmdcontroller "github.com/gardener/gardener/extensions/pkg/controller/cmd"
+	"github.com/gardener/gardener/extensions/pkg/controller/versionmigration"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+// NewCommand creates a new command which migrates the provider configurations of the extension objects of the given
+// extension type to the given target version. It is meant to be added as a sub-command to the extension's controller
+// manager command and to be executed, e.g., as a job after the extension was updated to a version which stores the
+// target version.
+func NewCommand(extensionType string, targetVersion schema.GroupVersion, converter versionmigration.Converter) *cobra.Command {
+	var (
+		restOpts      = &extensionscmdcontroller.RESTOptions{}
+		migrationOpts = &Options{
+			Type:                    extensionType,
+			ProgressNamespace:       os.Getenv("LEADER_ELECTION_NAMESPACE"),
+			ProgressConfigMapPrefix: "provider-config-migration",
+			BatchSize:               versionmigration.DefaultBatchSize,
+		}
+
+		aggOption = extensionscmdcontroller.NewOptionAggregator(
+			restOpts,
+			migrationOpts,
+		)
+	)
+
+	cmd := &cobra.Command{
+		Use:   "migrate-provider-config",
+		Short: fmt.Sprintf("Migrates the stored provider configurations of %s extension objects to %s", extensionType, targetVersion),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := logf.Log.WithName("provider-config-migration")
+
+			if err := aggOption.Complete(); err != nil {
+				return fmt.Errorf("error completing options: %w", err)
+			}
+
+			c, err := client.New(restOpts.Completed().Config, client.Options{Scheme: kubernetes.SeedScheme})
+			if err != nil {
+				return fmt.Errorf("could not create client: %w", err)
+			}
+
+			for _, migrator := range migrationOpts.Completed().Migrators(c, log, targetVersion, converter) {
+				progress, err := migrator.Migrate(ctx)
+				if err != nil {
+					return fmt.Errorf("failed migrating %s objects: %w", migrator.GVK.Kind, err)
+				}
+
+				log.Info("Migration completed", "kind", migrator.GVK.Kind, "migrated", progress.Migrated, "skipped", progress.Skipped)
+			}
+
+			return nil
+		},
+	}
+
+	aggOption.AddFlags(cmd.Flags())
+
+	return cmd
+}
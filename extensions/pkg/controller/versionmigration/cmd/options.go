@@ -0,0 +1,150 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/versionmigration"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// KindsFlag is the name of the command line flag to specify the kinds of the extension objects to migrate.
+	KindsFlag = "kinds"
+	// TypeFlag is the name of the command line flag to specify the extension type of the objects to migrate.
+	TypeFlag = "type"
+	// ProgressNamespaceFlag is the name of the command line flag to specify the namespace of the progress ConfigMaps.
+	ProgressNamespaceFlag = "progress-namespace"
+	// ProgressConfigMapPrefixFlag is the name of the command line flag to specify the name prefix of the progress
+	// ConfigMaps.
+	ProgressConfigMapPrefixFlag = "progress-configmap-prefix"
+	// BatchSizeFlag is the name of the command line flag to specify the number of objects listed per page.
+	BatchSizeFlag = "batch-size"
+	// DryRunFlag is the name of the command line flag to only send dry-run patches.
+	DryRunFlag = "dry-run"
+)
+
+// Options are command line options that can be set for the provider config migration.
+type Options struct {
+	// Kinds are the kinds of the extension objects in the extensions.gardener.cloud/v1alpha1 API group to migrate.
+	Kinds []string
+	// Type is the extension type of the objects to migrate.
+	Type string
+	// ProgressNamespace is the namespace of the ConfigMaps used for tracking the progress.
+	ProgressNamespace string
+	// ProgressConfigMapPrefix is the name prefix of the ConfigMaps used for tracking the progress.
+	ProgressConfigMapPrefix string
+	// BatchSize is the number of objects listed per page.
+	BatchSize int64
+	// DryRun indicates whether the patches should only be sent as dry-run requests.
+	DryRun bool
+
+	config *Config
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.Kinds, KindsFlag, o.Kinds, "The kinds of the extension objects whose provider config shall be migrated, e.g. Infrastructure,ControlPlane,Worker.")
+	fs.StringVar(&o.Type, TypeFlag, o.Type, "The extension type of the objects whose provider config shall be migrated.")
+	fs.StringVar(&o.ProgressNamespace, ProgressNamespaceFlag, o.ProgressNamespace, "The namespace of the ConfigMaps which track the progress of the migration.")
+	fs.StringVar(&o.ProgressConfigMapPrefix, ProgressConfigMapPrefixFlag, o.ProgressConfigMapPrefix, "The name prefix of the ConfigMaps which track the progress of the migration.")
+	fs.Int64Var(&o.BatchSize, BatchSizeFlag, o.BatchSize, "The number of objects which are listed and migrated per page.")
+	fs.BoolVar(&o.DryRun, DryRunFlag, o.DryRun, "If true, the patches are only sent as dry-run requests and the progress is not persisted.")
+}
+
+// Complete implements Completer.Complete.
+func (o *Options) Complete() error {
+	if len(o.Kinds) == 0 {
+		return fmt.Errorf("--%s must not be empty", KindsFlag)
+	}
+	if len(o.Type) == 0 {
+		return fmt.Errorf("--%s must not be empty", TypeFlag)
+	}
+	if len(o.ProgressNamespace) == 0 {
+		return fmt.Errorf("--%s must not be empty", ProgressNamespaceFlag)
+	}
+	if len(o.ProgressConfigMapPrefix) == 0 {
+		return fmt.Errorf("--%s must not be empty", ProgressConfigMapPrefixFlag)
+	}
+	if o.BatchSize <= 0 {
+		return fmt.Errorf("--%s must be greater than 0", BatchSizeFlag)
+	}
+
+	o.config = &Config{
+		Kinds:                   o.Kinds,
+		Type:                    o.Type,
+		ProgressNamespace:       o.ProgressNamespace,
+		ProgressConfigMapPrefix: o.ProgressConfigMapPrefix,
+		BatchSize:               o.BatchSize,
+		DryRun:                  o.DryRun,
+	}
+	return nil
+}
+
+// Completed returns the completed Config. Only call this if `Complete` was successful.
+func (o *Options) Completed() *Config {
+	return o.config
+}
+
+// Config is a completed provider config migration configuration.
+type Config struct {
+	// Kinds are the kinds of the extension objects in the extensions.gardener.cloud/v1alpha1 API group to migrate.
+	Kinds []string
+	// Type is the extension type of the objects to migrate.
+	Type string
+	// ProgressNamespace is the namespace of the ConfigMaps used for tracking the progress.
+	ProgressNamespace string
+	// ProgressConfigMapPrefix is the name prefix of the ConfigMaps used for tracking the progress.
+	ProgressConfigMapPrefix string
+	// BatchSize is the number of objects listed per page.
+	BatchSize int64
+	// DryRun indicates whether the patches should only be sent as dry-run requests.
+	DryRun bool
+}
+
+// Migrators returns a Migrator for each configured kind. The progress of each Migrator is tracked in a dedicated
+// ConfigMap named '<prefix>-<type>-<kind>'.
+func (c *Config) Migrators(cl client.Client, log logr.Logger, targetVersion schema.GroupVersion, converter versionmigration.Converter) []*versionmigration.Migrator {
+	migrators := make([]*versionmigration.Migrator, 0, len(c.Kinds))
+
+	for _, kind := range c.Kinds {
+		migrators = append(migrators, &versionmigration.Migrator{
+			Client:          cl,
+			Log:             log.WithName(strings.ToLower(kind)),
+			GVK:             extensionsv1alpha1.SchemeGroupVersion.WithKind(kind),
+			Type:            c.Type,
+			TargetVersion:   targetVersion,
+			Converter:       converter,
+			ProgressTracker: versionmigration.NewConfigMapProgressTracker(cl, c.ProgressNamespace, ProgressConfigMapName(c.ProgressConfigMapPrefix, c.Type, kind)),
+			BatchSize:       c.BatchSize,
+			DryRun:          c.DryRun,
+		})
+	}
+
+	return migrators
+}
+
+// ProgressConfigMapName returns the name of the ConfigMap which tracks the migration progress of the given extension
+// type and kind.
+func ProgressConfigMapName(prefix, extensionType, kind string) string {
+	return strings.ToLower(fmt.Sprintf("%s-%s-%s", prefix, extensionType, kind))
+}
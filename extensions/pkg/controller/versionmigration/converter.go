@@ -0,0 +1,90 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmigration
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+)
+
+// Converter converts serialized provider configurations to a target version.
+type Converter interface {
+	// Convert converts the given serialized provider configuration. It returns the converted provider configuration
+	// and whether a conversion was necessary at all.
+	Convert(data []byte) ([]byte, bool, error)
+}
+
+// ConverterFunc is a function that implements Converter.
+type ConverterFunc func(data []byte) ([]byte, bool, error)
+
+// Convert implements Converter.
+func (f ConverterFunc) Convert(data []byte) ([]byte, bool, error) {
+	return f(data)
+}
+
+type schemeConverter struct {
+	scheme  *runtime.Scheme
+	codecs  serializer.CodecFactory
+	encoder runtime.Encoder
+	target  schema.GroupVersion
+}
+
+// NewSchemeConverter returns a Converter which converts provider configurations to the given target version with the
+// help of the conversion functions registered in the given scheme. The scheme must contain the internal version of the
+// provider API as well as all versions that might still be stored. Note that the defaulting functions registered in
+// the scheme are applied while decoding.
+func NewSchemeConverter(scheme *runtime.Scheme, target schema.GroupVersion) Converter {
+	codecs := serializer.NewCodecFactory(scheme)
+
+	return &schemeConverter{
+		scheme:  scheme,
+		codecs:  codecs,
+		encoder: codecs.EncoderForVersion(jsonserializer.NewSerializerWithOptions(jsonserializer.DefaultMetaFactory, scheme, scheme, jsonserializer.SerializerOptions{}), target),
+		target:  target,
+	}
+}
+
+func (c *schemeConverter) Convert(data []byte) ([]byte, bool, error) {
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(data, typeMeta); err != nil {
+		return nil, false, fmt.Errorf("failed reading type information: %w", err)
+	}
+
+	gvk := schema.FromAPIVersionAndKind(typeMeta.APIVersion, typeMeta.Kind)
+	if gvk.GroupVersion() == c.target {
+		return data, false, nil
+	}
+	if !c.scheme.Recognizes(gvk) {
+		return nil, false, fmt.Errorf("kind %s is not registered in the scheme", gvk)
+	}
+
+	obj, err := runtime.Decode(c.codecs.UniversalDecoder(), data)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed decoding %s: %w", gvk, err)
+	}
+
+	out, err := runtime.Encode(c.encoder, obj)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed encoding %s to %s: %w", gvk, c.target, err)
+	}
+
+	return out, true, nil
+}
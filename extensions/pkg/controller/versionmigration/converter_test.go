@@ -0,0 +1,75 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmigration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/versionmigration"
+	localinstall "github.com/gardener/gardener/pkg/provider-local/apis/local/install"
+	localv1alpha1 "github.com/gardener/gardener/pkg/provider-local/apis/local/v1alpha1"
+)
+
+var _ = Describe("Converter", func() {
+	var (
+		scheme   *runtime.Scheme
+		targetGV = schema.GroupVersion{Group: localv1alpha1.GroupName, Version: "v1alpha2"}
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		localinstall.Install(scheme)
+		// Register the external types a second time for a newer version to simulate an API version bump.
+		scheme.AddKnownTypes(targetGV, &localv1alpha1.WorkerStatus{})
+	})
+
+	Describe("#NewSchemeConverter", func() {
+		It("should convert the provider config to the target version", func() {
+			converter := NewSchemeConverter(scheme, targetGV)
+
+			out, changed, err := converter.Convert([]byte(`{"apiVersion":"local.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerStatus","machineImages":[{"name":"foo","version":"1.0","image":"bar"}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(out).To(MatchJSON(`{"apiVersion":"local.provider.extensions.gardener.cloud/v1alpha2","kind":"WorkerStatus","machineImages":[{"name":"foo","version":"1.0","image":"bar"}]}`))
+		})
+
+		It("should not convert the provider config if it already has the target version", func() {
+			converter := NewSchemeConverter(scheme, localv1alpha1.SchemeGroupVersion)
+			data := []byte(`{"apiVersion":"local.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerStatus"}`)
+
+			out, changed, err := converter.Convert(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+			Expect(out).To(Equal(data))
+		})
+
+		It("should fail if the kind is not registered in the scheme", func() {
+			converter := NewSchemeConverter(scheme, targetGV)
+
+			_, _, err := converter.Convert([]byte(`{"apiVersion":"local.provider.extensions.gardener.cloud/v1alpha1","kind":"Unknown"}`))
+			Expect(err).To(MatchError(ContainSubstring("is not registered in the scheme")))
+		})
+
+		It("should fail if the provider config cannot be read", func() {
+			converter := NewSchemeConverter(scheme, targetGV)
+
+			_, _, err := converter.Convert([]byte(`foo`))
+			Expect(err).To(MatchError(ContainSubstring("failed reading type information")))
+		})
+	})
+})
@@ -0,0 +1,259 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmigration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultBatchSize is the default number of objects which are listed and migrated per page.
+const DefaultBatchSize int64 = 500
+
+var (
+	// DefaultTypeFieldPath is the default path of the extension type field in the migrated objects.
+	DefaultTypeFieldPath = []string{"spec", "type"}
+	// DefaultProviderConfigFieldPath is the default path of the provider configuration field in the migrated objects.
+	DefaultProviderConfigFieldPath = []string{"spec", "providerConfig"}
+)
+
+// Migrator migrates the provider configuration of all objects of a certain GroupVersionKind and extension type to a
+// target version. Objects are listed page by page across all namespaces, and the progress is persisted after every
+// page so that an interrupted migration continues where it stopped.
+type Migrator struct {
+	// Client is used to list and patch the objects.
+	Client client.Client
+	// Log is the logger.
+	Log logr.Logger
+	// Clock is used to determine the time of progress updates. Defaults to the real clock.
+	Clock clock.Clock
+	// GVK is the GroupVersionKind of the objects to migrate, e.g. extensions.gardener.cloud/v1alpha1, Kind=Worker.
+	GVK schema.GroupVersionKind
+	// Type is the extension type of the objects to migrate. If empty, objects of all types are migrated.
+	Type string
+	// TypeFieldPath is the path of the extension type field. Defaults to DefaultTypeFieldPath.
+	TypeFieldPath []string
+	// ProviderConfigFieldPath is the path of the provider configuration field. Defaults to
+	// DefaultProviderConfigFieldPath.
+	ProviderConfigFieldPath []string
+	// TargetVersion is the version to which the provider configurations are migrated.
+	TargetVersion schema.GroupVersion
+	// Converter converts the provider configurations to the target version.
+	Converter Converter
+	// ProgressTracker persists the progress of the migration. If nil, the progress is not persisted.
+	ProgressTracker ProgressTracker
+	// BatchSize is the number of objects listed per page. Defaults to DefaultBatchSize.
+	BatchSize int64
+	// DryRun indicates whether the patches should only be sent as dry-run requests.
+	DryRun bool
+}
+
+// Migrate migrates the provider configurations of all matching objects and returns the progress. If the progress
+// tracker reports that the migration to the target version was already completed, nothing is done. Objects which
+// could not be migrated are retried in a new pass when Migrate is called again.
+func (m *Migrator) Migrate(ctx context.Context) (*Progress, error) {
+	log := m.Log.WithValues("gvk", m.GVK, "type", m.Type, "targetVersion", m.TargetVersion)
+
+	progress, err := m.loadProgress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if progress.Completed {
+		log.Info("Migration was already completed, nothing to do")
+		return progress, nil
+	}
+
+	if progress.Continue == "" {
+		resetProgress(progress)
+	}
+
+	var (
+		errs      *multierror.Error
+		batchSize = m.BatchSize
+	)
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	for {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(m.GVK.GroupVersion().WithKind(m.GVK.Kind + "List"))
+
+		if err := m.Client.List(ctx, list, client.Limit(batchSize), client.Continue(progress.Continue)); err != nil {
+			if apierrors.IsResourceExpired(err) {
+				log.Info("Continue token expired, restarting migration from the beginning")
+				resetProgress(progress)
+				errs = nil
+				continue
+			}
+			return progress, fmt.Errorf("failed listing %s: %w", m.GVK.Kind, err)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+
+			migrated, err := m.migrateObject(ctx, obj)
+			switch {
+			case err != nil:
+				log.Error(err, "Failed migrating object", "object", client.ObjectKeyFromObject(obj))
+				errs = multierror.Append(errs, fmt.Errorf("failed migrating %s %s: %w", m.GVK.Kind, client.ObjectKeyFromObject(obj), err))
+				progress.Failed++
+			case migrated:
+				log.V(1).Info("Migrated object", "object", client.ObjectKeyFromObject(obj))
+				progress.Migrated++
+			default:
+				progress.Skipped++
+			}
+		}
+
+		progress.Continue = list.GetContinue()
+		if progress.Continue == "" {
+			// A pass with failures is not considered completed, the next call starts a new pass which retries the failed
+			// objects.
+			progress.Completed = progress.Failed == 0
+		}
+
+		if err := m.saveProgress(ctx, progress); err != nil {
+			return progress, err
+		}
+
+		log.Info("Processed page", "migrated", progress.Migrated, "skipped", progress.Skipped, "failed", progress.Failed)
+
+		if progress.Continue == "" {
+			break
+		}
+	}
+
+	return progress, errs.ErrorOrNil()
+}
+
+func (m *Migrator) migrateObject(ctx context.Context, obj *unstructured.Unstructured) (bool, error) {
+	if m.Type != "" {
+		extensionType, _, err := unstructured.NestedString(obj.Object, m.typeFieldPath()...)
+		if err != nil {
+			return false, fmt.Errorf("failed reading extension type: %w", err)
+		}
+		if extensionType != m.Type {
+			return false, nil
+		}
+	}
+
+	providerConfig, found, err := unstructured.NestedFieldNoCopy(obj.Object, m.providerConfigFieldPath()...)
+	if err != nil {
+		return false, fmt.Errorf("failed reading provider config: %w", err)
+	}
+	if !found || providerConfig == nil {
+		return false, nil
+	}
+
+	data, err := json.Marshal(providerConfig)
+	if err != nil {
+		return false, fmt.Errorf("failed marshalling provider config: %w", err)
+	}
+
+	converted, changed, err := m.Converter.Convert(data)
+	if err != nil {
+		return false, err
+	}
+	if !changed {
+		return false, nil
+	}
+
+	convertedProviderConfig := map[string]interface{}{}
+	if err := json.Unmarshal(converted, &convertedProviderConfig); err != nil {
+		return false, fmt.Errorf("failed unmarshalling converted provider config: %w", err)
+	}
+
+	patch := client.MergeFromWithOptions(obj.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	if err := unstructured.SetNestedMap(obj.Object, convertedProviderConfig, m.providerConfigFieldPath()...); err != nil {
+		return false, fmt.Errorf("failed setting converted provider config: %w", err)
+	}
+
+	var opts []client.PatchOption
+	if m.DryRun {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	if err := m.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return false, fmt.Errorf("failed patching provider config: %w", err)
+	}
+
+	return true, nil
+}
+
+func (m *Migrator) loadProgress(ctx context.Context) (*Progress, error) {
+	if m.ProgressTracker == nil {
+		return &Progress{TargetVersion: m.TargetVersion.String()}, nil
+	}
+
+	progress, err := m.ProgressTracker.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if progress.TargetVersion != m.TargetVersion.String() {
+		// The target version has changed (or no migration has been started yet), hence, start from scratch.
+		progress = &Progress{TargetVersion: m.TargetVersion.String()}
+	}
+
+	return progress, nil
+}
+
+func (m *Migrator) saveProgress(ctx context.Context, progress *Progress) error {
+	c := m.Clock
+	if c == nil {
+		c = clock.RealClock{}
+	}
+	progress.LastUpdateTime = c.Now()
+
+	if m.ProgressTracker == nil || m.DryRun {
+		return nil
+	}
+
+	return m.ProgressTracker.Save(ctx, progress)
+}
+
+func (m *Migrator) typeFieldPath() []string {
+	if len(m.TypeFieldPath) == 0 {
+		return DefaultTypeFieldPath
+	}
+	return m.TypeFieldPath
+}
+
+func (m *Migrator) providerConfigFieldPath() []string {
+	if len(m.ProviderConfigFieldPath) == 0 {
+		return DefaultProviderConfigFieldPath
+	}
+	return m.ProviderConfigFieldPath
+}
+
+func resetProgress(progress *Progress) {
+	progress.Continue = ""
+	progress.Migrated = 0
+	progress.Skipped = 0
+	progress.Failed = 0
+	progress.Completed = false
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmigration_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/versionmigration"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Migrator", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		tracker    ProgressTracker
+		migrator   *Migrator
+
+		targetGV = schema.GroupVersion{Group: "foo.provider.extensions.gardener.cloud", Version: "v1beta1"}
+		oldGV    = schema.GroupVersion{Group: "foo.provider.extensions.gardener.cloud", Version: "v1alpha1"}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+		tracker = NewConfigMapProgressTracker(fakeClient, "garden", "migration")
+
+		migrator = &Migrator{
+			Client:          fakeClient,
+			Log:             logr.Discard(),
+			Clock:           fakeClock,
+			GVK:             extensionsv1alpha1.SchemeGroupVersion.WithKind("Worker"),
+			Type:            "foo",
+			TargetVersion:   targetGV,
+			ProgressTracker: tracker,
+			Converter: ConverterFunc(func(data []byte) ([]byte, bool, error) {
+				old := fmt.Sprintf(`{"apiVersion":"%s","kind":"WorkerConfig"}`, oldGV)
+				if string(data) != old {
+					return data, false, nil
+				}
+				return []byte(fmt.Sprintf(`{"apiVersion":"%s","kind":"WorkerConfig"}`, targetGV)), true, nil
+			}),
+		}
+	})
+
+	createWorker := func(namespace, extensionType string, gv *schema.GroupVersion) *extensionsv1alpha1.Worker {
+		worker := &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: extensionType},
+			},
+		}
+		if gv != nil {
+			worker.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"apiVersion":"%s","kind":"WorkerConfig"}`, gv))}
+		}
+		ExpectWithOffset(1, fakeClient.Create(ctx, worker)).To(Succeed())
+		return worker
+	}
+
+	expectProviderConfigVersion := func(worker *extensionsv1alpha1.Worker, gv schema.GroupVersion) {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(worker), worker)).To(Succeed())
+		ExpectWithOffset(1, worker.Spec.ProviderConfig.Raw).To(MatchJSON(fmt.Sprintf(`{"apiVersion":"%s","kind":"WorkerConfig"}`, gv)))
+	}
+
+	Describe("#Migrate", func() {
+		It("should migrate the provider config of all objects of the extension type", func() {
+			worker1 := createWorker("shoot--foo--bar", "foo", &oldGV)
+			worker2 := createWorker("shoot--foo--baz", "foo", &targetGV)
+			worker3 := createWorker("shoot--foo--qux", "bar", &oldGV)
+			worker4 := createWorker("shoot--foo--quux", "foo", nil)
+
+			progress, err := migrator.Migrate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress).To(Equal(&Progress{
+				TargetVersion:  targetGV.String(),
+				Migrated:       1,
+				Skipped:        3,
+				Completed:      true,
+				LastUpdateTime: fakeClock.Now(),
+			}))
+
+			expectProviderConfigVersion(worker1, targetGV)
+			expectProviderConfigVersion(worker2, targetGV)
+			expectProviderConfigVersion(worker3, oldGV)
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(worker4), worker4)).To(Succeed())
+			Expect(worker4.Spec.ProviderConfig).To(BeNil())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "garden", Name: "migration"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{
+				"targetVersion":  targetGV.String(),
+				"continue":       "",
+				"migrated":       "1",
+				"skipped":        "3",
+				"failed":         "0",
+				"completed":      "true",
+				"lastUpdateTime": "2023-10-01T12:00:00Z",
+			}))
+		})
+
+		It("should do nothing if the migration to the target version was already completed", func() {
+			worker := createWorker("shoot--foo--bar", "foo", &oldGV)
+			Expect(tracker.Save(ctx, &Progress{TargetVersion: targetGV.String(), Migrated: 5, Completed: true})).To(Succeed())
+
+			progress, err := migrator.Migrate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.Migrated).To(Equal(5))
+
+			expectProviderConfigVersion(worker, oldGV)
+		})
+
+		It("should start from scratch if the target version has changed", func() {
+			worker := createWorker("shoot--foo--bar", "foo", &oldGV)
+			Expect(tracker.Save(ctx, &Progress{TargetVersion: oldGV.String(), Migrated: 5, Completed: true})).To(Succeed())
+
+			progress, err := migrator.Migrate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.Migrated).To(Equal(1))
+			Expect(progress.Completed).To(BeTrue())
+
+			expectProviderConfigVersion(worker, targetGV)
+		})
+
+		It("should not complete the migration if objects could not be migrated", func() {
+			worker := createWorker("shoot--foo--bar", "foo", &oldGV)
+			migrator.Converter = ConverterFunc(func([]byte) ([]byte, bool, error) {
+				return nil, false, fmt.Errorf("fake")
+			})
+
+			progress, err := migrator.Migrate(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed migrating Worker shoot--foo--bar/worker: fake")))
+			Expect(progress.Failed).To(Equal(1))
+			Expect(progress.Completed).To(BeFalse())
+
+			expectProviderConfigVersion(worker, oldGV)
+		})
+
+		It("should neither patch the objects nor persist the progress in dry-run mode", func() {
+			worker := createWorker("shoot--foo--bar", "foo", &oldGV)
+			migrator.DryRun = true
+
+			progress, err := migrator.Migrate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.Migrated).To(Equal(1))
+
+			expectProviderConfigVersion(worker, oldGV)
+
+			loaded, err := tracker.Load(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded).To(Equal(&Progress{}))
+		})
+	})
+})
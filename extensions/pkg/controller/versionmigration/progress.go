@@ -0,0 +1,153 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmigration
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// DataKeyTargetVersion is the data key in the progress ConfigMap which contains the target version of the migration.
+	DataKeyTargetVersion = "targetVersion"
+	// DataKeyContinue is the data key in the progress ConfigMap which contains the continue token of the last processed
+	// page.
+	DataKeyContinue = "continue"
+	// DataKeyMigrated is the data key in the progress ConfigMap which contains the number of migrated objects.
+	DataKeyMigrated = "migrated"
+	// DataKeySkipped is the data key in the progress ConfigMap which contains the number of objects which did not need
+	// to be migrated.
+	DataKeySkipped = "skipped"
+	// DataKeyFailed is the data key in the progress ConfigMap which contains the number of objects which could not be
+	// migrated.
+	DataKeyFailed = "failed"
+	// DataKeyCompleted is the data key in the progress ConfigMap which indicates whether the migration is completed.
+	DataKeyCompleted = "completed"
+	// DataKeyLastUpdateTime is the data key in the progress ConfigMap which contains the time of the last update.
+	DataKeyLastUpdateTime = "lastUpdateTime"
+)
+
+// Progress is the progress of a migration.
+type Progress struct {
+	// TargetVersion is the version to which the objects are migrated.
+	TargetVersion string
+	// Continue is the continue token of the last processed page. It is empty if the current pass has not yet processed
+	// any page.
+	Continue string
+	// Migrated is the number of objects which were migrated in the current pass.
+	Migrated int
+	// Skipped is the number of objects which did not need to be migrated in the current pass.
+	Skipped int
+	// Failed is the number of objects which could not be migrated in the current pass.
+	Failed int
+	// Completed indicates whether all objects were successfully migrated to the target version.
+	Completed bool
+	// LastUpdateTime is the time when the progress was last updated.
+	LastUpdateTime time.Time
+}
+
+// ProgressTracker loads and persists the progress of a migration.
+type ProgressTracker interface {
+	// Load loads the progress. It returns an empty Progress if no progress was persisted yet.
+	Load(context.Context) (*Progress, error)
+	// Save persists the given progress.
+	Save(context.Context, *Progress) error
+}
+
+type configMapProgressTracker struct {
+	client    client.Client
+	namespace string
+	name      string
+}
+
+// NewConfigMapProgressTracker returns a ProgressTracker which persists the progress in the ConfigMap with the given
+// namespace and name.
+func NewConfigMapProgressTracker(c client.Client, namespace, name string) ProgressTracker {
+	return &configMapProgressTracker{
+		client:    c,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+func (t *configMapProgressTracker) Load(ctx context.Context) (*Progress, error) {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.name}}
+	if err := t.client.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &Progress{}, nil
+		}
+		return nil, fmt.Errorf("failed reading progress ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	progress := &Progress{
+		TargetVersion: configMap.Data[DataKeyTargetVersion],
+		Continue:      configMap.Data[DataKeyContinue],
+		Completed:     configMap.Data[DataKeyCompleted] == "true",
+	}
+
+	for key, into := range map[string]*int{
+		DataKeyMigrated: &progress.Migrated,
+		DataKeySkipped:  &progress.Skipped,
+		DataKeyFailed:   &progress.Failed,
+	} {
+		value, ok := configMap.Data[key]
+		if !ok {
+			continue
+		}
+
+		count, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing %q in progress ConfigMap %s: %w", key, client.ObjectKeyFromObject(configMap), err)
+		}
+		*into = count
+	}
+
+	if value, ok := configMap.Data[DataKeyLastUpdateTime]; ok {
+		lastUpdateTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing %q in progress ConfigMap %s: %w", DataKeyLastUpdateTime, client.ObjectKeyFromObject(configMap), err)
+		}
+		progress.LastUpdateTime = lastUpdateTime
+	}
+
+	return progress, nil
+}
+
+func (t *configMapProgressTracker) Save(ctx context.Context, progress *Progress) error {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.name}}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, t.client, configMap, func() error {
+		configMap.Data = map[string]string{
+			DataKeyTargetVersion:  progress.TargetVersion,
+			DataKeyContinue:       progress.Continue,
+			DataKeyMigrated:       strconv.Itoa(progress.Migrated),
+			DataKeySkipped:        strconv.Itoa(progress.Skipped),
+			DataKeyFailed:         strconv.Itoa(progress.Failed),
+			DataKeyCompleted:      strconv.FormatBool(progress.Completed),
+			DataKeyLastUpdateTime: progress.LastUpdateTime.UTC().Format(time.RFC3339),
+		}
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmigration_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/versionmigration"
+)
+
+var _ = Describe("ProgressTracker", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		tracker    ProgressTracker
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		tracker = NewConfigMapProgressTracker(fakeClient, "garden", "migration")
+	})
+
+	Describe("#NewConfigMapProgressTracker", func() {
+		It("should return an empty progress if the ConfigMap does not exist", func() {
+			Expect(tracker.Load(ctx)).To(Equal(&Progress{}))
+		})
+
+		It("should persist and load the progress", func() {
+			progress := &Progress{
+				TargetVersion:  "foo/v1",
+				Continue:       "token",
+				Migrated:       1,
+				Skipped:        2,
+				Failed:         3,
+				LastUpdateTime: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+			}
+
+			Expect(tracker.Save(ctx, progress)).To(Succeed())
+			Expect(tracker.Load(ctx)).To(Equal(progress))
+		})
+
+		It("should fail if a counter cannot be parsed", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "migration"},
+				Data:       map[string]string{"migrated": "foo"},
+			})).To(Succeed())
+
+			_, err := tracker.Load(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`failed parsing "migrated"`)))
+		})
+	})
+})
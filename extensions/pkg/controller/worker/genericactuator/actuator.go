@@ -38,6 +38,21 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
+const (
+	// AnnotationPausedForMachineClass is the annotation on a MachineDeployment containing the name of the machine class
+	// for which the MachineDeployment was paused according to the stuck machine policy of its worker pool.
+	AnnotationPausedForMachineClass = "worker.gardener.cloud/paused-for-machine-class"
+
+	// AnnotationZoneCapacityShiftedAt is the annotation on a MachineDeployment containing the time at which its desired
+	// machines were shifted to the other zones of its worker pool because of insufficient capacity.
+	AnnotationZoneCapacityShiftedAt = "worker.gardener.cloud/zone-capacity-shifted-at"
+
+	defaultStuckMachineFailureThreshold int32 = 3
+
+	defaultZoneRebalancingFailureThreshold int32 = 3
+	defaultZoneRebalancingRecoveryPeriod         = 30 * time.Minute
+)
+
 type genericActuator struct {
 	delegateFactory    DelegateFactory
 	gardenReader       client.Reader
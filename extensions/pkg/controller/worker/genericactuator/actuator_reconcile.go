@@ -22,9 +22,11 @@ import (
 	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinecodes "github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
@@ -33,6 +35,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsworkercontroller "github.com/gardener/gardener/extensions/pkg/controller/worker"
 	extensionsworkerhelper "github.com/gardener/gardener/extensions/pkg/controller/worker/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -96,13 +99,20 @@ func (a *genericActuator) Reconcile(ctx context.Context, log logr.Logger, worker
 		existingMachineDeploymentNames.Insert(deployment.Name)
 	}
 
+	// Shift the desired machines of zones with insufficient capacity to the other zones of their worker pools.
+	var zoneCapacityShifts map[string]time.Time
+	if !isHibernationEnabled {
+		zoneCapacityShifts = rebalanceMachineDeploymentsOverZones(log, worker.Spec.Pools, existingMachineDeployments, wantedMachineDeployments, time.Now().UTC())
+	}
+
 	// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
-	if err := deployMachineDeployments(ctx, log, a.seedClient, cluster, worker, existingMachineDeployments, wantedMachineDeployments, clusterAutoscalerUsed); err != nil {
+	pausedMachineDeploymentNames, err := deployMachineDeployments(ctx, log, a.seedClient, cluster, worker, existingMachineDeployments, wantedMachineDeployments, clusterAutoscalerUsed, zoneCapacityShifts)
+	if err != nil {
 		return fmt.Errorf("failed to generate the machine deployment config: %w", err)
 	}
 
 	// update machineDeploymentsLastUpdateTime and the machine deployment slice in worker status
-	if err := a.updateWorkerStatusMachineDeployments(ctx, worker, wantedMachineDeployments); err != nil {
+	if err := a.updateWorkerStatusMachineDeployments(ctx, worker, wantedMachineDeployments, pausedMachineDeploymentNames, zoneCapacityShifts); err != nil {
 		return fmt.Errorf("failed to update the machine deployments in worker status: %w", err)
 	}
 
@@ -191,7 +201,13 @@ func deployMachineDeployments(
 	existingMachineDeployments *machinev1alpha1.MachineDeploymentList,
 	wantedMachineDeployments extensionsworkercontroller.MachineDeployments,
 	clusterAutoscalerUsed bool,
-) error {
+	zoneCapacityShifts map[string]time.Time,
+) (
+	sets.Set[string],
+	error,
+) {
+	pausedMachineDeploymentNames := sets.New[string]()
+
 	log.Info("Deploying machine deployments")
 	for _, deployment := range wantedMachineDeployments {
 		var (
@@ -206,7 +222,7 @@ func deployMachineDeployments(
 		case extensionscontroller.IsHibernationEnabled(cluster):
 			replicas = 0
 			if err := markAllMachinesForcefulDeletion(ctx, log, cl, worker.Namespace); err != nil {
-				return fmt.Errorf("marking all machines for forceful deletion failed: %w", err)
+				return nil, fmt.Errorf("marking all machines for forceful deletion failed: %w", err)
 			}
 		// If the cluster autoscaler is not enabled then min=max (as per API validation), hence
 		// we can use either min or max.
@@ -244,6 +260,15 @@ func deployMachineDeployments(
 			}
 		}
 
+		paused := !extensionscontroller.IsHibernationEnabled(cluster) &&
+			shouldPauseMachineDeployment(existingMachineDeployment, deployment, findStuckMachinePolicy(worker.Spec.Pools, deployment))
+		if paused {
+			pausedMachineDeploymentNames.Insert(deployment.Name)
+			if existingMachineDeployment != nil && !existingMachineDeployment.Spec.Paused {
+				log.Info("Pausing machine deployment because too many of its machines failed", "machineDeploymentName", deployment.Name)
+			}
+		}
+
 		machineDeployment := &machinev1alpha1.MachineDeployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      deployment.Name,
@@ -252,9 +277,22 @@ func deployMachineDeployments(
 		}
 
 		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, cl, machineDeployment, func() error {
+			if paused {
+				metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, AnnotationPausedForMachineClass, deployment.ClassName)
+			} else {
+				delete(machineDeployment.Annotations, AnnotationPausedForMachineClass)
+			}
+
+			if shiftedAt, ok := zoneCapacityShifts[deployment.Name]; ok {
+				metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, AnnotationZoneCapacityShiftedAt, shiftedAt.Format(time.RFC3339))
+			} else {
+				delete(machineDeployment.Annotations, AnnotationZoneCapacityShiftedAt)
+			}
+
 			machineDeployment.Spec = machinev1alpha1.MachineDeploymentSpec{
 				Replicas:        replicas,
 				MinReadySeconds: 500,
+				Paused:          paused,
 				Strategy: machinev1alpha1.MachineDeploymentStrategy{
 					Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
 					RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
@@ -290,20 +328,173 @@ func deployMachineDeployments(
 
 			return nil
 		}); err != nil {
-			return err
+			return nil, err
+		}
+	}
+
+	return pausedMachineDeploymentNames, nil
+}
+
+// findStuckMachinePolicy returns the stuck machine policy of the worker pool the given machine deployment belongs to.
+func findStuckMachinePolicy(pools []extensionsv1alpha1.WorkerPool, deployment extensionsworkercontroller.MachineDeployment) *gardencorev1beta1.StuckMachinePolicy {
+	poolName, ok := deployment.Labels[v1beta1constants.LabelWorkerPool]
+	if !ok {
+		return nil
+	}
+
+	for _, pool := range pools {
+		if pool.Name == poolName && pool.MachineControllerManagerSettings != nil {
+			return pool.MachineControllerManagerSettings.StuckMachinePolicy
 		}
 	}
 
 	return nil
 }
 
+// shouldPauseMachineDeployment determines whether the given machine deployment must be paused according to the stuck
+// machine policy of its worker pool. Once paused, a machine deployment stays paused until the configuration of the
+// worker pool and thus the name of the machine class changes.
+func shouldPauseMachineDeployment(existing *machinev1alpha1.MachineDeployment, wanted extensionsworkercontroller.MachineDeployment, policy *gardencorev1beta1.StuckMachinePolicy) bool {
+	if existing == nil || policy == nil || policy.Action != gardencorev1beta1.StuckMachineActionPausePool {
+		return false
+	}
+
+	if existing.Spec.Paused {
+		return existing.Annotations[AnnotationPausedForMachineClass] == wanted.ClassName
+	}
+
+	return int32(len(existing.Status.FailedMachines)) >= pointer.Int32Deref(policy.FailureThreshold, defaultStuckMachineFailureThreshold)
+}
+
+// rebalanceMachineDeploymentsOverZones shifts the desired machines of zones which repeatedly failed to provision
+// machines because of insufficient capacity to the other zones of their worker pool, if zone rebalancing is enabled for
+// the worker pool. The minimum and maximum of the affected machine deployments are adapted in place. A shift is
+// reverted after the recovery period of the worker pool, i.e., the zone is tried again. It returns the times at which
+// the machine deployments of the shifted zones were shifted.
+func rebalanceMachineDeploymentsOverZones(
+	log logr.Logger,
+	pools []extensionsv1alpha1.WorkerPool,
+	existingMachineDeployments *machinev1alpha1.MachineDeploymentList,
+	wantedMachineDeployments extensionsworkercontroller.MachineDeployments,
+	now time.Time,
+) map[string]time.Time {
+	zoneCapacityShifts := map[string]time.Time{}
+
+	for _, pool := range pools {
+		if pool.ZoneRebalancing == nil {
+			continue
+		}
+
+		var (
+			failureThreshold = pointer.Int32Deref(pool.ZoneRebalancing.FailureThreshold, defaultZoneRebalancingFailureThreshold)
+			recoveryPeriod   = defaultZoneRebalancingRecoveryPeriod
+
+			poolIndices    []int
+			healthyIndices []int
+			shiftedIndices = map[int]time.Time{}
+		)
+
+		if pool.ZoneRebalancing.RecoveryPeriod != nil {
+			recoveryPeriod = pool.ZoneRebalancing.RecoveryPeriod.Duration
+		}
+
+		for i, deployment := range wantedMachineDeployments {
+			if deployment.Labels[v1beta1constants.LabelWorkerPool] != pool.Name || deployment.Zone == "" {
+				continue
+			}
+			poolIndices = append(poolIndices, i)
+
+			existingMachineDeployment := getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+			shiftedAt, shifted := zoneCapacityShiftedAt(existingMachineDeployment)
+
+			switch {
+			case shifted && now.Before(shiftedAt.Add(recoveryPeriod)):
+				shiftedIndices[i] = shiftedAt
+			case countInsufficientCapacityFailures(existingMachineDeployment) >= failureThreshold:
+				log.Info("Shifting desired machines of zone to the other zones of the worker pool because of insufficient capacity", "workerPool", pool.Name, "zone", deployment.Zone, "machineDeploymentName", deployment.Name)
+				shiftedIndices[i] = now
+			default:
+				if shifted {
+					log.Info("Reverting shift of desired machines of zone after recovery period", "workerPool", pool.Name, "zone", deployment.Zone, "machineDeploymentName", deployment.Name)
+				}
+				healthyIndices = append(healthyIndices, i)
+			}
+		}
+
+		// There is nothing to rebalance if all zones have sufficient capacity, and there is no zone to shift the desired
+		// machines to if none of them has.
+		if len(shiftedIndices) == 0 || len(healthyIndices) == 0 {
+			continue
+		}
+
+		var minimum, maximum int32
+		for _, i := range poolIndices {
+			minimum += wantedMachineDeployments[i].Minimum
+			maximum += wantedMachineDeployments[i].Maximum
+		}
+
+		for zoneIndex, i := range healthyIndices {
+			wantedMachineDeployments[i].Minimum = extensionsworkercontroller.DistributeOverZones(int32(zoneIndex), minimum, int32(len(healthyIndices)))
+			wantedMachineDeployments[i].Maximum = extensionsworkercontroller.DistributeOverZones(int32(zoneIndex), maximum, int32(len(healthyIndices)))
+		}
+
+		for i, shiftedAt := range shiftedIndices {
+			wantedMachineDeployments[i].Minimum = 0
+			wantedMachineDeployments[i].Maximum = 0
+			zoneCapacityShifts[wantedMachineDeployments[i].Name] = shiftedAt
+		}
+	}
+
+	return zoneCapacityShifts
+}
+
+// zoneCapacityShiftedAt returns the time at which the desired machines of the given machine deployment were shifted to
+// the other zones of its worker pool.
+func zoneCapacityShiftedAt(machineDeployment *machinev1alpha1.MachineDeployment) (time.Time, bool) {
+	if machineDeployment == nil {
+		return time.Time{}, false
+	}
+
+	value, ok := machineDeployment.Annotations[AnnotationZoneCapacityShiftedAt]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	shiftedAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return shiftedAt, true
+}
+
+// countInsufficientCapacityFailures returns the number of failed machines of the given machine deployment whose
+// creation failed because the provider reported insufficient capacity.
+func countInsufficientCapacityFailures(machineDeployment *machinev1alpha1.MachineDeployment) int32 {
+	if machineDeployment == nil {
+		return 0
+	}
+
+	var count int32
+	for _, failedMachine := range machineDeployment.Status.FailedMachines {
+		if failedMachine != nil && failedMachine.LastOperation.ErrorCode == machinecodes.ResourceExhausted.String() {
+			count++
+		}
+	}
+
+	return count
+}
+
 // waitUntilWantedMachineDeploymentsAvailable waits until all the desired <machineDeployments> were marked as healthy /
 // available by the machine-controller-manager. It polls the status every 5 seconds.
 func (a *genericActuator) waitUntilWantedMachineDeploymentsAvailable(ctx context.Context, log logr.Logger, cluster *extensionscontroller.Cluster, worker *extensionsv1alpha1.Worker, alreadyExistingMachineDeploymentNames sets.Set[string], alreadyExistingMachineClassNames sets.Set[string], wantedMachineDeployments extensionsworkercontroller.MachineDeployments) error {
 	log.Info("Waiting until wanted machine deployments are available")
 
 	return retryutils.UntilTimeout(ctx, 5*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
-		var numHealthyDeployments, numUpdated, numAvailable, numUnavailable, numDesired, numberOfAwakeMachines int32
+		var (
+			numHealthyDeployments, numUpdated, numAvailable, numUnavailable, numDesired, numberOfAwakeMachines int32
+			rolloutProgress                                                                                    = make(map[string]*extensionsv1alpha1.MachineDeploymentProgress)
+		)
 
 		// Get the list of all machine deployments
 		machineDeployments := &machinev1alpha1.MachineDeploymentList{}
@@ -375,6 +566,17 @@ func (a *genericActuator) waitUntilWantedMachineDeploymentsAvailable(ctx context
 			numUpdated += deployment.Status.UpdatedReplicas
 			numAvailable += deployment.Status.AvailableReplicas
 			numUnavailable += deployment.Status.UnavailableReplicas
+
+			if progress := machineDeploymentProgress(&deployment); progress != nil {
+				rolloutProgress[deployment.Name] = progress
+			}
+		}
+
+		// report the rollout progress continuously so that it can be observed while waiting
+		if !extensionscontroller.IsHibernationEnabled(cluster) {
+			if err := a.updateWorkerStatusRolloutProgress(ctx, worker, rolloutProgress); err != nil {
+				return retryutils.MinorError(fmt.Errorf("failed updating the rollout progress in worker status: %w", err))
+			}
 		}
 
 		var msg string
@@ -431,27 +633,166 @@ func (a *genericActuator) waitUntilUnwantedMachineDeploymentsDeleted(ctx context
 	})
 }
 
-func (a *genericActuator) updateWorkerStatusMachineDeployments(ctx context.Context, worker *extensionsv1alpha1.Worker, machineDeployments extensionsworkercontroller.MachineDeployments) error {
+func (a *genericActuator) updateWorkerStatusMachineDeployments(ctx context.Context, worker *extensionsv1alpha1.Worker, machineDeployments extensionsworkercontroller.MachineDeployments, pausedMachineDeploymentNames sets.Set[string], zoneCapacityShifts map[string]time.Time) error {
 	if len(machineDeployments) == 0 {
 		return nil
 	}
 
+	pausedCondition, err := machineDeploymentsPausedCondition(worker, pausedMachineDeploymentNames)
+	if err != nil {
+		return err
+	}
+
+	zonesRebalancedCondition, err := zonesRebalancedCondition(worker, machineDeployments, zoneCapacityShifts)
+	if err != nil {
+		return err
+	}
+
 	var statusMachineDeployments []extensionsv1alpha1.MachineDeployment
 	for _, machineDeployment := range machineDeployments {
-		statusMachineDeployments = append(statusMachineDeployments, extensionsv1alpha1.MachineDeployment{
+		statusMachineDeployment := extensionsv1alpha1.MachineDeployment{
 			Name:    machineDeployment.Name,
 			Minimum: machineDeployment.Minimum,
 			Maximum: machineDeployment.Maximum,
-		})
+			Pool:    machineDeployment.Labels[v1beta1constants.LabelWorkerPool],
+		}
+
+		// keep the last reported rollout progress, it is updated while waiting for the machine deployments
+		if existing := findStatusMachineDeployment(worker.Status.MachineDeployments, machineDeployment.Name); existing != nil {
+			statusMachineDeployment.Progress = existing.Progress
+		}
+
+		statusMachineDeployments = append(statusMachineDeployments, statusMachineDeployment)
 	}
 	updateTime := metav1.Now()
 
 	patch := client.MergeFrom(worker.DeepCopy())
 	worker.Status.MachineDeployments = statusMachineDeployments
 	worker.Status.MachineDeploymentsLastUpdateTime = &updateTime
+	if pausedCondition != nil {
+		worker.Status.Conditions = v1beta1helper.MergeConditions(worker.Status.Conditions, *pausedCondition)
+	}
+	if zonesRebalancedCondition != nil {
+		worker.Status.Conditions = v1beta1helper.MergeConditions(worker.Status.Conditions, *zonesRebalancedCondition)
+	}
+	return a.seedClient.Status().Patch(ctx, worker, patch)
+}
+
+// updateWorkerStatusRolloutProgress updates the rollout progress of the machine deployments in the worker status if it
+// changed. Machine deployments without progress are not rolled out (anymore).
+func (a *genericActuator) updateWorkerStatusRolloutProgress(ctx context.Context, worker *extensionsv1alpha1.Worker, rolloutProgress map[string]*extensionsv1alpha1.MachineDeploymentProgress) error {
+	patch := client.MergeFrom(worker.DeepCopy())
+
+	var changed bool
+	for i, machineDeployment := range worker.Status.MachineDeployments {
+		if progress := rolloutProgress[machineDeployment.Name]; !apiequality.Semantic.DeepEqual(machineDeployment.Progress, progress) {
+			worker.Status.MachineDeployments[i].Progress = progress
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
 	return a.seedClient.Status().Patch(ctx, worker, patch)
 }
 
+// machineDeploymentProgress returns the rollout progress of the given machine deployment. It returns nil if all
+// desired machines are updated and ready and no old machines are left.
+func machineDeploymentProgress(deployment *machinev1alpha1.MachineDeployment) *extensionsv1alpha1.MachineDeploymentProgress {
+	progress := &extensionsv1alpha1.MachineDeploymentProgress{
+		Desired: deployment.Spec.Replicas,
+		Updated: deployment.Status.UpdatedReplicas,
+		Ready:   deployment.Status.AvailableReplicas,
+		Old:     max(deployment.Status.Replicas-deployment.Status.UpdatedReplicas, 0),
+	}
+
+	if progress.Updated >= progress.Desired && progress.Ready >= progress.Desired && progress.Old == 0 {
+		return nil
+	}
+	return progress
+}
+
+func findStatusMachineDeployment(machineDeployments []extensionsv1alpha1.MachineDeployment, name string) *extensionsv1alpha1.MachineDeployment {
+	for i := range machineDeployments {
+		if machineDeployments[i].Name == name {
+			return &machineDeployments[i]
+		}
+	}
+	return nil
+}
+
+// machineDeploymentsPausedCondition computes the condition reporting the machine deployments which were paused
+// according to the stuck machine policies of the worker pools. It returns nil if no condition needs to be reported.
+func machineDeploymentsPausedCondition(worker *extensionsv1alpha1.Worker, pausedMachineDeploymentNames sets.Set[string]) (*gardencorev1beta1.Condition, error) {
+	oldCondition := v1beta1helper.GetCondition(worker.Status.Conditions, extensionsv1alpha1.WorkerConditionTypeMachineDeploymentsPaused)
+	if oldCondition == nil && pausedMachineDeploymentNames.Len() == 0 {
+		return nil, nil
+	}
+
+	builder, err := v1beta1helper.NewConditionBuilder(extensionsv1alpha1.WorkerConditionTypeMachineDeploymentsPaused)
+	if err != nil {
+		return nil, err
+	}
+	if oldCondition != nil {
+		builder = builder.WithOldCondition(*oldCondition)
+	}
+
+	if pausedMachineDeploymentNames.Len() > 0 {
+		builder = builder.
+			WithStatus(gardencorev1beta1.ConditionTrue).
+			WithReason("TooManyFailedMachines").
+			WithMessage(fmt.Sprintf("The following machine deployments were paused because too many of their machines failed to be provisioned, they are resumed once the configuration of their worker pool is changed: %s", strings.Join(sets.List(pausedMachineDeploymentNames), ", ")))
+	} else {
+		builder = builder.
+			WithStatus(gardencorev1beta1.ConditionFalse).
+			WithReason("NoMachineDeploymentsPaused").
+			WithMessage("No machine deployments are paused.")
+	}
+
+	condition, _ := builder.Build()
+	return &condition, nil
+}
+
+// zonesRebalancedCondition computes the condition reporting the zones whose desired machines were shifted to the other
+// zones of their worker pool because of insufficient capacity. It returns nil if no condition needs to be reported.
+func zonesRebalancedCondition(worker *extensionsv1alpha1.Worker, machineDeployments extensionsworkercontroller.MachineDeployments, zoneCapacityShifts map[string]time.Time) (*gardencorev1beta1.Condition, error) {
+	oldCondition := v1beta1helper.GetCondition(worker.Status.Conditions, extensionsv1alpha1.WorkerConditionTypeZonesRebalanced)
+	if oldCondition == nil && len(zoneCapacityShifts) == 0 {
+		return nil, nil
+	}
+
+	builder, err := v1beta1helper.NewConditionBuilder(extensionsv1alpha1.WorkerConditionTypeZonesRebalanced)
+	if err != nil {
+		return nil, err
+	}
+	if oldCondition != nil {
+		builder = builder.WithOldCondition(*oldCondition)
+	}
+
+	if len(zoneCapacityShifts) > 0 {
+		var shiftedZones []string
+		for _, machineDeployment := range machineDeployments {
+			if shiftedAt, ok := zoneCapacityShifts[machineDeployment.Name]; ok {
+				shiftedZones = append(shiftedZones, fmt.Sprintf("%s/%s (since %s)", machineDeployment.Labels[v1beta1constants.LabelWorkerPool], machineDeployment.Zone, shiftedAt.Format(time.RFC3339)))
+			}
+		}
+
+		builder = builder.
+			WithStatus(gardencorev1beta1.ConditionTrue).
+			WithReason("InsufficientZoneCapacity").
+			WithMessage(fmt.Sprintf("The desired machines of the following worker pool zones were shifted to the other zones of their worker pool because of insufficient capacity, the shift is reverted after the recovery period of the worker pool: %s", strings.Join(shiftedZones, ", ")))
+	} else {
+		builder = builder.
+			WithStatus(gardencorev1beta1.ConditionFalse).
+			WithReason("NoZonesRebalanced").
+			WithMessage("No zones are rebalanced.")
+	}
+
+	condition, _ := builder.Build()
+	return &condition, nil
+}
+
 // Helper functions
 
 func shootIsAwake(isHibernated bool, existingMachineDeployments *machinev1alpha1.MachineDeploymentList) bool {
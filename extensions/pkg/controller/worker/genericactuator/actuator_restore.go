@@ -84,7 +84,7 @@ func RestoreWithoutReconcile(
 	}
 
 	// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
-	if err := deployMachineDeployments(ctx, log, seedClient, cluster, worker, existingMachineDeployments, wantedMachineDeployments, true); err != nil {
+	if _, err := deployMachineDeployments(ctx, log, seedClient, cluster, worker, existingMachineDeployments, wantedMachineDeployments, true, nil); err != nil {
 		return fmt.Errorf("failed to restore the machine deployment config: %w", err)
 	}
 
@@ -16,6 +16,7 @@ package genericactuator
 
 import (
 	"context"
+	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -26,10 +27,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
@@ -310,4 +317,307 @@ var _ = Describe("Actuator", func() {
 			Expect(restoreMachineSetsAndMachines(ctx, logger, a.seedClient, machineDeployments)).To(Succeed())
 		})
 	})
+
+	Describe("#findStuckMachinePolicy", func() {
+		var (
+			policy = &gardencorev1beta1.StuckMachinePolicy{Action: gardencorev1beta1.StuckMachineActionPausePool}
+			pools  = []extensionsv1alpha1.WorkerPool{
+				{Name: "pool1"},
+				{Name: "pool2", MachineControllerManagerSettings: &gardencorev1beta1.MachineControllerManagerSettings{StuckMachinePolicy: policy}},
+			}
+		)
+
+		It("should return the policy of the worker pool of the machine deployment", func() {
+			Expect(findStuckMachinePolicy(pools, worker.MachineDeployment{Labels: map[string]string{"worker.gardener.cloud/pool": "pool2"}})).To(Equal(policy))
+		})
+
+		It("should return nil if the worker pool has no policy", func() {
+			Expect(findStuckMachinePolicy(pools, worker.MachineDeployment{Labels: map[string]string{"worker.gardener.cloud/pool": "pool1"}})).To(BeNil())
+		})
+
+		It("should return nil if the machine deployment has no worker pool label", func() {
+			Expect(findStuckMachinePolicy(pools, worker.MachineDeployment{})).To(BeNil())
+		})
+	})
+
+	Describe("#shouldPauseMachineDeployment", func() {
+		var (
+			wanted         worker.MachineDeployment
+			existing       *machinev1alpha1.MachineDeployment
+			pausePolicy    *gardencorev1beta1.StuckMachinePolicy
+			failedMachines = func(n int) []*machinev1alpha1.MachineSummary {
+				var summaries []*machinev1alpha1.MachineSummary
+				for i := 0; i < n; i++ {
+					summaries = append(summaries, &machinev1alpha1.MachineSummary{})
+				}
+				return summaries
+			}
+		)
+
+		BeforeEach(func() {
+			wanted = worker.MachineDeployment{Name: "deployment", ClassName: "class-1"}
+			existing = &machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: "deployment"}}
+			pausePolicy = &gardencorev1beta1.StuckMachinePolicy{Action: gardencorev1beta1.StuckMachineActionPausePool, FailureThreshold: pointer.Int32(2)}
+		})
+
+		It("should not pause if there is no policy", func() {
+			existing.Status.FailedMachines = failedMachines(5)
+			Expect(shouldPauseMachineDeployment(existing, wanted, nil)).To(BeFalse())
+		})
+
+		It("should not pause if the action is KeepRetrying", func() {
+			existing.Status.FailedMachines = failedMachines(5)
+			Expect(shouldPauseMachineDeployment(existing, wanted, &gardencorev1beta1.StuckMachinePolicy{Action: gardencorev1beta1.StuckMachineActionKeepRetrying})).To(BeFalse())
+		})
+
+		It("should not pause if the machine deployment does not exist yet", func() {
+			Expect(shouldPauseMachineDeployment(nil, wanted, pausePolicy)).To(BeFalse())
+		})
+
+		It("should not pause if the failure threshold is not reached", func() {
+			existing.Status.FailedMachines = failedMachines(1)
+			Expect(shouldPauseMachineDeployment(existing, wanted, pausePolicy)).To(BeFalse())
+		})
+
+		It("should pause if the failure threshold is reached", func() {
+			existing.Status.FailedMachines = failedMachines(2)
+			Expect(shouldPauseMachineDeployment(existing, wanted, pausePolicy)).To(BeTrue())
+		})
+
+		It("should use the default failure threshold", func() {
+			pausePolicy.FailureThreshold = nil
+			existing.Status.FailedMachines = failedMachines(2)
+			Expect(shouldPauseMachineDeployment(existing, wanted, pausePolicy)).To(BeFalse())
+			existing.Status.FailedMachines = failedMachines(3)
+			Expect(shouldPauseMachineDeployment(existing, wanted, pausePolicy)).To(BeTrue())
+		})
+
+		It("should keep a paused machine deployment paused as long as the machine class does not change", func() {
+			existing.Spec.Paused = true
+			existing.Annotations = map[string]string{AnnotationPausedForMachineClass: "class-1"}
+			Expect(shouldPauseMachineDeployment(existing, wanted, pausePolicy)).To(BeTrue())
+		})
+
+		It("should resume a paused machine deployment if the machine class changed", func() {
+			existing.Spec.Paused = true
+			existing.Status.FailedMachines = failedMachines(5)
+			existing.Annotations = map[string]string{AnnotationPausedForMachineClass: "class-0"}
+			Expect(shouldPauseMachineDeployment(existing, wanted, pausePolicy)).To(BeFalse())
+		})
+	})
+
+	Describe("#machineDeploymentsPausedCondition", func() {
+		var w *extensionsv1alpha1.Worker
+
+		BeforeEach(func() {
+			w = &extensionsv1alpha1.Worker{}
+		})
+
+		It("should not report a condition if no machine deployment is or was paused", func() {
+			Expect(machineDeploymentsPausedCondition(w, sets.New[string]())).To(BeNil())
+		})
+
+		It("should report the paused machine deployments", func() {
+			condition, err := machineDeploymentsPausedCondition(w, sets.New("deployment-b", "deployment-a"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(condition.Type).To(BeEquivalentTo(extensionsv1alpha1.WorkerConditionTypeMachineDeploymentsPaused))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("TooManyFailedMachines"))
+			Expect(condition.Message).To(HaveSuffix("deployment-a, deployment-b"))
+		})
+
+		It("should reset the condition once no machine deployment is paused anymore", func() {
+			w.Status.Conditions = []gardencorev1beta1.Condition{{Type: extensionsv1alpha1.WorkerConditionTypeMachineDeploymentsPaused, Status: gardencorev1beta1.ConditionTrue}}
+
+			condition, err := machineDeploymentsPausedCondition(w, sets.New[string]())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NoMachineDeploymentsPaused"))
+		})
+	})
+
+	Describe("#rebalanceMachineDeploymentsOverZones", func() {
+		var (
+			now      = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+			pools    []extensionsv1alpha1.WorkerPool
+			existing *machinev1alpha1.MachineDeploymentList
+			wanted   worker.MachineDeployments
+
+			insufficientCapacityFailures = func(n int) []*machinev1alpha1.MachineSummary {
+				var summaries []*machinev1alpha1.MachineSummary
+				for i := 0; i < n; i++ {
+					summaries = append(summaries, &machinev1alpha1.MachineSummary{LastOperation: machinev1alpha1.LastOperation{ErrorCode: "ResourceExhausted"}})
+				}
+				return summaries
+			}
+		)
+
+		BeforeEach(func() {
+			pools = []extensionsv1alpha1.WorkerPool{{
+				Name:            "pool",
+				Zones:           []string{"a", "b", "c"},
+				ZoneRebalancing: &gardencorev1beta1.WorkerZoneRebalancing{FailureThreshold: pointer.Int32(2), RecoveryPeriod: &metav1.Duration{Duration: 30 * time.Minute}},
+			}}
+			existing = &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{
+				{ObjectMeta: metav1.ObjectMeta{Name: "pool-z1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "pool-z2"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "pool-z3"}},
+			}}
+			wanted = worker.MachineDeployments{
+				{Name: "pool-z1", Zone: "a", Minimum: 2, Maximum: 4, Labels: map[string]string{"worker.gardener.cloud/pool": "pool"}},
+				{Name: "pool-z2", Zone: "b", Minimum: 2, Maximum: 3, Labels: map[string]string{"worker.gardener.cloud/pool": "pool"}},
+				{Name: "pool-z3", Zone: "c", Minimum: 1, Maximum: 3, Labels: map[string]string{"worker.gardener.cloud/pool": "pool"}},
+			}
+		})
+
+		It("should not rebalance if zone rebalancing is not enabled", func() {
+			pools[0].ZoneRebalancing = nil
+			existing.Items[0].Status.FailedMachines = insufficientCapacityFailures(5)
+
+			Expect(rebalanceMachineDeploymentsOverZones(log.Log, pools, existing, wanted, now)).To(BeEmpty())
+			Expect(wanted[0].Minimum).To(Equal(int32(2)))
+		})
+
+		It("should not rebalance if the failure threshold is not reached", func() {
+			existing.Items[0].Status.FailedMachines = append(insufficientCapacityFailures(1), &machinev1alpha1.MachineSummary{LastOperation: machinev1alpha1.LastOperation{ErrorCode: "Internal"}})
+
+			Expect(rebalanceMachineDeploymentsOverZones(log.Log, pools, existing, wanted, now)).To(BeEmpty())
+		})
+
+		It("should shift the desired machines of a zone with insufficient capacity to the other zones", func() {
+			existing.Items[0].Status.FailedMachines = insufficientCapacityFailures(2)
+
+			Expect(rebalanceMachineDeploymentsOverZones(log.Log, pools, existing, wanted, now)).To(Equal(map[string]time.Time{"pool-z1": now}))
+			Expect(wanted[0].Minimum).To(Equal(int32(0)))
+			Expect(wanted[0].Maximum).To(Equal(int32(0)))
+			Expect(wanted[1].Minimum).To(Equal(int32(3)))
+			Expect(wanted[1].Maximum).To(Equal(int32(5)))
+			Expect(wanted[2].Minimum).To(Equal(int32(2)))
+			Expect(wanted[2].Maximum).To(Equal(int32(5)))
+		})
+
+		It("should keep the shift during the recovery period", func() {
+			shiftedAt := now.Add(-10 * time.Minute)
+			existing.Items[1].Annotations = map[string]string{AnnotationZoneCapacityShiftedAt: shiftedAt.Format(time.RFC3339)}
+
+			Expect(rebalanceMachineDeploymentsOverZones(log.Log, pools, existing, wanted, now)).To(Equal(map[string]time.Time{"pool-z2": shiftedAt}))
+			Expect(wanted[1].Maximum).To(Equal(int32(0)))
+		})
+
+		It("should revert the shift after the recovery period", func() {
+			existing.Items[1].Annotations = map[string]string{AnnotationZoneCapacityShiftedAt: now.Add(-30 * time.Minute).Format(time.RFC3339)}
+
+			Expect(rebalanceMachineDeploymentsOverZones(log.Log, pools, existing, wanted, now)).To(BeEmpty())
+			Expect(wanted[1].Minimum).To(Equal(int32(2)))
+			Expect(wanted[1].Maximum).To(Equal(int32(3)))
+		})
+
+		It("should not rebalance if all zones have insufficient capacity", func() {
+			for i := range existing.Items {
+				existing.Items[i].Status.FailedMachines = insufficientCapacityFailures(2)
+			}
+
+			Expect(rebalanceMachineDeploymentsOverZones(log.Log, pools, existing, wanted, now)).To(BeEmpty())
+		})
+
+		It("should ignore machine deployments without zone", func() {
+			wanted[0].Zone = ""
+			existing.Items[0].Status.FailedMachines = insufficientCapacityFailures(2)
+
+			Expect(rebalanceMachineDeploymentsOverZones(log.Log, pools, existing, wanted, now)).To(BeEmpty())
+		})
+	})
+
+	Describe("#zonesRebalancedCondition", func() {
+		var (
+			w           *extensionsv1alpha1.Worker
+			deployments = worker.MachineDeployments{
+				{Name: "pool-z1", Zone: "a", Labels: map[string]string{"worker.gardener.cloud/pool": "pool"}},
+				{Name: "pool-z2", Zone: "b", Labels: map[string]string{"worker.gardener.cloud/pool": "pool"}},
+			}
+		)
+
+		BeforeEach(func() {
+			w = &extensionsv1alpha1.Worker{}
+		})
+
+		It("should not report a condition if no zone is or was rebalanced", func() {
+			Expect(zonesRebalancedCondition(w, deployments, nil)).To(BeNil())
+		})
+
+		It("should report the shifted zones", func() {
+			condition, err := zonesRebalancedCondition(w, deployments, map[string]time.Time{"pool-z2": time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(condition.Type).To(BeEquivalentTo(extensionsv1alpha1.WorkerConditionTypeZonesRebalanced))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("InsufficientZoneCapacity"))
+			Expect(condition.Message).To(HaveSuffix("pool/b (since 2023-10-01T12:00:00Z)"))
+		})
+
+		It("should reset the condition once no zone is rebalanced anymore", func() {
+			w.Status.Conditions = []gardencorev1beta1.Condition{{Type: extensionsv1alpha1.WorkerConditionTypeZonesRebalanced, Status: gardencorev1beta1.ConditionTrue}}
+
+			condition, err := zonesRebalancedCondition(w, deployments, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NoZonesRebalanced"))
+		})
+	})
+
+	Describe("#machineDeploymentProgress", func() {
+		It("should return nil if the rollout is finished", func() {
+			Expect(machineDeploymentProgress(&machinev1alpha1.MachineDeployment{
+				Spec:   machinev1alpha1.MachineDeploymentSpec{Replicas: 3},
+				Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			})).To(BeNil())
+		})
+
+		It("should return the progress of a rolling update", func() {
+			Expect(machineDeploymentProgress(&machinev1alpha1.MachineDeployment{
+				Spec:   machinev1alpha1.MachineDeploymentSpec{Replicas: 3},
+				Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 4, UpdatedReplicas: 2, AvailableReplicas: 3},
+			})).To(Equal(&extensionsv1alpha1.MachineDeploymentProgress{Desired: 3, Updated: 2, Ready: 3, Old: 2}))
+		})
+
+		It("should return the progress if updated machines are not ready yet", func() {
+			Expect(machineDeploymentProgress(&machinev1alpha1.MachineDeployment{
+				Spec:   machinev1alpha1.MachineDeploymentSpec{Replicas: 2},
+				Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1},
+			})).To(Equal(&extensionsv1alpha1.MachineDeploymentProgress{Desired: 2, Updated: 2, Ready: 1}))
+		})
+	})
+
+	Describe("#updateWorkerStatusRolloutProgress", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			a          *genericActuator
+			w          *extensionsv1alpha1.Worker
+		)
+
+		BeforeEach(func() {
+			w = &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "shoot--foo--bar"},
+				Status: extensionsv1alpha1.WorkerStatus{MachineDeployments: []extensionsv1alpha1.MachineDeployment{
+					{Name: "pool-z1", Pool: "pool"},
+					{Name: "pool-z2", Pool: "pool", Progress: &extensionsv1alpha1.MachineDeploymentProgress{Desired: 1, Old: 1}},
+				}},
+			}
+
+			fakeClient = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Worker{}).WithObjects(w).Build()
+			a = &genericActuator{seedClient: fakeClient}
+		})
+
+		It("should update the progress of the machine deployments", func() {
+			Expect(a.updateWorkerStatusRolloutProgress(ctx, w, map[string]*extensionsv1alpha1.MachineDeploymentProgress{
+				"pool-z1": {Desired: 2, Updated: 1, Ready: 2, Old: 1},
+			})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(w), w)).To(Succeed())
+			Expect(w.Status.MachineDeployments).To(Equal([]extensionsv1alpha1.MachineDeployment{
+				{Name: "pool-z1", Pool: "pool", Progress: &extensionsv1alpha1.MachineDeploymentProgress{Desired: 2, Updated: 1, Ready: 2, Old: 1}},
+				{Name: "pool-z2", Pool: "pool"},
+			}))
+		})
+	})
 })
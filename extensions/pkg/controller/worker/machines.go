@@ -50,6 +50,9 @@ type MachineDeployment struct {
 	Taints               []corev1.Taint
 	State                *shootstate.MachineDeploymentState
 	MachineConfiguration *machinev1alpha1.MachineConfiguration
+	// Zone is the availability zone the machines of this MachineDeployment are created in. It must be set by provider
+	// extensions in order to support the automatic zone rebalancing of the worker pool.
+	Zone string
 }
 
 // MachineDeployments is a list of machine deployments.
@@ -0,0 +1,157 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener/extensions/pkg/leaderelection"
+)
+
+const (
+	// PriorityPreemptionFlag is the name of the command line flag to specify whether candidates with a higher priority
+	// preempt the current leader.
+	PriorityPreemptionFlag = "leader-election-priority-preemption"
+	// LeaseDurationFlag is the name of the command line flag to specify the leader election lease duration.
+	LeaseDurationFlag = "leader-election-lease-duration"
+	// RenewDeadlineFlag is the name of the command line flag to specify the leader election renew deadline.
+	RenewDeadlineFlag = "leader-election-renew-deadline"
+	// RetryPeriodFlag is the name of the command line flag to specify the leader election retry period.
+	RetryPeriodFlag = "leader-election-retry-period"
+)
+
+// Options are command line options for tuning the leader election of extensions.
+type Options struct {
+	// PriorityPreemption specifies whether a candidate with a higher priority preempts the current leader. The priority
+	// of a candidate is lowered if the node it runs on is cordoned.
+	PriorityPreemption bool
+	// NodeName is the name of the node the candidate runs on. It is required if PriorityPreemption is enabled.
+	NodeName string
+	// LeaseDuration is the duration that non-leader candidates wait to force acquire leadership.
+	LeaseDuration time.Duration
+	// RenewDeadline is the duration that the leader retries refreshing leadership before giving up.
+	RenewDeadline time.Duration
+	// RetryPeriod is the duration the candidates wait between tries of actions.
+	RetryPeriod time.Duration
+
+	config *Config
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.PriorityPreemption, PriorityPreemptionFlag, o.PriorityPreemption, "Whether a leader election candidate on a healthy node preempts the leader running on a cordoned node.")
+	fs.DurationVar(&o.LeaseDuration, LeaseDurationFlag, o.LeaseDuration, "The duration that non-leader candidates wait to force acquire leadership. Defaults to 15s if not set.")
+	fs.DurationVar(&o.RenewDeadline, RenewDeadlineFlag, o.RenewDeadline, "The duration that the leader retries refreshing leadership before giving up. Defaults to 10s if not set.")
+	fs.DurationVar(&o.RetryPeriod, RetryPeriodFlag, o.RetryPeriod, "The duration the leader election candidates wait between tries of actions. Defaults to 2s if not set.")
+}
+
+// Complete implements Completer.Complete.
+func (o *Options) Complete() error {
+	if o.PriorityPreemption && o.NodeName == "" {
+		return fmt.Errorf("the node name must be set when --%s is enabled", PriorityPreemptionFlag)
+	}
+
+	if o.LeaseDuration > 0 && o.RenewDeadline > 0 && o.LeaseDuration <= o.RenewDeadline {
+		return fmt.Errorf("--%s must be greater than --%s", LeaseDurationFlag, RenewDeadlineFlag)
+	}
+
+	if o.RenewDeadline > 0 && o.RetryPeriod > 0 && o.RenewDeadline <= o.RetryPeriod {
+		return fmt.Errorf("--%s must be greater than --%s", RenewDeadlineFlag, RetryPeriodFlag)
+	}
+
+	o.config = &Config{
+		PriorityPreemption: o.PriorityPreemption,
+		NodeName:           o.NodeName,
+		LeaseDuration:      o.LeaseDuration,
+		RenewDeadline:      o.RenewDeadline,
+		RetryPeriod:        o.RetryPeriod,
+	}
+	return nil
+}
+
+// Completed returns the completed Config. Only call this if `Complete` was successful.
+func (o *Options) Completed() *Config {
+	return o.config
+}
+
+// Config is a completed leader election configuration.
+type Config struct {
+	// PriorityPreemption specifies whether a candidate with a higher priority preempts the current leader.
+	PriorityPreemption bool
+	// NodeName is the name of the node the candidate runs on.
+	NodeName string
+	// LeaseDuration is the duration that non-leader candidates wait to force acquire leadership.
+	LeaseDuration time.Duration
+	// RenewDeadline is the duration that the leader retries refreshing leadership before giving up.
+	RenewDeadline time.Duration
+	// RetryPeriod is the duration the candidates wait between tries of actions.
+	RetryPeriod time.Duration
+}
+
+// Apply sets the values of this Config in the given manager.Options. It must be called after the leader election ID
+// and namespace have been set in the options. If priority preemption is enabled, a leaderelection.PriorityLeaseLock is
+// configured as resource lock of the manager.
+func (c *Config) Apply(restConfig *rest.Config, opts *manager.Options) error {
+	if c.LeaseDuration > 0 {
+		opts.LeaseDuration = &c.LeaseDuration
+	}
+	if c.RenewDeadline > 0 {
+		opts.RenewDeadline = &c.RenewDeadline
+	}
+	if c.RetryPeriod > 0 {
+		opts.RetryPeriod = &c.RetryPeriod
+	}
+
+	if !opts.LeaderElection || !c.PriorityPreemption {
+		return nil
+	}
+
+	if opts.LeaderElectionNamespace == "" {
+		return fmt.Errorf("leader election namespace must be set when priority preemption is enabled")
+	}
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating client for leader election: %w", err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed determining hostname for leader election identity: %w", err)
+	}
+
+	opts.LeaderElectionResourceLockInterface = &leaderelection.PriorityLeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: opts.LeaderElectionNamespace,
+			Name:      opts.LeaderElectionID,
+		},
+		Client:     client,
+		LockConfig: resourcelock.ResourceLockConfig{Identity: hostname + "_" + string(uuid.NewUUID())},
+		Priority:   leaderelection.NodePriority(client, c.NodeName),
+		Log:        logf.Log.WithName("leader-election"),
+	}
+	return nil
+}
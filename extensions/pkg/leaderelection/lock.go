@@ -0,0 +1,199 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package leaderelection provides a leader election resource lock for extensions which supports priority-based
+// preemption of the current leader.
+package leaderelection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// AnnotationPriority is the annotation on the leader election lease which contains the priority of the current
+	// leader.
+	AnnotationPriority = "leaderelection.extensions.gardener.cloud/priority"
+
+	// PriorityLow is the priority of candidates running on a node which is cordoned, e.g., because its zone is
+	// drained.
+	PriorityLow = 0
+	// PriorityHigh is the priority of candidates running on a healthy node.
+	PriorityHigh = 1
+)
+
+// PriorityFunc returns the current priority of the leader election candidate. Candidates with a higher priority
+// preempt the leader if it has a lower priority.
+type PriorityFunc func(ctx context.Context) (int, error)
+
+// NodePriority returns a PriorityFunc which returns PriorityLow if the node with the given name is cordoned, and
+// PriorityHigh otherwise.
+func NodePriority(client kubernetes.Interface, nodeName string) PriorityFunc {
+	return func(ctx context.Context) (int, error) {
+		node, err := client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return PriorityLow, err
+		}
+
+		if node.Spec.Unschedulable {
+			return PriorityLow, nil
+		}
+		return PriorityHigh, nil
+	}
+}
+
+// PriorityLeaseLock is a resourcelock.Interface based on a coordination.k8s.io/v1.Lease. In addition to the
+// resourcelock.LeaseLock, it records the priority of the leader in the lease. A candidate with a higher priority than
+// the current leader considers the lease to be released and acquires it without waiting for its expiration. The former
+// leader fails to renew the lease afterwards and steps down.
+type PriorityLeaseLock struct {
+	// LeaseMeta contains the name and the namespace of the lease.
+	LeaseMeta metav1.ObjectMeta
+	// Client is the client used to read and write the lease.
+	Client kubernetes.Interface
+	// LockConfig contains the identity and the event recorder of the candidate.
+	LockConfig resourcelock.ResourceLockConfig
+	// Priority returns the current priority of the candidate.
+	Priority PriorityFunc
+	// Log is the logger used to report preemptions.
+	Log logr.Logger
+
+	lease *coordinationv1.Lease
+}
+
+var _ resourcelock.Interface = &PriorityLeaseLock{}
+
+// Get returns the election record from the lease spec. If the lease is held by another candidate with a lower priority,
+// the returned record has no holder so that the leader is preempted.
+func (l *PriorityLeaseLock) Get(ctx context.Context) (*resourcelock.LeaderElectionRecord, []byte, error) {
+	lease, err := l.Client.CoordinationV1().Leases(l.LeaseMeta.Namespace).Get(ctx, l.LeaseMeta.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	l.lease = lease
+
+	record := resourcelock.LeaseSpecToLeaderElectionRecord(&lease.Spec)
+	recordBytes, err := json.Marshal(*record)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if record.HolderIdentity == "" || record.HolderIdentity == l.Identity() {
+		return record, recordBytes, nil
+	}
+
+	value, ok := lease.Annotations[AnnotationPriority]
+	if !ok {
+		return record, recordBytes, nil
+	}
+	leaderPriority, err := strconv.Atoi(value)
+	if err != nil {
+		return record, recordBytes, nil
+	}
+
+	priority, err := l.Priority(ctx)
+	if err != nil {
+		l.Log.Error(err, "Failed determining priority, not considering preemption of the current leader")
+		return record, recordBytes, nil
+	}
+
+	if priority > leaderPriority {
+		l.Log.Info("Preempting current leader with lower priority", "leader", record.HolderIdentity, "leaderPriority", leaderPriority, "priority", priority)
+		record.HolderIdentity = ""
+	}
+
+	return record, recordBytes, nil
+}
+
+// Create attempts to create the lease.
+func (l *PriorityLeaseLock) Create(ctx context.Context, ler resourcelock.LeaderElectionRecord) error {
+	lease, err := l.Client.CoordinationV1().Leases(l.LeaseMeta.Namespace).Create(ctx, &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        l.LeaseMeta.Name,
+			Namespace:   l.LeaseMeta.Namespace,
+			Annotations: map[string]string{AnnotationPriority: strconv.Itoa(l.currentPriority(ctx))},
+		},
+		Spec: resourcelock.LeaderElectionRecordToLeaseSpec(&ler),
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	l.lease = lease
+	return nil
+}
+
+// Update updates the spec and the priority annotation of the existing lease.
+func (l *PriorityLeaseLock) Update(ctx context.Context, ler resourcelock.LeaderElectionRecord) error {
+	if l.lease == nil {
+		return errors.New("lease not initialized, call get or create first")
+	}
+
+	l.lease.Spec = resourcelock.LeaderElectionRecordToLeaseSpec(&ler)
+	if l.lease.Annotations == nil {
+		l.lease.Annotations = map[string]string{}
+	}
+	l.lease.Annotations[AnnotationPriority] = strconv.Itoa(l.currentPriority(ctx))
+
+	lease, err := l.Client.CoordinationV1().Leases(l.LeaseMeta.Namespace).Update(ctx, l.lease, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	l.lease = lease
+	return nil
+}
+
+// currentPriority returns the priority of this candidate. If it cannot be determined, the lowest priority is returned
+// so that the leader can be preempted by candidates which are known to be healthy.
+func (l *PriorityLeaseLock) currentPriority(ctx context.Context) int {
+	priority, err := l.Priority(ctx)
+	if err != nil {
+		l.Log.Error(err, "Failed determining priority, falling back to lowest priority")
+		return PriorityLow
+	}
+	return priority
+}
+
+// RecordEvent records an event for the lease.
+func (l *PriorityLeaseLock) RecordEvent(s string) {
+	if l.LockConfig.EventRecorder == nil || l.lease == nil {
+		return
+	}
+
+	subject := &coordinationv1.Lease{ObjectMeta: l.lease.ObjectMeta}
+	subject.Kind = "Lease"
+	subject.APIVersion = coordinationv1.SchemeGroupVersion.String()
+	l.LockConfig.EventRecorder.Eventf(subject, corev1.EventTypeNormal, "LeaderElection", "%v %v", l.LockConfig.Identity, s)
+}
+
+// Describe returns the namespace and name of the lease.
+func (l *PriorityLeaseLock) Describe() string {
+	return fmt.Sprintf("%v/%v", l.LeaseMeta.Namespace, l.LeaseMeta.Name)
+}
+
+// Identity returns the identity of the candidate.
+func (l *PriorityLeaseLock) Identity() string {
+	return l.LockConfig.Identity
+}
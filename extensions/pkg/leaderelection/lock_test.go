@@ -0,0 +1,196 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leaderelection_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/extensions/pkg/leaderelection"
+)
+
+var _ = Describe("Lock", func() {
+	var (
+		ctx = context.TODO()
+
+		client   *fake.Clientset
+		priority int
+		lock     *PriorityLeaseLock
+		record   resourcelock.LeaderElectionRecord
+	)
+
+	BeforeEach(func() {
+		client = fake.NewSimpleClientset()
+		priority = PriorityHigh
+
+		lock = &PriorityLeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Namespace: "extension-foo", Name: "foo-leader-election"},
+			Client:     client,
+			LockConfig: resourcelock.ResourceLockConfig{Identity: "candidate"},
+			Priority: func(_ context.Context) (int, error) {
+				return priority, nil
+			},
+			Log: logr.Discard(),
+		}
+
+		now := metav1.NewTime(time.Now().Round(time.Second))
+		record = resourcelock.LeaderElectionRecord{
+			HolderIdentity:       "candidate",
+			LeaseDurationSeconds: 15,
+			AcquireTime:          now,
+			RenewTime:            now,
+		}
+	})
+
+	getLease := func() *coordinationv1.Lease {
+		lease, err := client.CoordinationV1().Leases("extension-foo").Get(ctx, "foo-leader-election", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return lease
+	}
+
+	createLease := func(holder string, annotations map[string]string) {
+		_, err := client.CoordinationV1().Leases("extension-foo").Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "extension-foo", Name: "foo-leader-election", Annotations: annotations},
+			Spec:       coordinationv1.LeaseSpec{HolderIdentity: pointer.String(holder), LeaseDurationSeconds: pointer.Int32(15)},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("#Create", func() {
+		It("should create the lease with the priority of the candidate", func() {
+			Expect(lock.Create(ctx, record)).To(Succeed())
+
+			lease := getLease()
+			Expect(lease.Spec.HolderIdentity).To(PointTo(Equal("candidate")))
+			Expect(lease.Annotations).To(HaveKeyWithValue(AnnotationPriority, "1"))
+		})
+
+		It("should create the lease with the lowest priority if the priority cannot be determined", func() {
+			lock.Priority = func(_ context.Context) (int, error) { return 0, fmt.Errorf("fake") }
+
+			Expect(lock.Create(ctx, record)).To(Succeed())
+			Expect(getLease().Annotations).To(HaveKeyWithValue(AnnotationPriority, "0"))
+		})
+	})
+
+	Describe("#Get", func() {
+		It("should return the record if the lease is held by the candidate itself", func() {
+			createLease("candidate", map[string]string{AnnotationPriority: "0"})
+
+			rec, _, err := lock.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rec.HolderIdentity).To(Equal("candidate"))
+		})
+
+		It("should return the record if the leader has the same priority", func() {
+			createLease("leader", map[string]string{AnnotationPriority: "1"})
+
+			rec, _, err := lock.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rec.HolderIdentity).To(Equal("leader"))
+		})
+
+		It("should return the record if the priority of the leader is unknown", func() {
+			createLease("leader", nil)
+
+			rec, _, err := lock.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rec.HolderIdentity).To(Equal("leader"))
+		})
+
+		It("should return the record if the priority of the candidate cannot be determined", func() {
+			createLease("leader", map[string]string{AnnotationPriority: "0"})
+			lock.Priority = func(_ context.Context) (int, error) { return 0, fmt.Errorf("fake") }
+
+			rec, _, err := lock.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rec.HolderIdentity).To(Equal("leader"))
+		})
+
+		It("should preempt the leader if it has a lower priority", func() {
+			createLease("leader", map[string]string{AnnotationPriority: "0"})
+
+			rec, _, err := lock.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rec.HolderIdentity).To(BeEmpty())
+
+			Expect(lock.Update(ctx, record)).To(Succeed())
+
+			lease := getLease()
+			Expect(lease.Spec.HolderIdentity).To(PointTo(Equal("candidate")))
+			Expect(lease.Annotations).To(HaveKeyWithValue(AnnotationPriority, "1"))
+		})
+	})
+
+	Describe("#Update", func() {
+		It("should fail if the lease was not retrieved before", func() {
+			Expect(lock.Update(ctx, record)).To(MatchError(ContainSubstring("lease not initialized")))
+		})
+
+		It("should update the priority of the leader", func() {
+			Expect(lock.Create(ctx, record)).To(Succeed())
+
+			priority = PriorityLow
+			Expect(lock.Update(ctx, record)).To(Succeed())
+			Expect(getLease().Annotations).To(HaveKeyWithValue(AnnotationPriority, "0"))
+		})
+	})
+
+	Describe("#Describe", func() {
+		It("should return the key of the lease", func() {
+			Expect(lock.Describe()).To(Equal("extension-foo/foo-leader-election"))
+		})
+	})
+
+	Describe("#NodePriority", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		})
+
+		It("should return the high priority for schedulable nodes", func() {
+			_, err := client.CoreV1().Nodes().Create(ctx, node, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(NodePriority(client, "node")(ctx)).To(Equal(PriorityHigh))
+		})
+
+		It("should return the low priority for cordoned nodes", func() {
+			node.Spec.Unschedulable = true
+			_, err := client.CoreV1().Nodes().Create(ctx, node, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(NodePriority(client, "node")(ctx)).To(Equal(PriorityLow))
+		})
+
+		It("should return an error if the node does not exist", func() {
+			_, err := NodePriority(client, "node")(ctx)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
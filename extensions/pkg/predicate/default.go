@@ -94,7 +94,8 @@ var defaultControllerPredicate = predicate.Funcs{
 func hasOperationAnnotation(obj client.Object) bool {
 	return obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile ||
 		obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationRestore ||
-		obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationMigrate
+		obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationMigrate ||
+		obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan
 }
 
 func lastOperationNotSuccessful(obj client.Object) bool {
@@ -57,6 +57,7 @@ var _ = Describe("Default", func() {
 					Entry("reconcile", "reconcile"),
 					Entry("migrate", "migrate"),
 					Entry("restore", "restore"),
+					Entry("plan", "plan"),
 				)
 
 				It("should return true when the deletion timestamp is set", func() {
@@ -97,6 +98,7 @@ var _ = Describe("Default", func() {
 					Entry("reconcile", "reconcile"),
 					Entry("migrate", "migrate"),
 					Entry("restore", "restore"),
+					Entry("plan", "plan"),
 				)
 
 				It("should return true when the deletion timestamp is set and the status is equal", func() {
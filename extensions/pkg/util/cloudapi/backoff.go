@@ -0,0 +1,137 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// AdaptiveBackoff maintains a delay per key (e.g., per cloud service) which is applied before requests are sent. The
+// delay is increased multiplicatively whenever a request is throttled and decreased again for every request which has
+// not been throttled, so that the request rate adapts to the rate limits of the cloud provider.
+type AdaptiveBackoff struct {
+	// Initial is the delay applied after the first throttled request.
+	Initial time.Duration
+	// Max is the maximum delay.
+	Max time.Duration
+	// Factor is the factor by which the delay is increased for throttled requests and decreased for successful
+	// requests.
+	Factor float64
+	// Clock is the clock.
+	Clock clock.WithTicker
+
+	lock   sync.Mutex
+	delays map[string]time.Duration
+}
+
+// NewAdaptiveBackoff returns a new adaptive backoff with the given initial and maximum delay.
+func NewAdaptiveBackoff(initial, max time.Duration) *AdaptiveBackoff {
+	return &AdaptiveBackoff{
+		Initial: initial,
+		Max:     max,
+		Factor:  2,
+		Clock:   clock.RealClock{},
+	}
+}
+
+// Delay returns the current delay for the given key.
+func (b *AdaptiveBackoff) Delay(key string) time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.delays[key]
+}
+
+// Throttled increases the delay for the given key. If the cloud provider asked to retry after a certain duration
+// then the delay is at least this duration (but never exceeds the maximum delay).
+func (b *AdaptiveBackoff) Throttled(key string, retryAfter time.Duration) time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delay := time.Duration(float64(b.delays[key]) * b.Factor)
+	if delay < b.Initial {
+		delay = b.Initial
+	}
+	if delay < retryAfter {
+		delay = retryAfter
+	}
+	if delay > b.Max {
+		delay = b.Max
+	}
+
+	b.setDelay(key, delay)
+	return delay
+}
+
+// Succeeded decreases the delay for the given key after a request which has not been throttled. Once it falls below the initial delay, no delay is applied
+// anymore.
+func (b *AdaptiveBackoff) Succeeded(key string) time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delay := time.Duration(float64(b.delays[key]) / b.Factor)
+	if delay < b.Initial {
+		delay = 0
+	}
+
+	b.setDelay(key, delay)
+	return delay
+}
+
+// Wait blocks for the current delay of the given key or until the context is cancelled.
+func (b *AdaptiveBackoff) Wait(ctx context.Context, key string) error {
+	delay := b.Delay(key)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := b.Clock.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C():
+		return nil
+	}
+}
+
+func (b *AdaptiveBackoff) setDelay(key string, delay time.Duration) {
+	if b.delays == nil {
+		b.delays = make(map[string]time.Duration)
+	}
+
+	if delay == 0 {
+		delete(b.delays, key)
+		return
+	}
+	b.delays[key] = delay
+}
@@ -0,0 +1,113 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/extensions/pkg/util/cloudapi"
+)
+
+var _ = Describe("AdaptiveBackoff", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		backoff   *AdaptiveBackoff
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		backoff = NewAdaptiveBackoff(time.Second, 10*time.Second)
+		backoff.Clock = fakeClock
+	})
+
+	Describe("#Throttled", func() {
+		It("should increase the delay up to the maximum", func() {
+			Expect(backoff.Delay("compute")).To(BeZero())
+
+			Expect(backoff.Throttled("compute", 0)).To(Equal(time.Second))
+			Expect(backoff.Throttled("compute", 0)).To(Equal(2 * time.Second))
+			Expect(backoff.Throttled("compute", 0)).To(Equal(4 * time.Second))
+			Expect(backoff.Throttled("compute", 0)).To(Equal(8 * time.Second))
+			Expect(backoff.Throttled("compute", 0)).To(Equal(10 * time.Second))
+			Expect(backoff.Delay("compute")).To(Equal(10 * time.Second))
+		})
+
+		It("should respect the duration after which the request may be retried", func() {
+			Expect(backoff.Throttled("compute", 5*time.Second)).To(Equal(5 * time.Second))
+			Expect(backoff.Throttled("compute", time.Minute)).To(Equal(10 * time.Second))
+		})
+
+		It("should maintain the delays per key", func() {
+			backoff.Throttled("compute", 0)
+
+			Expect(backoff.Delay("compute")).To(Equal(time.Second))
+			Expect(backoff.Delay("storage")).To(BeZero())
+		})
+	})
+
+	Describe("#Succeeded", func() {
+		It("should decrease the delay until no delay is applied anymore", func() {
+			backoff.Throttled("compute", 4*time.Second)
+
+			Expect(backoff.Succeeded("compute")).To(Equal(2 * time.Second))
+			Expect(backoff.Succeeded("compute")).To(Equal(time.Second))
+			Expect(backoff.Succeeded("compute")).To(BeZero())
+			Expect(backoff.Delay("compute")).To(BeZero())
+		})
+	})
+
+	Describe("#Wait", func() {
+		It("should return immediately if there is no delay", func() {
+			Expect(backoff.Wait(context.Background(), "compute")).To(Succeed())
+		})
+
+		It("should wait for the delay", func() {
+			backoff.Throttled("compute", 0)
+
+			done := make(chan error)
+			go func() {
+				done <- backoff.Wait(context.Background(), "compute")
+			}()
+
+			Eventually(fakeClock.HasWaiters).Should(BeTrue())
+			Consistently(done).ShouldNot(Receive())
+
+			fakeClock.Step(time.Second)
+			Eventually(done).Should(Receive(BeNil()))
+		})
+
+		It("should stop waiting when the context is cancelled", func() {
+			backoff.Throttled("compute", 0)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			Expect(backoff.Wait(ctx, "compute")).To(MatchError(context.Canceled))
+		})
+	})
+
+	It("should not delay anything if the backoff is nil", func() {
+		var backoff *AdaptiveBackoff
+
+		Expect(backoff.Throttled("compute", time.Second)).To(BeZero())
+		Expect(backoff.Delay("compute")).To(BeZero())
+		Expect(backoff.Wait(context.Background(), "compute")).To(Succeed())
+	})
+})
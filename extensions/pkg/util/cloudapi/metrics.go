@@ -0,0 +1,81 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener_extension"
+	metricsSubsystem = "cloud_api"
+
+	// ResultSuccess is the value of the 'result' label for successful requests.
+	ResultSuccess = "success"
+	// ResultError is the value of the 'result' label for failed requests which have not been throttled.
+	ResultError = "error"
+	// ResultThrottled is the value of the 'result' label for requests which have been throttled by the cloud provider.
+	ResultThrottled = "throttled"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	metricRequestsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests to cloud provider APIs.",
+		},
+		[]string{
+			"provider",
+			"service",
+			"operation",
+			"result",
+		},
+	)
+
+	metricRequestDuration = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Histogram of the latency of requests to cloud provider APIs.",
+			// Start with 10ms with the last bucket being [~80s, Inf)
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 14),
+		},
+		[]string{
+			"provider",
+			"service",
+			"operation",
+		},
+	)
+
+	metricBackoffDelay = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "backoff_delay_seconds",
+			Help:      "Current delay applied to requests to cloud provider APIs due to throttling.",
+		},
+		[]string{
+			"provider",
+			"service",
+		},
+	)
+)
@@ -0,0 +1,159 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// Request identifies a request to the API of a cloud provider.
+type Request struct {
+	// Service is the cloud service the request is sent to, e.g. 'ec2' or 'compute'.
+	Service string
+	// Operation is the operation which is invoked, e.g. 'DescribeInstances'.
+	Operation string
+}
+
+// ThrottleClassifier determines whether an error returned by a cloud provider SDK indicates that the request has been
+// throttled. If the cloud provider asked to retry after a certain duration then it is returned as well.
+type ThrottleClassifier func(err error) (throttled bool, retryAfter time.Duration)
+
+// Observer records standardized metrics for the requests to the API of a cloud provider and applies an adaptive
+// backoff per cloud service when requests are throttled. It can be used for SDKs based on net/http via RoundTripper
+// and for all other SDKs via Do.
+type Observer struct {
+	provider string
+	backoff  *AdaptiveBackoff
+	clock    clock.PassiveClock
+}
+
+// NewObserver returns a new observer for the given cloud provider. If the backoff is nil then the requests are only
+// observed but not delayed.
+func NewObserver(provider string, backoff *AdaptiveBackoff) *Observer {
+	return &Observer{
+		provider: provider,
+		backoff:  backoff,
+		clock:    clock.RealClock{},
+	}
+}
+
+// Do waits for the current backoff delay of the request's service, invokes the given function and records its
+// outcome. The given classifier is used to determine whether a returned error indicates a throttled request.
+func (o *Observer) Do(ctx context.Context, req Request, fn func(context.Context) error, isThrottled ThrottleClassifier) error {
+	if err := o.backoff.Wait(ctx, req.Service); err != nil {
+		return err
+	}
+
+	start := o.clock.Now()
+	err := fn(ctx)
+
+	result, retryAfter := ResultSuccess, time.Duration(0)
+	if err != nil {
+		result = ResultError
+		if isThrottled != nil {
+			if throttled, after := isThrottled(err); throttled {
+				result, retryAfter = ResultThrottled, after
+			}
+		}
+	}
+
+	o.record(req, start, result, retryAfter)
+	return err
+}
+
+// RoundTripper returns an http.RoundTripper which observes all requests sent via the given round tripper. The given
+// function maps the HTTP requests to the service and operation they invoke. If it is nil then the host of the URL is
+// used as service and the HTTP method as operation.
+func (o *Observer) RoundTripper(next http.RoundTripper, classify func(*http.Request) Request) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if classify == nil {
+		classify = func(r *http.Request) Request {
+			return Request{Service: r.URL.Host, Operation: r.Method}
+		}
+	}
+
+	return &roundTripper{observer: o, next: next, classify: classify}
+}
+
+type roundTripper struct {
+	observer *Observer
+	next     http.RoundTripper
+	classify func(*http.Request) Request
+}
+
+func (r *roundTripper) RoundTrip(httpReq *http.Request) (*http.Response, error) {
+	req := r.classify(httpReq)
+
+	if err := r.observer.backoff.Wait(httpReq.Context(), req.Service); err != nil {
+		return nil, err
+	}
+
+	start := r.observer.clock.Now()
+	resp, err := r.next.RoundTrip(httpReq)
+
+	result, retryAfter := ResultSuccess, time.Duration(0)
+	if throttled, after := IsThrottledResponse(resp); throttled {
+		result, retryAfter = ResultThrottled, after
+	} else if err != nil || resp.StatusCode >= http.StatusBadRequest {
+		result = ResultError
+	}
+
+	r.observer.record(req, start, result, retryAfter)
+	return resp, err
+}
+
+// IsThrottledResponse returns true if the given HTTP response indicates that the request has been throttled, i.e., if
+// its status code is 429 (Too Many Requests) or 503 (Service Unavailable) with a 'Retry-After' header. The duration of
+// the 'Retry-After' header (in seconds) is returned as well.
+func IsThrottledResponse(resp *http.Response) (bool, time.Duration) {
+	if resp == nil {
+		return false, 0
+	}
+
+	retryAfterHeader := resp.Header.Get("Retry-After")
+	if resp.StatusCode != http.StatusTooManyRequests && (resp.StatusCode != http.StatusServiceUnavailable || retryAfterHeader == "") {
+		return false, 0
+	}
+
+	seconds, err := strconv.Atoi(retryAfterHeader)
+	if err != nil || seconds < 0 {
+		return true, 0
+	}
+	return true, time.Duration(seconds) * time.Second
+}
+
+func (o *Observer) record(req Request, start time.Time, result string, retryAfter time.Duration) {
+	metricRequestsTotal.WithLabelValues(o.provider, req.Service, req.Operation, result).Inc()
+	metricRequestDuration.WithLabelValues(o.provider, req.Service, req.Operation).Observe(o.clock.Since(start).Seconds())
+
+	if o.backoff == nil {
+		return
+	}
+
+	var delay time.Duration
+	if result == ResultThrottled {
+		delay = o.backoff.Throttled(req.Service, retryAfter)
+	} else {
+		delay = o.backoff.Succeeded(req.Service)
+	}
+	metricBackoffDelay.WithLabelValues(o.provider, req.Service).Set(delay.Seconds())
+}
@@ -0,0 +1,204 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	. "github.com/gardener/gardener/extensions/pkg/util/cloudapi"
+)
+
+var _ = Describe("Observer", func() {
+	var (
+		ctx = context.Background()
+
+		provider string
+		backoff  *AdaptiveBackoff
+		observer *Observer
+
+		errThrottled = errors.New("throttled")
+		isThrottled  = func(err error) (bool, time.Duration) {
+			return errors.Is(err, errThrottled), 0
+		}
+	)
+
+	BeforeEach(func() {
+		// use a unique provider per test since the metrics are registered globally
+		provider = "test-" + CurrentSpecReport().LeafNodeText
+		backoff = NewAdaptiveBackoff(time.Millisecond, 10*time.Millisecond)
+		observer = NewObserver(provider, backoff)
+	})
+
+	metricValue := func(name string, labels map[string]string) float64 {
+		families, err := runtimemetrics.Registry.Gather()
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+		for _, family := range families {
+			if family.GetName() != name {
+				continue
+			}
+
+		metrics:
+			for _, metric := range family.GetMetric() {
+				metricLabels := map[string]string{}
+				for _, label := range metric.GetLabel() {
+					metricLabels[label.GetName()] = label.GetValue()
+				}
+				for key, value := range labels {
+					if metricLabels[key] != value {
+						continue metrics
+					}
+				}
+
+				switch {
+				case metric.GetCounter() != nil:
+					return metric.GetCounter().GetValue()
+				case metric.GetGauge() != nil:
+					return metric.GetGauge().GetValue()
+				case metric.GetHistogram() != nil:
+					return float64(metric.GetHistogram().GetSampleCount())
+				}
+			}
+		}
+		return 0
+	}
+
+	requests := func(service, operation, result string) float64 {
+		return metricValue("gardener_extension_cloud_api_requests_total", map[string]string{"provider": provider, "service": service, "operation": operation, "result": result})
+	}
+
+	Describe("#Do", func() {
+		It("should record successful requests", func() {
+			Expect(observer.Do(ctx, Request{Service: "compute", Operation: "ListInstances"}, func(context.Context) error { return nil }, isThrottled)).To(Succeed())
+
+			Expect(requests("compute", "ListInstances", "success")).To(Equal(float64(1)))
+			Expect(metricValue("gardener_extension_cloud_api_request_duration_seconds", map[string]string{"provider": provider, "service": "compute", "operation": "ListInstances"})).To(Equal(float64(1)))
+			Expect(backoff.Delay("compute")).To(BeZero())
+		})
+
+		It("should record failed requests", func() {
+			err := errors.New("fake")
+			Expect(observer.Do(ctx, Request{Service: "compute", Operation: "ListInstances"}, func(context.Context) error { return err }, isThrottled)).To(MatchError(err))
+
+			Expect(requests("compute", "ListInstances", "error")).To(Equal(float64(1)))
+			Expect(backoff.Delay("compute")).To(BeZero())
+		})
+
+		It("should record throttled requests and back off", func() {
+			Expect(observer.Do(ctx, Request{Service: "compute", Operation: "ListInstances"}, func(context.Context) error { return errThrottled }, isThrottled)).To(MatchError(errThrottled))
+			Expect(observer.Do(ctx, Request{Service: "compute", Operation: "ListInstances"}, func(context.Context) error { return errThrottled }, isThrottled)).To(MatchError(errThrottled))
+
+			Expect(requests("compute", "ListInstances", "throttled")).To(Equal(float64(2)))
+			Expect(backoff.Delay("compute")).To(Equal(2 * time.Millisecond))
+			Expect(metricValue("gardener_extension_cloud_api_backoff_delay_seconds", map[string]string{"provider": provider, "service": "compute"})).To(Equal(0.002))
+
+			Expect(observer.Do(ctx, Request{Service: "compute", Operation: "ListInstances"}, func(context.Context) error { return nil }, isThrottled)).To(Succeed())
+			Expect(backoff.Delay("compute")).To(Equal(time.Millisecond))
+		})
+	})
+
+	Describe("#RoundTripper", func() {
+		var (
+			server     *httptest.Server
+			statusCode int
+			header     http.Header
+			httpClient *http.Client
+		)
+
+		BeforeEach(func() {
+			statusCode, header = http.StatusOK, http.Header{}
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				for key, values := range header {
+					w.Header()[key] = values
+				}
+				w.WriteHeader(statusCode)
+			}))
+			DeferCleanup(server.Close)
+
+			httpClient = &http.Client{Transport: observer.RoundTripper(nil, func(r *http.Request) Request {
+				return Request{Service: "storage", Operation: r.Method + " " + r.URL.Path}
+			})}
+		})
+
+		get := func() {
+			resp, err := httpClient.Get(server.URL + "/buckets")
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(1, resp.Body.Close()).To(Succeed())
+		}
+
+		It("should record successful requests", func() {
+			get()
+
+			Expect(requests("storage", "GET /buckets", "success")).To(Equal(float64(1)))
+		})
+
+		It("should record failed requests", func() {
+			statusCode = http.StatusInternalServerError
+			get()
+
+			Expect(requests("storage", "GET /buckets", "error")).To(Equal(float64(1)))
+			Expect(backoff.Delay("storage")).To(BeZero())
+		})
+
+		It("should record throttled requests and back off", func() {
+			statusCode = http.StatusTooManyRequests
+			get()
+
+			Expect(requests("storage", "GET /buckets", "throttled")).To(Equal(float64(1)))
+			Expect(backoff.Delay("storage")).To(Equal(time.Millisecond))
+		})
+
+		It("should use the default classification", func() {
+			httpClient.Transport = observer.RoundTripper(nil, nil)
+			get()
+
+			Expect(requests(server.Listener.Addr().String(), "GET", "success")).To(Equal(float64(1)))
+		})
+	})
+
+	Describe("#IsThrottledResponse", func() {
+		DescribeTable("should detect throttled responses",
+			func(statusCode int, retryAfter string, expectedThrottled bool, expectedRetryAfter time.Duration) {
+				resp := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+				if retryAfter != "" {
+					resp.Header.Set("Retry-After", retryAfter)
+				}
+
+				throttled, after := IsThrottledResponse(resp)
+				Expect(throttled).To(Equal(expectedThrottled))
+				Expect(after).To(Equal(expectedRetryAfter))
+			},
+
+			Entry("ok", http.StatusOK, "", false, time.Duration(0)),
+			Entry("too many requests", http.StatusTooManyRequests, "", true, time.Duration(0)),
+			Entry("too many requests with retry-after", http.StatusTooManyRequests, "3", true, 3*time.Second),
+			Entry("too many requests with retry-after date", http.StatusTooManyRequests, "Wed, 21 Oct 2015 07:28:00 GMT", true, time.Duration(0)),
+			Entry("service unavailable", http.StatusServiceUnavailable, "", false, time.Duration(0)),
+			Entry("service unavailable with retry-after", http.StatusServiceUnavailable, "5", true, 5*time.Second),
+		)
+
+		It("should not consider missing responses as throttled", func() {
+			Expect(IsThrottledResponse(nil)).To(BeFalse())
+		})
+	})
+})
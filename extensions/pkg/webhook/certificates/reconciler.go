@@ -45,7 +45,8 @@ const certificateReconcilerName = "webhook-certificate"
 
 // reconciler is a simple reconciler that manages the webhook CA and server certificate using a secrets manager.
 // It runs Generate for both secret configs followed by Cleanup every SyncPeriod and updates the WebhookConfigurations
-// accordingly with the new CA bundle.
+// accordingly with the new CA bundle. Server certificates annotated as revoked are rotated immediately and published
+// in a CRL which is appended to the CA bundle.
 type reconciler struct {
 	// Clock is the clock.
 	Clock clock.Clock
@@ -124,7 +125,7 @@ func (r *reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 			return fmt.Errorf("failed to create new unchached client: %w", err)
 		}
 
-		sm, err := r.newSecretsManager(ctx, mgr.GetLogger(), uncachedClient)
+		sm, err := r.newSecretsManager(ctx, mgr.GetLogger(), uncachedClient, nil)
 		if err != nil {
 			return fmt.Errorf("failed to create new SecretsManager: %w", err)
 		}
@@ -156,7 +157,19 @@ func (r *reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 func (r *reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
-	sm, err := r.newSecretsManager(ctx, log, r.sourceClient)
+	revokedSerialNumbers, serverCertRevoked, err := r.revokedServerCertificates(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed determining revoked server certificates: %w", err)
+	}
+
+	var secretNamesToTimes map[string]time.Time
+	if serverCertRevoked {
+		// the server certificate is rotated by setting a new last rotation initiation time for its config
+		log.Info("Current webhook server certificate is revoked, rotating it", "serverSecretName", r.ServerSecretName)
+		secretNamesToTimes = map[string]time.Time{r.ServerSecretName: r.Clock.Now()}
+	}
+
+	sm, err := r.newSecretsManager(ctx, log, r.sourceClient, secretNamesToTimes)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to create new SecretsManager: %w", err)
 	}
@@ -184,8 +197,14 @@ func (r *reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 	}
 	log.Info("Generated webhook server cert", "serverSecretName", serverSecret.Name)
 
+	crl, err := r.reconcileRevocation(ctx, caSecret, serverSecret, revokedSerialNumbers)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	caBundle := caBundleWithCRL(caBundleSecret.Data[secretsutils.DataKeyCertificateBundle], crl)
+
 	for _, sourceWebhookConfig := range r.SourceWebhookConfigs.GetWebhookConfigs() {
-		if err := r.reconcileSourceWebhookConfig(ctx, sourceWebhookConfig, caBundleSecret); err != nil {
+		if err := r.reconcileSourceWebhookConfig(ctx, sourceWebhookConfig, caBundle); err != nil {
 			return reconcile.Result{}, fmt.Errorf("error reconciling source webhook config %s: %w", client.ObjectKeyFromObject(sourceWebhookConfig), err)
 		}
 		log.Info("Updated source webhook config with new CA bundle", "webhookConfig", sourceWebhookConfig)
@@ -195,7 +214,7 @@ func (r *reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 		for _, shootWebhookConfig := range r.ShootWebhookConfigs.GetWebhookConfigs() {
 			// update shoot webhook config object (in memory) with the freshly created CA bundle which is also used by the
 			// ControlPlane actuator
-			if err := extensionswebhook.InjectCABundleIntoWebhookConfig(shootWebhookConfig, caBundleSecret.Data[secretsutils.DataKeyCertificateBundle]); err != nil {
+			if err := extensionswebhook.InjectCABundleIntoWebhookConfig(shootWebhookConfig, caBundle); err != nil {
 				return reconcile.Result{}, err
 			}
 		}
@@ -222,18 +241,22 @@ func (r *reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 	return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
 }
 
-func (r *reconciler) reconcileSourceWebhookConfig(ctx context.Context, sourceWebhookConfig client.Object, caBundleSecret *corev1.Secret) error {
+func (r *reconciler) reconcileSourceWebhookConfig(ctx context.Context, sourceWebhookConfig client.Object, caBundle []byte) error {
+	return injectCABundleIntoSourceWebhookConfig(ctx, r.client, sourceWebhookConfig, caBundle)
+}
+
+func injectCABundleIntoSourceWebhookConfig(ctx context.Context, c client.Client, sourceWebhookConfig client.Object, caBundle []byte) error {
 	// copy object so that we don't lose its name on API/client errors
 	config := sourceWebhookConfig.DeepCopyObject().(client.Object)
-	if err := r.client.Get(ctx, client.ObjectKeyFromObject(config), config); err != nil {
+	if err := c.Get(ctx, client.ObjectKeyFromObject(config), config); err != nil {
 		return err
 	}
 
 	patch := client.MergeFromWithOptions(config.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
-	if err := extensionswebhook.InjectCABundleIntoWebhookConfig(config, caBundleSecret.Data[secretsutils.DataKeyCertificateBundle]); err != nil {
+	if err := extensionswebhook.InjectCABundleIntoWebhookConfig(config, caBundle); err != nil {
 		return err
 	}
-	return r.client.Patch(ctx, config, patch)
+	return c.Patch(ctx, config, patch)
 }
 
 func isWebhookServerSecretPresent(ctx context.Context, c client.Reader, scheme *runtime.Scheme, secretName, namespace, identity string) (bool, error) {
@@ -244,7 +267,7 @@ func isWebhookServerSecretPresent(ctx context.Context, c client.Reader, scheme *
 	})
 }
 
-func (r *reconciler) newSecretsManager(ctx context.Context, log logr.Logger, c client.Client) (secretsmanager.Interface, error) {
+func (r *reconciler) newSecretsManager(ctx context.Context, log logr.Logger, c client.Client, secretNamesToTimes map[string]time.Time) (secretsmanager.Interface, error) {
 	return secretsmanager.New(
 		ctx,
 		log.WithName("secretsmanager"),
@@ -252,7 +275,7 @@ func (r *reconciler) newSecretsManager(ctx context.Context, log logr.Logger, c c
 		c,
 		r.Namespace,
 		r.Identity,
-		secretsmanager.Config{CASecretAutoRotation: true},
+		secretsmanager.Config{CASecretAutoRotation: true, SecretNamesToTimes: secretNamesToTimes},
 	)
 }
 
@@ -16,11 +16,16 @@ package certificates
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"golang.org/x/crypto/ocsp"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,7 +44,8 @@ import (
 const certificateReloaderName = "webhook-certificate-reloader"
 
 // reloader is a simple reconciler that retrieves the current webhook server certificate managed by a secrets manager
-// every syncPeriod and writes it to certDir.
+// every syncPeriod and writes it to certDir. It also serves the certificate to the webhook server together with the
+// OCSP response generated by the certificate reconciler (OCSP stapling).
 type reloader struct {
 	// SyncPeriod is the frequency with which to reload the server cert. Defaults to 5m.
 	SyncPeriod time.Duration
@@ -54,6 +60,7 @@ type reloader struct {
 	reader                 client.Reader
 	certDir                string
 	newestServerSecretName string
+	certificate            atomic.Pointer[tls.Certificate]
 }
 
 // AddToManager does an initial retrieval of an existing webhook server secret and then adds reloader to the given
@@ -92,6 +99,16 @@ func (r *reloader) AddToManager(ctx context.Context, mgr manager.Manager, source
 		return err
 	}
 
+	if err := r.loadCertificate(ctx, apiReader, serverCert, serverKey); err != nil {
+		return err
+	}
+
+	// serve the loaded certificate including the stapled OCSP response instead of letting the webhook server watch the
+	// certificate files
+	defaultServer.Options.TLSOpts = append(defaultServer.Options.TLSOpts, func(config *tls.Config) {
+		config.GetCertificate = r.getCertificate
+	})
+
 	// add controller that reloads the server cert secret periodically
 	ctrl, err := controller.NewUnmanaged(certificateReloaderName, mgr, controller.Options{
 		Reconciler:   r,
@@ -139,6 +156,11 @@ func (r *reloader) Reconcile(ctx context.Context, _ reconcile.Request) (reconcil
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
+	// the OCSP response is renewed regularly, hence it is reloaded in every reconciliation
+	if err := r.loadCertificate(ctx, r.reader, serverCert, serverKey); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// prevent unnecessary disk writes
 	if secretName == r.newestServerSecretName {
 		log.V(1).Info("Secret already written to disk, checking again later")
@@ -155,12 +177,8 @@ func (r *reloader) Reconcile(ctx context.Context, _ reconcile.Request) (reconcil
 }
 
 func (r *reloader) getServerCert(ctx context.Context, reader client.Reader) (bool, string, []byte, []byte, error) {
-	secretList := &corev1.SecretList{}
-	if err := reader.List(ctx, secretList, client.InNamespace(r.Namespace), client.MatchingLabels{
-		secretsmanager.LabelKeyName:            r.ServerSecretName,
-		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
-		secretsmanager.LabelKeyManagerIdentity: r.Identity,
-	}); err != nil {
+	secretList, err := listServerSecrets(ctx, reader, r.ServerSecretName, r.Namespace, r.Identity)
+	if err != nil {
 		return false, "", nil, nil, err
 	}
 
@@ -172,6 +190,48 @@ func (r *reloader) getServerCert(ctx context.Context, reader client.Reader) (boo
 	return true, s.Name, s.Data[secretsutils.DataKeyCertificate], s.Data[secretsutils.DataKeyPrivateKey], nil
 }
 
+// loadCertificate loads the given server certificate together with the OCSP response from the revocation secret. The
+// OCSP response is only stapled if it was issued for the given certificate.
+func (r *reloader) loadCertificate(ctx context.Context, reader client.Reader, serverCert, serverKey []byte) error {
+	certificate, err := tls.X509KeyPair(serverCert, serverKey)
+	if err != nil {
+		return fmt.Errorf("failed loading server certificate: %w", err)
+	}
+
+	revocationSecret := &corev1.Secret{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: revocationSecretName(r.ServerSecretName), Namespace: r.Namespace}, revocationSecret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading revocation secret: %w", err)
+		}
+	} else if ocspResponse := revocationSecret.Data[DataKeyOCSPResponse]; len(ocspResponse) > 0 {
+		if leaf, err := x509.ParseCertificate(certificate.Certificate[0]); err == nil {
+			if _, err := ocsp.ParseResponseForCert(ocspResponse, leaf, nil); err == nil {
+				certificate.OCSPStaple = ocspResponse
+			}
+		}
+	}
+
+	r.certificate.Store(&certificate)
+	return nil
+}
+
+func (r *reloader) getCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	certificate := r.certificate.Load()
+	if certificate == nil {
+		return nil, fmt.Errorf("webhook server certificate is not loaded yet")
+	}
+	return certificate, nil
+}
+
+func listServerSecrets(ctx context.Context, reader client.Reader, serverSecretName, namespace, identity string) (*corev1.SecretList, error) {
+	secretList := &corev1.SecretList{}
+	return secretList, reader.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{
+		secretsmanager.LabelKeyName:            serverSecretName,
+		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyManagerIdentity: identity,
+	})
+}
+
 type nonLeaderElectionRunnable struct {
 	manager.Runnable
 }
@@ -0,0 +1,283 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// AnnotationRevoked is the annotation which can be set on webhook server certificate secrets (with value "true")
+	// in order to revoke them, e.g. because they have been compromised. The certificate reconciler immediately rotates
+	// a revoked server certificate and adds it to the certificate revocation list (CRL) distributed with the CA bundle.
+	AnnotationRevoked = "certificates.extensions.gardener.cloud/revoked"
+	// DataKeyCRL is the key in the revocation secret data holding the PEM-encoded certificate revocation list.
+	DataKeyCRL = "ca.crl"
+	// DataKeyOCSPResponse is the key in the revocation secret data holding the DER-encoded OCSP response for the
+	// current webhook server certificate. It is stapled to the TLS handshakes of the webhook server.
+	DataKeyOCSPResponse = "tls.ocsp"
+
+	// revocationInfoValidity is the validity of the generated CRL and OCSP responses. They are renewed when half of the
+	// validity has passed.
+	revocationInfoValidity = 24 * time.Hour
+	// pemTypeCRL is the PEM block type of certificate revocation lists.
+	pemTypeCRL = "X509 CRL"
+)
+
+// RevokeServerCertificate marks the given webhook server certificate secret as revoked. The certificate reconciler
+// rotates the server certificate and publishes the revoked certificate in the CRL with its next reconciliation.
+func RevokeServerCertificate(ctx context.Context, c client.Client, secret *corev1.Secret) error {
+	patch := client.MergeFrom(secret.DeepCopy())
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, AnnotationRevoked, "true")
+	return c.Patch(ctx, secret, patch)
+}
+
+// GenerateCRL generates a PEM-encoded certificate revocation list signed by the given CA which contains the given
+// entries.
+func GenerateCRL(ca *secretsutils.Certificate, entries []x509.RevocationListEntry, now time.Time) ([]byte, error) {
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(now.Unix()),
+		ThisUpdate:                now,
+		NextUpdate:                now.Add(revocationInfoValidity),
+		RevokedCertificateEntries: entries,
+	}, ca.Certificate, ca.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating certificate revocation list: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeCRL, Bytes: crl}), nil
+}
+
+// GenerateOCSPResponse generates a DER-encoded OCSP response signed by the given CA which states the revocation status
+// of the given certificate according to the given CRL entries.
+func GenerateOCSPResponse(ca *secretsutils.Certificate, cert *x509.Certificate, entries []x509.RevocationListEntry, now time.Time) ([]byte, error) {
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: cert.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(revocationInfoValidity),
+	}
+
+	for _, entry := range entries {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			template.Status = ocsp.Revoked
+			template.RevokedAt = entry.RevocationTime
+			template.RevocationReason = entry.ReasonCode
+			break
+		}
+	}
+
+	response, err := ocsp.CreateResponse(ca.Certificate, ca.Certificate, template, ca.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating OCSP response: %w", err)
+	}
+	return response, nil
+}
+
+// ParseCRLEntries parses the given PEM-encoded certificate revocation list and returns its entries.
+func ParseCRLEntries(data []byte) ([]x509.RevocationListEntry, error) {
+	crl, err := parseCRL(data)
+	if err != nil {
+		return nil, err
+	}
+	return crl.RevokedCertificateEntries, nil
+}
+
+func parseCRL(data []byte) (*x509.RevocationList, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != pemTypeCRL {
+		return nil, fmt.Errorf("data does not contain a PEM-encoded certificate revocation list")
+	}
+	return x509.ParseRevocationList(block.Bytes)
+}
+
+// revokedServerCertificates returns the serial numbers of all webhook server certificates which are annotated as
+// revoked. It also returns whether the newest server certificate is revoked, i.e. whether it must be rotated.
+func (r *reconciler) revokedServerCertificates(ctx context.Context) ([]*big.Int, bool, error) {
+	secretList, err := listServerSecrets(ctx, r.sourceClient, r.ServerSecretName, r.Namespace, r.Identity)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var (
+		serialNumbers []*big.Int
+		newestSecret  *corev1.Secret
+	)
+
+	for i, secret := range secretList.Items {
+		if newestSecret == nil || newestSecret.CreationTimestamp.Before(&secret.CreationTimestamp) {
+			newestSecret = &secretList.Items[i]
+		}
+
+		if secret.Annotations[AnnotationRevoked] != "true" {
+			continue
+		}
+
+		cert, err := utils.DecodeCertificate(secret.Data[secretsutils.DataKeyCertificate])
+		if err != nil {
+			return nil, false, fmt.Errorf("failed decoding certificate of revoked secret %s: %w", client.ObjectKeyFromObject(&secret), err)
+		}
+		serialNumbers = append(serialNumbers, cert.SerialNumber)
+	}
+
+	return serialNumbers, newestSecret != nil && newestSecret.Annotations[AnnotationRevoked] == "true", nil
+}
+
+// reconcileRevocation maintains the secret containing the CRL and the OCSP response for the current server certificate.
+// Both are signed by the current CA which always signs the current server certificate. Revoked certificates signed by
+// an old CA don't need to be listed as old CAs are removed from the CA bundle shortly after their rotation. Entries
+// are kept as long as the CA validity since all certificates revoked before have expired for sure. The PEM-encoded CRL
+// is returned if it contains any entries, i.e. if it must be distributed with the CA bundle.
+func (r *reconciler) reconcileRevocation(ctx context.Context, caSecret, serverSecret *corev1.Secret, revokedSerialNumbers []*big.Int) ([]byte, error) {
+	ca, err := secretsutils.LoadCertificate(caSecret.Name, caSecret.Data[secretsutils.DataKeyPrivateKeyCA], caSecret.Data[secretsutils.DataKeyCertificateCA])
+	if err != nil {
+		return nil, fmt.Errorf("failed loading CA certificate: %w", err)
+	}
+
+	serverCert, err := utils.DecodeCertificate(serverSecret.Data[secretsutils.DataKeyCertificate])
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding server certificate: %w", err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: revocationSecretName(r.ServerSecretName), Namespace: r.Namespace}}
+	if err := r.sourceClient.Get(ctx, client.ObjectKeyFromObject(secret), secret); client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+
+	now := r.Clock.Now().UTC()
+
+	entries, changed := mergeRevocationEntries(secret.Data[DataKeyCRL], revokedSerialNumbers, now)
+	if !changed && !revocationInfoNeedsRenewal(secret.Data, ca.Certificate, serverCert, now) {
+		return crlToDistribute(secret.Data[DataKeyCRL], entries), nil
+	}
+
+	crl, err := GenerateCRL(ca, entries, now)
+	if err != nil {
+		return nil, err
+	}
+
+	ocspResponse, err := GenerateOCSPResponse(ca, serverCert, entries, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.sourceClient, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			DataKeyCRL:          crl,
+			DataKeyOCSPResponse: ocspResponse,
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed reconciling revocation secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	return crlToDistribute(crl, entries), nil
+}
+
+func crlToDistribute(crl []byte, entries []x509.RevocationListEntry) []byte {
+	if len(entries) == 0 {
+		return nil
+	}
+	return crl
+}
+
+// mergeRevocationEntries adds the given serial numbers to the entries of the existing CRL (if not yet contained) and
+// drops entries which are older than the CA validity. It returns whether the entries changed.
+func mergeRevocationEntries(existingCRL []byte, serialNumbers []*big.Int, now time.Time) ([]x509.RevocationListEntry, bool) {
+	var (
+		entries []x509.RevocationListEntry
+		changed bool
+	)
+
+	// An invalid or missing CRL is regenerated from the currently revoked secrets.
+	existingEntries, _ := ParseCRLEntries(existingCRL)
+	for _, entry := range existingEntries {
+		if entry.RevocationTime.Add(caCertificateValidity).Before(now) {
+			changed = true
+			continue
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   entry.SerialNumber,
+			RevocationTime: entry.RevocationTime,
+			ReasonCode:     entry.ReasonCode,
+		})
+	}
+
+outer:
+	for _, serialNumber := range serialNumbers {
+		for _, entry := range entries {
+			if entry.SerialNumber.Cmp(serialNumber) == 0 {
+				continue outer
+			}
+		}
+
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serialNumber,
+			RevocationTime: now,
+			ReasonCode:     ocsp.KeyCompromise,
+		})
+		changed = true
+	}
+
+	return entries, changed
+}
+
+// revocationInfoNeedsRenewal returns true if the CRL or the OCSP response in the given data are missing, not issued by
+// the given CA, not issued for the given server certificate, or if half of their validity has passed.
+func revocationInfoNeedsRenewal(data map[string][]byte, ca, serverCert *x509.Certificate, now time.Time) bool {
+	renewalTime := now.Add(-revocationInfoValidity / 2)
+
+	crl, err := parseCRL(data[DataKeyCRL])
+	if err != nil || crl.CheckSignatureFrom(ca) != nil || crl.ThisUpdate.Before(renewalTime) {
+		return true
+	}
+
+	response, err := ocsp.ParseResponseForCert(data[DataKeyOCSPResponse], serverCert, ca)
+	return err != nil || response.ThisUpdate.Before(renewalTime)
+}
+
+// caBundleWithCRL appends the PEM-encoded CRL to the given CA bundle. Clients only considering certificates ignore
+// the CRL block.
+func caBundleWithCRL(caBundle, crl []byte) []byte {
+	if len(crl) == 0 {
+		return caBundle
+	}
+
+	bundle := make([]byte, 0, len(caBundle)+len(crl)+1)
+	bundle = append(bundle, bytes.TrimRight(caBundle, "\n")...)
+	bundle = append(bundle, '\n')
+	return append(bundle, crl...)
+}
+
+func revocationSecretName(serverSecretName string) string {
+	return serverSecretName + "-revocation"
+}
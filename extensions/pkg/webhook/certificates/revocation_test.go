@@ -0,0 +1,136 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificates_test
+
+import (
+	"context"
+	"crypto/x509"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/webhook/certificates"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Revocation", func() {
+	var (
+		now = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		ca         *secretsutils.Certificate
+		serverCert *secretsutils.Certificate
+	)
+
+	BeforeEach(func() {
+		generatedCA, err := (&secretsutils.CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "ca",
+			CertType:   secretsutils.CACert,
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		// load the CA from its PEM encoding like the reconciler does, so that the certificate contains all extensions
+		ca, err = secretsutils.LoadCertificate("ca", generatedCA.PrivateKeyPEM, generatedCA.CertificatePEM)
+		Expect(err).NotTo(HaveOccurred())
+
+		generatedServerCert, err := (&secretsutils.CertificateSecretConfig{
+			Name:       "server",
+			CommonName: "server",
+			CertType:   secretsutils.ServerCert,
+			SigningCA:  ca,
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		serverCert, err = secretsutils.LoadCertificate("server", generatedServerCert.PrivateKeyPEM, generatedServerCert.CertificatePEM)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#GenerateCRL", func() {
+		It("should generate a CRL signed by the CA containing the given entries", func() {
+			crl, err := GenerateCRL(ca, []x509.RevocationListEntry{{SerialNumber: serverCert.Certificate.SerialNumber, RevocationTime: now}}, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := ParseCRLEntries(crl)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].SerialNumber).To(Equal(serverCert.Certificate.SerialNumber))
+			Expect(entries[0].RevocationTime).To(Equal(now))
+		})
+
+		It("should generate an empty CRL", func() {
+			crl, err := GenerateCRL(ca, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := ParseCRLEntries(crl)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+
+	Describe("#ParseCRLEntries", func() {
+		It("should fail for data not containing a CRL", func() {
+			_, err := ParseCRLEntries(ca.CertificatePEM)
+			Expect(err).To(MatchError(ContainSubstring("does not contain a PEM-encoded certificate revocation list")))
+		})
+	})
+
+	Describe("#GenerateOCSPResponse", func() {
+		It("should state that the certificate is good if it is not revoked", func() {
+			data, err := GenerateOCSPResponse(ca, serverCert.Certificate, []x509.RevocationListEntry{{SerialNumber: big.NewInt(42), RevocationTime: now}}, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err := ocsp.ParseResponseForCert(data, serverCert.Certificate, ca.Certificate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Status).To(Equal(ocsp.Good))
+			Expect(response.ThisUpdate).To(Equal(now))
+			Expect(response.NextUpdate).To(Equal(now.Add(24 * time.Hour)))
+		})
+
+		It("should state that the certificate is revoked", func() {
+			data, err := GenerateOCSPResponse(ca, serverCert.Certificate, []x509.RevocationListEntry{{SerialNumber: serverCert.Certificate.SerialNumber, RevocationTime: now, ReasonCode: ocsp.KeyCompromise}}, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err := ocsp.ParseResponseForCert(data, serverCert.Certificate, ca.Certificate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Status).To(Equal(ocsp.Revoked))
+			Expect(response.RevokedAt).To(Equal(now))
+			Expect(response.RevocationReason).To(Equal(ocsp.KeyCompromise))
+		})
+	})
+
+	Describe("#RevokeServerCertificate", func() {
+		It("should annotate the secret", func() {
+			var (
+				ctx        = context.TODO()
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+				secret     = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "extension"}}
+			)
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(RevokeServerCertificate(ctx, fakeClient, secret)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			Expect(secret.Annotations).To(HaveKeyWithValue("certificates.extensions.gardener.cloud/revoked", "true"))
+		})
+	})
+})
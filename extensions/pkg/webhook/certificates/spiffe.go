@@ -0,0 +1,327 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+	"github.com/spiffe/go-spiffe/v2/workloadapi"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsshootwebhook "github.com/gardener/gardener/extensions/pkg/webhook/shoot"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	spiffeCertificateReconcilerName = "webhook-spiffe-certificate"
+	spiffeCertificateReloaderName   = "webhook-spiffe-certificate-reloader"
+)
+
+// DefaultSPIFFESyncPeriod is the default sync period for the SPIFFE certificate reconciler and reloader. The SVIDs and
+// trust bundles are rotated by the workload API and only read from memory, hence the period is shorter than
+// DefaultSyncPeriod.
+var DefaultSPIFFESyncPeriod = 30 * time.Second
+
+// X509Source is a source of X.509-SVIDs and X.509 trust bundles, e.g. a workloadapi.X509Source.
+type X509Source interface {
+	x509svid.Source
+	x509bundle.Source
+}
+
+// NewSPIFFEX509Source creates a new X509Source which is connected to the SPIFFE workload API listening on the given
+// unix socket path. It blocks until the first X.509-SVID has been received and keeps it up-to-date until the given
+// context is cancelled.
+func NewSPIFFEX509Source(ctx context.Context, socketPath string) (X509Source, error) {
+	addr := socketPath
+	if !strings.HasPrefix(addr, "unix://") {
+		addr = "unix://" + addr
+	}
+
+	source, err := workloadapi.NewX509Source(ctx, workloadapi.WithClientOptions(workloadapi.WithAddr(addr)))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating X.509 source for SPIFFE workload API at %q: %w", addr, err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = source.Close()
+	}()
+
+	return source, nil
+}
+
+// ReadSPIFFECertificates returns the PEM-encoded server certificate chain and private key of the current X.509-SVID
+// together with the PEM-encoded CA bundle of the SVID's trust domain.
+func ReadSPIFFECertificates(source X509Source) (serverCert, serverKey, caBundle []byte, err error) {
+	svid, err := source.GetX509SVID()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed getting X.509-SVID: %w", err)
+	}
+
+	serverCert, serverKey, err = svid.Marshal()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed marshalling X.509-SVID %q: %w", svid.ID, err)
+	}
+
+	bundle, err := source.GetX509BundleForTrustDomain(svid.ID.TrustDomain())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed getting X.509 bundle for trust domain %q: %w", svid.ID.TrustDomain(), err)
+	}
+
+	caBundle, err = bundle.Marshal()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed marshalling X.509 bundle for trust domain %q: %w", svid.ID.TrustDomain(), err)
+	}
+
+	return serverCert, serverKey, caBundle, nil
+}
+
+// AddSPIFFECertificateManagementToManager adds reconcilers to the given manager that use the X.509-SVIDs of the
+// SPIFFE workload API listening on the given socket as webhook certificates, namely
+// - write the current X.509-SVID to disk for the webhook server to pick up (in all replicas)
+// - inject the trust bundle of the SVID's trust domain into the webhook configs (in leader only)
+// The webhook server certificate is not generated by the extension in this case, i.e., the registration entry of the
+// extension must contain the DNS names (or IP addresses) the webhooks are registered with.
+func AddSPIFFECertificateManagementToManager(
+	ctx context.Context,
+	mgr manager.Manager,
+	socketPath string,
+	sourceWebhookConfigs extensionswebhook.Configs,
+	shootWebhookConfigs *extensionswebhook.Configs,
+	atomicShootWebhookConfigs *atomic.Value,
+	shootNamespaceSelector map[string]string,
+	shootWebhookManagedResourceName string,
+	componentName string,
+	namespace string,
+) error {
+	source, err := NewSPIFFEX509Source(ctx, socketPath)
+	if err != nil {
+		return err
+	}
+
+	// first, add reloader that writes the current SVID to the webhook server's cert dir (running in all replicas)
+	if err := (&spiffeReloader{
+		SyncPeriod: DefaultSPIFFESyncPeriod,
+		Source:     source,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed to add webhook server SPIFFE certificate reloader: %w", err)
+	}
+
+	// secondly, add reconciler that injects the trust bundle into the webhook configs (only running in the leader)
+	if err := (&spiffeReconciler{
+		SyncPeriod:                      DefaultSPIFFESyncPeriod,
+		Source:                          source,
+		SourceWebhookConfigs:            sourceWebhookConfigs,
+		ShootWebhookConfigs:             shootWebhookConfigs,
+		AtomicShootWebhookConfigs:       atomicShootWebhookConfigs,
+		Namespace:                       namespace,
+		ComponentName:                   componentName,
+		ShootWebhookManagedResourceName: shootWebhookManagedResourceName,
+		ShootNamespaceSelector:          shootNamespaceSelector,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed to add webhook SPIFFE certificate reconciler: %w", err)
+	}
+
+	return nil
+}
+
+// spiffeReloader is a simple reconciler that retrieves the current X.509-SVID from the SPIFFE workload API every
+// SyncPeriod and writes it to certDir.
+type spiffeReloader struct {
+	// SyncPeriod is the frequency with which to reload the server cert.
+	SyncPeriod time.Duration
+	// Source is the source of the X.509-SVIDs.
+	Source X509Source
+
+	lock             sync.Mutex
+	certDir          string
+	newestServerCert []byte
+}
+
+// AddToManager writes the current X.509-SVID to disk and then adds the reloader to the given manager in order to
+// periodically write rotated SVIDs.
+func (r *spiffeReloader) AddToManager(mgr manager.Manager) error {
+	webhookServer := mgr.GetWebhookServer()
+	defaultServer, ok := webhookServer.(*webhook.DefaultServer)
+	if !ok {
+		return fmt.Errorf("expected *webhook.DefaultServer, got %T", webhookServer)
+	}
+	r.certDir = defaultServer.Options.CertDir
+
+	// initial write of server cert, needed in order for the webhook server to start successfully
+	if _, err := r.writeServerCert(); err != nil {
+		return err
+	}
+
+	ctrl, err := controller.NewUnmanaged(spiffeCertificateReloaderName, mgr, controller.Options{
+		Reconciler:   r,
+		RecoverPanic: pointer.Bool(true),
+		// if going into exponential backoff, wait at most the configured sync period
+		RateLimiter: workqueue.NewWithMaxWaitRateLimiter(workqueue.DefaultControllerRateLimiter(), r.SyncPeriod),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = ctrl.Watch(controllerutils.EnqueueOnce, nil); err != nil {
+		return err
+	}
+
+	// we need to run this controller in all replicas even if they aren't leader right now, so that webhook servers
+	// in stand-by replicas reload rotated server certificates as well
+	return mgr.Add(nonLeaderElectionRunnable{ctrl})
+}
+
+// Reconcile writes the current X.509-SVID to the cert directory if it has changed. From here, the
+// controller-runtime's certwatcher will pick it up and use it for the webhook server.
+func (r *spiffeReloader) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx).WithValues("certDir", r.certDir)
+
+	written, err := r.writeServerCert()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if written {
+		log.Info("Found new X.509-SVID, wrote certificate to disk")
+	} else {
+		log.V(1).Info("X.509-SVID already written to disk, checking again later")
+	}
+
+	return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+}
+
+func (r *spiffeReloader) writeServerCert() (bool, error) {
+	serverCert, serverKey, _, err := ReadSPIFFECertificates(r.Source)
+	if err != nil {
+		return false, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// prevent unnecessary disk writes
+	if bytes.Equal(serverCert, r.newestServerCert) {
+		return false, nil
+	}
+
+	if err := writeCertificatesToDisk(r.certDir, serverCert, serverKey); err != nil {
+		return false, err
+	}
+
+	r.newestServerCert = serverCert
+	return true, nil
+}
+
+// spiffeReconciler is a simple reconciler that injects the trust bundle of the X.509-SVID's trust domain into the
+// WebhookConfigurations every SyncPeriod.
+type spiffeReconciler struct {
+	// SyncPeriod is the frequency with which to check the trust bundle.
+	SyncPeriod time.Duration
+	// Source is the source of the X.509 trust bundles.
+	Source X509Source
+	// SourceWebhookConfigs are the webhook configurations to reconcile in the Source cluster.
+	SourceWebhookConfigs extensionswebhook.Configs
+	// ShootWebhookConfigs are the webhook configurations to reconcile in all Shoot clusters.
+	ShootWebhookConfigs *extensionswebhook.Configs
+	// AtomicShootWebhookConfigs is an atomic value in which this reconciler will store the updated ShootWebhookConfigs.
+	// It is supposed to be shared with the ControlPlane actuator.
+	AtomicShootWebhookConfigs *atomic.Value
+	// Namespace is the namespace of the extension.
+	Namespace string
+	// Name of the component.
+	ComponentName string
+	// ShootWebhookManagedResourceName is the name of the ManagedResource containing the raw shoot webhook config.
+	ShootWebhookManagedResourceName string
+	// ShootNamespaceSelector is a label selector for shoot namespaces relevant to the extension.
+	ShootNamespaceSelector map[string]string
+
+	client         client.Client
+	newestCABundle []byte
+}
+
+// AddToManager adds the reconciler to the given manager in order to periodically inject the trust bundle into the
+// webhook configurations.
+func (r *spiffeReconciler) AddToManager(mgr manager.Manager) error {
+	r.client = mgr.GetClient()
+
+	ctrl, err := controller.New(spiffeCertificateReconcilerName, mgr, controller.Options{
+		Reconciler:   r,
+		RecoverPanic: pointer.Bool(true),
+		// if going into exponential backoff, wait at most the configured sync period
+		RateLimiter: workqueue.NewWithMaxWaitRateLimiter(workqueue.DefaultControllerRateLimiter(), r.SyncPeriod),
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.Watch(controllerutils.EnqueueOnce, nil)
+}
+
+// Reconcile updates all webhook configurations if the trust bundle has changed.
+func (r *spiffeReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	_, _, caBundle, err := ReadSPIFFECertificates(r.Source)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if bytes.Equal(caBundle, r.newestCABundle) {
+		log.V(1).Info("Trust bundle already injected into webhook configs, checking again later")
+		return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+	}
+
+	for _, sourceWebhookConfig := range r.SourceWebhookConfigs.GetWebhookConfigs() {
+		if err := injectCABundleIntoSourceWebhookConfig(ctx, r.client, sourceWebhookConfig, caBundle); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error reconciling source webhook config %s: %w", client.ObjectKeyFromObject(sourceWebhookConfig), err)
+		}
+		log.Info("Updated source webhook config with new trust bundle", "webhookConfig", sourceWebhookConfig)
+	}
+
+	if r.ShootWebhookConfigs != nil && r.ShootWebhookConfigs.HasWebhookConfig() {
+		for _, shootWebhookConfig := range r.ShootWebhookConfigs.GetWebhookConfigs() {
+			if err := extensionswebhook.InjectCABundleIntoWebhookConfig(shootWebhookConfig, caBundle); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
+		r.AtomicShootWebhookConfigs.Store(r.ShootWebhookConfigs.DeepCopy())
+
+		if err := extensionsshootwebhook.ReconcileWebhooksForAllNamespaces(ctx, r.client, r.Namespace, r.ComponentName, r.ShootWebhookManagedResourceName, r.ShootNamespaceSelector, *r.ShootWebhookConfigs); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error reconciling all shoot webhook configs: %w", err)
+		}
+		log.Info("Updated all shoot webhook configs with new trust bundle")
+	}
+
+	r.newestCABundle = caBundle
+	return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificates_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+
+	. "github.com/gardener/gardener/extensions/pkg/webhook/certificates"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+var _ = Describe("SPIFFE", func() {
+	Describe("#ReadSPIFFECertificates", func() {
+		var (
+			trustDomain = spiffeid.RequireTrustDomainFromString("example.org")
+
+			caCert *x509.Certificate
+			svid   *x509svid.SVID
+			bundle *x509bundle.Bundle
+		)
+
+		BeforeEach(func() {
+			caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			caCert = createCertificate(&x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: "spire-ca"},
+				URIs:                  []*url.URL{trustDomain.ID().URL()},
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+			}, nil, &caKey.PublicKey, caKey)
+
+			serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			serverCert := createCertificate(&x509.Certificate{
+				SerialNumber:          big.NewInt(2),
+				URIs:                  []*url.URL{spiffeid.RequireFromPath(trustDomain, "/gardener-extension-provider-test").URL()},
+				DNSNames:              []string{"gardener-extension-provider-test.extension-provider-test.svc"},
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageDigitalSignature,
+			}, caCert, &serverKey.PublicKey, caKey)
+
+			svid = &x509svid.SVID{
+				ID:           spiffeid.RequireFromPath(trustDomain, "/gardener-extension-provider-test"),
+				Certificates: []*x509.Certificate{serverCert},
+				PrivateKey:   serverKey,
+			}
+			bundle = x509bundle.FromX509Authorities(trustDomain, []*x509.Certificate{caCert})
+		})
+
+		It("should return the server certificate, key and CA bundle", func() {
+			serverCertPEM, serverKeyPEM, caBundlePEM, err := ReadSPIFFECertificates(&fakeX509Source{svid: svid, bundle: bundle})
+			Expect(err).NotTo(HaveOccurred())
+
+			serverCert, err := utils.DecodeCertificate(serverCertPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serverCert.DNSNames).To(ConsistOf("gardener-extension-provider-test.extension-provider-test.svc"))
+			Expect(serverKeyPEM).To(ContainSubstring("PRIVATE KEY"))
+
+			ca, err := utils.DecodeCertificate(caBundlePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ca.Equal(caCert)).To(BeTrue())
+		})
+
+		It("should fail if there is no bundle for the SVID's trust domain", func() {
+			_, _, _, err := ReadSPIFFECertificates(&fakeX509Source{
+				svid:   svid,
+				bundle: x509bundle.New(spiffeid.RequireTrustDomainFromString("other.org")),
+			})
+			Expect(err).To(MatchError(ContainSubstring(`failed getting X.509 bundle for trust domain "example.org"`)))
+		})
+	})
+})
+
+type fakeX509Source struct {
+	svid   *x509svid.SVID
+	bundle *x509bundle.Bundle
+}
+
+func (f *fakeX509Source) GetX509SVID() (*x509svid.SVID, error) {
+	return f.svid, nil
+}
+
+func (f *fakeX509Source) GetX509BundleForTrustDomain(trustDomain spiffeid.TrustDomain) (*x509bundle.Bundle, error) {
+	return f.bundle.GetX509BundleForTrustDomain(trustDomain)
+}
+
+func createCertificate(template, parent *x509.Certificate, publicKey *ecdsa.PublicKey, signer *ecdsa.PrivateKey) *x509.Certificate {
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent = template
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, publicKey, signer)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	return cert
+}
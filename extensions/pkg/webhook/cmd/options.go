@@ -21,6 +21,7 @@ import (
 	"sync/atomic"
 
 	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -30,6 +31,7 @@ import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/certificates"
 	extensionsshootwebhook "github.com/gardener/gardener/extensions/pkg/webhook/shoot"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
@@ -43,6 +45,12 @@ const (
 	ServicePortFlag = "webhook-config-service-port"
 	// NamespaceFlag is the name of the command line flag to specify the webhook config namespace for 'service' mode.
 	NamespaceFlag = "webhook-config-namespace"
+	// SPIFFESocketPathFlag is the name of the command line flag to specify the path of the SPIFFE workload API socket
+	// from which the webhook server certificates are obtained.
+	SPIFFESocketPathFlag = "webhook-config-spiffe-socket-path"
+	// ShootNamespaceFlag is the name of the command line flag to specify the shoot namespace in case the extension
+	// serves webhooks only for a single shoot.
+	ShootNamespaceFlag = "webhook-config-shoot-namespace"
 )
 
 // ServerOptions are command line options that can be set for ServerConfig.
@@ -55,6 +63,11 @@ type ServerOptions struct {
 	ServicePort int
 	// Namespace is the webhook config namespace for 'service' mode.
 	Namespace string
+	// SPIFFESocketPath is the path of the SPIFFE workload API socket from which the webhook server certificates are
+	// obtained.
+	SPIFFESocketPath string
+	// ShootNamespace is the control plane namespace of the only shoot the extension serves webhooks for.
+	ShootNamespace string
 
 	config *ServerConfig
 }
@@ -69,21 +82,34 @@ type ServerConfig struct {
 	ServicePort int
 	// Namespace is the webhook config namespace for 'service' mode.
 	Namespace string
+	// SPIFFESocketPath is the path of the SPIFFE workload API socket from which the webhook server certificates are
+	// obtained. If empty, the certificates are generated and rotated by the extension itself.
+	SPIFFESocketPath string
+	// ShootNamespace is the control plane namespace of the only shoot the extension serves webhooks for. If set, the
+	// extension runs in this namespace and all webhook configs, certificates and network policies are scoped to it.
+	ShootNamespace string
 }
 
 // Complete implements Completer.Complete.
 func (w *ServerOptions) Complete() error {
 	w.config = &ServerConfig{
-		Mode:        w.Mode,
-		URL:         w.URL,
-		ServicePort: w.ServicePort,
-		Namespace:   w.Namespace,
+		Mode:             w.Mode,
+		URL:              w.URL,
+		ServicePort:      w.ServicePort,
+		Namespace:        w.Namespace,
+		SPIFFESocketPath: w.SPIFFESocketPath,
+		ShootNamespace:   w.ShootNamespace,
 	}
 
 	if len(w.Mode) == 0 {
 		w.config.Mode = extensionswebhook.ModeService
 	}
 
+	if len(w.ShootNamespace) > 0 && len(w.Namespace) == 0 {
+		// In single-shoot mode, the extension runs inside the shoot's control plane namespace.
+		w.config.Namespace = w.ShootNamespace
+	}
+
 	return nil
 }
 
@@ -98,10 +124,17 @@ func (w *ServerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&w.URL, URLFlag, w.URL, "The webhook URL when running outside of the cluster it is serving.")
 	fs.IntVar(&w.ServicePort, ServicePortFlag, w.ServicePort, "The service port that exposes the webhook server.  If not specified it will fallback to the webhook server port.")
 	fs.StringVar(&w.Namespace, NamespaceFlag, w.Namespace, "The webhook config namespace for 'service' mode.")
+	fs.StringVar(&w.SPIFFESocketPath, SPIFFESocketPathFlag, w.SPIFFESocketPath, "Path of the SPIFFE workload API socket. If set, the webhook server certificates are obtained from the workload API instead of being generated by the extension.")
+	fs.StringVar(&w.ShootNamespace, ShootNamespaceFlag, w.ShootNamespace, "The control plane namespace of the only shoot the extension serves webhooks for. If set, the extension is expected to run in this namespace and does not register webhooks for any other namespace.")
 }
 
-// DisableFlag is the name of the command line flag to disable individual webhooks.
-const DisableFlag = "disable-webhooks"
+const (
+	// DisableFlag is the name of the command line flag to disable individual webhooks.
+	DisableFlag = "disable-webhooks"
+	// DisableConfigMapFlag is the name of the command line flag to specify the name of a ConfigMap in the webhook config
+	// namespace which lists webhooks to disable at runtime.
+	DisableConfigMapFlag = "disable-webhooks-configmap"
+)
 
 // NameToFactory binds a specific name to a webhook's factory function.
 type NameToFactory struct {
@@ -112,6 +145,9 @@ type NameToFactory struct {
 // SwitchOptions are options to build an AddToManager function that filters the disabled webhooks.
 type SwitchOptions struct {
 	Disabled []string
+	// DisabledConfigMapName is the name of a ConfigMap in the webhook config namespace which lists webhooks to disable
+	// at runtime (in addition to the webhooks disabled via Disabled).
+	DisabledConfigMapName string
 
 	nameToWebhookFactory     map[string]func(manager.Manager) (*extensionswebhook.Webhook, error)
 	webhookFactoryAggregator FactoryAggregator
@@ -127,6 +163,7 @@ func (w *SwitchOptions) Register(pairs ...NameToFactory) {
 // AddFlags implements Option.
 func (w *SwitchOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&w.Disabled, DisableFlag, w.Disabled, "List of webhooks to disable")
+	fs.StringVar(&w.DisabledConfigMapName, DisableConfigMapFlag, w.DisabledConfigMapName, fmt.Sprintf("Name of a ConfigMap in the webhook config namespace whose %q key contains a comma-separated list of webhooks to disable at runtime", DisabledConfigMapDataKey))
 }
 
 // Complete implements Option.
@@ -149,12 +186,18 @@ func (w *SwitchOptions) Complete() error {
 
 // Completed returns the completed SwitchConfig. Call this only after successfully calling `Completed`.
 func (w *SwitchOptions) Completed() *SwitchConfig {
-	return &SwitchConfig{WebhooksFactory: w.webhookFactoryAggregator.Webhooks}
+	return &SwitchConfig{
+		WebhooksFactory:       w.webhookFactoryAggregator.Webhooks,
+		DisabledConfigMapName: w.DisabledConfigMapName,
+	}
 }
 
 // SwitchConfig is the completed configuration of SwitchOptions.
 type SwitchConfig struct {
 	WebhooksFactory func(manager.Manager) ([]*extensionswebhook.Webhook, error)
+	// DisabledConfigMapName is the name of a ConfigMap in the webhook config namespace which lists webhooks to disable
+	// at runtime.
+	DisabledConfigMapName string
 }
 
 // Switch binds the given name to the given AddToManager function.
@@ -206,15 +249,29 @@ func (c *AddToManagerOptions) Complete() error {
 		return err
 	}
 
+	if c.Switch.DisabledConfigMapName != "" && c.Server.Namespace == "" && c.Server.ShootNamespace == "" {
+		return fmt.Errorf("--%s requires --%s to be set", DisableConfigMapFlag, NamespaceFlag)
+	}
+
+	if c.Server.ShootNamespace != "" && c.Server.Namespace != "" && c.Server.Namespace != c.Server.ShootNamespace {
+		return fmt.Errorf("--%s must be equal to --%s if set", NamespaceFlag, ShootNamespaceFlag)
+	}
+
 	return c.Server.Complete()
 }
 
 // Completed returns the completed AddToManagerConfig. Only call this if a previous call to `Complete` succeeded.
 func (c *AddToManagerOptions) Completed() *AddToManagerConfig {
+	shootNamespaceSelector := c.shootNamespaceSelector
+	if shootNamespace := c.Server.ShootNamespace; shootNamespace != "" {
+		// only reconcile the shoot webhook configs of the single shoot served by the extension
+		shootNamespaceSelector = utils.MergeStringMaps(shootNamespaceSelector, map[string]string{corev1.LabelMetadataName: shootNamespace})
+	}
+
 	return &AddToManagerConfig{
 		extensionName:                   c.extensionName,
 		shootWebhookManagedResourceName: c.shootWebhookManagedResourceName,
-		shootNamespaceSelector:          c.shootNamespaceSelector,
+		shootNamespaceSelector:          shootNamespaceSelector,
 
 		Server: *c.Server.Completed(),
 		Switch: *c.Switch.Completed(),
@@ -256,6 +313,30 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 		servicePort = c.Server.ServicePort
 	}
 
+	seedWebhookConfigs, shootWebhookConfigs, err := c.buildWebhookConfigs(mgr, webhooks, servicePort)
+	if err != nil {
+		return nil, fmt.Errorf("could not create webhooks: %w", err)
+	}
+
+	var webhookSwitch *webhookSwitch
+	if c.Switch.DisabledConfigMapName != "" {
+		webhookSwitch = newWebhookSwitch(webhooks, seedWebhookConfigs, func(webhooks []*extensionswebhook.Webhook) (extensionswebhook.Configs, error) {
+			configs, _, err := c.buildWebhookConfigs(mgr, webhooks, servicePort)
+			return configs, err
+		})
+
+		if err := (&webhookSwitchReconciler{
+			webhookSwitch: webhookSwitch,
+			configMapName: c.Switch.DisabledConfigMapName,
+			namespace:     c.Server.Namespace,
+			reconcileSeedWebhookConfigs: func(ctx context.Context, configs extensionswebhook.Configs) error {
+				return c.reconcileSeedWebhookConfig(mgr, configs, nil)(ctx)
+			},
+		}).AddToManager(mgr); err != nil {
+			return nil, fmt.Errorf("failed adding webhook switch controller: %w", err)
+		}
+	}
+
 	for _, wh := range webhooks {
 		path := wh.Path
 		if path == "" {
@@ -264,30 +345,29 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 			path = "/" + path
 		}
 
-		if wh.Handler != nil {
+		switch {
+		case webhookSwitch != nil:
+			webhookServer.Register(path, webhookSwitch.handler(wh))
+		case wh.Handler != nil:
 			webhookServer.Register(path, wh.Handler)
-		} else {
+		default:
 			webhookServer.Register(path, wh.Webhook)
 		}
 	}
 
-	seedWebhookConfigs, shootWebhookConfigs, err := extensionswebhook.BuildWebhookConfigs(
-		webhooks,
-		mgr.GetClient(),
-		c.Server.Namespace,
-		c.extensionName,
-		servicePort,
-		c.Server.Mode,
-		c.Server.URL,
-		nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("could not create webhooks: %w", err)
+	if c.Server.ShootNamespace != "" {
+		// In single-shoot mode, the webhook server runs next to the shoot's kube-apiserver, hence it only needs to be
+		// reachable from within the same namespace.
+		if err := mgr.Add(runOnceWithLeaderElection(func(ctx context.Context) error {
+			return extensionsshootwebhook.ReconcileNetworkPolicyForSingleShoot(ctx, mgr.GetClient(), c.Server.ShootNamespace, c.extensionName, defaultServer.Options.Port)
+		})); err != nil {
+			return nil, err
+		}
 	}
 
 	atomicShootWebhookConfigs := &atomic.Value{}
 
-	if c.Server.Namespace == "" {
+	if c.Server.Namespace == "" && c.Server.SPIFFESocketPath == "" {
 		// If the namespace is not set (e.g. when running locally), then we can't use the secrets manager for managing
 		// the webhook certificates. We simply generate a new certificate and write it to CertDir in this case.
 		mgr.GetLogger().Info("Running webhooks with unmanaged certificates (i.e., the webhook CA will not be rotated automatically). " +
@@ -321,12 +401,43 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 	// We only care about registering the desired webhooks here, but not the CA bundle, it will be managed by the
 	// reconciler. That's why we also don't reconcile the shoot webhook configs here. They are registered in the
 	// ControlPlane actuator and our reconciler will update the included CA bundles if necessary.
-	if err := mgr.Add(runOnceWithLeaderElection(
-		c.reconcileSeedWebhookConfig(mgr, seedWebhookConfigs, nil),
-	)); err != nil {
+	reconcileSeedWebhookConfig := c.reconcileSeedWebhookConfig(mgr, seedWebhookConfigs, nil)
+	if webhookSwitch != nil {
+		// do not register the webhooks which have been disabled at runtime in the meantime
+		reconcileSeedWebhookConfig = func(ctx context.Context) error {
+			return webhookSwitch.reconcileSeedWebhookConfigs(ctx, nil, func(ctx context.Context, configs extensionswebhook.Configs) error {
+				return c.reconcileSeedWebhookConfig(mgr, configs, nil)(ctx)
+			})
+		}
+	}
+
+	if err := mgr.Add(runOnceWithLeaderElection(reconcileSeedWebhookConfig)); err != nil {
 		return nil, err
 	}
 
+	if c.Server.SPIFFESocketPath != "" {
+		// The webhook server certificates are X.509-SVIDs issued by the SPIFFE workload API, hence they are neither
+		// generated nor rotated by the extension. We only need to inject the trust bundle into the webhook configs.
+		mgr.GetLogger().Info("Running webhooks with certificates obtained from SPIFFE workload API", "socketPath", c.Server.SPIFFESocketPath)
+
+		if err := certificates.AddSPIFFECertificateManagementToManager(
+			ctx,
+			mgr,
+			c.Server.SPIFFESocketPath,
+			seedWebhookConfigs,
+			&shootWebhookConfigs,
+			atomicShootWebhookConfigs,
+			c.shootNamespaceSelector,
+			c.shootWebhookManagedResourceName,
+			c.extensionName,
+			c.Server.Namespace,
+		); err != nil {
+			return nil, err
+		}
+
+		return atomicShootWebhookConfigs, nil
+	}
+
 	if err := certificates.AddCertificateManagementToManager(
 		ctx,
 		mgr,
@@ -348,6 +459,28 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 	return atomicShootWebhookConfigs, nil
 }
 
+func (c *AddToManagerConfig) buildWebhookConfigs(mgr manager.Manager, webhooks []*extensionswebhook.Webhook, servicePort int) (extensionswebhook.Configs, extensionswebhook.Configs, error) {
+	seedWebhookConfigs, shootWebhookConfigs, err := extensionswebhook.BuildWebhookConfigs(
+		webhooks,
+		mgr.GetClient(),
+		c.Server.Namespace,
+		c.extensionName,
+		servicePort,
+		c.Server.Mode,
+		c.Server.URL,
+		nil,
+	)
+	if err != nil {
+		return seedWebhookConfigs, shootWebhookConfigs, err
+	}
+
+	if c.Server.ShootNamespace != "" {
+		extensionswebhook.ScopeWebhookConfigsToNamespace(&seedWebhookConfigs, c.Server.ShootNamespace)
+	}
+
+	return seedWebhookConfigs, shootWebhookConfigs, nil
+}
+
 func (c *AddToManagerConfig) reconcileSeedWebhookConfig(mgr manager.Manager, webhookConfigs extensionswebhook.Configs, caBundle []byte) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
 		for _, webhookConfig := range webhookConfigs.GetWebhookConfigs() {
@@ -355,6 +488,10 @@ func (c *AddToManagerConfig) reconcileSeedWebhookConfig(mgr manager.Manager, web
 				return fmt.Errorf("error reconciling seed webhook config: %w", err)
 			}
 		}
+
+		if webhookConfigs.MutatingWebhookConfig != nil {
+			extensionswebhook.LogMutatingWebhookOrderingConflicts(ctx, mgr.GetLogger(), mgr.GetAPIReader(), webhookConfigs.MutatingWebhookConfig.Name)
+		}
 		return nil
 	}
 }
@@ -66,6 +66,25 @@ var _ = Describe("Options", func() {
 				Expect(switches.Disabled).To(Equal([]string{name1, name2}))
 			})
 
+			It("should correctly parse the ConfigMap flag", func() {
+				switches := NewSwitchOptions(Switch("foo", nil))
+
+				fs := pflag.NewFlagSet(commandName, pflag.ContinueOnError)
+				switches.AddFlags(fs)
+
+				err := fs.Parse(test.NewCommandBuilder(commandName).
+					Flags(
+						test.StringFlag(DisableConfigMapFlag, "disabled-webhooks"),
+					).
+					Command().
+					Slice())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(switches.Complete()).To(Succeed())
+
+				Expect(switches.Completed().DisabledConfigMapName).To(Equal("disabled-webhooks"))
+			})
+
 			It("should error on an unknown webhook", func() {
 				switches := NewSwitchOptions()
 
@@ -84,4 +103,97 @@ var _ = Describe("Options", func() {
 			})
 		})
 	})
+
+	Context("ServerOptions", func() {
+		const commandName = "test"
+
+		Describe("#AddFlags", func() {
+			It("should correctly parse the flags", func() {
+				opts := &ServerOptions{}
+
+				fs := pflag.NewFlagSet(commandName, pflag.ContinueOnError)
+				opts.AddFlags(fs)
+
+				err := fs.Parse(test.NewCommandBuilder(commandName).
+					Flags(
+						test.StringFlag(NamespaceFlag, "extension-foo"),
+						test.StringFlag(SPIFFESocketPathFlag, "/run/spire/sockets/agent.sock"),
+					).
+					Command().
+					Slice())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(opts.Complete()).To(Succeed())
+				Expect(opts.Completed()).To(Equal(&ServerConfig{
+					Mode:             "service",
+					Namespace:        "extension-foo",
+					SPIFFESocketPath: "/run/spire/sockets/agent.sock",
+				}))
+			})
+
+			It("should default the namespace to the shoot namespace", func() {
+				opts := &ServerOptions{}
+
+				fs := pflag.NewFlagSet(commandName, pflag.ContinueOnError)
+				opts.AddFlags(fs)
+
+				err := fs.Parse(test.NewCommandBuilder(commandName).
+					Flags(
+						test.StringFlag(ShootNamespaceFlag, "shoot--foo--bar"),
+					).
+					Command().
+					Slice())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(opts.Complete()).To(Succeed())
+				Expect(opts.Completed()).To(Equal(&ServerConfig{
+					Mode:           "service",
+					Namespace:      "shoot--foo--bar",
+					ShootNamespace: "shoot--foo--bar",
+				}))
+			})
+		})
+	})
+
+	Context("AddToManagerOptions", func() {
+		Describe("#Complete", func() {
+			It("should error if the ConfigMap for disabling webhooks is set without a webhook config namespace", func() {
+				opts := NewAddToManagerOptions("foo", "", nil, &ServerOptions{}, &SwitchOptions{DisabledConfigMapName: "disabled-webhooks"})
+
+				Expect(opts.Complete()).To(MatchError(ContainSubstring("--disable-webhooks-configmap requires --webhook-config-namespace to be set")))
+			})
+
+			It("should succeed if the ConfigMap for disabling webhooks is set with a webhook config namespace", func() {
+				opts := NewAddToManagerOptions("foo", "", nil, &ServerOptions{Namespace: "extension-foo"}, &SwitchOptions{DisabledConfigMapName: "disabled-webhooks"})
+
+				Expect(opts.Complete()).To(Succeed())
+				Expect(opts.Completed().Switch.DisabledConfigMapName).To(Equal("disabled-webhooks"))
+			})
+
+			It("should error if the webhook config namespace differs from the shoot namespace", func() {
+				opts := NewAddToManagerOptions("foo", "", nil, &ServerOptions{Namespace: "extension-foo", ShootNamespace: "shoot--foo--bar"}, &SwitchOptions{})
+
+				Expect(opts.Complete()).To(MatchError(ContainSubstring("--webhook-config-namespace must be equal to --webhook-config-shoot-namespace if set")))
+			})
+
+			It("should succeed if the ConfigMap for disabling webhooks is set with a shoot namespace", func() {
+				opts := NewAddToManagerOptions("foo", "", nil, &ServerOptions{ShootNamespace: "shoot--foo--bar"}, &SwitchOptions{DisabledConfigMapName: "disabled-webhooks"})
+
+				Expect(opts.Complete()).To(Succeed())
+				Expect(opts.Completed().Server.Namespace).To(Equal("shoot--foo--bar"))
+			})
+		})
+
+		Describe("#Completed", func() {
+			It("should restrict the shoot namespace selector to the shoot namespace", func() {
+				opts := NewAddToManagerOptions("foo", "", map[string]string{"extensions.extensions.gardener.cloud/foo": "true"}, &ServerOptions{ShootNamespace: "shoot--foo--bar"}, &SwitchOptions{})
+
+				Expect(opts.Complete()).To(Succeed())
+				Expect(opts.Completed().shootNamespaceSelector).To(Equal(map[string]string{
+					"extensions.extensions.gardener.cloud/foo": "true",
+					"kubernetes.io/metadata.name":              "shoot--foo--bar",
+				}))
+			})
+		})
+	})
 })
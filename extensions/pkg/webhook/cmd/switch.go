@@ -0,0 +1,257 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+)
+
+// DisabledConfigMapDataKey is the key in the data of the ConfigMap referenced via the DisableConfigMapFlag which
+// contains the comma-separated list of webhooks to disable at runtime.
+const DisabledConfigMapDataKey = "disabled"
+
+const webhookSwitchControllerName = "webhook-switch"
+
+// webhookSwitch keeps track of the webhooks which are disabled at runtime.
+type webhookSwitch struct {
+	webhooks                []*extensionswebhook.Webhook
+	seedWebhookConfigs      extensionswebhook.Configs
+	buildSeedWebhookConfigs func([]*extensionswebhook.Webhook) (extensionswebhook.Configs, error)
+
+	// disabled contains the webhooks which are currently not served.
+	lock     sync.RWMutex
+	disabled sets.Set[string]
+
+	// disabledInSeedWebhookConfigs contains the webhooks which are currently removed from the seed webhook configs.
+	seedWebhookConfigsLock       sync.Mutex
+	disabledInSeedWebhookConfigs sets.Set[string]
+}
+
+func newWebhookSwitch(
+	webhooks []*extensionswebhook.Webhook,
+	seedWebhookConfigs extensionswebhook.Configs,
+	buildSeedWebhookConfigs func([]*extensionswebhook.Webhook) (extensionswebhook.Configs, error),
+) *webhookSwitch {
+	return &webhookSwitch{
+		webhooks:                     webhooks,
+		seedWebhookConfigs:           seedWebhookConfigs,
+		buildSeedWebhookConfigs:      buildSeedWebhookConfigs,
+		disabled:                     sets.New[string](),
+		disabledInSeedWebhookConfigs: sets.New[string](),
+	}
+}
+
+func (s *webhookSwitch) isKnown(name string) bool {
+	for _, wh := range s.webhooks {
+		if wh.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *webhookSwitch) isDisabled(name string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.disabled.Has(name)
+}
+
+func (s *webhookSwitch) getDisabled() sets.Set[string] {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.disabled.Clone()
+}
+
+func (s *webhookSwitch) setDisabled(disabled sets.Set[string]) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.disabled = disabled.Clone()
+}
+
+// enabledWebhooks returns all webhooks which are not contained in the given set of disabled webhooks.
+func (s *webhookSwitch) enabledWebhooks(disabled sets.Set[string]) []*extensionswebhook.Webhook {
+	var webhooks []*extensionswebhook.Webhook
+	for _, wh := range s.webhooks {
+		if !disabled.Has(wh.Name) {
+			webhooks = append(webhooks, wh)
+		}
+	}
+	return webhooks
+}
+
+// handler wraps the handler of the given webhook. As long as the webhook is disabled, admission requests are allowed
+// without calling the webhook, all other requests are answered with '404 Not Found'.
+func (s *webhookSwitch) handler(wh *extensionswebhook.Webhook) http.Handler {
+	var (
+		handler         http.Handler = wh.Webhook
+		disabledHandler              = http.NotFoundHandler()
+	)
+
+	if wh.Handler != nil {
+		handler = wh.Handler
+	} else {
+		disabledHandler = &admission.Webhook{
+			Handler: admission.HandlerFunc(func(_ context.Context, _ admission.Request) admission.Response {
+				return admission.Allowed(fmt.Sprintf("webhook %q is disabled", wh.Name))
+			}),
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.isDisabled(wh.Name) {
+			disabledHandler.ServeHTTP(w, r)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// reconcileSeedWebhookConfigs reconciles the seed webhook configs such that they do not contain the given disabled
+// webhooks. If disabled is nil, the webhooks disabled by the previous call are kept disabled.
+func (s *webhookSwitch) reconcileSeedWebhookConfigs(
+	ctx context.Context,
+	disabled sets.Set[string],
+	reconcileSeedWebhookConfigs func(context.Context, extensionswebhook.Configs) error,
+) error {
+	s.seedWebhookConfigsLock.Lock()
+	defer s.seedWebhookConfigsLock.Unlock()
+
+	if disabled == nil {
+		disabled = s.disabledInSeedWebhookConfigs
+	}
+
+	desiredSeedWebhookConfigs, err := s.desiredSeedWebhookConfigs(disabled)
+	if err != nil {
+		return err
+	}
+
+	if err := reconcileSeedWebhookConfigs(ctx, desiredSeedWebhookConfigs); err != nil {
+		return err
+	}
+
+	s.disabledInSeedWebhookConfigs = disabled.Clone()
+	return nil
+}
+
+// desiredSeedWebhookConfigs returns copies of the seed webhook configs which only contain the webhooks that are not
+// disabled.
+func (s *webhookSwitch) desiredSeedWebhookConfigs(disabled sets.Set[string]) (extensionswebhook.Configs, error) {
+	enabledWebhookConfigs, err := s.buildSeedWebhookConfigs(s.enabledWebhooks(disabled))
+	if err != nil {
+		return extensionswebhook.Configs{}, err
+	}
+
+	desired := s.seedWebhookConfigs.DeepCopy()
+	if desired.MutatingWebhookConfig != nil {
+		desired.MutatingWebhookConfig.Webhooks = nil
+		if enabledWebhookConfigs.MutatingWebhookConfig != nil {
+			desired.MutatingWebhookConfig.Webhooks = enabledWebhookConfigs.MutatingWebhookConfig.Webhooks
+		}
+	}
+	if desired.ValidatingWebhookConfig != nil {
+		desired.ValidatingWebhookConfig.Webhooks = nil
+		if enabledWebhookConfigs.ValidatingWebhookConfig != nil {
+			desired.ValidatingWebhookConfig.Webhooks = enabledWebhookConfigs.ValidatingWebhookConfig.Webhooks
+		}
+	}
+
+	return *desired, nil
+}
+
+// webhookSwitchReconciler reads the webhooks to disable from a ConfigMap and updates the webhookSwitch and the seed
+// webhook configs accordingly.
+type webhookSwitchReconciler struct {
+	client                      client.Client
+	webhookSwitch               *webhookSwitch
+	configMapName               string
+	namespace                   string
+	reconcileSeedWebhookConfigs func(context.Context, extensionswebhook.Configs) error
+}
+
+// AddToManager adds the reconciler to the given manager. The controller runs in all replicas since every replica
+// needs to know which webhooks are disabled.
+func (r *webhookSwitchReconciler) AddToManager(mgr manager.Manager) error {
+	r.client = mgr.GetClient()
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(webhookSwitchControllerName).
+		For(&corev1.ConfigMap{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetNamespace() == r.namespace && obj.GetName() == r.configMapName
+		}))).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+			NeedLeaderElection:      pointer.Bool(false),
+		}).
+		Complete(r)
+}
+
+// Reconcile reconciles the ConfigMap listing the webhooks to disable.
+func (r *webhookSwitchReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.namespace, Name: r.configMapName}, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+		}
+		log.V(1).Info("ConfigMap is gone, enabling all webhooks")
+	}
+
+	disabled := sets.New[string]()
+	for _, name := range strings.Split(configMap.Data[DisabledConfigMapDataKey], ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !r.webhookSwitch.isKnown(name) {
+			log.Info("Ignoring unknown webhook", "webhook", name)
+			continue
+		}
+		disabled.Insert(name)
+	}
+
+	// Webhooks which get enabled must be served before they are added to the webhook configs again. Webhooks which get
+	// disabled must be removed from the webhook configs before they stop being served.
+	r.webhookSwitch.setDisabled(r.webhookSwitch.getDisabled().Intersection(disabled))
+
+	if err := r.webhookSwitch.reconcileSeedWebhookConfigs(ctx, disabled, r.reconcileSeedWebhookConfigs); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.webhookSwitch.setDisabled(disabled)
+	log.Info("Updated disabled webhooks", "disabled", sets.List(disabled))
+
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Switch", func() {
+	var (
+		ctx = context.TODO()
+
+		webhookFoo, webhookBar  *extensionswebhook.Webhook
+		seedWebhookConfigs      extensionswebhook.Configs
+		buildSeedWebhookConfigs func([]*extensionswebhook.Webhook) (extensionswebhook.Configs, error)
+
+		s *webhookSwitch
+	)
+
+	BeforeEach(func() {
+		webhookFoo = &extensionswebhook.Webhook{
+			Name: "foo",
+			Webhook: &admission.Webhook{Handler: admission.HandlerFunc(func(_ context.Context, _ admission.Request) admission.Response {
+				return admission.Denied("foo")
+			})},
+		}
+		webhookBar = &extensionswebhook.Webhook{
+			Name: "bar",
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+			}),
+		}
+
+		buildSeedWebhookConfigs = func(webhooks []*extensionswebhook.Webhook) (extensionswebhook.Configs, error) {
+			if len(webhooks) == 0 {
+				return extensionswebhook.Configs{}, nil
+			}
+
+			configs := extensionswebhook.Configs{MutatingWebhookConfig: &admissionregistrationv1.MutatingWebhookConfiguration{}}
+			for _, wh := range webhooks {
+				configs.MutatingWebhookConfig.Webhooks = append(configs.MutatingWebhookConfig.Webhooks, admissionregistrationv1.MutatingWebhook{Name: wh.Name})
+			}
+			return configs, nil
+		}
+
+		var err error
+		seedWebhookConfigs, err = buildSeedWebhookConfigs([]*extensionswebhook.Webhook{webhookBar, webhookFoo})
+		Expect(err).NotTo(HaveOccurred())
+		seedWebhookConfigs.MutatingWebhookConfig.Name = "gardener-extension-test"
+
+		s = newWebhookSwitch([]*extensionswebhook.Webhook{webhookBar, webhookFoo}, seedWebhookConfigs, buildSeedWebhookConfigs)
+	})
+
+	Describe("#handler", func() {
+		serveAdmissionRequest := func(handler http.Handler) *admissionv1.AdmissionResponse {
+			body, err := json.Marshal(&admissionv1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: admissionv1.SchemeGroupVersion.String(), Kind: "AdmissionReview"},
+				Request:  &admissionv1.AdmissionRequest{UID: types.UID("1234")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			req := httptest.NewRequest(http.MethodPost, "/foo", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			review := &admissionv1.AdmissionReview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), review)).To(Succeed())
+			return review.Response
+		}
+
+		It("should call the admission webhook if it is enabled", func() {
+			response := serveAdmissionRequest(s.handler(webhookFoo))
+			Expect(response.UID).To(Equal(types.UID("1234")))
+			Expect(response.Allowed).To(BeFalse())
+		})
+
+		It("should allow admission requests if the webhook is disabled", func() {
+			s.setDisabled(sets.New("foo"))
+
+			response := serveAdmissionRequest(s.handler(webhookFoo))
+			Expect(response.UID).To(Equal(types.UID("1234")))
+			Expect(response.Allowed).To(BeTrue())
+		})
+
+		It("should call the handler if it is enabled", func() {
+			recorder := httptest.NewRecorder()
+			s.handler(webhookBar).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/bar", nil))
+			Expect(recorder.Code).To(Equal(http.StatusTeapot))
+		})
+
+		It("should respond with 'Not Found' if the handler is disabled", func() {
+			s.setDisabled(sets.New("bar"))
+
+			recorder := httptest.NewRecorder()
+			s.handler(webhookBar).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/bar", nil))
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("#desiredSeedWebhookConfigs", func() {
+		It("should keep all webhooks if none is disabled", func() {
+			configs, err := s.desiredSeedWebhookConfigs(sets.New[string]())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configs).To(Equal(seedWebhookConfigs))
+		})
+
+		It("should remove the disabled webhooks", func() {
+			configs, err := s.desiredSeedWebhookConfigs(sets.New("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configs.MutatingWebhookConfig.Name).To(Equal("gardener-extension-test"))
+			Expect(configs.MutatingWebhookConfig.Webhooks).To(ConsistOf(admissionregistrationv1.MutatingWebhook{Name: "bar"}))
+		})
+
+		It("should keep an empty webhook config if all webhooks are disabled", func() {
+			configs, err := s.desiredSeedWebhookConfigs(sets.New("foo", "bar"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configs.MutatingWebhookConfig.Name).To(Equal("gardener-extension-test"))
+			Expect(configs.MutatingWebhookConfig.Webhooks).To(BeEmpty())
+		})
+	})
+
+	Describe("webhookSwitchReconciler", func() {
+		var (
+			fakeClient client.Client
+			reconciler *webhookSwitchReconciler
+			configMap  *corev1.ConfigMap
+
+			reconciledSeedWebhookConfigs []extensionswebhook.Configs
+			disabledDuringReconciliation sets.Set[string]
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			reconciledSeedWebhookConfigs = nil
+
+			reconciler = &webhookSwitchReconciler{
+				client:        fakeClient,
+				webhookSwitch: s,
+				configMapName: "disabled-webhooks",
+				namespace:     "extension-foo",
+				reconcileSeedWebhookConfigs: func(_ context.Context, configs extensionswebhook.Configs) error {
+					reconciledSeedWebhookConfigs = append(reconciledSeedWebhookConfigs, configs)
+					disabledDuringReconciliation = s.getDisabled()
+					return nil
+				},
+			}
+
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "disabled-webhooks", Namespace: "extension-foo"},
+				Data:       map[string]string{"disabled": "foo, unknown"},
+			}
+		})
+
+		runReconcile := func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(configMap)})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("should disable the listed webhooks after removing them from the seed webhook configs", func() {
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			runReconcile()
+
+			Expect(s.getDisabled()).To(Equal(sets.New("foo")))
+			Expect(disabledDuringReconciliation).To(BeEmpty())
+			Expect(reconciledSeedWebhookConfigs).To(HaveLen(1))
+			Expect(reconciledSeedWebhookConfigs[0].MutatingWebhookConfig.Webhooks).To(ConsistOf(admissionregistrationv1.MutatingWebhook{Name: "bar"}))
+		})
+
+		It("should enable the webhooks before adding them to the seed webhook configs again", func() {
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+			runReconcile()
+
+			configMap.Data["disabled"] = "bar"
+			Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+			runReconcile()
+
+			Expect(s.getDisabled()).To(Equal(sets.New("bar")))
+			Expect(disabledDuringReconciliation).To(BeEmpty())
+			Expect(reconciledSeedWebhookConfigs).To(HaveLen(2))
+			Expect(reconciledSeedWebhookConfigs[1].MutatingWebhookConfig.Webhooks).To(ConsistOf(admissionregistrationv1.MutatingWebhook{Name: "foo"}))
+		})
+
+		It("should enable all webhooks if the ConfigMap does not exist", func() {
+			s.setDisabled(sets.New("foo", "bar"))
+
+			runReconcile()
+
+			Expect(s.getDisabled()).To(BeEmpty())
+			Expect(reconciledSeedWebhookConfigs).To(HaveLen(1))
+			Expect(reconciledSeedWebhookConfigs[0]).To(Equal(seedWebhookConfigs))
+		})
+
+		It("should keep the webhooks disabled in the seed webhook configs when reconciling them without a disabled set", func() {
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+			runReconcile()
+
+			Expect(s.reconcileSeedWebhookConfigs(ctx, nil, reconciler.reconcileSeedWebhookConfigs)).To(Succeed())
+
+			Expect(reconciledSeedWebhookConfigs).To(HaveLen(2))
+			Expect(reconciledSeedWebhookConfigs[1].MutatingWebhookConfig.Webhooks).To(ConsistOf(admissionregistrationv1.MutatingWebhook{Name: "bar"}))
+		})
+	})
+})
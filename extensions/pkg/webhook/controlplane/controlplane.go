@@ -17,6 +17,7 @@ package controlplane
 import (
 	"fmt"
 
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -54,6 +55,12 @@ type Args struct {
 	Types []extensionswebhook.Type
 	// Mutator is a mutator to be used by the admission handler.
 	Mutator extensionswebhook.Mutator
+	// ReinvocationPolicy is the reinvocation policy of the webhook. Control plane objects are often mutated by multiple
+	// extensions (e.g., provider and service extensions), hence it defaults to IfNeeded so that mutations of
+	// extensions which are invoked later are not lost. Mutators must be idempotent.
+	ReinvocationPolicy *admissionregistrationv1.ReinvocationPolicyType
+	// MatchConditions are CEL expressions which must all evaluate to true for a request to be sent to the webhook.
+	MatchConditions []admissionregistrationv1.MatchCondition
 }
 
 // New creates a new controlplane webhook with the given args.
@@ -75,14 +82,22 @@ func New(mgr manager.Manager, args Args) (*extensionswebhook.Webhook, error) {
 		return nil, err
 	}
 
+	reinvocationPolicy := args.ReinvocationPolicy
+	if reinvocationPolicy == nil {
+		ifNeeded := admissionregistrationv1.IfNeededReinvocationPolicy
+		reinvocationPolicy = &ifNeeded
+	}
+
 	return &extensionswebhook.Webhook{
-		Name:     getName(args.Kind),
-		Provider: args.Provider,
-		Types:    args.Types,
-		Target:   extensionswebhook.TargetSeed,
-		Path:     getName(args.Kind),
-		Webhook:  &admission.Webhook{Handler: handler, RecoverPanic: true},
-		Selector: namespaceSelector,
+		Name:               getName(args.Kind),
+		Provider:           args.Provider,
+		Types:              args.Types,
+		Target:             extensionswebhook.TargetSeed,
+		Path:               getName(args.Kind),
+		Webhook:            &admission.Webhook{Handler: handler, RecoverPanic: true},
+		Selector:           namespaceSelector,
+		ReinvocationPolicy: reinvocationPolicy,
+		MatchConditions:    args.MatchConditions,
 	}, nil
 }
 
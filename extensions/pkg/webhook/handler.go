@@ -21,10 +21,12 @@ import (
 	"net/http"
 
 	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -76,22 +78,22 @@ func (b *HandlerBuilder) WithPredicates(predicates ...predicate.Predicate) *Hand
 // Build creates a new admission.Handler with the settings previously specified with the HandlerBuilder's functions.
 func (b *HandlerBuilder) Build() (admission.Handler, error) {
 	h := &handler{
-		typesMap:   make(map[metav1.GroupVersionKind]client.Object),
-		actionMap:  make(map[metav1.GroupVersionKind]handlerAction),
+		typesMap:   make(map[typeKey]client.Object),
+		actionMap:  make(map[typeKey]handlerAction),
 		predicates: b.predicates,
 		scheme:     b.scheme,
 		logger:     b.logger,
 	}
 
 	for m, t := range b.actionMap {
-		typesMap, err := buildTypesMap(b.scheme, objectsFromTypes(t))
+		typesMap, err := buildTypesMap(b.scheme, t)
 		if err != nil {
 			return nil, err
 		}
 		mutator := m
-		for gvk, obj := range typesMap {
-			h.typesMap[gvk] = obj
-			h.actionMap[gvk] = mutator
+		for key, obj := range typesMap {
+			h.typesMap[key] = obj
+			h.actionMap[key] = mutator
 		}
 	}
 	h.decoder = serializer.NewCodecFactory(b.scheme).UniversalDecoder()
@@ -110,9 +112,20 @@ func (mf actionFunc) do(ctx context.Context, new, old client.Object) error {
 	return mf(ctx, new, old)
 }
 
+// typeKey identifies the objects contained in admission requests by their GroupVersionKind and the requested
+// subresource (empty for the main resource).
+type typeKey struct {
+	gvk         metav1.GroupVersionKind
+	subresource string
+}
+
+func typeKeyForRequest(req admission.Request) typeKey {
+	return typeKey{gvk: req.AdmissionRequest.Kind, subresource: req.AdmissionRequest.SubResource}
+}
+
 type handler struct {
-	actionMap  map[metav1.GroupVersionKind]handlerAction
-	typesMap   map[metav1.GroupVersionKind]client.Object
+	actionMap  map[typeKey]handlerAction
+	typesMap   map[typeKey]client.Object
 	predicates []predicate.Predicate
 	decoder    runtime.Decoder
 	scheme     *runtime.Scheme
@@ -121,34 +134,37 @@ type handler struct {
 
 // Handle handles the given admission request.
 func (h *handler) Handle(ctx context.Context, req admission.Request) admission.Response {
-	ar := req.AdmissionRequest
+	var (
+		ar  = req.AdmissionRequest
+		key = typeKeyForRequest(req)
+	)
 
 	// Decode object
-	t, ok := h.typesMap[ar.Kind]
+	t, ok := h.typesMap[key]
 	if !ok {
 		// check if we can find an internal type
-		for gvk, obj := range h.typesMap {
-			if gvk.Version == runtime.APIVersionInternal && gvk.Group == ar.Kind.Group && gvk.Kind == ar.Kind.Kind {
+		for k, obj := range h.typesMap {
+			if isInternalTypeKeyFor(k, key) {
 				t = obj
 				break
 			}
 		}
 		if t == nil {
-			return admission.Errored(http.StatusBadRequest, fmt.Errorf("unexpected request kind %s", ar.Kind.String()))
+			return admission.Errored(http.StatusBadRequest, unexpectedRequestError(ar))
 		}
 	}
 
-	mutator, ok := h.actionMap[ar.Kind]
+	mutator, ok := h.actionMap[key]
 	if !ok {
 		// check if we can find an internal type
-		for gvk, m := range h.actionMap {
-			if gvk.Version == runtime.APIVersionInternal && gvk.Group == ar.Kind.Group && gvk.Kind == ar.Kind.Kind {
+		for k, m := range h.actionMap {
+			if isInternalTypeKeyFor(k, key) {
 				mutator = m
 				break
 			}
 		}
 		if mutator == nil {
-			return admission.Errored(http.StatusBadRequest, fmt.Errorf("unexpected request kind %s", ar.Kind.String()))
+			return admission.Errored(http.StatusBadRequest, unexpectedRequestError(ar))
 		}
 	}
 
@@ -208,26 +224,39 @@ func handle(ctx context.Context, req admission.Request, m handlerAction, t clien
 	return admission.ValidationResponse(true, "")
 }
 
-func objectsFromTypes(in []Type) []client.Object {
-	out := make([]client.Object, 0, len(in))
-	for _, t := range in {
-		out = append(out, t.Obj)
+func isInternalTypeKeyFor(key, requestKey typeKey) bool {
+	return key.gvk.Version == runtime.APIVersionInternal &&
+		key.gvk.Group == requestKey.gvk.Group &&
+		key.gvk.Kind == requestKey.gvk.Kind &&
+		key.subresource == requestKey.subresource
+}
+
+func unexpectedRequestError(ar admissionv1.AdmissionRequest) error {
+	if ar.SubResource != "" {
+		return fmt.Errorf("unexpected request kind %s for subresource %s", ar.Kind.String(), ar.SubResource)
 	}
-	return out
+	return fmt.Errorf("unexpected request kind %s", ar.Kind.String())
 }
 
-// buildTypesMap builds a map of the given types keyed by their GroupVersionKind, using the scheme from the given Manager.
-func buildTypesMap(scheme *runtime.Scheme, types []client.Object) (map[metav1.GroupVersionKind]client.Object, error) {
-	typesMap := make(map[metav1.GroupVersionKind]client.Object)
+// buildTypesMap builds a map of the objects contained in admission requests for the given types, keyed by their
+// GroupVersionKind and subresource, using the given scheme. For subresources with a dedicated object type (e.g., the
+// scale subresource), the map contains the subresource object type.
+func buildTypesMap(scheme *runtime.Scheme, types []Type) (map[typeKey]client.Object, error) {
+	typesMap := make(map[typeKey]client.Object)
 	for _, t := range types {
+		obj := t.Obj
+		if t.SubresourceObj != nil {
+			obj = t.SubresourceObj
+		}
+
 		// Get GVK from the type
-		gvk, err := apiutil.GVKForObject(t, scheme)
+		gvk, err := apiutil.GVKForObject(obj, scheme)
 		if err != nil {
-			return nil, fmt.Errorf("could not get GroupVersionKind from object %v: %w", t, err)
+			return nil, fmt.Errorf("could not get GroupVersionKind from object %v: %w", obj, err)
 		}
 
 		// Add the type to the types map
-		typesMap[metav1.GroupVersionKind(gvk)] = t
+		typesMap[typeKey{gvk: metav1.GroupVersionKind(gvk), subresource: pointer.StringDeref(t.Subresource, "")}] = obj
 	}
 	return typesMap, nil
 }
@@ -22,22 +22,20 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
-	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
-// NewHandlerWithShootClient creates a new handler for the given types, using the given mutator, and logger.
+// NewHandlerWithShootClient creates a new handler for the given types, using the given mutator, and logger. The shoot
+// clients passed to the mutator are cached per shoot and rate-limited, see ShootClientCache.
 func NewHandlerWithShootClient(mgr manager.Manager, types []Type, mutator MutatorWithShootClient, logger logr.Logger) (http.Handler, error) {
-	// Build a map of the given types keyed by their GVKs
-	typesMap, err := buildTypesMap(mgr.GetScheme(), objectsFromTypes(types))
+	// Build a map of the given types keyed by their GVKs and subresources
+	typesMap, err := buildTypesMap(mgr.GetScheme(), types)
 	if err != nil {
 		return nil, err
 	}
@@ -47,11 +45,12 @@ func NewHandlerWithShootClient(mgr manager.Manager, types []Type, mutator Mutato
 	return remoteAddrInjectingHandler{
 		Handler: &admission.Webhook{
 			Handler: &handlerShootClient{
-				typesMap: typesMap,
-				mutator:  mutator,
-				client:   mgr.GetClient(),
-				decoder:  serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
-				logger:   logger.WithName("handlerShootClient"),
+				typesMap:         typesMap,
+				mutator:          mutator,
+				client:           mgr.GetClient(),
+				shootClientCache: NewShootClientCache(mgr.GetClient(), ShootClientCacheOptions{}),
+				decoder:          serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+				logger:           logger.WithName("handlerShootClient"),
 			},
 			RecoverPanic: true,
 		},
@@ -59,11 +58,12 @@ func NewHandlerWithShootClient(mgr manager.Manager, types []Type, mutator Mutato
 }
 
 type handlerShootClient struct {
-	typesMap map[metav1.GroupVersionKind]client.Object
-	mutator  MutatorWithShootClient
-	client   client.Client
-	decoder  runtime.Decoder
-	logger   logr.Logger
+	typesMap         map[typeKey]client.Object
+	mutator          MutatorWithShootClient
+	client           client.Client
+	shootClientCache ShootClientCache
+	decoder          runtime.Decoder
+	logger           logr.Logger
 }
 
 func (h *handlerShootClient) Handle(ctx context.Context, req admission.Request) admission.Response {
@@ -108,18 +108,18 @@ func (h *handlerShootClient) Handle(ctx context.Context, req admission.Request)
 			return fmt.Errorf("could not find shoot namespace for webhook request from remote address %s", remoteAddr)
 		}
 
-		_, shootClient, err := util.NewClientForShoot(ctx, h.client, shootNamespace, client.Options{}, extensionsconfig.RESTOptions{})
+		shootClient, err := h.shootClientCache.Get(ctx, shootNamespace)
 		if err != nil {
-			return fmt.Errorf("could not create shoot client: %w", err)
+			return err
 		}
 
 		return h.mutator.Mutate(ctx, new, old, shootClient)
 	}
 
 	// Decode object
-	t, ok := h.typesMap[req.AdmissionRequest.Kind]
+	t, ok := h.typesMap[typeKeyForRequest(req)]
 	if !ok {
-		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unexpected request kind %s", req.AdmissionRequest.Kind))
+		return admission.Errored(http.StatusBadRequest, unexpectedRequestError(req.AdmissionRequest))
 	}
 
 	return handle(ctx, req, mut, t, h.decoder, h.logger)
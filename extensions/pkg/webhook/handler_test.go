@@ -25,6 +25,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"gomodules.xyz/jsonpatch/v2"
 	admissionv1 "k8s.io/api/admission/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -62,6 +63,7 @@ var _ = Describe("Handler", func() {
 		// Build scheme
 		scheme := runtime.NewScheme()
 		_ = corev1.AddToScheme(scheme)
+		_ = autoscalingv1.AddToScheme(scheme)
 
 		// Create mock manager
 		mgr = mockmanager.NewMockManager(ctrl)
@@ -188,6 +190,82 @@ var _ = Describe("Handler", func() {
 				},
 			}))
 		})
+
+		Context("subresources", func() {
+			It("should call the mutator registered for the requested subresource", func() {
+				// Create mock mutators
+				mutator := extensionsmockwebhook.NewMockMutator(ctrl)
+				statusMutator := extensionsmockwebhook.NewMockMutator(ctrl)
+				statusMutator.EXPECT().Mutate(context.TODO(), svc, nil).Return(nil)
+
+				// Create handler
+				h, err := NewBuilder(mgr, logger).
+					WithMutator(mutator, objTypes...).
+					WithMutator(statusMutator, StatusSubresourceType(&corev1.Service{})).
+					Build()
+				Expect(err).NotTo(HaveOccurred())
+
+				req.AdmissionRequest.Operation = admissionv1.Update
+				req.AdmissionRequest.SubResource = "status"
+
+				// Call Handle and check response
+				resp := h.Handle(context.TODO(), req)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
+			It("should decode the subresource object for the scale subresource", func() {
+				scale := &autoscalingv1.Scale{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+					Spec:       autoscalingv1.ScaleSpec{Replicas: 3},
+				}
+
+				// Create mock mutator
+				mutator := extensionsmockwebhook.NewMockMutator(ctrl)
+				mutator.EXPECT().Mutate(context.TODO(), scale, nil).DoAndReturn(func(ctx context.Context, obj, oldObj client.Object) error {
+					obj.(*autoscalingv1.Scale).Spec.Replicas = 2
+					return nil
+				})
+
+				// Create handler
+				h, err := NewBuilder(mgr, logger).WithMutator(mutator, ScaleSubresourceType(&corev1.ReplicationController{})).Build()
+				Expect(err).NotTo(HaveOccurred())
+
+				req.AdmissionRequest.Kind = metav1.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"}
+				req.AdmissionRequest.Operation = admissionv1.Update
+				req.AdmissionRequest.SubResource = "scale"
+				req.AdmissionRequest.Object = runtime.RawExtension{Raw: encode(scale)}
+
+				// Call Handle and check response
+				resp := h.Handle(context.TODO(), req)
+				Expect(resp.Allowed).To(BeTrue())
+				Expect(resp.Patches).To(ConsistOf(jsonpatch.JsonPatchOperation{
+					Operation: "replace",
+					Path:      "/spec/replicas",
+					Value:     float64(2),
+				}))
+			})
+
+			It("should return an error response if no mutator is registered for the requested subresource", func() {
+				// Create handler
+				h, err := NewBuilder(mgr, logger).WithMutator(extensionsmockwebhook.NewMockMutator(ctrl), objTypes...).Build()
+				Expect(err).NotTo(HaveOccurred())
+
+				req.AdmissionRequest.Operation = admissionv1.Update
+				req.AdmissionRequest.SubResource = "status"
+
+				// Call Handle and check response
+				resp := h.Handle(context.TODO(), req)
+				Expect(resp).To(Equal(admission.Response{
+					AdmissionResponse: admissionv1.AdmissionResponse{
+						Allowed: false,
+						Result: &metav1.Status{
+							Code:    http.StatusBadRequest,
+							Message: "unexpected request kind /v1, Kind=Service for subresource status",
+						},
+					},
+				}))
+			})
+		})
 	})
 })
 
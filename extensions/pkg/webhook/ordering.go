@@ -0,0 +1,195 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MutatingWebhookOrderingConflict describes two mutating webhooks of different webhook configurations which act on the
+// same resource. The kube-apiserver invokes the webhook configurations in the lexical order of their names, hence
+// mutations of the second webhook are not seen by the first webhook unless it is configured with the reinvocation
+// policy IfNeeded.
+type MutatingWebhookOrderingConflict struct {
+	// First is the webhook which is invoked first, in the format <configuration-name>/<webhook-name>.
+	First string
+	// Second is the webhook which is invoked after the first one, in the format <configuration-name>/<webhook-name>.
+	Second string
+	// Resource is the resource both webhooks act on, in the format <group>/<version>/<resource>.
+	Resource string
+}
+
+// String returns a human-readable description of the conflict.
+func (c MutatingWebhookOrderingConflict) String() string {
+	return fmt.Sprintf("mutating webhook %q is invoked before %q for resource %q but is not reinvoked if the object is modified afterwards, "+
+		"set its reinvocation policy to %q to not lose mutations", c.First, c.Second, c.Resource, admissionregistrationv1.IfNeededReinvocationPolicy)
+}
+
+// ComputeMutatingWebhookOrderingConflicts computes the conflicts among the given mutating webhook configurations, see
+// MutatingWebhookOrderingConflict. Only webhooks of different configurations are considered since the order of the
+// webhooks within one configuration is controlled by its owner. Namespace and object selectors are not evaluated, hence
+// the result is a superset of the conflicts which can actually occur.
+func ComputeMutatingWebhookOrderingConflicts(configs []admissionregistrationv1.MutatingWebhookConfiguration) []MutatingWebhookOrderingConflict {
+	var (
+		sortedConfigs = slices.Clone(configs)
+		conflicts     []MutatingWebhookOrderingConflict
+	)
+
+	sort.Slice(sortedConfigs, func(i, j int) bool { return sortedConfigs[i].Name < sortedConfigs[j].Name })
+
+	for i, firstConfig := range sortedConfigs {
+		for _, first := range firstConfig.Webhooks {
+			if first.ReinvocationPolicy != nil && *first.ReinvocationPolicy == admissionregistrationv1.IfNeededReinvocationPolicy {
+				continue
+			}
+
+			for _, secondConfig := range sortedConfigs[i+1:] {
+				for _, second := range secondConfig.Webhooks {
+					for _, resource := range overlappingResources(first.Rules, second.Rules) {
+						conflicts = append(conflicts, MutatingWebhookOrderingConflict{
+							First:    firstConfig.Name + "/" + first.Name,
+							Second:   secondConfig.Name + "/" + second.Name,
+							Resource: resource,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// LogMutatingWebhookOrderingConflicts lists the mutating webhook configurations of all extensions and logs the conflicts
+// involving the webhook configuration with the given name. It does not return an error since the diagnostics are
+// informational only, e.g., the extension might not be permitted to list webhook configurations.
+func LogMutatingWebhookOrderingConflicts(ctx context.Context, log logr.Logger, c client.Reader, configName string) {
+	configList := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := c.List(ctx, configList); err != nil {
+		log.Info("Could not list mutating webhook configurations for checking the webhook ordering", "error", err.Error())
+		return
+	}
+
+	var configs []admissionregistrationv1.MutatingWebhookConfiguration
+	for _, config := range configList.Items {
+		if strings.HasPrefix(config.Name, NamePrefix) {
+			configs = append(configs, config)
+		}
+	}
+
+	for _, conflict := range ComputeMutatingWebhookOrderingConflicts(configs) {
+		if strings.HasPrefix(conflict.First, configName+"/") || strings.HasPrefix(conflict.Second, configName+"/") {
+			log.Info("Detected potentially lost mutations because of mutating webhook ordering", "conflict", conflict.String())
+		}
+	}
+}
+
+// overlappingResources returns the resources (<group>/<version>/<resource>) matched by both rule lists for at least one
+// common operation.
+func overlappingResources(rules1, rules2 []admissionregistrationv1.RuleWithOperations) []string {
+	var resources []string
+
+	for _, rule1 := range rules1 {
+		for _, rule2 := range rules2 {
+			if !overlaps(operationsToStrings(rule1.Operations), operationsToStrings(rule2.Operations)) ||
+				!overlaps(rule1.APIGroups, rule2.APIGroups) ||
+				!overlaps(rule1.APIVersions, rule2.APIVersions) {
+				continue
+			}
+
+			for _, resource1 := range rule1.Resources {
+				for _, resource2 := range rule2.Resources {
+					if resource := intersectResource(resource1, resource2); resource != "" {
+						resources = append(resources, fmt.Sprintf("%s/%s/%s", firstNonWildcard(rule1.APIGroups, rule2.APIGroups), firstNonWildcard(rule1.APIVersions, rule2.APIVersions), resource))
+					}
+				}
+			}
+		}
+	}
+
+	slices.Sort(resources)
+	return slices.Compact(resources)
+}
+
+func overlaps(values1, values2 []string) bool {
+	for _, v1 := range values1 {
+		for _, v2 := range values2 {
+			if v1 == "*" || v2 == "*" || v1 == v2 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func firstNonWildcard(values1, values2 []string) string {
+	for _, v1 := range values1 {
+		for _, v2 := range values2 {
+			switch {
+			case v1 == v2:
+				return v1
+			case v1 == "*":
+				return v2
+			case v2 == "*":
+				return v1
+			}
+		}
+	}
+	return "*"
+}
+
+// intersectResource returns the more specific of the given resources if they match each other, considering the
+// wildcards "*" (all resources), "*/*" (all resources and subresources) and "<resource>/*".
+func intersectResource(resource1, resource2 string) string {
+	if matchesResource(resource1, resource2) {
+		return resource2
+	}
+	if matchesResource(resource2, resource1) {
+		return resource1
+	}
+	return ""
+}
+
+// matchesResource returns true if the pattern matches the given resource.
+func matchesResource(pattern, resource string) bool {
+	if pattern == resource || pattern == "*/*" {
+		return true
+	}
+
+	resourceName, subresource, hasSubresource := strings.Cut(resource, "/")
+	patternName, patternSubresource, patternHasSubresource := strings.Cut(pattern, "/")
+
+	if hasSubresource != patternHasSubresource {
+		return false
+	}
+
+	return (patternName == "*" || patternName == resourceName) && (!hasSubresource || patternSubresource == "*" || patternSubresource == subresource)
+}
+
+func operationsToStrings(operations []admissionregistrationv1.OperationType) []string {
+	out := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		out = append(out, string(operation))
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/extensions/pkg/webhook"
+)
+
+var _ = Describe("Ordering", func() {
+	Describe("#ComputeMutatingWebhookOrderingConflicts", func() {
+		var (
+			reinvocationPolicyNever    = admissionregistrationv1.NeverReinvocationPolicy
+			reinvocationPolicyIfNeeded = admissionregistrationv1.IfNeededReinvocationPolicy
+
+			rule = func(group, version string, resources ...string) admissionregistrationv1.RuleWithOperations {
+				return admissionregistrationv1.RuleWithOperations{
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+					Rule:       admissionregistrationv1.Rule{APIGroups: []string{group}, APIVersions: []string{version}, Resources: resources},
+				}
+			}
+
+			config = func(name string, webhooks ...admissionregistrationv1.MutatingWebhook) admissionregistrationv1.MutatingWebhookConfiguration {
+				return admissionregistrationv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: name}, Webhooks: webhooks}
+			}
+		)
+
+		It("should return no conflicts for a single configuration", func() {
+			Expect(ComputeMutatingWebhookOrderingConflicts([]admissionregistrationv1.MutatingWebhookConfiguration{
+				config("gardener-extension-provider-foo",
+					admissionregistrationv1.MutatingWebhook{Name: "a", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "deployments")}},
+					admissionregistrationv1.MutatingWebhook{Name: "b", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "deployments")}},
+				),
+			})).To(BeEmpty())
+		})
+
+		It("should return no conflicts for disjoint resources", func() {
+			Expect(ComputeMutatingWebhookOrderingConflicts([]admissionregistrationv1.MutatingWebhookConfiguration{
+				config("gardener-extension-provider-foo", admissionregistrationv1.MutatingWebhook{Name: "a", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "deployments")}}),
+				config("gardener-extension-shoot-bar", admissionregistrationv1.MutatingWebhook{Name: "b", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "statefulsets", "deployments/scale")}}),
+				config("gardener-extension-shoot-baz", admissionregistrationv1.MutatingWebhook{Name: "c", Rules: []admissionregistrationv1.RuleWithOperations{rule("", "v1", "deployments")}}),
+			})).To(BeEmpty())
+		})
+
+		It("should return the conflicts in the invocation order of the configurations", func() {
+			Expect(ComputeMutatingWebhookOrderingConflicts([]admissionregistrationv1.MutatingWebhookConfiguration{
+				config("gardener-extension-shoot-bar", admissionregistrationv1.MutatingWebhook{Name: "b", Rules: []admissionregistrationv1.RuleWithOperations{rule("*", "v1", "*")}}),
+				config("gardener-extension-provider-foo", admissionregistrationv1.MutatingWebhook{Name: "a", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "deployments")}, ReinvocationPolicy: &reinvocationPolicyNever}),
+			})).To(ConsistOf(MutatingWebhookOrderingConflict{
+				First:    "gardener-extension-provider-foo/a",
+				Second:   "gardener-extension-shoot-bar/b",
+				Resource: "apps/v1/deployments",
+			}))
+		})
+
+		It("should not return conflicts for webhooks which are reinvoked if needed", func() {
+			Expect(ComputeMutatingWebhookOrderingConflicts([]admissionregistrationv1.MutatingWebhookConfiguration{
+				config("gardener-extension-provider-foo", admissionregistrationv1.MutatingWebhook{Name: "a", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "deployments")}, ReinvocationPolicy: &reinvocationPolicyIfNeeded}),
+				config("gardener-extension-shoot-bar", admissionregistrationv1.MutatingWebhook{Name: "b", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "deployments")}}),
+			})).To(BeEmpty())
+		})
+
+		It("should consider subresource wildcards", func() {
+			Expect(ComputeMutatingWebhookOrderingConflicts([]admissionregistrationv1.MutatingWebhookConfiguration{
+				config("gardener-extension-provider-foo", admissionregistrationv1.MutatingWebhook{Name: "a", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "deployments/*")}}),
+				config("gardener-extension-shoot-bar", admissionregistrationv1.MutatingWebhook{Name: "b", Rules: []admissionregistrationv1.RuleWithOperations{rule("apps", "v1", "deployments", "deployments/scale")}}),
+			})).To(ConsistOf(MutatingWebhookOrderingConflict{
+				First:    "gardener-extension-provider-foo/a",
+				Second:   "gardener-extension-shoot-bar/b",
+				Resource: "apps/v1/deployments/scale",
+			}))
+		})
+	})
+})
@@ -93,6 +93,40 @@ func (c *Configs) HasWebhookConfig() bool {
 	return c.MutatingWebhookConfig != nil || c.ValidatingWebhookConfig != nil
 }
 
+// HasMatchConditions returns true if at least one webhook in 'Configs' has match conditions.
+func (c *Configs) HasMatchConditions() bool {
+	if c.MutatingWebhookConfig != nil {
+		for _, webhook := range c.MutatingWebhookConfig.Webhooks {
+			if len(webhook.MatchConditions) > 0 {
+				return true
+			}
+		}
+	}
+	if c.ValidatingWebhookConfig != nil {
+		for _, webhook := range c.ValidatingWebhookConfig.Webhooks {
+			if len(webhook.MatchConditions) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RemoveMatchConditions removes the match conditions from all webhooks in 'Configs'. This is required for clusters
+// whose API server does not know the field yet (Kubernetes < 1.27).
+func (c *Configs) RemoveMatchConditions() {
+	if c.MutatingWebhookConfig != nil {
+		for i := range c.MutatingWebhookConfig.Webhooks {
+			c.MutatingWebhookConfig.Webhooks[i].MatchConditions = nil
+		}
+	}
+	if c.ValidatingWebhookConfig != nil {
+		for i := range c.ValidatingWebhookConfig.Webhooks {
+			c.ValidatingWebhookConfig.Webhooks[i].MatchConditions = nil
+		}
+	}
+}
+
 // BuildWebhookConfigs builds webhook.Configs for seed and shoot from the given webhooks slice.
 func BuildWebhookConfigs(
 	webhooks []*Webhook,
@@ -165,6 +199,41 @@ func BuildWebhookConfigs(
 	return seedWebhookConfigs, shootWebhookConfigs, nil
 }
 
+// ScopeWebhookConfigsToNamespace restricts the given webhook configs to objects in the given namespace. The names of the
+// webhook configs are suffixed with the namespace so that multiple instances of the same extension which serve only a
+// single namespace each (e.g., extensions running inside a shoot control plane namespace) do not conflict with each
+// other. Existing namespace selectors of the webhooks are preserved and combined with the namespace restriction.
+func ScopeWebhookConfigsToNamespace(configs *Configs, namespace string) {
+	scopeSelector := func(selector *metav1.LabelSelector) *metav1.LabelSelector {
+		if selector == nil {
+			selector = &metav1.LabelSelector{}
+		} else {
+			selector = selector.DeepCopy()
+		}
+
+		selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      corev1.LabelMetadataName,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   []string{namespace},
+		})
+		return selector
+	}
+
+	if config := configs.MutatingWebhookConfig; config != nil {
+		config.Name += "-" + namespace
+		for i := range config.Webhooks {
+			config.Webhooks[i].NamespaceSelector = scopeSelector(config.Webhooks[i].NamespaceSelector)
+		}
+	}
+
+	if config := configs.ValidatingWebhookConfig; config != nil {
+		config.Name += "-" + namespace
+		for i := range config.Webhooks {
+			config.Webhooks[i].NamespaceSelector = scopeSelector(config.Webhooks[i].NamespaceSelector)
+		}
+	}
+}
+
 // ReconcileSeedWebhookConfig reconciles the given webhook config in the seed cluster.
 // If a CA bundle is given, it is injected it into all desired webhooks. If not, the CA bundle from the webhook config
 // on the cluster (if any) is kept.
@@ -320,16 +389,23 @@ func buildRule(c client.Client, t Type) (*admissionregistrationv1.RuleWithOperat
 	}
 
 	resource := mapping.Resource.Resource
+	operations := []admissionregistrationv1.OperationType{
+		admissionregistrationv1.Create,
+		admissionregistrationv1.Update,
+	}
+
 	if t.Subresource != nil {
 		resource += fmt.Sprintf("/%s", *t.Subresource)
+
+		// The status and scale subresources can only be updated, requests for them are never sent with operation CREATE.
+		if *t.Subresource == SubresourceStatus || *t.Subresource == SubresourceScale {
+			operations = []admissionregistrationv1.OperationType{admissionregistrationv1.Update}
+		}
 	}
 
 	// Create and return RuleWithOperations
 	return &admissionregistrationv1.RuleWithOperations{
-		Operations: []admissionregistrationv1.OperationType{
-			admissionregistrationv1.Create,
-			admissionregistrationv1.Update,
-		},
+		Operations: operations,
 		Rule: admissionregistrationv1.Rule{
 			APIGroups:   []string{gvk.Group},
 			APIVersions: []string{apiVersions},
@@ -410,6 +486,7 @@ func createAndAddToWebhookConfig(
 		webhookToRegister.FailurePolicy = failurePolicy
 		webhookToRegister.MatchPolicy = matchPolicy
 		webhookToRegister.ClientConfig = clientConfig
+		webhookToRegister.MatchConditions = webhook.MatchConditions
 		webhookConfigs.ValidatingWebhookConfig.Webhooks = append(webhookConfigs.ValidatingWebhookConfig.Webhooks, webhookToRegister)
 	default:
 		if webhookConfigs.MutatingWebhookConfig == nil {
@@ -435,6 +512,8 @@ func createAndAddToWebhookConfig(
 		webhookToRegister.FailurePolicy = failurePolicy
 		webhookToRegister.MatchPolicy = matchPolicy
 		webhookToRegister.ClientConfig = clientConfig
+		webhookToRegister.ReinvocationPolicy = webhook.ReinvocationPolicy
+		webhookToRegister.MatchConditions = webhook.MatchConditions
 		webhookConfigs.MutatingWebhookConfig.Webhooks = append(webhookConfigs.MutatingWebhookConfig.Webhooks, webhookToRegister)
 	}
 }
@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/gomega"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -85,15 +86,44 @@ var _ = Describe("Registration", func() {
 				Expect(configs.HasWebhookConfig()).To(BeFalse())
 			})
 		})
+
+		Describe("#HasMatchConditions", func() {
+			It("should return 'true' if at least one webhook has match conditions", func() {
+				configs.MutatingWebhookConfig = &admissionregistrationv1.MutatingWebhookConfiguration{Webhooks: []admissionregistrationv1.MutatingWebhook{{}}}
+				configs.ValidatingWebhookConfig = &admissionregistrationv1.ValidatingWebhookConfiguration{Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+					MatchConditions: []admissionregistrationv1.MatchCondition{{Name: "foo", Expression: "true"}},
+				}}}
+				Expect(configs.HasMatchConditions()).To(BeTrue())
+			})
+
+			It("should return 'false' if no webhook has match conditions", func() {
+				configs.MutatingWebhookConfig = &admissionregistrationv1.MutatingWebhookConfiguration{Webhooks: []admissionregistrationv1.MutatingWebhook{{}}}
+				Expect(configs.HasMatchConditions()).To(BeFalse())
+			})
+		})
+
+		Describe("#RemoveMatchConditions", func() {
+			It("should remove the match conditions from all webhooks", func() {
+				matchConditions := []admissionregistrationv1.MatchCondition{{Name: "foo", Expression: "true"}}
+				configs.MutatingWebhookConfig = &admissionregistrationv1.MutatingWebhookConfiguration{Webhooks: []admissionregistrationv1.MutatingWebhook{{MatchConditions: matchConditions}}}
+				configs.ValidatingWebhookConfig = &admissionregistrationv1.ValidatingWebhookConfiguration{Webhooks: []admissionregistrationv1.ValidatingWebhook{{MatchConditions: matchConditions}}}
+
+				configs.RemoveMatchConditions()
+
+				Expect(configs.MutatingWebhookConfig.Webhooks[0].MatchConditions).To(BeNil())
+				Expect(configs.ValidatingWebhookConfig.Webhooks[0].MatchConditions).To(BeNil())
+			})
+		})
 	})
 
 	Describe("#BuildWebhookConfigs", func() {
 		var (
-			failurePolicyIgnore         = admissionregistrationv1.Ignore
-			failurePolicyFail           = admissionregistrationv1.Fail
-			matchPolicyExact            = admissionregistrationv1.Exact
-			sideEffectsNone             = admissionregistrationv1.SideEffectClassNone
-			defaultTimeoutSeconds int32 = 10
+			failurePolicyIgnore              = admissionregistrationv1.Ignore
+			failurePolicyFail                = admissionregistrationv1.Fail
+			matchPolicyExact                 = admissionregistrationv1.Exact
+			sideEffectsNone                  = admissionregistrationv1.SideEffectClassNone
+			reinvocationPolicyIfNeeded       = admissionregistrationv1.IfNeededReinvocationPolicy
+			defaultTimeoutSeconds      int32 = 10
 
 			providerName = "provider-foo"
 			namespace    = "extension-" + providerName
@@ -108,14 +138,20 @@ var _ = Describe("Registration", func() {
 					Target:   TargetSeed,
 					Path:     "path1",
 					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					MatchConditions: []admissionregistrationv1.MatchCondition{{
+						Name:       "provider-label",
+						Expression: `has(object.metadata.labels) && 'provider' in object.metadata.labels`,
+					}},
 				},
 				{
 					Name:     "webhook2",
 					Provider: "provider2",
-					Types:    []Type{{Obj: &corev1.Pod{}}},
+					Types:    []Type{{Obj: &corev1.Pod{}}, ScaleSubresourceType(&appsv1.Deployment{})},
 					Target:   TargetSeed,
 					Path:     "path2",
 					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"bar": "foo"}},
+
+					ReinvocationPolicy: &reinvocationPolicyIfNeeded,
 				},
 				{
 					Action:         "mutating",
@@ -131,7 +167,7 @@ var _ = Describe("Registration", func() {
 				{
 					Name:          "webhook4",
 					Provider:      "provider4",
-					Types:         []Type{{Obj: &corev1.Service{}}},
+					Types:         []Type{{Obj: &corev1.Service{}}, StatusSubresourceType(&corev1.Service{})},
 					Target:        TargetShoot,
 					Path:          "path4",
 					FailurePolicy: &failurePolicyFail,
@@ -152,7 +188,7 @@ var _ = Describe("Registration", func() {
 					Action:   "validating",
 					Name:     "webhook2",
 					Provider: "provider2",
-					Types:    []Type{{Obj: &corev1.Pod{}}},
+					Types:    []Type{{Obj: &corev1.Pod{}}, ScaleSubresourceType(&appsv1.Deployment{})},
 					Target:   TargetSeed,
 					Path:     "path2",
 					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"bar": "foo"}},
@@ -167,12 +203,16 @@ var _ = Describe("Registration", func() {
 					Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"baz": "foo"}},
 					ObjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "baz"}},
 					TimeoutSeconds: pointer.Int32(1337),
+					MatchConditions: []admissionregistrationv1.MatchCondition{{
+						Name:       "exclude-kube-system",
+						Expression: `request.namespace != 'kube-system'`,
+					}},
 				},
 				{
 					Action:        "validating",
 					Name:          "webhook4",
 					Provider:      "provider4",
-					Types:         []Type{{Obj: &corev1.Service{}}},
+					Types:         []Type{{Obj: &corev1.Service{}}, StatusSubresourceType(&corev1.Service{})},
 					Target:        TargetShoot,
 					Path:          "path4",
 					FailurePolicy: &failurePolicyFail,
@@ -185,10 +225,11 @@ var _ = Describe("Registration", func() {
 		)
 
 		BeforeEach(func() {
-			restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Group: "", Version: "v1"}})
+			restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Group: "", Version: "v1"}, {Group: "apps", Version: "v1"}})
 			for _, kind := range []string{"ConfigMap", "Secret", "Pod", "Service", "ServiceAccount"} {
 				restMapper.Add(schema.GroupVersionKind{Group: "", Version: "v1", Kind: kind}, meta.RESTScopeNamespace)
 			}
+			restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
 
 			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithRESTMapper(restMapper).Build()
 		})
@@ -255,6 +296,7 @@ var _ = Describe("Registration", func() {
 							},
 							AdmissionReviewVersions: []string{"v1", "v1beta1"},
 							NamespaceSelector:       mutatingWebhooks[0].Selector,
+							MatchConditions:         mutatingWebhooks[0].MatchConditions,
 							FailurePolicy:           &failurePolicyFail,
 							MatchPolicy:             &matchPolicyExact,
 							SideEffects:             &sideEffectsNone,
@@ -268,6 +310,10 @@ var _ = Describe("Registration", func() {
 									Rule:       admissionregistrationv1.Rule{APIGroups: []string{""}, APIVersions: []string{"v1"}, Resources: []string{"pods"}},
 									Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
 								},
+								{
+									Rule:       admissionregistrationv1.Rule{APIGroups: []string{"apps"}, APIVersions: []string{"v1"}, Resources: []string{"deployments/scale"}},
+									Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Update},
+								},
 							},
 							AdmissionReviewVersions: []string{"v1", "v1beta1"},
 							NamespaceSelector:       mutatingWebhooks[1].Selector,
@@ -275,6 +321,7 @@ var _ = Describe("Registration", func() {
 							MatchPolicy:             &matchPolicyExact,
 							SideEffects:             &sideEffectsNone,
 							TimeoutSeconds:          &defaultTimeoutSeconds,
+							ReinvocationPolicy:      &reinvocationPolicyIfNeeded,
 						},
 					},
 				}))
@@ -312,6 +359,10 @@ var _ = Describe("Registration", func() {
 									Rule:       admissionregistrationv1.Rule{APIGroups: []string{""}, APIVersions: []string{"v1"}, Resources: []string{"pods"}},
 									Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
 								},
+								{
+									Rule:       admissionregistrationv1.Rule{APIGroups: []string{"apps"}, APIVersions: []string{"v1"}, Resources: []string{"deployments/scale"}},
+									Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Update},
+								},
 							},
 							AdmissionReviewVersions: []string{"v1", "v1beta1"},
 							NamespaceSelector:       validatingWebhooks[1].Selector,
@@ -354,6 +405,10 @@ var _ = Describe("Registration", func() {
 									Rule:       admissionregistrationv1.Rule{APIGroups: []string{""}, APIVersions: []string{"v1"}, Resources: []string{"services"}},
 									Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
 								},
+								{
+									Rule:       admissionregistrationv1.Rule{APIGroups: []string{""}, APIVersions: []string{"v1"}, Resources: []string{"services/status"}},
+									Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Update},
+								},
 							},
 							AdmissionReviewVersions: []string{"v1", "v1beta1"},
 							NamespaceSelector:       mutatingWebhooks[3].Selector,
@@ -381,6 +436,7 @@ var _ = Describe("Registration", func() {
 							},
 							AdmissionReviewVersions: []string{"v1", "v1beta1"},
 							NamespaceSelector:       validatingWebhooks[2].Selector,
+							MatchConditions:         validatingWebhooks[2].MatchConditions,
 							ObjectSelector:          validatingWebhooks[2].ObjectSelector,
 							FailurePolicy:           &failurePolicyIgnore,
 							MatchPolicy:             &matchPolicyExact,
@@ -395,6 +451,10 @@ var _ = Describe("Registration", func() {
 									Rule:       admissionregistrationv1.Rule{APIGroups: []string{""}, APIVersions: []string{"v1"}, Resources: []string{"services"}},
 									Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
 								},
+								{
+									Rule:       admissionregistrationv1.Rule{APIGroups: []string{""}, APIVersions: []string{"v1"}, Resources: []string{"services/status"}},
+									Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Update},
+								},
 							},
 							AdmissionReviewVersions: []string{"v1", "v1beta1"},
 							NamespaceSelector:       validatingWebhooks[3].Selector,
@@ -413,6 +473,45 @@ var _ = Describe("Registration", func() {
 		)
 	})
 
+	Describe("#ScopeWebhookConfigsToNamespace", func() {
+		It("should suffix the names and restrict the namespace selectors to the namespace", func() {
+			configs := Configs{
+				MutatingWebhookConfig: &admissionregistrationv1.MutatingWebhookConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-test"},
+					Webhooks: []admissionregistrationv1.MutatingWebhook{
+						{Name: "without-selector"},
+						{Name: "with-selector", NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+					},
+				},
+				ValidatingWebhookConfig: &admissionregistrationv1.ValidatingWebhookConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-test"},
+					Webhooks:   []admissionregistrationv1.ValidatingWebhook{{Name: "without-selector"}},
+				},
+			}
+
+			ScopeWebhookConfigsToNamespace(&configs, "shoot--foo--bar")
+
+			namespaceRequirement := metav1.LabelSelectorRequirement{
+				Key:      "kubernetes.io/metadata.name",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"shoot--foo--bar"},
+			}
+
+			Expect(configs.MutatingWebhookConfig.Name).To(Equal("gardener-extension-provider-test-shoot--foo--bar"))
+			Expect(configs.MutatingWebhookConfig.Webhooks[0].NamespaceSelector).To(Equal(&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{namespaceRequirement},
+			}))
+			Expect(configs.MutatingWebhookConfig.Webhooks[1].NamespaceSelector).To(Equal(&metav1.LabelSelector{
+				MatchLabels:      map[string]string{"foo": "bar"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{namespaceRequirement},
+			}))
+			Expect(configs.ValidatingWebhookConfig.Name).To(Equal("gardener-extension-provider-test-shoot--foo--bar"))
+			Expect(configs.ValidatingWebhookConfig.Webhooks[0].NamespaceSelector).To(Equal(&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{namespaceRequirement},
+			}))
+		})
+	})
+
 	Describe("#ReconcileSeedWebhookConfig", func() {
 		var (
 			ctx        = context.Background()
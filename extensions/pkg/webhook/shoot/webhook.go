@@ -29,10 +29,12 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
 // ReconcileWebhookConfig deploys the shoot webhook configuration, i.e., a network policy to allow the
@@ -61,6 +63,18 @@ func ReconcileWebhookConfig(
 		}
 	}
 
+	if shootWebhookConfigs.HasMatchConditions() {
+		matchConditionsSupported, err := versionutils.CheckVersionMeetsConstraint(cluster.Shoot.Spec.Kubernetes.Version, ">= 1.27")
+		if err != nil {
+			return fmt.Errorf("failed checking whether match conditions are supported by the shoot's Kubernetes version: %w", err)
+		}
+
+		if !matchConditionsSupported {
+			shootWebhookConfigs = *shootWebhookConfigs.DeepCopy()
+			shootWebhookConfigs.RemoveMatchConditions()
+		}
+	}
+
 	data, err := managedresources.
 		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
 		AddAllAndSerialize(shootWebhookConfigs.GetWebhookConfigs()...)
@@ -120,3 +134,41 @@ func ReconcileWebhooksForAllNamespaces(
 
 	return flow.Parallel(fns...)(ctx)
 }
+
+// ReconcileNetworkPolicyForSingleShoot deploys a network policy into the given shoot namespace which allows the
+// kube-apiserver of the shoot to reach the webhook server of an extension running in the same namespace. It is used by
+// extensions serving webhooks only for a single shoot, hence the policy does not require any cross-namespace rules.
+func ReconcileNetworkPolicyForSingleShoot(ctx context.Context, c client.Client, shootNamespace, extensionName string, serverPort int) error {
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: NetworkPolicyNameForSingleShoot(extensionName), Namespace: shootNamespace}}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, networkPolicy, func() error {
+		networkPolicy.Spec = networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": webhook.PrefixedName(extensionName)}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{
+						v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
+						v1beta1constants.LabelRole: v1beta1constants.LabelAPIServer,
+					}},
+				}},
+				Ports: []networkingv1.NetworkPolicyPort{{
+					Protocol: utils.ProtocolPtr(corev1.ProtocolTCP),
+					Port:     utils.IntStrPtrFromInt32(int32(serverPort)),
+				}},
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not reconcile network policy for webhook server in namespace '%s': %w", shootNamespace, err)
+	}
+
+	return nil
+}
+
+// NetworkPolicyNameForSingleShoot returns the name of the network policy allowing the shoot's kube-apiserver to reach
+// the webhook server of an extension serving only a single shoot.
+func NetworkPolicyNameForSingleShoot(extensionName string) string {
+	return "ingress-to-" + webhook.PrefixedName(extensionName) + "-from-kube-apiserver"
+}
@@ -23,10 +23,12 @@ import (
 	. "github.com/onsi/gomega"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -94,6 +96,47 @@ webhooks:
 			Expect(ReconcileWebhookConfig(ctx, fakeClient, namespace, extensionNamespace, extensionName, managedResourceName, shootWebhookConfigs, cluster)).To(Succeed())
 			expectWebhookConfigReconciliation(ctx, fakeClient, namespace, managedResourceName, shootWebhookConfigRaw)
 		})
+
+		Context("with match conditions", func() {
+			BeforeEach(func() {
+				shootWebhookConfigs.MutatingWebhookConfig.Webhooks[0].MatchConditions = []admissionregistrationv1.MatchCondition{{
+					Name:       "foo",
+					Expression: "true",
+				}}
+			})
+
+			It("should keep the match conditions for Kubernetes versions >= 1.27", func() {
+				cluster.Shoot.Spec.Kubernetes.Version = "1.27.3"
+
+				Expect(ReconcileWebhookConfig(ctx, fakeClient, namespace, extensionNamespace, extensionName, managedResourceName, shootWebhookConfigs, cluster)).To(Succeed())
+				expectWebhookConfigReconciliation(ctx, fakeClient, namespace, managedResourceName, map[string][]byte{"mutatingwebhookconfiguration____provider-test.yaml": []byte(`apiVersion: admissionregistration.k8s.io/v1
+kind: MutatingWebhookConfiguration
+metadata:
+  creationTimestamp: null
+  name: provider-test
+webhooks:
+- admissionReviewVersions: null
+  clientConfig: {}
+  matchConditions:
+  - expression: "true"
+    name: foo
+  name: some-webhook
+  sideEffects: null
+`)})
+			})
+
+			It("should remove the match conditions for Kubernetes versions < 1.27", func() {
+				cluster.Shoot.Spec.Kubernetes.Version = "1.26.8"
+
+				Expect(ReconcileWebhookConfig(ctx, fakeClient, namespace, extensionNamespace, extensionName, managedResourceName, shootWebhookConfigs, cluster)).To(Succeed())
+				expectWebhookConfigReconciliation(ctx, fakeClient, namespace, managedResourceName, shootWebhookConfigRaw)
+				Expect(shootWebhookConfigs.MutatingWebhookConfig.Webhooks[0].MatchConditions).NotTo(BeEmpty())
+			})
+
+			It("should fail if the Kubernetes version cannot be parsed", func() {
+				Expect(ReconcileWebhookConfig(ctx, fakeClient, namespace, extensionNamespace, extensionName, managedResourceName, shootWebhookConfigs, cluster)).To(MatchError(ContainSubstring("failed checking whether match conditions are supported")))
+			})
+		})
 	})
 
 	Describe("#ReconcileWebhooksForAllNamespaces", func() {
@@ -227,6 +270,30 @@ webhooks:
 			Expect(err.(*multierror.Error).Errors).To(ConsistOf(Equal(errors.New("no shoot found in cluster resource"))))
 		})
 	})
+
+	Describe("#ReconcileNetworkPolicyForSingleShoot", func() {
+		It("should allow the shoot's kube-apiserver to reach the webhook server", func() {
+			namespace := "shoot--foo--bar"
+
+			Expect(ReconcileNetworkPolicyForSingleShoot(ctx, fakeClient, namespace, extensionName, 10250)).To(Succeed())
+
+			networkPolicy := &networkingv1.NetworkPolicy{}
+			Expect(fakeClient.Get(ctx, kubernetesutils.Key(namespace, "ingress-to-gardener-extension-provider-test-from-kube-apiserver"), networkPolicy)).To(Succeed())
+
+			protocol := corev1.ProtocolTCP
+			port := intstr.FromInt32(10250)
+			Expect(networkPolicy.Spec).To(Equal(networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": "gardener-extension-provider-test"}},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{{
+						PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "kubernetes", "role": "apiserver"}},
+					}},
+					Ports: []networkingv1.NetworkPolicyPort{{Protocol: &protocol, Port: &port}},
+				}},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			}))
+		})
+	})
 })
 
 func expectWebhookConfigReconciliation(ctx context.Context, fakeClient client.Client, namespace, managedResourceName string, shootWebhookConfigRaw map[string][]byte) {
@@ -0,0 +1,222 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
+	"github.com/gardener/gardener/extensions/pkg/util"
+)
+
+const (
+	// DefaultShootClientTTL is the default duration for which a client for a shoot cluster is reused before it is
+	// created again, e.g. to pick up a rotated kubeconfig.
+	DefaultShootClientTTL = 10 * time.Minute
+
+	// defaultShootClientQPS and defaultShootClientBurst limit the rate of requests a webhook sends to a shoot cluster.
+	defaultShootClientQPS   float32 = 20
+	defaultShootClientBurst         = 40
+	// defaultShootClientTimeout bounds every request to a shoot cluster so that webhook handlers stay well below the
+	// default admission timeout of 10s.
+	defaultShootClientTimeout = 5 * time.Second
+)
+
+// NewClientForShootFunc is a function that creates a client for the shoot cluster whose control plane runs in the
+// given namespace.
+type NewClientForShootFunc func(ctx context.Context, c client.Client, namespace string, opts client.Options, restOptions extensionsconfig.RESTOptions) (client.Client, error)
+
+// ShootClientCache provides clients for shoot clusters which are reused across admission requests.
+type ShootClientCache interface {
+	// Get returns a client for the shoot cluster whose control plane runs in the given namespace. A cached client is
+	// returned as long as it is not older than the configured TTL. Reads of the returned client are cached for the
+	// lifetime of the returned client, hence it must only be used for a single admission request.
+	Get(ctx context.Context, namespace string) (client.Client, error)
+}
+
+// ShootClientCacheOptions are options for a ShootClientCache.
+type ShootClientCacheOptions struct {
+	// TTL is the duration for which a client is reused. Defaults to DefaultShootClientTTL.
+	TTL time.Duration
+	// RESTOptions are applied to the rest.Config of the shoot clients. Unset fields are defaulted so that requests to
+	// shoot clusters are rate-limited and bounded by a timeout.
+	RESTOptions extensionsconfig.RESTOptions
+	// Clock is used to determine whether a cached client has expired. Defaults to the real clock.
+	Clock clock.PassiveClock
+	// NewClientForShoot creates a new client for a shoot cluster. Defaults to a function based on
+	// util.NewClientForShoot.
+	NewClientForShoot NewClientForShootFunc
+}
+
+// NewShootClientCache creates a new ShootClientCache which uses the given seed client for reading the shoot
+// kubeconfigs.
+func NewShootClientCache(c client.Client, opts ShootClientCacheOptions) ShootClientCache {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultShootClientTTL
+	}
+	if opts.RESTOptions.QPS == nil {
+		opts.RESTOptions.QPS = pointer.Float32(defaultShootClientQPS)
+	}
+	if opts.RESTOptions.Burst == nil {
+		opts.RESTOptions.Burst = pointer.Int(defaultShootClientBurst)
+	}
+	if opts.RESTOptions.Timeout == nil {
+		opts.RESTOptions.Timeout = pointer.Duration(defaultShootClientTimeout)
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+	if opts.NewClientForShoot == nil {
+		opts.NewClientForShoot = newClientForShoot
+	}
+
+	return &shootClientCache{
+		client:  c,
+		opts:    opts,
+		entries: make(map[string]*shootClientCacheEntry),
+	}
+}
+
+func newClientForShoot(ctx context.Context, c client.Client, namespace string, opts client.Options, restOptions extensionsconfig.RESTOptions) (client.Client, error) {
+	_, shootClient, err := util.NewClientForShoot(ctx, c, namespace, opts, restOptions)
+	return shootClient, err
+}
+
+type shootClientCache struct {
+	client client.Client
+	opts   ShootClientCacheOptions
+
+	lock    sync.Mutex
+	entries map[string]*shootClientCacheEntry
+}
+
+type shootClientCacheEntry struct {
+	client    client.Client
+	createdAt time.Time
+}
+
+func (c *shootClientCache) Get(ctx context.Context, namespace string) (client.Client, error) {
+	shootClient, err := c.get(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return newRequestCachingClient(shootClient), nil
+}
+
+func (c *shootClientCache) get(ctx context.Context, namespace string) (client.Client, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.opts.Clock.Now()
+	if entry, ok := c.entries[namespace]; ok && now.Sub(entry.createdAt) < c.opts.TTL {
+		return entry.client, nil
+	}
+
+	shootClient, err := c.opts.NewClientForShoot(ctx, c.client, namespace, client.Options{}, c.opts.RESTOptions)
+	if err != nil {
+		delete(c.entries, namespace)
+		return nil, fmt.Errorf("could not create shoot client: %w", err)
+	}
+
+	// Drop expired entries of other shoots so that clients of deleted shoots do not pile up.
+	for ns, entry := range c.entries {
+		if now.Sub(entry.createdAt) >= c.opts.TTL {
+			delete(c.entries, ns)
+		}
+	}
+
+	c.entries[namespace] = &shootClientCacheEntry{client: shootClient, createdAt: now}
+	return shootClient, nil
+}
+
+// requestCachingClient is a client.Client which remembers the results of Get calls, so that the same object is only
+// read once from the shoot cluster during a single admission request.
+type requestCachingClient struct {
+	client.Client
+
+	lock    sync.Mutex
+	results map[requestCacheKey]requestCacheResult
+}
+
+type requestCacheKey struct {
+	gvk string
+	key client.ObjectKey
+}
+
+type requestCacheResult struct {
+	obj runtime.Object
+	err error
+}
+
+func newRequestCachingClient(c client.Client) client.Client {
+	return &requestCachingClient{
+		Client:  c,
+		results: make(map[requestCacheKey]requestCacheResult),
+	}
+}
+
+// Get reads the object from the cache of the current request or from the shoot cluster. Only successful reads and
+// 'not found' errors are cached.
+func (c *requestCachingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if len(opts) > 0 {
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return err
+	}
+	cacheKey := requestCacheKey{gvk: gvk.String(), key: key}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if result, ok := c.results[cacheKey]; ok {
+		if result.err != nil {
+			return result.err
+		}
+		return copyInto(result.obj, obj)
+	}
+
+	if err := c.Client.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.results[cacheKey] = requestCacheResult{err: err}
+		}
+		return err
+	}
+
+	c.results[cacheKey] = requestCacheResult{obj: obj.DeepCopyObject()}
+	return nil
+}
+
+func copyInto(src runtime.Object, dst client.Object) error {
+	srcValue, dstValue := reflect.ValueOf(src.DeepCopyObject()), reflect.ValueOf(dst)
+	if srcValue.Type() != dstValue.Type() {
+		return fmt.Errorf("cached object of type %T cannot be copied into %T", src, dst)
+	}
+	dstValue.Elem().Set(srcValue.Elem())
+	return nil
+}
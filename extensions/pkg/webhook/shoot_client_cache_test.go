@@ -0,0 +1,124 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
+	. "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ShootClientCache", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		fakeClock   *testclock.FakeClock
+		shootClient client.Client
+		getCalls    int
+
+		createdClients  int
+		lastRESTOptions extensionsconfig.RESTOptions
+
+		cache ShootClientCache
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		getCalls = 0
+		createdClients = 0
+
+		shootClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.ShootScheme).
+			WithObjects(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}, Data: map[string]string{"foo": "bar"}}).
+			WithInterceptorFuncs(interceptor.Funcs{Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				getCalls++
+				return c.Get(ctx, key, obj, opts...)
+			}}).
+			Build()
+
+		cache = NewShootClientCache(nil, ShootClientCacheOptions{
+			TTL:   time.Minute,
+			Clock: fakeClock,
+			NewClientForShoot: func(_ context.Context, _ client.Client, ns string, _ client.Options, restOptions extensionsconfig.RESTOptions) (client.Client, error) {
+				Expect(ns).To(Equal(namespace))
+				createdClients++
+				lastRESTOptions = restOptions
+				return shootClient, nil
+			},
+		})
+	})
+
+	Describe("#Get", func() {
+		It("should default the REST options", func() {
+			_, err := cache.Get(ctx, namespace)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(lastRESTOptions).To(Equal(extensionsconfig.RESTOptions{
+				QPS:     pointer.Float32(20),
+				Burst:   pointer.Int(40),
+				Timeout: pointer.Duration(5 * time.Second),
+			}))
+		})
+
+		It("should reuse the client until the TTL has expired", func() {
+			_, err := cache.Get(ctx, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			fakeClock.Step(59 * time.Second)
+			_, err = cache.Get(ctx, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createdClients).To(Equal(1))
+
+			fakeClock.Step(time.Second)
+			_, err = cache.Get(ctx, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createdClients).To(Equal(2))
+		})
+
+		It("should cache reads during a single request", func() {
+			c, err := cache.Get(ctx, namespace)
+			Expect(err).NotTo(HaveOccurred())
+
+			for i := 0; i < 3; i++ {
+				configMap := &corev1.ConfigMap{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "default"}, configMap)).To(Succeed())
+				Expect(configMap.Data).To(Equal(map[string]string{"foo": "bar"}))
+				configMap.Data["foo"] = "changed"
+
+				Expect(c.Get(ctx, client.ObjectKey{Name: "bar", Namespace: "default"}, &corev1.ConfigMap{})).To(BeNotFoundError())
+			}
+			Expect(getCalls).To(Equal(2))
+
+			c, err = cache.Get(ctx, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+			Expect(getCalls).To(Equal(3))
+		})
+	})
+})
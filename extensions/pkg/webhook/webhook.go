@@ -19,12 +19,17 @@ import (
 	"net/http"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
 const (
@@ -36,6 +41,11 @@ const (
 	TargetSeed = "seed"
 	// TargetShoot defines that the webhook is to be installed in the shoot.
 	TargetShoot = "shoot"
+
+	// SubresourceStatus is the name of the status subresource.
+	SubresourceStatus = "status"
+	// SubresourceScale is the name of the scale subresource.
+	SubresourceScale = "scale"
 )
 
 // Webhook is the specification of a webhook.
@@ -52,25 +62,59 @@ type Webhook struct {
 	ObjectSelector *metav1.LabelSelector
 	FailurePolicy  *admissionregistrationv1.FailurePolicyType
 	TimeoutSeconds *int32
+	// ReinvocationPolicy is rendered into mutating webhooks only. If set to IfNeeded, the webhook is invoked again if
+	// webhooks of other extensions, which are invoked later, modify the object.
+	ReinvocationPolicy *admissionregistrationv1.ReinvocationPolicyType
+	// MatchConditions are CEL expressions rendered into the webhook configuration. Requests are only sent to the webhook
+	// if all conditions evaluate to true. They are only a means to reduce the webhook traffic since they are dropped for
+	// Kubernetes versions < 1.27, hence the handlers must not rely on them.
+	MatchConditions []admissionregistrationv1.MatchCondition
 }
 
 // Type contains information about the Kubernetes object types and subresources the webhook acts upon.
 type Type struct {
 	Obj         client.Object
 	Subresource *string
+	// SubresourceObj is the type of the object contained in admission requests for the subresource. It only needs to be
+	// set if it differs from Obj, e.g., *autoscalingv1.Scale for the scale subresource.
+	SubresourceObj client.Object
+}
+
+// StatusSubresourceType returns a Type for the status subresource of the given object type.
+func StatusSubresourceType(obj client.Object) Type {
+	return Type{Obj: obj, Subresource: pointer.String(SubresourceStatus)}
+}
+
+// ScaleSubresourceType returns a Type for the scale subresource of the given object type. Admission requests for this
+// subresource contain autoscaling/v1 Scale objects, hence the mutators and validators are called with *autoscalingv1.Scale.
+func ScaleSubresourceType(obj client.Object) Type {
+	return Type{Obj: obj, Subresource: pointer.String(SubresourceScale), SubresourceObj: &autoscalingv1.Scale{}}
 }
 
 // Args contains Webhook creation arguments.
 type Args struct {
-	Provider       string
-	Name           string
-	Path           string
-	Target         string
+	Provider string
+	Name     string
+	Path     string
+	Target   string
+	// ExtensionType is the type of the `Extension` resources the webhook belongs to. If set, webhooks with target 'seed'
+	// which do not specify a Selector are scoped to the namespaces of shoots using this extension type (see
+	// NamespaceSelectorForExtensionType).
+	ExtensionType  string
 	Selector       *metav1.LabelSelector
 	ObjectSelector *metav1.LabelSelector
 	Predicates     []predicate.Predicate
 	Validators     map[Validator][]Type
 	Mutators       map[Mutator][]Type
+	// ReinvocationPolicy is the reinvocation policy of a mutating webhook. It must not be set for validating webhooks.
+	// Mutators of webhooks with policy IfNeeded must be idempotent, see
+	// https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#reinvocation-policy.
+	ReinvocationPolicy *admissionregistrationv1.ReinvocationPolicyType
+	// MatchConditions are CEL expressions which must all evaluate to true for a request to be sent to the webhook, see
+	// https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#matching-requests-matchconditions.
+	// They are only evaluated by API servers of Kubernetes version >= 1.27 (with the `AdmissionWebhookMatchConditions`
+	// feature gate enabled for version 1.27).
+	MatchConditions []admissionregistrationv1.MatchCondition
 }
 
 // New creates a new Webhook with the given args.
@@ -95,6 +139,14 @@ func New(mgr manager.Manager, args Args) (*Webhook, error) {
 		actionType = ActionValidating
 	}
 
+	if args.ReinvocationPolicy != nil && actionType != ActionMutating {
+		return nil, fmt.Errorf("failed to create webhook because a reinvocation policy is only permitted for mutating webhooks")
+	}
+
+	if err := validateMatchConditions(args.MatchConditions); err != nil {
+		return nil, fmt.Errorf("failed to create webhook because of invalid match conditions: %w", err)
+	}
+
 	for mut, objs := range args.Mutators {
 		builder.WithMutator(mut, objs...)
 		objTypes = append(objTypes, objs...)
@@ -112,18 +164,56 @@ func New(mgr manager.Manager, args Args) (*Webhook, error) {
 		return nil, err
 	}
 
+	selector := args.Selector
+	if selector == nil && args.Target == TargetSeed && args.ExtensionType != "" {
+		selector = NamespaceSelectorForExtensionType(args.ExtensionType)
+	}
+
 	// Create webhook
 	logger.Info("Creating webhook")
 
 	return &Webhook{
-		Name:           args.Name,
-		Provider:       args.Provider,
-		Action:         actionType,
-		Selector:       args.Selector,
-		ObjectSelector: args.ObjectSelector,
-		Path:           args.Path,
-		Target:         args.Target,
-		Webhook:        &admission.Webhook{Handler: handler, RecoverPanic: true},
-		Types:          objTypes,
+		Name:               args.Name,
+		Provider:           args.Provider,
+		Action:             actionType,
+		Selector:           selector,
+		ObjectSelector:     args.ObjectSelector,
+		Path:               args.Path,
+		Target:             args.Target,
+		Webhook:            &admission.Webhook{Handler: handler, RecoverPanic: true},
+		Types:              objTypes,
+		ReinvocationPolicy: args.ReinvocationPolicy,
+		MatchConditions:    args.MatchConditions,
 	}, nil
 }
+
+func validateMatchConditions(matchConditions []admissionregistrationv1.MatchCondition) error {
+	names := sets.New[string]()
+
+	for _, matchCondition := range matchConditions {
+		if matchCondition.Name == "" {
+			return fmt.Errorf("name must not be empty")
+		}
+		if names.Has(matchCondition.Name) {
+			return fmt.Errorf("duplicate name %q", matchCondition.Name)
+		}
+		names.Insert(matchCondition.Name)
+
+		if matchCondition.Expression == "" {
+			return fmt.Errorf("expression of %q must not be empty", matchCondition.Name)
+		}
+	}
+
+	return nil
+}
+
+// NamespaceSelectorForExtensionType returns a namespace selector which matches the control plane namespaces of all
+// shoots using the given extension type. The gardenlet maintains the respective label on the namespaces whenever it
+// reconciles a shoot, hence the selector automatically follows enabling or disabling the extension for a shoot.
+func NamespaceSelectorForExtensionType(extensionType string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: v1beta1constants.LabelExtensionPrefix + extensionType, Operator: metav1.LabelSelectorOpIn, Values: []string{"true"}},
+		},
+	}
+}
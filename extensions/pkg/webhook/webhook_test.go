@@ -19,6 +19,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
@@ -53,6 +54,10 @@ var _ = Describe("Webhook", func() {
 				Mutators: map[Mutator][]Type{
 					&fakeMutator{}: {{Obj: &corev1.Secret{}}},
 				},
+				MatchConditions: []admissionregistrationv1.MatchCondition{{
+					Name:       "foo",
+					Expression: "true",
+				}},
 			})
 
 			Expect(err).NotTo(HaveOccurred())
@@ -66,6 +71,52 @@ var _ = Describe("Webhook", func() {
 			}))
 			Expect(webhook.Webhook).NotTo(BeNil())
 			Expect(webhook.Types).To(ConsistOf(Type{Obj: &corev1.Secret{}}))
+			Expect(webhook.MatchConditions).To(ConsistOf(admissionregistrationv1.MatchCondition{Name: "foo", Expression: "true"}))
+		})
+
+		Context("extension type", func() {
+			var args Args
+
+			BeforeEach(func() {
+				args = Args{
+					Provider:      "test-provider",
+					Name:          "webhook-test",
+					Target:        TargetSeed,
+					ExtensionType: "test-extension",
+					Mutators: map[Mutator][]Type{
+						&fakeMutator{}: {{Obj: &corev1.Secret{}}},
+					},
+				}
+			})
+
+			It("should scope seed webhooks to the namespaces of shoots using the extension type", func() {
+				webhook, err := New(mgr, args)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(webhook.Selector).To(Equal(&metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "extensions.gardener.cloud/test-extension", Operator: metav1.LabelSelectorOpIn, Values: []string{"true"}},
+					},
+				}))
+			})
+
+			It("should not overwrite an explicitly configured selector", func() {
+				args.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+
+				webhook, err := New(mgr, args)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(webhook.Selector).To(Equal(&metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}))
+			})
+
+			It("should not scope shoot webhooks", func() {
+				args.Target = TargetShoot
+
+				webhook, err := New(mgr, args)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(webhook.Selector).To(BeNil())
+			})
 		})
 
 		It("should fail because mutators and validators are configured", func() {
@@ -81,6 +132,38 @@ var _ = Describe("Webhook", func() {
 			Expect(webhook).To(BeNil())
 			Expect(err).To(MatchError("failed to create webhook because a mixture of mutating and validating functions is not permitted"))
 		})
+
+		It("should fail because a reinvocation policy is configured for validators", func() {
+			reinvocationPolicy := admissionregistrationv1.IfNeededReinvocationPolicy
+
+			webhook, err := New(mgr, Args{
+				Validators: map[Validator][]Type{
+					&fakeValidator{}: {{Obj: &corev1.ConfigMap{}}},
+				},
+				ReinvocationPolicy: &reinvocationPolicy,
+			})
+
+			Expect(webhook).To(BeNil())
+			Expect(err).To(MatchError("failed to create webhook because a reinvocation policy is only permitted for mutating webhooks"))
+		})
+
+		DescribeTable("should fail because of invalid match conditions",
+			func(matchConditions []admissionregistrationv1.MatchCondition, expectedErr string) {
+				webhook, err := New(mgr, Args{
+					Mutators: map[Mutator][]Type{
+						&fakeMutator{}: {{Obj: &corev1.Secret{}}},
+					},
+					MatchConditions: matchConditions,
+				})
+
+				Expect(webhook).To(BeNil())
+				Expect(err).To(MatchError("failed to create webhook because of invalid match conditions: " + expectedErr))
+			},
+
+			Entry("empty name", []admissionregistrationv1.MatchCondition{{Expression: "true"}}, "name must not be empty"),
+			Entry("duplicate name", []admissionregistrationv1.MatchCondition{{Name: "foo", Expression: "true"}, {Name: "foo", Expression: "false"}}, `duplicate name "foo"`),
+			Entry("empty expression", []admissionregistrationv1.MatchCondition{{Name: "foo"}}, `expression of "foo" must not be empty`),
+		)
 	})
 })
 
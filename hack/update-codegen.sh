@@ -37,6 +37,7 @@ AVAILABLE_CODEGEN_OPTIONS=(
   "resourcemanager_groups"
   "shoottolerationrestriction_groups"
   "shootdnsrewriting_groups"
+  "shootworkermetadatarestriction_groups"
   "provider_local_groups"
   "extensions_config_groups"
   "nodeagent_groups"
@@ -445,6 +446,28 @@ shootdnsrewriting_groups() {
 }
 export -f shootdnsrewriting_groups
 
+shootworkermetadatarestriction_groups() {
+  echo "Generating API groups for plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction"
+
+  bash "${PROJECT_ROOT}"/hack/generate-internal-groups.sh \
+    deepcopy,defaulter \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis \
+    github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis \
+    "shootworkermetadatarestriction:v1alpha1" \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+
+  bash "${PROJECT_ROOT}"/hack/generate-internal-groups.sh \
+    conversion \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis \
+    github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis \
+    "shootworkermetadatarestriction:v1alpha1" \
+    --extra-peer-dirs=github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction,github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction/v1alpha1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion,k8s.io/apimachinery/pkg/runtime,k8s.io/component-base/config,k8s.io/component-base/config/v1alpha1 \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+}
+export -f shootworkermetadatarestriction_groups
+
 # local.provider.extensions.gardener.cloud APIs
 
 provider_local_groups() {
@@ -105,6 +105,10 @@ const (
 	ImageNameNodeLocalDns = "node-local-dns"
 	// ImageNameNodeProblemDetector is a constant for an image in the image vector with name 'node-problem-detector'.
 	ImageNameNodeProblemDetector = "node-problem-detector"
+	// ImageNameOauth2Proxy is a constant for an image in the image vector with name 'oauth2-proxy'.
+	ImageNameOauth2Proxy = "oauth2-proxy"
+	// ImageNameParca is a constant for an image in the image vector with name 'parca'.
+	ImageNameParca = "parca"
 	// ImageNamePauseContainer is a constant for an image in the image vector with name 'pause-container'.
 	ImageNamePauseContainer = "pause-container"
 	// ImageNamePlutono is a constant for an image in the image vector with name 'plutono'.
@@ -43,3 +43,9 @@ func init() {
 func ImageVector() imagevector.ImageVector {
 	return imageVector
 }
+
+// Overwrite merges the given image vector overwrite into the image vector. It is not safe for concurrent use, hence it
+// must only be called during the startup of a component before the image vector is used.
+func Overwrite(overwrite imagevector.ImageVector) {
+	imageVector = imagevector.Merge(imageVector, overwrite)
+}
@@ -169,6 +169,11 @@ func (h *Handler) admitSourceBackupEntry(ctx context.Context, backupEntry *garde
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
+	if shoot.Status.LastOperation != nil && shoot.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate &&
+		shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateProcessing && shoot.Annotations[v1beta1constants.AnnotationShootCloneFrom] != "" {
+		return h.admitCloneSourceBackupEntry(ctx, backupEntry, shoot)
+	}
+
 	if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.Type != gardencorev1beta1.LastOperationTypeRestore ||
 		shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateProcessing {
 		return admission.Errored(http.StatusForbidden, fmt.Errorf("creation of source BackupEntry is only allowed during shoot Restore operation (shoot: %s)", shootName))
@@ -192,6 +197,37 @@ func (h *Handler) admitSourceBackupEntry(ctx context.Context, backupEntry *garde
 	return admission.Allowed("")
 }
 
+func (h *Handler) admitCloneSourceBackupEntry(ctx context.Context, backupEntry *gardencorev1beta1.BackupEntry, shoot *gardencorev1beta1.Shoot) admission.Response {
+	// The source BackupEntry is created during the creation of a shoot which is cloned from another shoot in the same
+	// project. It must point to the bucket of the BackupEntry of the shoot to clone from.
+	cloneSourceShoot := &gardencorev1beta1.Shoot{}
+	if err := h.Client.Get(ctx, kubernetesutils.Key(shoot.Namespace, shoot.Annotations[v1beta1constants.AnnotationShootCloneFrom]), cloneSourceShoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Errored(http.StatusForbidden, fmt.Errorf("could not find shoot to clone from: %w", err))
+		}
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	cloneSourceBackupEntryName, err := gardenerutils.GenerateBackupEntryName(cloneSourceShoot.Status.TechnicalID, cloneSourceShoot.UID)
+	if err != nil {
+		return admission.Errored(http.StatusForbidden, err)
+	}
+
+	cloneSourceBackupEntry := &gardencorev1beta1.BackupEntry{}
+	if err := h.Client.Get(ctx, kubernetesutils.Key(cloneSourceShoot.Namespace, cloneSourceBackupEntryName), cloneSourceBackupEntry); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Errored(http.StatusForbidden, fmt.Errorf("could not find BackupEntry %s of shoot to clone from: %w", cloneSourceBackupEntryName, err))
+		}
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if backupEntry.Spec.BucketName != cloneSourceBackupEntry.Spec.BucketName {
+		return admission.Errored(http.StatusForbidden, fmt.Errorf("bucket name of source BackupEntry must equal bucket name of BackupEntry %s of shoot to clone from", cloneSourceBackupEntryName))
+	}
+
+	return admission.Allowed("")
+}
+
 func (h *Handler) admitBastion(seedName string, request admission.Request) admission.Response {
 	if request.Operation != admissionv1.Create {
 		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unexpected operation: %q", request.Operation))
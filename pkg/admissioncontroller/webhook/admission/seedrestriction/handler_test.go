@@ -620,6 +620,54 @@ var _ = Describe("handler", func() {
 
 							Expect(handler.Handle(ctx, request)).To(Equal(responseAllowed))
 						})
+
+						Context("when the shoot is being cloned", func() {
+							const cloneSourceBackupEntryName = "shoot--foo--bar--1234"
+
+							BeforeEach(func() {
+								shoot.Namespace = namespace
+								shoot.Annotations = map[string]string{"shoot.gardener.cloud/clone-from": "bar"}
+								shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+
+								mockCache.EXPECT().Get(ctx, kubernetesutils.Key(namespace, shootName), gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+									shoot.DeepCopyInto(obj)
+									return nil
+								})
+								mockCache.EXPECT().Get(ctx, kubernetesutils.Key(namespace, "bar"), gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+									(&gardencorev1beta1.Shoot{
+										ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace, UID: "1234"},
+										Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--foo--bar"},
+									}).DeepCopyInto(obj)
+									return nil
+								})
+							})
+
+							It("should forbid the request because the source BackupEntry does not point to the bucket of the shoot to clone from", func() {
+								mockCache.EXPECT().Get(ctx, kubernetesutils.Key(namespace, cloneSourceBackupEntryName), gomock.AssignableToTypeOf(&gardencorev1beta1.BackupEntry{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.BackupEntry, _ ...client.GetOption) error {
+									(&gardencorev1beta1.BackupEntry{Spec: gardencorev1beta1.BackupEntrySpec{BucketName: "some-different-bucket"}}).DeepCopyInto(obj)
+									return nil
+								})
+
+								Expect(handler.Handle(ctx, request)).To(Equal(admission.Response{
+									AdmissionResponse: admissionv1.AdmissionResponse{
+										Allowed: false,
+										Result: &metav1.Status{
+											Code:    int32(http.StatusForbidden),
+											Message: fmt.Sprintf("bucket name of source BackupEntry must equal bucket name of BackupEntry %s of shoot to clone from", cloneSourceBackupEntryName),
+										},
+									},
+								}))
+							})
+
+							It("should allow creation of source BackupEntry if it points to the bucket of the shoot to clone from", func() {
+								mockCache.EXPECT().Get(ctx, kubernetesutils.Key(namespace, cloneSourceBackupEntryName), gomock.AssignableToTypeOf(&gardencorev1beta1.BackupEntry{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.BackupEntry, _ ...client.GetOption) error {
+									(&gardencorev1beta1.BackupEntry{Spec: gardencorev1beta1.BackupEntrySpec{BucketName: bucketName}}).DeepCopyInto(obj)
+									return nil
+								})
+
+								Expect(handler.Handle(ctx, request)).To(Equal(responseAllowed))
+							})
+						})
 					})
 				})
 			})
@@ -1074,6 +1122,7 @@ var _ = Describe("handler", func() {
 						Describe("ssh-keypair suffix", func() { testSuite(".ssh-keypair") })
 						Describe("ssh-keypair.old suffix", func() { testSuite(".ssh-keypair.old") })
 						Describe("monitoring suffix", func() { testSuite(".monitoring") })
+						Describe("service-account-issuer suffix", func() { testSuite(".service-account-issuer") })
 					})
 
 					Context("bootstrap token secret for managed seed", func() {
@@ -1398,7 +1447,7 @@ var _ = Describe("handler", func() {
 									ObjectMeta: metav1.ObjectMeta{Namespace: managedSeed1Namespace},
 									Spec: seedmanagementv1alpha1.ManagedSeedSpec{
 										Shoot: &seedmanagementv1alpha1.Shoot{Name: shoot1.Name},
-										Gardenlet: &seedmanagementv1alpha1.Gardenlet{
+										Gardenlet: &seedmanagementv1alpha1.GardenletConfig{
 											Config: runtime.RawExtension{
 												Object: &gardenletv1alpha1.GardenletConfiguration{
 													SeedConfig: seedConfig1,
@@ -1411,7 +1460,7 @@ var _ = Describe("handler", func() {
 									ObjectMeta: metav1.ObjectMeta{Namespace: managedSeed1Namespace},
 									Spec: seedmanagementv1alpha1.ManagedSeedSpec{
 										Shoot: &seedmanagementv1alpha1.Shoot{Name: shoot2.Name},
-										Gardenlet: &seedmanagementv1alpha1.Gardenlet{
+										Gardenlet: &seedmanagementv1alpha1.GardenletConfig{
 											Config: runtime.RawExtension{
 												Object: &gardenletv1alpha1.GardenletConfiguration{
 													SeedConfig: seedConfig2,
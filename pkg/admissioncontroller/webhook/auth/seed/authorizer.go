@@ -72,6 +72,7 @@ var (
 	eventCoreResource                 = corev1.Resource("events")
 	eventResource                     = eventsv1.Resource("events")
 	exposureClassResource             = gardencorev1beta1.Resource("exposureclasses")
+	gardenletResource                 = seedmanagementv1alpha1.Resource("gardenlets")
 	internalSecretResource            = gardencorev1beta1.Resource("internalsecrets")
 	leaseResource                     = coordinationv1.Resource("leases")
 	managedSeedResource               = seedmanagementv1alpha1.Resource("managedseeds")
@@ -114,7 +115,7 @@ func (a *authorizer) Authorize(_ context.Context, attrs auth.Attributes) (auth.D
 			)
 		case bastionResource:
 			return a.authorize(requestLog, seedName, graph.VertexTypeBastion, attrs,
-				[]string{"update", "patch"},
+				[]string{"update", "patch", "delete"},
 				[]string{"create", "get", "list", "watch"},
 				[]string{"status"},
 			)
@@ -162,6 +163,8 @@ func (a *authorizer) Authorize(_ context.Context, attrs auth.Attributes) (auth.D
 			return a.authorizeEvent(requestLog, attrs)
 		case exposureClassResource:
 			return a.authorizeRead(requestLog, seedName, graph.VertexTypeExposureClass, attrs)
+		case gardenletResource:
+			return a.authorizeGardenlet(requestLog, seedName, attrs)
 		case internalSecretResource:
 			return a.authorize(requestLog, seedName, graph.VertexTypeInternalSecret, attrs,
 				[]string{"get", "update", "patch", "delete", "list", "watch"},
@@ -259,6 +262,30 @@ func (a *authorizer) authorizeEvent(log logr.Logger, attrs auth.Attributes) (aut
 	return auth.DecisionAllow, "", nil
 }
 
+func (a *authorizer) authorizeGardenlet(log logr.Logger, seedName string, attrs auth.Attributes) (auth.Decision, string, error) {
+	if ok, reason := a.checkSubresource(log, attrs, "status"); !ok {
+		return auth.DecisionNoOpinion, reason, nil
+	}
+
+	// Similar to other resources for which the gardenlet has a controller, list/watch requests are always allowed.
+	if utils.ValueExists(attrs.GetVerb(), []string{"list", "watch"}) {
+		return auth.DecisionAllow, "", nil
+	}
+
+	if ok, reason := a.checkVerb(log, attrs, "get", "list", "watch", "update", "patch"); !ok {
+		return auth.DecisionNoOpinion, reason, nil
+	}
+
+	// Gardenlet resources are not part of the graph since they are not referenced by any other object. Gardenlets may
+	// only access the Gardenlet resource named after their seed in the garden namespace.
+	if attrs.GetNamespace() != v1beta1constants.GardenNamespace || attrs.GetName() != seedName {
+		log.Info("Denying authorization because Gardenlet object does not belong to seed")
+		return auth.DecisionNoOpinion, fmt.Sprintf("gardenlet object must be named '%s' in namespace '%s'", seedName, v1beta1constants.GardenNamespace), nil
+	}
+
+	return auth.DecisionAllow, "", nil
+}
+
 func (a *authorizer) authorizeLease(log logr.Logger, seedName string, userType seedidentity.UserType, attrs auth.Attributes) (auth.Decision, string, error) {
 	// extension clients may only work with leases in the seed namespace
 	if userType == seedidentity.UserTypeExtension {
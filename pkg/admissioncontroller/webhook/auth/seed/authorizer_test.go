@@ -982,11 +982,10 @@ var _ = Describe("Seed", func() {
 						decision, reason, err := authorizer.Authorize(ctx, attrs)
 						Expect(err).NotTo(HaveOccurred())
 						Expect(decision).To(Equal(auth.DecisionNoOpinion))
-						Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [create get list watch update patch]"))
+						Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [create get list watch update patch delete]"))
 
 					},
 
-					Entry("delete", "delete"),
 					Entry("deletecollection", "deletecollection"),
 				)
 
@@ -999,6 +998,38 @@ var _ = Describe("Seed", func() {
 					Expect(reason).To(ContainSubstring("only the following subresources are allowed for this resource type: [status]"))
 				})
 
+				It("should allow when verb is delete and resource does not exist", func() {
+					attrs.Verb = "delete"
+
+					graph.EXPECT().HasVertex(graphpkg.VertexTypeBastion, namespace, name).Return(false)
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionAllow))
+					Expect(reason).To(BeEmpty())
+				})
+
+				It("should allow when verb is delete and the Bastion belongs to a Shoot of the seed", func() {
+					attrs.Verb = "delete"
+
+					graph.EXPECT().HasVertex(graphpkg.VertexTypeBastion, namespace, name).Return(true)
+					graph.EXPECT().HasPathFrom(graphpkg.VertexTypeBastion, namespace, name, graphpkg.VertexTypeSeed, "", seedName).Return(true)
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionAllow))
+					Expect(reason).To(BeEmpty())
+				})
+
+				It("should have no opinion when verb is delete and the Bastion does not belong to a Shoot of the seed", func() {
+					attrs.Verb = "delete"
+
+					graph.EXPECT().HasVertex(graphpkg.VertexTypeBastion, namespace, name).Return(true)
+					graph.EXPECT().HasPathFrom(graphpkg.VertexTypeBastion, namespace, name, graphpkg.VertexTypeSeed, "", seedName).Return(false)
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionNoOpinion))
+					Expect(reason).To(ContainSubstring("no relationship found"))
+				})
+
 				DescribeTable("should return correct result if path exists",
 					func(verb, subresource string) {
 						attrs.Verb = verb
@@ -1024,6 +1055,100 @@ var _ = Describe("Seed", func() {
 				)
 			})
 
+			Context("when requested for Gardenlets", func() {
+				var (
+					name, namespace string
+					attrs           *auth.AttributesRecord
+				)
+
+				BeforeEach(func() {
+					name, namespace = seedName, "garden"
+					attrs = &auth.AttributesRecord{
+						User:            seedUser,
+						Name:            name,
+						Namespace:       namespace,
+						APIGroup:        seedmanagementv1alpha1.SchemeGroupVersion.Group,
+						Resource:        "gardenlets",
+						ResourceRequest: true,
+						Verb:            "list",
+					}
+				})
+
+				DescribeTable("should allow because verb is list or watch",
+					func(verb string) {
+						attrs.Verb = verb
+						attrs.Name = ""
+
+						decision, reason, err := authorizer.Authorize(ctx, attrs)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(decision).To(Equal(auth.DecisionAllow))
+						Expect(reason).To(BeEmpty())
+					},
+
+					Entry("list", "list"),
+					Entry("watch", "watch"),
+				)
+
+				DescribeTable("should have no opinion because verb is not allowed",
+					func(verb string) {
+						attrs.Verb = verb
+
+						decision, reason, err := authorizer.Authorize(ctx, attrs)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(decision).To(Equal(auth.DecisionNoOpinion))
+						Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [get list watch update patch]"))
+					},
+
+					Entry("create", "create"),
+					Entry("delete", "delete"),
+					Entry("deletecollection", "deletecollection"),
+				)
+
+				It("should have no opinion because no allowed subresource", func() {
+					attrs.Subresource = "foo"
+
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionNoOpinion))
+					Expect(reason).To(ContainSubstring("only the following subresources are allowed for this resource type: [status]"))
+				})
+
+				DescribeTable("should allow because object belongs to seed",
+					func(verb, subresource string) {
+						attrs.Verb = verb
+						attrs.Subresource = subresource
+
+						decision, reason, err := authorizer.Authorize(ctx, attrs)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(decision).To(Equal(auth.DecisionAllow))
+						Expect(reason).To(BeEmpty())
+					},
+
+					Entry("get", "get", ""),
+					Entry("patch w/o subresource", "patch", ""),
+					Entry("patch w/ subresource", "patch", "status"),
+					Entry("update w/o subresource", "update", ""),
+					Entry("update w/ subresource", "update", "status"),
+				)
+
+				DescribeTable("should have no opinion because object does not belong to seed",
+					func(verb, name, namespace string) {
+						attrs.Verb = verb
+						attrs.Name = name
+						attrs.Namespace = namespace
+
+						decision, reason, err := authorizer.Authorize(ctx, attrs)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(decision).To(Equal(auth.DecisionNoOpinion))
+						Expect(reason).To(ContainSubstring("gardenlet object must be named"))
+					},
+
+					Entry("get w/ foreign name", "get", "foo", "garden"),
+					Entry("patch w/ foreign namespace", "patch", seedName, "foo"),
+					Entry("update w/ foreign name", "update", "foo", "garden"),
+				)
+			})
+
 			Context("when requested for ManagedSeeds", func() {
 				var (
 					name, namespace string
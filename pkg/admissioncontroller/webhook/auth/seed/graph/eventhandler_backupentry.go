@@ -50,6 +50,7 @@ func (g *graph) setupBackupEntryWatch(_ context.Context, informer cache.Informer
 			if !apiequality.Semantic.DeepEqual(oldBackupEntry.Spec.SeedName, newBackupEntry.Spec.SeedName) ||
 				!apiequality.Semantic.DeepEqual(oldBackupEntry.Status.SeedName, newBackupEntry.Status.SeedName) ||
 				!apiequality.Semantic.DeepEqual(oldBackupEntry.OwnerReferences, newBackupEntry.OwnerReferences) ||
+				!apiequality.Semantic.DeepEqual(oldBackupEntry.Spec.Encryption, newBackupEntry.Spec.Encryption) ||
 				oldBackupEntry.Spec.BucketName != newBackupEntry.Spec.BucketName {
 				g.handleBackupEntryCreateOrUpdate(newBackupEntry)
 			}
@@ -78,6 +79,7 @@ func (g *graph) handleBackupEntryCreateOrUpdate(backupEntry *gardencorev1beta1.B
 	defer g.lock.Unlock()
 
 	g.deleteAllIncomingEdges(VertexTypeBackupBucket, VertexTypeBackupEntry, backupEntry.Namespace, backupEntry.Name)
+	g.deleteAllIncomingEdges(VertexTypeSecret, VertexTypeBackupEntry, backupEntry.Namespace, backupEntry.Name)
 	g.deleteAllOutgoingEdges(VertexTypeBackupEntry, backupEntry.Namespace, backupEntry.Name, VertexTypeShoot)
 	g.deleteAllOutgoingEdges(VertexTypeBackupEntry, backupEntry.Namespace, backupEntry.Name, VertexTypeSeed)
 
@@ -88,6 +90,11 @@ func (g *graph) handleBackupEntryCreateOrUpdate(backupEntry *gardencorev1beta1.B
 
 	g.addEdge(backupBucketVertex, backupEntryVertex)
 
+	if backupEntry.Spec.Encryption != nil {
+		secretVertex := g.getOrCreateVertex(VertexTypeSecret, backupEntry.Namespace, backupEntry.Spec.Encryption.SecretName)
+		g.addEdge(secretVertex, backupEntryVertex)
+	}
+
 	if backupEntry.Spec.SeedName != nil {
 		seedVertex := g.getOrCreateVertex(VertexTypeSeed, "", *backupEntry.Spec.SeedName)
 		g.addEdge(backupEntryVertex, seedVertex)
@@ -134,6 +134,11 @@ func (g *graph) handleShootCreateOrUpdate(shoot *gardencorev1beta1.Shoot) {
 				g.addEdge(secretVertex, shootVertex)
 			}
 		}
+
+		if shoot.Spec.DNS.Private != nil && shoot.Spec.DNS.Private.SecretName != nil {
+			secretVertex := g.getOrCreateVertex(VertexTypeSecret, shoot.Namespace, *shoot.Spec.DNS.Private.SecretName)
+			g.addEdge(secretVertex, shootVertex)
+		}
 	}
 
 	for _, resource := range shoot.Spec.Resources {
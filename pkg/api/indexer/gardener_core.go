@@ -70,6 +70,15 @@ func ControllerInstallationRegistrationRefNameIndexerFunc(obj client.Object) []s
 	return []string{controllerInstallation.Spec.RegistrationRef.Name}
 }
 
+// ShootSecretBindingNameIndexerFunc extracts the .spec.secretBindingName field of a Shoot.
+func ShootSecretBindingNameIndexerFunc(obj client.Object) []string {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return []string{""}
+	}
+	return []string{pointer.StringDeref(shoot.Spec.SecretBindingName, "")}
+}
+
 // InternalSecretTypeIndexerFunc extracts the .type field of an InternalSecret.
 func InternalSecretTypeIndexerFunc(obj client.Object) []string {
 	internalSecret, ok := obj.(*gardencorev1beta1.InternalSecret)
@@ -101,6 +110,14 @@ func AddShootSeedName(ctx context.Context, indexer client.FieldIndexer) error {
 	return nil
 }
 
+// AddShootSecretBindingName adds an index for core.ShootSecretBindingName to the given indexer.
+func AddShootSecretBindingName(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootSecretBindingName, ShootSecretBindingNameIndexerFunc); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to Shoot Informer: %w", core.ShootSecretBindingName, err)
+	}
+	return nil
+}
+
 // AddShootStatusSeedName adds an index for core.ShootStatusSeedName to the given indexer.
 func AddShootStatusSeedName(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
@@ -65,6 +65,21 @@ var _ = Describe("Core", func() {
 		Entry("Shoot w/ seedName", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{SeedName: pointer.String("seed")}}, ConsistOf("seed")),
 	)
 
+	DescribeTable("#AddShootSecretBindingName",
+		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
+			Expect(AddShootSecretBindingName(context.TODO(), indexer)).To(Succeed())
+
+			Expect(indexer.obj).To(Equal(&gardencorev1beta1.Shoot{}))
+			Expect(indexer.field).To(Equal("spec.secretBindingName"))
+			Expect(indexer.extractValue).NotTo(BeNil())
+			Expect(indexer.extractValue(obj)).To(matcher)
+		},
+
+		Entry("no Shoot", &corev1.Secret{}, ConsistOf("")),
+		Entry("Shoot w/o secretBindingName", &gardencorev1beta1.Shoot{}, ConsistOf("")),
+		Entry("Shoot w/ secretBindingName", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{SecretBindingName: pointer.String("secretbinding")}}, ConsistOf("secretbinding")),
+	)
+
 	DescribeTable("#AddShootStatusSeedName",
 		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
 			Expect(AddShootStatusSeedName(context.TODO(), indexer)).To(Succeed())
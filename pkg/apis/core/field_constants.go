@@ -45,6 +45,9 @@ const (
 	// ShootCloudProfileName is the field selector path for finding
 	// the CloudProfile name of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
 	ShootCloudProfileName = "spec.cloudProfileName"
+	// ShootSecretBindingName is the field selector path for finding
+	// the SecretBinding name of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
+	ShootSecretBindingName = "spec.secretBindingName"
 	// ShootSeedName is the field selector path for finding
 	// the Seed cluster of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
 	ShootSeedName = "spec.seedName"
@@ -220,6 +220,52 @@ func FindMachineImageVersion(machineImages []core.MachineImage, name, version st
 	return core.MachineImageVersion{}, false
 }
 
+// UnsatisfiedMachineTypeRequirements returns the names of the given machine <requirements> which are not satisfied by
+// the given <machineType>.
+func UnsatisfiedMachineTypeRequirements(requirements *core.MachineRequirements, machineType core.MachineType) []string {
+	if requirements == nil {
+		return nil
+	}
+
+	var (
+		unsatisfied  []string
+		capabilities = machineType.Capabilities
+	)
+
+	if capabilities == nil {
+		capabilities = &core.MachineCapabilities{}
+	}
+
+	if pointer.BoolDeref(requirements.GPU, false) && machineType.GPU.IsZero() {
+		unsatisfied = append(unsatisfied, "gpu")
+	}
+	if pointer.BoolDeref(requirements.ConfidentialComputing, false) && !pointer.BoolDeref(capabilities.ConfidentialComputing, false) {
+		unsatisfied = append(unsatisfied, "confidentialComputing")
+	}
+	if pointer.BoolDeref(requirements.NestedVirtualization, false) && !pointer.BoolDeref(capabilities.NestedVirtualization, false) {
+		unsatisfied = append(unsatisfied, "nestedVirtualization")
+	}
+
+	return unsatisfied
+}
+
+// UnsatisfiedMachineImageVersionRequirements returns the names of the given machine <requirements> which are not
+// satisfied by the given <imageVersion>.
+func UnsatisfiedMachineImageVersionRequirements(requirements *core.MachineRequirements, imageVersion core.MachineImageVersion) []string {
+	if requirements == nil {
+		return nil
+	}
+
+	var unsatisfied []string
+
+	if pointer.BoolDeref(requirements.ConfidentialComputing, false) &&
+		(imageVersion.Capabilities == nil || !pointer.BoolDeref(imageVersion.Capabilities.ConfidentialComputing, false)) {
+		unsatisfied = append(unsatisfied, "confidentialComputing")
+	}
+
+	return unsatisfied
+}
+
 // ShootUsesUnmanagedDNS returns true if the shoot's DNS section is marked as 'unmanaged'.
 func ShootUsesUnmanagedDNS(shoot *core.Shoot) bool {
 	if shoot.Spec.DNS == nil {
@@ -24,6 +24,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
@@ -607,6 +608,40 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	DescribeTable("#UnsatisfiedMachineTypeRequirements",
+		func(requirements *core.MachineRequirements, machineType core.MachineType, expected []string) {
+			Expect(UnsatisfiedMachineTypeRequirements(requirements, machineType)).To(Equal(expected))
+		},
+
+		Entry("no requirements", nil, core.MachineType{}, nil),
+		Entry("requirements not set to true", &core.MachineRequirements{GPU: pointer.Bool(false)}, core.MachineType{}, nil),
+		Entry("all requirements unsatisfied",
+			&core.MachineRequirements{GPU: pointer.Bool(true), ConfidentialComputing: pointer.Bool(true), NestedVirtualization: pointer.Bool(true)},
+			core.MachineType{Capabilities: &core.MachineCapabilities{ConfidentialComputing: pointer.Bool(false)}},
+			[]string{"gpu", "confidentialComputing", "nestedVirtualization"},
+		),
+		Entry("all requirements satisfied",
+			&core.MachineRequirements{GPU: pointer.Bool(true), ConfidentialComputing: pointer.Bool(true), NestedVirtualization: pointer.Bool(true)},
+			core.MachineType{GPU: resource.MustParse("1"), Capabilities: &core.MachineCapabilities{ConfidentialComputing: pointer.Bool(true), NestedVirtualization: pointer.Bool(true)}},
+			nil,
+		),
+	)
+
+	DescribeTable("#UnsatisfiedMachineImageVersionRequirements",
+		func(requirements *core.MachineRequirements, imageVersion core.MachineImageVersion, expected []string) {
+			Expect(UnsatisfiedMachineImageVersionRequirements(requirements, imageVersion)).To(Equal(expected))
+		},
+
+		Entry("no requirements", nil, core.MachineImageVersion{}, nil),
+		Entry("only machine type requirements", &core.MachineRequirements{GPU: pointer.Bool(true)}, core.MachineImageVersion{}, nil),
+		Entry("confidential computing unsupported", &core.MachineRequirements{ConfidentialComputing: pointer.Bool(true)}, core.MachineImageVersion{}, []string{"confidentialComputing"}),
+		Entry("confidential computing supported",
+			&core.MachineRequirements{ConfidentialComputing: pointer.Bool(true)},
+			core.MachineImageVersion{Capabilities: &core.MachineCapabilities{ConfidentialComputing: pointer.Bool(true)}},
+			nil,
+		),
+	)
+
 	classificationPreview := core.ClassificationPreview
 	classificationDeprecated := core.ClassificationDeprecated
 	classificationSupported := core.ClassificationSupported
@@ -54,6 +54,15 @@ type BackupEntrySpec struct {
 	BucketName string
 	// SeedName holds the name of the seed to which this BackupEntry is scheduled
 	SeedName *string
+	// Encryption contains the configuration for encrypting the backups with a customer-supplied key.
+	Encryption *BackupEntryEncryption
+}
+
+// BackupEntryEncryption contains the configuration for encrypting the backups with a customer-supplied key.
+type BackupEntryEncryption struct {
+	// SecretName is the name of a secret in the namespace of the BackupEntry which contains the encryption key in its
+	// `key` data key.
+	SecretName string
 }
 
 // BackupEntryStatus holds the most recently observed status of the Backup Entry.
@@ -70,4 +79,14 @@ type BackupEntryStatus struct {
 	SeedName *string
 	// MigrationStartTime is the time when a migration to a different seed was initiated.
 	MigrationStartTime *metav1.Time
+	// Encryption contains the status of the encryption of the backups with a customer-supplied key.
+	Encryption *BackupEntryEncryptionStatus
+}
+
+// BackupEntryEncryptionStatus contains the status of the encryption of the backups with a customer-supplied key.
+type BackupEntryEncryptionStatus struct {
+	// KeyChecksum is the checksum of the encryption key which is currently used for new backups.
+	KeyChecksum string
+	// LastRotationTime is the time when the encryption key was rotated the last time.
+	LastRotationTime *metav1.Time
 }
@@ -15,6 +15,7 @@
 package core
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -118,6 +119,20 @@ type MachineImageVersion struct {
 	// - '>= 1.26' - supports only kubelet versions greater than or equal to 1.26
 	// - '< 1.26' - supports only kubelet versions less than 1.26
 	KubeletVersionConstraint *string
+	// Vulnerabilities is a list of known vulnerabilities (e.g., CVEs) affecting the machine image in this version.
+	// Versions affected by critical vulnerabilities are not considered for automatic updates. Shoots using such versions
+	// are forcefully updated if their project opted in for critical vulnerability updates.
+	Vulnerabilities []MachineImageVulnerability
+	// Capabilities are the capabilities supported by the machine image in this version.
+	Capabilities *MachineCapabilities
+}
+
+// MachineImageVulnerability contains information about a vulnerability affecting a machine image version.
+type MachineImageVulnerability struct {
+	// ID is the identifier of the vulnerability, e.g., `CVE-2023-12345`.
+	ID string
+	// Severity is the severity of the vulnerability. Possible values are `critical`, `high`, `medium`, and `low`.
+	Severity VulnerabilitySeverity
 }
 
 // ExpirableVersion contains a version and an expiration date.
@@ -146,6 +161,31 @@ type MachineType struct {
 	Usable *bool
 	// Architecture is the CPU architecture of this machine type.
 	Architecture *string
+	// GPUResourceName is the name of the extended resource under which nodes of this machine type advertise their GPUs
+	// (e.g., `nvidia.com/gpu`).
+	GPUResourceName *string
+	// ExtendedResources are additional extended resources which nodes of this machine type advertise.
+	ExtendedResources corev1.ResourceList
+	// NodeLabels are labels which nodes of this machine type carry (e.g., the GPU model).
+	NodeLabels map[string]string
+	// Deprecation marks the machine type as deprecated.
+	Deprecation *TypeDeprecation
+	// Capabilities are the capabilities of this machine type.
+	Capabilities *MachineCapabilities
+}
+
+// MachineCapabilities contains the capabilities of a machine type or machine image version.
+type MachineCapabilities struct {
+	// ConfidentialComputing states whether confidential computing is supported.
+	ConfidentialComputing *bool
+	// NestedVirtualization states whether nested virtualization is supported.
+	NestedVirtualization *bool
+}
+
+// TypeDeprecation marks a machine or volume type as deprecated.
+type TypeDeprecation struct {
+	// Successor is the name of the type which shall be used instead of the deprecated type.
+	Successor *string
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -193,6 +233,8 @@ type VolumeType struct {
 	Usable *bool
 	// MinSize is the minimal supported storage size.
 	MinSize *resource.Quantity
+	// Deprecation marks the volume type as deprecated.
+	Deprecation *TypeDeprecation
 }
 
 const (
@@ -231,3 +273,18 @@ const (
 	// UpdateStrategyMajor indicates that auto-updates are performed always to the overall latest version.
 	UpdateStrategyMajor MachineImageUpdateStrategy = "major"
 )
+
+// VulnerabilitySeverity is the severity of a vulnerability.
+type VulnerabilitySeverity string
+
+const (
+	// VulnerabilitySeverityCritical indicates a critical vulnerability. Machine image versions affected by critical
+	// vulnerabilities may be updated outside of the maintenance time window.
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "critical"
+	// VulnerabilitySeverityHigh indicates a vulnerability with high severity.
+	VulnerabilitySeverityHigh VulnerabilitySeverity = "high"
+	// VulnerabilitySeverityMedium indicates a vulnerability with medium severity.
+	VulnerabilitySeverityMedium VulnerabilitySeverity = "medium"
+	// VulnerabilitySeverityLow indicates a vulnerability with low severity.
+	VulnerabilitySeverityLow VulnerabilitySeverity = "low"
+)
@@ -81,6 +81,8 @@ type ProjectStatus struct {
 	StaleAutoDeleteTimestamp *metav1.Time
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	LastActivityTimestamp *metav1.Time
+	// Conditions represents the latest available observations of the project's lifecycle.
+	Conditions []Condition
 }
 
 // ProjectMember is a member of a project.
@@ -124,6 +126,15 @@ const (
 	ProjectMemberExtensionPrefix = "extension:"
 )
 
+const (
+	// ProjectInactive is a condition type indicating that the project has not been used for a certain time. Its reason
+	// reflects the stage of the project's lifecycle.
+	ProjectInactive ConditionType = "Inactive"
+	// ProjectArchived is a condition type indicating that the project has been archived because of its inactivity. No
+	// new shoots can be created in archived projects.
+	ProjectArchived ConditionType = "Archived"
+)
+
 // ProjectPhase is a label for the condition of a project at the current time.
 type ProjectPhase string
 
@@ -206,6 +206,8 @@ type SeedSettings struct {
 	// TopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed.
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting
+	// ControlPlanePriority controls the priority classes of shoot control plane components in the seed.
+	ControlPlanePriority *SeedSettingControlPlanePriority
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the
@@ -301,6 +303,26 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool
 }
 
+// SeedSettingControlPlanePriority controls the priority classes of shoot control plane components in the seed.
+type SeedSettingControlPlanePriority struct {
+	// Tiers is a list of priority tiers which are assigned to shoots based on their purpose. Shoots whose purpose is
+	// not listed in any tier use the default priority classes.
+	Tiers []SeedSettingControlPlanePriorityTier
+}
+
+// SeedSettingControlPlanePriorityTier describes the priority classes of shoot control plane components for a set of
+// shoot purposes.
+type SeedSettingControlPlanePriorityTier struct {
+	// Purposes is the list of shoot purposes this tier applies to.
+	Purposes []ShootPurpose
+	// ETCD is the name of the priority class used for the etcd pods of the shoots.
+	ETCD *string
+	// KubeAPIServer is the name of the priority class used for the kube-apiserver pods of the shoots.
+	KubeAPIServer *string
+	// KubeControllerManager is the name of the priority class used for the kube-controller-manager pods of the shoots.
+	KubeControllerManager *string
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -79,6 +79,8 @@ type ShootSpec struct {
 	// Maintenance contains information about the time window for maintenance operations and which
 	// operations should be performed.
 	Maintenance *Maintenance
+	// Logging contains information about custom logging configurations for the shoot.
+	Logging *Logging
 	// Monitoring contains information about custom monitoring configurations for the shoot.
 	Monitoring *Monitoring
 	// Provider contains all provider-specific and provider-relevant information.
@@ -110,6 +112,9 @@ type ShootSpec struct {
 	// If not specified, the default scheduler takes over.
 	// This field is immutable.
 	SchedulerName *string
+	// TrustedCABundles contains additional CA bundles (e.g., corporate CAs) which are added to the trust stores of all
+	// nodes and published in the `gardener-trusted-ca-bundles` ConfigMap in all namespaces of the Shoot cluster.
+	TrustedCABundles []TrustedCABundle
 }
 
 // GetProviderType gets the type of the provider.
@@ -163,6 +168,152 @@ type ShootStatus struct {
 	// Secrets are encrypted by default and are not part of the list.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	EncryptedResources []string
+	// ServiceLevelObjectives contains the current state of the service level objectives of the Shoot's control plane.
+	ServiceLevelObjectives []ServiceLevelObjectiveStatus
+	// AdmissionWarnings contains the warnings which were issued by the admission plugins of the gardener-apiserver
+	// for the last create or update request of the Shoot.
+	AdmissionWarnings []AdmissionWarning
+	// APIServerEndpoint contains the current state of the kube-apiserver endpoint of the Shoot.
+	APIServerEndpoint *APIServerEndpointStatus
+	// VulnerabilityScanResults contains the results of the latest vulnerability scans of the machine images used by the
+	// worker pools of the Shoot. They are reported by extensions scanning the nodes for vulnerabilities.
+	VulnerabilityScanResults []VulnerabilityScanResult
+	// MaintenanceHistory contains the most recent changes which were performed on the Shoot by the maintenance, e.g.,
+	// automatic version updates or migrations of deprecated fields. The oldest records are removed once the maximum
+	// number of records is reached.
+	MaintenanceHistory []MaintenanceRecord
+	// InfrastructurePlan contains the changes a reconciliation of the provider infrastructure would perform. It is
+	// computed when the Shoot is annotated with `gardener.cloud/operation=plan`.
+	InfrastructurePlan *InfrastructurePlan
+	// DeletionPreview contains information about the resources which would be destroyed or retained when the Shoot is
+	// deleted. It is computed when the Shoot is annotated with `gardener.cloud/operation=preview-deletion`.
+	DeletionPreview *ShootDeletionPreview
+	// WorkerRolloutProgress contains the progress of the worker pools whose machines are currently rolled out. It is
+	// updated continuously during the rollout and removed once it has finished.
+	WorkerRolloutProgress []WorkerPoolRolloutProgress
+}
+
+// WorkerPoolRolloutProgress contains the progress of a worker pool whose machines are rolled out.
+type WorkerPoolRolloutProgress struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Desired is the number of desired machines.
+	Desired int32
+	// Updated is the number of machines which already run the current configuration of the worker pool.
+	Updated int32
+	// Ready is the number of machines which are ready.
+	Ready int32
+	// Old is the number of machines which still run an outdated configuration of the worker pool.
+	Old int32
+}
+
+// ShootDeletionPreview contains information about the resources which would be destroyed or retained when the Shoot
+// is deleted.
+type ShootDeletionPreview struct {
+	// LastUpdateTime is the time when the preview was computed.
+	LastUpdateTime metav1.Time
+	// WorkerPools contains the number of nodes per worker pool which would be deleted.
+	WorkerPools []WorkerPoolDeletionPreview
+	// Volumes contains the number of persistent volumes which would be deleted or retained. It is not set if the
+	// volumes could not be determined, e.g., because the Shoot is hibernated.
+	Volumes *VolumesDeletionPreview
+	// DNSRecords is the list of DNS names whose records would be deleted.
+	DNSRecords []string
+	// BackupRetention is the period for which the etcd backups are retained after the Shoot was deleted. It is not
+	// set if the Shoot has no backups.
+	BackupRetention *metav1.Duration
+}
+
+// WorkerPoolDeletionPreview contains the number of nodes of a worker pool which would be deleted.
+type WorkerPoolDeletionPreview struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32
+}
+
+// VolumesDeletionPreview contains the number of persistent volumes which would be deleted or retained.
+type VolumesDeletionPreview struct {
+	// Deleted is the number of persistent volumes which would be deleted together with their backing disks.
+	Deleted int32
+	// Retained is the number of persistent volumes whose backing disks would be retained in the infrastructure account
+	// due to their `Retain` reclaim policy.
+	Retained int32
+}
+
+// InfrastructurePlan is a preview of the changes a reconciliation would perform on the provider infrastructure.
+type InfrastructurePlan struct {
+	// LastUpdateTime is the time when the plan was computed.
+	LastUpdateTime metav1.Time
+	// Supported indicates whether the provider extension supports computing a plan. If it is false, no changes are
+	// reported.
+	Supported bool
+	// Changes is the list of changes which would be performed on the provider infrastructure.
+	Changes []InfrastructurePlannedChange
+}
+
+// InfrastructurePlannedChange is a single change which would be performed on a resource of the provider
+// infrastructure.
+type InfrastructurePlannedChange struct {
+	// Action is the action which would be performed on the resource, i.e. `Create`, `Update`, `Replace`, or `Delete`.
+	Action string
+	// ResourceType is the provider-specific type of the resource.
+	ResourceType string
+	// Name is the provider-specific name or identifier of the resource.
+	Name string
+	// Reason is a human-readable explanation why the change is necessary.
+	Reason *string
+}
+
+// VulnerabilityScanResult contains the result of a vulnerability scan of the machine image used by a worker pool.
+type VulnerabilityScanResult struct {
+	// WorkerPool is the name of the scanned worker pool.
+	WorkerPool string
+	// MachineImageName is the name of the machine image used by the worker pool at the time of the scan.
+	MachineImageName string
+	// MachineImageVersion is the version of the machine image used by the worker pool at the time of the scan.
+	MachineImageVersion string
+	// Vulnerabilities is the list of vulnerabilities found by the scan.
+	Vulnerabilities []MachineImageVulnerability
+	// ScanTime is the time at which the scan was performed.
+	ScanTime metav1.Time
+}
+
+// APIServerEndpointStatus contains the current state of the kube-apiserver endpoint of the Shoot.
+type APIServerEndpointStatus struct {
+	// StaticIP is the static IP address which was allocated for the kube-apiserver endpoint.
+	StaticIP *string
+	// AllowedSourceRanges is the list of CIDRs which are currently allowed to access the kube-apiserver, i.e., the
+	// configured ranges and the egress CIDRs of the worker nodes.
+	AllowedSourceRanges []string
+}
+
+// AdmissionWarning is a warning which was issued by an admission plugin of the gardener-apiserver.
+type AdmissionWarning struct {
+	// Plugin is the name of the admission plugin which issued the warning.
+	Plugin string
+	// Reason is a brief, machine-readable explanation of the warning, e.g. `KubernetesVersionExpiring`.
+	Reason string
+	// Message is a human-readable message containing details about the warning and how to address it.
+	Message string
+}
+
+// ServiceLevelObjectiveStatus contains the current state of a service level objective of the Shoot's control plane.
+type ServiceLevelObjectiveStatus struct {
+	// Name is the name of the service level objective, e.g. `APIServerAvailability`.
+	Name string
+	// Objective is the targeted percentage of good requests within the window, e.g. `99.9`.
+	Objective string
+	// Window is the time window in which the service level objective is evaluated.
+	Window metav1.Duration
+	// Current is the percentage of good requests within the window, e.g. `99.95`. It is not set if it could not be
+	// determined.
+	Current *string
+	// ErrorBudgetRemaining is the percentage of the error budget which remains within the window, e.g. `50`. It is
+	// negative if the error budget is exhausted. It is not set if it could not be determined.
+	ErrorBudgetRemaining *string
+	// LastUpdateTime is the last time the status was updated.
+	LastUpdateTime metav1.Time
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -175,6 +326,25 @@ type LastMaintenance struct {
 	State LastOperationState
 	// FailureReason holds the information about the last maintenance operation failure reason.
 	FailureReason *string
+	// OutOfWindow indicates whether the maintenance was performed outside of the maintenance time window, e.g., because
+	// machine image versions affected by critical vulnerabilities had to be updated.
+	OutOfWindow *bool
+}
+
+// MaintenanceRecord describes a change which was performed on the Shoot by the maintenance.
+type MaintenanceRecord struct {
+	// Time is the time when the change was performed.
+	Time metav1.Time
+	// Field is the path of the changed field, e.g. `spec.kubernetes.version`.
+	Field string
+	// OldValue is the value of the field before the change.
+	OldValue *string
+	// NewValue is the value of the field after the change.
+	NewValue *string
+	// Reason describes why the change was performed, e.g., the update policy which triggered it.
+	Reason string
+	// OutOfWindow indicates whether the change was performed outside of the maintenance time window.
+	OutOfWindow *bool
 }
 
 // ShootCredentials contains information about the shoot credentials.
@@ -272,6 +442,19 @@ type ETCDEncryptionKeyRotation struct {
 	// LastCompletionTriggeredTime is the recent time when the certificate authority credential rotation completion was
 	// triggered.
 	LastCompletionTriggeredTime *metav1.Time
+	// ReencryptedResources contains the progress of rewriting the encrypted resources so that they become encrypted
+	// with the new ETCD encryption key. It is reset when a new rotation is initiated.
+	ReencryptedResources []ReencryptedResource
+}
+
+// ReencryptedResource contains the progress of rewriting the objects of an encrypted resource.
+type ReencryptedResource struct {
+	// Resource is the kind and group of the resource, e.g. 'Secret' or 'Deployment.apps'.
+	Resource string
+	// Total is the number of objects of the resource which must be encrypted with the new key.
+	Total int32
+	// Rewritten is the number of objects of the resource which are already encrypted with the new key.
+	Rewritten int32
 }
 
 // CredentialsRotationPhase is a string alias.
@@ -341,6 +524,25 @@ type ControlPlane struct {
 	// HighAvailability holds the configuration settings for high availability of the
 	// control plane of a shoot.
 	HighAvailability *HighAvailability
+	// VPN contains the settings for the VPN connection between the control plane and the data plane of the shoot.
+	VPN *ControlPlaneVPN
+}
+
+// ControlPlaneVPN contains the settings for the VPN connection between the control plane and the data plane of a
+// shoot.
+type ControlPlaneVPN struct {
+	// HighThroughput enables the high-throughput mode in which multiple parallel VPN tunnels are established between
+	// the control plane and the data plane, and the traffic is load-balanced across them.
+	HighThroughput *VPNHighThroughput
+}
+
+// VPNHighThroughput contains the settings for the high-throughput mode of the VPN.
+type VPNHighThroughput struct {
+	// MinTunnels is the minimum number of parallel VPN tunnels.
+	MinTunnels *int32
+	// MaxTunnels is the maximum number of parallel VPN tunnels. The number of tunnels is scaled automatically between
+	// MinTunnels and MaxTunnels based on the observed VPN throughput.
+	MaxTunnels *int32
 }
 
 // DNS holds information about the provider, the hosted zone id and the domain.
@@ -351,6 +553,26 @@ type DNS struct {
 	// Providers is a list of DNS providers that shall be enabled for this shoot cluster. Only relevant if
 	// not a default domain is used.
 	Providers []DNSProvider
+	// Private contains information about an additional private DNS zone (e.g., a private hosted zone or a corporate
+	// DNS) in which the kube-apiserver of the Shoot cluster shall be resolvable. This is useful for clusters which
+	// are only reachable from private networks.
+	Private *PrivateDNS
+}
+
+// PrivateDNS contains information about a private DNS zone for the Shoot cluster.
+type PrivateDNS struct {
+	// Domain is the private domain of the Shoot cluster. The kube-apiserver is served under `api.<domain>`, and this
+	// name is added to its serving certificate. This field is immutable.
+	Domain string
+	// Type is the DNS provider type managing the private zone. If set to `unmanaged`, no DNS record is created by
+	// Gardener and the record has to be maintained by the user.
+	Type string
+	// SecretName is the name of a secret in the project namespace containing credentials for the DNS provider.
+	// It is required unless the type is `unmanaged`.
+	SecretName *string
+	// Zone is the identifier of the private hosted zone. If not specified, the DNS provider determines the zone
+	// based on the domain.
+	Zone *string
 }
 
 // DNSProvider contains information about a DNS provider.
@@ -532,6 +754,18 @@ type VerticalPodAutoscaler struct {
 	UpdaterInterval *metav1.Duration
 	// RecommenderInterval is the interval how often metrics should be fetched (default: 1m0s).
 	RecommenderInterval *metav1.Duration
+	// TargetCPUPercentile is the usage percentile that will be used as a base for the CPU target recommendation
+	// (default: 0.9).
+	TargetCPUPercentile *float64
+	// TargetMemoryPercentile is the usage percentile that will be used as a base for the memory target recommendation
+	// (default: 0.9).
+	TargetMemoryPercentile *float64
+	// CPUHistogramDecayHalfLife is the amount of time it takes a historical CPU usage sample to lose half of its weight
+	// (default: 24h0m0s).
+	CPUHistogramDecayHalfLife *metav1.Duration
+	// MemoryHistogramDecayHalfLife is the amount of time it takes a historical memory usage sample to lose half of its
+	// weight (default: 24h0m0s).
+	MemoryHistogramDecayHalfLife *metav1.Duration
 }
 
 // KubernetesConfig contains common configuration fields for the control plane components.
@@ -587,6 +821,27 @@ type KubeAPIServerConfig struct {
 	DefaultUnreachableTolerationSeconds *int64
 	// EncryptionConfig contains customizable encryption configuration of the API server.
 	EncryptionConfig *EncryptionConfig
+	// Endpoint contains settings for the endpoint of the kube-apiserver which is exposed by the Seed.
+	Endpoint *APIServerEndpoint
+}
+
+// APIServerEndpoint contains settings for the endpoint of the kube-apiserver.
+type APIServerEndpoint struct {
+	// AllowedSourceRanges is a list of CIDRs which are allowed to access the kube-apiserver. If it is set, all other
+	// clients are denied, except for the worker nodes of the Shoot whose egress CIDRs are always allowed.
+	AllowedSourceRanges []string
+	// StaticIP requests a dedicated load balancer with a static IP address for the kube-apiserver. It is only
+	// supported if the provider extension of the Seed supports it.
+	StaticIP *APIServerStaticIP
+}
+
+// APIServerStaticIP contains settings for the static IP address of the kube-apiserver endpoint.
+type APIServerStaticIP struct {
+	// Address is an IP address which was reserved upfront in the infrastructure account of the Seed. If it is not set,
+	// a static IP address is allocated by the provider extension.
+	Address *string
+	// PTRRecord is the domain name of the reverse DNS (PTR) record which is configured for the static IP address.
+	PTRRecord *string
 }
 
 // APIServerLogging contains configuration for the logs level and http access logs
@@ -605,8 +860,33 @@ type APIServerRequests struct {
 	// MaxMutatingInflight is the maximum number of mutating requests in flight at a given time. When the server
 	// exceeds this, it rejects requests.
 	MaxMutatingInflight *int32
+	// PriorityAndFairness contains configuration for the API Priority and Fairness (APF) objects which are managed by
+	// Gardener in the shoot cluster.
+	PriorityAndFairness *APIServerPriorityAndFairness
+}
+
+// APIServerPriorityAndFairness contains configuration for the API Priority and Fairness (APF) objects which are
+// managed by Gardener in the shoot cluster.
+type APIServerPriorityAndFairness struct {
+	// Presets is a list of APF presets which are deployed to the shoot cluster. Each preset consists of a set of
+	// FlowSchemas and PriorityLevelConfigurations which are maintained by Gardener for the Kubernetes version of the shoot.
+	Presets []APIServerPriorityAndFairnessPreset
 }
 
+// APIServerPriorityAndFairnessPreset is a name of an API Priority and Fairness preset.
+type APIServerPriorityAndFairnessPreset string
+
+const (
+	// APIServerPriorityAndFairnessPresetProtectSystemComponents is a preset which assigns the requests of the system
+	// components running in the kube-system namespace to a dedicated priority level, so that they are not starved by
+	// other clients.
+	APIServerPriorityAndFairnessPresetProtectSystemComponents APIServerPriorityAndFairnessPreset = "ProtectSystemComponents"
+	// APIServerPriorityAndFairnessPresetLimitWorkloadServiceAccounts is a preset which assigns the requests of all
+	// service accounts outside of the kube-system namespace to a priority level with a limited share of the
+	// kube-apiserver's concurrency, so that request bursts, e.g., from CI pipelines, cannot overload the kube-apiserver.
+	APIServerPriorityAndFairnessPresetLimitWorkloadServiceAccounts APIServerPriorityAndFairnessPreset = "LimitWorkloadServiceAccounts"
+)
+
 // EncryptionConfig contains customizable encryption configuration of the API server.
 type EncryptionConfig struct {
 	// Resources contains the list of resources that shall be encrypted in addition to secrets.
@@ -637,6 +917,11 @@ type ServiceAccountConfig struct {
 	// These values are not used to generate new service account tokens. Only useful when service account tokens are also
 	// issued by another external system or a change of the current issuer that is used for generating tokens is being performed.
 	AcceptedIssuers []string
+	// SigningKeyRotationPeriod is the period after which the service account signing key is rotated automatically
+	// during the maintenance time window. The previous signing key is still accepted for verifying tokens until all
+	// tokens issued with it are expired, i.e., the rotation is completed automatically after MaxTokenExpiration passed.
+	// Requires MaxTokenExpiration to be set and ExtendTokenExpiration to be disabled.
+	SigningKeyRotationPeriod *metav1.Duration
 }
 
 // AuditConfig contains settings for audit of the api server
@@ -768,6 +1053,28 @@ type KubeSchedulerConfig struct {
 	// Profile configures the scheduling profile for the cluster.
 	// If not specified, the used profile is "balanced" (provides the default kube-scheduler behavior).
 	Profile *SchedulingProfile
+	// Profiles are additional scheduling profiles which are configured for the kube-scheduler. Pods are scheduled with
+	// such a profile if their `.spec.schedulerName` is set to the name of the profile.
+	Profiles []KubeSchedulerProfile
+}
+
+// KubeSchedulerProfile contains the configuration of an additional scheduling profile of the kube-scheduler.
+type KubeSchedulerProfile struct {
+	// SchedulerName is the name of the profile. It must not be `default-scheduler` or `bin-packing-scheduler`.
+	SchedulerName string
+	// BinPacking enables the bin-packing scoring strategy for this profile, i.e., Nodes with the most allocated
+	// resources are preferred (similar to the "bin-packing" scheduling profile).
+	BinPacking *bool
+	// ScorePlugins configures the weights of score plugins of this profile.
+	ScorePlugins []KubeSchedulerScorePlugin
+}
+
+// KubeSchedulerScorePlugin configures the weight of a score plugin of the kube-scheduler.
+type KubeSchedulerScorePlugin struct {
+	// Name is the name of the score plugin.
+	Name string
+	// Weight is the weight of the score plugin. It must be in the range from 1 to 100.
+	Weight int32
 }
 
 // SchedulingProfile is a string alias used for scheduling profile values.
@@ -899,6 +1206,9 @@ type KubeletConfig struct {
 	StreamingConnectionIdleTimeout *metav1.Duration
 	// MemorySwap configures swap memory available to container workloads.
 	MemorySwap *MemorySwapConfiguration
+	// ImageMinimumGCAge is the minimum age for an unused image before it is garbage collected.
+	// Default: 2m
+	ImageMinimumGCAge *metav1.Duration
 }
 
 // KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
@@ -981,12 +1291,14 @@ type Networking struct {
 	Type *string
 	// ProviderConfig is the configuration passed to network resource.
 	ProviderConfig *runtime.RawExtension
-	// Pods is the CIDR of the pod network. This field is immutable.
+	// Pods is the CIDR of the pod network. This field is immutable unless the feature gate
+	// ExpandableShootSpecNetworkingCIDRs is enabled, which allows expanding it to a larger CIDR containing the previous one.
 	Pods *string
 	// Nodes is the CIDR of the entire node network.
 	// This field is immutable if the feature gate MutableShootSpecNetworkingNodes is disabled.
 	Nodes *string
-	// Services is the CIDR of the service network. This field is immutable.
+	// Services is the CIDR of the service network. This field is immutable unless the feature gate
+	// ExpandableShootSpecNetworkingCIDRs is enabled, which allows expanding it to a larger CIDR containing the previous one.
 	Services *string
 	// IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md.
@@ -1027,6 +1339,9 @@ type MaintenanceAutoUpdate struct {
 	KubernetesVersion bool
 	// MachineImageVersion indicates whether the machine image version may be automatically updated (default: true).
 	MachineImageVersion *bool
+	// DeprecatedTypes indicates whether deprecated machine and volume types of worker pools may be automatically
+	// replaced by their successors suggested in the cloud profile (default: false).
+	DeprecatedTypes *bool
 }
 
 // MaintenanceTimeWindow contains information about the time window for maintenance operations.
@@ -1039,6 +1354,24 @@ type MaintenanceTimeWindow struct {
 	End string
 }
 
+// Logging contains information about the logging configuration for the shoot.
+type Logging struct {
+	// Retention is the duration for which the control plane logs of the shoot are retained in the seed. It must be a
+	// multiple of 24h.
+	Retention *metav1.Duration
+	// StorageQuota is the size of the volume storing the control plane logs of the shoot in the seed. When the volume
+	// runs full, the oldest logs are deleted before the retention period has passed.
+	StorageQuota *resource.Quantity
+	// QueryAPI contains information about the authenticated query API for the control plane logs of the shoot.
+	QueryAPI *LoggingQueryAPI
+}
+
+// LoggingQueryAPI contains information about the authenticated query API for the control plane logs of the shoot.
+type LoggingQueryAPI struct {
+	// Enabled controls whether the query API is exposed.
+	Enabled bool
+}
+
 // Monitoring contains information about the monitoring configuration for the shoot.
 type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
@@ -1116,6 +1449,66 @@ type Worker struct {
 	MachineControllerManagerSettings *MachineControllerManagerSettings
 	// Sysctls is a map of kernel settings to apply on all machines in this worker pool.
 	Sysctls map[string]string
+	// MachineImageUpdatePolicy contains the policy for automatic updates of the machine image version of this worker pool.
+	MachineImageUpdatePolicy *MachineImageUpdatePolicy
+	// Topology contains settings for the topology labels which are added to the nodes of this worker pool.
+	Topology *WorkerTopology
+	// MaxNodeProvisionTime is the maximum duration the provisioning of a machine of this worker pool may take before the
+	// machine is declared failed and replaced by the machine-controller-manager.
+	MaxNodeProvisionTime *metav1.Duration
+	// OperatingSystemConfigRollout contains settings for the staged rollout of operating system config changes to the
+	// nodes of this worker pool.
+	OperatingSystemConfigRollout *OperatingSystemConfigRollout
+	// ZoneRebalancing contains settings for the automatic rebalancing of the machines of this worker pool to its other
+	// zones in case a zone repeatedly has insufficient capacity. If not set, machines are not rebalanced.
+	ZoneRebalancing *WorkerZoneRebalancing
+}
+
+// WorkerZoneRebalancing contains settings for the automatic rebalancing of the machines of a worker pool to its other
+// zones in case a zone repeatedly has insufficient capacity. Once the number of machines of a zone which failed due to
+// insufficient capacity reaches the failure threshold, the desired machines of the zone are shifted to the other zones
+// of the worker pool. The shift is reverted after the recovery period in order to check whether the zone has capacity
+// again.
+type WorkerZoneRebalancing struct {
+	// FailureThreshold is the number of machines of a zone which failed due to insufficient capacity after which the
+	// desired machines of the zone are shifted to the other zones of the worker pool.
+	FailureThreshold *int32
+	// RecoveryPeriod is the duration after which the shift is reverted.
+	RecoveryPeriod *metav1.Duration
+}
+
+// OperatingSystemConfigRollout contains settings for the staged rollout of operating system config changes to the nodes
+// of a worker pool. Changes are first applied to the canary nodes. They are only propagated to the remaining nodes once
+// all canary nodes have been verified to be healthy. If a canary node does not become healthy within the health
+// timeout, the rollout is halted.
+type OperatingSystemConfigRollout struct {
+	// CanaryNodes is the number of nodes of the worker pool to which changes are applied first.
+	CanaryNodes int32
+	// HealthTimeout is the duration within which the canary nodes must become healthy after the changes have been applied.
+	HealthTimeout *metav1.Duration
+}
+
+// WorkerTopology contains settings for the topology labels which are added to the nodes of a worker pool. The labels
+// are also added to the node template used by the cluster-autoscaler when scaling the worker pool from zero, so that
+// pods and nodes can be spread predictably across zones regardless of the infrastructure provider. If the worker pool
+// uses exactly one zone, the nodes are additionally labeled with `topology.kubernetes.io/zone`.
+type WorkerTopology struct {
+	// ZoneGroup is the name of a group of zones the nodes of this worker pool belong to. It is added to the nodes with the
+	// `topology.gardener.cloud/zone-group` label.
+	ZoneGroup *string
+	// DedicatedToProject controls whether the nodes of this worker pool are labeled with
+	// `topology.gardener.cloud/dedicated-to-project=<project-name>`.
+	DedicatedToProject *bool
+}
+
+// MachineImageUpdatePolicy contains the policy for automatic updates of the machine image version of a worker pool.
+type MachineImageUpdatePolicy struct {
+	// PinPatchStream pins the worker pool to the patch stream (i.e., the major and minor version) of its current machine
+	// image version. Automatic updates are only performed to the latest patch version of the current minor version,
+	// regardless of the update strategy configured in the CloudProfile. Expired versions or versions affected by
+	// critical vulnerabilities are still forcefully updated to the next higher minor version if no suitable patch
+	// version is available.
+	PinPatchStream *bool
 }
 
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
@@ -1130,12 +1523,37 @@ type MachineControllerManagerSettings struct {
 	MaxEvictRetries *int32
 	// NodeConditions are the set of conditions if set to true for the period of MachineHealthTimeout, machine will be declared failed.
 	NodeConditions []string
+	// StuckMachinePolicy contains the policy for machines of this worker pool which repeatedly fail to be provisioned.
+	StuckMachinePolicy *StuckMachinePolicy
+}
+
+// StuckMachinePolicy contains the policy for machines of a worker pool which repeatedly fail to be provisioned.
+type StuckMachinePolicy struct {
+	// Action is the action which is taken once the number of failed machines of a machine deployment of the worker pool
+	// reaches the FailureThreshold.
+	Action StuckMachineAction
+	// FailureThreshold is the number of failed machines of a machine deployment after which the Action is taken.
+	FailureThreshold *int32
 }
 
+// StuckMachineAction is a type alias for the action which is taken for machines which repeatedly fail to be provisioned.
+type StuckMachineAction string
+
+const (
+	// StuckMachineActionKeepRetrying keeps replacing failed machines.
+	StuckMachineActionKeepRetrying StuckMachineAction = "KeepRetrying"
+	// StuckMachineActionPausePool pauses the machine deployments of the worker pool until its configuration is changed.
+	StuckMachineActionPausePool StuckMachineAction = "PausePool"
+)
+
 // WorkerSystemComponents contains configuration for system components related to this worker pool
 type WorkerSystemComponents struct {
 	// Allow determines whether the pool should be allowed to host system components or not (defaults to true)
 	Allow bool
+	// Only determines whether the pool is dedicated to system components. If true, the nodes of this pool are tainted
+	// with `worker.gardener.cloud/system-components-only=true:NoSchedule` so that only Gardener-managed system
+	// components (which tolerate this taint) are scheduled onto them. Requires `allow` to be true.
+	Only *bool
 }
 
 // WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.
@@ -1159,6 +1577,19 @@ type Machine struct {
 	Image *ShootMachineImage
 	// Architecture is the CPU architecture of the machines in this worker pool.
 	Architecture *string
+	// Requirements are the capabilities the machines of this worker pool require.
+	Requirements *MachineRequirements
+}
+
+// MachineRequirements contains the capabilities required by the machines of a worker pool.
+type MachineRequirements struct {
+	// GPU states whether the machine type must have GPUs.
+	GPU *bool
+	// ConfidentialComputing states whether the machine type and the machine image version must support confidential
+	// computing.
+	ConfidentialComputing *bool
+	// NestedVirtualization states whether the machine type must support nested virtualization.
+	NestedVirtualization *bool
 }
 
 // ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be
@@ -1249,14 +1680,32 @@ type SystemComponents struct {
 	CoreDNS *CoreDNS
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	NodeLocalDNS *NodeLocalDNS
+	// UserProvided is a list of system components which are provided by the user instead of Gardener. Gardener does
+	// neither deploy nor health-check these components, and removes them in case they were deployed before.
+	UserProvided []UserProvidedSystemComponent
 }
 
+// UserProvidedSystemComponent is a name of a system component which can be provided by the user instead of Gardener.
+type UserProvidedSystemComponent string
+
+const (
+	// UserProvidedSystemComponentMetricsServer is the name of the metrics-server system component.
+	UserProvidedSystemComponentMetricsServer UserProvidedSystemComponent = "metrics-server"
+	// UserProvidedSystemComponentKubeProxy is the name of the kube-proxy system component.
+	UserProvidedSystemComponentKubeProxy UserProvidedSystemComponent = "kube-proxy"
+)
+
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
 type CoreDNS struct {
 	// Autoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
 	Autoscaling *CoreDNSAutoscaling
 	// Rewriting contains the setting related to rewriting of requests, which are obviously incorrect due to the unnecessary application of the search path.
 	Rewriting *CoreDNSRewriting
+	// Zones contains the settings of additional DNS zones served by the Core DNS components, e.g., stub domains whose
+	// requests are forwarded to dedicated upstream DNS servers.
+	Zones []CoreDNSZone
+	// Rewrites contains rules for rewriting the names of DNS requests.
+	Rewrites []CoreDNSRewrite
 }
 
 // CoreDNSAutoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1282,6 +1731,40 @@ type CoreDNSRewriting struct {
 	CommonSuffixes []string
 }
 
+// CoreDNSZone contains the settings of an additional DNS zone served by the Core DNS components.
+type CoreDNSZone struct {
+	// Name is the name of the zone, e.g., `corp.example.com`. It must neither be the cluster domain nor one of its
+	// sub-domains.
+	Name string
+	// Upstreams are the DNS servers to which requests for the zone are forwarded. Each entry must be an IP address,
+	// optionally followed by a port, e.g., `10.0.0.10` or `10.0.0.10:5353`.
+	Upstreams []string
+}
+
+// CoreDNSRewrite contains a rule for rewriting the names of DNS requests.
+type CoreDNSRewrite struct {
+	// Match is the type of the match of the name. Supported values are `exact`, `prefix`, `suffix`, and `substring`.
+	Match CoreDNSRewriteMatch
+	// From is the name (or the part of the name, depending on the match type) which is rewritten.
+	From string
+	// To is the replacement for the matched name (or part of the name).
+	To string
+}
+
+// CoreDNSRewriteMatch is a type alias for the match type of Core DNS rewrite rules.
+type CoreDNSRewriteMatch string
+
+const (
+	// CoreDNSRewriteMatchExact is a constant for rewrite rules matching the exact name.
+	CoreDNSRewriteMatchExact CoreDNSRewriteMatch = "exact"
+	// CoreDNSRewriteMatchPrefix is a constant for rewrite rules matching a prefix of the name.
+	CoreDNSRewriteMatchPrefix CoreDNSRewriteMatch = "prefix"
+	// CoreDNSRewriteMatchSuffix is a constant for rewrite rules matching a suffix of the name.
+	CoreDNSRewriteMatchSuffix CoreDNSRewriteMatch = "suffix"
+	// CoreDNSRewriteMatchSubstring is a constant for rewrite rules matching a substring of the name.
+	CoreDNSRewriteMatchSubstring CoreDNSRewriteMatch = "substring"
+)
+
 // NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 type NodeLocalDNS struct {
 	// Enabled indicates whether node local DNS is enabled or not.
@@ -1298,6 +1781,14 @@ type NodeLocalDNS struct {
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
 }
 
+// TrustedCABundle is a named bundle of additional trusted CA certificates.
+type TrustedCABundle struct {
+	// Name is the unique name of the bundle. The bundle is published with key `<name>.pem` in the ConfigMap.
+	Name string
+	// CABundle contains the PEM-encoded CA certificates.
+	CABundle string
+}
+
 const (
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
@@ -1329,6 +1820,24 @@ const (
 	// ShootMaintenancePreconditionsSatisfied is a constant for a condition type indicating whether all preconditions
 	// for a shoot maintenance operation are satisfied.
 	ShootMaintenancePreconditionsSatisfied ConditionType = "MaintenancePreconditionsSatisfied"
+	// ShootUpgradePreflightChecksPassed is a constant for a condition type indicating whether the Shoot passed the
+	// pre-flight checks for an upgrade to the next Kubernetes minor version.
+	ShootUpgradePreflightChecksPassed ConditionType = "UpgradePreflightChecksPassed"
+	// ShootPodDisruptionBudgetsAllowNodeDrain is a constant for a condition type indicating whether the
+	// PodDisruptionBudgets in the Shoot cluster allow draining its nodes.
+	ShootPodDisruptionBudgetsAllowNodeDrain ConditionType = "PodDisruptionBudgetsAllowNodeDrain"
+	// ShootEncryptionConfigApplied is a constant for a condition type indicating whether the objects of the resources
+	// which were added to or removed from the encryption configuration of the kube-apiserver have been rewritten.
+	ShootEncryptionConfigApplied ConditionType = "EncryptionConfigApplied"
+	// ShootEtcdHighAvailabilityApplied is a constant for a condition type indicating whether the etcd clusters of a
+	// Shoot which started with a single-member etcd have been scaled up to highly available clusters.
+	ShootEtcdHighAvailabilityApplied ConditionType = "EtcdHighAvailabilityApplied"
+	// ShootBackupReady is a constant for a condition type indicating whether the latest full and delta snapshots of
+	// the main etcd of the Shoot are recent enough.
+	ShootBackupReady ConditionType = "BackupReady"
+	// ShootPolicyBundlesApplied is a constant for a condition type indicating whether the policy bundles distributed to
+	// the Shoot have been applied successfully.
+	ShootPolicyBundlesApplied ConditionType = "PolicyBundlesApplied"
 )
 
 // DNSUnmanaged is a constant for the 'unmanaged' DNS provider.
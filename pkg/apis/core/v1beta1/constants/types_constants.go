@@ -181,6 +181,9 @@ const (
 	// ConfigMapNameShootInfo is the name of a ConfigMap in the kube-system namespace of shoot clusters which contains
 	// information about the shoot cluster.
 	ConfigMapNameShootInfo = "shoot-info"
+	// ConfigMapNameTrustedCABundles is the name of a ConfigMap in the namespaces of shoot clusters which contains the
+	// additional trusted CA bundles configured in the Shoot specification.
+	ConfigMapNameTrustedCABundles = "gardener-trusted-ca-bundles"
 
 	// StatefulSetNameAlertManager is a constant for the name of a Kubernetes stateful set object that contains
 	// the alertmanager pod.
@@ -236,6 +239,9 @@ const (
 	// GardenerOperationRenewKubeconfig is a constant for the value of the operation annotation to renew the gardenlet's
 	// kubeconfig secret.
 	GardenerOperationRenewKubeconfig = "renew-kubeconfig"
+	// GardenerOperationPlan is a constant for the value of the operation annotation describing a request to compute
+	// the changes a reconciliation would perform without applying them.
+	GardenerOperationPlan = "plan"
 
 	// GardenRole is a constant for a label that describes a role.
 	GardenRole = "gardener.cloud/role"
@@ -289,6 +295,12 @@ const (
 	GardenRoleGlobalShootRemoteWriteMonitoring = "global-shoot-remote-write-monitoring"
 	// GardenRoleAlerting is the value of GardenRole key indicating type 'alerting'.
 	GardenRoleAlerting = "alerting"
+	// GardenRoleShootServiceAccountIssuer is the value of GardenRole key indicating type 'shoot-service-account-issuer'.
+	GardenRoleShootServiceAccountIssuer = "shoot-service-account-issuer"
+	// GardenRolePolicyBundle is the value of GardenRole key indicating type 'policy-bundle'.
+	GardenRolePolicyBundle = "policy-bundle"
+	// GardenRoleServiceAccountIssuer is the value of GardenRole key indicating type 'service-account-issuer'.
+	GardenRoleServiceAccountIssuer = "service-account-issuer"
 	// GardenRoleHvpa is the value of GardenRole key indicating type 'hvpa'.
 	GardenRoleHvpa = "hvpa"
 	// GardenRoleControlPlaneWildcardCert is the value of the GardenRole key indicating type 'controlplane-cert'.
@@ -296,6 +308,8 @@ const (
 	GardenRoleControlPlaneWildcardCert = "controlplane-cert"
 	// GardenRoleExposureClassHandler is the value of the GardenRole key indicating type 'exposureclass-handler'.
 	GardenRoleExposureClassHandler = "exposureclass-handler"
+	// GardenRoleGardenletShard is the value of the GardenRole key indicating type 'gardenlet-shard'.
+	GardenRoleGardenletShard = "gardenlet-shard"
 
 	// ShootUID is an annotation key for the shoot namespace in the seed cluster,
 	// which value will be the value of `shoot.status.uid`
@@ -327,6 +341,19 @@ const (
 	// Note that this annotation is alpha and can be removed anytime without further notice. Only use it if you know
 	// what you do.
 	ShootAlphaControlPlaneHAVPN = "alpha.control-plane.shoot.gardener.cloud/high-availability-vpn"
+	// ShootAlphaPodSecurityContextDefaulting is a constant for an annotation on the Shoot resource which enables the
+	// defaulting of the security context (seccomp and AppArmor profiles, dropped capabilities, non-root user) of
+	// Gardener-managed pods in the shoot cluster. Possible values are ShootPodSecurityContextDefaultingEnforce and
+	// ShootPodSecurityContextDefaultingDryRun.
+	// Note that this annotation is alpha and can be removed anytime without further notice. Only use it if you know
+	// what you do.
+	ShootAlphaPodSecurityContextDefaulting = "alpha.security.shoot.gardener.cloud/pod-security-context-defaulting"
+	// ShootPodSecurityContextDefaultingEnforce is a value for the ShootAlphaPodSecurityContextDefaulting annotation
+	// which lets the defaults be applied to the pods.
+	ShootPodSecurityContextDefaultingEnforce = "enforce"
+	// ShootPodSecurityContextDefaultingDryRun is a value for the ShootAlphaPodSecurityContextDefaulting annotation which
+	// only reports the changes which would be applied to the pods.
+	ShootPodSecurityContextDefaultingDryRun = "dry-run"
 	// ShootExpirationTimestamp is an annotation on a Shoot resource whose value represents the time when the Shoot lifetime
 	// is expired. The lifetime can be extended, but at most by the minimal value of the 'clusterLifetimeDays' property
 	// of referenced quotas.
@@ -350,6 +377,9 @@ const (
 	// ShootTaskDeployDNSRecordExternal is a name for a Shoot's external DNS record deployment task. It indicates that
 	// the external DNSRecord extension resources shall be reconciled.
 	ShootTaskDeployDNSRecordExternal = "deployDNSRecordExternal"
+	// ShootTaskDeployDNSRecordPrivate is a name for a Shoot's private DNS record deployment task. It indicates that
+	// the private DNSRecord extension resources shall be reconciled.
+	ShootTaskDeployDNSRecordPrivate = "deployDNSRecordPrivate"
 	// ShootTaskDeployDNSRecordIngress is a name for a Shoot's ingress DNS record deployment task. It indicates that
 	// the ingress DNSRecord extension resources shall be reconciled.
 	ShootTaskDeployDNSRecordIngress = "deployDNSRecordIngress"
@@ -357,12 +387,36 @@ const (
 	ShootTaskRestartControlPlanePods = "restartControlPlanePods"
 	// ShootTaskRestartCoreAddons is a name for a Shoot task which is dedicated to restart some core addons.
 	ShootTaskRestartCoreAddons = "restartCoreAddons"
+	// ShootTaskCollectDiagnostics is a name for a Shoot task which is dedicated to collect the diagnostic bundle of the
+	// control plane components.
+	ShootTaskCollectDiagnostics = "collectDiagnostics"
+	// ShootTaskValidateResilience is a name for a Shoot task which is dedicated to validate the resilience of the shoot
+	// against disruptions of its control plane components.
+	ShootTaskValidateResilience = "validateResilience"
+	// ShootTaskPlanInfrastructure is a name for a Shoot task which is dedicated to compute the changes a reconciliation
+	// of the Infrastructure extension resource would perform without applying them.
+	ShootTaskPlanInfrastructure = "planInfrastructure"
+	// ShootTaskPreviewDeletion is a name for a Shoot task which is dedicated to compute which resources would be
+	// destroyed or retained when the Shoot is deleted.
+	ShootTaskPreviewDeletion = "previewDeletion"
 	// ShootOperationMaintain is a constant for an annotation on a Shoot indicating that the Shoot maintenance shall be
 	// executed as soon as possible.
 	ShootOperationMaintain = "maintain"
 	// ShootOperationRetry is a constant for an annotation on a Shoot indicating that a failed Shoot reconciliation shall be
 	// retried.
 	ShootOperationRetry = "retry"
+	// ShootOperationCollectDiagnostics is a constant for an annotation on a Shoot indicating that the effective
+	// configuration of the control plane components shall be collected into a diagnostic bundle.
+	ShootOperationCollectDiagnostics = "collect-diagnostics"
+	// ShootOperationValidateResilience is a constant for an annotation on a Shoot indicating that the VPN components,
+	// the kube-apiserver and one etcd member shall be disrupted deliberately while measuring the downtime of the shoot.
+	ShootOperationValidateResilience = "validate-resilience"
+	// ShootOperationPlan is a constant for an annotation on a Shoot indicating that the changes a reconciliation of the
+	// provider infrastructure would perform shall be computed and published in the Shoot status without applying them.
+	ShootOperationPlan = "plan"
+	// ShootOperationPreviewDeletion is a constant for an annotation on a Shoot indicating that the resources which would
+	// be destroyed or retained when the Shoot is deleted shall be computed and published in the Shoot status.
+	ShootOperationPreviewDeletion = "preview-deletion"
 	// OperationRotateCredentialsStart is a constant for an annotation indicating that the rotation of all credentials
 	// shall be started. This includes CAs, certificates, kubeconfigs, SSH keypairs, observability credentials, and
 	// ServiceAccount signing key.
@@ -435,6 +489,12 @@ const (
 
 	// LabelSecretBindingReference is used to identify secrets which are referred by a SecretBinding (not necessarily in the same namespace).
 	LabelSecretBindingReference = "reference.gardener.cloud/secretbinding"
+	// AnnotationSecretBindingShootCount is a constant for an annotation on a SecretBinding which contains the number of
+	// Shoots using the SecretBinding.
+	AnnotationSecretBindingShootCount = "secretbinding.gardener.cloud/shoot-count"
+	// AnnotationSecretBindingShoots is a constant for an annotation on a SecretBinding which contains the comma-separated
+	// names of the Shoots using the SecretBinding.
+	AnnotationSecretBindingShoots = "secretbinding.gardener.cloud/shoots"
 
 	// LabelExtensionExtensionTypePrefix is used to prefix extension label for extension types.
 	LabelExtensionExtensionTypePrefix = "extensions.extensions.gardener.cloud/"
@@ -571,6 +631,12 @@ const (
 	// AnnotationSchedulingCloudProfiles is a constant for an annotation key on a configmap which denotes
 	// the linked cloudprofiles containing the region distances.
 	AnnotationSchedulingCloudProfiles = "scheduling.gardener.cloud/cloudprofiles"
+	// SchedulingCloudProfilesAll is a constant for a value of the AnnotationSchedulingCloudProfiles annotation denoting
+	// that the region config applies to all cloudprofiles. Region configs linked to a specific cloudprofile take precedence.
+	SchedulingCloudProfilesAll = "*"
+	// AnnotationSchedulingUserRegion is a constant for an annotation key on a Shoot resource which denotes the region
+	// of the cluster's users. If set, it is used instead of the shoot's region to determine the distance to the seeds.
+	AnnotationSchedulingUserRegion = "scheduling.gardener.cloud/user-region"
 
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
@@ -612,8 +678,53 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootSkipUpgradePreflightChecks is a key for an annotation on a Shoot resource whose value must be set
+	// to "true" in order to allow an upgrade to the next Kubernetes minor version although the upgrade pre-flight checks
+	// reported blocking findings.
+	AnnotationShootSkipUpgradePreflightChecks = "shoot.gardener.cloud/skip-upgrade-preflight-checks"
+	// AnnotationShootDeprecatedAPIUsage is a key for an annotation on a Shoot resource which is maintained by gardenlet
+	// and lists the deprecated APIs which have been requested from the shoot's kube-apiserver.
+	AnnotationShootDeprecatedAPIUsage = "shoot.gardener.cloud/deprecated-api-usage"
+	// AnnotationShootCloneFrom is a key for an annotation on a Shoot resource which can only be set on creation and
+	// contains the name of another Shoot in the same project. The etcd of the new Shoot is restored from the latest
+	// backup of the referenced Shoot.
+	AnnotationShootCloneFrom = "shoot.gardener.cloud/clone-from"
+	// AnnotationShootBackupEncryptionKeySecretName is a key for an annotation on a Shoot resource which contains the name
+	// of a secret in the project namespace. The secret contains a customer-supplied key in its `key` data key which is
+	// used to encrypt the etcd backups of the shoot.
+	AnnotationShootBackupEncryptionKeySecretName = "backup.shoot.gardener.cloud/encryption-key-secret-name"
+	// AnnotationAuthenticationIssuer is a key for an annotation on a Shoot resource which can be set to "managed" in
+	// order to let Gardener manage the service account issuer of the shoot cluster, i.e., the issuer is located under the
+	// central hostname configured in the garden cluster and the OIDC discovery documents are written into a secret in
+	// the project namespace. Once set, the annotation cannot be removed or changed anymore.
+	AnnotationAuthenticationIssuer = "authentication.gardener.cloud/issuer"
+	// AnnotationAuthenticationIssuerManaged is a value for the AnnotationAuthenticationIssuer annotation which indicates
+	// that the service account issuer of the shoot cluster is managed by Gardener.
+	AnnotationAuthenticationIssuerManaged = "managed"
 	// AnnotationCoreDNSRewritingDisabled disables core dns query rewriting even if the corresponding feature gate is enabled.
 	AnnotationCoreDNSRewritingDisabled = "alpha.featuregates.shoot.gardener.cloud/core-dns-rewriting-disabled"
+	// AnnotationKubeAPIServerEndpointStaticIP is a key for an annotation on the load balancer Service of a dedicated
+	// kube-apiserver endpoint which requests a static IP address. Its value is the IP address which was reserved upfront,
+	// or empty if the provider extension shall allocate a static IP address. Provider extensions supporting static IP
+	// addresses translate it into their provider-specific load balancer configuration.
+	AnnotationKubeAPIServerEndpointStaticIP = "endpoint.kube-apiserver.gardener.cloud/static-ip"
+	// AnnotationKubeAPIServerEndpointPTRRecord is a key for an annotation on the load balancer Service of a dedicated
+	// kube-apiserver endpoint which contains the domain name of the reverse DNS (PTR) record to configure for its IP
+	// address.
+	AnnotationKubeAPIServerEndpointPTRRecord = "endpoint.kube-apiserver.gardener.cloud/ptr-record"
+	// LabelPolicyBundleName is a key for a label on policy bundle secrets in the garden cluster and on the
+	// ManagedResources deploying them to shoot clusters. Its value is the name of the policy bundle.
+	LabelPolicyBundleName = "policy-bundle.gardener.cloud/name"
+	// AnnotationPolicyBundleVersion is a key for an annotation on policy bundle secrets in the garden cluster and on the
+	// ManagedResources deploying them to shoot clusters. Its value is the version of the policy bundle.
+	AnnotationPolicyBundleVersion = "policy-bundle.gardener.cloud/version"
+	// AnnotationPolicyBundleProjects is a key for an annotation on policy bundle secrets in the garden cluster. Its value
+	// is a comma-separated list of project names the policy bundle is restricted to. If it is not set, the policy bundle
+	// is distributed to the shoots of all projects.
+	AnnotationPolicyBundleProjects = "policy-bundle.gardener.cloud/projects"
+	// AnnotationShootPolicyBundleVersionPrefix is the prefix for annotations on a Shoot resource which pin the version of
+	// a policy bundle. The suffix is the name of the policy bundle, the value is the version which shall be deployed.
+	AnnotationShootPolicyBundleVersionPrefix = "policy-bundle.shoot.gardener.cloud/"
 
 	// AnnotationSeccompDefaultProfile is the key for an annotation applied to a PodSecurityPolicy which specifies
 	// which is the default seccomp profile to apply to containers.
@@ -626,6 +737,12 @@ const (
 	// AnnotationPodSecurityEnforce is a constant for an annotation on `ControllerRegistration`s and `ControllerInstallation`s. When set the
 	// `extension` namespace is created with "pod-security.kubernetes.io/enforce" label set to AnnotationPodSecurityEnforce's value.
 	AnnotationPodSecurityEnforce = "security.gardener.cloud/pod-security-enforce"
+	// AnnotationProfilingScrape is a constant for an annotation on pods. When set to "true", the pprof endpoints of the
+	// pod are periodically scraped by the continuous profiling component in the seed.
+	AnnotationProfilingScrape = "profiling.gardener.cloud/scrape"
+	// AnnotationProfilingPort is a constant for an annotation on pods which specifies the port serving the pprof
+	// endpoints (under the '/debug/pprof' path).
+	AnnotationProfilingPort = "profiling.gardener.cloud/port"
 	// OperatingSystemConfigUnitNameKubeletService is a constant for a unit in the operating system config that contains the kubelet service.
 	OperatingSystemConfigUnitNameKubeletService = "kubelet.service"
 	// OperatingSystemConfigUnitNameContainerDService is a constant for a unit in the operating system config that contains the containerd service.
@@ -666,10 +783,17 @@ const (
 	LabelWorkerPoolDeprecated = "worker.garden.sapcloud.io/group"
 	// LabelWorkerPoolSystemComponents is a constant that indicates whether the worker pool should host system components
 	LabelWorkerPoolSystemComponents = "worker.gardener.cloud/system-components"
+	// LabelTopologyZoneGroup is a constant for a label that indicates the group of zones the node belongs to.
+	LabelTopologyZoneGroup = "topology.gardener.cloud/zone-group"
+	// LabelTopologyDedicatedToProject is a constant for a label that indicates the project the node is dedicated to.
+	LabelTopologyDedicatedToProject = "topology.gardener.cloud/dedicated-to-project"
 
 	// EventResourceReferenced indicates that the resource deletion is in waiting mode because the resource is still
 	// being referenced by at least one other resource (e.g. a SecretBinding is still referenced by a Shoot)
 	EventResourceReferenced = "ResourceReferenced"
+	// EventDeprecatedTypesInUse indicates that Shoots still use machine or volume types which are deprecated in the
+	// CloudProfile.
+	EventDeprecatedTypesInUse = "DeprecatedTypesInUse"
 
 	// ReferencedResourcesPrefix is the prefix used when copying referenced resources to the Shoot namespace in the Seed,
 	// to avoid naming collisions with resources managed by Gardener.
@@ -708,6 +832,10 @@ const (
 	// skipped by the stale project controller. If the project has already configured stale timestamps in its status
 	// then they will be reset.
 	ProjectSkipStaleCheck = "project.gardener.cloud/skip-stale-check"
+	// ProjectCriticalVulnerabilityUpdates is the key of an annotation on a project which opts in the shoots of this
+	// project for forceful updates of machine image versions affected by critical vulnerabilities, even outside of
+	// their maintenance time window.
+	ProjectCriticalVulnerabilityUpdates = "project.gardener.cloud/critical-vulnerability-updates"
 	// NamespaceProject is the key of an annotation on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.gardener.cloud/project"
 	// NamespaceKeepAfterProjectDeletion is a constant for an annotation on a `Namespace` resource that states that it
@@ -728,6 +856,18 @@ const (
 	BackupSecretName string = "etcd-backup"
 	// DataKeyBackupBucketName is the name of a data key whose value contains the backup bucket name.
 	DataKeyBackupBucketName string = "bucketName"
+	// DataKeyBackupEncryptionKey is the name of a data key whose value contains a customer-supplied key used to encrypt
+	// backups.
+	DataKeyBackupEncryptionKey string = "key"
+	// DataKeyBackupPreviousEncryptionKey is the name of a data key whose value contains the customer-supplied key which
+	// was used to encrypt backups before the last key rotation.
+	DataKeyBackupPreviousEncryptionKey string = "previousKey"
+	// DataKeyEtcdBackupEncryptionKey is the name of a data key in the etcd backup secret whose value contains a
+	// customer-supplied key used to encrypt the etcd snapshots.
+	DataKeyEtcdBackupEncryptionKey string = "encryptionKey"
+	// DataKeyEtcdBackupPreviousEncryptionKey is the name of a data key in the etcd backup secret whose value contains the
+	// customer-supplied key which was used to encrypt the etcd snapshots before the last key rotation.
+	DataKeyEtcdBackupPreviousEncryptionKey string = "previousEncryptionKey"
 	// BackupSourcePrefix is the prefix for names of resources related to source backupentries when copying backups.
 	BackupSourcePrefix = "source"
 
@@ -738,6 +878,8 @@ const (
 	DNSRecordInternalName = "internal"
 	// DNSRecordExternalName is a constant for DNSRecord objects used for the external domain name.
 	DNSRecordExternalName = "external"
+	// DNSRecordPrivateName is a constant for DNSRecord objects used for the private domain name.
+	DNSRecordPrivateName = "private"
 
 	// ArchitectureAMD64 is a constant for the 'amd64' architecture.
 	ArchitectureAMD64 = "amd64"
@@ -846,6 +988,8 @@ const (
 
 	// TaintNodeCriticalComponentsNotReady is the key for the gardener-managed node components taint.
 	TaintNodeCriticalComponentsNotReady = "node.gardener.cloud/critical-components-not-ready"
+	// TaintWorkerPoolSystemComponentsOnly is the key for the taint of worker pool nodes dedicated to system components.
+	TaintWorkerPoolSystemComponentsOnly = "worker.gardener.cloud/system-components-only"
 	// LabelNodeCriticalComponent is the label key for marking node-critical component pods.
 	LabelNodeCriticalComponent = "node.gardener.cloud/critical-component"
 	// AnnotationPrefixWaitForCSINode is the annotation key for csi-driver-node pods, indicating they use the driver
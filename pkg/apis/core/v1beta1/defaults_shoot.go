@@ -241,6 +241,17 @@ func SetDefaults_Networking(obj *Networking) {
 	}
 }
 
+// SetDefaults_VPNHighThroughput sets default values for VPNHighThroughput objects.
+func SetDefaults_VPNHighThroughput(obj *VPNHighThroughput) {
+	if obj.MinTunnels == nil {
+		v := DefaultVPNHighThroughputMinTunnels
+		obj.MinTunnels = &v
+	}
+	if obj.MaxTunnels == nil {
+		obj.MaxTunnels = pointer.Int32(*obj.MinTunnels)
+	}
+}
+
 // SetDefaults_Maintenance sets default values for Maintenance objects.
 func SetDefaults_Maintenance(obj *Maintenance) {
 	if obj.TimeWindow == nil {
@@ -297,6 +308,25 @@ func SetDefaults_Worker(obj *Worker) {
 			Allow: DefaultWorkerSystemComponentsAllow,
 		}
 	}
+	if obj.MachineControllerManagerSettings != nil && obj.MachineControllerManagerSettings.StuckMachinePolicy != nil {
+		if obj.MachineControllerManagerSettings.StuckMachinePolicy.Action == "" {
+			obj.MachineControllerManagerSettings.StuckMachinePolicy.Action = StuckMachineActionKeepRetrying
+		}
+		if obj.MachineControllerManagerSettings.StuckMachinePolicy.FailureThreshold == nil {
+			obj.MachineControllerManagerSettings.StuckMachinePolicy.FailureThreshold = pointer.Int32(3)
+		}
+	}
+	if obj.OperatingSystemConfigRollout != nil && obj.OperatingSystemConfigRollout.HealthTimeout == nil {
+		obj.OperatingSystemConfigRollout.HealthTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.ZoneRebalancing != nil {
+		if obj.ZoneRebalancing.FailureThreshold == nil {
+			obj.ZoneRebalancing.FailureThreshold = pointer.Int32(3)
+		}
+		if obj.ZoneRebalancing.RecoveryPeriod == nil {
+			obj.ZoneRebalancing.RecoveryPeriod = &metav1.Duration{Duration: 30 * time.Minute}
+		}
+	}
 }
 
 // SetDefaults_ClusterAutoscaler sets default values for ClusterAutoscaler object.
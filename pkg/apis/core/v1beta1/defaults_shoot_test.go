@@ -315,6 +315,96 @@ var _ = Describe("Shoot defaulting", func() {
 		})
 	})
 
+	Describe("Worker stuck machine policy", func() {
+		It("should not default the stuck machine policy if it is not set", func() {
+			obj.Spec.Provider.Workers = []Worker{{MachineControllerManagerSettings: &MachineControllerManagerSettings{}}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].MachineControllerManagerSettings.StuckMachinePolicy).To(BeNil())
+		})
+
+		It("should default the action and the failure threshold", func() {
+			obj.Spec.Provider.Workers = []Worker{{MachineControllerManagerSettings: &MachineControllerManagerSettings{StuckMachinePolicy: &StuckMachinePolicy{}}}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].MachineControllerManagerSettings.StuckMachinePolicy).To(Equal(&StuckMachinePolicy{
+				Action:           StuckMachineActionKeepRetrying,
+				FailureThreshold: pointer.Int32(3),
+			}))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.Spec.Provider.Workers = []Worker{{MachineControllerManagerSettings: &MachineControllerManagerSettings{StuckMachinePolicy: &StuckMachinePolicy{
+				Action:           StuckMachineActionPausePool,
+				FailureThreshold: pointer.Int32(5),
+			}}}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].MachineControllerManagerSettings.StuckMachinePolicy).To(Equal(&StuckMachinePolicy{
+				Action:           StuckMachineActionPausePool,
+				FailureThreshold: pointer.Int32(5),
+			}))
+		})
+	})
+
+	Describe("Worker operating system config rollout", func() {
+		It("should not default the rollout settings if they are not set", func() {
+			obj.Spec.Provider.Workers = []Worker{{}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].OperatingSystemConfigRollout).To(BeNil())
+		})
+
+		It("should default the health timeout", func() {
+			obj.Spec.Provider.Workers = []Worker{{OperatingSystemConfigRollout: &OperatingSystemConfigRollout{CanaryNodes: 1}}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].OperatingSystemConfigRollout).To(Equal(&OperatingSystemConfigRollout{
+				CanaryNodes:   1,
+				HealthTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			}))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.Spec.Provider.Workers = []Worker{{OperatingSystemConfigRollout: &OperatingSystemConfigRollout{CanaryNodes: 2, HealthTimeout: &metav1.Duration{Duration: time.Minute}}}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].OperatingSystemConfigRollout).To(Equal(&OperatingSystemConfigRollout{
+				CanaryNodes:   2,
+				HealthTimeout: &metav1.Duration{Duration: time.Minute},
+			}))
+		})
+	})
+
+	Describe("Worker zone rebalancing", func() {
+		It("should not default the zone rebalancing settings if they are not set", func() {
+			obj.Spec.Provider.Workers = []Worker{{}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].ZoneRebalancing).To(BeNil())
+		})
+
+		It("should default the failure threshold and recovery period", func() {
+			obj.Spec.Provider.Workers = []Worker{{ZoneRebalancing: &WorkerZoneRebalancing{}}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].ZoneRebalancing).To(Equal(&WorkerZoneRebalancing{
+				FailureThreshold: pointer.Int32(3),
+				RecoveryPeriod:   &metav1.Duration{Duration: 30 * time.Minute},
+			}))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.Spec.Provider.Workers = []Worker{{ZoneRebalancing: &WorkerZoneRebalancing{FailureThreshold: pointer.Int32(5), RecoveryPeriod: &metav1.Duration{Duration: time.Hour}}}}
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].ZoneRebalancing).To(Equal(&WorkerZoneRebalancing{
+				FailureThreshold: pointer.Int32(5),
+				RecoveryPeriod:   &metav1.Duration{Duration: time.Hour},
+			}))
+		})
+	})
+
 	Describe("Purpose defaulting", func() {
 		It("should default purpose field", func() {
 			obj.Spec.Purpose = nil
@@ -536,6 +626,43 @@ var _ = Describe("Shoot defaulting", func() {
 		})
 	})
 
+	Describe("VPN high-throughput defaulting", func() {
+		It("should not default the VPN settings if not configured", func() {
+			obj.Spec.ControlPlane = &ControlPlane{}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.ControlPlane.VPN).To(BeNil())
+		})
+
+		It("should default the number of tunnels", func() {
+			obj.Spec.ControlPlane = &ControlPlane{VPN: &ControlPlaneVPN{HighThroughput: &VPNHighThroughput{}}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.ControlPlane.VPN.HighThroughput.MinTunnels).To(PointTo(Equal(int32(2))))
+			Expect(obj.Spec.ControlPlane.VPN.HighThroughput.MaxTunnels).To(PointTo(Equal(int32(2))))
+		})
+
+		It("should default the maximum number of tunnels to the minimum number of tunnels", func() {
+			obj.Spec.ControlPlane = &ControlPlane{VPN: &ControlPlaneVPN{HighThroughput: &VPNHighThroughput{MinTunnels: pointer.Int32(3)}}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.ControlPlane.VPN.HighThroughput.MinTunnels).To(PointTo(Equal(int32(3))))
+			Expect(obj.Spec.ControlPlane.VPN.HighThroughput.MaxTunnels).To(PointTo(Equal(int32(3))))
+		})
+
+		It("should not overwrite the already set values", func() {
+			obj.Spec.ControlPlane = &ControlPlane{VPN: &ControlPlaneVPN{HighThroughput: &VPNHighThroughput{MinTunnels: pointer.Int32(1), MaxTunnels: pointer.Int32(4)}}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.ControlPlane.VPN.HighThroughput.MinTunnels).To(PointTo(Equal(int32(1))))
+			Expect(obj.Spec.ControlPlane.VPN.HighThroughput.MaxTunnels).To(PointTo(Equal(int32(4))))
+		})
+	})
+
 	Describe("Maintenance defaulting", func() {
 		BeforeEach(func() {
 			obj.Spec.Maintenance = nil
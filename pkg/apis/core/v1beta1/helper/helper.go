@@ -357,6 +357,11 @@ func ShootUsesUnmanagedDNS(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Spec.DNS != nil && len(shoot.Spec.DNS.Providers) > 0 && shoot.Spec.DNS.Providers[0].Type != nil && *shoot.Spec.DNS.Providers[0].Type == "unmanaged"
 }
 
+// ShootUsesManagedServiceAccountIssuer returns true if the service account issuer of the shoot is managed by Gardener.
+func ShootUsesManagedServiceAccountIssuer(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Annotations[v1beta1constants.AnnotationAuthenticationIssuer] == v1beta1constants.AnnotationAuthenticationIssuerManaged
+}
+
 // ShootNeedsForceDeletion determines whether a Shoot should be force deleted or not.
 func ShootNeedsForceDeletion(shoot *gardencorev1beta1.Shoot) bool {
 	if shoot == nil {
@@ -410,6 +415,24 @@ func SeedSettingTopologyAwareRoutingEnabled(settings *gardencorev1beta1.SeedSett
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingControlPlanePriorityTierForPurpose returns the control plane priority tier of the seed settings which
+// applies to shoots with the given purpose. It returns nil if no tier is configured for the purpose.
+func SeedSettingControlPlanePriorityTierForPurpose(settings *gardencorev1beta1.SeedSettings, purpose gardencorev1beta1.ShootPurpose) *gardencorev1beta1.SeedSettingControlPlanePriorityTier {
+	if settings == nil || settings.ControlPlanePriority == nil {
+		return nil
+	}
+
+	for _, tier := range settings.ControlPlanePriority.Tiers {
+		for _, p := range tier.Purposes {
+			if p == purpose {
+				return tier.DeepCopy()
+			}
+		}
+	}
+
+	return nil
+}
+
 // DetermineMachineImageForName finds the cloud specific machine images in the <cloudProfile> for the given <name> and
 // region. In case it does not find the machine image with the <name>, it returns false. Otherwise, true and the
 // cloud-specific machine image will be returned.
@@ -472,11 +495,45 @@ func FindMachineTypeByName(machines []gardencorev1beta1.MachineType, name string
 	return nil
 }
 
+// UnsatisfiedMachineTypeRequirements returns the names of the given machine <requirements> which are not satisfied by
+// the given <machineType>.
+func UnsatisfiedMachineTypeRequirements(requirements *gardencorev1beta1.MachineRequirements, machineType gardencorev1beta1.MachineType) []string {
+	if requirements == nil {
+		return nil
+	}
+
+	var (
+		unsatisfied  []string
+		capabilities = machineType.Capabilities
+	)
+
+	if capabilities == nil {
+		capabilities = &gardencorev1beta1.MachineCapabilities{}
+	}
+
+	if pointer.BoolDeref(requirements.GPU, false) && machineType.GPU.IsZero() {
+		unsatisfied = append(unsatisfied, "gpu")
+	}
+	if pointer.BoolDeref(requirements.ConfidentialComputing, false) && !pointer.BoolDeref(capabilities.ConfidentialComputing, false) {
+		unsatisfied = append(unsatisfied, "confidentialComputing")
+	}
+	if pointer.BoolDeref(requirements.NestedVirtualization, false) && !pointer.BoolDeref(capabilities.NestedVirtualization, false) {
+		unsatisfied = append(unsatisfied, "nestedVirtualization")
+	}
+
+	return unsatisfied
+}
+
 // SystemComponentsAllowed checks if the given worker allows system components to be scheduled onto it
 func SystemComponentsAllowed(worker *gardencorev1beta1.Worker) bool {
 	return worker.SystemComponents == nil || worker.SystemComponents.Allow
 }
 
+// SystemComponentsOnly checks if the given worker is dedicated to system components.
+func SystemComponentsOnly(worker *gardencorev1beta1.Worker) bool {
+	return SystemComponentsAllowed(worker) && worker.SystemComponents != nil && pointer.BoolDeref(worker.SystemComponents.Only, false)
+}
+
 // KubernetesVersionExistsInCloudProfile checks if the given Kubernetes version exists in the CloudProfile
 func KubernetesVersionExistsInCloudProfile(cloudProfile *gardencorev1beta1.CloudProfile, currentVersion string) (bool, gardencorev1beta1.ExpirableVersion, error) {
 	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
@@ -1017,6 +1074,11 @@ func NginxIngressEnabled(addons *gardencorev1beta1.Addons) bool {
 	return addons != nil && addons.NginxIngress != nil && addons.NginxIngress.Enabled
 }
 
+// IsSystemComponentUserProvided returns true if the given system component is provided by the user instead of Gardener.
+func IsSystemComponentUserProvided(systemComponents *gardencorev1beta1.SystemComponents, component gardencorev1beta1.UserProvidedSystemComponent) bool {
+	return systemComponents != nil && slices.Contains(systemComponents.UserProvided, component)
+}
+
 // KubeProxyEnabled returns true if the kube-proxy is enabled in the Shoot manifest.
 func KubeProxyEnabled(config *gardencorev1beta1.KubeProxyConfig) bool {
 	return config != nil && config.Enabled != nil && *config.Enabled
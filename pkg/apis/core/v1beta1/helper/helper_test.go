@@ -25,6 +25,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
@@ -244,6 +245,25 @@ var _ = Describe("Helper", func() {
 		Entry("worker found", []gardencorev1beta1.MachineType{{Name: "foo"}}, "foo", &gardencorev1beta1.MachineType{Name: "foo"}),
 	)
 
+	DescribeTable("#UnsatisfiedMachineTypeRequirements",
+		func(requirements *gardencorev1beta1.MachineRequirements, machineType gardencorev1beta1.MachineType, expected []string) {
+			Expect(UnsatisfiedMachineTypeRequirements(requirements, machineType)).To(Equal(expected))
+		},
+
+		Entry("no requirements", nil, gardencorev1beta1.MachineType{}, nil),
+		Entry("requirements not set to true", &gardencorev1beta1.MachineRequirements{GPU: pointer.Bool(false)}, gardencorev1beta1.MachineType{}, nil),
+		Entry("all requirements unsatisfied",
+			&gardencorev1beta1.MachineRequirements{GPU: pointer.Bool(true), ConfidentialComputing: pointer.Bool(true), NestedVirtualization: pointer.Bool(true)},
+			gardencorev1beta1.MachineType{Capabilities: &gardencorev1beta1.MachineCapabilities{ConfidentialComputing: pointer.Bool(false)}},
+			[]string{"gpu", "confidentialComputing", "nestedVirtualization"},
+		),
+		Entry("all requirements satisfied",
+			&gardencorev1beta1.MachineRequirements{GPU: pointer.Bool(true), ConfidentialComputing: pointer.Bool(true), NestedVirtualization: pointer.Bool(true)},
+			gardencorev1beta1.MachineType{GPU: resource.MustParse("1"), Capabilities: &gardencorev1beta1.MachineCapabilities{ConfidentialComputing: pointer.Bool(true), NestedVirtualization: pointer.Bool(true)}},
+			nil,
+		),
+	)
+
 	DescribeTable("#TaintsHave",
 		func(taints []gardencorev1beta1.SeedTaint, key string, expectation bool) {
 			Expect(TaintsHave(taints, key)).To(Equal(expectation))
@@ -504,6 +524,17 @@ var _ = Describe("Helper", func() {
 		Entry("systemComponents.allowed = true", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true}}, true),
 	)
 
+	DescribeTable("#SystemComponentsOnly",
+		func(worker *gardencorev1beta1.Worker, systemComponentsOnly bool) {
+			Expect(SystemComponentsOnly(worker)).To(Equal(systemComponentsOnly))
+		},
+		Entry("no systemComponents section", &gardencorev1beta1.Worker{}, false),
+		Entry("systemComponents.only not set", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true}}, false),
+		Entry("systemComponents.only = false", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true, Only: &falseVar}}, false),
+		Entry("systemComponents.only = true", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true, Only: &trueVar}}, true),
+		Entry("systemComponents.only = true but not allowed", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: false, Only: &trueVar}}, false),
+	)
+
 	DescribeTable("#HibernationIsEnabled",
 		func(shoot *gardencorev1beta1.Shoot, hibernated bool) {
 			Expect(HibernationIsEnabled(shoot)).To(Equal(hibernated))
@@ -598,6 +629,21 @@ var _ = Describe("Helper", func() {
 		Entry("dns providers and unmanaged type", &gardencorev1beta1.DNS{Providers: []gardencorev1beta1.DNSProvider{{Type: &unmanagedType}}}, true),
 	)
 
+	DescribeTable("#ShootUsesManagedServiceAccountIssuer",
+		func(annotations map[string]string, expectation bool) {
+			shoot := &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: annotations,
+				},
+			}
+			Expect(ShootUsesManagedServiceAccountIssuer(shoot)).To(Equal(expectation))
+		},
+
+		Entry("no annotations", nil, false),
+		Entry("other annotation value", map[string]string{"authentication.gardener.cloud/issuer": "foo"}, false),
+		Entry("managed issuer", map[string]string{"authentication.gardener.cloud/issuer": "managed"}, true),
+	)
+
 	DescribeTable("#ShootNeedsForceDeletion",
 		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
 			Expect(ShootNeedsForceDeletion(shoot)).To(match)
@@ -695,6 +741,24 @@ var _ = Describe("Helper", func() {
 		Entry("topology-aware routing disabled", &gardencorev1beta1.SeedSettings{TopologyAwareRouting: &gardencorev1beta1.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingControlPlanePriorityTierForPurpose",
+		func(settings *gardencorev1beta1.SeedSettings, purpose gardencorev1beta1.ShootPurpose, expected *gardencorev1beta1.SeedSettingControlPlanePriorityTier) {
+			Expect(SeedSettingControlPlanePriorityTierForPurpose(settings, purpose)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, gardencorev1beta1.ShootPurposeProduction, nil),
+		Entry("no control plane priority setting", &gardencorev1beta1.SeedSettings{}, gardencorev1beta1.ShootPurposeProduction, nil),
+		Entry("no matching tier", &gardencorev1beta1.SeedSettings{ControlPlanePriority: &gardencorev1beta1.SeedSettingControlPlanePriority{Tiers: []gardencorev1beta1.SeedSettingControlPlanePriorityTier{
+			{Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation}, ETCD: pointer.String("gardener-system-100")},
+		}}}, gardencorev1beta1.ShootPurposeProduction, nil),
+		Entry("matching tier", &gardencorev1beta1.SeedSettings{ControlPlanePriority: &gardencorev1beta1.SeedSettingControlPlanePriority{Tiers: []gardencorev1beta1.SeedSettingControlPlanePriorityTier{
+			{Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation}, ETCD: pointer.String("gardener-system-100")},
+			{Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeDevelopment, gardencorev1beta1.ShootPurposeProduction}, ETCD: pointer.String("gardener-system-500")},
+		}}}, gardencorev1beta1.ShootPurposeProduction, &gardencorev1beta1.SeedSettingControlPlanePriorityTier{
+			Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeDevelopment, gardencorev1beta1.ShootPurposeProduction}, ETCD: pointer.String("gardener-system-500"),
+		}),
+	)
+
 	Describe("#FindMachineImageVersion", func() {
 		var machineImages []gardencorev1beta1.MachineImage
 
@@ -2420,6 +2484,17 @@ var _ = Describe("Helper", func() {
 		Entry("nginxIngress enabled", &gardencorev1beta1.Addons{NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}}}, BeTrue()),
 	)
 
+	DescribeTable("#IsSystemComponentUserProvided",
+		func(systemComponents *gardencorev1beta1.SystemComponents, matcher gomegatypes.GomegaMatcher) {
+			Expect(IsSystemComponentUserProvided(systemComponents, gardencorev1beta1.UserProvidedSystemComponentMetricsServer)).To(matcher)
+		},
+
+		Entry("systemComponents nil", nil, BeFalse()),
+		Entry("userProvided empty", &gardencorev1beta1.SystemComponents{}, BeFalse()),
+		Entry("other component user-provided", &gardencorev1beta1.SystemComponents{UserProvided: []gardencorev1beta1.UserProvidedSystemComponent{gardencorev1beta1.UserProvidedSystemComponentKubeProxy}}, BeFalse()),
+		Entry("component user-provided", &gardencorev1beta1.SystemComponents{UserProvided: []gardencorev1beta1.UserProvidedSystemComponent{gardencorev1beta1.UserProvidedSystemComponentKubeProxy, gardencorev1beta1.UserProvidedSystemComponentMetricsServer}}, BeTrue()),
+	)
+
 	DescribeTable("#KubeProxyEnabled",
 		func(kubeProxy *gardencorev1beta1.KubeProxyConfig, matcher gomegatypes.GomegaMatcher) {
 			Expect(KubeProxyEnabled(kubeProxy)).To(matcher)
@@ -58,6 +58,16 @@ type BackupEntrySpec struct {
 	// SeedName holds the name of the seed to which this BackupEntry is scheduled
 	// +optional
 	SeedName *string `json:"seedName,omitempty" protobuf:"bytes,2,opt,name=seedName"`
+	// Encryption contains the configuration for encrypting the backups with a customer-supplied key.
+	// +optional
+	Encryption *BackupEntryEncryption `json:"encryption,omitempty" protobuf:"bytes,3,opt,name=encryption"`
+}
+
+// BackupEntryEncryption contains the configuration for encrypting the backups with a customer-supplied key.
+type BackupEntryEncryption struct {
+	// SecretName is the name of a secret in the namespace of the BackupEntry which contains the encryption key in its
+	// `key` data key.
+	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
 }
 
 // BackupEntryStatus holds the most recently observed status of the Backup Entry.
@@ -79,4 +89,16 @@ type BackupEntryStatus struct {
 	// MigrationStartTime is the time when a migration to a different seed was initiated.
 	// +optional
 	MigrationStartTime *metav1.Time `json:"migrationStartTime,omitempty" protobuf:"bytes,5,opt,name=migrationStartTime"`
+	// Encryption contains the status of the encryption of the backups with a customer-supplied key.
+	// +optional
+	Encryption *BackupEntryEncryptionStatus `json:"encryption,omitempty" protobuf:"bytes,6,opt,name=encryption"`
+}
+
+// BackupEntryEncryptionStatus contains the status of the encryption of the backups with a customer-supplied key.
+type BackupEntryEncryptionStatus struct {
+	// KeyChecksum is the checksum of the encryption key which is currently used for new backups.
+	KeyChecksum string `json:"keyChecksum" protobuf:"bytes,1,opt,name=keyChecksum"`
+	// LastRotationTime is the time when the encryption key was rotated the last time.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty" protobuf:"bytes,2,opt,name=lastRotationTime"`
 }
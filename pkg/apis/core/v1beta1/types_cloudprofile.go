@@ -15,6 +15,7 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -137,6 +138,23 @@ type MachineImageVersion struct {
 	// - '< 1.26' - supports only kubelet versions less than 1.26
 	// +optional
 	KubeletVersionConstraint *string `json:"kubeletVersionConstraint,omitempty" protobuf:"bytes,4,opt,name=kubeletVersionConstraint"`
+	// Vulnerabilities is a list of known vulnerabilities (e.g., CVEs) affecting the machine image in this version.
+	// Versions affected by critical vulnerabilities are not considered for automatic updates. Shoots using such versions
+	// are forcefully updated if their project opted in for critical vulnerability updates.
+	// +optional
+	Vulnerabilities []MachineImageVulnerability `json:"vulnerabilities,omitempty" protobuf:"bytes,5,rep,name=vulnerabilities"`
+	// Capabilities are the capabilities supported by the machine image in this version. Worker pools requiring a
+	// capability can only use machine image versions supporting it.
+	// +optional
+	Capabilities *MachineCapabilities `json:"capabilities,omitempty" protobuf:"bytes,6,opt,name=capabilities"`
+}
+
+// MachineImageVulnerability contains information about a vulnerability affecting a machine image version.
+type MachineImageVulnerability struct {
+	// ID is the identifier of the vulnerability, e.g., `CVE-2023-12345`.
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Severity is the severity of the vulnerability. Possible values are `critical`, `high`, `medium`, and `low`.
+	Severity VulnerabilitySeverity `json:"severity" protobuf:"bytes,2,opt,name=severity,casttype=VulnerabilitySeverity"`
 }
 
 // ExpirableVersion contains a version and an expiration date.
@@ -170,6 +188,46 @@ type MachineType struct {
 	// Architecture is the CPU architecture of this machine type.
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,7,opt,name=architecture"`
+	// GPUResourceName is the name of the extended resource under which nodes of this machine type advertise their GPUs
+	// (e.g., `nvidia.com/gpu`). It is used by the cluster-autoscaler when scaling worker pools from zero. Defaults to
+	// `gpu` if not set.
+	// +optional
+	GPUResourceName *string `json:"gpuResourceName,omitempty" protobuf:"bytes,8,opt,name=gpuResourceName"`
+	// ExtendedResources are additional extended resources which nodes of this machine type advertise. They are used by
+	// the cluster-autoscaler when scaling worker pools from zero.
+	// +optional
+	ExtendedResources corev1.ResourceList `json:"extendedResources,omitempty" protobuf:"bytes,9,rep,name=extendedResources,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// NodeLabels are labels which nodes of this machine type carry (e.g., the GPU model). They are used by the
+	// cluster-autoscaler when scaling worker pools from zero.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty" protobuf:"bytes,10,rep,name=nodeLabels"`
+	// Deprecation marks the machine type as deprecated. New worker pools using it are warned about, existing worker pools
+	// may be migrated to the successor during the maintenance time window.
+	// +optional
+	Deprecation *TypeDeprecation `json:"deprecation,omitempty" protobuf:"bytes,11,opt,name=deprecation"`
+	// Capabilities are the capabilities of this machine type. Worker pools requiring a capability can only use machine
+	// types offering it.
+	// +optional
+	Capabilities *MachineCapabilities `json:"capabilities,omitempty" protobuf:"bytes,12,opt,name=capabilities"`
+}
+
+// MachineCapabilities contains the capabilities of a machine type or machine image version.
+type MachineCapabilities struct {
+	// ConfidentialComputing states whether confidential computing (e.g., memory encryption of the virtual machine) is
+	// supported.
+	// +optional
+	ConfidentialComputing *bool `json:"confidentialComputing,omitempty" protobuf:"varint,1,opt,name=confidentialComputing"`
+	// NestedVirtualization states whether nested virtualization is supported.
+	// +optional
+	NestedVirtualization *bool `json:"nestedVirtualization,omitempty" protobuf:"varint,2,opt,name=nestedVirtualization"`
+}
+
+// TypeDeprecation marks a machine or volume type as deprecated.
+type TypeDeprecation struct {
+	// Successor is the name of the type which shall be used instead of the deprecated type. It must refer to a
+	// non-deprecated type of the same kind in the cloud profile.
+	// +optional
+	Successor *string `json:"successor,omitempty" protobuf:"bytes,1,opt,name=successor"`
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -227,6 +285,10 @@ type VolumeType struct {
 	// MinSize is the minimal supported storage size.
 	// +optional
 	MinSize *resource.Quantity `json:"minSize,omitempty" protobuf:"bytes,4,opt,name=minSize"`
+	// Deprecation marks the volume type as deprecated. New worker pools using it are warned about, existing worker pools
+	// may be migrated to the successor during the maintenance time window.
+	// +optional
+	Deprecation *TypeDeprecation `json:"deprecation,omitempty" protobuf:"bytes,5,opt,name=deprecation"`
 }
 
 const (
@@ -265,3 +327,18 @@ const (
 	// UpdateStrategyMajor indicates that auto-updates are performed always to the overall latest version.
 	UpdateStrategyMajor MachineImageUpdateStrategy = "major"
 )
+
+// VulnerabilitySeverity is the severity of a vulnerability.
+type VulnerabilitySeverity string
+
+const (
+	// VulnerabilitySeverityCritical indicates a critical vulnerability. Machine image versions affected by critical
+	// vulnerabilities may be updated outside of the maintenance time window.
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "critical"
+	// VulnerabilitySeverityHigh indicates a vulnerability with high severity.
+	VulnerabilitySeverityHigh VulnerabilitySeverity = "high"
+	// VulnerabilitySeverityMedium indicates a vulnerability with medium severity.
+	VulnerabilitySeverityMedium VulnerabilitySeverity = "medium"
+	// VulnerabilitySeverityLow indicates a vulnerability with low severity.
+	VulnerabilitySeverityLow VulnerabilitySeverity = "low"
+)
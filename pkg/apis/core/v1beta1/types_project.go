@@ -100,6 +100,11 @@ type ProjectStatus struct {
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	// +optional
 	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastActivityTimestamp"`
+	// Conditions represents the latest available observations of the project's lifecycle.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,6,rep,name=conditions"`
 }
 
 // ProjectMember is a member of a project.
@@ -156,6 +161,15 @@ const (
 	ProjectMemberExtensionPrefix = "extension:"
 )
 
+const (
+	// ProjectInactive is a condition type indicating that the project has not been used for a certain time. Its reason
+	// reflects the stage of the project's lifecycle.
+	ProjectInactive ConditionType = "Inactive"
+	// ProjectArchived is a condition type indicating that the project has been archived because of its inactivity. No
+	// new shoots can be created in archived projects.
+	ProjectArchived ConditionType = "Archived"
+)
+
 // ProjectPhase is a label for the condition of a project at the current time.
 type ProjectPhase string
 
@@ -179,4 +193,11 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventInactive indicates that the project has been inactive for a certain time and that the action of the
+	// project lifecycle policy will be executed soon.
+	ProjectEventInactive = "ProjectInactive"
+	// ProjectEventArchived indicates that the project has been archived because of its inactivity.
+	ProjectEventArchived = "ProjectArchived"
+	// ProjectEventUnarchived indicates that an archived project has been reactivated.
+	ProjectEventUnarchived = "ProjectUnarchived"
 )
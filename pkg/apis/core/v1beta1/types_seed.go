@@ -263,6 +263,9 @@ type SeedSettings struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	// +optional
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting `json:"topologyAwareRouting,omitempty" protobuf:"bytes,8,opt,name=topologyAwareRouting"`
+	// ControlPlanePriority controls the priority classes of shoot control plane components in the seed.
+	// +optional
+	ControlPlanePriority *SeedSettingControlPlanePriority `json:"controlPlanePriority,omitempty" protobuf:"bytes,9,opt,name=controlPlanePriority"`
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.
@@ -374,6 +377,30 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
 }
 
+// SeedSettingControlPlanePriority controls the priority classes of shoot control plane components in the seed.
+type SeedSettingControlPlanePriority struct {
+	// Tiers is a list of priority tiers which are assigned to shoots based on their purpose. Shoots whose purpose is
+	// not listed in any tier use the default priority classes.
+	// +optional
+	Tiers []SeedSettingControlPlanePriorityTier `json:"tiers,omitempty" protobuf:"bytes,1,rep,name=tiers"`
+}
+
+// SeedSettingControlPlanePriorityTier describes the priority classes of shoot control plane components for a set of
+// shoot purposes.
+type SeedSettingControlPlanePriorityTier struct {
+	// Purposes is the list of shoot purposes this tier applies to.
+	Purposes []ShootPurpose `json:"purposes" protobuf:"bytes,1,rep,name=purposes,casttype=ShootPurpose"`
+	// ETCD is the name of the priority class used for the etcd pods of the shoots.
+	// +optional
+	ETCD *string `json:"etcd,omitempty" protobuf:"bytes,2,opt,name=etcd"`
+	// KubeAPIServer is the name of the priority class used for the kube-apiserver pods of the shoots.
+	// +optional
+	KubeAPIServer *string `json:"kubeAPIServer,omitempty" protobuf:"bytes,3,opt,name=kubeAPIServer"`
+	// KubeControllerManager is the name of the priority class used for the kube-controller-manager pods of the shoots.
+	// +optional
+	KubeControllerManager *string `json:"kubeControllerManager,omitempty" protobuf:"bytes,4,opt,name=kubeControllerManager"`
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -91,6 +91,9 @@ type ShootSpec struct {
 	// operations should be performed.
 	// +optional
 	Maintenance *Maintenance `json:"maintenance,omitempty" protobuf:"bytes,8,opt,name=maintenance"`
+	// Logging contains information about custom logging configurations for the shoot.
+	// +optional
+	Logging *Logging `json:"logging,omitempty" protobuf:"bytes,22,opt,name=logging"`
 	// Monitoring contains information about custom monitoring configurations for the shoot.
 	// +optional
 	Monitoring *Monitoring `json:"monitoring,omitempty" protobuf:"bytes,9,opt,name=monitoring"`
@@ -135,6 +138,14 @@ type ShootSpec struct {
 	// This field is immutable.
 	// +optional
 	SchedulerName *string `json:"schedulerName,omitempty" protobuf:"bytes,21,opt,name=schedulerName"`
+	// TrustedCABundles contains additional CA bundles (e.g., corporate CAs) which are added to the trust stores of all
+	// nodes and published in the `gardener-trusted-ca-bundles` ConfigMap in all namespaces of the Shoot cluster. In
+	// order to rotate a CA, add a bundle with the new CA first and remove the bundle with the old CA once all clients
+	// trust the new CA.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	TrustedCABundles []TrustedCABundle `json:"trustedCABundles,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,23,rep,name=trustedCABundles"`
 }
 
 // GetProviderType gets the type of the provider.
@@ -208,6 +219,171 @@ type ShootStatus struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty" protobuf:"bytes,18,rep,name=encryptedResources"`
+	// ServiceLevelObjectives contains the current state of the service level objectives of the Shoot's control plane.
+	// +optional
+	ServiceLevelObjectives []ServiceLevelObjectiveStatus `json:"serviceLevelObjectives,omitempty" protobuf:"bytes,19,rep,name=serviceLevelObjectives"`
+	// AdmissionWarnings contains the warnings which were issued by the admission plugins of the gardener-apiserver
+	// for the last create or update request of the Shoot.
+	// +optional
+	AdmissionWarnings []AdmissionWarning `json:"admissionWarnings,omitempty" protobuf:"bytes,20,rep,name=admissionWarnings"`
+	// APIServerEndpoint contains the current state of the kube-apiserver endpoint of the Shoot.
+	// +optional
+	APIServerEndpoint *APIServerEndpointStatus `json:"apiServerEndpoint,omitempty" protobuf:"bytes,21,opt,name=apiServerEndpoint"`
+	// VulnerabilityScanResults contains the results of the latest vulnerability scans of the machine images used by the
+	// worker pools of the Shoot. They are reported by extensions scanning the nodes for vulnerabilities.
+	// +optional
+	VulnerabilityScanResults []VulnerabilityScanResult `json:"vulnerabilityScanResults,omitempty" protobuf:"bytes,22,rep,name=vulnerabilityScanResults"`
+	// MaintenanceHistory contains the most recent changes which were performed on the Shoot by the maintenance, e.g.,
+	// automatic version updates or migrations of deprecated fields. The oldest records are removed once the maximum
+	// number of records is reached.
+	// +optional
+	MaintenanceHistory []MaintenanceRecord `json:"maintenanceHistory,omitempty" protobuf:"bytes,23,rep,name=maintenanceHistory"`
+	// InfrastructurePlan contains the changes a reconciliation of the provider infrastructure would perform. It is
+	// computed when the Shoot is annotated with `gardener.cloud/operation=plan`.
+	// +optional
+	InfrastructurePlan *InfrastructurePlan `json:"infrastructurePlan,omitempty" protobuf:"bytes,24,opt,name=infrastructurePlan"`
+	// DeletionPreview contains information about the resources which would be destroyed or retained when the Shoot is
+	// deleted. It is computed when the Shoot is annotated with `gardener.cloud/operation=preview-deletion`.
+	// +optional
+	DeletionPreview *ShootDeletionPreview `json:"deletionPreview,omitempty" protobuf:"bytes,25,opt,name=deletionPreview"`
+	// WorkerRolloutProgress contains the progress of the worker pools whose machines are currently rolled out. It is
+	// updated continuously during the rollout and removed once it has finished.
+	// +optional
+	WorkerRolloutProgress []WorkerPoolRolloutProgress `json:"workerRolloutProgress,omitempty" protobuf:"bytes,26,rep,name=workerRolloutProgress"`
+}
+
+// WorkerPoolRolloutProgress contains the progress of a worker pool whose machines are rolled out.
+type WorkerPoolRolloutProgress struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Desired is the number of desired machines.
+	Desired int32 `json:"desired" protobuf:"varint,2,opt,name=desired"`
+	// Updated is the number of machines which already run the current configuration of the worker pool.
+	Updated int32 `json:"updated" protobuf:"varint,3,opt,name=updated"`
+	// Ready is the number of machines which are ready.
+	Ready int32 `json:"ready" protobuf:"varint,4,opt,name=ready"`
+	// Old is the number of machines which still run an outdated configuration of the worker pool.
+	Old int32 `json:"old" protobuf:"varint,5,opt,name=old"`
+}
+
+// ShootDeletionPreview contains information about the resources which would be destroyed or retained when the Shoot
+// is deleted.
+type ShootDeletionPreview struct {
+	// LastUpdateTime is the time when the preview was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,1,opt,name=lastUpdateTime"`
+	// WorkerPools contains the number of nodes per worker pool which would be deleted.
+	// +optional
+	WorkerPools []WorkerPoolDeletionPreview `json:"workerPools,omitempty" protobuf:"bytes,2,rep,name=workerPools"`
+	// Volumes contains the number of persistent volumes which would be deleted or retained. It is not set if the
+	// volumes could not be determined, e.g., because the Shoot is hibernated.
+	// +optional
+	Volumes *VolumesDeletionPreview `json:"volumes,omitempty" protobuf:"bytes,3,opt,name=volumes"`
+	// DNSRecords is the list of DNS names whose records would be deleted.
+	// +optional
+	DNSRecords []string `json:"dnsRecords,omitempty" protobuf:"bytes,4,rep,name=dnsRecords"`
+	// BackupRetention is the period for which the etcd backups are retained after the Shoot was deleted. It is not
+	// set if the Shoot has no backups.
+	// +optional
+	BackupRetention *metav1.Duration `json:"backupRetention,omitempty" protobuf:"bytes,5,opt,name=backupRetention"`
+}
+
+// WorkerPoolDeletionPreview contains the number of nodes of a worker pool which would be deleted.
+type WorkerPoolDeletionPreview struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32 `json:"nodes" protobuf:"varint,2,opt,name=nodes"`
+}
+
+// VolumesDeletionPreview contains the number of persistent volumes which would be deleted or retained.
+type VolumesDeletionPreview struct {
+	// Deleted is the number of persistent volumes which would be deleted together with their backing disks.
+	Deleted int32 `json:"deleted" protobuf:"varint,1,opt,name=deleted"`
+	// Retained is the number of persistent volumes whose backing disks would be retained in the infrastructure account
+	// due to their `Retain` reclaim policy.
+	Retained int32 `json:"retained" protobuf:"varint,2,opt,name=retained"`
+}
+
+// InfrastructurePlan is a preview of the changes a reconciliation would perform on the provider infrastructure.
+type InfrastructurePlan struct {
+	// LastUpdateTime is the time when the plan was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,1,opt,name=lastUpdateTime"`
+	// Supported indicates whether the provider extension supports computing a plan. If it is false, no changes are
+	// reported.
+	Supported bool `json:"supported" protobuf:"varint,2,opt,name=supported"`
+	// Changes is the list of changes which would be performed on the provider infrastructure.
+	// +optional
+	Changes []InfrastructurePlannedChange `json:"changes,omitempty" protobuf:"bytes,3,rep,name=changes"`
+}
+
+// InfrastructurePlannedChange is a single change which would be performed on a resource of the provider
+// infrastructure.
+type InfrastructurePlannedChange struct {
+	// Action is the action which would be performed on the resource, i.e. `Create`, `Update`, `Replace`, or `Delete`.
+	Action string `json:"action" protobuf:"bytes,1,opt,name=action"`
+	// ResourceType is the provider-specific type of the resource.
+	ResourceType string `json:"resourceType" protobuf:"bytes,2,opt,name=resourceType"`
+	// Name is the provider-specific name or identifier of the resource.
+	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Reason is a human-readable explanation why the change is necessary.
+	// +optional
+	Reason *string `json:"reason,omitempty" protobuf:"bytes,4,opt,name=reason"`
+}
+
+// VulnerabilityScanResult contains the result of a vulnerability scan of the machine image used by a worker pool.
+type VulnerabilityScanResult struct {
+	// WorkerPool is the name of the scanned worker pool.
+	WorkerPool string `json:"workerPool" protobuf:"bytes,1,opt,name=workerPool"`
+	// MachineImageName is the name of the machine image used by the worker pool at the time of the scan.
+	MachineImageName string `json:"machineImageName" protobuf:"bytes,2,opt,name=machineImageName"`
+	// MachineImageVersion is the version of the machine image used by the worker pool at the time of the scan.
+	MachineImageVersion string `json:"machineImageVersion" protobuf:"bytes,3,opt,name=machineImageVersion"`
+	// Vulnerabilities is the list of vulnerabilities found by the scan.
+	// +optional
+	Vulnerabilities []MachineImageVulnerability `json:"vulnerabilities,omitempty" protobuf:"bytes,4,rep,name=vulnerabilities"`
+	// ScanTime is the time at which the scan was performed.
+	ScanTime metav1.Time `json:"scanTime" protobuf:"bytes,5,opt,name=scanTime"`
+}
+
+// APIServerEndpointStatus contains the current state of the kube-apiserver endpoint of the Shoot.
+type APIServerEndpointStatus struct {
+	// StaticIP is the static IP address which was allocated for the kube-apiserver endpoint.
+	// +optional
+	StaticIP *string `json:"staticIP,omitempty" protobuf:"bytes,1,opt,name=staticIP"`
+	// AllowedSourceRanges is the list of CIDRs which are currently allowed to access the kube-apiserver, i.e., the
+	// configured ranges and the egress CIDRs of the worker nodes.
+	// +optional
+	AllowedSourceRanges []string `json:"allowedSourceRanges,omitempty" protobuf:"bytes,2,rep,name=allowedSourceRanges"`
+}
+
+// AdmissionWarning is a warning which was issued by an admission plugin of the gardener-apiserver.
+type AdmissionWarning struct {
+	// Plugin is the name of the admission plugin which issued the warning.
+	Plugin string `json:"plugin" protobuf:"bytes,1,opt,name=plugin"`
+	// Reason is a brief, machine-readable explanation of the warning, e.g. `KubernetesVersionExpiring`.
+	Reason string `json:"reason" protobuf:"bytes,2,opt,name=reason"`
+	// Message is a human-readable message containing details about the warning and how to address it.
+	Message string `json:"message" protobuf:"bytes,3,opt,name=message"`
+}
+
+// ServiceLevelObjectiveStatus contains the current state of a service level objective of the Shoot's control plane.
+type ServiceLevelObjectiveStatus struct {
+	// Name is the name of the service level objective, e.g. `APIServerAvailability`.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Objective is the targeted percentage of good requests within the window, e.g. `99.9`.
+	Objective string `json:"objective" protobuf:"bytes,2,opt,name=objective"`
+	// Window is the time window in which the service level objective is evaluated.
+	Window metav1.Duration `json:"window" protobuf:"bytes,3,opt,name=window"`
+	// Current is the percentage of good requests within the window, e.g. `99.95`. It is not set if it could not be
+	// determined.
+	// +optional
+	Current *string `json:"current,omitempty" protobuf:"bytes,4,opt,name=current"`
+	// ErrorBudgetRemaining is the percentage of the error budget which remains within the window, e.g. `50`. It is
+	// negative if the error budget is exhausted. It is not set if it could not be determined.
+	// +optional
+	ErrorBudgetRemaining *string `json:"errorBudgetRemaining,omitempty" protobuf:"bytes,5,opt,name=errorBudgetRemaining"`
+	// LastUpdateTime is the last time the status was updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,6,opt,name=lastUpdateTime"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -221,6 +397,29 @@ type LastMaintenance struct {
 	// FailureReason holds the information about the last maintenance operation failure reason.
 	// +optional
 	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
+	// OutOfWindow indicates whether the maintenance was performed outside of the maintenance time window, e.g., because
+	// machine image versions affected by critical vulnerabilities had to be updated.
+	// +optional
+	OutOfWindow *bool `json:"outOfWindow,omitempty" protobuf:"varint,5,opt,name=outOfWindow"`
+}
+
+// MaintenanceRecord describes a change which was performed on the Shoot by the maintenance.
+type MaintenanceRecord struct {
+	// Time is the time when the change was performed.
+	Time metav1.Time `json:"time" protobuf:"bytes,1,opt,name=time"`
+	// Field is the path of the changed field, e.g. `spec.kubernetes.version`.
+	Field string `json:"field" protobuf:"bytes,2,opt,name=field"`
+	// OldValue is the value of the field before the change.
+	// +optional
+	OldValue *string `json:"oldValue,omitempty" protobuf:"bytes,3,opt,name=oldValue"`
+	// NewValue is the value of the field after the change.
+	// +optional
+	NewValue *string `json:"newValue,omitempty" protobuf:"bytes,4,opt,name=newValue"`
+	// Reason describes why the change was performed, e.g., the update policy which triggered it.
+	Reason string `json:"reason" protobuf:"bytes,5,opt,name=reason"`
+	// OutOfWindow indicates whether the change was performed outside of the maintenance time window.
+	// +optional
+	OutOfWindow *bool `json:"outOfWindow,omitempty" protobuf:"varint,6,opt,name=outOfWindow"`
 }
 
 // ShootCredentials contains information about the shoot credentials.
@@ -343,6 +542,20 @@ type ETCDEncryptionKeyRotation struct {
 	// triggered.
 	// +optional
 	LastCompletionTriggeredTime *metav1.Time `json:"lastCompletionTriggeredTime,omitempty" protobuf:"bytes,5,opt,name=lastCompletionTriggeredTime"`
+	// ReencryptedResources contains the progress of rewriting the encrypted resources so that they become encrypted
+	// with the new ETCD encryption key. It is reset when a new rotation is initiated.
+	// +optional
+	ReencryptedResources []ReencryptedResource `json:"reencryptedResources,omitempty" protobuf:"bytes,6,rep,name=reencryptedResources"`
+}
+
+// ReencryptedResource contains the progress of rewriting the objects of an encrypted resource.
+type ReencryptedResource struct {
+	// Resource is the kind and group of the resource, e.g. 'Secret' or 'Deployment.apps'.
+	Resource string `json:"resource" protobuf:"bytes,1,opt,name=resource"`
+	// Total is the number of objects of the resource which must be encrypted with the new key.
+	Total int32 `json:"total" protobuf:"varint,2,opt,name=total"`
+	// Rewritten is the number of objects of the resource which are already encrypted with the new key.
+	Rewritten int32 `json:"rewritten" protobuf:"varint,3,opt,name=rewritten"`
 }
 
 // CredentialsRotationPhase is a string alias.
@@ -419,8 +632,35 @@ type ControlPlane struct {
 	// control plane of a shoot.
 	// +optional
 	HighAvailability *HighAvailability `json:"highAvailability,omitempty" protobuf:"bytes,1,name=highAvailability"`
+	// VPN contains the settings for the VPN connection between the control plane and the data plane of the shoot.
+	// +optional
+	VPN *ControlPlaneVPN `json:"vpn,omitempty" protobuf:"bytes,2,opt,name=vpn"`
+}
+
+// ControlPlaneVPN contains the settings for the VPN connection between the control plane and the data plane of a
+// shoot.
+type ControlPlaneVPN struct {
+	// HighThroughput enables the high-throughput mode in which multiple parallel VPN tunnels are established between
+	// the control plane and the data plane, and the traffic is load-balanced across them.
+	// +optional
+	HighThroughput *VPNHighThroughput `json:"highThroughput,omitempty" protobuf:"bytes,1,opt,name=highThroughput"`
 }
 
+// VPNHighThroughput contains the settings for the high-throughput mode of the VPN.
+type VPNHighThroughput struct {
+	// MinTunnels is the minimum number of parallel VPN tunnels. Defaults to 2.
+	// +optional
+	MinTunnels *int32 `json:"minTunnels,omitempty" protobuf:"varint,1,opt,name=minTunnels"`
+	// MaxTunnels is the maximum number of parallel VPN tunnels. The number of tunnels is scaled automatically between
+	// MinTunnels and MaxTunnels based on the observed VPN throughput. Defaults to MinTunnels.
+	// +optional
+	MaxTunnels *int32 `json:"maxTunnels,omitempty" protobuf:"varint,2,opt,name=maxTunnels"`
+}
+
+// DefaultVPNHighThroughputMinTunnels is the default value for the MinTunnels field in the VPN high-throughput
+// configuration.
+const DefaultVPNHighThroughputMinTunnels int32 = 2
+
 // DNS holds information about the provider, the hosted zone id and the domain.
 type DNS struct {
 	// Domain is the external available domain of the Shoot cluster. This domain will be written into the
@@ -433,6 +673,29 @@ type DNS struct {
 	// +patchStrategy=merge
 	// +optional
 	Providers []DNSProvider `json:"providers,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=providers"`
+	// Private contains information about an additional private DNS zone (e.g., a private hosted zone or a corporate
+	// DNS) in which the kube-apiserver of the Shoot cluster shall be resolvable. This is useful for clusters which
+	// are only reachable from private networks.
+	// +optional
+	Private *PrivateDNS `json:"private,omitempty" protobuf:"bytes,3,opt,name=private"`
+}
+
+// PrivateDNS contains information about a private DNS zone for the Shoot cluster.
+type PrivateDNS struct {
+	// Domain is the private domain of the Shoot cluster. The kube-apiserver is served under `api.<domain>`, and this
+	// name is added to its serving certificate. This field is immutable.
+	Domain string `json:"domain" protobuf:"bytes,1,opt,name=domain"`
+	// Type is the DNS provider type managing the private zone. If set to `unmanaged`, no DNS record is created by
+	// Gardener and the record has to be maintained by the user.
+	Type string `json:"type" protobuf:"bytes,2,opt,name=type"`
+	// SecretName is the name of a secret in the project namespace containing credentials for the DNS provider.
+	// It is required unless the type is `unmanaged`.
+	// +optional
+	SecretName *string `json:"secretName,omitempty" protobuf:"bytes,3,opt,name=secretName"`
+	// Zone is the identifier of the private hosted zone. If not specified, the DNS provider determines the zone
+	// based on the domain.
+	// +optional
+	Zone *string `json:"zone,omitempty" protobuf:"bytes,4,opt,name=zone"`
 }
 
 // DNSProvider contains information about a DNS provider.
@@ -656,6 +919,22 @@ type VerticalPodAutoscaler struct {
 	// RecommenderInterval is the interval how often metrics should be fetched (default: 1m0s).
 	// +optional
 	RecommenderInterval *metav1.Duration `json:"recommenderInterval,omitempty" protobuf:"bytes,8,opt,name=recommenderInterval"`
+	// TargetCPUPercentile is the usage percentile that will be used as a base for the CPU target recommendation
+	// (default: 0.9).
+	// +optional
+	TargetCPUPercentile *float64 `json:"targetCPUPercentile,omitempty" protobuf:"fixed64,9,opt,name=targetCPUPercentile"`
+	// TargetMemoryPercentile is the usage percentile that will be used as a base for the memory target recommendation
+	// (default: 0.9).
+	// +optional
+	TargetMemoryPercentile *float64 `json:"targetMemoryPercentile,omitempty" protobuf:"fixed64,10,opt,name=targetMemoryPercentile"`
+	// CPUHistogramDecayHalfLife is the amount of time it takes a historical CPU usage sample to lose half of its weight
+	// (default: 24h0m0s).
+	// +optional
+	CPUHistogramDecayHalfLife *metav1.Duration `json:"cpuHistogramDecayHalfLife,omitempty" protobuf:"bytes,11,opt,name=cpuHistogramDecayHalfLife"`
+	// MemoryHistogramDecayHalfLife is the amount of time it takes a historical memory usage sample to lose half of its
+	// weight (default: 24h0m0s).
+	// +optional
+	MemoryHistogramDecayHalfLife *metav1.Duration `json:"memoryHistogramDecayHalfLife,omitempty" protobuf:"bytes,12,opt,name=memoryHistogramDecayHalfLife"`
 }
 
 const (
@@ -755,6 +1034,33 @@ type KubeAPIServerConfig struct {
 	// EncryptionConfig contains customizable encryption configuration of the Kube API server.
 	// +optional
 	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty" protobuf:"bytes,16,opt,name=encryptionConfig"`
+	// Endpoint contains settings for the endpoint of the kube-apiserver which is exposed by the Seed.
+	// +optional
+	Endpoint *APIServerEndpoint `json:"endpoint,omitempty" protobuf:"bytes,17,opt,name=endpoint"`
+}
+
+// APIServerEndpoint contains settings for the endpoint of the kube-apiserver.
+type APIServerEndpoint struct {
+	// AllowedSourceRanges is a list of CIDRs which are allowed to access the kube-apiserver. If it is set, all other
+	// clients are denied, except for the worker nodes of the Shoot whose egress CIDRs are always allowed.
+	// See https://github.com/gardener/gardener/blob/master/docs/usage/shoot_api_server_endpoint.md for more details.
+	// +optional
+	AllowedSourceRanges []string `json:"allowedSourceRanges,omitempty" protobuf:"bytes,1,rep,name=allowedSourceRanges"`
+	// StaticIP requests a dedicated load balancer with a static IP address for the kube-apiserver. It is only
+	// supported if the provider extension of the Seed supports it.
+	// +optional
+	StaticIP *APIServerStaticIP `json:"staticIP,omitempty" protobuf:"bytes,2,opt,name=staticIP"`
+}
+
+// APIServerStaticIP contains settings for the static IP address of the kube-apiserver endpoint.
+type APIServerStaticIP struct {
+	// Address is an IP address which was reserved upfront in the infrastructure account of the Seed. If it is not set,
+	// a static IP address is allocated by the provider extension.
+	// +optional
+	Address *string `json:"address,omitempty" protobuf:"bytes,1,opt,name=address"`
+	// PTRRecord is the domain name of the reverse DNS (PTR) record which is configured for the static IP address.
+	// +optional
+	PTRRecord *string `json:"ptrRecord,omitempty" protobuf:"bytes,2,opt,name=ptrRecord"`
 }
 
 // APIServerLogging contains configuration for the logs level and http access logs
@@ -778,8 +1084,35 @@ type APIServerRequests struct {
 	// exceeds this, it rejects requests.
 	// +optional
 	MaxMutatingInflight *int32 `json:"maxMutatingInflight,omitempty" protobuf:"bytes,2,name=maxMutatingInflight"`
+	// PriorityAndFairness contains configuration for the API Priority and Fairness (APF) objects which are managed by
+	// Gardener in the shoot cluster.
+	// +optional
+	PriorityAndFairness *APIServerPriorityAndFairness `json:"priorityAndFairness,omitempty" protobuf:"bytes,3,opt,name=priorityAndFairness"`
+}
+
+// APIServerPriorityAndFairness contains configuration for the API Priority and Fairness (APF) objects which are
+// managed by Gardener in the shoot cluster.
+type APIServerPriorityAndFairness struct {
+	// Presets is a list of APF presets which are deployed to the shoot cluster. Each preset consists of a set of
+	// FlowSchemas and PriorityLevelConfigurations which are maintained by Gardener for the Kubernetes version of the shoot.
+	// +optional
+	Presets []APIServerPriorityAndFairnessPreset `json:"presets,omitempty" protobuf:"bytes,1,rep,name=presets,casttype=APIServerPriorityAndFairnessPreset"`
 }
 
+// APIServerPriorityAndFairnessPreset is a name of an API Priority and Fairness preset.
+type APIServerPriorityAndFairnessPreset string
+
+const (
+	// APIServerPriorityAndFairnessPresetProtectSystemComponents is a preset which assigns the requests of the system
+	// components running in the kube-system namespace to a dedicated priority level, so that they are not starved by
+	// other clients.
+	APIServerPriorityAndFairnessPresetProtectSystemComponents APIServerPriorityAndFairnessPreset = "ProtectSystemComponents"
+	// APIServerPriorityAndFairnessPresetLimitWorkloadServiceAccounts is a preset which assigns the requests of all
+	// service accounts outside of the kube-system namespace to a priority level with a limited share of the
+	// kube-apiserver's concurrency, so that request bursts, e.g., from CI pipelines, cannot overload the kube-apiserver.
+	APIServerPriorityAndFairnessPresetLimitWorkloadServiceAccounts APIServerPriorityAndFairnessPreset = "LimitWorkloadServiceAccounts"
+)
+
 // EncryptionConfig contains customizable encryption configuration of the API server.
 type EncryptionConfig struct {
 	// Resources contains the list of resources that shall be encrypted in addition to secrets.
@@ -818,6 +1151,12 @@ type ServiceAccountConfig struct {
 	// issued by another external system or a change of the current issuer that is used for generating tokens is being performed.
 	// +optional
 	AcceptedIssuers []string `json:"acceptedIssuers,omitempty" protobuf:"bytes,5,opt,name=acceptedIssuers"`
+	// SigningKeyRotationPeriod is the period after which the service account signing key is rotated automatically
+	// during the maintenance time window. The previous signing key is still accepted for verifying tokens until all
+	// tokens issued with it are expired, i.e., the rotation is completed automatically after MaxTokenExpiration passed.
+	// Requires MaxTokenExpiration to be set and ExtendTokenExpiration to be disabled.
+	// +optional
+	SigningKeyRotationPeriod *metav1.Duration `json:"signingKeyRotationPeriod,omitempty" protobuf:"bytes,6,opt,name=signingKeyRotationPeriod"`
 }
 
 // AuditConfig contains settings for audit of the api server
@@ -993,6 +1332,31 @@ type KubeSchedulerConfig struct {
 	// If not specified, the used profile is "balanced" (provides the default kube-scheduler behavior).
 	// +optional
 	Profile *SchedulingProfile `json:"profile,omitempty" protobuf:"bytes,3,opt,name=profile,casttype=SchedulingProfile"`
+	// Profiles are additional scheduling profiles which are configured for the kube-scheduler. Pods are scheduled with
+	// such a profile if their `.spec.schedulerName` is set to the name of the profile.
+	// +optional
+	Profiles []KubeSchedulerProfile `json:"profiles,omitempty" protobuf:"bytes,4,rep,name=profiles"`
+}
+
+// KubeSchedulerProfile contains the configuration of an additional scheduling profile of the kube-scheduler.
+type KubeSchedulerProfile struct {
+	// SchedulerName is the name of the profile. It must not be `default-scheduler` or `bin-packing-scheduler`.
+	SchedulerName string `json:"schedulerName" protobuf:"bytes,1,opt,name=schedulerName"`
+	// BinPacking enables the bin-packing scoring strategy for this profile, i.e., Nodes with the most allocated
+	// resources are preferred (similar to the "bin-packing" scheduling profile).
+	// +optional
+	BinPacking *bool `json:"binPacking,omitempty" protobuf:"varint,2,opt,name=binPacking"`
+	// ScorePlugins configures the weights of score plugins of this profile.
+	// +optional
+	ScorePlugins []KubeSchedulerScorePlugin `json:"scorePlugins,omitempty" protobuf:"bytes,3,rep,name=scorePlugins"`
+}
+
+// KubeSchedulerScorePlugin configures the weight of a score plugin of the kube-scheduler.
+type KubeSchedulerScorePlugin struct {
+	// Name is the name of the score plugin.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Weight is the weight of the score plugin. It must be in the range from 1 to 100.
+	Weight int32 `json:"weight" protobuf:"varint,2,opt,name=weight"`
 }
 
 // SchedulingProfile is a string alias used for scheduling profile values.
@@ -1157,6 +1521,10 @@ type KubeletConfig struct {
 	// MemorySwap configures swap memory available to container workloads.
 	// +optional
 	MemorySwap *MemorySwapConfiguration `json:"memorySwap,omitempty" protobuf:"bytes,26,opt,name=memorySwap"`
+	// ImageMinimumGCAge is the minimum age for an unused image before it is garbage collected.
+	// +optional
+	// Default: 2m
+	ImageMinimumGCAge *metav1.Duration `json:"imageMinimumGCAge,omitempty" protobuf:"bytes,27,opt,name=imageMinimumGCAge"`
 }
 
 // KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
@@ -1261,14 +1629,16 @@ type Networking struct {
 	// ProviderConfig is the configuration passed to network resource.
 	// +optional
 	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,2,opt,name=providerConfig"`
-	// Pods is the CIDR of the pod network. This field is immutable.
+	// Pods is the CIDR of the pod network. This field is immutable unless the feature gate
+	// ExpandableShootSpecNetworkingCIDRs is enabled, which allows expanding it to a larger CIDR containing the previous one.
 	// +optional
 	Pods *string `json:"pods,omitempty" protobuf:"bytes,3,opt,name=pods"`
 	// Nodes is the CIDR of the entire node network.
 	// This field is immutable if the feature gate MutableShootSpecNetworkingNodes is disabled.
 	// +optional
 	Nodes *string `json:"nodes,omitempty" protobuf:"bytes,4,opt,name=nodes"`
-	// Services is the CIDR of the service network. This field is immutable.
+	// Services is the CIDR of the service network. This field is immutable unless the feature gate
+	// ExpandableShootSpecNetworkingCIDRs is enabled, which allows expanding it to a larger CIDR containing the previous one.
 	// +optional
 	Services *string `json:"services,omitempty" protobuf:"bytes,5,opt,name=services"`
 	// IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable.
@@ -1315,6 +1685,10 @@ type MaintenanceAutoUpdate struct {
 	// MachineImageVersion indicates whether the machine image version may be automatically updated (default: true).
 	// +optional
 	MachineImageVersion *bool `json:"machineImageVersion,omitempty" protobuf:"varint,2,opt,name=machineImageVersion"`
+	// DeprecatedTypes indicates whether deprecated machine and volume types of worker pools may be automatically
+	// replaced by their successors suggested in the cloud profile (default: false).
+	// +optional
+	DeprecatedTypes *bool `json:"deprecatedTypes,omitempty" protobuf:"varint,3,opt,name=deprecatedTypes"`
 }
 
 // MaintenanceTimeWindow contains information about the time window for maintenance operations.
@@ -1331,6 +1705,29 @@ type MaintenanceTimeWindow struct {
 	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
 }
 
+// Logging contains information about the logging configuration for the shoot.
+type Logging struct {
+	// Retention is the duration for which the control plane logs of the shoot are retained in the seed. It must be a
+	// multiple of 24h. Defaults to 360h (15 days).
+	// +optional
+	Retention *metav1.Duration `json:"retention,omitempty" protobuf:"bytes,1,opt,name=retention"`
+	// StorageQuota is the size of the volume storing the control plane logs of the shoot in the seed. When the volume
+	// runs full, the oldest logs are deleted before the retention period has passed. Defaults to 30Gi. Once set, it
+	// cannot be decreased.
+	// +optional
+	StorageQuota *resource.Quantity `json:"storageQuota,omitempty" protobuf:"bytes,2,opt,name=storageQuota"`
+	// QueryAPI contains information about the authenticated query API for the control plane logs of the shoot.
+	// +optional
+	QueryAPI *LoggingQueryAPI `json:"queryAPI,omitempty" protobuf:"bytes,3,opt,name=queryAPI"`
+}
+
+// LoggingQueryAPI contains information about the authenticated query API for the control plane logs of the shoot.
+type LoggingQueryAPI struct {
+	// Enabled controls whether the query API is exposed. Requests are authenticated and authorized by the shoot's
+	// kube-apiserver, hence only users with access to the shoot cluster can query its logs.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+}
+
 // Monitoring contains information about the monitoring configuration for the shoot.
 type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
@@ -1432,6 +1829,79 @@ type Worker struct {
 	// Sysctls is a map of kernel settings to apply on all machines in this worker pool.
 	// +optional
 	Sysctls map[string]string `json:"sysctls,omitempty" protobuf:"bytes,20,rep,name=sysctls"`
+	// MachineImageUpdatePolicy contains the policy for automatic updates of the machine image version of this worker pool.
+	// +optional
+	MachineImageUpdatePolicy *MachineImageUpdatePolicy `json:"machineImageUpdatePolicy,omitempty" protobuf:"bytes,21,opt,name=machineImageUpdatePolicy"`
+	// Topology contains settings for the topology labels which are added to the nodes of this worker pool.
+	// +optional
+	Topology *WorkerTopology `json:"topology,omitempty" protobuf:"bytes,22,opt,name=topology"`
+	// MaxNodeProvisionTime is the maximum duration the provisioning of a machine of this worker pool may take before the
+	// machine is declared failed and replaced by the machine-controller-manager. It must not be set together with
+	// `machineControllerManager.machineCreationTimeout`.
+	// +optional
+	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty" protobuf:"bytes,23,opt,name=maxNodeProvisionTime"`
+	// OperatingSystemConfigRollout contains settings for the staged rollout of operating system config changes to the
+	// nodes of this worker pool. This is only considered if the gardener-node-agent is used.
+	// +optional
+	OperatingSystemConfigRollout *OperatingSystemConfigRollout `json:"operatingSystemConfigRollout,omitempty" protobuf:"bytes,24,opt,name=operatingSystemConfigRollout"`
+	// ZoneRebalancing contains settings for the automatic rebalancing of the machines of this worker pool to its other
+	// zones in case a zone repeatedly has insufficient capacity. If not set, machines are not rebalanced.
+	// +optional
+	ZoneRebalancing *WorkerZoneRebalancing `json:"zoneRebalancing,omitempty" protobuf:"bytes,25,opt,name=zoneRebalancing"`
+}
+
+// WorkerZoneRebalancing contains settings for the automatic rebalancing of the machines of a worker pool to its other
+// zones in case a zone repeatedly has insufficient capacity. Once the number of machines of a zone which failed due to
+// insufficient capacity reaches the failure threshold, the desired machines of the zone are shifted to the other zones
+// of the worker pool. The shift is reverted after the recovery period in order to check whether the zone has capacity
+// again.
+type WorkerZoneRebalancing struct {
+	// FailureThreshold is the number of machines of a zone which failed due to insufficient capacity after which the
+	// desired machines of the zone are shifted to the other zones of the worker pool. Defaults to `3`.
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty" protobuf:"varint,1,opt,name=failureThreshold"`
+	// RecoveryPeriod is the duration after which the shift is reverted. Defaults to `30m`.
+	// +optional
+	RecoveryPeriod *metav1.Duration `json:"recoveryPeriod,omitempty" protobuf:"bytes,2,opt,name=recoveryPeriod"`
+}
+
+// OperatingSystemConfigRollout contains settings for the staged rollout of operating system config changes to the nodes
+// of a worker pool. Changes are first applied to the canary nodes. They are only propagated to the remaining nodes once
+// all canary nodes have been verified to be healthy. If a canary node does not become healthy within the health
+// timeout, the rollout is halted.
+type OperatingSystemConfigRollout struct {
+	// CanaryNodes is the number of nodes of the worker pool to which changes are applied first.
+	CanaryNodes int32 `json:"canaryNodes" protobuf:"varint,1,opt,name=canaryNodes"`
+	// HealthTimeout is the duration within which the canary nodes must become healthy after the changes have been applied.
+	// Defaults to `10m`.
+	// +optional
+	HealthTimeout *metav1.Duration `json:"healthTimeout,omitempty" protobuf:"bytes,2,opt,name=healthTimeout"`
+}
+
+// WorkerTopology contains settings for the topology labels which are added to the nodes of a worker pool. The labels
+// are also added to the node template used by the cluster-autoscaler when scaling the worker pool from zero, so that
+// pods and nodes can be spread predictably across zones regardless of the infrastructure provider. If the worker pool
+// uses exactly one zone, the nodes are additionally labeled with `topology.kubernetes.io/zone`.
+type WorkerTopology struct {
+	// ZoneGroup is the name of a group of zones the nodes of this worker pool belong to. It is added to the nodes with the
+	// `topology.gardener.cloud/zone-group` label.
+	// +optional
+	ZoneGroup *string `json:"zoneGroup,omitempty" protobuf:"bytes,1,opt,name=zoneGroup"`
+	// DedicatedToProject controls whether the nodes of this worker pool are labeled with
+	// `topology.gardener.cloud/dedicated-to-project=<project-name>`.
+	// +optional
+	DedicatedToProject *bool `json:"dedicatedToProject,omitempty" protobuf:"varint,2,opt,name=dedicatedToProject"`
+}
+
+// MachineImageUpdatePolicy contains the policy for automatic updates of the machine image version of a worker pool.
+type MachineImageUpdatePolicy struct {
+	// PinPatchStream pins the worker pool to the patch stream (i.e., the major and minor version) of its current machine
+	// image version. Automatic updates are only performed to the latest patch version of the current minor version,
+	// regardless of the update strategy configured in the CloudProfile. Expired versions or versions affected by
+	// critical vulnerabilities are still forcefully updated to the next higher minor version if no suitable patch
+	// version is available.
+	// +optional
+	PinPatchStream *bool `json:"pinPatchStream,omitempty" protobuf:"varint,1,opt,name=pinPatchStream"`
 }
 
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
@@ -1451,12 +1921,44 @@ type MachineControllerManagerSettings struct {
 	// NodeConditions are the set of conditions if set to true for the period of MachineHealthTimeout, machine will be declared failed.
 	// +optional
 	NodeConditions []string `json:"nodeConditions,omitempty" protobuf:"bytes,5,name=nodeConditions"`
+	// StuckMachinePolicy contains the policy for machines of this worker pool which repeatedly fail to be provisioned.
+	// +optional
+	StuckMachinePolicy *StuckMachinePolicy `json:"stuckMachinePolicy,omitempty" protobuf:"bytes,6,opt,name=stuckMachinePolicy"`
+}
+
+// StuckMachinePolicy contains the policy for machines of a worker pool which repeatedly fail to be provisioned.
+type StuckMachinePolicy struct {
+	// Action is the action which is taken once the number of failed machines of a machine deployment of the worker pool
+	// reaches the `failureThreshold`. Possible values are `KeepRetrying` (failed machines are replaced over and over
+	// again) and `PausePool` (the machine deployment is paused and the `MachineDeploymentsPaused` condition is reported
+	// on the Worker until the configuration of the worker pool is changed). Defaults to `KeepRetrying`.
+	// +optional
+	Action StuckMachineAction `json:"action,omitempty" protobuf:"bytes,1,opt,name=action,casttype=StuckMachineAction"`
+	// FailureThreshold is the number of failed machines of a machine deployment after which the action is taken.
+	// Defaults to `3`.
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty" protobuf:"varint,2,opt,name=failureThreshold"`
 }
 
+// StuckMachineAction is a type alias for the action which is taken for machines which repeatedly fail to be provisioned.
+type StuckMachineAction string
+
+const (
+	// StuckMachineActionKeepRetrying keeps replacing failed machines.
+	StuckMachineActionKeepRetrying StuckMachineAction = "KeepRetrying"
+	// StuckMachineActionPausePool pauses the machine deployments of the worker pool until its configuration is changed.
+	StuckMachineActionPausePool StuckMachineAction = "PausePool"
+)
+
 // WorkerSystemComponents contains configuration for system components related to this worker pool
 type WorkerSystemComponents struct {
 	// Allow determines whether the pool should be allowed to host system components or not (defaults to true)
 	Allow bool `json:"allow" protobuf:"bytes,1,name=allow"`
+	// Only determines whether the pool is dedicated to system components. If true, the nodes of this pool are tainted
+	// with `worker.gardener.cloud/system-components-only=true:NoSchedule` so that only Gardener-managed system
+	// components (which tolerate this taint) are scheduled onto them. Requires `allow` to be true.
+	// +optional
+	Only *bool `json:"only,omitempty" protobuf:"varint,2,opt,name=only"`
 }
 
 // WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.
@@ -1484,6 +1986,24 @@ type Machine struct {
 	// Architecture is CPU architecture of machines in this worker pool.
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,3,opt,name=architecture"`
+	// Requirements are the capabilities the machines of this worker pool require. They are validated against the
+	// capabilities of the machine type and machine image version in the referenced CloudProfile.
+	// +optional
+	Requirements *MachineRequirements `json:"requirements,omitempty" protobuf:"bytes,4,opt,name=requirements"`
+}
+
+// MachineRequirements contains the capabilities required by the machines of a worker pool.
+type MachineRequirements struct {
+	// GPU states whether the machine type must have GPUs.
+	// +optional
+	GPU *bool `json:"gpu,omitempty" protobuf:"varint,1,opt,name=gpu"`
+	// ConfidentialComputing states whether the machine type and the machine image version must support confidential
+	// computing.
+	// +optional
+	ConfidentialComputing *bool `json:"confidentialComputing,omitempty" protobuf:"varint,2,opt,name=confidentialComputing"`
+	// NestedVirtualization states whether the machine type must support nested virtualization.
+	// +optional
+	NestedVirtualization *bool `json:"nestedVirtualization,omitempty" protobuf:"varint,3,opt,name=nestedVirtualization"`
 }
 
 // ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be
@@ -1589,8 +2109,22 @@ type SystemComponents struct {
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	// +optional
 	NodeLocalDNS *NodeLocalDNS `json:"nodeLocalDNS,omitempty" protobuf:"bytes,2,opt,name=nodeLocalDNS"`
+	// UserProvided is a list of system components which are provided by the user instead of Gardener. Gardener does
+	// neither deploy nor health-check these components, and removes them in case they were deployed before.
+	// +optional
+	UserProvided []UserProvidedSystemComponent `json:"userProvided,omitempty" protobuf:"bytes,3,rep,name=userProvided,casttype=UserProvidedSystemComponent"`
 }
 
+// UserProvidedSystemComponent is a name of a system component which can be provided by the user instead of Gardener.
+type UserProvidedSystemComponent string
+
+const (
+	// UserProvidedSystemComponentMetricsServer is the name of the metrics-server system component.
+	UserProvidedSystemComponentMetricsServer UserProvidedSystemComponent = "metrics-server"
+	// UserProvidedSystemComponentKubeProxy is the name of the kube-proxy system component.
+	UserProvidedSystemComponentKubeProxy UserProvidedSystemComponent = "kube-proxy"
+)
+
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
 type CoreDNS struct {
 	// Autoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1599,6 +2133,13 @@ type CoreDNS struct {
 	// Rewriting contains the setting related to rewriting of requests, which are obviously incorrect due to the unnecessary application of the search path.
 	// +optional
 	Rewriting *CoreDNSRewriting `json:"rewriting,omitempty" protobuf:"bytes,2,opt,name=rewriting"`
+	// Zones contains the settings of additional DNS zones served by the Core DNS components, e.g., stub domains whose
+	// requests are forwarded to dedicated upstream DNS servers.
+	// +optional
+	Zones []CoreDNSZone `json:"zones,omitempty" protobuf:"bytes,3,rep,name=zones"`
+	// Rewrites contains rules for rewriting the names of DNS requests.
+	// +optional
+	Rewrites []CoreDNSRewrite `json:"rewrites,omitempty" protobuf:"bytes,4,rep,name=rewrites"`
 }
 
 // CoreDNSAutoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1625,6 +2166,40 @@ type CoreDNSRewriting struct {
 	CommonSuffixes []string `json:"commonSuffixes,omitempty" protobuf:"bytes,1,rep,name=commonSuffixes"`
 }
 
+// CoreDNSZone contains the settings of an additional DNS zone served by the Core DNS components.
+type CoreDNSZone struct {
+	// Name is the name of the zone, e.g., `corp.example.com`. It must neither be the cluster domain nor one of its
+	// sub-domains.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Upstreams are the DNS servers to which requests for the zone are forwarded. Each entry must be an IP address,
+	// optionally followed by a port, e.g., `10.0.0.10` or `10.0.0.10:5353`.
+	Upstreams []string `json:"upstreams" protobuf:"bytes,2,rep,name=upstreams"`
+}
+
+// CoreDNSRewrite contains a rule for rewriting the names of DNS requests.
+type CoreDNSRewrite struct {
+	// Match is the type of the match of the name. Supported values are `exact`, `prefix`, `suffix`, and `substring`.
+	Match CoreDNSRewriteMatch `json:"match" protobuf:"bytes,1,opt,name=match,casttype=CoreDNSRewriteMatch"`
+	// From is the name (or the part of the name, depending on the match type) which is rewritten.
+	From string `json:"from" protobuf:"bytes,2,opt,name=from"`
+	// To is the replacement for the matched name (or part of the name).
+	To string `json:"to" protobuf:"bytes,3,opt,name=to"`
+}
+
+// CoreDNSRewriteMatch is a type alias for the match type of Core DNS rewrite rules.
+type CoreDNSRewriteMatch string
+
+const (
+	// CoreDNSRewriteMatchExact is a constant for rewrite rules matching the exact name.
+	CoreDNSRewriteMatchExact CoreDNSRewriteMatch = "exact"
+	// CoreDNSRewriteMatchPrefix is a constant for rewrite rules matching a prefix of the name.
+	CoreDNSRewriteMatchPrefix CoreDNSRewriteMatch = "prefix"
+	// CoreDNSRewriteMatchSuffix is a constant for rewrite rules matching a suffix of the name.
+	CoreDNSRewriteMatchSuffix CoreDNSRewriteMatch = "suffix"
+	// CoreDNSRewriteMatchSubstring is a constant for rewrite rules matching a substring of the name.
+	CoreDNSRewriteMatchSubstring CoreDNSRewriteMatch = "substring"
+)
+
 // NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 type NodeLocalDNS struct {
 	// Enabled indicates whether node local DNS is enabled or not.
@@ -1643,11 +2218,22 @@ type NodeLocalDNS struct {
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
 }
 
+// TrustedCABundle is a named bundle of additional trusted CA certificates.
+type TrustedCABundle struct {
+	// Name is the unique name of the bundle. The bundle is published with key `<name>.pem` in the ConfigMap.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// CABundle contains the PEM-encoded CA certificates.
+	CABundle string `json:"caBundle" protobuf:"bytes,2,opt,name=caBundle"`
+}
+
 const (
 	// ShootMaintenanceFailed indicates that a shoot maintenance operation failed.
 	ShootMaintenanceFailed = "MaintenanceFailed"
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
+	// ShootEventImageVersionVulnerabilityMaintenance indicates that a machine image version affected by critical
+	// vulnerabilities has been updated outside of the maintenance time window.
+	ShootEventImageVersionVulnerabilityMaintenance = "MachineImageVersionVulnerabilityMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
 	ShootEventK8sVersionMaintenance = "KubernetesVersionMaintenance"
 	// ShootEventHibernationEnabled indicates that hibernation started.
@@ -1682,6 +2268,27 @@ const (
 	// ShootCRDsWithProblematicConversionWebhooks is a constant for a condition type indicating that the Shoot cluster has
 	// CRDs with conversion webhooks and multiple stored versions which can break the reconciliation flow of the cluster.
 	ShootCRDsWithProblematicConversionWebhooks ConditionType = "CRDsWithProblematicConversionWebhooks"
+	// ShootSSHAccessDisabled is a constant for a condition type indicating whether disabling the SSH access to the worker
+	// nodes has been fully enforced, i.e., all Bastions have been removed and no node has SSH public keys anymore.
+	ShootSSHAccessDisabled ConditionType = "SSHAccessDisabled"
+	// ShootUpgradePreflightChecksPassed is a constant for a condition type indicating whether the Shoot passed the
+	// pre-flight checks for an upgrade to the next Kubernetes minor version.
+	ShootUpgradePreflightChecksPassed ConditionType = "UpgradePreflightChecksPassed"
+	// ShootPodDisruptionBudgetsAllowNodeDrain is a constant for a condition type indicating whether the
+	// PodDisruptionBudgets in the Shoot cluster allow draining its nodes.
+	ShootPodDisruptionBudgetsAllowNodeDrain ConditionType = "PodDisruptionBudgetsAllowNodeDrain"
+	// ShootEncryptionConfigApplied is a constant for a condition type indicating whether the objects of the resources
+	// which were added to or removed from the encryption configuration of the kube-apiserver have been rewritten.
+	ShootEncryptionConfigApplied ConditionType = "EncryptionConfigApplied"
+	// ShootEtcdHighAvailabilityApplied is a constant for a condition type indicating whether the etcd clusters of a
+	// Shoot which started with a single-member etcd have been scaled up to highly available clusters.
+	ShootEtcdHighAvailabilityApplied ConditionType = "EtcdHighAvailabilityApplied"
+	// ShootBackupReady is a constant for a condition type indicating whether the latest full and delta snapshots of
+	// the main etcd of the Shoot are recent enough.
+	ShootBackupReady ConditionType = "BackupReady"
+	// ShootPolicyBundlesApplied is a constant for a condition type indicating whether the policy bundles distributed to
+	// the Shoot have been applied successfully.
+	ShootPolicyBundlesApplied ConditionType = "PolicyBundlesApplied"
 )
 
 // ShootPurpose is a type alias for string.
@@ -42,6 +42,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIServerEndpoint)(nil), (*core.APIServerEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIServerEndpoint_To_core_APIServerEndpoint(a.(*APIServerEndpoint), b.(*core.APIServerEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.APIServerEndpoint)(nil), (*APIServerEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_APIServerEndpoint_To_v1beta1_APIServerEndpoint(a.(*core.APIServerEndpoint), b.(*APIServerEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIServerEndpointStatus)(nil), (*core.APIServerEndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIServerEndpointStatus_To_core_APIServerEndpointStatus(a.(*APIServerEndpointStatus), b.(*core.APIServerEndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.APIServerEndpointStatus)(nil), (*APIServerEndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_APIServerEndpointStatus_To_v1beta1_APIServerEndpointStatus(a.(*core.APIServerEndpointStatus), b.(*APIServerEndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*APIServerLogging)(nil), (*core.APIServerLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_APIServerLogging_To_core_APIServerLogging(a.(*APIServerLogging), b.(*core.APIServerLogging), scope)
 	}); err != nil {
@@ -52,6 +72,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*APIServerPriorityAndFairness)(nil), (*core.APIServerPriorityAndFairness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIServerPriorityAndFairness_To_core_APIServerPriorityAndFairness(a.(*APIServerPriorityAndFairness), b.(*core.APIServerPriorityAndFairness), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.APIServerPriorityAndFairness)(nil), (*APIServerPriorityAndFairness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_APIServerPriorityAndFairness_To_v1beta1_APIServerPriorityAndFairness(a.(*core.APIServerPriorityAndFairness), b.(*APIServerPriorityAndFairness), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*APIServerRequests)(nil), (*core.APIServerRequests)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_APIServerRequests_To_core_APIServerRequests(a.(*APIServerRequests), b.(*core.APIServerRequests), scope)
 	}); err != nil {
@@ -62,6 +92,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*APIServerStaticIP)(nil), (*core.APIServerStaticIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIServerStaticIP_To_core_APIServerStaticIP(a.(*APIServerStaticIP), b.(*core.APIServerStaticIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.APIServerStaticIP)(nil), (*APIServerStaticIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_APIServerStaticIP_To_v1beta1_APIServerStaticIP(a.(*core.APIServerStaticIP), b.(*APIServerStaticIP), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Addon)(nil), (*core.Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Addon_To_core_Addon(a.(*Addon), b.(*core.Addon), scope)
 	}); err != nil {
@@ -92,6 +132,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdmissionWarning)(nil), (*core.AdmissionWarning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AdmissionWarning_To_core_AdmissionWarning(a.(*AdmissionWarning), b.(*core.AdmissionWarning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AdmissionWarning)(nil), (*AdmissionWarning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AdmissionWarning_To_v1beta1_AdmissionWarning(a.(*core.AdmissionWarning), b.(*AdmissionWarning), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Alerting)(nil), (*core.Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Alerting_To_core_Alerting(a.(*Alerting), b.(*core.Alerting), scope)
 	}); err != nil {
@@ -192,6 +242,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupEntryEncryption)(nil), (*core.BackupEntryEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupEntryEncryption_To_core_BackupEntryEncryption(a.(*BackupEntryEncryption), b.(*core.BackupEntryEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupEntryEncryption)(nil), (*BackupEntryEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupEntryEncryption_To_v1beta1_BackupEntryEncryption(a.(*core.BackupEntryEncryption), b.(*BackupEntryEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupEntryEncryptionStatus)(nil), (*core.BackupEntryEncryptionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupEntryEncryptionStatus_To_core_BackupEntryEncryptionStatus(a.(*BackupEntryEncryptionStatus), b.(*core.BackupEntryEncryptionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupEntryEncryptionStatus)(nil), (*BackupEntryEncryptionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupEntryEncryptionStatus_To_v1beta1_BackupEntryEncryptionStatus(a.(*core.BackupEntryEncryptionStatus), b.(*BackupEntryEncryptionStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupEntryList)(nil), (*core.BackupEntryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_BackupEntryList_To_core_BackupEntryList(a.(*BackupEntryList), b.(*core.BackupEntryList), scope)
 	}); err != nil {
@@ -312,6 +382,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneVPN)(nil), (*core.ControlPlaneVPN)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneVPN_To_core_ControlPlaneVPN(a.(*ControlPlaneVPN), b.(*core.ControlPlaneVPN), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneVPN)(nil), (*ControlPlaneVPN)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneVPN_To_v1beta1_ControlPlaneVPN(a.(*core.ControlPlaneVPN), b.(*ControlPlaneVPN), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeployment)(nil), (*core.ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(a.(*ControllerDeployment), b.(*core.ControllerDeployment), scope)
 	}); err != nil {
@@ -452,6 +532,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CoreDNSRewrite)(nil), (*core.CoreDNSRewrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CoreDNSRewrite_To_core_CoreDNSRewrite(a.(*CoreDNSRewrite), b.(*core.CoreDNSRewrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CoreDNSRewrite)(nil), (*CoreDNSRewrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CoreDNSRewrite_To_v1beta1_CoreDNSRewrite(a.(*core.CoreDNSRewrite), b.(*CoreDNSRewrite), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CoreDNSRewriting)(nil), (*core.CoreDNSRewriting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CoreDNSRewriting_To_core_CoreDNSRewriting(a.(*CoreDNSRewriting), b.(*core.CoreDNSRewriting), scope)
 	}); err != nil {
@@ -462,6 +552,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CoreDNSZone)(nil), (*core.CoreDNSZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CoreDNSZone_To_core_CoreDNSZone(a.(*CoreDNSZone), b.(*core.CoreDNSZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CoreDNSZone)(nil), (*CoreDNSZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CoreDNSZone_To_v1beta1_CoreDNSZone(a.(*core.CoreDNSZone), b.(*CoreDNSZone), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*core.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNS_To_core_DNS(a.(*DNS), b.(*core.DNS), scope)
 	}); err != nil {
@@ -662,6 +762,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InfrastructurePlan)(nil), (*core.InfrastructurePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InfrastructurePlan_To_core_InfrastructurePlan(a.(*InfrastructurePlan), b.(*core.InfrastructurePlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InfrastructurePlan)(nil), (*InfrastructurePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InfrastructurePlan_To_v1beta1_InfrastructurePlan(a.(*core.InfrastructurePlan), b.(*InfrastructurePlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InfrastructurePlannedChange)(nil), (*core.InfrastructurePlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InfrastructurePlannedChange_To_core_InfrastructurePlannedChange(a.(*InfrastructurePlannedChange), b.(*core.InfrastructurePlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InfrastructurePlannedChange)(nil), (*InfrastructurePlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InfrastructurePlannedChange_To_v1beta1_InfrastructurePlannedChange(a.(*core.InfrastructurePlannedChange), b.(*InfrastructurePlannedChange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Ingress)(nil), (*core.Ingress)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Ingress_To_core_Ingress(a.(*Ingress), b.(*core.Ingress), scope)
 	}); err != nil {
@@ -737,6 +857,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerProfile)(nil), (*core.KubeSchedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeSchedulerProfile_To_core_KubeSchedulerProfile(a.(*KubeSchedulerProfile), b.(*core.KubeSchedulerProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeSchedulerProfile)(nil), (*KubeSchedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeSchedulerProfile_To_v1beta1_KubeSchedulerProfile(a.(*core.KubeSchedulerProfile), b.(*KubeSchedulerProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerScorePlugin)(nil), (*core.KubeSchedulerScorePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeSchedulerScorePlugin_To_core_KubeSchedulerScorePlugin(a.(*KubeSchedulerScorePlugin), b.(*core.KubeSchedulerScorePlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeSchedulerScorePlugin)(nil), (*KubeSchedulerScorePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeSchedulerScorePlugin_To_v1beta1_KubeSchedulerScorePlugin(a.(*core.KubeSchedulerScorePlugin), b.(*KubeSchedulerScorePlugin), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeletConfig)(nil), (*core.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeletConfig_To_core_KubeletConfig(a.(*KubeletConfig), b.(*core.KubeletConfig), scope)
 	}); err != nil {
@@ -857,6 +997,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Logging)(nil), (*core.Logging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Logging_To_core_Logging(a.(*Logging), b.(*core.Logging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Logging)(nil), (*Logging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Logging_To_v1beta1_Logging(a.(*core.Logging), b.(*Logging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoggingQueryAPI)(nil), (*core.LoggingQueryAPI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoggingQueryAPI_To_core_LoggingQueryAPI(a.(*LoggingQueryAPI), b.(*core.LoggingQueryAPI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LoggingQueryAPI)(nil), (*LoggingQueryAPI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LoggingQueryAPI_To_v1beta1_LoggingQueryAPI(a.(*core.LoggingQueryAPI), b.(*LoggingQueryAPI), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Machine)(nil), (*core.Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Machine_To_core_Machine(a.(*Machine), b.(*core.Machine), scope)
 	}); err != nil {
@@ -867,6 +1027,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineCapabilities)(nil), (*core.MachineCapabilities)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineCapabilities_To_core_MachineCapabilities(a.(*MachineCapabilities), b.(*core.MachineCapabilities), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineCapabilities)(nil), (*MachineCapabilities)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineCapabilities_To_v1beta1_MachineCapabilities(a.(*core.MachineCapabilities), b.(*MachineCapabilities), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineControllerManagerSettings)(nil), (*core.MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineControllerManagerSettings_To_core_MachineControllerManagerSettings(a.(*MachineControllerManagerSettings), b.(*core.MachineControllerManagerSettings), scope)
 	}); err != nil {
@@ -887,6 +1057,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageUpdatePolicy)(nil), (*core.MachineImageUpdatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageUpdatePolicy_To_core_MachineImageUpdatePolicy(a.(*MachineImageUpdatePolicy), b.(*core.MachineImageUpdatePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineImageUpdatePolicy)(nil), (*MachineImageUpdatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineImageUpdatePolicy_To_v1beta1_MachineImageUpdatePolicy(a.(*core.MachineImageUpdatePolicy), b.(*MachineImageUpdatePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*core.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(a.(*MachineImageVersion), b.(*core.MachineImageVersion), scope)
 	}); err != nil {
@@ -897,6 +1077,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageVulnerability)(nil), (*core.MachineImageVulnerability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageVulnerability_To_core_MachineImageVulnerability(a.(*MachineImageVulnerability), b.(*core.MachineImageVulnerability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineImageVulnerability)(nil), (*MachineImageVulnerability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability(a.(*core.MachineImageVulnerability), b.(*MachineImageVulnerability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineRequirements)(nil), (*core.MachineRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineRequirements_To_core_MachineRequirements(a.(*MachineRequirements), b.(*core.MachineRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineRequirements)(nil), (*MachineRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineRequirements_To_v1beta1_MachineRequirements(a.(*core.MachineRequirements), b.(*MachineRequirements), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineType)(nil), (*core.MachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineType_To_core_MachineType(a.(*MachineType), b.(*core.MachineType), scope)
 	}); err != nil {
@@ -937,6 +1137,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceRecord)(nil), (*core.MaintenanceRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceRecord_To_core_MaintenanceRecord(a.(*MaintenanceRecord), b.(*core.MaintenanceRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceRecord)(nil), (*MaintenanceRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceRecord_To_v1beta1_MaintenanceRecord(a.(*core.MaintenanceRecord), b.(*MaintenanceRecord), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1037,6 +1247,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemConfigRollout)(nil), (*core.OperatingSystemConfigRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OperatingSystemConfigRollout_To_core_OperatingSystemConfigRollout(a.(*OperatingSystemConfigRollout), b.(*core.OperatingSystemConfigRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OperatingSystemConfigRollout)(nil), (*OperatingSystemConfigRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OperatingSystemConfigRollout_To_v1beta1_OperatingSystemConfigRollout(a.(*core.OperatingSystemConfigRollout), b.(*OperatingSystemConfigRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateDNS)(nil), (*core.PrivateDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PrivateDNS_To_core_PrivateDNS(a.(*PrivateDNS), b.(*core.PrivateDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PrivateDNS)(nil), (*PrivateDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PrivateDNS_To_v1beta1_PrivateDNS(a.(*core.PrivateDNS), b.(*PrivateDNS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*core.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_core_Project(a.(*Project), b.(*core.Project), scope)
 	}); err != nil {
@@ -1117,6 +1347,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReencryptedResource)(nil), (*core.ReencryptedResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ReencryptedResource_To_core_ReencryptedResource(a.(*ReencryptedResource), b.(*core.ReencryptedResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ReencryptedResource)(nil), (*ReencryptedResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ReencryptedResource_To_v1beta1_ReencryptedResource(a.(*core.ReencryptedResource), b.(*ReencryptedResource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1267,6 +1507,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingControlPlanePriority)(nil), (*core.SeedSettingControlPlanePriority)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingControlPlanePriority_To_core_SeedSettingControlPlanePriority(a.(*SeedSettingControlPlanePriority), b.(*core.SeedSettingControlPlanePriority), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingControlPlanePriority)(nil), (*SeedSettingControlPlanePriority)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingControlPlanePriority_To_v1beta1_SeedSettingControlPlanePriority(a.(*core.SeedSettingControlPlanePriority), b.(*SeedSettingControlPlanePriority), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingControlPlanePriorityTier)(nil), (*core.SeedSettingControlPlanePriorityTier)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingControlPlanePriorityTier_To_core_SeedSettingControlPlanePriorityTier(a.(*SeedSettingControlPlanePriorityTier), b.(*core.SeedSettingControlPlanePriorityTier), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingControlPlanePriorityTier)(nil), (*SeedSettingControlPlanePriorityTier)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingControlPlanePriorityTier_To_v1beta1_SeedSettingControlPlanePriorityTier(a.(*core.SeedSettingControlPlanePriorityTier), b.(*SeedSettingControlPlanePriorityTier), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingDependencyWatchdog)(nil), (*core.SeedSettingDependencyWatchdog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(a.(*SeedSettingDependencyWatchdog), b.(*core.SeedSettingDependencyWatchdog), scope)
 	}); err != nil {
@@ -1457,6 +1717,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServiceLevelObjectiveStatus)(nil), (*core.ServiceLevelObjectiveStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceLevelObjectiveStatus_To_core_ServiceLevelObjectiveStatus(a.(*ServiceLevelObjectiveStatus), b.(*core.ServiceLevelObjectiveStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ServiceLevelObjectiveStatus)(nil), (*ServiceLevelObjectiveStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ServiceLevelObjectiveStatus_To_v1beta1_ServiceLevelObjectiveStatus(a.(*core.ServiceLevelObjectiveStatus), b.(*ServiceLevelObjectiveStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Shoot)(nil), (*core.Shoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Shoot_To_core_Shoot(a.(*Shoot), b.(*core.Shoot), scope)
 	}); err != nil {
@@ -1497,6 +1767,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootDeletionPreview)(nil), (*core.ShootDeletionPreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootDeletionPreview_To_core_ShootDeletionPreview(a.(*ShootDeletionPreview), b.(*core.ShootDeletionPreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootDeletionPreview)(nil), (*ShootDeletionPreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootDeletionPreview_To_v1beta1_ShootDeletionPreview(a.(*core.ShootDeletionPreview), b.(*ShootDeletionPreview), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootKubeconfigRotation)(nil), (*core.ShootKubeconfigRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(a.(*ShootKubeconfigRotation), b.(*core.ShootKubeconfigRotation), scope)
 	}); err != nil {
@@ -1607,6 +1887,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StuckMachinePolicy)(nil), (*core.StuckMachinePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StuckMachinePolicy_To_core_StuckMachinePolicy(a.(*StuckMachinePolicy), b.(*core.StuckMachinePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.StuckMachinePolicy)(nil), (*StuckMachinePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_StuckMachinePolicy_To_v1beta1_StuckMachinePolicy(a.(*core.StuckMachinePolicy), b.(*StuckMachinePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SystemComponents)(nil), (*core.SystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SystemComponents_To_core_SystemComponents(a.(*SystemComponents), b.(*core.SystemComponents), scope)
 	}); err != nil {
@@ -1627,6 +1917,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TrustedCABundle)(nil), (*core.TrustedCABundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrustedCABundle_To_core_TrustedCABundle(a.(*TrustedCABundle), b.(*core.TrustedCABundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TrustedCABundle)(nil), (*TrustedCABundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TrustedCABundle_To_v1beta1_TrustedCABundle(a.(*core.TrustedCABundle), b.(*TrustedCABundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TypeDeprecation)(nil), (*core.TypeDeprecation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TypeDeprecation_To_core_TypeDeprecation(a.(*TypeDeprecation), b.(*core.TypeDeprecation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TypeDeprecation)(nil), (*TypeDeprecation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TypeDeprecation_To_v1beta1_TypeDeprecation(a.(*core.TypeDeprecation), b.(*TypeDeprecation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPNHighThroughput)(nil), (*core.VPNHighThroughput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VPNHighThroughput_To_core_VPNHighThroughput(a.(*VPNHighThroughput), b.(*core.VPNHighThroughput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VPNHighThroughput)(nil), (*VPNHighThroughput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VPNHighThroughput_To_v1beta1_VPNHighThroughput(a.(*core.VPNHighThroughput), b.(*VPNHighThroughput), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -1657,6 +1977,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VolumesDeletionPreview)(nil), (*core.VolumesDeletionPreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumesDeletionPreview_To_core_VolumesDeletionPreview(a.(*VolumesDeletionPreview), b.(*core.VolumesDeletionPreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VolumesDeletionPreview)(nil), (*VolumesDeletionPreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VolumesDeletionPreview_To_v1beta1_VolumesDeletionPreview(a.(*core.VolumesDeletionPreview), b.(*VolumesDeletionPreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VulnerabilityScanResult)(nil), (*core.VulnerabilityScanResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VulnerabilityScanResult_To_core_VulnerabilityScanResult(a.(*VulnerabilityScanResult), b.(*core.VulnerabilityScanResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VulnerabilityScanResult)(nil), (*VulnerabilityScanResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VulnerabilityScanResult_To_v1beta1_VulnerabilityScanResult(a.(*core.VulnerabilityScanResult), b.(*VulnerabilityScanResult), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WatchCacheSizes)(nil), (*core.WatchCacheSizes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WatchCacheSizes_To_core_WatchCacheSizes(a.(*WatchCacheSizes), b.(*core.WatchCacheSizes), scope)
 	}); err != nil {
@@ -1687,6 +2027,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolDeletionPreview)(nil), (*core.WorkerPoolDeletionPreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolDeletionPreview_To_core_WorkerPoolDeletionPreview(a.(*WorkerPoolDeletionPreview), b.(*core.WorkerPoolDeletionPreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolDeletionPreview)(nil), (*WorkerPoolDeletionPreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolDeletionPreview_To_v1beta1_WorkerPoolDeletionPreview(a.(*core.WorkerPoolDeletionPreview), b.(*WorkerPoolDeletionPreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolRolloutProgress)(nil), (*core.WorkerPoolRolloutProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolRolloutProgress_To_core_WorkerPoolRolloutProgress(a.(*WorkerPoolRolloutProgress), b.(*core.WorkerPoolRolloutProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolRolloutProgress)(nil), (*WorkerPoolRolloutProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolRolloutProgress_To_v1beta1_WorkerPoolRolloutProgress(a.(*core.WorkerPoolRolloutProgress), b.(*WorkerPoolRolloutProgress), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
 	}); err != nil {
@@ -1697,6 +2057,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerTopology)(nil), (*core.WorkerTopology)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerTopology_To_core_WorkerTopology(a.(*WorkerTopology), b.(*core.WorkerTopology), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerTopology)(nil), (*WorkerTopology)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerTopology_To_v1beta1_WorkerTopology(a.(*core.WorkerTopology), b.(*WorkerTopology), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerZoneRebalancing)(nil), (*core.WorkerZoneRebalancing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerZoneRebalancing_To_core_WorkerZoneRebalancing(a.(*WorkerZoneRebalancing), b.(*core.WorkerZoneRebalancing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerZoneRebalancing)(nil), (*WorkerZoneRebalancing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerZoneRebalancing_To_v1beta1_WorkerZoneRebalancing(a.(*core.WorkerZoneRebalancing), b.(*WorkerZoneRebalancing), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkersSettings)(nil), (*core.WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkersSettings_To_core_WorkersSettings(a.(*WorkersSettings), b.(*core.WorkersSettings), scope)
 	}); err != nil {
@@ -1735,6 +2115,50 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1beta1_APIServerEndpoint_To_core_APIServerEndpoint(in *APIServerEndpoint, out *core.APIServerEndpoint, s conversion.Scope) error {
+	out.AllowedSourceRanges = *(*[]string)(unsafe.Pointer(&in.AllowedSourceRanges))
+	out.StaticIP = (*core.APIServerStaticIP)(unsafe.Pointer(in.StaticIP))
+	return nil
+}
+
+// Convert_v1beta1_APIServerEndpoint_To_core_APIServerEndpoint is an autogenerated conversion function.
+func Convert_v1beta1_APIServerEndpoint_To_core_APIServerEndpoint(in *APIServerEndpoint, out *core.APIServerEndpoint, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIServerEndpoint_To_core_APIServerEndpoint(in, out, s)
+}
+
+func autoConvert_core_APIServerEndpoint_To_v1beta1_APIServerEndpoint(in *core.APIServerEndpoint, out *APIServerEndpoint, s conversion.Scope) error {
+	out.AllowedSourceRanges = *(*[]string)(unsafe.Pointer(&in.AllowedSourceRanges))
+	out.StaticIP = (*APIServerStaticIP)(unsafe.Pointer(in.StaticIP))
+	return nil
+}
+
+// Convert_core_APIServerEndpoint_To_v1beta1_APIServerEndpoint is an autogenerated conversion function.
+func Convert_core_APIServerEndpoint_To_v1beta1_APIServerEndpoint(in *core.APIServerEndpoint, out *APIServerEndpoint, s conversion.Scope) error {
+	return autoConvert_core_APIServerEndpoint_To_v1beta1_APIServerEndpoint(in, out, s)
+}
+
+func autoConvert_v1beta1_APIServerEndpointStatus_To_core_APIServerEndpointStatus(in *APIServerEndpointStatus, out *core.APIServerEndpointStatus, s conversion.Scope) error {
+	out.StaticIP = (*string)(unsafe.Pointer(in.StaticIP))
+	out.AllowedSourceRanges = *(*[]string)(unsafe.Pointer(&in.AllowedSourceRanges))
+	return nil
+}
+
+// Convert_v1beta1_APIServerEndpointStatus_To_core_APIServerEndpointStatus is an autogenerated conversion function.
+func Convert_v1beta1_APIServerEndpointStatus_To_core_APIServerEndpointStatus(in *APIServerEndpointStatus, out *core.APIServerEndpointStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIServerEndpointStatus_To_core_APIServerEndpointStatus(in, out, s)
+}
+
+func autoConvert_core_APIServerEndpointStatus_To_v1beta1_APIServerEndpointStatus(in *core.APIServerEndpointStatus, out *APIServerEndpointStatus, s conversion.Scope) error {
+	out.StaticIP = (*string)(unsafe.Pointer(in.StaticIP))
+	out.AllowedSourceRanges = *(*[]string)(unsafe.Pointer(&in.AllowedSourceRanges))
+	return nil
+}
+
+// Convert_core_APIServerEndpointStatus_To_v1beta1_APIServerEndpointStatus is an autogenerated conversion function.
+func Convert_core_APIServerEndpointStatus_To_v1beta1_APIServerEndpointStatus(in *core.APIServerEndpointStatus, out *APIServerEndpointStatus, s conversion.Scope) error {
+	return autoConvert_core_APIServerEndpointStatus_To_v1beta1_APIServerEndpointStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_APIServerLogging_To_core_APIServerLogging(in *APIServerLogging, out *core.APIServerLogging, s conversion.Scope) error {
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	out.HTTPAccessVerbosity = (*int32)(unsafe.Pointer(in.HTTPAccessVerbosity))
@@ -1757,9 +2181,30 @@ func Convert_core_APIServerLogging_To_v1beta1_APIServerLogging(in *core.APIServe
 	return autoConvert_core_APIServerLogging_To_v1beta1_APIServerLogging(in, out, s)
 }
 
+func autoConvert_v1beta1_APIServerPriorityAndFairness_To_core_APIServerPriorityAndFairness(in *APIServerPriorityAndFairness, out *core.APIServerPriorityAndFairness, s conversion.Scope) error {
+	out.Presets = *(*[]core.APIServerPriorityAndFairnessPreset)(unsafe.Pointer(&in.Presets))
+	return nil
+}
+
+// Convert_v1beta1_APIServerPriorityAndFairness_To_core_APIServerPriorityAndFairness is an autogenerated conversion function.
+func Convert_v1beta1_APIServerPriorityAndFairness_To_core_APIServerPriorityAndFairness(in *APIServerPriorityAndFairness, out *core.APIServerPriorityAndFairness, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIServerPriorityAndFairness_To_core_APIServerPriorityAndFairness(in, out, s)
+}
+
+func autoConvert_core_APIServerPriorityAndFairness_To_v1beta1_APIServerPriorityAndFairness(in *core.APIServerPriorityAndFairness, out *APIServerPriorityAndFairness, s conversion.Scope) error {
+	out.Presets = *(*[]APIServerPriorityAndFairnessPreset)(unsafe.Pointer(&in.Presets))
+	return nil
+}
+
+// Convert_core_APIServerPriorityAndFairness_To_v1beta1_APIServerPriorityAndFairness is an autogenerated conversion function.
+func Convert_core_APIServerPriorityAndFairness_To_v1beta1_APIServerPriorityAndFairness(in *core.APIServerPriorityAndFairness, out *APIServerPriorityAndFairness, s conversion.Scope) error {
+	return autoConvert_core_APIServerPriorityAndFairness_To_v1beta1_APIServerPriorityAndFairness(in, out, s)
+}
+
 func autoConvert_v1beta1_APIServerRequests_To_core_APIServerRequests(in *APIServerRequests, out *core.APIServerRequests, s conversion.Scope) error {
 	out.MaxNonMutatingInflight = (*int32)(unsafe.Pointer(in.MaxNonMutatingInflight))
 	out.MaxMutatingInflight = (*int32)(unsafe.Pointer(in.MaxMutatingInflight))
+	out.PriorityAndFairness = (*core.APIServerPriorityAndFairness)(unsafe.Pointer(in.PriorityAndFairness))
 	return nil
 }
 
@@ -1771,6 +2216,7 @@ func Convert_v1beta1_APIServerRequests_To_core_APIServerRequests(in *APIServerRe
 func autoConvert_core_APIServerRequests_To_v1beta1_APIServerRequests(in *core.APIServerRequests, out *APIServerRequests, s conversion.Scope) error {
 	out.MaxNonMutatingInflight = (*int32)(unsafe.Pointer(in.MaxNonMutatingInflight))
 	out.MaxMutatingInflight = (*int32)(unsafe.Pointer(in.MaxMutatingInflight))
+	out.PriorityAndFairness = (*APIServerPriorityAndFairness)(unsafe.Pointer(in.PriorityAndFairness))
 	return nil
 }
 
@@ -1779,6 +2225,28 @@ func Convert_core_APIServerRequests_To_v1beta1_APIServerRequests(in *core.APISer
 	return autoConvert_core_APIServerRequests_To_v1beta1_APIServerRequests(in, out, s)
 }
 
+func autoConvert_v1beta1_APIServerStaticIP_To_core_APIServerStaticIP(in *APIServerStaticIP, out *core.APIServerStaticIP, s conversion.Scope) error {
+	out.Address = (*string)(unsafe.Pointer(in.Address))
+	out.PTRRecord = (*string)(unsafe.Pointer(in.PTRRecord))
+	return nil
+}
+
+// Convert_v1beta1_APIServerStaticIP_To_core_APIServerStaticIP is an autogenerated conversion function.
+func Convert_v1beta1_APIServerStaticIP_To_core_APIServerStaticIP(in *APIServerStaticIP, out *core.APIServerStaticIP, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIServerStaticIP_To_core_APIServerStaticIP(in, out, s)
+}
+
+func autoConvert_core_APIServerStaticIP_To_v1beta1_APIServerStaticIP(in *core.APIServerStaticIP, out *APIServerStaticIP, s conversion.Scope) error {
+	out.Address = (*string)(unsafe.Pointer(in.Address))
+	out.PTRRecord = (*string)(unsafe.Pointer(in.PTRRecord))
+	return nil
+}
+
+// Convert_core_APIServerStaticIP_To_v1beta1_APIServerStaticIP is an autogenerated conversion function.
+func Convert_core_APIServerStaticIP_To_v1beta1_APIServerStaticIP(in *core.APIServerStaticIP, out *APIServerStaticIP, s conversion.Scope) error {
+	return autoConvert_core_APIServerStaticIP_To_v1beta1_APIServerStaticIP(in, out, s)
+}
+
 func autoConvert_v1beta1_Addon_To_core_Addon(in *Addon, out *core.Addon, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -1847,6 +2315,30 @@ func Convert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *core.AdmissionP
 	return autoConvert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in, out, s)
 }
 
+func autoConvert_v1beta1_AdmissionWarning_To_core_AdmissionWarning(in *AdmissionWarning, out *core.AdmissionWarning, s conversion.Scope) error {
+	out.Plugin = in.Plugin
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_AdmissionWarning_To_core_AdmissionWarning is an autogenerated conversion function.
+func Convert_v1beta1_AdmissionWarning_To_core_AdmissionWarning(in *AdmissionWarning, out *core.AdmissionWarning, s conversion.Scope) error {
+	return autoConvert_v1beta1_AdmissionWarning_To_core_AdmissionWarning(in, out, s)
+}
+
+func autoConvert_core_AdmissionWarning_To_v1beta1_AdmissionWarning(in *core.AdmissionWarning, out *AdmissionWarning, s conversion.Scope) error {
+	out.Plugin = in.Plugin
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_AdmissionWarning_To_v1beta1_AdmissionWarning is an autogenerated conversion function.
+func Convert_core_AdmissionWarning_To_v1beta1_AdmissionWarning(in *core.AdmissionWarning, out *AdmissionWarning, s conversion.Scope) error {
+	return autoConvert_core_AdmissionWarning_To_v1beta1_AdmissionWarning(in, out, s)
+}
+
 func autoConvert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
 	return nil
@@ -2097,6 +2589,48 @@ func Convert_core_BackupEntry_To_v1beta1_BackupEntry(in *core.BackupEntry, out *
 	return autoConvert_core_BackupEntry_To_v1beta1_BackupEntry(in, out, s)
 }
 
+func autoConvert_v1beta1_BackupEntryEncryption_To_core_BackupEntryEncryption(in *BackupEntryEncryption, out *core.BackupEntryEncryption, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1beta1_BackupEntryEncryption_To_core_BackupEntryEncryption is an autogenerated conversion function.
+func Convert_v1beta1_BackupEntryEncryption_To_core_BackupEntryEncryption(in *BackupEntryEncryption, out *core.BackupEntryEncryption, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupEntryEncryption_To_core_BackupEntryEncryption(in, out, s)
+}
+
+func autoConvert_core_BackupEntryEncryption_To_v1beta1_BackupEntryEncryption(in *core.BackupEntryEncryption, out *BackupEntryEncryption, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_core_BackupEntryEncryption_To_v1beta1_BackupEntryEncryption is an autogenerated conversion function.
+func Convert_core_BackupEntryEncryption_To_v1beta1_BackupEntryEncryption(in *core.BackupEntryEncryption, out *BackupEntryEncryption, s conversion.Scope) error {
+	return autoConvert_core_BackupEntryEncryption_To_v1beta1_BackupEntryEncryption(in, out, s)
+}
+
+func autoConvert_v1beta1_BackupEntryEncryptionStatus_To_core_BackupEntryEncryptionStatus(in *BackupEntryEncryptionStatus, out *core.BackupEntryEncryptionStatus, s conversion.Scope) error {
+	out.KeyChecksum = in.KeyChecksum
+	out.LastRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastRotationTime))
+	return nil
+}
+
+// Convert_v1beta1_BackupEntryEncryptionStatus_To_core_BackupEntryEncryptionStatus is an autogenerated conversion function.
+func Convert_v1beta1_BackupEntryEncryptionStatus_To_core_BackupEntryEncryptionStatus(in *BackupEntryEncryptionStatus, out *core.BackupEntryEncryptionStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupEntryEncryptionStatus_To_core_BackupEntryEncryptionStatus(in, out, s)
+}
+
+func autoConvert_core_BackupEntryEncryptionStatus_To_v1beta1_BackupEntryEncryptionStatus(in *core.BackupEntryEncryptionStatus, out *BackupEntryEncryptionStatus, s conversion.Scope) error {
+	out.KeyChecksum = in.KeyChecksum
+	out.LastRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastRotationTime))
+	return nil
+}
+
+// Convert_core_BackupEntryEncryptionStatus_To_v1beta1_BackupEntryEncryptionStatus is an autogenerated conversion function.
+func Convert_core_BackupEntryEncryptionStatus_To_v1beta1_BackupEntryEncryptionStatus(in *core.BackupEntryEncryptionStatus, out *BackupEntryEncryptionStatus, s conversion.Scope) error {
+	return autoConvert_core_BackupEntryEncryptionStatus_To_v1beta1_BackupEntryEncryptionStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_BackupEntryList_To_core_BackupEntryList(in *BackupEntryList, out *core.BackupEntryList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.BackupEntry)(unsafe.Pointer(&in.Items))
@@ -2122,6 +2656,7 @@ func Convert_core_BackupEntryList_To_v1beta1_BackupEntryList(in *core.BackupEntr
 func autoConvert_v1beta1_BackupEntrySpec_To_core_BackupEntrySpec(in *BackupEntrySpec, out *core.BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.Encryption = (*core.BackupEntryEncryption)(unsafe.Pointer(in.Encryption))
 	return nil
 }
 
@@ -2133,6 +2668,7 @@ func Convert_v1beta1_BackupEntrySpec_To_core_BackupEntrySpec(in *BackupEntrySpec
 func autoConvert_core_BackupEntrySpec_To_v1beta1_BackupEntrySpec(in *core.BackupEntrySpec, out *BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.Encryption = (*BackupEntryEncryption)(unsafe.Pointer(in.Encryption))
 	return nil
 }
 
@@ -2147,6 +2683,7 @@ func autoConvert_v1beta1_BackupEntryStatus_To_core_BackupEntryStatus(in *BackupE
 	out.ObservedGeneration = in.ObservedGeneration
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.Encryption = (*core.BackupEntryEncryptionStatus)(unsafe.Pointer(in.Encryption))
 	return nil
 }
 
@@ -2161,6 +2698,7 @@ func autoConvert_core_BackupEntryStatus_To_v1beta1_BackupEntryStatus(in *core.Ba
 	out.ObservedGeneration = in.ObservedGeneration
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.Encryption = (*BackupEntryEncryptionStatus)(unsafe.Pointer(in.Encryption))
 	return nil
 }
 
@@ -2405,6 +2943,7 @@ func Convert_core_ContainerRuntime_To_v1beta1_ContainerRuntime(in *core.Containe
 
 func autoConvert_v1beta1_ControlPlane_To_core_ControlPlane(in *ControlPlane, out *core.ControlPlane, s conversion.Scope) error {
 	out.HighAvailability = (*core.HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.VPN = (*core.ControlPlaneVPN)(unsafe.Pointer(in.VPN))
 	return nil
 }
 
@@ -2415,6 +2954,7 @@ func Convert_v1beta1_ControlPlane_To_core_ControlPlane(in *ControlPlane, out *co
 
 func autoConvert_core_ControlPlane_To_v1beta1_ControlPlane(in *core.ControlPlane, out *ControlPlane, s conversion.Scope) error {
 	out.HighAvailability = (*HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.VPN = (*ControlPlaneVPN)(unsafe.Pointer(in.VPN))
 	return nil
 }
 
@@ -2423,6 +2963,26 @@ func Convert_core_ControlPlane_To_v1beta1_ControlPlane(in *core.ControlPlane, ou
 	return autoConvert_core_ControlPlane_To_v1beta1_ControlPlane(in, out, s)
 }
 
+func autoConvert_v1beta1_ControlPlaneVPN_To_core_ControlPlaneVPN(in *ControlPlaneVPN, out *core.ControlPlaneVPN, s conversion.Scope) error {
+	out.HighThroughput = (*core.VPNHighThroughput)(unsafe.Pointer(in.HighThroughput))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneVPN_To_core_ControlPlaneVPN is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneVPN_To_core_ControlPlaneVPN(in *ControlPlaneVPN, out *core.ControlPlaneVPN, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneVPN_To_core_ControlPlaneVPN(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneVPN_To_v1beta1_ControlPlaneVPN(in *core.ControlPlaneVPN, out *ControlPlaneVPN, s conversion.Scope) error {
+	out.HighThroughput = (*VPNHighThroughput)(unsafe.Pointer(in.HighThroughput))
+	return nil
+}
+
+// Convert_core_ControlPlaneVPN_To_v1beta1_ControlPlaneVPN is an autogenerated conversion function.
+func Convert_core_ControlPlaneVPN_To_v1beta1_ControlPlaneVPN(in *core.ControlPlaneVPN, out *ControlPlaneVPN, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneVPN_To_v1beta1_ControlPlaneVPN(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Type = in.Type
@@ -2746,6 +3306,8 @@ func Convert_core_ControllerResourceLifecycle_To_v1beta1_ControllerResourceLifec
 func autoConvert_v1beta1_CoreDNS_To_core_CoreDNS(in *CoreDNS, out *core.CoreDNS, s conversion.Scope) error {
 	out.Autoscaling = (*core.CoreDNSAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	out.Rewriting = (*core.CoreDNSRewriting)(unsafe.Pointer(in.Rewriting))
+	out.Zones = *(*[]core.CoreDNSZone)(unsafe.Pointer(&in.Zones))
+	out.Rewrites = *(*[]core.CoreDNSRewrite)(unsafe.Pointer(&in.Rewrites))
 	return nil
 }
 
@@ -2757,6 +3319,8 @@ func Convert_v1beta1_CoreDNS_To_core_CoreDNS(in *CoreDNS, out *core.CoreDNS, s c
 func autoConvert_core_CoreDNS_To_v1beta1_CoreDNS(in *core.CoreDNS, out *CoreDNS, s conversion.Scope) error {
 	out.Autoscaling = (*CoreDNSAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	out.Rewriting = (*CoreDNSRewriting)(unsafe.Pointer(in.Rewriting))
+	out.Zones = *(*[]CoreDNSZone)(unsafe.Pointer(&in.Zones))
+	out.Rewrites = *(*[]CoreDNSRewrite)(unsafe.Pointer(&in.Rewrites))
 	return nil
 }
 
@@ -2785,6 +3349,30 @@ func Convert_core_CoreDNSAutoscaling_To_v1beta1_CoreDNSAutoscaling(in *core.Core
 	return autoConvert_core_CoreDNSAutoscaling_To_v1beta1_CoreDNSAutoscaling(in, out, s)
 }
 
+func autoConvert_v1beta1_CoreDNSRewrite_To_core_CoreDNSRewrite(in *CoreDNSRewrite, out *core.CoreDNSRewrite, s conversion.Scope) error {
+	out.Match = core.CoreDNSRewriteMatch(in.Match)
+	out.From = in.From
+	out.To = in.To
+	return nil
+}
+
+// Convert_v1beta1_CoreDNSRewrite_To_core_CoreDNSRewrite is an autogenerated conversion function.
+func Convert_v1beta1_CoreDNSRewrite_To_core_CoreDNSRewrite(in *CoreDNSRewrite, out *core.CoreDNSRewrite, s conversion.Scope) error {
+	return autoConvert_v1beta1_CoreDNSRewrite_To_core_CoreDNSRewrite(in, out, s)
+}
+
+func autoConvert_core_CoreDNSRewrite_To_v1beta1_CoreDNSRewrite(in *core.CoreDNSRewrite, out *CoreDNSRewrite, s conversion.Scope) error {
+	out.Match = CoreDNSRewriteMatch(in.Match)
+	out.From = in.From
+	out.To = in.To
+	return nil
+}
+
+// Convert_core_CoreDNSRewrite_To_v1beta1_CoreDNSRewrite is an autogenerated conversion function.
+func Convert_core_CoreDNSRewrite_To_v1beta1_CoreDNSRewrite(in *core.CoreDNSRewrite, out *CoreDNSRewrite, s conversion.Scope) error {
+	return autoConvert_core_CoreDNSRewrite_To_v1beta1_CoreDNSRewrite(in, out, s)
+}
+
 func autoConvert_v1beta1_CoreDNSRewriting_To_core_CoreDNSRewriting(in *CoreDNSRewriting, out *core.CoreDNSRewriting, s conversion.Scope) error {
 	out.CommonSuffixes = *(*[]string)(unsafe.Pointer(&in.CommonSuffixes))
 	return nil
@@ -2805,9 +3393,32 @@ func Convert_core_CoreDNSRewriting_To_v1beta1_CoreDNSRewriting(in *core.CoreDNSR
 	return autoConvert_core_CoreDNSRewriting_To_v1beta1_CoreDNSRewriting(in, out, s)
 }
 
+func autoConvert_v1beta1_CoreDNSZone_To_core_CoreDNSZone(in *CoreDNSZone, out *core.CoreDNSZone, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Upstreams = *(*[]string)(unsafe.Pointer(&in.Upstreams))
+	return nil
+}
+
+// Convert_v1beta1_CoreDNSZone_To_core_CoreDNSZone is an autogenerated conversion function.
+func Convert_v1beta1_CoreDNSZone_To_core_CoreDNSZone(in *CoreDNSZone, out *core.CoreDNSZone, s conversion.Scope) error {
+	return autoConvert_v1beta1_CoreDNSZone_To_core_CoreDNSZone(in, out, s)
+}
+
+func autoConvert_core_CoreDNSZone_To_v1beta1_CoreDNSZone(in *core.CoreDNSZone, out *CoreDNSZone, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Upstreams = *(*[]string)(unsafe.Pointer(&in.Upstreams))
+	return nil
+}
+
+// Convert_core_CoreDNSZone_To_v1beta1_CoreDNSZone is an autogenerated conversion function.
+func Convert_core_CoreDNSZone_To_v1beta1_CoreDNSZone(in *core.CoreDNSZone, out *CoreDNSZone, s conversion.Scope) error {
+	return autoConvert_core_CoreDNSZone_To_v1beta1_CoreDNSZone(in, out, s)
+}
+
 func autoConvert_v1beta1_DNS_To_core_DNS(in *DNS, out *core.DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Providers = *(*[]core.DNSProvider)(unsafe.Pointer(&in.Providers))
+	out.Private = (*core.PrivateDNS)(unsafe.Pointer(in.Private))
 	return nil
 }
 
@@ -2819,6 +3430,7 @@ func Convert_v1beta1_DNS_To_core_DNS(in *DNS, out *core.DNS, s conversion.Scope)
 func autoConvert_core_DNS_To_v1beta1_DNS(in *core.DNS, out *DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Providers = *(*[]DNSProvider)(unsafe.Pointer(&in.Providers))
+	out.Private = (*PrivateDNS)(unsafe.Pointer(in.Private))
 	return nil
 }
 
@@ -2929,6 +3541,7 @@ func autoConvert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRota
 	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
 	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.ReencryptedResources = *(*[]core.ReencryptedResource)(unsafe.Pointer(&in.ReencryptedResources))
 	return nil
 }
 
@@ -2943,6 +3556,7 @@ func autoConvert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRota
 	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.ReencryptedResources = *(*[]ReencryptedResource)(unsafe.Pointer(&in.ReencryptedResources))
 	return nil
 }
 
@@ -3283,6 +3897,56 @@ func Convert_core_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscal
 	return autoConvert_core_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_InfrastructurePlan_To_core_InfrastructurePlan(in *InfrastructurePlan, out *core.InfrastructurePlan, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Supported = in.Supported
+	out.Changes = *(*[]core.InfrastructurePlannedChange)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_v1beta1_InfrastructurePlan_To_core_InfrastructurePlan is an autogenerated conversion function.
+func Convert_v1beta1_InfrastructurePlan_To_core_InfrastructurePlan(in *InfrastructurePlan, out *core.InfrastructurePlan, s conversion.Scope) error {
+	return autoConvert_v1beta1_InfrastructurePlan_To_core_InfrastructurePlan(in, out, s)
+}
+
+func autoConvert_core_InfrastructurePlan_To_v1beta1_InfrastructurePlan(in *core.InfrastructurePlan, out *InfrastructurePlan, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Supported = in.Supported
+	out.Changes = *(*[]InfrastructurePlannedChange)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_core_InfrastructurePlan_To_v1beta1_InfrastructurePlan is an autogenerated conversion function.
+func Convert_core_InfrastructurePlan_To_v1beta1_InfrastructurePlan(in *core.InfrastructurePlan, out *InfrastructurePlan, s conversion.Scope) error {
+	return autoConvert_core_InfrastructurePlan_To_v1beta1_InfrastructurePlan(in, out, s)
+}
+
+func autoConvert_v1beta1_InfrastructurePlannedChange_To_core_InfrastructurePlannedChange(in *InfrastructurePlannedChange, out *core.InfrastructurePlannedChange, s conversion.Scope) error {
+	out.Action = in.Action
+	out.ResourceType = in.ResourceType
+	out.Name = in.Name
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	return nil
+}
+
+// Convert_v1beta1_InfrastructurePlannedChange_To_core_InfrastructurePlannedChange is an autogenerated conversion function.
+func Convert_v1beta1_InfrastructurePlannedChange_To_core_InfrastructurePlannedChange(in *InfrastructurePlannedChange, out *core.InfrastructurePlannedChange, s conversion.Scope) error {
+	return autoConvert_v1beta1_InfrastructurePlannedChange_To_core_InfrastructurePlannedChange(in, out, s)
+}
+
+func autoConvert_core_InfrastructurePlannedChange_To_v1beta1_InfrastructurePlannedChange(in *core.InfrastructurePlannedChange, out *InfrastructurePlannedChange, s conversion.Scope) error {
+	out.Action = in.Action
+	out.ResourceType = in.ResourceType
+	out.Name = in.Name
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	return nil
+}
+
+// Convert_core_InfrastructurePlannedChange_To_v1beta1_InfrastructurePlannedChange is an autogenerated conversion function.
+func Convert_core_InfrastructurePlannedChange_To_v1beta1_InfrastructurePlannedChange(in *core.InfrastructurePlannedChange, out *InfrastructurePlannedChange, s conversion.Scope) error {
+	return autoConvert_core_InfrastructurePlannedChange_To_v1beta1_InfrastructurePlannedChange(in, out, s)
+}
+
 func autoConvert_v1beta1_Ingress_To_core_Ingress(in *Ingress, out *core.Ingress, s conversion.Scope) error {
 	out.Domain = in.Domain
 	if err := Convert_v1beta1_IngressController_To_core_IngressController(&in.Controller, &out.Controller, s); err != nil {
@@ -3423,6 +4087,7 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
 	out.EncryptionConfig = (*core.EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	out.Endpoint = (*core.APIServerEndpoint)(unsafe.Pointer(in.Endpoint))
 	return nil
 }
 
@@ -3459,6 +4124,7 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	out.Endpoint = (*APIServerEndpoint)(unsafe.Pointer(in.Endpoint))
 	return nil
 }
 
@@ -3533,6 +4199,7 @@ func autoConvert_v1beta1_KubeSchedulerConfig_To_core_KubeSchedulerConfig(in *Kub
 	}
 	out.KubeMaxPDVols = (*string)(unsafe.Pointer(in.KubeMaxPDVols))
 	out.Profile = (*core.SchedulingProfile)(unsafe.Pointer(in.Profile))
+	out.Profiles = *(*[]core.KubeSchedulerProfile)(unsafe.Pointer(&in.Profiles))
 	return nil
 }
 
@@ -3547,6 +4214,7 @@ func autoConvert_core_KubeSchedulerConfig_To_v1beta1_KubeSchedulerConfig(in *cor
 	}
 	out.KubeMaxPDVols = (*string)(unsafe.Pointer(in.KubeMaxPDVols))
 	out.Profile = (*SchedulingProfile)(unsafe.Pointer(in.Profile))
+	out.Profiles = *(*[]KubeSchedulerProfile)(unsafe.Pointer(&in.Profiles))
 	return nil
 }
 
@@ -3555,6 +4223,52 @@ func Convert_core_KubeSchedulerConfig_To_v1beta1_KubeSchedulerConfig(in *core.Ku
 	return autoConvert_core_KubeSchedulerConfig_To_v1beta1_KubeSchedulerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeSchedulerProfile_To_core_KubeSchedulerProfile(in *KubeSchedulerProfile, out *core.KubeSchedulerProfile, s conversion.Scope) error {
+	out.SchedulerName = in.SchedulerName
+	out.BinPacking = (*bool)(unsafe.Pointer(in.BinPacking))
+	out.ScorePlugins = *(*[]core.KubeSchedulerScorePlugin)(unsafe.Pointer(&in.ScorePlugins))
+	return nil
+}
+
+// Convert_v1beta1_KubeSchedulerProfile_To_core_KubeSchedulerProfile is an autogenerated conversion function.
+func Convert_v1beta1_KubeSchedulerProfile_To_core_KubeSchedulerProfile(in *KubeSchedulerProfile, out *core.KubeSchedulerProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeSchedulerProfile_To_core_KubeSchedulerProfile(in, out, s)
+}
+
+func autoConvert_core_KubeSchedulerProfile_To_v1beta1_KubeSchedulerProfile(in *core.KubeSchedulerProfile, out *KubeSchedulerProfile, s conversion.Scope) error {
+	out.SchedulerName = in.SchedulerName
+	out.BinPacking = (*bool)(unsafe.Pointer(in.BinPacking))
+	out.ScorePlugins = *(*[]KubeSchedulerScorePlugin)(unsafe.Pointer(&in.ScorePlugins))
+	return nil
+}
+
+// Convert_core_KubeSchedulerProfile_To_v1beta1_KubeSchedulerProfile is an autogenerated conversion function.
+func Convert_core_KubeSchedulerProfile_To_v1beta1_KubeSchedulerProfile(in *core.KubeSchedulerProfile, out *KubeSchedulerProfile, s conversion.Scope) error {
+	return autoConvert_core_KubeSchedulerProfile_To_v1beta1_KubeSchedulerProfile(in, out, s)
+}
+
+func autoConvert_v1beta1_KubeSchedulerScorePlugin_To_core_KubeSchedulerScorePlugin(in *KubeSchedulerScorePlugin, out *core.KubeSchedulerScorePlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1beta1_KubeSchedulerScorePlugin_To_core_KubeSchedulerScorePlugin is an autogenerated conversion function.
+func Convert_v1beta1_KubeSchedulerScorePlugin_To_core_KubeSchedulerScorePlugin(in *KubeSchedulerScorePlugin, out *core.KubeSchedulerScorePlugin, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeSchedulerScorePlugin_To_core_KubeSchedulerScorePlugin(in, out, s)
+}
+
+func autoConvert_core_KubeSchedulerScorePlugin_To_v1beta1_KubeSchedulerScorePlugin(in *core.KubeSchedulerScorePlugin, out *KubeSchedulerScorePlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_core_KubeSchedulerScorePlugin_To_v1beta1_KubeSchedulerScorePlugin is an autogenerated conversion function.
+func Convert_core_KubeSchedulerScorePlugin_To_v1beta1_KubeSchedulerScorePlugin(in *core.KubeSchedulerScorePlugin, out *KubeSchedulerScorePlugin, s conversion.Scope) error {
+	return autoConvert_core_KubeSchedulerScorePlugin_To_v1beta1_KubeSchedulerScorePlugin(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig, out *core.KubeletConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_core_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -3584,6 +4298,7 @@ func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig,
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
 	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*core.MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
+	out.ImageMinimumGCAge = (*metav1.Duration)(unsafe.Pointer(in.ImageMinimumGCAge))
 	return nil
 }
 
@@ -3621,6 +4336,7 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
 	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
+	out.ImageMinimumGCAge = (*metav1.Duration)(unsafe.Pointer(in.ImageMinimumGCAge))
 	return nil
 }
 
@@ -3906,6 +4622,7 @@ func autoConvert_v1beta1_LastMaintenance_To_core_LastMaintenance(in *LastMainten
 	out.TriggeredTime = in.TriggeredTime
 	out.State = core.LastOperationState(in.State)
 	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	out.OutOfWindow = (*bool)(unsafe.Pointer(in.OutOfWindow))
 	return nil
 }
 
@@ -3919,6 +4636,7 @@ func autoConvert_core_LastMaintenance_To_v1beta1_LastMaintenance(in *core.LastMa
 	out.TriggeredTime = in.TriggeredTime
 	out.State = LastOperationState(in.State)
 	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	out.OutOfWindow = (*bool)(unsafe.Pointer(in.OutOfWindow))
 	return nil
 }
 
@@ -3950,9 +4668,53 @@ func autoConvert_core_LastOperation_To_v1beta1_LastOperation(in *core.LastOperat
 	return nil
 }
 
-// Convert_core_LastOperation_To_v1beta1_LastOperation is an autogenerated conversion function.
-func Convert_core_LastOperation_To_v1beta1_LastOperation(in *core.LastOperation, out *LastOperation, s conversion.Scope) error {
-	return autoConvert_core_LastOperation_To_v1beta1_LastOperation(in, out, s)
+// Convert_core_LastOperation_To_v1beta1_LastOperation is an autogenerated conversion function.
+func Convert_core_LastOperation_To_v1beta1_LastOperation(in *core.LastOperation, out *LastOperation, s conversion.Scope) error {
+	return autoConvert_core_LastOperation_To_v1beta1_LastOperation(in, out, s)
+}
+
+func autoConvert_v1beta1_Logging_To_core_Logging(in *Logging, out *core.Logging, s conversion.Scope) error {
+	out.Retention = (*metav1.Duration)(unsafe.Pointer(in.Retention))
+	out.StorageQuota = (*resource.Quantity)(unsafe.Pointer(in.StorageQuota))
+	out.QueryAPI = (*core.LoggingQueryAPI)(unsafe.Pointer(in.QueryAPI))
+	return nil
+}
+
+// Convert_v1beta1_Logging_To_core_Logging is an autogenerated conversion function.
+func Convert_v1beta1_Logging_To_core_Logging(in *Logging, out *core.Logging, s conversion.Scope) error {
+	return autoConvert_v1beta1_Logging_To_core_Logging(in, out, s)
+}
+
+func autoConvert_core_Logging_To_v1beta1_Logging(in *core.Logging, out *Logging, s conversion.Scope) error {
+	out.Retention = (*metav1.Duration)(unsafe.Pointer(in.Retention))
+	out.StorageQuota = (*resource.Quantity)(unsafe.Pointer(in.StorageQuota))
+	out.QueryAPI = (*LoggingQueryAPI)(unsafe.Pointer(in.QueryAPI))
+	return nil
+}
+
+// Convert_core_Logging_To_v1beta1_Logging is an autogenerated conversion function.
+func Convert_core_Logging_To_v1beta1_Logging(in *core.Logging, out *Logging, s conversion.Scope) error {
+	return autoConvert_core_Logging_To_v1beta1_Logging(in, out, s)
+}
+
+func autoConvert_v1beta1_LoggingQueryAPI_To_core_LoggingQueryAPI(in *LoggingQueryAPI, out *core.LoggingQueryAPI, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_LoggingQueryAPI_To_core_LoggingQueryAPI is an autogenerated conversion function.
+func Convert_v1beta1_LoggingQueryAPI_To_core_LoggingQueryAPI(in *LoggingQueryAPI, out *core.LoggingQueryAPI, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoggingQueryAPI_To_core_LoggingQueryAPI(in, out, s)
+}
+
+func autoConvert_core_LoggingQueryAPI_To_v1beta1_LoggingQueryAPI(in *core.LoggingQueryAPI, out *LoggingQueryAPI, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_core_LoggingQueryAPI_To_v1beta1_LoggingQueryAPI is an autogenerated conversion function.
+func Convert_core_LoggingQueryAPI_To_v1beta1_LoggingQueryAPI(in *core.LoggingQueryAPI, out *LoggingQueryAPI, s conversion.Scope) error {
+	return autoConvert_core_LoggingQueryAPI_To_v1beta1_LoggingQueryAPI(in, out, s)
 }
 
 func autoConvert_v1beta1_Machine_To_core_Machine(in *Machine, out *core.Machine, s conversion.Scope) error {
@@ -3967,6 +4729,7 @@ func autoConvert_v1beta1_Machine_To_core_Machine(in *Machine, out *core.Machine,
 		out.Image = nil
 	}
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Requirements = (*core.MachineRequirements)(unsafe.Pointer(in.Requirements))
 	return nil
 }
 
@@ -3987,6 +4750,7 @@ func autoConvert_core_Machine_To_v1beta1_Machine(in *core.Machine, out *Machine,
 		out.Image = nil
 	}
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Requirements = (*MachineRequirements)(unsafe.Pointer(in.Requirements))
 	return nil
 }
 
@@ -3995,12 +4759,35 @@ func Convert_core_Machine_To_v1beta1_Machine(in *core.Machine, out *Machine, s c
 	return autoConvert_core_Machine_To_v1beta1_Machine(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineCapabilities_To_core_MachineCapabilities(in *MachineCapabilities, out *core.MachineCapabilities, s conversion.Scope) error {
+	out.ConfidentialComputing = (*bool)(unsafe.Pointer(in.ConfidentialComputing))
+	out.NestedVirtualization = (*bool)(unsafe.Pointer(in.NestedVirtualization))
+	return nil
+}
+
+// Convert_v1beta1_MachineCapabilities_To_core_MachineCapabilities is an autogenerated conversion function.
+func Convert_v1beta1_MachineCapabilities_To_core_MachineCapabilities(in *MachineCapabilities, out *core.MachineCapabilities, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineCapabilities_To_core_MachineCapabilities(in, out, s)
+}
+
+func autoConvert_core_MachineCapabilities_To_v1beta1_MachineCapabilities(in *core.MachineCapabilities, out *MachineCapabilities, s conversion.Scope) error {
+	out.ConfidentialComputing = (*bool)(unsafe.Pointer(in.ConfidentialComputing))
+	out.NestedVirtualization = (*bool)(unsafe.Pointer(in.NestedVirtualization))
+	return nil
+}
+
+// Convert_core_MachineCapabilities_To_v1beta1_MachineCapabilities is an autogenerated conversion function.
+func Convert_core_MachineCapabilities_To_v1beta1_MachineCapabilities(in *core.MachineCapabilities, out *MachineCapabilities, s conversion.Scope) error {
+	return autoConvert_core_MachineCapabilities_To_v1beta1_MachineCapabilities(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineControllerManagerSettings_To_core_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *core.MachineControllerManagerSettings, s conversion.Scope) error {
 	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
 	out.MachineHealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
 	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = *(*[]string)(unsafe.Pointer(&in.NodeConditions))
+	out.StuckMachinePolicy = (*core.StuckMachinePolicy)(unsafe.Pointer(in.StuckMachinePolicy))
 	return nil
 }
 
@@ -4015,6 +4802,7 @@ func autoConvert_core_MachineControllerManagerSettings_To_v1beta1_MachineControl
 	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = *(*[]string)(unsafe.Pointer(&in.NodeConditions))
+	out.StuckMachinePolicy = (*StuckMachinePolicy)(unsafe.Pointer(in.StuckMachinePolicy))
 	return nil
 }
 
@@ -4047,6 +4835,26 @@ func Convert_core_MachineImage_To_v1beta1_MachineImage(in *core.MachineImage, ou
 	return autoConvert_core_MachineImage_To_v1beta1_MachineImage(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageUpdatePolicy_To_core_MachineImageUpdatePolicy(in *MachineImageUpdatePolicy, out *core.MachineImageUpdatePolicy, s conversion.Scope) error {
+	out.PinPatchStream = (*bool)(unsafe.Pointer(in.PinPatchStream))
+	return nil
+}
+
+// Convert_v1beta1_MachineImageUpdatePolicy_To_core_MachineImageUpdatePolicy is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageUpdatePolicy_To_core_MachineImageUpdatePolicy(in *MachineImageUpdatePolicy, out *core.MachineImageUpdatePolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageUpdatePolicy_To_core_MachineImageUpdatePolicy(in, out, s)
+}
+
+func autoConvert_core_MachineImageUpdatePolicy_To_v1beta1_MachineImageUpdatePolicy(in *core.MachineImageUpdatePolicy, out *MachineImageUpdatePolicy, s conversion.Scope) error {
+	out.PinPatchStream = (*bool)(unsafe.Pointer(in.PinPatchStream))
+	return nil
+}
+
+// Convert_core_MachineImageUpdatePolicy_To_v1beta1_MachineImageUpdatePolicy is an autogenerated conversion function.
+func Convert_core_MachineImageUpdatePolicy_To_v1beta1_MachineImageUpdatePolicy(in *core.MachineImageUpdatePolicy, out *MachineImageUpdatePolicy, s conversion.Scope) error {
+	return autoConvert_core_MachineImageUpdatePolicy_To_v1beta1_MachineImageUpdatePolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(in *MachineImageVersion, out *core.MachineImageVersion, s conversion.Scope) error {
 	if err := Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(&in.ExpirableVersion, &out.ExpirableVersion, s); err != nil {
 		return err
@@ -4054,6 +4862,8 @@ func autoConvert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(in *Mac
 	out.CRI = *(*[]core.CRI)(unsafe.Pointer(&in.CRI))
 	out.Architectures = *(*[]string)(unsafe.Pointer(&in.Architectures))
 	out.KubeletVersionConstraint = (*string)(unsafe.Pointer(in.KubeletVersionConstraint))
+	out.Vulnerabilities = *(*[]core.MachineImageVulnerability)(unsafe.Pointer(&in.Vulnerabilities))
+	out.Capabilities = (*core.MachineCapabilities)(unsafe.Pointer(in.Capabilities))
 	return nil
 }
 
@@ -4069,6 +4879,8 @@ func autoConvert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in *cor
 	out.CRI = *(*[]CRI)(unsafe.Pointer(&in.CRI))
 	out.Architectures = *(*[]string)(unsafe.Pointer(&in.Architectures))
 	out.KubeletVersionConstraint = (*string)(unsafe.Pointer(in.KubeletVersionConstraint))
+	out.Vulnerabilities = *(*[]MachineImageVulnerability)(unsafe.Pointer(&in.Vulnerabilities))
+	out.Capabilities = (*MachineCapabilities)(unsafe.Pointer(in.Capabilities))
 	return nil
 }
 
@@ -4077,6 +4889,52 @@ func Convert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in *core.Ma
 	return autoConvert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageVulnerability_To_core_MachineImageVulnerability(in *MachineImageVulnerability, out *core.MachineImageVulnerability, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Severity = core.VulnerabilitySeverity(in.Severity)
+	return nil
+}
+
+// Convert_v1beta1_MachineImageVulnerability_To_core_MachineImageVulnerability is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageVulnerability_To_core_MachineImageVulnerability(in *MachineImageVulnerability, out *core.MachineImageVulnerability, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageVulnerability_To_core_MachineImageVulnerability(in, out, s)
+}
+
+func autoConvert_core_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability(in *core.MachineImageVulnerability, out *MachineImageVulnerability, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Severity = VulnerabilitySeverity(in.Severity)
+	return nil
+}
+
+// Convert_core_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability is an autogenerated conversion function.
+func Convert_core_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability(in *core.MachineImageVulnerability, out *MachineImageVulnerability, s conversion.Scope) error {
+	return autoConvert_core_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineRequirements_To_core_MachineRequirements(in *MachineRequirements, out *core.MachineRequirements, s conversion.Scope) error {
+	out.GPU = (*bool)(unsafe.Pointer(in.GPU))
+	out.ConfidentialComputing = (*bool)(unsafe.Pointer(in.ConfidentialComputing))
+	out.NestedVirtualization = (*bool)(unsafe.Pointer(in.NestedVirtualization))
+	return nil
+}
+
+// Convert_v1beta1_MachineRequirements_To_core_MachineRequirements is an autogenerated conversion function.
+func Convert_v1beta1_MachineRequirements_To_core_MachineRequirements(in *MachineRequirements, out *core.MachineRequirements, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineRequirements_To_core_MachineRequirements(in, out, s)
+}
+
+func autoConvert_core_MachineRequirements_To_v1beta1_MachineRequirements(in *core.MachineRequirements, out *MachineRequirements, s conversion.Scope) error {
+	out.GPU = (*bool)(unsafe.Pointer(in.GPU))
+	out.ConfidentialComputing = (*bool)(unsafe.Pointer(in.ConfidentialComputing))
+	out.NestedVirtualization = (*bool)(unsafe.Pointer(in.NestedVirtualization))
+	return nil
+}
+
+// Convert_core_MachineRequirements_To_v1beta1_MachineRequirements is an autogenerated conversion function.
+func Convert_core_MachineRequirements_To_v1beta1_MachineRequirements(in *core.MachineRequirements, out *MachineRequirements, s conversion.Scope) error {
+	return autoConvert_core_MachineRequirements_To_v1beta1_MachineRequirements(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *core.MachineType, s conversion.Scope) error {
 	out.CPU = in.CPU
 	out.GPU = in.GPU
@@ -4085,6 +4943,11 @@ func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *c
 	out.Storage = (*core.MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.GPUResourceName = (*string)(unsafe.Pointer(in.GPUResourceName))
+	out.ExtendedResources = *(*v1.ResourceList)(unsafe.Pointer(&in.ExtendedResources))
+	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
+	out.Deprecation = (*core.TypeDeprecation)(unsafe.Pointer(in.Deprecation))
+	out.Capabilities = (*core.MachineCapabilities)(unsafe.Pointer(in.Capabilities))
 	return nil
 }
 
@@ -4101,6 +4964,11 @@ func autoConvert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, o
 	out.Storage = (*MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.GPUResourceName = (*string)(unsafe.Pointer(in.GPUResourceName))
+	out.ExtendedResources = *(*v1.ResourceList)(unsafe.Pointer(&in.ExtendedResources))
+	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
+	out.Deprecation = (*TypeDeprecation)(unsafe.Pointer(in.Deprecation))
+	out.Capabilities = (*MachineCapabilities)(unsafe.Pointer(in.Capabilities))
 	return nil
 }
 
@@ -4162,6 +5030,7 @@ func Convert_core_Maintenance_To_v1beta1_Maintenance(in *core.Maintenance, out *
 func autoConvert_v1beta1_MaintenanceAutoUpdate_To_core_MaintenanceAutoUpdate(in *MaintenanceAutoUpdate, out *core.MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
 	out.MachineImageVersion = (*bool)(unsafe.Pointer(in.MachineImageVersion))
+	out.DeprecatedTypes = (*bool)(unsafe.Pointer(in.DeprecatedTypes))
 	return nil
 }
 
@@ -4173,6 +5042,7 @@ func Convert_v1beta1_MaintenanceAutoUpdate_To_core_MaintenanceAutoUpdate(in *Mai
 func autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *core.MaintenanceAutoUpdate, out *MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
 	out.MachineImageVersion = (*bool)(unsafe.Pointer(in.MachineImageVersion))
+	out.DeprecatedTypes = (*bool)(unsafe.Pointer(in.DeprecatedTypes))
 	return nil
 }
 
@@ -4181,6 +5051,36 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceRecord_To_core_MaintenanceRecord(in *MaintenanceRecord, out *core.MaintenanceRecord, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Field = in.Field
+	out.OldValue = (*string)(unsafe.Pointer(in.OldValue))
+	out.NewValue = (*string)(unsafe.Pointer(in.NewValue))
+	out.Reason = in.Reason
+	out.OutOfWindow = (*bool)(unsafe.Pointer(in.OutOfWindow))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceRecord_To_core_MaintenanceRecord is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceRecord_To_core_MaintenanceRecord(in *MaintenanceRecord, out *core.MaintenanceRecord, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceRecord_To_core_MaintenanceRecord(in, out, s)
+}
+
+func autoConvert_core_MaintenanceRecord_To_v1beta1_MaintenanceRecord(in *core.MaintenanceRecord, out *MaintenanceRecord, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Field = in.Field
+	out.OldValue = (*string)(unsafe.Pointer(in.OldValue))
+	out.NewValue = (*string)(unsafe.Pointer(in.NewValue))
+	out.Reason = in.Reason
+	out.OutOfWindow = (*bool)(unsafe.Pointer(in.OutOfWindow))
+	return nil
+}
+
+// Convert_core_MaintenanceRecord_To_v1beta1_MaintenanceRecord is an autogenerated conversion function.
+func Convert_core_MaintenanceRecord_To_v1beta1_MaintenanceRecord(in *core.MaintenanceRecord, out *MaintenanceRecord, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceRecord_To_v1beta1_MaintenanceRecord(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -4433,6 +5333,54 @@ func Convert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClie
 	return autoConvert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClientAuthentication(in, out, s)
 }
 
+func autoConvert_v1beta1_OperatingSystemConfigRollout_To_core_OperatingSystemConfigRollout(in *OperatingSystemConfigRollout, out *core.OperatingSystemConfigRollout, s conversion.Scope) error {
+	out.CanaryNodes = in.CanaryNodes
+	out.HealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	return nil
+}
+
+// Convert_v1beta1_OperatingSystemConfigRollout_To_core_OperatingSystemConfigRollout is an autogenerated conversion function.
+func Convert_v1beta1_OperatingSystemConfigRollout_To_core_OperatingSystemConfigRollout(in *OperatingSystemConfigRollout, out *core.OperatingSystemConfigRollout, s conversion.Scope) error {
+	return autoConvert_v1beta1_OperatingSystemConfigRollout_To_core_OperatingSystemConfigRollout(in, out, s)
+}
+
+func autoConvert_core_OperatingSystemConfigRollout_To_v1beta1_OperatingSystemConfigRollout(in *core.OperatingSystemConfigRollout, out *OperatingSystemConfigRollout, s conversion.Scope) error {
+	out.CanaryNodes = in.CanaryNodes
+	out.HealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	return nil
+}
+
+// Convert_core_OperatingSystemConfigRollout_To_v1beta1_OperatingSystemConfigRollout is an autogenerated conversion function.
+func Convert_core_OperatingSystemConfigRollout_To_v1beta1_OperatingSystemConfigRollout(in *core.OperatingSystemConfigRollout, out *OperatingSystemConfigRollout, s conversion.Scope) error {
+	return autoConvert_core_OperatingSystemConfigRollout_To_v1beta1_OperatingSystemConfigRollout(in, out, s)
+}
+
+func autoConvert_v1beta1_PrivateDNS_To_core_PrivateDNS(in *PrivateDNS, out *core.PrivateDNS, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.Type = in.Type
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	return nil
+}
+
+// Convert_v1beta1_PrivateDNS_To_core_PrivateDNS is an autogenerated conversion function.
+func Convert_v1beta1_PrivateDNS_To_core_PrivateDNS(in *PrivateDNS, out *core.PrivateDNS, s conversion.Scope) error {
+	return autoConvert_v1beta1_PrivateDNS_To_core_PrivateDNS(in, out, s)
+}
+
+func autoConvert_core_PrivateDNS_To_v1beta1_PrivateDNS(in *core.PrivateDNS, out *PrivateDNS, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.Type = in.Type
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	return nil
+}
+
+// Convert_core_PrivateDNS_To_v1beta1_PrivateDNS is an autogenerated conversion function.
+func Convert_core_PrivateDNS_To_v1beta1_PrivateDNS(in *core.PrivateDNS, out *PrivateDNS, s conversion.Scope) error {
+	return autoConvert_core_PrivateDNS_To_v1beta1_PrivateDNS(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_core_Project(in *Project, out *core.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_core_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4568,6 +5516,7 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -4582,6 +5531,7 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -4732,6 +5682,30 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_ReencryptedResource_To_core_ReencryptedResource(in *ReencryptedResource, out *core.ReencryptedResource, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.Total = in.Total
+	out.Rewritten = in.Rewritten
+	return nil
+}
+
+// Convert_v1beta1_ReencryptedResource_To_core_ReencryptedResource is an autogenerated conversion function.
+func Convert_v1beta1_ReencryptedResource_To_core_ReencryptedResource(in *ReencryptedResource, out *core.ReencryptedResource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ReencryptedResource_To_core_ReencryptedResource(in, out, s)
+}
+
+func autoConvert_core_ReencryptedResource_To_v1beta1_ReencryptedResource(in *core.ReencryptedResource, out *ReencryptedResource, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.Total = in.Total
+	out.Rewritten = in.Rewritten
+	return nil
+}
+
+// Convert_core_ReencryptedResource_To_v1beta1_ReencryptedResource is an autogenerated conversion function.
+func Convert_core_ReencryptedResource_To_v1beta1_ReencryptedResource(in *core.ReencryptedResource, out *ReencryptedResource, s conversion.Scope) error {
+	return autoConvert_core_ReencryptedResource_To_v1beta1_ReencryptedResource(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
@@ -5110,6 +6084,52 @@ func Convert_core_SeedSelector_To_v1beta1_SeedSelector(in *core.SeedSelector, ou
 	return autoConvert_core_SeedSelector_To_v1beta1_SeedSelector(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingControlPlanePriority_To_core_SeedSettingControlPlanePriority(in *SeedSettingControlPlanePriority, out *core.SeedSettingControlPlanePriority, s conversion.Scope) error {
+	out.Tiers = *(*[]core.SeedSettingControlPlanePriorityTier)(unsafe.Pointer(&in.Tiers))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingControlPlanePriority_To_core_SeedSettingControlPlanePriority is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingControlPlanePriority_To_core_SeedSettingControlPlanePriority(in *SeedSettingControlPlanePriority, out *core.SeedSettingControlPlanePriority, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingControlPlanePriority_To_core_SeedSettingControlPlanePriority(in, out, s)
+}
+
+func autoConvert_core_SeedSettingControlPlanePriority_To_v1beta1_SeedSettingControlPlanePriority(in *core.SeedSettingControlPlanePriority, out *SeedSettingControlPlanePriority, s conversion.Scope) error {
+	out.Tiers = *(*[]SeedSettingControlPlanePriorityTier)(unsafe.Pointer(&in.Tiers))
+	return nil
+}
+
+// Convert_core_SeedSettingControlPlanePriority_To_v1beta1_SeedSettingControlPlanePriority is an autogenerated conversion function.
+func Convert_core_SeedSettingControlPlanePriority_To_v1beta1_SeedSettingControlPlanePriority(in *core.SeedSettingControlPlanePriority, out *SeedSettingControlPlanePriority, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingControlPlanePriority_To_v1beta1_SeedSettingControlPlanePriority(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettingControlPlanePriorityTier_To_core_SeedSettingControlPlanePriorityTier(in *SeedSettingControlPlanePriorityTier, out *core.SeedSettingControlPlanePriorityTier, s conversion.Scope) error {
+	out.Purposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.Purposes))
+	out.ETCD = (*string)(unsafe.Pointer(in.ETCD))
+	out.KubeAPIServer = (*string)(unsafe.Pointer(in.KubeAPIServer))
+	out.KubeControllerManager = (*string)(unsafe.Pointer(in.KubeControllerManager))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingControlPlanePriorityTier_To_core_SeedSettingControlPlanePriorityTier is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingControlPlanePriorityTier_To_core_SeedSettingControlPlanePriorityTier(in *SeedSettingControlPlanePriorityTier, out *core.SeedSettingControlPlanePriorityTier, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingControlPlanePriorityTier_To_core_SeedSettingControlPlanePriorityTier(in, out, s)
+}
+
+func autoConvert_core_SeedSettingControlPlanePriorityTier_To_v1beta1_SeedSettingControlPlanePriorityTier(in *core.SeedSettingControlPlanePriorityTier, out *SeedSettingControlPlanePriorityTier, s conversion.Scope) error {
+	out.Purposes = *(*[]ShootPurpose)(unsafe.Pointer(&in.Purposes))
+	out.ETCD = (*string)(unsafe.Pointer(in.ETCD))
+	out.KubeAPIServer = (*string)(unsafe.Pointer(in.KubeAPIServer))
+	out.KubeControllerManager = (*string)(unsafe.Pointer(in.KubeControllerManager))
+	return nil
+}
+
+// Convert_core_SeedSettingControlPlanePriorityTier_To_v1beta1_SeedSettingControlPlanePriorityTier is an autogenerated conversion function.
+func Convert_core_SeedSettingControlPlanePriorityTier_To_v1beta1_SeedSettingControlPlanePriorityTier(in *core.SeedSettingControlPlanePriorityTier, out *SeedSettingControlPlanePriorityTier, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingControlPlanePriorityTier_To_v1beta1_SeedSettingControlPlanePriorityTier(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(in *SeedSettingDependencyWatchdog, out *core.SeedSettingDependencyWatchdog, s conversion.Scope) error {
 	out.Weeder = (*core.SeedSettingDependencyWatchdogWeeder)(unsafe.Pointer(in.Weeder))
 	out.Prober = (*core.SeedSettingDependencyWatchdogProber)(unsafe.Pointer(in.Prober))
@@ -5333,6 +6353,7 @@ func autoConvert_v1beta1_SeedSettings_To_core_SeedSettings(in *SeedSettings, out
 	out.VerticalPodAutoscaler = (*core.SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*core.SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*core.SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.ControlPlanePriority = (*core.SeedSettingControlPlanePriority)(unsafe.Pointer(in.ControlPlanePriority))
 	return nil
 }
 
@@ -5348,6 +6369,7 @@ func autoConvert_core_SeedSettings_To_v1beta1_SeedSettings(in *core.SeedSettings
 	out.VerticalPodAutoscaler = (*SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.ControlPlanePriority = (*SeedSettingControlPlanePriority)(unsafe.Pointer(in.ControlPlanePriority))
 	return nil
 }
 
@@ -5535,6 +6557,7 @@ func autoConvert_v1beta1_ServiceAccountConfig_To_core_ServiceAccountConfig(in *S
 	out.ExtendTokenExpiration = (*bool)(unsafe.Pointer(in.ExtendTokenExpiration))
 	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	out.AcceptedIssuers = *(*[]string)(unsafe.Pointer(&in.AcceptedIssuers))
+	out.SigningKeyRotationPeriod = (*metav1.Duration)(unsafe.Pointer(in.SigningKeyRotationPeriod))
 	return nil
 }
 
@@ -5548,6 +6571,7 @@ func autoConvert_core_ServiceAccountConfig_To_v1beta1_ServiceAccountConfig(in *c
 	out.ExtendTokenExpiration = (*bool)(unsafe.Pointer(in.ExtendTokenExpiration))
 	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	out.AcceptedIssuers = *(*[]string)(unsafe.Pointer(&in.AcceptedIssuers))
+	out.SigningKeyRotationPeriod = (*metav1.Duration)(unsafe.Pointer(in.SigningKeyRotationPeriod))
 	return nil
 }
 
@@ -5584,6 +6608,36 @@ func Convert_core_ServiceAccountKeyRotation_To_v1beta1_ServiceAccountKeyRotation
 	return autoConvert_core_ServiceAccountKeyRotation_To_v1beta1_ServiceAccountKeyRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_ServiceLevelObjectiveStatus_To_core_ServiceLevelObjectiveStatus(in *ServiceLevelObjectiveStatus, out *core.ServiceLevelObjectiveStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Objective = in.Objective
+	out.Window = in.Window
+	out.Current = (*string)(unsafe.Pointer(in.Current))
+	out.ErrorBudgetRemaining = (*string)(unsafe.Pointer(in.ErrorBudgetRemaining))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ServiceLevelObjectiveStatus_To_core_ServiceLevelObjectiveStatus is an autogenerated conversion function.
+func Convert_v1beta1_ServiceLevelObjectiveStatus_To_core_ServiceLevelObjectiveStatus(in *ServiceLevelObjectiveStatus, out *core.ServiceLevelObjectiveStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceLevelObjectiveStatus_To_core_ServiceLevelObjectiveStatus(in, out, s)
+}
+
+func autoConvert_core_ServiceLevelObjectiveStatus_To_v1beta1_ServiceLevelObjectiveStatus(in *core.ServiceLevelObjectiveStatus, out *ServiceLevelObjectiveStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Objective = in.Objective
+	out.Window = in.Window
+	out.Current = (*string)(unsafe.Pointer(in.Current))
+	out.ErrorBudgetRemaining = (*string)(unsafe.Pointer(in.ErrorBudgetRemaining))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ServiceLevelObjectiveStatus_To_v1beta1_ServiceLevelObjectiveStatus is an autogenerated conversion function.
+func Convert_core_ServiceLevelObjectiveStatus_To_v1beta1_ServiceLevelObjectiveStatus(in *core.ServiceLevelObjectiveStatus, out *ServiceLevelObjectiveStatus, s conversion.Scope) error {
+	return autoConvert_core_ServiceLevelObjectiveStatus_To_v1beta1_ServiceLevelObjectiveStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Shoot_To_core_Shoot(in *Shoot, out *core.Shoot, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ShootSpec_To_core_ShootSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -5688,6 +6742,34 @@ func Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(i
 	return autoConvert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootDeletionPreview_To_core_ShootDeletionPreview(in *ShootDeletionPreview, out *core.ShootDeletionPreview, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.WorkerPools = *(*[]core.WorkerPoolDeletionPreview)(unsafe.Pointer(&in.WorkerPools))
+	out.Volumes = (*core.VolumesDeletionPreview)(unsafe.Pointer(in.Volumes))
+	out.DNSRecords = *(*[]string)(unsafe.Pointer(&in.DNSRecords))
+	out.BackupRetention = (*metav1.Duration)(unsafe.Pointer(in.BackupRetention))
+	return nil
+}
+
+// Convert_v1beta1_ShootDeletionPreview_To_core_ShootDeletionPreview is an autogenerated conversion function.
+func Convert_v1beta1_ShootDeletionPreview_To_core_ShootDeletionPreview(in *ShootDeletionPreview, out *core.ShootDeletionPreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootDeletionPreview_To_core_ShootDeletionPreview(in, out, s)
+}
+
+func autoConvert_core_ShootDeletionPreview_To_v1beta1_ShootDeletionPreview(in *core.ShootDeletionPreview, out *ShootDeletionPreview, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.WorkerPools = *(*[]WorkerPoolDeletionPreview)(unsafe.Pointer(&in.WorkerPools))
+	out.Volumes = (*VolumesDeletionPreview)(unsafe.Pointer(in.Volumes))
+	out.DNSRecords = *(*[]string)(unsafe.Pointer(&in.DNSRecords))
+	out.BackupRetention = (*metav1.Duration)(unsafe.Pointer(in.BackupRetention))
+	return nil
+}
+
+// Convert_core_ShootDeletionPreview_To_v1beta1_ShootDeletionPreview is an autogenerated conversion function.
+func Convert_core_ShootDeletionPreview_To_v1beta1_ShootDeletionPreview(in *core.ShootDeletionPreview, out *ShootDeletionPreview, s conversion.Scope) error {
+	return autoConvert_core_ShootDeletionPreview_To_v1beta1_ShootDeletionPreview(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
 	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -5835,6 +6917,7 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	}
 	out.Networking = (*core.Networking)(unsafe.Pointer(in.Networking))
 	out.Maintenance = (*core.Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Logging = (*core.Logging)(unsafe.Pointer(in.Logging))
 	out.Monitoring = (*core.Monitoring)(unsafe.Pointer(in.Monitoring))
 	if err := Convert_v1beta1_Provider_To_core_Provider(&in.Provider, &out.Provider, s); err != nil {
 		return err
@@ -5850,6 +6933,7 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.SystemComponents = (*core.SystemComponents)(unsafe.Pointer(in.SystemComponents))
 	out.ControlPlane = (*core.ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
+	out.TrustedCABundles = *(*[]core.TrustedCABundle)(unsafe.Pointer(&in.TrustedCABundles))
 	return nil
 }
 
@@ -5869,6 +6953,7 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	}
 	out.Networking = (*Networking)(unsafe.Pointer(in.Networking))
 	out.Maintenance = (*Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Logging = (*Logging)(unsafe.Pointer(in.Logging))
 	out.Monitoring = (*Monitoring)(unsafe.Pointer(in.Monitoring))
 	if err := Convert_core_Provider_To_v1beta1_Provider(&in.Provider, &out.Provider, s); err != nil {
 		return err
@@ -5884,6 +6969,7 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.SystemComponents = (*SystemComponents)(unsafe.Pointer(in.SystemComponents))
 	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
+	out.TrustedCABundles = *(*[]TrustedCABundle)(unsafe.Pointer(&in.TrustedCABundles))
 	return nil
 }
 
@@ -5985,6 +7071,14 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.ServiceLevelObjectives = *(*[]core.ServiceLevelObjectiveStatus)(unsafe.Pointer(&in.ServiceLevelObjectives))
+	out.AdmissionWarnings = *(*[]core.AdmissionWarning)(unsafe.Pointer(&in.AdmissionWarnings))
+	out.APIServerEndpoint = (*core.APIServerEndpointStatus)(unsafe.Pointer(in.APIServerEndpoint))
+	out.VulnerabilityScanResults = *(*[]core.VulnerabilityScanResult)(unsafe.Pointer(&in.VulnerabilityScanResults))
+	out.MaintenanceHistory = *(*[]core.MaintenanceRecord)(unsafe.Pointer(&in.MaintenanceHistory))
+	out.InfrastructurePlan = (*core.InfrastructurePlan)(unsafe.Pointer(in.InfrastructurePlan))
+	out.DeletionPreview = (*core.ShootDeletionPreview)(unsafe.Pointer(in.DeletionPreview))
+	out.WorkerRolloutProgress = *(*[]core.WorkerPoolRolloutProgress)(unsafe.Pointer(&in.WorkerRolloutProgress))
 	return nil
 }
 
@@ -6014,6 +7108,14 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.ServiceLevelObjectives = *(*[]ServiceLevelObjectiveStatus)(unsafe.Pointer(&in.ServiceLevelObjectives))
+	out.AdmissionWarnings = *(*[]AdmissionWarning)(unsafe.Pointer(&in.AdmissionWarnings))
+	out.APIServerEndpoint = (*APIServerEndpointStatus)(unsafe.Pointer(in.APIServerEndpoint))
+	out.VulnerabilityScanResults = *(*[]VulnerabilityScanResult)(unsafe.Pointer(&in.VulnerabilityScanResults))
+	out.MaintenanceHistory = *(*[]MaintenanceRecord)(unsafe.Pointer(&in.MaintenanceHistory))
+	out.InfrastructurePlan = (*InfrastructurePlan)(unsafe.Pointer(in.InfrastructurePlan))
+	out.DeletionPreview = (*ShootDeletionPreview)(unsafe.Pointer(in.DeletionPreview))
+	out.WorkerRolloutProgress = *(*[]WorkerPoolRolloutProgress)(unsafe.Pointer(&in.WorkerRolloutProgress))
 	return nil
 }
 
@@ -6048,9 +7150,32 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 	return autoConvert_core_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_StuckMachinePolicy_To_core_StuckMachinePolicy(in *StuckMachinePolicy, out *core.StuckMachinePolicy, s conversion.Scope) error {
+	out.Action = core.StuckMachineAction(in.Action)
+	out.FailureThreshold = (*int32)(unsafe.Pointer(in.FailureThreshold))
+	return nil
+}
+
+// Convert_v1beta1_StuckMachinePolicy_To_core_StuckMachinePolicy is an autogenerated conversion function.
+func Convert_v1beta1_StuckMachinePolicy_To_core_StuckMachinePolicy(in *StuckMachinePolicy, out *core.StuckMachinePolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_StuckMachinePolicy_To_core_StuckMachinePolicy(in, out, s)
+}
+
+func autoConvert_core_StuckMachinePolicy_To_v1beta1_StuckMachinePolicy(in *core.StuckMachinePolicy, out *StuckMachinePolicy, s conversion.Scope) error {
+	out.Action = StuckMachineAction(in.Action)
+	out.FailureThreshold = (*int32)(unsafe.Pointer(in.FailureThreshold))
+	return nil
+}
+
+// Convert_core_StuckMachinePolicy_To_v1beta1_StuckMachinePolicy is an autogenerated conversion function.
+func Convert_core_StuckMachinePolicy_To_v1beta1_StuckMachinePolicy(in *core.StuckMachinePolicy, out *StuckMachinePolicy, s conversion.Scope) error {
+	return autoConvert_core_StuckMachinePolicy_To_v1beta1_StuckMachinePolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemComponents, out *core.SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*core.CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*core.NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.UserProvided = *(*[]core.UserProvidedSystemComponent)(unsafe.Pointer(&in.UserProvided))
 	return nil
 }
 
@@ -6062,6 +7187,7 @@ func Convert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemCompone
 func autoConvert_core_SystemComponents_To_v1beta1_SystemComponents(in *core.SystemComponents, out *SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.UserProvided = *(*[]UserProvidedSystemComponent)(unsafe.Pointer(&in.UserProvided))
 	return nil
 }
 
@@ -6092,6 +7218,70 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_TrustedCABundle_To_core_TrustedCABundle(in *TrustedCABundle, out *core.TrustedCABundle, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CABundle = in.CABundle
+	return nil
+}
+
+// Convert_v1beta1_TrustedCABundle_To_core_TrustedCABundle is an autogenerated conversion function.
+func Convert_v1beta1_TrustedCABundle_To_core_TrustedCABundle(in *TrustedCABundle, out *core.TrustedCABundle, s conversion.Scope) error {
+	return autoConvert_v1beta1_TrustedCABundle_To_core_TrustedCABundle(in, out, s)
+}
+
+func autoConvert_core_TrustedCABundle_To_v1beta1_TrustedCABundle(in *core.TrustedCABundle, out *TrustedCABundle, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CABundle = in.CABundle
+	return nil
+}
+
+// Convert_core_TrustedCABundle_To_v1beta1_TrustedCABundle is an autogenerated conversion function.
+func Convert_core_TrustedCABundle_To_v1beta1_TrustedCABundle(in *core.TrustedCABundle, out *TrustedCABundle, s conversion.Scope) error {
+	return autoConvert_core_TrustedCABundle_To_v1beta1_TrustedCABundle(in, out, s)
+}
+
+func autoConvert_v1beta1_TypeDeprecation_To_core_TypeDeprecation(in *TypeDeprecation, out *core.TypeDeprecation, s conversion.Scope) error {
+	out.Successor = (*string)(unsafe.Pointer(in.Successor))
+	return nil
+}
+
+// Convert_v1beta1_TypeDeprecation_To_core_TypeDeprecation is an autogenerated conversion function.
+func Convert_v1beta1_TypeDeprecation_To_core_TypeDeprecation(in *TypeDeprecation, out *core.TypeDeprecation, s conversion.Scope) error {
+	return autoConvert_v1beta1_TypeDeprecation_To_core_TypeDeprecation(in, out, s)
+}
+
+func autoConvert_core_TypeDeprecation_To_v1beta1_TypeDeprecation(in *core.TypeDeprecation, out *TypeDeprecation, s conversion.Scope) error {
+	out.Successor = (*string)(unsafe.Pointer(in.Successor))
+	return nil
+}
+
+// Convert_core_TypeDeprecation_To_v1beta1_TypeDeprecation is an autogenerated conversion function.
+func Convert_core_TypeDeprecation_To_v1beta1_TypeDeprecation(in *core.TypeDeprecation, out *TypeDeprecation, s conversion.Scope) error {
+	return autoConvert_core_TypeDeprecation_To_v1beta1_TypeDeprecation(in, out, s)
+}
+
+func autoConvert_v1beta1_VPNHighThroughput_To_core_VPNHighThroughput(in *VPNHighThroughput, out *core.VPNHighThroughput, s conversion.Scope) error {
+	out.MinTunnels = (*int32)(unsafe.Pointer(in.MinTunnels))
+	out.MaxTunnels = (*int32)(unsafe.Pointer(in.MaxTunnels))
+	return nil
+}
+
+// Convert_v1beta1_VPNHighThroughput_To_core_VPNHighThroughput is an autogenerated conversion function.
+func Convert_v1beta1_VPNHighThroughput_To_core_VPNHighThroughput(in *VPNHighThroughput, out *core.VPNHighThroughput, s conversion.Scope) error {
+	return autoConvert_v1beta1_VPNHighThroughput_To_core_VPNHighThroughput(in, out, s)
+}
+
+func autoConvert_core_VPNHighThroughput_To_v1beta1_VPNHighThroughput(in *core.VPNHighThroughput, out *VPNHighThroughput, s conversion.Scope) error {
+	out.MinTunnels = (*int32)(unsafe.Pointer(in.MinTunnels))
+	out.MaxTunnels = (*int32)(unsafe.Pointer(in.MaxTunnels))
+	return nil
+}
+
+// Convert_core_VPNHighThroughput_To_v1beta1_VPNHighThroughput is an autogenerated conversion function.
+func Convert_core_VPNHighThroughput_To_v1beta1_VPNHighThroughput(in *core.VPNHighThroughput, out *VPNHighThroughput, s conversion.Scope) error {
+	return autoConvert_core_VPNHighThroughput_To_v1beta1_VPNHighThroughput(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -6101,6 +7291,10 @@ func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in
 	out.RecommendationMarginFraction = (*float64)(unsafe.Pointer(in.RecommendationMarginFraction))
 	out.UpdaterInterval = (*metav1.Duration)(unsafe.Pointer(in.UpdaterInterval))
 	out.RecommenderInterval = (*metav1.Duration)(unsafe.Pointer(in.RecommenderInterval))
+	out.TargetCPUPercentile = (*float64)(unsafe.Pointer(in.TargetCPUPercentile))
+	out.TargetMemoryPercentile = (*float64)(unsafe.Pointer(in.TargetMemoryPercentile))
+	out.CPUHistogramDecayHalfLife = (*metav1.Duration)(unsafe.Pointer(in.CPUHistogramDecayHalfLife))
+	out.MemoryHistogramDecayHalfLife = (*metav1.Duration)(unsafe.Pointer(in.MemoryHistogramDecayHalfLife))
 	return nil
 }
 
@@ -6118,6 +7312,10 @@ func autoConvert_core_VerticalPodAutoscaler_To_v1beta1_VerticalPodAutoscaler(in
 	out.RecommendationMarginFraction = (*float64)(unsafe.Pointer(in.RecommendationMarginFraction))
 	out.UpdaterInterval = (*metav1.Duration)(unsafe.Pointer(in.UpdaterInterval))
 	out.RecommenderInterval = (*metav1.Duration)(unsafe.Pointer(in.RecommenderInterval))
+	out.TargetCPUPercentile = (*float64)(unsafe.Pointer(in.TargetCPUPercentile))
+	out.TargetMemoryPercentile = (*float64)(unsafe.Pointer(in.TargetMemoryPercentile))
+	out.CPUHistogramDecayHalfLife = (*metav1.Duration)(unsafe.Pointer(in.CPUHistogramDecayHalfLife))
+	out.MemoryHistogramDecayHalfLife = (*metav1.Duration)(unsafe.Pointer(in.MemoryHistogramDecayHalfLife))
 	return nil
 }
 
@@ -6157,6 +7355,7 @@ func autoConvert_v1beta1_VolumeType_To_core_VolumeType(in *VolumeType, out *core
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.Deprecation = (*core.TypeDeprecation)(unsafe.Pointer(in.Deprecation))
 	return nil
 }
 
@@ -6170,6 +7369,7 @@ func autoConvert_core_VolumeType_To_v1beta1_VolumeType(in *core.VolumeType, out
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.Deprecation = (*TypeDeprecation)(unsafe.Pointer(in.Deprecation))
 	return nil
 }
 
@@ -6178,6 +7378,56 @@ func Convert_core_VolumeType_To_v1beta1_VolumeType(in *core.VolumeType, out *Vol
 	return autoConvert_core_VolumeType_To_v1beta1_VolumeType(in, out, s)
 }
 
+func autoConvert_v1beta1_VolumesDeletionPreview_To_core_VolumesDeletionPreview(in *VolumesDeletionPreview, out *core.VolumesDeletionPreview, s conversion.Scope) error {
+	out.Deleted = in.Deleted
+	out.Retained = in.Retained
+	return nil
+}
+
+// Convert_v1beta1_VolumesDeletionPreview_To_core_VolumesDeletionPreview is an autogenerated conversion function.
+func Convert_v1beta1_VolumesDeletionPreview_To_core_VolumesDeletionPreview(in *VolumesDeletionPreview, out *core.VolumesDeletionPreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_VolumesDeletionPreview_To_core_VolumesDeletionPreview(in, out, s)
+}
+
+func autoConvert_core_VolumesDeletionPreview_To_v1beta1_VolumesDeletionPreview(in *core.VolumesDeletionPreview, out *VolumesDeletionPreview, s conversion.Scope) error {
+	out.Deleted = in.Deleted
+	out.Retained = in.Retained
+	return nil
+}
+
+// Convert_core_VolumesDeletionPreview_To_v1beta1_VolumesDeletionPreview is an autogenerated conversion function.
+func Convert_core_VolumesDeletionPreview_To_v1beta1_VolumesDeletionPreview(in *core.VolumesDeletionPreview, out *VolumesDeletionPreview, s conversion.Scope) error {
+	return autoConvert_core_VolumesDeletionPreview_To_v1beta1_VolumesDeletionPreview(in, out, s)
+}
+
+func autoConvert_v1beta1_VulnerabilityScanResult_To_core_VulnerabilityScanResult(in *VulnerabilityScanResult, out *core.VulnerabilityScanResult, s conversion.Scope) error {
+	out.WorkerPool = in.WorkerPool
+	out.MachineImageName = in.MachineImageName
+	out.MachineImageVersion = in.MachineImageVersion
+	out.Vulnerabilities = *(*[]core.MachineImageVulnerability)(unsafe.Pointer(&in.Vulnerabilities))
+	out.ScanTime = in.ScanTime
+	return nil
+}
+
+// Convert_v1beta1_VulnerabilityScanResult_To_core_VulnerabilityScanResult is an autogenerated conversion function.
+func Convert_v1beta1_VulnerabilityScanResult_To_core_VulnerabilityScanResult(in *VulnerabilityScanResult, out *core.VulnerabilityScanResult, s conversion.Scope) error {
+	return autoConvert_v1beta1_VulnerabilityScanResult_To_core_VulnerabilityScanResult(in, out, s)
+}
+
+func autoConvert_core_VulnerabilityScanResult_To_v1beta1_VulnerabilityScanResult(in *core.VulnerabilityScanResult, out *VulnerabilityScanResult, s conversion.Scope) error {
+	out.WorkerPool = in.WorkerPool
+	out.MachineImageName = in.MachineImageName
+	out.MachineImageVersion = in.MachineImageVersion
+	out.Vulnerabilities = *(*[]MachineImageVulnerability)(unsafe.Pointer(&in.Vulnerabilities))
+	out.ScanTime = in.ScanTime
+	return nil
+}
+
+// Convert_core_VulnerabilityScanResult_To_v1beta1_VulnerabilityScanResult is an autogenerated conversion function.
+func Convert_core_VulnerabilityScanResult_To_v1beta1_VulnerabilityScanResult(in *core.VulnerabilityScanResult, out *VulnerabilityScanResult, s conversion.Scope) error {
+	return autoConvert_core_VulnerabilityScanResult_To_v1beta1_VulnerabilityScanResult(in, out, s)
+}
+
 func autoConvert_v1beta1_WatchCacheSizes_To_core_WatchCacheSizes(in *WatchCacheSizes, out *core.WatchCacheSizes, s conversion.Scope) error {
 	out.Default = (*int32)(unsafe.Pointer(in.Default))
 	out.Resources = *(*[]core.ResourceWatchCacheSize)(unsafe.Pointer(&in.Resources))
@@ -6231,6 +7481,11 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.SystemComponents = (*core.WorkerSystemComponents)(unsafe.Pointer(in.SystemComponents))
 	out.MachineControllerManagerSettings = (*core.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.MachineImageUpdatePolicy = (*core.MachineImageUpdatePolicy)(unsafe.Pointer(in.MachineImageUpdatePolicy))
+	out.Topology = (*core.WorkerTopology)(unsafe.Pointer(in.Topology))
+	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.OperatingSystemConfigRollout = (*core.OperatingSystemConfigRollout)(unsafe.Pointer(in.OperatingSystemConfigRollout))
+	out.ZoneRebalancing = (*core.WorkerZoneRebalancing)(unsafe.Pointer(in.ZoneRebalancing))
 	return nil
 }
 
@@ -6270,6 +7525,11 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
 	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.MachineImageUpdatePolicy = (*MachineImageUpdatePolicy)(unsafe.Pointer(in.MachineImageUpdatePolicy))
+	out.Topology = (*WorkerTopology)(unsafe.Pointer(in.Topology))
+	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.OperatingSystemConfigRollout = (*OperatingSystemConfigRollout)(unsafe.Pointer(in.OperatingSystemConfigRollout))
+	out.ZoneRebalancing = (*WorkerZoneRebalancing)(unsafe.Pointer(in.ZoneRebalancing))
 	return nil
 }
 
@@ -6316,8 +7576,59 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerPoolDeletionPreview_To_core_WorkerPoolDeletionPreview(in *WorkerPoolDeletionPreview, out *core.WorkerPoolDeletionPreview, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Nodes = in.Nodes
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolDeletionPreview_To_core_WorkerPoolDeletionPreview is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolDeletionPreview_To_core_WorkerPoolDeletionPreview(in *WorkerPoolDeletionPreview, out *core.WorkerPoolDeletionPreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolDeletionPreview_To_core_WorkerPoolDeletionPreview(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolDeletionPreview_To_v1beta1_WorkerPoolDeletionPreview(in *core.WorkerPoolDeletionPreview, out *WorkerPoolDeletionPreview, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Nodes = in.Nodes
+	return nil
+}
+
+// Convert_core_WorkerPoolDeletionPreview_To_v1beta1_WorkerPoolDeletionPreview is an autogenerated conversion function.
+func Convert_core_WorkerPoolDeletionPreview_To_v1beta1_WorkerPoolDeletionPreview(in *core.WorkerPoolDeletionPreview, out *WorkerPoolDeletionPreview, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolDeletionPreview_To_v1beta1_WorkerPoolDeletionPreview(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerPoolRolloutProgress_To_core_WorkerPoolRolloutProgress(in *WorkerPoolRolloutProgress, out *core.WorkerPoolRolloutProgress, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Desired = in.Desired
+	out.Updated = in.Updated
+	out.Ready = in.Ready
+	out.Old = in.Old
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolRolloutProgress_To_core_WorkerPoolRolloutProgress is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolRolloutProgress_To_core_WorkerPoolRolloutProgress(in *WorkerPoolRolloutProgress, out *core.WorkerPoolRolloutProgress, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolRolloutProgress_To_core_WorkerPoolRolloutProgress(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolRolloutProgress_To_v1beta1_WorkerPoolRolloutProgress(in *core.WorkerPoolRolloutProgress, out *WorkerPoolRolloutProgress, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Desired = in.Desired
+	out.Updated = in.Updated
+	out.Ready = in.Ready
+	out.Old = in.Old
+	return nil
+}
+
+// Convert_core_WorkerPoolRolloutProgress_To_v1beta1_WorkerPoolRolloutProgress is an autogenerated conversion function.
+func Convert_core_WorkerPoolRolloutProgress_To_v1beta1_WorkerPoolRolloutProgress(in *core.WorkerPoolRolloutProgress, out *WorkerPoolRolloutProgress, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolRolloutProgress_To_v1beta1_WorkerPoolRolloutProgress(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
+	out.Only = (*bool)(unsafe.Pointer(in.Only))
 	return nil
 }
 
@@ -6328,6 +7639,7 @@ func Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *W
 
 func autoConvert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(in *core.WorkerSystemComponents, out *WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
+	out.Only = (*bool)(unsafe.Pointer(in.Only))
 	return nil
 }
 
@@ -6336,6 +7648,50 @@ func Convert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(in *c
 	return autoConvert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerTopology_To_core_WorkerTopology(in *WorkerTopology, out *core.WorkerTopology, s conversion.Scope) error {
+	out.ZoneGroup = (*string)(unsafe.Pointer(in.ZoneGroup))
+	out.DedicatedToProject = (*bool)(unsafe.Pointer(in.DedicatedToProject))
+	return nil
+}
+
+// Convert_v1beta1_WorkerTopology_To_core_WorkerTopology is an autogenerated conversion function.
+func Convert_v1beta1_WorkerTopology_To_core_WorkerTopology(in *WorkerTopology, out *core.WorkerTopology, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerTopology_To_core_WorkerTopology(in, out, s)
+}
+
+func autoConvert_core_WorkerTopology_To_v1beta1_WorkerTopology(in *core.WorkerTopology, out *WorkerTopology, s conversion.Scope) error {
+	out.ZoneGroup = (*string)(unsafe.Pointer(in.ZoneGroup))
+	out.DedicatedToProject = (*bool)(unsafe.Pointer(in.DedicatedToProject))
+	return nil
+}
+
+// Convert_core_WorkerTopology_To_v1beta1_WorkerTopology is an autogenerated conversion function.
+func Convert_core_WorkerTopology_To_v1beta1_WorkerTopology(in *core.WorkerTopology, out *WorkerTopology, s conversion.Scope) error {
+	return autoConvert_core_WorkerTopology_To_v1beta1_WorkerTopology(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerZoneRebalancing_To_core_WorkerZoneRebalancing(in *WorkerZoneRebalancing, out *core.WorkerZoneRebalancing, s conversion.Scope) error {
+	out.FailureThreshold = (*int32)(unsafe.Pointer(in.FailureThreshold))
+	out.RecoveryPeriod = (*metav1.Duration)(unsafe.Pointer(in.RecoveryPeriod))
+	return nil
+}
+
+// Convert_v1beta1_WorkerZoneRebalancing_To_core_WorkerZoneRebalancing is an autogenerated conversion function.
+func Convert_v1beta1_WorkerZoneRebalancing_To_core_WorkerZoneRebalancing(in *WorkerZoneRebalancing, out *core.WorkerZoneRebalancing, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerZoneRebalancing_To_core_WorkerZoneRebalancing(in, out, s)
+}
+
+func autoConvert_core_WorkerZoneRebalancing_To_v1beta1_WorkerZoneRebalancing(in *core.WorkerZoneRebalancing, out *WorkerZoneRebalancing, s conversion.Scope) error {
+	out.FailureThreshold = (*int32)(unsafe.Pointer(in.FailureThreshold))
+	out.RecoveryPeriod = (*metav1.Duration)(unsafe.Pointer(in.RecoveryPeriod))
+	return nil
+}
+
+// Convert_core_WorkerZoneRebalancing_To_v1beta1_WorkerZoneRebalancing is an autogenerated conversion function.
+func Convert_core_WorkerZoneRebalancing_To_v1beta1_WorkerZoneRebalancing(in *core.WorkerZoneRebalancing, out *WorkerZoneRebalancing, s conversion.Scope) error {
+	return autoConvert_core_WorkerZoneRebalancing_To_v1beta1_WorkerZoneRebalancing(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkersSettings_To_core_WorkersSettings(in *WorkersSettings, out *core.WorkersSettings, s conversion.Scope) error {
 	out.SSHAccess = (*core.SSHAccess)(unsafe.Pointer(in.SSHAccess))
 	return nil
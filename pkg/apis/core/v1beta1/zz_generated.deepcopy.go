@@ -29,6 +29,58 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerEndpoint) DeepCopyInto(out *APIServerEndpoint) {
+	*out = *in
+	if in.AllowedSourceRanges != nil {
+		in, out := &in.AllowedSourceRanges, &out.AllowedSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StaticIP != nil {
+		in, out := &in.StaticIP, &out.StaticIP
+		*out = new(APIServerStaticIP)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerEndpoint.
+func (in *APIServerEndpoint) DeepCopy() *APIServerEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerEndpointStatus) DeepCopyInto(out *APIServerEndpointStatus) {
+	*out = *in
+	if in.StaticIP != nil {
+		in, out := &in.StaticIP, &out.StaticIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedSourceRanges != nil {
+		in, out := &in.AllowedSourceRanges, &out.AllowedSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerEndpointStatus.
+func (in *APIServerEndpointStatus) DeepCopy() *APIServerEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerLogging) DeepCopyInto(out *APIServerLogging) {
 	*out = *in
@@ -55,6 +107,27 @@ func (in *APIServerLogging) DeepCopy() *APIServerLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerPriorityAndFairness) DeepCopyInto(out *APIServerPriorityAndFairness) {
+	*out = *in
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = make([]APIServerPriorityAndFairnessPreset, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerPriorityAndFairness.
+func (in *APIServerPriorityAndFairness) DeepCopy() *APIServerPriorityAndFairness {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerPriorityAndFairness)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerRequests) DeepCopyInto(out *APIServerRequests) {
 	*out = *in
@@ -68,6 +141,11 @@ func (in *APIServerRequests) DeepCopyInto(out *APIServerRequests) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PriorityAndFairness != nil {
+		in, out := &in.PriorityAndFairness, &out.PriorityAndFairness
+		*out = new(APIServerPriorityAndFairness)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -81,6 +159,32 @@ func (in *APIServerRequests) DeepCopy() *APIServerRequests {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerStaticIP) DeepCopyInto(out *APIServerStaticIP) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.PTRRecord != nil {
+		in, out := &in.PTRRecord, &out.PTRRecord
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerStaticIP.
+func (in *APIServerStaticIP) DeepCopy() *APIServerStaticIP {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerStaticIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addon) DeepCopyInto(out *Addon) {
 	*out = *in
@@ -154,6 +258,22 @@ func (in *AdmissionPlugin) DeepCopy() *AdmissionPlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionWarning) DeepCopyInto(out *AdmissionWarning) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionWarning.
+func (in *AdmissionWarning) DeepCopy() *AdmissionWarning {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionWarning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alerting) DeepCopyInto(out *Alerting) {
 	*out = *in
@@ -412,6 +532,42 @@ func (in *BackupEntry) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEntryEncryption) DeepCopyInto(out *BackupEntryEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEntryEncryption.
+func (in *BackupEntryEncryption) DeepCopy() *BackupEntryEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEntryEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEntryEncryptionStatus) DeepCopyInto(out *BackupEntryEncryptionStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEntryEncryptionStatus.
+func (in *BackupEntryEncryptionStatus) DeepCopy() *BackupEntryEncryptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEntryEncryptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupEntryList) DeepCopyInto(out *BackupEntryList) {
 	*out = *in
@@ -453,6 +609,11 @@ func (in *BackupEntrySpec) DeepCopyInto(out *BackupEntrySpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BackupEntryEncryption)
+		**out = **in
+	}
 	return
 }
 
@@ -488,6 +649,11 @@ func (in *BackupEntryStatus) DeepCopyInto(out *BackupEntryStatus) {
 		in, out := &in.MigrationStartTime, &out.MigrationStartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BackupEntryEncryptionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -804,6 +970,11 @@ func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
 		*out = new(HighAvailability)
 		**out = **in
 	}
+	if in.VPN != nil {
+		in, out := &in.VPN, &out.VPN
+		*out = new(ControlPlaneVPN)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -817,6 +988,27 @@ func (in *ControlPlane) DeepCopy() *ControlPlane {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneVPN) DeepCopyInto(out *ControlPlaneVPN) {
+	*out = *in
+	if in.HighThroughput != nil {
+		in, out := &in.HighThroughput, &out.HighThroughput
+		*out = new(VPNHighThroughput)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneVPN.
+func (in *ControlPlaneVPN) DeepCopy() *ControlPlaneVPN {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneVPN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -1193,6 +1385,18 @@ func (in *CoreDNS) DeepCopyInto(out *CoreDNS) {
 		*out = new(CoreDNSRewriting)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]CoreDNSZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rewrites != nil {
+		in, out := &in.Rewrites, &out.Rewrites
+		*out = make([]CoreDNSRewrite, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1222,6 +1426,22 @@ func (in *CoreDNSAutoscaling) DeepCopy() *CoreDNSAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSRewrite) DeepCopyInto(out *CoreDNSRewrite) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSRewrite.
+func (in *CoreDNSRewrite) DeepCopy() *CoreDNSRewrite {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSRewrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreDNSRewriting) DeepCopyInto(out *CoreDNSRewriting) {
 	*out = *in
@@ -1243,6 +1463,27 @@ func (in *CoreDNSRewriting) DeepCopy() *CoreDNSRewriting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSZone) DeepCopyInto(out *CoreDNSZone) {
+	*out = *in
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSZone.
+func (in *CoreDNSZone) DeepCopy() *CoreDNSZone {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNS) DeepCopyInto(out *DNS) {
 	*out = *in
@@ -1258,6 +1499,11 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Private != nil {
+		in, out := &in.Private, &out.Private
+		*out = new(PrivateDNS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1399,6 +1645,11 @@ func (in *ETCDEncryptionKeyRotation) DeepCopyInto(out *ETCDEncryptionKeyRotation
 		in, out := &in.LastCompletionTriggeredTime, &out.LastCompletionTriggeredTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ReencryptedResources != nil {
+		in, out := &in.ReencryptedResources, &out.ReencryptedResources
+		*out = make([]ReencryptedResource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1785,6 +2036,51 @@ func (in *HorizontalPodAutoscalerConfig) DeepCopy() *HorizontalPodAutoscalerConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructurePlan) DeepCopyInto(out *InfrastructurePlan) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]InfrastructurePlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructurePlan.
+func (in *InfrastructurePlan) DeepCopy() *InfrastructurePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructurePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructurePlannedChange) DeepCopyInto(out *InfrastructurePlannedChange) {
+	*out = *in
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructurePlannedChange.
+func (in *InfrastructurePlannedChange) DeepCopy() *InfrastructurePlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructurePlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ingress) DeepCopyInto(out *Ingress) {
 	*out = *in
@@ -1987,6 +2283,11 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(EncryptionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(APIServerEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2078,6 +2379,13 @@ func (in *KubeSchedulerConfig) DeepCopyInto(out *KubeSchedulerConfig) {
 		*out = new(SchedulingProfile)
 		**out = **in
 	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]KubeSchedulerProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2091,6 +2399,48 @@ func (in *KubeSchedulerConfig) DeepCopy() *KubeSchedulerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSchedulerProfile) DeepCopyInto(out *KubeSchedulerProfile) {
+	*out = *in
+	if in.BinPacking != nil {
+		in, out := &in.BinPacking, &out.BinPacking
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScorePlugins != nil {
+		in, out := &in.ScorePlugins, &out.ScorePlugins
+		*out = make([]KubeSchedulerScorePlugin, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerProfile.
+func (in *KubeSchedulerProfile) DeepCopy() *KubeSchedulerProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSchedulerProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSchedulerScorePlugin) DeepCopyInto(out *KubeSchedulerScorePlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerScorePlugin.
+func (in *KubeSchedulerScorePlugin) DeepCopy() *KubeSchedulerScorePlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSchedulerScorePlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	*out = *in
@@ -2220,6 +2570,11 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 		*out = new(MemorySwapConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageMinimumGCAge != nil {
+		in, out := &in.ImageMinimumGCAge, &out.ImageMinimumGCAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2560,6 +2915,11 @@ func (in *LastMaintenance) DeepCopyInto(out *LastMaintenance) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OutOfWindow != nil {
+		in, out := &in.OutOfWindow, &out.OutOfWindow
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2591,33 +2951,111 @@ func (in *LastOperation) DeepCopy() *LastOperation {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Machine) DeepCopyInto(out *Machine) {
+func (in *Logging) DeepCopyInto(out *Logging) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(ShootMachineImage)
-		(*in).DeepCopyInto(*out)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
-		*out = new(string)
+	if in.StorageQuota != nil {
+		in, out := &in.StorageQuota, &out.StorageQuota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.QueryAPI != nil {
+		in, out := &in.QueryAPI, &out.QueryAPI
+		*out = new(LoggingQueryAPI)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
-func (in *Machine) DeepCopy() *Machine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
 	if in == nil {
 		return nil
 	}
-	out := new(Machine)
+	out := new(Logging)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineControllerManagerSettings) DeepCopyInto(out *MachineControllerManagerSettings) {
+func (in *LoggingQueryAPI) DeepCopyInto(out *LoggingQueryAPI) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingQueryAPI.
+func (in *LoggingQueryAPI) DeepCopy() *LoggingQueryAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingQueryAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Machine) DeepCopyInto(out *Machine) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ShootMachineImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.Requirements != nil {
+		in, out := &in.Requirements, &out.Requirements
+		*out = new(MachineRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
+func (in *Machine) DeepCopy() *Machine {
+	if in == nil {
+		return nil
+	}
+	out := new(Machine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCapabilities) DeepCopyInto(out *MachineCapabilities) {
+	*out = *in
+	if in.ConfidentialComputing != nil {
+		in, out := &in.ConfidentialComputing, &out.ConfidentialComputing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NestedVirtualization != nil {
+		in, out := &in.NestedVirtualization, &out.NestedVirtualization
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCapabilities.
+func (in *MachineCapabilities) DeepCopy() *MachineCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineControllerManagerSettings) DeepCopyInto(out *MachineControllerManagerSettings) {
 	*out = *in
 	if in.MachineDrainTimeout != nil {
 		in, out := &in.MachineDrainTimeout, &out.MachineDrainTimeout
@@ -2644,6 +3082,11 @@ func (in *MachineControllerManagerSettings) DeepCopyInto(out *MachineControllerM
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StuckMachinePolicy != nil {
+		in, out := &in.StuckMachinePolicy, &out.StuckMachinePolicy
+		*out = new(StuckMachinePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2685,6 +3128,27 @@ func (in *MachineImage) DeepCopy() *MachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageUpdatePolicy) DeepCopyInto(out *MachineImageUpdatePolicy) {
+	*out = *in
+	if in.PinPatchStream != nil {
+		in, out := &in.PinPatchStream, &out.PinPatchStream
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageUpdatePolicy.
+func (in *MachineImageUpdatePolicy) DeepCopy() *MachineImageUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageUpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 	*out = *in
@@ -2706,6 +3170,16 @@ func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = make([]MachineImageVulnerability, len(*in))
+		copy(*out, *in)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(MachineCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2719,6 +3193,53 @@ func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVulnerability) DeepCopyInto(out *MachineImageVulnerability) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVulnerability.
+func (in *MachineImageVulnerability) DeepCopy() *MachineImageVulnerability {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVulnerability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRequirements) DeepCopyInto(out *MachineRequirements) {
+	*out = *in
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfidentialComputing != nil {
+		in, out := &in.ConfidentialComputing, &out.ConfidentialComputing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NestedVirtualization != nil {
+		in, out := &in.NestedVirtualization, &out.NestedVirtualization
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequirements.
+func (in *MachineRequirements) DeepCopy() *MachineRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineType) DeepCopyInto(out *MachineType) {
 	*out = *in
@@ -2740,6 +3261,35 @@ func (in *MachineType) DeepCopyInto(out *MachineType) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GPUResourceName != nil {
+		in, out := &in.GPUResourceName, &out.GPUResourceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExtendedResources != nil {
+		in, out := &in.ExtendedResources, &out.ExtendedResources
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(TypeDeprecation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(MachineCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2818,6 +3368,11 @@ func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DeprecatedTypes != nil {
+		in, out := &in.DeprecatedTypes, &out.DeprecatedTypes
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2831,6 +3386,38 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceRecord) DeepCopyInto(out *MaintenanceRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.OldValue != nil {
+		in, out := &in.OldValue, &out.OldValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.NewValue != nil {
+		in, out := &in.NewValue, &out.NewValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutOfWindow != nil {
+		in, out := &in.OutOfWindow, &out.OutOfWindow
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceRecord.
+func (in *MaintenanceRecord) DeepCopy() *MaintenanceRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
@@ -3137,6 +3724,53 @@ func (in *OpenIDConnectClientAuthentication) DeepCopy() *OpenIDConnectClientAuth
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemConfigRollout) DeepCopyInto(out *OperatingSystemConfigRollout) {
+	*out = *in
+	if in.HealthTimeout != nil {
+		in, out := &in.HealthTimeout, &out.HealthTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemConfigRollout.
+func (in *OperatingSystemConfigRollout) DeepCopy() *OperatingSystemConfigRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemConfigRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNS) DeepCopyInto(out *PrivateDNS) {
+	*out = *in
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNS.
+func (in *PrivateDNS) DeepCopy() *PrivateDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -3288,6 +3922,13 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3458,6 +4099,22 @@ func (in *QuotaSpec) DeepCopy() *QuotaSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReencryptedResource) DeepCopyInto(out *ReencryptedResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReencryptedResource.
+func (in *ReencryptedResource) DeepCopy() *ReencryptedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ReencryptedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Region) DeepCopyInto(out *Region) {
 	*out = *in
@@ -3839,6 +4496,65 @@ func (in *SeedSelector) DeepCopy() *SeedSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingControlPlanePriority) DeepCopyInto(out *SeedSettingControlPlanePriority) {
+	*out = *in
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make([]SeedSettingControlPlanePriorityTier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingControlPlanePriority.
+func (in *SeedSettingControlPlanePriority) DeepCopy() *SeedSettingControlPlanePriority {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingControlPlanePriority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingControlPlanePriorityTier) DeepCopyInto(out *SeedSettingControlPlanePriorityTier) {
+	*out = *in
+	if in.Purposes != nil {
+		in, out := &in.Purposes, &out.Purposes
+		*out = make([]ShootPurpose, len(*in))
+		copy(*out, *in)
+	}
+	if in.ETCD != nil {
+		in, out := &in.ETCD, &out.ETCD
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubeAPIServer != nil {
+		in, out := &in.KubeAPIServer, &out.KubeAPIServer
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubeControllerManager != nil {
+		in, out := &in.KubeControllerManager, &out.KubeControllerManager
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingControlPlanePriorityTier.
+func (in *SeedSettingControlPlanePriorityTier) DeepCopy() *SeedSettingControlPlanePriorityTier {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingControlPlanePriorityTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingDependencyWatchdog) DeepCopyInto(out *SeedSettingDependencyWatchdog) {
 	*out = *in
@@ -4106,6 +4822,11 @@ func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
 		*out = new(SeedSettingTopologyAwareRouting)
 		**out = **in
 	}
+	if in.ControlPlanePriority != nil {
+		in, out := &in.ControlPlanePriority, &out.ControlPlanePriority
+		*out = new(SeedSettingControlPlanePriority)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4330,6 +5051,11 @@ func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SigningKeyRotationPeriod != nil {
+		in, out := &in.SigningKeyRotationPeriod, &out.SigningKeyRotationPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -4375,6 +5101,34 @@ func (in *ServiceAccountKeyRotation) DeepCopy() *ServiceAccountKeyRotation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveStatus) DeepCopyInto(out *ServiceLevelObjectiveStatus) {
+	*out = *in
+	out.Window = in.Window
+	if in.Current != nil {
+		in, out := &in.Current, &out.Current
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorBudgetRemaining != nil {
+		in, out := &in.ErrorBudgetRemaining, &out.ErrorBudgetRemaining
+		*out = new(string)
+		**out = **in
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveStatus.
+func (in *ServiceLevelObjectiveStatus) DeepCopy() *ServiceLevelObjectiveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Shoot) DeepCopyInto(out *Shoot) {
 	*out = *in
@@ -4486,6 +5240,43 @@ func (in *ShootCredentialsRotation) DeepCopy() *ShootCredentialsRotation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDeletionPreview) DeepCopyInto(out *ShootDeletionPreview) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolDeletionPreview, len(*in))
+		copy(*out, *in)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = new(VolumesDeletionPreview)
+		**out = **in
+	}
+	if in.DNSRecords != nil {
+		in, out := &in.DNSRecords, &out.DNSRecords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackupRetention != nil {
+		in, out := &in.BackupRetention, &out.BackupRetention
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDeletionPreview.
+func (in *ShootDeletionPreview) DeepCopy() *ShootDeletionPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDeletionPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootKubeconfigRotation) DeepCopyInto(out *ShootKubeconfigRotation) {
 	*out = *in
@@ -4655,6 +5446,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(Maintenance)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Monitoring != nil {
 		in, out := &in.Monitoring, &out.Monitoring
 		*out = new(Monitoring)
@@ -4713,6 +5509,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TrustedCABundles != nil {
+		in, out := &in.TrustedCABundles, &out.TrustedCABundles
+		*out = make([]TrustedCABundle, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4895,6 +5696,52 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceLevelObjectives != nil {
+		in, out := &in.ServiceLevelObjectives, &out.ServiceLevelObjectives
+		*out = make([]ServiceLevelObjectiveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdmissionWarnings != nil {
+		in, out := &in.AdmissionWarnings, &out.AdmissionWarnings
+		*out = make([]AdmissionWarning, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIServerEndpoint != nil {
+		in, out := &in.APIServerEndpoint, &out.APIServerEndpoint
+		*out = new(APIServerEndpointStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VulnerabilityScanResults != nil {
+		in, out := &in.VulnerabilityScanResults, &out.VulnerabilityScanResults
+		*out = make([]VulnerabilityScanResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaintenanceHistory != nil {
+		in, out := &in.MaintenanceHistory, &out.MaintenanceHistory
+		*out = make([]MaintenanceRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InfrastructurePlan != nil {
+		in, out := &in.InfrastructurePlan, &out.InfrastructurePlan
+		*out = new(InfrastructurePlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPreview != nil {
+		in, out := &in.DeletionPreview, &out.DeletionPreview
+		*out = new(ShootDeletionPreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerRolloutProgress != nil {
+		in, out := &in.WorkerRolloutProgress, &out.WorkerRolloutProgress
+		*out = make([]WorkerPoolRolloutProgress, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4926,6 +5773,27 @@ func (in *ShootTemplate) DeepCopy() *ShootTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StuckMachinePolicy) DeepCopyInto(out *StuckMachinePolicy) {
+	*out = *in
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StuckMachinePolicy.
+func (in *StuckMachinePolicy) DeepCopy() *StuckMachinePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StuckMachinePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
 	*out = *in
@@ -4939,6 +5807,11 @@ func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
 		*out = new(NodeLocalDNS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UserProvided != nil {
+		in, out := &in.UserProvided, &out.UserProvided
+		*out = make([]UserProvidedSystemComponent, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4973,6 +5846,69 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedCABundle) DeepCopyInto(out *TrustedCABundle) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedCABundle.
+func (in *TrustedCABundle) DeepCopy() *TrustedCABundle {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedCABundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TypeDeprecation) DeepCopyInto(out *TypeDeprecation) {
+	*out = *in
+	if in.Successor != nil {
+		in, out := &in.Successor, &out.Successor
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TypeDeprecation.
+func (in *TypeDeprecation) DeepCopy() *TypeDeprecation {
+	if in == nil {
+		return nil
+	}
+	out := new(TypeDeprecation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNHighThroughput) DeepCopyInto(out *VPNHighThroughput) {
+	*out = *in
+	if in.MinTunnels != nil {
+		in, out := &in.MinTunnels, &out.MinTunnels
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxTunnels != nil {
+		in, out := &in.MaxTunnels, &out.MaxTunnels
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNHighThroughput.
+func (in *VPNHighThroughput) DeepCopy() *VPNHighThroughput {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNHighThroughput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -5011,6 +5947,26 @@ func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.TargetCPUPercentile != nil {
+		in, out := &in.TargetCPUPercentile, &out.TargetCPUPercentile
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TargetMemoryPercentile != nil {
+		in, out := &in.TargetMemoryPercentile, &out.TargetMemoryPercentile
+		*out = new(float64)
+		**out = **in
+	}
+	if in.CPUHistogramDecayHalfLife != nil {
+		in, out := &in.CPUHistogramDecayHalfLife, &out.CPUHistogramDecayHalfLife
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MemoryHistogramDecayHalfLife != nil {
+		in, out := &in.MemoryHistogramDecayHalfLife, &out.MemoryHistogramDecayHalfLife
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -5068,6 +6024,11 @@ func (in *VolumeType) DeepCopyInto(out *VolumeType) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(TypeDeprecation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5081,6 +6042,44 @@ func (in *VolumeType) DeepCopy() *VolumeType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumesDeletionPreview) DeepCopyInto(out *VolumesDeletionPreview) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumesDeletionPreview.
+func (in *VolumesDeletionPreview) DeepCopy() *VolumesDeletionPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumesDeletionPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VulnerabilityScanResult) DeepCopyInto(out *VulnerabilityScanResult) {
+	*out = *in
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = make([]MachineImageVulnerability, len(*in))
+		copy(*out, *in)
+	}
+	in.ScanTime.DeepCopyInto(&out.ScanTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VulnerabilityScanResult.
+func (in *VulnerabilityScanResult) DeepCopy() *VulnerabilityScanResult {
+	if in == nil {
+		return nil
+	}
+	out := new(VulnerabilityScanResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WatchCacheSizes) DeepCopyInto(out *WatchCacheSizes) {
 	*out = *in
@@ -5189,7 +6188,7 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 	if in.SystemComponents != nil {
 		in, out := &in.SystemComponents, &out.SystemComponents
 		*out = new(WorkerSystemComponents)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MachineControllerManagerSettings != nil {
 		in, out := &in.MachineControllerManagerSettings, &out.MachineControllerManagerSettings
@@ -5203,6 +6202,31 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 			(*out)[key] = val
 		}
 	}
+	if in.MachineImageUpdatePolicy != nil {
+		in, out := &in.MachineImageUpdatePolicy, &out.MachineImageUpdatePolicy
+		*out = new(MachineImageUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(WorkerTopology)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxNodeProvisionTime != nil {
+		in, out := &in.MaxNodeProvisionTime, &out.MaxNodeProvisionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OperatingSystemConfigRollout != nil {
+		in, out := &in.OperatingSystemConfigRollout, &out.OperatingSystemConfigRollout
+		*out = new(OperatingSystemConfigRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneRebalancing != nil {
+		in, out := &in.ZoneRebalancing, &out.ZoneRebalancing
+		*out = new(WorkerZoneRebalancing)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5242,9 +6266,46 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolDeletionPreview) DeepCopyInto(out *WorkerPoolDeletionPreview) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolDeletionPreview.
+func (in *WorkerPoolDeletionPreview) DeepCopy() *WorkerPoolDeletionPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolDeletionPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolRolloutProgress) DeepCopyInto(out *WorkerPoolRolloutProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolRolloutProgress.
+func (in *WorkerPoolRolloutProgress) DeepCopy() *WorkerPoolRolloutProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolRolloutProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSystemComponents) DeepCopyInto(out *WorkerSystemComponents) {
 	*out = *in
+	if in.Only != nil {
+		in, out := &in.Only, &out.Only
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -5258,6 +6319,58 @@ func (in *WorkerSystemComponents) DeepCopy() *WorkerSystemComponents {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerTopology) DeepCopyInto(out *WorkerTopology) {
+	*out = *in
+	if in.ZoneGroup != nil {
+		in, out := &in.ZoneGroup, &out.ZoneGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.DedicatedToProject != nil {
+		in, out := &in.DedicatedToProject, &out.DedicatedToProject
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerTopology.
+func (in *WorkerTopology) DeepCopy() *WorkerTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerZoneRebalancing) DeepCopyInto(out *WorkerZoneRebalancing) {
+	*out = *in
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RecoveryPeriod != nil {
+		in, out := &in.RecoveryPeriod, &out.RecoveryPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerZoneRebalancing.
+func (in *WorkerZoneRebalancing) DeepCopy() *WorkerZoneRebalancing {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerZoneRebalancing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkersSettings) DeepCopyInto(out *WorkersSettings) {
 	*out = *in
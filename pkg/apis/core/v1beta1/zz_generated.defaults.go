@@ -160,6 +160,13 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 		a := &in.Spec.Provider.Workers[i]
 		SetDefaults_Worker(a)
 	}
+	if in.Spec.ControlPlane != nil {
+		if in.Spec.ControlPlane.VPN != nil {
+			if in.Spec.ControlPlane.VPN.HighThroughput != nil {
+				SetDefaults_VPNHighThroughput(in.Spec.ControlPlane.VPN.HighThroughput)
+			}
+		}
+	}
 }
 
 func SetObjectDefaults_ShootList(in *ShootList) {
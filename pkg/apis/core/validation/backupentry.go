@@ -50,13 +50,29 @@ func ValidateBackupEntrySpec(spec *core.BackupEntrySpec, fldPath *field.Path) fi
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("bucketName"), spec.BucketName, "bucketName must not be empty"))
 	}
 
+	if spec.Encryption != nil {
+		secretNamePath := fldPath.Child("encryption", "secretName")
+		if len(spec.Encryption.SecretName) == 0 {
+			allErrs = append(allErrs, field.Required(secretNamePath, "must provide the name of the secret containing the encryption key"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(spec.Encryption.SecretName, false) {
+				allErrs = append(allErrs, field.Invalid(secretNamePath, spec.Encryption.SecretName, msg))
+			}
+		}
+	}
+
 	return allErrs
 }
 
 // ValidateBackupEntrySpecUpdate validates the specification of a BackupEntry object.
-func ValidateBackupEntrySpecUpdate(_, _ *core.BackupEntrySpec, _ *field.Path) field.ErrorList {
+func ValidateBackupEntrySpecUpdate(newSpec, oldSpec *core.BackupEntrySpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	// Backups which have already been encrypted with a customer-supplied key must not be mixed with unencrypted ones.
+	if oldSpec.Encryption != nil && newSpec.Encryption == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("encryption"), "encryption cannot be disabled once it has been enabled"))
+	}
+
 	return allErrs
 }
 
@@ -75,6 +75,26 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.bucketName"),
 			}))))
 		})
+
+		It("should allow a valid encryption configuration", func() {
+			backupEntry.Spec.Encryption = &core.BackupEntryEncryption{SecretName: "backup-encryption-key"}
+
+			Expect(ValidateBackupEntry(backupEntry)).To(BeEmpty())
+		})
+
+		It("should forbid an empty or invalid encryption secret name", func() {
+			backupEntry.Spec.Encryption = &core.BackupEntryEncryption{}
+			Expect(ValidateBackupEntry(backupEntry)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.encryption.secretName"),
+			}))))
+
+			backupEntry.Spec.Encryption.SecretName = "Invalid_Name"
+			Expect(ValidateBackupEntry(backupEntry)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.encryption.secretName"),
+			}))))
+		})
 	})
 
 	Context("#ValidateBackupEntryUpdate", func() {
@@ -86,6 +106,25 @@ var _ = Describe("validation", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should allow changing the encryption secret", func() {
+			backupEntry.Spec.Encryption = &core.BackupEntryEncryption{SecretName: "old-key"}
+			newBackupEntry := prepareBackupEntryForUpdate(backupEntry)
+			newBackupEntry.Spec.Encryption.SecretName = "new-key"
+
+			Expect(ValidateBackupEntryUpdate(newBackupEntry, backupEntry)).To(BeEmpty())
+		})
+
+		It("should forbid disabling the encryption", func() {
+			backupEntry.Spec.Encryption = &core.BackupEntryEncryption{SecretName: "old-key"}
+			newBackupEntry := prepareBackupEntryForUpdate(backupEntry)
+			newBackupEntry.Spec.Encryption = nil
+
+			Expect(ValidateBackupEntryUpdate(newBackupEntry, backupEntry)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.encryption"),
+			}))))
+		})
 	})
 })
 
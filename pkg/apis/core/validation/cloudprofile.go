@@ -20,6 +20,7 @@ import (
 	"slices"
 
 	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -43,6 +44,13 @@ var (
 		string(core.UpdateStrategyMinor),
 		string(core.UpdateStrategyMajor),
 	)
+
+	availableVulnerabilitySeverities = sets.New(
+		string(core.VulnerabilitySeverityCritical),
+		string(core.VulnerabilitySeverityHigh),
+		string(core.VulnerabilitySeverityMedium),
+		string(core.VulnerabilitySeverityLow),
+	)
 )
 
 // ValidateCloudProfile validates a CloudProfile object.
@@ -197,6 +205,64 @@ func validateMachineTypes(machineTypes []core.MachineType, fldPath *field.Path)
 		if machineType.Storage != nil {
 			allErrs = append(allErrs, validateMachineTypeStorage(*machineType.Storage, idxPath.Child("storage"))...)
 		}
+
+		if machineType.GPUResourceName != nil {
+			allErrs = append(allErrs, validateMachineTypeGPUResourceName(*machineType.GPUResourceName, idxPath.Child("gpuResourceName"))...)
+		}
+
+		for resourceName, quantity := range machineType.ExtendedResources {
+			extendedResourcePath := idxPath.Child("extendedResources").Key(string(resourceName))
+			if !kubernetescorevalidation.IsExtendedResourceName(resourceName) {
+				allErrs = append(allErrs, field.Invalid(extendedResourcePath, resourceName, "must be an extended resource name"))
+				continue
+			}
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(string(resourceName), quantity, extendedResourcePath)...)
+		}
+
+		allErrs = append(allErrs, metav1validation.ValidateLabels(machineType.NodeLabels, idxPath.Child("nodeLabels"))...)
+	}
+
+	deprecated := make(map[string]bool, len(machineTypes))
+	for _, machineType := range machineTypes {
+		deprecated[machineType.Name] = machineType.Deprecation != nil
+	}
+	for i, machineType := range machineTypes {
+		allErrs = append(allErrs, validateTypeDeprecation(machineType.Name, machineType.Deprecation, deprecated, fldPath.Index(i).Child("deprecation"))...)
+	}
+
+	return allErrs
+}
+
+// validateTypeDeprecation validates the deprecation of a machine or volume type. The map of deprecated types must contain
+// all types of the same kind in the cloud profile.
+func validateTypeDeprecation(name string, deprecation *core.TypeDeprecation, deprecated map[string]bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if deprecation == nil || deprecation.Successor == nil {
+		return allErrs
+	}
+
+	successorPath := fldPath.Child("successor")
+	successor := *deprecation.Successor
+
+	isDeprecated, ok := deprecated[successor]
+	switch {
+	case successor == name:
+		allErrs = append(allErrs, field.Invalid(successorPath, successor, "must not refer to the deprecated type itself"))
+	case !ok:
+		allErrs = append(allErrs, field.NotFound(successorPath, successor))
+	case isDeprecated:
+		allErrs = append(allErrs, field.Invalid(successorPath, successor, "must not refer to a deprecated type"))
+	}
+
+	return allErrs
+}
+
+func validateMachineTypeGPUResourceName(gpuResourceName string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if gpuResourceName != "gpu" && !kubernetescorevalidation.IsExtendedResourceName(corev1.ResourceName(gpuResourceName)) {
+		allErrs = append(allErrs, field.Invalid(fldPath, gpuResourceName, `must either be "gpu" or an extended resource name`))
 	}
 
 	return allErrs
@@ -286,6 +352,8 @@ func validateMachineImages(machineImages []core.MachineImage, fldPath *field.Pat
 					allErrs = append(allErrs, field.Invalid(versionsPath.Child("kubeletVersionConstraint"), machineVersion.KubeletVersionConstraint, fmt.Sprintf("cannot parse the kubeletVersionConstraint: %s", err.Error())))
 				}
 			}
+
+			allErrs = append(allErrs, validateMachineImageVulnerabilities(machineVersion.Vulnerabilities, versionsPath.Child("vulnerabilities"))...)
 		}
 	}
 
@@ -353,6 +421,28 @@ func validateMachineImageVersionArchitecture(archs []string, fldPath *field.Path
 	return allErrs
 }
 
+func validateMachineImageVulnerabilities(vulnerabilities []core.MachineImageVulnerability, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	ids := sets.New[string]()
+
+	for i, vulnerability := range vulnerabilities {
+		idxPath := fldPath.Index(i)
+
+		if len(vulnerability.ID) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("id"), "must provide the identifier of the vulnerability"))
+		} else if ids.Has(vulnerability.ID) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("id"), vulnerability.ID))
+		}
+		ids.Insert(vulnerability.ID)
+
+		if !availableVulnerabilitySeverities.Has(string(vulnerability.Severity)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("severity"), vulnerability.Severity, sets.List(availableVulnerabilitySeverities)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateMachineTypeArchitecture(arch *string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -391,6 +481,14 @@ func validateVolumeTypes(volumeTypes []core.VolumeType, fldPath *field.Path) fie
 		}
 	}
 
+	deprecated := make(map[string]bool, len(volumeTypes))
+	for _, volumeType := range volumeTypes {
+		deprecated[volumeType.Name] = volumeType.Deprecation != nil
+	}
+	for i, volumeType := range volumeTypes {
+		allErrs = append(allErrs, validateTypeDeprecation(volumeType.Name, volumeType.Deprecation, deprecated, fldPath.Index(i).Child("deprecation"))...)
+	}
+
 	return allErrs
 }
 
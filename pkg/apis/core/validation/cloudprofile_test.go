@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -728,6 +729,40 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 						})),
 					))
 				})
+
+				It("should allow valid vulnerabilities for machine image versions", func() {
+					cloudProfile.Spec.MachineImages[0].Versions[0].Vulnerabilities = []core.MachineImageVulnerability{
+						{ID: "CVE-2023-12345", Severity: "critical"},
+						{ID: "CVE-2023-23456", Severity: "low"},
+					}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid invalid vulnerabilities for machine image versions", func() {
+					cloudProfile.Spec.MachineImages[0].Versions[0].Vulnerabilities = []core.MachineImageVulnerability{
+						{ID: "", Severity: "critical"},
+						{ID: "CVE-2023-12345", Severity: "foo"},
+						{ID: "CVE-2023-12345", Severity: "high"},
+					}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.machineImages[0].versions[0].vulnerabilities[0].id"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.machineImages[0].versions[0].vulnerabilities[1].severity"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.machineImages[0].versions[0].vulnerabilities[2].id"),
+						})),
+					))
+				})
 			})
 
 			It("should forbid if no cri is present", func() {
@@ -919,6 +954,85 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					errorList := ValidateCloudProfile(cloudProfile)
 					Expect(errorList).To(BeEmpty())
 				})
+
+				It("should allow machine types with valid scale-from-zero properties", func() {
+					machineType := machineType.DeepCopy()
+					machineType.GPUResourceName = pointer.String("nvidia.com/gpu")
+					machineType.ExtendedResources = corev1.ResourceList{"example.com/dongle": resource.MustParse("2")}
+					machineType.NodeLabels = map[string]string{"example.com/gpu-model": "a100"}
+					cloudProfile.Spec.MachineTypes = []core.MachineType{*machineType}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid machine types with invalid scale-from-zero properties", func() {
+					machineType := machineType.DeepCopy()
+					machineType.GPUResourceName = pointer.String("nvidia")
+					machineType.ExtendedResources = corev1.ResourceList{
+						"memory":             resource.MustParse("1Gi"),
+						"example.com/dongle": resource.MustParse("500m"),
+					}
+					machineType.NodeLabels = map[string]string{"/invalid": "a100"}
+					cloudProfile.Spec.MachineTypes = []core.MachineType{*machineType}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].gpuResourceName"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].extendedResources[memory]"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].extendedResources[example.com/dongle]"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].nodeLabels"),
+					})),
+					))
+				})
+
+				It("should allow deprecated machine types with a valid successor", func() {
+					deprecatedMachineType := machineType.DeepCopy()
+					deprecatedMachineType.Name = "machine-type-old"
+					deprecatedMachineType.Deprecation = &core.TypeDeprecation{Successor: pointer.String(machineType.Name)}
+					otherDeprecatedMachineType := machineType.DeepCopy()
+					otherDeprecatedMachineType.Name = "machine-type-older"
+					otherDeprecatedMachineType.Deprecation = &core.TypeDeprecation{}
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType, *deprecatedMachineType, *otherDeprecatedMachineType}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid deprecated machine types with invalid successors", func() {
+					selfMachineType := machineType.DeepCopy()
+					selfMachineType.Name = "machine-type-self"
+					selfMachineType.Deprecation = &core.TypeDeprecation{Successor: pointer.String("machine-type-self")}
+					unknownMachineType := machineType.DeepCopy()
+					unknownMachineType.Name = "machine-type-unknown"
+					unknownMachineType.Deprecation = &core.TypeDeprecation{Successor: pointer.String("does-not-exist")}
+					deprecatedSuccessorMachineType := machineType.DeepCopy()
+					deprecatedSuccessorMachineType.Name = "machine-type-chain"
+					deprecatedSuccessorMachineType.Deprecation = &core.TypeDeprecation{Successor: pointer.String("machine-type-self")}
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType, *selfMachineType, *unknownMachineType, *deprecatedSuccessorMachineType}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.machineTypes[1].deprecation.successor"),
+						"Detail": Equal("must not refer to the deprecated type itself"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotFound),
+						"Field": Equal("spec.machineTypes[2].deprecation.successor"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.machineTypes[3].deprecation.successor"),
+						"Detail": Equal("must not refer to a deprecated type"),
+					})),
+					))
+				})
 			})
 
 			Context("regions validation", func() {
@@ -1022,6 +1136,42 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					})),
 					))
 				})
+
+				It("should allow deprecated volume types with a valid successor", func() {
+					cloudProfile.Spec.VolumeTypes = append(cloudProfile.Spec.VolumeTypes, core.VolumeType{
+						Name:        "volume-type-old",
+						Class:       "premium",
+						Deprecation: &core.TypeDeprecation{Successor: pointer.String("volume-type-1")},
+					})
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid deprecated volume types with invalid successors", func() {
+					cloudProfile.Spec.VolumeTypes = append(cloudProfile.Spec.VolumeTypes,
+						core.VolumeType{
+							Name:        "volume-type-unknown",
+							Class:       "premium",
+							Deprecation: &core.TypeDeprecation{Successor: pointer.String("does-not-exist")},
+						},
+						core.VolumeType{
+							Name:        "volume-type-chain",
+							Class:       "premium",
+							Deprecation: &core.TypeDeprecation{Successor: pointer.String("volume-type-unknown")},
+						},
+					)
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotFound),
+						"Field": Equal("spec.volumeTypes[1].deprecation.successor"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.volumeTypes[2].deprecation.successor"),
+					})),
+					))
+				})
 			})
 
 			It("should forbid unsupported seed selectors", func() {
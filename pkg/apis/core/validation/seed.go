@@ -45,6 +45,13 @@ var (
 		v1beta1constants.GardenerOperationReconcile,
 		v1beta1constants.GardenerOperationRenewKubeconfig,
 	)
+	availableControlPlanePriorityClassNames = sets.New(
+		v1beta1constants.PriorityClassNameShootControlPlane500,
+		v1beta1constants.PriorityClassNameShootControlPlane400,
+		v1beta1constants.PriorityClassNameShootControlPlane300,
+		v1beta1constants.PriorityClassNameShootControlPlane200,
+		v1beta1constants.PriorityClassNameShootControlPlane100,
+	)
 )
 
 // ValidateSeed validates a Seed object.
@@ -207,6 +214,9 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		if helper.SeedSettingTopologyAwareRoutingEnabled(seedSpec.Settings) && len(seedSpec.Provider.Zones) <= 1 {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("settings", "topologyAwareRouting", "enabled"), "topology-aware routing can only be enabled on multi-zone Seed clusters (with at least two zones in spec.provider.zones)"))
 		}
+		if seedSpec.Settings.ControlPlanePriority != nil {
+			allErrs = append(allErrs, validateSeedSettingControlPlanePriority(seedSpec.Settings.ControlPlanePriority, fldPath.Child("settings", "controlPlanePriority"))...)
+		}
 	}
 
 	if !inTemplate && seedSpec.Ingress == nil {
@@ -291,6 +301,48 @@ func validateSeedNetworks(seedNetworks core.SeedNetworks, fldPath *field.Path, i
 	return allErrs
 }
 
+func validateSeedSettingControlPlanePriority(controlPlanePriority *core.SeedSettingControlPlanePriority, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs           = field.ErrorList{}
+		allowedPurposes   = sets.New(append(sets.List(availableShootPurposes), string(core.ShootPurposeInfrastructure))...)
+		specifiedPurposes = sets.New[core.ShootPurpose]()
+	)
+
+	for i, tier := range controlPlanePriority.Tiers {
+		idxPath := fldPath.Child("tiers").Index(i)
+
+		if len(tier.Purposes) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("purposes"), "at least one shoot purpose must be specified"))
+		}
+
+		for j, purpose := range tier.Purposes {
+			if !allowedPurposes.Has(string(purpose)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("purposes").Index(j), purpose, sets.List(allowedPurposes)))
+				continue
+			}
+			if specifiedPurposes.Has(purpose) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("purposes").Index(j), purpose))
+			}
+			specifiedPurposes.Insert(purpose)
+		}
+
+		for _, priorityClass := range []struct {
+			name *string
+			path *field.Path
+		}{
+			{tier.ETCD, idxPath.Child("etcd")},
+			{tier.KubeAPIServer, idxPath.Child("kubeAPIServer")},
+			{tier.KubeControllerManager, idxPath.Child("kubeControllerManager")},
+		} {
+			if priorityClass.name != nil && !availableControlPlanePriorityClassNames.Has(*priorityClass.name) {
+				allErrs = append(allErrs, field.NotSupported(priorityClass.path, *priorityClass.name, sets.List(availableControlPlanePriorityClassNames)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateSeedSpecUpdate validates the specification updates of a Seed object.
 func ValidateSeedSpecUpdate(newSeedSpec, oldSeedSpec *core.SeedSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
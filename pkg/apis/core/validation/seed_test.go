@@ -1048,6 +1048,76 @@ var _ = Describe("Seed Validation Tests", func() {
 
 				Expect(errorList).To(BeEmpty())
 			})
+
+			Context("control plane priority", func() {
+				It("should allow valid control plane priority tiers", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ControlPlanePriority: &core.SeedSettingControlPlanePriority{
+							Tiers: []core.SeedSettingControlPlanePriorityTier{
+								{
+									Purposes:      []core.ShootPurpose{core.ShootPurposeProduction, core.ShootPurposeInfrastructure},
+									ETCD:          pointer.String("gardener-system-500"),
+									KubeAPIServer: pointer.String("gardener-system-500"),
+								},
+								{
+									Purposes:              []core.ShootPurpose{core.ShootPurposeEvaluation},
+									ETCD:                  pointer.String("gardener-system-100"),
+									KubeAPIServer:         pointer.String("gardener-system-100"),
+									KubeControllerManager: pointer.String("gardener-system-100"),
+								},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should forbid invalid control plane priority tiers", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ControlPlanePriority: &core.SeedSettingControlPlanePriority{
+							Tiers: []core.SeedSettingControlPlanePriorityTier{
+								{
+									Purposes:              []core.ShootPurpose{core.ShootPurposeProduction, "foo"},
+									ETCD:                  pointer.String("gardener-system-critical"),
+									KubeControllerManager: pointer.String("bar"),
+								},
+								{
+									Purposes:      []core.ShootPurpose{core.ShootPurposeProduction},
+									KubeAPIServer: pointer.String("gardener-system-600"),
+								},
+								{},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.settings.controlPlanePriority.tiers[0].purposes[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.settings.controlPlanePriority.tiers[0].etcd"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.settings.controlPlanePriority.tiers[0].kubeControllerManager"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.settings.controlPlanePriority.tiers[1].purposes[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.settings.controlPlanePriority.tiers[1].kubeAPIServer"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.settings.controlPlanePriority.tiers[2].purposes"),
+						})),
+					))
+				})
+			})
 		})
 
 		It("should fail updating immutable fields", func() {
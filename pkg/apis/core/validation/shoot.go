@@ -39,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/util/cert"
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -53,6 +54,7 @@ import (
 	apigroupsvalidation "github.com/gardener/gardener/pkg/utils/validation/apigroups"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	featuresvalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	kubeletconfigvalidation "github.com/gardener/gardener/pkg/utils/validation/kubeletconfig"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
@@ -72,6 +74,10 @@ var (
 	availableShootOperations = sets.New(
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
+		v1beta1constants.ShootOperationCollectDiagnostics,
+		v1beta1constants.ShootOperationValidateResilience,
+		v1beta1constants.ShootOperationPlan,
+		v1beta1constants.ShootOperationPreviewDeletion,
 	).Union(availableShootMaintenanceOperations)
 	availableShootMaintenanceOperations = sets.New(
 		v1beta1constants.GardenerOperationReconcile,
@@ -102,6 +108,14 @@ var (
 	availableWorkerCRINamesForShoot = sets.New(
 		string(core.CRINameContainerD),
 	)
+	availableAPIServerPriorityAndFairnessPresets = sets.New(
+		string(core.APIServerPriorityAndFairnessPresetProtectSystemComponents),
+		string(core.APIServerPriorityAndFairnessPresetLimitWorkloadServiceAccounts),
+	)
+	availableStuckMachineActions = sets.New(
+		string(core.StuckMachineActionKeepRetrying),
+		string(core.StuckMachineActionPausePool),
+	)
 	availableClusterAutoscalerExpanderModes = sets.New(
 		string(core.ClusterAutoscalerExpanderLeastWaste),
 		string(core.ClusterAutoscalerExpanderMostPods),
@@ -112,10 +126,35 @@ var (
 		string(core.CoreDNSAutoscalingModeClusterProportional),
 		string(core.CoreDNSAutoscalingModeHorizontal),
 	)
+	availableCoreDNSRewriteMatches = sets.New(
+		string(core.CoreDNSRewriteMatchExact),
+		string(core.CoreDNSRewriteMatchPrefix),
+		string(core.CoreDNSRewriteMatchSuffix),
+		string(core.CoreDNSRewriteMatchSubstring),
+	)
+	availableUserProvidedSystemComponents = sets.New(
+		string(core.UserProvidedSystemComponentMetricsServer),
+		string(core.UserProvidedSystemComponentKubeProxy),
+	)
 	availableSchedulingProfiles = sets.New(
 		string(core.SchedulingProfileBalanced),
 		string(core.SchedulingProfileBinPacking),
 	)
+	// reservedSchedulerNames are the names of the scheduling profiles which are configured by Gardener itself.
+	reservedSchedulerNames = sets.New(
+		corev1.DefaultSchedulerName,
+		"bin-packing-scheduler",
+	)
+	availableKubeSchedulerScorePlugins = sets.New(
+		"ImageLocality",
+		"InterPodAffinity",
+		"NodeAffinity",
+		"NodeResourcesBalancedAllocation",
+		"NodeResourcesFit",
+		"PodTopologySpread",
+		"TaintToleration",
+		"VolumeBinding",
+	)
 	errorCodesAllowingForceDeletion = sets.New(
 		core.ErrorInfraUnauthenticated,
 		core.ErrorInfraUnauthorized,
@@ -153,6 +192,8 @@ func ValidateShoot(shoot *core.Shoot) field.ErrorList {
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shoot.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateNameConsecutiveHyphens(shoot.Name, field.NewPath("metadata", "name"))...)
 	allErrs = append(allErrs, validateShootOperation(shoot.Annotations[v1beta1constants.GardenerOperation], shoot.Annotations[v1beta1constants.GardenerMaintenanceOperation], shoot, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateShootServiceAccountIssuer(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateShootPodSecurityContextDefaulting(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, ValidateShootSpec(shoot.ObjectMeta, &shoot.Spec, field.NewPath("spec"), false)...)
 	allErrs = append(allErrs, ValidateShootHAConfig(shoot)...)
 
@@ -167,6 +208,10 @@ func ValidateShootUpdate(newShoot, oldShoot *core.Shoot) field.ErrorList {
 	allErrs = append(allErrs, ValidateShootObjectMetaUpdate(newShoot.ObjectMeta, oldShoot.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateShootSpecUpdate(&newShoot.Spec, &oldShoot.Spec, newShoot.ObjectMeta, field.NewPath("spec"))...)
 
+	// control plane must be upgraded before worker pools, but not beyond the kubelet version skew of any worker pool
+	controlPlaneUpgradeErrs, laggingWorkerVersionPaths := validateControlPlaneUpgradeKubeletVersionSkew(&newShoot.Spec, &oldShoot.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, controlPlaneUpgradeErrs...)
+
 	var (
 		etcdEncryptionKeyRotation *core.ETCDEncryptionKeyRotation
 		oldEncryptionConfig       *core.EncryptionConfig
@@ -190,7 +235,9 @@ func ValidateShootUpdate(newShoot, oldShoot *core.Shoot) field.ErrorList {
 	allErrs = append(allErrs, ValidateEncryptionConfigUpdate(newEncryptionConfig, oldEncryptionConfig, sets.New(newShoot.Status.EncryptedResources...), etcdEncryptionKeyRotation, hibernationEnabled, field.NewPath("spec", "kubernetes", "kubeAPIServer", "encryptionConfig"))...)
 	// validate version updates only to kubernetes 1.25
 	allErrs = append(allErrs, validateKubernetesVersionUpdate125(newShoot, oldShoot)...)
-	allErrs = append(allErrs, ValidateShoot(newShoot)...)
+	// the skew of worker pools which are lagging behind because of the control plane upgrade is already reported for the
+	// control plane version, hence the same violation is not reported again for the unchanged worker pool versions
+	allErrs = append(allErrs, removeForbiddenErrorsForFields(ValidateShoot(newShoot), laggingWorkerVersionPaths)...)
 	allErrs = append(allErrs, ValidateShootHAConfigUpdate(newShoot, oldShoot)...)
 	allErrs = append(allErrs, validateHibernationUpdate(newShoot, oldShoot)...)
 
@@ -233,6 +280,43 @@ func ValidateShootTemplateUpdate(newShootTemplate, oldShootTemplate *core.ShootT
 func ValidateShootObjectMetaUpdate(newMeta, oldMeta metav1.ObjectMeta, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateShootKubeconfigRotation(newMeta, oldMeta, fldPath)...)
+	allErrs = append(allErrs, validateShootServiceAccountIssuerUpdate(newMeta, oldMeta, fldPath)...)
+	return allErrs
+}
+
+// validateShootServiceAccountIssuer validates the value of the annotation for a managed service account issuer.
+func validateShootServiceAccountIssuer(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if issuer, ok := annotations[v1beta1constants.AnnotationAuthenticationIssuer]; ok && issuer != v1beta1constants.AnnotationAuthenticationIssuerManaged {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Key(v1beta1constants.AnnotationAuthenticationIssuer), issuer, []string{v1beta1constants.AnnotationAuthenticationIssuerManaged}))
+	}
+
+	return allErrs
+}
+
+// validateShootPodSecurityContextDefaulting validates the value of the annotation for the pod security context
+// defaulting.
+func validateShootPodSecurityContextDefaulting(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	availableModes := []string{v1beta1constants.ShootPodSecurityContextDefaultingEnforce, v1beta1constants.ShootPodSecurityContextDefaultingDryRun}
+	if mode, ok := annotations[v1beta1constants.ShootAlphaPodSecurityContextDefaulting]; ok && !slices.Contains(availableModes, mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Key(v1beta1constants.ShootAlphaPodSecurityContextDefaulting), mode, availableModes))
+	}
+
+	return allErrs
+}
+
+// validateShootServiceAccountIssuerUpdate validates that the annotation for a managed service account issuer cannot be
+// removed or changed once it was set.
+func validateShootServiceAccountIssuerUpdate(newMeta, oldMeta metav1.ObjectMeta, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldIssuer, ok := oldMeta.Annotations[v1beta1constants.AnnotationAuthenticationIssuer]; ok && newMeta.Annotations[v1beta1constants.AnnotationAuthenticationIssuer] != oldIssuer {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("annotations").Key(v1beta1constants.AnnotationAuthenticationIssuer), "managed service account issuer cannot be removed or changed once it was set"))
+	}
+
 	return allErrs
 }
 
@@ -272,8 +356,11 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, isDockerConfigured(spec.Provider.Workers), workerless, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
+	allErrs = append(allErrs, validateLogging(spec.Logging, fldPath.Child("logging"))...)
 	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
+	allErrs = append(allErrs, validateControlPlaneVPN(spec.ControlPlane, workerless, fldPath.Child("controlPlane"))...)
 	allErrs = append(allErrs, ValidateHibernation(meta.Annotations, spec.Hibernation, fldPath.Child("hibernation"))...)
+	allErrs = append(allErrs, validateTrustedCABundles(spec.TrustedCABundles, fldPath.Child("trustedCABundles"))...)
 
 	if len(spec.Region) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "must specify a region"))
@@ -305,6 +392,13 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, ValidateTolerations(spec.Tolerations, fldPath.Child("tolerations"))...)
 	allErrs = append(allErrs, ValidateSystemComponents(spec.SystemComponents, fldPath.Child("systemComponents"), workerless)...)
 
+	if spec.SystemComponents != nil {
+		kubeProxyDisabled := spec.Kubernetes.KubeProxy != nil && spec.Kubernetes.KubeProxy.Enabled != nil && !*spec.Kubernetes.KubeProxy.Enabled
+		if idx := slices.Index(spec.SystemComponents.UserProvided, core.UserProvidedSystemComponentKubeProxy); idx >= 0 && kubeProxyDisabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("systemComponents", "userProvided").Index(idx), "kube-proxy cannot be user-provided if it is disabled via spec.kubernetes.kubeProxy.enabled"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -337,6 +431,7 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 	allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
 
 	allErrs = append(allErrs, validateKubeControllerManagerUpdate(newSpec.Kubernetes.KubeControllerManager, oldSpec.Kubernetes.KubeControllerManager, fldPath.Child("kubernetes", "kubeControllerManager"))...)
+	allErrs = append(allErrs, validateLoggingUpdate(newSpec.Logging, oldSpec.Logging, fldPath.Child("logging"))...)
 
 	if err := validateWorkerUpdate(len(newSpec.Provider.Workers) > 0, len(oldSpec.Provider.Workers) > 0, fldPath.Child("provider", "workers")); err != nil {
 		allErrs = append(allErrs, err)
@@ -416,6 +511,28 @@ func ValidateShootStatusUpdate(newStatus, oldStatus core.ShootStatus) field.Erro
 	if len(newStatus.AdvertisedAddresses) > 0 {
 		allErrs = append(allErrs, validateAdvertiseAddresses(newStatus.AdvertisedAddresses, fldPath.Child("advertisedAddresses"))...)
 	}
+	allErrs = append(allErrs, validateVulnerabilityScanResults(newStatus.VulnerabilityScanResults, fldPath.Child("vulnerabilityScanResults"))...)
+
+	return allErrs
+}
+
+func validateVulnerabilityScanResults(results []core.VulnerabilityScanResult, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, result := range results {
+		idxPath := fldPath.Index(i)
+
+		if len(result.WorkerPool) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("workerPool"), "must provide the name of the scanned worker pool"))
+		}
+		if len(result.MachineImageName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("machineImageName"), "must provide the name of the scanned machine image"))
+		}
+		if len(result.MachineImageVersion) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("machineImageVersion"), "must provide the version of the scanned machine image"))
+		}
+		allErrs = append(allErrs, validateMachineImageVulnerabilities(result.Vulnerabilities, idxPath.Child("vulnerabilities"))...)
+	}
 
 	return allErrs
 }
@@ -647,6 +764,10 @@ func validateDNSUpdate(new, old *core.DNS, seedGotAssigned bool, fldPath *field.
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Domain, old.Domain, fldPath.Child("domain"))...)
 		}
 
+		if old.Private != nil && new.Private != nil {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Private.Domain, old.Private.Domain, fldPath.Child("private", "domain"))...)
+		}
+
 		if seedGotAssigned {
 			var (
 				primaryOld = helper.FindPrimaryDNSProvider(old.Providers)
@@ -721,10 +842,10 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Type, oldNetworking.Type, fldPath.Child("type"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.IPFamilies, oldNetworking.IPFamilies, fldPath.Child("ipFamilies"))...)
 	if oldNetworking.Pods != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Pods, oldNetworking.Pods, fldPath.Child("pods"))...)
+		allErrs = append(allErrs, validateNetworkingCIDRUpdate(newNetworking.Pods, oldNetworking.Pods, fldPath.Child("pods"))...)
 	}
 	if oldNetworking.Services != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Services, oldNetworking.Services, fldPath.Child("services"))...)
+		allErrs = append(allErrs, validateNetworkingCIDRUpdate(newNetworking.Services, oldNetworking.Services, fldPath.Child("services"))...)
 	}
 	if !features.DefaultFeatureGate.Enabled(features.MutableShootSpecNetworkingNodes) && oldNetworking.Nodes != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Nodes, oldNetworking.Nodes, fldPath.Child("nodes"))...)
@@ -733,7 +854,38 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 	return allErrs
 }
 
-// validateWorkerGroupAndControlPlaneKubernetesVersion ensures that new version is newer than old version and does not skip two minor
+// validateNetworkingCIDRUpdate ensures that the given CIDR is not changed. If the feature gate
+// ExpandableShootSpecNetworkingCIDRs is enabled, it may be changed to a larger CIDR containing the old one.
+func validateNetworkingCIDRUpdate(newCIDR, oldCIDR *string, fldPath *field.Path) field.ErrorList {
+	if !features.DefaultFeatureGate.Enabled(features.ExpandableShootSpecNetworkingCIDRs) || newCIDR == nil || *newCIDR == *oldCIDR {
+		return apivalidation.ValidateImmutableField(newCIDR, oldCIDR, fldPath)
+	}
+
+	allErrs := field.ErrorList{}
+
+	_, oldNet, err := net.ParseCIDR(*oldCIDR)
+	if err != nil {
+		// the old CIDR cannot be validated anymore, hence only allow the expansion of valid CIDRs
+		return append(allErrs, apivalidation.ValidateImmutableField(newCIDR, oldCIDR, fldPath)...)
+	}
+	_, newNet, err := net.ParseCIDR(*newCIDR)
+	if err != nil {
+		// parsing errors are already reported by validateNetworking
+		return allErrs
+	}
+
+	oldOnes, oldBits := oldNet.Mask.Size()
+	newOnes, newBits := newNet.Mask.Size()
+	if newBits != oldBits || newOnes >= oldOnes || !newNet.Contains(oldNet.IP) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *newCIDR, fmt.Sprintf("field can only be expanded to a larger CIDR containing the previous CIDR %q", *oldCIDR)))
+	}
+
+	return allErrs
+}
+
+// validateWorkerGroupAndControlPlaneKubernetesVersion ensures that the worker group kubernetes version complies with the
+// kubelet version skew policy, i.e. it is not newer than the control plane version and at most two (three as of
+// Kubernetes 1.28) minor versions older.
 func validateWorkerGroupAndControlPlaneKubernetesVersion(controlPlaneVersion, workerGroupVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -743,35 +895,110 @@ func validateWorkerGroupAndControlPlaneKubernetesVersion(controlPlaneVersion, wo
 		allErrs = append(allErrs, field.Invalid(fldPath, controlPlaneVersion, err.Error()))
 	}
 	if uplift {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "worker group kubernetes version must not be higher than control plane version"))
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("worker group kubernetes version must not be higher than control plane version %s, upgrade the control plane first", controlPlaneVersion)))
 	}
 
-	// Forbid Kubernetes version upgrade which skips a minor version
-	workerVersion, err := semver.NewVersion(workerGroupVersion)
+	minimumWorkerVersion, maxSkew, err := minimumKubeletVersion(controlPlaneVersion)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, controlPlaneVersion, err.Error()))
+		return allErrs
+	}
+
+	versionSkewViolation, err := versionutils.CompareVersions(workerGroupVersion, "<", minimumWorkerVersion.String())
 	if err != nil {
 		allErrs = append(allErrs, field.Invalid(fldPath, workerGroupVersion, err.Error()))
 	}
+	if versionSkewViolation {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("worker group kubernetes version must be at most %s minor versions behind control plane version %s, use at least version %d.%d for this worker group", maxSkew, controlPlaneVersion, minimumWorkerVersion.Major(), minimumWorkerVersion.Minor())))
+	}
+
+	return allErrs
+}
 
+// validateControlPlaneUpgradeKubeletVersionSkew ensures that a control plane kubernetes version upgrade does not move
+// the control plane out of the allowed kubelet version skew of worker pools which pin an older kubernetes version. The
+// returned errors name the worker pools which have to be upgraded before the control plane can be upgraded. Worker pools
+// whose version is changed in the same update are validated on their own field and are hence skipped. Additionally, the
+// paths of the version fields of the lagging worker pools are returned.
+func validateControlPlaneUpgradeKubeletVersionSkew(newSpec, oldSpec *core.ShootSpec, fldPath *field.Path) (field.ErrorList, sets.Set[string]) {
 	var (
-		k8sGreaterEqual128, _ = versionutils.CheckVersionMeetsConstraint(controlPlaneVersion, ">= 1.28")
-		minorSkewVersion      = workerVersion.IncMinor().IncMinor().IncMinor()
-		maxSkew               = "two"
+		allErrs                   = field.ErrorList{}
+		laggingWorkerVersionPaths = sets.New[string]()
 	)
 
-	if k8sGreaterEqual128 {
-		minorSkewVersion = workerVersion.IncMinor().IncMinor().IncMinor().IncMinor()
-		maxSkew = "three"
+	if newSpec.Kubernetes.Version == oldSpec.Kubernetes.Version {
+		return allErrs, laggingWorkerVersionPaths
 	}
 
-	versionSkewViolation, err := versionutils.CompareVersions(controlPlaneVersion, ">=", minorSkewVersion.String())
+	minimumWorkerVersion, maxSkew, err := minimumKubeletVersion(newSpec.Kubernetes.Version)
 	if err != nil {
-		allErrs = append(allErrs, field.Invalid(fldPath, controlPlaneVersion, err.Error()))
+		// the version itself is validated elsewhere
+		return allErrs, laggingWorkerVersionPaths
 	}
-	if versionSkewViolation {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "worker group kubernetes version must be at most "+maxSkew+" minor versions behind control plane version"))
+
+	oldWorkerVersions := make(map[string]string, len(oldSpec.Provider.Workers))
+	for _, worker := range oldSpec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			oldWorkerVersions[worker.Name] = *worker.Kubernetes.Version
+		}
 	}
 
-	return allErrs
+	for i, worker := range newSpec.Provider.Workers {
+		if worker.Kubernetes == nil || worker.Kubernetes.Version == nil {
+			continue
+		}
+
+		if oldVersion, ok := oldWorkerVersions[worker.Name]; !ok || oldVersion != *worker.Kubernetes.Version {
+			continue
+		}
+
+		versionSkewViolation, err := versionutils.CompareVersions(*worker.Kubernetes.Version, "<", minimumWorkerVersion.String())
+		if err != nil || !versionSkewViolation {
+			continue
+		}
+
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubernetes", "version"), fmt.Sprintf("control plane cannot be upgraded to %s before worker pool %q is upgraded from %s to at least %d.%d (kubelet must be at most %s minor versions behind the control plane)", newSpec.Kubernetes.Version, worker.Name, *worker.Kubernetes.Version, minimumWorkerVersion.Major(), minimumWorkerVersion.Minor(), maxSkew)))
+		laggingWorkerVersionPaths.Insert(fldPath.Child("provider", "workers").Index(i).Child("kubernetes", "version").String())
+	}
+
+	return allErrs, laggingWorkerVersionPaths
+}
+
+// removeForbiddenErrorsForFields returns the given errors without the errors of type 'Forbidden' for the given fields.
+func removeForbiddenErrorsForFields(errs field.ErrorList, fields sets.Set[string]) field.ErrorList {
+	if fields.Len() == 0 {
+		return errs
+	}
+
+	return errs.Filter(func(err error) bool {
+		fieldErr, ok := err.(*field.Error)
+		return ok && fieldErr.Type == field.ErrorTypeForbidden && fields.Has(fieldErr.Field)
+	})
+}
+
+// minimumKubeletVersion returns the lowest kubelet version which is allowed by the kubelet version skew policy for the
+// given control plane version, together with the maximum skew in words.
+func minimumKubeletVersion(controlPlaneVersion string) (*semver.Version, string, error) {
+	version, err := semver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var (
+		maxSkew      uint64 = 2
+		maxSkewWords        = "two"
+	)
+
+	if k8sGreaterEqual128, _ := versionutils.CheckVersionMeetsConstraint(controlPlaneVersion, ">= 1.28"); k8sGreaterEqual128 {
+		maxSkew, maxSkewWords = 3, "three"
+	}
+
+	minorVersion := uint64(0)
+	if version.Minor() > maxSkew {
+		minorVersion = version.Minor() - maxSkew
+	}
+
+	return semver.New(version.Major(), minorVersion, 0, "", ""), maxSkewWords, nil
 }
 
 func validateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
@@ -831,6 +1058,42 @@ func validateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
 		}
 	}
 
+	allErrs = append(allErrs, validatePrivateDNS(dns.Private, dns.Domain, fldPath.Child("private"))...)
+
+	return allErrs
+}
+
+func validatePrivateDNS(private *core.PrivateDNS, domain *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if private == nil {
+		return allErrs
+	}
+
+	if len(private.Domain) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("domain"), "private domain must be set"))
+	} else {
+		allErrs = append(allErrs, ValidateDNS1123Subdomain(private.Domain, fldPath.Child("domain"))...)
+		if domain != nil && *domain == private.Domain {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("domain"), private.Domain, "private domain must differ from the external domain"))
+		}
+	}
+
+	if len(private.Type) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "type must be set"))
+	}
+
+	if private.Type == core.DNSUnmanaged {
+		if private.SecretName != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secretName"), private.SecretName, fmt.Sprintf("secretName must not be set when type is %q", core.DNSUnmanaged)))
+		}
+		if private.Zone != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zone"), private.Zone, fmt.Sprintf("zone must not be set when type is %q", core.DNSUnmanaged)))
+		}
+	} else if len(private.Type) > 0 && (private.SecretName == nil || len(*private.SecretName) == 0) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretName"), fmt.Sprintf("secretName must be set when type is not %q", core.DNSUnmanaged)))
+	}
+
 	return allErrs
 }
 
@@ -1068,6 +1331,57 @@ func ValidateAPIServerRequests(requests *core.APIServerRequests, fldPath *field.
 				allErrs = append(allErrs, field.Invalid(path, *v, fmt.Sprintf("cannot set higher than %d", maxMutatingRequestsInflight)))
 			}
 		}
+
+		if priorityAndFairness := requests.PriorityAndFairness; priorityAndFairness != nil {
+			presets := sets.New[string]()
+			for i, preset := range priorityAndFairness.Presets {
+				path := fldPath.Child("priorityAndFairness", "presets").Index(i)
+
+				if !availableAPIServerPriorityAndFairnessPresets.Has(string(preset)) {
+					allErrs = append(allErrs, field.NotSupported(path, preset, sets.List(availableAPIServerPriorityAndFairnessPresets)))
+				}
+				if presets.Has(string(preset)) {
+					allErrs = append(allErrs, field.Duplicate(path, preset))
+				}
+				presets.Insert(string(preset))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAPIServerEndpoint validates the endpoint settings of the kube-apiserver.
+func ValidateAPIServerEndpoint(endpoint *core.APIServerEndpoint, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if endpoint == nil {
+		return allErrs
+	}
+
+	allowedSourceRanges := sets.New[string]()
+	for i, sourceRange := range endpoint.AllowedSourceRanges {
+		path := fldPath.Child("allowedSourceRanges").Index(i)
+		cidr := cidrvalidation.NewCIDR(sourceRange, path)
+
+		allErrs = append(allErrs, cidr.ValidateParse()...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(path, cidr.GetCIDR())...)
+
+		if allowedSourceRanges.Has(sourceRange) {
+			allErrs = append(allErrs, field.Duplicate(path, sourceRange))
+		}
+		allowedSourceRanges.Insert(sourceRange)
+	}
+
+	if staticIP := endpoint.StaticIP; staticIP != nil {
+		staticIPPath := fldPath.Child("staticIP")
+
+		if staticIP.Address != nil && net.ParseIP(*staticIP.Address) == nil {
+			allErrs = append(allErrs, field.Invalid(staticIPPath.Child("address"), *staticIP.Address, "must be a valid IP address"))
+		}
+		if staticIP.PTRRecord != nil {
+			allErrs = append(allErrs, ValidateDNS1123Subdomain(*staticIP.PTRRecord, staticIPPath.Child("ptrRecord"))...)
+		}
 	}
 
 	return allErrs
@@ -1174,6 +1488,18 @@ func ValidateVerticalPodAutoscaler(autoScaler core.VerticalPodAutoscaler, fldPat
 	if interval := autoScaler.RecommenderInterval; interval != nil && interval.Duration < 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("recommenderInterval"), *interval, "can not be negative"))
 	}
+	if percentile := autoScaler.TargetCPUPercentile; percentile != nil && (*percentile <= 0 || *percentile > 1) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetCPUPercentile"), *percentile, "must be greater than 0 and less than or equal to 1"))
+	}
+	if percentile := autoScaler.TargetMemoryPercentile; percentile != nil && (*percentile <= 0 || *percentile > 1) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetMemoryPercentile"), *percentile, "must be greater than 0 and less than or equal to 1"))
+	}
+	if halfLife := autoScaler.CPUHistogramDecayHalfLife; halfLife != nil && halfLife.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuHistogramDecayHalfLife"), *halfLife, "must be greater than 0"))
+	}
+	if halfLife := autoScaler.MemoryHistogramDecayHalfLife; halfLife != nil && halfLife.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryHistogramDecayHalfLife"), *halfLife, "must be greater than 0"))
+	}
 
 	return allErrs
 }
@@ -1337,6 +1663,11 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 	allErrs = append(allErrs, validateEncryptionConfig(kubeAPIServer.EncryptionConfig, version, defaultEncryptedResources, fldPath)...)
 
 	allErrs = append(allErrs, ValidateAPIServerRequests(kubeAPIServer.Requests, fldPath.Child("requests"))...)
+	if workerless && kubeAPIServer.Requests != nil && kubeAPIServer.Requests.PriorityAndFairness != nil && len(kubeAPIServer.Requests.PriorityAndFairness.Presets) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("requests", "priorityAndFairness", "presets"), "API Priority and Fairness presets are only supported for clusters with worker nodes"))
+	}
+
+	allErrs = append(allErrs, ValidateAPIServerEndpoint(kubeAPIServer.Endpoint, fldPath.Child("endpoint"))...)
 
 	if kubeAPIServer.ServiceAccountConfig != nil {
 		if kubeAPIServer.ServiceAccountConfig.MaxTokenExpiration != nil {
@@ -1370,6 +1701,19 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 				}
 			}
 		}
+		if period := kubeAPIServer.ServiceAccountConfig.SigningKeyRotationPeriod; period != nil {
+			path := fldPath.Child("serviceAccountConfig", "signingKeyRotationPeriod")
+
+			if maxTokenExpiration := kubeAPIServer.ServiceAccountConfig.MaxTokenExpiration; maxTokenExpiration == nil {
+				allErrs = append(allErrs, field.Forbidden(path, "requires maxTokenExpiration to be set"))
+			} else if period.Duration < maxTokenExpiration.Duration {
+				allErrs = append(allErrs, field.Invalid(path, *period, fmt.Sprintf("must not be shorter than maxTokenExpiration (%s)", maxTokenExpiration.Duration)))
+			}
+
+			if extendTokenExpiration := kubeAPIServer.ServiceAccountConfig.ExtendTokenExpiration; extendTokenExpiration == nil || *extendTokenExpiration {
+				allErrs = append(allErrs, field.Forbidden(path, "requires extendTokenExpiration to be disabled"))
+			}
+		}
 	}
 
 	if kubeAPIServer.EventTTL != nil {
@@ -1460,12 +1804,59 @@ func validateKubeScheduler(ks *core.KubeSchedulerConfig, version string, fldPath
 			}
 		}
 
+		allErrs = append(allErrs, validateKubeSchedulerProfiles(ks.Profiles, fldPath.Child("profiles"))...)
 		allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(ks.FeatureGates, version, fldPath.Child("featureGates"))...)
 	}
 
 	return allErrs
 }
 
+func validateKubeSchedulerProfiles(profiles []core.KubeSchedulerProfile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	schedulerNames := sets.New[string]()
+
+	for i, profile := range profiles {
+		idxPath := fldPath.Index(i)
+
+		if len(profile.SchedulerName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("schedulerName"), "must provide a scheduler name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(profile.SchedulerName) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("schedulerName"), profile.SchedulerName, msg))
+			}
+			if reservedSchedulerNames.Has(profile.SchedulerName) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("schedulerName"), fmt.Sprintf("scheduler name must not be one of %v since these profiles are configured by Gardener", sets.List(reservedSchedulerNames))))
+			}
+			if schedulerNames.Has(profile.SchedulerName) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("schedulerName"), profile.SchedulerName))
+			}
+			schedulerNames.Insert(profile.SchedulerName)
+		}
+
+		pluginNames := sets.New[string]()
+		for j, plugin := range profile.ScorePlugins {
+			pluginPath := idxPath.Child("scorePlugins").Index(j)
+
+			if !availableKubeSchedulerScorePlugins.Has(plugin.Name) {
+				allErrs = append(allErrs, field.NotSupported(pluginPath.Child("name"), plugin.Name, sets.List(availableKubeSchedulerScorePlugins)))
+			} else if pluginNames.Has(plugin.Name) {
+				allErrs = append(allErrs, field.Duplicate(pluginPath.Child("name"), plugin.Name))
+			}
+			pluginNames.Insert(plugin.Name)
+
+			if pointer.BoolDeref(profile.BinPacking, false) && plugin.Name == "NodeResourcesBalancedAllocation" {
+				allErrs = append(allErrs, field.Forbidden(pluginPath.Child("name"), "score plugin is disabled when bin-packing is enabled"))
+			}
+
+			if plugin.Weight < 1 || plugin.Weight > 100 {
+				allErrs = append(allErrs, field.Invalid(pluginPath.Child("weight"), plugin.Weight, "weight must be in the range from 1 to 100"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateKubeProxy(kp *core.KubeProxyConfig, version string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if kp != nil {
@@ -1479,6 +1870,46 @@ func validateKubeProxy(kp *core.KubeProxyConfig, version string, fldPath *field.
 	return allErrs
 }
 
+func validateLogging(logging *core.Logging, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if logging == nil {
+		return allErrs
+	}
+
+	if logging.Retention != nil {
+		retentionPath := fldPath.Child("retention")
+		if logging.Retention.Duration < 24*time.Hour {
+			allErrs = append(allErrs, field.Invalid(retentionPath, logging.Retention.Duration.String(), "must be at least 24h"))
+		} else if logging.Retention.Duration%(24*time.Hour) != 0 {
+			allErrs = append(allErrs, field.Invalid(retentionPath, logging.Retention.Duration.String(), "must be a multiple of 24h"))
+		}
+	}
+
+	if logging.StorageQuota != nil && logging.StorageQuota.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("storageQuota"), logging.StorageQuota.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateLoggingUpdate(newLogging, oldLogging *core.Logging, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldLogging == nil || oldLogging.StorageQuota == nil {
+		return allErrs
+	}
+
+	// decreasing the storage quota would require recreating the volume and thus lose all logs
+	if newLogging == nil || newLogging.StorageQuota == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("storageQuota"), "storage quota cannot be unset once it was set"))
+	} else if newLogging.StorageQuota.Cmp(*oldLogging.StorageQuota) < 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("storageQuota"), fmt.Sprintf("storage quota cannot be decreased (current value: %s)", oldLogging.StorageQuota.String())))
+	}
+
+	return allErrs
+}
+
 func validateMonitoring(monitoring *core.Monitoring, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if monitoring != nil && monitoring.Alerting != nil {
@@ -1487,6 +1918,53 @@ func validateMonitoring(monitoring *core.Monitoring, fldPath *field.Path) field.
 	return allErrs
 }
 
+// maxVPNHighThroughputTunnels is the maximum number of parallel VPN tunnels in the high-throughput mode.
+const maxVPNHighThroughputTunnels = 8
+
+func validateControlPlaneVPN(controlPlane *core.ControlPlane, workerless bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if controlPlane == nil || controlPlane.VPN == nil {
+		return allErrs
+	}
+
+	if workerless {
+		return append(allErrs, field.Forbidden(fldPath.Child("vpn"), workerlessErrorMsg))
+	}
+
+	highThroughput := controlPlane.VPN.HighThroughput
+	if highThroughput == nil {
+		return allErrs
+	}
+
+	fldPath = fldPath.Child("vpn", "highThroughput")
+
+	// Highly available control planes require at least two tunnels, otherwise a single zone outage breaks the VPN.
+	minTunnels := int32(1)
+	if controlPlane.HighAvailability != nil {
+		minTunnels = 2
+	}
+
+	if highThroughput.MinTunnels != nil {
+		if *highThroughput.MinTunnels < minTunnels {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minTunnels"), *highThroughput.MinTunnels, fmt.Sprintf("must be at least %d", minTunnels)))
+		}
+		if *highThroughput.MinTunnels > maxVPNHighThroughputTunnels {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minTunnels"), *highThroughput.MinTunnels, fmt.Sprintf("must not be greater than %d", maxVPNHighThroughputTunnels)))
+		}
+	}
+
+	if highThroughput.MaxTunnels != nil {
+		if highThroughput.MinTunnels != nil && *highThroughput.MaxTunnels < *highThroughput.MinTunnels {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxTunnels"), *highThroughput.MaxTunnels, "must not be less than minTunnels"))
+		}
+		if *highThroughput.MaxTunnels > maxVPNHighThroughputTunnels {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxTunnels"), *highThroughput.MaxTunnels, fmt.Sprintf("must not be greater than %d", maxVPNHighThroughputTunnels)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	emails := make(map[string]struct{})
@@ -1504,6 +1982,35 @@ func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorL
 	return allErrs
 }
 
+func validateTrustedCABundles(bundles []core.TrustedCABundle, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, bundle := range bundles {
+		idxPath := fldPath.Index(i)
+
+		if len(bundle.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(bundle.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), bundle.Name, msg))
+			}
+			if names.Has(bundle.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), bundle.Name))
+			}
+			names.Insert(bundle.Name)
+		}
+
+		if _, err := cert.ParseCertsPEM([]byte(bundle.CABundle)); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("caBundle"), bundle.CABundle, fmt.Sprintf("caBundle must only contain valid PEM-encoded certificates: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, workerless bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1640,6 +2147,9 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 	if len(worker.Taints) > 0 {
 		allErrs = append(allErrs, validateTaints(worker.Taints, fldPath.Child("taints"))...)
 	}
+	if worker.SystemComponents != nil && pointer.BoolDeref(worker.SystemComponents.Only, false) && !worker.SystemComponents.Allow {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("systemComponents", "only"), "worker pool can only be dedicated to system components if it allows system components"))
+	}
 	if worker.Kubernetes != nil {
 		if worker.Kubernetes.Version != nil {
 			workerGroupKubernetesVersion := *worker.Kubernetes.Version
@@ -1713,6 +2223,89 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		allErrs = append(allErrs, ValidateArchitecture(worker.Machine.Architecture, fldPath.Child("machine", "architecture"))...)
 	}
 
+	if worker.Topology != nil {
+		allErrs = append(allErrs, validateWorkerTopology(worker.Topology, fldPath.Child("topology"))...)
+	}
+
+	if worker.MaxNodeProvisionTime != nil {
+		allErrs = append(allErrs, ValidatePositiveDuration(worker.MaxNodeProvisionTime, fldPath.Child("maxNodeProvisionTime"))...)
+		if worker.MachineControllerManagerSettings != nil && worker.MachineControllerManagerSettings.MachineCreationTimeout != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxNodeProvisionTime"), "must not be set together with machineControllerManager.machineCreationTimeout"))
+		}
+	}
+
+	if worker.MachineControllerManagerSettings != nil && worker.MachineControllerManagerSettings.StuckMachinePolicy != nil {
+		allErrs = append(allErrs, validateStuckMachinePolicy(worker.MachineControllerManagerSettings.StuckMachinePolicy, fldPath.Child("machineControllerManager", "stuckMachinePolicy"))...)
+	}
+
+	if worker.OperatingSystemConfigRollout != nil {
+		allErrs = append(allErrs, validateOperatingSystemConfigRollout(worker.OperatingSystemConfigRollout, fldPath.Child("operatingSystemConfigRollout"))...)
+	}
+
+	if worker.ZoneRebalancing != nil {
+		allErrs = append(allErrs, validateWorkerZoneRebalancing(worker.ZoneRebalancing, worker.Zones, fldPath.Child("zoneRebalancing"))...)
+	}
+
+	return allErrs
+}
+
+func validateWorkerZoneRebalancing(zoneRebalancing *core.WorkerZoneRebalancing, zones []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(zones) < 2 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must only be set for worker pools with at least two zones"))
+	}
+
+	if zoneRebalancing.FailureThreshold != nil && *zoneRebalancing.FailureThreshold < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureThreshold"), *zoneRebalancing.FailureThreshold, "must be at least 1"))
+	}
+
+	if zoneRebalancing.RecoveryPeriod != nil && zoneRebalancing.RecoveryPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("recoveryPeriod"), zoneRebalancing.RecoveryPeriod.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateOperatingSystemConfigRollout(rollout *core.OperatingSystemConfigRollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rollout.CanaryNodes < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("canaryNodes"), rollout.CanaryNodes, "must be at least 1"))
+	}
+
+	if rollout.HealthTimeout != nil && rollout.HealthTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("healthTimeout"), rollout.HealthTimeout.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateStuckMachinePolicy(policy *core.StuckMachinePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableStuckMachineActions.Has(string(policy.Action)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), policy.Action, sets.List(availableStuckMachineActions)))
+	}
+
+	if policy.FailureThreshold != nil && *policy.FailureThreshold < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureThreshold"), *policy.FailureThreshold, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
+func validateWorkerTopology(topology *core.WorkerTopology, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if topology.ZoneGroup != nil {
+		if len(*topology.ZoneGroup) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("zoneGroup"), "must not be empty if specified"))
+		} else if errs := validation.IsValidLabelValue(*topology.ZoneGroup); len(errs) != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zoneGroup"), *topology.ZoneGroup, strings.Join(errs, ";")))
+		}
+	}
+
 	return allErrs
 }
 
@@ -1770,7 +2363,11 @@ func ValidateKubeletConfig(kubeletConfig core.KubeletConfig, version string, doc
 	if kubeletConfig.ImageGCHighThresholdPercent != nil && kubeletConfig.ImageGCLowThresholdPercent != nil && *kubeletConfig.ImageGCLowThresholdPercent >= *kubeletConfig.ImageGCHighThresholdPercent {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("imageGCLowThresholdPercent"), "imageGCLowThresholdPercent must be less than imageGCHighThresholdPercent"))
 	}
+	if kubeletConfig.ImageMinimumGCAge != nil {
+		allErrs = append(allErrs, ValidatePositiveDuration(kubeletConfig.ImageMinimumGCAge, fldPath.Child("imageMinimumGCAge"))...)
+	}
 	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(kubeletConfig.FeatureGates, version, fldPath.Child("featureGates"))...)
+	allErrs = append(allErrs, kubeletconfigvalidation.ValidateFields(kubeletConfig, version, fldPath)...)
 	if v := kubeletConfig.RegistryPullQPS; v != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*v), fldPath.Child("registryPullQPS"))...)
 	}
@@ -1778,9 +2375,6 @@ func ValidateKubeletConfig(kubeletConfig core.KubeletConfig, version string, doc
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*v), fldPath.Child("registryBurst"))...)
 	}
 	if v := kubeletConfig.SeccompDefault; v != nil {
-		if k8sVersionIsLessThan125, _ := versionutils.CompareVersions(version, "<", "1.25"); k8sVersionIsLessThan125 {
-			allErrs = append(allErrs, field.Forbidden(fldPath.Child("seccompDefault"), "seccomp defaulting is not available for kubernetes versions < 1.25"))
-		}
 		if featureGateEnabled, ok := kubeletConfig.FeatureGates["SeccompDefault"]; ok && !featureGateEnabled && *v {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("seccompDefault"), "seccomp defaulting is not available when kubelet's 'SeccompDefault' feature gate is disabled"))
 		}
@@ -1821,7 +2415,7 @@ func validateKubeletConfigEviction(eviction *core.KubeletConfigEviction, fldPath
 	allErrs = append(allErrs, ValidateResourceQuantityOrPercent(eviction.ImageFSAvailable, fldPath, "imagefsAvailable")...)
 	allErrs = append(allErrs, ValidateResourceQuantityOrPercent(eviction.ImageFSInodesFree, fldPath, "imagefsInodesFree")...)
 	allErrs = append(allErrs, ValidateResourceQuantityOrPercent(eviction.NodeFSAvailable, fldPath, "nodefsAvailable")...)
-	allErrs = append(allErrs, ValidateResourceQuantityOrPercent(eviction.ImageFSInodesFree, fldPath, "imagefsInodesFree")...)
+	allErrs = append(allErrs, ValidateResourceQuantityOrPercent(eviction.NodeFSInodesFree, fldPath, "nodefsInodesFree")...)
 	return allErrs
 }
 
@@ -1839,8 +2433,8 @@ func validateKubeletConfigEvictionMinimumReclaim(eviction *core.KubeletConfigEvi
 	if eviction.NodeFSAvailable != nil {
 		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("nodefsAvailable", *eviction.NodeFSAvailable, fldPath.Child("nodefsAvailable"))...)
 	}
-	if eviction.ImageFSInodesFree != nil {
-		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("imagefsInodesFree", *eviction.ImageFSInodesFree, fldPath.Child("imagefsInodesFree"))...)
+	if eviction.NodeFSInodesFree != nil {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("nodefsInodesFree", *eviction.NodeFSInodesFree, fldPath.Child("nodefsInodesFree"))...)
 	}
 	return allErrs
 }
@@ -1851,7 +2445,7 @@ func validateKubeletConfigEvictionSoftGracePeriod(eviction *core.KubeletConfigEv
 	allErrs = append(allErrs, ValidatePositiveDuration(eviction.ImageFSAvailable, fldPath.Child("imagefsAvailable"))...)
 	allErrs = append(allErrs, ValidatePositiveDuration(eviction.ImageFSInodesFree, fldPath.Child("imagefsInodesFree"))...)
 	allErrs = append(allErrs, ValidatePositiveDuration(eviction.NodeFSAvailable, fldPath.Child("nodefsAvailable"))...)
-	allErrs = append(allErrs, ValidatePositiveDuration(eviction.ImageFSInodesFree, fldPath.Child("imagefsInodesFree"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(eviction.NodeFSInodesFree, fldPath.Child("nodefsInodesFree"))...)
 	return allErrs
 }
 
@@ -1872,7 +2466,7 @@ func validateKubeletConfigReserved(reserved *core.KubeletConfigReserved, fldPath
 	return allErrs
 }
 
-var reservedTaintKeys = sets.New(v1beta1constants.TaintNodeCriticalComponentsNotReady)
+var reservedTaintKeys = sets.New(v1beta1constants.TaintNodeCriticalComponentsNotReady, v1beta1constants.TaintWorkerPoolSystemComponentsOnly)
 
 func validateClusterAutoscalerIgnoreTaints(ignoredTaints []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -2248,6 +2842,19 @@ func ValidateSystemComponents(systemComponents *core.SystemComponents, fldPath *
 
 	allErrs = append(allErrs, validateCoreDNS(systemComponents.CoreDNS, fldPath.Child("coreDNS"))...)
 
+	userProvided := sets.New[core.UserProvidedSystemComponent]()
+	for i, component := range systemComponents.UserProvided {
+		idxPath := fldPath.Child("userProvided").Index(i)
+
+		if !availableUserProvidedSystemComponents.Has(string(component)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, component, sets.List(availableUserProvidedSystemComponents)))
+		}
+		if userProvided.Has(component) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, component))
+		}
+		userProvided.Insert(component)
+	}
+
 	return allErrs
 }
 
@@ -2265,6 +2872,88 @@ func validateCoreDNS(coreDNS *core.CoreDNS, fldPath *field.Path) field.ErrorList
 	if coreDNS.Rewriting != nil {
 		allErrs = append(allErrs, ValidateCoreDNSRewritingCommonSuffixes(coreDNS.Rewriting.CommonSuffixes, fldPath.Child("rewriting"))...)
 	}
+	allErrs = append(allErrs, validateCoreDNSZones(coreDNS.Zones, fldPath.Child("zones"))...)
+	allErrs = append(allErrs, validateCoreDNSRewrites(coreDNS.Rewrites, fldPath.Child("rewrites"))...)
+
+	return allErrs
+}
+
+func validateCoreDNSZones(zones []core.CoreDNSZone, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, zone := range zones {
+		idxPath := fldPath.Index(i)
+		name := strings.TrimSuffix(zone.Name, ".")
+
+		if name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a zone name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), zone.Name, msg))
+			}
+			if name == core.DefaultDomain || strings.HasSuffix(name, "."+core.DefaultDomain) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("zone must neither be the cluster domain %q nor one of its sub-domains", core.DefaultDomain)))
+			}
+			if names.Has(name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), zone.Name))
+			}
+			names.Insert(name)
+		}
+
+		if len(zone.Upstreams) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("upstreams"), "must provide at least one upstream DNS server"))
+		}
+		for j, upstream := range zone.Upstreams {
+			if !isValidCoreDNSUpstream(upstream) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("upstreams").Index(j), upstream, "must be an IP address, optionally followed by a port"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func isValidCoreDNSUpstream(upstream string) bool {
+	if net.ParseIP(upstream) != nil {
+		return true
+	}
+
+	host, port, err := net.SplitHostPort(upstream)
+	if err != nil || net.ParseIP(host) == nil {
+		return false
+	}
+	p, err := strconv.Atoi(port)
+	return err == nil && p > 0 && p <= 65535
+}
+
+var coreDNSRewriteNameRegex = regexp.MustCompile(`^[a-zA-Z0-9.-]+$`)
+
+func validateCoreDNSRewrites(rewrites []core.CoreDNSRewrite, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, rewrite := range rewrites {
+		idxPath := fldPath.Index(i)
+
+		if !availableCoreDNSRewriteMatches.Has(string(rewrite.Match)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("match"), rewrite.Match, sets.List(availableCoreDNSRewriteMatches)))
+		}
+
+		allErrs = append(allErrs, validateCoreDNSRewriteName(rewrite.From, idxPath.Child("from"))...)
+		allErrs = append(allErrs, validateCoreDNSRewriteName(rewrite.To, idxPath.Child("to"))...)
+	}
+
+	return allErrs
+}
+
+func validateCoreDNSRewriteName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if name == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "must not be empty"))
+	} else if !coreDNSRewriteNameRegex.MatchString(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "must only consist of alphanumeric characters, dots ('.') and dashes ('-')"))
+	}
 
 	return allErrs
 }
@@ -2324,7 +3013,7 @@ func validateShootOperation(operation, maintenanceOperation string, shoot *core.
 		if !availableShootOperations.Has(operation) {
 			allErrs = append(allErrs, field.NotSupported(fldPathOp, operation, sets.List(availableShootOperations)))
 		}
-		if helper.IsShootInHibernation(shoot) && forbiddenShootOperationsWhenHibernated.Has(operation) {
+		if helper.IsShootInHibernation(shoot) && (forbiddenShootOperationsWhenHibernated.Has(operation) || operation == v1beta1constants.ShootOperationValidateResilience) {
 			allErrs = append(allErrs, field.Forbidden(fldPathOp, "operation is not permitted when shoot is hibernated or is waking up"))
 		}
 		if !apiequality.Semantic.DeepEqual(getResourcesForEncryption(shoot.Spec.Kubernetes.KubeAPIServer), shoot.Status.EncryptedResources) &&
@@ -2414,6 +3103,11 @@ func validateShootOperationContext(operation string, shoot *core.Shoot, fldPath
 		if helper.GetShootETCDEncryptionKeyRotationPhase(shoot.Status.Credentials) != core.RotationPrepared {
 			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot complete ETCD encryption key rotation if .status.credentials.rotation.etcdEncryptionKey.phase is not 'Prepared'"))
 		}
+
+	case v1beta1constants.ShootOperationPlan:
+		if !isShootReadyForRotationStart(shoot.Status.LastOperation) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot plan infrastructure changes if shoot was not yet created successfully or is not ready for reconciliation"))
+		}
 	}
 	return allErrs
 }
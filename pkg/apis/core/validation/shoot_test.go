@@ -783,6 +783,122 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		Context("trusted CA bundles", func() {
+			var caBundle string
+
+			BeforeEach(func() {
+				caBundle = *shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.CABundle
+			})
+
+			It("should allow valid trusted CA bundles", func() {
+				shoot.Spec.TrustedCABundles = []core.TrustedCABundle{
+					{Name: "corporate-ca", CABundle: caBundle},
+					{Name: "corporate-ca-new", CABundle: caBundle + "\n" + caBundle},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid trusted CA bundles", func() {
+				shoot.Spec.TrustedCABundles = []core.TrustedCABundle{
+					{Name: "", CABundle: caBundle},
+					{Name: "Corporate_CA", CABundle: caBundle},
+					{Name: "corporate-ca", CABundle: "foo"},
+					{Name: "corporate-ca", CABundle: caBundle},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.trustedCABundles[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.trustedCABundles[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.trustedCABundles[2].caBundle"),
+						"Detail": ContainSubstring("caBundle must only contain valid PEM-encoded certificates"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.trustedCABundles[3].name"),
+					})),
+				))
+			})
+		})
+
+		Context("logging", func() {
+			It("should allow valid logging settings", func() {
+				shoot.Spec.Logging = &core.Logging{
+					Retention:    &metav1.Duration{Duration: 7 * 24 * time.Hour},
+					StorageQuota: resource.NewQuantity(10*1024*1024*1024, resource.BinarySI),
+					QueryAPI:     &core.LoggingQueryAPI{Enabled: true},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid logging settings", func() {
+				shoot.Spec.Logging = &core.Logging{
+					Retention:    &metav1.Duration{Duration: 36 * time.Hour},
+					StorageQuota: resource.NewQuantity(0, resource.BinarySI),
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.logging.retention"),
+						"Detail": Equal("must be a multiple of 24h"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.logging.storageQuota"),
+						"Detail": Equal("must be greater than 0"),
+					})),
+				))
+			})
+
+			It("should forbid a retention shorter than 24h", func() {
+				shoot.Spec.Logging = &core.Logging{Retention: &metav1.Duration{Duration: time.Hour}}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.logging.retention"),
+					"Detail": Equal("must be at least 24h"),
+				}))))
+			})
+
+			It("should allow increasing the storage quota", func() {
+				shoot.Spec.Logging = &core.Logging{StorageQuota: resource.NewQuantity(10*1024*1024*1024, resource.BinarySI)}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Logging.StorageQuota = resource.NewQuantity(20*1024*1024*1024, resource.BinarySI)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should forbid decreasing or unsetting the storage quota", func() {
+				shoot.Spec.Logging = &core.Logging{StorageQuota: resource.NewQuantity(10*1024*1024*1024, resource.BinarySI)}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Logging.StorageQuota = resource.NewQuantity(5*1024*1024*1024, resource.BinarySI)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.logging.storageQuota"),
+					"Detail": Equal("storage quota cannot be decreased (current value: 10Gi)"),
+				}))))
+
+				newShoot.Spec.Logging = nil
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.logging.storageQuota"),
+					"Detail": Equal("storage quota cannot be unset once it was set"),
+				}))))
+			})
+		})
+
 		It("should forbid invalid tolerations", func() {
 			shoot.Spec.Tolerations = []core.Toleration{
 				{},
@@ -1459,6 +1575,97 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
+			Context("private", func() {
+				BeforeEach(func() {
+					shoot.Spec.DNS.Private = &core.PrivateDNS{
+						Domain:     "shoot.corp.internal",
+						Type:       "some-dns-provider",
+						SecretName: pointer.String("private-dns"),
+					}
+				})
+
+				It("should allow a valid private DNS configuration", func() {
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should allow the 'unmanaged' type without secret name", func() {
+					shoot.Spec.DNS.Private.Type = core.DNSUnmanaged
+					shoot.Spec.DNS.Private.SecretName = nil
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid private DNS configurations", func() {
+					shoot.Spec.DNS.Private.Domain = "foo/bar.baz"
+					shoot.Spec.DNS.Private.Type = ""
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.dns.private.domain"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.dns.private.type"),
+						})),
+					))
+				})
+
+				It("should forbid using the external domain as private domain", func() {
+					shoot.Spec.DNS.Private.Domain = *shoot.Spec.DNS.Domain
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.dns.private.domain"),
+						"Detail": Equal("private domain must differ from the external domain"),
+					}))))
+				})
+
+				It("should require a secret name if type is not 'unmanaged'", func() {
+					shoot.Spec.DNS.Private.SecretName = nil
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.dns.private.secretName"),
+					}))))
+				})
+
+				It("should forbid secret name and zone if type is 'unmanaged'", func() {
+					shoot.Spec.DNS.Private.Type = core.DNSUnmanaged
+					shoot.Spec.DNS.Private.Zone = pointer.String("zone")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.dns.private.secretName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.dns.private.zone"),
+						})),
+					))
+				})
+
+				It("should allow adding and removing the private DNS configuration", func() {
+					oldShoot := prepareShootForUpdate(shoot)
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.DNS.Private = nil
+
+					Expect(ValidateShootUpdate(newShoot, oldShoot)).To(BeEmpty())
+					Expect(ValidateShootUpdate(oldShoot, newShoot)).To(BeEmpty())
+				})
+
+				It("should forbid updating the private domain", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.DNS.Private.Domain = "other.corp.internal"
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.private.domain"),
+					}))))
+				})
+			})
+
 			It("should allow assigning the dns domain (dns nil)", func() {
 				oldShoot := prepareShootForUpdate(shoot)
 				oldShoot.Spec.DNS = nil
@@ -2159,6 +2366,104 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"Field": Equal("spec.kubernetes.kubeAPIServer.requests.maxMutatingInflight"),
 					}))))
 				})
+
+				It("should allow supported priority and fairness presets", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Requests = &core.APIServerRequests{
+						PriorityAndFairness: &core.APIServerPriorityAndFairness{
+							Presets: []core.APIServerPriorityAndFairnessPreset{
+								core.APIServerPriorityAndFairnessPresetProtectSystemComponents,
+								core.APIServerPriorityAndFairnessPresetLimitWorkloadServiceAccounts,
+							},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid unsupported and duplicate priority and fairness presets", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Requests = &core.APIServerRequests{
+						PriorityAndFairness: &core.APIServerPriorityAndFairness{
+							Presets: []core.APIServerPriorityAndFairnessPreset{
+								core.APIServerPriorityAndFairnessPresetProtectSystemComponents,
+								"Foo",
+								core.APIServerPriorityAndFairnessPresetProtectSystemComponents,
+							},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.requests.priorityAndFairness.presets[1]"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.requests.priorityAndFairness.presets[2]"),
+					}))))
+				})
+
+				It("should forbid priority and fairness presets for workerless shoots", func() {
+					Expect(ValidateKubeAPIServer(&core.KubeAPIServerConfig{
+						Requests: &core.APIServerRequests{
+							PriorityAndFairness: &core.APIServerPriorityAndFairness{
+								Presets: []core.APIServerPriorityAndFairnessPreset{core.APIServerPriorityAndFairnessPresetProtectSystemComponents},
+							},
+						},
+					}, "1.28.2", true, sets.New[string](), field.NewPath("kubeAPIServer"))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("kubeAPIServer.requests.priorityAndFairness.presets"),
+					}))))
+				})
+			})
+
+			Context("endpoint", func() {
+				It("should allow valid endpoint settings", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Endpoint = &core.APIServerEndpoint{
+						AllowedSourceRanges: []string{"10.0.0.0/8", "2001:db8::/64"},
+						StaticIP: &core.APIServerStaticIP{
+							Address:   pointer.String("1.2.3.4"),
+							PTRRecord: pointer.String("api.example.com"),
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid and duplicate allowed source ranges", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Endpoint = &core.APIServerEndpoint{
+						AllowedSourceRanges: []string{"foo", "10.0.0.1/8", "192.168.0.0/16", "192.168.0.0/16"},
+					}
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.allowedSourceRanges[0]"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.allowedSourceRanges[1]"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.allowedSourceRanges[3]"),
+					}))))
+				})
+
+				It("should forbid an invalid static IP address and PTR record", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Endpoint = &core.APIServerEndpoint{
+						StaticIP: &core.APIServerStaticIP{
+							Address:   pointer.String("1.2.3"),
+							PTRRecord: pointer.String("-foo_"),
+						},
+					}
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.staticIP.address"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.staticIP.ptrRecord"),
+					}))))
+				})
 			})
 
 			Context("service account config", func() {
@@ -2231,6 +2536,52 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"Detail": ContainSubstring("acceptedIssuers cannot contains the issuer field value: foo"),
 					}))))
 				})
+
+				It("should allow to specify a signing key rotation period", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
+						ExtendTokenExpiration:    pointer.Bool(false),
+						MaxTokenExpiration:       &metav1.Duration{Duration: 720 * time.Hour},
+						SigningKeyRotationPeriod: &metav1.Duration{Duration: 2160 * time.Hour},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid a signing key rotation period without max token expiration and with extended token expiration", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
+						SigningKeyRotationPeriod: &metav1.Duration{Duration: 2160 * time.Hour},
+					}
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("spec.kubernetes.kubeAPIServer.serviceAccountConfig.signingKeyRotationPeriod"),
+							"Detail": Equal("requires maxTokenExpiration to be set"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("spec.kubernetes.kubeAPIServer.serviceAccountConfig.signingKeyRotationPeriod"),
+							"Detail": Equal("requires extendTokenExpiration to be disabled"),
+						})),
+					))
+				})
+
+				It("should forbid a signing key rotation period shorter than the max token expiration", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
+						ExtendTokenExpiration:    pointer.Bool(false),
+						MaxTokenExpiration:       &metav1.Duration{Duration: 1440 * time.Hour},
+						SigningKeyRotationPeriod: &metav1.Duration{Duration: 720 * time.Hour},
+					}
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.serviceAccountConfig.signingKeyRotationPeriod"),
+					}))))
+				})
 			})
 
 			It("should not allow to specify a negative event ttl duration", func() {
@@ -2674,6 +3025,95 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Field": Equal("spec.kubernetes.kubeScheduler.profile"),
 				}))))
 			})
+
+			It("should succeed when using valid additional scheduling profiles", func() {
+				shoot.Spec.Kubernetes.KubeScheduler.Profiles = []core.KubeSchedulerProfile{
+					{
+						SchedulerName: "packing",
+						BinPacking:    pointer.Bool(true),
+						ScorePlugins:  []core.KubeSchedulerScorePlugin{{Name: "PodTopologySpread", Weight: 1}},
+					},
+					{
+						SchedulerName: "spreading",
+						ScorePlugins: []core.KubeSchedulerScorePlugin{
+							{Name: "PodTopologySpread", Weight: 100},
+							{Name: "NodeResourcesBalancedAllocation", Weight: 5},
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should fail when using invalid, reserved or duplicate scheduler names", func() {
+				shoot.Spec.Kubernetes.KubeScheduler.Profiles = []core.KubeSchedulerProfile{
+					{SchedulerName: ""},
+					{SchedulerName: "Foo_Bar"},
+					{SchedulerName: "default-scheduler"},
+					{SchedulerName: "bin-packing-scheduler"},
+					{SchedulerName: "foo"},
+					{SchedulerName: "foo"},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].schedulerName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[1].schedulerName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[2].schedulerName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[3].schedulerName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[5].schedulerName"),
+					})),
+				))
+			})
+
+			It("should fail when using invalid score plugins", func() {
+				shoot.Spec.Kubernetes.KubeScheduler.Profiles = []core.KubeSchedulerProfile{{
+					SchedulerName: "foo",
+					BinPacking:    pointer.Bool(true),
+					ScorePlugins: []core.KubeSchedulerScorePlugin{
+						{Name: "Foo", Weight: 1},
+						{Name: "ImageLocality", Weight: 0},
+						{Name: "ImageLocality", Weight: 101},
+						{Name: "NodeResourcesBalancedAllocation", Weight: 1},
+					},
+				}}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].scorePlugins[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].scorePlugins[1].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].scorePlugins[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].scorePlugins[2].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].scorePlugins[3].name"),
+					})),
+				))
+			})
 		})
 
 		Context("KubeProxy validation", func() {
@@ -2941,6 +3381,23 @@ var _ = Describe("Shoot Validation Tests", func() {
 					field.Invalid(field.NewPath("updaterInterval"), negativeDuration, "can not be negative"),
 					field.Invalid(field.NewPath("recommenderInterval"), negativeDuration, "can not be negative"),
 				)),
+				Entry("valid recommender settings", core.VerticalPodAutoscaler{
+					TargetCPUPercentile:          pointer.Float64(0.95),
+					TargetMemoryPercentile:       pointer.Float64(1),
+					CPUHistogramDecayHalfLife:    &metav1.Duration{Duration: time.Hour},
+					MemoryHistogramDecayHalfLife: &metav1.Duration{Duration: 48 * time.Hour},
+				}, BeEmpty()),
+				Entry("invalid recommender settings", core.VerticalPodAutoscaler{
+					TargetCPUPercentile:          pointer.Float64(0),
+					TargetMemoryPercentile:       pointer.Float64(1.5),
+					CPUHistogramDecayHalfLife:    &metav1.Duration{},
+					MemoryHistogramDecayHalfLife: &negativeDuration,
+				}, ConsistOf(
+					field.Invalid(field.NewPath("targetCPUPercentile"), float64(0), "must be greater than 0 and less than or equal to 1"),
+					field.Invalid(field.NewPath("targetMemoryPercentile"), 1.5, "must be greater than 0 and less than or equal to 1"),
+					field.Invalid(field.NewPath("cpuHistogramDecayHalfLife"), metav1.Duration{}, "must be greater than 0"),
+					field.Invalid(field.NewPath("memoryHistogramDecayHalfLife"), negativeDuration, "must be greater than 0"),
+				)),
 			)
 		})
 
@@ -3257,7 +3714,7 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":   Equal(field.ErrorTypeForbidden),
 					"Field":  Equal("spec.provider.workers[0].kubernetes.version"),
-					"Detail": Equal("worker group kubernetes version must not be higher than control plane version"),
+					"Detail": Equal("worker group kubernetes version must not be higher than control plane version 1.26.2, upgrade the control plane first"),
 				}))))
 			})
 
@@ -3297,8 +3754,8 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":   Equal(field.ErrorTypeForbidden),
-					"Field":  Equal("spec.provider.workers[0].kubernetes.version"),
-					"Detail": Equal("worker group kubernetes version must be at most two minor versions behind control plane version"),
+					"Field":  Equal("spec.kubernetes.version"),
+					"Detail": Equal(`control plane cannot be upgraded to 1.27.0 before worker pool "worker-name" is upgraded from 1.24.2 to at least 1.25 (kubelet must be at most two minor versions behind the control plane)`),
 				}))))
 			})
 
@@ -3319,10 +3776,50 @@ var _ = Describe("Shoot Validation Tests", func() {
 				newShoot := prepareShootForUpdate(shoot)
 				newShoot.Spec.Kubernetes.Version = "1.28.0"
 
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.kubernetes.version"),
+					"Detail": Equal(`control plane cannot be upgraded to 1.28.0 before worker pool "worker-name" is upgraded from 1.24.2 to at least 1.25 (kubelet must be at most three minor versions behind the control plane)`),
+				}))))
+			})
+
+			It("should allow to upgrade the control plane and a lagging worker pool in the same update", func() {
+				shoot.Spec.Kubernetes.Version = "1.26.0"
+				shoot.Spec.Provider.Workers[0].Kubernetes = &core.WorkerKubernetes{Version: pointer.String("1.24.2")}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Kubernetes.Version = "1.27.0"
+				newShoot.Spec.Provider.Workers[0].Kubernetes.Version = pointer.String("1.25.0")
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should only report the worker pool version if it is changed together with the control plane version", func() {
+				shoot.Spec.Kubernetes.Version = "1.26.0"
+				shoot.Spec.Provider.Workers[0].Kubernetes = &core.WorkerKubernetes{Version: pointer.String("1.24.2")}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Kubernetes.Version = "1.27.0"
+				newShoot.Spec.Provider.Workers[0].Kubernetes.Version = pointer.String("1.24.3")
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.provider.workers[0].kubernetes.version"),
+					"Detail": Equal("worker group kubernetes version must be at most two minor versions behind control plane version 1.27.0, use at least version 1.25 for this worker group"),
+				}))))
+			})
+
+			It("should forbid to upgrade a worker pool beyond the control plane version in the same update", func() {
+				shoot.Spec.Kubernetes.Version = "1.26.0"
+				shoot.Spec.Provider.Workers[0].Kubernetes = &core.WorkerKubernetes{Version: pointer.String("1.26.0")}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].Kubernetes.Version = pointer.String("1.27.0")
+
 				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":   Equal(field.ErrorTypeForbidden),
 					"Field":  Equal("spec.provider.workers[0].kubernetes.version"),
-					"Detail": Equal("worker group kubernetes version must be at most three minor versions behind control plane version"),
+					"Detail": Equal("worker group kubernetes version must not be higher than control plane version 1.26.0, upgrade the control plane first"),
 				}))))
 			})
 
@@ -3450,6 +3947,92 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			It("should forbid changing the networking pods and services ranges", func() {
+				shoot.Spec.Networking.Pods = pointer.String("100.96.0.0/12")
+				shoot.Spec.Networking.Services = pointer.String("100.64.0.0/14")
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Networking.Pods = pointer.String("100.96.0.0/11")
+				newShoot.Spec.Networking.Services = pointer.String("100.64.0.0/13")
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.pods"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.services"),
+				}))))
+			})
+
+			Context("ExpandableShootSpecNetworkingCIDRs feature gate is enabled", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ExpandableShootSpecNetworkingCIDRs, true))
+					shoot.Spec.Networking.Pods = pointer.String("100.96.0.0/12")
+					shoot.Spec.Networking.Services = pointer.String("100.64.0.0/14")
+				})
+
+				It("should allow expanding the networking pods and services ranges", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.Pods = pointer.String("100.96.0.0/11")
+					newShoot.Spec.Networking.Services = pointer.String("100.64.0.0/13")
+
+					errorList := ValidateShootUpdate(newShoot, shoot)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid shrinking the networking pods and services ranges", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.Pods = pointer.String("100.96.0.0/13")
+					newShoot.Spec.Networking.Services = pointer.String("100.64.0.0/15")
+
+					errorList := ValidateShootUpdate(newShoot, shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.pods"),
+						"Detail": ContainSubstring("can only be expanded to a larger CIDR containing the previous CIDR"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.services"),
+						"Detail": ContainSubstring("can only be expanded to a larger CIDR containing the previous CIDR"),
+					}))))
+				})
+
+				It("should forbid changing the networking pods and services ranges to CIDRs not containing the previous ones", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.Pods = pointer.String("100.112.0.0/12")
+					newShoot.Spec.Networking.Services = pointer.String("100.0.0.0/11")
+
+					errorList := ValidateShootUpdate(newShoot, shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.pods"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.services"),
+					}))))
+				})
+
+				It("should forbid removing the networking pods and services ranges", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.Pods = nil
+					newShoot.Spec.Networking.Services = nil
+
+					errorList := ValidateShootUpdate(newShoot, shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.pods"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.services"),
+					}))))
+				})
+			})
+
 			It("should forbid specifying unsupported IP family", func() {
 				shoot.Spec.Networking.IPFamilies = []core.IPFamily{"IPv5"}
 
@@ -3763,6 +4346,52 @@ var _ = Describe("Shoot Validation Tests", func() {
 			)
 		})
 
+		Describe("managed service account issuer", func() {
+			It("should allow enabling the managed service account issuer", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, "authentication.gardener.cloud/issuer", "managed")
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported values for the managed service account issuer annotation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "authentication.gardener.cloud/issuer", "foo")
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[authentication.gardener.cloud/issuer]"),
+				}))))
+			})
+
+			It("should forbid removing the managed service account issuer annotation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "authentication.gardener.cloud/issuer", "managed")
+				newShoot := prepareShootForUpdate(shoot)
+				delete(newShoot.Annotations, "authentication.gardener.cloud/issuer")
+
+				Expect(ValidateShootObjectMetaUpdate(newShoot.ObjectMeta, shoot.ObjectMeta, field.NewPath("metadata"))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("metadata.annotations[authentication.gardener.cloud/issuer]"),
+				}))))
+			})
+		})
+
+		Describe("pod security context defaulting", func() {
+			DescribeTable("should validate the mode",
+				func(mode string, matcher gomegatypes.GomegaMatcher) {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "alpha.security.shoot.gardener.cloud/pod-security-context-defaulting", mode)
+
+					Expect(ValidateShoot(shoot)).To(matcher)
+				},
+
+				Entry("enforce", "enforce", BeEmpty()),
+				Entry("dry-run", "dry-run", BeEmpty()),
+				Entry("unsupported mode", "foo", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[alpha.security.shoot.gardener.cloud/pod-security-context-defaulting]"),
+				})))),
+			)
+		})
+
 		Describe("#ValidateSystemComponents", func() {
 			DescribeTable("validate system components",
 				func(systemComponents *core.SystemComponents, workerlessShoot bool, matcher gomegatypes.GomegaMatcher) {
@@ -3781,7 +4410,161 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Entry("incorrect core dns autoscaler", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: "dummy"}}}, false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type": Equal(field.ErrorTypeNotSupported),
 				})))),
+				Entry("valid core dns zones", &core.SystemComponents{CoreDNS: &core.CoreDNS{Zones: []core.CoreDNSZone{
+					{Name: "corp.example.com", Upstreams: []string{"10.0.0.10", "10.0.0.11:5353"}},
+					{Name: "example.org.", Upstreams: []string{"2001:db8::1", "[2001:db8::2]:53"}},
+				}}}, false, BeEmpty()),
+				Entry("invalid core dns zones", &core.SystemComponents{CoreDNS: &core.CoreDNS{Zones: []core.CoreDNSZone{
+					{Name: "", Upstreams: []string{"10.0.0.10"}},
+					{Name: "foo_bar", Upstreams: []string{"10.0.0.10"}},
+					{Name: "svc.cluster.local", Upstreams: []string{"10.0.0.10"}},
+					{Name: "corp.example.com", Upstreams: []string{"10.0.0.10"}},
+					{Name: "corp.example.com.", Upstreams: []string{"10.0.0.10"}},
+					{Name: "example.org"},
+					{Name: "example.net", Upstreams: []string{"dns.example.com", "10.0.0.10:0", "10.0.0.10:70000"}},
+				}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("coreDNS.zones[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.zones[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("coreDNS.zones[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("coreDNS.zones[4].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("coreDNS.zones[5].upstreams"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.zones[6].upstreams[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.zones[6].upstreams[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.zones[6].upstreams[2]"),
+					})),
+				)),
+				Entry("valid core dns rewrites", &core.SystemComponents{CoreDNS: &core.CoreDNS{Rewrites: []core.CoreDNSRewrite{
+					{Match: core.CoreDNSRewriteMatchExact, From: "foo.example.com", To: "bar.example.com"},
+					{Match: core.CoreDNSRewriteMatchSuffix, From: ".old.example.com", To: ".new.example.com"},
+				}}}, false, BeEmpty()),
+				Entry("invalid core dns rewrites", &core.SystemComponents{CoreDNS: &core.CoreDNS{Rewrites: []core.CoreDNSRewrite{
+					{Match: "regex", From: "foo", To: "bar"},
+					{Match: core.CoreDNSRewriteMatchPrefix, From: "", To: "bar"},
+					{Match: core.CoreDNSRewriteMatchSubstring, From: "foo", To: "bar }\nimport"},
+				}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("coreDNS.rewrites[0].match"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("coreDNS.rewrites[1].from"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.rewrites[2].to"),
+					})),
+				)),
+				Entry("valid user-provided components", &core.SystemComponents{UserProvided: []core.UserProvidedSystemComponent{core.UserProvidedSystemComponentMetricsServer, core.UserProvidedSystemComponentKubeProxy}}, false, BeEmpty()),
+				Entry("invalid user-provided components", &core.SystemComponents{UserProvided: []core.UserProvidedSystemComponent{core.UserProvidedSystemComponentMetricsServer, "coredns", core.UserProvidedSystemComponentMetricsServer}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("userProvided[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("userProvided[2]"),
+					})),
+				)),
 			)
+
+			It("should forbid a user-provided kube-proxy if kube-proxy is disabled", func() {
+				proxyMode := core.ProxyModeIPTables
+				shoot.Spec.SystemComponents = &core.SystemComponents{UserProvided: []core.UserProvidedSystemComponent{core.UserProvidedSystemComponentMetricsServer, core.UserProvidedSystemComponentKubeProxy}}
+				shoot.Spec.Kubernetes.KubeProxy = &core.KubeProxyConfig{Mode: &proxyMode, Enabled: pointer.Bool(false)}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.systemComponents.userProvided[1]"),
+				}))))
+			})
+
+			It("should allow a user-provided kube-proxy if kube-proxy is enabled", func() {
+				proxyMode := core.ProxyModeIPTables
+				shoot.Spec.SystemComponents = &core.SystemComponents{UserProvided: []core.UserProvidedSystemComponent{core.UserProvidedSystemComponentKubeProxy}}
+				shoot.Spec.Kubernetes.KubeProxy = &core.KubeProxyConfig{Mode: &proxyMode, Enabled: pointer.Bool(true)}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+		})
+
+		Context("VPN high-throughput mode", func() {
+			It("should allow a valid configuration", func() {
+				shoot.Spec.ControlPlane = &core.ControlPlane{VPN: &core.ControlPlaneVPN{HighThroughput: &core.VPNHighThroughput{MinTunnels: pointer.Int32(1), MaxTunnels: pointer.Int32(4)}}}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid numbers of tunnels", func() {
+				shoot.Spec.ControlPlane = &core.ControlPlane{VPN: &core.ControlPlaneVPN{HighThroughput: &core.VPNHighThroughput{MinTunnels: pointer.Int32(0), MaxTunnels: pointer.Int32(9)}}}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.controlPlane.vpn.highThroughput.minTunnels"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.controlPlane.vpn.highThroughput.maxTunnels"),
+					})),
+				))
+			})
+
+			It("should forbid a maximum number of tunnels less than the minimum", func() {
+				shoot.Spec.ControlPlane = &core.ControlPlane{VPN: &core.ControlPlaneVPN{HighThroughput: &core.VPNHighThroughput{MinTunnels: pointer.Int32(3), MaxTunnels: pointer.Int32(2)}}}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.controlPlane.vpn.highThroughput.maxTunnels"),
+					"Detail": Equal("must not be less than minTunnels"),
+				}))))
+			})
+
+			It("should require at least two tunnels for highly available control planes", func() {
+				shoot.Spec.ControlPlane = &core.ControlPlane{
+					HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeNode}},
+					VPN:              &core.ControlPlaneVPN{HighThroughput: &core.VPNHighThroughput{MinTunnels: pointer.Int32(1), MaxTunnels: pointer.Int32(2)}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.controlPlane.vpn.highThroughput.minTunnels"),
+					"Detail": Equal("must be at least 2"),
+				}))))
+			})
+
+			It("should forbid the VPN settings for workerless shoots", func() {
+				shoot.Spec.Provider.Workers = nil
+				shoot.Spec.ControlPlane = &core.ControlPlane{VPN: &core.ControlPlaneVPN{HighThroughput: &core.VPNHighThroughput{MinTunnels: pointer.Int32(2), MaxTunnels: pointer.Int32(2)}}}
+
+				Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.controlPlane.vpn"),
+				}))))
+			})
 		})
 
 		Describe("#ValidateCoreDNSRewritingCommonSuffixes", func() {
@@ -4745,6 +5528,79 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
+			It("should allow collecting the diagnostic bundle", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "collect-diagnostics")
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid collecting the diagnostic bundle as maintenance operation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "collect-diagnostics")
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[maintenance.gardener.cloud/operation]"),
+				}))))
+			})
+
+			It("should allow validating the resilience", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "validate-resilience")
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid validating the resilience when the shoot is hibernated", func() {
+				shoot.Spec.Hibernation = &core.Hibernation{Enabled: pointer.Bool(true)}
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "validate-resilience")
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+					"Detail": ContainSubstring("operation is not permitted when shoot is hibernated or is waking up"),
+				}))))
+			})
+
+			It("should forbid validating the resilience as maintenance operation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "validate-resilience")
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[maintenance.gardener.cloud/operation]"),
+				}))))
+			})
+
+			It("should allow previewing the deletion", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "preview-deletion")
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid previewing the deletion as maintenance operation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "preview-deletion")
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[maintenance.gardener.cloud/operation]"),
+				}))))
+			})
+
+			It("should allow planning the infrastructure changes", func() {
+				shoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeReconcile, State: core.LastOperationStateSucceeded}
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "plan")
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid planning the infrastructure changes when the shoot was not yet created successfully", func() {
+				shoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeCreate, State: core.LastOperationStateError}
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "plan")
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+					"Detail": ContainSubstring("cannot plan infrastructure changes if shoot was not yet created successfully"),
+				}))))
+			})
+
+			It("should forbid planning the infrastructure changes as maintenance operation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "plan")
+				Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[maintenance.gardener.cloud/operation]"),
+				}))))
+			})
+
 			It("should return an error if the maintenance operation annotation is invalid", func() {
 				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "foo-bar")
 				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
@@ -5279,6 +6135,48 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 		})
+
+		Context("vulnerability scan results", func() {
+			It("should allow valid vulnerability scan results", func() {
+				newShoot.Status.VulnerabilityScanResults = []core.VulnerabilityScanResult{{
+					WorkerPool:          "worker",
+					MachineImageName:    "gardenlinux",
+					MachineImageVersion: "1312.2.0",
+					Vulnerabilities: []core.MachineImageVulnerability{
+						{ID: "CVE-2023-12345", Severity: core.VulnerabilitySeverityHigh},
+					},
+				}}
+
+				Expect(ValidateShootStatusUpdate(newShoot.Status, shoot.Status)).To(BeEmpty())
+			})
+
+			It("should forbid incomplete vulnerability scan results", func() {
+				newShoot.Status.VulnerabilityScanResults = []core.VulnerabilityScanResult{{
+					Vulnerabilities: []core.MachineImageVulnerability{
+						{ID: "CVE-2023-12345", Severity: "severe"},
+					},
+				}}
+
+				Expect(ValidateShootStatusUpdate(newShoot.Status, shoot.Status)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("status.vulnerabilityScanResults[0].workerPool"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("status.vulnerabilityScanResults[0].machineImageName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("status.vulnerabilityScanResults[0].machineImageVersion"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("status.vulnerabilityScanResults[0].vulnerabilities[0].severity"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateWorker", func() {
@@ -5730,6 +6628,174 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		It("should reject kubelet config fields not supported by the Kubernetes version of the worker pool", func() {
+			maxSurge := intstr.FromInt32(1)
+			maxUnavailable := intstr.FromInt32(0)
+			worker := core.Worker{
+				Name: "worker-name",
+				Machine: core.Machine{
+					Type: "large",
+					Image: &core.ShootMachineImage{
+						Name:    "image-name",
+						Version: "1.0.0",
+					},
+					Architecture: pointer.String("amd64"),
+				},
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+				Kubernetes: &core.WorkerKubernetes{
+					Version: pointer.String("1.24.8"),
+					Kubelet: &core.KubeletConfig{
+						SeccompDefault: pointer.Bool(true),
+					},
+				},
+			}
+			errList := ValidateWorker(worker, core.Kubernetes{Version: "1.25.4"}, nil, false)
+			Expect(errList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("kubernetes.kubelet.seccompDefault"),
+					"Detail": Equal("not supported in Kubernetes version 1.24.8"),
+				})),
+			))
+		})
+
+		DescribeTable("validate topology",
+			func(topology *core.WorkerTopology, matcher gomegatypes.GomegaMatcher) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type:         "large",
+						Architecture: pointer.String("amd64"),
+					},
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+					Topology:       topology,
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.27.3"}, nil, false)).To(matcher)
+			},
+
+			Entry("no topology", nil, BeEmpty()),
+			Entry("empty topology", &core.WorkerTopology{}, BeEmpty()),
+			Entry("valid topology", &core.WorkerTopology{ZoneGroup: pointer.String("eu-west"), DedicatedToProject: pointer.Bool(true)}, BeEmpty()),
+			Entry("empty zone group", &core.WorkerTopology{ZoneGroup: pointer.String("")}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("topology.zoneGroup"),
+			})))),
+			Entry("invalid zone group", &core.WorkerTopology{ZoneGroup: pointer.String("eu west")}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("topology.zoneGroup"),
+			})))),
+		)
+
+		DescribeTable("validate maxNodeProvisionTime and stuck machine policy",
+			func(maxNodeProvisionTime *metav1.Duration, mcmSettings *core.MachineControllerManagerSettings, matcher gomegatypes.GomegaMatcher) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type:         "large",
+						Architecture: pointer.String("amd64"),
+					},
+					MaxSurge:                         &maxSurge,
+					MaxUnavailable:                   &maxUnavailable,
+					MaxNodeProvisionTime:             maxNodeProvisionTime,
+					MachineControllerManagerSettings: mcmSettings,
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.27.3"}, nil, false)).To(matcher)
+			},
+
+			Entry("nothing set", nil, nil, BeEmpty()),
+			Entry("valid maxNodeProvisionTime", &metav1.Duration{Duration: 15 * time.Minute}, nil, BeEmpty()),
+			Entry("negative maxNodeProvisionTime", &metav1.Duration{Duration: -time.Minute}, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("maxNodeProvisionTime"),
+			})))),
+			Entry("maxNodeProvisionTime together with machineCreationTimeout", &metav1.Duration{Duration: 15 * time.Minute}, &core.MachineControllerManagerSettings{MachineCreationTimeout: &metav1.Duration{Duration: 20 * time.Minute}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("maxNodeProvisionTime"),
+			})))),
+			Entry("valid stuck machine policy", nil, &core.MachineControllerManagerSettings{StuckMachinePolicy: &core.StuckMachinePolicy{Action: core.StuckMachineActionPausePool, FailureThreshold: pointer.Int32(3)}}, BeEmpty()),
+			Entry("unsupported stuck machine action", nil, &core.MachineControllerManagerSettings{StuckMachinePolicy: &core.StuckMachinePolicy{Action: "Foo"}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("machineControllerManager.stuckMachinePolicy.action"),
+			})))),
+			Entry("invalid failure threshold", nil, &core.MachineControllerManagerSettings{StuckMachinePolicy: &core.StuckMachinePolicy{Action: core.StuckMachineActionKeepRetrying, FailureThreshold: pointer.Int32(0)}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("machineControllerManager.stuckMachinePolicy.failureThreshold"),
+			})))),
+		)
+
+		DescribeTable("validate operating system config rollout",
+			func(rollout *core.OperatingSystemConfigRollout, matcher gomegatypes.GomegaMatcher) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type:         "large",
+						Architecture: pointer.String("amd64"),
+					},
+					MaxSurge:                     &maxSurge,
+					MaxUnavailable:               &maxUnavailable,
+					OperatingSystemConfigRollout: rollout,
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.27.3"}, nil, false)).To(matcher)
+			},
+
+			Entry("nothing set", nil, BeEmpty()),
+			Entry("valid rollout", &core.OperatingSystemConfigRollout{CanaryNodes: 2, HealthTimeout: &metav1.Duration{Duration: 5 * time.Minute}}, BeEmpty()),
+			Entry("no canary nodes", &core.OperatingSystemConfigRollout{CanaryNodes: 0}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("operatingSystemConfigRollout.canaryNodes"),
+			})))),
+			Entry("non-positive health timeout", &core.OperatingSystemConfigRollout{CanaryNodes: 1, HealthTimeout: &metav1.Duration{}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("operatingSystemConfigRollout.healthTimeout"),
+			})))),
+		)
+
+		DescribeTable("validate zone rebalancing",
+			func(zones []string, zoneRebalancing *core.WorkerZoneRebalancing, matcher gomegatypes.GomegaMatcher) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type:         "large",
+						Architecture: pointer.String("amd64"),
+					},
+					MaxSurge:        &maxSurge,
+					MaxUnavailable:  &maxUnavailable,
+					Zones:           zones,
+					ZoneRebalancing: zoneRebalancing,
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.27.3"}, nil, false)).To(matcher)
+			},
+
+			Entry("nothing set", []string{"a"}, nil, BeEmpty()),
+			Entry("valid zone rebalancing", []string{"a", "b"}, &core.WorkerZoneRebalancing{FailureThreshold: pointer.Int32(3), RecoveryPeriod: &metav1.Duration{Duration: 30 * time.Minute}}, BeEmpty()),
+			Entry("only one zone", []string{"a"}, &core.WorkerZoneRebalancing{}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("zoneRebalancing"),
+			})))),
+			Entry("invalid failure threshold", []string{"a", "b"}, &core.WorkerZoneRebalancing{FailureThreshold: pointer.Int32(0)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("zoneRebalancing.failureThreshold"),
+			})))),
+			Entry("non-positive recovery period", []string{"a", "b"}, &core.WorkerZoneRebalancing{RecoveryPeriod: &metav1.Duration{}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("zoneRebalancing.recoveryPeriod"),
+			})))),
+		)
+
 		DescribeTable("validate CRI name depending on the kubernetes version",
 			func(name core.CRIName, matcher gomegatypes.GomegaMatcher) {
 				worker := core.Worker{
@@ -5867,6 +6933,59 @@ var _ = Describe("Shoot Validation Tests", func() {
 					})),
 				))
 			})
+
+			It("should forbid the taint key for worker pools dedicated to system components", func() {
+				worker.Taints = []corev1.Taint{{
+					Key:    "worker.gardener.cloud/system-components-only",
+					Value:  "true",
+					Effect: "NoSchedule",
+				}}
+
+				errList := ValidateWorker(worker, kubernetes, fldPath, false)
+
+				Expect(errList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("workers[0].taints[0].key"),
+						"Detail": Equal("taint key is reserved by gardener"),
+					})),
+				))
+			})
+		})
+
+		Describe("system components validation", func() {
+			var (
+				worker     core.Worker
+				kubernetes core.Kubernetes
+				fldPath    *field.Path
+			)
+
+			BeforeEach(func() {
+				worker = core.Worker{
+					Name: "worker1",
+					Machine: core.Machine{
+						Type: "xlarge",
+					},
+				}
+				fldPath = field.NewPath("workers").Index(0)
+			})
+
+			It("should allow dedicating the worker pool to system components", func() {
+				worker.SystemComponents = &core.WorkerSystemComponents{Allow: true, Only: pointer.Bool(true)}
+
+				Expect(ValidateWorker(worker, kubernetes, fldPath, false)).To(BeEmpty())
+			})
+
+			It("should forbid dedicating the worker pool to system components if they are not allowed", func() {
+				worker.SystemComponents = &core.WorkerSystemComponents{Allow: false, Only: pointer.Bool(true)}
+
+				Expect(ValidateWorker(worker, kubernetes, fldPath, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("workers[0].systemComponents.only"),
+					})),
+				))
+			})
 		})
 	})
 
@@ -6156,6 +7275,15 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal(field.NewPath("evictionSoft.memoryAvailable").String()),
 				})))),
+			Entry("only allow resource.Quantity or percent value for nodefsInodesFree", validPercentValue, validPercentValue, validPercentValue, validPercentValue, invalidValue, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(field.NewPath("evictionHard.nodefsInodesFree").String()),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(field.NewPath("evictionSoft.nodefsInodesFree").String()),
+				})))),
 		)
 
 		Describe("pod pids limits", func() {
@@ -6219,12 +7347,12 @@ var _ = Describe("Shoot Validation Tests", func() {
 			Entry("do not allow to set SeccompDefault to true when k8s version < 1.25", "1.24", true, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":   Equal(field.ErrorTypeForbidden),
 				"Field":  Equal("seccompDefault"),
-				"Detail": Equal("seccomp defaulting is not available for kubernetes versions < 1.25"),
+				"Detail": Equal("not supported in Kubernetes version 1.24"),
 			})))),
 			Entry("do not allow to set SeccompDefault to false when k8s version < 1.25", "1.24", false, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":   Equal(field.ErrorTypeForbidden),
 				"Field":  Equal("seccompDefault"),
-				"Detail": Equal("seccomp defaulting is not available for kubernetes versions < 1.25"),
+				"Detail": Equal("not supported in Kubernetes version 1.24"),
 			})))),
 			Entry("do not allow to set SeccompDefault to true when feature gate is disabled", "1.25", true, pointer.Bool(false), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":   Equal(field.ErrorTypeForbidden),
@@ -6258,6 +7386,10 @@ var _ = Describe("Shoot Validation Tests", func() {
 				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal(field.NewPath("evictionMinimumReclaim.memoryAvailable").String()),
 			})))),
+			Entry("only allow positive resource.Quantity for nodefsInodesFree", validResourceQuantity, validResourceQuantity, validResourceQuantity, validResourceQuantity, invalidResourceQuantity, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal(field.NewPath("evictionMinimumReclaim.nodefsInodesFree").String()),
+			})))),
 		)
 
 		validDuration := metav1.Duration{Duration: 2 * time.Minute}
@@ -6285,6 +7417,11 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal(field.NewPath("evictionSoftGracePeriod.memoryAvailable").String()),
 				})))),
+			Entry("only allow positive Duration for nodefsInodesFree", validDuration, validDuration, validDuration, validDuration, invalidDuration, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(field.NewPath("evictionSoftGracePeriod.nodefsInodesFree").String()),
+				})))),
 		)
 
 		DescribeTable("EvictionPressureTransitionPeriod",
@@ -6451,6 +7588,26 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		DescribeTable("ImageMinimumGCAge",
+			func(imageMinimumGCAge metav1.Duration, matcher gomegatypes.GomegaMatcher) {
+				kubeletConfig := core.KubeletConfig{
+					ImageMinimumGCAge: &imageMinimumGCAge,
+				}
+
+				errList := ValidateKubeletConfig(kubeletConfig, "", true, nil)
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("valid configuration", validDuration, BeEmpty()),
+			Entry("only allow positive Duration", invalidDuration, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(field.NewPath("imageMinimumGCAge").String()),
+				})),
+			)),
+		)
+
 		DescribeTable("EvictionMaxPodGracePeriod",
 			func(evictionMaxPodGracePeriod int32, matcher gomegatypes.GomegaMatcher) {
 				kubeletConfig := core.KubeletConfig{
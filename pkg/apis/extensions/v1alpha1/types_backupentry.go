@@ -86,6 +86,11 @@ type BackupEntrySpec struct {
 	BucketName string `json:"bucketName"`
 	// SecretRef is a reference to a secret that contains the credentials to access object store.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// EncryptionSecretRef is a reference to a secret that contains a customer-supplied key used to encrypt the backups.
+	// The secret contains the current key in the `key` data key and, after a rotation, the previous key in the
+	// `previousKey` data key.
+	// +optional
+	EncryptionSecretRef *corev1.SecretReference `json:"encryptionSecretRef,omitempty"`
 }
 
 // BackupEntryStatus is the status for an BackupEntry resource.
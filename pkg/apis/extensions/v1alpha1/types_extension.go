@@ -16,6 +16,8 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 var _ Object = (*Extension)(nil)
@@ -74,4 +76,9 @@ type ExtensionSpec struct {
 type ExtensionStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
 	DefaultStatus `json:",inline"`
+	// VulnerabilityScanResults contains the results of the latest vulnerability scans of the machine images used by the
+	// worker pools of the shoot. It is only set by extensions scanning the nodes for vulnerabilities. gardenlet
+	// publishes the results in the status of the Shoot.
+	// +optional
+	VulnerabilityScanResults []gardencorev1beta1.VulnerabilityScanResult `json:"vulnerabilityScanResults,omitempty"`
 }
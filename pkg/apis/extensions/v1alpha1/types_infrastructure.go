@@ -93,4 +93,50 @@ type InfrastructureStatus struct {
 	// IPs may not be stable in which case the extension controller may opt to not populate this field.
 	// +optional
 	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
+	// Plan is a machine-readable preview of the changes the acting extension controller would perform on the
+	// provider's infrastructure when reconciling the current specification. It is only computed on request, i.e.,
+	// when the Infrastructure is annotated with `gardener.cloud/operation=plan`, and it is not populated by
+	// extensions which do not support planning.
+	// +optional
+	Plan *InfrastructurePlan `json:"plan,omitempty"`
+}
+
+// InfrastructurePlan is a preview of the changes an extension controller would perform on the provider's
+// infrastructure.
+type InfrastructurePlan struct {
+	// LastUpdateTime is the time when the plan was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Changes is the list of changes which would be performed on the provider's infrastructure. An empty list
+	// indicates that the infrastructure is up-to-date.
+	// +optional
+	Changes []InfrastructurePlannedChange `json:"changes,omitempty"`
+}
+
+// InfrastructurePlannedChange is a single change which would be performed on a resource of the provider's
+// infrastructure.
+type InfrastructurePlannedChange struct {
+	// Action is the action which would be performed on the resource.
+	Action InfrastructurePlannedAction `json:"action"`
+	// ResourceType is the provider-specific type of the resource, e.g. `subnet` or `security-group`.
+	ResourceType string `json:"resourceType"`
+	// Name is the provider-specific name or identifier of the resource.
+	Name string `json:"name"`
+	// Reason is a human-readable explanation why the change is necessary, e.g. the attribute forcing a replacement.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
 }
+
+// InfrastructurePlannedAction is a type for actions which would be performed on a resource of the provider's
+// infrastructure.
+type InfrastructurePlannedAction string
+
+const (
+	// InfrastructurePlannedActionCreate indicates that the resource would be created.
+	InfrastructurePlannedActionCreate InfrastructurePlannedAction = "Create"
+	// InfrastructurePlannedActionUpdate indicates that the resource would be updated in-place.
+	InfrastructurePlannedActionUpdate InfrastructurePlannedAction = "Update"
+	// InfrastructurePlannedActionReplace indicates that the resource would be deleted and re-created.
+	InfrastructurePlannedActionReplace InfrastructurePlannedAction = "Replace"
+	// InfrastructurePlannedActionDelete indicates that the resource would be deleted.
+	InfrastructurePlannedActionDelete InfrastructurePlannedAction = "Delete"
+)
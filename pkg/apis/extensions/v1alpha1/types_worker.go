@@ -154,12 +154,19 @@ type WorkerPool struct {
 	// Architecture is the CPU architecture of the worker pool machines and machine image.
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
+	// ZoneRebalancing contains settings for the automatic rebalancing of the machines of this worker pool to its other
+	// zones in case a zone repeatedly has insufficient capacity.
+	// +optional
+	ZoneRebalancing *gardencorev1beta1.WorkerZoneRebalancing `json:"zoneRebalancing,omitempty"`
 }
 
 // NodeTemplate contains information about the expected node properties.
 type NodeTemplate struct {
 	// Capacity represents the expected Node capacity.
 	Capacity corev1.ResourceList `json:"capacity"`
+	// Labels are the expected labels of the Node.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // MachineImage contains logical information about the name and the version of the machie image that
@@ -223,4 +230,32 @@ type MachineDeployment struct {
 	Minimum int32 `json:"minimum"`
 	// Maximum is the maximum number for this machine deployment.
 	Maximum int32 `json:"maximum"`
+	// Pool is the name of the worker pool this machine deployment belongs to.
+	// +optional
+	Pool string `json:"pool,omitempty"`
+	// Progress is the progress of the machine deployment while its machines are rolled out. It is only set during
+	// rollouts.
+	// +optional
+	Progress *MachineDeploymentProgress `json:"progress,omitempty"`
 }
+
+// MachineDeploymentProgress is the progress of a machine deployment while its machines are rolled out.
+type MachineDeploymentProgress struct {
+	// Desired is the number of desired machines.
+	Desired int32 `json:"desired"`
+	// Updated is the number of machines which already run the current configuration of the worker pool.
+	Updated int32 `json:"updated"`
+	// Ready is the number of machines which are ready.
+	Ready int32 `json:"ready"`
+	// Old is the number of machines which still run an outdated configuration of the worker pool.
+	Old int32 `json:"old"`
+}
+
+const (
+	// WorkerConditionTypeMachineDeploymentsPaused specifies the condition type "MachineDeploymentsPaused" which reports
+	// machine deployments which were paused according to the stuck machine policy of their worker pool.
+	WorkerConditionTypeMachineDeploymentsPaused = "MachineDeploymentsPaused"
+	// WorkerConditionTypeZonesRebalanced specifies the condition type "ZonesRebalanced" which reports zones whose
+	// desired machines were shifted to the other zones of their worker pool because of insufficient capacity.
+	WorkerConditionTypeZonesRebalanced = "ZonesRebalanced"
+)
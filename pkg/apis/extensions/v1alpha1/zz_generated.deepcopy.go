@@ -199,6 +199,11 @@ func (in *BackupEntrySpec) DeepCopyInto(out *BackupEntrySpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.SecretRef = in.SecretRef
+	if in.EncryptionSecretRef != nil {
+		in, out := &in.EncryptionSecretRef, &out.EncryptionSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
 	return
 }
 
@@ -1003,6 +1008,13 @@ func (in *ExtensionSpec) DeepCopy() *ExtensionSpec {
 func (in *ExtensionStatus) DeepCopyInto(out *ExtensionStatus) {
 	*out = *in
 	in.DefaultStatus.DeepCopyInto(&out.DefaultStatus)
+	if in.VulnerabilityScanResults != nil {
+		in, out := &in.VulnerabilityScanResults, &out.VulnerabilityScanResults
+		*out = make([]v1beta1.VulnerabilityScanResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1183,6 +1195,51 @@ func (in *InfrastructureList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructurePlan) DeepCopyInto(out *InfrastructurePlan) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]InfrastructurePlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructurePlan.
+func (in *InfrastructurePlan) DeepCopy() *InfrastructurePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructurePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructurePlannedChange) DeepCopyInto(out *InfrastructurePlannedChange) {
+	*out = *in
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructurePlannedChange.
+func (in *InfrastructurePlannedChange) DeepCopy() *InfrastructurePlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructurePlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureSpec) DeepCopyInto(out *InfrastructureSpec) {
 	*out = *in
@@ -1220,6 +1277,11 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(InfrastructurePlan)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1236,6 +1298,11 @@ func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeployment) DeepCopyInto(out *MachineDeployment) {
 	*out = *in
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(MachineDeploymentProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -1249,6 +1316,22 @@ func (in *MachineDeployment) DeepCopy() *MachineDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentProgress) DeepCopyInto(out *MachineDeploymentProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentProgress.
+func (in *MachineDeploymentProgress) DeepCopy() *MachineDeploymentProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 	*out = *in
@@ -1375,6 +1458,13 @@ func (in *NodeTemplate) DeepCopyInto(out *NodeTemplate) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1753,6 +1843,11 @@ func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneRebalancing != nil {
+		in, out := &in.ZoneRebalancing, &out.ZoneRebalancing
+		*out = new(v1beta1.WorkerZoneRebalancing)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1808,7 +1903,9 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	if in.MachineDeployments != nil {
 		in, out := &in.MachineDeployments, &out.MachineDeployments
 		*out = make([]MachineDeployment, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.MachineDeploymentsLastUpdateTime != nil {
 		in, out := &in.MachineDeploymentsLastUpdateTime, &out.MachineDeploymentsLastUpdateTime
@@ -21,6 +21,7 @@ import (
 	"github.com/go-test/deep"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -101,6 +102,7 @@ func ValidateWorkerPools(pools []extensionsv1alpha1.WorkerPool, fldPath *field.P
 			for resourceName, value := range pool.NodeTemplate.Capacity {
 				allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(string(resourceName), value, idxPath.Child("nodeTemplate", "capacity", string(resourceName)))...)
 			}
+			allErrs = append(allErrs, metav1validation.ValidateLabels(pool.NodeTemplate.Labels, idxPath.Child("nodeTemplate", "labels"))...)
 		}
 	}
 
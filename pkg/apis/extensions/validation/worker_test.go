@@ -99,6 +99,7 @@ var _ = Describe("Worker validation tests", func() {
 					"gpu":    resource.MustParse("1"),
 					"memory": resource.MustParse("8Gi"),
 				},
+				Labels: map[string]string{"/invalid": "foo"},
 			}
 
 			errorList := ValidateWorker(workerCopy)
@@ -121,6 +122,9 @@ var _ = Describe("Worker validation tests", func() {
 			})), PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal("spec.pools[0].nodeTemplate.capacity.cpu"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.pools[0].nodeTemplate.labels"),
 			})), PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeNotSupported),
 				"Field": Equal("spec.pools[0].architecture"),
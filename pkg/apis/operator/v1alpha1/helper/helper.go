@@ -15,6 +15,8 @@
 package helper
 
 import (
+	"time"
+
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 )
@@ -149,3 +151,76 @@ func HighAvailabilityEnabled(garden *operatorv1alpha1.Garden) bool {
 func TopologyAwareRoutingEnabled(settings *operatorv1alpha1.Settings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
+
+const (
+	// DefaultReplicationInterval is the default interval in which the etcd backups of the primary virtual garden are
+	// copied by a standby garden.
+	DefaultReplicationInterval = 10 * time.Minute
+	// DefaultReplicationMaxLag is the default maximum duration since the last successful replication until the
+	// replication is considered unhealthy.
+	DefaultReplicationMaxLag = 30 * time.Minute
+)
+
+// IsVirtualGardenStandby returns true if the garden is configured as standby for a primary virtual garden.
+func IsVirtualGardenStandby(garden *operatorv1alpha1.Garden) bool {
+	replication := garden.Spec.VirtualCluster.Replication
+	return replication != nil && replication.Role == operatorv1alpha1.ReplicationRoleStandby
+}
+
+// IsVirtualGardenPromotionPending returns true if a former standby garden was switched to the 'Primary' role but has
+// not yet been promoted completely, i.e., the gardenlets have not yet been re-pointed to it.
+func IsVirtualGardenPromotionPending(garden *operatorv1alpha1.Garden) bool {
+	replication := garden.Spec.VirtualCluster.Replication
+	if replication == nil || replication.Role != operatorv1alpha1.ReplicationRolePrimary || replication.Source == nil {
+		return false
+	}
+
+	return garden.Status.Replication != nil &&
+		garden.Status.Replication.LastReplicationTime != nil &&
+		garden.Status.Replication.PromotionTime == nil
+}
+
+// GetReplicationInterval returns the configured replication interval or the default value.
+func GetReplicationInterval(replication *operatorv1alpha1.Replication) time.Duration {
+	if replication != nil && replication.Interval != nil {
+		return replication.Interval.Duration
+	}
+	return DefaultReplicationInterval
+}
+
+// GetReplicationMaxLag returns the configured maximum replication lag or the default value.
+func GetReplicationMaxLag(replication *operatorv1alpha1.Replication) time.Duration {
+	if replication != nil && replication.MaxLag != nil {
+		return replication.MaxLag.Duration
+	}
+	return DefaultReplicationMaxLag
+}
+
+const (
+	// DefaultHorizontalAutoscalingMinReplicas is the default minimum number of replicas for the horizontal autoscaling
+	// of a virtual garden component.
+	DefaultHorizontalAutoscalingMinReplicas int32 = 1
+	// DefaultHorizontalAutoscalingMaxReplicas is the default maximum number of replicas for the horizontal autoscaling
+	// of a virtual garden component.
+	DefaultHorizontalAutoscalingMaxReplicas int32 = 4
+)
+
+// GetHorizontalAutoscalingReplicas returns the configured minimum and maximum number of replicas or the default values.
+func GetHorizontalAutoscalingReplicas(autoscaling *operatorv1alpha1.HorizontalAutoscaling) (int32, int32) {
+	minReplicas, maxReplicas := DefaultHorizontalAutoscalingMinReplicas, DefaultHorizontalAutoscalingMaxReplicas
+	if autoscaling != nil && autoscaling.MinReplicas != nil {
+		minReplicas = *autoscaling.MinReplicas
+	}
+	if autoscaling != nil && autoscaling.MaxReplicas != nil {
+		maxReplicas = *autoscaling.MaxReplicas
+	}
+	return minReplicas, maxReplicas
+}
+
+// GetResourceProfile returns the configured resource profile or the default `Medium` profile.
+func GetResourceProfile(resources *operatorv1alpha1.ComponentResources) operatorv1alpha1.ResourceProfile {
+	if resources != nil && resources.Profile != nil {
+		return *resources.Profile
+	}
+	return operatorv1alpha1.ResourceProfileMedium
+}
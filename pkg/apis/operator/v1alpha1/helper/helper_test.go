@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
@@ -191,8 +192,84 @@ var _ = Describe("helper", func() {
 		Entry("topology-aware routing enabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: true}}, true),
 		Entry("topology-aware routing disabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: false}}, false),
 	)
+
+	DescribeTable("#IsVirtualGardenStandby",
+		func(replication *operatorv1alpha1.Replication, expected bool) {
+			garden := &operatorv1alpha1.Garden{}
+			garden.Spec.VirtualCluster.Replication = replication
+
+			Expect(IsVirtualGardenStandby(garden)).To(Equal(expected))
+		},
+
+		Entry("no replication", nil, false),
+		Entry("primary", &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRolePrimary}, false),
+		Entry("standby", &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRoleStandby}, true),
+	)
+
+	DescribeTable("#IsVirtualGardenPromotionPending",
+		func(replication *operatorv1alpha1.Replication, status *operatorv1alpha1.ReplicationStatus, expected bool) {
+			garden := &operatorv1alpha1.Garden{}
+			garden.Spec.VirtualCluster.Replication = replication
+			garden.Status.Replication = status
+
+			Expect(IsVirtualGardenPromotionPending(garden)).To(Equal(expected))
+		},
+
+		Entry("no replication", nil, nil, false),
+		Entry("standby", &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRoleStandby, Source: &operatorv1alpha1.Backup{}}, &operatorv1alpha1.ReplicationStatus{LastReplicationTime: timePointer(time.Now())}, false),
+		Entry("primary without source", &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRolePrimary}, &operatorv1alpha1.ReplicationStatus{LastReplicationTime: timePointer(time.Now())}, false),
+		Entry("primary which never replicated", &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRolePrimary, Source: &operatorv1alpha1.Backup{}}, nil, false),
+		Entry("primary which was already promoted", &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRolePrimary, Source: &operatorv1alpha1.Backup{}}, &operatorv1alpha1.ReplicationStatus{LastReplicationTime: timePointer(time.Now()), PromotionTime: timePointer(time.Now())}, false),
+		Entry("former standby switched to primary", &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRolePrimary, Source: &operatorv1alpha1.Backup{}}, &operatorv1alpha1.ReplicationStatus{LastReplicationTime: timePointer(time.Now())}, true),
+	)
+
+	DescribeTable("#GetReplicationInterval",
+		func(replication *operatorv1alpha1.Replication, expected time.Duration) {
+			Expect(GetReplicationInterval(replication)).To(Equal(expected))
+		},
+
+		Entry("no replication", nil, DefaultReplicationInterval),
+		Entry("interval not set", &operatorv1alpha1.Replication{}, DefaultReplicationInterval),
+		Entry("interval set", &operatorv1alpha1.Replication{Interval: &metav1.Duration{Duration: time.Minute}}, time.Minute),
+	)
+
+	DescribeTable("#GetReplicationMaxLag",
+		func(replication *operatorv1alpha1.Replication, expected time.Duration) {
+			Expect(GetReplicationMaxLag(replication)).To(Equal(expected))
+		},
+
+		Entry("no replication", nil, DefaultReplicationMaxLag),
+		Entry("max lag not set", &operatorv1alpha1.Replication{}, DefaultReplicationMaxLag),
+		Entry("max lag set", &operatorv1alpha1.Replication{MaxLag: &metav1.Duration{Duration: time.Hour}}, time.Hour),
+	)
+
+	DescribeTable("#GetHorizontalAutoscalingReplicas",
+		func(autoscaling *operatorv1alpha1.HorizontalAutoscaling, expectedMin, expectedMax int32) {
+			minReplicas, maxReplicas := GetHorizontalAutoscalingReplicas(autoscaling)
+			Expect(minReplicas).To(Equal(expectedMin))
+			Expect(maxReplicas).To(Equal(expectedMax))
+		},
+
+		Entry("no autoscaling", nil, DefaultHorizontalAutoscalingMinReplicas, DefaultHorizontalAutoscalingMaxReplicas),
+		Entry("replicas not set", &operatorv1alpha1.HorizontalAutoscaling{}, DefaultHorizontalAutoscalingMinReplicas, DefaultHorizontalAutoscalingMaxReplicas),
+		Entry("replicas set", &operatorv1alpha1.HorizontalAutoscaling{MinReplicas: pointer.Int32(2), MaxReplicas: pointer.Int32(8)}, int32(2), int32(8)),
+	)
+
+	DescribeTable("#GetResourceProfile",
+		func(resources *operatorv1alpha1.ComponentResources, expected operatorv1alpha1.ResourceProfile) {
+			Expect(GetResourceProfile(resources)).To(Equal(expected))
+		},
+
+		Entry("no resources", nil, operatorv1alpha1.ResourceProfileMedium),
+		Entry("profile not set", &operatorv1alpha1.ComponentResources{}, operatorv1alpha1.ResourceProfileMedium),
+		Entry("profile set", &operatorv1alpha1.ComponentResources{Profile: resourceProfilePtr(operatorv1alpha1.ResourceProfileLarge)}, operatorv1alpha1.ResourceProfileLarge),
+	)
 })
 
+func resourceProfilePtr(profile operatorv1alpha1.ResourceProfile) *operatorv1alpha1.ResourceProfile {
+	return &profile
+}
+
 func timePointer(t time.Time) *metav1.Time {
 	return &metav1.Time{Time: t}
 }
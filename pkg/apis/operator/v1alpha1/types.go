@@ -174,8 +174,43 @@ type VirtualCluster struct {
 	Maintenance Maintenance `json:"maintenance"`
 	// Networking contains information about cluster networking such as CIDRs, etc.
 	Networking Networking `json:"networking"`
+	// Replication contains configuration for replicating the virtual garden cluster to a standby garden running in
+	// another region.
+	// +optional
+	Replication *Replication `json:"replication,omitempty"`
 }
 
+// Replication contains configuration for replicating the virtual garden cluster to a standby garden.
+type Replication struct {
+	// Role is the role of this garden in a replicated setup. A 'Standby' garden only runs the runtime components and
+	// continuously copies the etcd backups of the primary virtual garden into its own backup bucket. Changing the role
+	// from 'Standby' to 'Primary' promotes the standby garden, i.e., the virtual garden is restored from the replicated
+	// backups and all gardenlets are re-pointed to it.
+	// +kubebuilder:validation:Enum=Primary;Standby
+	Role ReplicationRole `json:"role"`
+	// Source contains the object store configuration of the etcd backups of the primary virtual garden. It is required
+	// for the 'Standby' role.
+	// +optional
+	Source *Backup `json:"source,omitempty"`
+	// Interval is the interval in which the etcd backups of the primary virtual garden are copied. Defaults to `10m`.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// MaxLag is the maximum duration since the last successful replication until the replication is considered
+	// unhealthy. Defaults to `30m`.
+	// +optional
+	MaxLag *metav1.Duration `json:"maxLag,omitempty"`
+}
+
+// ReplicationRole is the role of a garden in a replicated setup.
+type ReplicationRole string
+
+const (
+	// ReplicationRolePrimary is the role of the garden serving the virtual garden cluster.
+	ReplicationRolePrimary ReplicationRole = "Primary"
+	// ReplicationRoleStandby is the role of a garden replicating the etcd backups of the primary virtual garden.
+	ReplicationRoleStandby ReplicationRole = "Standby"
+)
+
 // DNS holds information about DNS settings.
 type DNS struct {
 	// Domains are the external domains of the virtual garden cluster.
@@ -203,6 +238,9 @@ type ETCDMain struct {
 	// Storage contains storage configuration.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
+	// Resources contains configuration for the sizing and the vertical autoscaling of the main etcd.
+	// +optional
+	Resources *ComponentResources `json:"resources,omitempty"`
 }
 
 // ETCDEvents contains configuration for the events etcd.
@@ -210,6 +248,9 @@ type ETCDEvents struct {
 	// Storage contains storage configuration.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
+	// Resources contains configuration for the sizing and the vertical autoscaling of the events etcd.
+	// +optional
+	Resources *ComponentResources `json:"resources,omitempty"`
 }
 
 // Storage contains storage configuration.
@@ -223,6 +264,55 @@ type Storage struct {
 	ClassName *string `json:"className,omitempty"`
 }
 
+// ResourceProfile is the name of a predefined set of resource requests and vertical autoscaling bounds for a component
+// of the virtual garden.
+type ResourceProfile string
+
+const (
+	// ResourceProfileSmall is the resource profile for small landscapes, e.g., for development or testing purposes.
+	ResourceProfileSmall ResourceProfile = "Small"
+	// ResourceProfileMedium is the default resource profile.
+	ResourceProfileMedium ResourceProfile = "Medium"
+	// ResourceProfileLarge is the resource profile for large landscapes with many seeds and shoots.
+	ResourceProfileLarge ResourceProfile = "Large"
+)
+
+// ComponentResources contains configuration for the sizing and the vertical autoscaling of a component of the virtual
+// garden.
+type ComponentResources struct {
+	// Profile is the resource profile of the component. It determines the initial resource requests and the bounds for
+	// the resources recommended by the vertical autoscaling. Defaults to `Medium`.
+	// +kubebuilder:validation:Enum=Small;Medium;Large
+	// +optional
+	Profile *ResourceProfile `json:"profile,omitempty"`
+	// MinAllowed overrides the lower bounds of the resource profile for the resources recommended by the vertical
+	// autoscaling.
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// MaxAllowed overrides the upper bounds of the resource profile for the resources recommended by the vertical
+	// autoscaling.
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+}
+
+// HorizontalAutoscaling contains configuration for the horizontal autoscaling of a component of the virtual garden
+// based on the rate of requests it serves.
+type HorizontalAutoscaling struct {
+	// MinReplicas is the minimum number of replicas. Defaults to `1`.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of replicas. Defaults to `4`.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// TargetRequestsPerReplica is the average number of requests per second which a replica should serve. The replicas
+	// are scaled based on the `apiserver_request_rate` pods metric, which must be provided by a custom metrics adapter
+	// (e.g., prometheus-adapter) in the runtime cluster.
+	// +kubebuilder:validation:Minimum=1
+	TargetRequestsPerReplica int32 `json:"targetRequestsPerReplica"`
+}
+
 // Backup contains the object store configuration for backups for the virtual garden etcd.
 type Backup struct {
 	// Provider is a provider name. This field is immutable.
@@ -424,6 +514,13 @@ type GardenerAPIServerConfig struct {
 	// EncryptionConfig contains customizable encryption configuration of the Gardener API server.
 	// +optional
 	EncryptionConfig *gardencorev1beta1.EncryptionConfig `json:"encryptionConfig,omitempty"`
+	// Resources contains configuration for the sizing and the vertical autoscaling of the gardener-apiserver.
+	// +optional
+	Resources *ComponentResources `json:"resources,omitempty"`
+	// Autoscaling contains configuration for the horizontal autoscaling of the gardener-apiserver based on the rate of
+	// requests it serves.
+	// +optional
+	Autoscaling *HorizontalAutoscaling `json:"autoscaling,omitempty"`
 }
 
 // GardenerAdmissionControllerConfig contains configuration settings for the gardener-admission-controller.
@@ -481,6 +578,9 @@ type GardenerControllerManagerConfig struct {
 	// +kubebuilder:default=info
 	// +optional
 	LogLevel *string `json:"logLevel,omitempty"`
+	// Resources contains configuration for the sizing and the vertical autoscaling of the gardener-controller-manager.
+	// +optional
+	Resources *ComponentResources `json:"resources,omitempty"`
 }
 
 // ProjectQuotaConfiguration defines quota configurations.
@@ -525,6 +625,19 @@ type GardenStatus struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/concepts/operator.md#etcd-encryption-config for more details.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty"`
+	// Replication contains information about the replication of the virtual garden cluster.
+	// +optional
+	Replication *ReplicationStatus `json:"replication,omitempty"`
+}
+
+// ReplicationStatus contains information about the replication of the virtual garden cluster.
+type ReplicationStatus struct {
+	// LastReplicationTime is the last time the etcd backups of the primary virtual garden were successfully copied.
+	// +optional
+	LastReplicationTime *metav1.Time `json:"lastReplicationTime,omitempty"`
+	// PromotionTime is the time when this garden was promoted from 'Standby' to 'Primary'.
+	// +optional
+	PromotionTime *metav1.Time `json:"promotionTime,omitempty"`
 }
 
 // Credentials contains information about the virtual garden cluster credentials.
@@ -559,6 +672,9 @@ const (
 	VirtualGardenAPIServerAvailable gardencorev1beta1.ConditionType = "VirtualGardenAPIServerAvailable"
 	// ObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
 	ObservabilityComponentsHealthy gardencorev1beta1.ConditionType = "ObservabilityComponentsHealthy"
+	// VirtualGardenReplicationHealthy is a constant for a condition type indicating the health of the replication of the
+	// virtual garden's etcd backups to a standby garden.
+	VirtualGardenReplicationHealthy gardencorev1beta1.ConditionType = "VirtualGardenReplicationHealthy"
 )
 
 // AvailableOperationAnnotations is the set of available operation annotations for Garden resources.
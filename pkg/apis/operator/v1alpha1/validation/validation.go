@@ -19,6 +19,7 @@ import (
 	"net"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
@@ -44,6 +45,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
@@ -109,6 +111,7 @@ func validateVirtualClusterUpdate(oldGarden, newGarden *operatorv1alpha1.Garden)
 
 	allErrs = append(allErrs, gardencorevalidation.ValidateKubernetesVersionUpdate(newVirtualCluster.Kubernetes.Version, oldVirtualCluster.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
 	allErrs = append(allErrs, validateEncryptionConfigUpdate(oldGarden, newGarden)...)
+	allErrs = append(allErrs, validateReplicationUpdate(oldVirtualCluster.Replication, newVirtualCluster.Replication, fldPath.Child("replication"))...)
 
 	return allErrs
 }
@@ -156,6 +159,10 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		}
 
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeAPIServer(coreKubeAPIServerConfig, virtualCluster.Kubernetes.Version, true, gardenerutils.DefaultResourcesForEncryption(), path)...)
+
+		if serviceAccountConfig := kubeAPIServer.ServiceAccountConfig; serviceAccountConfig != nil && serviceAccountConfig.SigningKeyRotationPeriod != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("serviceAccountConfig", "signingKeyRotationPeriod"), "automatic rotation of the service account signing key is not supported for the virtual garden cluster"))
+		}
 	}
 
 	if kubeControllerManager := virtualCluster.Kubernetes.KubeControllerManager; kubeControllerManager != nil && kubeControllerManager.KubeControllerManagerConfig != nil {
@@ -169,7 +176,17 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeControllerManager(coreKubeControllerManagerConfig, nil, virtualCluster.Kubernetes.Version, true, path)...)
 	}
 
+	if etcd := virtualCluster.ETCD; etcd != nil {
+		if etcd.Main != nil {
+			allErrs = append(allErrs, validateComponentResources(etcd.Main.Resources, fldPath.Child("etcd", "main", "resources"))...)
+		}
+		if etcd.Events != nil {
+			allErrs = append(allErrs, validateComponentResources(etcd.Events.Resources, fldPath.Child("etcd", "events", "resources"))...)
+		}
+	}
+
 	allErrs = append(allErrs, validateGardener(virtualCluster.Gardener, fldPath.Child("gardener"))...)
+	allErrs = append(allErrs, validateReplication(virtualCluster, fldPath.Child("replication"))...)
 
 	if _, _, err := net.ParseCIDR(virtualCluster.Networking.Services); err != nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("networking", "services"), virtualCluster.Networking.Services, fmt.Sprintf("cannot parse service network cidr: %s", err.Error())))
@@ -187,6 +204,62 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 	return allErrs
 }
 
+func validateReplication(virtualCluster operatorv1alpha1.VirtualCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	replication := virtualCluster.Replication
+	if replication == nil {
+		return allErrs
+	}
+
+	if replication.Role == operatorv1alpha1.ReplicationRoleStandby && replication.Source == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("source"), "source must be set for the 'Standby' role"))
+	}
+
+	if replication.Source != nil {
+		if virtualCluster.ETCD == nil || virtualCluster.ETCD.Main == nil || virtualCluster.ETCD.Main.Backup == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "virtualCluster", "etcd", "main", "backup"), "backup of the main etcd must be configured when replicating the virtual garden"))
+		} else if backup := virtualCluster.ETCD.Main.Backup; backup.Provider == replication.Source.Provider && backup.BucketName == replication.Source.BucketName {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("source", "bucketName"), replication.Source.BucketName, "source bucket must differ from the backup bucket of the main etcd"))
+		}
+	}
+
+	if replication.Interval != nil && replication.Interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), replication.Interval.Duration.String(), "interval must be positive"))
+	}
+	if replication.MaxLag != nil && replication.MaxLag.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxLag"), replication.MaxLag.Duration.String(), "max lag must be positive"))
+	}
+	if interval, maxLag := helper.GetReplicationInterval(replication), helper.GetReplicationMaxLag(replication); maxLag <= interval {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxLag"), maxLag.String(), fmt.Sprintf("max lag must be greater than the replication interval (%s)", interval)))
+	}
+
+	return allErrs
+}
+
+func validateReplicationUpdate(oldReplication, newReplication *operatorv1alpha1.Replication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		oldIsStandby = oldReplication != nil && oldReplication.Role == operatorv1alpha1.ReplicationRoleStandby
+		newIsStandby = newReplication != nil && newReplication.Role == operatorv1alpha1.ReplicationRoleStandby
+	)
+
+	// A standby garden does not run a virtual garden, hence, turning a running garden into a standby garden would
+	// effectively shut down its virtual garden. The former primary garden must be deleted and re-created as standby.
+	if newIsStandby && !oldIsStandby {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("role"), "an existing garden cannot be turned into a standby garden, it must be re-created instead"))
+	}
+
+	// A standby garden is promoted by changing its role to 'Primary' while keeping the source, so that the operator can
+	// do a final replication before restoring the virtual garden.
+	if oldIsStandby && (newReplication == nil || newReplication.Source == nil) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "replication source must not be removed from a standby garden, change the role to 'Primary' to promote it"))
+	}
+
+	return allErrs
+}
+
 func validateGardener(config operatorv1alpha1.Gardener, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -274,8 +347,15 @@ func validateGardenerAPIServerConfig(config *operatorv1alpha1.GardenerAPIServerC
 			allErrs = append(allErrs, field.InternalError(fldPath.Child("requests"), err))
 		}
 		allErrs = append(allErrs, gardencorevalidation.ValidateAPIServerRequests(requests, fldPath.Child("requests"))...)
+
+		if config.Requests.PriorityAndFairness != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("requests", "priorityAndFairness"), "API Priority and Fairness presets are not supported for the gardener-apiserver"))
+		}
 	}
 
+	allErrs = append(allErrs, validateComponentResources(config.Resources, fldPath.Child("resources"))...)
+	allErrs = append(allErrs, validateHorizontalAutoscaling(config.Autoscaling, fldPath.Child("autoscaling"))...)
+
 	return allErrs
 }
 
@@ -311,6 +391,8 @@ func validateGardenerControllerManagerConfig(config *operatorv1alpha1.GardenerCo
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(quota.ProjectSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("defaultProjectQuotas").Index(i).Child("projectSelector"))...)
 	}
 
+	allErrs = append(allErrs, validateComponentResources(config.Resources, fldPath.Child("resources"))...)
+
 	return allErrs
 }
 
@@ -326,6 +408,73 @@ func validateGardenerSchedulerConfig(config *operatorv1alpha1.GardenerSchedulerC
 	return allErrs
 }
 
+var supportedResourceProfiles = sets.New(
+	string(operatorv1alpha1.ResourceProfileSmall),
+	string(operatorv1alpha1.ResourceProfileMedium),
+	string(operatorv1alpha1.ResourceProfileLarge),
+)
+
+func validateComponentResources(resources *operatorv1alpha1.ComponentResources, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if resources == nil {
+		return allErrs
+	}
+
+	if resources.Profile != nil && !supportedResourceProfiles.Has(string(*resources.Profile)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("profile"), *resources.Profile, sets.List(supportedResourceProfiles)))
+	}
+
+	for _, resourceList := range []struct {
+		name      string
+		resources corev1.ResourceList
+	}{
+		{"minAllowed", resources.MinAllowed},
+		{"maxAllowed", resources.MaxAllowed},
+	} {
+		for resourceName, quantity := range resourceList.resources {
+			idxPath := fldPath.Child(resourceList.name).Key(string(resourceName))
+
+			if resourceName != corev1.ResourceCPU && resourceName != corev1.ResourceMemory {
+				allErrs = append(allErrs, field.NotSupported(idxPath, resourceName, []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}))
+				continue
+			}
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(string(resourceName), quantity, idxPath)...)
+		}
+	}
+
+	for resourceName, minAllowed := range resources.MinAllowed {
+		if maxAllowed, ok := resources.MaxAllowed[resourceName]; ok && minAllowed.Cmp(maxAllowed) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minAllowed").Key(string(resourceName)), minAllowed.String(), fmt.Sprintf("must not be greater than maxAllowed (%s)", maxAllowed.String())))
+		}
+	}
+
+	return allErrs
+}
+
+func validateHorizontalAutoscaling(autoscaling *operatorv1alpha1.HorizontalAutoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoscaling == nil {
+		return allErrs
+	}
+
+	if autoscaling.MinReplicas != nil && *autoscaling.MinReplicas <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *autoscaling.MinReplicas, "must be greater than 0"))
+	}
+	if autoscaling.MaxReplicas != nil && *autoscaling.MaxReplicas <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *autoscaling.MaxReplicas, "must be greater than 0"))
+	}
+	if minReplicas, maxReplicas := helper.GetHorizontalAutoscalingReplicas(autoscaling); minReplicas > maxReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), maxReplicas, fmt.Sprintf("must not be less than minReplicas (%d)", minReplicas)))
+	}
+	if autoscaling.TargetRequestsPerReplica <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetRequestsPerReplica"), autoscaling.TargetRequestsPerReplica, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
 func validateGardenerFeatureGates(featureGates map[string]bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -376,6 +525,12 @@ func validateOperationContext(operation string, garden *operatorv1alpha1.Garden,
 
 	resourcesToEncrypt := append(sharedcomponent.GetResourcesForEncryptionFromConfig(encryptionConfig), sharedcomponent.GetResourcesForEncryptionFromConfig(gardenerEncryptionConfig)...)
 
+	// The credentials of a standby garden must match those of the primary garden, hence, they must not be rotated
+	// independently.
+	if helper.IsVirtualGardenStandby(garden) && operation != v1beta1constants.GardenerOperationReconcile {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot rotate credentials of a standby garden"))
+	}
+
 	switch operation {
 	case v1beta1constants.OperationRotateCredentialsStart:
 		if garden.DeletionTimestamp != nil {
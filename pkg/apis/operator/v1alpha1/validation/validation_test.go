@@ -16,6 +16,7 @@ package validation_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -40,7 +41,10 @@ import (
 
 var _ = Describe("Validation Tests", func() {
 	Describe("#ValidateGarden", func() {
-		var garden *operatorv1alpha1.Garden
+		var (
+			garden               *operatorv1alpha1.Garden
+			resourceProfileLarge = operatorv1alpha1.ResourceProfileLarge
+		)
 
 		BeforeEach(func() {
 			garden = &operatorv1alpha1.Garden{
@@ -921,6 +925,156 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("KubeAPIServer", func() {
+				It("should forbid configuring an automatic rotation of the service account signing key", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.ServiceAccountConfig = &gardencorev1beta1.ServiceAccountConfig{
+						ExtendTokenExpiration:    pointer.Bool(false),
+						MaxTokenExpiration:       &metav1.Duration{Duration: 720 * time.Hour},
+						SigningKeyRotationPeriod: &metav1.Duration{Duration: 720 * time.Hour},
+					}
+
+					Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.serviceAccountConfig.signingKeyRotationPeriod"),
+					}))))
+				})
+			})
+
+			Context("ETCD", func() {
+				It("should allow valid resources", func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main:   &operatorv1alpha1.ETCDMain{Resources: &operatorv1alpha1.ComponentResources{Profile: &resourceProfileLarge}},
+						Events: &operatorv1alpha1.ETCDEvents{Resources: &operatorv1alpha1.ComponentResources{Profile: &resourceProfileLarge}},
+					}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should forbid invalid resources", func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{Resources: &operatorv1alpha1.ComponentResources{
+							MaxAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Gi")},
+						}},
+						Events: &operatorv1alpha1.ETCDEvents{Resources: &operatorv1alpha1.ComponentResources{
+							MinAllowed: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+						}},
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.virtualCluster.etcd.main.resources.maxAllowed[memory]"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.virtualCluster.etcd.events.resources.minAllowed[pods]"),
+					}))))
+				})
+			})
+
+			Context("Replication", func() {
+				BeforeEach(func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{
+							Backup: &operatorv1alpha1.Backup{Provider: "local", BucketName: "standby-bucket"},
+						},
+					}
+					garden.Spec.VirtualCluster.Replication = &operatorv1alpha1.Replication{
+						Role:   operatorv1alpha1.ReplicationRoleStandby,
+						Source: &operatorv1alpha1.Backup{Provider: "local", BucketName: "primary-bucket"},
+					}
+				})
+
+				It("should allow a valid replication configuration", func() {
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should require a source for the 'Standby' role", func() {
+					garden.Spec.VirtualCluster.Replication.Source = nil
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.virtualCluster.replication.source"),
+					}))))
+				})
+
+				It("should require a backup of the main etcd", func() {
+					garden.Spec.VirtualCluster.ETCD = nil
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.virtualCluster.etcd.main.backup"),
+					}))))
+				})
+
+				It("should forbid using the backup bucket of the main etcd as source", func() {
+					garden.Spec.VirtualCluster.Replication.Source.BucketName = "standby-bucket"
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.virtualCluster.replication.source.bucketName"),
+					}))))
+				})
+
+				It("should forbid invalid interval and max lag durations", func() {
+					garden.Spec.VirtualCluster.Replication.Interval = &metav1.Duration{}
+					garden.Spec.VirtualCluster.Replication.MaxLag = &metav1.Duration{Duration: -time.Minute}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.virtualCluster.replication.interval"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.replication.maxLag"),
+							"Detail": Equal("max lag must be positive"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.replication.maxLag"),
+							"Detail": Equal("max lag must be greater than the replication interval (0s)"),
+						})),
+					))
+				})
+
+				It("should forbid a max lag which is not greater than the interval", func() {
+					garden.Spec.VirtualCluster.Replication.Interval = &metav1.Duration{Duration: 10 * time.Minute}
+					garden.Spec.VirtualCluster.Replication.MaxLag = &metav1.Duration{Duration: 5 * time.Minute}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.virtualCluster.replication.maxLag"),
+						"Detail": Equal("max lag must be greater than the replication interval (10m0s)"),
+					}))))
+				})
+
+				It("should forbid an interval which is not smaller than the default max lag", func() {
+					garden.Spec.VirtualCluster.Replication.Interval = &metav1.Duration{Duration: time.Hour}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.virtualCluster.replication.maxLag"),
+						"Detail": Equal("max lag must be greater than the replication interval (1h0m0s)"),
+					}))))
+				})
+
+				It("should forbid rotating credentials of a standby garden", func() {
+					metav1.SetMetaDataAnnotation(&garden.ObjectMeta, "gardener.cloud/operation", "rotate-ca-start")
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot rotate credentials of a standby garden"),
+					}))))
+				})
+
+				It("should allow the 'Primary' role without source", func() {
+					garden.Spec.VirtualCluster.ETCD = nil
+					garden.Spec.VirtualCluster.Replication = &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRolePrimary}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+			})
+
 			Context("Gardener", func() {
 				Context("APIServer", func() {
 					BeforeEach(func() {
@@ -1258,6 +1412,105 @@ var _ = Describe("Validation Tests", func() {
 								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.requests.maxMutatingInflight"),
 							}))))
 						})
+
+						It("should forbid priority and fairness presets", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Requests = &gardencorev1beta1.APIServerRequests{
+								PriorityAndFairness: &gardencorev1beta1.APIServerPriorityAndFairness{},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.requests.priorityAndFairness"),
+							}))))
+						})
+					})
+
+					Context("Resources", func() {
+						It("should allow valid resources", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Resources = &operatorv1alpha1.ComponentResources{
+								Profile:    &resourceProfileLarge,
+								MinAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+								MaxAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8"), corev1.ResourceMemory: resource.MustParse("40Gi")},
+							}
+
+							Expect(ValidateGarden(garden)).To(BeEmpty())
+						})
+
+						It("should forbid unsupported profiles", func() {
+							profile := operatorv1alpha1.ResourceProfile("Huge")
+							garden.Spec.VirtualCluster.Gardener.APIServer.Resources = &operatorv1alpha1.ComponentResources{Profile: &profile}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeNotSupported),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.resources.profile"),
+							}))))
+						})
+
+						It("should forbid unsupported resources and negative quantities", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Resources = &operatorv1alpha1.ComponentResources{
+								MinAllowed: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+								MaxAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("-1")},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeNotSupported),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.resources.minAllowed[storage]"),
+							})), PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.resources.maxAllowed[cpu]"),
+							}))))
+						})
+
+						It("should forbid minAllowed being greater than maxAllowed", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Resources = &operatorv1alpha1.ComponentResources{
+								MinAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+								MaxAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.resources.minAllowed[memory]"),
+							}))))
+						})
+					})
+
+					Context("Autoscaling", func() {
+						It("should allow valid autoscaling configuration", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Autoscaling = &operatorv1alpha1.HorizontalAutoscaling{
+								MinReplicas:              pointer.Int32(2),
+								MaxReplicas:              pointer.Int32(8),
+								TargetRequestsPerReplica: 200,
+							}
+
+							Expect(ValidateGarden(garden)).To(BeEmpty())
+						})
+
+						It("should forbid invalid replicas and targets", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Autoscaling = &operatorv1alpha1.HorizontalAutoscaling{
+								MinReplicas: pointer.Int32(0),
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.autoscaling.minReplicas"),
+							})), PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.autoscaling.targetRequestsPerReplica"),
+							}))))
+						})
+
+						It("should forbid maxReplicas being less than minReplicas", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Autoscaling = &operatorv1alpha1.HorizontalAutoscaling{
+								MinReplicas:              pointer.Int32(3),
+								MaxReplicas:              pointer.Int32(2),
+								TargetRequestsPerReplica: 200,
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.autoscaling.maxReplicas"),
+							}))))
+						})
 					})
 				})
 
@@ -1541,6 +1794,22 @@ var _ = Describe("Validation Tests", func() {
 							}))))
 						})
 					})
+
+					Context("Resources", func() {
+						It("should forbid minAllowed being greater than maxAllowed", func() {
+							garden.Spec.VirtualCluster.Gardener.ControllerManager = &operatorv1alpha1.GardenerControllerManagerConfig{
+								Resources: &operatorv1alpha1.ComponentResources{
+									MinAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+									MaxAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+								},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerControllerManager.resources.minAllowed[cpu]"),
+							}))))
+						})
+					})
 				})
 
 				Context("Scheduler", func() {
@@ -1678,6 +1947,58 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("replication", func() {
+				var (
+					etcd   *operatorv1alpha1.ETCD
+					source *operatorv1alpha1.Backup
+				)
+
+				BeforeEach(func() {
+					etcd = &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Backup: &operatorv1alpha1.Backup{Provider: "local", BucketName: "own-bucket"}}}
+					source = &operatorv1alpha1.Backup{Provider: "local", BucketName: "primary-bucket"}
+
+					oldGarden.Spec.VirtualCluster.ETCD = etcd
+					newGarden.Spec.VirtualCluster.ETCD = etcd
+				})
+
+				It("should allow promoting a standby garden", func() {
+					oldGarden.Spec.VirtualCluster.Replication = &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRoleStandby, Source: source}
+					newGarden.Spec.VirtualCluster.Replication = &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRolePrimary, Source: source}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Field": ContainSubstring("replication"),
+					}))))
+				})
+
+				It("should forbid turning a primary garden into a standby garden", func() {
+					oldGarden.Spec.VirtualCluster.Replication = &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRolePrimary}
+					newGarden.Spec.VirtualCluster.Replication = &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRoleStandby, Source: source}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.replication.role"),
+					}))))
+				})
+
+				It("should forbid turning a garden without replication into a standby garden", func() {
+					newGarden.Spec.VirtualCluster.Replication = &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRoleStandby, Source: source}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.replication.role"),
+					}))))
+				})
+
+				It("should forbid removing the replication from a standby garden", func() {
+					oldGarden.Spec.VirtualCluster.Replication = &operatorv1alpha1.Replication{Role: operatorv1alpha1.ReplicationRoleStandby, Source: source}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.replication"),
+					}))))
+				})
+			})
+
 			Context("kubernetes", func() {
 				It("should not not allow version downgrade", func() {
 					version := semver.MustParse(newGarden.Spec.VirtualCluster.Kubernetes.Version)
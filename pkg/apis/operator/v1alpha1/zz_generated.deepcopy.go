@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -118,6 +119,41 @@ func (in *Backup) DeepCopy() *Backup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentResources) DeepCopyInto(out *ComponentResources) {
+	*out = *in
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(ResourceProfile)
+		**out = **in
+	}
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaxAllowed != nil {
+		in, out := &in.MaxAllowed, &out.MaxAllowed
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentResources.
+func (in *ComponentResources) DeepCopy() *ComponentResources {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
 	*out = *in
@@ -251,6 +287,11 @@ func (in *ETCDEvents) DeepCopyInto(out *ETCDEvents) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -277,6 +318,11 @@ func (in *ETCDMain) DeepCopyInto(out *ETCDMain) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -399,6 +445,11 @@ func (in *GardenStatus) DeepCopyInto(out *GardenStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ReplicationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -489,6 +540,16 @@ func (in *GardenerAPIServerConfig) DeepCopyInto(out *GardenerAPIServerConfig) {
 		*out = new(v1beta1.EncryptionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(HorizontalAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -544,6 +605,11 @@ func (in *GardenerControllerManagerConfig) DeepCopyInto(out *GardenerControllerM
 		*out = new(string)
 		**out = **in
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -611,6 +677,32 @@ func (in *HighAvailability) DeepCopy() *HighAvailability {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalAutoscaling) DeepCopyInto(out *HorizontalAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalAutoscaling.
+func (in *HorizontalAutoscaling) DeepCopy() *HorizontalAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	*out = *in
@@ -780,6 +872,61 @@ func (in *Provider) DeepCopy() *Provider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Replication) DeepCopyInto(out *Replication) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(Backup)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxLag != nil {
+		in, out := &in.MaxLag, &out.MaxLag
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Replication.
+func (in *Replication) DeepCopy() *Replication {
+	if in == nil {
+		return nil
+	}
+	out := new(Replication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationStatus) DeepCopyInto(out *ReplicationStatus) {
+	*out = *in
+	if in.LastReplicationTime != nil {
+		in, out := &in.LastReplicationTime, &out.LastReplicationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PromotionTime != nil {
+		in, out := &in.PromotionTime, &out.PromotionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationStatus.
+func (in *ReplicationStatus) DeepCopy() *ReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceAdmissionConfiguration) DeepCopyInto(out *ResourceAdmissionConfiguration) {
 	*out = *in
@@ -1051,6 +1198,11 @@ func (in *VirtualCluster) DeepCopyInto(out *VirtualCluster) {
 	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
 	out.Maintenance = in.Maintenance
 	out.Networking = in.Networking
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(Replication)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -86,6 +86,9 @@ const (
 	// LabelPurposeTokenInvalidation is a constant for a label value indicating that this secret should be considered by
 	// the token-invalidator.
 	LabelPurposeTokenInvalidation = "token-invalidator"
+	// LabelPurposeTrustedCABundle is a constant for a label value indicating that this config map is a copy of the
+	// trusted CA bundles maintained by the trusted-ca-bundle controller.
+	LabelPurposeTrustedCABundle = "trusted-ca-bundle"
 	// ResourceManagerClass is a constant for the key in a label describing the class of the respective object. This can
 	// be used to differentiate between multiple instances of the same controller (e.g., token-requestor).
 	ResourceManagerClass = "resources.gardener.cloud/class"
@@ -148,6 +151,16 @@ const (
 	// defaulting of its seccomp profile.
 	SeccompProfileSkip = "seccompprofile.resources.gardener.cloud/skip"
 
+	// PodSecurityContextSkip is a constant for a label on a Pod which indicates that this Pod should not be considered
+	// for defaulting of its security context.
+	PodSecurityContextSkip = "pod-security-context.resources.gardener.cloud/skip"
+	// PodSecurityContextSkipRules is a constant for an annotation on a Pod containing a comma-separated list of
+	// security context defaulting rules which should not be applied to this Pod.
+	PodSecurityContextSkipRules = "pod-security-context.resources.gardener.cloud/skip-rules"
+	// PodSecurityContextDryRunChanges is a constant for an annotation on a Pod containing a comma-separated list of the
+	// changes the security context defaulting would have applied if it was not running in dry-run mode.
+	PodSecurityContextDryRunChanges = "pod-security-context.resources.gardener.cloud/dry-run-changes"
+
 	// KubernetesServiceHostInject is a constant for a label on a Pod or a Namespace which indicates that all pods in
 	// this namespace (or the specific pod) should not be considered for injection of the KUBERNETES_SERVICE_HOST
 	// environment variable.
@@ -256,6 +269,25 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// HealthChecks is a list of health checks based on CEL expressions. They allow determining the health of objects
+	// of kinds without a built-in health check, e.g., custom resources of extensions.
+	// +optional
+	HealthChecks []HealthCheck `json:"healthChecks,omitempty"`
+}
+
+// HealthCheck defines how the health of the objects of a certain kind is determined.
+type HealthCheck struct {
+	// Group is the API group of the objects. An empty group denotes the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the objects.
+	Kind string `json:"kind"`
+	// Expression is a CEL expression which must evaluate to true if an object is healthy. The object is accessible via
+	// the variable `self`, e.g., `self.status.phase == 'Ready'`.
+	Expression string `json:"expression"`
+	// Message is reported in the `ResourcesHealthy` condition if the expression evaluates to false.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ManagedResourceStatus is the status of a managed resource.
@@ -45,6 +45,8 @@ var (
 // Adds the list of known types to the given scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Gardenlet{},
+		&GardenletList{},
 		&ManagedSeed{},
 		&ManagedSeedList{},
 		&ManagedSeedSet{},
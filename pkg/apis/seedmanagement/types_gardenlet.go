@@ -0,0 +1,73 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanagement
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Gardenlet represents the desired deployment of a gardenlet which is responsible for a Seed that is not managed by a
+// ManagedSeed. The gardenlet running in the seed cluster watches this resource and upgrades itself accordingly.
+type Gardenlet struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Specification of the Gardenlet.
+	Spec GardenletSpec
+	// Most recently observed status of the Gardenlet.
+	Status GardenletStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GardenletList is a list of Gardenlet objects.
+type GardenletList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of Gardenlets.
+	Items []Gardenlet
+}
+
+// GardenletSpec is the specification of a Gardenlet.
+type GardenletSpec struct {
+	// Deployment specifies certain gardenlet deployment parameters, such as the number of replicas,
+	// the image, etc.
+	Deployment GardenletDeployment
+	// Config is the GardenletConfiguration used to configure gardenlet. It is merged with the configuration of the
+	// currently running gardenlet.
+	Config runtime.Object
+}
+
+// GardenletStatus is the status of a Gardenlet.
+type GardenletStatus struct {
+	// Conditions represents the latest available observations of a Gardenlet's current state.
+	Conditions []gardencore.Condition
+	// ObservedGeneration is the most recent generation observed for this Gardenlet. It corresponds to the Gardenlet's
+	// generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64
+}
+
+const (
+	// GardenletReconciled is a condition type for indicating whether the Gardenlet's specification has been rolled out
+	// successfully.
+	GardenletReconciled gardencore.ConditionType = "GardenletReconciled"
+)
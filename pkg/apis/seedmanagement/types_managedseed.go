@@ -62,7 +62,18 @@ type ManagedSeedSpec struct {
 	Shoot *Shoot
 	// Gardenlet specifies that the ManagedSeed controller should deploy a gardenlet into the cluster
 	// with the given deployment parameters and GardenletConfiguration.
-	Gardenlet *Gardenlet
+	Gardenlet *GardenletConfig
+	// Replacement specifies that the seed registered by this ManagedSeed shall be replaced by another seed.
+	Replacement *Replacement
+}
+
+// Replacement specifies the replacement of the seed registered by a ManagedSeed.
+type Replacement struct {
+	// SeedName is the name of the seed replacing the seed registered by this ManagedSeed.
+	SeedName string
+	// MaxConcurrentMigrations is the maximum number of shoots which are migrated to the replacement seed at the same
+	// time.
+	MaxConcurrentMigrations *int32
 }
 
 // Shoot identifies the Shoot that should be registered as Seed.
@@ -71,8 +82,8 @@ type Shoot struct {
 	Name string
 }
 
-// Gardenlet specifies gardenlet deployment parameters and the GardenletConfiguration used to configure gardenlet.
-type Gardenlet struct {
+// GardenletConfig specifies gardenlet deployment parameters and the GardenletConfiguration used to configure gardenlet.
+type GardenletConfig struct {
 	// Deployment specifies certain gardenlet deployment parameters, such as the number of replicas,
 	// the image, etc.
 	Deployment *GardenletDeployment
@@ -146,8 +157,41 @@ type ManagedSeedStatus struct {
 	// ObservedGeneration is the most recent generation observed for this ManagedSeed. It corresponds to the
 	// ManagedSeed's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64
+	// Replacement contains information about the progress of the replacement of the seed.
+	Replacement *ReplacementStatus
+}
+
+// ReplacementStatus contains information about the progress of the replacement of the seed.
+type ReplacementStatus struct {
+	// Phase is the phase of the replacement.
+	Phase ReplacementPhase
+	// Message contains details about the current phase.
+	Message string
+	// ShootsTotal is the number of shoots which were scheduled to the seed when the migration started.
+	ShootsTotal int32
+	// ShootsMigrated is the number of shoots which have been migrated to the replacement seed.
+	ShootsMigrated int32
+	// ShootsMigrating is the list of names of shoots (in the format `<namespace>/<name>`) which are currently migrated
+	// to the replacement seed.
+	ShootsMigrating []string
+	// LastTransitionTime is the last time the phase transitioned.
+	LastTransitionTime *metav1.Time
 }
 
+// ReplacementPhase is the phase of a seed replacement.
+type ReplacementPhase string
+
+const (
+	// ReplacementPending is the phase in which the replacement seed is not yet ready to take over shoots.
+	ReplacementPending ReplacementPhase = "Pending"
+	// ReplacementMigrating is the phase in which the shoots are migrated to the replacement seed.
+	ReplacementMigrating ReplacementPhase = "Migrating"
+	// ReplacementVerifying is the phase in which it is verified that all shoots have been migrated successfully.
+	ReplacementVerifying ReplacementPhase = "Verifying"
+	// ReplacementDecommissioning is the phase in which the ManagedSeed of the replaced seed is deleted.
+	ReplacementDecommissioning ReplacementPhase = "Decommissioning"
+)
+
 const (
 	// ManagedSeedShootReconciled is a condition type for indicating whether the ManagedSeed's shoot has been reconciled.
 	ManagedSeedShootReconciled gardencore.ConditionType = "ShootReconciled"
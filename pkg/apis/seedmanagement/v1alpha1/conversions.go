@@ -46,7 +46,7 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 	return nil
 }
 
-func Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in *Gardenlet, out *seedmanagement.Gardenlet, s conversion.Scope) error {
+func Convert_v1alpha1_GardenletConfig_To_seedmanagement_GardenletConfig(in *GardenletConfig, out *seedmanagement.GardenletConfig, s conversion.Scope) error {
 	if in.Config.Object == nil {
 		cfg, err := encoding.DecodeGardenletConfigurationFromBytes(in.Config.Raw, false)
 		if err != nil {
@@ -54,11 +54,11 @@ func Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in *Gardenlet, out *
 		}
 		in.Config.Object = cfg
 	}
-	return autoConvert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in, out, s)
+	return autoConvert_v1alpha1_GardenletConfig_To_seedmanagement_GardenletConfig(in, out, s)
 }
 
-func Convert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(in *seedmanagement.Gardenlet, out *Gardenlet, s conversion.Scope) error {
-	if err := autoConvert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(in, out, s); err != nil {
+func Convert_seedmanagement_GardenletConfig_To_v1alpha1_GardenletConfig(in *seedmanagement.GardenletConfig, out *GardenletConfig, s conversion.Scope) error {
+	if err := autoConvert_seedmanagement_GardenletConfig_To_v1alpha1_GardenletConfig(in, out, s); err != nil {
 		return err
 	}
 	if out.Config.Raw == nil {
@@ -75,6 +75,35 @@ func Convert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(in *seedmanagement.G
 	return nil
 }
 
+func Convert_v1alpha1_GardenletSpec_To_seedmanagement_GardenletSpec(in *GardenletSpec, out *seedmanagement.GardenletSpec, s conversion.Scope) error {
+	if in.Config.Object == nil && in.Config.Raw != nil {
+		cfg, err := encoding.DecodeGardenletConfigurationFromBytes(in.Config.Raw, false)
+		if err != nil {
+			return err
+		}
+		in.Config.Object = cfg
+	}
+	return autoConvert_v1alpha1_GardenletSpec_To_seedmanagement_GardenletSpec(in, out, s)
+}
+
+func Convert_seedmanagement_GardenletSpec_To_v1alpha1_GardenletSpec(in *seedmanagement.GardenletSpec, out *GardenletSpec, s conversion.Scope) error {
+	if err := autoConvert_seedmanagement_GardenletSpec_To_v1alpha1_GardenletSpec(in, out, s); err != nil {
+		return err
+	}
+	if out.Config.Raw == nil && out.Config.Object != nil {
+		cfg, ok := out.Config.Object.(*gardenletv1alpha1.GardenletConfiguration)
+		if !ok {
+			return fmt.Errorf("unknown gardenlet config object type")
+		}
+		raw, err := encoding.EncodeGardenletConfigurationToBytes(cfg)
+		if err != nil {
+			return err
+		}
+		out.Config.Raw = raw
+	}
+	return nil
+}
+
 func Convert_v1beta1_SeedTemplate_To_core_SeedTemplate(in *gardencorev1beta1.SeedTemplate, out *gardencore.SeedTemplate, s conversion.Scope) error {
 	return gardencorev1beta1.Convert_v1beta1_SeedTemplate_To_core_SeedTemplate(in, out, s)
 }
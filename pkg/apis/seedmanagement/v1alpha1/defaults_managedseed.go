@@ -35,7 +35,14 @@ func addDefaultingFuncs(scheme *runtime.Scheme) error {
 // SetDefaults_ManagedSeed sets default values for ManagedSeed objects.
 func SetDefaults_ManagedSeed(obj *ManagedSeed) {
 	if obj.Spec.Gardenlet != nil {
-		setDefaultsGardenlet(obj.Spec.Gardenlet, obj.Name, obj.Namespace)
+		setDefaultsGardenletConfig(obj.Spec.Gardenlet, obj.Name, obj.Namespace)
+	}
+}
+
+// SetDefaults_Replacement sets default values for Replacement objects.
+func SetDefaults_Replacement(obj *Replacement) {
+	if obj.MaxConcurrentMigrations == nil {
+		obj.MaxConcurrentMigrations = pointer.Int32(1)
 	}
 }
 
@@ -76,7 +83,7 @@ func SetDefaults_Image(obj *Image) {
 	}
 }
 
-func setDefaultsGardenlet(obj *Gardenlet, name, namespace string) {
+func setDefaultsGardenletConfig(obj *GardenletConfig, name, namespace string) {
 	// Set deployment defaults
 	if obj.Deployment == nil {
 		obj.Deployment = &GardenletDeployment{}
@@ -50,7 +50,7 @@ var _ = Describe("Defaults", func() {
 		})
 
 		It("should default gardenlet deployment and configuration", func() {
-			obj.Spec.Gardenlet = &Gardenlet{}
+			obj.Spec.Gardenlet = &GardenletConfig{}
 
 			SetDefaults_ManagedSeed(obj)
 
@@ -60,7 +60,7 @@ var _ = Describe("Defaults", func() {
 					Namespace: namespace,
 				},
 				Spec: ManagedSeedSpec{
-					Gardenlet: &Gardenlet{
+					Gardenlet: &GardenletConfig{
 						Deployment: &GardenletDeployment{},
 						Config: runtime.RawExtension{
 							Object: &gardenletv1alpha1.GardenletConfiguration{
@@ -84,7 +84,7 @@ var _ = Describe("Defaults", func() {
 		})
 
 		It("should default gardenlet deployment, configuration, and backup secret reference if backup is specified", func() {
-			obj.Spec.Gardenlet = &Gardenlet{
+			obj.Spec.Gardenlet = &GardenletConfig{
 				Config: runtime.RawExtension{
 					Raw: encode(&gardenletv1alpha1.GardenletConfiguration{
 						TypeMeta: metav1.TypeMeta{
@@ -110,7 +110,7 @@ var _ = Describe("Defaults", func() {
 					Namespace: namespace,
 				},
 				Spec: ManagedSeedSpec{
-					Gardenlet: &Gardenlet{
+					Gardenlet: &GardenletConfig{
 						Deployment: &GardenletDeployment{},
 						Config: runtime.RawExtension{
 							Object: &gardenletv1alpha1.GardenletConfiguration{
@@ -28,7 +28,8 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -75,10 +76,38 @@ func (m *Gardenlet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Gardenlet proto.InternalMessageInfo
 
+func (m *GardenletConfig) Reset()      { *m = GardenletConfig{} }
+func (*GardenletConfig) ProtoMessage() {}
+func (*GardenletConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{1}
+}
+func (m *GardenletConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GardenletConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GardenletConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GardenletConfig.Merge(m, src)
+}
+func (m *GardenletConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GardenletConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GardenletConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GardenletConfig proto.InternalMessageInfo
+
 func (m *GardenletDeployment) Reset()      { *m = GardenletDeployment{} }
 func (*GardenletDeployment) ProtoMessage() {}
 func (*GardenletDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{1}
+	return fileDescriptor_d64c05a219673fe5, []int{2}
 }
 func (m *GardenletDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -103,10 +132,94 @@ func (m *GardenletDeployment) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GardenletDeployment proto.InternalMessageInfo
 
+func (m *GardenletList) Reset()      { *m = GardenletList{} }
+func (*GardenletList) ProtoMessage() {}
+func (*GardenletList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{3}
+}
+func (m *GardenletList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GardenletList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GardenletList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GardenletList.Merge(m, src)
+}
+func (m *GardenletList) XXX_Size() int {
+	return m.Size()
+}
+func (m *GardenletList) XXX_DiscardUnknown() {
+	xxx_messageInfo_GardenletList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GardenletList proto.InternalMessageInfo
+
+func (m *GardenletSpec) Reset()      { *m = GardenletSpec{} }
+func (*GardenletSpec) ProtoMessage() {}
+func (*GardenletSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{4}
+}
+func (m *GardenletSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GardenletSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GardenletSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GardenletSpec.Merge(m, src)
+}
+func (m *GardenletSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *GardenletSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_GardenletSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GardenletSpec proto.InternalMessageInfo
+
+func (m *GardenletStatus) Reset()      { *m = GardenletStatus{} }
+func (*GardenletStatus) ProtoMessage() {}
+func (*GardenletStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{5}
+}
+func (m *GardenletStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GardenletStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GardenletStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GardenletStatus.Merge(m, src)
+}
+func (m *GardenletStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *GardenletStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GardenletStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GardenletStatus proto.InternalMessageInfo
+
 func (m *Image) Reset()      { *m = Image{} }
 func (*Image) ProtoMessage() {}
 func (*Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{2}
+	return fileDescriptor_d64c05a219673fe5, []int{6}
 }
 func (m *Image) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,7 +247,7 @@ var xxx_messageInfo_Image proto.InternalMessageInfo
 func (m *ManagedSeed) Reset()      { *m = ManagedSeed{} }
 func (*ManagedSeed) ProtoMessage() {}
 func (*ManagedSeed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{3}
+	return fileDescriptor_d64c05a219673fe5, []int{7}
 }
 func (m *ManagedSeed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +275,7 @@ var xxx_messageInfo_ManagedSeed proto.InternalMessageInfo
 func (m *ManagedSeedList) Reset()      { *m = ManagedSeedList{} }
 func (*ManagedSeedList) ProtoMessage() {}
 func (*ManagedSeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{4}
+	return fileDescriptor_d64c05a219673fe5, []int{8}
 }
 func (m *ManagedSeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +303,7 @@ var xxx_messageInfo_ManagedSeedList proto.InternalMessageInfo
 func (m *ManagedSeedSet) Reset()      { *m = ManagedSeedSet{} }
 func (*ManagedSeedSet) ProtoMessage() {}
 func (*ManagedSeedSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{5}
+	return fileDescriptor_d64c05a219673fe5, []int{9}
 }
 func (m *ManagedSeedSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +331,7 @@ var xxx_messageInfo_ManagedSeedSet proto.InternalMessageInfo
 func (m *ManagedSeedSetList) Reset()      { *m = ManagedSeedSetList{} }
 func (*ManagedSeedSetList) ProtoMessage() {}
 func (*ManagedSeedSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{6}
+	return fileDescriptor_d64c05a219673fe5, []int{10}
 }
 func (m *ManagedSeedSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +359,7 @@ var xxx_messageInfo_ManagedSeedSetList proto.InternalMessageInfo
 func (m *ManagedSeedSetSpec) Reset()      { *m = ManagedSeedSetSpec{} }
 func (*ManagedSeedSetSpec) ProtoMessage() {}
 func (*ManagedSeedSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{7}
+	return fileDescriptor_d64c05a219673fe5, []int{11}
 }
 func (m *ManagedSeedSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +387,7 @@ var xxx_messageInfo_ManagedSeedSetSpec proto.InternalMessageInfo
 func (m *ManagedSeedSetStatus) Reset()      { *m = ManagedSeedSetStatus{} }
 func (*ManagedSeedSetStatus) ProtoMessage() {}
 func (*ManagedSeedSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{8}
+	return fileDescriptor_d64c05a219673fe5, []int{12}
 }
 func (m *ManagedSeedSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +415,7 @@ var xxx_messageInfo_ManagedSeedSetStatus proto.InternalMessageInfo
 func (m *ManagedSeedSpec) Reset()      { *m = ManagedSeedSpec{} }
 func (*ManagedSeedSpec) ProtoMessage() {}
 func (*ManagedSeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{9}
+	return fileDescriptor_d64c05a219673fe5, []int{13}
 }
 func (m *ManagedSeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,7 +443,7 @@ var xxx_messageInfo_ManagedSeedSpec proto.InternalMessageInfo
 func (m *ManagedSeedStatus) Reset()      { *m = ManagedSeedStatus{} }
 func (*ManagedSeedStatus) ProtoMessage() {}
 func (*ManagedSeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{10}
+	return fileDescriptor_d64c05a219673fe5, []int{14}
 }
 func (m *ManagedSeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +471,7 @@ var xxx_messageInfo_ManagedSeedStatus proto.InternalMessageInfo
 func (m *ManagedSeedTemplate) Reset()      { *m = ManagedSeedTemplate{} }
 func (*ManagedSeedTemplate) ProtoMessage() {}
 func (*ManagedSeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{11}
+	return fileDescriptor_d64c05a219673fe5, []int{15}
 }
 func (m *ManagedSeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +499,7 @@ var xxx_messageInfo_ManagedSeedTemplate proto.InternalMessageInfo
 func (m *PendingReplica) Reset()      { *m = PendingReplica{} }
 func (*PendingReplica) ProtoMessage() {}
 func (*PendingReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{12}
+	return fileDescriptor_d64c05a219673fe5, []int{16}
 }
 func (m *PendingReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,10 +524,66 @@ func (m *PendingReplica) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PendingReplica proto.InternalMessageInfo
 
+func (m *Replacement) Reset()      { *m = Replacement{} }
+func (*Replacement) ProtoMessage() {}
+func (*Replacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{17}
+}
+func (m *Replacement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Replacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replacement.Merge(m, src)
+}
+func (m *Replacement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replacement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Replacement proto.InternalMessageInfo
+
+func (m *ReplacementStatus) Reset()      { *m = ReplacementStatus{} }
+func (*ReplacementStatus) ProtoMessage() {}
+func (*ReplacementStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{18}
+}
+func (m *ReplacementStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplacementStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReplacementStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplacementStatus.Merge(m, src)
+}
+func (m *ReplacementStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplacementStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplacementStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplacementStatus proto.InternalMessageInfo
+
 func (m *RollingUpdateStrategy) Reset()      { *m = RollingUpdateStrategy{} }
 func (*RollingUpdateStrategy) ProtoMessage() {}
 func (*RollingUpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{13}
+	return fileDescriptor_d64c05a219673fe5, []int{19}
 }
 func (m *RollingUpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +611,7 @@ var xxx_messageInfo_RollingUpdateStrategy proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{14}
+	return fileDescriptor_d64c05a219673fe5, []int{20}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +639,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *UpdateStrategy) Reset()      { *m = UpdateStrategy{} }
 func (*UpdateStrategy) ProtoMessage() {}
 func (*UpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{15}
+	return fileDescriptor_d64c05a219673fe5, []int{21}
 }
 func (m *UpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,9 +666,13 @@ var xxx_messageInfo_UpdateStrategy proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Gardenlet)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Gardenlet")
+	proto.RegisterType((*GardenletConfig)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletConfig")
 	proto.RegisterType((*GardenletDeployment)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletDeployment")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletDeployment.PodAnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletDeployment.PodLabelsEntry")
+	proto.RegisterType((*GardenletList)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletList")
+	proto.RegisterType((*GardenletSpec)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletSpec")
+	proto.RegisterType((*GardenletStatus)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletStatus")
 	proto.RegisterType((*Image)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Image")
 	proto.RegisterType((*ManagedSeed)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeed")
 	proto.RegisterType((*ManagedSeedList)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedList")
@@ -511,6 +684,8 @@ func init() {
 	proto.RegisterType((*ManagedSeedStatus)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedStatus")
 	proto.RegisterType((*ManagedSeedTemplate)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedTemplate")
 	proto.RegisterType((*PendingReplica)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.PendingReplica")
+	proto.RegisterType((*Replacement)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Replacement")
+	proto.RegisterType((*ReplacementStatus)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ReplacementStatus")
 	proto.RegisterType((*RollingUpdateStrategy)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.RollingUpdateStrategy")
 	proto.RegisterType((*Shoot)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Shoot")
 	proto.RegisterType((*UpdateStrategy)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.UpdateStrategy")
@@ -521,116 +696,135 @@ func init() {
 }
 
 var fileDescriptor_d64c05a219673fe5 = []byte{
-	// 1732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x8f, 0x1b, 0x49,
-	0x15, 0x9f, 0x9e, 0x19, 0xcf, 0xb8, 0x9f, 0x33, 0x9e, 0x4c, 0x65, 0x36, 0x78, 0x47, 0xc2, 0x1e,
-	0x59, 0x02, 0x0d, 0x1f, 0xdb, 0x26, 0xc3, 0x0a, 0x85, 0x85, 0xac, 0xe4, 0x9e, 0x84, 0xec, 0xae,
-	0x32, 0x89, 0x29, 0xcf, 0x04, 0x09, 0x71, 0xa0, 0xdc, 0x5d, 0xf1, 0x34, 0x71, 0x7f, 0x6c, 0x57,
-	0xd9, 0x1b, 0x0b, 0x09, 0xad, 0xb8, 0x71, 0x40, 0x42, 0xfb, 0x2f, 0x20, 0xf1, 0xb7, 0xe4, 0xb8,
-	0x42, 0x1c, 0x56, 0x20, 0x59, 0x89, 0x41, 0x48, 0x70, 0xe1, 0x3e, 0x07, 0x84, 0xaa, 0xba, 0xfa,
-	0xd3, 0x36, 0x19, 0x62, 0x93, 0xc3, 0xde, 0xba, 0xde, 0xc7, 0xef, 0xbd, 0x7a, 0xf5, 0xab, 0xaa,
-	0x57, 0x0d, 0xa7, 0x7d, 0x87, 0x5f, 0x0c, 0x7b, 0x86, 0xe5, 0xbb, 0xad, 0x3e, 0x09, 0x6d, 0xea,
-	0xd1, 0x30, 0xfd, 0x08, 0x9e, 0xf6, 0x5b, 0x24, 0x70, 0x58, 0x8b, 0x51, 0x6a, 0xbb, 0xc4, 0x23,
-	0x7d, 0xea, 0x52, 0x8f, 0xb7, 0x46, 0xb7, 0xc8, 0x20, 0xb8, 0x20, 0xb7, 0x5a, 0x7d, 0x61, 0x46,
-	0x38, 0xb5, 0x8d, 0x20, 0xf4, 0xb9, 0x8f, 0xee, 0xa4, 0x70, 0x46, 0x8c, 0x92, 0x7e, 0x04, 0x4f,
-	0xfb, 0x86, 0x80, 0x33, 0xf2, 0x70, 0x46, 0x0c, 0x77, 0x60, 0x5e, 0x2d, 0x1b, 0xcb, 0x0f, 0x69,
-	0x6b, 0x74, 0xab, 0x47, 0xf9, 0x6c, 0x0a, 0x07, 0xef, 0x64, 0x31, 0xfc, 0xbe, 0xdf, 0x92, 0xe2,
-	0xde, 0xf0, 0x89, 0x1c, 0xc9, 0x81, 0xfc, 0x52, 0xe6, 0xcd, 0xa7, 0xb7, 0x99, 0xe1, 0xf8, 0x02,
-	0x38, 0xc6, 0x9d, 0x81, 0x7c, 0x37, 0xb5, 0x71, 0x89, 0x75, 0xe1, 0x78, 0x34, 0x1c, 0xa7, 0xd9,
-	0xb8, 0x94, 0x93, 0x79, 0x5e, 0xad, 0x45, 0x5e, 0xe1, 0xd0, 0xe3, 0x8e, 0x4b, 0x67, 0x1c, 0xbe,
-	0xf7, 0x2a, 0x07, 0x66, 0x5d, 0x50, 0x97, 0x14, 0xfd, 0x9a, 0x2f, 0xd6, 0x41, 0xbf, 0x2f, 0x8b,
-	0x34, 0xa0, 0x1c, 0xfd, 0x5a, 0x03, 0xb0, 0x69, 0x30, 0xf0, 0xc7, 0xa2, 0xb6, 0x35, 0xed, 0x50,
-	0x3b, 0xaa, 0x1c, 0x63, 0x63, 0xa9, 0x85, 0x31, 0x12, 0xf8, 0xbb, 0x09, 0xb2, 0x59, 0x9d, 0x4e,
-	0x1a, 0x90, 0x8e, 0x71, 0x26, 0x2a, 0x3a, 0x87, 0x2d, 0xcb, 0xf7, 0x9e, 0x38, 0xfd, 0xda, 0xba,
-	0x8c, 0xff, 0x8e, 0x11, 0xcd, 0xcd, 0xc8, 0xce, 0x4d, 0x86, 0x55, 0x73, 0x33, 0x30, 0xf9, 0xe4,
-	0xde, 0x33, 0x4e, 0x3d, 0xe6, 0xf8, 0x9e, 0x59, 0x7d, 0x3e, 0x69, 0xac, 0x4d, 0x27, 0x8d, 0xad,
-	0x13, 0x09, 0x82, 0x15, 0x18, 0xba, 0x0d, 0x7a, 0xcf, 0xf7, 0x39, 0xe3, 0x21, 0x09, 0x6a, 0x1b,
-	0x87, 0xda, 0x91, 0x6e, 0x1e, 0x4c, 0x27, 0x0d, 0xdd, 0x8c, 0x85, 0x97, 0xd9, 0x01, 0x4e, 0x8d,
-	0xd1, 0x1d, 0xd8, 0x75, 0x69, 0xd8, 0xa7, 0x3f, 0x71, 0xf8, 0x45, 0x87, 0x84, 0xa2, 0x32, 0x9b,
-	0x87, 0xda, 0x51, 0xd9, 0xbc, 0x31, 0x9d, 0x34, 0x76, 0x4f, 0xf3, 0x2a, 0x5c, 0xb4, 0x6d, 0x7e,
-	0xa6, 0xc3, 0x8d, 0x39, 0x35, 0x40, 0xef, 0xc2, 0xb5, 0x90, 0x06, 0x03, 0xc7, 0x22, 0x27, 0xfe,
-	0x50, 0x55, 0xbb, 0x64, 0x5e, 0x9f, 0x4e, 0x1a, 0xd7, 0x70, 0x46, 0x8e, 0x73, 0x56, 0xe8, 0x01,
-	0xec, 0x87, 0x74, 0xe4, 0x88, 0xa9, 0x7e, 0xe0, 0x30, 0xee, 0x87, 0xe3, 0x07, 0x8e, 0xeb, 0x70,
-	0x59, 0xab, 0x92, 0x59, 0x9b, 0x4e, 0x1a, 0xfb, 0x78, 0x8e, 0x1e, 0xcf, 0xf5, 0x42, 0x3f, 0x02,
-	0xc4, 0x68, 0x38, 0x72, 0x2c, 0xda, 0xb6, 0x2c, 0x81, 0xff, 0x90, 0xb8, 0x54, 0x55, 0xe7, 0xe6,
-	0x74, 0xd2, 0x40, 0xdd, 0x19, 0x2d, 0x9e, 0xe3, 0x81, 0x28, 0x94, 0x1c, 0x97, 0xf4, 0xa9, 0x2c,
-	0x4c, 0xe5, 0xf8, 0xee, 0x92, 0x94, 0xf9, 0x50, 0x60, 0x99, 0xfa, 0x74, 0xd2, 0x28, 0xc9, 0x4f,
-	0x1c, 0xa1, 0xa3, 0x73, 0xd0, 0x43, 0xca, 0xfc, 0x61, 0x68, 0x51, 0x56, 0x2b, 0xc9, 0x50, 0x47,
-	0x19, 0x76, 0x18, 0x62, 0x13, 0x1a, 0xa3, 0x5b, 0x06, 0x56, 0x46, 0x98, 0x7e, 0x3c, 0x74, 0x42,
-	0x09, 0xce, 0xcc, 0x1d, 0xb1, 0xda, 0xb1, 0x86, 0xe1, 0x14, 0x09, 0x7d, 0xa6, 0x81, 0x1e, 0xf8,
-	0xf6, 0x03, 0xd2, 0xa3, 0x03, 0x56, 0xdb, 0x3a, 0xdc, 0x38, 0xaa, 0x1c, 0x93, 0xd5, 0xb3, 0xde,
-	0xe8, 0xc4, 0x31, 0xee, 0x79, 0x3c, 0x1c, 0x9b, 0x7b, 0x8a, 0xa9, 0x7a, 0x22, 0xc7, 0x69, 0x1a,
-	0xe8, 0x0f, 0x1a, 0x54, 0x03, 0xdf, 0x6e, 0x7b, 0x9e, 0xcf, 0x09, 0x77, 0x7c, 0x8f, 0xd5, 0xb6,
-	0x65, 0x66, 0x4f, 0xfe, 0x3f, 0x99, 0x65, 0x02, 0x45, 0xe9, 0xdd, 0x54, 0xe9, 0x55, 0xf3, 0x4a,
-	0x5c, 0xc8, 0x0a, 0x59, 0xb0, 0x47, 0x6c, 0xdb, 0x11, 0x03, 0x32, 0x78, 0xec, 0x0f, 0x86, 0x2e,
-	0x65, 0xb5, 0xb2, 0x4c, 0xf5, 0x60, 0xde, 0xe2, 0x44, 0x26, 0xe6, 0xdb, 0x0a, 0x7e, 0xaf, 0x5d,
-	0x74, 0xc6, 0xb3, 0x78, 0xe8, 0x13, 0xb8, 0x59, 0x14, 0x9e, 0x0a, 0xf6, 0xb1, 0x9a, 0x2e, 0x23,
-	0x35, 0x16, 0x47, 0x92, 0x76, 0x66, 0x5d, 0x85, 0xbb, 0xd9, 0x9e, 0x0b, 0x83, 0x17, 0xc0, 0xa3,
-	0xef, 0xc3, 0x06, 0xf5, 0x46, 0x35, 0x58, 0x3c, 0x9f, 0x7b, 0xde, 0xe8, 0x31, 0x09, 0xcd, 0x8a,
-	0x0a, 0xb0, 0x71, 0xcf, 0x1b, 0x61, 0xe1, 0x83, 0xde, 0x86, 0x8d, 0x51, 0x40, 0x6a, 0x15, 0x79,
-	0x56, 0x6c, 0x0b, 0xd5, 0xe3, 0x4e, 0x1b, 0x0b, 0xd9, 0xc1, 0x0f, 0xa1, 0x9a, 0x27, 0x03, 0xba,
-	0x0e, 0x1b, 0x4f, 0xe9, 0x58, 0x1e, 0x02, 0x3a, 0x16, 0x9f, 0x68, 0x1f, 0x4a, 0x23, 0x32, 0x18,
-	0x52, 0xb9, 0xb5, 0x75, 0x1c, 0x0d, 0xde, 0x5b, 0xbf, 0xad, 0x1d, 0xb4, 0xe1, 0xc6, 0x9c, 0x05,
-	0xfb, 0x5f, 0x20, 0x9a, 0xbf, 0xd7, 0x20, 0xda, 0x5a, 0xc8, 0x00, 0x08, 0x69, 0xe0, 0x33, 0x47,
-	0x9c, 0x0a, 0x91, 0x73, 0x74, 0x3c, 0xe3, 0x44, 0x8a, 0x33, 0x16, 0x62, 0x56, 0x9c, 0x44, 0x67,
-	0xb3, 0x1e, 0xcd, 0xea, 0x8c, 0xf4, 0xb1, 0x90, 0xa1, 0x47, 0x00, 0xc1, 0x70, 0x30, 0xe8, 0xf8,
-	0x03, 0xc7, 0x1a, 0xab, 0x53, 0xa4, 0x25, 0xa0, 0x3a, 0x89, 0xf4, 0x72, 0xd2, 0xf8, 0xea, 0xec,
-	0xad, 0x69, 0xa4, 0x06, 0x38, 0x03, 0xd1, 0xfc, 0xcb, 0x3a, 0x54, 0x4e, 0x25, 0x85, 0xed, 0x2e,
-	0xa5, 0x36, 0xfa, 0x39, 0x94, 0xc5, 0x8d, 0x69, 0x13, 0x4e, 0xd4, 0xe5, 0xf4, 0x9d, 0x85, 0x97,
-	0x83, 0xdc, 0x03, 0xc2, 0x5a, 0xac, 0xd1, 0xa3, 0xde, 0x2f, 0xa8, 0xc5, 0x4f, 0x29, 0x27, 0x26,
-	0x52, 0xeb, 0x04, 0xa9, 0x0c, 0x27, 0xa8, 0x28, 0x80, 0x4d, 0x16, 0x50, 0x4b, 0x5d, 0x3d, 0x0f,
-	0x97, 0xdc, 0x6a, 0x99, 0xdc, 0xbb, 0x01, 0xb5, 0xcc, 0x6b, 0x2a, 0xf6, 0xa6, 0x18, 0x61, 0x19,
-	0x09, 0x3d, 0x83, 0x2d, 0xc6, 0x09, 0x1f, 0x32, 0x59, 0xb0, 0xca, 0x71, 0x67, 0x85, 0x31, 0x25,
-	0x6e, 0x7a, 0x23, 0x46, 0x63, 0xac, 0xe2, 0x35, 0x5f, 0x68, 0xb0, 0x9b, 0xb1, 0x7e, 0xe0, 0x30,
-	0x8e, 0x7e, 0x36, 0x53, 0x61, 0xe3, 0x6a, 0x15, 0x16, 0xde, 0xb2, 0xbe, 0xd7, 0x55, 0xb4, 0x72,
-	0x2c, 0xc9, 0x54, 0xd7, 0x87, 0x92, 0xc3, 0xa9, 0xcb, 0x6a, 0xeb, 0x72, 0x3b, 0x7d, 0xb4, 0xba,
-	0xa9, 0x9a, 0x3b, 0x2a, 0x6c, 0xe9, 0x43, 0x11, 0x00, 0x47, 0x71, 0x9a, 0x7f, 0x5b, 0x87, 0x6a,
-	0xb6, 0x20, 0x94, 0xbf, 0x01, 0x0e, 0xb1, 0x1c, 0x87, 0x7e, 0xbc, 0xc2, 0xf5, 0xa4, 0x7c, 0x21,
-	0x8d, 0x7e, 0x59, 0xa0, 0x51, 0x77, 0xb5, 0x61, 0xff, 0x3b, 0x93, 0xfe, 0xae, 0x01, 0xca, 0x3b,
-	0xbc, 0x01, 0x32, 0x85, 0x79, 0x32, 0x9d, 0xae, 0x74, 0xc2, 0x0b, 0xf8, 0xf4, 0xef, 0xcd, 0xe2,
-	0x44, 0xc5, 0x12, 0xa0, 0x23, 0x28, 0xab, 0x26, 0x8d, 0xa9, 0x36, 0xee, 0x9a, 0x48, 0x5a, 0xb5,
-	0x71, 0x0c, 0x27, 0x5a, 0x44, 0xa0, 0xcc, 0xe8, 0x80, 0x5a, 0xdc, 0x0f, 0x15, 0x3f, 0xbe, 0x7b,
-	0xc5, 0x92, 0x88, 0xbb, 0xa2, 0xab, 0x5c, 0xd3, 0xba, 0xc4, 0x12, 0x9c, 0xc0, 0xa2, 0x4f, 0x35,
-	0x28, 0x73, 0xea, 0x06, 0x03, 0xc2, 0xa9, 0x22, 0x03, 0x5e, 0x5d, 0x6d, 0xce, 0x14, 0x72, 0x9a,
-	0x42, 0x2c, 0xc1, 0x49, 0x54, 0xf4, 0x2b, 0xd8, 0x61, 0x17, 0xbe, 0xcf, 0x63, 0x95, 0x6a, 0x0b,
-	0xdb, 0x57, 0x4c, 0x43, 0xdd, 0xac, 0xf2, 0x8d, 0x66, 0x74, 0xb3, 0x40, 0xe6, 0x5b, 0x2a, 0xea,
-	0x4e, 0x4e, 0x8c, 0xf3, 0xe1, 0xd0, 0x6f, 0x34, 0xa8, 0x0e, 0x03, 0x9b, 0x70, 0xda, 0xe5, 0xe2,
-	0xb5, 0xd3, 0x1f, 0xab, 0x6e, 0x71, 0x59, 0x92, 0x9c, 0xe7, 0x40, 0x4d, 0x24, 0xda, 0xa3, 0xbc,
-	0x0c, 0x17, 0x02, 0x2f, 0x6c, 0xd8, 0xb7, 0x5e, 0xa7, 0x61, 0x6f, 0xfe, 0x79, 0x0b, 0xf6, 0xe7,
-	0x6d, 0x4d, 0xf4, 0x11, 0x20, 0xbf, 0x27, 0x3a, 0x73, 0x6a, 0xdf, 0x8f, 0xde, 0x78, 0x8e, 0xef,
-	0x49, 0x32, 0x6e, 0x98, 0x07, 0xaa, 0x68, 0xe8, 0xd1, 0x8c, 0x05, 0x9e, 0xe3, 0x85, 0xbe, 0x9d,
-	0xa1, 0x73, 0xf4, 0xae, 0x48, 0x16, 0x7b, 0x0e, 0xa5, 0x7f, 0x00, 0x3b, 0x21, 0x25, 0xf6, 0x38,
-	0x56, 0x49, 0xce, 0x95, 0xd2, 0x95, 0xc2, 0x59, 0x25, 0xce, 0xdb, 0xa2, 0xfb, 0xb0, 0xe7, 0xd1,
-	0x67, 0x5c, 0x8d, 0x1f, 0x0e, 0xdd, 0x1e, 0x0d, 0x25, 0x5b, 0x4a, 0x69, 0x83, 0xf8, 0xb0, 0x68,
-	0x80, 0x67, 0x7d, 0x50, 0x1b, 0x76, 0xad, 0x61, 0x28, 0x5f, 0x60, 0x71, 0x1e, 0x25, 0x09, 0xf3,
-	0x15, 0x05, 0xb3, 0x7b, 0x92, 0x57, 0xe3, 0xa2, 0xbd, 0x80, 0x88, 0xd6, 0xce, 0x4e, 0x20, 0xb6,
-	0xf2, 0x10, 0xe7, 0x79, 0x35, 0x2e, 0xda, 0xe7, 0xb2, 0x88, 0x56, 0xaf, 0xb6, 0x2d, 0xdb, 0xa0,
-	0xd9, 0x2c, 0x22, 0x35, 0x2e, 0xda, 0xa3, 0xf7, 0x63, 0xea, 0x26, 0x08, 0xe5, 0xe8, 0x39, 0x16,
-	0xb7, 0xe3, 0xe7, 0x39, 0x2d, 0x2e, 0x58, 0xa3, 0xf7, 0xa0, 0x6a, 0xf9, 0x83, 0x81, 0x1c, 0x44,
-	0x0f, 0x4b, 0x5d, 0x4e, 0x42, 0x72, 0xf5, 0x24, 0xa7, 0xc1, 0x05, 0x4b, 0xf4, 0x31, 0x80, 0xe5,
-	0x7b, 0x51, 0x1f, 0xcc, 0x54, 0xcf, 0x7b, 0xe7, 0x75, 0x36, 0xed, 0x49, 0x8c, 0x92, 0x5e, 0x95,
-	0x89, 0x88, 0xe1, 0x4c, 0x10, 0xb9, 0x55, 0x03, 0xea, 0xd9, 0x8e, 0xd7, 0x57, 0x55, 0x94, 0x0d,
-	0xf3, 0xf2, 0x5b, 0xb5, 0x93, 0x03, 0x8d, 0xa6, 0x9f, 0x97, 0xe1, 0x42, 0xe0, 0xe6, 0xbf, 0xf2,
-	0x0d, 0x91, 0x3c, 0xda, 0x29, 0x94, 0xe4, 0xd9, 0xa2, 0x2e, 0xb0, 0x65, 0x5f, 0xb6, 0xf2, 0xd8,
-	0x8a, 0x5e, 0xb6, 0xf2, 0x13, 0x47, 0xe8, 0x68, 0x08, 0x7a, 0x3f, 0x7e, 0x97, 0xa9, 0x43, 0xfb,
-	0x83, 0x55, 0xbd, 0xf3, 0xa2, 0x97, 0x6f, 0x32, 0xc4, 0x69, 0xa4, 0xe6, 0x1f, 0x35, 0xd8, 0x9b,
-	0x69, 0x18, 0x0b, 0x34, 0xd0, 0xde, 0x04, 0x0d, 0xe6, 0x1f, 0x5f, 0xeb, 0xaf, 0x73, 0x7c, 0x35,
-	0xff, 0xa1, 0xc1, 0x8d, 0x39, 0x37, 0xd6, 0x97, 0xf1, 0xf5, 0xd0, 0xfc, 0xa7, 0x06, 0x05, 0x56,
-	0xa3, 0x43, 0xd8, 0xf4, 0x88, 0x4b, 0xd5, 0x53, 0x2e, 0x71, 0x92, 0xff, 0x6e, 0xa4, 0x06, 0xbd,
-	0x0f, 0x5b, 0x21, 0x25, 0x4c, 0x15, 0x58, 0x37, 0xbf, 0x1e, 0xb7, 0x75, 0x58, 0x4a, 0x2f, 0x27,
-	0x8d, 0xfd, 0xc2, 0x4e, 0x91, 0x72, 0xac, 0xbc, 0xd0, 0x23, 0x28, 0x31, 0xc7, 0xb3, 0xe2, 0xee,
-	0xe2, 0x9b, 0x57, 0xab, 0xe2, 0x99, 0xe3, 0xd2, 0xb4, 0xad, 0xea, 0x0a, 0x00, 0x1c, 0xe1, 0xa0,
-	0xaf, 0xc1, 0x76, 0x48, 0x79, 0xe8, 0x50, 0xa6, 0xce, 0xfe, 0xca, 0x74, 0xd2, 0xd8, 0xc6, 0x91,
-	0x08, 0xc7, 0xba, 0xe6, 0x5d, 0x78, 0x0b, 0x8b, 0x03, 0xcb, 0xeb, 0xe7, 0xef, 0x5c, 0xf4, 0x2d,
-	0xd0, 0x03, 0x12, 0x72, 0x27, 0xb9, 0xf3, 0x4a, 0x11, 0xe7, 0x3b, 0xb1, 0x10, 0xa7, 0xfa, 0xe6,
-	0x37, 0x20, 0xda, 0x7a, 0xaf, 0x2e, 0x54, 0xf3, 0x4f, 0x1a, 0x14, 0xae, 0x77, 0x74, 0x0c, 0x9b,
-	0x7c, 0x1c, 0xc4, 0x4e, 0x75, 0xe1, 0x70, 0x36, 0x0e, 0xe8, 0xe5, 0xa4, 0x81, 0xf2, 0x96, 0x42,
-	0x8a, 0xa5, 0x2d, 0xfa, 0xad, 0x06, 0x3b, 0x61, 0x36, 0x71, 0x45, 0x90, 0xb3, 0x25, 0x09, 0x32,
-	0xb7, 0x18, 0xe6, 0x9e, 0xbc, 0x74, 0xb3, 0x2a, 0x9c, 0x8f, 0x6e, 0x5a, 0xcf, 0x5f, 0xd6, 0xd7,
-	0x3e, 0x7f, 0x59, 0x5f, 0xfb, 0xe2, 0x65, 0x7d, 0xed, 0xd3, 0x69, 0x5d, 0x7b, 0x3e, 0xad, 0x6b,
-	0x9f, 0x4f, 0xeb, 0xda, 0x17, 0xd3, 0xba, 0xf6, 0x62, 0x5a, 0xd7, 0x7e, 0xf7, 0xd7, 0xfa, 0xda,
-	0x4f, 0xef, 0x2c, 0xf5, 0x7b, 0xff, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x51, 0x54, 0xe6,
-	0x1e, 0x18, 0x00, 0x00,
+	// 2037 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6f, 0x5b, 0x49,
+	0x15, 0xef, 0x4d, 0xe2, 0x24, 0x3e, 0x6e, 0x92, 0x66, 0x92, 0x6d, 0xbd, 0x41, 0xd8, 0xc1, 0x12,
+	0x28, 0x0b, 0xec, 0x0d, 0x2d, 0x0b, 0x94, 0x85, 0xac, 0x94, 0x9b, 0x96, 0xee, 0xae, 0x92, 0x34,
+	0x8c, 0x93, 0x22, 0x21, 0x1e, 0x98, 0x5c, 0x4f, 0x6f, 0x2e, 0xf5, 0xfd, 0xd8, 0x3b, 0x63, 0x6f,
+	0xad, 0x15, 0x68, 0x05, 0x12, 0x12, 0x0f, 0x48, 0xa8, 0xff, 0x01, 0x42, 0xe2, 0x6f, 0xe9, 0x63,
+	0x1f, 0x40, 0xaa, 0x00, 0x59, 0x5b, 0x83, 0x90, 0xe0, 0x85, 0x17, 0x9e, 0xfa, 0x80, 0xd0, 0x7c,
+	0xdc, 0x4f, 0xdb, 0x6d, 0xb6, 0x36, 0x91, 0xd8, 0x37, 0xcf, 0xf9, 0xf8, 0x9d, 0x33, 0x67, 0xce,
+	0x9d, 0x73, 0xce, 0x18, 0x0e, 0x1c, 0x97, 0x9f, 0x75, 0x4e, 0x4d, 0x3b, 0xf0, 0xb6, 0x1d, 0x12,
+	0xb5, 0xa8, 0x4f, 0xa3, 0xf4, 0x47, 0xf8, 0xc0, 0xd9, 0x26, 0xa1, 0xcb, 0xb6, 0x19, 0xa5, 0x2d,
+	0x8f, 0xf8, 0xc4, 0xa1, 0x1e, 0xf5, 0xf9, 0x76, 0xf7, 0x3a, 0x69, 0x87, 0x67, 0xe4, 0xfa, 0xb6,
+	0x23, 0xc4, 0x08, 0xa7, 0x2d, 0x33, 0x8c, 0x02, 0x1e, 0xa0, 0x9d, 0x14, 0xce, 0x8c, 0x51, 0xd2,
+	0x1f, 0xe1, 0x03, 0xc7, 0x14, 0x70, 0x66, 0x1e, 0xce, 0x8c, 0xe1, 0x36, 0xac, 0xf3, 0x79, 0x63,
+	0x07, 0x11, 0xdd, 0xee, 0x5e, 0x3f, 0xa5, 0x7c, 0xd8, 0x85, 0x8d, 0x37, 0xb3, 0x18, 0x81, 0x13,
+	0x6c, 0x4b, 0xf2, 0x69, 0xe7, 0xbe, 0x5c, 0xc9, 0x85, 0xfc, 0xa5, 0xc5, 0x1b, 0x0f, 0x6e, 0x32,
+	0xd3, 0x0d, 0x04, 0x70, 0x8c, 0x3b, 0x04, 0xf9, 0x56, 0x2a, 0xe3, 0x11, 0xfb, 0xcc, 0xf5, 0x69,
+	0xd4, 0x4b, 0xbd, 0xf1, 0x28, 0x27, 0xa3, 0xb4, 0xb6, 0xc7, 0x69, 0x45, 0x1d, 0x9f, 0xbb, 0x1e,
+	0x1d, 0x52, 0xf8, 0xe6, 0xcb, 0x14, 0x98, 0x7d, 0x46, 0x3d, 0x52, 0xd4, 0x6b, 0xfc, 0x71, 0x06,
+	0xca, 0x77, 0x64, 0x90, 0xda, 0x94, 0xa3, 0x1f, 0xc3, 0xa2, 0xf0, 0xa8, 0x45, 0x38, 0xa9, 0x1a,
+	0x9b, 0xc6, 0x56, 0xe5, 0xc6, 0xd7, 0x4c, 0x05, 0x6c, 0x66, 0x81, 0xd3, 0xc3, 0x10, 0xd2, 0x66,
+	0xf7, 0xba, 0x79, 0xf7, 0xf4, 0x27, 0xd4, 0xe6, 0x07, 0x94, 0x13, 0x0b, 0x3d, 0xee, 0xd7, 0x2f,
+	0x0d, 0xfa, 0x75, 0x48, 0x69, 0x38, 0x41, 0x45, 0x3e, 0xcc, 0xb1, 0x90, 0xda, 0xd5, 0x19, 0x89,
+	0xbe, 0x6f, 0x4e, 0x74, 0xe6, 0x66, 0xe2, 0x79, 0x33, 0xa4, 0xb6, 0x75, 0x59, 0x5b, 0x9e, 0x13,
+	0x2b, 0x2c, 0xed, 0xa0, 0x2e, 0xcc, 0x33, 0x4e, 0x78, 0x87, 0x55, 0x67, 0xa5, 0xc5, 0xc3, 0xa9,
+	0x59, 0x94, 0xa8, 0xd6, 0xb2, 0xb6, 0x39, 0xaf, 0xd6, 0x58, 0x5b, 0x6b, 0xfc, 0x7d, 0x06, 0x56,
+	0x12, 0xd9, 0xbd, 0xc0, 0xbf, 0xef, 0x3a, 0xe8, 0xe7, 0x06, 0x40, 0x8b, 0x86, 0xed, 0xa0, 0x27,
+	0x30, 0x75, 0x80, 0xf1, 0xb4, 0x1c, 0xba, 0x95, 0x20, 0x5b, 0xcb, 0x22, 0xfc, 0xe9, 0x1a, 0x67,
+	0xac, 0xa2, 0x13, 0x98, 0xb7, 0xa5, 0x3b, 0xfa, 0x08, 0xde, 0x1c, 0x7b, 0xc0, 0x3a, 0x73, 0x4c,
+	0x4c, 0x3e, 0xbc, 0xfd, 0x90, 0x53, 0x9f, 0xb9, 0x81, 0x9f, 0xee, 0x57, 0xed, 0x09, 0x6b, 0x30,
+	0x74, 0x13, 0xca, 0xa7, 0x41, 0xc0, 0x19, 0x8f, 0x48, 0x28, 0x43, 0x5d, 0xb6, 0x36, 0x06, 0xfd,
+	0x7a, 0xd9, 0x8a, 0x89, 0xcf, 0xb3, 0x0b, 0x9c, 0x0a, 0xa3, 0x1d, 0x58, 0xf1, 0x68, 0xe4, 0xd0,
+	0x1f, 0xb8, 0xfc, 0xec, 0x88, 0x44, 0x22, 0x32, 0x73, 0x9b, 0xc6, 0xd6, 0xa2, 0xb5, 0x36, 0xe8,
+	0xd7, 0x57, 0x0e, 0xf2, 0x2c, 0x5c, 0x94, 0x6d, 0x3c, 0x2a, 0xc3, 0xda, 0x88, 0x18, 0xa0, 0xb7,
+	0xe0, 0x72, 0x44, 0xc3, 0xb6, 0x6b, 0x93, 0xbd, 0xa0, 0xa3, 0xa3, 0x5d, 0xb2, 0xae, 0x0c, 0xfa,
+	0xf5, 0xcb, 0x38, 0x43, 0xc7, 0x39, 0x29, 0xb4, 0x0f, 0xeb, 0x11, 0xed, 0xba, 0x62, 0xab, 0xef,
+	0xba, 0x8c, 0x07, 0x51, 0x6f, 0xdf, 0xf5, 0x5c, 0x2e, 0x63, 0x55, 0xb2, 0xaa, 0x83, 0x7e, 0x7d,
+	0x1d, 0x8f, 0xe0, 0xe3, 0x91, 0x5a, 0xe8, 0x7b, 0x80, 0x18, 0x8d, 0xba, 0xae, 0x4d, 0x77, 0x6d,
+	0x5b, 0xe0, 0x1f, 0x12, 0x8f, 0xea, 0xe8, 0x5c, 0x1d, 0xf4, 0xeb, 0xa8, 0x39, 0xc4, 0xc5, 0x23,
+	0x34, 0x10, 0x85, 0x92, 0xeb, 0x11, 0x87, 0xca, 0xc0, 0x54, 0x6e, 0xdc, 0x9a, 0x30, 0x65, 0xde,
+	0x13, 0x58, 0x56, 0x79, 0xd0, 0xaf, 0x97, 0xe4, 0x4f, 0xac, 0xd0, 0xd1, 0x09, 0x94, 0x23, 0xca,
+	0x82, 0x4e, 0x64, 0x53, 0x56, 0x2d, 0x49, 0x53, 0x5b, 0x99, 0xec, 0x30, 0xc5, 0x15, 0x27, 0x3e,
+	0x76, 0xac, 0x85, 0x30, 0xfd, 0xa0, 0xe3, 0x46, 0x12, 0x9c, 0x59, 0x4b, 0xe2, 0xb4, 0x63, 0x0e,
+	0xc3, 0x29, 0x12, 0x7a, 0x64, 0x40, 0x39, 0x0c, 0x5a, 0xfb, 0xe4, 0x94, 0xb6, 0x59, 0x75, 0x7e,
+	0x73, 0x76, 0xab, 0x72, 0x83, 0x4c, 0x3f, 0xeb, 0xcd, 0xa3, 0xd8, 0xc6, 0x6d, 0x9f, 0x47, 0x3d,
+	0x6b, 0x55, 0x67, 0x6a, 0x39, 0xa1, 0xe3, 0xd4, 0x0d, 0xf4, 0x7b, 0x03, 0x96, 0xc3, 0xa0, 0xb5,
+	0xeb, 0xfb, 0x01, 0x27, 0xdc, 0x0d, 0x7c, 0x56, 0x5d, 0x90, 0x9e, 0xdd, 0xff, 0xdf, 0x78, 0x96,
+	0x31, 0xa4, 0xdc, 0xbb, 0xaa, 0xdd, 0x5b, 0xce, 0x33, 0x71, 0xc1, 0x2b, 0x64, 0xc3, 0x2a, 0x69,
+	0xb5, 0x5c, 0xb1, 0x20, 0xed, 0x7b, 0x41, 0xbb, 0xe3, 0x51, 0x56, 0x5d, 0x94, 0xae, 0x6e, 0x8c,
+	0x3a, 0x1c, 0x25, 0x62, 0xbd, 0xae, 0xe1, 0x57, 0x77, 0x8b, 0xca, 0x78, 0x18, 0x0f, 0x7d, 0x08,
+	0x57, 0x8b, 0xc4, 0x03, 0x91, 0x7d, 0xac, 0x5a, 0x96, 0x96, 0xea, 0xe3, 0x2d, 0x49, 0x39, 0xab,
+	0xa6, 0xcd, 0x5d, 0xdd, 0x1d, 0x09, 0x83, 0xc7, 0xc0, 0xa3, 0x6f, 0xc3, 0x2c, 0xf5, 0xbb, 0x55,
+	0x18, 0xbf, 0x9f, 0xdb, 0x7e, 0xf7, 0x1e, 0x89, 0xac, 0x8a, 0x36, 0x30, 0x7b, 0xdb, 0xef, 0x62,
+	0xa1, 0x83, 0x5e, 0x87, 0xd9, 0x6e, 0x48, 0xaa, 0x15, 0x79, 0x57, 0x2c, 0x08, 0xd6, 0xbd, 0xa3,
+	0x5d, 0x2c, 0x68, 0x1b, 0xdf, 0x85, 0xe5, 0x7c, 0x32, 0xa0, 0x2b, 0x30, 0xfb, 0x80, 0xf6, 0xe4,
+	0x25, 0x50, 0xc6, 0xe2, 0x27, 0x5a, 0x87, 0x52, 0x97, 0xb4, 0x3b, 0x54, 0x7e, 0xda, 0x65, 0xac,
+	0x16, 0x6f, 0xcf, 0xdc, 0x34, 0x36, 0x76, 0x61, 0x6d, 0xc4, 0x81, 0x7d, 0x1a, 0x88, 0xc6, 0x5f,
+	0x0c, 0x58, 0x4a, 0x12, 0x61, 0xdf, 0x65, 0x1c, 0xfd, 0x68, 0xa8, 0xb2, 0x9a, 0xe7, 0xab, 0xac,
+	0x42, 0x5b, 0xd6, 0xd5, 0x2b, 0x3a, 0x02, 0x8b, 0x31, 0x25, 0x53, 0x55, 0x3d, 0x28, 0xb9, 0x9c,
+	0x7a, 0xac, 0x3a, 0x23, 0x03, 0xf9, 0xee, 0xb4, 0x72, 0xd8, 0x5a, 0xd2, 0x46, 0x4b, 0xef, 0x09,
+	0x78, 0xac, 0xac, 0x34, 0xfe, 0x95, 0xdd, 0x9e, 0x28, 0xb6, 0xe8, 0x97, 0x17, 0x55, 0xda, 0x92,
+	0xee, 0xe2, 0x42, 0xcb, 0x5b, 0xe3, 0x89, 0x91, 0x29, 0xe7, 0xaa, 0xd4, 0xa3, 0x0f, 0x00, 0xec,
+	0xc0, 0x57, 0x69, 0xcd, 0xaa, 0x86, 0x8c, 0xfc, 0xce, 0x39, 0xb7, 0xac, 0xb3, 0x5b, 0x76, 0xa1,
+	0xe6, 0x5e, 0x8c, 0x92, 0xee, 0x2e, 0x21, 0x31, 0x9c, 0x31, 0x82, 0xde, 0x07, 0x14, 0x9c, 0x8a,
+	0x02, 0x41, 0x5b, 0x77, 0x54, 0x23, 0xe7, 0x06, 0xbe, 0xdc, 0xe9, 0xac, 0xb5, 0xa1, 0x75, 0xd1,
+	0xdd, 0x21, 0x09, 0x3c, 0x42, 0xab, 0xf1, 0x3b, 0x03, 0xd4, 0xf5, 0x8f, 0x4c, 0x80, 0x88, 0x86,
+	0x01, 0x73, 0x45, 0xe5, 0x52, 0x09, 0xae, 0x5a, 0x08, 0x9c, 0x50, 0x71, 0x46, 0x42, 0x7c, 0x79,
+	0x9c, 0xa8, 0x00, 0x97, 0xd5, 0x97, 0x77, 0x4c, 0x1c, 0x2c, 0x68, 0xe8, 0x2e, 0x40, 0xd8, 0x69,
+	0xb7, 0x8f, 0x82, 0xb6, 0x6b, 0xf7, 0x74, 0xa5, 0xdb, 0x16, 0x50, 0x47, 0x09, 0xf5, 0x79, 0xbf,
+	0xfe, 0xf9, 0xe1, 0xbe, 0xd9, 0x4c, 0x05, 0x70, 0x06, 0xa2, 0xf1, 0xe7, 0x19, 0xa8, 0x1c, 0xc8,
+	0xdc, 0x68, 0x35, 0x29, 0x6d, 0x5d, 0x40, 0x87, 0x1a, 0xe6, 0x3a, 0xd4, 0x49, 0xfb, 0xc5, 0x8c,
+	0xef, 0x63, 0x7b, 0xd4, 0x87, 0x85, 0x1e, 0xf5, 0x68, 0x8a, 0x36, 0x5f, 0xdc, 0xa5, 0x7e, 0x62,
+	0xc0, 0x4a, 0x46, 0xfa, 0x02, 0x6e, 0xaa, 0x20, 0x7f, 0x53, 0xbd, 0x3f, 0xbd, 0xad, 0x8e, 0xb9,
+	0xab, 0xfe, 0x36, 0x03, 0xcb, 0xd9, 0x80, 0x5c, 0xc8, 0x94, 0xc3, 0x72, 0x39, 0xf4, 0xfd, 0x29,
+	0x9e, 0xe7, 0x0b, 0x46, 0x9d, 0x8f, 0x0a, 0x69, 0xd4, 0x9c, 0xae, 0xd9, 0x97, 0xcc, 0x3b, 0x06,
+	0xa0, 0xbc, 0xc2, 0x05, 0x24, 0x53, 0x94, 0x4f, 0xa6, 0x83, 0xa9, 0x6e, 0x78, 0x4c, 0x3e, 0xfd,
+	0x67, 0xae, 0xb8, 0x51, 0x59, 0x00, 0xb7, 0x60, 0x51, 0x0f, 0x12, 0x4c, 0x8f, 0x1a, 0x97, 0x85,
+	0xd3, 0x7a, 0xd4, 0x60, 0x38, 0xe1, 0x22, 0x02, 0x8b, 0x8c, 0xb6, 0xa9, 0xcd, 0x83, 0x48, 0xe7,
+	0xc7, 0xd7, 0xcf, 0x19, 0x12, 0xd1, 0xcf, 0x34, 0xb5, 0x6a, 0x1a, 0x97, 0x98, 0x82, 0x13, 0x58,
+	0xf4, 0xb1, 0x01, 0x8b, 0x9c, 0x7a, 0x61, 0x9b, 0x70, 0xaa, 0x93, 0x01, 0x4f, 0x2f, 0x36, 0xc7,
+	0x1a, 0x39, 0x75, 0x21, 0xa6, 0xe0, 0xc4, 0x2a, 0xfa, 0x19, 0x2c, 0xb1, 0xb3, 0x20, 0xe0, 0x31,
+	0x4b, 0x8f, 0x2e, 0xbb, 0xaf, 0x52, 0x1f, 0x9b, 0x59, 0x20, 0xeb, 0x35, 0x6d, 0x75, 0x29, 0x47,
+	0xc6, 0x79, 0x73, 0xe8, 0x57, 0x06, 0x2c, 0x77, 0xc2, 0x16, 0xe1, 0xb4, 0xc9, 0x23, 0xc2, 0xa9,
+	0xd3, 0xd3, 0x13, 0xcd, 0xa4, 0x49, 0x72, 0x92, 0x03, 0xb5, 0x90, 0x68, 0xe1, 0xf3, 0x34, 0x5c,
+	0x30, 0x3c, 0x76, 0xa8, 0x9c, 0x7f, 0x95, 0xa1, 0xb2, 0xf1, 0xa7, 0x79, 0x58, 0x1f, 0xf5, 0x69,
+	0x8e, 0x69, 0x0e, 0x8c, 0x57, 0x69, 0x0e, 0xd0, 0x57, 0x33, 0xe9, 0xac, 0x66, 0xdf, 0xe4, 0xb0,
+	0x47, 0xa4, 0xf4, 0x77, 0x60, 0x29, 0xa2, 0xa4, 0xd5, 0x8b, 0x59, 0x32, 0xe7, 0x4a, 0xe9, 0x49,
+	0xe1, 0x2c, 0x13, 0xe7, 0x65, 0xd1, 0x1d, 0x58, 0xf5, 0xe9, 0x43, 0xae, 0xd7, 0x87, 0x1d, 0xef,
+	0x94, 0x46, 0x32, 0x5b, 0x4a, 0xe9, 0x10, 0x73, 0x58, 0x14, 0xc0, 0xc3, 0x3a, 0x68, 0x17, 0x56,
+	0xec, 0x4e, 0x24, 0x5f, 0x09, 0x62, 0x3f, 0x4a, 0x12, 0xe6, 0x9a, 0x86, 0x59, 0xd9, 0xcb, 0xb3,
+	0x71, 0x51, 0x5e, 0x40, 0xa8, 0xb3, 0x6b, 0x25, 0x10, 0xf3, 0x79, 0x88, 0x93, 0x3c, 0x1b, 0x17,
+	0xe5, 0x73, 0x5e, 0xa8, 0xd3, 0xab, 0x2e, 0xc8, 0x36, 0x68, 0xd8, 0x0b, 0xc5, 0xc6, 0x45, 0x79,
+	0xf4, 0x4e, 0x9c, 0xba, 0x09, 0xc2, 0xa2, 0x7a, 0x32, 0x88, 0x47, 0xc6, 0x93, 0x1c, 0x17, 0x17,
+	0xa4, 0xd1, 0xdb, 0xb0, 0x6c, 0x07, 0xed, 0xb6, 0x5c, 0xa8, 0xc7, 0x8f, 0xb2, 0xdc, 0x84, 0xcc,
+	0xd5, 0xbd, 0x1c, 0x07, 0x17, 0x24, 0x0b, 0x4d, 0x2d, 0x5c, 0x44, 0x53, 0x2b, 0x3e, 0xd5, 0x90,
+	0xfa, 0x2d, 0xd7, 0x77, 0x74, 0x14, 0xe5, 0x50, 0x37, 0xf9, 0xa7, 0x7a, 0x94, 0x03, 0x55, 0xdb,
+	0xcf, 0xd3, 0x70, 0xc1, 0x70, 0xe3, 0xdf, 0x33, 0xb9, 0x86, 0x48, 0x5e, 0xed, 0x14, 0x4a, 0xf2,
+	0x6e, 0xd1, 0x05, 0x6c, 0xd2, 0xd7, 0x17, 0x79, 0x6d, 0xa9, 0xd7, 0x17, 0xf9, 0x13, 0x2b, 0x74,
+	0xf4, 0x11, 0x94, 0x9d, 0x78, 0xc2, 0x98, 0xf6, 0x63, 0xa5, 0x9a, 0x66, 0xd4, 0x1b, 0x4d, 0x42,
+	0xc4, 0xa9, 0x3d, 0xf4, 0x53, 0xa8, 0x88, 0xaf, 0x99, 0xd8, 0x12, 0x41, 0x5f, 0xd6, 0x93, 0x36,
+	0x67, 0x38, 0x45, 0xb4, 0x56, 0x06, 0xfd, 0x7a, 0x25, 0x43, 0xc0, 0x59, 0x7b, 0x8d, 0xa7, 0x33,
+	0xb0, 0x3a, 0xd4, 0xb5, 0xfe, 0x9f, 0x0f, 0x58, 0xe8, 0x17, 0x46, 0x3e, 0xa8, 0xd3, 0x69, 0xee,
+	0x33, 0x31, 0xd4, 0x2d, 0xd9, 0x8b, 0x43, 0xfb, 0x0f, 0x03, 0xd6, 0x46, 0x14, 0xef, 0xcf, 0xe2,
+	0x20, 0xd5, 0xf8, 0xa7, 0x01, 0x85, 0x0f, 0x1c, 0x6d, 0xc2, 0x9c, 0x4f, 0x3c, 0xaa, 0xa7, 0xda,
+	0x44, 0x49, 0x3e, 0xb5, 0x4a, 0x0e, 0x7a, 0x07, 0xe6, 0x23, 0x4a, 0x98, 0x3e, 0xe6, 0xb2, 0xf5,
+	0xa5, 0xb8, 0xc3, 0xc5, 0x92, 0xfa, 0xbc, 0x5f, 0x5f, 0x2f, 0x5c, 0x1a, 0x92, 0x8e, 0xb5, 0x16,
+	0xba, 0x0b, 0x25, 0xe6, 0xfa, 0x76, 0xdc, 0x68, 0x7d, 0xf9, 0x7c, 0x51, 0x3c, 0x76, 0x3d, 0x9a,
+	0x76, 0x98, 0x4d, 0x01, 0x80, 0x15, 0x0e, 0xfa, 0x22, 0x2c, 0x44, 0x94, 0x47, 0x2e, 0x65, 0xba,
+	0x0c, 0x56, 0x06, 0xfd, 0xfa, 0x02, 0x56, 0x24, 0x1c, 0xf3, 0x1a, 0x8f, 0x0c, 0xc8, 0x9e, 0xba,
+	0x28, 0xd9, 0x22, 0x76, 0x87, 0xe9, 0x6e, 0x33, 0x2d, 0xa2, 0xa2, 0xe3, 0x44, 0x02, 0x9d, 0xc0,
+	0x35, 0x8f, 0x3c, 0xdc, 0x0b, 0x7c, 0x5d, 0x7c, 0x0e, 0x5c, 0x27, 0xd2, 0xef, 0xa0, 0xaa, 0xde,
+	0x7f, 0x6e, 0xd0, 0xaf, 0x5f, 0x3b, 0x18, 0x2d, 0x82, 0xc7, 0xe9, 0x36, 0x7e, 0x3b, 0x0b, 0xab,
+	0x43, 0x19, 0x8a, 0xbe, 0x05, 0xa5, 0xf0, 0x8c, 0xb0, 0xd8, 0xaf, 0x2f, 0xc4, 0xdb, 0x3e, 0x12,
+	0xc4, 0xe7, 0xfd, 0xfa, 0x95, 0x8c, 0x8a, 0xa4, 0x61, 0x25, 0x8f, 0xde, 0x80, 0x05, 0x8f, 0x32,
+	0x46, 0x1c, 0xfd, 0xc6, 0x66, 0xad, 0x68, 0xd5, 0x85, 0x03, 0x45, 0xc6, 0x31, 0x1f, 0x7d, 0x03,
+	0x2a, 0xf2, 0x1e, 0x65, 0xc7, 0x01, 0x27, 0x6d, 0xdd, 0x81, 0xac, 0x69, 0xf1, 0x4a, 0x33, 0x65,
+	0xe1, 0xac, 0x9c, 0xa8, 0xb5, 0x6a, 0xa9, 0x36, 0x41, 0x5b, 0x3a, 0xe6, 0x49, 0xad, 0x6d, 0xe6,
+	0xb8, 0xb8, 0x20, 0x8d, 0x76, 0x60, 0x25, 0x4b, 0x71, 0x7d, 0xa7, 0x5a, 0xda, 0x9c, 0xdd, 0x2a,
+	0xab, 0x7f, 0x2f, 0x9a, 0x79, 0x16, 0x2e, 0xca, 0xa2, 0x08, 0x50, 0x9b, 0x30, 0x7e, 0x1c, 0x11,
+	0x9f, 0xc9, 0x2b, 0x48, 0xe4, 0x85, 0xec, 0x39, 0x3e, 0x5d, 0x26, 0xc9, 0x7f, 0x13, 0xf6, 0x87,
+	0x90, 0xf0, 0x08, 0xf4, 0xc6, 0x2d, 0x78, 0x0d, 0x8b, 0xa2, 0xef, 0x3b, 0xf9, 0xbe, 0x15, 0x7d,
+	0x05, 0xca, 0x21, 0x89, 0xb8, 0x9b, 0xf4, 0x8d, 0x25, 0x55, 0x31, 0x8e, 0x62, 0x22, 0x4e, 0xf9,
+	0x8d, 0x37, 0x40, 0x95, 0xaf, 0x97, 0x7f, 0x61, 0x8d, 0x3f, 0x18, 0x50, 0x68, 0x91, 0xd1, 0x0d,
+	0x98, 0xe3, 0xbd, 0x30, 0x56, 0xaa, 0x09, 0x85, 0xe3, 0x5e, 0x28, 0x72, 0x01, 0xe5, 0x25, 0x05,
+	0x15, 0x4b, 0x59, 0xf4, 0x6b, 0x03, 0x96, 0xa2, 0xac, 0xe3, 0xfa, 0x66, 0x39, 0x9e, 0xf4, 0x46,
+	0x1d, 0x15, 0x0c, 0x6b, 0x55, 0x36, 0xae, 0x59, 0x16, 0xce, 0x5b, 0xb7, 0xec, 0xc7, 0xcf, 0x6a,
+	0x97, 0x9e, 0x3c, 0xab, 0x5d, 0x7a, 0xfa, 0xac, 0x76, 0xe9, 0xe3, 0x41, 0xcd, 0x78, 0x3c, 0xa8,
+	0x19, 0x4f, 0x06, 0x35, 0xe3, 0xe9, 0xa0, 0x66, 0x7c, 0x32, 0xa8, 0x19, 0xbf, 0xf9, 0x6b, 0xed,
+	0xd2, 0x0f, 0x77, 0x26, 0xfa, 0x93, 0xfc, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xd6, 0x39,
+	0x46, 0x64, 0x1f, 0x00, 0x00,
 }
 
 func (m *Gardenlet) Marshal() (dAtA []byte, err error) {
@@ -649,6 +843,59 @@ func (m *Gardenlet) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *Gardenlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GardenletConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GardenletConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GardenletConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -859,7 +1106,7 @@ func (m *GardenletDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Image) Marshal() (dAtA []byte, err error) {
+func (m *GardenletList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -869,41 +1116,44 @@ func (m *Image) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Image) MarshalTo(dAtA []byte) (int, error) {
+func (m *GardenletList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Image) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GardenletList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PullPolicy != nil {
-		i -= len(*m.PullPolicy)
-		copy(dAtA[i:], *m.PullPolicy)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.PullPolicy)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Tag != nil {
-		i -= len(*m.Tag)
-		copy(dAtA[i:], *m.Tag)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Tag)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Repository != nil {
-		i -= len(*m.Repository)
-		copy(dAtA[i:], *m.Repository)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Repository)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ManagedSeed) Marshal() (dAtA []byte, err error) {
+func (m *GardenletSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -913,28 +1163,18 @@ func (m *ManagedSeed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ManagedSeed) MarshalTo(dAtA []byte) (int, error) {
+func (m *GardenletSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ManagedSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GardenletSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -944,7 +1184,7 @@ func (m *ManagedSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Deployment.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -956,7 +1196,7 @@ func (m *ManagedSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ManagedSeedList) Marshal() (dAtA []byte, err error) {
+func (m *GardenletStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -966,20 +1206,23 @@ func (m *ManagedSeedList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ManagedSeedList) MarshalTo(dAtA []byte) (int, error) {
+func (m *GardenletStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ManagedSeedList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GardenletStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -987,15 +1230,149 @@ func (m *ManagedSeedList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	return len(dAtA) - i, nil
+}
+
+func (m *Image) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Image) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Image) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PullPolicy != nil {
+		i -= len(*m.PullPolicy)
+		copy(dAtA[i:], *m.PullPolicy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.PullPolicy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Tag != nil {
+		i -= len(*m.Tag)
+		copy(dAtA[i:], *m.Tag)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Tag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repository != nil {
+		i -= len(*m.Repository)
+		copy(dAtA[i:], *m.Repository)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Repository)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManagedSeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedSeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ManagedSeedList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedSeedList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedSeedList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
@@ -1280,6 +1657,18 @@ func (m *ManagedSeedSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Replacement != nil {
+		{
+			size, err := m.Replacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Gardenlet != nil {
 		{
 			size, err := m.Gardenlet.MarshalToSizedBuffer(dAtA[:i])
@@ -1327,6 +1716,18 @@ func (m *ManagedSeedStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Replacement != nil {
+		{
+			size, err := m.Replacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
 	i--
 	dAtA[i] = 0x10
@@ -1438,6 +1839,99 @@ func (m *PendingReplica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Replacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxConcurrentMigrations != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxConcurrentMigrations))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.SeedName)
+	copy(dAtA[i:], m.SeedName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SeedName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplacementStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplacementStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplacementStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ShootsMigrating) > 0 {
+		for iNdEx := len(m.ShootsMigrating) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ShootsMigrating[iNdEx])
+			copy(dAtA[i:], m.ShootsMigrating[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ShootsMigrating[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ShootsMigrated))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ShootsTotal))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RollingUpdateStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1548,6 +2042,21 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	return base
 }
 func (m *Gardenlet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GardenletConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1633,22 +2142,68 @@ func (m *GardenletDeployment) Size() (n int) {
 	return n
 }
 
-func (m *Image) Size() (n int) {
+func (m *GardenletList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Repository != nil {
-		l = len(*m.Repository)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Tag != nil {
-		l = len(*m.Tag)
-		n += 1 + l + sovGenerated(uint64(l))
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.PullPolicy != nil {
-		l = len(*m.PullPolicy)
+	return n
+}
+
+func (m *GardenletSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deployment.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Config.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GardenletStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	return n
+}
+
+func (m *Image) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repository != nil {
+		l = len(*m.Repository)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Tag != nil {
+		l = len(*m.Tag)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PullPolicy != nil {
+		l = len(*m.PullPolicy)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
@@ -1789,6 +2344,10 @@ func (m *ManagedSeedSpec) Size() (n int) {
 		l = m.Gardenlet.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Replacement != nil {
+		l = m.Replacement.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1805,6 +2364,10 @@ func (m *ManagedSeedStatus) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if m.Replacement != nil {
+		l = m.Replacement.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1839,6 +2402,45 @@ func (m *PendingReplica) Size() (n int) {
 	return n
 }
 
+func (m *Replacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SeedName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxConcurrentMigrations != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxConcurrentMigrations))
+	}
+	return n
+}
+
+func (m *ReplacementStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ShootsTotal))
+	n += 1 + sovGenerated(uint64(m.ShootsMigrated))
+	if len(m.ShootsMigrating) > 0 {
+		for _, s := range m.ShootsMigrating {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RollingUpdateStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1890,6 +2492,18 @@ func (this *Gardenlet) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Gardenlet{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "GardenletSpec", "GardenletSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "GardenletStatus", "GardenletStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GardenletConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GardenletConfig{`,
 		`Deployment:` + strings.Replace(this.Deployment.String(), "GardenletDeployment", "GardenletDeployment", 1) + `,`,
 		`Config:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Config), "RawExtension", "runtime.RawExtension", 1), `&`, ``, 1) + `,`,
 		`Bootstrap:` + valueToStringGenerated(this.Bootstrap) + `,`,
@@ -1942,7 +2556,7 @@ func (this *GardenletDeployment) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`ServiceAccountName:` + valueToStringGenerated(this.ServiceAccountName) + `,`,
 		`Image:` + strings.Replace(this.Image.String(), "Image", "Image", 1) + `,`,
-		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1) + `,`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v11.ResourceRequirements", 1) + `,`,
 		`PodLabels:` + mapStringForPodLabels + `,`,
 		`PodAnnotations:` + mapStringForPodAnnotations + `,`,
 		`AdditionalVolumes:` + repeatedStringForAdditionalVolumes + `,`,
@@ -1953,6 +2567,49 @@ func (this *GardenletDeployment) String() string {
 	}, "")
 	return s
 }
+func (this *GardenletList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Gardenlet{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Gardenlet", "Gardenlet", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&GardenletList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GardenletSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GardenletSpec{`,
+		`Deployment:` + strings.Replace(strings.Replace(this.Deployment.String(), "GardenletDeployment", "GardenletDeployment", 1), `&`, ``, 1) + `,`,
+		`Config:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Config), "RawExtension", "runtime.RawExtension", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GardenletStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&GardenletStatus{`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Image) String() string {
 	if this == nil {
 		return "nil"
@@ -1970,7 +2627,7 @@ func (this *ManagedSeed) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ManagedSeed{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ManagedSeedSpec", "ManagedSeedSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ManagedSeedStatus", "ManagedSeedStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -1987,7 +2644,7 @@ func (this *ManagedSeedList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ManagedSeedList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -1998,7 +2655,7 @@ func (this *ManagedSeedSet) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ManagedSeedSet{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ManagedSeedSetSpec", "ManagedSeedSetSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ManagedSeedSetStatus", "ManagedSeedSetStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -2015,7 +2672,7 @@ func (this *ManagedSeedSetList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ManagedSeedSetList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -2027,7 +2684,7 @@ func (this *ManagedSeedSetSpec) String() string {
 	}
 	s := strings.Join([]string{`&ManagedSeedSetSpec{`,
 		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v11.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ManagedSeedTemplate", "ManagedSeedTemplate", 1), `&`, ``, 1) + `,`,
 		`ShootTemplate:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ShootTemplate), "ShootTemplate", "v1beta1.ShootTemplate", 1), `&`, ``, 1) + `,`,
 		`UpdateStrategy:` + strings.Replace(this.UpdateStrategy.String(), "UpdateStrategy", "UpdateStrategy", 1) + `,`,
@@ -2067,7 +2724,8 @@ func (this *ManagedSeedSpec) String() string {
 	}
 	s := strings.Join([]string{`&ManagedSeedSpec{`,
 		`Shoot:` + strings.Replace(this.Shoot.String(), "Shoot", "Shoot", 1) + `,`,
-		`Gardenlet:` + strings.Replace(this.Gardenlet.String(), "Gardenlet", "Gardenlet", 1) + `,`,
+		`Gardenlet:` + strings.Replace(this.Gardenlet.String(), "GardenletConfig", "GardenletConfig", 1) + `,`,
+		`Replacement:` + strings.Replace(this.Replacement.String(), "Replacement", "Replacement", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2084,6 +2742,7 @@ func (this *ManagedSeedStatus) String() string {
 	s := strings.Join([]string{`&ManagedSeedStatus{`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`Replacement:` + strings.Replace(this.Replacement.String(), "ReplacementStatus", "ReplacementStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2093,7 +2752,7 @@ func (this *ManagedSeedTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ManagedSeedTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ManagedSeedSpec", "ManagedSeedSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -2106,12 +2765,38 @@ func (this *PendingReplica) String() string {
 	s := strings.Join([]string{`&PendingReplica{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Since:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Since:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Retries:` + valueToStringGenerated(this.Retries) + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *Replacement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Replacement{`,
+		`SeedName:` + fmt.Sprintf("%v", this.SeedName) + `,`,
+		`MaxConcurrentMigrations:` + valueToStringGenerated(this.MaxConcurrentMigrations) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplacementStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplacementStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ShootsTotal:` + fmt.Sprintf("%v", this.ShootsTotal) + `,`,
+		`ShootsMigrated:` + fmt.Sprintf("%v", this.ShootsMigrated) + `,`,
+		`ShootsMigrating:` + fmt.Sprintf("%v", this.ShootsMigrating) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RollingUpdateStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -2182,7 +2867,7 @@ func (m *Gardenlet) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2209,16 +2894,13 @@ func (m *Gardenlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deployment == nil {
-				m.Deployment = &GardenletDeployment{}
-			}
-			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2245,15 +2927,15 @@ func (m *Gardenlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2263,46 +2945,25 @@ func (m *Gardenlet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := Bootstrap(dAtA[iNdEx:postIndex])
-			m.Bootstrap = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeWithParent", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := bool(v != 0)
-			m.MergeWithParent = &b
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2324,7 +2985,7 @@ func (m *Gardenlet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
+func (m *GardenletConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2347,17 +3008,17 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GardenletDeployment: wiretype end group for non-group")
+			return fmt.Errorf("proto: GardenletConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GardenletDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GardenletConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2367,17 +3028,33 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReplicaCount = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deployment == nil {
+				m.Deployment = &GardenletDeployment{}
+			}
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2387,15 +3064,28 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RevisionHistoryLimit = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2423,14 +3113,14 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ServiceAccountName = &s
+			s := Bootstrap(dAtA[iNdEx:postIndex])
+			m.Bootstrap = &s
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeWithParent", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2440,19 +3130,163 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			b := bool(v != 0)
+			m.MergeWithParent = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GardenletDeployment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GardenletDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReplicaCount = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Image == nil {
@@ -2492,7 +3326,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Resources == nil {
-				m.Resources = &v1.ResourceRequirements{}
+				m.Resources = &v11.ResourceRequirements{}
 			}
 			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2781,7 +3615,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalVolumes = append(m.AdditionalVolumes, v1.Volume{})
+			m.AdditionalVolumes = append(m.AdditionalVolumes, v11.Volume{})
 			if err := m.AdditionalVolumes[len(m.AdditionalVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2815,7 +3649,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalVolumeMounts = append(m.AdditionalVolumeMounts, v1.VolumeMount{})
+			m.AdditionalVolumeMounts = append(m.AdditionalVolumeMounts, v11.VolumeMount{})
 			if err := m.AdditionalVolumeMounts[len(m.AdditionalVolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2849,7 +3683,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
+			m.Env = append(m.Env, v11.EnvVar{})
 			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2896,7 +3730,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Image) Unmarshal(dAtA []byte) error {
+func (m *GardenletList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2919,17 +3753,17 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+			return fmt.Errorf("proto: GardenletList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GardenletList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2939,30 +3773,30 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Repository = &s
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2972,57 +3806,25 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Tag = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Gardenlet{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			s := k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			m.PullPolicy = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3045,7 +3847,7 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeed) Unmarshal(dAtA []byte) error {
+func (m *GardenletSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3068,15 +3870,15 @@ func (m *ManagedSeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: GardenletSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GardenletSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3103,46 +3905,13 @@ func (m *ManagedSeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3169,7 +3938,7 @@ func (m *ManagedSeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3194,7 +3963,7 @@ func (m *ManagedSeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedList) Unmarshal(dAtA []byte) error {
+func (m *GardenletStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3217,15 +3986,15 @@ func (m *ManagedSeedList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedList: wiretype end group for non-group")
+			return fmt.Errorf("proto: GardenletStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GardenletStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3252,15 +4021,16 @@ func (m *ManagedSeedList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, v1beta1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			var msglen int
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3270,26 +4040,11 @@ func (m *ManagedSeedList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, ManagedSeed{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3311,7 +4066,7 @@ func (m *ManagedSeedList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
+func (m *Image) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3334,17 +4089,17 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: Image: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3354,30 +4109,30 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Repository = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3387,30 +4142,30 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Tag = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3420,24 +4175,24 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			m.PullPolicy = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3460,7 +4215,7 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedSetList) Unmarshal(dAtA []byte) error {
+func (m *ManagedSeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3483,15 +4238,15 @@ func (m *ManagedSeedSetList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedSetList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedSeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedSetList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedSeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3518,13 +4273,13 @@ func (m *ManagedSeedSetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3551,8 +4306,40 @@ func (m *ManagedSeedSetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ManagedSeedSet{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3577,7 +4364,7 @@ func (m *ManagedSeedSetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
+func (m *ManagedSeedList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3600,17 +4387,17 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedSetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedSeedList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedSeedList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3620,15 +4407,28 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3655,13 +4455,64 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ManagedSeed{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedSeedSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedSeedSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3688,13 +4539,13 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShootTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3721,13 +4572,13 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShootTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3754,33 +4605,10 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateStrategy == nil {
-				m.UpdateStrategy = &UpdateStrategy{}
-			}
-			if err := m.UpdateStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RevisionHistoryLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3802,7 +4630,7 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
+func (m *ManagedSeedSetList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3825,17 +4653,17 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedSeedSetList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedSeedSetList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			m.ObservedGeneration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3845,16 +4673,30 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			m.Replicas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3864,35 +4706,81 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Replicas |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ReadyReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadyReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ManagedSeedSet{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedSeedSetSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedSeedSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextReplicaNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			m.NextReplicaNumber = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3902,16 +4790,17 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextReplicaNumber |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReplicas", wireType)
+			m.Replicas = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			m.CurrentReplicas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3921,16 +4810,30 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentReplicas |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.UpdatedReplicas = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3940,16 +4843,30 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3959,29 +4876,30 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentRevision = string(dAtA[iNdEx:postIndex])
+			if err := m.ShootTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3991,27 +4909,31 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateRevision = string(dAtA[iNdEx:postIndex])
+			if m.UpdateStrategy == nil {
+				m.UpdateStrategy = &UpdateStrategy{}
+			}
+			if err := m.UpdateStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -4028,8 +4950,534 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.CollisionCount = &v
-		case 10:
+			m.RevisionHistoryLimit = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedSeedSetStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedSeedSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			}
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReplicaNumber", wireType)
+			}
+			m.NextReplicaNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextReplicaNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReplicas", wireType)
+			}
+			m.CurrentReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			}
+			m.UpdatedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CollisionCount = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, v1beta1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReplica", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingReplica == nil {
+				m.PendingReplica = &PendingReplica{}
+			}
+			if err := m.PendingReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedSeedSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedSeedSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedSeedSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shoot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shoot == nil {
+				m.Shoot = &Shoot{}
+			}
+			if err := m.Shoot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gardenlet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gardenlet == nil {
+				m.Gardenlet = &GardenletConfig{}
+			}
+			if err := m.Gardenlet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Replacement == nil {
+				m.Replacement = &Replacement{}
+			}
+			if err := m.Replacement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedSeedStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedSeedStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedSeedStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
@@ -4063,9 +5511,28 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingReplica", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4092,10 +5559,10 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingReplica == nil {
-				m.PendingReplica = &PendingReplica{}
+			if m.Replacement == nil {
+				m.Replacement = &ReplacementStatus{}
 			}
-			if err := m.PendingReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replacement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4120,7 +5587,7 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedSpec) Unmarshal(dAtA []byte) error {
+func (m *ManagedSeedTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4143,15 +5610,15 @@ func (m *ManagedSeedSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedSeedTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedSeedTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4178,16 +5645,13 @@ func (m *ManagedSeedSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shoot == nil {
-				m.Shoot = &Shoot{}
-			}
-			if err := m.Shoot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gardenlet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4214,10 +5678,7 @@ func (m *ManagedSeedSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gardenlet == nil {
-				m.Gardenlet = &Gardenlet{}
-			}
-			if err := m.Gardenlet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4242,7 +5703,7 @@ func (m *ManagedSeedSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedStatus) Unmarshal(dAtA []byte) error {
+func (m *PendingReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4265,15 +5726,79 @@ func (m *ManagedSeedStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = PendingReplicaReason(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4300,16 +5825,15 @@ func (m *ManagedSeedStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1beta1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
-			m.ObservedGeneration = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4319,11 +5843,12 @@ func (m *ManagedSeedStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Retries = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4345,7 +5870,7 @@ func (m *ManagedSeedStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedTemplate) Unmarshal(dAtA []byte) error {
+func (m *Replacement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4368,17 +5893,17 @@ func (m *ManagedSeedTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Replacement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Replacement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4388,30 +5913,29 @@ func (m *ManagedSeedTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SeedName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentMigrations", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4421,25 +5945,12 @@ func (m *ManagedSeedTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MaxConcurrentMigrations = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4461,7 +5972,7 @@ func (m *ManagedSeedTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingReplica) Unmarshal(dAtA []byte) error {
+func (m *ReplacementStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4484,15 +5995,15 @@ func (m *PendingReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplacementStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplacementStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4520,11 +6031,11 @@ func (m *PendingReplica) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Phase = ReplacementPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4552,13 +6063,51 @@ func (m *PendingReplica) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = PendingReplicaReason(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootsTotal", wireType)
+			}
+			m.ShootsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShootsTotal |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootsMigrated", wireType)
+			}
+			m.ShootsMigrated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShootsMigrated |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootsMigrating", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4568,30 +6117,29 @@ func (m *PendingReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ShootsMigrating = append(m.ShootsMigrating, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4601,12 +6149,28 @@ func (m *PendingReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Retries = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -0,0 +1,86 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Gardenlet represents the desired deployment of a gardenlet which is responsible for a Seed that is not managed by a
+// ManagedSeed. The gardenlet running in the seed cluster watches this resource and upgrades itself accordingly.
+type Gardenlet struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Specification of the Gardenlet.
+	// +optional
+	Spec GardenletSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Most recently observed status of the Gardenlet.
+	// +optional
+	Status GardenletStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GardenletList is a list of Gardenlet objects.
+type GardenletList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of Gardenlets.
+	Items []Gardenlet `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// GardenletSpec is the specification of a Gardenlet.
+type GardenletSpec struct {
+	// Deployment specifies certain gardenlet deployment parameters, such as the number of replicas,
+	// the image, etc.
+	// +optional
+	Deployment GardenletDeployment `json:"deployment,omitempty" protobuf:"bytes,1,opt,name=deployment"`
+	// Config is the GardenletConfiguration used to configure gardenlet. It is merged with the configuration of the
+	// currently running gardenlet.
+	// +optional
+	Config runtime.RawExtension `json:"config,omitempty" protobuf:"bytes,2,opt,name=config"`
+}
+
+// GardenletStatus is the status of a Gardenlet.
+type GardenletStatus struct {
+	// Conditions represents the latest available observations of a Gardenlet's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+	// ObservedGeneration is the most recent generation observed for this Gardenlet. It corresponds to the Gardenlet's
+	// generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,2,opt,name=observedGeneration"`
+}
+
+const (
+	// GardenletReconciled is a condition type for indicating whether the Gardenlet's specification has been rolled out
+	// successfully.
+	GardenletReconciled gardencorev1beta1.ConditionType = "GardenletReconciled"
+	// GardenletReasonRolledBack is a reason for the GardenletReconciled condition indicating that the rollout of the
+	// Gardenlet's specification failed and the last successfully rolled out specification was restored.
+	GardenletReasonRolledBack = "RolledBack"
+)
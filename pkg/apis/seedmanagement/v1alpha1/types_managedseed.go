@@ -74,7 +74,22 @@ type ManagedSeedSpec struct {
 	// Gardenlet specifies that the ManagedSeed controller should deploy a gardenlet into the cluster
 	// with the given deployment parameters and GardenletConfiguration.
 	// +optional
-	Gardenlet *Gardenlet `json:"gardenlet,omitempty" protobuf:"bytes,3,opt,name=gardenlet"`
+	Gardenlet *GardenletConfig `json:"gardenlet,omitempty" protobuf:"bytes,3,opt,name=gardenlet"`
+	// Replacement specifies that the seed registered by this ManagedSeed shall be replaced by another seed. All shoots
+	// are migrated to the replacement seed before this ManagedSeed is deleted.
+	// +optional
+	Replacement *Replacement `json:"replacement,omitempty" protobuf:"bytes,4,opt,name=replacement"`
+}
+
+// Replacement specifies the replacement of the seed registered by a ManagedSeed.
+type Replacement struct {
+	// SeedName is the name of the seed replacing the seed registered by this ManagedSeed.
+	// This field is immutable.
+	SeedName string `json:"seedName" protobuf:"bytes,1,opt,name=seedName"`
+	// MaxConcurrentMigrations is the maximum number of shoots which are migrated to the replacement seed at the same
+	// time. Defaults to 1.
+	// +optional
+	MaxConcurrentMigrations *int32 `json:"maxConcurrentMigrations,omitempty" protobuf:"varint,2,opt,name=maxConcurrentMigrations"`
 }
 
 // Shoot identifies the Shoot that should be registered as Seed.
@@ -83,8 +98,8 @@ type Shoot struct {
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
-// Gardenlet specifies gardenlet deployment parameters and the GardenletConfiguration used to configure gardenlet.
-type Gardenlet struct {
+// GardenletConfig specifies gardenlet deployment parameters and the GardenletConfiguration used to configure gardenlet.
+type GardenletConfig struct {
 	// Deployment specifies certain gardenlet deployment parameters, such as the number of replicas,
 	// the image, etc.
 	// +optional
@@ -179,8 +194,47 @@ type ManagedSeedStatus struct {
 	// ObservedGeneration is the most recent generation observed for this ManagedSeed. It corresponds to the
 	// ManagedSeed's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,2,opt,name=observedGeneration"`
+	// Replacement contains information about the progress of the replacement of the seed.
+	// +optional
+	Replacement *ReplacementStatus `json:"replacement,omitempty" protobuf:"bytes,3,opt,name=replacement"`
 }
 
+// ReplacementStatus contains information about the progress of the replacement of the seed.
+type ReplacementStatus struct {
+	// Phase is the phase of the replacement.
+	Phase ReplacementPhase `json:"phase" protobuf:"bytes,1,opt,name=phase,casttype=ReplacementPhase"`
+	// Message contains details about the current phase.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// ShootsTotal is the number of shoots which were scheduled to the seed when the migration started.
+	// +optional
+	ShootsTotal int32 `json:"shootsTotal,omitempty" protobuf:"varint,3,opt,name=shootsTotal"`
+	// ShootsMigrated is the number of shoots which have been migrated to the replacement seed.
+	// +optional
+	ShootsMigrated int32 `json:"shootsMigrated,omitempty" protobuf:"varint,4,opt,name=shootsMigrated"`
+	// ShootsMigrating is the list of names of shoots (in the format `<namespace>/<name>`) which are currently migrated
+	// to the replacement seed.
+	// +optional
+	ShootsMigrating []string `json:"shootsMigrating,omitempty" protobuf:"bytes,5,rep,name=shootsMigrating"`
+	// LastTransitionTime is the last time the phase transitioned.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,6,opt,name=lastTransitionTime"`
+}
+
+// ReplacementPhase is the phase of a seed replacement.
+type ReplacementPhase string
+
+const (
+	// ReplacementPending is the phase in which the replacement seed is not yet ready to take over shoots.
+	ReplacementPending ReplacementPhase = "Pending"
+	// ReplacementMigrating is the phase in which the shoots are migrated to the replacement seed.
+	ReplacementMigrating ReplacementPhase = "Migrating"
+	// ReplacementVerifying is the phase in which it is verified that all shoots have been migrated successfully.
+	ReplacementVerifying ReplacementPhase = "Verifying"
+	// ReplacementDecommissioning is the phase in which the ManagedSeed of the replaced seed is deleted.
+	ReplacementDecommissioning ReplacementPhase = "Decommissioning"
+)
+
 const (
 	// ManagedSeedShootReconciled is a condition type for indicating whether the ManagedSeed's shoot has been reconciled.
 	ManagedSeedShootReconciled gardencorev1beta1.ConditionType = "ShootReconciled"
@@ -28,6 +28,7 @@ import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	seedmanagement "github.com/gardener/gardener/pkg/apis/seedmanagement"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -39,6 +40,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Gardenlet)(nil), (*seedmanagement.Gardenlet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(a.(*Gardenlet), b.(*seedmanagement.Gardenlet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.Gardenlet)(nil), (*Gardenlet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(a.(*seedmanagement.Gardenlet), b.(*Gardenlet), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GardenletDeployment)(nil), (*seedmanagement.GardenletDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GardenletDeployment_To_seedmanagement_GardenletDeployment(a.(*GardenletDeployment), b.(*seedmanagement.GardenletDeployment), scope)
 	}); err != nil {
@@ -49,6 +60,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GardenletList)(nil), (*seedmanagement.GardenletList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GardenletList_To_seedmanagement_GardenletList(a.(*GardenletList), b.(*seedmanagement.GardenletList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.GardenletList)(nil), (*GardenletList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_GardenletList_To_v1alpha1_GardenletList(a.(*seedmanagement.GardenletList), b.(*GardenletList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GardenletStatus)(nil), (*seedmanagement.GardenletStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GardenletStatus_To_seedmanagement_GardenletStatus(a.(*GardenletStatus), b.(*seedmanagement.GardenletStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.GardenletStatus)(nil), (*GardenletStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_GardenletStatus_To_v1alpha1_GardenletStatus(a.(*seedmanagement.GardenletStatus), b.(*GardenletStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Image)(nil), (*seedmanagement.Image)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Image_To_seedmanagement_Image(a.(*Image), b.(*seedmanagement.Image), scope)
 	}); err != nil {
@@ -159,6 +190,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Replacement)(nil), (*seedmanagement.Replacement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Replacement_To_seedmanagement_Replacement(a.(*Replacement), b.(*seedmanagement.Replacement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.Replacement)(nil), (*Replacement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_Replacement_To_v1alpha1_Replacement(a.(*seedmanagement.Replacement), b.(*Replacement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplacementStatus)(nil), (*seedmanagement.ReplacementStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReplacementStatus_To_seedmanagement_ReplacementStatus(a.(*ReplacementStatus), b.(*seedmanagement.ReplacementStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.ReplacementStatus)(nil), (*ReplacementStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_ReplacementStatus_To_v1alpha1_ReplacementStatus(a.(*seedmanagement.ReplacementStatus), b.(*ReplacementStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RollingUpdateStrategy)(nil), (*seedmanagement.RollingUpdateStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrategy(a.(*RollingUpdateStrategy), b.(*seedmanagement.RollingUpdateStrategy), scope)
 	}); err != nil {
@@ -199,13 +250,23 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*seedmanagement.Gardenlet)(nil), (*Gardenlet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(a.(*seedmanagement.Gardenlet), b.(*Gardenlet), scope)
+	if err := s.AddConversionFunc((*seedmanagement.GardenletConfig)(nil), (*GardenletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_GardenletConfig_To_v1alpha1_GardenletConfig(a.(*seedmanagement.GardenletConfig), b.(*GardenletConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*Gardenlet)(nil), (*seedmanagement.Gardenlet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(a.(*Gardenlet), b.(*seedmanagement.Gardenlet), scope)
+	if err := s.AddConversionFunc((*seedmanagement.GardenletSpec)(nil), (*GardenletSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_GardenletSpec_To_v1alpha1_GardenletSpec(a.(*seedmanagement.GardenletSpec), b.(*GardenletSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*GardenletConfig)(nil), (*seedmanagement.GardenletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GardenletConfig_To_seedmanagement_GardenletConfig(a.(*GardenletConfig), b.(*seedmanagement.GardenletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*GardenletSpec)(nil), (*seedmanagement.GardenletSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GardenletSpec_To_seedmanagement_GardenletSpec(a.(*GardenletSpec), b.(*seedmanagement.GardenletSpec), scope)
 	}); err != nil {
 		return err
 	}
@@ -223,6 +284,38 @@ func RegisterConversions(s *runtime.Scheme) error {
 }
 
 func autoConvert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in *Gardenlet, out *seedmanagement.Gardenlet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_GardenletSpec_To_seedmanagement_GardenletSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_GardenletStatus_To_seedmanagement_GardenletStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet is an autogenerated conversion function.
+func Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in *Gardenlet, out *seedmanagement.Gardenlet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in, out, s)
+}
+
+func autoConvert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(in *seedmanagement.Gardenlet, out *Gardenlet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_seedmanagement_GardenletSpec_To_v1alpha1_GardenletSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_seedmanagement_GardenletStatus_To_v1alpha1_GardenletStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet is an autogenerated conversion function.
+func Convert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(in *seedmanagement.Gardenlet, out *Gardenlet, s conversion.Scope) error {
+	return autoConvert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(in, out, s)
+}
+
+func autoConvert_v1alpha1_GardenletConfig_To_seedmanagement_GardenletConfig(in *GardenletConfig, out *seedmanagement.GardenletConfig, s conversion.Scope) error {
 	out.Deployment = (*seedmanagement.GardenletDeployment)(unsafe.Pointer(in.Deployment))
 	if err := runtime.Convert_runtime_RawExtension_To_runtime_Object(&in.Config, &out.Config, s); err != nil {
 		return err
@@ -232,7 +325,7 @@ func autoConvert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in *Gardenlet, o
 	return nil
 }
 
-func autoConvert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(in *seedmanagement.Gardenlet, out *Gardenlet, s conversion.Scope) error {
+func autoConvert_seedmanagement_GardenletConfig_To_v1alpha1_GardenletConfig(in *seedmanagement.GardenletConfig, out *GardenletConfig, s conversion.Scope) error {
 	out.Deployment = (*GardenletDeployment)(unsafe.Pointer(in.Deployment))
 	if err := runtime.Convert_runtime_Object_To_runtime_RawExtension(&in.Config, &out.Config, s); err != nil {
 		return err
@@ -282,6 +375,90 @@ func Convert_seedmanagement_GardenletDeployment_To_v1alpha1_GardenletDeployment(
 	return autoConvert_seedmanagement_GardenletDeployment_To_v1alpha1_GardenletDeployment(in, out, s)
 }
 
+func autoConvert_v1alpha1_GardenletList_To_seedmanagement_GardenletList(in *GardenletList, out *seedmanagement.GardenletList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]seedmanagement.Gardenlet, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_GardenletList_To_seedmanagement_GardenletList is an autogenerated conversion function.
+func Convert_v1alpha1_GardenletList_To_seedmanagement_GardenletList(in *GardenletList, out *seedmanagement.GardenletList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GardenletList_To_seedmanagement_GardenletList(in, out, s)
+}
+
+func autoConvert_seedmanagement_GardenletList_To_v1alpha1_GardenletList(in *seedmanagement.GardenletList, out *GardenletList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Gardenlet, len(*in))
+		for i := range *in {
+			if err := Convert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_seedmanagement_GardenletList_To_v1alpha1_GardenletList is an autogenerated conversion function.
+func Convert_seedmanagement_GardenletList_To_v1alpha1_GardenletList(in *seedmanagement.GardenletList, out *GardenletList, s conversion.Scope) error {
+	return autoConvert_seedmanagement_GardenletList_To_v1alpha1_GardenletList(in, out, s)
+}
+
+func autoConvert_v1alpha1_GardenletSpec_To_seedmanagement_GardenletSpec(in *GardenletSpec, out *seedmanagement.GardenletSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_GardenletDeployment_To_seedmanagement_GardenletDeployment(&in.Deployment, &out.Deployment, s); err != nil {
+		return err
+	}
+	if err := runtime.Convert_runtime_RawExtension_To_runtime_Object(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_seedmanagement_GardenletSpec_To_v1alpha1_GardenletSpec(in *seedmanagement.GardenletSpec, out *GardenletSpec, s conversion.Scope) error {
+	if err := Convert_seedmanagement_GardenletDeployment_To_v1alpha1_GardenletDeployment(&in.Deployment, &out.Deployment, s); err != nil {
+		return err
+	}
+	if err := runtime.Convert_runtime_Object_To_runtime_RawExtension(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_GardenletStatus_To_seedmanagement_GardenletStatus(in *GardenletStatus, out *seedmanagement.GardenletStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1alpha1_GardenletStatus_To_seedmanagement_GardenletStatus is an autogenerated conversion function.
+func Convert_v1alpha1_GardenletStatus_To_seedmanagement_GardenletStatus(in *GardenletStatus, out *seedmanagement.GardenletStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GardenletStatus_To_seedmanagement_GardenletStatus(in, out, s)
+}
+
+func autoConvert_seedmanagement_GardenletStatus_To_v1alpha1_GardenletStatus(in *seedmanagement.GardenletStatus, out *GardenletStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_seedmanagement_GardenletStatus_To_v1alpha1_GardenletStatus is an autogenerated conversion function.
+func Convert_seedmanagement_GardenletStatus_To_v1alpha1_GardenletStatus(in *seedmanagement.GardenletStatus, out *GardenletStatus, s conversion.Scope) error {
+	return autoConvert_seedmanagement_GardenletStatus_To_v1alpha1_GardenletStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Image_To_seedmanagement_Image(in *Image, out *seedmanagement.Image, s conversion.Scope) error {
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
@@ -536,13 +713,14 @@ func autoConvert_v1alpha1_ManagedSeedSpec_To_seedmanagement_ManagedSeedSpec(in *
 	out.Shoot = (*seedmanagement.Shoot)(unsafe.Pointer(in.Shoot))
 	if in.Gardenlet != nil {
 		in, out := &in.Gardenlet, &out.Gardenlet
-		*out = new(seedmanagement.Gardenlet)
-		if err := Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(*in, *out, s); err != nil {
+		*out = new(seedmanagement.GardenletConfig)
+		if err := Convert_v1alpha1_GardenletConfig_To_seedmanagement_GardenletConfig(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
 		out.Gardenlet = nil
 	}
+	out.Replacement = (*seedmanagement.Replacement)(unsafe.Pointer(in.Replacement))
 	return nil
 }
 
@@ -555,13 +733,14 @@ func autoConvert_seedmanagement_ManagedSeedSpec_To_v1alpha1_ManagedSeedSpec(in *
 	out.Shoot = (*Shoot)(unsafe.Pointer(in.Shoot))
 	if in.Gardenlet != nil {
 		in, out := &in.Gardenlet, &out.Gardenlet
-		*out = new(Gardenlet)
-		if err := Convert_seedmanagement_Gardenlet_To_v1alpha1_Gardenlet(*in, *out, s); err != nil {
+		*out = new(GardenletConfig)
+		if err := Convert_seedmanagement_GardenletConfig_To_v1alpha1_GardenletConfig(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
 		out.Gardenlet = nil
 	}
+	out.Replacement = (*Replacement)(unsafe.Pointer(in.Replacement))
 	return nil
 }
 
@@ -573,6 +752,7 @@ func Convert_seedmanagement_ManagedSeedSpec_To_v1alpha1_ManagedSeedSpec(in *seed
 func autoConvert_v1alpha1_ManagedSeedStatus_To_seedmanagement_ManagedSeedStatus(in *ManagedSeedStatus, out *seedmanagement.ManagedSeedStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Replacement = (*seedmanagement.ReplacementStatus)(unsafe.Pointer(in.Replacement))
 	return nil
 }
 
@@ -584,6 +764,7 @@ func Convert_v1alpha1_ManagedSeedStatus_To_seedmanagement_ManagedSeedStatus(in *
 func autoConvert_seedmanagement_ManagedSeedStatus_To_v1alpha1_ManagedSeedStatus(in *seedmanagement.ManagedSeedStatus, out *ManagedSeedStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Replacement = (*ReplacementStatus)(unsafe.Pointer(in.Replacement))
 	return nil
 }
 
@@ -644,6 +825,58 @@ func Convert_seedmanagement_PendingReplica_To_v1alpha1_PendingReplica(in *seedma
 	return autoConvert_seedmanagement_PendingReplica_To_v1alpha1_PendingReplica(in, out, s)
 }
 
+func autoConvert_v1alpha1_Replacement_To_seedmanagement_Replacement(in *Replacement, out *seedmanagement.Replacement, s conversion.Scope) error {
+	out.SeedName = in.SeedName
+	out.MaxConcurrentMigrations = (*int32)(unsafe.Pointer(in.MaxConcurrentMigrations))
+	return nil
+}
+
+// Convert_v1alpha1_Replacement_To_seedmanagement_Replacement is an autogenerated conversion function.
+func Convert_v1alpha1_Replacement_To_seedmanagement_Replacement(in *Replacement, out *seedmanagement.Replacement, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Replacement_To_seedmanagement_Replacement(in, out, s)
+}
+
+func autoConvert_seedmanagement_Replacement_To_v1alpha1_Replacement(in *seedmanagement.Replacement, out *Replacement, s conversion.Scope) error {
+	out.SeedName = in.SeedName
+	out.MaxConcurrentMigrations = (*int32)(unsafe.Pointer(in.MaxConcurrentMigrations))
+	return nil
+}
+
+// Convert_seedmanagement_Replacement_To_v1alpha1_Replacement is an autogenerated conversion function.
+func Convert_seedmanagement_Replacement_To_v1alpha1_Replacement(in *seedmanagement.Replacement, out *Replacement, s conversion.Scope) error {
+	return autoConvert_seedmanagement_Replacement_To_v1alpha1_Replacement(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReplacementStatus_To_seedmanagement_ReplacementStatus(in *ReplacementStatus, out *seedmanagement.ReplacementStatus, s conversion.Scope) error {
+	out.Phase = seedmanagement.ReplacementPhase(in.Phase)
+	out.Message = in.Message
+	out.ShootsTotal = in.ShootsTotal
+	out.ShootsMigrated = in.ShootsMigrated
+	out.ShootsMigrating = *(*[]string)(unsafe.Pointer(&in.ShootsMigrating))
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	return nil
+}
+
+// Convert_v1alpha1_ReplacementStatus_To_seedmanagement_ReplacementStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ReplacementStatus_To_seedmanagement_ReplacementStatus(in *ReplacementStatus, out *seedmanagement.ReplacementStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReplacementStatus_To_seedmanagement_ReplacementStatus(in, out, s)
+}
+
+func autoConvert_seedmanagement_ReplacementStatus_To_v1alpha1_ReplacementStatus(in *seedmanagement.ReplacementStatus, out *ReplacementStatus, s conversion.Scope) error {
+	out.Phase = ReplacementPhase(in.Phase)
+	out.Message = in.Message
+	out.ShootsTotal = in.ShootsTotal
+	out.ShootsMigrated = in.ShootsMigrated
+	out.ShootsMigrating = *(*[]string)(unsafe.Pointer(&in.ShootsMigrating))
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	return nil
+}
+
+// Convert_seedmanagement_ReplacementStatus_To_v1alpha1_ReplacementStatus is an autogenerated conversion function.
+func Convert_seedmanagement_ReplacementStatus_To_v1alpha1_ReplacementStatus(in *seedmanagement.ReplacementStatus, out *ReplacementStatus, s conversion.Scope) error {
+	return autoConvert_seedmanagement_ReplacementStatus_To_v1alpha1_ReplacementStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrategy(in *RollingUpdateStrategy, out *seedmanagement.RollingUpdateStrategy, s conversion.Scope) error {
 	out.Partition = (*int32)(unsafe.Pointer(in.Partition))
 	return nil
@@ -29,6 +29,34 @@ import (
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gardenlet) DeepCopyInto(out *Gardenlet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gardenlet.
+func (in *Gardenlet) DeepCopy() *Gardenlet {
+	if in == nil {
+		return nil
+	}
+	out := new(Gardenlet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Gardenlet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenletConfig) DeepCopyInto(out *GardenletConfig) {
 	*out = *in
 	if in.Deployment != nil {
 		in, out := &in.Deployment, &out.Deployment
@@ -49,12 +77,12 @@ func (in *Gardenlet) DeepCopyInto(out *Gardenlet) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gardenlet.
-func (in *Gardenlet) DeepCopy() *Gardenlet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenletConfig.
+func (in *GardenletConfig) DeepCopy() *GardenletConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Gardenlet)
+	out := new(GardenletConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -140,6 +168,80 @@ func (in *GardenletDeployment) DeepCopy() *GardenletDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenletList) DeepCopyInto(out *GardenletList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Gardenlet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenletList.
+func (in *GardenletList) DeepCopy() *GardenletList {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenletList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GardenletList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenletSpec) DeepCopyInto(out *GardenletSpec) {
+	*out = *in
+	in.Deployment.DeepCopyInto(&out.Deployment)
+	in.Config.DeepCopyInto(&out.Config)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenletSpec.
+func (in *GardenletSpec) DeepCopy() *GardenletSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenletSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenletStatus) DeepCopyInto(out *GardenletStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1beta1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenletStatus.
+func (in *GardenletStatus) DeepCopy() *GardenletStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenletStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -370,7 +472,12 @@ func (in *ManagedSeedSpec) DeepCopyInto(out *ManagedSeedSpec) {
 	}
 	if in.Gardenlet != nil {
 		in, out := &in.Gardenlet, &out.Gardenlet
-		*out = new(Gardenlet)
+		*out = new(GardenletConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(Replacement)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -396,6 +503,11 @@ func (in *ManagedSeedStatus) DeepCopyInto(out *ManagedSeedStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(ReplacementStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -449,6 +561,52 @@ func (in *PendingReplica) DeepCopy() *PendingReplica {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Replacement) DeepCopyInto(out *Replacement) {
+	*out = *in
+	if in.MaxConcurrentMigrations != nil {
+		in, out := &in.MaxConcurrentMigrations, &out.MaxConcurrentMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Replacement.
+func (in *Replacement) DeepCopy() *Replacement {
+	if in == nil {
+		return nil
+	}
+	out := new(Replacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplacementStatus) DeepCopyInto(out *ReplacementStatus) {
+	*out = *in
+	if in.ShootsMigrating != nil {
+		in, out := &in.ShootsMigrating, &out.ShootsMigrating
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplacementStatus.
+func (in *ReplacementStatus) DeepCopy() *ReplacementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplacementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RollingUpdateStrategy) DeepCopyInto(out *RollingUpdateStrategy) {
 	*out = *in
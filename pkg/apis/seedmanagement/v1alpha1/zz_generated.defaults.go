@@ -29,6 +29,8 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&Gardenlet{}, func(obj interface{}) { SetObjectDefaults_Gardenlet(obj.(*Gardenlet)) })
+	scheme.AddTypeDefaultingFunc(&GardenletList{}, func(obj interface{}) { SetObjectDefaults_GardenletList(obj.(*GardenletList)) })
 	scheme.AddTypeDefaultingFunc(&ManagedSeed{}, func(obj interface{}) { SetObjectDefaults_ManagedSeed(obj.(*ManagedSeed)) })
 	scheme.AddTypeDefaultingFunc(&ManagedSeedList{}, func(obj interface{}) { SetObjectDefaults_ManagedSeedList(obj.(*ManagedSeedList)) })
 	scheme.AddTypeDefaultingFunc(&ManagedSeedSet{}, func(obj interface{}) { SetObjectDefaults_ManagedSeedSet(obj.(*ManagedSeedSet)) })
@@ -36,6 +38,20 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	return nil
 }
 
+func SetObjectDefaults_Gardenlet(in *Gardenlet) {
+	SetDefaults_GardenletDeployment(&in.Spec.Deployment)
+	if in.Spec.Deployment.Image != nil {
+		SetDefaults_Image(in.Spec.Deployment.Image)
+	}
+}
+
+func SetObjectDefaults_GardenletList(in *GardenletList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_Gardenlet(a)
+	}
+}
+
 func SetObjectDefaults_ManagedSeed(in *ManagedSeed) {
 	SetDefaults_ManagedSeed(in)
 	if in.Spec.Gardenlet != nil {
@@ -46,6 +62,9 @@ func SetObjectDefaults_ManagedSeed(in *ManagedSeed) {
 			}
 		}
 	}
+	if in.Spec.Replacement != nil {
+		SetDefaults_Replacement(in.Spec.Replacement)
+	}
 }
 
 func SetObjectDefaults_ManagedSeedList(in *ManagedSeedList) {
@@ -65,6 +84,9 @@ func SetObjectDefaults_ManagedSeedSet(in *ManagedSeedSet) {
 			}
 		}
 	}
+	if in.Spec.Template.Spec.Replacement != nil {
+		SetDefaults_Replacement(in.Spec.Template.Spec.Replacement)
+	}
 	if in.Spec.UpdateStrategy != nil {
 		SetDefaults_UpdateStrategy(in.Spec.UpdateStrategy)
 		if in.Spec.UpdateStrategy.RollingUpdate != nil {
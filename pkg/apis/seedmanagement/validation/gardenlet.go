@@ -0,0 +1,111 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
+	gardenletvalidation "github.com/gardener/gardener/pkg/gardenlet/apis/config/validation"
+)
+
+// ValidateGardenlet validates a Gardenlet object.
+func ValidateGardenlet(gardenlet *seedmanagement.Gardenlet) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Ensure namespace is garden
+	if gardenlet.Namespace != v1beta1constants.GardenNamespace {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "namespace"), gardenlet.Namespace, "namespace must be garden"))
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&gardenlet.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateGardenletSpec(&gardenlet.Spec, gardenlet.Name, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateGardenletUpdate validates a Gardenlet object before an update.
+func ValidateGardenletUpdate(newGardenlet, oldGardenlet *seedmanagement.Gardenlet) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newGardenlet.ObjectMeta, &oldGardenlet.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateGardenlet(newGardenlet)...)
+
+	return allErrs
+}
+
+// ValidateGardenletStatusUpdate validates a Gardenlet object before a status update.
+func ValidateGardenletStatusUpdate(newGardenlet, oldGardenlet *seedmanagement.Gardenlet) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newGardenlet.ObjectMeta, &oldGardenlet.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateGardenletStatus(&newGardenlet.Status, field.NewPath("status"))...)
+
+	return allErrs
+}
+
+// ValidateGardenletSpec validates the specification of a Gardenlet object.
+func ValidateGardenletSpec(spec *seedmanagement.GardenletSpec, name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateGardenletDeployment(&spec.Deployment, fldPath.Child("deployment"))...)
+
+	if spec.Config != nil {
+		configPath := fldPath.Child("config")
+
+		// Convert gardenlet config to an internal version
+		gardenletConfig, err := gardenlethelper.ConvertGardenletConfiguration(spec.Config)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(configPath, spec.Config, fmt.Sprintf("could not convert gardenlet config: %v", err)))
+			return allErrs
+		}
+
+		// The configuration is merged with the one of the running gardenlet, hence it does not need to be complete.
+		allErrs = append(allErrs, gardenletvalidation.ValidateGardenletConfiguration(gardenletConfig, configPath, true)...)
+
+		// Ensure the gardenlet does not get registered for a different seed
+		if gardenletConfig.SeedConfig != nil && gardenletConfig.SeedConfig.Name != "" && gardenletConfig.SeedConfig.Name != name {
+			allErrs = append(allErrs, field.Invalid(configPath.Child("seedConfig", "metadata", "name"), gardenletConfig.SeedConfig.Name, "seed name must match the name of the Gardenlet"))
+		}
+
+		// Ensure the connection to the garden cluster is not changed since it is taken over from the running gardenlet
+		if gcc := gardenletConfig.GardenClientConnection; gcc != nil {
+			gccPath := configPath.Child("gardenClientConnection")
+			if gcc.Kubeconfig != "" {
+				allErrs = append(allErrs, field.Forbidden(gccPath.Child("kubeconfig"), "kubeconfig is taken over from the running gardenlet"))
+			}
+			if gcc.BootstrapKubeconfig != nil {
+				allErrs = append(allErrs, field.Forbidden(gccPath.Child("bootstrapKubeconfig"), "bootstrap kubeconfig is forbidden since the gardenlet is already registered"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateGardenletStatus validates the given GardenletStatus.
+func ValidateGardenletStatus(status *seedmanagement.GardenletStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Ensure integer fields are non-negative
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(status.ObservedGeneration, fldPath.Child("observedGeneration"))...)
+
+	return allErrs
+}
@@ -0,0 +1,164 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+	. "github.com/gardener/gardener/pkg/apis/seedmanagement/validation"
+	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+)
+
+var _ = Describe("Gardenlet Validation Tests", func() {
+	var gardenlet *seedmanagement.Gardenlet
+
+	BeforeEach(func() {
+		gardenlet = &seedmanagement.Gardenlet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: seedmanagement.GardenletSpec{
+				Deployment: seedmanagement.GardenletDeployment{
+					ReplicaCount: pointer.Int32(1),
+					Image: &seedmanagement.Image{
+						Repository: pointer.String("repository"),
+						Tag:        pointer.String("v1.2.3"),
+						PullPolicy: pullPolicyPtr(corev1.PullIfNotPresent),
+					},
+				},
+				Config: &gardenletv1alpha1.GardenletConfiguration{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: gardenletv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "GardenletConfiguration",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateGardenlet", func() {
+		It("should allow a valid Gardenlet", func() {
+			Expect(ValidateGardenlet(gardenlet)).To(BeEmpty())
+		})
+
+		It("should allow a Gardenlet without config", func() {
+			gardenlet.Spec.Config = nil
+
+			Expect(ValidateGardenlet(gardenlet)).To(BeEmpty())
+		})
+
+		It("should forbid a Gardenlet in a namespace other than garden", func() {
+			gardenlet.Namespace = "foo"
+
+			Expect(ValidateGardenlet(gardenlet)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.namespace"),
+				})),
+			))
+		})
+
+		It("should forbid invalid deployment parameters", func() {
+			gardenlet.Spec.Deployment.ReplicaCount = pointer.Int32(-1)
+			gardenlet.Spec.Deployment.Image.Tag = pointer.String("")
+
+			Expect(ValidateGardenlet(gardenlet)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.replicaCount"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.image.tag"),
+				})),
+			))
+		})
+
+		It("should forbid a seed name different from the Gardenlet name", func() {
+			gardenlet.Spec.Config.(*gardenletv1alpha1.GardenletConfiguration).SeedConfig = &gardenletv1alpha1.SeedConfig{
+				SeedTemplate: gardencorev1beta1.SeedTemplate{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+			}
+
+			Expect(ValidateGardenlet(gardenlet)).To(ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.config.seedConfig.metadata.name"),
+				})),
+			))
+		})
+
+		It("should forbid overwriting the garden client connection", func() {
+			gardenlet.Spec.Config.(*gardenletv1alpha1.GardenletConfiguration).GardenClientConnection = &gardenletv1alpha1.GardenClientConnection{
+				ClientConnectionConfiguration: v1alpha1.ClientConnectionConfiguration{
+					Kubeconfig: "kubeconfig",
+				},
+				BootstrapKubeconfig: &corev1.SecretReference{Name: "bootstrap"},
+			}
+
+			Expect(ValidateGardenlet(gardenlet)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.config.gardenClientConnection.kubeconfig"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.config.gardenClientConnection.bootstrapKubeconfig"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateGardenletUpdate", func() {
+		It("should forbid changing the namespace", func() {
+			newGardenlet := gardenlet.DeepCopy()
+			newGardenlet.ResourceVersion = "1"
+			gardenlet.ResourceVersion = "1"
+			newGardenlet.Namespace = "foo"
+
+			Expect(ValidateGardenletUpdate(newGardenlet, gardenlet)).To(ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.namespace"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateGardenletStatusUpdate", func() {
+		It("should forbid negative integer fields", func() {
+			newGardenlet := gardenlet.DeepCopy()
+			newGardenlet.ResourceVersion = "1"
+			gardenlet.ResourceVersion = "1"
+			newGardenlet.Status.ObservedGeneration = -1
+
+			Expect(ValidateGardenletStatusUpdate(newGardenlet, gardenlet)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.observedGeneration"),
+				})),
+			))
+		})
+	})
+})
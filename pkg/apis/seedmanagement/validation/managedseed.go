@@ -46,6 +46,10 @@ func ValidateManagedSeed(managedSeed *seedmanagement.ManagedSeed) field.ErrorLis
 	allErrs = append(allErrs, validateManagedSeedOperation(managedSeed.Annotations[v1beta1constants.GardenerOperation], field.NewPath("metadata", "annotations").Key(v1beta1constants.GardenerOperation))...)
 	allErrs = append(allErrs, ValidateManagedSeedSpec(&managedSeed.Spec, field.NewPath("spec"), false)...)
 
+	if managedSeed.Spec.Replacement != nil && managedSeed.Spec.Replacement.SeedName == managedSeed.Name {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "replacement", "seedName"), managedSeed.Spec.Replacement.SeedName, "seed cannot be replaced by itself"))
+	}
+
 	return allErrs
 }
 
@@ -110,6 +114,14 @@ func ValidateManagedSeedSpec(spec *seedmanagement.ManagedSeedSpec, fldPath *fiel
 		allErrs = append(allErrs, validateGardenlet(spec.Gardenlet, fldPath.Child("gardenlet"), inTemplate)...)
 	}
 
+	if spec.Replacement != nil {
+		if inTemplate {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("replacement"), "replacement is not supported in templates"))
+		} else {
+			allErrs = append(allErrs, validateReplacement(spec.Replacement, fldPath.Child("replacement"))...)
+		}
+	}
+
 	return allErrs
 }
 
@@ -124,6 +136,15 @@ func ValidateManagedSeedSpecUpdate(newSpec, oldSpec *seedmanagement.ManagedSeedS
 		allErrs = append(allErrs, validateGardenletUpdate(newSpec.Gardenlet, oldSpec.Gardenlet, fldPath.Child("gardenlet"))...)
 	}
 
+	// Ensure a started replacement is neither removed nor redirected to another seed
+	if oldSpec.Replacement != nil {
+		var newSeedName *string
+		if newSpec.Replacement != nil {
+			newSeedName = &newSpec.Replacement.SeedName
+		}
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedName, &oldSpec.Replacement.SeedName, fldPath.Child("replacement", "seedName"))...)
+	}
+
 	return allErrs
 }
 
@@ -134,6 +155,48 @@ func ValidateManagedSeedStatus(status *seedmanagement.ManagedSeedStatus, fieldPa
 	// Ensure integer fields are non-negative
 	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(status.ObservedGeneration, fieldPath.Child("observedGeneration"))...)
 
+	if status.Replacement != nil {
+		allErrs = append(allErrs, validateReplacementStatus(status.Replacement, fieldPath.Child("replacement"))...)
+	}
+
+	return allErrs
+}
+
+var availableReplacementPhases = sets.New(
+	string(seedmanagement.ReplacementPending),
+	string(seedmanagement.ReplacementMigrating),
+	string(seedmanagement.ReplacementVerifying),
+	string(seedmanagement.ReplacementDecommissioning),
+)
+
+func validateReplacement(replacement *seedmanagement.Replacement, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if replacement.SeedName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("seedName"), "seed name is required"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSLabel(replacement.SeedName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("seedName"), replacement.SeedName, msg))
+		}
+	}
+
+	if replacement.MaxConcurrentMigrations != nil && *replacement.MaxConcurrentMigrations < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMigrations"), *replacement.MaxConcurrentMigrations, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
+func validateReplacementStatus(status *seedmanagement.ReplacementStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableReplacementPhases.Has(string(status.Phase)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("phase"), status.Phase, sets.List(availableReplacementPhases)))
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.ShootsTotal), fldPath.Child("shootsTotal"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.ShootsMigrated), fldPath.Child("shootsMigrated"))...)
+
 	return allErrs
 }
 
@@ -148,7 +211,7 @@ func validateShoot(shoot *seedmanagement.Shoot, fldPath *field.Path, inTemplate
 	return allErrs
 }
 
-func validateGardenlet(gardenlet *seedmanagement.Gardenlet, fldPath *field.Path, inTemplate bool) field.ErrorList {
+func validateGardenlet(gardenlet *seedmanagement.GardenletConfig, fldPath *field.Path, inTemplate bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if gardenlet.Deployment != nil {
@@ -179,7 +242,7 @@ func validateGardenlet(gardenlet *seedmanagement.Gardenlet, fldPath *field.Path,
 	return allErrs
 }
 
-func validateGardenletUpdate(newGardenlet, oldGardenlet *seedmanagement.Gardenlet, fldPath *field.Path) field.ErrorList {
+func validateGardenletUpdate(newGardenlet, oldGardenlet *seedmanagement.GardenletConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if newGardenlet.Config != nil && oldGardenlet.Config != nil {
@@ -103,7 +103,7 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 				Shoot: &seedmanagement.Shoot{
 					Name: name,
 				},
-				Gardenlet: &seedmanagement.Gardenlet{},
+				Gardenlet: &seedmanagement.GardenletConfig{},
 			},
 			Status: seedmanagement.ManagedSeedStatus{
 				ObservedGeneration: 1,
@@ -218,6 +218,46 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 			))
 		})
 
+		Context("replacement", func() {
+			It("should allow a valid replacement", func() {
+				managedSeed.Spec.Replacement = &seedmanagement.Replacement{
+					SeedName:                "new-seed",
+					MaxConcurrentMigrations: pointer.Int32(3),
+				}
+
+				Expect(ValidateManagedSeed(managedSeed)).To(BeEmpty())
+			})
+
+			It("should forbid empty or invalid fields in replacement", func() {
+				managedSeed.Spec.Replacement = &seedmanagement.Replacement{
+					MaxConcurrentMigrations: pointer.Int32(0),
+				}
+
+				Expect(ValidateManagedSeed(managedSeed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.replacement.seedName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.replacement.maxConcurrentMigrations"),
+					})),
+				))
+			})
+
+			It("should forbid replacing the seed by itself", func() {
+				managedSeed.Spec.Replacement = &seedmanagement.Replacement{SeedName: managedSeed.Name}
+
+				Expect(ValidateManagedSeed(managedSeed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.replacement.seedName"),
+						"Detail": Equal("seed cannot be replaced by itself"),
+					})),
+				))
+			})
+		})
+
 		Context("gardenlet", func() {
 			var (
 				seedx *gardencorev1beta1.Seed
@@ -228,7 +268,7 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 				seedx, err = gardencorehelper.ConvertSeedExternal(seed)
 				Expect(err).NotTo(HaveOccurred())
 
-				managedSeed.Spec.Gardenlet = &seedmanagement.Gardenlet{
+				managedSeed.Spec.Gardenlet = &seedmanagement.GardenletConfig{
 					Deployment: &seedmanagement.GardenletDeployment{
 						Image: &seedmanagement.Image{
 							PullPolicy: pullPolicyPtr(corev1.PullIfNotPresent),
@@ -444,6 +484,44 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 			))
 		})
 
+		Context("replacement", func() {
+			BeforeEach(func() {
+				managedSeed.Spec.Replacement = &seedmanagement.Replacement{SeedName: "new-seed"}
+				newManagedSeed = managedSeed.DeepCopy()
+				newManagedSeed.ResourceVersion = "1"
+			})
+
+			It("should allow changing the maximum number of concurrent migrations", func() {
+				newManagedSeed.Spec.Replacement.MaxConcurrentMigrations = pointer.Int32(5)
+
+				Expect(ValidateManagedSeedUpdate(newManagedSeed, managedSeed)).To(BeEmpty())
+			})
+
+			It("should forbid changing the replacement seed name", func() {
+				newManagedSeed.Spec.Replacement.SeedName = "other-seed"
+
+				Expect(ValidateManagedSeedUpdate(newManagedSeed, managedSeed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.replacement.seedName"),
+						"Detail": Equal("field is immutable"),
+					})),
+				))
+			})
+
+			It("should forbid removing the replacement", func() {
+				newManagedSeed.Spec.Replacement = nil
+
+				Expect(ValidateManagedSeedUpdate(newManagedSeed, managedSeed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.replacement.seedName"),
+						"Detail": Equal("field is immutable"),
+					})),
+				))
+			})
+		})
+
 		Context("gardenlet", func() {
 			var (
 				seedx *gardencorev1beta1.Seed
@@ -454,7 +532,7 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 				seedx, err = gardencorehelper.ConvertSeedExternal(seed)
 				Expect(err).NotTo(HaveOccurred())
 
-				managedSeed.Spec.Gardenlet = &seedmanagement.Gardenlet{
+				managedSeed.Spec.Gardenlet = &seedmanagement.GardenletConfig{
 					Config:          gardenletConfiguration(seedx, nil),
 					Bootstrap:       bootstrapPtr(seedmanagement.BootstrapToken),
 					MergeWithParent: pointer.Bool(true),
@@ -517,6 +595,26 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		It("should forbid invalid replacement status", func() {
+			newManagedSeed.Status.Replacement = &seedmanagement.ReplacementStatus{
+				Phase:          "Foo",
+				ShootsMigrated: -1,
+			}
+
+			errorList := ValidateManagedSeedStatusUpdate(newManagedSeed, managedSeed)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.replacement.phase"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.replacement.shootsMigrated"),
+				})),
+			))
+		})
+
 		It("should forbid negative observed generation", func() {
 			newManagedSeed.Status.ObservedGeneration = -1
 
@@ -40,6 +40,7 @@ import (
 	shoottolerationrestriction "github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction"
 	shootvalidator "github.com/gardener/gardener/plugin/pkg/shoot/validator"
 	shootvpa "github.com/gardener/gardener/plugin/pkg/shoot/vpa"
+	shootworkermetadatarestriction "github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction"
 )
 
 // RegisterAllAdmissionPlugins registers all admission plugins.
@@ -55,6 +56,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	shootmanagedseed.Register(plugins)
 	shootnodelocaldns.Register(plugins)
 	shootdnsrewriting.Register(plugins)
+	shootworkermetadatarestriction.Register(plugins)
 	shootvalidator.Register(plugins)
 	seedvalidator.Register(plugins)
 	controllerregistrationresources.Register(plugins)
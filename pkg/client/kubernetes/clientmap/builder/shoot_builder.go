@@ -23,6 +23,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/internal"
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
 )
 
 // ShootClientMapBuilder can build a ClientMap which can be used to construct a ClientMap for requesting and storing
@@ -31,6 +32,7 @@ type ShootClientMapBuilder struct {
 	gardenClient           client.Client
 	seedClient             client.Client
 	clientConnectionConfig *componentbaseconfig.ClientConnectionConfiguration
+	rateLimiters           *ratelimiter.Registry
 }
 
 // NewShootClientMapBuilder constructs a new ShootClientMapBuilder.
@@ -56,6 +58,13 @@ func (b *ShootClientMapBuilder) WithClientConnectionConfig(cfg *componentbasecon
 	return b
 }
 
+// WithRateLimiters sets the registry of adaptive rate limiters that should be used by ClientSets created by this
+// ClientMap.
+func (b *ShootClientMapBuilder) WithRateLimiters(rateLimiters *ratelimiter.Registry) *ShootClientMapBuilder {
+	b.rateLimiters = rateLimiters
+	return b
+}
+
 // Build builds the ShootClientMap using the provided attributes.
 func (b *ShootClientMapBuilder) Build(log logr.Logger) (clientmap.ClientMap, error) {
 	if b.gardenClient == nil {
@@ -72,5 +81,6 @@ func (b *ShootClientMapBuilder) Build(log logr.Logger) (clientmap.ClientMap, err
 		GardenClient:           b.gardenClient,
 		SeedClient:             b.seedClient,
 		ClientConnectionConfig: *b.clientConnectionConfig,
+		RateLimiters:           b.rateLimiters,
 	}), nil
 }
@@ -28,6 +28,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
@@ -56,6 +57,9 @@ type ShootClientSetFactory struct {
 	SeedClient client.Client
 	// ClientConnectionConfiguration is the configuration that will be used by created ClientSets.
 	ClientConnectionConfig componentbaseconfig.ClientConnectionConfiguration
+	// RateLimiters is the registry of adaptive rate limiters for the shoot clients. If it is nil, the static QPS and
+	// burst from the ClientConnectionConfig are used.
+	RateLimiters *ratelimiter.Registry
 
 	// log is a logger for logging entries related to creating Shoot ClientSets.
 	log logr.Logger
@@ -90,11 +94,16 @@ func (f *ShootClientSetFactory) NewClientSet(ctx context.Context, k clientmap.Cl
 		return nil, "", fmt.Errorf("token for shoot kubeconfig was not populated yet")
 	}
 
-	clientSet, err := NewClientFromSecretObject(kubeconfigSecret,
+	opts := []kubernetes.ConfigFunc{
 		kubernetes.WithClientConnectionOptions(f.ClientConnectionConfig),
 		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.ShootScheme}),
 		kubernetes.WithDisabledCachedClient(),
-	)
+	}
+	if f.RateLimiters != nil {
+		opts = append(opts, kubernetes.WithAdaptiveRateLimiter(f.RateLimiters.For(k.Key())))
+	}
+
+	clientSet, err := NewClientFromSecretObject(kubeconfigSecret, opts...)
 	if err != nil {
 		return nil, "", err
 	}
@@ -146,6 +155,9 @@ func (f *ShootClientSetFactory) InvalidateClient(k clientmap.ClientSetKey) error
 		return fmt.Errorf("unsupported ClientSetKey: expected %T got %T", ShootClientSetKey{}, k)
 	}
 	delete(f.clientKeyToSeedNamespace, key)
+	if f.RateLimiters != nil {
+		f.RateLimiters.Forget(key.Key())
+	}
 	return nil
 }
 
@@ -23,6 +23,8 @@ import (
 	componentbaseconfig "k8s.io/component-base/config"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
 )
 
 // Config carries options for new ClientSets.
@@ -99,6 +101,19 @@ func WithClientConnectionOptions(cfg componentbaseconfig.ClientConnectionConfigu
 	}
 }
 
+// WithAdaptiveRateLimiter returns a ConfigFunc that configures the REST config to use the given adaptive rate limiter
+// instead of the static QPS and burst, and to report the responses of the API server to it.
+func WithAdaptiveRateLimiter(limiter *ratelimiter.Adaptive) ConfigFunc {
+	return func(config *Config) error {
+		if config.restConfig == nil {
+			return errors.New("REST config must be set before setting the rate limiter")
+		}
+		config.restConfig.RateLimiter = limiter
+		config.restConfig.Wrap(limiter.WrapTransport)
+		return nil
+	}
+}
+
 // WithClientOptions returns a ConfigFunc that sets the passed Options on the Config object.
 func WithClientOptions(opt client.Options) ConfigFunc {
 	return func(config *Config) error {
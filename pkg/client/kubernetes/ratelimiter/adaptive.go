@@ -0,0 +1,159 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiter
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// decreaseFactor is the factor by which the QPS is reduced when the API server is considered overloaded.
+	decreaseFactor = 0.5
+	// increaseFactor is the fraction of the maximum QPS by which the QPS is increased after a healthy response.
+	increaseFactor = 0.01
+)
+
+// Options contains the options for an adaptive rate limiter.
+type Options struct {
+	// QPS is the maximum QPS of the rate limiter.
+	QPS float32
+	// Burst is the maximum burst of the rate limiter.
+	Burst int
+	// MinQPS is the lower bound to which the QPS is reduced.
+	MinQPS float32
+	// LatencyThreshold is the request latency above which the API server is considered overloaded.
+	LatencyThreshold time.Duration
+	// SaturationWindow is the duration for which the API server is considered saturated after the last overload signal.
+	SaturationWindow time.Duration
+}
+
+// Adaptive is a rate limiter which adapts its QPS based on the observed latency and throttling responses of the API
+// server. The QPS is halved whenever the API server responds with 429 (Too Many Requests) or slower than the latency
+// threshold, and it is slowly increased again up to the configured maximum with every healthy response.
+type Adaptive struct {
+	clock   clock.Clock
+	opts    Options
+	limiter *rate.Limiter
+
+	lock         sync.Mutex
+	qps          float32
+	lastOverload time.Time
+}
+
+var _ flowcontrol.RateLimiter = &Adaptive{}
+
+// NewAdaptive creates a new adaptive rate limiter.
+func NewAdaptive(clock clock.Clock, opts Options) *Adaptive {
+	if opts.MinQPS > opts.QPS {
+		opts.MinQPS = opts.QPS
+	}
+
+	return &Adaptive{
+		clock:   clock,
+		opts:    opts,
+		limiter: rate.NewLimiter(rate.Limit(opts.QPS), opts.Burst),
+		qps:     opts.QPS,
+	}
+}
+
+// TryAccept returns true if a token is taken immediately. Otherwise, it returns false.
+func (a *Adaptive) TryAccept() bool {
+	return a.limiter.Allow()
+}
+
+// Accept returns once a token becomes available.
+func (a *Adaptive) Accept() {
+	time.Sleep(a.limiter.Reserve().Delay())
+}
+
+// Wait returns nil if a token is taken before the Context is done.
+func (a *Adaptive) Wait(ctx context.Context) error {
+	return a.limiter.Wait(ctx)
+}
+
+// Stop stops the rate limiter. It is a no-op for the adaptive rate limiter.
+func (a *Adaptive) Stop() {}
+
+// QPS returns the current QPS of the rate limiter.
+func (a *Adaptive) QPS() float32 {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.qps
+}
+
+// Saturated returns true if the API server has been considered overloaded within the saturation window.
+func (a *Adaptive) Saturated() bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return !a.lastOverload.IsZero() && a.clock.Since(a.lastOverload) < a.opts.SaturationWindow
+}
+
+// Observe adapts the QPS of the rate limiter based on the latency and status code of a response of the API server.
+func (a *Adaptive) Observe(latency time.Duration, statusCode int) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	qps := a.qps
+	if statusCode == http.StatusTooManyRequests || latency > a.opts.LatencyThreshold {
+		a.lastOverload = a.clock.Now()
+		qps = max(a.opts.MinQPS, qps*decreaseFactor)
+	} else {
+		qps = min(a.opts.QPS, qps+a.opts.QPS*increaseFactor)
+	}
+
+	if qps == a.qps {
+		return
+	}
+
+	a.qps = qps
+	a.limiter.SetLimit(rate.Limit(qps))
+	a.limiter.SetBurst(max(1, int(float32(a.opts.Burst)*qps/a.opts.QPS)))
+}
+
+// WrapTransport returns a round tripper which reports the latency and status code of all responses to the rate limiter.
+// Long-running requests like watches are not considered. It is meant to be used as `rest.Config.WrapTransport`.
+func (a *Adaptive) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &observingRoundTripper{delegate: rt, limiter: a}
+}
+
+type observingRoundTripper struct {
+	delegate http.RoundTripper
+	limiter  *Adaptive
+}
+
+func (o *observingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := o.limiter.clock.Now()
+	resp, err := o.delegate.RoundTrip(req)
+	if err != nil || isLongRunning(req) {
+		return resp, err
+	}
+
+	o.limiter.Observe(o.limiter.clock.Since(start), resp.StatusCode)
+	return resp, nil
+}
+
+func isLongRunning(req *http.Request) bool {
+	query := req.URL.Query()
+	return query.Get("watch") == "true" || query.Get("follow") == "true"
+}
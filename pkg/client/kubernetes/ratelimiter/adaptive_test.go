@@ -0,0 +1,125 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiter_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
+)
+
+var _ = Describe("Adaptive", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		limiter   *Adaptive
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		limiter = NewAdaptive(fakeClock, Options{
+			QPS:              40,
+			Burst:            80,
+			MinQPS:           5,
+			LatencyThreshold: time.Second,
+			SaturationWindow: time.Minute,
+		})
+	})
+
+	Describe("#Observe", func() {
+		It("should start with the maximum QPS", func() {
+			Expect(limiter.QPS()).To(Equal(float32(40)))
+			Expect(limiter.Saturated()).To(BeFalse())
+		})
+
+		It("should halve the QPS for throttling responses", func() {
+			limiter.Observe(0, http.StatusTooManyRequests)
+			Expect(limiter.QPS()).To(Equal(float32(20)))
+			Expect(limiter.Saturated()).To(BeTrue())
+		})
+
+		It("should halve the QPS for slow responses", func() {
+			limiter.Observe(2*time.Second, http.StatusOK)
+			Expect(limiter.QPS()).To(Equal(float32(20)))
+			Expect(limiter.Saturated()).To(BeTrue())
+		})
+
+		It("should not reduce the QPS below the minimum", func() {
+			for i := 0; i < 10; i++ {
+				limiter.Observe(0, http.StatusTooManyRequests)
+			}
+			Expect(limiter.QPS()).To(Equal(float32(5)))
+		})
+
+		It("should slowly increase the QPS up to the maximum for healthy responses", func() {
+			limiter.Observe(0, http.StatusTooManyRequests)
+			limiter.Observe(10*time.Millisecond, http.StatusOK)
+			Expect(limiter.QPS()).To(Equal(float32(20.4)))
+
+			for i := 0; i < 100; i++ {
+				limiter.Observe(10*time.Millisecond, http.StatusOK)
+			}
+			Expect(limiter.QPS()).To(Equal(float32(40)))
+		})
+
+		It("should no longer be saturated after the saturation window", func() {
+			limiter.Observe(0, http.StatusTooManyRequests)
+			fakeClock.Step(59 * time.Second)
+			Expect(limiter.Saturated()).To(BeTrue())
+			fakeClock.Step(time.Second)
+			Expect(limiter.Saturated()).To(BeFalse())
+		})
+	})
+
+	Describe("#WrapTransport", func() {
+		var (
+			server     *httptest.Server
+			statusCode int
+		)
+
+		BeforeEach(func() {
+			statusCode = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(statusCode)
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		do := func(path string) {
+			req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			resp, err := limiter.WrapTransport(http.DefaultTransport).RoundTrip(req)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(1, resp.Body.Close()).To(Succeed())
+		}
+
+		It("should report throttling responses to the rate limiter", func() {
+			statusCode = http.StatusTooManyRequests
+			do("/api/v1/pods")
+			Expect(limiter.QPS()).To(Equal(float32(20)))
+		})
+
+		It("should ignore long-running requests", func() {
+			statusCode = http.StatusTooManyRequests
+			do("/api/v1/pods?watch=true")
+			Expect(limiter.QPS()).To(Equal(float32(40)))
+		})
+	})
+})
@@ -0,0 +1,74 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiter
+
+import (
+	"sync"
+
+	"k8s.io/utils/clock"
+)
+
+// Registry manages the adaptive rate limiters for multiple API servers, e.g., one per shoot.
+type Registry struct {
+	clock clock.Clock
+	opts  Options
+
+	lock     sync.RWMutex
+	limiters map[string]*Adaptive
+}
+
+// NewRegistry creates a new registry which creates adaptive rate limiters with the given options.
+func NewRegistry(clock clock.Clock, opts Options) *Registry {
+	return &Registry{
+		clock:    clock,
+		opts:     opts,
+		limiters: make(map[string]*Adaptive),
+	}
+}
+
+// For returns the adaptive rate limiter for the given key. It is created if it does not exist yet.
+func (r *Registry) For(key string) *Adaptive {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = NewAdaptive(r.clock, r.opts)
+		r.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// Forget removes the adaptive rate limiter for the given key.
+func (r *Registry) Forget(key string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.limiters, key)
+}
+
+// Saturated returns true if the API server for the given key is considered saturated. It returns false if the registry
+// is nil or if there is no rate limiter for the given key.
+func (r *Registry) Saturated(key string) bool {
+	if r == nil {
+		return false
+	}
+
+	r.lock.RLock()
+	limiter, ok := r.limiters[key]
+	r.lock.RUnlock()
+
+	return ok && limiter.Saturated()
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiter_test
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
+)
+
+var _ = Describe("Registry", func() {
+	var registry *Registry
+
+	BeforeEach(func() {
+		registry = NewRegistry(testclock.NewFakeClock(time.Now()), Options{
+			QPS:              40,
+			Burst:            80,
+			MinQPS:           5,
+			LatencyThreshold: time.Second,
+			SaturationWindow: time.Minute,
+		})
+	})
+
+	It("should return the same rate limiter for the same key", func() {
+		Expect(registry.For("foo")).To(BeIdenticalTo(registry.For("foo")))
+		Expect(registry.For("foo")).NotTo(BeIdenticalTo(registry.For("bar")))
+	})
+
+	It("should report whether the API server for a key is saturated", func() {
+		registry.For("foo").Observe(0, http.StatusTooManyRequests)
+		registry.For("bar").Observe(0, http.StatusOK)
+
+		Expect(registry.Saturated("foo")).To(BeTrue())
+		Expect(registry.Saturated("bar")).To(BeFalse())
+		Expect(registry.Saturated("baz")).To(BeFalse())
+	})
+
+	It("should forget rate limiters", func() {
+		registry.For("foo").Observe(0, http.StatusTooManyRequests)
+		registry.Forget("foo")
+
+		Expect(registry.Saturated("foo")).To(BeFalse())
+		Expect(registry.For("foo").QPS()).To(Equal(float32(40)))
+	})
+
+	It("should not consider anything saturated for a nil registry", func() {
+		registry = nil
+		Expect(registry.Saturated("foo")).To(BeFalse())
+	})
+})
@@ -19,53 +19,201 @@ limitations under the License.
 package v1alpha1
 
 import (
-	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // GardenletApplyConfiguration represents an declarative configuration of the Gardenlet type for use
 // with apply.
 type GardenletApplyConfiguration struct {
-	Deployment      *GardenletDeploymentApplyConfiguration `json:"deployment,omitempty"`
-	Config          *runtime.RawExtension                  `json:"config,omitempty"`
-	Bootstrap       *seedmanagementv1alpha1.Bootstrap      `json:"bootstrap,omitempty"`
-	MergeWithParent *bool                                  `json:"mergeWithParent,omitempty"`
+	v1.TypeMetaApplyConfiguration    `json:",inline"`
+	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Spec                             *GardenletSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *GardenletStatusApplyConfiguration `json:"status,omitempty"`
 }
 
-// GardenletApplyConfiguration constructs an declarative configuration of the Gardenlet type for use with
+// Gardenlet constructs an declarative configuration of the Gardenlet type for use with
 // apply.
-func Gardenlet() *GardenletApplyConfiguration {
-	return &GardenletApplyConfiguration{}
+func Gardenlet(name, namespace string) *GardenletApplyConfiguration {
+	b := &GardenletApplyConfiguration{}
+	b.WithName(name)
+	b.WithNamespace(namespace)
+	b.WithKind("Gardenlet")
+	b.WithAPIVersion("seedmanagement.gardener.cloud/v1alpha1")
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithKind(value string) *GardenletApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithAPIVersion(value string) *GardenletApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithName(value string) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.Name = &value
+	return b
 }
 
-// WithDeployment sets the Deployment field in the declarative configuration to the given value
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
-// If called multiple times, the Deployment field is set to the value of the last call.
-func (b *GardenletApplyConfiguration) WithDeployment(value *GardenletDeploymentApplyConfiguration) *GardenletApplyConfiguration {
-	b.Deployment = value
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithGenerateName(value string) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.GenerateName = &value
 	return b
 }
 
-// WithConfig sets the Config field in the declarative configuration to the given value
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
-// If called multiple times, the Config field is set to the value of the last call.
-func (b *GardenletApplyConfiguration) WithConfig(value runtime.RawExtension) *GardenletApplyConfiguration {
-	b.Config = &value
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithNamespace(value string) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.Namespace = &value
 	return b
 }
 
-// WithBootstrap sets the Bootstrap field in the declarative configuration to the given value
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithUID(value types.UID) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithResourceVersion(value string) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithGeneration(value int64) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithCreationTimestamp(value metav1.Time) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *GardenletApplyConfiguration) WithLabels(entries map[string]string) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *GardenletApplyConfiguration) WithAnnotations(entries map[string]string) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *GardenletApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.OwnerReferences = append(b.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *GardenletApplyConfiguration) WithFinalizers(values ...string) *GardenletApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.Finalizers = append(b.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *GardenletApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
-// If called multiple times, the Bootstrap field is set to the value of the last call.
-func (b *GardenletApplyConfiguration) WithBootstrap(value seedmanagementv1alpha1.Bootstrap) *GardenletApplyConfiguration {
-	b.Bootstrap = &value
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithSpec(value *GardenletSpecApplyConfiguration) *GardenletApplyConfiguration {
+	b.Spec = value
 	return b
 }
 
-// WithMergeWithParent sets the MergeWithParent field in the declarative configuration to the given value
+// WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
-// If called multiple times, the MergeWithParent field is set to the value of the last call.
-func (b *GardenletApplyConfiguration) WithMergeWithParent(value bool) *GardenletApplyConfiguration {
-	b.MergeWithParent = &value
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *GardenletApplyConfiguration) WithStatus(value *GardenletStatusApplyConfiguration) *GardenletApplyConfiguration {
+	b.Status = value
 	return b
 }
@@ -0,0 +1,51 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// GardenletSpecApplyConfiguration represents an declarative configuration of the GardenletSpec type for use
+// with apply.
+type GardenletSpecApplyConfiguration struct {
+	Deployment *GardenletDeploymentApplyConfiguration `json:"deployment,omitempty"`
+	Config     *runtime.RawExtension                  `json:"config,omitempty"`
+}
+
+// GardenletSpecApplyConfiguration constructs an declarative configuration of the GardenletSpec type for use with
+// apply.
+func GardenletSpec() *GardenletSpecApplyConfiguration {
+	return &GardenletSpecApplyConfiguration{}
+}
+
+// WithDeployment sets the Deployment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deployment field is set to the value of the last call.
+func (b *GardenletSpecApplyConfiguration) WithDeployment(value *GardenletDeploymentApplyConfiguration) *GardenletSpecApplyConfiguration {
+	b.Deployment = value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *GardenletSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *GardenletSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
@@ -21,8 +21,8 @@ package v1alpha1
 // ManagedSeedSpecApplyConfiguration represents an declarative configuration of the ManagedSeedSpec type for use
 // with apply.
 type ManagedSeedSpecApplyConfiguration struct {
-	Shoot     *ShootApplyConfiguration     `json:"shoot,omitempty"`
-	Gardenlet *GardenletApplyConfiguration `json:"gardenlet,omitempty"`
+	Shoot     *ShootApplyConfiguration           `json:"shoot,omitempty"`
+	Gardenlet *GardenletConfigApplyConfiguration `json:"gardenlet,omitempty"`
 }
 
 // ManagedSeedSpecApplyConfiguration constructs an declarative configuration of the ManagedSeedSpec type for use with
@@ -42,7 +42,7 @@ func (b *ManagedSeedSpecApplyConfiguration) WithShoot(value *ShootApplyConfigura
 // WithGardenlet sets the Gardenlet field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Gardenlet field is set to the value of the last call.
-func (b *ManagedSeedSpecApplyConfiguration) WithGardenlet(value *GardenletApplyConfiguration) *ManagedSeedSpecApplyConfiguration {
+func (b *ManagedSeedSpecApplyConfiguration) WithGardenlet(value *GardenletConfigApplyConfiguration) *ManagedSeedSpecApplyConfiguration {
 	b.Gardenlet = value
 	return b
 }
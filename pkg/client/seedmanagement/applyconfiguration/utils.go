@@ -31,8 +31,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=seedmanagement.gardener.cloud, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithKind("Gardenlet"):
 		return &seedmanagementv1alpha1.GardenletApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GardenletConfig"):
+		return &seedmanagementv1alpha1.GardenletConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GardenletDeployment"):
 		return &seedmanagementv1alpha1.GardenletDeploymentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GardenletSpec"):
+		return &seedmanagementv1alpha1.GardenletSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GardenletStatus"):
+		return &seedmanagementv1alpha1.GardenletStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Image"):
 		return &seedmanagementv1alpha1.ImageApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ManagedSeed"):
@@ -28,6 +28,10 @@ type FakeSeedmanagementV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeSeedmanagementV1alpha1) Gardenlets(namespace string) v1alpha1.GardenletInterface {
+	return &FakeGardenlets{c, namespace}
+}
+
 func (c *FakeSeedmanagementV1alpha1) ManagedSeeds(namespace string) v1alpha1.ManagedSeedInterface {
 	return &FakeManagedSeeds{c, namespace}
 }
@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type GardenletExpansion interface{}
+
 type ManagedSeedExpansion interface{}
 
 type ManagedSeedSetExpansion interface{}
@@ -28,6 +28,7 @@ import (
 
 type SeedmanagementV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	GardenletsGetter
 	ManagedSeedsGetter
 	ManagedSeedSetsGetter
 }
@@ -37,6 +38,10 @@ type SeedmanagementV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SeedmanagementV1alpha1Client) Gardenlets(namespace string) GardenletInterface {
+	return newGardenlets(c, namespace)
+}
+
 func (c *SeedmanagementV1alpha1Client) ManagedSeeds(namespace string) ManagedSeedInterface {
 	return newManagedSeeds(c, namespace)
 }
@@ -53,6 +53,8 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=seedmanagement.gardener.cloud, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("gardenlets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Seedmanagement().V1alpha1().Gardenlets().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("managedseeds"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Seedmanagement().V1alpha1().ManagedSeeds().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("managedseedsets"):
@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Gardenlets returns a GardenletInformer.
+	Gardenlets() GardenletInformer
 	// ManagedSeeds returns a ManagedSeedInformer.
 	ManagedSeeds() ManagedSeedInformer
 	// ManagedSeedSets returns a ManagedSeedSetInformer.
@@ -41,6 +43,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Gardenlets returns a GardenletInformer.
+func (v *version) Gardenlets() GardenletInformer {
+	return &gardenletInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ManagedSeeds returns a ManagedSeedInformer.
 func (v *version) ManagedSeeds() ManagedSeedInformer {
 	return &managedSeedInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -17,17 +17,23 @@ package apiserver
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -104,6 +110,60 @@ func ReconcileConfigMapAuditPolicy(ctx context.Context, c client.Client, configM
 	return client.IgnoreAlreadyExists(c.Create(ctx, configMap))
 }
 
+// DeprecatedAPIsAuditPolicy returns an audit policy which logs the metadata of requests to the built-in APIs which are
+// deprecated but still served in the given Kubernetes version. Since audit policies cannot match API versions, requests
+// to other versions of the same resources are logged as well.
+func DeprecatedAPIsAuditPolicy(version *semver.Version) (string, error) {
+	resourcesByGroup := make(map[string]sets.Set[string])
+	for gvk, t := range kubernetes.ShootScheme.AllKnownTypes() {
+		if strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+
+		deprecated, ok := reflect.New(t).Interface().(interface{ APILifecycleDeprecated() (int, int) })
+		if !ok || !releasedIn(version, deprecated.APILifecycleDeprecated) {
+			continue
+		}
+		if removed, ok := deprecated.(interface{ APILifecycleRemoved() (int, int) }); ok && releasedIn(version, removed.APILifecycleRemoved) {
+			continue
+		}
+
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		if resourcesByGroup[gvr.Group] == nil {
+			resourcesByGroup[gvr.Group] = sets.New[string]()
+		}
+		resourcesByGroup[gvr.Group].Insert(gvr.Resource, gvr.Resource+"/*")
+	}
+
+	var groupResources []auditv1.GroupResources
+	for _, group := range sets.List(sets.KeySet(resourcesByGroup)) {
+		groupResources = append(groupResources, auditv1.GroupResources{Group: group, Resources: sets.List(resourcesByGroup[group])})
+	}
+
+	policy := &auditv1.Policy{
+		OmitStages: []auditv1.Stage{auditv1.StageRequestReceived},
+		Rules: []auditv1.PolicyRule{
+			{Level: auditv1.LevelMetadata, Resources: groupResources},
+			{Level: auditv1.LevelNone},
+		},
+	}
+	if len(groupResources) == 0 {
+		policy.Rules = policy.Rules[1:]
+	}
+
+	data, err := runtime.Encode(auditCodec, policy)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// releasedIn returns true if the release returned by the given function is the given version or an earlier one.
+func releasedIn(version *semver.Version, release func() (int, int)) bool {
+	major, minor := release()
+	return uint64(major) < version.Major() || (uint64(major) == version.Major() && uint64(minor) <= version.Minor())
+}
+
 // InjectAuditSettings injects the audit settings into `gardener-apiserver` and `kube-apiserver` deployments.
 func InjectAuditSettings(deployment *appsv1.Deployment, configMapAuditPolicy *corev1.ConfigMap, secretWebhookKubeconfig *corev1.Secret, auditConfig *AuditConfig) {
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--audit-policy-file=%s/%s", volumeMountPathAuditPolicy, configMapAuditPolicyDataKey))
@@ -17,6 +17,7 @@ package apiserver_test
 import (
 	"context"
 
+	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
@@ -189,6 +190,46 @@ rules:
 		})
 	})
 
+	Describe("#DeprecatedAPIsAuditPolicy", func() {
+		It("should return a policy logging the requests to the deprecated APIs served in the given version", func() {
+			Expect(DeprecatedAPIsAuditPolicy(semver.MustParse("1.25.4"))).To(Equal(`apiVersion: audit.k8s.io/v1
+kind: Policy
+metadata:
+  creationTimestamp: null
+omitStages:
+- RequestReceived
+rules:
+- level: Metadata
+  resources:
+  - resources:
+    - componentstatuses
+    - componentstatuses/*
+  - group: autoscaling
+    resources:
+    - horizontalpodautoscalers
+    - horizontalpodautoscalers/*
+  - group: flowcontrol.apiserver.k8s.io
+    resources:
+    - flowschemas
+    - flowschemas/*
+    - prioritylevelconfigurations
+    - prioritylevelconfigurations/*
+  - group: storage.k8s.io
+    resources:
+    - csistoragecapacities
+    - csistoragecapacities/*
+- level: None
+`))
+		})
+
+		It("should not consider APIs which are already removed in the given version", func() {
+			policy, err := DeprecatedAPIsAuditPolicy(semver.MustParse("1.27.0"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).NotTo(ContainSubstring("horizontalpodautoscalers"))
+			Expect(policy).NotTo(ContainSubstring("csistoragecapacities"))
+		})
+	})
+
 	Describe("#InjectAuditSettings", func() {
 		It("should inject the correct settings w/o webhook", func() {
 			deployment := &appsv1.Deployment{}
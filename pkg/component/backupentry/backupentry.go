@@ -59,6 +59,9 @@ type Values struct {
 	// BackupEntry does not exist yet. Otherwise, the existing `.spec.bucketName` will be kept even if the BucketName in
 	// these values differs.
 	BucketName string
+	// EncryptionSecretName is the name of a secret in the namespace of the BackupEntry which contains a customer-supplied
+	// key used to encrypt the backups.
+	EncryptionSecretName *string
 }
 
 // New creates a new instance of DeployWaiter for a BackupEntry.
@@ -178,6 +181,10 @@ func (b *backupEntry) reconcile(ctx context.Context, backupEntry *gardencorev1be
 		backupEntry.Spec.BucketName = bucketName
 		backupEntry.Spec.SeedName = seedName
 
+		if b.values.EncryptionSecretName != nil {
+			backupEntry.Spec.Encryption = &gardencorev1beta1.BackupEntryEncryption{SecretName: *b.values.EncryptionSecretName}
+		}
+
 		return nil
 	})
 
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -151,6 +152,21 @@ var _ = Describe("BackupEntry", func() {
 
 			Expect(actual).To(DeepEqual(expected))
 		})
+
+		It("should create correct BackupEntry with encryption", func() {
+			defer test.WithVars(&TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			values.EncryptionSecretName = pointer.String("encryption-key")
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &gardencorev1beta1.BackupEntry{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, actual)).To(Succeed())
+			expected.Annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationReconcile
+			expected.Spec.Encryption = &gardencorev1beta1.BackupEntryEncryption{SecretName: "encryption-key"}
+
+			Expect(actual).To(DeepEqual(expected))
+		})
 	})
 
 	Describe("#Wait", func() {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -102,6 +103,10 @@ type Values struct {
 	SearchPathRewritesEnabled bool
 	// SearchPathRewriteCommonSuffixes contains common suffixes to be rewritten when SearchPathRewritesEnabled is set.
 	SearchPathRewriteCommonSuffixes []string
+	// Zones contains additional DNS zones whose requests are forwarded to dedicated upstream DNS servers.
+	Zones []gardencorev1beta1.CoreDNSZone
+	// Rewrites contains rules for rewriting the names of DNS requests.
+	Rewrites []gardencorev1beta1.CoreDNSRewrite
 }
 
 // New creates a new instance of DeployWaiter for coredns.
@@ -234,7 +239,7 @@ func (c *coreDNS) computeResourcesData() (map[string][]byte, error) {
   health {
       lameduck 15s
   }
-  ready` + getSearchPathRewrites(c.values.SearchPathRewritesEnabled, c.values.ClusterDomain, c.values.SearchPathRewriteCommonSuffixes) + `
+  ready` + getSearchPathRewrites(c.values.SearchPathRewritesEnabled, c.values.ClusterDomain, c.values.SearchPathRewriteCommonSuffixes) + getRewrites(c.values.Rewrites) + `
   kubernetes ` + c.values.ClusterDomain + ` in-addr.arpa ip6.arpa {
       pods insecure
       fallthrough in-addr.arpa ip6.arpa
@@ -248,7 +253,7 @@ func (c *coreDNS) computeResourcesData() (map[string][]byte, error) {
   loadbalance round_robin
   import custom/*.override
 }
-import custom/*.server
+` + getZoneServerBlocks(c.values.Zones) + `import custom/*.server
 `,
 			},
 		}
@@ -761,3 +766,25 @@ func getSearchPathRewrites(enabled bool, clusterDomain string, commonSuffixes []
     answer value (^(?:[^\.]+\.)+)svc\.` + quotedClusterDomain + ` {1}svc.` + clusterDomain + `.svc.` + clusterDomain + `
   }` + suffixRewrites
 }
+
+func getRewrites(rewrites []gardencorev1beta1.CoreDNSRewrite) string {
+	out := ""
+	for _, rewrite := range rewrites {
+		out += `
+  rewrite stop name ` + string(rewrite.Match) + ` ` + rewrite.From + ` ` + rewrite.To + ` answer auto`
+	}
+	return out
+}
+
+func getZoneServerBlocks(zones []gardencorev1beta1.CoreDNSZone) string {
+	out := ""
+	for _, zone := range zones {
+		out += zone.Name + `:` + strconv.Itoa(corednsconstants.PortServer) + ` {
+  errors
+  cache 30
+  forward . ` + strings.Join(zone.Upstreams, " ") + `
+}
+`
+	}
+	return out
+}
@@ -115,7 +115,7 @@ subjects:
   name: coredns
   namespace: kube-system
 `
-		configMapYAML = func(rewritingEnabled bool, commonSuffixes []string) string {
+		configMapYAML = func(rewritingEnabled bool, commonSuffixes []string, customRewrites, zoneServerBlocks string) string {
 			out := `apiVersion: v1
 data:
   Corefile: |
@@ -144,7 +144,7 @@ data:
       }`
 				}
 			}
-			out += `
+			out += customRewrites + `
       kubernetes ` + clusterDomain + ` in-addr.arpa ip6.arpa {
           pods insecure
           fallthrough in-addr.arpa ip6.arpa
@@ -158,7 +158,7 @@ data:
       loadbalance round_robin
       import custom/*.override
     }
-    import custom/*.server
+` + zoneServerBlocks + `    import custom/*.server
 kind: ConfigMap
 metadata:
   creationTimestamp: null
@@ -615,6 +615,8 @@ status: {}
 			vpaEnabled       bool
 			rewritingEnabled bool
 			commonSuffixes   []string
+			customRewrites   string
+			zoneServerBlocks string
 		)
 
 		BeforeEach(func() {
@@ -622,6 +624,8 @@ status: {}
 			vpaEnabled = false
 			rewritingEnabled = false
 			commonSuffixes = []string{}
+			customRewrites = ""
+			zoneServerBlocks = ""
 		})
 
 		JustBeforeEach(func() {
@@ -669,7 +673,7 @@ status: {}
 			Expect(string(managedResourceSecret.Data["serviceaccount__kube-system__coredns.yaml"])).To(Equal(serviceAccountYAML))
 			Expect(string(managedResourceSecret.Data["clusterrole____system_coredns.yaml"])).To(Equal(clusterRoleYAML))
 			Expect(string(managedResourceSecret.Data["clusterrolebinding____system_coredns.yaml"])).To(Equal(clusterRoleBindingYAML))
-			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns.yaml"])).To(Equal(configMapYAML(rewritingEnabled, commonSuffixes)))
+			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns.yaml"])).To(Equal(configMapYAML(rewritingEnabled, commonSuffixes, customRewrites, zoneServerBlocks)))
 			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns-custom.yaml"])).To(Equal(configMapCustomYAML))
 			Expect(string(managedResourceSecret.Data["service__kube-system__kube-dns.yaml"])).To(Equal(serviceYAML))
 			Expect(string(managedResourceSecret.Data["networkpolicy__kube-system__gardener.cloud--allow-dns.yaml"])).To(Equal(networkPolicyYAML))
@@ -740,6 +744,44 @@ status: {}
 			})
 		})
 
+		Context("w/ custom zones and rewrites", func() {
+			BeforeEach(func() {
+				values.Zones = []gardencorev1beta1.CoreDNSZone{
+					{Name: "corp.example.com", Upstreams: []string{"10.0.0.10", "10.0.0.11:5353"}},
+					{Name: "example.org", Upstreams: []string{"2001:db8::1"}},
+				}
+				values.Rewrites = []gardencorev1beta1.CoreDNSRewrite{
+					{Match: gardencorev1beta1.CoreDNSRewriteMatchExact, From: "foo.example.com", To: "bar.example.com"},
+					{Match: gardencorev1beta1.CoreDNSRewriteMatchSuffix, From: ".old.example.com", To: ".new.example.com"},
+				}
+				component = New(c, namespace, values)
+
+				customRewrites = `
+      rewrite stop name exact foo.example.com bar.example.com answer auto
+      rewrite stop name suffix .old.example.com .new.example.com answer auto`
+				zoneServerBlocks = `    corp.example.com:8053 {
+      errors
+      cache 30
+      forward . 10.0.0.10 10.0.0.11:5353
+    }
+    example.org:8053 {
+      errors
+      cache 30
+      forward . 2001:db8::1
+    }
+`
+			})
+
+			It("should successfully deploy all resources", func() {
+				Expect(string(managedResourceSecret.Data["deployment__kube-system__coredns.yaml"])).To(Equal(deploymentYAMLFor("", nil, true, true)))
+			})
+
+			AfterEach(func() {
+				values.Zones = nil
+				values.Rewrites = nil
+			})
+		})
+
 		Context("w/ cluster proportional autoscaler enabled", func() {
 			BeforeEach(func() {
 				cpaEnabled = true
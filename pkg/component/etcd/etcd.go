@@ -162,6 +162,15 @@ type Values struct {
 	PriorityClassName           string
 	HighAvailabilityEnabled     bool
 	TopologyAwareRoutingEnabled bool
+	// DeferPriorityClassNameChange controls whether the priority class name of an existing etcd is kept. Changing it
+	// rolls the etcd pods, hence it should only be done during the maintenance time window.
+	DeferPriorityClassNameChange bool
+	// LocalStorageClassName is the name of a storage class providing node-local volumes. If set, etcds with backups
+	// are placed on local storage since their data can be restored when the node hosting the volume is lost.
+	LocalStorageClassName *string
+	// ResourceRequests are the resource requests of the etcd container. The given values overwrite the defaults for the
+	// respective resources.
+	ResourceRequests corev1.ResourceList
 }
 
 func (e *etcd) Deploy(ctx context.Context) error {
@@ -195,7 +204,8 @@ func (e *etcd) Deploy(ctx context.Context) error {
 	var (
 		hvpa = e.emptyHVPA()
 
-		replicas = e.computeReplicas(existingEtcd)
+		replicas          = e.computeReplicas(existingEtcd)
+		priorityClassName = e.computePriorityClassName(existingEtcd)
 
 		resourcesEtcd, resourcesBackupRestore = e.computeContainerResources(existingSts)
 		garbageCollectionPolicy               = druidv1alpha1.GarbageCollectionPolicy(druidv1alpha1.GarbageCollectionPolicyExponential)
@@ -223,6 +233,20 @@ func (e *etcd) Deploy(ctx context.Context) error {
 		}
 	}
 
+	maxAllowed := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("4"),
+		corev1.ResourceMemory: resource.MustParse("30G"),
+	}
+
+	if e.values.HvpaConfig != nil {
+		for name, quantity := range e.values.HvpaConfig.MinAllowed {
+			minAllowed[name] = quantity
+		}
+		for name, quantity := range e.values.HvpaConfig.MaxAllowed {
+			maxAllowed[name] = quantity
+		}
+	}
+
 	etcdCASecret, found := e.secretsManager.Get(v1beta1constants.SecretNameCAETCD)
 	if !found {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCD)
@@ -277,7 +301,7 @@ func (e *etcd) Deploy(ctx context.Context) error {
 			v1beta1constants.GardenRole: v1beta1constants.GardenRoleControlPlane,
 		}
 		e.etcd.Spec.Replicas = replicas
-		e.etcd.Spec.PriorityClassName = &e.values.PriorityClassName
+		e.etcd.Spec.PriorityClassName = &priorityClassName
 		e.etcd.Spec.Annotations = annotations
 		e.etcd.Spec.Labels = utils.MergeStringMaps(e.getRoleLabels(), map[string]string{
 			v1beta1constants.LabelApp:                             LabelAppValue,
@@ -399,7 +423,7 @@ func (e *etcd) Deploy(ctx context.Context) error {
 		}
 
 		e.etcd.Spec.StorageCapacity = utils.QuantityPtr(resource.MustParse(e.values.StorageCapacity))
-		e.etcd.Spec.StorageClass = e.values.StorageClassName
+		e.etcd.Spec.StorageClass = e.computeStorageClassName(existingEtcd, existingSts)
 		e.etcd.Spec.VolumeClaimTemplate = &volumeClaimTemplate
 		return nil
 	}); err != nil {
@@ -511,12 +535,9 @@ func (e *etcd) Deploy(ctx context.Context) error {
 						ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
 							ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
 								{
-									ContainerName: containerNameEtcd,
-									MinAllowed:    minAllowed,
-									MaxAllowed: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("4"),
-										corev1.ResourceMemory: resource.MustParse("30G"),
-									},
+									ContainerName:    containerNameEtcd,
+									MinAllowed:       minAllowed,
+									MaxAllowed:       maxAllowed,
 									ControlledValues: &controlledValues,
 								},
 								{
@@ -729,6 +750,27 @@ func (e *etcd) GetReplicas() *int32 { return e.values.Replicas }
 
 func (e *etcd) SetReplicas(replicas *int32) { e.values.Replicas = replicas }
 
+// computeStorageClassName returns the storage class for the volumes of the etcd. Only etcds with backups are placed on
+// local storage. If the etcd's statefulset already exists, its storage class is not switched between local and network
+// storage since the volume claim templates of statefulsets cannot be changed.
+func (e *etcd) computeStorageClassName(existingEtcd *druidv1alpha1.Etcd, existingSts *appsv1.StatefulSet) *string {
+	if e.values.LocalStorageClassName == nil {
+		return e.values.StorageClassName
+	}
+
+	storageClassName := e.values.StorageClassName
+	if e.values.BackupConfig != nil {
+		storageClassName = e.values.LocalStorageClassName
+	}
+
+	if existingEtcd != nil && existingSts != nil &&
+		pointer.StringEqual(existingEtcd.Spec.StorageClass, e.values.LocalStorageClassName) != pointer.StringEqual(storageClassName, e.values.LocalStorageClassName) {
+		return existingEtcd.Spec.StorageClass
+	}
+
+	return storageClassName
+}
+
 func (e *etcd) computeContainerResources(existingSts *appsv1.StatefulSet) (*corev1.ResourceRequirements, *corev1.ResourceRequirements) {
 	var (
 		resourcesEtcd = &corev1.ResourceRequirements{
@@ -745,6 +787,10 @@ func (e *etcd) computeContainerResources(existingSts *appsv1.StatefulSet) (*core
 		}
 	)
 
+	for name, quantity := range e.values.ResourceRequests {
+		resourcesEtcd.Requests[name] = quantity
+	}
+
 	if existingSts != nil && e.values.HvpaConfig != nil && e.values.HvpaConfig.Enabled {
 		for k := range existingSts.Spec.Template.Spec.Containers {
 			v := existingSts.Spec.Template.Spec.Containers[k]
@@ -775,6 +821,13 @@ func (e *etcd) computeReplicas(existingEtcd *druidv1alpha1.Etcd) int32 {
 	return 0
 }
 
+func (e *etcd) computePriorityClassName(existingEtcd *druidv1alpha1.Etcd) string {
+	if e.values.DeferPriorityClassNameChange && existingEtcd != nil && existingEtcd.Spec.PriorityClassName != nil {
+		return *existingEtcd.Spec.PriorityClassName
+	}
+	return e.values.PriorityClassName
+}
+
 func (e *etcd) computeDefragmentationSchedule(existingEtcd *druidv1alpha1.Etcd) *string {
 	defragmentationSchedule := e.values.DefragmentationSchedule
 	if existingEtcd != nil && existingEtcd.Spec.Etcd.DefragmentationSchedule != nil {
@@ -837,6 +890,12 @@ type BackupConfig struct {
 	LeaderElection *gardenletconfig.ETCDBackupLeaderElection
 	// DeltaSnapshotRetentionPeriod defines the duration for which delta snapshots will be retained, excluding the latest snapshot set.
 	DeltaSnapshotRetentionPeriod *metav1.Duration
+	// FullSnapshotFreshnessThreshold is the maximum age of the latest full snapshot before an alert is fired.
+	// Defaults to 24h.
+	FullSnapshotFreshnessThreshold *metav1.Duration
+	// DeltaSnapshotFreshnessThreshold is the maximum age of the latest delta snapshot before an alert is fired.
+	// Defaults to 15m.
+	DeltaSnapshotFreshnessThreshold *metav1.Duration
 }
 
 // HVPAConfig contains information for configuring the HVPA object for the etcd.
@@ -848,4 +907,10 @@ type HVPAConfig struct {
 	MaintenanceTimeWindow gardencorev1beta1.MaintenanceTimeWindow
 	// The update mode to use for scale down.
 	ScaleDownUpdateMode *string
+	// MinAllowed are the minimum resources the vertical pod autoscaler may recommend for the etcd container. The given
+	// values overwrite the defaults for the respective resources.
+	MinAllowed corev1.ResourceList
+	// MaxAllowed are the maximum resources the vertical pod autoscaler may recommend for the etcd container. The given
+	// values overwrite the defaults for the respective resources.
+	MaxAllowed corev1.ResourceList
 }
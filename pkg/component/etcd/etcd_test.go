@@ -636,6 +636,73 @@ var _ = Describe("Etcd", func() {
 			Expect(etcd.Deploy(ctx)).To(Succeed())
 		})
 
+		It("should successfully deploy (normal etcd) with configured resources", func() {
+			oldTimeNow := TimeNow
+			defer func() { TimeNow = oldTimeNow }()
+			TimeNow = func() time.Time { return now }
+
+			etcd = New(log, c, testNamespace, sm, Values{
+				Role:                    testRole,
+				Class:                   class,
+				Replicas:                replicas,
+				StorageCapacity:         storageCapacity,
+				StorageClassName:        &storageClassName,
+				DefragmentationSchedule: &defragmentationSchedule,
+				CARotationPhase:         "",
+				PriorityClassName:       priorityClassName,
+				ResourceRequests:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			})
+			etcd.SetHVPAConfig(&HVPAConfig{
+				Enabled:               true,
+				MaintenanceTimeWindow: maintenanceTimeWindow,
+				ScaleDownUpdateMode:   pointer.String(scaleDownUpdateMode),
+				MinAllowed:            corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2G")},
+				MaxAllowed:            corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")},
+			})
+
+			expectedHVPA := hvpaFor(class, 1, scaleDownUpdateMode)
+			expectedHVPA.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies[0].MinAllowed = corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("2G"),
+			}
+			expectedHVPA.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies[0].MaxAllowed = corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("8"),
+				corev1.ResourceMemory: resource.MustParse("30G"),
+			}
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(etcdObjFor(
+						class,
+						1,
+						nil,
+						"",
+						"",
+						&corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("1"),
+								corev1.ResourceMemory: resource.MustParse("1G"),
+							},
+						},
+						nil,
+						secretNameCA,
+						secretNameClient,
+						secretNameServer,
+						nil,
+						nil,
+						false)))
+				}),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, hvpaName), gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()).Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(expectedHVPA))
+				}),
+			)
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+		})
+
 		It("should not panic during deploy when etcd resource exists, but its status is not yet populated", func() {
 			oldTimeNow := TimeNow
 			defer func() { TimeNow = oldTimeNow }()
@@ -885,6 +952,148 @@ var _ = Describe("Etcd", func() {
 			Expect(etcd.Deploy(ctx)).To(Succeed())
 		})
 
+		It("should successfully deploy (normal etcd) and keep the existing priority class name if the change is deferred", func() {
+			oldTimeNow := TimeNow
+			defer func() { TimeNow = oldTimeNow }()
+			TimeNow = func() time.Time { return now }
+
+			existingPriorityClassName := "gardener-system-300"
+
+			etcd = New(log, c, testNamespace, sm, Values{
+				Role:                         testRole,
+				Class:                        class,
+				Replicas:                     replicas,
+				StorageCapacity:              storageCapacity,
+				StorageClassName:             &storageClassName,
+				DefragmentationSchedule:      &defragmentationSchedule,
+				CARotationPhase:              "",
+				PriorityClassName:            priorityClassName,
+				DeferPriorityClassNameChange: true,
+			})
+			setHVPAConfig()
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(ctx context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					(&druidv1alpha1.Etcd{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      etcdName,
+							Namespace: testNamespace,
+						},
+						Spec: druidv1alpha1.EtcdSpec{
+							PriorityClassName: &existingPriorityClassName,
+						},
+						Status: druidv1alpha1.EtcdStatus{
+							Etcd: &druidv1alpha1.CrossVersionObjectReference{
+								Name: etcdName,
+							},
+						},
+					}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+					return nil
+				}),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(ctx context.Context, obj *druidv1alpha1.Etcd, _ client.Patch, _ ...client.PatchOption) {
+					// ignore status when comparing
+					obj.Status = druidv1alpha1.EtcdStatus{}
+
+					expectedObj := etcdObjFor(
+						class,
+						1,
+						nil,
+						"",
+						"",
+						nil,
+						nil,
+						secretNameCA,
+						secretNameClient,
+						secretNameServer,
+						nil,
+						nil,
+						false)
+					expectedObj.Spec.PriorityClassName = pointer.String(existingPriorityClassName)
+
+					Expect(obj).To(DeepEqual(expectedObj))
+				}),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, hvpaName), gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()).Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(hvpaFor(class, 1, scaleDownUpdateMode)))
+				}),
+			)
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+		})
+
+		It("should successfully deploy (normal etcd) and change the existing priority class name if the change is not deferred", func() {
+			oldTimeNow := TimeNow
+			defer func() { TimeNow = oldTimeNow }()
+			TimeNow = func() time.Time { return now }
+
+			existingPriorityClassName := "gardener-system-300"
+
+			etcd = New(log, c, testNamespace, sm, Values{
+				Role:                         testRole,
+				Class:                        class,
+				Replicas:                     replicas,
+				StorageCapacity:              storageCapacity,
+				StorageClassName:             &storageClassName,
+				DefragmentationSchedule:      &defragmentationSchedule,
+				CARotationPhase:              "",
+				PriorityClassName:            priorityClassName,
+				DeferPriorityClassNameChange: false,
+			})
+			setHVPAConfig()
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(ctx context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					(&druidv1alpha1.Etcd{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      etcdName,
+							Namespace: testNamespace,
+						},
+						Spec: druidv1alpha1.EtcdSpec{
+							PriorityClassName: &existingPriorityClassName,
+						},
+						Status: druidv1alpha1.EtcdStatus{
+							Etcd: &druidv1alpha1.CrossVersionObjectReference{
+								Name: etcdName,
+							},
+						},
+					}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+					return nil
+				}),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(ctx context.Context, obj *druidv1alpha1.Etcd, _ client.Patch, _ ...client.PatchOption) {
+					// ignore status when comparing
+					obj.Status = druidv1alpha1.EtcdStatus{}
+
+					expectedObj := etcdObjFor(
+						class,
+						1,
+						nil,
+						"",
+						"",
+						nil,
+						nil,
+						secretNameCA,
+						secretNameClient,
+						secretNameServer,
+						nil,
+						nil,
+						false)
+					expectedObj.Spec.PriorityClassName = pointer.String(priorityClassName)
+
+					Expect(obj).To(DeepEqual(expectedObj))
+				}),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, hvpaName), gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()).Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(hvpaFor(class, 1, scaleDownUpdateMode)))
+				}),
+			)
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+		})
+
 		It("should successfully deploy (normal etcd) and keep the existing resource request settings (but not limits) to not interfer with HVPA controller", func() {
 			oldTimeNow := TimeNow
 			defer func() { TimeNow = oldTimeNow }()
@@ -1133,6 +1342,108 @@ var _ = Describe("Etcd", func() {
 
 				Expect(etcd.Deploy(ctx)).To(Succeed())
 			})
+
+			Context("with local storage", func() {
+				var localStorageClassName = "local-nvme"
+
+				BeforeEach(func() {
+					etcd = New(log, c, testNamespace, sm, Values{
+						Role:                    testRole,
+						Class:                   class,
+						Replicas:                replicas,
+						StorageCapacity:         storageCapacity,
+						StorageClassName:        &storageClassName,
+						DefragmentationSchedule: &defragmentationSchedule,
+						CARotationPhase:         "",
+						PriorityClassName:       priorityClassName,
+						LocalStorageClassName:   &localStorageClassName,
+					})
+					setHVPAConfig()
+					etcd.SetBackupConfig(backupConfig)
+				})
+
+				It("should place a new etcd on local storage", func() {
+					oldTimeNow := TimeNow
+					defer func() { TimeNow = oldTimeNow }()
+					TimeNow = func() time.Time { return now }
+
+					gomock.InOrder(
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+						c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+							expobj := etcdObjFor(
+								class,
+								1,
+								backupConfig,
+								"",
+								"",
+								nil,
+								nil,
+								secretNameCA,
+								secretNameClient,
+								secretNameServer,
+								nil,
+								nil,
+								false)
+							expobj.Spec.StorageClass = &localStorageClassName
+
+							Expect(obj).To(DeepEqual(expobj))
+						}),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, hvpaName), gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+						c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()),
+					)
+
+					Expect(etcd.Deploy(ctx)).To(Succeed())
+				})
+
+				It("should keep an existing etcd on network storage", func() {
+					oldTimeNow := TimeNow
+					defer func() { TimeNow = oldTimeNow }()
+					TimeNow = func() time.Time { return now }
+
+					gomock.InOrder(
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(ctx context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+							(&druidv1alpha1.Etcd{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      etcdName,
+									Namespace: testNamespace,
+								},
+								Spec: druidv1alpha1.EtcdSpec{
+									StorageClass: &storageClassName,
+								},
+							}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+							return nil
+						}),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&appsv1.StatefulSet{})),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+						c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj.(*druidv1alpha1.Etcd).Spec.StorageClass).To(Equal(&storageClassName))
+						}),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, hvpaName), gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+						c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()),
+					)
+
+					Expect(etcd.Deploy(ctx)).To(Succeed())
+				})
+
+				It("should keep a new etcd without backup on network storage", func() {
+					etcd.SetBackupConfig(nil)
+
+					gomock.InOrder(
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+						c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj.(*druidv1alpha1.Etcd).Spec.StorageClass).To(Equal(&storageClassName))
+						}),
+						c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, hvpaName), gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+						c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()),
+					)
+
+					Expect(etcd.Deploy(ctx)).To(Succeed())
+				})
+			})
 		})
 
 		Context("when HA setup is configured", func() {
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 	"text/template"
+	"time"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -34,6 +35,8 @@ const (
 
 	monitoringMetricEtcdDiskBackendCommitDurationSecondsBucket = "etcd_disk_backend_commit_duration_seconds_bucket"
 	monitoringMetricEtcdDiskWalFsyncDurationSecondsBucket      = "etcd_disk_wal_fsync_duration_seconds_bucket"
+	monitoringMetricEtcdDebuggingMvccCurrentRevision           = "etcd_debugging_mvcc_current_revision"
+	monitoringMetricEtcdDebuggingMvccCompactRevision           = "etcd_debugging_mvcc_compact_revision"
 	monitoringMetricEtcdMvccDBTotalSizeInBytes                 = "etcd_mvcc_db_total_size_in_bytes"
 	monitoringMetricEtcdMvccDBTotalSizeInUseInBytes            = "etcd_mvcc_db_total_size_in_use_in_bytes"
 	monitoringMetricEtcdNetworkClientGrpcReceivedBytesTotal    = "etcd_network_client_grpc_received_bytes_total"
@@ -91,6 +94,8 @@ const (
 	monitoringMetricProcessResidentMemoryBytes = "process_resident_memory_bytes"
 	monitoringMetricProcessCPUSecondsTotal     = "process_cpu_seconds_total"
 
+	monitoringMetricApiserverRequestTotal = "apiserver_request_total"
+
 	monitoringAlertingRules = `groups:
 - name: ` + monitoringPrometheusJobEtcdNamePrefix + `-{{ .role }}.rules
   rules:
@@ -170,6 +175,40 @@ const (
   - record: shoot:apiserver_storage_objects:sum_by_resource
     expr: max(apiserver_storage_objects) by (resource)
 
+  # etcd revision growth anomaly detection
+  - record: shoot:etcd_mvcc_current_revision:deriv5m
+    expr: max by (job) (deriv(` + monitoringMetricEtcdDebuggingMvccCurrentRevision + `{job="` + monitoringPrometheusJobEtcdNamePrefix + `-{{ .role }}"}[5m]))
+  - record: shoot:etcd_mvcc_current_revision:deriv5m:avg_over_time1d
+    expr: avg_over_time(shoot:etcd_mvcc_current_revision:deriv5m{job="` + monitoringPrometheusJobEtcdNamePrefix + `-{{ .role }}"}[1d])
+  - record: shoot:etcd_mvcc_uncompacted_revisions:max
+    expr: max by (job) (` + monitoringMetricEtcdDebuggingMvccCurrentRevision + `{job="` + monitoringPrometheusJobEtcdNamePrefix + `-{{ .role }}"} - ` + monitoringMetricEtcdDebuggingMvccCompactRevision + `{job="` + monitoringPrometheusJobEtcdNamePrefix + `-{{ .role }}"})
+  {{- if eq .role .roleMain }}
+  # events are stored in the etcd with role 'events', hence they do not contribute to the revision growth of this etcd
+  - record: shoot:apiserver_request_total:write_rate5m_by_resource
+    expr: sum by (group, resource) (rate(` + monitoringMetricApiserverRequestTotal + `{job="kube-apiserver",verb=~"POST|PUT|PATCH|DELETE|DELETECOLLECTION",resource!="events"}[5m]))
+  {{- end }}
+
+  - alert: KubeEtcd3{{ .Role }}RevisionGrowthAnomaly
+    expr: |
+      {{- if eq .role .roleMain }}
+        topk(3, shoot:apiserver_request_total:write_rate5m_by_resource)
+      and on ()
+      {{- end }}
+      (
+          shoot:etcd_mvcc_current_revision:deriv5m{job="` + monitoringPrometheusJobEtcdNamePrefix + `-{{ .role }}"} > {{ .revisionGrowthMinimumRate }}
+        and
+          shoot:etcd_mvcc_current_revision:deriv5m{job="` + monitoringPrometheusJobEtcdNamePrefix + `-{{ .role }}"} > {{ .revisionGrowthAnomalyFactor }} * shoot:etcd_mvcc_current_revision:deriv5m:avg_over_time1d{job="` + monitoringPrometheusJobEtcdNamePrefix + `-{{ .role }}"}
+      )
+    for: 15m
+    labels:
+      service: etcd
+      severity: warning
+      type: seed
+      visibility: operator
+    annotations:
+      description: The revision of etcd3 {{ .role }} grows more than {{ .revisionGrowthAnomalyFactor }} times faster than on average during the last day, which might be caused by a controller writing objects in a loop.{{ if eq .role .roleMain }} Resource {{"{{ $labels.resource }}"}} (group {{"{{ $labels.group }}"}}) is among the most frequently written resources with {{"{{ $value | humanize }}"}} writes per second.{{ end }} Etcd storage might be exhausted soon.
+      summary: Abnormal revision growth of etcd3 {{ .role }}.
+
   {{- if .backupEnabled }}
   # etcd backup failure alerts
   - alert: KubeEtcdDeltaBackupFailed
@@ -178,7 +217,7 @@ const (
                 (
                     time() - ` + monitoringMetricBackupRestoreSnapshotLatestTimestamp + `{job="` + monitoringPrometheusJobBackupRestoreNamePrefix + `-{{ .role }}",kind="Incr"}
                   > bool
-                    900
+                    {{ .deltaSnapshotThresholdSeconds }}
                 )
               *
                 etcdbr_snapshot_required{job="` + monitoringPrometheusJobBackupRestoreNamePrefix + `-{{ .role }}",kind="Incr"}
@@ -202,7 +241,7 @@ const (
                 (
                     time() - ` + monitoringMetricBackupRestoreSnapshotLatestTimestamp + `{job="` + monitoringPrometheusJobBackupRestoreNamePrefix + `-{{ .role }}",kind="Full"}
                   > bool
-                    86400
+                    {{ .fullSnapshotThresholdSeconds }}
                 )
               *
                 etcdbr_snapshot_required{job="` + monitoringPrometheusJobBackupRestoreNamePrefix + `-{{ .role }}",kind="Full"}
@@ -249,10 +288,21 @@ const (
 `
 )
 
+const (
+	// monitoringRevisionGrowthMinimumRate is the minimum number of new revisions per second before an abnormal
+	// revision growth is alerted. It prevents alerts for otherwise idle clusters.
+	monitoringRevisionGrowthMinimumRate = 50
+	// monitoringRevisionGrowthAnomalyFactor is the factor by which the revision growth must exceed its average of the
+	// last day to be considered abnormal.
+	monitoringRevisionGrowthAnomalyFactor = 3
+)
+
 var (
 	monitoringAllowedMetricsEtcd = []string{
 		monitoringMetricEtcdDiskBackendCommitDurationSecondsBucket,
 		monitoringMetricEtcdDiskWalFsyncDurationSecondsBucket,
+		monitoringMetricEtcdDebuggingMvccCurrentRevision,
+		monitoringMetricEtcdDebuggingMvccCompactRevision,
 		monitoringMetricEtcdMvccDBTotalSizeInBytes,
 		monitoringMetricEtcdMvccDBTotalSizeInUseInBytes,
 		monitoringMetricEtcdNetworkClientGrpcReceivedBytesTotal,
@@ -449,14 +499,29 @@ func (e *etcd) AlertingRules() (map[string]string, error) {
 		etcdReplicas = *e.values.Replicas
 	}
 
+	fullSnapshotThreshold, deltaSnapshotThreshold := 24*time.Hour, 15*time.Minute
+	if e.values.BackupConfig != nil {
+		if e.values.BackupConfig.FullSnapshotFreshnessThreshold != nil {
+			fullSnapshotThreshold = e.values.BackupConfig.FullSnapshotFreshnessThreshold.Duration
+		}
+		if e.values.BackupConfig.DeltaSnapshotFreshnessThreshold != nil {
+			deltaSnapshotThreshold = e.values.BackupConfig.DeltaSnapshotFreshnessThreshold.Duration
+		}
+	}
+
 	if err := monitoringAlertingRulesTemplate.Execute(&alertingRules, map[string]interface{}{
-		"role":               e.values.Role,
-		"Role":               cases.Title(language.English).String(e.values.Role),
-		"class":              e.values.Class,
-		"classImportant":     ClassImportant,
-		"backupEnabled":      e.values.BackupConfig != nil,
-		"etcdQuorumReplicas": int(etcdReplicas/2) + 1,
-		"isHA":               etcdReplicas > 1,
+		"role":                          e.values.Role,
+		"Role":                          cases.Title(language.English).String(e.values.Role),
+		"class":                         e.values.Class,
+		"classImportant":                ClassImportant,
+		"backupEnabled":                 e.values.BackupConfig != nil,
+		"fullSnapshotThresholdSeconds":  int64(fullSnapshotThreshold.Seconds()),
+		"deltaSnapshotThresholdSeconds": int64(deltaSnapshotThreshold.Seconds()),
+		"etcdQuorumReplicas":            int(etcdReplicas/2) + 1,
+		"isHA":                          etcdReplicas > 1,
+		"roleMain":                      v1beta1constants.ETCDRoleMain,
+		"revisionGrowthMinimumRate":     monitoringRevisionGrowthMinimumRate,
+		"revisionGrowthAnomalyFactor":   monitoringRevisionGrowthAnomalyFactor,
 	}); err != nil {
 		return nil, err
 	}
@@ -17,9 +17,12 @@ package etcd_test
 import (
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	. "github.com/gardener/gardener/pkg/component/etcd"
@@ -157,6 +160,25 @@ var _ = Describe("Monitoring", func() {
 				})
 			})
 		})
+
+		It("should use the configured snapshot freshness thresholds in the backup alerts", func() {
+			etcd := New(logr.Discard(), nil, testNamespace, nil, Values{
+				Role:     testRole,
+				Class:    ClassNormal,
+				Replicas: pointer.Int32(1),
+			})
+			etcd.SetBackupConfig(&BackupConfig{
+				FullSnapshotFreshnessThreshold:  &metav1.Duration{Duration: 12 * time.Hour},
+				DeltaSnapshotFreshnessThreshold: &metav1.Duration{Duration: 30 * time.Minute},
+			})
+
+			alertingRules, err := etcd.AlertingRules()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(alertingRules).To(HaveKeyWithValue(fmt.Sprintf("kube-etcd3-%s.rules.yaml", testRole), And(
+				MatchRegexp(`kind="Incr"}\s+> bool\s+1800\s`),
+				MatchRegexp(`kind="Full"}\s+> bool\s+43200\s`),
+			)))
+		})
 	})
 })
 
@@ -189,7 +211,7 @@ metric_relabel_configs:
   action: labeldrop
 - source_labels: [ __name__ ]
   action: keep
-  regex: ^(etcd_disk_backend_commit_duration_seconds_bucket|etcd_disk_wal_fsync_duration_seconds_bucket|etcd_mvcc_db_total_size_in_bytes|etcd_mvcc_db_total_size_in_use_in_bytes|etcd_network_client_grpc_received_bytes_total|etcd_network_client_grpc_sent_bytes_total|etcd_network_peer_received_bytes_total|etcd_network_peer_sent_bytes_total|etcd_network_active_peers|etcd_network_peer_round_trip_time_seconds_bucket|etcd_server_has_leader|etcd_server_is_leader|etcd_server_leader_changes_seen_total|etcd_server_is_learner|etcd_server_learner_promote_successes|etcd_server_proposals_applied_total|etcd_server_proposals_committed_total|etcd_server_proposals_failed_total|etcd_server_proposals_pending|etcd_server_heartbeat_send_failures_total|etcd_server_slow_read_indexes_total|etcd_server_slow_apply_total|grpc_server_handled_total|grpc_server_started_total|process_max_fds|process_open_fds|process_resident_memory_bytes)$`
+  regex: ^(etcd_disk_backend_commit_duration_seconds_bucket|etcd_disk_wal_fsync_duration_seconds_bucket|etcd_debugging_mvcc_current_revision|etcd_debugging_mvcc_compact_revision|etcd_mvcc_db_total_size_in_bytes|etcd_mvcc_db_total_size_in_use_in_bytes|etcd_network_client_grpc_received_bytes_total|etcd_network_client_grpc_sent_bytes_total|etcd_network_peer_received_bytes_total|etcd_network_peer_sent_bytes_total|etcd_network_active_peers|etcd_network_peer_round_trip_time_seconds_bucket|etcd_server_has_leader|etcd_server_is_leader|etcd_server_leader_changes_seen_total|etcd_server_is_learner|etcd_server_learner_promote_successes|etcd_server_proposals_applied_total|etcd_server_proposals_committed_total|etcd_server_proposals_failed_total|etcd_server_proposals_pending|etcd_server_heartbeat_send_failures_total|etcd_server_slow_read_indexes_total|etcd_server_slow_apply_total|grpc_server_handled_total|grpc_server_started_total|process_max_fds|process_open_fds|process_resident_memory_bytes)$`
 
 	expectedScrapeConfigBackupRestore = `job_name: kube-etcd3-backup-restore-` + testRole + `
 scheme: https
@@ -400,6 +422,36 @@ static_configs:
 
   - record: shoot:apiserver_storage_objects:sum_by_resource
     expr: max(apiserver_storage_objects) by (resource)
+
+  # etcd revision growth anomaly detection
+  - record: shoot:etcd_mvcc_current_revision:deriv5m
+    expr: max by (job) (deriv(etcd_debugging_mvcc_current_revision{job="kube-etcd3-` + testRole + `"}[5m]))
+  - record: shoot:etcd_mvcc_current_revision:deriv5m:avg_over_time1d
+    expr: avg_over_time(shoot:etcd_mvcc_current_revision:deriv5m{job="kube-etcd3-` + testRole + `"}[1d])
+  - record: shoot:etcd_mvcc_uncompacted_revisions:max
+    expr: max by (job) (etcd_debugging_mvcc_current_revision{job="kube-etcd3-` + testRole + `"} - etcd_debugging_mvcc_compact_revision{job="kube-etcd3-` + testRole + `"})
+  # events are stored in the etcd with role 'events', hence they do not contribute to the revision growth of this etcd
+  - record: shoot:apiserver_request_total:write_rate5m_by_resource
+    expr: sum by (group, resource) (rate(apiserver_request_total{job="kube-apiserver",verb=~"POST|PUT|PATCH|DELETE|DELETECOLLECTION",resource!="events"}[5m]))
+
+  - alert: KubeEtcd3` + testROLE + `RevisionGrowthAnomaly
+    expr: |
+        topk(3, shoot:apiserver_request_total:write_rate5m_by_resource)
+      and on ()
+      (
+          shoot:etcd_mvcc_current_revision:deriv5m{job="kube-etcd3-` + testRole + `"} > 50
+        and
+          shoot:etcd_mvcc_current_revision:deriv5m{job="kube-etcd3-` + testRole + `"} > 3 * shoot:etcd_mvcc_current_revision:deriv5m:avg_over_time1d{job="kube-etcd3-` + testRole + `"}
+      )
+    for: 15m
+    labels:
+      service: etcd
+      severity: warning
+      type: seed
+      visibility: operator
+    annotations:
+      description: The revision of etcd3 ` + testRole + ` grows more than 3 times faster than on average during the last day, which might be caused by a controller writing objects in a loop. Resource {{ $labels.resource }} (group {{ $labels.group }}) is among the most frequently written resources with {{ $value | humanize }} writes per second. Etcd storage might be exhausted soon.
+      summary: Abnormal revision growth of etcd3 ` + testRole + `.
 `
 
 	alertingRulesBackup = `  # etcd backup failure alerts
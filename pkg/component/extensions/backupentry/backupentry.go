@@ -64,6 +64,8 @@ type Values struct {
 	Region string
 	// SecretRef is a reference to a secret with the infrastructure credentials.
 	SecretRef corev1.SecretReference
+	// EncryptionSecretRef is an optional reference to a secret with a customer-supplied encryption key.
+	EncryptionSecretRef *corev1.SecretReference
 	// BucketName is the name of the bucket in which the entry shall be created.
 	BucketName string
 	// BackupBucketProviderStatus is the optional provider status of the BackupBucket.
@@ -127,6 +129,7 @@ func (b *backupEntry) deploy(ctx context.Context, operation string) (extensionsv
 			},
 			Region:                     b.values.Region,
 			SecretRef:                  b.values.SecretRef,
+			EncryptionSecretRef:        b.values.EncryptionSecretRef,
 			BucketName:                 b.values.BucketName,
 			BackupBucketProviderStatus: b.values.BackupBucketProviderStatus,
 		}
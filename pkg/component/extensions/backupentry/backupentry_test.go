@@ -71,6 +71,10 @@ var _ = Describe("#BackupEntry", func() {
 			Name:      "secretname",
 			Namespace: "secretnamespace",
 		}
+		encryptionSecretRef = &corev1.SecretReference{
+			Name:      "encryptionsecretname",
+			Namespace: "secretnamespace",
+		}
 	)
 
 	BeforeEach(func() {
@@ -94,6 +98,7 @@ var _ = Describe("#BackupEntry", func() {
 			ProviderConfig:             providerConfig,
 			Region:                     region,
 			SecretRef:                  secretRef,
+			EncryptionSecretRef:        encryptionSecretRef,
 			BucketName:                 bucketName,
 			BackupBucketProviderStatus: backupBucketProviderStatus,
 		}
@@ -123,6 +128,7 @@ var _ = Describe("#BackupEntry", func() {
 				},
 				Region:                     region,
 				SecretRef:                  secretRef,
+				EncryptionSecretRef:        encryptionSecretRef,
 				BucketName:                 bucketName,
 				BackupBucketProviderStatus: backupBucketProviderStatus,
 			},
@@ -16,6 +16,7 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -58,6 +59,10 @@ type Interface interface {
 	NodesCIDR() *string
 	// EgressCIDRs returns a list of CIDRs used as source IP by any traffic originating from the shoot's worker nodes.
 	EgressCIDRs() []string
+	// Plan updates the Infrastructure resource and requests the extension controller to compute the changes a
+	// reconciliation would perform without applying them. It waits until the plan has been computed and returns it.
+	// A nil plan is returned if the extension controller does not support planning.
+	Plan(context.Context) (*extensionsv1alpha1.InfrastructurePlan, error)
 }
 
 // Values contains the values used to create an Infrastructure resources.
@@ -136,7 +141,7 @@ func (i *infrastructure) deploy(ctx context.Context, operation string) (extensio
 	}
 
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, i.client, i.infrastructure, func() error {
-		if i.values.AnnotateOperation || i.lastOperationNotSuccessful() || i.isTimestampInvalidOrAfterLastUpdateTime() {
+		if operation == v1beta1constants.GardenerOperationPlan || i.values.AnnotateOperation || i.lastOperationNotSuccessful() || i.isTimestampInvalidOrAfterLastUpdateTime() {
 			// Check if gardener timestamp is in an invalid format or is after status.LastOperation.LastUpdateTime.
 			// If that is the case health checks for the infrastructure will fail so we request a reconciliation to correct the current state.
 			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerOperation, operation)
@@ -161,6 +166,39 @@ func (i *infrastructure) deploy(ctx context.Context, operation string) (extensio
 	return i.infrastructure, err
 }
 
+// Plan uses the seed client to update the Infrastructure resource with the plan operation annotation and waits until
+// the extension controller has computed the plan.
+func (i *infrastructure) Plan(ctx context.Context) (*extensionsv1alpha1.InfrastructurePlan, error) {
+	if _, err := i.deploy(ctx, v1beta1constants.GardenerOperationPlan); err != nil {
+		return nil, err
+	}
+
+	if err := extensions.WaitUntilObjectReadyWithHealthFunction(
+		ctx,
+		i.client,
+		i.log,
+		func(obj client.Object) error {
+			if op := obj.GetAnnotations()[v1beta1constants.GardenerOperation]; op == v1beta1constants.GardenerOperationPlan {
+				return fmt.Errorf("gardener operation %q is not yet picked up by controller", op)
+			}
+			return nil
+		},
+		i.infrastructure,
+		extensionsv1alpha1.InfrastructureResource,
+		i.waitInterval,
+		i.waitSevereThreshold,
+		i.waitTimeout,
+		func() error {
+			i.extractStatus(i.infrastructure.Status)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return i.infrastructure.Status.Plan, nil
+}
+
 // Restore uses the seed client and the ShootState to create the Infrastructure resources and restore their state.
 func (i *infrastructure) Restore(ctx context.Context, shootState *gardencorev1beta1.ShootState) error {
 	return extensions.RestoreExtensionWithDeployFunction(
@@ -32,6 +32,7 @@ import (
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -364,6 +365,63 @@ var _ = Describe("#Interface", func() {
 		})
 	})
 
+	Describe("#Plan", func() {
+		var plan *extensionsv1alpha1.InfrastructurePlan
+
+		BeforeEach(func() {
+			plan = &extensionsv1alpha1.InfrastructurePlan{
+				Changes: []extensionsv1alpha1.InfrastructurePlannedChange{
+					{Action: extensionsv1alpha1.InfrastructurePlannedActionReplace, ResourceType: "subnet", Name: "nodes"},
+				},
+			}
+		})
+
+		It("should request the plan and return an error if it was not computed", func() {
+			defer test.WithVars(
+				&infrastructure.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			deployWaiter.SetSSHPublicKey(sshPublicKey)
+			_, err := deployWaiter.Plan(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`gardener operation "plan" is not yet picked up by controller`)))
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expected), actual)).To(Succeed())
+			Expect(actual.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationPlan))
+			Expect(actual.Spec).To(Equal(expected.Spec))
+		})
+
+		It("should return the plan once it was computed and extract the status", func() {
+			defer test.WithVars(
+				&infrastructure.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			s := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+			c = fake.NewClientBuilder().WithScheme(s).WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if err := cl.Get(ctx, key, obj, opts...); err != nil {
+						return err
+					}
+					// simulate the extension controller computing the plan
+					if infra, ok := obj.(*extensionsv1alpha1.Infrastructure); ok && infra.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan {
+						delete(infra.Annotations, v1beta1constants.GardenerOperation)
+						infra.Status.Plan = plan
+						infra.Status.NodesCIDR = nodesCIDR
+					}
+					return nil
+				},
+			}).Build()
+			deployWaiter = infrastructure.New(log, c, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+
+			deployWaiter.SetSSHPublicKey(sshPublicKey)
+			Expect(deployWaiter.Plan(ctx)).To(Equal(plan))
+			Expect(deployWaiter.NodesCIDR()).To(Equal(nodesCIDR))
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should not return error when it's not found", func() {
 			Expect(deployWaiter.Destroy(ctx)).To(Succeed())
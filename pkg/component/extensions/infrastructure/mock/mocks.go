@@ -122,6 +122,21 @@ func (mr *MockInterfaceMockRecorder) NodesCIDR() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodesCIDR", reflect.TypeOf((*MockInterface)(nil).NodesCIDR))
 }
 
+// Plan mocks base method.
+func (m *MockInterface) Plan(arg0 context.Context) (*v1alpha1.InfrastructurePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0)
+	ret0, _ := ret[0].(*v1alpha1.InfrastructurePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockInterfaceMockRecorder) Plan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockInterface)(nil).Plan), arg0)
+}
+
 // ProviderStatus mocks base method.
 func (m *MockInterface) ProviderStatus() *runtime.RawExtension {
 	m.ctrl.T.Helper()
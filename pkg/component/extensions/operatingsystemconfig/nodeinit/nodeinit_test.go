@@ -50,7 +50,7 @@ var _ = Describe("Init", func() {
 
 		BeforeEach(func() {
 			worker = gardencorev1beta1.Worker{}
-			config = nodeagentcomponent.ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, oscSyncJitterPeriod, nil)
+			config = nodeagentcomponent.ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, oscSyncJitterPeriod, nil, nil)
 		})
 
 		When("kubelet data volume is not configured", func() {
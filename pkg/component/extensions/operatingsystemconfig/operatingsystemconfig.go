@@ -132,6 +132,8 @@ type OriginalValues struct {
 	ValiIngressHostName string
 	// NodeLocalDNSEnabled indicates whether node local dns is enabled or not.
 	NodeLocalDNSEnabled bool
+	// ProjectName is the name of the project the shoot belongs to.
+	ProjectName string
 	// SyncJitterPeriod is the duration of how the operating system config sync will be jittered on updates.
 	SyncJitterPeriod *metav1.Duration
 }
@@ -553,6 +555,7 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 		valiIngressHostName:     o.values.ValiIngressHostName,
 		valitailEnabled:         o.values.ValitailEnabled,
 		nodeLocalDNSEnabled:     o.values.NodeLocalDNSEnabled,
+		projectName:             o.values.ProjectName,
 		oscSyncJitterPeriod:     o.values.SyncJitterPeriod,
 	}, nil
 }
@@ -616,6 +619,7 @@ type deployer struct {
 	valiIngressHostName     string
 	valitailEnabled         bool
 	nodeLocalDNSEnabled     bool
+	projectName             string
 	oscSyncJitterPeriod     *metav1.Duration
 }
 
@@ -643,7 +647,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		initUnits, initFiles, err = InitConfigFn(
 			d.worker,
 			d.images[imagevector.ImageNameGardenerNodeAgent].String(),
-			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, d.clusterCABundle, d.oscSyncJitterPeriod, nil),
+			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, d.clusterCABundle, d.oscSyncJitterPeriod, nodeagent.RolloutConfig(d.worker.OperatingSystemConfigRollout), nil),
 		)
 		if err != nil {
 			return nil, err
@@ -677,7 +681,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			ClusterDomain:           d.clusterDomain,
 			CRIName:                 d.criName,
 			Images:                  d.images,
-			NodeLabels:              gardenerutils.NodeLabelsForWorkerPool(d.worker, d.nodeLocalDNSEnabled),
+			NodeLabels:              gardenerutils.NodeLabelsForWorkerPool(d.worker, d.nodeLocalDNSEnabled, d.projectName),
 			KubeletCABundle:         d.kubeletCABundle,
 			KubeletConfigParameters: d.kubeletConfigParameters,
 			KubeletCLIFlags:         d.kubeletCLIFlags,
@@ -690,6 +694,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			APIServerURL:            d.apiServerURL,
 			Sysctls:                 d.worker.Sysctls,
 			OSCSyncJitterPeriod:     d.oscSyncJitterPeriod,
+			OSCRollout:              nodeagent.RolloutConfig(d.worker.OperatingSystemConfigRollout),
 		})
 		if err != nil {
 			return nil, err
@@ -19,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
@@ -51,4 +52,5 @@ type Context struct {
 	APIServerURL            string
 	Sysctls                 map[string]string
 	OSCSyncJitterPeriod     *metav1.Duration
+	OSCRollout              *nodeagentv1alpha1.OperatingSystemConfigRolloutConfig
 }
@@ -55,6 +55,7 @@ type ConfigurableKubeletConfigParameters struct {
 	FeatureGates                     map[string]bool
 	ImageGCHighThresholdPercent      *int32
 	ImageGCLowThresholdPercent       *int32
+	ImageMinimumGCAge                *metav1.Duration
 	SeccompDefault                   *bool
 	SerializeImagePulls              *bool
 	StreamingConnectionIdleTimeout   *metav1.Duration
@@ -98,6 +99,7 @@ func KubeletConfigParametersFromCoreV1beta1KubeletConfig(kubeletConfig *gardenco
 		out.FailSwapOn = kubeletConfig.FailSwapOn
 		out.ImageGCHighThresholdPercent = kubeletConfig.ImageGCHighThresholdPercent
 		out.ImageGCLowThresholdPercent = kubeletConfig.ImageGCLowThresholdPercent
+		out.ImageMinimumGCAge = kubeletConfig.ImageMinimumGCAge
 		out.SeccompDefault = kubeletConfig.SeccompDefault
 		out.SerializeImagePulls = kubeletConfig.SerializeImagePulls
 		out.RegistryPullQPS = kubeletConfig.RegistryPullQPS
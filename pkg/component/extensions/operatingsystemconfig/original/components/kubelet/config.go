@@ -81,7 +81,7 @@ func Config(kubernetesVersion *semver.Version, clusterDNSAddress, clusterDomain
 		HTTPCheckFrequency:               metav1.Duration{Duration: 20 * time.Second},
 		ImageGCHighThresholdPercent:      params.ImageGCHighThresholdPercent,
 		ImageGCLowThresholdPercent:       params.ImageGCLowThresholdPercent,
-		ImageMinimumGCAge:                metav1.Duration{Duration: 2 * time.Minute},
+		ImageMinimumGCAge:                *params.ImageMinimumGCAge,
 		KubeAPIBurst:                     50,
 		KubeAPIQPS:                       pointer.Int32(50),
 		KubeReserved:                     params.KubeReserved,
@@ -236,6 +236,10 @@ func setConfigDefaults(c *components.ConfigurableKubeletConfigParameters, kubern
 		c.ImageGCLowThresholdPercent = pointer.Int32(40)
 	}
 
+	if c.ImageMinimumGCAge == nil {
+		c.ImageMinimumGCAge = &metav1.Duration{Duration: 2 * time.Minute}
+	}
+
 	if c.SerializeImagePulls == nil {
 		c.SerializeImagePulls = pointer.Bool(true)
 	}
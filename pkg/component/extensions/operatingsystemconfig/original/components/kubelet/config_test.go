@@ -49,6 +49,7 @@ var _ = Describe("Config", func() {
 			FeatureGates:                     map[string]bool{"Foo": false},
 			ImageGCHighThresholdPercent:      pointer.Int32(34),
 			ImageGCLowThresholdPercent:       pointer.Int32(12),
+			ImageMinimumGCAge:                &metav1.Duration{Duration: 5 * time.Minute},
 			ProtectKernelDefaults:            pointer.Bool(true),
 			SeccompDefault:                   pointer.Bool(true),
 			SerializeImagePulls:              pointer.Bool(true),
@@ -225,7 +226,7 @@ var _ = Describe("Config", func() {
 			HTTPCheckFrequency:               metav1.Duration{Duration: 20 * time.Second},
 			ImageGCHighThresholdPercent:      params.ImageGCHighThresholdPercent,
 			ImageGCLowThresholdPercent:       params.ImageGCLowThresholdPercent,
-			ImageMinimumGCAge:                metav1.Duration{Duration: 2 * time.Minute},
+			ImageMinimumGCAge:                *params.ImageMinimumGCAge,
 			KubeAPIBurst:                     50,
 			KubeAPIQPS:                       pointer.Int32(50),
 			KubeReserved:                     utils.MergeStringMaps(params.KubeReserved, map[string]string{"memory": "1Gi"}),
@@ -28,6 +28,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
@@ -78,7 +79,7 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		})
 	}
 
-	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, ctx.OSCSyncJitterPeriod, additionalTokenSyncConfigs))
+	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, ctx.OSCSyncJitterPeriod, ctx.OSCRollout, additionalTokenSyncConfigs))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed generating files: %w", err)
 	}
@@ -127,6 +128,7 @@ func ComponentConfig(
 	apiServerURL string,
 	caBundle []byte,
 	syncJitterPeriod *metav1.Duration,
+	rollout *nodeagentv1alpha1.OperatingSystemConfigRolloutConfig,
 	additionalTokenSyncConfigs []nodeagentv1alpha1.TokenSecretSyncConfig,
 ) *nodeagentv1alpha1.NodeAgentConfiguration {
 	return &nodeagentv1alpha1.NodeAgentConfiguration{
@@ -139,6 +141,7 @@ func ComponentConfig(
 				SecretName:        oscSecretName,
 				KubernetesVersion: kubernetesVersion,
 				SyncJitterPeriod:  syncJitterPeriod,
+				Rollout:           rollout,
 			},
 			Token: nodeagentv1alpha1.TokenControllerConfig{
 				SyncConfigs: append([]nodeagentv1alpha1.TokenSecretSyncConfig{{
@@ -154,6 +157,19 @@ func ComponentConfig(
 	}
 }
 
+// RolloutConfig returns the rollout configuration for the gardener-node-agent based on the given settings of the
+// worker pool. It returns nil if no staged rollout is configured.
+func RolloutConfig(rollout *gardencorev1beta1.OperatingSystemConfigRollout) *nodeagentv1alpha1.OperatingSystemConfigRolloutConfig {
+	if rollout == nil {
+		return nil
+	}
+
+	return &nodeagentv1alpha1.OperatingSystemConfigRolloutConfig{
+		CanaryNodes:   rollout.CanaryNodes,
+		HealthTimeout: rollout.HealthTimeout,
+	}
+}
+
 // Files returns the files related to the gardener-node-agent unit.
 func Files(config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.File, error) {
 	configRaw, err := runtime.Encode(codec, config)
@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
 	. "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
@@ -38,6 +39,7 @@ var _ = Describe("Component", func() {
 		apiServerURL               = "https://localhost"
 		caBundle                   = []byte("ca-bundle")
 		syncJitterPeriod           = &metav1.Duration{Duration: time.Second}
+		rollout                    = &nodeagentv1alpha1.OperatingSystemConfigRolloutConfig{CanaryNodes: 1, HealthTimeout: &metav1.Duration{Duration: 5 * time.Minute}}
 		additionalTokenSyncConfigs = []nodeagentv1alpha1.TokenSecretSyncConfig{{
 			SecretName: "gardener-valitail",
 			Path:       "/var/lib/valitail/auth-token",
@@ -54,7 +56,7 @@ var _ = Describe("Component", func() {
 		It("should return the expected units and files", func() {
 			key := "key"
 
-			expectedFiles, err := Files(ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, nil))
+			expectedFiles, err := Files(ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, rollout, nil))
 			Expect(err).NotTo(HaveOccurred())
 
 			units, files, err := component.Config(components.Context{
@@ -64,6 +66,7 @@ var _ = Describe("Component", func() {
 				CABundle:            pointer.String(string(caBundle)),
 				Images:              map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: "gardener-node-agent", Tag: pointer.String("v1")}},
 				OSCSyncJitterPeriod: syncJitterPeriod,
+				OSCRollout:          rollout,
 			})
 
 			expectedFiles = append(expectedFiles, extensionsv1alpha1.File{
@@ -120,7 +123,7 @@ WantedBy=multi-user.target`))
 
 	Describe("#ComponentConfig", func() {
 		It("should return the expected result", func() {
-			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, additionalTokenSyncConfigs)).To(Equal(&nodeagentv1alpha1.NodeAgentConfiguration{
+			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, rollout, additionalTokenSyncConfigs)).To(Equal(&nodeagentv1alpha1.NodeAgentConfiguration{
 				APIServer: nodeagentv1alpha1.APIServer{
 					Server:   apiServerURL,
 					CABundle: caBundle,
@@ -130,6 +133,7 @@ WantedBy=multi-user.target`))
 						SecretName:        oscSecretName,
 						KubernetesVersion: kubernetesVersion,
 						SyncJitterPeriod:  syncJitterPeriod,
+						Rollout:           rollout,
 					},
 					Token: nodeagentv1alpha1.TokenControllerConfig{
 						SyncConfigs: []nodeagentv1alpha1.TokenSecretSyncConfig{
@@ -149,9 +153,25 @@ WantedBy=multi-user.target`))
 		})
 	})
 
+	Describe("#RolloutConfig", func() {
+		It("should return nil if no rollout is configured", func() {
+			Expect(RolloutConfig(nil)).To(BeNil())
+		})
+
+		It("should return the expected result", func() {
+			Expect(RolloutConfig(&gardencorev1beta1.OperatingSystemConfigRollout{
+				CanaryNodes:   2,
+				HealthTimeout: &metav1.Duration{Duration: time.Minute},
+			})).To(Equal(&nodeagentv1alpha1.OperatingSystemConfigRolloutConfig{
+				CanaryNodes:   2,
+				HealthTimeout: &metav1.Duration{Duration: time.Minute},
+			}))
+		})
+	})
+
 	Describe("#Files", func() {
 		It("should return the expected files", func() {
-			config := ComponentConfig(oscSecretName, nil, apiServerURL, caBundle, syncJitterPeriod, additionalTokenSyncConfigs)
+			config := ComponentConfig(oscSecretName, nil, apiServerURL, caBundle, syncJitterPeriod, nil, additionalTokenSyncConfigs)
 
 			Expect(Files(config)).To(ConsistOf(extensionsv1alpha1.File{
 				Path:        "/var/lib/gardener-node-agent/config.yaml",
@@ -83,6 +83,11 @@ func RBACResourcesData(secretNames []string) (map[string][]byte, error) {
 					Resources: []string{"leases"},
 					Verbs:     []string{"get", "list", "watch", "create", "update"},
 				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods"},
+					Verbs:     []string{"get", "list"},
+				},
 			},
 		}
 
@@ -109,6 +109,13 @@ rules:
   - watch
   - create
   - update
+- apiGroups:
+  - ""
+  resources:
+  - pods
+  verbs:
+  - get
+  - list
 `
 
 			roleBindingYAML = `apiVersion: rbac.authorization.k8s.io/v1
@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -30,7 +31,9 @@ import (
 )
 
 // OperatingSystemConfigSecret returns a Kubernetes secret object containing the OperatingSystemConfig that
-// gardener-node-agent will read and reconcile on the worker machines.
+// gardener-node-agent will read and reconcile on the worker machines. Files whose content is referenced via a secret
+// are not inlined into the OperatingSystemConfig. Instead, the referenced data is copied into dedicated secrets which
+// are returned as well and which gardener-node-agent reads when it applies the files.
 func OperatingSystemConfigSecret(
 	ctx context.Context,
 	seedClient client.Client,
@@ -39,6 +42,7 @@ func OperatingSystemConfigSecret(
 	workerPoolName string,
 ) (
 	*corev1.Secret,
+	[]*corev1.Secret,
 	error,
 ) {
 	operatingSystemConfig := &extensionsv1alpha1.OperatingSystemConfig{
@@ -47,13 +51,19 @@ func OperatingSystemConfigSecret(
 			Labels:      osc.Labels,
 			Annotations: osc.Annotations,
 		},
-		Spec:   osc.Spec,
+		Spec:   *osc.Spec.DeepCopy(),
 		Status: osc.Status,
 	}
 
 	// The OperatingSystemConfig will be deployed to the shoot to get processed by gardener-node-agent. It doesn't
-	// have access to the referenced secrets (stored in the shoot namespace in the seed), hence we have to translate
-	// all references into inline content.
+	// have access to the referenced secrets (stored in the shoot namespace in the seed), hence we copy the referenced
+	// data into secrets in the shoot and rewrite the references accordingly. This way, sensitive content is neither
+	// part of the OperatingSystemConfig secret nor of the user data of the machines.
+	var (
+		fileContentSecrets     []*corev1.Secret
+		fileContentSecretNames = sets.New[string]()
+	)
+
 	for i, file := range operatingSystemConfig.Spec.Files {
 		if file.Content.SecretRef == nil {
 			continue
@@ -61,19 +71,24 @@ func OperatingSystemConfigSecret(
 
 		secret := &corev1.Secret{}
 		if err := seedClient.Get(ctx, client.ObjectKey{Name: file.Content.SecretRef.Name, Namespace: osc.Namespace}, secret); err != nil {
-			return nil, fmt.Errorf("cannot resolve secret ref from osc: %w", err)
+			return nil, nil, fmt.Errorf("cannot resolve secret ref from osc: %w", err)
+		}
+
+		fileContentSecret := fileContentSecretFor(secretName, workerPoolName, file.Content.SecretRef, secret.Data[file.Content.SecretRef.DataKey])
+		operatingSystemConfig.Spec.Files[i].Content.SecretRef = &extensionsv1alpha1.FileContentSecretRef{
+			Name:    fileContentSecret.Name,
+			DataKey: file.Content.SecretRef.DataKey,
 		}
 
-		operatingSystemConfig.Spec.Files[i].Content.SecretRef = nil
-		operatingSystemConfig.Spec.Files[i].Content.Inline = &extensionsv1alpha1.FileContentInline{
-			Encoding: "b64",
-			Data:     utils.EncodeBase64(secret.Data[file.Content.SecretRef.DataKey]),
+		if !fileContentSecretNames.Has(fileContentSecret.Name) {
+			fileContentSecretNames.Insert(fileContentSecret.Name)
+			fileContentSecrets = append(fileContentSecrets, fileContentSecret)
 		}
 	}
 
 	operatingSystemConfigRaw, err := runtime.Encode(codec, operatingSystemConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed encoding OperatingSystemConfig: %w", err)
+		return nil, nil, fmt.Errorf("failed encoding OperatingSystemConfig: %w", err)
 	}
 
 	return &corev1.Secret{
@@ -89,5 +104,22 @@ func OperatingSystemConfigSecret(
 			},
 		},
 		Data: map[string][]byte{nodeagentv1alpha1.DataKeyOperatingSystemConfig: operatingSystemConfigRaw},
-	}, nil
+	}, fileContentSecrets, nil
+}
+
+// fileContentSecretFor returns a secret in the shoot containing the data of a file referenced by the
+// OperatingSystemConfig. The name contains a hash of the data, so that a change of the content results in a new
+// reference and gardener-node-agent detects that it has to write the file again.
+func fileContentSecretFor(oscSecretName, workerPoolName string, secretRef *extensionsv1alpha1.FileContentSecretRef, data []byte) *corev1.Secret {
+	checksum := utils.ComputeSHA256Hex(append([]byte(secretRef.Name+"/"+secretRef.DataKey+"/"), data...))
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      oscSecretName + "-file-" + checksum[:8],
+			Namespace: metav1.NamespaceSystem,
+			Labels:    map[string]string{v1beta1constants.LabelWorkerPool: workerPoolName},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{secretRef.DataKey: data},
+	}
 }
@@ -38,11 +38,12 @@ var _ = Describe("Secrets", func() {
 			secretName     = "secret-name"
 			workerPoolName = "worker-pool-name"
 
-			namespace         = "namespace"
-			fileSecret        *corev1.Secret
-			fileSecretDataKey = "foo"
-			fileSecretContent = []byte("bar")
-			osc               *extensionsv1alpha1.OperatingSystemConfig
+			namespace             = "namespace"
+			fileSecret            *corev1.Secret
+			fileSecretDataKey     = "foo"
+			fileSecretContent     = []byte("bar")
+			fileContentSecretName = secretName + "-file-" + utils.ComputeSHA256Hex([]byte("file-secret/foo/bar"))[:8]
+			osc                   *extensionsv1alpha1.OperatingSystemConfig
 		)
 
 		BeforeEach(func() {
@@ -93,15 +94,24 @@ var _ = Describe("Secrets", func() {
 			}
 		})
 
-		It("should generate the expected secret", func() {
-			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+		It("should generate the expected secrets", func() {
+			secret, fileContentSecrets, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(fileContentSecrets).To(ConsistOf(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fileContentSecretName,
+					Namespace: "kube-system",
+					Labels:    map[string]string{"worker.gardener.cloud/pool": workerPoolName},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{fileSecretDataKey: fileSecretContent},
+			}))
 			Expect(secret).To(Equal(&corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      secretName,
 					Namespace: "kube-system",
 					Annotations: map[string]string{
-						"checksum/data-script": "b0a0d190d45f0d67d97bf30d7e45d9cbbaa86bbe99f34bc095a6fd172d1a18a2",
+						"checksum/data-script": "03f472253698b265b2452bb5ffee0326077ba312075e35f824ad4a1b99e404dc",
 					},
 					Labels: map[string]string{
 						"gardener.cloud/role":        "operating-system-config",
@@ -120,9 +130,9 @@ metadata:
 spec:
   files:
   - content:
-      inline:
-        data: ` + utils.EncodeBase64(fileSecretContent) + `
-        encoding: b64
+      secretRef:
+        dataKey: ` + fileSecretDataKey + `
+        name: ` + fileContentSecretName + `
     path: /some/path
   purpose: ""
   type: ""
@@ -146,9 +156,27 @@ status:
 				},
 			})
 
-			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+			secret, fileContentSecrets, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
 			Expect(err).To(MatchError(ContainSubstring(`cannot resolve secret ref from osc: secrets "non-existing" not found`)))
 			Expect(secret).To(BeNil())
+			Expect(fileContentSecrets).To(BeNil())
+		})
+
+		It("should generate one secret when multiple files reference the same data", func() {
+			osc.Spec.Files = append(osc.Spec.Files, extensionsv1alpha1.File{
+				Path:    "/some/other/path",
+				Content: *osc.Spec.Files[0].Content.DeepCopy(),
+			})
+
+			_, fileContentSecrets, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileContentSecrets).To(HaveLen(1))
+		})
+
+		It("should not modify the passed OperatingSystemConfig", func() {
+			_, _, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(osc.Spec.Files[0].Content.SecretRef.Name).To(Equal(fileSecret.Name))
 		})
 	})
 })
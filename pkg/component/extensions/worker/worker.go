@@ -23,6 +23,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +36,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
@@ -88,6 +90,8 @@ type Values struct {
 	WorkerNameToOperatingSystemConfigsMap map[string]*operatingsystemconfig.OperatingSystemConfigs
 	// NodeLocalDNSEnabled indicates whether node local dns is enabled or not.
 	NodeLocalDNSEnabled bool
+	// ProjectName is the name of the project the shoot belongs to.
+	ProjectName string
 }
 
 // New creates a new instance of Interface.
@@ -187,19 +191,30 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 
 		nodeTemplate, machineType := w.findNodeTemplateAndMachineTypeByPoolName(obj, workerPool.Name)
 
-		if nodeTemplate == nil || machineType != workerPool.Machine.Type {
-			// initializing nodeTemplate by fetching details from cloudprofile, if present there
-			if machineDetails := v1beta1helper.FindMachineTypeByName(w.values.MachineTypes, workerPool.Machine.Type); machineDetails != nil {
-				nodeTemplate = &extensionsv1alpha1.NodeTemplate{
-					Capacity: corev1.ResourceList{
-						corev1.ResourceCPU:    machineDetails.CPU,
-						"gpu":                 machineDetails.GPU,
-						corev1.ResourceMemory: machineDetails.Memory,
-					},
-				}
-			} else {
-				nodeTemplate = nil
+		// The nodeTemplate is always computed from the cloudprofile if the machine type is present there, so that changes
+		// to the machine type capabilities are reflected without requiring a change of the worker pool. Otherwise, an
+		// existing nodeTemplate is only kept as long as the machine type of the worker pool does not change.
+		if machineDetails := v1beta1helper.FindMachineTypeByName(w.values.MachineTypes, workerPool.Machine.Type); machineDetails != nil {
+			// The requirements are validated when the shoot is admitted, however, the capabilities of the machine type might
+			// have been changed in the cloudprofile since then.
+			if unsatisfied := v1beta1helper.UnsatisfiedMachineTypeRequirements(workerPool.Machine.Requirements, *machineDetails); len(unsatisfied) > 0 {
+				return nil, fmt.Errorf("machine type %q of worker pool %q does not satisfy the requirements %v", workerPool.Machine.Type, workerPool.Name, unsatisfied)
 			}
+			nodeTemplate = computeNodeTemplate(*machineDetails, workerPool.Volume)
+		} else if machineType != workerPool.Machine.Type {
+			nodeTemplate = nil
+		}
+		nodeTemplate = addTopologyLabelsToNodeTemplate(nodeTemplate, gardenerutils.TopologyLabelsForWorkerPool(workerPool, w.values.ProjectName))
+
+		// The maximum node provision time of the worker pool is handed over to the machine-controller-manager as machine
+		// creation timeout, so that it is reflected in the machine deployments independent of the provider extension.
+		machineControllerManagerSettings := workerPool.MachineControllerManagerSettings
+		if workerPool.MaxNodeProvisionTime != nil {
+			machineControllerManagerSettings = machineControllerManagerSettings.DeepCopy()
+			if machineControllerManagerSettings == nil {
+				machineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{}
+			}
+			machineControllerManagerSettings.MachineCreationTimeout = workerPool.MaxNodeProvisionTime
 		}
 
 		pools = append(pools, extensionsv1alpha1.WorkerPool{
@@ -209,8 +224,8 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			MaxSurge:       *workerPool.MaxSurge,
 			MaxUnavailable: *workerPool.MaxUnavailable,
 			Annotations:    workerPool.Annotations,
-			Labels:         gardenerutils.NodeLabelsForWorkerPool(workerPool, w.values.NodeLocalDNSEnabled),
-			Taints:         workerPool.Taints,
+			Labels:         gardenerutils.NodeLabelsForWorkerPool(workerPool, w.values.NodeLocalDNSEnabled, w.values.ProjectName),
+			Taints:         gardenerutils.NodeTaintsForWorkerPool(workerPool),
 			MachineType:    workerPool.Machine.Type,
 			MachineImage: extensionsv1alpha1.MachineImage{
 				Name:    workerPool.Machine.Image.Name,
@@ -224,8 +239,9 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			KubeletDataVolumeName:            workerPool.KubeletDataVolumeName,
 			KubernetesVersion:                &workerPoolKubernetesVersion,
 			Zones:                            workerPool.Zones,
-			MachineControllerManagerSettings: workerPool.MachineControllerManagerSettings,
+			MachineControllerManagerSettings: machineControllerManagerSettings,
 			Architecture:                     workerPool.Machine.Architecture,
+			ZoneRebalancing:                  workerPool.ZoneRebalancing,
 		})
 	}
 
@@ -395,6 +411,65 @@ func (w *worker) MachineDeployments() []extensionsv1alpha1.MachineDeployment {
 	return w.machineDeployments
 }
 
+// computeNodeTemplate computes the nodeTemplate which is used by the cluster-autoscaler when scaling a worker pool from
+// zero based on the capabilities of the given machine type.
+// addTopologyLabelsToNodeTemplate returns a copy of the given nodeTemplate with the given topology labels, so that the
+// cluster-autoscaler considers them when scaling the worker pool from zero. Topology labels which are no longer desired
+// are removed from the copy.
+func addTopologyLabelsToNodeTemplate(nodeTemplate *extensionsv1alpha1.NodeTemplate, topologyLabels map[string]string) *extensionsv1alpha1.NodeTemplate {
+	if nodeTemplate == nil {
+		return nil
+	}
+
+	nodeTemplate = nodeTemplate.DeepCopy()
+	delete(nodeTemplate.Labels, corev1.LabelTopologyZone)
+	delete(nodeTemplate.Labels, v1beta1constants.LabelTopologyZoneGroup)
+	delete(nodeTemplate.Labels, v1beta1constants.LabelTopologyDedicatedToProject)
+	nodeTemplate.Labels = utils.MergeStringMaps(nodeTemplate.Labels, topologyLabels)
+	if len(nodeTemplate.Labels) == 0 {
+		nodeTemplate.Labels = nil
+	}
+
+	return nodeTemplate
+}
+
+func computeNodeTemplate(machineType gardencorev1beta1.MachineType, volume *gardencorev1beta1.Volume) *extensionsv1alpha1.NodeTemplate {
+	gpuResourceName := corev1.ResourceName("gpu")
+	if machineType.GPUResourceName != nil {
+		gpuResourceName = corev1.ResourceName(*machineType.GPUResourceName)
+	}
+
+	nodeTemplate := &extensionsv1alpha1.NodeTemplate{
+		Capacity: corev1.ResourceList{
+			corev1.ResourceCPU:    machineType.CPU,
+			gpuResourceName:       machineType.GPU,
+			corev1.ResourceMemory: machineType.Memory,
+		},
+	}
+
+	// The root volume configured for the worker pool takes precedence over the storage of the machine type.
+	if volume != nil {
+		if volumeSize, err := resource.ParseQuantity(volume.VolumeSize); err == nil {
+			nodeTemplate.Capacity[corev1.ResourceEphemeralStorage] = volumeSize
+		}
+	} else if machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+		nodeTemplate.Capacity[corev1.ResourceEphemeralStorage] = *machineType.Storage.StorageSize
+	}
+
+	for resourceName, quantity := range machineType.ExtendedResources {
+		nodeTemplate.Capacity[resourceName] = quantity
+	}
+
+	if len(machineType.NodeLabels) > 0 {
+		nodeTemplate.Labels = make(map[string]string, len(machineType.NodeLabels))
+		for key, value := range machineType.NodeLabels {
+			nodeTemplate.Labels[key] = value
+		}
+	}
+
+	return nodeTemplate
+}
+
 func (w *worker) findNodeTemplateAndMachineTypeByPoolName(obj *extensionsv1alpha1.Worker, poolName string) (*extensionsv1alpha1.NodeTemplate, string) {
 	for _, pool := range obj.Spec.Pools {
 		if pool.Name == poolName {
@@ -99,16 +99,19 @@ var _ = Describe("Worker", func() {
 		worker1Zone2                          = "worker1zone1"
 		worker1Arch                           = pointer.String("amd64")
 
-		worker2Name                      = "worker2"
-		worker2Minimum             int32 = 5
-		worker2Maximum             int32 = 6
-		worker2MaxSurge                  = intstr.FromInt32(7)
-		worker2MaxUnavailable            = intstr.FromInt32(8)
-		worker2MachineType               = "worker2machinetype"
-		worker2MachineImageName          = "worker2machineimage"
-		worker2MachineImageVersion       = "worker2machineimagev1"
-		worker2UserData                  = []byte("bootstrap-me-now")
-		worker2Arch                      = pointer.String("arm64")
+		worker2Name                         = "worker2"
+		worker2Minimum                int32 = 5
+		worker2Maximum                int32 = 6
+		worker2MaxSurge                     = intstr.FromInt32(7)
+		worker2MaxUnavailable               = intstr.FromInt32(8)
+		worker2MachineType                  = "worker2machinetype"
+		worker2MachineImageName             = "worker2machineimage"
+		worker2MachineImageVersion          = "worker2machineimagev1"
+		worker2UserData                     = []byte("bootstrap-me-now")
+		worker2Arch                         = pointer.String("arm64")
+		worker2MachineTypeStorageSize       = resource.MustParse("100Gi")
+		worker2MaxNodeProvisionTime         = &metav1.Duration{Duration: 15 * time.Minute}
+		worker2ZoneRebalancing              = &gardencorev1beta1.WorkerZoneRebalancing{FailureThreshold: pointer.Int32(3), RecoveryPeriod: &metav1.Duration{Duration: 30 * time.Minute}}
 
 		machineTypes = []gardencorev1beta1.MachineType{
 			{
@@ -118,27 +121,37 @@ var _ = Describe("Worker", func() {
 				Memory: resource.MustParse("256Gi"),
 			},
 			{
-				Name:   worker2MachineType,
-				CPU:    resource.MustParse("16"),
-				GPU:    resource.MustParse("0"),
-				Memory: resource.MustParse("512Gi"),
+				Name:            worker2MachineType,
+				CPU:             resource.MustParse("16"),
+				GPU:             resource.MustParse("2"),
+				Memory:          resource.MustParse("512Gi"),
+				Storage:         &gardencorev1beta1.MachineTypeStorage{StorageSize: &worker2MachineTypeStorageSize},
+				GPUResourceName: pointer.String("nvidia.com/gpu"),
+				ExtendedResources: corev1.ResourceList{
+					"example.com/dongle": resource.MustParse("1"),
+				},
+				NodeLabels: map[string]string{"example.com/gpu-model": "a100"},
 			},
 		}
 
 		workerPool1NodeTemplate = &extensionsv1alpha1.NodeTemplate{
 			Capacity: corev1.ResourceList{
-				"cpu":    machineTypes[0].CPU,
-				"gpu":    machineTypes[0].GPU,
-				"memory": machineTypes[0].Memory,
+				"cpu":               machineTypes[0].CPU,
+				"gpu":               machineTypes[0].GPU,
+				"memory":            machineTypes[0].Memory,
+				"ephemeral-storage": resource.MustParse(worker1VolumeSize),
 			},
 		}
 
 		workerPool2NodeTemplate = &extensionsv1alpha1.NodeTemplate{
 			Capacity: corev1.ResourceList{
-				"cpu":    machineTypes[1].CPU,
-				"gpu":    machineTypes[1].GPU,
-				"memory": machineTypes[1].Memory,
+				"cpu":                machineTypes[1].CPU,
+				"nvidia.com/gpu":     machineTypes[1].GPU,
+				"memory":             machineTypes[1].Memory,
+				"ephemeral-storage":  *machineTypes[1].Storage.StorageSize,
+				"example.com/dongle": machineTypes[1].ExtendedResources["example.com/dongle"],
 			},
+			Labels: machineTypes[1].NodeLabels,
 		}
 
 		w, empty *extensionsv1alpha1.Worker
@@ -238,6 +251,8 @@ var _ = Describe("Worker", func() {
 					Kubernetes: &gardencorev1beta1.WorkerKubernetes{
 						Version: &workerKubernetesVersion,
 					},
+					MaxNodeProvisionTime: worker2MaxNodeProvisionTime,
+					ZoneRebalancing:      worker2ZoneRebalancing,
 				},
 			},
 		}
@@ -334,6 +349,10 @@ var _ = Describe("Worker", func() {
 					UserData:          worker2UserData,
 					NodeTemplate:      workerPool2NodeTemplate,
 					Architecture:      worker2Arch,
+					MachineControllerManagerSettings: &gardencorev1beta1.MachineControllerManagerSettings{
+						MachineCreationTimeout: worker2MaxNodeProvisionTime,
+					},
+					ZoneRebalancing: worker2ZoneRebalancing,
 				},
 			},
 		}
@@ -375,6 +394,20 @@ var _ = Describe("Worker", func() {
 				Spec: wSpec,
 			}))
 		})
+
+		It("should fail if the machine type does not satisfy the requirements of the worker pool", func() {
+			newValues := *values
+			newValues.Workers = []gardencorev1beta1.Worker{*values.Workers[1].DeepCopy()}
+			newValues.Workers[0].Machine.Requirements = &gardencorev1beta1.MachineRequirements{
+				GPU:                  pointer.Bool(true),
+				NestedVirtualization: pointer.Bool(true),
+			}
+			newValues.MachineTypes = machineTypes
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(MatchError(ContainSubstring("does not satisfy the requirements [nestedVirtualization]")))
+		})
+
 		It("should initialize nodeTemplate when it exists for pool in worker resource, but absent in cloudProfile", func() {
 			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
 			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
@@ -423,6 +456,44 @@ var _ = Describe("Worker", func() {
 			}))
 		})
 
+		It("should update nodeTemplate from cloudProfile, when machineType capabilities changed without worker pool change", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			newValues := *values
+			newValues.Workers = []gardencorev1beta1.Worker{
+				values.Workers[1],
+			}
+			newValues.MachineTypes = machineTypes
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools = []extensionsv1alpha1.WorkerPool{
+				wSpec.Pools[1],
+			}
+
+			existingWorker := w.DeepCopy()
+			existingWorker.Spec.Pools = []extensionsv1alpha1.WorkerPool{
+				wSpec.Pools[1],
+			}
+			existingWorker.Spec.Pools[0].NodeTemplate = &extensionsv1alpha1.NodeTemplate{
+				Capacity: corev1.ResourceList{
+					"cpu":    machineTypes[1].CPU,
+					"gpu":    resource.MustParse("0"),
+					"memory": machineTypes[1].Memory,
+				},
+			}
+
+			Expect(c.Create(ctx, existingWorker)).To(Succeed(), "creating worker succeeds")
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+
+			Expect(obj.Spec).To(DeepEqual(*expectedWorkerSpec))
+		})
+
 		It("should initialize nodeTemplate from cloudProfile, when machineType updated for worker pool", func() {
 			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
 			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
@@ -470,6 +541,106 @@ var _ = Describe("Worker", func() {
 			}))
 		})
 
+		It("should add the topology labels to the node labels and the nodeTemplate", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			newValues := *values
+			newValues.ProjectName = "dev"
+			newValues.Workers = []gardencorev1beta1.Worker{
+				*values.Workers[1].DeepCopy(),
+			}
+			newValues.Workers[0].Zones = []string{"zone-a"}
+			newValues.Workers[0].Topology = &gardencorev1beta1.WorkerTopology{
+				ZoneGroup:          pointer.String("group-a"),
+				DedicatedToProject: pointer.Bool(true),
+			}
+
+			topologyLabels := map[string]string{
+				"topology.kubernetes.io/zone":                  "zone-a",
+				"topology.gardener.cloud/zone-group":           "group-a",
+				"topology.gardener.cloud/dedicated-to-project": "dev",
+			}
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools = []extensionsv1alpha1.WorkerPool{
+				*wSpec.Pools[1].DeepCopy(),
+			}
+			expectedWorkerSpec.Pools[0].Zones = []string{"zone-a"}
+			expectedWorkerSpec.Pools[0].Labels = utils.MergeStringMaps(expectedWorkerSpec.Pools[0].Labels, topologyLabels)
+			expectedWorkerSpec.Pools[0].NodeTemplate.Labels = utils.MergeStringMaps(machineTypes[1].NodeLabels, topologyLabels)
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+			Expect(obj.Spec).To(DeepEqual(*expectedWorkerSpec))
+		})
+
+		It("should taint the nodes of worker pools dedicated to system components", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			newValues := *values
+			newValues.Workers = []gardencorev1beta1.Worker{
+				*values.Workers[0].DeepCopy(),
+			}
+			newValues.Workers[0].SystemComponents = &gardencorev1beta1.WorkerSystemComponents{Allow: true, Only: pointer.Bool(true)}
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools = []extensionsv1alpha1.WorkerPool{
+				*wSpec.Pools[0].DeepCopy(),
+			}
+			expectedWorkerSpec.Pools[0].Labels = utils.MergeStringMaps(expectedWorkerSpec.Pools[0].Labels, map[string]string{
+				"worker.gardener.cloud/system-components": "true",
+			})
+			expectedWorkerSpec.Pools[0].Taints = append(expectedWorkerSpec.Pools[0].Taints, corev1.Taint{
+				Key:    "worker.gardener.cloud/system-components-only",
+				Value:  "true",
+				Effect: corev1.TaintEffectNoSchedule,
+			})
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+			Expect(obj.Spec).To(DeepEqual(*expectedWorkerSpec))
+		})
+
+		It("should remove outdated topology labels from an existing nodeTemplate", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			newValues := *values
+			newValues.Workers = []gardencorev1beta1.Worker{
+				values.Workers[1],
+			}
+			newValues.MachineTypes = []gardencorev1beta1.MachineType{}
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools = []extensionsv1alpha1.WorkerPool{
+				wSpec.Pools[1],
+			}
+
+			existingWorker := w.DeepCopy()
+			existingWorker.Spec.Pools = []extensionsv1alpha1.WorkerPool{
+				*wSpec.Pools[1].DeepCopy(),
+			}
+			existingWorker.Spec.Pools[0].NodeTemplate.Labels = utils.MergeStringMaps(machineTypes[1].NodeLabels, map[string]string{
+				"topology.gardener.cloud/zone-group": "group-a",
+			})
+
+			Expect(c.Create(ctx, existingWorker)).To(Succeed(), "creating worker succeeds")
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+			Expect(obj.Spec).To(DeepEqual(*expectedWorkerSpec))
+		})
 	})
 
 	Describe("#Wait", func() {
@@ -70,6 +70,26 @@ type Values struct {
 	LogFormat string
 	// TopologyAwareRoutingEnabled specifies where the topology-aware feature is enabled.
 	TopologyAwareRoutingEnabled bool
+	// MinAllowed are the minimum resources the vertical pod autoscaler may recommend for the gardener-apiserver. The
+	// given values overwrite the defaults for the respective resources.
+	MinAllowed corev1.ResourceList
+	// MaxAllowed are the maximum resources the vertical pod autoscaler may recommend for the gardener-apiserver. The
+	// given values overwrite the defaults for the respective resources.
+	MaxAllowed corev1.ResourceList
+	// HorizontalAutoscaling contains the configuration for scaling the gardener-apiserver horizontally based on its
+	// request rate. If nil, no request-based horizontal autoscaling is configured.
+	HorizontalAutoscaling *HorizontalAutoscalingConfig
+}
+
+// HorizontalAutoscalingConfig contains the configuration for scaling the gardener-apiserver horizontally based on its
+// request rate.
+type HorizontalAutoscalingConfig struct {
+	// MinReplicas is the minimum number of replicas.
+	MinReplicas int32
+	// MaxReplicas is the maximum number of replicas.
+	MaxReplicas int32
+	// TargetRequestsPerReplica is the average number of requests per second a single replica should serve.
+	TargetRequestsPerReplica int32
 }
 
 // New creates a new instance of DeployWaiter for the gardener-apiserver.
@@ -153,6 +173,7 @@ func (g *gardenerAPIServer) Deploy(ctx context.Context) error {
 		g.podDisruptionBudget(),
 		g.serviceRuntime(),
 		g.verticalPodAutoscaler(),
+		g.horizontalPodAutoscaler(),
 		g.hvpa(),
 		g.deployment(secretCAETCD, secretETCDClient, secretGenericTokenKubeconfig, secretServer, secretAdmissionKubeconfigs, secretETCDEncryptionConfiguration, secretAuditWebhookKubeconfig, secretVirtualGardenAccess, configMapAuditPolicy, configMapAdmissionConfigs),
 	)
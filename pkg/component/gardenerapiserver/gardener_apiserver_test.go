@@ -23,6 +23,7 @@ import (
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
@@ -1390,6 +1391,12 @@ kubeConfigFile: /etc/kubernetes/admission-kubeconfigs/validatingadmissionwebhook
 			})
 
 			Context("resources generation", func() {
+				var expectedRuntimeResourcesCount int
+
+				BeforeEach(func() {
+					expectedRuntimeResourcesCount = 4
+				})
+
 				JustBeforeEach(func() {
 					Expect(deployer.Deploy(ctx)).To(Succeed())
 
@@ -1444,7 +1451,7 @@ kubeConfigFile: /etc/kubernetes/admission-kubeconfigs/validatingadmissionwebhook
 					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretVirtual), managedResourceSecretVirtual)).To(Succeed())
 
 					Expect(managedResourceSecretRuntime.Type).To(Equal(corev1.SecretTypeOpaque))
-					Expect(managedResourceSecretRuntime.Data).To(HaveLen(4))
+					Expect(managedResourceSecretRuntime.Data).To(HaveLen(expectedRuntimeResourcesCount))
 					Expect(string(managedResourceSecretRuntime.Data["poddisruptionbudget__some-namespace__gardener-apiserver.yaml"])).To(Equal(componenttest.Serialize(podDisruptionBudget)))
 					Expect(string(managedResourceSecretRuntime.Data["service__some-namespace__gardener-apiserver.yaml"])).To(Equal(componenttest.Serialize(serviceRuntime)))
 					Expect(string(managedResourceSecretRuntime.Data["deployment__some-namespace__gardener-apiserver.yaml"])).To(Equal(componenttest.Serialize(deployment)))
@@ -1476,6 +1483,59 @@ kubeConfigFile: /etc/kubernetes/admission-kubeconfigs/validatingadmissionwebhook
 					It("should successfully deploy all resources", func() {
 						Expect(string(managedResourceSecretRuntime.Data["verticalpodautoscaler__some-namespace__gardener-apiserver-vpa.yaml"])).To(Equal(componenttest.Serialize(vpa)))
 					})
+
+					Context("when resources and horizontal autoscaling are configured", func() {
+						BeforeEach(func() {
+							expectedRuntimeResourcesCount = 5
+
+							values.MinAllowed = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+							values.MaxAllowed = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}
+							values.HorizontalAutoscaling = &HorizontalAutoscalingConfig{
+								MinReplicas:              2,
+								MaxReplicas:              6,
+								TargetRequestsPerReplica: 300,
+							}
+							deployer = New(fakeClient, namespace, fakeSecretManager, values)
+						})
+
+						It("should successfully deploy all resources", func() {
+							vpa.Spec.ResourcePolicy.ContainerPolicies[0].MinAllowed = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+							vpa.Spec.ResourcePolicy.ContainerPolicies[0].MaxAllowed = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}
+
+							hpa := &autoscalingv2.HorizontalPodAutoscaler{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      "gardener-apiserver",
+									Namespace: namespace,
+									Labels: map[string]string{
+										"app":  "gardener",
+										"role": "apiserver",
+									},
+								},
+								Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+									MinReplicas: pointer.Int32(2),
+									MaxReplicas: 6,
+									ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+										APIVersion: "apps/v1",
+										Kind:       "Deployment",
+										Name:       "gardener-apiserver",
+									},
+									Metrics: []autoscalingv2.MetricSpec{{
+										Type: autoscalingv2.PodsMetricSourceType,
+										Pods: &autoscalingv2.PodsMetricSource{
+											Metric: autoscalingv2.MetricIdentifier{Name: "apiserver_request_rate"},
+											Target: autoscalingv2.MetricTarget{
+												Type:         autoscalingv2.AverageValueMetricType,
+												AverageValue: resource.NewQuantity(300, resource.DecimalSI),
+											},
+										},
+									}},
+								},
+							}
+
+							Expect(string(managedResourceSecretRuntime.Data["verticalpodautoscaler__some-namespace__gardener-apiserver-vpa.yaml"])).To(Equal(componenttest.Serialize(vpa)))
+							Expect(string(managedResourceSecretRuntime.Data["horizontalpodautoscaler__some-namespace__gardener-apiserver.yaml"])).To(Equal(componenttest.Serialize(hpa)))
+						})
+					})
 				})
 
 				Context("when HVPA is enabled", func() {
@@ -1487,6 +1547,35 @@ kubeConfigFile: /etc/kubernetes/admission-kubeconfigs/validatingadmissionwebhook
 					It("should successfully deploy all resources", func() {
 						Expect(string(managedResourceSecretRuntime.Data["hvpa__some-namespace__gardener-apiserver-hvpa.yaml"])).To(Equal(componenttest.Serialize(hvpa)))
 					})
+
+					Context("when resources and horizontal autoscaling are configured", func() {
+						BeforeEach(func() {
+							values.MaxAllowed = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("40G")}
+							values.HorizontalAutoscaling = &HorizontalAutoscalingConfig{
+								MinReplicas:              2,
+								MaxReplicas:              6,
+								TargetRequestsPerReplica: 300,
+							}
+							deployer = New(fakeClient, namespace, fakeSecretManager, values)
+						})
+
+						It("should successfully deploy all resources", func() {
+							hvpa.Spec.Hpa.Template.Spec.MinReplicas = pointer.Int32(2)
+							hvpa.Spec.Hpa.Template.Spec.MaxReplicas = 6
+							hvpa.Spec.Hpa.Template.Spec.Metrics = append(hvpa.Spec.Hpa.Template.Spec.Metrics, autoscalingv2beta1.MetricSpec{
+								Type: autoscalingv2beta1.PodsMetricSourceType,
+								Pods: &autoscalingv2beta1.PodsMetricSource{
+									MetricName:         "apiserver_request_rate",
+									TargetAverageValue: *resource.NewQuantity(300, resource.DecimalSI),
+								},
+							})
+							hvpa.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies[0].MaxAllowed[corev1.ResourceMemory] = resource.MustParse("40G")
+							hvpa.Spec.WeightBasedScalingIntervals[0].StartReplicaCount = 2
+							hvpa.Spec.WeightBasedScalingIntervals[0].LastReplicaCount = 5
+
+							Expect(string(managedResourceSecretRuntime.Data["hvpa__some-namespace__gardener-apiserver-hvpa.yaml"])).To(Equal(componenttest.Serialize(hvpa)))
+						})
+					})
 				})
 			})
 		})
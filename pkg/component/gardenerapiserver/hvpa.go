@@ -38,8 +38,36 @@ func (g *gardenerAPIServer) hvpa() *hvpav1alpha1.Hvpa {
 		maxReplicas int32 = 4
 		hpaLabels         = map[string]string{"role": "gardener-apiserver-hpa"}
 		vpaLabels         = map[string]string{"role": "gardener-apiserver-vpa"}
+		hpaMetrics        = []autoscalingv2beta1.MetricSpec{
+			{
+				Type: autoscalingv2beta1.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta1.ResourceMetricSource{
+					Name:                     corev1.ResourceCPU,
+					TargetAverageUtilization: pointer.Int32(80),
+				},
+			},
+			{
+				Type: autoscalingv2beta1.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta1.ResourceMetricSource{
+					Name:                     corev1.ResourceMemory,
+					TargetAverageUtilization: pointer.Int32(80),
+				},
+			},
+		}
 	)
 
+	if g.values.HorizontalAutoscaling != nil {
+		replicas = g.values.HorizontalAutoscaling.MinReplicas
+		maxReplicas = g.values.HorizontalAutoscaling.MaxReplicas
+		hpaMetrics = append(hpaMetrics, autoscalingv2beta1.MetricSpec{
+			Type: autoscalingv2beta1.PodsMetricSourceType,
+			Pods: &autoscalingv2beta1.PodsMetricSource{
+				MetricName:         MetricNameRequestRate,
+				TargetAverageValue: *resource.NewQuantity(int64(g.values.HorizontalAutoscaling.TargetRequestsPerReplica), resource.DecimalSI),
+			},
+		})
+	}
+
 	return &hvpav1alpha1.Hvpa{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      DeploymentName + "-hvpa",
@@ -68,22 +96,7 @@ func (g *gardenerAPIServer) hvpa() *hvpav1alpha1.Hvpa {
 					Spec: hvpav1alpha1.HpaTemplateSpec{
 						MinReplicas: pointer.Int32(replicas),
 						MaxReplicas: maxReplicas,
-						Metrics: []autoscalingv2beta1.MetricSpec{
-							{
-								Type: autoscalingv2beta1.ResourceMetricSourceType,
-								Resource: &autoscalingv2beta1.ResourceMetricSource{
-									Name:                     corev1.ResourceCPU,
-									TargetAverageUtilization: pointer.Int32(80),
-								},
-							},
-							{
-								Type: autoscalingv2beta1.ResourceMetricSourceType,
-								Resource: &autoscalingv2beta1.ResourceMetricSource{
-									Name:                     corev1.ResourceMemory,
-									TargetAverageUtilization: pointer.Int32(80),
-								},
-							},
-						},
+						Metrics:     hpaMetrics,
 					},
 				},
 			},
@@ -140,13 +153,13 @@ func (g *gardenerAPIServer) hvpa() *hvpav1alpha1.Hvpa {
 						ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
 							ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
 								ContainerName: containerName,
-								MinAllowed: corev1.ResourceList{
+								MinAllowed: mergeResourceList(corev1.ResourceList{
 									corev1.ResourceMemory: resource.MustParse("400M"),
-								},
-								MaxAllowed: corev1.ResourceList{
+								}, g.values.MinAllowed),
+								MaxAllowed: mergeResourceList(corev1.ResourceList{
 									corev1.ResourceCPU:    resource.MustParse("4"),
 									corev1.ResourceMemory: resource.MustParse("25G"),
-								},
+								}, g.values.MaxAllowed),
 							}},
 						},
 					},
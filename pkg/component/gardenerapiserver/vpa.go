@@ -48,12 +48,21 @@ func (g *gardenerAPIServer) verticalPodAutoscaler() *vpaautoscalingv1.VerticalPo
 				ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
 					{
 						ContainerName: vpaautoscalingv1.DefaultContainerResourcePolicy,
-						MinAllowed: corev1.ResourceList{
+						MinAllowed: mergeResourceList(corev1.ResourceList{
 							corev1.ResourceMemory: resource.MustParse("256Mi"),
-						},
+						}, g.values.MinAllowed),
+						MaxAllowed: g.values.MaxAllowed,
 					},
 				},
 			},
 		},
 	}
 }
+
+// mergeResourceList overwrites the entries of the given defaults with the given overrides.
+func mergeResourceList(defaults, overrides corev1.ResourceList) corev1.ResourceList {
+	for name, quantity := range overrides {
+		defaults[name] = quantity
+	}
+	return defaults
+}
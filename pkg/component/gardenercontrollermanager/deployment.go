@@ -80,10 +80,10 @@ func (g *gardenerControllerManager) deployment(secretGenericTokenKubeconfig, sec
 								fmt.Sprintf("--config=%s/%s", volumeMountConfig, dataConfigKey),
 							},
 							Resources: corev1.ResourceRequirements{
-								Requests: map[corev1.ResourceName]resource.Quantity{
+								Requests: mergeResourceList(corev1.ResourceList{
 									corev1.ResourceCPU:    resource.MustParse("1"),
 									corev1.ResourceMemory: resource.MustParse("1Gi"),
-								},
+								}, g.values.ResourceRequests),
 							},
 							LivenessProbe: &corev1.Probe{
 								ProbeHandler: corev1.ProbeHandler{
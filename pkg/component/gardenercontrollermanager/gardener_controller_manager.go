@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -60,6 +61,15 @@ type Values struct {
 	Quotas []controllermanagerv1alpha1.QuotaConfiguration
 	// FeatureGates is the set of feature gates.
 	FeatureGates map[string]bool
+	// ResourceRequests are the resource requests of the gardener-controller-manager. The given values overwrite the
+	// defaults for the respective resources.
+	ResourceRequests corev1.ResourceList
+	// MinAllowed are the minimum resources the vertical pod autoscaler may recommend for the
+	// gardener-controller-manager. The given values overwrite the defaults for the respective resources.
+	MinAllowed corev1.ResourceList
+	// MaxAllowed are the maximum resources the vertical pod autoscaler may recommend for the
+	// gardener-controller-manager.
+	MaxAllowed corev1.ResourceList
 }
 
 // New creates a new instance of DeployWaiter for the gardener-controller-manager.
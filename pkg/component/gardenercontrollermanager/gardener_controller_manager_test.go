@@ -340,6 +340,25 @@ var _ = Describe("GardenerControllerManager", func() {
 				Expect(managedResourceSecretVirtual.Immutable).To(Equal(pointer.Bool(true)))
 				Expect(managedResourceSecretVirtual.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 			})
+
+			It("should successfully deploy the configured resources", func() {
+				values.ResourceRequests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")}
+				values.MinAllowed = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+				values.MaxAllowed = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourceMemory: resource.MustParse("16Gi")}
+				deployer = New(fakeClient, namespace, fakeSecretManager, values)
+
+				Expect(deployer.Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+				managedResourceSecretRuntime.Name = managedResourceRuntime.Spec.SecretRefs[0].Name
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretRuntime), managedResourceSecretRuntime)).To(Succeed())
+
+				vpa.Spec.ResourcePolicy.ContainerPolicies[0].MinAllowed = values.MinAllowed
+				vpa.Spec.ResourcePolicy.ContainerPolicies[0].MaxAllowed = values.MaxAllowed
+
+				Expect(string(managedResourceSecretRuntime.Data["verticalpodautoscaler__some-namespace__gardener-controller-manager-vpa.yaml"])).To(Equal(componenttest.Serialize(vpa)))
+				Expect(string(managedResourceSecretRuntime.Data["deployment__some-namespace__gardener-controller-manager.yaml"])).To(Equal(deployment(namespace, "gardener-controller-manager-config-cff08f20", values)))
+			})
 		})
 
 		Context("secrets", func() {
@@ -745,6 +764,14 @@ func configMap(namespace string, testValues Values) string {
 }
 
 func deployment(namespace, configSecretName string, testValues Values) string {
+	requests := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1"),
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+	for name, quantity := range testValues.ResourceRequests {
+		requests[name] = quantity
+	}
+
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "gardener-controller-manager",
@@ -791,10 +818,7 @@ func deployment(namespace, configSecretName string, testValues Values) string {
 								"--config=/etc/gardener-controller-manager/config/config.yaml",
 							},
 							Resources: corev1.ResourceRequirements{
-								Requests: map[corev1.ResourceName]resource.Quantity{
-									corev1.ResourceCPU:    resource.MustParse("1"),
-									corev1.ResourceMemory: resource.MustParse("1Gi"),
-								},
+								Requests: requests,
 							},
 							LivenessProbe: &corev1.Probe{
 								ProbeHandler: corev1.ProbeHandler{
@@ -55,6 +55,13 @@ type MonitoringComponent interface {
 	AlertingRules() (map[string]string, error)
 }
 
+// ConfigExporter exports the effective configuration of a control plane component.
+type ConfigExporter interface {
+	// Snapshot returns the configuration (flags, configuration files, checksums) the component is currently running
+	// with.
+	Snapshot(ctx context.Context) (*ConfigSnapshot, error)
+}
+
 // IstioConfigInterface contains functions for retrieving data from the istio configuration.
 type IstioConfigInterface interface {
 	// ServiceName is the currently used name of the istio ingress service, which is responsible for the shoot cluster.
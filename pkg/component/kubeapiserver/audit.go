@@ -0,0 +1,70 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeapiserver
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+	resourcemanagerconstants "github.com/gardener/gardener/pkg/component/resourcemanager/constants"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/deprecatedapicallers"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// computeAuditConfig returns the audit configuration for kube-apiserver. If the deprecated-api-callers webhook is
+// enabled and no other audit webhook is configured, the audit events are sent to the gardener-resource-manager running
+// in the same namespace. In this case, the default audit policy only logs requests to deprecated APIs.
+func (k *kubeAPIServer) computeAuditConfig() (*apiserver.AuditConfig, error) {
+	if !k.values.DeprecatedAPICallersWebhookEnabled || (k.values.Audit != nil && k.values.Audit.Webhook != nil) {
+		return k.values.Audit, nil
+	}
+
+	caBundleSecret, found := k.secretsManager.Get(v1beta1constants.SecretNameCACluster)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCACluster)
+	}
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		deprecatedapicallers.HandlerName,
+		clientcmdv1.Cluster{
+			Server:                   fmt.Sprintf("https://%s.%s%s", resourcemanagerconstants.ServiceName, k.namespace, deprecatedapicallers.WebhookPath),
+			CertificateAuthorityData: caBundleSecret.Data[secretsutils.DataKeyCertificateBundle],
+		},
+		clientcmdv1.AuthInfo{},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	auditConfig := &apiserver.AuditConfig{Webhook: &apiserver.AuditWebhook{Kubeconfig: kubeconfig}}
+
+	if k.values.Audit != nil && k.values.Audit.Policy != nil {
+		auditConfig.Policy = k.values.Audit.Policy
+	} else {
+		policy, err := apiserver.DeprecatedAPIsAuditPolicy(k.values.Version)
+		if err != nil {
+			return nil, err
+		}
+		auditConfig.Policy = &policy
+	}
+
+	return auditConfig, nil
+}
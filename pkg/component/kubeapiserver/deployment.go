@@ -133,6 +133,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 	secretAuditWebhookKubeconfig *corev1.Secret,
 	secretAuthenticationWebhookKubeconfig *corev1.Secret,
 	secretAuthorizationWebhookKubeconfig *corev1.Secret,
+	auditConfig *apiserver.AuditConfig,
 	tlsSNISecrets []tlsSNISecret,
 	fastRollout bool,
 ) error {
@@ -380,7 +381,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 		}
 
 		apiserver.InjectDefaultSettings(deployment, k.values.NamePrefix, k.values.Values, secretCAETCD, secretETCDClient, secretServer)
-		apiserver.InjectAuditSettings(deployment, configMapAuditPolicy, secretAuditWebhookKubeconfig, k.values.Audit)
+		apiserver.InjectAuditSettings(deployment, configMapAuditPolicy, secretAuditWebhookKubeconfig, auditConfig)
 		apiserver.InjectAdmissionSettings(deployment, configMapAdmissionConfigs, secretAdmissionKubeconfigs, k.values.Values)
 		apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration)
 		k.handleLifecycleSettings(deployment)
@@ -684,6 +685,12 @@ func (k *kubeAPIServer) handleVPNSettingsHA(
 			},
 		},
 	}...)
+	if k.values.VPN.HighThroughputEnabled {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "BONDING_MODE",
+			Value: vpnseedserver.BondingModeHighThroughput,
+		})
+	}
 	container.LivenessProbe = nil
 	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
 		Name:      volumeNameAPIServerAccess,
@@ -58,6 +58,7 @@ const (
 type Interface interface {
 	apiserver.Interface
 	component.MonitoringComponent
+	component.ConfigExporter
 	// GetValues returns the current configuration values of the deployer.
 	GetValues() Values
 	// SetExternalHostname sets the ExternalHostname field in the Values of the deployer.
@@ -90,6 +91,9 @@ type Values struct {
 	// DefaultUnreachableTolerationSeconds indicates the tolerationSeconds of the toleration for unreachable:NoExecute
 	// that is added by default to every pod that does not already have such a toleration (flag `--default-unreachable-toleration-seconds`).
 	DefaultUnreachableTolerationSeconds *int64
+	// DeprecatedAPICallersWebhookEnabled states whether the audit events shall be sent to the deprecated-api-callers
+	// webhook of the gardener-resource-manager if no other audit webhook is configured.
+	DeprecatedAPICallersWebhookEnabled bool
 	// EventTTL is the amount of time to retain events.
 	EventTTL *metav1.Duration
 	// ExternalHostname is the external hostname which should be exposed by the kube-apiserver.
@@ -178,6 +182,8 @@ type VPNConfig struct {
 	HighAvailabilityNumberOfSeedServers int
 	// HighAvailabilityNumberOfShootClients is the number of VPN shoot clients used for HA
 	HighAvailabilityNumberOfShootClients int
+	// HighThroughputEnabled states whether the traffic is load-balanced across all tunnels to the VPN seed servers.
+	HighThroughputEnabled bool
 }
 
 // ServerCertificateConfig contains configuration for the server certificate.
@@ -336,10 +342,14 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if err := apiserver.ReconcileConfigMapAuditPolicy(ctx, k.client.Client(), configMapAuditPolicy, k.values.Audit); err != nil {
+	auditConfig, err := k.computeAuditConfig()
+	if err != nil {
+		return err
+	}
+	if err := apiserver.ReconcileConfigMapAuditPolicy(ctx, k.client.Client(), configMapAuditPolicy, auditConfig); err != nil {
 		return err
 	}
-	if err := apiserver.ReconcileSecretAuditWebhookKubeconfig(ctx, k.client.Client(), secretAuditWebhookKubeconfig, k.values.Audit); err != nil {
+	if err := apiserver.ReconcileSecretAuditWebhookKubeconfig(ctx, k.client.Client(), secretAuditWebhookKubeconfig, auditConfig); err != nil {
 		return err
 	}
 
@@ -412,6 +422,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		secretAuditWebhookKubeconfig,
 		secretAuthenticationWebhookKubeconfig,
 		secretAuthorizationWebhookKubeconfig,
+		auditConfig,
 		tlsSNISecrets,
 		k.values.FastRollout,
 	); err != nil {
@@ -516,6 +527,10 @@ func (k *kubeAPIServer) WaitCleanup(ctx context.Context) error {
 	})
 }
 
+func (k *kubeAPIServer) Snapshot(ctx context.Context) (*component.ConfigSnapshot, error) {
+	return component.SnapshotDeployment(ctx, k.client.Client(), k.namespace, v1beta1constants.DeploymentNameKubeAPIServer, ContainerNameKubeAPIServer)
+}
+
 func (k *kubeAPIServer) GetValues() Values {
 	return k.values
 }
@@ -42,6 +42,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
@@ -729,6 +730,117 @@ subjects:
 					},
 				}))
 			})
+
+			Context("API priority and fairness presets", func() {
+				var priorityAndFairness *gardencorev1beta1.APIServerPriorityAndFairness
+
+				BeforeEach(func() {
+					priorityAndFairness = &gardencorev1beta1.APIServerPriorityAndFairness{
+						Presets: []gardencorev1beta1.APIServerPriorityAndFairnessPreset{
+							gardencorev1beta1.APIServerPriorityAndFairnessPresetProtectSystemComponents,
+							gardencorev1beta1.APIServerPriorityAndFairnessPresetLimitWorkloadServiceAccounts,
+						},
+					}
+				})
+
+				JustBeforeEach(func() {
+					values.Requests = &gardencorev1beta1.APIServerRequests{PriorityAndFairness: priorityAndFairness}
+					kapi = New(kubernetesInterface, namespace, sm, values)
+				})
+
+				deployAndGetShootResources := func() map[string][]byte {
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+					return managedResourceSecret.Data
+				}
+
+				Context("Kubernetes version < 1.26", func() {
+					It("should deploy the presets with the v1beta2 API", func() {
+						data := deployAndGetShootResources()
+
+						Expect(data).To(HaveKey("prioritylevelconfiguration____gardener-system-components.yaml"))
+						Expect(data).To(HaveKey("prioritylevelconfiguration____gardener-workload-service-accounts.yaml"))
+						Expect(data).To(HaveKey("flowschema____gardener-workload-service-accounts.yaml"))
+						Expect(string(data["flowschema____gardener-system-components.yaml"])).To(Equal(`apiVersion: flowcontrol.apiserver.k8s.io/v1beta2
+kind: FlowSchema
+metadata:
+  creationTimestamp: null
+  name: gardener-system-components
+spec:
+  distinguisherMethod:
+    type: ByUser
+  matchingPrecedence: 850
+  priorityLevelConfiguration:
+    name: gardener-system-components
+  rules:
+  - nonResourceRules:
+    - nonResourceURLs:
+      - '*'
+      verbs:
+      - '*'
+    resourceRules:
+    - apiGroups:
+      - '*'
+      clusterScope: true
+      namespaces:
+      - '*'
+      resources:
+      - '*'
+      verbs:
+      - '*'
+    subjects:
+    - group:
+        name: system:serviceaccounts:kube-system
+      kind: Group
+status: {}
+`))
+						Expect(string(data["prioritylevelconfiguration____gardener-system-components.yaml"])).To(ContainSubstring("assuredConcurrencyShares: 50"))
+					})
+				})
+
+				Context("Kubernetes version >= 1.26", func() {
+					BeforeEach(func() {
+						version = semver.MustParse("1.28.2")
+					})
+
+					It("should deploy the presets with the v1beta3 API", func() {
+						data := deployAndGetShootResources()
+
+						Expect(data).To(HaveKey("flowschema____gardener-system-components.yaml"))
+						Expect(data).To(HaveKey("prioritylevelconfiguration____gardener-system-components.yaml"))
+						Expect(data).To(HaveKey("flowschema____gardener-workload-service-accounts.yaml"))
+						Expect(string(data["prioritylevelconfiguration____gardener-workload-service-accounts.yaml"])).To(Equal(`apiVersion: flowcontrol.apiserver.k8s.io/v1beta3
+kind: PriorityLevelConfiguration
+metadata:
+  creationTimestamp: null
+  name: gardener-workload-service-accounts
+spec:
+  limited:
+    limitResponse:
+      queuing:
+        handSize: 4
+        queueLengthLimit: 50
+        queues: 32
+      type: Queue
+    nominalConcurrencyShares: 10
+  type: Limited
+status: {}
+`))
+					})
+				})
+
+				Context("no presets", func() {
+					BeforeEach(func() {
+						priorityAndFairness = nil
+					})
+
+					It("should not deploy any priority and fairness objects", func() {
+						Expect(deployAndGetShootResources()).To(HaveLen(2))
+					})
+				})
+			})
 		})
 
 		Describe("Secrets", func() {
@@ -1095,6 +1207,69 @@ resources:
 				}))
 			})
 
+			It("should successfully deploy the audit webhook kubeconfig secret resource for the deprecated-api-callers webhook", func() {
+				kapi = New(kubernetesInterface, namespace, sm, Values{
+					Values: apiserver.Values{
+						RuntimeVersion: runtimeVersion,
+					},
+					DeprecatedAPICallersWebhookEnabled: true,
+					Version:                            version,
+				})
+
+				kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+					"deprecated-api-callers",
+					clientcmdv1.Cluster{Server: "https://gardener-resource-manager." + namespace + "/webhooks/deprecated-api-callers"},
+					clientcmdv1.AuthInfo{},
+				))
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-audit-webhook-kubeconfig", Namespace: namespace},
+					Data:       map[string][]byte{"kubeconfig.yaml": kubeconfig},
+				}
+				Expect(kubernetesutils.MakeUnique(expectedSecret)).To(Succeed())
+
+				Expect(kapi.Deploy(ctx)).To(Succeed())
+
+				actualSecret := &corev1.Secret{}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(expectedSecret), actualSecret)).To(Succeed())
+				Expect(actualSecret.Data).To(Equal(expectedSecret.Data))
+			})
+
+			It("should not deploy an audit webhook kubeconfig secret resource for the deprecated-api-callers webhook if another audit webhook is configured", func() {
+				var (
+					kubeconfig  = []byte("some-kubeconfig")
+					auditConfig = &apiserver.AuditConfig{Webhook: &apiserver.AuditWebhook{Kubeconfig: kubeconfig}}
+				)
+
+				kapi = New(kubernetesInterface, namespace, sm, Values{
+					Values: apiserver.Values{
+						Audit:          auditConfig,
+						RuntimeVersion: runtimeVersion,
+					},
+					DeprecatedAPICallersWebhookEnabled: true,
+					Version:                            version,
+				})
+
+				expectedSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-audit-webhook-kubeconfig", Namespace: namespace},
+					Data:       map[string][]byte{"kubeconfig.yaml": kubeconfig},
+				}
+				Expect(kubernetesutils.MakeUnique(expectedSecret)).To(Succeed())
+
+				Expect(kapi.Deploy(ctx)).To(Succeed())
+
+				secretList := &corev1.SecretList{}
+				Expect(c.List(ctx, secretList, client.InNamespace(namespace))).To(Succeed())
+				var auditWebhookSecrets []string
+				for _, secret := range secretList.Items {
+					if strings.HasPrefix(secret.Name, "kube-apiserver-audit-webhook-kubeconfig") {
+						auditWebhookSecrets = append(auditWebhookSecrets, secret.Name)
+					}
+				}
+				Expect(auditWebhookSecrets).To(ConsistOf(expectedSecret.Name))
+			})
+
 			It("should successfully deploy the authentication webhook kubeconfig secret resource", func() {
 				var (
 					kubeconfig        = []byte("some-kubeconfig")
@@ -1554,6 +1729,53 @@ rules:
 				})
 			})
 
+			Context("audit policy with deprecated-api-callers webhook", func() {
+				It("should successfully deploy the configmap resource w/ policy for deprecated APIs", func() {
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							RuntimeVersion: runtimeVersion,
+						},
+						DeprecatedAPICallersWebhookEnabled: true,
+						Version:                            version,
+					})
+
+					policy, err := apiserver.DeprecatedAPIsAuditPolicy(version)
+					Expect(err).NotTo(HaveOccurred())
+
+					configMapAuditPolicy = &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "audit-policy-config", Namespace: namespace},
+						Data:       map[string]string{"audit-policy.yaml": policy},
+					}
+					Expect(kubernetesutils.MakeUnique(configMapAuditPolicy)).To(Succeed())
+
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapAuditPolicy), configMapAuditPolicy)).To(Succeed())
+					Expect(configMapAuditPolicy.Data["audit-policy.yaml"]).To(ContainSubstring("level: Metadata"))
+				})
+
+				It("should successfully deploy the configmap resource w/ configured policy", func() {
+					policy := "some-audit-policy"
+
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							Audit:          &apiserver.AuditConfig{Policy: &policy},
+							RuntimeVersion: runtimeVersion,
+						},
+						DeprecatedAPICallersWebhookEnabled: true,
+						Version:                            version,
+					})
+
+					configMapAuditPolicy = &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "audit-policy-config", Namespace: namespace},
+						Data:       map[string]string{"audit-policy.yaml": policy},
+					}
+					Expect(kubernetesutils.MakeUnique(configMapAuditPolicy)).To(Succeed())
+
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapAuditPolicy), configMapAuditPolicy)).To(Succeed())
+				})
+			})
+
 			Context("egress selector", func() {
 				It("should successfully deploy the configmap resource", func() {
 					kapi = New(kubernetesInterface, namespace, sm, Values{
@@ -2248,6 +2470,32 @@ rules:
 				))
 			})
 
+			It("should configure the load-balancing bonding mode when VPN high throughput is enabled", func() {
+				values := Values{
+					Values: apiserver.Values{
+						RuntimeVersion: runtimeVersion,
+					},
+					Images:             Images{VPNClient: "vpn-client-image:really-latest"},
+					ServiceNetworkCIDR: "4.5.6.0/24",
+					VPN: VPNConfig{
+						Enabled:                              true,
+						HighAvailabilityEnabled:              true,
+						HighAvailabilityNumberOfSeedServers:  4,
+						HighAvailabilityNumberOfShootClients: 2,
+						HighThroughputEnabled:                true,
+						PodNetworkCIDR:                       "1.2.3.0/24",
+						NodeNetworkCIDR:                      pointer.String("7.8.9.0/24"),
+					},
+					Version: version,
+				}
+				kapi = New(kubernetesInterface, namespace, sm, values)
+				deployAndRead()
+
+				Expect(deployment.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+				Expect(deployment.Spec.Template.Spec.InitContainers[0].Env).To(ContainElement(corev1.EnvVar{Name: "BONDING_MODE", Value: "balance-xor"}))
+				Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(values.VPN.HighAvailabilityNumberOfSeedServers + 2))
+			})
+
 			It("should have the watchdog container when the kubernetes is version 1.24", func() {
 				var (
 					version = semver.MustParse("1.24.7")
@@ -3354,6 +3602,22 @@ rules:
 					))
 				})
 
+				It("should properly configure the audit settings with the deprecated-api-callers webhook", func() {
+					values.DeprecatedAPICallersWebhookEnabled = true
+					kapi = New(kubernetesInterface, namespace, sm, values)
+					deployAndRead()
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--audit-webhook-config-file=/etc/kubernetes/webhook/audit/kubeconfig.yaml"))
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement("--audit-log-path=/tmp/audit/audit.log"))
+					Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(
+						corev1.VolumeMount{
+							Name:      "audit-webhook-kubeconfig",
+							MountPath: "/etc/kubernetes/webhook/audit",
+							ReadOnly:  true,
+						},
+					))
+				})
+
 				It("should properly configure the authentication settings with webhook", func() {
 					values.AuthenticationWebhook = &AuthenticationWebhook{
 						Kubeconfig: []byte("foo"),
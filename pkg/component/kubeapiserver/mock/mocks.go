@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	component "github.com/gardener/gardener/pkg/component"
 	apiserver "github.com/gardener/gardener/pkg/component/apiserver"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubeapiserver"
 	gomock "go.uber.org/mock/gomock"
@@ -219,6 +220,21 @@ func (mr *MockInterfaceMockRecorder) SetServiceAccountConfig(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServiceAccountConfig", reflect.TypeOf((*MockInterface)(nil).SetServiceAccountConfig), arg0)
 }
 
+// Snapshot mocks base method.
+func (m *MockInterface) Snapshot(arg0 context.Context) (*component.ConfigSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", arg0)
+	ret0, _ := ret[0].(*component.ConfigSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockInterfaceMockRecorder) Snapshot(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockInterface)(nil).Snapshot), arg0)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(arg0 context.Context) error {
 	m.ctrl.T.Helper()
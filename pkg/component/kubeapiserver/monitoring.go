@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"strings"
 	"text/template"
+	"time"
 
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
@@ -75,6 +76,12 @@ const (
 	monitoringmetricApiserverCacheList                                   = "apiserver_cache_list_.+"
 	monitoringmetricApiserverStorageList                                 = "apiserver_storage_list_.+"
 
+	monitoringMetricSLOAvailability = "shoot:apiserver_availability_slo"
+	monitoringMetricSLOLatency      = "shoot:apiserver_latency_slo"
+	// The error budgets are the complements of the objectives defined in ServiceLevelObjectives.
+	monitoringSLOAvailabilityErrorBudget = "0.001"
+	monitoringSLOLatencyErrorBudget      = "0.01"
+
 	// TODO: Replace below hard-coded job name of the Blackbox Exporter once its deployment has been refactored.
 	monitoringAlertingRules = `groups:
 - name: kube-apiserver.rules
@@ -208,9 +215,130 @@ const (
     annotations:
       description: The API Server requests failure rate exceeds 10%.
       summary: Kubernetes API server failure rate is high
+- name: kube-apiserver-slo.rules
+  rules:
+  ### API server availability SLO: 99.9% of the requests do not fail with a server error ###
+  - record: ` + monitoringMetricSLOAvailability + `:burn_rate5m
+    expr: (sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT",code=~"5.."}[5m])) or vector(0)) / sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT"}[5m])) / ` + monitoringSLOAvailabilityErrorBudget + `
+  - record: ` + monitoringMetricSLOAvailability + `:burn_rate30m
+    expr: (sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT",code=~"5.."}[30m])) or vector(0)) / sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT"}[30m])) / ` + monitoringSLOAvailabilityErrorBudget + `
+  - record: ` + monitoringMetricSLOAvailability + `:burn_rate1h
+    expr: (sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT",code=~"5.."}[1h])) or vector(0)) / sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT"}[1h])) / ` + monitoringSLOAvailabilityErrorBudget + `
+  - record: ` + monitoringMetricSLOAvailability + `:burn_rate2h
+    expr: (sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT",code=~"5.."}[2h])) or vector(0)) / sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT"}[2h])) / ` + monitoringSLOAvailabilityErrorBudget + `
+  - record: ` + monitoringMetricSLOAvailability + `:burn_rate6h
+    expr: (sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT",code=~"5.."}[6h])) or vector(0)) / sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT"}[6h])) / ` + monitoringSLOAvailabilityErrorBudget + `
+  - record: ` + monitoringMetricSLOAvailability + `:burn_rate1d
+    expr: (sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT",code=~"5.."}[1d])) or vector(0)) / sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT"}[1d])) / ` + monitoringSLOAvailabilityErrorBudget + `
+  - record: ` + monitoringMetricSLOAvailability + `:burn_rate3d
+    expr: (sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT",code=~"5.."}[3d])) or vector(0)) / sum(rate(` + monitoringMetricApiserverRequestTotal + `{job="` + monitoringPrometheusJobName + `",verb!~"WATCH|CONNECT"}[3d])) / ` + monitoringSLOAvailabilityErrorBudget + `
+  ### API server latency SLO: 99% of the (non-long-running) requests are served within 1s ###
+  - record: ` + monitoringMetricSLOLatency + `:burn_rate5m
+    expr: (1 - sum(rate(` + monitoringMetricApiserverRequestDurationSecondsBucket + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[5m])) / sum(rate(` + monitoringMetricApiserverRequestDurationSecondsCount + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[5m]))) / ` + monitoringSLOLatencyErrorBudget + `
+  - record: ` + monitoringMetricSLOLatency + `:burn_rate30m
+    expr: (1 - sum(rate(` + monitoringMetricApiserverRequestDurationSecondsBucket + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[30m])) / sum(rate(` + monitoringMetricApiserverRequestDurationSecondsCount + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[30m]))) / ` + monitoringSLOLatencyErrorBudget + `
+  - record: ` + monitoringMetricSLOLatency + `:burn_rate1h
+    expr: (1 - sum(rate(` + monitoringMetricApiserverRequestDurationSecondsBucket + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[1h])) / sum(rate(` + monitoringMetricApiserverRequestDurationSecondsCount + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[1h]))) / ` + monitoringSLOLatencyErrorBudget + `
+  - record: ` + monitoringMetricSLOLatency + `:burn_rate2h
+    expr: (1 - sum(rate(` + monitoringMetricApiserverRequestDurationSecondsBucket + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[2h])) / sum(rate(` + monitoringMetricApiserverRequestDurationSecondsCount + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[2h]))) / ` + monitoringSLOLatencyErrorBudget + `
+  - record: ` + monitoringMetricSLOLatency + `:burn_rate6h
+    expr: (1 - sum(rate(` + monitoringMetricApiserverRequestDurationSecondsBucket + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[6h])) / sum(rate(` + monitoringMetricApiserverRequestDurationSecondsCount + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[6h]))) / ` + monitoringSLOLatencyErrorBudget + `
+  - record: ` + monitoringMetricSLOLatency + `:burn_rate1d
+    expr: (1 - sum(rate(` + monitoringMetricApiserverRequestDurationSecondsBucket + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[1d])) / sum(rate(` + monitoringMetricApiserverRequestDurationSecondsCount + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[1d]))) / ` + monitoringSLOLatencyErrorBudget + `
+  - record: ` + monitoringMetricSLOLatency + `:burn_rate3d
+    expr: (1 - sum(rate(` + monitoringMetricApiserverRequestDurationSecondsBucket + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[3d])) / sum(rate(` + monitoringMetricApiserverRequestDurationSecondsCount + `{job="` + monitoringPrometheusJobName + `",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[3d]))) / ` + monitoringSLOLatencyErrorBudget + `
+  - alert: ApiServerAvailabilityErrorBudgetBurn
+    expr: (` + monitoringMetricSLOAvailability + `:burn_rate1h > 14.4 and ` + monitoringMetricSLOAvailability + `:burn_rate5m > 14.4) or (` + monitoringMetricSLOAvailability + `:burn_rate6h > 6 and ` + monitoringMetricSLOAvailability + `:burn_rate30m > 6)
+    for: 2m
+    labels:
+      service: ` + v1beta1constants.DeploymentNameKubeAPIServer + `
+      severity: critical
+      type: seed
+      visibility: owner
+    annotations:
+      description: 'The API server is burning its availability error budget (99.9% of the requests without server errors over 30 days) too fast. If this continues, the error budget is exhausted within a few days.'
+      summary: 'The API server availability error budget is burning too fast'
+  - alert: ApiServerAvailabilityErrorBudgetBurn
+    expr: (` + monitoringMetricSLOAvailability + `:burn_rate1d > 3 and ` + monitoringMetricSLOAvailability + `:burn_rate2h > 3) or (` + monitoringMetricSLOAvailability + `:burn_rate3d > 1 and ` + monitoringMetricSLOAvailability + `:burn_rate6h > 1)
+    for: 15m
+    labels:
+      service: ` + v1beta1constants.DeploymentNameKubeAPIServer + `
+      severity: warning
+      type: seed
+      visibility: owner
+    annotations:
+      description: 'The API server is burning its availability error budget (99.9% of the requests without server errors over 30 days) too fast. If this continues, the error budget is exhausted before the end of the 30 days window.'
+      summary: 'The API server availability error budget is burning too fast'
+  - alert: ApiServerLatencyErrorBudgetBurn
+    expr: (` + monitoringMetricSLOLatency + `:burn_rate1h > 14.4 and ` + monitoringMetricSLOLatency + `:burn_rate5m > 14.4) or (` + monitoringMetricSLOLatency + `:burn_rate6h > 6 and ` + monitoringMetricSLOLatency + `:burn_rate30m > 6)
+    for: 2m
+    labels:
+      service: ` + v1beta1constants.DeploymentNameKubeAPIServer + `
+      severity: critical
+      type: seed
+      visibility: owner
+    annotations:
+      description: 'The API server is burning its latency error budget (99% of the requests served within 1s over 30 days) too fast. If this continues, the error budget is exhausted within a few days.'
+      summary: 'The API server latency error budget is burning too fast'
+  - alert: ApiServerLatencyErrorBudgetBurn
+    expr: (` + monitoringMetricSLOLatency + `:burn_rate1d > 3 and ` + monitoringMetricSLOLatency + `:burn_rate2h > 3) or (` + monitoringMetricSLOLatency + `:burn_rate3d > 1 and ` + monitoringMetricSLOLatency + `:burn_rate6h > 1)
+    for: 15m
+    labels:
+      service: ` + v1beta1constants.DeploymentNameKubeAPIServer + `
+      severity: warning
+      type: seed
+      visibility: owner
+    annotations:
+      description: 'The API server is burning its latency error budget (99% of the requests served within 1s over 30 days) too fast. If this continues, the error budget is exhausted before the end of the 30 days window.'
+      summary: 'The API server latency error budget is burning too fast'
+- name: kube-apiserver-slo-window.rules
+  interval: 5m
+  rules:
+  - record: ` + monitoringMetricSLOAvailability + `:sli_30d
+    expr: 1 - avg_over_time(` + monitoringMetricSLOAvailability + `:burn_rate5m[30d]) * ` + monitoringSLOAvailabilityErrorBudget + `
+  - record: ` + monitoringMetricSLOAvailability + `:error_budget_remaining_30d
+    expr: 1 - avg_over_time(` + monitoringMetricSLOAvailability + `:burn_rate5m[30d])
+  - record: ` + monitoringMetricSLOLatency + `:sli_30d
+    expr: 1 - avg_over_time(` + monitoringMetricSLOLatency + `:burn_rate5m[30d]) * ` + monitoringSLOLatencyErrorBudget + `
+  - record: ` + monitoringMetricSLOLatency + `:error_budget_remaining_30d
+    expr: 1 - avg_over_time(` + monitoringMetricSLOLatency + `:burn_rate5m[30d])
 `
 )
 
+// ServiceLevelObjectiveWindow is the time window in which the service level objectives of the kube-apiserver are
+// evaluated.
+const ServiceLevelObjectiveWindow = 30 * 24 * time.Hour
+
+// ServiceLevelObjective describes a service level objective of the kube-apiserver whose SLI and remaining error budget
+// are recorded by the shoot's Prometheus.
+type ServiceLevelObjective struct {
+	// Name is the name of the service level objective.
+	Name string
+	// Objective is the targeted percentage of good requests within the ServiceLevelObjectiveWindow.
+	Objective string
+	// SLIMetric is the name of the metric containing the ratio of good requests within the window.
+	SLIMetric string
+	// ErrorBudgetRemainingMetric is the name of the metric containing the ratio of the error budget which remains within
+	// the window.
+	ErrorBudgetRemainingMetric string
+}
+
+// ServiceLevelObjectives are the service level objectives of the kube-apiserver.
+var ServiceLevelObjectives = []ServiceLevelObjective{
+	{
+		Name:                       "APIServerAvailability",
+		Objective:                  "99.9",
+		SLIMetric:                  monitoringMetricSLOAvailability + ":sli_30d",
+		ErrorBudgetRemainingMetric: monitoringMetricSLOAvailability + ":error_budget_remaining_30d",
+	},
+	{
+		Name:                       "APIServerLatency",
+		Objective:                  "99",
+		SLIMetric:                  monitoringMetricSLOLatency + ":sli_30d",
+		ErrorBudgetRemainingMetric: monitoringMetricSLOLatency + ":error_budget_remaining_30d",
+	},
+}
+
 var (
 	monitoringAllowedMetrics = []string{
 		monitoringMetricAuthenticationAttempts,
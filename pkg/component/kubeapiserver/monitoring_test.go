@@ -206,5 +206,92 @@ metric_relabel_configs:
     annotations:
       description: The API Server requests failure rate exceeds 10%.
       summary: Kubernetes API server failure rate is high
+- name: kube-apiserver-slo.rules
+  rules:
+  ### API server availability SLO: 99.9% of the requests do not fail with a server error ###
+  - record: shoot:apiserver_availability_slo:burn_rate5m
+    expr: (sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT",code=~"5.."}[5m])) or vector(0)) / sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT"}[5m])) / 0.001
+  - record: shoot:apiserver_availability_slo:burn_rate30m
+    expr: (sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT",code=~"5.."}[30m])) or vector(0)) / sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT"}[30m])) / 0.001
+  - record: shoot:apiserver_availability_slo:burn_rate1h
+    expr: (sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT",code=~"5.."}[1h])) or vector(0)) / sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT"}[1h])) / 0.001
+  - record: shoot:apiserver_availability_slo:burn_rate2h
+    expr: (sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT",code=~"5.."}[2h])) or vector(0)) / sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT"}[2h])) / 0.001
+  - record: shoot:apiserver_availability_slo:burn_rate6h
+    expr: (sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT",code=~"5.."}[6h])) or vector(0)) / sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT"}[6h])) / 0.001
+  - record: shoot:apiserver_availability_slo:burn_rate1d
+    expr: (sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT",code=~"5.."}[1d])) or vector(0)) / sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT"}[1d])) / 0.001
+  - record: shoot:apiserver_availability_slo:burn_rate3d
+    expr: (sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT",code=~"5.."}[3d])) or vector(0)) / sum(rate(apiserver_request_total{job="kube-apiserver",verb!~"WATCH|CONNECT"}[3d])) / 0.001
+  ### API server latency SLO: 99% of the (non-long-running) requests are served within 1s ###
+  - record: shoot:apiserver_latency_slo:burn_rate5m
+    expr: (1 - sum(rate(apiserver_request_duration_seconds_bucket{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[5m])) / sum(rate(apiserver_request_duration_seconds_count{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[5m]))) / 0.01
+  - record: shoot:apiserver_latency_slo:burn_rate30m
+    expr: (1 - sum(rate(apiserver_request_duration_seconds_bucket{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[30m])) / sum(rate(apiserver_request_duration_seconds_count{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[30m]))) / 0.01
+  - record: shoot:apiserver_latency_slo:burn_rate1h
+    expr: (1 - sum(rate(apiserver_request_duration_seconds_bucket{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[1h])) / sum(rate(apiserver_request_duration_seconds_count{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[1h]))) / 0.01
+  - record: shoot:apiserver_latency_slo:burn_rate2h
+    expr: (1 - sum(rate(apiserver_request_duration_seconds_bucket{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[2h])) / sum(rate(apiserver_request_duration_seconds_count{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[2h]))) / 0.01
+  - record: shoot:apiserver_latency_slo:burn_rate6h
+    expr: (1 - sum(rate(apiserver_request_duration_seconds_bucket{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[6h])) / sum(rate(apiserver_request_duration_seconds_count{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[6h]))) / 0.01
+  - record: shoot:apiserver_latency_slo:burn_rate1d
+    expr: (1 - sum(rate(apiserver_request_duration_seconds_bucket{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[1d])) / sum(rate(apiserver_request_duration_seconds_count{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[1d]))) / 0.01
+  - record: shoot:apiserver_latency_slo:burn_rate3d
+    expr: (1 - sum(rate(apiserver_request_duration_seconds_bucket{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST",le="1"}[3d])) / sum(rate(apiserver_request_duration_seconds_count{job="kube-apiserver",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH|WATCHLIST"}[3d]))) / 0.01
+  - alert: ApiServerAvailabilityErrorBudgetBurn
+    expr: (shoot:apiserver_availability_slo:burn_rate1h > 14.4 and shoot:apiserver_availability_slo:burn_rate5m > 14.4) or (shoot:apiserver_availability_slo:burn_rate6h > 6 and shoot:apiserver_availability_slo:burn_rate30m > 6)
+    for: 2m
+    labels:
+      service: kube-apiserver
+      severity: critical
+      type: seed
+      visibility: owner
+    annotations:
+      description: 'The API server is burning its availability error budget (99.9% of the requests without server errors over 30 days) too fast. If this continues, the error budget is exhausted within a few days.'
+      summary: 'The API server availability error budget is burning too fast'
+  - alert: ApiServerAvailabilityErrorBudgetBurn
+    expr: (shoot:apiserver_availability_slo:burn_rate1d > 3 and shoot:apiserver_availability_slo:burn_rate2h > 3) or (shoot:apiserver_availability_slo:burn_rate3d > 1 and shoot:apiserver_availability_slo:burn_rate6h > 1)
+    for: 15m
+    labels:
+      service: kube-apiserver
+      severity: warning
+      type: seed
+      visibility: owner
+    annotations:
+      description: 'The API server is burning its availability error budget (99.9% of the requests without server errors over 30 days) too fast. If this continues, the error budget is exhausted before the end of the 30 days window.'
+      summary: 'The API server availability error budget is burning too fast'
+  - alert: ApiServerLatencyErrorBudgetBurn
+    expr: (shoot:apiserver_latency_slo:burn_rate1h > 14.4 and shoot:apiserver_latency_slo:burn_rate5m > 14.4) or (shoot:apiserver_latency_slo:burn_rate6h > 6 and shoot:apiserver_latency_slo:burn_rate30m > 6)
+    for: 2m
+    labels:
+      service: kube-apiserver
+      severity: critical
+      type: seed
+      visibility: owner
+    annotations:
+      description: 'The API server is burning its latency error budget (99% of the requests served within 1s over 30 days) too fast. If this continues, the error budget is exhausted within a few days.'
+      summary: 'The API server latency error budget is burning too fast'
+  - alert: ApiServerLatencyErrorBudgetBurn
+    expr: (shoot:apiserver_latency_slo:burn_rate1d > 3 and shoot:apiserver_latency_slo:burn_rate2h > 3) or (shoot:apiserver_latency_slo:burn_rate3d > 1 and shoot:apiserver_latency_slo:burn_rate6h > 1)
+    for: 15m
+    labels:
+      service: kube-apiserver
+      severity: warning
+      type: seed
+      visibility: owner
+    annotations:
+      description: 'The API server is burning its latency error budget (99% of the requests served within 1s over 30 days) too fast. If this continues, the error budget is exhausted before the end of the 30 days window.'
+      summary: 'The API server latency error budget is burning too fast'
+- name: kube-apiserver-slo-window.rules
+  interval: 5m
+  rules:
+  - record: shoot:apiserver_availability_slo:sli_30d
+    expr: 1 - avg_over_time(shoot:apiserver_availability_slo:burn_rate5m[30d]) * 0.001
+  - record: shoot:apiserver_availability_slo:error_budget_remaining_30d
+    expr: 1 - avg_over_time(shoot:apiserver_availability_slo:burn_rate5m[30d])
+  - record: shoot:apiserver_latency_slo:sli_30d
+    expr: 1 - avg_over_time(shoot:apiserver_latency_slo:burn_rate5m[30d]) * 0.01
+  - record: shoot:apiserver_latency_slo:error_budget_remaining_30d
+    expr: 1 - avg_over_time(shoot:apiserver_latency_slo:burn_rate5m[30d])
 `
 )
@@ -0,0 +1,201 @@
+// Copyright 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeapiserver
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	flowcontrolv1beta2 "k8s.io/api/flowcontrol/v1beta2"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/version"
+)
+
+// priorityAndFairnessPreset describes a pair of a FlowSchema and a PriorityLevelConfiguration with the same name. The
+// presets are defined independently of the API version so that they can be rendered for every supported Kubernetes
+// version.
+type priorityAndFairnessPreset struct {
+	name string
+	// matchingPrecedence of the FlowSchema. The precedences are chosen relative to the suggested FlowSchemas of the
+	// kube-apiserver, see https://kubernetes.io/docs/concepts/cluster-administration/flow-control/#defaults.
+	matchingPrecedence int32
+	// groups are the names of the user groups whose requests are matched by the FlowSchema.
+	groups []string
+	// concurrencyShares is the (nominal) share of the kube-apiserver's concurrency limit of the priority level.
+	concurrencyShares int32
+	queues            int32
+	handSize          int32
+	queueLengthLimit  int32
+}
+
+var priorityAndFairnessPresets = map[gardencorev1beta1.APIServerPriorityAndFairnessPreset]priorityAndFairnessPreset{
+	// Requests of the kube-system service accounts are matched before the suggested `kube-system-service-accounts`
+	// FlowSchema (precedence 900) which shares the `workload-high` priority level with other workloads.
+	gardencorev1beta1.APIServerPriorityAndFairnessPresetProtectSystemComponents: {
+		name:               "gardener-system-components",
+		matchingPrecedence: 850,
+		groups:             []string{"system:serviceaccounts:kube-system"},
+		concurrencyShares:  50,
+		queues:             64,
+		handSize:           6,
+		queueLengthLimit:   50,
+	},
+	// Requests of all other service accounts are matched before the suggested `service-accounts` FlowSchema
+	// (precedence 9000) which would assign them to the `workload-low` priority level.
+	gardencorev1beta1.APIServerPriorityAndFairnessPresetLimitWorkloadServiceAccounts: {
+		name:               "gardener-workload-service-accounts",
+		matchingPrecedence: 8900,
+		groups:             []string{"system:serviceaccounts"},
+		concurrencyShares:  10,
+		queues:             32,
+		handSize:           4,
+		queueLengthLimit:   50,
+	},
+}
+
+func (k *kubeAPIServer) computePriorityAndFairnessObjects() ([]client.Object, error) {
+	if k.values.Requests == nil || k.values.Requests.PriorityAndFairness == nil {
+		return nil, nil
+	}
+
+	var objects []client.Object
+
+	for _, presetName := range k.values.Requests.PriorityAndFairness.Presets {
+		preset, ok := priorityAndFairnessPresets[presetName]
+		if !ok {
+			return nil, fmt.Errorf("unknown API priority and fairness preset %q", presetName)
+		}
+
+		objects = append(objects, preset.objects(k.values.Version)...)
+	}
+
+	return objects, nil
+}
+
+func (p priorityAndFairnessPreset) objects(kubernetesVersion *semver.Version) []client.Object {
+	// The flowcontrol.apiserver.k8s.io/v1beta3 API is served as of Kubernetes 1.26, older versions only serve v1beta2.
+	if version.ConstraintK8sLess126.Check(kubernetesVersion) {
+		return p.objectsV1beta2()
+	}
+	return p.objectsV1beta3()
+}
+
+func (p priorityAndFairnessPreset) objectsV1beta3() []client.Object {
+	var subjects []flowcontrolv1beta3.Subject
+	for _, group := range p.groups {
+		subjects = append(subjects, flowcontrolv1beta3.Subject{
+			Kind:  flowcontrolv1beta3.SubjectKindGroup,
+			Group: &flowcontrolv1beta3.GroupSubject{Name: group},
+		})
+	}
+
+	return []client.Object{
+		&flowcontrolv1beta3.PriorityLevelConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: p.name},
+			Spec: flowcontrolv1beta3.PriorityLevelConfigurationSpec{
+				Type: flowcontrolv1beta3.PriorityLevelEnablementLimited,
+				Limited: &flowcontrolv1beta3.LimitedPriorityLevelConfiguration{
+					NominalConcurrencyShares: p.concurrencyShares,
+					LimitResponse: flowcontrolv1beta3.LimitResponse{
+						Type: flowcontrolv1beta3.LimitResponseTypeQueue,
+						Queuing: &flowcontrolv1beta3.QueuingConfiguration{
+							Queues:           p.queues,
+							HandSize:         p.handSize,
+							QueueLengthLimit: p.queueLengthLimit,
+						},
+					},
+				},
+			},
+		},
+		&flowcontrolv1beta3.FlowSchema{
+			ObjectMeta: metav1.ObjectMeta{Name: p.name},
+			Spec: flowcontrolv1beta3.FlowSchemaSpec{
+				PriorityLevelConfiguration: flowcontrolv1beta3.PriorityLevelConfigurationReference{Name: p.name},
+				MatchingPrecedence:         p.matchingPrecedence,
+				DistinguisherMethod:        &flowcontrolv1beta3.FlowDistinguisherMethod{Type: flowcontrolv1beta3.FlowDistinguisherMethodByUserType},
+				Rules: []flowcontrolv1beta3.PolicyRulesWithSubjects{{
+					Subjects: subjects,
+					ResourceRules: []flowcontrolv1beta3.ResourcePolicyRule{{
+						Verbs:        []string{flowcontrolv1beta3.VerbAll},
+						APIGroups:    []string{flowcontrolv1beta3.APIGroupAll},
+						Resources:    []string{flowcontrolv1beta3.ResourceAll},
+						ClusterScope: true,
+						Namespaces:   []string{flowcontrolv1beta3.NamespaceEvery},
+					}},
+					NonResourceRules: []flowcontrolv1beta3.NonResourcePolicyRule{{
+						Verbs:           []string{flowcontrolv1beta3.VerbAll},
+						NonResourceURLs: []string{flowcontrolv1beta3.NonResourceAll},
+					}},
+				}},
+			},
+		},
+	}
+}
+
+func (p priorityAndFairnessPreset) objectsV1beta2() []client.Object {
+	var subjects []flowcontrolv1beta2.Subject
+	for _, group := range p.groups {
+		subjects = append(subjects, flowcontrolv1beta2.Subject{
+			Kind:  flowcontrolv1beta2.SubjectKindGroup,
+			Group: &flowcontrolv1beta2.GroupSubject{Name: group},
+		})
+	}
+
+	return []client.Object{
+		&flowcontrolv1beta2.PriorityLevelConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: p.name},
+			Spec: flowcontrolv1beta2.PriorityLevelConfigurationSpec{
+				Type: flowcontrolv1beta2.PriorityLevelEnablementLimited,
+				Limited: &flowcontrolv1beta2.LimitedPriorityLevelConfiguration{
+					AssuredConcurrencyShares: p.concurrencyShares,
+					LimitResponse: flowcontrolv1beta2.LimitResponse{
+						Type: flowcontrolv1beta2.LimitResponseTypeQueue,
+						Queuing: &flowcontrolv1beta2.QueuingConfiguration{
+							Queues:           p.queues,
+							HandSize:         p.handSize,
+							QueueLengthLimit: p.queueLengthLimit,
+						},
+					},
+				},
+			},
+		},
+		&flowcontrolv1beta2.FlowSchema{
+			ObjectMeta: metav1.ObjectMeta{Name: p.name},
+			Spec: flowcontrolv1beta2.FlowSchemaSpec{
+				PriorityLevelConfiguration: flowcontrolv1beta2.PriorityLevelConfigurationReference{Name: p.name},
+				MatchingPrecedence:         p.matchingPrecedence,
+				DistinguisherMethod:        &flowcontrolv1beta2.FlowDistinguisherMethod{Type: flowcontrolv1beta2.FlowDistinguisherMethodByUserType},
+				Rules: []flowcontrolv1beta2.PolicyRulesWithSubjects{{
+					Subjects: subjects,
+					ResourceRules: []flowcontrolv1beta2.ResourcePolicyRule{{
+						Verbs:        []string{flowcontrolv1beta2.VerbAll},
+						APIGroups:    []string{flowcontrolv1beta2.APIGroupAll},
+						Resources:    []string{flowcontrolv1beta2.ResourceAll},
+						ClusterScope: true,
+						Namespaces:   []string{flowcontrolv1beta2.NamespaceEvery},
+					}},
+					NonResourceRules: []flowcontrolv1beta2.NonResourcePolicyRule{{
+						Verbs:           []string{flowcontrolv1beta2.VerbAll},
+						NonResourceURLs: []string{flowcontrolv1beta2.NonResourceAll},
+					}},
+				}},
+			},
+		},
+	}
+}
@@ -18,6 +18,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -80,8 +81,13 @@ func (k *kubeAPIServer) computeShootResourcesData() (map[string][]byte, error) {
 		}
 	)
 
-	return registry.AddAllAndSerialize(
+	priorityAndFairnessObjects, err := k.computePriorityAndFairnessObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	return registry.AddAllAndSerialize(append([]client.Object{
 		clusterRole,
 		clusterRoleBinding,
-	)
+	}, priorityAndFairnessObjects...)...)
 }
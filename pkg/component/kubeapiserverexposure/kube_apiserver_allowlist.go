@@ -0,0 +1,98 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeapiserverexposure
+
+import (
+	"context"
+
+	istioapisecurityv1beta1 "istio.io/api/security/v1beta1"
+	istioapitypev1beta1 "istio.io/api/type/v1beta1"
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// AllowlistValues configure the allowlist of the kube-apiserver endpoint.
+type AllowlistValues struct {
+	// Hosts are the SNI hosts of the kube-apiserver.
+	Hosts []string
+	// AllowedSourceRanges is the list of CIDRs which are allowed to access the kube-apiserver. If it is empty, the access
+	// is not restricted.
+	AllowedSourceRanges []string
+	// IstioIngressGateway contains the values of the istio ingress gateway which exposes the kube-apiserver.
+	IstioIngressGateway IstioIngressGateway
+}
+
+// NewAllowlist creates a new instance of Deployer which restricts the access to the kube-apiserver via the istio
+// ingress gateway to the allowed source ranges.
+func NewAllowlist(client client.Client, namespace string, valuesFunc func() *AllowlistValues) component.Deployer {
+	if valuesFunc == nil {
+		valuesFunc = func() *AllowlistValues { return &AllowlistValues{} }
+	}
+
+	return &allowlist{
+		client:     client,
+		namespace:  namespace,
+		valuesFunc: valuesFunc,
+	}
+}
+
+type allowlist struct {
+	client     client.Client
+	namespace  string
+	valuesFunc func() *AllowlistValues
+}
+
+func (a *allowlist) Deploy(ctx context.Context) error {
+	values := a.valuesFunc()
+	if len(values.AllowedSourceRanges) == 0 || len(values.Hosts) == 0 {
+		return a.Destroy(ctx)
+	}
+
+	authorizationPolicy := a.emptyAuthorizationPolicy(values)
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, a.client, authorizationPolicy, func() error {
+		authorizationPolicy.Labels = utils.MergeStringMaps(authorizationPolicy.Labels, getLabels())
+		// The policy denies all connections to the hosts of the kube-apiserver which do not originate from the allowed
+		// source ranges. Connections to other hosts exposed by the same istio ingress gateway are not affected.
+		authorizationPolicy.Spec = istioapisecurityv1beta1.AuthorizationPolicy{
+			Selector: &istioapitypev1beta1.WorkloadSelector{MatchLabels: values.IstioIngressGateway.Labels},
+			Action:   istioapisecurityv1beta1.AuthorizationPolicy_DENY,
+			Rules: []*istioapisecurityv1beta1.Rule{{
+				From: []*istioapisecurityv1beta1.Rule_From{{
+					Source: &istioapisecurityv1beta1.Source{NotRemoteIpBlocks: values.AllowedSourceRanges},
+				}},
+				When: []*istioapisecurityv1beta1.Condition{{
+					Key:    "connection.sni",
+					Values: values.Hosts,
+				}},
+			}},
+		}
+		return nil
+	})
+	return err
+}
+
+func (a *allowlist) Destroy(ctx context.Context) error {
+	return client.IgnoreNotFound(a.client.Delete(ctx, a.emptyAuthorizationPolicy(a.valuesFunc())))
+}
+
+func (a *allowlist) emptyAuthorizationPolicy(values *AllowlistValues) *istiosecurityv1beta1.AuthorizationPolicy {
+	return &istiosecurityv1beta1.AuthorizationPolicy{ObjectMeta: metav1.ObjectMeta{Name: a.namespace, Namespace: values.IstioIngressGateway.Namespace}}
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeapiserverexposure_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	istioapisecurityv1beta1 "istio.io/api/security/v1beta1"
+	istioapitypev1beta1 "istio.io/api/type/v1beta1"
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/kubeapiserverexposure"
+	comptest "github.com/gardener/gardener/pkg/component/test"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("#Allowlist", func() {
+	var (
+		ctx context.Context
+		c   client.Client
+
+		namespace      = "shoot--foo--bar"
+		istioNamespace = "istio-foo"
+		istioLabels    = map[string]string{"foo": "bar"}
+		hosts          = []string{"api.foo.bar", "api.internal.foo.bar"}
+
+		values    *AllowlistValues
+		deployer  component.Deployer
+		policyKey client.ObjectKey
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		s := runtime.NewScheme()
+		Expect(istiosecurityv1beta1.AddToScheme(s)).To(Succeed())
+		c = fake.NewClientBuilder().WithScheme(s).Build()
+
+		values = &AllowlistValues{
+			Hosts:               hosts,
+			AllowedSourceRanges: []string{"10.0.0.0/8", "1.2.3.4/32"},
+			IstioIngressGateway: IstioIngressGateway{
+				Namespace: istioNamespace,
+				Labels:    istioLabels,
+			},
+		}
+		deployer = NewAllowlist(c, namespace, func() *AllowlistValues { return values })
+		policyKey = kubernetesutils.Key(istioNamespace, namespace)
+	})
+
+	Describe("#Deploy", func() {
+		It("should deploy the authorization policy denying all other source ranges", func() {
+			Expect(deployer.Deploy(ctx)).To(Succeed())
+
+			policy := &istiosecurityv1beta1.AuthorizationPolicy{}
+			Expect(c.Get(ctx, policyKey, policy)).To(Succeed())
+			Expect(policy.Labels).To(Equal(map[string]string{"app": "kubernetes", "role": "apiserver"}))
+			Expect(&policy.Spec).To(BeComparableTo(&istioapisecurityv1beta1.AuthorizationPolicy{
+				Selector: &istioapitypev1beta1.WorkloadSelector{MatchLabels: istioLabels},
+				Action:   istioapisecurityv1beta1.AuthorizationPolicy_DENY,
+				Rules: []*istioapisecurityv1beta1.Rule{{
+					From: []*istioapisecurityv1beta1.Rule_From{{
+						Source: &istioapisecurityv1beta1.Source{NotRemoteIpBlocks: []string{"10.0.0.0/8", "1.2.3.4/32"}},
+					}},
+					When: []*istioapisecurityv1beta1.Condition{{
+						Key:    "connection.sni",
+						Values: hosts,
+					}},
+				}},
+			}, comptest.CmpOptsForAuthorizationPolicy()))
+		})
+
+		It("should delete the authorization policy if no source ranges are configured", func() {
+			Expect(deployer.Deploy(ctx)).To(Succeed())
+
+			values.AllowedSourceRanges = nil
+			Expect(deployer.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, policyKey, &istiosecurityv1beta1.AuthorizationPolicy{})).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the authorization policy", func() {
+			Expect(deployer.Deploy(ctx)).To(Succeed())
+			Expect(deployer.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, policyKey, &istiosecurityv1beta1.AuthorizationPolicy{})).To(BeNotFoundError())
+		})
+
+		It("should succeed if the authorization policy does not exist", func() {
+			Expect(deployer.Destroy(ctx)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,160 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeapiserverexposure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/kubeapiserver"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubeapiserver/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// LoadBalancerServiceName is the name of the Service of the dedicated load balancer of the kube-apiserver endpoint.
+const LoadBalancerServiceName = v1beta1constants.DeploymentNameKubeAPIServer + "-endpoint"
+
+// LoadBalancerValues configure the dedicated load balancer of the kube-apiserver endpoint.
+type LoadBalancerValues struct {
+	// AllowedSourceRanges is the list of CIDRs which are allowed to access the load balancer. If it is empty, the access
+	// is not restricted.
+	AllowedSourceRanges []string
+	// StaticIPAddress is the IP address which was reserved upfront for the load balancer. If it is nil, the provider
+	// extension allocates a static IP address.
+	StaticIPAddress *string
+	// PTRRecord is the domain name of the reverse DNS (PTR) record to configure for the IP address of the load balancer.
+	PTRRecord *string
+}
+
+// NewLoadBalancer creates a new instance of DeployWaiter for a dedicated load balancer Service with a static IP address
+// which exposes the kube-apiserver. The static IP address is requested from the provider extension via the
+// annotations of the Service. <waiter> is optional and defaulted to
+// github.com/gardener/gardener/pkg/utils/retry.DefaultOps().
+func NewLoadBalancer(
+	log logr.Logger,
+	cl client.Client,
+	namespace string,
+	valuesFunc func() *LoadBalancerValues,
+	waiter retry.Ops,
+	ingressFunc func(ingressIP string),
+) component.DeployWaiter {
+	if valuesFunc == nil {
+		valuesFunc = func() *LoadBalancerValues { return &LoadBalancerValues{} }
+	}
+
+	if waiter == nil {
+		waiter = retry.DefaultOps()
+	}
+
+	if ingressFunc == nil {
+		ingressFunc = func(_ string) {}
+	}
+
+	return &loadBalancer{
+		log:         log,
+		client:      cl,
+		namespace:   namespace,
+		valuesFunc:  valuesFunc,
+		waiter:      waiter,
+		ingressFunc: ingressFunc,
+	}
+}
+
+type loadBalancer struct {
+	log         logr.Logger
+	client      client.Client
+	namespace   string
+	valuesFunc  func() *LoadBalancerValues
+	waiter      retry.Ops
+	ingressFunc func(ingressIP string)
+}
+
+func (l *loadBalancer) Deploy(ctx context.Context) error {
+	var (
+		values = l.valuesFunc()
+		obj    = l.emptyService()
+	)
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, l.client, obj, func() error {
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, v1beta1constants.AnnotationKubeAPIServerEndpointStaticIP, pointer.StringDeref(values.StaticIPAddress, ""))
+		if values.PTRRecord != nil {
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, v1beta1constants.AnnotationKubeAPIServerEndpointPTRRecord, *values.PTRRecord)
+		} else {
+			delete(obj.Annotations, v1beta1constants.AnnotationKubeAPIServerEndpointPTRRecord)
+		}
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, resourcesv1alpha1.NetworkingFromWorldToPorts, fmt.Sprintf(`[{"protocol":"TCP","port":%d}]`, kubeapiserverconstants.Port))
+
+		obj.Labels = utils.MergeStringMaps(obj.Labels, getLabels())
+
+		obj.Spec.Type = corev1.ServiceTypeLoadBalancer
+		obj.Spec.Selector = getLabels()
+		obj.Spec.LoadBalancerSourceRanges = values.AllowedSourceRanges
+		obj.Spec.Ports = kubernetesutils.ReconcileServicePorts(obj.Spec.Ports, []corev1.ServicePort{
+			{
+				Name:       kubeapiserver.ServicePortName,
+				Protocol:   corev1.ProtocolTCP,
+				Port:       kubeapiserverconstants.Port,
+				TargetPort: intstr.FromInt32(kubeapiserverconstants.Port),
+			},
+		}, corev1.ServiceTypeLoadBalancer)
+
+		return nil
+	})
+	return err
+}
+
+func (l *loadBalancer) Destroy(ctx context.Context) error {
+	return client.IgnoreNotFound(l.client.Delete(ctx, l.emptyService()))
+}
+
+func (l *loadBalancer) Wait(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	return l.waiter.Until(ctx, DefaultInterval, func(ctx context.Context) (done bool, err error) {
+		svc := l.emptyService()
+
+		loadBalancerIngress, err := kubernetesutils.GetLoadBalancerIngress(ctx, l.client, svc)
+		if err != nil {
+			l.log.Info("Waiting until the kube-apiserver endpoint LoadBalancer deployed in the Seed cluster is ready", "service", client.ObjectKeyFromObject(svc))
+			return retry.MinorError(fmt.Errorf("kube-apiserver endpoint LoadBalancer deployed in the Seed cluster is not ready yet: %w", err))
+		}
+		l.ingressFunc(loadBalancerIngress)
+
+		return retry.Ok()
+	})
+}
+
+func (l *loadBalancer) WaitCleanup(ctx context.Context) error {
+	return kubernetesutils.WaitUntilResourceDeleted(ctx, l.client, l.emptyService(), 2*time.Second)
+}
+
+func (l *loadBalancer) emptyService() *corev1.Service {
+	return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: LoadBalancerServiceName, Namespace: l.namespace}}
+}
@@ -0,0 +1,137 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeapiserverexposure_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/kubeapiserverexposure"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("#LoadBalancer", func() {
+	var (
+		ctx context.Context
+		c   client.Client
+
+		namespace = "shoot--foo--bar"
+
+		values       *LoadBalancerValues
+		ingressIP    string
+		deployWaiter component.DeployWaiter
+		serviceKey   client.ObjectKey
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		s := runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		c = fake.NewClientBuilder().WithScheme(s).Build()
+
+		ingressIP = ""
+		values = &LoadBalancerValues{
+			AllowedSourceRanges: []string{"10.0.0.0/8"},
+			StaticIPAddress:     pointer.String("1.2.3.4"),
+			PTRRecord:           pointer.String("api.example.com"),
+		}
+		deployWaiter = NewLoadBalancer(logr.Discard(), c, namespace, func() *LoadBalancerValues { return values }, &retryfake.Ops{MaxAttempts: 1}, func(ip string) { ingressIP = ip })
+		serviceKey = kubernetesutils.Key(namespace, "kube-apiserver-endpoint")
+	})
+
+	Describe("#Deploy", func() {
+		It("should deploy the load balancer service requesting the static IP address", func() {
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			service := &corev1.Service{}
+			Expect(c.Get(ctx, serviceKey, service)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{
+				"endpoint.kube-apiserver.gardener.cloud/static-ip":        "1.2.3.4",
+				"endpoint.kube-apiserver.gardener.cloud/ptr-record":       "api.example.com",
+				"networking.resources.gardener.cloud/from-world-to-ports": `[{"protocol":"TCP","port":443}]`,
+			}))
+			Expect(service.Labels).To(Equal(map[string]string{"app": "kubernetes", "role": "apiserver"}))
+			Expect(service.Spec).To(Equal(corev1.ServiceSpec{
+				Type:                     corev1.ServiceTypeLoadBalancer,
+				Selector:                 map[string]string{"app": "kubernetes", "role": "apiserver"},
+				LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+				Ports: []corev1.ServicePort{{
+					Name:       "kube-apiserver",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       443,
+					TargetPort: intstr.FromInt32(443),
+				}},
+			}))
+		})
+
+		It("should let the provider extension allocate the static IP address and remove the PTR record", func() {
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			values.StaticIPAddress = nil
+			values.PTRRecord = nil
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			service := &corev1.Service{}
+			Expect(c.Get(ctx, serviceKey, service)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue("endpoint.kube-apiserver.gardener.cloud/static-ip", ""))
+			Expect(service.Annotations).NotTo(HaveKey("endpoint.kube-apiserver.gardener.cloud/ptr-record"))
+		})
+	})
+
+	Describe("#Wait", func() {
+		It("should fail if the load balancer is not ready", func() {
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			Expect(deployWaiter.Wait(ctx)).To(MatchError(ContainSubstring("not ready yet")))
+			Expect(ingressIP).To(BeEmpty())
+		})
+
+		It("should report the IP address of the load balancer", func() {
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: serviceKey.Name, Namespace: serviceKey.Namespace}}
+			Expect(c.Get(ctx, serviceKey, service)).To(Succeed())
+			service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}
+			Expect(c.Status().Update(ctx, service)).To(Succeed())
+
+			Expect(deployWaiter.Wait(ctx)).To(Succeed())
+			Expect(ingressIP).To(Equal("1.2.3.4"))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the load balancer service", func() {
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+			Expect(deployWaiter.Destroy(ctx)).To(Succeed())
+			Expect(deployWaiter.WaitCleanup(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, serviceKey, &corev1.Service{})).To(BeNotFoundError())
+		})
+	})
+})
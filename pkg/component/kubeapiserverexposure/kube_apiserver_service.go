@@ -59,6 +59,10 @@ type ServiceValues struct {
 	TopologyAwareRoutingEnabled bool
 	// RuntimeKubernetesVersion is the Kubernetes version of the runtime cluster.
 	RuntimeKubernetesVersion *semver.Version
+	// ExtensionsAccessRestricted indicates whether the access of extensions to the kube-apiserver is restricted to the
+	// extensions managing the shoot. If true, the access is not allowed for all extensions, instead, it is allowed by
+	// the NetworkPolicy controller for the managing extensions only.
+	ExtensionsAccessRestricted bool
 }
 
 // serviceValues configure the kube-apiserver service.
@@ -69,6 +73,7 @@ type serviceValues struct {
 	namePrefix                  string
 	topologyAwareRoutingEnabled bool
 	runtimeKubernetesVersion    *semver.Version
+	extensionsAccessRestricted  bool
 	clusterIP                   string
 }
 
@@ -112,6 +117,7 @@ func NewService(
 		internalValues.namePrefix = values.NamePrefix
 		internalValues.topologyAwareRoutingEnabled = values.TopologyAwareRoutingEnabled
 		internalValues.runtimeKubernetesVersion = values.RuntimeKubernetesVersion
+		internalValues.extensionsAccessRestricted = values.ExtensionsAccessRestricted
 	}
 
 	return &service{
@@ -158,8 +164,11 @@ func (s *service) Deploy(ctx context.Context) error {
 			namespaceSelectors = append(namespaceSelectors,
 				metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: v1beta1constants.GardenNamespace}},
 				metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: v1beta1constants.LabelExposureClassHandlerName, Operator: metav1.LabelSelectorOpExists}}},
-				metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleExtension}},
 			)
+
+			if !s.values.extensionsAccessRestricted {
+				namespaceSelectors = append(namespaceSelectors, metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleExtension}})
+			}
 		}
 		utilruntime.Must(gardenerutils.InjectNetworkPolicyNamespaceSelectors(obj, namespaceSelectors...))
 
@@ -194,6 +194,34 @@ var _ = Describe("#Service", func() {
 	})
 
 	Describe("#Deploy", func() {
+		Context("when the access of extensions is restricted", func() {
+			It("should not allow all extensions to access the kube-apiserver", func() {
+				namespace = "shoot--foo--bar"
+
+				defaultDepWaiter = NewService(
+					log,
+					c,
+					namespace,
+					&ServiceValues{
+						AnnotationsFunc:            func() map[string]string { return nil },
+						NamePrefix:                 namePrefix,
+						ExtensionsAccessRestricted: true,
+					},
+					func() client.ObjectKey { return sniServiceObjKey },
+					&retryfake.Ops{MaxAttempts: 1},
+					clusterIPFunc,
+					ingressIPFunc,
+					"",
+				)
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				actual := &corev1.Service{}
+				Expect(c.Get(ctx, kubernetesutils.Key(namespace, expectedName), actual)).To(Succeed())
+				Expect(actual.Annotations).To(HaveKeyWithValue("networking.resources.gardener.cloud/namespace-selectors", `[{"matchLabels":{"gardener.cloud/role":"istio-ingress"}},{"matchLabels":{"networking.gardener.cloud/access-target-apiserver":"allowed"}},{"matchLabels":{"kubernetes.io/metadata.name":"garden"}},{"matchExpressions":[{"key":"handler.exposureclass.gardener.cloud/name","operator":"Exists"}]}]`))
+			})
+		})
+
 		Context("when TopologyAwareRoutingEnabled=true", func() {
 			It("should successfully deploy with expected kube-apiserver service annotations and labels", func() {
 				defaultDepWaiter = NewService(
@@ -83,6 +83,7 @@ const (
 type Interface interface {
 	component.DeployWaiter
 	component.MonitoringComponent
+	component.ConfigExporter
 	// SetReplicaCount sets the replica count for the kube-controller-manager.
 	SetReplicaCount(replicas int32)
 	// SetRuntimeConfig sets the runtime config for the kube-controller-manager.
@@ -583,6 +584,10 @@ func (k *kubeControllerManager) Destroy(ctx context.Context) error {
 	)
 }
 
+func (k *kubeControllerManager) Snapshot(ctx context.Context) (*component.ConfigSnapshot, error) {
+	return component.SnapshotDeployment(ctx, k.seedClient.Client(), k.namespace, v1beta1constants.DeploymentNameKubeControllerManager, containerName)
+}
+
 func (k *kubeControllerManager) SetShootClient(c client.Client) { k.shootClient = c }
 func (k *kubeControllerManager) SetReplicaCount(replicas int32) { k.values.Replicas = replicas }
 func (k *kubeControllerManager) SetRuntimeConfig(runtimeConfig map[string]bool) {
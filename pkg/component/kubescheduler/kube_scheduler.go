@@ -84,9 +84,11 @@ clientConnection:
   kubeconfig: ` + gardenerutils.PathGenericKubeconfig + `
 leaderElection:
   leaderElect: true
-{{- if eq .profile "bin-packing" }}
+{{- if or (eq .profile "bin-packing") .profiles }}
 profiles:
 - schedulerName: ` + corev1.DefaultSchedulerName + `
+{{- end }}
+{{- if eq .profile "bin-packing" }}
 {{- if eq .apiVersion "kubescheduler.config.k8s.io/v1beta1" }}
 - schedulerName: ` + BinPackingSchedulerName + `
   plugins:
@@ -108,6 +110,31 @@ profiles:
       disabled:
       - name: NodeResourcesBalancedAllocation
 {{- end }}
+{{- end }}
+{{- range .profiles }}
+- schedulerName: {{ .SchedulerName }}
+{{- if .BinPacking }}
+  pluginConfig:
+  - name: NodeResourcesFit
+    args:
+      scoringStrategy:
+        type: MostAllocated
+{{- end }}
+{{- if or .BinPacking .ScorePlugins }}
+  plugins:
+    score:
+{{- if .BinPacking }}
+      disabled:
+      - name: NodeResourcesBalancedAllocation
+{{- end }}
+{{- if .ScorePlugins }}
+      enabled:
+{{- range .ScorePlugins }}
+      - name: {{ .Name }}
+        weight: {{ .Weight }}
+{{- end }}
+{{- end }}
+{{- end }}
 {{- end }}`
 )
 
@@ -115,6 +142,7 @@ profiles:
 type Interface interface {
 	component.DeployWaiter
 	component.MonitoringComponent
+	component.ConfigExporter
 }
 
 // New creates a new instance of DeployWaiter for the kube-scheduler.
@@ -410,6 +438,10 @@ func getLabels() map[string]string {
 	}
 }
 
+func (k *kubeScheduler) Snapshot(ctx context.Context) (*component.ConfigSnapshot, error) {
+	return component.SnapshotDeployment(ctx, k.client, k.namespace, v1beta1constants.DeploymentNameKubeScheduler, containerName)
+}
+
 func (k *kubeScheduler) Destroy(_ context.Context) error     { return nil }
 func (k *kubeScheduler) Wait(_ context.Context) error        { return nil }
 func (k *kubeScheduler) WaitCleanup(_ context.Context) error { return nil }
@@ -496,16 +528,31 @@ func (k *kubeScheduler) computeComponentConfig() (string, error) {
 		apiVersion = "kubescheduler.config.k8s.io/v1beta3"
 	}
 
-	profile := gardencorev1beta1.SchedulingProfileBalanced
-	if k.config != nil && k.config.Profile != nil {
-		profile = *k.config.Profile
+	var (
+		profile  = gardencorev1beta1.SchedulingProfileBalanced
+		profiles []componentConfigProfile
+	)
+
+	if k.config != nil {
+		if k.config.Profile != nil {
+			profile = *k.config.Profile
+		}
+
+		for _, p := range k.config.Profiles {
+			profiles = append(profiles, componentConfigProfile{
+				SchedulerName: p.SchedulerName,
+				BinPacking:    pointer.BoolDeref(p.BinPacking, false),
+				ScorePlugins:  p.ScorePlugins,
+			})
+		}
 	}
 
 	var (
 		componentConfigYAML bytes.Buffer
-		values              = map[string]string{
+		values              = map[string]interface{}{
 			"apiVersion": apiVersion,
 			"profile":    string(profile),
+			"profiles":   profiles,
 		}
 	)
 	if err := componentConfigTemplate.Execute(&componentConfigYAML, values); err != nil {
@@ -515,6 +562,13 @@ func (k *kubeScheduler) computeComponentConfig() (string, error) {
 	return componentConfigYAML.String(), nil
 }
 
+// componentConfigProfile contains the values for rendering an additional scheduling profile into the component config.
+type componentConfigProfile struct {
+	SchedulerName string
+	BinPacking    bool
+	ScorePlugins  []gardencorev1beta1.KubeSchedulerScorePlugin
+}
+
 func (k *kubeScheduler) computeCommand(port int32) []string {
 	var command []string
 
@@ -529,7 +583,7 @@ func (k *kubeScheduler) computeCommand(port int32) []string {
 		fmt.Sprintf("--secure-port=%d", port),
 	)
 
-	if k.config != nil {
+	if k.config != nil && k.config.FeatureGates != nil {
 		command = append(command, kubernetesutils.FeatureGatesToCommandLineParameter(k.config.FeatureGates))
 	}
 
@@ -70,6 +70,23 @@ var _ = Describe("KubeScheduler", func() {
 			KubeMaxPDVols: pointer.String("23"),
 			Profile:       &profileBinPacking,
 		}
+		configProfiles = &gardencorev1beta1.KubeSchedulerConfig{
+			Profiles: []gardencorev1beta1.KubeSchedulerProfile{
+				{
+					SchedulerName: "packing",
+					BinPacking:    pointer.Bool(true),
+					ScorePlugins:  []gardencorev1beta1.KubeSchedulerScorePlugin{{Name: "ImageLocality", Weight: 5}},
+				},
+				{
+					SchedulerName: "spreading",
+					BinPacking:    pointer.Bool(false),
+					ScorePlugins:  []gardencorev1beta1.KubeSchedulerScorePlugin{{Name: "PodTopologySpread", Weight: 10}},
+				},
+				{
+					SchedulerName: "plain",
+				},
+			},
+		}
 
 		secretNameClientCA = "ca-client"
 		secretNameServer   = "kube-scheduler-server"
@@ -512,9 +529,24 @@ subjects:
 			Entry("kubernetes 1.25 w/ full config", "1.25.0", configFull, "testdata/component-config-1.25-bin-packing.yaml"),
 			Entry("kubernetes 1.26 w/o config", "1.26.0", configEmpty, "testdata/component-config-1.25.yaml"),
 			Entry("kubernetes 1.26 w/ full config", "1.26.0", configFull, "testdata/component-config-1.25-bin-packing.yaml"),
+			Entry("kubernetes 1.26 w/ additional profiles", "1.26.0", configProfiles, "testdata/component-config-1.25-profiles.yaml"),
 		)
 	})
 
+	Describe("#Snapshot", func() {
+		It("should return the configuration of the deployed kube-scheduler", func() {
+			Expect(kubeScheduler.Deploy(ctx)).To(Succeed())
+
+			snapshot, err := kubeScheduler.Snapshot(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Component).To(Equal("kube-scheduler"))
+			Expect(snapshot.Image).To(Equal(image))
+			Expect(snapshot.Flags).To(ContainElement("--config=/var/lib/kube-scheduler-config/config.yaml"))
+			Expect(snapshot.Files).To(HaveKey("/var/lib/kube-scheduler-config/config.yaml"))
+			Expect(snapshot.Checksums).To(HaveKey("kube-scheduler-config"))
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should return nil as it's not implemented as of now", func() {
 			Expect(kubeScheduler.Destroy(ctx)).To(Succeed())
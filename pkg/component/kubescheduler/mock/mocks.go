@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	component "github.com/gardener/gardener/pkg/component"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -92,6 +93,21 @@ func (mr *MockInterfaceMockRecorder) ScrapeConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScrapeConfigs", reflect.TypeOf((*MockInterface)(nil).ScrapeConfigs))
 }
 
+// Snapshot mocks base method.
+func (m *MockInterface) Snapshot(arg0 context.Context) (*component.ConfigSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", arg0)
+	ret0, _ := ret[0].(*component.ConfigSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockInterfaceMockRecorder) Snapshot(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockInterface)(nil).Snapshot), arg0)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,191 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubestatemetrics
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	customResourceStateConfigMapNamePrefix = "kube-state-metrics-custom-resource-state"
+	customResourceStateDataKey             = "custom-resource-state.yaml"
+	customResourceStateVolumeName          = "custom-resource-state-config"
+	customResourceStateVolumeMountPath     = "/etc/kube-state-metrics/custom-resource-state"
+
+	customResourceStateMetricNamePrefix = "kube_customresource"
+
+	customResourceStateMetricLastOperation = "last_operation"
+	customResourceStateMetricLastError     = "last_error"
+	customResourceStateMetricCondition     = "condition"
+)
+
+// customResourceStateExtensionKinds are the kinds of the extensions.gardener.cloud/v1alpha1 resources for which
+// kube-state-metrics exposes metrics about their state.
+var customResourceStateExtensionKinds = []string{
+	extensionsv1alpha1.WorkerResource,
+	extensionsv1alpha1.InfrastructureResource,
+	extensionsv1alpha1.ControlPlaneResource,
+	extensionsv1alpha1.DNSRecordResource,
+}
+
+// The following types model the subset of the kube-state-metrics CustomResourceStateMetrics configuration which is
+// needed for the extension resources, see
+// https://github.com/kubernetes/kube-state-metrics/blob/main/docs/customresourcestate-metrics.md.
+
+type customResourceStateMetrics struct {
+	Kind string                         `json:"kind"`
+	Spec customResourceStateMetricsSpec `json:"spec"`
+}
+
+type customResourceStateMetricsSpec struct {
+	Resources []customResourceStateResource `json:"resources"`
+}
+
+type customResourceStateResource struct {
+	GroupVersionKind metav1.GroupVersionKind     `json:"groupVersionKind"`
+	MetricNamePrefix string                      `json:"metricNamePrefix"`
+	LabelsFromPath   map[string][]string         `json:"labelsFromPath,omitempty"`
+	Metrics          []customResourceStateMetric `json:"metrics"`
+}
+
+type customResourceStateMetric struct {
+	Name string                        `json:"name"`
+	Help string                        `json:"help"`
+	Each customResourceStateMetricEach `json:"each"`
+}
+
+type customResourceStateMetricEach struct {
+	Type string                         `json:"type"`
+	Info *customResourceStateMetricInfo `json:"info,omitempty"`
+}
+
+type customResourceStateMetricInfo struct {
+	Path           []string            `json:"path"`
+	LabelsFromPath map[string][]string `json:"labelsFromPath,omitempty"`
+}
+
+func customResourceStateConfig() string {
+	config := customResourceStateMetrics{Kind: "CustomResourceStateMetrics"}
+
+	for _, kind := range customResourceStateExtensionKinds {
+		config.Spec.Resources = append(config.Spec.Resources, customResourceStateResource{
+			GroupVersionKind: metav1.GroupVersionKind{
+				Group:   extensionsv1alpha1.SchemeGroupVersion.Group,
+				Version: extensionsv1alpha1.SchemeGroupVersion.Version,
+				Kind:    kind,
+			},
+			MetricNamePrefix: customResourceStateMetricNamePrefixFor(kind),
+			LabelsFromPath: map[string][]string{
+				"name":      {"metadata", "name"},
+				"namespace": {"metadata", "namespace"},
+				// The `type` label is already set by the scrape configuration, hence the extension type is exposed with a
+				// dedicated label.
+				"extension_type": {"spec", "type"},
+			},
+			Metrics: []customResourceStateMetric{
+				{
+					Name: customResourceStateMetricLastOperation,
+					Help: "The last operation of the " + kind + " resource.",
+					Each: customResourceStateMetricEach{
+						Type: "Info",
+						Info: &customResourceStateMetricInfo{
+							Path: []string{"status", "lastOperation"},
+							LabelsFromPath: map[string][]string{
+								"operation": {"type"},
+								"state":     {"state"},
+							},
+						},
+					},
+				},
+				{
+					Name: customResourceStateMetricLastError,
+					Help: "The last error of the " + kind + " resource.",
+					Each: customResourceStateMetricEach{
+						Type: "Info",
+						Info: &customResourceStateMetricInfo{
+							Path: []string{"status", "lastError"},
+							LabelsFromPath: map[string][]string{
+								"description": {"description"},
+							},
+						},
+					},
+				},
+				{
+					Name: customResourceStateMetricCondition,
+					Help: "The conditions of the " + kind + " resource.",
+					Each: customResourceStateMetricEach{
+						Type: "Info",
+						Info: &customResourceStateMetricInfo{
+							Path: []string{"status", "conditions"},
+							LabelsFromPath: map[string][]string{
+								"condition": {"type"},
+								"status":    {"status"},
+								"reason":    {"reason"},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	data, err := yaml.Marshal(config)
+	utilruntime.Must(err)
+	return string(data)
+}
+
+func customResourceStateMetricNamePrefixFor(kind string) string {
+	return customResourceStateMetricNamePrefix + "_" + strings.ToLower(kind)
+}
+
+// customResourceStateMetricNames returns the names of all metrics which are generated by kube-state-metrics for the
+// extension resources.
+func customResourceStateMetricNames() []string {
+	var names []string
+
+	for _, kind := range customResourceStateExtensionKinds {
+		for _, metric := range []string{
+			customResourceStateMetricLastOperation,
+			customResourceStateMetricLastError,
+			customResourceStateMetricCondition,
+		} {
+			names = append(names, customResourceStateMetricNamePrefixFor(kind)+"_"+metric)
+		}
+	}
+
+	return names
+}
+
+func (k *kubeStateMetrics) customResourceStateConfigMap() *corev1.ConfigMap {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      customResourceStateConfigMapNamePrefix,
+			Namespace: k.namespace,
+			Labels:    k.getLabels(),
+		},
+		Data: map[string]string{customResourceStateDataKey: customResourceStateConfig()},
+	}
+
+	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
+	return configMap
+}
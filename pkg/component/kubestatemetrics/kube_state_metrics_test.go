@@ -16,6 +16,8 @@ package kubestatemetrics_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -40,6 +42,7 @@ import (
 	. "github.com/gardener/gardener/pkg/component/kubestatemetrics"
 	componenttest "github.com/gardener/gardener/pkg/component/test"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -124,11 +127,23 @@ var _ = Describe("KubeStateMetrics", func() {
 			}
 
 			if clusterType == component.ClusterTypeSeed {
-				obj.Rules = append(obj.Rules, rbacv1.PolicyRule{
-					APIGroups: []string{"autoscaling"},
-					Resources: []string{"horizontalpodautoscalers"},
-					Verbs:     []string{"list", "watch"},
-				})
+				obj.Rules = append(obj.Rules,
+					rbacv1.PolicyRule{
+						APIGroups: []string{"autoscaling"},
+						Resources: []string{"horizontalpodautoscalers"},
+						Verbs:     []string{"list", "watch"},
+					},
+					rbacv1.PolicyRule{
+						APIGroups: []string{"apiextensions.k8s.io"},
+						Resources: []string{"customresourcedefinitions"},
+						Verbs:     []string{"list", "watch"},
+					},
+					rbacv1.PolicyRule{
+						APIGroups: []string{"extensions.gardener.cloud"},
+						Resources: []string{"controlplanes", "dnsrecords", "infrastructures", "workers"},
+						Verbs:     []string{"list", "watch"},
+					},
+				)
 			}
 
 			return obj
@@ -173,6 +188,29 @@ var _ = Describe("KubeStateMetrics", func() {
 
 			return obj
 		}
+		customResourceStateConfigMap = func() *corev1.ConfigMap {
+			data, err := os.ReadFile(filepath.Join("testdata", "custom_resource_state.yaml"))
+			Expect(err).NotTo(HaveOccurred())
+
+			obj := &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "kube-state-metrics-custom-resource-state",
+					Namespace: namespace,
+					Labels: map[string]string{
+						"component": "kube-state-metrics",
+						"type":      "seed",
+					},
+				},
+				Data: map[string]string{"custom-resource-state.yaml": string(data)},
+			}
+			Expect(kubernetesutils.MakeUnique(obj)).To(Succeed())
+
+			return obj
+		}
 		serviceFor = func(clusterType component.ClusterType) *corev1.Service {
 			obj := &corev1.Service{
 				TypeMeta: metav1.TypeMeta{
@@ -298,9 +336,35 @@ var _ = Describe("KubeStateMetrics", func() {
 						"kube_verticalpodautoscaler_status_recommendation_containerrecommendations_lowerbound," +
 						"kube_verticalpodautoscaler_spec_resourcepolicy_container_policies_minallowed," +
 						"kube_verticalpodautoscaler_spec_resourcepolicy_container_policies_maxallowed," +
-						"kube_verticalpodautoscaler_spec_updatepolicy_updatemode",
+						"kube_verticalpodautoscaler_spec_updatepolicy_updatemode," +
+						"kube_customresource_worker_last_operation," +
+						"kube_customresource_worker_last_error," +
+						"kube_customresource_worker_condition," +
+						"kube_customresource_infrastructure_last_operation," +
+						"kube_customresource_infrastructure_last_error," +
+						"kube_customresource_infrastructure_condition," +
+						"kube_customresource_controlplane_last_operation," +
+						"kube_customresource_controlplane_last_error," +
+						"kube_customresource_controlplane_condition," +
+						"kube_customresource_dnsrecord_last_operation," +
+						"kube_customresource_dnsrecord_last_error," +
+						"kube_customresource_dnsrecord_condition",
+					"--custom-resource-state-config-file=/etc/kube-state-metrics/custom-resource-state/custom-resource-state.yaml",
 				}
 				serviceAccountName = "kube-state-metrics"
+				volumeMounts = []corev1.VolumeMount{{
+					Name:      "custom-resource-state-config",
+					MountPath: "/etc/kube-state-metrics/custom-resource-state",
+					ReadOnly:  true,
+				}}
+				volumes = []corev1.Volume{{
+					Name: "custom-resource-state-config",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: customResourceStateConfigMap().Name},
+						},
+					},
+				}}
 			}
 
 			if clusterType == component.ClusterTypeShoot {
@@ -596,7 +660,7 @@ var _ = Describe("KubeStateMetrics", func() {
 				managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
 				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
 				Expect(managedResourceSecret.Type).To(Equal(corev1.SecretTypeOpaque))
-				Expect(managedResourceSecret.Data).To(HaveLen(7))
+				Expect(managedResourceSecret.Data).To(HaveLen(8))
 				Expect(managedResourceSecret.Immutable).To(Equal(pointer.Bool(true)))
 				Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 
@@ -604,6 +668,7 @@ var _ = Describe("KubeStateMetrics", func() {
 				Expect(string(managedResourceSecret.Data["clusterrole____gardener.cloud_monitoring_kube-state-metrics-seed.yaml"])).To(Equal(componenttest.Serialize(clusterRoleFor(component.ClusterTypeSeed))))
 				Expect(string(managedResourceSecret.Data["clusterrolebinding____gardener.cloud_monitoring_kube-state-metrics-seed.yaml"])).To(Equal(componenttest.Serialize(clusterRoleBindingFor(component.ClusterTypeSeed))))
 				Expect(string(managedResourceSecret.Data["service__"+namespace+"__kube-state-metrics.yaml"])).To(Equal(componenttest.Serialize(serviceFor(component.ClusterTypeSeed))))
+				Expect(string(managedResourceSecret.Data["configmap__"+namespace+"__"+customResourceStateConfigMap().Name+".yaml"])).To(Equal(componenttest.Serialize(customResourceStateConfigMap())))
 				Expect(string(managedResourceSecret.Data["deployment__"+namespace+"__kube-state-metrics.yaml"])).To(Equal(componenttest.Serialize(deploymentFor(component.ClusterTypeSeed))))
 				Expect(string(managedResourceSecret.Data["verticalpodautoscaler__"+namespace+"__kube-state-metrics-vpa.yaml"])).To(Equal(componenttest.Serialize(vpa)))
 				Expect(string(managedResourceSecret.Data["poddisruptionbudget__"+namespace+"__kube-state-metrics-pdb.yaml"])).To(Equal(componenttest.Serialize(pdb)))
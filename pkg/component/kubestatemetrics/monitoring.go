@@ -87,7 +87,7 @@ const (
 )
 
 var (
-	centralMonitoringAllowedMetrics = []string{
+	centralMonitoringAllowedMetrics = append([]string{
 		monitoringMetricKubeDaemonSetMetadataGeneration,
 		monitoringMetricKubeDaemonSetStatusCurrentNumberScheduled,
 		monitoringMetricKubeDaemonSetStatusDesiredNumberScheduled,
@@ -139,7 +139,7 @@ var (
 		monitoringMetricKubeVerticalPodAutoscalerSpecResourcepolicyContainerPoliciesMinallowed,
 		monitoringMetricKubeVerticalPodAutoscalerSpecResourcepolicyContainerPoliciesMaxallowed,
 		monitoringMetricKubeVerticalPodAutoscalerSpecUpdatePolicyUpdateMode,
-	}
+	}, customResourceStateMetricNames()...)
 
 	shootMonitoringAllowedMetrics = []string{
 		monitoringMetricKubeDaemonSetMetadataGeneration,
@@ -162,6 +162,7 @@ var (
 		monitoringMetricKubeNodeStatusAllocatable,
 		monitoringMetricKubeNodeStatusCapacity,
 		monitoringMetricKubeNodeStatusCondition,
+		monitoringMetricKubePersistentVolumeClaimResourceRequestsStorageBytes,
 		monitoringMetricKubePodContainerInfo,
 		monitoringMetricKubePodContainerResourceLimits,
 		monitoringMetricKubePodContainerResourceRequests,
@@ -32,6 +32,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubeapiserver/constants"
@@ -57,12 +58,16 @@ func (k *kubeStateMetrics) getResourceConfigs(genericTokenKubeconfigSecretName s
 	)
 
 	if k.values.ClusterType == component.ClusterTypeSeed {
-		serviceAccount := k.emptyServiceAccount()
+		var (
+			serviceAccount               = k.emptyServiceAccount()
+			customResourceStateConfigMap = k.customResourceStateConfigMap()
+		)
 
 		configs = append(configs,
 			component.ResourceConfig{Obj: serviceAccount, Class: component.Runtime, MutateFn: func() { k.reconcileServiceAccount(serviceAccount) }},
 			component.ResourceConfig{Obj: clusterRoleBinding, Class: component.Application, MutateFn: func() { k.reconcileClusterRoleBinding(clusterRoleBinding, clusterRole, serviceAccount) }},
-			component.ResourceConfig{Obj: deployment, Class: component.Runtime, MutateFn: func() { k.reconcileDeployment(deployment, serviceAccount, "", nil) }},
+			component.ResourceConfig{Obj: customResourceStateConfigMap, Class: component.Runtime},
+			component.ResourceConfig{Obj: deployment, Class: component.Runtime, MutateFn: func() { k.reconcileDeployment(deployment, serviceAccount, customResourceStateConfigMap, "", nil) }},
 			component.ResourceConfig{Obj: pdb, Class: component.Runtime, MutateFn: func() { k.reconcilePodDisruptionBudget(pdb, deployment) }},
 		)
 	}
@@ -72,7 +77,9 @@ func (k *kubeStateMetrics) getResourceConfigs(genericTokenKubeconfigSecretName s
 			component.ResourceConfig{Obj: clusterRoleBinding, Class: component.Application, MutateFn: func() {
 				k.reconcileClusterRoleBinding(clusterRoleBinding, clusterRole, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: shootAccessSecret.ServiceAccountName, Namespace: metav1.NamespaceSystem}})
 			}},
-			component.ResourceConfig{Obj: deployment, Class: component.Runtime, MutateFn: func() { k.reconcileDeployment(deployment, nil, genericTokenKubeconfigSecretName, shootAccessSecret) }},
+			component.ResourceConfig{Obj: deployment, Class: component.Runtime, MutateFn: func() {
+				k.reconcileDeployment(deployment, nil, nil, genericTokenKubeconfigSecretName, shootAccessSecret)
+			}},
 		)
 	}
 
@@ -131,11 +138,23 @@ func (k *kubeStateMetrics) reconcileClusterRole(clusterRole *rbacv1.ClusterRole)
 	}
 
 	if k.values.ClusterType == component.ClusterTypeSeed {
-		clusterRole.Rules = append(clusterRole.Rules, rbacv1.PolicyRule{
-			APIGroups: []string{"autoscaling"},
-			Resources: []string{"horizontalpodautoscalers"},
-			Verbs:     []string{"list", "watch"},
-		})
+		clusterRole.Rules = append(clusterRole.Rules,
+			rbacv1.PolicyRule{
+				APIGroups: []string{"autoscaling"},
+				Resources: []string{"horizontalpodautoscalers"},
+				Verbs:     []string{"list", "watch"},
+			},
+			rbacv1.PolicyRule{
+				APIGroups: []string{"apiextensions.k8s.io"},
+				Resources: []string{"customresourcedefinitions"},
+				Verbs:     []string{"list", "watch"},
+			},
+			rbacv1.PolicyRule{
+				APIGroups: []string{extensionsv1alpha1.SchemeGroupVersion.Group},
+				Resources: []string{"controlplanes", "dnsrecords", "infrastructures", "workers"},
+				Verbs:     []string{"list", "watch"},
+			},
+		)
 	}
 }
 
@@ -196,6 +215,7 @@ func (k *kubeStateMetrics) emptyDeployment() *appsv1.Deployment {
 func (k *kubeStateMetrics) reconcileDeployment(
 	deployment *appsv1.Deployment,
 	serviceAccount *corev1.ServiceAccount,
+	customResourceStateConfigMap *corev1.ConfigMap,
 	genericTokenKubeconfigSecretName string,
 	shootAccessSecret *gardenerutils.AccessSecret,
 ) {
@@ -222,6 +242,7 @@ func (k *kubeStateMetrics) reconcileDeployment(
 			"--metric-labels-allowlist=nodes=[*]",
 			"--metric-annotations-allowlist=namespaces=[shoot.gardener.cloud/uid]",
 			fmt.Sprintf("--metric-allowlist=%s", strings.Join(centralMonitoringAllowedMetrics, ",")),
+			fmt.Sprintf("--custom-resource-state-config-file=%s/%s", customResourceStateVolumeMountPath, customResourceStateDataKey),
 		)
 	}
 
@@ -299,6 +320,19 @@ func (k *kubeStateMetrics) reconcileDeployment(
 
 	if k.values.ClusterType == component.ClusterTypeSeed {
 		deployment.Spec.Template.Spec.ServiceAccountName = serviceAccount.Name
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{
+			Name:      customResourceStateVolumeName,
+			MountPath: customResourceStateVolumeMountPath,
+			ReadOnly:  true,
+		}}
+		deployment.Spec.Template.Spec.Volumes = []corev1.Volume{{
+			Name: customResourceStateVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: customResourceStateConfigMap.Name},
+				},
+			},
+		}}
 	}
 	if k.values.ClusterType == component.ClusterTypeShoot {
 		deployment.Spec.Template.Spec.AutomountServiceAccountToken = pointer.Bool(false)
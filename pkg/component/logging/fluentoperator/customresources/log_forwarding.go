@@ -0,0 +1,232 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customresources
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins"
+	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/filter"
+	fluentbitv1alpha2output "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/output"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// RedactedValue is the value which replaces the values of masked keys in forwarded log records.
+	RedactedValue = "[REDACTED]"
+
+	logForwardingTLSMountPath = "/fluent-bit/forwarding"
+)
+
+// GetLogForwardingClusterFilters returns the ClusterFilters which select and redact the logs for the given log
+// forwardings. The selected log records are copied to a dedicated tag per log forwarding, i.e., the logs shipped to
+// Vali are not affected by the redaction rules.
+func GetLogForwardingClusterFilters(forwardings []config.LogForwarding, labels map[string]string) []*fluentbitv1alpha2.ClusterFilter {
+	var filters []*fluentbitv1alpha2.ClusterFilter
+
+	for _, forwarding := range forwardings {
+		filters = append(filters,
+			&fluentbitv1alpha2.ClusterFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					// This filter must run after the '03-add-tag-to-record' filter because it matches the 'tag' key of
+					// the record.
+					Name:   "04-forwarding-" + forwarding.Name,
+					Labels: labels,
+				},
+				Spec: fluentbitv1alpha2.FilterSpec{
+					Match: "kubernetes.*",
+					FilterItems: []fluentbitv1alpha2.FilterItem{
+						{
+							RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+								Rules:       []string{fmt.Sprintf("$tag %s %s true", logForwardingTagRegex(forwarding.Selector), logForwardingTag(forwarding))},
+								EmitterName: "forwarding-" + forwarding.Name,
+							},
+						},
+					},
+				},
+			},
+			&fluentbitv1alpha2.ClusterFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "04-forwarding-" + forwarding.Name + "-redaction",
+					Labels: labels,
+				},
+				Spec: fluentbitv1alpha2.FilterSpec{
+					Match:       logForwardingTag(forwarding),
+					FilterItems: logForwardingRedactionFilterItems(forwarding.Redaction),
+				},
+			},
+		)
+	}
+
+	return filters
+}
+
+// GetLogForwardingClusterOutputs returns the ClusterOutputs which ship the logs of the given log forwardings to the
+// configured external endpoints.
+func GetLogForwardingClusterOutputs(forwardings []config.LogForwarding, labels map[string]string) []*fluentbitv1alpha2.ClusterOutput {
+	var outputs []*fluentbitv1alpha2.ClusterOutput
+
+	for _, forwarding := range forwardings {
+		output := &fluentbitv1alpha2.ClusterOutput{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "forwarding-" + forwarding.Name,
+				Labels: labels,
+			},
+			Spec: fluentbitv1alpha2.OutputSpec{
+				Match:      logForwardingTag(forwarding),
+				RetryLimit: "5",
+			},
+		}
+
+		switch {
+		case forwarding.Syslog != nil:
+			output.Spec.Syslog = &fluentbitv1alpha2output.Syslog{
+				Host:             forwarding.Syslog.Host,
+				Port:             forwarding.Syslog.Port,
+				Mode:             "tls",
+				SyslogFormat:     pointer.StringDeref(forwarding.Syslog.Format, "rfc5424"),
+				SyslogMessageKey: "log",
+				TLS:              logForwardingTLS(forwarding, forwarding.Syslog.Host),
+			}
+		case forwarding.OTLP != nil:
+			output.Spec.OpenTelemetry = &fluentbitv1alpha2output.OpenTelemetry{
+				Host: forwarding.OTLP.Host,
+				Port: forwarding.OTLP.Port,
+				Uri:  pointer.StringDeref(forwarding.OTLP.URI, "/v1/logs"),
+				TLS:  logForwardingTLS(forwarding, forwarding.OTLP.Host),
+			}
+		default:
+			continue
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs
+}
+
+// GetLogForwardingVolumes returns the volumes and volume mounts for the fluent-bit pods which provide the TLS secrets of
+// the given log forwardings.
+func GetLogForwardingVolumes(forwardings []config.LogForwarding) ([]corev1.Volume, []corev1.VolumeMount) {
+	var (
+		volumes      []corev1.Volume
+		volumeMounts []corev1.VolumeMount
+	)
+
+	for _, forwarding := range forwardings {
+		if forwarding.TLS == nil {
+			continue
+		}
+
+		// The name of the log forwarding might be too long for a volume name, hence it is hashed.
+		name := "forwarding-tls-" + utils.ComputeSHA256Hex([]byte(forwarding.Name))[:8]
+		volumes = append(volumes, corev1.Volume{
+			Name:         name,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: forwarding.TLS.SecretName}},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: logForwardingTLSDirectory(forwarding),
+			ReadOnly:  true,
+		})
+	}
+
+	return volumes, volumeMounts
+}
+
+func logForwardingTLS(forwarding config.LogForwarding, host string) *plugins.TLS {
+	tls := &plugins.TLS{Verify: pointer.Bool(true), Vhost: host}
+	if forwarding.TLS == nil {
+		return tls
+	}
+
+	directory := logForwardingTLSDirectory(forwarding)
+	tls.CAFile = filepath.Join(directory, "ca.crt")
+	if forwarding.TLS.ClientCertificate {
+		tls.CRTFile = filepath.Join(directory, corev1.TLSCertKey)
+		tls.KeyFile = filepath.Join(directory, corev1.TLSPrivateKeyKey)
+	}
+	return tls
+}
+
+func logForwardingTLSDirectory(forwarding config.LogForwarding) string {
+	return filepath.Join(logForwardingTLSMountPath, forwarding.Name, "tls")
+}
+
+func logForwardingTag(forwarding config.LogForwarding) string {
+	return "forwarding." + forwarding.Name
+}
+
+// logForwardingTagRegex returns a regular expression matching the tags of the container logs selected by the given
+// selector. The tags have the format 'kubernetes.var.log.containers.<pod>_<namespace>_<container>-<container-id>.log'.
+// The names in the selector are quoted so that they are matched literally.
+func logForwardingTagRegex(selector config.LogForwardingSelector) string {
+	var namespaces []string
+	for _, project := range selector.Projects {
+		namespaces = append(namespaces, "shoot--"+regexp.QuoteMeta(project)+"--.+")
+	}
+	if selector.Extensions {
+		namespaces = append(namespaces, "extension-.+")
+	}
+	for _, namespace := range selector.Namespaces {
+		namespaces = append(namespaces, regexp.QuoteMeta(namespace))
+	}
+
+	containers := ".+"
+	if len(selector.Components) > 0 {
+		var quoted []string
+		for _, component := range selector.Components {
+			quoted = append(quoted, regexp.QuoteMeta(component))
+		}
+		containers = strings.Join(quoted, "|")
+	}
+
+	return fmt.Sprintf(`^kubernetes\.var\.log\.containers\.[^_]+_(%s)_(%s)-[0-9a-f]+\.log$`, strings.Join(namespaces, "|"), containers)
+}
+
+func logForwardingRedactionFilterItems(redaction *config.LogForwardingRedaction) []fluentbitv1alpha2.FilterItem {
+	rules := []fluentbitv1alpha2filter.Rule{{Remove: "tag"}}
+	if redaction != nil {
+		for _, key := range redaction.RemoveKeys {
+			rules = append(rules, fluentbitv1alpha2filter.Rule{RemoveWildcard: key})
+		}
+	}
+
+	items := []fluentbitv1alpha2.FilterItem{{Modify: &fluentbitv1alpha2filter.Modify{Rules: rules}}}
+
+	if redaction != nil {
+		// Conditions apply to all rules of a modify filter, hence every masked key needs a dedicated filter so that the
+		// key is only set if it exists in the record.
+		for _, key := range redaction.MaskKeys {
+			items = append(items, fluentbitv1alpha2.FilterItem{
+				Modify: &fluentbitv1alpha2filter.Modify{
+					Conditions: []fluentbitv1alpha2filter.Condition{{KeyExists: key}},
+					Rules:      []fluentbitv1alpha2filter.Rule{{Set: map[string]string{key: RedactedValue}}},
+				},
+			})
+		}
+	}
+
+	return items
+}
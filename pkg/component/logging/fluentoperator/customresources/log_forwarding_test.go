@@ -0,0 +1,199 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customresources_test
+
+import (
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins"
+	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/filter"
+	fluentbitv1alpha2output "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/output"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/pkg/component/logging/fluentoperator/customresources"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("LogForwarding", func() {
+	var (
+		labels      = map[string]string{"some-key": "some-value"}
+		forwardings []config.LogForwarding
+	)
+
+	BeforeEach(func() {
+		forwardings = []config.LogForwarding{
+			{
+				Name:   "siem",
+				Syslog: &config.LogForwardingSyslog{Host: "siem.example.com", Port: pointer.Int32(6514), Format: pointer.String("rfc3164")},
+				Selector: config.LogForwardingSelector{
+					Projects:   []string{"foo", "bar"},
+					Extensions: true,
+					Components: []string{"kube-apiserver", "gardener-resource-manager"},
+				},
+				Redaction: &config.LogForwardingRedaction{
+					RemoveKeys: []string{"user*"},
+					MaskKeys:   []string{"token"},
+				},
+				TLS: &config.LogForwardingTLS{SecretName: "siem-tls", ClientCertificate: true},
+			},
+			{
+				Name:     "otlp",
+				OTLP:     &config.LogForwardingOTLP{Host: "otlp.example.com", Port: pointer.Int32(4318), URI: pointer.String("/v1/logs")},
+				Selector: config.LogForwardingSelector{Namespaces: []string{"garden"}},
+				TLS:      &config.LogForwardingTLS{SecretName: "otlp-ca"},
+			},
+		}
+	})
+
+	Describe("#GetLogForwardingClusterFilters", func() {
+		It("should return the expected ClusterFilters", func() {
+			Expect(GetLogForwardingClusterFilters(forwardings, labels)).To(Equal([]*fluentbitv1alpha2.ClusterFilter{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "04-forwarding-siem", Labels: labels},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "kubernetes.*",
+						FilterItems: []fluentbitv1alpha2.FilterItem{{
+							RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+								Rules:       []string{`$tag ^kubernetes\.var\.log\.containers\.[^_]+_(shoot--foo--.+|shoot--bar--.+|extension-.+)_(kube-apiserver|gardener-resource-manager)-[0-9a-f]+\.log$ forwarding.siem true`},
+								EmitterName: "forwarding-siem",
+							},
+						}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "04-forwarding-siem-redaction", Labels: labels},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "forwarding.siem",
+						FilterItems: []fluentbitv1alpha2.FilterItem{
+							{Modify: &fluentbitv1alpha2filter.Modify{Rules: []fluentbitv1alpha2filter.Rule{{Remove: "tag"}, {RemoveWildcard: "user*"}}}},
+							{Modify: &fluentbitv1alpha2filter.Modify{
+								Conditions: []fluentbitv1alpha2filter.Condition{{KeyExists: "token"}},
+								Rules:      []fluentbitv1alpha2filter.Rule{{Set: map[string]string{"token": "[REDACTED]"}}},
+							}},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "04-forwarding-otlp", Labels: labels},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "kubernetes.*",
+						FilterItems: []fluentbitv1alpha2.FilterItem{{
+							RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+								Rules:       []string{`$tag ^kubernetes\.var\.log\.containers\.[^_]+_(garden)_(.+)-[0-9a-f]+\.log$ forwarding.otlp true`},
+								EmitterName: "forwarding-otlp",
+							},
+						}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "04-forwarding-otlp-redaction", Labels: labels},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "forwarding.otlp",
+						FilterItems: []fluentbitv1alpha2.FilterItem{
+							{Modify: &fluentbitv1alpha2filter.Modify{Rules: []fluentbitv1alpha2filter.Rule{{Remove: "tag"}}}},
+						},
+					},
+				},
+			}))
+		})
+
+		It("should match the names in the selector literally", func() {
+			forwardings = []config.LogForwarding{{
+				Name:     "quoted",
+				Syslog:   &config.LogForwardingSyslog{Host: "siem.example.com"},
+				Selector: config.LogForwardingSelector{Projects: []string{"a.b"}, Namespaces: []string{"x|y"}, Components: []string{"c+"}},
+			}}
+
+			filters := GetLogForwardingClusterFilters(forwardings, labels)
+			Expect(filters).NotTo(BeEmpty())
+			Expect(filters[0].Spec.FilterItems[0].RewriteTag.Rules).To(ConsistOf(
+				`$tag ^kubernetes\.var\.log\.containers\.[^_]+_(shoot--a\.b--.+|x\|y)_(c\+)-[0-9a-f]+\.log$ forwarding.quoted true`,
+			))
+		})
+
+		It("should return no ClusterFilters if no log forwarding is configured", func() {
+			Expect(GetLogForwardingClusterFilters(nil, labels)).To(BeEmpty())
+		})
+	})
+
+	Describe("#GetLogForwardingClusterOutputs", func() {
+		It("should return the expected ClusterOutputs", func() {
+			Expect(GetLogForwardingClusterOutputs(forwardings, labels)).To(Equal([]*fluentbitv1alpha2.ClusterOutput{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "forwarding-siem", Labels: labels},
+					Spec: fluentbitv1alpha2.OutputSpec{
+						Match:      "forwarding.siem",
+						RetryLimit: "5",
+						Syslog: &fluentbitv1alpha2output.Syslog{
+							Host:             "siem.example.com",
+							Port:             pointer.Int32(6514),
+							Mode:             "tls",
+							SyslogFormat:     "rfc3164",
+							SyslogMessageKey: "log",
+							TLS: &plugins.TLS{
+								Verify:  pointer.Bool(true),
+								Vhost:   "siem.example.com",
+								CAFile:  "/fluent-bit/forwarding/siem/tls/ca.crt",
+								CRTFile: "/fluent-bit/forwarding/siem/tls/tls.crt",
+								KeyFile: "/fluent-bit/forwarding/siem/tls/tls.key",
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "forwarding-otlp", Labels: labels},
+					Spec: fluentbitv1alpha2.OutputSpec{
+						Match:      "forwarding.otlp",
+						RetryLimit: "5",
+						OpenTelemetry: &fluentbitv1alpha2output.OpenTelemetry{
+							Host: "otlp.example.com",
+							Port: pointer.Int32(4318),
+							Uri:  "/v1/logs",
+							TLS:  &plugins.TLS{Verify: pointer.Bool(true), Vhost: "otlp.example.com", CAFile: "/fluent-bit/forwarding/otlp/tls/ca.crt"},
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("#GetLogForwardingVolumes", func() {
+		It("should return the volumes and volume mounts for the TLS secrets", func() {
+			volumes, volumeMounts := GetLogForwardingVolumes(forwardings)
+
+			Expect(volumes).To(HaveLen(2))
+			Expect(volumes[0].Secret).To(Equal(&corev1.SecretVolumeSource{SecretName: "siem-tls"}))
+			Expect(volumes[1].Secret).To(Equal(&corev1.SecretVolumeSource{SecretName: "otlp-ca"}))
+			Expect(volumes[0].Name).NotTo(Equal(volumes[1].Name))
+
+			Expect(volumeMounts).To(Equal([]corev1.VolumeMount{
+				{Name: volumes[0].Name, MountPath: "/fluent-bit/forwarding/siem/tls", ReadOnly: true},
+				{Name: volumes[1].Name, MountPath: "/fluent-bit/forwarding/otlp/tls", ReadOnly: true},
+			}))
+		})
+
+		It("should return no volumes if no log forwarding uses TLS secrets", func() {
+			forwardings[0].TLS = nil
+			forwardings[1].TLS = nil
+
+			volumes, volumeMounts := GetLogForwardingVolumes(forwardings)
+			Expect(volumes).To(BeEmpty())
+			Expect(volumeMounts).To(BeEmpty())
+		})
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/logging/fluentoperator/customresources"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 )
@@ -43,6 +44,8 @@ type FluentBitValues struct {
 	InitContainerImage string
 	// PriorityClass is the name of the priority class of the fluent-bit.
 	PriorityClass string
+	// LogForwardings are the configurations for forwarding selected logs to external endpoints.
+	LogForwardings []config.LogForwarding
 }
 
 type fluentBit struct {
@@ -132,9 +135,21 @@ end
 
 	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
 
+	fluentBitLabels := getFluentBitLabels()
+	if len(f.values.LogForwardings) > 0 {
+		// The external log forwarding endpoints can be located in public or private networks.
+		fluentBitLabels[v1beta1constants.LabelNetworkPolicyToPublicNetworks] = v1beta1constants.LabelNetworkPolicyAllowed
+		fluentBitLabels[v1beta1constants.LabelNetworkPolicyToPrivateNetworks] = v1beta1constants.LabelNetworkPolicyAllowed
+	}
+
+	fluentBitCustomResource := customresources.GetFluentBit(fluentBitLabels, v1beta1constants.DaemonSetNameFluentBit, f.namespace, f.values.Image, f.values.InitContainerImage, f.values.PriorityClass)
+	logForwardingVolumes, logForwardingVolumeMounts := customresources.GetLogForwardingVolumes(f.values.LogForwardings)
+	fluentBitCustomResource.Spec.Volumes = append(fluentBitCustomResource.Spec.Volumes, logForwardingVolumes...)
+	fluentBitCustomResource.Spec.VolumesMounts = append(fluentBitCustomResource.Spec.VolumesMounts, logForwardingVolumeMounts...)
+
 	resources := []client.Object{
 		configMap,
-		customresources.GetFluentBit(getFluentBitLabels(), v1beta1constants.DaemonSetNameFluentBit, f.namespace, f.values.Image, f.values.InitContainerImage, f.values.PriorityClass),
+		fluentBitCustomResource,
 		customresources.GetClusterFluentBitConfig(v1beta1constants.DaemonSetNameFluentBit, getCustomResourcesLabels()),
 		customresources.GetDefaultClusterOutput(getCustomResourcesLabels()),
 	}
@@ -151,6 +166,14 @@ end
 		resources = append(resources, clusterParser)
 	}
 
+	for _, clusterFilter := range customresources.GetLogForwardingClusterFilters(f.values.LogForwardings, getCustomResourcesLabels()) {
+		resources = append(resources, clusterFilter)
+	}
+
+	for _, clusterOutput := range customresources.GetLogForwardingClusterOutputs(f.values.LogForwardings, getCustomResourcesLabels()) {
+		resources = append(resources, clusterOutput)
+	}
+
 	serializedResources, err := registry.AddAllAndSerialize(resources...)
 	if err != nil {
 		return err
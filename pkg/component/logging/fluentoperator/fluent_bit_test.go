@@ -34,6 +34,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	. "github.com/gardener/gardener/pkg/component/logging/fluentoperator"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
@@ -128,6 +129,27 @@ var _ = Describe("Fluent Bit", func() {
 			Expect(customResourcesManagedResourceSecret.Data).To(HaveKey("clusterparser____containerd-parser.yaml"))
 			Expect(customResourcesManagedResourceSecret.Data).To(HaveKey("clusteroutput____journald.yaml"))
 		})
+
+		It("should successfully deploy the resources for the log forwardings", func() {
+			forwardingValues := values
+			forwardingValues.LogForwardings = []config.LogForwarding{{
+				Name:     "siem",
+				Syslog:   &config.LogForwardingSyslog{Host: "siem.example.com"},
+				Selector: config.LogForwardingSelector{Projects: []string{"foo"}},
+			}}
+			component = NewFluentBit(c, namespace, forwardingValues)
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(customResourcesManagedResource), customResourcesManagedResource)).To(Succeed())
+			customResourcesManagedResourceSecret.Name = customResourcesManagedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(customResourcesManagedResourceSecret), customResourcesManagedResourceSecret)).To(Succeed())
+			Expect(customResourcesManagedResourceSecret.Data).To(HaveLen(12))
+			Expect(customResourcesManagedResourceSecret.Data).To(HaveKey("fluentbit__" + namespace + "__fluent-bit-7713c.yaml"))
+			Expect(customResourcesManagedResourceSecret.Data).To(HaveKey("clusterfilter____04-forwarding-siem.yaml"))
+			Expect(customResourcesManagedResourceSecret.Data).To(HaveKey("clusterfilter____04-forwarding-siem-redaction.yaml"))
+			Expect(customResourcesManagedResourceSecret.Data).To(HaveKey("clusteroutput____forwarding-siem.yaml"))
+		})
 	})
 
 	Describe("#Destroy", func() {
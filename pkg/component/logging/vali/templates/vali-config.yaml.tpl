@@ -33,7 +33,7 @@ storage_config:
   filesystem:
     directory: /data/vali/chunks
 chunk_store_config:
-  max_look_back_period: 360h
+  max_look_back_period: {{ .RetentionPeriod }}
 table_manager:
   retention_deletes_enabled: true
-  retention_period: 360h
+  retention_period: {{ .RetentionPeriod }}
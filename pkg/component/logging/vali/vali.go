@@ -20,6 +20,7 @@ import (
 	_ "embed"
 	"fmt"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig/v3"
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
@@ -94,6 +95,10 @@ const (
 	kubeRBACProxyName = "kube-rbac-proxy"
 	kubeRBACProxyPort = 8080
 
+	queryClusterRoleName = "gardener.cloud:logging:query"
+
+	defaultRetentionPeriod = 360 * time.Hour
+
 	initLargeDirName = "init-large-dir"
 )
 
@@ -104,8 +109,18 @@ var (
 	//go:embed templates/vali-init.sh
 	valiInitScript string
 
-	//go:embed templates/vali-config.yaml
-	valiConfig string
+	//go:embed templates/vali-config.yaml.tpl
+	valiConfigTplContent string
+	valiConfigTemplate   *template.Template
+
+	// queryAPIPaths are the read-only paths of the Vali HTTP API which are exposed if the query API is enabled.
+	queryAPIPaths = []string{
+		"/vali/api/v1/query",
+		"/vali/api/v1/query_range",
+		"/vali/api/v1/label",
+		"/vali/api/v1/labels",
+		"/vali/api/v1/series",
+	}
 
 	//go:embed templates/telegraf-config.tpl
 	telegrafConfigTplContent string
@@ -119,6 +134,7 @@ var (
 func init() {
 	telegrafStartScriptTemplate = template.Must(template.New("telegraf-config").Funcs(sprig.TxtFuncMap()).Parse(telegrafStartScriptTplContent))
 	telegrafConfigTemplate = template.Must(template.New("telegraf-start").Funcs(sprig.TxtFuncMap()).Parse(telegrafConfigTplContent))
+	valiConfigTemplate = template.Must(template.New("vali-config").Parse(valiConfigTplContent))
 }
 
 // Interface contains functions for a vali deployer.
@@ -144,6 +160,11 @@ type Values struct {
 	HVPAEnabled             bool
 	Storage                 *resource.Quantity
 	MaintenanceTimeWindow   *hvpav1alpha1.MaintenanceTimeWindow
+	// RetentionPeriod is the duration for which logs are retained. It must be a multiple of 24h. Defaults to 360h.
+	RetentionPeriod *time.Duration
+	// QueryAPIEnabled controls whether the query API is exposed via the ingress. Requests are authenticated and
+	// authorized by the kube-rbac-proxy against the target cluster.
+	QueryAPIEnabled bool
 }
 
 type vali struct {
@@ -191,9 +212,15 @@ func (v *vali) Deploy(ctx context.Context) error {
 		kubeRBACProxyShootAccessSecret   = v.newKubeRBACProxyShootAccessSecret()
 	)
 
-	if v.values.ShootNodeLoggingEnabled {
-		if err := valitailShootAccessSecret.Reconcile(ctx, v.client); err != nil {
-			return err
+	if v.isKubeRBACProxyEnabled() {
+		if v.values.ShootNodeLoggingEnabled {
+			if err := valitailShootAccessSecret.Reconcile(ctx, v.client); err != nil {
+				return err
+			}
+		} else {
+			if err := kubernetesutils.DeleteObjects(ctx, v.client, valitailShootAccessSecret.Secret); err != nil {
+				return err
+			}
 		}
 		if err := kubeRBACProxyShootAccessSecret.Reconcile(ctx, v.client); err != nil {
 			return err
@@ -212,35 +239,42 @@ func (v *vali) Deploy(ctx context.Context) error {
 			return err
 		}
 
-		telegrafConfigMap, err := v.getTelegrafConfigMap()
-		if err != nil {
-			return err
-		}
-		telegrafConfigMapName = telegrafConfigMap.Name
-
 		genericTokenKubeconfigSecret, found := v.secretsManager.Get(v1beta1constants.SecretNameGenericTokenKubeconfig)
 		if !found {
 			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
 		}
 		genericTokenKubeconfigSecretName = genericTokenKubeconfigSecret.Name
 
-		resources = append(resources,
-			v.getIngress(ingressTLSSecret.Name),
-			telegrafConfigMap,
-		)
+		resources = append(resources, v.getIngress(ingressTLSSecret.Name))
 
-		resourcesTarget, err := managedresources.
-			NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
-			AddAllAndSerialize(
-				v.getKubeRBACProxyClusterRoleBinding(kubeRBACProxyShootAccessSecret.ServiceAccountName),
+		resourcesTarget := []client.Object{v.getKubeRBACProxyClusterRoleBinding(kubeRBACProxyShootAccessSecret.ServiceAccountName)}
+
+		if v.values.ShootNodeLoggingEnabled {
+			telegrafConfigMap, err := v.getTelegrafConfigMap()
+			if err != nil {
+				return err
+			}
+			telegrafConfigMapName = telegrafConfigMap.Name
+
+			resources = append(resources, telegrafConfigMap)
+			resourcesTarget = append(resourcesTarget,
 				v.getValitailClusterRole(),
 				v.getValitailClusterRoleBinding(valitailShootAccessSecret.ServiceAccountName),
 			)
+		}
+
+		if v.values.QueryAPIEnabled {
+			resourcesTarget = append(resourcesTarget, v.getQueryClusterRole())
+		}
+
+		serializedResourcesTarget, err := managedresources.
+			NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
+			AddAllAndSerialize(resourcesTarget...)
 		if err != nil {
 			return err
 		}
 
-		if err := managedresources.CreateForShoot(ctx, v.client, v.namespace, managedResourceNameTarget, managedresources.LabelValueGardener, false, resourcesTarget); err != nil {
+		if err := managedresources.CreateForShoot(ctx, v.client, v.namespace, managedResourceNameTarget, managedresources.LabelValueGardener, false, serializedResourcesTarget); err != nil {
 			return err
 		}
 	} else {
@@ -289,6 +323,12 @@ func (v *vali) Destroy(ctx context.Context) error {
 	)
 }
 
+// isKubeRBACProxyEnabled returns true if Vali is exposed via an ingress which is protected by the kube-rbac-proxy, i.e.
+// if either valitail pushes node logs or users can query the logs.
+func (v *vali) isKubeRBACProxyEnabled() bool {
+	return v.values.ShootNodeLoggingEnabled || v.values.QueryAPIEnabled
+}
+
 func (v *vali) newValitailShootAccessSecret() *gardenerutils.AccessSecret {
 	return gardenerutils.NewShootAccessSecret("valitail", v.namespace).
 		WithServiceAccountName(valitailName).
@@ -451,13 +491,18 @@ func (v *vali) getHVPA() *hvpav1alpha1.Hvpa {
 		hvpa.Spec.Vpa.ScaleDown.UpdatePolicy.UpdateMode = pointer.String(hvpav1alpha1.UpdateModeMaintenanceWindow)
 	}
 
-	if v.values.ShootNodeLoggingEnabled {
+	if v.isKubeRBACProxyEnabled() {
 		hvpa.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies = append(hvpa.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies,
 			vpaautoscalingv1.ContainerResourcePolicy{
 				ContainerName:    kubeRBACProxyName,
 				Mode:             &containerPolicyOff,
 				ControlledValues: &controlledValues,
 			},
+		)
+	}
+
+	if v.values.ShootNodeLoggingEnabled {
+		hvpa.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies = append(hvpa.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies,
 			vpaautoscalingv1.ContainerResourcePolicy{
 				ContainerName:    telegrafName,
 				Mode:             &containerPolicyOff,
@@ -470,7 +515,31 @@ func (v *vali) getHVPA() *hvpav1alpha1.Hvpa {
 }
 
 func (v *vali) getIngress(secretName string) *networkingv1.Ingress {
-	pathType := networkingv1.PathTypePrefix
+	var (
+		pathType = networkingv1.PathTypePrefix
+		paths    []string
+	)
+
+	if v.values.ShootNodeLoggingEnabled {
+		paths = append(paths, "/vali/api/v1/push")
+	}
+	if v.values.QueryAPIEnabled {
+		paths = append(paths, queryAPIPaths...)
+	}
+
+	httpIngressPaths := make([]networkingv1.HTTPIngressPath, 0, len(paths))
+	for _, path := range paths {
+		httpIngressPaths = append(httpIngressPaths, networkingv1.HTTPIngressPath{
+			Backend: networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: valiServiceName,
+					Port: networkingv1.ServiceBackendPort{Number: kubeRBACProxyPort},
+				},
+			},
+			Path:     path,
+			PathType: &pathType,
+		})
+	}
 
 	ingress := &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
@@ -488,16 +557,7 @@ func (v *vali) getIngress(secretName string) *networkingv1.Ingress {
 				Host: v.values.IngressHost,
 				IngressRuleValue: networkingv1.IngressRuleValue{
 					HTTP: &networkingv1.HTTPIngressRuleValue{
-						Paths: []networkingv1.HTTPIngressPath{{
-							Backend: networkingv1.IngressBackend{
-								Service: &networkingv1.IngressServiceBackend{
-									Name: valiServiceName,
-									Port: networkingv1.ServiceBackendPort{Number: kubeRBACProxyPort},
-								},
-							},
-							Path:     "/vali/api/v1/push",
-							PathType: &pathType,
-						}},
+						Paths: httpIngressPaths,
 					},
 				},
 			}},
@@ -534,14 +594,17 @@ func (v *vali) getService() *corev1.Service {
 		}}
 	)
 
+	if v.isKubeRBACProxyEnabled() {
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Port:       kubeRBACProxyPort,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt32(kubeRBACProxyPort),
+			Name:       "external",
+		})
+	}
+
 	if v.values.ShootNodeLoggingEnabled {
 		service.Spec.Ports = append(service.Spec.Ports,
-			corev1.ServicePort{
-				Port:       kubeRBACProxyPort,
-				Protocol:   corev1.ProtocolTCP,
-				TargetPort: intstr.FromInt32(kubeRBACProxyPort),
-				Name:       "external",
-			},
 			corev1.ServicePort{
 				Port:       telegrafServicePort,
 				Protocol:   corev1.ProtocolTCP,
@@ -569,6 +632,16 @@ func (v *vali) getService() *corev1.Service {
 }
 
 func (v *vali) getValiConfigMap() (*corev1.ConfigMap, error) {
+	retentionPeriod := defaultRetentionPeriod
+	if v.values.RetentionPeriod != nil {
+		retentionPeriod = *v.values.RetentionPeriod
+	}
+
+	var valiConfig bytes.Buffer
+	if err := valiConfigTemplate.Execute(&valiConfig, map[string]interface{}{"RetentionPeriod": fmt.Sprintf("%dh", int64(retentionPeriod.Hours()))}); err != nil {
+		return nil, fmt.Errorf("failed to render vali configuration: %w", err)
+	}
+
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "vali-config",
@@ -576,7 +649,7 @@ func (v *vali) getValiConfigMap() (*corev1.ConfigMap, error) {
 			Labels:    getLabels(),
 		},
 		Data: map[string]string{
-			valiDataKeyConfig:     valiConfig,
+			valiDataKeyConfig:     valiConfig.String(),
 			curatorDataKeyConfig:  curatorConfig,
 			valiDataKeyInitScript: valiInitScript,
 		},
@@ -820,7 +893,7 @@ fi
 		statefulSet.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage] = *v.values.Storage
 	}
 
-	if v.values.ShootNodeLoggingEnabled {
+	if v.isKubeRBACProxyEnabled() {
 		statefulSet.Spec.Template.Labels[v1beta1constants.LabelNetworkPolicyToDNS] = v1beta1constants.LabelNetworkPolicyAllowed
 		statefulSet.Spec.Template.Labels[gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port)] = v1beta1constants.LabelNetworkPolicyAllowed
 		statefulSet.Spec.Template.Spec.Containers = append(statefulSet.Spec.Template.Spec.Containers,
@@ -855,6 +928,11 @@ fi
 					ReadOnlyRootFilesystem: pointer.Bool(true),
 				},
 			},
+		)
+	}
+
+	if v.values.ShootNodeLoggingEnabled {
+		statefulSet.Spec.Template.Spec.Containers = append(statefulSet.Spec.Template.Spec.Containers,
 			corev1.Container{
 				Name:  telegrafName,
 				Image: v.values.TelegrafImage,
@@ -912,7 +990,9 @@ wait
 				},
 			},
 		})
+	}
 
+	if v.isKubeRBACProxyEnabled() {
 		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(statefulSet, genericTokenKubeconfigSecretName, "shoot-access-"+kubeRBACProxyName, kubeRBACProxyName))
 	}
 
@@ -969,6 +1049,25 @@ func (v *vali) getValitailClusterRole() *rbacv1.ClusterRole {
 	}
 }
 
+func (v *vali) getQueryClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   queryClusterRoleName,
+			Labels: map[string]string{v1beta1constants.LabelApp: valiName},
+		},
+		Rules: []rbacv1.PolicyRule{{
+			NonResourceURLs: []string{
+				"/vali/api/v1/query",
+				"/vali/api/v1/query_range",
+				"/vali/api/v1/label/*",
+				"/vali/api/v1/labels",
+				"/vali/api/v1/series",
+			},
+			Verbs: []string{"get"},
+		}},
+	}
+}
+
 func (v *vali) getValitailClusterRoleBinding(serviceAccountName string) *rbacv1.ClusterRoleBinding {
 	return &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1038,7 +1137,7 @@ func (v *vali) resizeOrDeleteValiDataVolumeIfStorageNotTheSame(ctx context.Conte
 		return err
 	}
 
-	if err := kubernetes.ScaleStatefulSetAndWaitUntilScaled(ctx, v.client, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: v1beta1constants.StatefulSetNameVali}, 0); client.IgnoreNotFound(err) != nil {
+	if err := kubernetes.ScaleStatefulSetAndWaitUntilScaled(ctx, v.client, client.ObjectKey{Namespace: v.namespace, Name: v1beta1constants.StatefulSetNameVali}, 0); client.IgnoreNotFound(err) != nil {
 		return err
 	}
 
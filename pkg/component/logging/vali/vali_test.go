@@ -17,6 +17,7 @@ package vali_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -193,11 +194,11 @@ var _ = Describe("Vali", func() {
 			Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 
 			Expect(string(managedResourceSecret.Data["configmap__shoot--foo--bar__"+telegrafConfigMapName+".yaml"])).To(Equal(test.Serialize(getTelegrafConfigMap())))
-			Expect(string(managedResourceSecret.Data["configmap__shoot--foo--bar__"+valiConfigMapName+".yaml"])).To(Equal(test.Serialize(getValiConfigMap())))
-			Expect(string(managedResourceSecret.Data["hvpa__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getHVPA(true))))
-			Expect(string(managedResourceSecret.Data["ingress__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getIngress())))
-			Expect(string(managedResourceSecret.Data["service__shoot--foo--bar__logging.yaml"])).To(Equal(test.Serialize(getService(true, "shoot"))))
-			Expect(string(managedResourceSecret.Data["statefulset__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getStatefulSet(true))))
+			Expect(string(managedResourceSecret.Data["configmap__shoot--foo--bar__"+valiConfigMapName+".yaml"])).To(Equal(test.Serialize(getValiConfigMap("360h"))))
+			Expect(string(managedResourceSecret.Data["hvpa__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getHVPA(true, true))))
+			Expect(string(managedResourceSecret.Data["ingress__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getIngress("/vali/api/v1/push"))))
+			Expect(string(managedResourceSecret.Data["service__shoot--foo--bar__logging.yaml"])).To(Equal(test.Serialize(getService(true, true, "shoot"))))
+			Expect(string(managedResourceSecret.Data["statefulset__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getStatefulSet(true, true))))
 
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceTarget), managedResourceTarget)).To(Succeed())
 			expectedTargetMr := &resourcesv1alpha1.ManagedResource{
@@ -234,6 +235,60 @@ var _ = Describe("Vali", func() {
 			Expect(string(managedResourceSecretTarget.Data["clusterrolebinding____gardener.cloud_logging_valitail.yaml"])).To(Equal(test.Serialize(getValitailClusterRoleBinding())))
 		})
 
+		It("should successfully deploy all resources for shoot with query API and custom retention period", func() {
+			valiDeployer := New(
+				c,
+				namespace,
+				fakeSecretManager,
+				Values{
+					Replicas:              1,
+					Storage:               &storage,
+					QueryAPIEnabled:       true,
+					RetentionPeriod:       pointer.Duration(7 * 24 * time.Hour),
+					HVPAEnabled:           true,
+					MaintenanceTimeWindow: &hvpav1alpha1.MaintenanceTimeWindow{Begin: maintenanceBegin, End: maintenanceEnd},
+					ValiImage:             valiImage,
+					CuratorImage:          curatorImage,
+					RenameLokiToValiImage: alpineImage,
+					InitLargeDirImage:     initLargeDirImage,
+					TelegrafImage:         telegrafImage,
+					KubeRBACProxyImage:    kubeRBACProxyImage,
+					PriorityClassName:     priorityClassName,
+					ClusterType:           "shoot",
+					IngressHost:           valiHost,
+				},
+			)
+
+			Expect(valiDeployer.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: valitailShootAccessSecretName, Namespace: namespace}, &corev1.Secret{})).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKey{Name: kubeRBacProxyShootAccessSecretName, Namespace: namespace}, &corev1.Secret{})).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Data).To(HaveLen(5))
+
+			valiConfigMap := getValiConfigMap("168h")
+			Expect(string(managedResourceSecret.Data["configmap__shoot--foo--bar__"+valiConfigMap.Name+".yaml"])).To(Equal(test.Serialize(valiConfigMap)))
+			Expect(string(managedResourceSecret.Data["hvpa__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getHVPA(true, false))))
+			Expect(string(managedResourceSecret.Data["ingress__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getIngress(
+				"/vali/api/v1/query",
+				"/vali/api/v1/query_range",
+				"/vali/api/v1/label",
+				"/vali/api/v1/labels",
+				"/vali/api/v1/series",
+			))))
+			Expect(string(managedResourceSecret.Data["service__shoot--foo--bar__logging.yaml"])).To(Equal(test.Serialize(getService(true, false, "shoot"))))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceTarget), managedResourceTarget)).To(Succeed())
+			managedResourceSecretTarget.Name = managedResourceTarget.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretTarget), managedResourceSecretTarget)).To(Succeed())
+			Expect(managedResourceSecretTarget.Data).To(HaveLen(2))
+			Expect(string(managedResourceSecretTarget.Data["clusterrolebinding____gardener.cloud_logging_kube-rbac-proxy.yaml"])).To(Equal(test.Serialize(getKubeRBACProxyClusterRoleBinding())))
+			Expect(string(managedResourceSecretTarget.Data["clusterrole____gardener.cloud_logging_query.yaml"])).To(Equal(test.Serialize(getQueryClusterRole())))
+		})
+
 		It("should successfully deploy all resources for seed", func() {
 			valiDeployer := New(
 				c,
@@ -300,10 +355,10 @@ var _ = Describe("Vali", func() {
 			Expect(managedResourceSecret.Immutable).To(Equal(pointer.Bool(true)))
 			Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 
-			Expect(string(managedResourceSecret.Data["configmap__shoot--foo--bar__"+valiConfigMapName+".yaml"])).To(Equal(test.Serialize(getValiConfigMap())))
-			Expect(string(managedResourceSecret.Data["hvpa__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getHVPA(false))))
-			Expect(string(managedResourceSecret.Data["service__shoot--foo--bar__logging.yaml"])).To(Equal(test.Serialize(getService(false, "seed"))))
-			Expect(string(managedResourceSecret.Data["statefulset__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getStatefulSet(false))))
+			Expect(string(managedResourceSecret.Data["configmap__shoot--foo--bar__"+valiConfigMapName+".yaml"])).To(Equal(test.Serialize(getValiConfigMap("360h"))))
+			Expect(string(managedResourceSecret.Data["hvpa__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getHVPA(false, false))))
+			Expect(string(managedResourceSecret.Data["service__shoot--foo--bar__logging.yaml"])).To(Equal(test.Serialize(getService(false, false, "seed"))))
+			Expect(string(managedResourceSecret.Data["statefulset__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getStatefulSet(false, false))))
 		})
 
 		It("should successfully deploy all resources for seed without HVPA", func() {
@@ -357,9 +412,9 @@ var _ = Describe("Vali", func() {
 			Expect(managedResourceSecret.Immutable).To(Equal(pointer.Bool(true)))
 			Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 
-			Expect(string(managedResourceSecret.Data["configmap__shoot--foo--bar__"+valiConfigMapName+".yaml"])).To(Equal(test.Serialize(getValiConfigMap())))
-			Expect(string(managedResourceSecret.Data["service__shoot--foo--bar__logging.yaml"])).To(Equal(test.Serialize(getService(false, "seed"))))
-			Expect(string(managedResourceSecret.Data["statefulset__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getStatefulSet(false))))
+			Expect(string(managedResourceSecret.Data["configmap__shoot--foo--bar__"+valiConfigMapName+".yaml"])).To(Equal(test.Serialize(getValiConfigMap("360h"))))
+			Expect(string(managedResourceSecret.Data["service__shoot--foo--bar__logging.yaml"])).To(Equal(test.Serialize(getService(false, false, "seed"))))
+			Expect(string(managedResourceSecret.Data["statefulset__shoot--foo--bar__vali.yaml"])).To(Equal(test.Serialize(getStatefulSet(false, false))))
 		})
 	})
 
@@ -803,7 +858,7 @@ var _ = Describe("Vali", func() {
 	})
 })
 
-func getService(isRBACProxyEnabled bool, clusterType string) *corev1.Service {
+func getService(isRBACProxyEnabled, isTelegrafEnabled bool, clusterType string) *corev1.Service {
 	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        "logging",
@@ -826,31 +881,32 @@ func getService(isRBACProxyEnabled bool, clusterType string) *corev1.Service {
 	}
 
 	if isRBACProxyEnabled {
-		svc.Spec.Ports = append(svc.Spec.Ports, []corev1.ServicePort{
-			{
-				Port:       8080,
-				Protocol:   corev1.ProtocolTCP,
-				TargetPort: intstr.FromInt32(8080),
-				Name:       "external",
-			},
-			{
-				Port:       9273,
-				Protocol:   corev1.ProtocolTCP,
-				TargetPort: intstr.FromInt32(9273),
-				Name:       "telegraf",
-			},
-		}...)
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Port:       8080,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt32(8080),
+			Name:       "external",
+		})
+	}
+
+	if isTelegrafEnabled {
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Port:       9273,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt32(9273),
+			Name:       "telegraf",
+		})
 	}
 
 	switch clusterType {
 	case "seed":
-		if isRBACProxyEnabled {
+		if isTelegrafEnabled {
 			svc.Annotations["networking.resources.gardener.cloud/from-all-seed-scrape-targets-allowed-ports"] = `[{"protocol":"TCP","port":3100},{"protocol":"TCP","port":9273}]`
 		} else {
 			svc.Annotations["networking.resources.gardener.cloud/from-all-seed-scrape-targets-allowed-ports"] = `[{"protocol":"TCP","port":3100}]`
 		}
 	case "shoot":
-		if isRBACProxyEnabled {
+		if isTelegrafEnabled {
 			svc.Annotations["networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports"] = `[{"protocol":"TCP","port":3100},{"protocol":"TCP","port":9273}]`
 		} else {
 			svc.Annotations["networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports"] = `[{"protocol":"TCP","port":3100}]`
@@ -862,7 +918,7 @@ func getService(isRBACProxyEnabled bool, clusterType string) *corev1.Service {
 	return svc
 }
 
-func getValiConfigMap() *corev1.ConfigMap {
+func getValiConfigMap(retentionPeriod string) *corev1.ConfigMap {
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "vali-config",
@@ -905,10 +961,10 @@ storage_config:
   filesystem:
     directory: /data/vali/chunks
 chunk_store_config:
-  max_look_back_period: 360h
+  max_look_back_period: ` + retentionPeriod + `
 table_manager:
   retention_deletes_enabled: true
-  retention_period: 360h
+  retention_period: ` + retentionPeriod + `
 `,
 			"curator.yaml": `LogLevel: info
 DiskPath: /data/vali/chunks
@@ -982,7 +1038,7 @@ wait
 	return configMap
 }
 
-func getHVPA(isRBACProxyEnabled bool) *hvpav1alpha1.Hvpa {
+func getHVPA(isRBACProxyEnabled, isTelegrafEnabled bool) *hvpav1alpha1.Hvpa {
 	controlledValues := vpaautoscalingv1.ContainerControlledValuesRequestsOnly
 	containerPolicyOff := vpaautoscalingv1.ContainerScalingModeOff
 
@@ -1134,24 +1190,43 @@ func getHVPA(isRBACProxyEnabled bool) *hvpav1alpha1.Hvpa {
 
 	if isRBACProxyEnabled {
 		obj.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies = append(obj.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies,
-			[]vpaautoscalingv1.ContainerResourcePolicy{
-				{
-					ContainerName:    "kube-rbac-proxy",
-					Mode:             &containerPolicyOff,
-					ControlledValues: &controlledValues,
-				},
-				{
-					ContainerName:    "telegraf",
-					Mode:             &containerPolicyOff,
-					ControlledValues: &controlledValues,
-				},
-			}...)
+			vpaautoscalingv1.ContainerResourcePolicy{
+				ContainerName:    "kube-rbac-proxy",
+				Mode:             &containerPolicyOff,
+				ControlledValues: &controlledValues,
+			})
+	}
+
+	if isTelegrafEnabled {
+		obj.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies = append(obj.Spec.Vpa.Template.Spec.ResourcePolicy.ContainerPolicies,
+			vpaautoscalingv1.ContainerResourcePolicy{
+				ContainerName:    "telegraf",
+				Mode:             &containerPolicyOff,
+				ControlledValues: &controlledValues,
+			})
 	}
 	return obj
 }
 
-func getIngress() *networkingv1.Ingress {
+func getIngress(paths ...string) *networkingv1.Ingress {
 	pathType := networkingv1.PathTypePrefix
+
+	var httpIngressPaths []networkingv1.HTTPIngressPath
+	for _, path := range paths {
+		httpIngressPaths = append(httpIngressPaths, networkingv1.HTTPIngressPath{
+			Backend: networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: "logging",
+					Port: networkingv1.ServiceBackendPort{
+						Number: 8080,
+					},
+				},
+			},
+			Path:     path,
+			PathType: &pathType,
+		})
+	}
+
 	return &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      valiName,
@@ -1171,20 +1246,7 @@ func getIngress() *networkingv1.Ingress {
 					Host: valiHost,
 					IngressRuleValue: networkingv1.IngressRuleValue{
 						HTTP: &networkingv1.HTTPIngressRuleValue{
-							Paths: []networkingv1.HTTPIngressPath{
-								{
-									Backend: networkingv1.IngressBackend{
-										Service: &networkingv1.IngressServiceBackend{
-											Name: "logging",
-											Port: networkingv1.ServiceBackendPort{
-												Number: 8080,
-											},
-										},
-									},
-									Path:     "/vali/api/v1/push",
-									PathType: &pathType,
-								},
-							},
+							Paths: httpIngressPaths,
 						},
 					},
 				},
@@ -1193,7 +1255,7 @@ func getIngress() *networkingv1.Ingress {
 	}
 }
 
-func getStatefulSet(isRBACProxyEnabled bool) *appsv1.StatefulSet {
+func getStatefulSet(isRBACProxyEnabled, isTelegrafEnabled bool) *appsv1.StatefulSet {
 	fsGroupChangeOnRootMismatch := corev1.FSGroupChangeOnRootMismatch
 	sts := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1450,6 +1512,11 @@ fi
 					ReadOnlyRootFilesystem: pointer.Bool(true),
 				},
 			},
+		}...)
+	}
+
+	if isTelegrafEnabled {
+		sts.Spec.Template.Spec.Containers = append(sts.Spec.Template.Spec.Containers, []corev1.Container{
 			{
 				Name:  "telegraf",
 				Image: telegrafImage,
@@ -1502,17 +1569,20 @@ wait
 			},
 		}...)
 
-		sts.Spec.Template.Spec.Volumes = append(sts.Spec.Template.Spec.Volumes, []corev1.Volume{
-			{
-				Name: "telegraf-config-volume",
-				VolumeSource: corev1.VolumeSource{
-					ConfigMap: &corev1.ConfigMapVolumeSource{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: telegrafConfigMapName,
-						},
+		sts.Spec.Template.Spec.Volumes = append(sts.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: "telegraf-config-volume",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: telegrafConfigMapName,
 					},
 				},
 			},
+		})
+	}
+
+	if isRBACProxyEnabled {
+		sts.Spec.Template.Spec.Volumes = append(sts.Spec.Template.Spec.Volumes, []corev1.Volume{
 			{
 				Name: "kubeconfig",
 				VolumeSource: corev1.VolumeSource{
@@ -1608,6 +1678,25 @@ func getValitailClusterRole() *rbacv1.ClusterRole {
 	}
 }
 
+func getQueryClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "gardener.cloud:logging:query",
+			Labels: map[string]string{"app": "vali"},
+		},
+		Rules: []rbacv1.PolicyRule{{
+			NonResourceURLs: []string{
+				"/vali/api/v1/query",
+				"/vali/api/v1/query_range",
+				"/vali/api/v1/label/*",
+				"/vali/api/v1/labels",
+				"/vali/api/v1/series",
+			},
+			Verbs: []string{"get"},
+		}},
+	}
+}
+
 func getValitailClusterRoleBinding() *rbacv1.ClusterRoleBinding {
 	return &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,151 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// ConfigMapNameCentralRecordingRules is the name of the ConfigMap containing the recording rules of the shoot
+	// Prometheus which have to be evaluated by the central store if Prometheus runs in agent mode.
+	ConfigMapNameCentralRecordingRules = "prometheus-central-recording-rules"
+	// LabelCentralRecordingRules is the label of the ConfigMaps containing the recording rules which have to be evaluated
+	// by the central store.
+	LabelCentralRecordingRules = "monitoring.gardener.cloud/central-recording-rules"
+	// AnnotationTenant is the annotation of the ConfigMaps containing the recording rules which have to be evaluated by
+	// the central store. It contains the tenant ID under which the samples of the shoot are written to the central store.
+	AnnotationTenant = "monitoring.gardener.cloud/tenant"
+)
+
+type ruleFile struct {
+	Groups []ruleGroup `json:"groups"`
+}
+
+type ruleGroup struct {
+	Name     string                   `json:"name"`
+	Interval string                   `json:"interval,omitempty"`
+	Rules    []map[string]interface{} `json:"rules"`
+}
+
+// CentralRecordingRules returns the recording rules of the shoot Prometheus which have to be evaluated by the central
+// store if Prometheus runs in agent mode. The customized rule files replace the rule files with the same name, and the
+// additional rules contain the rule files of the monitoring components and extensions in YAML format. It returns rule
+// files which only contain the groups with recording rules, keyed by the names of the rule files. Rule files without
+// recording rules are omitted.
+func CentralRecordingRules(workerless bool, customizedRules map[string]string, additionalRules string) (map[string]string, error) {
+	ruleFiles, err := shootRuleFiles(workerless)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, content := range customizedRules {
+		ruleFiles[name] = content
+	}
+
+	additionalRuleFiles := map[string]string{}
+	if err := yaml.Unmarshal([]byte(additionalRules), &additionalRuleFiles); err != nil {
+		return nil, fmt.Errorf("failed decoding additional rule files: %w", err)
+	}
+	for name, content := range additionalRuleFiles {
+		ruleFiles[name] = content
+	}
+
+	recordingRules := map[string]string{}
+	for name, content := range ruleFiles {
+		file := &ruleFile{}
+		if err := yaml.Unmarshal([]byte(content), file); err != nil {
+			return nil, fmt.Errorf("failed decoding rule file %q: %w", name, err)
+		}
+
+		var groups []ruleGroup
+		for _, group := range file.Groups {
+			var rules []map[string]interface{}
+			for _, rule := range group.Rules {
+				if _, ok := rule["record"]; ok {
+					rules = append(rules, rule)
+				}
+			}
+
+			if len(rules) > 0 {
+				group.Rules = rules
+				groups = append(groups, group)
+			}
+		}
+
+		if len(groups) == 0 {
+			continue
+		}
+
+		data, err := yaml.Marshal(&ruleFile{Groups: groups})
+		if err != nil {
+			return nil, err
+		}
+		recordingRules[name] = string(data)
+	}
+
+	return recordingRules, nil
+}
+
+func (m *monitoring) agentValues() map[string]interface{} {
+	agent := m.values.PrometheusAgent
+	if agent == nil {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	values := map[string]interface{}{
+		"enabled": true,
+		"url":     agent.URL,
+	}
+	if agent.TenantHeader != nil {
+		values["tenantHeader"] = *agent.TenantHeader
+	}
+	return values
+}
+
+// reconcileCentralRecordingRules hands over the recording rules of the shoot Prometheus to the central store if
+// Prometheus runs in agent mode, since it does not evaluate any rules in this mode.
+func (m *monitoring) reconcileCentralRecordingRules(ctx context.Context, customizedRules map[string]string, additionalRules string) error {
+	configMap := m.newCentralRecordingRulesConfigMap()
+
+	if m.values.PrometheusAgent == nil {
+		return kubernetesutils.DeleteObject(ctx, m.client, configMap)
+	}
+
+	recordingRules, err := CentralRecordingRules(m.values.IsWorkerless, customizedRules, additionalRules)
+	if err != nil {
+		return err
+	}
+
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, m.client, configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, LabelCentralRecordingRules, "true")
+		metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, AnnotationTenant, m.namespace)
+		configMap.Data = recordingRules
+		return nil
+	})
+	return err
+}
+
+func (m *monitoring) newCentralRecordingRulesConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapNameCentralRecordingRules, Namespace: m.namespace}}
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/component/monitoring"
+)
+
+var _ = Describe("Agent", func() {
+	Describe("#CentralRecordingRules", func() {
+		It("should return the recording rules of the shoot Prometheus", func() {
+			recordingRules, err := CentralRecordingRules(false, nil, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(recordingRules).To(HaveKey("networking.rules.yaml"))
+			Expect(recordingRules["networking.rules.yaml"]).To(ContainSubstring("record: shoot:container_network_transmit_bytes_total_vpn:sum"))
+			Expect(recordingRules["networking.rules.yaml"]).NotTo(ContainSubstring("alert:"))
+		})
+
+		It("should omit the rule files without recording rules", func() {
+			recordingRules, err := CentralRecordingRules(false, nil, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(recordingRules).NotTo(HaveKey("prometheus.rules.yaml"))
+		})
+
+		It("should consider the customized and additional rule files", func() {
+			customizedRules := map[string]string{
+				"networking.rules.yaml": `groups:
+- name: networking.rules
+  rules:
+  - record: customized:sum
+    expr: sum(foo)
+`,
+			}
+			additionalRules := `component.rules.yaml: |
+  groups:
+  - name: component.rules
+    interval: 30s
+    rules:
+    - alert: ComponentDown
+      expr: absent(up{job="component"} == 1)
+    - record: component:up:sum
+      expr: sum(up{job="component"})
+      labels:
+        foo: bar
+alerts-only.rules.yaml: |
+  groups:
+  - name: alerts-only.rules
+    rules:
+    - alert: Foo
+      expr: vector(1)
+`
+
+			recordingRules, err := CentralRecordingRules(false, customizedRules, additionalRules)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(recordingRules).To(HaveKeyWithValue("networking.rules.yaml", `groups:
+- name: networking.rules
+  rules:
+  - expr: sum(foo)
+    record: customized:sum
+`))
+			Expect(recordingRules).To(HaveKeyWithValue("component.rules.yaml", `groups:
+- interval: 30s
+  name: component.rules
+  rules:
+  - expr: sum(up{job="component"})
+    labels:
+      foo: bar
+    record: component:up:sum
+`))
+			Expect(recordingRules).NotTo(HaveKey("alerts-only.rules.yaml"))
+		})
+
+		It("should fail if the additional rules cannot be decoded", func() {
+			_, err := CentralRecordingRules(false, nil, "foo")
+			Expect(err).To(MatchError(ContainSubstring("failed decoding additional rule files")))
+		})
+
+		It("should fail if a rule file cannot be decoded", func() {
+			_, err := CentralRecordingRules(false, map[string]string{"foo.rules.yaml": "groups: foo"}, "")
+			Expect(err).To(MatchError(ContainSubstring(`failed decoding rule file "foo.rules.yaml"`)))
+		})
+	})
+})
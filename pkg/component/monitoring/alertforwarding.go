@@ -0,0 +1,151 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// AlertMetadata contains the garden metadata which is added to the alerts of the shoot monitoring stack.
+type AlertMetadata struct {
+	// ProjectOwner is the name of the owner of the shoot's project.
+	ProjectOwner string
+	// SeedName is the name of the seed hosting the shoot's control plane.
+	SeedName string
+	// ShootPurpose is the purpose of the shoot.
+	ShootPurpose string
+}
+
+// AlertEnrichmentRelabelConfigs computes the Prometheus alert relabel configs which add the given garden metadata as
+// well as the links to the runbooks from the given catalog to the alerts.
+func AlertEnrichmentRelabelConfigs(metadata AlertMetadata, runbooks map[string]string) []map[string]interface{} {
+	var configs []map[string]interface{}
+
+	for _, label := range []struct{ key, value string }{
+		{"project_owner", metadata.ProjectOwner},
+		{"seed", metadata.SeedName},
+		{"shoot_purpose", metadata.ShootPurpose},
+	} {
+		if label.value == "" {
+			continue
+		}
+		configs = append(configs, map[string]interface{}{
+			"target_label": label.key,
+			"replacement":  label.value,
+			"action":       "replace",
+		})
+	}
+
+	for _, alertName := range sets.List(sets.KeySet(runbooks)) {
+		configs = append(configs, map[string]interface{}{
+			"source_labels": []string{"alertname"},
+			"regex":         regexp.QuoteMeta(alertName),
+			"target_label":  "runbook_url",
+			"replacement":   runbooks[alertName],
+			"action":        "replace",
+		})
+	}
+
+	return configs
+}
+
+const (
+	jiraMessageTemplate     = `[{{ .CommonLabels.severity }}] {{ .CommonLabels.alertname }} in shoot {{ .CommonLabels.project }}/{{ .CommonLabels.shoot_name }}`
+	jiraDescriptionTemplate = `{{ range .Alerts }}{{ .Annotations.summary }}
+{{ .Annotations.description }}
+{{ end }}`
+	jiraPriorityTemplate = `{{ if eq .CommonLabels.severity "blocker" }}P1{{ else if eq .CommonLabels.severity "critical" }}P2{{ else if eq .CommonLabels.severity "warning" }}P3{{ else }}P5{{ end }}`
+)
+
+// AlertForwardingRouteAndReceiver computes the Alertmanager route and receiver for the given ticketing webhook. The
+// given secret contains the URL of the webhook and the credentials.
+func AlertForwardingRouteAndReceiver(webhook gardenletconfig.AlertForwardingWebhook, secret *corev1.Secret) (map[string]interface{}, map[string]interface{}, error) {
+	url, ok := secret.Data["url"]
+	if !ok {
+		return nil, nil, fmt.Errorf("secret %s/%s of alert forwarding webhook %q does not contain a url", secret.Namespace, secret.Name, webhook.Name)
+	}
+
+	receiverName := "forward-" + webhook.Name
+
+	// Alerts are grouped by name and severity so that each notification results in one ticket per alert.
+	route := map[string]interface{}{
+		"receiver": receiverName,
+		"group_by": []string{"alertname", "severity"},
+		"continue": true,
+	}
+	if len(webhook.Severities) > 0 {
+		route["match_re"] = map[string]interface{}{
+			"severity": "^(" + strings.Join(webhook.Severities, "|") + ")$",
+		}
+	}
+
+	receiver := map[string]interface{}{
+		"name": receiverName,
+	}
+
+	switch webhook.Format {
+	case gardenletconfig.AlertForwardingFormatJira:
+		apiKey, ok := secret.Data["apiKey"]
+		if !ok {
+			return nil, nil, fmt.Errorf("secret %s/%s of alert forwarding webhook %q does not contain an apiKey", secret.Namespace, secret.Name, webhook.Name)
+		}
+
+		receiver["opsgenie_configs"] = []map[string]interface{}{{
+			"api_url":       string(url),
+			"api_key":       string(apiKey),
+			"send_resolved": true,
+			"source":        "gardener",
+			"message":       jiraMessageTemplate,
+			"description":   jiraDescriptionTemplate,
+			"priority":      jiraPriorityTemplate,
+			"tags":          "gardener,{{ .CommonLabels.severity }}",
+			"details": map[string]string{
+				"project":       "{{ .CommonLabels.project }}",
+				"project_owner": "{{ .CommonLabels.project_owner }}",
+				"shoot":         "{{ .CommonLabels.shoot_name }}",
+				"shoot_purpose": "{{ .CommonLabels.shoot_purpose }}",
+				"seed":          "{{ .CommonLabels.seed }}",
+				"runbook_url":   "{{ .CommonLabels.runbook_url }}",
+			},
+		}}
+
+	case gardenletconfig.AlertForwardingFormatAlertmanager, gardenletconfig.AlertForwardingFormatServiceNow:
+		webhookConfig := map[string]interface{}{
+			"url":           string(url),
+			"send_resolved": true,
+		}
+		if username, password := secret.Data["username"], secret.Data["password"]; len(username) > 0 && len(password) > 0 {
+			webhookConfig["http_config"] = map[string]interface{}{
+				"basic_auth": map[string]interface{}{
+					"username": string(username),
+					"password": string(password),
+				},
+			}
+		}
+		receiver["webhook_configs"] = []map[string]interface{}{webhookConfig}
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported format %q of alert forwarding webhook %q", webhook.Format, webhook.Name)
+	}
+
+	return route, receiver, nil
+}
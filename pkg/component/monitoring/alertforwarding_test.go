@@ -0,0 +1,151 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/component/monitoring"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("AlertForwarding", func() {
+	Describe("#AlertEnrichmentRelabelConfigs", func() {
+		It("should return nothing if there is no metadata and no runbook", func() {
+			Expect(AlertEnrichmentRelabelConfigs(AlertMetadata{}, nil)).To(BeEmpty())
+		})
+
+		It("should compute the relabel configs for the metadata and the runbooks", func() {
+			Expect(AlertEnrichmentRelabelConfigs(
+				AlertMetadata{ProjectOwner: "john.doe@example.com", SeedName: "aws-eu1", ShootPurpose: "production"},
+				map[string]string{
+					"KubeletTooManyPods":    "https://runbooks.example.com/kubelet",
+					"ApiServerNotReachable": "https://runbooks.example.com/apiserver",
+				},
+			)).To(Equal([]map[string]interface{}{
+				{"target_label": "project_owner", "replacement": "john.doe@example.com", "action": "replace"},
+				{"target_label": "seed", "replacement": "aws-eu1", "action": "replace"},
+				{"target_label": "shoot_purpose", "replacement": "production", "action": "replace"},
+				{"source_labels": []string{"alertname"}, "regex": "ApiServerNotReachable", "target_label": "runbook_url", "replacement": "https://runbooks.example.com/apiserver", "action": "replace"},
+				{"source_labels": []string{"alertname"}, "regex": "KubeletTooManyPods", "target_label": "runbook_url", "replacement": "https://runbooks.example.com/kubelet", "action": "replace"},
+			}))
+		})
+	})
+
+	Describe("#AlertForwardingRouteAndReceiver", func() {
+		var secret *corev1.Secret
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ticketing", Namespace: "garden"},
+				Data: map[string][]byte{
+					"url":      []byte("https://tickets.example.com"),
+					"username": []byte("user"),
+					"password": []byte("pass"),
+					"apiKey":   []byte("key"),
+				},
+			}
+		})
+
+		It("should compute the route and receiver for the Alertmanager format", func() {
+			route, receiver, err := AlertForwardingRouteAndReceiver(gardenletconfig.AlertForwardingWebhook{
+				Name:       "tickets",
+				Format:     gardenletconfig.AlertForwardingFormatAlertmanager,
+				Severities: []string{"blocker", "critical"},
+			}, secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(route).To(Equal(map[string]interface{}{
+				"receiver": "forward-tickets",
+				"group_by": []string{"alertname", "severity"},
+				"continue": true,
+				"match_re": map[string]interface{}{"severity": "^(blocker|critical)$"},
+			}))
+			Expect(receiver).To(Equal(map[string]interface{}{
+				"name": "forward-tickets",
+				"webhook_configs": []map[string]interface{}{{
+					"url":           "https://tickets.example.com",
+					"send_resolved": true,
+					"http_config": map[string]interface{}{
+						"basic_auth": map[string]interface{}{"username": "user", "password": "pass"},
+					},
+				}},
+			}))
+		})
+
+		It("should compute the route and receiver for the ServiceNow format without credentials", func() {
+			delete(secret.Data, "username")
+			delete(secret.Data, "password")
+
+			route, receiver, err := AlertForwardingRouteAndReceiver(gardenletconfig.AlertForwardingWebhook{
+				Name:   "servicenow",
+				Format: gardenletconfig.AlertForwardingFormatServiceNow,
+			}, secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(route).To(Equal(map[string]interface{}{
+				"receiver": "forward-servicenow",
+				"group_by": []string{"alertname", "severity"},
+				"continue": true,
+			}))
+			Expect(receiver).To(Equal(map[string]interface{}{
+				"name": "forward-servicenow",
+				"webhook_configs": []map[string]interface{}{{
+					"url":           "https://tickets.example.com",
+					"send_resolved": true,
+				}},
+			}))
+		})
+
+		It("should compute the receiver for the Jira format", func() {
+			_, receiver, err := AlertForwardingRouteAndReceiver(gardenletconfig.AlertForwardingWebhook{
+				Name:   "jira",
+				Format: gardenletconfig.AlertForwardingFormatJira,
+			}, secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(receiver).To(HaveKeyWithValue("name", "forward-jira"))
+			Expect(receiver).To(HaveKeyWithValue("opsgenie_configs", ConsistOf(And(
+				HaveKeyWithValue("api_url", "https://tickets.example.com"),
+				HaveKeyWithValue("api_key", "key"),
+				HaveKeyWithValue("send_resolved", true),
+				HaveKeyWithValue("details", HaveKeyWithValue("project_owner", "{{ .CommonLabels.project_owner }}")),
+				HaveKeyWithValue("details", HaveKeyWithValue("runbook_url", "{{ .CommonLabels.runbook_url }}")),
+			))))
+		})
+
+		It("should fail if the secret does not contain the url", func() {
+			delete(secret.Data, "url")
+
+			_, _, err := AlertForwardingRouteAndReceiver(gardenletconfig.AlertForwardingWebhook{Name: "tickets", Format: gardenletconfig.AlertForwardingFormatAlertmanager}, secret)
+			Expect(err).To(MatchError(ContainSubstring("does not contain a url")))
+		})
+
+		It("should fail if the secret does not contain the api key for the Jira format", func() {
+			delete(secret.Data, "apiKey")
+
+			_, _, err := AlertForwardingRouteAndReceiver(gardenletconfig.AlertForwardingWebhook{Name: "jira", Format: gardenletconfig.AlertForwardingFormatJira}, secret)
+			Expect(err).To(MatchError(ContainSubstring("does not contain an apiKey")))
+		})
+
+		It("should fail for an unsupported format", func() {
+			_, _, err := AlertForwardingRouteAndReceiver(gardenletconfig.AlertForwardingWebhook{Name: "slack", Format: "Slack"}, secret)
+			Expect(err).To(MatchError(ContainSubstring("unsupported format")))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring
+
+import (
+	"regexp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// AlertRelabelConfigs computes the Prometheus alert relabel configs for the given alert overrides. Only overrides which
+// apply to shoots with the given purpose are considered.
+func AlertRelabelConfigs(overrides []gardenletconfig.AlertOverride, purpose gardencorev1beta1.ShootPurpose) []map[string]interface{} {
+	var configs []map[string]interface{}
+
+	for _, override := range overrides {
+		if len(override.ShootPurposes) > 0 && !slices.Contains(override.ShootPurposes, gardencore.ShootPurpose(purpose)) {
+			continue
+		}
+
+		regex := regexp.QuoteMeta(override.AlertName)
+
+		if override.Drop {
+			configs = append(configs, map[string]interface{}{
+				"source_labels": []string{"alertname"},
+				"regex":         regex,
+				"action":        "drop",
+			})
+			continue
+		}
+
+		labels := map[string]string{}
+		for key, value := range override.Labels {
+			labels[key] = value
+		}
+		if override.Severity != nil {
+			labels["severity"] = *override.Severity
+		}
+
+		for _, key := range sets.List(sets.KeySet(labels)) {
+			configs = append(configs, map[string]interface{}{
+				"source_labels": []string{"alertname"},
+				"regex":         regex,
+				"target_label":  key,
+				"replacement":   labels[key],
+				"action":        "replace",
+			})
+		}
+	}
+
+	return configs
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/component/monitoring"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("AlertOverrides", func() {
+	Describe("#AlertRelabelConfigs", func() {
+		var overrides []gardenletconfig.AlertOverride
+
+		BeforeEach(func() {
+			overrides = []gardenletconfig.AlertOverride{
+				{AlertName: "KubeletTooManyPods", ShootPurposes: []gardencore.ShootPurpose{"evaluation"}, Drop: true},
+				{AlertName: "ApiServerNotReachable", Severity: pointer.String("blocker"), Labels: map[string]string{"team": "core", "escalation_policy": "24x7"}},
+			}
+		})
+
+		It("should return nothing if there are no overrides", func() {
+			Expect(AlertRelabelConfigs(nil, gardencorev1beta1.ShootPurposeProduction)).To(BeEmpty())
+		})
+
+		It("should compute the relabel configs for all overrides matching the shoot purpose", func() {
+			Expect(AlertRelabelConfigs(overrides, gardencorev1beta1.ShootPurposeEvaluation)).To(Equal([]map[string]interface{}{
+				{"source_labels": []string{"alertname"}, "regex": "KubeletTooManyPods", "action": "drop"},
+				{"source_labels": []string{"alertname"}, "regex": "ApiServerNotReachable", "target_label": "escalation_policy", "replacement": "24x7", "action": "replace"},
+				{"source_labels": []string{"alertname"}, "regex": "ApiServerNotReachable", "target_label": "severity", "replacement": "blocker", "action": "replace"},
+				{"source_labels": []string{"alertname"}, "regex": "ApiServerNotReachable", "target_label": "team", "replacement": "core", "action": "replace"},
+			}))
+		})
+
+		It("should skip overrides not matching the shoot purpose", func() {
+			Expect(AlertRelabelConfigs(overrides, gardencorev1beta1.ShootPurposeProduction)).To(Equal([]map[string]interface{}{
+				{"source_labels": []string{"alertname"}, "regex": "ApiServerNotReachable", "target_label": "escalation_policy", "replacement": "24x7", "action": "replace"},
+				{"source_labels": []string{"alertname"}, "regex": "ApiServerNotReachable", "target_label": "severity", "replacement": "blocker", "action": "replace"},
+				{"source_labels": []string{"alertname"}, "regex": "ApiServerNotReachable", "target_label": "team", "replacement": "core", "action": "replace"},
+			}))
+		})
+
+		It("should quote the alert name", func() {
+			Expect(AlertRelabelConfigs([]gardenletconfig.AlertOverride{{AlertName: "Foo.*", Drop: true}}, "")).To(Equal([]map[string]interface{}{
+				{"source_labels": []string{"alertname"}, "regex": `Foo\.\*`, "action": "drop"},
+			}))
+		})
+	})
+})
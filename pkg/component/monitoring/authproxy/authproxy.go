@@ -0,0 +1,405 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authproxy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "observability-auth-proxy"
+	// Name is the name of the observability auth proxy.
+	Name = "observability-auth-proxy"
+	// Port is the port exposed by the observability auth proxy.
+	Port = 4180
+	// PathPrefix is the prefix of the paths served by the observability auth proxy. Ingresses protected by the auth
+	// proxy must route this prefix to the auth proxy, see IngressPath.
+	PathPrefix = "/oauth2"
+	// DataKeyClientSecret is the data key of the secret referenced by the client secret reference containing the
+	// secret of the OIDC client.
+	DataKeyClientSecret = "clientSecret"
+
+	secretNameCookie            = "observability-auth-proxy-cookie"
+	secretNameClientSecret      = "observability-auth-proxy-client"
+	volumeNameClientSecret      = "client-secret"
+	volumeNameHtpasswd          = "htpasswd"
+	volumeMountPathClientSecret = "/etc/oauth2-proxy/client"
+	volumeMountPathHtpasswd     = "/etc/oauth2-proxy/htpasswd"
+	dataKeyClientSecretFile     = "client-secret"
+	htpasswdFileName            = "htpasswd"
+	cookieSecretLength          = 32
+	portNameHTTP                = "http"
+)
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+// Values is a set of configuration values for the observability auth proxy.
+type Values struct {
+	// Image is the container image used for the auth proxy.
+	Image string
+	// Replicas is the number of pod replicas.
+	Replicas int32
+	// PriorityClassName is the name of the priority class.
+	PriorityClassName string
+	// IssuerURL is the URL of the OIDC issuer.
+	IssuerURL string
+	// ClientID is the ID of the OIDC client.
+	ClientID string
+	// ClientSecretRef is a reference to the secret in the seed cluster containing the secret of the OIDC client.
+	ClientSecretRef corev1.SecretReference
+	// GroupsClaim is the name of the claim in the ID token containing the groups of the user.
+	GroupsClaim string
+	// CookieRefresh is the interval after which the session cookie is refreshed with the help of the refresh token.
+	CookieRefresh time.Duration
+	// AllowedGroups are the groups whose members are granted access.
+	AllowedGroups []string
+	// DelegateAuthorization specifies whether the allowed groups are passed by the ingresses in the auth URL for each
+	// request instead of being configured for the auth proxy, see AuthorizingIngressAnnotations. This allows sharing a
+	// single auth proxy for ingresses with different allowed groups.
+	DelegateAuthorization bool
+	// BreakGlassBasicAuth specifies whether the basic authentication credentials of the observability ingresses are
+	// accepted in addition to the single sign-on.
+	BreakGlassBasicAuth bool
+}
+
+// New creates a new instance of DeployWaiter for the observability auth proxy which protects the observability
+// ingresses with OIDC-based single sign-on.
+func New(
+	client client.Client,
+	namespace string,
+	secretsManager secretsmanager.Interface,
+	values Values,
+) component.DeployWaiter {
+	return &authProxy{
+		client:         client,
+		namespace:      namespace,
+		secretsManager: secretsManager,
+		values:         values,
+	}
+}
+
+type authProxy struct {
+	client         client.Client
+	namespace      string
+	secretsManager secretsmanager.Interface
+	values         Values
+}
+
+func (a *authProxy) Deploy(ctx context.Context) error {
+	clientSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: a.values.ClientSecretRef.Namespace, Name: a.values.ClientSecretRef.Name}, clientSecret); err != nil {
+		return fmt.Errorf("failed reading OIDC client secret %s/%s: %w", a.values.ClientSecretRef.Namespace, a.values.ClientSecretRef.Name, err)
+	}
+	if len(clientSecret.Data[DataKeyClientSecret]) == 0 {
+		return fmt.Errorf("OIDC client secret %s/%s does not contain data key %q", a.values.ClientSecretRef.Namespace, a.values.ClientSecretRef.Name, DataKeyClientSecret)
+	}
+
+	cookieSecret, err := a.secretsManager.Generate(ctx, &secretsutils.BasicAuthSecretConfig{
+		Name:           secretNameCookie,
+		Format:         secretsutils.BasicAuthFormatNormal,
+		Username:       "cookie",
+		PasswordLength: cookieSecretLength,
+	}, secretsmanager.Persist(), secretsmanager.Rotate(secretsmanager.InPlace))
+	if err != nil {
+		return err
+	}
+
+	var credentialsSecret *corev1.Secret
+	if a.values.BreakGlassBasicAuth {
+		var found bool
+		credentialsSecret, found = a.secretsManager.Get(v1beta1constants.SecretNameObservabilityIngressUsers)
+		if !found {
+			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameObservabilityIngressUsers)
+		}
+	}
+
+	var (
+		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretNameClientSecret,
+				Namespace: a.namespace,
+				Labels:    getLabels(),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{dataKeyClientSecretFile: clientSecret.Data[DataKeyClientSecret]},
+		}
+	)
+
+	utilruntime.Must(kubernetesutils.MakeUnique(secret))
+
+	data, err := registry.AddAllAndSerialize(
+		secret,
+		a.deployment(secret, cookieSecret, credentialsSecret),
+		a.service(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, a.client, a.namespace, ManagedResourceName, false, data)
+}
+
+func (a *authProxy) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, a.client, a.namespace, ManagedResourceName)
+}
+
+func (a *authProxy) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, a.client, a.namespace, ManagedResourceName)
+}
+
+func (a *authProxy) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, a.client, a.namespace, ManagedResourceName)
+}
+
+func (a *authProxy) deployment(clientSecret, cookieSecret, credentialsSecret *corev1.Secret) *appsv1.Deployment {
+	args := []string{
+		"--provider=oidc",
+		"--oidc-issuer-url=" + a.values.IssuerURL,
+		"--client-id=" + a.values.ClientID,
+		"--client-secret-file=" + volumeMountPathClientSecret + "/" + dataKeyClientSecretFile,
+		"--oidc-groups-claim=" + a.values.GroupsClaim,
+		"--scope=openid email profile offline_access " + a.values.GroupsClaim,
+		"--email-domain=*",
+		"--http-address=0.0.0.0:" + fmt.Sprint(Port),
+		"--proxy-prefix=" + PathPrefix,
+		"--reverse-proxy=true",
+		"--set-xauthrequest=true",
+		"--skip-provider-button=false",
+		"--upstream=static://202",
+		"--cookie-name=_" + strings.ReplaceAll(Name, "-", "_"),
+		"--cookie-secure=true",
+		"--cookie-refresh=" + a.values.CookieRefresh.String(),
+		"--cookie-expire=" + (24 * time.Hour).String(),
+	}
+
+	if !a.values.DelegateAuthorization {
+		for _, group := range allowedGroupsOrNone(a.values.AllowedGroups) {
+			args = append(args, "--allowed-group="+group)
+		}
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      Name,
+			Namespace: a.namespace,
+			Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+				v1beta1constants.GardenRole: v1beta1constants.GardenRoleMonitoring,
+			}),
+		},
+		Spec: appsv1.DeploymentSpec{
+			RevisionHistoryLimit: pointer.Int32(2),
+			Replicas:             pointer.Int32(a.values.Replicas),
+			Selector:             &metav1.LabelSelector{MatchLabels: getLabels()},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+						v1beta1constants.GardenRole:                         v1beta1constants.GardenRoleMonitoring,
+						v1beta1constants.LabelNetworkPolicyToDNS:            v1beta1constants.LabelNetworkPolicyAllowed,
+						v1beta1constants.LabelNetworkPolicyToPublicNetworks: v1beta1constants.LabelNetworkPolicyAllowed,
+					}),
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken: pointer.Bool(false),
+					PriorityClassName:            a.values.PriorityClassName,
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: pointer.Bool(true),
+						RunAsUser:    pointer.Int64(65532),
+						RunAsGroup:   pointer.Int64(65532),
+					},
+					Containers: []corev1.Container{{
+						Name:            Name,
+						Image:           a.values.Image,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Args:            args,
+						Env: []corev1.EnvVar{{
+							Name: "OAUTH2_PROXY_COOKIE_SECRET",
+							ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: cookieSecret.Name},
+								Key:                  secretsutils.DataKeyPassword,
+							}},
+						}},
+						Ports: []corev1.ContainerPort{{
+							Name:          portNameHTTP,
+							ContainerPort: Port,
+							Protocol:      corev1.ProtocolTCP,
+						}},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{
+								Path: "/ready",
+								Port: intstr.FromInt32(Port),
+							}},
+						},
+						LivenessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{
+								Path: "/ping",
+								Port: intstr.FromInt32(Port),
+							}},
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("10m"),
+								corev1.ResourceMemory: resource.MustParse("32Mi"),
+							},
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: resource.MustParse("200Mi"),
+							},
+						},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      volumeNameClientSecret,
+							MountPath: volumeMountPathClientSecret,
+							ReadOnly:  true,
+						}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: volumeNameClientSecret,
+						VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+							SecretName: clientSecret.Name,
+						}},
+					}},
+				},
+			},
+		},
+	}
+
+	if credentialsSecret != nil {
+		container := &deployment.Spec.Template.Spec.Containers[0]
+		container.Args = append(container.Args,
+			"--htpasswd-file="+volumeMountPathHtpasswd+"/"+htpasswdFileName,
+			"--display-htpasswd-form=true",
+		)
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeNameHtpasswd,
+			MountPath: volumeMountPathHtpasswd,
+			ReadOnly:  true,
+		})
+		deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: volumeNameHtpasswd,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: credentialsSecret.Name,
+				Items:      []corev1.KeyToPath{{Key: secretsutils.DataKeySHA1Auth, Path: htpasswdFileName}},
+			}},
+		})
+	}
+
+	utilruntime.Must(references.InjectAnnotations(deployment))
+	return deployment
+}
+
+func (a *authProxy) service() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      Name,
+			Namespace: a.namespace,
+			Labels:    getLabels(),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: getLabels(),
+			Ports: []corev1.ServicePort{{
+				Name:       portNameHTTP,
+				Port:       Port,
+				Protocol:   corev1.ProtocolTCP,
+				TargetPort: intstr.FromInt32(Port),
+			}},
+		},
+	}
+}
+
+// IngressAnnotations returns the annotations which instruct the nginx ingress controller to authenticate all requests
+// of an ingress via the observability auth proxy running in the given namespace.
+func IngressAnnotations(namespace string) map[string]string {
+	return map[string]string{
+		"nginx.ingress.kubernetes.io/auth-url":              fmt.Sprintf("http://%s.%s.svc.cluster.local:%d%s/auth", Name, namespace, Port, PathPrefix),
+		"nginx.ingress.kubernetes.io/auth-signin":           "https://$host" + PathPrefix + "/start?rd=$escaped_request_uri",
+		"nginx.ingress.kubernetes.io/auth-response-headers": "X-Auth-Request-User,X-Auth-Request-Email",
+	}
+}
+
+// AuthorizingIngressAnnotations returns the annotations which instruct the nginx ingress controller to authenticate all
+// requests of an ingress via the observability auth proxy running in the given namespace, and to only grant access to
+// members of the given groups. The auth proxy must be configured to delegate the authorization, see
+// Values.DelegateAuthorization.
+func AuthorizingIngressAnnotations(namespace string, allowedGroups []string) map[string]string {
+	annotations := IngressAnnotations(namespace)
+	annotations["nginx.ingress.kubernetes.io/auth-url"] += "?" + url.Values{"allowed_groups": {strings.Join(allowedGroupsOrNone(allowedGroups), ",")}}.Encode()
+	return annotations
+}
+
+// IngressPath returns the ingress path which routes the requests for the sign-in and callback endpoints to the
+// observability auth proxy.
+func IngressPath() networkingv1.HTTPIngressPath {
+	pathType := networkingv1.PathTypePrefix
+
+	return networkingv1.HTTPIngressPath{
+		Path:     PathPrefix,
+		PathType: &pathType,
+		Backend: networkingv1.IngressBackend{
+			Service: &networkingv1.IngressServiceBackend{
+				Name: Name,
+				Port: networkingv1.ServiceBackendPort{Number: Port},
+			},
+		},
+	}
+}
+
+// allowedGroupsOrNone returns the given groups or a group which cannot exist if no group is given. Without any allowed
+// group, oauth2-proxy would grant access to every user which is able to authenticate at the OIDC issuer.
+func allowedGroupsOrNone(groups []string) []string {
+	if len(groups) == 0 {
+		return []string{"system:none"}
+	}
+	return groups
+}
+
+func getLabels() map[string]string {
+	return map[string]string{
+		v1beta1constants.LabelApp: Name,
+	}
+}
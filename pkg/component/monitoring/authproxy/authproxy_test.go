@@ -0,0 +1,233 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authproxy_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/monitoring/authproxy"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("AuthProxy", func() {
+	var (
+		ctx = context.TODO()
+
+		namespace = "shoot--foo--bar"
+
+		c              client.Client
+		secretsManager secretsmanager.Interface
+		values         Values
+		authProxy      component.DeployWaiter
+
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		secretsManager = fakesecretsmanager.New(c, namespace)
+
+		values = Values{
+			Image:             "oauth2-proxy:v1.2.3",
+			Replicas:          1,
+			PriorityClassName: "priority",
+			IssuerURL:         "https://issuer.example.com",
+			ClientID:          "monitoring",
+			ClientSecretRef:   corev1.SecretReference{Name: "monitoring-oidc", Namespace: "garden"},
+			GroupsClaim:       "groups",
+			CookieRefresh:     time.Hour,
+			AllowedGroups:     []string{"admins", "viewers"},
+		}
+
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "monitoring-oidc", Namespace: "garden"},
+			Data:       map[string][]byte{DataKeyClientSecret: []byte("client-secret")},
+		})).To(Succeed())
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.SecretNameObservabilityIngressUsers, Namespace: namespace},
+		})).To(Succeed())
+
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "observability-auth-proxy", Namespace: namespace}}
+		managedResourceSecret = &corev1.Secret{}
+	})
+
+	JustBeforeEach(func() {
+		authProxy = New(c, namespace, secretsManager, values)
+	})
+
+	deployAndGetDeployment := func() *appsv1.Deployment {
+		Expect(authProxy.Deploy(ctx)).To(Succeed())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		Expect(managedResource.Spec.Class).To(PointTo(Equal("seed")))
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, managedResourceSecret)).To(Succeed())
+		Expect(managedResourceSecret.Data).To(HaveLen(3))
+		Expect(managedResourceSecret.Data).To(HaveKey("service__shoot--foo--bar__observability-auth-proxy.yaml"))
+
+		obj, _, err := kubernetes.SeedCodec.UniversalDecoder().Decode(managedResourceSecret.Data["deployment__shoot--foo--bar__observability-auth-proxy.yaml"], nil, &appsv1.Deployment{})
+		Expect(err).NotTo(HaveOccurred())
+		return obj.(*appsv1.Deployment)
+	}
+
+	Describe("#Deploy", func() {
+		It("should fail if the OIDC client secret does not exist", func() {
+			values.ClientSecretRef.Name = "unknown"
+			authProxy = New(c, namespace, secretsManager, values)
+
+			Expect(authProxy.Deploy(ctx)).To(MatchError(ContainSubstring("failed reading OIDC client secret garden/unknown")))
+		})
+
+		It("should fail if the OIDC client secret does not contain the client secret", func() {
+			Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: "garden"}})).To(Succeed())
+			values.ClientSecretRef.Name = "empty"
+			authProxy = New(c, namespace, secretsManager, values)
+
+			Expect(authProxy.Deploy(ctx)).To(MatchError(ContainSubstring(`does not contain data key "clientSecret"`)))
+		})
+
+		It("should deploy the auth proxy without break-glass basic authentication", func() {
+			deployment := deployAndGetDeployment()
+
+			Expect(deployment.Spec.Replicas).To(PointTo(Equal(int32(1))))
+			Expect(deployment.Spec.Template.Labels).To(And(
+				HaveKeyWithValue("networking.gardener.cloud/to-dns", "allowed"),
+				HaveKeyWithValue("networking.gardener.cloud/to-public-networks", "allowed"),
+			))
+			Expect(deployment.Spec.Template.Spec.PriorityClassName).To(Equal("priority"))
+
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("oauth2-proxy:v1.2.3"))
+			Expect(container.Args).To(ContainElements(
+				"--provider=oidc",
+				"--oidc-issuer-url=https://issuer.example.com",
+				"--client-id=monitoring",
+				"--oidc-groups-claim=groups",
+				"--cookie-refresh=1h0m0s",
+				"--allowed-group=admins",
+				"--allowed-group=viewers",
+			))
+			Expect(container.Args).NotTo(ContainElement(HavePrefix("--htpasswd-file")))
+			Expect(container.Env[0].ValueFrom.SecretKeyRef.Name).To(HavePrefix("observability-auth-proxy-cookie-"))
+			Expect(deployment.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		})
+
+		It("should deny access via single sign-on if no group is allowed", func() {
+			values.AllowedGroups = nil
+			authProxy = New(c, namespace, secretsManager, values)
+
+			deployment := deployAndGetDeployment()
+
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--allowed-group=system:none"))
+		})
+
+		It("should not configure the allowed groups if the authorization is delegated to the ingresses", func() {
+			values.DelegateAuthorization = true
+			authProxy = New(c, namespace, secretsManager, values)
+
+			deployment := deployAndGetDeployment()
+
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement(HavePrefix("--allowed-group")))
+		})
+
+		Context("with break-glass basic authentication", func() {
+			BeforeEach(func() {
+				values.BreakGlassBasicAuth = true
+			})
+
+			It("should deploy the auth proxy with the basic authentication credentials", func() {
+				deployment := deployAndGetDeployment()
+
+				Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--htpasswd-file=/etc/oauth2-proxy/htpasswd/htpasswd"))
+				Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+					Name: "htpasswd",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+						SecretName: v1beta1constants.SecretNameObservabilityIngressUsers,
+						Items:      []corev1.KeyToPath{{Key: "auth", Path: "htpasswd"}},
+					}},
+				}))
+			})
+
+			It("should fail if the basic authentication credentials do not exist", func() {
+				Expect(c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.SecretNameObservabilityIngressUsers, Namespace: namespace}})).To(Succeed())
+
+				Expect(authProxy.Deploy(ctx)).To(MatchError(ContainSubstring(`secret "observability-ingress-users" not found`)))
+			})
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the managed resource", func() {
+			Expect(authProxy.Deploy(ctx)).To(Succeed())
+			Expect(authProxy.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#IngressAnnotations", func() {
+		It("should return the annotations for the nginx external authentication", func() {
+			Expect(IngressAnnotations(namespace)).To(Equal(map[string]string{
+				"nginx.ingress.kubernetes.io/auth-url":              "http://observability-auth-proxy.shoot--foo--bar.svc.cluster.local:4180/oauth2/auth",
+				"nginx.ingress.kubernetes.io/auth-signin":           "https://$host/oauth2/start?rd=$escaped_request_uri",
+				"nginx.ingress.kubernetes.io/auth-response-headers": "X-Auth-Request-User,X-Auth-Request-Email",
+			}))
+		})
+	})
+
+	Describe("#AuthorizingIngressAnnotations", func() {
+		It("should pass the allowed groups in the auth URL", func() {
+			Expect(AuthorizingIngressAnnotations("garden", []string{"admins", "system:viewers"})).To(Equal(map[string]string{
+				"nginx.ingress.kubernetes.io/auth-url":              "http://observability-auth-proxy.garden.svc.cluster.local:4180/oauth2/auth?allowed_groups=admins%2Csystem%3Aviewers",
+				"nginx.ingress.kubernetes.io/auth-signin":           "https://$host/oauth2/start?rd=$escaped_request_uri",
+				"nginx.ingress.kubernetes.io/auth-response-headers": "X-Auth-Request-User,X-Auth-Request-Email",
+			}))
+		})
+
+		It("should deny access if no group is allowed", func() {
+			Expect(AuthorizingIngressAnnotations("garden", nil)).To(HaveKeyWithValue(
+				"nginx.ingress.kubernetes.io/auth-url", "http://observability-auth-proxy.garden.svc.cluster.local:4180/oauth2/auth?allowed_groups=system%3Anone",
+			))
+		})
+	})
+
+	Describe("#IngressPath", func() {
+		It("should route the auth proxy endpoints to the auth proxy", func() {
+			path := IngressPath()
+
+			Expect(path.Path).To(Equal("/oauth2"))
+			Expect(path.PathType).To(PointTo(Equal(networkingv1.PathTypePrefix)))
+			Expect(path.Backend.Service.Name).To(Equal("observability-auth-proxy"))
+			Expect(path.Backend.Service.Port.Number).To(Equal(int32(4180)))
+		})
+	})
+})
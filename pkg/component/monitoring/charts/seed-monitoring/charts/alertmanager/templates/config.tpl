@@ -24,6 +24,10 @@ route:
   receiver: dev-null
 
   routes:
+{{- if .Values.forwarding.routes }}
+  # forward alerts to ticketing systems
+{{ toYaml .Values.forwarding.routes | indent 2 }}
+{{- end }}
   # email only for critical and blocker
   - match_re:
       visibility: ^(all|owner)$
@@ -79,4 +83,7 @@ receivers:
   email_configs:
 {{ toYaml .Values.emailConfigs | indent 2 }}
 {{- end }}
+{{- if .Values.forwarding.receivers }}
+{{ toYaml .Values.forwarding.receivers }}
+{{- end }}
 {{- end -}}
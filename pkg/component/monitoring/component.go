@@ -37,6 +37,9 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/etcd"
+	"github.com/gardener/gardener/pkg/component/monitoring/authproxy"
+	"github.com/gardener/gardener/pkg/component/monitoring/customization"
+	"github.com/gardener/gardener/pkg/component/monitoring/gateway"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/utils"
@@ -72,10 +75,15 @@ type Interface interface {
 
 // Values is a set of configuration values for the monitoring components.
 type Values struct {
+	// AlertForwardingWebhooks is a list of ticketing webhooks to which the alerts are forwarded by Alertmanager.
+	AlertForwardingWebhooks []gardenletconfig.AlertForwardingWebhook
 	// AlertingSecrets is a list of alerting secrets.
 	AlertingSecrets []*corev1.Secret
 	// AlertmanagerEnabled specifies whether Alertmanager is enabled.
 	AlertmanagerEnabled bool
+	// AuthProxyEnabled specifies whether the ingresses are protected by the observability auth proxy instead of basic
+	// authentication.
+	AuthProxyEnabled bool
 	// APIServerDomain is the domain of the API server.
 	APIServerDomain string
 	// APIServerHost is the host of the API server.
@@ -98,6 +106,9 @@ type Values struct {
 	ImageConfigmapReloader string
 	// ImagePrometheus is the image of Prometheus.
 	ImagePrometheus string
+	// GatewayHost is the host of the shared observability gateway of the seed. If set, Prometheus and Alertmanager are
+	// served by the gateway under the paths of the shoot instead of dedicated ingresses.
+	GatewayHost string
 	// IngressHostAlertmanager is the host name of Alertmanager.
 	IngressHostAlertmanager string
 	// IngressHostPrometheus is the host name of Prometheus.
@@ -114,12 +125,21 @@ type Values struct {
 	NodeLocalDNSEnabled bool
 	// ProjectName is the name of the project.
 	ProjectName string
+	// ProjectOwner is the name of the owner of the project.
+	ProjectOwner string
 	// PodNetworkCIDR is the CIDR of the pod network.
 	PodNetworkCIDR *string
 	// ServiceNetworkCIDR is the CIDR of the service network.
 	ServiceNetworkCIDR *string
+	// SeedName is the name of the seed cluster.
+	SeedName string
+	// ShootPurpose is the purpose of the shoot cluster.
+	ShootPurpose gardencorev1beta1.ShootPurpose
 	// NodeNetworkCIDR is the CIDR of the node network.
 	NodeNetworkCIDR *string
+	// PrometheusAgent contains the settings for running Prometheus in agent mode. Prometheus runs in server mode if it
+	// is nil.
+	PrometheusAgent *gardenletconfig.ShootPrometheusAgentConfig
 	// Replicas is the number of replicas.
 	Replicas int32
 	// RuntimeProviderType is the provider type of the runtime cluster.
@@ -184,7 +204,16 @@ func (m *monitoring) Deploy(ctx context.Context) error {
 	}
 
 	var ingressTLSSecretName string
-	if m.values.WildcardCertName != nil {
+	if m.values.GatewayHost != "" {
+		// The observability gateway serves Prometheus and Alertmanager, hence the dedicated ingresses are not needed
+		// anymore. They are not part of the charts in this case and must be deleted explicitly.
+		if err := kubernetesutils.DeleteObjects(ctx, m.client,
+			&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "prometheus", Namespace: m.namespace}},
+			&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "alertmanager", Namespace: m.namespace}},
+		); err != nil {
+			return err
+		}
+	} else if m.values.WildcardCertName != nil {
 		ingressTLSSecretName = *m.values.WildcardCertName
 	} else {
 		ingressTLSSecret, err := m.secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
@@ -232,16 +261,7 @@ func (m *monitoring) Deploy(ctx context.Context) error {
 			"nodeLocalDNS": map[string]interface{}{
 				"enabled": m.values.NodeLocalDNSEnabled,
 			},
-			"ingress": map[string]interface{}{
-				"class":          v1beta1constants.SeedNginxIngressClass,
-				"authSecretName": credentialsSecret.Name,
-				"hosts": []map[string]interface{}{
-					{
-						"hostName":   m.values.IngressHostPrometheus,
-						"secretName": ingressTLSSecretName,
-					},
-				},
-			},
+			"ingress": m.ingressValues(credentialsSecret.Name, "prometheus", m.values.IngressHostPrometheus, ingressTLSSecretName),
 			"namespace": map[string]interface{}{
 				"uid": m.values.NamespaceUID,
 			},
@@ -270,7 +290,9 @@ func (m *monitoring) Deploy(ctx context.Context) error {
 			"alerting":                alerting,
 			"additionalRules":         alertingRules.String(),
 			"additionalScrapeConfigs": scrapeConfigs.String(),
+			"agent":                   m.agentValues(),
 		}
+		customizedRules map[string]string
 	)
 
 	if services := m.values.ServiceNetworkCIDR; services != nil {
@@ -326,6 +348,38 @@ func (m *monitoring) Deploy(ctx context.Context) error {
 		prometheusConfig["externalLabels"] = m.values.Config.Shoot.ExternalLabels
 	}
 
+	// set alert relabel configs for overriding or dropping alerts and for enriching them with garden metadata
+	if m.values.Config != nil && m.values.Config.Shoot != nil {
+		alertRelabelConfigs := AlertRelabelConfigs(m.values.Config.Shoot.AlertOverrides, m.values.ShootPurpose)
+		if alertForwarding := m.values.Config.Shoot.AlertForwarding; alertForwarding != nil {
+			alertRelabelConfigs = append(alertRelabelConfigs, AlertEnrichmentRelabelConfigs(AlertMetadata{
+				ProjectOwner: m.values.ProjectOwner,
+				SeedName:     m.values.SeedName,
+				ShootPurpose: string(m.values.ShootPurpose),
+			}, alertForwarding.Runbooks)...)
+		}
+		if len(alertRelabelConfigs) > 0 {
+			prometheusConfig["alertRelabelConfigs"] = alertRelabelConfigs
+		}
+	}
+
+	// apply landscape-specific customizations of the rule files
+	if m.values.Config != nil && m.values.Config.Customizations != nil {
+		rules, reports, err := CustomizedRules(m.values.Config.Customizations.Rules, m.values.IsWorkerless)
+		if err != nil {
+			return err
+		}
+		if len(reports) > 0 {
+			customizedRules = rules
+			report, err := customization.EncodeReports(reports)
+			if err != nil {
+				return err
+			}
+			prometheusConfig["customizedRules"] = customizedRules
+			prometheusConfig["customizationReport"] = report
+		}
+	}
+
 	coreValues := map[string]interface{}{
 		"global": map[string]interface{}{
 			"shootKubeVersion": map[string]interface{}{
@@ -343,6 +397,10 @@ func (m *monitoring) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	if err := m.reconcileCentralRecordingRules(ctx, customizedRules, alertingRules.String()); err != nil {
+		return err
+	}
+
 	// Check if we want to deploy an alertmanager into the shoot namespace.
 	if m.values.AlertmanagerEnabled {
 		var emailConfigs []map[string]interface{}
@@ -364,10 +422,26 @@ func (m *monitoring) Deploy(ctx context.Context) error {
 			}
 		}
 
+		var forwardingRoutes, forwardingReceivers []map[string]interface{}
+		for _, webhook := range m.values.AlertForwardingWebhooks {
+			secret := &corev1.Secret{}
+			if err := m.client.Get(ctx, kubernetesutils.Key(webhook.SecretRef.Namespace, webhook.SecretRef.Name), secret); err != nil {
+				return fmt.Errorf("failed reading secret of alert forwarding webhook %q: %w", webhook.Name, err)
+			}
+
+			route, receiver, err := AlertForwardingRouteAndReceiver(webhook, secret)
+			if err != nil {
+				return err
+			}
+			forwardingRoutes = append(forwardingRoutes, route)
+			forwardingReceivers = append(forwardingReceivers, receiver)
+		}
+
 		var alertManagerIngressTLSSecretName string
+		// The observability gateway serves Alertmanager if it is configured, hence no certificate is needed in this case.
 		if m.values.WildcardCertName != nil {
 			alertManagerIngressTLSSecretName = *m.values.WildcardCertName
-		} else {
+		} else if m.values.GatewayHost == "" {
 			ingressTLSSecret, err := m.secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
 				Name:                        "alertmanager-tls",
 				CommonName:                  "alertmanager",
@@ -388,19 +462,14 @@ func (m *monitoring) Deploy(ctx context.Context) error {
 				"alertmanager":       m.values.ImageAlertmanager,
 				"configmap-reloader": m.values.ImageConfigmapReloader,
 			},
-			"ingress": map[string]interface{}{
-				"class":          v1beta1constants.SeedNginxIngressClass,
-				"authSecretName": credentialsSecret.Name,
-				"hosts": []map[string]interface{}{
-					{
-						"hostName":   m.values.IngressHostAlertmanager,
-						"secretName": alertManagerIngressTLSSecretName,
-					},
-				},
-			},
+			"ingress":      m.ingressValues(credentialsSecret.Name, "alertmanager", m.values.IngressHostAlertmanager, alertManagerIngressTLSSecretName),
 			"replicas":     m.values.Replicas,
 			"storage":      m.values.StorageCapacityAlertmanager,
 			"emailConfigs": emailConfigs,
+			"forwarding": map[string]interface{}{
+				"routes":    forwardingRoutes,
+				"receivers": forwardingReceivers,
+			},
 		}
 
 		return m.chartApplier.ApplyFromEmbeddedFS(ctx, chartAlertmanager, chartPathAlertmanager, m.namespace, "alertmanager", kubernetes.Values(alertManagerValues))
@@ -450,6 +519,7 @@ func (m *monitoring) Destroy(ctx context.Context) error {
 				Name:      "blackbox-exporter-config-prometheus",
 			},
 		},
+		m.newCentralRecordingRulesConfigMap(),
 		&corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: m.namespace,
@@ -507,6 +577,42 @@ func (m *monitoring) SetNamespaceUID(uid types.UID)                   { m.values
 func (m *monitoring) SetComponents(c []component.MonitoringComponent) { m.values.Components = c }
 func (m *monitoring) SetWildcardCertName(secretName *string)          { m.values.WildcardCertName = secretName }
 
+func (m *monitoring) ingressValues(authSecretName, gatewayRouteName, host, tlsSecretName string) map[string]interface{} {
+	if m.values.GatewayHost != "" {
+		return map[string]interface{}{
+			"enabled": false,
+			"host":    m.values.GatewayHost + gateway.Path(m.namespace, gatewayRouteName),
+		}
+	}
+
+	return map[string]interface{}{
+		"enabled":        true,
+		"class":          v1beta1constants.SeedNginxIngressClass,
+		"authSecretName": authSecretName,
+		"authProxy":      m.authProxyValues(),
+		"hosts": []map[string]interface{}{
+			{
+				"hostName":   host,
+				"secretName": tlsSecretName,
+			},
+		},
+	}
+}
+
+func (m *monitoring) authProxyValues() map[string]interface{} {
+	if !m.values.AuthProxyEnabled {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	return map[string]interface{}{
+		"enabled":     true,
+		"annotations": authproxy.IngressAnnotations(m.namespace),
+		"serviceName": authproxy.Name,
+		"port":        authproxy.Port,
+		"path":        authproxy.PathPrefix,
+	}
+}
+
 func (m *monitoring) newShootAccessSecret() *gardenerutils.AccessSecret {
 	return gardenerutils.NewShootAccessSecret(v1beta1constants.StatefulSetNamePrometheus, m.namespace)
 }
@@ -0,0 +1,148 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customization
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	jsonpatchdiff "gomodules.xyz/jsonpatch/v2"
+	"sigs.k8s.io/yaml"
+
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// AnnotationReport is the key of the annotation on the rendered ConfigMaps which contains the report about the
+// applied customizations.
+const AnnotationReport = "monitoring.gardener.cloud/customization-report"
+
+// Result is the result of applying a customization.
+type Result string
+
+const (
+	// ResultApplied means that the customization was applied successfully.
+	ResultApplied Result = "Applied"
+	// ResultFailed means that the patch of the customization could not be applied, e.g., because the customized
+	// document was changed in a way that the patch no longer fits. The document is rendered without the customization.
+	ResultFailed Result = "Failed"
+	// ResultNotFound means that there is no document with the ID of the customization.
+	ResultNotFound Result = "NotFound"
+)
+
+// Report contains the result of applying a customization.
+type Report struct {
+	// ID is the ID of the customization.
+	ID string `json:"id"`
+	// Result is the result of applying the customization.
+	Result Result `json:"result"`
+	// Message contains details in case the customization could not be applied.
+	Message string `json:"message,omitempty"`
+	// Changes is a list of the JSON patch operations which describe the difference between the original and the
+	// customized document, e.g. `replace /panels/0/title`.
+	Changes []string `json:"changes,omitempty"`
+}
+
+// DecodePatch decodes the given JSON patch in JSON or YAML format.
+func DecodePatch(patch string) (jsonpatch.Patch, error) {
+	patchJSON, err := yaml.YAMLToJSON([]byte(patch))
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.DecodePatch(patchJSON)
+}
+
+// Customize applies the given customizations to the given documents in JSON or YAML format. The documents are keyed
+// by their names, the given function returns the ID of a document. Customized documents are returned in JSON format,
+// all other documents are returned unchanged. Customizations which cannot be applied are skipped, i.e., they never
+// prevent rendering the documents. The returned reports contain the result of every customization.
+func Customize(customizations []gardenletconfig.MonitoringCustomization, documents map[string]string, id func(name, document string) string) (map[string]string, []Report) {
+	if len(customizations) == 0 {
+		return documents, nil
+	}
+
+	namesByID := make(map[string]string, len(documents))
+	for name, document := range documents {
+		namesByID[id(name, document)] = name
+	}
+
+	var (
+		result  = make(map[string]string, len(documents))
+		reports = make([]Report, 0, len(customizations))
+	)
+
+	for name, document := range documents {
+		result[name] = document
+	}
+
+	for _, customization := range customizations {
+		name, ok := namesByID[customization.ID]
+		if !ok {
+			reports = append(reports, Report{ID: customization.ID, Result: ResultNotFound})
+			continue
+		}
+
+		customized, changes, err := apply(customization.Patch, result[name])
+		if err != nil {
+			reports = append(reports, Report{ID: customization.ID, Result: ResultFailed, Message: err.Error()})
+			continue
+		}
+
+		result[name] = customized
+		reports = append(reports, Report{ID: customization.ID, Result: ResultApplied, Changes: changes})
+	}
+
+	return result, reports
+}
+
+// EncodeReports encodes the given reports so that they can be stored in the annotation with key AnnotationReport.
+func EncodeReports(reports []Report) (string, error) {
+	data, err := json.Marshal(reports)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func apply(patch, document string) (string, []string, error) {
+	decodedPatch, err := DecodePatch(patch)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed decoding patch: %w", err)
+	}
+
+	original, err := yaml.YAMLToJSON([]byte(document))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed decoding document: %w", err)
+	}
+
+	customized, err := decodedPatch.Apply(original)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed applying patch: %w", err)
+	}
+
+	operations, err := jsonpatchdiff.CreatePatch(original, customized)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed computing changes: %w", err)
+	}
+
+	changes := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		changes = append(changes, operation.Operation+" "+operation.Path)
+	}
+	sort.Strings(changes)
+
+	return string(customized), changes, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customization_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/component/monitoring/customization"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("Customization", func() {
+	Describe("#DecodePatch", func() {
+		It("should decode a patch in JSON format", func() {
+			patch, err := customization.DecodePatch(`[{"op": "remove", "path": "/title"}]`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(patch).To(HaveLen(1))
+			Expect(patch[0].Kind()).To(Equal("remove"))
+		})
+
+		It("should decode a patch in YAML format", func() {
+			patch, err := customization.DecodePatch("- op: replace\n  path: /title\n  value: foo\n")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(patch).To(HaveLen(1))
+			Expect(patch[0].Kind()).To(Equal("replace"))
+		})
+
+		It("should fail if the patch is not a list of operations", func() {
+			_, err := customization.DecodePatch(`{"op": "remove"}`)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Customize", func() {
+		var (
+			documents map[string]string
+			uid       = func(name, _ string) string { return name + "-uid" }
+		)
+
+		BeforeEach(func() {
+			documents = map[string]string{
+				"foo": `{"title": "Foo", "panels": [{"title": "Panel"}]}`,
+				"bar": "title: Bar\n",
+			}
+		})
+
+		It("should return the documents unchanged if there are no customizations", func() {
+			result, reports := customization.Customize(nil, documents, uid)
+			Expect(result).To(Equal(documents))
+			Expect(reports).To(BeEmpty())
+		})
+
+		It("should apply the customizations and report the results", func() {
+			result, reports := customization.Customize([]gardenletconfig.MonitoringCustomization{
+				{ID: "foo-uid", Patch: "- op: replace\n  path: /panels/0/title\n  value: Customized\n- op: add\n  path: /tags\n  value: [landscape]\n"},
+				{ID: "bar-uid", Patch: `[{"op": "test", "path": "/title", "value": "Baz"}, {"op": "replace", "path": "/title", "value": "Foo"}]`},
+				{ID: "baz-uid", Patch: `[{"op": "remove", "path": "/title"}]`},
+			}, documents, uid)
+
+			Expect(result).To(HaveLen(2))
+			Expect(result["foo"]).To(MatchJSON(`{"title": "Foo", "panels": [{"title": "Customized"}], "tags": ["landscape"]}`))
+			Expect(result["bar"]).To(Equal("title: Bar\n"))
+			Expect(documents["foo"]).To(Equal(`{"title": "Foo", "panels": [{"title": "Panel"}]}`), "original documents must not be changed")
+
+			Expect(reports).To(HaveLen(3))
+			Expect(reports[0]).To(Equal(customization.Report{ID: "foo-uid", Result: customization.ResultApplied, Changes: []string{"add /tags", "replace /panels/0/title"}}))
+			Expect(reports[1].ID).To(Equal("bar-uid"))
+			Expect(reports[1].Result).To(Equal(customization.ResultFailed))
+			Expect(reports[1].Message).To(ContainSubstring("failed applying patch"))
+			Expect(reports[2]).To(Equal(customization.Report{ID: "baz-uid", Result: customization.ResultNotFound}))
+		})
+	})
+
+	Describe("#EncodeReports", func() {
+		It("should encode the reports", func() {
+			Expect(customization.EncodeReports([]customization.Report{
+				{ID: "foo", Result: customization.ResultApplied, Changes: []string{"remove /title"}},
+				{ID: "bar", Result: customization.ResultNotFound},
+			})).To(Equal(`[{"id":"foo","result":"Applied","changes":["remove /title"]},{"id":"bar","result":"NotFound"}]`))
+		})
+	})
+})
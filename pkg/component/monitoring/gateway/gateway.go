@@ -0,0 +1,299 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/monitoring/authproxy"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the ingress of the observability gateway.
+	ManagedResourceName = "observability-gateway"
+	// ManagedResourceNameRoutes is the name of the ManagedResource containing the routes of a shoot.
+	ManagedResourceNameRoutes = "observability-gateway-routes"
+	// SubDomain is the sub domain of the seed's ingress domain under which the observability gateway is served.
+	SubDomain = "observability"
+
+	name                   = "observability-gateway"
+	secretNameTLS          = "observability-gateway-tls"
+	annotationRewrite      = "nginx.ingress.kubernetes.io/rewrite-target"
+	annotationUseRegex     = "nginx.ingress.kubernetes.io/use-regex"
+	annotationSnippet      = "nginx.ingress.kubernetes.io/server-snippet"
+	tlsCertificateValidity = 730 * 24 * time.Hour
+)
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+// Path returns the path under which the observability endpoint with the given name of the shoot with the given
+// namespace in the seed is served by the observability gateway.
+func Path(namespace, name string) string {
+	return "/" + namespace + "/" + name
+}
+
+// URL returns the URL under which the observability endpoint with the given name of the shoot with the given namespace
+// in the seed is served by the observability gateway with the given host.
+func URL(host, namespace, name string) string {
+	return "https://" + host + Path(namespace, name) + "/"
+}
+
+// Values is a set of configuration values for the observability gateway.
+type Values struct {
+	// Host is the host of the observability gateway.
+	Host string
+	// WildcardCertName is the name of the wildcard TLS certificate which is issued for the seed's ingress domain. If it
+	// is not set, a certificate for the host is generated.
+	WildcardCertName *string
+}
+
+// New creates a new instance of DeployWaiter for the observability gateway of the seed. The gateway serves the
+// observability endpoints of all shoots of the seed under a single host with path-based routing. It terminates TLS
+// and routes the sign-in requests to the observability auth proxy which must run in the same namespace, while the
+// routes to the endpoints of the shoots are deployed with NewRoutes.
+func New(
+	client client.Client,
+	namespace string,
+	secretsManager secretsmanager.Interface,
+	values Values,
+) component.DeployWaiter {
+	return &gateway{
+		client:         client,
+		namespace:      namespace,
+		secretsManager: secretsManager,
+		values:         values,
+	}
+}
+
+type gateway struct {
+	client         client.Client
+	namespace      string
+	secretsManager secretsmanager.Interface
+	values         Values
+}
+
+func (g *gateway) Deploy(ctx context.Context) error {
+	var tlsSecretName string
+	if g.values.WildcardCertName != nil {
+		tlsSecretName = *g.values.WildcardCertName
+	} else {
+		tlsSecret, err := g.secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+			Name:                        secretNameTLS,
+			CommonName:                  name,
+			Organization:                []string{"gardener.cloud:monitoring:ingress"},
+			DNSNames:                    []string{g.values.Host},
+			CertType:                    secretsutils.ServerCert,
+			Validity:                    pointer.Duration(tlsCertificateValidity),
+			SkipPublishingCACertificate: true,
+		}, secretsmanager.SignedByCA(v1beta1constants.SecretNameCASeed))
+		if err != nil {
+			return err
+		}
+		tlsSecretName = tlsSecret.Name
+	}
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: g.namespace,
+			Labels:    getLabels(),
+			Annotations: map[string]string{
+				// The snippet applies to all routes of the host, hence the endpoints of Prometheus and Alertmanager which
+				// must not be reachable from outside are blocked here centrally for all shoots.
+				annotationSnippet: `location ~ ^/[^/]+/(prometheus|alertmanager)/-/(reload|quit) {
+  return 403;
+}
+location ~ ^/[^/]+/prometheus/api/v1/targets {
+  return 403;
+}
+`,
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: pointer.String(v1beta1constants.SeedNginxIngressClass),
+			TLS: []networkingv1.IngressTLS{{
+				SecretName: tlsSecretName,
+				Hosts:      []string{g.values.Host},
+			}},
+			Rules: []networkingv1.IngressRule{{
+				Host: g.values.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{authproxy.IngressPath()},
+					},
+				},
+			}},
+		},
+	}
+
+	registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+	data, err := registry.AddAllAndSerialize(ingress)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, g.client, g.namespace, ManagedResourceName, false, data)
+}
+
+func (g *gateway) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, g.client, g.namespace, ManagedResourceName)
+}
+
+func (g *gateway) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, g.client, g.namespace, ManagedResourceName)
+}
+
+func (g *gateway) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, g.client, g.namespace, ManagedResourceName)
+}
+
+// Route is an observability endpoint of a shoot which is served by the observability gateway.
+type Route struct {
+	// Name is the name of the endpoint. It is served under the path returned by Path.
+	Name string
+	// ServiceName is the name of the service of the endpoint.
+	ServiceName string
+	// Port is the port of the service of the endpoint.
+	Port int32
+}
+
+// RoutesValues is a set of configuration values for the routes of a shoot.
+type RoutesValues struct {
+	// Host is the host of the observability gateway.
+	Host string
+	// AuthProxyNamespace is the namespace of the observability auth proxy of the gateway.
+	AuthProxyNamespace string
+	// AllowedGroups are the groups whose members are granted access to the endpoints of the shoot.
+	AllowedGroups []string
+	// Routes are the observability endpoints of the shoot.
+	Routes []Route
+}
+
+// NewRoutes creates a new instance of DeployWaiter for the routes of the observability gateway to the observability
+// endpoints of a shoot. All endpoints of the shoot are served by a single ingress without a dedicated TLS certificate.
+// The requests are authorized centrally by the auth proxy of the gateway based on the given allowed groups.
+func NewRoutes(
+	client client.Client,
+	namespace string,
+	values RoutesValues,
+) component.DeployWaiter {
+	return &routes{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type routes struct {
+	client    client.Client
+	namespace string
+	values    RoutesValues
+}
+
+func (r *routes) Deploy(ctx context.Context) error {
+	var (
+		pathType = networkingv1.PathTypeImplementationSpecific
+		paths    []networkingv1.HTTPIngressPath
+	)
+
+	for _, route := range r.values.Routes {
+		paths = append(paths, networkingv1.HTTPIngressPath{
+			// The path prefix is stripped by the rewrite target, i.e. the endpoints are served at the root path.
+			Path:     regexp.QuoteMeta(Path(r.namespace, route.Name)) + "(/|$)(.*)",
+			PathType: &pathType,
+			Backend: networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: route.ServiceName,
+					Port: networkingv1.ServiceBackendPort{Number: route.Port},
+				},
+			},
+		})
+	}
+
+	annotations := authproxy.AuthorizingIngressAnnotations(r.values.AuthProxyNamespace, r.values.AllowedGroups)
+	annotations[annotationUseRegex] = "true"
+	annotations[annotationRewrite] = "/$2"
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   r.namespace,
+			Labels:      getLabels(),
+			Annotations: annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: pointer.String(v1beta1constants.SeedNginxIngressClass),
+			Rules: []networkingv1.IngressRule{{
+				Host: r.values.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{Paths: paths},
+				},
+			}},
+		},
+	}
+
+	registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+	data, err := registry.AddAllAndSerialize(ingress)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, r.client, r.namespace, ManagedResourceNameRoutes, false, data)
+}
+
+func (r *routes) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, r.client, r.namespace, ManagedResourceNameRoutes)
+}
+
+func (r *routes) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, r.client, r.namespace, ManagedResourceNameRoutes)
+}
+
+func (r *routes) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, r.client, r.namespace, ManagedResourceNameRoutes)
+}
+
+func getLabels() map[string]string {
+	return map[string]string{
+		v1beta1constants.LabelApp: name,
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/monitoring/gateway"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Gateway", func() {
+	var (
+		ctx = context.TODO()
+
+		host = "observability.seed.example.com"
+
+		c client.Client
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+	})
+
+	getIngress := func(namespace, managedResourceName string) *networkingv1.Ingress {
+		managedResource := &resourcesv1alpha1.ManagedResource{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResourceName}, managedResource)).To(Succeed())
+		Expect(managedResource.Spec.Class).To(PointTo(Equal("seed")))
+
+		managedResourceSecret := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, managedResourceSecret)).To(Succeed())
+		Expect(managedResourceSecret.Data).To(HaveLen(1))
+
+		obj, _, err := kubernetes.SeedCodec.UniversalDecoder().Decode(managedResourceSecret.Data["ingress__"+namespace+"__observability-gateway.yaml"], nil, &networkingv1.Ingress{})
+		Expect(err).NotTo(HaveOccurred())
+		return obj.(*networkingv1.Ingress)
+	}
+
+	Describe("#Path", func() {
+		It("should return the path of the endpoint", func() {
+			Expect(Path("shoot--foo--bar", "plutono")).To(Equal("/shoot--foo--bar/plutono"))
+		})
+	})
+
+	Describe("#URL", func() {
+		It("should return the URL of the endpoint", func() {
+			Expect(URL(host, "shoot--foo--bar", "plutono")).To(Equal("https://observability.seed.example.com/shoot--foo--bar/plutono/"))
+		})
+	})
+
+	Describe("#New", func() {
+		var (
+			namespace      = "garden"
+			secretsManager secretsmanager.Interface
+			values         Values
+			gateway        component.DeployWaiter
+		)
+
+		BeforeEach(func() {
+			secretsManager = fakesecretsmanager.New(c, namespace)
+			values = Values{Host: host}
+		})
+
+		JustBeforeEach(func() {
+			gateway = New(c, namespace, secretsManager, values)
+		})
+
+		It("should deploy the ingress with a generated certificate", func() {
+			Expect(gateway.Deploy(ctx)).To(Succeed())
+
+			ingress := getIngress(namespace, "observability-gateway")
+			Expect(ingress.Annotations).To(HaveKeyWithValue("nginx.ingress.kubernetes.io/server-snippet", ContainSubstring("location ~ ^/[^/]+/(prometheus|alertmanager)/-/(reload|quit)")))
+			Expect(ingress.Spec.IngressClassName).To(PointTo(Equal("nginx-ingress-gardener")))
+			Expect(ingress.Spec.TLS).To(ConsistOf(networkingv1.IngressTLS{SecretName: "observability-gateway-tls", Hosts: []string{host}}))
+			Expect(ingress.Spec.Rules).To(HaveLen(1))
+			Expect(ingress.Spec.Rules[0].Host).To(Equal(host))
+			Expect(ingress.Spec.Rules[0].HTTP.Paths).To(HaveLen(1))
+			Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Path).To(Equal("/oauth2"))
+			Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name).To(Equal("observability-auth-proxy"))
+		})
+
+		Context("with wildcard certificate", func() {
+			BeforeEach(func() {
+				values.WildcardCertName = pointer.String("wildcard")
+			})
+
+			It("should use the wildcard certificate", func() {
+				Expect(gateway.Deploy(ctx)).To(Succeed())
+
+				Expect(getIngress(namespace, "observability-gateway").Spec.TLS).To(ConsistOf(networkingv1.IngressTLS{SecretName: "wildcard", Hosts: []string{host}}))
+			})
+		})
+
+		It("should delete the managed resource", func() {
+			Expect(gateway.Deploy(ctx)).To(Succeed())
+			Expect(gateway.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "observability-gateway"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#NewRoutes", func() {
+		var (
+			namespace = "shoot--foo--bar"
+			routes    component.DeployWaiter
+		)
+
+		BeforeEach(func() {
+			routes = NewRoutes(c, namespace, RoutesValues{
+				Host:               host,
+				AuthProxyNamespace: "garden",
+				AllowedGroups:      []string{"admins"},
+				Routes: []Route{
+					{Name: "plutono", ServiceName: "plutono", Port: 3000},
+					{Name: "prometheus", ServiceName: "prometheus-web", Port: 80},
+				},
+			})
+		})
+
+		It("should deploy a single ingress for all routes", func() {
+			Expect(routes.Deploy(ctx)).To(Succeed())
+
+			ingress := getIngress(namespace, "observability-gateway-routes")
+			Expect(ingress.Annotations).To(And(
+				HaveKeyWithValue("nginx.ingress.kubernetes.io/auth-url", "http://observability-auth-proxy.garden.svc.cluster.local:4180/oauth2/auth?allowed_groups=admins"),
+				HaveKeyWithValue("nginx.ingress.kubernetes.io/use-regex", "true"),
+				HaveKeyWithValue("nginx.ingress.kubernetes.io/rewrite-target", "/$2"),
+			))
+			Expect(ingress.Spec.TLS).To(BeEmpty())
+			Expect(ingress.Spec.Rules).To(HaveLen(1))
+			Expect(ingress.Spec.Rules[0].Host).To(Equal(host))
+
+			pathType := networkingv1.PathTypeImplementationSpecific
+			Expect(ingress.Spec.Rules[0].HTTP.Paths).To(Equal([]networkingv1.HTTPIngressPath{
+				{
+					Path:     "/shoot--foo--bar/plutono(/|$)(.*)",
+					PathType: &pathType,
+					Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+						Name: "plutono",
+						Port: networkingv1.ServiceBackendPort{Number: 3000},
+					}},
+				},
+				{
+					Path:     "/shoot--foo--bar/prometheus(/|$)(.*)",
+					PathType: &pathType,
+					Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+						Name: "prometheus-web",
+						Port: networkingv1.ServiceBackendPort{Number: 80},
+					}},
+				},
+			}))
+		})
+
+		It("should delete the managed resource", func() {
+			Expect(routes.Deploy(ctx)).To(Succeed())
+			Expect(routes.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "observability-gateway-routes"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+	})
+})
@@ -0,0 +1,79 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring
+
+import (
+	"io/fs"
+	"path"
+
+	"github.com/gardener/gardener/pkg/component/monitoring/customization"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var rulesPath = path.Join("charts", "seed-monitoring", "charts", "core", "charts", "prometheus", "rules")
+
+// CustomizedRules applies the given customizations to the rule files of the shoot Prometheus. It returns the
+// successfully customized rule files keyed by their names and the reports of all customizations.
+func CustomizedRules(customizations []gardenletconfig.MonitoringCustomization, workerless bool) (map[string]string, []customization.Report, error) {
+	if len(customizations) == 0 {
+		return nil, nil, nil
+	}
+
+	ruleFiles, err := shootRuleFiles(workerless)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	customizedRuleFiles, reports := customization.Customize(customizations, ruleFiles, func(name, _ string) string { return name })
+
+	customized := map[string]string{}
+	for _, report := range reports {
+		if report.Result == customization.ResultApplied {
+			customized[report.ID] = customizedRuleFiles[report.ID]
+		}
+	}
+
+	return customized, reports, nil
+}
+
+// shootRuleFiles returns the rule files of the shoot Prometheus keyed by their names.
+func shootRuleFiles(workerless bool) (map[string]string, error) {
+	dirs := []string{rulesPath, path.Join(rulesPath, "worker")}
+	if workerless {
+		dirs[1] = path.Join(rulesPath, "workerless")
+	}
+
+	ruleFiles := map[string]string{}
+	for _, dir := range dirs {
+		entries, err := fs.ReadDir(chartCore, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || path.Ext(entry.Name()) != ".yaml" {
+				continue
+			}
+
+			data, err := chartCore.ReadFile(path.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			ruleFiles[entry.Name()] = string(data)
+		}
+	}
+
+	return ruleFiles, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/component/monitoring"
+	"github.com/gardener/gardener/pkg/component/monitoring/customization"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("RuleCustomizations", func() {
+	Describe("#CustomizedRules", func() {
+		var customizations []gardenletconfig.MonitoringCustomization
+
+		BeforeEach(func() {
+			customizations = []gardenletconfig.MonitoringCustomization{
+				{ID: "kube-pods.rules.yaml", Patch: "- op: replace\n  path: /groups/0/rules/0/for\n  value: 2h\n"},
+				{ID: "prometheus.rules.yaml", Patch: "- op: test\n  path: /groups/0/name\n  value: foo\n"},
+				{ID: "unknown.rules.yaml", Patch: "[]"},
+			}
+		})
+
+		It("should return nothing if there are no customizations", func() {
+			customized, reports, err := CustomizedRules(nil, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customized).To(BeNil())
+			Expect(reports).To(BeNil())
+		})
+
+		It("should return the customized rule files and the reports", func() {
+			customized, reports, err := CustomizedRules(customizations, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(customized).To(HaveKey("kube-pods.rules.yaml"))
+			Expect(customized).To(HaveLen(1))
+			Expect(customized["kube-pods.rules.yaml"]).To(ContainSubstring(`"alert":"KubePodPendingShoot"`))
+			Expect(customized["kube-pods.rules.yaml"]).To(ContainSubstring(`"for":"2h"`))
+
+			Expect(reports).To(HaveLen(3))
+			Expect(reports[0]).To(Equal(customization.Report{ID: "kube-pods.rules.yaml", Result: customization.ResultApplied, Changes: []string{"replace /groups/0/rules/0/for"}}))
+			Expect(reports[1].Result).To(Equal(customization.ResultFailed))
+			Expect(reports[2]).To(Equal(customization.Report{ID: "unknown.rules.yaml", Result: customization.ResultNotFound}))
+		})
+
+		It("should only consider the rule files for workerless shoots", func() {
+			customizations = append(customizations, gardenletconfig.MonitoringCustomization{ID: "kube-kubelet.rules.yaml", Patch: "[]"})
+
+			customized, reports, err := CustomizedRules(customizations, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(customized).To(HaveKey("kube-pods.rules.yaml"))
+			Expect(reports[3]).To(Equal(customization.Report{ID: "kube-kubelet.rules.yaml", Result: customization.ResultNotFound}))
+		})
+	})
+})
@@ -0,0 +1,109 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parca
+
+import (
+	"fmt"
+
+	"github.com/prometheus/common/model"
+	"sigs.k8s.io/yaml"
+)
+
+type config struct {
+	ObjectStorage objectStorage  `json:"object_storage"`
+	ScrapeConfigs []scrapeConfig `json:"scrape_configs"`
+}
+
+type objectStorage struct {
+	Bucket map[string]interface{} `json:"bucket"`
+}
+
+type scrapeConfig struct {
+	JobName             string          `json:"job_name"`
+	ScrapeInterval      string          `json:"scrape_interval"`
+	KubernetesSDConfigs []kubernetesSD  `json:"kubernetes_sd_configs"`
+	RelabelConfigs      []relabelConfig `json:"relabel_configs"`
+}
+
+type kubernetesSD struct {
+	Role string `json:"role"`
+}
+
+type relabelConfig struct {
+	SourceLabels []string `json:"source_labels,omitempty"`
+	Action       string   `json:"action,omitempty"`
+	Regex        string   `json:"regex,omitempty"`
+	Replacement  string   `json:"replacement,omitempty"`
+	TargetLabel  string   `json:"target_label,omitempty"`
+}
+
+// computeConfig returns the Parca configuration. It scrapes all running pods annotated with
+// 'profiling.gardener.cloud/scrape=true' on the port specified in the 'profiling.gardener.cloud/port' annotation.
+func (p *parca) computeConfig() ([]byte, error) {
+	bucket := map[string]interface{}{
+		"type":   "FILESYSTEM",
+		"config": map[string]interface{}{"directory": volumeMountPathStorage},
+	}
+
+	if len(p.values.StorageConfig) > 0 {
+		bucket = map[string]interface{}{}
+		if err := yaml.Unmarshal(p.values.StorageConfig, &bucket); err != nil {
+			return nil, fmt.Errorf("failed to parse object storage configuration: %w", err)
+		}
+		if _, ok := bucket["type"]; !ok {
+			return nil, fmt.Errorf("object storage configuration does not specify a type")
+		}
+	}
+
+	return yaml.Marshal(config{
+		ObjectStorage: objectStorage{Bucket: bucket},
+		ScrapeConfigs: []scrapeConfig{{
+			JobName:             "pods",
+			ScrapeInterval:      model.Duration(p.values.ScrapeInterval).String(),
+			KubernetesSDConfigs: []kubernetesSD{{Role: "pod"}},
+			RelabelConfigs: []relabelConfig{
+				{
+					SourceLabels: []string{"__meta_kubernetes_pod_annotation_profiling_gardener_cloud_scrape"},
+					Action:       "keep",
+					Regex:        "true",
+				},
+				{
+					SourceLabels: []string{"__meta_kubernetes_pod_phase"},
+					Action:       "drop",
+					Regex:        "Pending|Succeeded|Failed|Completed",
+				},
+				{
+					SourceLabels: []string{"__address__", "__meta_kubernetes_pod_annotation_profiling_gardener_cloud_port"},
+					Action:       "replace",
+					Regex:        `([^:]+)(?::\d+)?;(\d+)`,
+					Replacement:  "$1:$2",
+					TargetLabel:  "__address__",
+				},
+				{
+					SourceLabels: []string{"__meta_kubernetes_namespace"},
+					TargetLabel:  "namespace",
+				},
+				{
+					SourceLabels: []string{"__meta_kubernetes_pod_name"},
+					TargetLabel:  "pod",
+				},
+				{
+					SourceLabels: []string{"__meta_kubernetes_pod_container_name"},
+					TargetLabel:  "container",
+				},
+			},
+		}},
+	})
+}
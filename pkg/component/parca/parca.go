@@ -0,0 +1,335 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parca
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the managed resource for the resources.
+	ManagedResourceName = "parca"
+	// DataKeyStorageConfig is the key in the data of the storage secret which contains the object storage configuration.
+	DataKeyStorageConfig = "bucket.yaml"
+
+	name          = "parca"
+	containerName = "parca"
+
+	portNameHTTP = "http"
+	portHTTP     = 7070
+
+	configDataKey          = "parca.yaml"
+	volumeNameConfig       = "config"
+	volumeMountPathConfig  = "/etc/parca"
+	volumeNameStorage      = "storage"
+	volumeMountPathStorage = "/var/lib/parca"
+)
+
+// Interface contains functions for a Parca deployer.
+type Interface interface {
+	component.DeployWaiter
+}
+
+// New creates a new instance of DeployWaiter for Parca, the continuous profiling server which periodically collects
+// the pprof profiles of all pods opting in via the 'profiling.gardener.cloud/*' annotations.
+func New(client client.Client, namespace string, values Values) Interface {
+	return &parca{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type parca struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+// Values is a set of configuration values for the Parca component.
+type Values struct {
+	// Image is the container image.
+	Image string
+	// PriorityClassName is the name of the priority class.
+	PriorityClassName string
+	// ScrapeInterval is the interval in which the profiles are collected.
+	ScrapeInterval time.Duration
+	// StorageConfig is the object storage configuration (in the format understood by Parca) the profiles are shipped
+	// to. If empty, the profiles are only kept on the (ephemeral) local file system of the Parca pod.
+	StorageConfig []byte
+}
+
+func (p *parca) Deploy(ctx context.Context) error {
+	config, err := p.computeConfig()
+	if err != nil {
+		return err
+	}
+
+	var (
+		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+		serviceAccount = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: p.namespace,
+				Labels:    getLabels(),
+			},
+			AutomountServiceAccountToken: pointer.Bool(false),
+		}
+		clusterRole = &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "gardener.cloud:parca",
+				Labels: getLabels(),
+			},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "list", "watch"},
+			}},
+		}
+		clusterRoleBinding = &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "gardener.cloud:parca",
+				Labels: getLabels(),
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     clusterRole.Name,
+			},
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccount.Name,
+				Namespace: serviceAccount.Namespace,
+			}},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "parca-config",
+				Namespace: p.namespace,
+				Labels:    getLabels(),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{configDataKey: config},
+		}
+	)
+
+	utilruntime.Must(kubernetesutils.MakeUnique(secret))
+
+	var (
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: p.namespace,
+				Labels:    getLabels(),
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: getLabels(),
+				Ports: []corev1.ServicePort{{
+					Name:       portNameHTTP,
+					Protocol:   corev1.ProtocolTCP,
+					Port:       portHTTP,
+					TargetPort: intstr.FromInt32(portHTTP),
+				}},
+			},
+		}
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: p.namespace,
+				Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+					resourcesv1alpha1.HighAvailabilityConfigType: resourcesv1alpha1.HighAvailabilityConfigTypeController,
+				}),
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas:             pointer.Int32(1),
+				RevisionHistoryLimit: pointer.Int32(2),
+				Selector:             &metav1.LabelSelector{MatchLabels: getLabels()},
+				// Parca keeps the most recent profiles in memory, hence, a second replica must not be started before the
+				// first one has been terminated.
+				Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+							v1beta1constants.LabelNetworkPolicyToDNS:                                                         v1beta1constants.LabelNetworkPolicyAllowed,
+							v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer:                                            v1beta1constants.LabelNetworkPolicyAllowed,
+							v1beta1constants.LabelNetworkPolicyToPublicNetworks:                                              v1beta1constants.LabelNetworkPolicyAllowed,
+							v1beta1constants.LabelNetworkPolicyToPrivateNetworks:                                             v1beta1constants.LabelNetworkPolicyAllowed,
+							"networking.resources.gardener.cloud/to-" + v1beta1constants.LabelNetworkPolicySeedScrapeTargets: v1beta1constants.LabelNetworkPolicyAllowed,
+						}),
+					},
+					Spec: corev1.PodSpec{
+						AutomountServiceAccountToken: pointer.Bool(true),
+						PriorityClassName:            p.values.PriorityClassName,
+						ServiceAccountName:           serviceAccount.Name,
+						SecurityContext: &corev1.PodSecurityContext{
+							RunAsNonRoot: pointer.Bool(true),
+							RunAsUser:    pointer.Int64(65534),
+							FSGroup:      pointer.Int64(65534),
+							SeccompProfile: &corev1.SeccompProfile{
+								Type: corev1.SeccompProfileTypeRuntimeDefault,
+							},
+						},
+						Containers: []corev1.Container{{
+							Name:            containerName,
+							Image:           p.values.Image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args: []string{
+								fmt.Sprintf("--config-path=%s/%s", volumeMountPathConfig, configDataKey),
+								fmt.Sprintf("--http-address=:%d", portHTTP),
+								"--log-level=info",
+								"--enable-persistence",
+							},
+							Ports: []corev1.ContainerPort{{
+								Name:          portNameHTTP,
+								ContainerPort: portHTTP,
+								Protocol:      corev1.ProtocolTCP,
+							}},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("50m"),
+									corev1.ResourceMemory: resource.MustParse("256Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: pointer.Bool(false),
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      volumeNameConfig,
+									MountPath: volumeMountPathConfig,
+									ReadOnly:  true,
+								},
+								{
+									Name:      volumeNameStorage,
+									MountPath: volumeMountPathStorage,
+								},
+							},
+						}},
+						Volumes: []corev1.Volume{
+							{
+								Name: volumeNameConfig,
+								VolumeSource: corev1.VolumeSource{
+									Secret: &corev1.SecretVolumeSource{
+										SecretName: secret.Name,
+									},
+								},
+							},
+							{
+								Name: volumeNameStorage,
+								VolumeSource: corev1.VolumeSource{
+									EmptyDir: &corev1.EmptyDirVolumeSource{},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		vpaUpdateMode = vpaautoscalingv1.UpdateModeAuto
+		vpa           = &vpaautoscalingv1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "parca-vpa",
+				Namespace: p.namespace,
+				Labels:    getLabels(),
+			},
+			Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+					Name:       deployment.Name,
+				},
+				UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{
+					UpdateMode: &vpaUpdateMode,
+				},
+				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
+						ContainerName: vpaautoscalingv1.DefaultContainerResourcePolicy,
+						MinAllowed: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("128Mi"),
+						},
+					}},
+				},
+			},
+		}
+	)
+
+	utilruntime.Must(references.InjectAnnotations(deployment))
+
+	resources, err := registry.AddAllAndSerialize(
+		serviceAccount,
+		clusterRole,
+		clusterRoleBinding,
+		secret,
+		service,
+		deployment,
+		vpa,
+	)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, p.client, p.namespace, ManagedResourceName, false, resources)
+}
+
+func (p *parca) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, p.client, p.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (p *parca) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, p.client, p.namespace, ManagedResourceName)
+}
+
+func (p *parca) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, p.client, p.namespace, ManagedResourceName)
+}
+
+func getLabels() map[string]string {
+	return map[string]string{v1beta1constants.LabelApp: name}
+}
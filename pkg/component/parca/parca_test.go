@@ -0,0 +1,523 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parca_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/parca"
+	componenttest "github.com/gardener/gardener/pkg/component/test"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Parca", func() {
+	var (
+		ctx = context.TODO()
+
+		namespace         = "some-namespace"
+		image             = "some-image:some-tag"
+		priorityClassName = "some-priority-class"
+		values            Values
+
+		c         client.Client
+		component component.DeployWaiter
+
+		managedResourceName   = "parca"
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+
+		configFor func(bucket string) string
+
+		serviceAccount     *corev1.ServiceAccount
+		clusterRole        *rbacv1.ClusterRole
+		clusterRoleBinding *rbacv1.ClusterRoleBinding
+		secretFor          func(config string) *corev1.Secret
+		service            *corev1.Service
+		deploymentFor      func(secretName string) *appsv1.Deployment
+		vpa                *vpaautoscalingv1.VerticalPodAutoscaler
+	)
+
+	BeforeEach(func() {
+		values = Values{
+			Image:             image,
+			PriorityClassName: priorityClassName,
+			ScrapeInterval:    time.Minute,
+		}
+
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		configFor = func(bucket string) string {
+			return `object_storage:
+  bucket:
+` + bucket + `scrape_configs:
+- job_name: pods
+  kubernetes_sd_configs:
+  - role: pod
+  relabel_configs:
+  - action: keep
+    regex: "true"
+    source_labels:
+    - __meta_kubernetes_pod_annotation_profiling_gardener_cloud_scrape
+  - action: drop
+    regex: Pending|Succeeded|Failed|Completed
+    source_labels:
+    - __meta_kubernetes_pod_phase
+  - action: replace
+    regex: ([^:]+)(?::\d+)?;(\d+)
+    replacement: $1:$2
+    source_labels:
+    - __address__
+    - __meta_kubernetes_pod_annotation_profiling_gardener_cloud_port
+    target_label: __address__
+  - source_labels:
+    - __meta_kubernetes_namespace
+    target_label: namespace
+  - source_labels:
+    - __meta_kubernetes_pod_name
+    target_label: pod
+  - source_labels:
+    - __meta_kubernetes_pod_container_name
+    target_label: container
+  scrape_interval: 1m
+`
+		}
+
+		serviceAccount = &corev1.ServiceAccount{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ServiceAccount",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "parca",
+				Namespace: namespace,
+				Labels:    map[string]string{"app": "parca"},
+			},
+			AutomountServiceAccountToken: pointer.Bool(false),
+		}
+		clusterRole = &rbacv1.ClusterRole{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "rbac.authorization.k8s.io/v1",
+				Kind:       "ClusterRole",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "gardener.cloud:parca",
+				Labels: map[string]string{"app": "parca"},
+			},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "list", "watch"},
+			}},
+		}
+		clusterRoleBinding = &rbacv1.ClusterRoleBinding{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "rbac.authorization.k8s.io/v1",
+				Kind:       "ClusterRoleBinding",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "gardener.cloud:parca",
+				Labels: map[string]string{"app": "parca"},
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "ClusterRole",
+				Name:     "gardener.cloud:parca",
+			},
+			Subjects: []rbacv1.Subject{{
+				Kind:      "ServiceAccount",
+				Name:      "parca",
+				Namespace: namespace,
+			}},
+		}
+		secretFor = func(config string) *corev1.Secret {
+			secret := &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "Secret",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "parca-config",
+					Namespace: namespace,
+					Labels:    map[string]string{"app": "parca"},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{"parca.yaml": []byte(config)},
+			}
+			utilruntime.Must(kubernetesutils.MakeUnique(secret))
+			return secret
+		}
+		service = &corev1.Service{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Service",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "parca",
+				Namespace: namespace,
+				Labels:    map[string]string{"app": "parca"},
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: map[string]string{"app": "parca"},
+				Ports: []corev1.ServicePort{{
+					Name:       "http",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       7070,
+					TargetPort: intstr.FromInt32(7070),
+				}},
+			},
+		}
+		deploymentFor = func(secretName string) *appsv1.Deployment {
+			deployment := &appsv1.Deployment{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "parca",
+					Namespace: namespace,
+					Labels: map[string]string{
+						"app": "parca",
+						"high-availability-config.resources.gardener.cloud/type": "controller",
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas:             pointer.Int32(1),
+					RevisionHistoryLimit: pointer.Int32(2),
+					Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{"app": "parca"}},
+					Strategy:             appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app":                              "parca",
+								"networking.gardener.cloud/to-dns": "allowed",
+								"networking.gardener.cloud/to-runtime-apiserver":                 "allowed",
+								"networking.gardener.cloud/to-public-networks":                   "allowed",
+								"networking.gardener.cloud/to-private-networks":                  "allowed",
+								"networking.resources.gardener.cloud/to-all-seed-scrape-targets": "allowed",
+							},
+						},
+						Spec: corev1.PodSpec{
+							AutomountServiceAccountToken: pointer.Bool(true),
+							PriorityClassName:            priorityClassName,
+							ServiceAccountName:           "parca",
+							SecurityContext: &corev1.PodSecurityContext{
+								RunAsNonRoot:   pointer.Bool(true),
+								RunAsUser:      pointer.Int64(65534),
+								FSGroup:        pointer.Int64(65534),
+								SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+							},
+							Containers: []corev1.Container{{
+								Name:            "parca",
+								Image:           image,
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Args: []string{
+									"--config-path=/etc/parca/parca.yaml",
+									"--http-address=:7070",
+									"--log-level=info",
+									"--enable-persistence",
+								},
+								Ports: []corev1.ContainerPort{{
+									Name:          "http",
+									ContainerPort: 7070,
+									Protocol:      corev1.ProtocolTCP,
+								}},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("50m"),
+										corev1.ResourceMemory: resource.MustParse("256Mi"),
+									},
+								},
+								SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: pointer.Bool(false)},
+								VolumeMounts: []corev1.VolumeMount{
+									{Name: "config", MountPath: "/etc/parca", ReadOnly: true},
+									{Name: "storage", MountPath: "/var/lib/parca"},
+								},
+							}},
+							Volumes: []corev1.Volume{
+								{
+									Name:         "config",
+									VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secretName}},
+								},
+								{
+									Name:         "storage",
+									VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+								},
+							},
+						},
+					},
+				},
+			}
+			utilruntime.Must(references.InjectAnnotations(deployment))
+			return deployment
+		}
+		vpaUpdateMode := vpaautoscalingv1.UpdateModeAuto
+		vpa = &vpaautoscalingv1.VerticalPodAutoscaler{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "autoscaling.k8s.io/v1",
+				Kind:       "VerticalPodAutoscaler",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "parca-vpa",
+				Namespace: namespace,
+				Labels:    map[string]string{"app": "parca"},
+			},
+			Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "parca",
+				},
+				UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{UpdateMode: &vpaUpdateMode},
+				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
+						ContainerName: "*",
+						MinAllowed:    corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+					}},
+				},
+			},
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      managedResourceName,
+				Namespace: namespace,
+			},
+		}
+		managedResourceSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "managedresource-" + managedResource.Name,
+				Namespace: namespace,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		component = New(c, namespace, values)
+	})
+
+	Describe("#Deploy", func() {
+		var expectResources = func(config string) {
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			expectedMr := &resourcesv1alpha1.ManagedResource{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: resourcesv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "ManagedResource",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            managedResourceName,
+					Namespace:       namespace,
+					Labels:          map[string]string{"gardener.cloud/role": "seed-system-component"},
+					ResourceVersion: "1",
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					Class: pointer.String("seed"),
+					SecretRefs: []corev1.LocalObjectReference{{
+						Name: managedResource.Spec.SecretRefs[0].Name,
+					}},
+					KeepObjects: pointer.Bool(false),
+				},
+			}
+			utilruntime.Must(references.InjectAnnotations(expectedMr))
+			Expect(managedResource).To(DeepEqual(expectedMr))
+
+			secret := secretFor(config)
+
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(managedResourceSecret.Immutable).To(Equal(pointer.Bool(true)))
+			Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
+			Expect(managedResourceSecret.Data).To(HaveLen(7))
+			Expect(string(managedResourceSecret.Data["serviceaccount__"+namespace+"__parca.yaml"])).To(Equal(componenttest.Serialize(serviceAccount)))
+			Expect(string(managedResourceSecret.Data["clusterrole____gardener.cloud_parca.yaml"])).To(Equal(componenttest.Serialize(clusterRole)))
+			Expect(string(managedResourceSecret.Data["clusterrolebinding____gardener.cloud_parca.yaml"])).To(Equal(componenttest.Serialize(clusterRoleBinding)))
+			Expect(string(managedResourceSecret.Data["secret__"+namespace+"__"+secret.Name+".yaml"])).To(Equal(componenttest.Serialize(secret)))
+			Expect(string(managedResourceSecret.Data["service__"+namespace+"__parca.yaml"])).To(Equal(componenttest.Serialize(service)))
+			Expect(string(managedResourceSecret.Data["deployment__"+namespace+"__parca.yaml"])).To(Equal(componenttest.Serialize(deploymentFor(secret.Name))))
+			Expect(string(managedResourceSecret.Data["verticalpodautoscaler__"+namespace+"__parca-vpa.yaml"])).To(Equal(componenttest.Serialize(vpa)))
+		}
+
+		It("should successfully deploy all resources with a local storage", func() {
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(MatchError(apierrors.NewNotFound(schema.GroupResource{Group: resourcesv1alpha1.SchemeGroupVersion.Group, Resource: "managedresources"}, managedResource.Name)))
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			expectResources(configFor(`    config:
+      directory: /var/lib/parca
+    type: FILESYSTEM
+`))
+		})
+
+		When("an object storage is configured", func() {
+			BeforeEach(func() {
+				values.StorageConfig = []byte(`type: S3
+config:
+  bucket: profiles
+  endpoint: s3.eu-central-1.amazonaws.com
+`)
+			})
+
+			It("should successfully deploy all resources", func() {
+				Expect(component.Deploy(ctx)).To(Succeed())
+
+				expectResources(configFor(`    config:
+      bucket: profiles
+      endpoint: s3.eu-central-1.amazonaws.com
+    type: S3
+`))
+			})
+		})
+
+		It("should fail if the object storage configuration cannot be parsed", func() {
+			values.StorageConfig = []byte("{")
+			component = New(c, namespace, values)
+
+			Expect(component.Deploy(ctx)).To(MatchError(ContainSubstring("failed to parse object storage configuration")))
+		})
+
+		It("should fail if the object storage configuration does not specify a type", func() {
+			values.StorageConfig = []byte("config: {}")
+			component = New(c, namespace, values)
+
+			Expect(component.Deploy(ctx)).To(MatchError(ContainSubstring("does not specify a type")))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully destroy all resources", func() {
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
+
+			Expect(component.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(MatchError(apierrors.NewNotFound(schema.GroupResource{Group: resourcesv1alpha1.SchemeGroupVersion.Group, Resource: "managedresources"}, managedResource.Name)))
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(MatchError(apierrors.NewNotFound(schema.GroupResource{Group: corev1.SchemeGroupVersion.Group, Resource: "secrets"}, managedResourceSecret.Name)))
+		})
+	})
+
+	Context("waiting functions", func() {
+		var fakeOps *retryfake.Ops
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			DeferCleanup(test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			))
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because reading the ManagedResource fails", func() {
+				Expect(component.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should fail because the ManagedResource doesn't become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       managedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{
+								Type:   resourcesv1alpha1.ResourcesApplied,
+								Status: gardencorev1beta1.ConditionFalse,
+							},
+							{
+								Type:   resourcesv1alpha1.ResourcesHealthy,
+								Status: gardencorev1beta1.ConditionFalse,
+							},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(component.Wait(ctx)).To(MatchError(ContainSubstring("is not healthy")))
+			})
+
+			It("should successfully wait for the managed resource to become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       managedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{
+								Type:   resourcesv1alpha1.ResourcesApplied,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+							{
+								Type:   resourcesv1alpha1.ResourcesHealthy,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(component.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should fail when the wait for the managed resource deletion times out", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+				Expect(component.WaitCleanup(ctx)).To(MatchError(ContainSubstring("still exists")))
+			})
+
+			It("should not return an error when it's already removed", func() {
+				Expect(component.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -62,6 +62,30 @@ func (mr *MockInterfaceMockRecorder) Destroy(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), arg0)
 }
 
+// SetAuthProxyEnabled mocks base method.
+func (m *MockInterface) SetAuthProxyEnabled(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAuthProxyEnabled", arg0)
+}
+
+// SetAuthProxyEnabled indicates an expected call of SetAuthProxyEnabled.
+func (mr *MockInterfaceMockRecorder) SetAuthProxyEnabled(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuthProxyEnabled", reflect.TypeOf((*MockInterface)(nil).SetAuthProxyEnabled), arg0)
+}
+
+// SetGatewayURL mocks base method.
+func (m *MockInterface) SetGatewayURL(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetGatewayURL", arg0)
+}
+
+// SetGatewayURL indicates an expected call of SetGatewayURL.
+func (mr *MockInterfaceMockRecorder) SetGatewayURL(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGatewayURL", reflect.TypeOf((*MockInterface)(nil).SetGatewayURL), arg0)
+}
+
 // SetWildcardCertName mocks base method.
 func (m *MockInterface) SetWildcardCertName(arg0 *string) {
 	m.ctrl.T.Helper()
@@ -42,7 +42,10 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/logging/vali"
+	"github.com/gardener/gardener/pkg/component/monitoring/authproxy"
+	"github.com/gardener/gardener/pkg/component/monitoring/customization"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -89,14 +92,26 @@ type Interface interface {
 	component.DeployWaiter
 	// SetWildcardCertName sets the WildcardCertName components.
 	SetWildcardCertName(*string)
+	// SetAuthProxyEnabled sets the AuthProxyEnabled field.
+	SetAuthProxyEnabled(bool)
+	// SetGatewayURL sets the GatewayURL field.
+	SetGatewayURL(string)
 }
 
 // Values is a set of configuration values for the plutono component.
 type Values struct {
 	// AuthSecretName is the secret name of plutono credentials.
 	AuthSecretName string
+	// AuthProxyEnabled specifies whether the ingress is protected by the observability auth proxy instead of basic
+	// authentication.
+	AuthProxyEnabled bool
+	// GatewayURL is the URL under which plutono is served by the shared observability gateway of the seed. If set, no
+	// dedicated ingress is deployed for plutono.
+	GatewayURL string
 	// ClusterType specifies the type of the cluster to which plutono is being deployed.
 	ClusterType component.ClusterType
+	// DashboardCustomizations is a list of landscape-specific customizations which are applied to the dashboards.
+	DashboardCustomizations []gardenletconfig.MonitoringCustomization
 	// Image is the container image used for plutono.
 	Image string
 	// IngressHost is the host name of plutono.
@@ -156,7 +171,7 @@ func (p *plutono) Deploy(ctx context.Context) error {
 				metav1.SetMetaDataLabel(&configMap.ObjectMeta, "component", name)
 				metav1.SetMetaDataLabel(&configMap.ObjectMeta, references.LabelKeyGarbageCollectable, references.LabelValueGarbageCollectable)
 				return nil
-			}); err != nil {
+			}, controllerutils.SkipEmptyPatch{}); err != nil {
 				return err
 			}
 		}
@@ -191,6 +206,14 @@ func (p *plutono) SetWildcardCertName(secretName *string) {
 	p.values.WildcardCertName = secretName
 }
 
+func (p *plutono) SetAuthProxyEnabled(enabled bool) {
+	p.values.AuthProxyEnabled = enabled
+}
+
+func (p *plutono) SetGatewayURL(url string) {
+	p.values.GatewayURL = url
+}
+
 func (p *plutono) computeResourcesData(ctx context.Context) ([]*corev1.ConfigMap, map[string][]byte, error) {
 	var (
 		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
@@ -497,6 +520,15 @@ func (p *plutono) getDashboardsConfigMap(ctx context.Context, suffix string) (*c
 		}
 	}
 
+	dashboards, reports := customization.Customize(p.values.DashboardCustomizations, dashboards, dashboardUID)
+	if len(reports) > 0 {
+		report, err := customization.EncodeReports(reports)
+		if err != nil {
+			return nil, err
+		}
+		metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, customization.AnnotationReport, report)
+	}
+
 	// this is necessary to prevent hitting configmap size limit.
 	if dashboards, err := convertToCompactJSON(dashboards); err != nil {
 		return nil, err
@@ -507,6 +539,17 @@ func (p *plutono) getDashboardsConfigMap(ctx context.Context, suffix string) (*c
 	return configMap, nil
 }
 
+// dashboardUID returns the `uid` of the given dashboard. Dashboards which cannot be decoded have no `uid`.
+func dashboardUID(_, dashboard string) string {
+	var metadata struct {
+		UID string `json:"uid"`
+	}
+	if err := yaml.Unmarshal([]byte(dashboard), &metadata); err != nil {
+		return ""
+	}
+	return metadata.UID
+}
+
 func (p *plutono) getService() *corev1.Service {
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -687,6 +730,12 @@ func (p *plutono) getDeployment(providerConfigMap, dataSourceConfigMap, dashboar
 		})
 	}
 
+	if p.values.GatewayURL != "" {
+		// The gateway strips the path prefix before forwarding the requests, hence plutono must not serve from the sub
+		// path but only generate the links accordingly.
+		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "PL_SERVER_ROOT_URL", Value: p.values.GatewayURL})
+	}
+
 	if p.values.ClusterType == component.ClusterTypeSeed {
 		deployment.Labels = utils.MergeStringMaps(deployment.Labels, map[string]string{v1beta1constants.LabelRole: v1beta1constants.LabelMonitoring})
 	} else if p.values.ClusterType == component.ClusterTypeShoot {
@@ -738,6 +787,11 @@ func (p *plutono) getIngress(ctx context.Context) (*networkingv1.Ingress, error)
 		caName = v1beta1constants.SecretNameCACluster
 	}
 
+	// The observability gateway of the seed serves plutono, hence no dedicated ingress is needed.
+	if p.values.GatewayURL != "" {
+		return nil, nil
+	}
+
 	var ingressTLSSecretName string
 	if p.values.WildcardCertName != nil {
 		ingressTLSSecretName = *p.values.WildcardCertName
@@ -801,6 +855,11 @@ func (p *plutono) getIngress(ctx context.Context) (*networkingv1.Ingress, error)
 		ingress.Labels = getLabels()
 	}
 
+	if p.values.AuthProxyEnabled {
+		ingress.Annotations = authproxy.IngressAnnotations(p.namespace)
+		ingress.Spec.Rules[0].HTTP.Paths = append([]networkingv1.HTTPIngressPath{authproxy.IngressPath()}, ingress.Spec.Rules[0].HTTP.Paths...)
+	}
+
 	return ingress, nil
 }
 
@@ -35,6 +35,7 @@ import (
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/yaml"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -44,6 +45,7 @@ import (
 	comp "github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/logging/vali"
 	. "github.com/gardener/gardener/pkg/component/plutono"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -468,22 +470,30 @@ status:
 kind: Ingress
 metadata:
   annotations:
-    nginx.ingress.kubernetes.io/auth-realm: Authentication Required
 `
-				if !values.IsGardenCluster {
-					if values.ClusterType == comp.ClusterTypeShoot {
-						out += `    nginx.ingress.kubernetes.io/auth-secret: observability-ingress-users-f27eb0bf
+				if values.AuthProxyEnabled {
+					out += `    nginx.ingress.kubernetes.io/auth-response-headers: X-Auth-Request-User,X-Auth-Request-Email
+    nginx.ingress.kubernetes.io/auth-signin: https://$host/oauth2/start?rd=$escaped_request_uri
+    nginx.ingress.kubernetes.io/auth-url: http://observability-auth-proxy.` + namespace + `.svc.cluster.local:4180/oauth2/auth
+`
+				} else {
+					out += `    nginx.ingress.kubernetes.io/auth-realm: Authentication Required
+`
+					if !values.IsGardenCluster {
+						if values.ClusterType == comp.ClusterTypeShoot {
+							out += `    nginx.ingress.kubernetes.io/auth-secret: observability-ingress-users-f27eb0bf
     nginx.ingress.kubernetes.io/auth-type: basic
 `
-					} else {
-						out += `    nginx.ingress.kubernetes.io/auth-secret: global-monitoring-secret
+						} else {
+							out += `    nginx.ingress.kubernetes.io/auth-secret: global-monitoring-secret
     nginx.ingress.kubernetes.io/auth-type: basic
 `
-					}
-				} else {
-					out += `    nginx.ingress.kubernetes.io/auth-secret: observability-ingress-0da36eb1
+						}
+					} else {
+						out += `    nginx.ingress.kubernetes.io/auth-secret: observability-ingress-0da36eb1
     nginx.ingress.kubernetes.io/auth-type: basic
 `
+					}
 				}
 				out += `  creationTimestamp: null
 `
@@ -500,7 +510,18 @@ spec:
   - host: ` + values.IngressHost + `
     http:
       paths:
-      - backend:
+`
+				if values.AuthProxyEnabled {
+					out += `      - backend:
+          service:
+            name: observability-auth-proxy
+            port:
+              number: 4180
+        path: /oauth2
+        pathType: Prefix
+`
+				}
+				out += `      - backend:
           service:
             name: plutono
             port:
@@ -559,7 +580,11 @@ status:
 			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
 			Expect(managedResourceSecret.Type).To(Equal(corev1.SecretTypeOpaque))
-			Expect(managedResourceSecret.Data).To(HaveLen(5))
+			if values.GatewayURL == "" {
+				Expect(managedResourceSecret.Data).To(HaveLen(5))
+			} else {
+				Expect(managedResourceSecret.Data).To(HaveLen(4))
+			}
 			Expect(managedResourceSecret.Immutable).To(Equal(pointer.Bool(true)))
 			Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 		})
@@ -581,7 +606,7 @@ status:
 					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-datasources-27f1a6c5.yaml"])).To(Equal(dataSourceConfigMapYAMLFor(values)))
 					plutonoDashboardsConfigMap, err := getDashboardConfigMaps(ctx, c, namespace, "plutono-dashboards-[^-]{8}")
 					Expect(err).ToNot(HaveOccurred())
-					testDashboardConfigMap(ctx, c, types.NamespacedName{Namespace: namespace, Name: plutonoDashboardsConfigMap.Name}, 22)
+					testDashboardConfigMap(ctx, c, types.NamespacedName{Namespace: namespace, Name: plutonoDashboardsConfigMap.Name}, 24)
 					Expect(string(managedResourceSecret.Data["service__some-namespace__plutono.yaml"])).To(Equal(serviceYAMLFor(values)))
 					Expect(string(managedResourceSecret.Data["ingress__some-namespace__plutono.yaml"])).To(Equal(ingressYAMLFor(values)))
 					managedResourceDeployment, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(managedResourceSecret.Data["deployment__some-namespace__plutono.yaml"], nil, &appsv1.Deployment{})
@@ -590,6 +615,25 @@ status:
 					utilruntime.Must(references.InjectAnnotations(deployment))
 					Expect(deployment).To(DeepEqual(managedResourceDeployment))
 				})
+
+				Context("with dashboard customizations", func() {
+					BeforeEach(func() {
+						values.DashboardCustomizations = []gardenletconfig.MonitoringCustomization{
+							{ID: "fluentbit", Patch: `[{"op": "replace", "path": "/title", "value": "Customized Fluent Bit"}]`},
+							{ID: "unknown", Patch: `[{"op": "remove", "path": "/title"}]`},
+						}
+					})
+
+					It("should apply the customizations and report the results", func() {
+						plutonoDashboardsConfigMap, err := getDashboardConfigMaps(ctx, c, namespace, "plutono-dashboards-[^-]{8}")
+						Expect(err).ToNot(HaveOccurred())
+						testDashboardConfigMap(ctx, c, types.NamespacedName{Namespace: namespace, Name: plutonoDashboardsConfigMap.Name}, 24)
+
+						Expect(plutonoDashboardsConfigMap.Data["fluent-bit-dashboard.json"]).To(ContainSubstring(`"title":"Customized Fluent Bit"`))
+						Expect(plutonoDashboardsConfigMap.Annotations).To(HaveKeyWithValue("monitoring.gardener.cloud/customization-report",
+							`[{"id":"fluentbit","result":"Applied","changes":["replace /title"]},{"id":"unknown","result":"NotFound"}]`))
+					})
+				})
 			})
 
 			Context("Cluster is garden cluster", func() {
@@ -638,6 +682,21 @@ status:
 				Expect(deployment).To(DeepEqual(managedResourceDeployment))
 			})
 
+			It("should not patch unchanged dashboard ConfigMaps on subsequent deployments", func() {
+				var configMapPatches int
+				interceptedClient := interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+					Patch: func(ctx context.Context, cl client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						if _, ok := obj.(*corev1.ConfigMap); ok {
+							configMapPatches++
+						}
+						return cl.Patch(ctx, obj, patch, opts...)
+					},
+				})
+
+				Expect(New(interceptedClient, namespace, fakeSecretManager, values).Deploy(ctx)).To(Succeed())
+				Expect(configMapPatches).To(BeZero())
+			})
+
 			Context("w/ include istio, node-local-dns, mcm, ha-vpn, vpa", func() {
 				BeforeEach(func() {
 					values.IncludeIstioDashboards = true
@@ -662,6 +721,33 @@ status:
 				})
 			})
 
+			Context("w/ auth proxy", func() {
+				BeforeEach(func() {
+					values.AuthProxyEnabled = true
+				})
+
+				It("should protect the ingress with the auth proxy", func() {
+					Expect(string(managedResourceSecret.Data["ingress__some-namespace__plutono.yaml"])).To(Equal(ingressYAMLFor(values)))
+				})
+			})
+
+			Context("w/ shared gateway", func() {
+				BeforeEach(func() {
+					values.GatewayURL = "https://observability.seed.example.com/some-namespace/plutono/"
+				})
+
+				It("should not deploy an ingress but configure the root URL", func() {
+					Expect(managedResourceSecret.Data).NotTo(HaveKey("ingress__some-namespace__plutono.yaml"))
+
+					managedResourceDeployment, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(managedResourceSecret.Data["deployment__some-namespace__plutono.yaml"], nil, &appsv1.Deployment{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(managedResourceDeployment.(*appsv1.Deployment).Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+						Name:  "PL_SERVER_ROOT_URL",
+						Value: "https://observability.seed.example.com/some-namespace/plutono/",
+					}))
+				})
+			})
+
 			Context("shoot is workerless", func() {
 				BeforeEach(func() {
 					values.IsWorkerless = true
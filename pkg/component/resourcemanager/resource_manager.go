@@ -18,6 +18,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -63,11 +64,13 @@ import (
 	resourcemanagerv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/crddeletionprotection"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/deprecatedapicallers"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/endpointslicehints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/extensionvalidation"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/kubernetesservicehost"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podsecuritycontext"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopologyspreadconstraints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/projectedtokenmount"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/seccompprofile"
@@ -180,7 +183,7 @@ var (
 			{
 				APIGroups:     []string{""},
 				Resources:     []string{"configmaps"},
-				ResourceNames: []string{namePrefix + configMapNamePrefix},
+				ResourceNames: []string{namePrefix + configMapNamePrefix, deprecatedapicallers.ConfigMapName},
 				Verbs:         []string{"get", "watch", "update", "patch"},
 			},
 			{
@@ -309,6 +312,9 @@ type Values struct {
 	SchedulingProfile *gardencorev1beta1.SchedulingProfile
 	// DefaultSeccompProfileEnabled specifies if the defaulting seccomp profile webhook of GRM should be enabled or not.
 	DefaultSeccompProfileEnabled bool
+	// DeprecatedAPICallersEnabled specifies if the deprecated-api-callers webhook of GRM should be enabled or not. It is
+	// only applicable for the GRM that is deployed in the Shoot control plane (when TargetDiffersFromSourceCluster=true).
+	DeprecatedAPICallersEnabled bool
 	// EndpointSliceHintsEnabled specifies if the EndpointSlice hints webhook of GRM should be enabled or not.
 	EndpointSliceHintsEnabled bool
 	// KubernetesServiceHost specifies the FQDN of the API server of the target cluster. If it is non-nil, the GRM's
@@ -316,6 +322,11 @@ type Values struct {
 	KubernetesServiceHost *string
 	// PodTopologySpreadConstraintsEnabled specifies if the pod's TSC should be mutated to support rolling updates.
 	PodTopologySpreadConstraintsEnabled bool
+	// PodSecurityContextDefaultingEnabled specifies if the pod-security-context webhook of GRM should be enabled or not.
+	PodSecurityContextDefaultingEnabled bool
+	// PodSecurityContextDefaultingDryRun specifies if the pod-security-context webhook should only report the changes
+	// it would apply to pods.
+	PodSecurityContextDefaultingDryRun bool
 	// FailureToleranceType determines the failure tolerance type for the resource manager deployment.
 	FailureToleranceType *gardencorev1beta1.FailureToleranceType
 	// Zones is number of availability zones.
@@ -325,6 +336,9 @@ type Values struct {
 	TopologyAwareRoutingEnabled bool
 	// IsWorkerless specifies whether the cluster has workers.
 	IsWorkerless bool
+	// ProfilingEnabled specifies whether the pprof endpoints should be served and the pod should be annotated for
+	// being scraped by the continuous profiling component in the seed.
+	ProfilingEnabled bool
 }
 
 // VPAConfig contains information for configuring VerticalPodAutoscaler settings for the gardener-resource-manager deployment.
@@ -564,6 +578,10 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 				DefaultNotReadyTolerationSeconds:    r.values.DefaultNotReadyToleration,
 				DefaultUnreachableTolerationSeconds: r.values.DefaultUnreachableToleration,
 			},
+			PodSecurityContext: resourcemanagerv1alpha1.PodSecurityContextWebhookConfig{
+				Enabled: r.values.PodSecurityContextDefaultingEnabled,
+				DryRun:  r.values.PodSecurityContextDefaultingDryRun,
+			},
 			PodTopologySpreadConstraints: resourcemanagerv1alpha1.PodTopologySpreadConstraintsWebhookConfig{
 				Enabled: r.values.PodTopologySpreadConstraintsEnabled,
 			},
@@ -576,6 +594,10 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		},
 	}
 
+	if r.values.ProfilingEnabled {
+		config.Debugging = &componentbaseconfigv1alpha1.DebuggingConfiguration{EnableProfiling: pointer.Bool(true)}
+	}
+
 	if r.values.WatchedNamespace != nil {
 		config.SourceClientConnection.Namespaces = []string{*r.values.WatchedNamespace}
 	}
@@ -647,6 +669,14 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		}
 
 		config.Controllers.Node.Enabled = true
+		config.Controllers.TrustedCABundle.Enabled = true
+
+		if r.values.DeprecatedAPICallersEnabled {
+			config.Webhooks.DeprecatedAPICallers = resourcemanagerv1alpha1.DeprecatedAPICallersWebhookConfig{
+				Enabled:   true,
+				Namespace: r.namespace,
+			}
+		}
 	}
 
 	// this function should be called at the last to make sure we disable
@@ -732,6 +762,13 @@ func (r *resourceManager) ensureService(ctx context.Context) error {
 				Port:     utils.IntStrPtrFromInt32(resourcemanagerconstants.ServerPort),
 				Protocol: utils.ProtocolPtr(corev1.ProtocolTCP),
 			}))
+
+			if r.values.ProfilingEnabled {
+				// The continuous profiling component runs in the garden namespace of the seed, hence, it must be allowed
+				// to reach the pprof endpoints served on the metrics port.
+				utilruntime.Must(gardenerutils.InjectNetworkPolicyAnnotationsForSeedScrapeTargets(service, portMetrics))
+				utilruntime.Must(gardenerutils.InjectNetworkPolicyNamespaceSelectors(service, metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: v1beta1constants.GardenNamespace}}))
+			}
 		}
 
 		topologyAwareRoutingEnabled := r.values.TopologyAwareRoutingEnabled && r.values.TargetDiffersFromSourceCluster
@@ -811,6 +848,7 @@ func (r *resourceManager) ensureDeployment(ctx context.Context, configMap *corev
 				Labels: utils.MergeStringMaps(r.getDeploymentTemplateLabels(), r.getNetworkPolicyLabels(), map[string]string{
 					resourcesv1alpha1.ProjectedTokenSkip: "true",
 				}),
+				Annotations: r.getDeploymentTemplateAnnotations(),
 			},
 			Spec: corev1.PodSpec{
 				PriorityClassName: r.values.PriorityClassName,
@@ -1251,6 +1289,10 @@ func (r *resourceManager) getMutatingWebhookConfigurationWebhooks(
 		webhooks = append(webhooks, GetSeccompProfileMutatingWebhook(r.values.NamePrefix, namespaceSelector, secretServerCA, buildClientConfigFn))
 	}
 
+	if r.values.PodSecurityContextDefaultingEnabled {
+		webhooks = append(webhooks, GetPodSecurityContextMutatingWebhook(r.values.NamePrefix, namespaceSelector, objectSelector, secretServerCA, buildClientConfigFn))
+	}
+
 	if r.values.KubernetesServiceHost != nil {
 		webhooks = append(webhooks, GetKubernetesServiceHostMutatingWebhook(nil, secretServerCA, buildClientConfigFn))
 	}
@@ -1705,6 +1747,57 @@ func GetSeccompProfileMutatingWebhook(
 	}
 }
 
+// GetPodSecurityContextMutatingWebhook returns the pod-security-context mutating webhook for the resourcemanager
+// component for reuse between the component and integration tests.
+func GetPodSecurityContextMutatingWebhook(
+	resourceManagerPrefix string,
+	namespaceSelector, objectSelector *metav1.LabelSelector,
+	secretServerCA *corev1.Secret,
+	buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig,
+) admissionregistrationv1.MutatingWebhook {
+	var (
+		failurePolicy = admissionregistrationv1.Fail
+		matchPolicy   = admissionregistrationv1.Exact
+		sideEffect    = admissionregistrationv1.SideEffectClassNone
+	)
+
+	oSelector := &metav1.LabelSelector{}
+	if objectSelector != nil {
+		oSelector = objectSelector.DeepCopy()
+	}
+	oSelector.MatchExpressions = append(oSelector.MatchExpressions,
+		metav1.LabelSelectorRequirement{
+			Key:      resourcesv1alpha1.PodSecurityContextSkip,
+			Operator: metav1.LabelSelectorOpDoesNotExist,
+		},
+		metav1.LabelSelectorRequirement{
+			Key:      v1beta1constants.LabelApp,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{resourceManagerPrefix + LabelValue},
+		},
+	)
+
+	return admissionregistrationv1.MutatingWebhook{
+		Name: "pod-security-context.resources.gardener.cloud",
+		Rules: []admissionregistrationv1.RuleWithOperations{{
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{corev1.GroupName},
+				APIVersions: []string{corev1.SchemeGroupVersion.Version},
+				Resources:   []string{"pods"},
+			},
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+		}},
+		NamespaceSelector:       namespaceSelector,
+		ObjectSelector:          oSelector,
+		ClientConfig:            buildClientConfigFn(secretServerCA, podsecuritycontext.WebhookPath),
+		AdmissionReviewVersions: []string{admissionv1beta1.SchemeGroupVersion.Version, admissionv1.SchemeGroupVersion.Version},
+		FailurePolicy:           &failurePolicy,
+		MatchPolicy:             &matchPolicy,
+		SideEffects:             &sideEffect,
+		TimeoutSeconds:          pointer.Int32(10),
+	}
+}
+
 // GetKubernetesServiceHostMutatingWebhook returns the kubernetes-service-host mutating webhook for the resourcemanager
 // component for reuse between the component and integration tests.
 func GetKubernetesServiceHostMutatingWebhook(
@@ -1985,6 +2078,17 @@ func (r *resourceManager) getNetworkPolicyLabels() map[string]string {
 	return labels
 }
 
+func (r *resourceManager) getDeploymentTemplateAnnotations() map[string]string {
+	if !r.values.ProfilingEnabled {
+		return nil
+	}
+
+	return map[string]string{
+		v1beta1constants.AnnotationProfilingScrape: "true",
+		v1beta1constants.AnnotationProfilingPort:   strconv.Itoa(metricsPort),
+	}
+}
+
 func (r *resourceManager) appLabel() map[string]string {
 	return map[string]string{
 		v1beta1constants.LabelApp: r.values.NamePrefix + LabelValue,
@@ -2049,9 +2153,11 @@ func disableControllersAndWebhooksForWorkerlessShoot(config *resourcemanagerv1al
 	// disable unneeded controllers
 	config.Controllers.KubeletCSRApprover.Enabled = false
 	config.Controllers.Node.Enabled = false
+	config.Controllers.TrustedCABundle.Enabled = false
 
 	// disable unneeded webhooks
 	config.Webhooks.PodSchedulerName.Enabled = false
+	config.Webhooks.PodSecurityContext.Enabled = false
 	config.Webhooks.SystemComponentsConfig.Enabled = false
 	config.Webhooks.ProjectedTokenMount.Enabled = false
 	config.Webhooks.HighAvailabilityConfig.Enabled = false
@@ -184,7 +184,7 @@ var _ = Describe("ResourceManager", func() {
 			{
 				APIGroups:     []string{""},
 				Resources:     []string{"configmaps"},
-				ResourceNames: []string{"gardener-resource-manager"},
+				ResourceNames: []string{"gardener-resource-manager", "deprecated-api-callers"},
 				Verbs:         []string{"get", "watch", "update", "patch"},
 			},
 			{
@@ -317,6 +317,7 @@ var _ = Describe("ResourceManager", func() {
 			ConcurrentSyncs:                                  &concurrentSyncs,
 			DefaultNotReadyToleration:                        defaultNotReadyTolerationSeconds,
 			DefaultUnreachableToleration:                     defaultUnreachableTolerationSeconds,
+			DeprecatedAPICallersEnabled:                      true,
 			NetworkPolicyAdditionalNamespaceSelectors:        additionalNetworkPolicyNamespaceSelectors,
 			NetworkPolicyControllerIngressControllerSelector: ingressControllerSelector,
 			HealthSyncPeriod:                                 &healthSyncPeriod,
@@ -471,6 +472,7 @@ var _ = Describe("ResourceManager", func() {
 				}
 
 				config.Controllers.Node.Enabled = !isWorkerless
+				config.Controllers.TrustedCABundle.Enabled = !isWorkerless
 				config.Webhooks.PodSchedulerName = resourcemanagerv1alpha1.PodSchedulerNameWebhookConfig{
 					Enabled:       !isWorkerless,
 					SchedulerName: pointer.String("bin-packing-scheduler"),
@@ -489,6 +491,13 @@ var _ = Describe("ResourceManager", func() {
 						{Key: "c"},
 					},
 				}
+
+				if cfg.DeprecatedAPICallersEnabled {
+					config.Webhooks.DeprecatedAPICallers = resourcemanagerv1alpha1.DeprecatedAPICallersWebhookConfig{
+						Enabled:   true,
+						Namespace: deployNamespace,
+					}
+				}
 			} else {
 				config.Controllers.NetworkPolicy = resourcemanagerv1alpha1.NetworkPolicyControllerConfig{
 					Enabled:         true,
@@ -510,6 +519,10 @@ var _ = Describe("ResourceManager", func() {
 				config.Webhooks.SeccompProfile.Enabled = true
 			}
 
+			if cfg.ProfilingEnabled {
+				config.Debugging = &componentbaseconfigv1alpha1.DebuggingConfiguration{EnableProfiling: pointer.Bool(true)}
+			}
+
 			data, err := runtime.Encode(codec, config)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -778,6 +791,11 @@ var _ = Describe("ResourceManager", func() {
 
 			utilruntime.Must(references.InjectAnnotations(deployment))
 
+			if cfg.ProfilingEnabled {
+				metav1.SetMetaDataAnnotation(&deployment.Spec.Template.ObjectMeta, "profiling.gardener.cloud/scrape", "true")
+				metav1.SetMetaDataAnnotation(&deployment.Spec.Template.ObjectMeta, "profiling.gardener.cloud/port", "8080")
+			}
+
 			if targetClusterDiffersFromSourceCluster {
 				deployment.Labels = utils.MergeStringMaps(deployment.Labels, map[string]string{
 					"high-availability-config.resources.gardener.cloud/type": "server",
@@ -2217,6 +2235,84 @@ subjects:
 			})
 		})
 
+		Context("target cluster != source cluster, profiling enabled", func() {
+			JustBeforeEach(func() {
+				clusterRole.Rules = allowManagedResources
+				cfg.TargetDiffersFromSourceCluster = true
+				cfg.TargetNamespaces = targetNamespaces
+				cfg.WatchedNamespace = nil
+				cfg.ProfilingEnabled = true
+				configMap = configMapFor(nil, pointer.String(gardenerutils.PathGenericKubeconfig), false)
+				deployment = deploymentFor(configMap.Name, cfg.RuntimeKubernetesVersion, nil, pointer.String(gardenerutils.PathGenericKubeconfig), true, nil)
+				service.Annotations["networking.resources.gardener.cloud/from-all-seed-scrape-targets-allowed-ports"] = `[{"protocol":"TCP","port":8080}]`
+				service.Annotations["networking.resources.gardener.cloud/namespace-selectors"] = `[{"matchLabels":{"kubernetes.io/metadata.name":"garden"}}]`
+
+				resourceManager = New(c, deployNamespace, sm, cfg)
+				resourceManager.SetSecrets(secrets)
+			})
+
+			It("should enable profiling and allow the profiling component to scrape the pod", func() {
+				gomock.InOrder(
+					c.EXPECT().Get(ctx, kubernetesutils.Key(deployNamespace, secret.Name), gomock.AssignableToTypeOf(&corev1.Secret{})).
+						Do(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) {
+							obj.SetResourceVersion("0")
+						}),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&corev1.Secret{}), gomock.Any()).
+						Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj).To(DeepEqual(secret))
+						}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(deployNamespace, "gardener-resource-manager"), gomock.AssignableToTypeOf(&corev1.ServiceAccount{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&corev1.ServiceAccount{}), gomock.Any()).
+						Do(func(ctx context.Context, obj runtime.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj).To(DeepEqual(serviceAccount))
+						}),
+					c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).
+						Do(func(_ context.Context, obj *corev1.ConfigMap, _ ...client.CreateOption) {
+							Expect(obj).To(DeepEqual(configMap))
+						}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(clusterRoleName), gomock.AssignableToTypeOf(&rbacv1.ClusterRole{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&rbacv1.ClusterRole{}), gomock.Any()).
+						Do(func(ctx context.Context, obj runtime.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj).To(DeepEqual(clusterRole))
+						}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(clusterRoleName), gomock.AssignableToTypeOf(&rbacv1.ClusterRoleBinding{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&rbacv1.ClusterRoleBinding{}), gomock.Any()).
+						Do(func(ctx context.Context, obj runtime.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj).To(DeepEqual(clusterRoleBinding))
+						}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(deployNamespace, "gardener-resource-manager"), gomock.AssignableToTypeOf(&corev1.Service{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&corev1.Service{}), gomock.Any()).
+						Do(func(ctx context.Context, obj runtime.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj).To(DeepEqual(service))
+						}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(deployNamespace, "gardener-resource-manager"), gomock.AssignableToTypeOf(&appsv1.Deployment{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&appsv1.Deployment{}), gomock.Any()).
+						Do(func(ctx context.Context, obj runtime.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj).To(DeepEqual(deployment))
+						}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(deployNamespace, pdb.Name), gomock.AssignableToTypeOf(&policyv1.PodDisruptionBudget{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&policyv1.PodDisruptionBudget{}), gomock.Any()).
+						Do(func(ctx context.Context, obj runtime.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj).To(DeepEqual(pdb))
+						}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(deployNamespace, "gardener-resource-manager-vpa"), gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{}), gomock.Any()).
+						Do(func(ctx context.Context, obj runtime.Object, _ client.Patch, _ ...client.PatchOption) {
+							Expect(obj).To(DeepEqual(vpa))
+						}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(deployNamespace, managedResourceSecret.Name), gomock.AssignableToTypeOf(&corev1.Secret{})),
+					c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&corev1.Secret{})).Do(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) {
+						Expect(obj).To(DeepEqual(managedResourceSecret))
+					}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(deployNamespace, "shoot-core-gardener-resource-manager"), gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})),
+					c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Do(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) {
+						Expect(obj).To(Equal(managedResource))
+					}),
+				)
+				Expect(resourceManager.Deploy(ctx)).To(Succeed())
+			})
+		})
+
 		Context("target cluster = source cluster", func() {
 			BeforeEach(func() {
 				clusterRole.Rules = allowAll
@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/logging/fluentoperator"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 )
 
 // NewFluentBit instantiates a new `Fluent-bit` component.
@@ -28,6 +29,7 @@ func NewFluentBit(
 	gardenNamespaceName string,
 	enabled bool,
 	priorityClassName string,
+	logForwardings []config.LogForwarding,
 ) (
 	deployer component.DeployWaiter,
 	err error,
@@ -49,6 +51,7 @@ func NewFluentBit(
 			Image:              fluentBitImage.String(),
 			InitContainerImage: fluentBitInitImage.String(),
 			PriorityClass:      priorityClassName,
+			LogForwardings:     logForwardings,
 		},
 	)
 
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/component-base/version"
@@ -27,6 +28,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	operatorv1alpha1helper "github.com/gardener/gardener/pkg/apis/operator/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/component/apiserver"
 	"github.com/gardener/gardener/pkg/component/gardenerapiserver"
 	"github.com/gardener/gardener/pkg/logger"
@@ -44,6 +46,8 @@ func NewGardenerAPIServer(
 	secretsManager secretsmanager.Interface,
 	apiServerConfig *operatorv1alpha1.GardenerAPIServerConfig,
 	autoscalingConfig apiserver.AutoscalingConfig,
+	vpaMinAllowed corev1.ResourceList,
+	vpaMaxAllowed corev1.ResourceList,
 	auditWebhookConfig *apiserver.AuditWebhook,
 	topologyAwareRoutingEnabled bool,
 	clusterIdentity string,
@@ -65,6 +69,7 @@ func NewGardenerAPIServer(
 		requests                 *gardencorev1beta1.APIServerRequests
 		watchCacheSizes          *gardencorev1beta1.WatchCacheSizes
 		logging                  *gardencorev1beta1.APIServerLogging
+		horizontalAutoscaling    *gardenerapiserver.HorizontalAutoscalingConfig
 	)
 
 	if apiServerConfig != nil {
@@ -79,6 +84,15 @@ func NewGardenerAPIServer(
 		logging = apiServerConfig.Logging
 		requests = apiServerConfig.Requests
 		watchCacheSizes = apiServerConfig.WatchCacheSizes
+
+		if apiServerConfig.Autoscaling != nil {
+			minReplicas, maxReplicas := operatorv1alpha1helper.GetHorizontalAutoscalingReplicas(apiServerConfig.Autoscaling)
+			horizontalAutoscaling = &gardenerapiserver.HorizontalAutoscalingConfig{
+				MinReplicas:              minReplicas,
+				MaxReplicas:              maxReplicas,
+				TargetRequestsPerReplica: apiServerConfig.Autoscaling.TargetRequestsPerReplica,
+			}
+		}
 	}
 
 	logLevel := logger.InfoLevel
@@ -112,6 +126,9 @@ func NewGardenerAPIServer(
 			LogLevel:                    logLevel,
 			LogFormat:                   logger.FormatJSON,
 			TopologyAwareRoutingEnabled: topologyAwareRoutingEnabled,
+			MinAllowed:                  vpaMinAllowed,
+			MaxAllowed:                  vpaMaxAllowed,
+			HorizontalAutoscaling:       horizontalAutoscaling,
 		},
 	), nil
 }
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -35,6 +36,7 @@ import (
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/apiserver"
+	"github.com/gardener/gardener/pkg/component/gardenerapiserver"
 	mockgardenerapiserver "github.com/gardener/gardener/pkg/component/gardenerapiserver/mock"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -64,6 +66,8 @@ var _ = Describe("GardenerAPIServer", func() {
 			secret             *corev1.Secret
 			runtimeVersion     *semver.Version
 			autoscalingConfig  apiserver.AutoscalingConfig
+			vpaMinAllowed      corev1.ResourceList
+			vpaMaxAllowed      corev1.ResourceList
 			auditWebhookConfig *apiserver.AuditWebhook
 			sm                 secretsmanager.Interface
 		)
@@ -73,6 +77,8 @@ var _ = Describe("GardenerAPIServer", func() {
 			objectMeta = metav1.ObjectMeta{Namespace: namespace, Name: name}
 			runtimeVersion = semver.MustParse("1.27.0")
 			autoscalingConfig = apiserver.AutoscalingConfig{}
+			vpaMinAllowed = nil
+			vpaMaxAllowed = nil
 			auditWebhookConfig = nil
 
 			secret = &corev1.Secret{
@@ -96,7 +102,7 @@ var _ = Describe("GardenerAPIServer", func() {
 				func(configuredPlugins []gardencorev1beta1.AdmissionPlugin, expectedPlugins []apiserver.AdmissionPluginConfig) {
 					apiServerConfig.AdmissionPlugins = configuredPlugins
 
-					gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+					gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(gardenerAPIServer.GetValues().EnabledAdmissionPlugins).To(Equal(expectedPlugins))
 				},
@@ -133,7 +139,7 @@ var _ = Describe("GardenerAPIServer", func() {
 				var expectedDisabledPlugins []gardencorev1beta1.AdmissionPlugin
 
 				AfterEach(func() {
-					gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+					gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(gardenerAPIServer.GetValues().DisabledAdmissionPlugins).To(Equal(expectedDisabledPlugins))
 				})
@@ -202,7 +208,7 @@ var _ = Describe("GardenerAPIServer", func() {
 						prepTest()
 					}
 
-					gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+					gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 					Expect(err).To(errMatcher)
 					if gardenerAPIServer != nil {
 						Expect(gardenerAPIServer.GetValues().Audit).To(Equal(expectedConfig))
@@ -335,7 +341,7 @@ var _ = Describe("GardenerAPIServer", func() {
 
 		Describe("FeatureGates", func() {
 			It("should set the field to nil by default", func() {
-				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(gardenerAPIServer.GetValues().FeatureGates).To(BeNil())
 			})
@@ -349,7 +355,7 @@ var _ = Describe("GardenerAPIServer", func() {
 					},
 				}
 
-				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(gardenerAPIServer.GetValues().FeatureGates).To(Equal(featureGates))
 			})
@@ -357,7 +363,7 @@ var _ = Describe("GardenerAPIServer", func() {
 
 		Describe("Requests", func() {
 			It("should set the field to nil by default", func() {
-				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(gardenerAPIServer.GetValues().Requests).To(BeNil())
 			})
@@ -369,15 +375,52 @@ var _ = Describe("GardenerAPIServer", func() {
 				}
 				apiServerConfig = &operatorv1alpha1.GardenerAPIServerConfig{Requests: requests}
 
-				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(gardenerAPIServer.GetValues().Requests).To(Equal(requests))
 			})
 		})
 
+		Describe("VPA bounds", func() {
+			It("should set the fields to the given values", func() {
+				vpaMinAllowed = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+				vpaMaxAllowed = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}
+
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gardenerAPIServer.GetValues().MinAllowed).To(Equal(vpaMinAllowed))
+				Expect(gardenerAPIServer.GetValues().MaxAllowed).To(Equal(vpaMaxAllowed))
+			})
+		})
+
+		Describe("HorizontalAutoscaling", func() {
+			It("should set the field to nil by default", func() {
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gardenerAPIServer.GetValues().HorizontalAutoscaling).To(BeNil())
+			})
+
+			It("should set the field to the configured values and default the replicas", func() {
+				apiServerConfig = &operatorv1alpha1.GardenerAPIServerConfig{
+					Autoscaling: &operatorv1alpha1.HorizontalAutoscaling{
+						MaxReplicas:              pointer.Int32(8),
+						TargetRequestsPerReplica: 200,
+					},
+				}
+
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gardenerAPIServer.GetValues().HorizontalAutoscaling).To(Equal(&gardenerapiserver.HorizontalAutoscalingConfig{
+					MinReplicas:              1,
+					MaxReplicas:              8,
+					TargetRequestsPerReplica: 200,
+				}))
+			})
+		})
+
 		Describe("WatchCacheSizes", func() {
 			It("should set the field to nil by default", func() {
-				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(gardenerAPIServer.GetValues().WatchCacheSizes).To(BeNil())
 			})
@@ -389,7 +432,7 @@ var _ = Describe("GardenerAPIServer", func() {
 				}
 				apiServerConfig = &operatorv1alpha1.GardenerAPIServerConfig{WatchCacheSizes: watchCacheSizes}
 
-				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
+				gardenerAPIServer, err := NewGardenerAPIServer(ctx, runtimeClient, namespace, objectMeta, runtimeVersion, sm, apiServerConfig, autoscalingConfig, vpaMinAllowed, vpaMaxAllowed, auditWebhookConfig, topologyAwareRoutingEnabled, clusterIdentity)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(gardenerAPIServer.GetValues().WatchCacheSizes).To(Equal(watchCacheSizes))
 			})
@@ -100,6 +100,7 @@ func NewKubeAPIServer(
 	authenticationWebhookConfig *kubeapiserver.AuthenticationWebhook,
 	authorizationWebhookConfig *kubeapiserver.AuthorizationWebhook,
 	resourcesToStoreInETCDEvents []schema.GroupResource,
+	deprecatedAPICallersWebhookEnabled bool,
 	fastRollout bool,
 ) (
 	kubeapiserver.Interface,
@@ -185,6 +186,7 @@ func NewKubeAPIServer(
 			AuthorizationWebhook:                authorizationWebhookConfig,
 			DefaultNotReadyTolerationSeconds:    defaultNotReadyTolerationSeconds,
 			DefaultUnreachableTolerationSeconds: defaultUnreachableTolerationSeconds,
+			DeprecatedAPICallersWebhookEnabled:  deprecatedAPICallersWebhookEnabled,
 			EventTTL:                            eventTTL,
 			Images:                              images,
 			IsWorkerless:                        isWorkerless,
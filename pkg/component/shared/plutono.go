@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/plutono"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -34,6 +35,7 @@ func NewPlutono(
 	includeIstioDashboards, isWorkerless bool,
 	isGardenCluster, nodeLocalDNSEnabled, vpnHighAvailabilityEnabled, vpaEnabled bool,
 	wildcardCertName *string,
+	customizations *gardenletconfig.MonitoringCustomizations,
 ) (
 	plutono.Interface,
 	error,
@@ -43,6 +45,11 @@ func NewPlutono(
 		return nil, err
 	}
 
+	var dashboardCustomizations []gardenletconfig.MonitoringCustomization
+	if customizations != nil {
+		dashboardCustomizations = customizations.Dashboards
+	}
+
 	return plutono.New(
 		c,
 		namespace,
@@ -50,6 +57,7 @@ func NewPlutono(
 		plutono.Values{
 			AuthSecretName:             authSecretName,
 			ClusterType:                clusterType,
+			DashboardCustomizations:    dashboardCustomizations,
 			Image:                      plutonoImage.String(),
 			IngressHost:                ingressHost,
 			IncludeIstioDashboards:     includeIstioDashboards,
@@ -62,6 +62,7 @@ func NewRuntimeGardenerResourceManager(
 	endpointSliceHintsEnabled bool,
 	additionalNetworkPolicyNamespaceSelectors []metav1.LabelSelector,
 	zones []string,
+	profilingEnabled bool,
 ) (
 	component.DeployWaiter,
 	error,
@@ -96,6 +97,7 @@ func NewRuntimeGardenerResourceManager(
 		//  MatchLabelKeysInPodTopologySpread feature gate is beta and enabled by default (probably 1.26+).
 		PodTopologySpreadConstraintsEnabled: true,
 		PriorityClassName:                   priorityClassName,
+		ProfilingEnabled:                    profilingEnabled,
 		Replicas:                            pointer.Int32(2),
 		ResourceClass:                       pointer.String(v1beta1constants.SeedResourceManagerClass),
 		SecretNameServerCA:                  secretNameServerCA,
@@ -123,6 +125,7 @@ func NewTargetGardenerResourceManager(
 	logLevel, logFormat string,
 	namePrefix string,
 	podTopologySpreadConstraintsEnabled bool,
+	podSecurityContextDefaultingEnabled, podSecurityContextDefaultingDryRun bool,
 	priorityClassName string,
 	schedulingProfile *gardencorev1beta1.SchedulingProfile,
 	secretNameServerCA string,
@@ -130,7 +133,9 @@ func NewTargetGardenerResourceManager(
 	topologyAwareRoutingEnabled bool,
 	kubernetesServiceHost *string,
 	isWorkerless bool,
+	deprecatedAPICallersEnabled bool,
 	targetNamespaces []string,
+	profilingEnabled bool,
 ) (
 	resourcemanager.Interface,
 	error,
@@ -147,6 +152,7 @@ func NewTargetGardenerResourceManager(
 		ConcurrentSyncs:                      pointer.Int(20),
 		DefaultNotReadyToleration:            defaultNotReadyTolerationSeconds,
 		DefaultUnreachableToleration:         defaultUnreachableTolerationSeconds,
+		DeprecatedAPICallersEnabled:          deprecatedAPICallersEnabled,
 		HealthSyncPeriod:                     &metav1.Duration{Duration: time.Minute},
 		Image:                                image.String(),
 		KubernetesServiceHost:                kubernetesServiceHost,
@@ -158,7 +164,10 @@ func NewTargetGardenerResourceManager(
 		MaxConcurrentCSRApproverWorkers:      pointer.Int(5),
 		NamePrefix:                           namePrefix,
 		PodTopologySpreadConstraintsEnabled:  podTopologySpreadConstraintsEnabled,
+		PodSecurityContextDefaultingEnabled:  podSecurityContextDefaultingEnabled,
+		PodSecurityContextDefaultingDryRun:   podSecurityContextDefaultingDryRun,
 		PriorityClassName:                    priorityClassName,
+		ProfilingEnabled:                     profilingEnabled,
 		SchedulingProfile:                    schedulingProfile,
 		SecretNameServerCA:                   secretNameServerCA,
 		SyncPeriod:                           &metav1.Duration{Duration: time.Minute},
@@ -15,6 +15,8 @@
 package shared
 
 import (
+	"time"
+
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,8 +35,10 @@ func NewVali(
 	clusterType component.ClusterType,
 	replicas int32,
 	isShootNodeLoggingEnabled bool,
+	isQueryAPIEnabled bool,
 	priorityClassName string,
 	storage *resource.Quantity,
+	retentionPeriod *time.Duration,
 	ingressHost string,
 	hvpaEnabled bool,
 	maintenanceTimeWindow *hvpav1alpha1.MaintenanceTimeWindow,
@@ -83,8 +87,10 @@ func NewVali(
 		HVPAEnabled:             hvpaEnabled,
 		MaintenanceTimeWindow:   maintenanceTimeWindow,
 		ShootNodeLoggingEnabled: isShootNodeLoggingEnabled,
+		QueryAPIEnabled:         isQueryAPIEnabled,
 		PriorityClassName:       priorityClassName,
 		Storage:                 storage,
+		RetentionPeriod:         retentionPeriod,
 		ClusterType:             clusterType,
 		IngressHost:             ingressHost,
 	})
@@ -0,0 +1,138 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// SnapshotDeployment returns the configuration the container with the given name of the given deployment is running
+// with. The flags are read from the container's command and arguments, the configuration files from the mounted
+// ConfigMaps. Mounted Secrets only contribute their checksums, i.e., their content is never part of the snapshot.
+func SnapshotDeployment(ctx context.Context, c client.Reader, namespace, name, containerName string) (*ConfigSnapshot, error) {
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, kubernetesutils.Key(namespace, name), deployment); err != nil {
+		return nil, err
+	}
+
+	var container *corev1.Container
+	for i, cont := range deployment.Spec.Template.Spec.Containers {
+		if cont.Name == containerName {
+			container = &deployment.Spec.Template.Spec.Containers[i]
+			break
+		}
+	}
+	if container == nil {
+		return nil, fmt.Errorf("deployment %s does not have a container named %q", client.ObjectKeyFromObject(deployment), containerName)
+	}
+
+	snapshot := &ConfigSnapshot{
+		Component: name,
+		Image:     container.Image,
+		Files:     map[string]string{},
+		Checksums: map[string]string{},
+	}
+
+	if len(container.Command) > 1 {
+		snapshot.Flags = append(snapshot.Flags, container.Command[1:]...)
+	}
+	snapshot.Flags = append(snapshot.Flags, container.Args...)
+
+	volumes := make(map[string]corev1.Volume, len(deployment.Spec.Template.Spec.Volumes))
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		volumes[volume.Name] = volume
+	}
+
+	for _, volumeMount := range container.VolumeMounts {
+		volume, ok := volumes[volumeMount.Name]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case volume.ConfigMap != nil:
+			configMap := &corev1.ConfigMap{}
+			if err := c.Get(ctx, kubernetesutils.Key(namespace, volume.ConfigMap.Name), configMap); err != nil {
+				if apierrors.IsNotFound(err) && pointer.BoolDeref(volume.ConfigMap.Optional, false) {
+					continue
+				}
+				return nil, err
+			}
+
+			snapshot.Checksums[volume.Name] = utils.ComputeConfigMapChecksum(configMap.Data)
+			for filePath, content := range mountedFiles(volumeMount, volume.ConfigMap.Items, configMap.Data) {
+				snapshot.Files[filePath] = content
+			}
+
+		case volume.Secret != nil:
+			secret := &corev1.Secret{}
+			if err := c.Get(ctx, kubernetesutils.Key(namespace, volume.Secret.SecretName), secret); err != nil {
+				if apierrors.IsNotFound(err) && pointer.BoolDeref(volume.Secret.Optional, false) {
+					continue
+				}
+				return nil, err
+			}
+
+			snapshot.Checksums[volume.Name] = utils.ComputeSecretChecksum(secret.Data)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// mountedFiles returns the files (mapping the path in the container to the content) of the given data which are
+// visible via the given volume mount.
+func mountedFiles(volumeMount corev1.VolumeMount, items []corev1.KeyToPath, data map[string]string) map[string]string {
+	keyToPath := make(map[string]string, len(data))
+	if len(items) > 0 {
+		for _, item := range items {
+			keyToPath[item.Key] = item.Path
+		}
+	} else {
+		for key := range data {
+			keyToPath[key] = key
+		}
+	}
+
+	files := make(map[string]string, len(keyToPath))
+	for key, filePath := range keyToPath {
+		content, ok := data[key]
+		if !ok {
+			continue
+		}
+
+		if volumeMount.SubPath != "" {
+			if filePath == volumeMount.SubPath {
+				files[volumeMount.MountPath] = content
+			}
+			continue
+		}
+
+		files[path.Join(volumeMount.MountPath, filePath)] = content
+	}
+
+	return files
+}
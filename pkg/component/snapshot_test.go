@@ -0,0 +1,149 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		ctx        = context.TODO()
+		namespace  = "shoot--foo--bar"
+		fakeClient client.Client
+
+		deployment *appsv1.Deployment
+		configMap  *corev1.ConfigMap
+		secret     *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config-abcd", Namespace: namespace},
+			Data:       map[string]string{"config.yaml": "foo: bar", "other.yaml": "bar: baz"},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "server-cert", Namespace: namespace},
+			Data:       map[string][]byte{"tls.key": []byte("secret")},
+		}
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "component", Namespace: namespace},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{Name: "sidecar", Image: "sidecar:v1"},
+							{
+								Name:    "component",
+								Image:   "component:v1",
+								Command: []string{"/usr/local/bin/component", "--foo=bar"},
+								Args:    []string{"--bar=baz"},
+								VolumeMounts: []corev1.VolumeMount{
+									{Name: "config", MountPath: "/etc/component/config"},
+									{Name: "single-file", MountPath: "/etc/component/other.yaml", SubPath: "other.yaml"},
+									{Name: "server-cert", MountPath: "/srv/component/tls"},
+									{Name: "optional", MountPath: "/etc/component/optional"},
+								},
+							},
+						},
+						Volumes: []corev1.Volume{
+							{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+								Items:                []corev1.KeyToPath{{Key: "config.yaml", Path: "component.yaml"}},
+							}}},
+							{Name: "single-file", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+							}}},
+							{Name: "server-cert", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secret.Name}}},
+							{Name: "optional", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "missing", Optional: pointer.Bool(true)}}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#SnapshotDeployment", func() {
+		It("should return the configuration of the container", func() {
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			snapshot, err := SnapshotDeployment(ctx, fakeClient, namespace, "component", "component")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot).To(Equal(&ConfigSnapshot{
+				Component: "component",
+				Image:     "component:v1",
+				Flags:     []string{"--foo=bar", "--bar=baz"},
+				Files: map[string]string{
+					"/etc/component/config/component.yaml": "foo: bar",
+					"/etc/component/other.yaml":            "bar: baz",
+				},
+				Checksums: map[string]string{
+					"config":      utils.ComputeConfigMapChecksum(configMap.Data),
+					"single-file": utils.ComputeConfigMapChecksum(configMap.Data),
+					"server-cert": utils.ComputeSecretChecksum(secret.Data),
+				},
+			}))
+		})
+
+		It("should fail if the container does not exist", func() {
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			_, err := SnapshotDeployment(ctx, fakeClient, namespace, "component", "foo")
+			Expect(err).To(MatchError(ContainSubstring(`does not have a container named "foo"`)))
+		})
+
+		It("should fail if a mounted ConfigMap does not exist", func() {
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			_, err := SnapshotDeployment(ctx, fakeClient, namespace, "component", "component")
+			Expect(err).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#Export", func() {
+		It("should serialize the snapshot", func() {
+			data, err := (&ConfigSnapshot{
+				Component: "component",
+				Flags:     []string{"--foo=bar"},
+				Files:     map[string]string{"/etc/component/config.yaml": "foo: bar"},
+			}).Export()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(`component: component
+files:
+  /etc/component/config.yaml: 'foo: bar'
+flags:
+- --foo=bar
+`))
+		})
+	})
+})
@@ -21,6 +21,8 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	istioapimetav1alpha1 "istio.io/api/meta/v1alpha1"
 	istioapinetworkingv1beta1 "istio.io/api/networking/v1beta1"
+	istioapisecurityv1beta1 "istio.io/api/security/v1beta1"
+	istioapitypev1beta1 "istio.io/api/type/v1beta1"
 )
 
 // CmpOptsForDestinationRule returns a compare option to ignore unexported fields in types related to destination rules
@@ -66,3 +68,17 @@ func CmpOptsForVirtualService() cmp.Option {
 		istioapimetav1alpha1.IstioStatus{},
 	)
 }
+
+// CmpOptsForAuthorizationPolicy returns a compare option to ignore unexported fields in types related to authorization
+// policies
+func CmpOptsForAuthorizationPolicy() cmp.Option {
+	return cmpopts.IgnoreUnexported(
+		istioapisecurityv1beta1.AuthorizationPolicy{},
+		istioapisecurityv1beta1.Rule{},
+		istioapisecurityv1beta1.Rule_From{},
+		istioapisecurityv1beta1.Source{},
+		istioapisecurityv1beta1.Condition{},
+		istioapitypev1beta1.WorkloadSelector{},
+		istioapimetav1alpha1.IstioStatus{},
+	)
+}
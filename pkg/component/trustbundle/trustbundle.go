@@ -0,0 +1,217 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustbundle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "shoot-core-trust-bundle"
+	// ConfigMapName is the name of the ConfigMap in the shoot which contains the trust bundle.
+	ConfigMapName = "gardener-trust-bundle"
+
+	// DataKeyCABundle is the key in the data of the ConfigMap containing the CA bundle of the shoot cluster.
+	DataKeyCABundle = "ca.crt"
+	// DataKeyClusterIdentity is the key in the data of the ConfigMap containing the identity of the shoot cluster.
+	DataKeyClusterIdentity = "cluster-identity"
+	// DataKeySeedName is the key in the data of the ConfigMap containing the name of the seed hosting the shoot's
+	// control plane.
+	DataKeySeedName = "seed-name"
+	// DataKeySeedClusterIdentity is the key in the data of the ConfigMap containing the identity of the seed cluster.
+	DataKeySeedClusterIdentity = "seed-cluster-identity"
+	// DataKeyGardenClusterIdentity is the key in the data of the ConfigMap containing the identity of the garden cluster.
+	DataKeyGardenClusterIdentity = "garden-cluster-identity"
+	// DataKeyAPIServerURL is the key in the data of the ConfigMap containing the URL of the shoot's API server.
+	DataKeyAPIServerURL = "api-server-url"
+	// DataKeyTrustedCABundles is the key in the data of the trusted CA bundles ConfigMap containing all additional
+	// trusted CA bundles of the shoot concatenated.
+	DataKeyTrustedCABundles = "ca-bundle.crt"
+
+	roleName = "gardener.cloud:trust-bundle:reader"
+)
+
+// Interface contains functions for managing the trust bundle of a shoot.
+type Interface interface {
+	component.DeployWaiter
+	// SetClusterIdentity sets the identity of the shoot cluster.
+	SetClusterIdentity(string)
+}
+
+// Values contains configurations for the component.
+type Values struct {
+	// ClusterIdentity is the identity of the shoot cluster.
+	ClusterIdentity string
+	// SeedName is the name of the seed hosting the shoot's control plane.
+	SeedName string
+	// SeedClusterIdentity is the identity of the seed cluster.
+	SeedClusterIdentity string
+	// GardenClusterIdentity is the identity of the garden cluster.
+	GardenClusterIdentity string
+	// APIServerURL is the URL of the shoot's API server.
+	APIServerURL string
+	// TrustedCABundles are the additional trusted CA bundles of the shoot. If set, they are published in the
+	// kube-system namespace from where the gardener-resource-manager copies them into all other namespaces.
+	TrustedCABundles []gardencorev1beta1.TrustedCABundle
+}
+
+// New creates a new instance of DeployWaiter for the trust bundle of a shoot.
+func New(client client.Client, namespace string, secretsManager secretsmanager.Interface, values Values) Interface {
+	return &trustBundle{
+		client:         client,
+		namespace:      namespace,
+		secretsManager: secretsManager,
+		values:         values,
+	}
+}
+
+type trustBundle struct {
+	client         client.Client
+	namespace      string
+	secretsManager secretsmanager.Interface
+	values         Values
+}
+
+func (t *trustBundle) Deploy(ctx context.Context) error {
+	caBundleSecret, found := t.secretsManager.Get(v1beta1constants.SecretNameCACluster)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCACluster)
+	}
+
+	data, err := t.computeResourcesData(string(caBundleSecret.Data[secretsutils.DataKeyCertificateBundle]))
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForShoot(ctx, t.client, t.namespace, ManagedResourceName, managedresources.LabelValueGardener, false, data)
+}
+
+func (t *trustBundle) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForShoot(ctx, t.client, t.namespace, ManagedResourceName)
+}
+
+func (t *trustBundle) SetClusterIdentity(identity string) {
+	t.values.ClusterIdentity = identity
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (t *trustBundle) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, t.client, t.namespace, ManagedResourceName)
+}
+
+func (t *trustBundle) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, t.client, t.namespace, ManagedResourceName)
+}
+
+func (t *trustBundle) computeResourcesData(caBundle string) (map[string][]byte, error) {
+	var (
+		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ConfigMapName,
+				Namespace: metav1.NamespacePublic,
+			},
+			Data: map[string]string{
+				DataKeyCABundle:              caBundle,
+				DataKeyClusterIdentity:       t.values.ClusterIdentity,
+				DataKeySeedName:              t.values.SeedName,
+				DataKeySeedClusterIdentity:   t.values.SeedClusterIdentity,
+				DataKeyGardenClusterIdentity: t.values.GardenClusterIdentity,
+				DataKeyAPIServerURL:          t.values.APIServerURL,
+			},
+		}
+
+		// The trust bundle only contains public information, hence all authenticated users are allowed to read it.
+		role = &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      roleName,
+				Namespace: metav1.NamespacePublic,
+			},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups:     []string{""},
+				Resources:     []string{"configmaps"},
+				ResourceNames: []string{ConfigMapName},
+				Verbs:         []string{"get", "list", "watch"},
+			}},
+		}
+
+		roleBinding = &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      roleName,
+				Namespace: metav1.NamespacePublic,
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     role.Name,
+			},
+			Subjects: []rbacv1.Subject{{
+				APIGroup: rbacv1.GroupName,
+				Kind:     rbacv1.GroupKind,
+				Name:     "system:authenticated",
+			}},
+		}
+	)
+
+	objects := []client.Object{configMap, role, roleBinding}
+
+	if len(t.values.TrustedCABundles) > 0 {
+		trustedCABundlesConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      v1beta1constants.ConfigMapNameTrustedCABundles,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: map[string]string{},
+		}
+
+		var caBundles []string
+		for _, bundle := range t.values.TrustedCABundles {
+			trustedCABundlesConfigMap.Data[bundle.Name+".pem"] = bundle.CABundle
+			caBundles = append(caBundles, strings.TrimSuffix(bundle.CABundle, "\n"))
+		}
+		trustedCABundlesConfigMap.Data[DataKeyTrustedCABundles] = strings.Join(caBundles, "\n") + "\n"
+
+		objects = append(objects, trustedCABundlesConfigMap)
+	}
+
+	return registry.AddAllAndSerialize(objects...)
+}
@@ -0,0 +1,307 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustbundle_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/trustbundle"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("TrustBundle", func() {
+	var (
+		c           client.Client
+		sm          secretsmanager.Interface
+		trustBundle Interface
+
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		managedResourceName       = "shoot-core-trust-bundle"
+		managedResourceSecretName = "managedresource-shoot-core-trust-bundle"
+
+		configMapYAMLFor = func(clusterIdentity string) string {
+			return `apiVersion: v1
+data:
+  api-server-url: https://api.foo.bar.example.com
+  ca.crt: |
+    -----BEGIN CERTIFICATE-----
+    old
+    -----END CERTIFICATE-----
+    -----BEGIN CERTIFICATE-----
+    new
+    -----END CERTIFICATE-----
+  cluster-identity: ` + clusterIdentity + `
+  garden-cluster-identity: garden
+  seed-cluster-identity: seed-identity
+  seed-name: seed
+kind: ConfigMap
+metadata:
+  creationTimestamp: null
+  name: gardener-trust-bundle
+  namespace: kube-public
+`
+		}
+
+		roleYAML = `apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  creationTimestamp: null
+  name: gardener.cloud:trust-bundle:reader
+  namespace: kube-public
+rules:
+- apiGroups:
+  - ""
+  resourceNames:
+  - gardener-trust-bundle
+  resources:
+  - configmaps
+  verbs:
+  - get
+  - list
+  - watch
+`
+
+		roleBindingYAML = `apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  creationTimestamp: null
+  name: gardener.cloud:trust-bundle:reader
+  namespace: kube-public
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: gardener.cloud:trust-bundle:reader
+subjects:
+- apiGroup: rbac.authorization.k8s.io
+  kind: Group
+  name: system:authenticated
+`
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		sm = fakesecretsmanager.New(c, namespace)
+
+		By("Create secrets managed outside of this package for whose secretsmanager.Get() will be called")
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: namespace},
+			Data:       map[string][]byte{"bundle.crt": []byte("-----BEGIN CERTIFICATE-----\nold\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nnew\n-----END CERTIFICATE-----\n")},
+		})).To(Succeed())
+
+		trustBundle = New(c, namespace, sm, Values{
+			ClusterIdentity:       "shoot-identity",
+			SeedName:              "seed",
+			SeedClusterIdentity:   "seed-identity",
+			GardenClusterIdentity: "garden",
+			APIServerURL:          "https://api.foo.bar.example.com",
+		})
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: resourcesv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ManagedResource",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            managedResourceName,
+				Namespace:       namespace,
+				Labels:          map[string]string{"origin": "gardener"},
+				ResourceVersion: "1",
+			},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				SecretRefs:   []corev1.LocalObjectReference{},
+				InjectLabels: map[string]string{"shoot.gardener.cloud/no-cleanup": "true"},
+				KeepObjects:  pointer.Bool(false),
+			},
+		}
+	})
+
+	Describe("#Deploy", func() {
+		var managedResourceSecret *corev1.Secret
+
+		expectResources := func(clusterIdentity string) {
+			actualMr := &resourcesv1alpha1.ManagedResource{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), actualMr)).To(Succeed())
+			managedResource.ResourceVersion = actualMr.ResourceVersion
+			managedResource.Spec.SecretRefs = []corev1.LocalObjectReference{{Name: actualMr.Spec.SecretRefs[0].Name}}
+
+			utilruntime.Must(references.InjectAnnotations(managedResource))
+			Expect(actualMr).To(DeepEqual(managedResource))
+
+			managedResourceSecret = &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Name).To(HavePrefix(managedResourceSecretName))
+			Expect(managedResourceSecret.Data).To(HaveLen(3))
+			Expect(string(managedResourceSecret.Data["configmap__kube-public__gardener-trust-bundle.yaml"])).To(Equal(configMapYAMLFor(clusterIdentity)))
+			Expect(string(managedResourceSecret.Data["role__kube-public__gardener.cloud_trust-bundle_reader.yaml"])).To(Equal(roleYAML))
+			Expect(string(managedResourceSecret.Data["rolebinding__kube-public__gardener.cloud_trust-bundle_reader.yaml"])).To(Equal(roleBindingYAML))
+		}
+
+		It("should successfully deploy all resources", func() {
+			Expect(trustBundle.Deploy(ctx)).To(Succeed())
+			expectResources("shoot-identity")
+		})
+
+		It("should use the cluster identity which was set later", func() {
+			trustBundle.SetClusterIdentity("other-identity")
+
+			Expect(trustBundle.Deploy(ctx)).To(Succeed())
+			expectResources("other-identity")
+		})
+
+		It("should publish the trusted CA bundles of the shoot", func() {
+			trustBundle = New(c, namespace, sm, Values{
+				ClusterIdentity:       "shoot-identity",
+				SeedName:              "seed",
+				SeedClusterIdentity:   "seed-identity",
+				GardenClusterIdentity: "garden",
+				APIServerURL:          "https://api.foo.bar.example.com",
+				TrustedCABundles: []gardencorev1beta1.TrustedCABundle{
+					{Name: "corporate-ca", CABundle: "-----BEGIN CERTIFICATE-----\ncorporate\n-----END CERTIFICATE-----\n"},
+					{Name: "partner-ca", CABundle: "-----BEGIN CERTIFICATE-----\npartner\n-----END CERTIFICATE-----"},
+				},
+			})
+
+			Expect(trustBundle.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Data).To(HaveLen(4))
+			Expect(string(managedResourceSecret.Data["configmap__kube-system__gardener-trusted-ca-bundles.yaml"])).To(Equal(`apiVersion: v1
+data:
+  ca-bundle.crt: |
+    -----BEGIN CERTIFICATE-----
+    corporate
+    -----END CERTIFICATE-----
+    -----BEGIN CERTIFICATE-----
+    partner
+    -----END CERTIFICATE-----
+  corporate-ca.pem: |
+    -----BEGIN CERTIFICATE-----
+    corporate
+    -----END CERTIFICATE-----
+  partner-ca.pem: |-
+    -----BEGIN CERTIFICATE-----
+    partner
+    -----END CERTIFICATE-----
+kind: ConfigMap
+metadata:
+  creationTimestamp: null
+  name: gardener-trusted-ca-bundles
+  namespace: kube-system
+`))
+		})
+
+		It("should fail if the CA bundle secret does not exist", func() {
+			Expect(c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: namespace}})).To(Succeed())
+
+			Expect(trustBundle.Deploy(ctx)).To(MatchError(ContainSubstring(`secret "ca" not found`)))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully delete all the resources", func() {
+			mrSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: managedResourceSecretName, Namespace: namespace}}
+			mr := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: managedResourceName, Namespace: namespace}}
+			Expect(c.Create(ctx, mrSecret)).To(Succeed())
+			Expect(c.Create(ctx, mr)).To(Succeed())
+
+			Expect(trustBundle.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(mrSecret), mrSecret)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(BeNotFoundError())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var (
+			fakeOps   *retryfake.Ops
+			resetVars func()
+		)
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			resetVars = test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			)
+		})
+
+		AfterEach(func() {
+			resetVars()
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because reading the ManagedResource fails", func() {
+				Expect(trustBundle.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should successfully wait for the managed resource to become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       managedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{
+								Type:   resourcesv1alpha1.ResourcesApplied,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+							{
+								Type:   resourcesv1alpha1.ResourcesHealthy,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(trustBundle.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should successfully wait for the managed resource to be deleted", func() {
+				Expect(trustBundle.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -16,6 +16,7 @@ package component
 
 import (
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
+	"sigs.k8s.io/yaml"
 )
 
 // Secret is a structure that contains information about a Kubernetes secret which is managed externally.
@@ -51,3 +52,23 @@ type CentralLoggingConfig struct {
 	// Parser contains the parsers for specific component.
 	Parsers []*fluentbitv1alpha2.ClusterParser
 }
+
+// ConfigSnapshot is the effective configuration of a control plane component as it is currently deployed.
+type ConfigSnapshot struct {
+	// Component is the name of the component.
+	Component string `json:"component"`
+	// Image is the container image the component is running with.
+	Image string `json:"image,omitempty"`
+	// Flags are the command line flags the component is started with.
+	Flags []string `json:"flags,omitempty"`
+	// Files maps the paths of the mounted configuration files to their content. The content of secrets is never
+	// included.
+	Files map[string]string `json:"files,omitempty"`
+	// Checksums are the checksums of the configuration (including secrets) the component is running with.
+	Checksums map[string]string `json:"checksums,omitempty"`
+}
+
+// Export serializes the snapshot for a diagnostic bundle.
+func (c *ConfigSnapshot) Export() ([]byte, error) {
+	return yaml.Marshal(c)
+}
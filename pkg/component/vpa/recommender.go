@@ -57,6 +57,15 @@ type ValuesRecommender struct {
 	PriorityClassName string
 	// Replicas is the number of pod replicas.
 	Replicas *int32
+	// TargetCPUPercentile is the usage percentile that will be used as a base for the CPU target recommendation.
+	TargetCPUPercentile *float64
+	// TargetMemoryPercentile is the usage percentile that will be used as a base for the memory target recommendation.
+	TargetMemoryPercentile *float64
+	// CPUHistogramDecayHalfLife is the amount of time it takes a historical CPU usage sample to lose half of its weight.
+	CPUHistogramDecayHalfLife *metav1.Duration
+	// MemoryHistogramDecayHalfLife is the amount of time it takes a historical memory usage sample to lose half of its
+	// weight.
+	MemoryHistogramDecayHalfLife *metav1.Duration
 }
 
 func (v *vpa) recommenderResourceConfigs() component.ResourceConfigs {
@@ -201,18 +210,8 @@ func (v *vpa) reconcileRecommenderDeployment(deployment *appsv1.Deployment, serv
 					Image:           v.values.Recommender.Image,
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					Command:         v.computeRecommenderCommands(),
-					Args: []string{
-						"--v=3",
-						"--stderrthreshold=info",
-						"--pod-recommendation-min-cpu-millicores=5",
-						"--pod-recommendation-min-memory-mb=10",
-						fmt.Sprintf("--recommendation-margin-fraction=%f", pointer.Float64Deref(v.values.Recommender.RecommendationMarginFraction, gardencorev1beta1.DefaultRecommendationMarginFraction)),
-						fmt.Sprintf("--recommender-interval=%s", durationDeref(v.values.Recommender.Interval, gardencorev1beta1.DefaultRecommenderInterval).Duration),
-						"--kube-api-qps=100",
-						"--kube-api-burst=120",
-						"--memory-saver=true",
-					},
-					LivenessProbe: newDefaultLivenessProbe(),
+					Args:            v.computeRecommenderArgs(),
+					LivenessProbe:   newDefaultLivenessProbe(),
 					Ports: []corev1.ContainerPort{
 						{
 							Name:          "server",
@@ -277,6 +276,35 @@ func (v *vpa) reconcileRecommenderVPA(vpa *vpaautoscalingv1.VerticalPodAutoscale
 	}
 }
 
+func (v *vpa) computeRecommenderArgs() []string {
+	out := []string{
+		"--v=3",
+		"--stderrthreshold=info",
+		"--pod-recommendation-min-cpu-millicores=5",
+		"--pod-recommendation-min-memory-mb=10",
+		fmt.Sprintf("--recommendation-margin-fraction=%f", pointer.Float64Deref(v.values.Recommender.RecommendationMarginFraction, gardencorev1beta1.DefaultRecommendationMarginFraction)),
+		fmt.Sprintf("--recommender-interval=%s", durationDeref(v.values.Recommender.Interval, gardencorev1beta1.DefaultRecommenderInterval).Duration),
+		"--kube-api-qps=100",
+		"--kube-api-burst=120",
+		"--memory-saver=true",
+	}
+
+	if v.values.Recommender.TargetCPUPercentile != nil {
+		out = append(out, fmt.Sprintf("--target-cpu-percentile=%f", *v.values.Recommender.TargetCPUPercentile))
+	}
+	if v.values.Recommender.TargetMemoryPercentile != nil {
+		out = append(out, fmt.Sprintf("--target-memory-percentile=%f", *v.values.Recommender.TargetMemoryPercentile))
+	}
+	if v.values.Recommender.CPUHistogramDecayHalfLife != nil {
+		out = append(out, fmt.Sprintf("--cpu-histogram-decay-half-life=%s", v.values.Recommender.CPUHistogramDecayHalfLife.Duration))
+	}
+	if v.values.Recommender.MemoryHistogramDecayHalfLife != nil {
+		out = append(out, fmt.Sprintf("--memory-histogram-decay-half-life=%s", v.values.Recommender.MemoryHistogramDecayHalfLife.Duration))
+	}
+
+	return out
+}
+
 func (v *vpa) computeRecommenderCommands() []string {
 	out := []string{"./recommender"}
 
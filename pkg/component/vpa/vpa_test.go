@@ -1405,6 +1405,10 @@ var _ = Describe("VPA", func() {
 			It("should successfully deploy with special configuration", func() {
 				valuesRecommender.Interval = &metav1.Duration{Duration: 3 * time.Hour}
 				valuesRecommender.RecommendationMarginFraction = pointer.Float64(8.91)
+				valuesRecommender.TargetCPUPercentile = pointer.Float64(0.95)
+				valuesRecommender.TargetMemoryPercentile = pointer.Float64(0.99)
+				valuesRecommender.CPUHistogramDecayHalfLife = &metav1.Duration{Duration: 12 * time.Hour}
+				valuesRecommender.MemoryHistogramDecayHalfLife = &metav1.Duration{Duration: 48 * time.Hour}
 
 				valuesUpdater.Interval = &metav1.Duration{Duration: 4 * time.Hour}
 				valuesUpdater.EvictAfterOOMThreshold = &metav1.Duration{Duration: 5 * time.Hour}
@@ -1468,6 +1472,12 @@ var _ = Describe("VPA", func() {
 					component.ClusterTypeSeed,
 				)
 				adaptNetworkPolicyLabelsForClusterTypeSeed(deploymentRecommender.Spec.Template.Labels)
+				deploymentRecommender.Spec.Template.Spec.Containers[0].Args = append(deploymentRecommender.Spec.Template.Spec.Containers[0].Args,
+					"--target-cpu-percentile=0.950000",
+					"--target-memory-percentile=0.990000",
+					"--cpu-histogram-decay-half-life=12h0m0s",
+					"--memory-histogram-decay-half-life=48h0m0s",
+				)
 
 				Expect(string(managedResourceSecret.Data["deployment__"+namespace+"__vpa-updater.yaml"])).To(Equal(componenttest.Serialize(deploymentUpdater)))
 				Expect(string(managedResourceSecret.Data["deployment__"+namespace+"__vpa-recommender.yaml"])).To(Equal(componenttest.Serialize(deploymentRecommender)))
@@ -68,7 +68,13 @@ const (
 	OpenVPNPort = 1194
 	// HighAvailabilityReplicaCount is the replica count used when highly available VPN is configured.
 	HighAvailabilityReplicaCount = 2
-	metricsPortName              = "metrics"
+	// MaxNumberOfSeedServers is the maximum number of VPN seed servers, i.e. the maximum number of parallel tunnels
+	// in the high-throughput mode.
+	MaxNumberOfSeedServers = 8
+	// BondingModeHighThroughput is the bonding mode used by the VPN clients in the high-throughput mode. It distributes
+	// the connections across all tunnels based on a hash of their addresses and ports.
+	BondingModeHighThroughput = "balance-xor"
+	metricsPortName           = "metrics"
 	// MetricsPort is the port metrics can be scraped at.
 	MetricsPort = 15000
 
@@ -251,11 +257,13 @@ func (v *vpnSeedServer) Deploy(ctx context.Context) error {
 				return err
 			}
 		}
-		if err := kubernetesutils.DeleteObjects(ctx, v.client,
-			v.emptyDeployment(),
-			v.emptyService(nil),
-			v.emptyDestinationRule(nil),
-		); err != nil {
+		objects := []client.Object{v.emptyDeployment(), v.emptyService(nil), v.emptyDestinationRule(nil)}
+		// Clean up the objects of seed servers which are no longer needed, e.g. after the number of tunnels was reduced
+		// in the high-throughput mode.
+		for i := v.values.HighAvailabilityNumberOfSeedServers; i < MaxNumberOfSeedServers; i++ {
+			objects = append(objects, v.emptyService(&i), v.emptyDestinationRule(&i))
+		}
+		if err := kubernetesutils.DeleteObjects(ctx, v.client, objects...); err != nil {
 			return err
 		}
 	} else {
@@ -102,6 +102,8 @@ type Values struct {
 	HighAvailabilityNumberOfSeedServers int
 	// HighAvailabilityNumberOfShootClients is the number of VPN shoot clients used for HA
 	HighAvailabilityNumberOfShootClients int
+	// HighThroughputEnabled marks whether the traffic is load-balanced across all tunnels to the VPN seed servers.
+	HighThroughputEnabled bool
 	// PSPDisabled marks whether the PodSecurityPolicy admission plugin is disabled.
 	PSPDisabled bool
 }
@@ -845,6 +847,12 @@ func (v *vpnShoot) getInitContainers() []corev1.Container {
 				Value: fmt.Sprintf("%d", v.values.HighAvailabilityNumberOfShootClients),
 			},
 		}...)
+		if v.values.HighThroughputEnabled {
+			container.Env = append(container.Env, corev1.EnvVar{
+				Name:  "BONDING_MODE",
+				Value: vpnseedserver.BondingModeHighThroughput,
+			})
+		}
 	}
 	return []corev1.Container{container}
 }
@@ -779,6 +779,30 @@ status: {}
 					values.HighAvailabilityEnabled = false
 				})
 			})
+
+			Context("w/ high throughput", func() {
+				BeforeEach(func() {
+					values.HighAvailabilityEnabled = true
+					values.HighAvailabilityNumberOfSeedServers = 4
+					values.HighAvailabilityNumberOfShootClients = 2
+					values.HighThroughputEnabled = true
+				})
+
+				It("should configure the load-balancing bonding mode", func() {
+					statefulSet := &appsv1.StatefulSet{}
+					Expect(runtime.DecodeInto(newCodec(), managedResourceSecret.Data["statefulset__kube-system__vpn-shoot.yaml"], statefulSet)).To(Succeed())
+					Expect(statefulSet.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+					Expect(statefulSet.Spec.Template.Spec.InitContainers[0].Env).To(ContainElements(
+						corev1.EnvVar{Name: "HA_VPN_SERVERS", Value: "4"},
+						corev1.EnvVar{Name: "BONDING_MODE", Value: "balance-xor"},
+					))
+				})
+
+				AfterEach(func() {
+					values.HighAvailabilityEnabled = false
+					values.HighThroughputEnabled = false
+				})
+			})
 		})
 
 		Context("PodSecurityPolicy", func() {
@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controller/networkpolicy/hostnameresolver"
 	"github.com/gardener/gardener/pkg/controllerutils/mapper"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
@@ -107,6 +108,18 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, runt
 		return err
 	}
 
+	if r.ExtensionsAccess != nil {
+		// The NetworkPolicies restricting the access of extensions to the kube-apiservers of shoots depend on the labels
+		// of the extension namespaces.
+		if err := c.Watch(
+			source.Kind(runtimeCluster.GetCache(), &corev1.Namespace{}),
+			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapToNamespaces), mapper.UpdateWithNew, c.GetLogger()),
+			r.IsExtensionNamespace(),
+		); err != nil {
+			return err
+		}
+	}
+
 	for _, registerer := range r.WatchRegisterers {
 		if err := registerer(c); err != nil {
 			return err
@@ -177,3 +190,10 @@ func (r *Reconciler) IsKubernetesEndpoint() predicate.Predicate {
 		return obj.GetNamespace() == corev1.NamespaceDefault && obj.GetName() == "kubernetes"
 	})
 }
+
+// IsExtensionNamespace returns a predicate which evaluates if the object is a namespace of an extension.
+func (r *Reconciler) IsExtensionNamespace() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetLabels()[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleExtension
+	})
+}
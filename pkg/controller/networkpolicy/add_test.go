@@ -61,6 +61,27 @@ var _ = Describe("Add", func() {
 			Expect(p.Generic(event.GenericEvent{Object: networkPolicy})).To(BeTrue())
 		})
 
+		It("should return true because the NetworkPolicy restricts the access of extensions to kube-apiservers", func() {
+			reconciler.ExtensionsAccess = &ExtensionsAccessConfig{}
+			p = reconciler.NetworkPolicyPredicate()
+
+			for _, name := range []string{"allow-from-managing-extensions-to-kube-apiserver", "allow-to-shoot-kube-apiservers"} {
+				networkPolicy.Name = name
+				Expect(p.Create(event.CreateEvent{Object: networkPolicy})).To(BeTrue())
+				Expect(p.Update(event.UpdateEvent{ObjectNew: networkPolicy})).To(BeTrue())
+				Expect(p.Delete(event.DeleteEvent{Object: networkPolicy})).To(BeTrue())
+				Expect(p.Generic(event.GenericEvent{Object: networkPolicy})).To(BeTrue())
+			}
+		})
+
+		It("should return false because the access of extensions to kube-apiservers is not restricted", func() {
+			networkPolicy.Name = "allow-from-managing-extensions-to-kube-apiserver"
+			Expect(p.Create(event.CreateEvent{Object: networkPolicy})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: networkPolicy})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: networkPolicy})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: networkPolicy})).To(BeFalse())
+		})
+
 		It("should return false because the NetworkPolicy is not managed by this reconciler", func() {
 			networkPolicy.Name = "not-managed"
 			Expect(p.Create(event.CreateEvent{Object: networkPolicy})).To(BeFalse())
@@ -230,4 +251,32 @@ var _ = Describe("Add", func() {
 			Expect(p.Generic(event.GenericEvent{Object: endpoint})).To(BeFalse())
 		})
 	})
+
+	Describe("#IsExtensionNamespace", func() {
+		var (
+			p         predicate.Predicate
+			namespace *corev1.Namespace
+		)
+
+		BeforeEach(func() {
+			p = reconciler.IsExtensionNamespace()
+			namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "extension-foo", Labels: map[string]string{"gardener.cloud/role": "extension"}}}
+		})
+
+		It("should return true because the namespace is an extension namespace", func() {
+			Expect(p.Create(event.CreateEvent{Object: namespace})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: namespace})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: namespace})).To(BeTrue())
+			Expect(p.Generic(event.GenericEvent{Object: namespace})).To(BeTrue())
+		})
+
+		It("should return false because the namespace is not an extension namespace", func() {
+			namespace.Labels["gardener.cloud/role"] = "shoot"
+
+			Expect(p.Create(event.CreateEvent{Object: namespace})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: namespace})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: namespace})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: namespace})).To(BeFalse())
+		})
+	})
 })
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -39,12 +40,14 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corednsconstants "github.com/gardener/gardener/pkg/component/coredns/constants"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubeapiserver/constants"
 	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/nodelocaldns/constants"
 	"github.com/gardener/gardener/pkg/controller/networkpolicy/helper"
 	"github.com/gardener/gardener/pkg/controller/networkpolicy/hostnameresolver"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	netutils "github.com/gardener/gardener/pkg/utils/net"
 )
@@ -58,9 +61,23 @@ type Reconciler struct {
 	ResolverUpdate                    <-chan event.GenericEvent
 	RuntimeNetworks                   RuntimeNetworkConfig
 	AdditionalNamespaceSelectors      []metav1.LabelSelector
+	ExtensionsAccess                  *ExtensionsAccessConfig
 	additionalNamespaceLabelSelectors []labels.Selector
 }
 
+// ExtensionsAccessConfig is the configuration for restricting the access of extensions to the kube-apiservers of the
+// shoots they manage. If it is not set, the NetworkPolicies restricting the access are not maintained.
+type ExtensionsAccessConfig struct {
+	// Enforce specifies whether the restricted access is enforced. If false, the extensions which would lose their
+	// access are only reported.
+	Enforce bool
+}
+
+// AnnotationExtensionNamespacesWithoutAccess is the annotation on the NetworkPolicy allowing the extensions managing a
+// shoot to access its kube-apiserver. It lists the namespaces of all other extensions, i.e., of those which can only
+// access the kube-apiserver as long as the restricted access is not enforced.
+const AnnotationExtensionNamespacesWithoutAccess = "networking.gardener.cloud/extension-namespaces-without-access"
+
 // RuntimeNetworkConfig is the configuration of the networks for the runtime cluster.
 type RuntimeNetworkConfig struct {
 	// IPFamilies specifies the IP protocol versions used in the runtime cluster.
@@ -247,6 +264,30 @@ func (r *Reconciler) networkPolicyConfigs() []networkPolicyConfig {
 		},
 	}
 
+	if r.ExtensionsAccess != nil {
+		configs = append(configs, networkPolicyConfig{
+			name:          "allow-from-managing-extensions-to-kube-apiserver",
+			reconcileFunc: r.reconcileNetworkPolicyAllowFromManagingExtensionsToKubeAPIServer,
+			namespaceSelectors: []labels.Selector{
+				labels.SelectorFromSet(labels.Set{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}),
+			},
+		})
+
+		// As long as the restricted access is not enforced, the egress traffic of extensions to the kube-apiservers of
+		// all shoots is allowed by the NetworkPolicies generated by gardener-resource-manager for the kube-apiserver
+		// services, hence, this NetworkPolicy is only needed when enforcing the restricted access.
+		var namespaceSelectors []labels.Selector
+		if r.ExtensionsAccess.Enforce {
+			namespaceSelectors = append(namespaceSelectors, labels.SelectorFromSet(labels.Set{v1beta1constants.GardenRole: v1beta1constants.GardenRoleExtension}))
+		}
+
+		configs = append(configs, networkPolicyConfig{
+			name:               "allow-to-shoot-kube-apiservers",
+			reconcileFunc:      r.reconcileNetworkPolicyAllowToShootKubeAPIServers,
+			namespaceSelectors: namespaceSelectors,
+		})
+	}
+
 	return configs
 }
 
@@ -547,3 +588,134 @@ func (r *Reconciler) reconcileNetworkPolicyAllowToDNS(ctx context.Context, log l
 		}
 	})
 }
+
+func (r *Reconciler) reconcileNetworkPolicyAllowFromManagingExtensionsToKubeAPIServer(ctx context.Context, log logr.Logger, networkPolicy *networkingv1.NetworkPolicy) error {
+	cluster := &extensionsv1alpha1.Cluster{}
+	if err := r.RuntimeClient.Get(ctx, client.ObjectKey{Name: networkPolicy.Namespace}, cluster); err != nil {
+		return err
+	}
+
+	shoot, err := extensions.ShootFromCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	// The labels of the shoot describe the extension types it uses. The namespaces of the extensions carry the same
+	// labels for the extension types they are responsible for, see gardenerutils.ExtensionTypeLabelsForControllerRegistration.
+	var extensionTypeLabelKeys []string
+	if shoot != nil {
+		for key, value := range shoot.Labels {
+			if value == "true" && hasExtensionTypeLabelPrefix(key) {
+				extensionTypeLabelKeys = append(extensionTypeLabelKeys, key)
+			}
+		}
+	}
+	slices.Sort(extensionTypeLabelKeys)
+
+	var (
+		peers                 []networkingv1.NetworkPolicyPeer
+		namespaceSelectorSets []labels.Set
+	)
+
+	for _, key := range extensionTypeLabelKeys {
+		namespaceLabels := map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleExtension, key: "true"}
+		namespaceSelectorSets = append(namespaceSelectorSets, namespaceLabels)
+
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: namespaceLabels},
+			PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{toAllShootsKubeAPIServerLabel: v1beta1constants.LabelNetworkPolicyAllowed}},
+		})
+	}
+
+	extensionNamespaceList := &corev1.NamespaceList{}
+	if err := r.RuntimeClient.List(ctx, extensionNamespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleExtension}); err != nil {
+		return err
+	}
+
+	var namespacesWithoutAccess []string
+	for _, namespace := range extensionNamespaceList.Items {
+		hasAccess := false
+		for _, set := range namespaceSelectorSets {
+			if labels.SelectorFromSet(set).Matches(labels.Set(namespace.Labels)) {
+				hasAccess = true
+				break
+			}
+		}
+
+		if !hasAccess {
+			namespacesWithoutAccess = append(namespacesWithoutAccess, namespace.Name)
+		}
+	}
+	slices.Sort(namespacesWithoutAccess)
+
+	if len(namespacesWithoutAccess) > 0 && !r.ExtensionsAccess.Enforce {
+		log.Info("Extensions not managing the shoot will lose their access to the kube-apiserver once the restricted access is enforced", "extensionNamespaces", namespacesWithoutAccess)
+	}
+
+	ingressRules := []networkingv1.NetworkPolicyIngressRule{}
+	if len(peers) > 0 {
+		ingressRules = append(ingressRules, networkingv1.NetworkPolicyIngressRule{
+			From:  peers,
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: utils.ProtocolPtr(corev1.ProtocolTCP), Port: utils.IntStrPtrFromInt32(kubeapiserverconstants.Port)}},
+		})
+	}
+
+	return r.reconcileNetworkPolicy(ctx, log, networkPolicy, func(policy *networkingv1.NetworkPolicy) {
+		metav1.SetMetaDataAnnotation(&policy.ObjectMeta, v1beta1constants.GardenerDescription, fmt.Sprintf("Allows "+
+			"ingress traffic to the kube-apiserver from pods labeled with '%s=%s' in the namespaces of the extensions "+
+			"managing the shoot.", toAllShootsKubeAPIServerLabel, v1beta1constants.LabelNetworkPolicyAllowed))
+
+		if len(namespacesWithoutAccess) > 0 {
+			metav1.SetMetaDataAnnotation(&policy.ObjectMeta, AnnotationExtensionNamespacesWithoutAccess, strings.Join(namespacesWithoutAccess, ","))
+		} else {
+			delete(policy.Annotations, AnnotationExtensionNamespacesWithoutAccess)
+		}
+
+		policy.Spec = networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: kubeAPIServerLabels()},
+			Ingress:     ingressRules,
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		}
+	})
+}
+
+func (r *Reconciler) reconcileNetworkPolicyAllowToShootKubeAPIServers(ctx context.Context, log logr.Logger, networkPolicy *networkingv1.NetworkPolicy) error {
+	return r.reconcileNetworkPolicy(ctx, log, networkPolicy, func(policy *networkingv1.NetworkPolicy) {
+		metav1.SetMetaDataAnnotation(&policy.ObjectMeta, v1beta1constants.GardenerDescription, fmt.Sprintf("Allows "+
+			"egress traffic from pods labeled with '%s=%s' to the kube-apiservers of all shoots. The ingress traffic is "+
+			"restricted to the extensions managing the respective shoot.", toAllShootsKubeAPIServerLabel,
+			v1beta1constants.LabelNetworkPolicyAllowed))
+
+		policy.Spec = networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{toAllShootsKubeAPIServerLabel: v1beta1constants.LabelNetworkPolicyAllowed}},
+			Egress: []networkingv1.NetworkPolicyEgressRule{{
+				To: []networkingv1.NetworkPolicyPeer{{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}},
+					PodSelector:       &metav1.LabelSelector{MatchLabels: kubeAPIServerLabels()},
+				}},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: utils.ProtocolPtr(corev1.ProtocolTCP), Port: utils.IntStrPtrFromInt32(kubeapiserverconstants.Port)}},
+			}},
+			Ingress:     []networkingv1.NetworkPolicyIngressRule{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+		}
+	})
+}
+
+// toAllShootsKubeAPIServerLabel is the label of the extension pods which need to access the kube-apiservers of shoots.
+var toAllShootsKubeAPIServerLabel = gardenerutils.NetworkPolicyLabel(v1beta1constants.LabelNetworkPolicyShootNamespaceAlias+"-"+v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port)
+
+func kubeAPIServerLabels() map[string]string {
+	return map[string]string{
+		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
+		v1beta1constants.LabelRole: v1beta1constants.LabelAPIServer,
+	}
+}
+
+func hasExtensionTypeLabelPrefix(key string) bool {
+	for _, prefix := range gardenerutils.ExtensionTypeLabelPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -91,6 +91,8 @@ type ControllerManagerControllerConfiguration struct {
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration
+	// ManagedSeedReplacement defines the configuration of the ManagedSeedReplacement controller.
+	ManagedSeedReplacement *ManagedSeedReplacementControllerConfiguration
 }
 
 // BastionControllerConfiguration defines the configuration of the Bastion
@@ -172,8 +174,35 @@ type ProjectControllerConfiguration struct {
 	StaleExpirationTimeDays *int
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	StaleSyncPeriod *metav1.Duration
+	// LifecyclePolicy configures how inactive Projects are handled. If not set, the policy is derived from the
+	// StaleGracePeriodDays and StaleExpirationTimeDays settings, i.e. inactive Projects are deleted.
+	LifecyclePolicy *ProjectLifecyclePolicy
 }
 
+// ProjectLifecyclePolicy configures how inactive Projects are handled.
+type ProjectLifecyclePolicy struct {
+	// NotificationDays is the number of days a `Project` may be inactive before its owner is notified about the
+	// upcoming action.
+	NotificationDays int
+	// ApprovalWindowDays is the number of days between notifying the owner and executing the action. The owner can
+	// prevent the action during this window by using the `Project` again.
+	ApprovalWindowDays int
+	// Action is the action executed for inactive Projects once the approval window has passed.
+	Action ProjectLifecycleAction
+}
+
+// ProjectLifecycleAction is the action executed for inactive Projects.
+type ProjectLifecycleAction string
+
+const (
+	// ProjectLifecycleActionNone only marks inactive Projects and notifies their owners.
+	ProjectLifecycleActionNone ProjectLifecycleAction = "None"
+	// ProjectLifecycleActionArchive archives inactive Projects, i.e. no new Shoots can be created in them.
+	ProjectLifecycleActionArchive ProjectLifecycleAction = "Archive"
+	// ProjectLifecycleActionDelete deletes inactive Projects.
+	ProjectLifecycleActionDelete ProjectLifecycleAction = "Delete"
+)
+
 // QuotaConfiguration defines quota configurations.
 type QuotaConfiguration struct {
 	// Config is the quota specification used for the project set-up.
@@ -252,6 +281,10 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootControlPlaneRestarter *bool
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	EnableShootCoreAddonRestarter *bool
+	// VulnerabilitySeverityThreshold is the minimum severity of vulnerabilities reported by vulnerability scans of the
+	// worker pools (see `.status.vulnerabilityScanResults` of Shoots) for which the machine image versions are
+	// forcefully updated. If it is not set, the results of vulnerability scans are not considered for maintenance.
+	VulnerabilitySeverityThreshold *string
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -326,6 +359,16 @@ type ManagedSeedSetControllerConfiguration struct {
 	SyncPeriod metav1.Duration
 }
 
+// ManagedSeedReplacementControllerConfiguration defines the configuration of the
+// ManagedSeedReplacement controller.
+type ManagedSeedReplacementControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the progress of running replacements is checked.
+	SyncPeriod metav1.Duration
+}
+
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
 type ConditionThreshold struct {
 	// Type is the type of the condition to define the threshold for.
@@ -199,6 +199,18 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		obj.Controllers.ManagedSeedSet.ConcurrentSyncs = &v
 	}
 
+	if obj.Controllers.ManagedSeedReplacement == nil {
+		obj.Controllers.ManagedSeedReplacement = &ManagedSeedReplacementControllerConfiguration{
+			SyncPeriod: metav1.Duration{
+				Duration: time.Minute,
+			},
+		}
+	}
+	if obj.Controllers.ManagedSeedReplacement.ConcurrentSyncs == nil {
+		v := DefaultControllerConcurrentSyncs
+		obj.Controllers.ManagedSeedReplacement.ConcurrentSyncs = &v
+	}
+
 	if obj.LogLevel == "" {
 		obj.LogLevel = LogLevelInfo
 	}
@@ -110,6 +110,10 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootStatusLabel.ConcurrentSyncs).NotTo(BeNil())
 			Expect(obj.Controllers.ShootStatusLabel.ConcurrentSyncs).To(PointTo(Equal(5)))
 
+			Expect(obj.Controllers.ManagedSeedReplacement).NotTo(BeNil())
+			Expect(obj.Controllers.ManagedSeedReplacement.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ManagedSeedReplacement.SyncPeriod).To(Equal(metav1.Duration{Duration: time.Minute}))
+
 			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
 			Expect(obj.LogFormat).To(Equal(logger.FormatJSON))
 
@@ -113,6 +113,9 @@ type ControllerManagerControllerConfiguration struct {
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
+	// ManagedSeedReplacement defines the configuration of the ManagedSeedReplacement controller.
+	// +optional
+	ManagedSeedReplacement *ManagedSeedReplacementControllerConfiguration `json:"managedSeedReplacement,omitempty"`
 }
 
 // BastionControllerConfiguration defines the configuration of the Bastion
@@ -209,8 +212,37 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// LifecyclePolicy configures how inactive Projects are handled. If not set, the policy is derived from the
+	// StaleGracePeriodDays and StaleExpirationTimeDays settings, i.e. inactive Projects are deleted.
+	// +optional
+	LifecyclePolicy *ProjectLifecyclePolicy `json:"lifecyclePolicy,omitempty"`
+}
+
+// ProjectLifecyclePolicy configures how inactive Projects are handled.
+type ProjectLifecyclePolicy struct {
+	// NotificationDays is the number of days a `Project` may be inactive before its owner is notified about the
+	// upcoming action.
+	NotificationDays int `json:"notificationDays"`
+	// ApprovalWindowDays is the number of days between notifying the owner and executing the action. The owner can
+	// prevent the action during this window by using the `Project` again.
+	ApprovalWindowDays int `json:"approvalWindowDays"`
+	// Action is the action executed for inactive Projects once the approval window has passed. Possible values are
+	// `None`, `Archive`, and `Delete`.
+	Action ProjectLifecycleAction `json:"action"`
 }
 
+// ProjectLifecycleAction is the action executed for inactive Projects.
+type ProjectLifecycleAction string
+
+const (
+	// ProjectLifecycleActionNone only marks inactive Projects and notifies their owners.
+	ProjectLifecycleActionNone ProjectLifecycleAction = "None"
+	// ProjectLifecycleActionArchive archives inactive Projects, i.e. no new Shoots can be created in them.
+	ProjectLifecycleActionArchive ProjectLifecycleAction = "Archive"
+	// ProjectLifecycleActionDelete deletes inactive Projects.
+	ProjectLifecycleActionDelete ProjectLifecycleAction = "Delete"
+)
+
 // QuotaConfiguration defines quota configurations.
 type QuotaConfiguration struct {
 	// Config is the quota specification used for the project set-up.
@@ -305,6 +337,12 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// VulnerabilitySeverityThreshold is the minimum severity of vulnerabilities reported by vulnerability scans of the
+	// worker pools (see `.status.vulnerabilityScanResults` of Shoots) for which the machine image versions are
+	// forcefully updated. Possible values are `critical`, `high`, `medium`, and `low`. If it is not set, the results of
+	// vulnerability scans are not considered for maintenance.
+	// +optional
+	VulnerabilitySeverityThreshold *string `json:"vulnerabilitySeverityThreshold,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -392,6 +430,17 @@ type ManagedSeedSetControllerConfiguration struct {
 	SyncPeriod metav1.Duration `json:"syncPeriod"`
 }
 
+// ManagedSeedReplacementControllerConfiguration defines the configuration of the
+// ManagedSeedReplacement controller.
+type ManagedSeedReplacementControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the progress of running replacements is checked.
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+}
+
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
 type ConditionThreshold struct {
 	// Type is the type of the condition to define the threshold for.
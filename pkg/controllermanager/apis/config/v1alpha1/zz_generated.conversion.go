@@ -139,6 +139,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSeedReplacementControllerConfiguration)(nil), (*config.ManagedSeedReplacementControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedSeedReplacementControllerConfiguration_To_config_ManagedSeedReplacementControllerConfiguration(a.(*ManagedSeedReplacementControllerConfiguration), b.(*config.ManagedSeedReplacementControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ManagedSeedReplacementControllerConfiguration)(nil), (*ManagedSeedReplacementControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ManagedSeedReplacementControllerConfiguration_To_v1alpha1_ManagedSeedReplacementControllerConfiguration(a.(*config.ManagedSeedReplacementControllerConfiguration), b.(*ManagedSeedReplacementControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManagedSeedSetControllerConfiguration)(nil), (*config.ManagedSeedSetControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(a.(*ManagedSeedSetControllerConfiguration), b.(*config.ManagedSeedSetControllerConfiguration), scope)
 	}); err != nil {
@@ -159,6 +169,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectLifecyclePolicy)(nil), (*config.ProjectLifecyclePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectLifecyclePolicy_To_config_ProjectLifecyclePolicy(a.(*ProjectLifecyclePolicy), b.(*config.ProjectLifecyclePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProjectLifecyclePolicy)(nil), (*ProjectLifecyclePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProjectLifecyclePolicy_To_v1alpha1_ProjectLifecyclePolicy(a.(*config.ProjectLifecyclePolicy), b.(*ProjectLifecyclePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*config.QuotaConfiguration)(nil), (*QuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_config_QuotaConfiguration_To_v1alpha1_QuotaConfiguration(a.(*config.QuotaConfiguration), b.(*QuotaConfiguration), scope)
 	}); err != nil {
@@ -528,6 +548,7 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.ShootConditions = (*config.ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*config.ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
 	out.ManagedSeedSet = (*config.ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
+	out.ManagedSeedReplacement = (*config.ManagedSeedReplacementControllerConfiguration)(unsafe.Pointer(in.ManagedSeedReplacement))
 	return nil
 }
 
@@ -570,6 +591,7 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.ShootConditions = (*ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
 	out.ManagedSeedSet = (*ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
+	out.ManagedSeedReplacement = (*ManagedSeedReplacementControllerConfiguration)(unsafe.Pointer(in.ManagedSeedReplacement))
 	return nil
 }
 
@@ -640,6 +662,28 @@ func Convert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureCla
 	return autoConvert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureClassControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ManagedSeedReplacementControllerConfiguration_To_config_ManagedSeedReplacementControllerConfiguration(in *ManagedSeedReplacementControllerConfiguration, out *config.ManagedSeedReplacementControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_v1alpha1_ManagedSeedReplacementControllerConfiguration_To_config_ManagedSeedReplacementControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedSeedReplacementControllerConfiguration_To_config_ManagedSeedReplacementControllerConfiguration(in *ManagedSeedReplacementControllerConfiguration, out *config.ManagedSeedReplacementControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedSeedReplacementControllerConfiguration_To_config_ManagedSeedReplacementControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ManagedSeedReplacementControllerConfiguration_To_v1alpha1_ManagedSeedReplacementControllerConfiguration(in *config.ManagedSeedReplacementControllerConfiguration, out *ManagedSeedReplacementControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_config_ManagedSeedReplacementControllerConfiguration_To_v1alpha1_ManagedSeedReplacementControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ManagedSeedReplacementControllerConfiguration_To_v1alpha1_ManagedSeedReplacementControllerConfiguration(in *config.ManagedSeedReplacementControllerConfiguration, out *ManagedSeedReplacementControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ManagedSeedReplacementControllerConfiguration_To_v1alpha1_ManagedSeedReplacementControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(in *ManagedSeedSetControllerConfiguration, out *config.ManagedSeedSetControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MaxShootRetries = (*int)(unsafe.Pointer(in.MaxShootRetries))
@@ -681,6 +725,7 @@ func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectContro
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.LifecyclePolicy = (*config.ProjectLifecyclePolicy)(unsafe.Pointer(in.LifecyclePolicy))
 	return nil
 }
 
@@ -706,6 +751,7 @@ func autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectContro
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.LifecyclePolicy = (*ProjectLifecyclePolicy)(unsafe.Pointer(in.LifecyclePolicy))
 	return nil
 }
 
@@ -714,6 +760,30 @@ func Convert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectController
 	return autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ProjectLifecyclePolicy_To_config_ProjectLifecyclePolicy(in *ProjectLifecyclePolicy, out *config.ProjectLifecyclePolicy, s conversion.Scope) error {
+	out.NotificationDays = in.NotificationDays
+	out.ApprovalWindowDays = in.ApprovalWindowDays
+	out.Action = config.ProjectLifecycleAction(in.Action)
+	return nil
+}
+
+// Convert_v1alpha1_ProjectLifecyclePolicy_To_config_ProjectLifecyclePolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectLifecyclePolicy_To_config_ProjectLifecyclePolicy(in *ProjectLifecyclePolicy, out *config.ProjectLifecyclePolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectLifecyclePolicy_To_config_ProjectLifecyclePolicy(in, out, s)
+}
+
+func autoConvert_config_ProjectLifecyclePolicy_To_v1alpha1_ProjectLifecyclePolicy(in *config.ProjectLifecyclePolicy, out *ProjectLifecyclePolicy, s conversion.Scope) error {
+	out.NotificationDays = in.NotificationDays
+	out.ApprovalWindowDays = in.ApprovalWindowDays
+	out.Action = ProjectLifecycleAction(in.Action)
+	return nil
+}
+
+// Convert_config_ProjectLifecyclePolicy_To_v1alpha1_ProjectLifecyclePolicy is an autogenerated conversion function.
+func Convert_config_ProjectLifecyclePolicy_To_v1alpha1_ProjectLifecyclePolicy(in *config.ProjectLifecyclePolicy, out *ProjectLifecyclePolicy, s conversion.Scope) error {
+	return autoConvert_config_ProjectLifecyclePolicy_To_v1alpha1_ProjectLifecyclePolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(in *QuotaConfiguration, out *config.QuotaConfiguration, s conversion.Scope) error {
 	if err := runtime.Convert_runtime_RawExtension_To_runtime_Object(&in.Config, &out.Config, s); err != nil {
 		return err
@@ -939,6 +1009,7 @@ func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.VulnerabilitySeverityThreshold = (*string)(unsafe.Pointer(in.VulnerabilitySeverityThreshold))
 	return nil
 }
 
@@ -951,6 +1022,7 @@ func autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.VulnerabilitySeverityThreshold = (*string)(unsafe.Pointer(in.VulnerabilitySeverityThreshold))
 	return nil
 }
 
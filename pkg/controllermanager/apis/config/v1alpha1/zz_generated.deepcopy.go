@@ -278,6 +278,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ManagedSeedSetControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManagedSeedReplacement != nil {
+		in, out := &in.ManagedSeedReplacement, &out.ManagedSeedReplacement
+		*out = new(ManagedSeedReplacementControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -359,6 +364,28 @@ func (in *ExposureClassControllerConfiguration) DeepCopy() *ExposureClassControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSeedReplacementControllerConfiguration) DeepCopyInto(out *ManagedSeedReplacementControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	out.SyncPeriod = in.SyncPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSeedReplacementControllerConfiguration.
+func (in *ManagedSeedReplacementControllerConfiguration) DeepCopy() *ManagedSeedReplacementControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSeedReplacementControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedSetControllerConfiguration) DeepCopyInto(out *ManagedSeedSetControllerConfiguration) {
 	*out = *in
@@ -421,6 +448,11 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.LifecyclePolicy != nil {
+		in, out := &in.LifecyclePolicy, &out.LifecyclePolicy
+		*out = new(ProjectLifecyclePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -434,6 +466,22 @@ func (in *ProjectControllerConfiguration) DeepCopy() *ProjectControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectLifecyclePolicy) DeepCopyInto(out *ProjectLifecyclePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLifecyclePolicy.
+func (in *ProjectLifecyclePolicy) DeepCopy() *ProjectLifecyclePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectLifecyclePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaConfiguration) DeepCopyInto(out *QuotaConfiguration) {
 	*out = *in
@@ -703,6 +751,11 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.VulnerabilitySeverityThreshold != nil {
+		in, out := &in.VulnerabilitySeverityThreshold, &out.VulnerabilitySeverityThreshold
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
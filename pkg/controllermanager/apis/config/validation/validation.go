@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -51,6 +52,25 @@ func validateControllerManagerControllerConfiguration(conf config.ControllerMana
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	allErrs = append(allErrs, validateShootMaintenanceControllerConfiguration(conf.ShootMaintenance, fldPath.Child("shootMaintenance"))...)
+
+	return allErrs
+}
+
+var availableVulnerabilitySeverities = sets.New(
+	string(gardencorev1beta1.VulnerabilitySeverityCritical),
+	string(gardencorev1beta1.VulnerabilitySeverityHigh),
+	string(gardencorev1beta1.VulnerabilitySeverityMedium),
+	string(gardencorev1beta1.VulnerabilitySeverityLow),
+)
+
+func validateShootMaintenanceControllerConfiguration(conf config.ShootMaintenanceControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.VulnerabilitySeverityThreshold != nil && !availableVulnerabilitySeverities.Has(*conf.VulnerabilitySeverityThreshold) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("vulnerabilitySeverityThreshold"), *conf.VulnerabilitySeverityThreshold, sets.List(availableVulnerabilitySeverities)))
+	}
+
 	return allErrs
 }
 
@@ -59,6 +79,31 @@ func validateProjectControllerConfiguration(conf *config.ProjectControllerConfig
 	for i, quotaConfig := range conf.Quotas {
 		allErrs = append(allErrs, validateProjectQuotaConfiguration(quotaConfig, fldPath.Child("quotas").Index(i))...)
 	}
+	if conf.LifecyclePolicy != nil {
+		allErrs = append(allErrs, validateProjectLifecyclePolicy(conf.LifecyclePolicy, fldPath.Child("lifecyclePolicy"))...)
+	}
+	return allErrs
+}
+
+var availableProjectLifecycleActions = sets.New(
+	string(config.ProjectLifecycleActionNone),
+	string(config.ProjectLifecycleActionArchive),
+	string(config.ProjectLifecycleActionDelete),
+)
+
+func validateProjectLifecyclePolicy(policy *config.ProjectLifecyclePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy.NotificationDays < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("notificationDays"), policy.NotificationDays, "must not be negative"))
+	}
+	if policy.ApprovalWindowDays < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("approvalWindowDays"), policy.ApprovalWindowDays, "must not be negative"))
+	}
+	if !availableProjectLifecycleActions.Has(string(policy.Action)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), policy.Action, sets.List(availableProjectLifecycleActions)))
+	}
+
 	return allErrs
 }
 
@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
@@ -114,5 +115,67 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 				))
 			})
 		})
+
+		Context("ProjectLifecyclePolicy", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &config.ProjectControllerConfiguration{}
+			})
+
+			It("should pass because the lifecycle policy is valid", func() {
+				conf.Controllers.Project.LifecyclePolicy = &config.ProjectLifecyclePolicy{
+					NotificationDays:   30,
+					ApprovalWindowDays: 14,
+					Action:             config.ProjectLifecycleActionArchive,
+				}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail because the lifecycle policy is invalid", func() {
+				conf.Controllers.Project.LifecyclePolicy = &config.ProjectLifecyclePolicy{
+					NotificationDays:   -1,
+					ApprovalWindowDays: -1,
+					Action:             "Hibernate",
+				}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.lifecyclePolicy.notificationDays"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.lifecyclePolicy.approvalWindowDays"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.project.lifecyclePolicy.action"),
+					})),
+				))
+			})
+		})
+	})
+
+	Context("ShootMaintenanceControllerConfiguration", func() {
+		It("should pass because no vulnerability severity threshold is specified", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass because the vulnerability severity threshold is supported", func() {
+			conf.Controllers.ShootMaintenance.VulnerabilitySeverityThreshold = pointer.String("high")
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the vulnerability severity threshold is not supported", func() {
+			conf.Controllers.ShootMaintenance.VulnerabilitySeverityThreshold = pointer.String("severe")
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootMaintenance.vulnerabilitySeverityThreshold"),
+				})),
+			))
+		})
 	})
 })
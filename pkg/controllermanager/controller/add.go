@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/event"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/exposureclass"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/managedseedreplacement"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/quota"
@@ -87,6 +88,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding ExposureClass controller: %w", err)
 	}
 
+	if err := (&managedseedreplacement.Reconciler{
+		Config: *cfg.Controllers.ManagedSeedReplacement,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding ManagedSeedReplacement controller: %w", err)
+	}
+
 	if err := (&managedseedset.Reconciler{
 		Config: *cfg.Controllers.ManagedSeedSet,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -17,6 +17,8 @@ package cloudprofile
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -94,5 +96,65 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{}, r.reportDeprecatedTypeUsages(ctx, cloudProfile)
+}
+
+// reportDeprecatedTypeUsages emits an event on the CloudProfile listing the Shoots which still use deprecated machine
+// or volume types.
+func (r *Reconciler) reportDeprecatedTypeUsages(ctx context.Context, cloudProfile *gardencorev1beta1.CloudProfile) error {
+	deprecatedMachineTypes := sets.New[string]()
+	for _, machineType := range cloudProfile.Spec.MachineTypes {
+		if machineType.Deprecation != nil {
+			deprecatedMachineTypes.Insert(machineType.Name)
+		}
+	}
+
+	deprecatedVolumeTypes := sets.New[string]()
+	for _, volumeType := range cloudProfile.Spec.VolumeTypes {
+		if volumeType.Deprecation != nil {
+			deprecatedVolumeTypes.Insert(volumeType.Name)
+		}
+	}
+
+	if deprecatedMachineTypes.Len() == 0 && deprecatedVolumeTypes.Len() == 0 {
+		return nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return err
+	}
+
+	var usages []string
+	for _, shoot := range shootList.Items {
+		if shoot.Spec.CloudProfileName != cloudProfile.Name {
+			continue
+		}
+
+		usedTypes := sets.New[string]()
+		for _, worker := range shoot.Spec.Provider.Workers {
+			if deprecatedMachineTypes.Has(worker.Machine.Type) {
+				usedTypes.Insert(worker.Machine.Type)
+			}
+			if worker.Volume != nil && worker.Volume.Type != nil && deprecatedVolumeTypes.Has(*worker.Volume.Type) {
+				usedTypes.Insert(*worker.Volume.Type)
+			}
+			for _, dataVolume := range worker.DataVolumes {
+				if dataVolume.Type != nil && deprecatedVolumeTypes.Has(*dataVolume.Type) {
+					usedTypes.Insert(*dataVolume.Type)
+				}
+			}
+		}
+
+		if usedTypes.Len() > 0 {
+			usages = append(usages, fmt.Sprintf("%s/%s (%s)", shoot.Namespace, shoot.Name, strings.Join(sets.List(usedTypes), ", ")))
+		}
+	}
+
+	if len(usages) > 0 {
+		slices.Sort(usages)
+		r.Recorder.Eventf(cloudProfile, corev1.EventTypeWarning, v1beta1constants.EventDeprecatedTypesInUse, "The following Shoots still use deprecated machine or volume types: %s", strings.Join(usages, "; "))
+	}
+
+	return nil
 }
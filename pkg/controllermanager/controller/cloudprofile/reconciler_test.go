@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -114,6 +115,93 @@ var _ = Describe("Reconciler", func() {
 			Expect(result).To(Equal(reconcile.Result{}))
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		Context("with deprecated types", func() {
+			var recorder *record.FakeRecorder
+
+			BeforeEach(func() {
+				recorder = record.NewFakeRecorder(1)
+				reconciler = &Reconciler{Client: c, Recorder: recorder}
+
+				cloudProfile.Finalizers = []string{finalizerName}
+				cloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{
+					{Name: "m-old", Deprecation: &gardencorev1beta1.TypeDeprecation{Successor: pointer.String("m-new")}},
+					{Name: "m-new"},
+				}
+				cloudProfile.Spec.VolumeTypes = []gardencorev1beta1.VolumeType{
+					{Name: "v-old", Deprecation: &gardencorev1beta1.TypeDeprecation{}},
+				}
+			})
+
+			It("should report the Shoots still using deprecated types", func() {
+				c.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{})).DoAndReturn(func(_ context.Context, obj *gardencorev1beta1.ShootList, _ ...client.ListOption) error {
+					(&gardencorev1beta1.ShootList{Items: []gardencorev1beta1.Shoot{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "shoot-b", Namespace: "garden-dev"},
+							Spec: gardencorev1beta1.ShootSpec{
+								CloudProfileName: cloudProfileName,
+								Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{
+									Machine:     gardencorev1beta1.Machine{Type: "m-old"},
+									DataVolumes: []gardencorev1beta1.DataVolume{{Type: pointer.String("v-old")}},
+								}}},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "shoot-a", Namespace: "garden-dev"},
+							Spec: gardencorev1beta1.ShootSpec{
+								CloudProfileName: cloudProfileName,
+								Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{
+									Machine: gardencorev1beta1.Machine{Type: "m-new"},
+									Volume:  &gardencorev1beta1.Volume{Type: pointer.String("v-old")},
+								}}},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "shoot-c", Namespace: "garden-dev"},
+							Spec: gardencorev1beta1.ShootSpec{
+								CloudProfileName: cloudProfileName,
+								Provider:         gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Machine: gardencorev1beta1.Machine{Type: "m-new"}}}},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "shoot-d", Namespace: "garden-dev"},
+							Spec: gardencorev1beta1.ShootSpec{
+								CloudProfileName: "other",
+								Provider:         gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Machine: gardencorev1beta1.Machine{Type: "m-old"}}}},
+							},
+						},
+					}}).DeepCopyInto(obj)
+					return nil
+				})
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: cloudProfileName}})
+				Expect(result).To(Equal(reconcile.Result{}))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(recorder.Events).To(Receive(Equal("Warning DeprecatedTypesInUse The following Shoots still use deprecated machine or volume types: garden-dev/shoot-a (v-old); garden-dev/shoot-b (m-old, v-old)")))
+			})
+
+			It("should not report anything if no Shoot uses deprecated types", func() {
+				c.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{})).DoAndReturn(func(_ context.Context, obj *gardencorev1beta1.ShootList, _ ...client.ListOption) error {
+					(&gardencorev1beta1.ShootList{}).DeepCopyInto(obj)
+					return nil
+				})
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: cloudProfileName}})
+				Expect(result).To(Equal(reconcile.Result{}))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(recorder.Events).NotTo(Receive())
+			})
+
+			It("should return an error if listing the Shoots fails", func() {
+				c.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{})).Return(fakeErr)
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: cloudProfileName}})
+				Expect(result).To(Equal(reconcile.Result{}))
+				Expect(err).To(MatchError(fakeErr))
+			})
+		})
 	})
 
 	Context("when deletion timestamp set", func() {
@@ -0,0 +1,311 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedseedreplacement
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+// Reconciler reconciles ManagedSeeds with a replacement. It migrates all shoots from the seed registered by the
+// ManagedSeed to the replacement seed and deletes the ManagedSeed afterwards.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.ManagedSeedReplacementControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles ManagedSeeds with a replacement.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	managedSeed := &seedmanagementv1alpha1.ManagedSeed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, managedSeed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if managedSeed.Spec.Replacement == nil || managedSeed.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	log = log.WithValues("replacementSeedName", managedSeed.Spec.Replacement.SeedName)
+
+	status := managedSeed.Status.Replacement.DeepCopy()
+	if status == nil {
+		status = &seedmanagementv1alpha1.ReplacementStatus{}
+		r.transition(managedSeed, status, seedmanagementv1alpha1.ReplacementPending, "Waiting for the replacement seed to become ready")
+	}
+
+	reconcileErr := r.reconcile(ctx, log, managedSeed, status)
+
+	if !apiequality.Semantic.DeepEqual(managedSeed.Status.Replacement, status) {
+		patch := client.MergeFrom(managedSeed.DeepCopy())
+		managedSeed.Status.Replacement = status
+		if err := r.Client.Status().Patch(ctx, managedSeed, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating replacement status: %w", err)
+		}
+	}
+
+	if reconcileErr != nil {
+		return reconcile.Result{}, reconcileErr
+	}
+
+	if status.Phase == seedmanagementv1alpha1.ReplacementDecommissioning {
+		log.Info("Seed replacement completed, deleting ManagedSeed")
+		r.Recorder.Event(managedSeed, corev1.EventTypeNormal, "ReplacementCompleted", "All shoots have been migrated to the replacement seed, deleting ManagedSeed")
+		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, managedSeed))
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, managedSeed *seedmanagementv1alpha1.ManagedSeed, status *seedmanagementv1alpha1.ReplacementStatus) error {
+	var (
+		seedName            = managedSeed.Name
+		replacementSeedName = managedSeed.Spec.Replacement.SeedName
+	)
+
+	if status.Phase == seedmanagementv1alpha1.ReplacementPending {
+		if err := r.checkReplacementSeed(ctx, seedName, replacementSeedName); err != nil {
+			status.Message = fmt.Sprintf("Waiting for the replacement seed to become ready: %v", err)
+			return nil
+		}
+
+		log.Info("Replacement seed is ready, starting shoot migration")
+		r.transition(managedSeed, status, seedmanagementv1alpha1.ReplacementMigrating, "Migrating shoots to the replacement seed")
+	}
+
+	if status.Phase == seedmanagementv1alpha1.ReplacementMigrating {
+		done, err := r.migrateShoots(ctx, log, managedSeed, status)
+		if err != nil || !done {
+			return err
+		}
+
+		log.Info("All shoots have been migrated, verifying replacement")
+		r.transition(managedSeed, status, seedmanagementv1alpha1.ReplacementVerifying, "Verifying that all shoots have been migrated to the replacement seed")
+	}
+
+	if status.Phase == seedmanagementv1alpha1.ReplacementVerifying {
+		remaining, inFlight, err := r.determineShoots(ctx, seedName, replacementSeedName, status.ShootsMigrating)
+		if err != nil {
+			return err
+		}
+
+		if len(remaining) > 0 || len(inFlight) > 0 {
+			log.Info("Found shoots which have not been migrated yet, continuing migration", "remaining", len(remaining), "inFlight", len(inFlight))
+			r.transition(managedSeed, status, seedmanagementv1alpha1.ReplacementMigrating, "Found shoots which have not been migrated yet")
+			return nil
+		}
+
+		if err := r.checkReplacementSeed(ctx, seedName, replacementSeedName); err != nil {
+			status.Message = fmt.Sprintf("Waiting for the replacement seed to become ready: %v", err)
+			return nil
+		}
+
+		r.transition(managedSeed, status, seedmanagementv1alpha1.ReplacementDecommissioning, "Deleting ManagedSeed of the replaced seed")
+	}
+
+	return nil
+}
+
+// migrateShoots binds shoots of the replaced seed to the replacement seed while respecting the maximum number of
+// concurrent migrations. It returns true if all shoots have been migrated.
+func (r *Reconciler) migrateShoots(ctx context.Context, log logr.Logger, managedSeed *seedmanagementv1alpha1.ManagedSeed, status *seedmanagementv1alpha1.ReplacementStatus) (bool, error) {
+	var (
+		seedName                = managedSeed.Name
+		replacementSeedName     = managedSeed.Spec.Replacement.SeedName
+		maxConcurrentMigrations = int(pointer.Int32Deref(managedSeed.Spec.Replacement.MaxConcurrentMigrations, 1))
+	)
+
+	remaining, inFlight, err := r.determineShoots(ctx, seedName, replacementSeedName, status.ShootsMigrating)
+	if err != nil {
+		return false, err
+	}
+
+	// Shoots might still be scheduled to the replaced seed while the migration is running, hence the total number has
+	// to be adapted.
+	if total := status.ShootsMigrated + int32(len(remaining)+len(inFlight)); total > status.ShootsTotal {
+		status.ShootsTotal = total
+	}
+
+	var (
+		bound   int
+		bindErr error
+	)
+
+	for _, shoot := range remaining {
+		if len(inFlight) >= maxConcurrentMigrations {
+			break
+		}
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		key := client.ObjectKeyFromObject(shoot)
+		log.Info("Migrating shoot to replacement seed", "shoot", key)
+
+		shoot.Spec.SeedName = &replacementSeedName
+		if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+			bindErr = fmt.Errorf("failed migrating shoot %s to seed %q: %w", key, replacementSeedName, err)
+			r.Recorder.Event(managedSeed, corev1.EventTypeWarning, "ShootMigrationFailed", bindErr.Error())
+			break
+		}
+
+		r.Recorder.Eventf(managedSeed, corev1.EventTypeNormal, "ShootMigrationStarted", "Started migration of shoot %s to seed %q", key, replacementSeedName)
+		inFlight.Insert(key.String())
+		bound++
+	}
+
+	numRemaining := len(remaining) - bound
+
+	status.ShootsMigrating = sets.List(inFlight)
+	status.ShootsMigrated = status.ShootsTotal - int32(numRemaining+len(inFlight))
+	status.Message = fmt.Sprintf("Migrated %d/%d shoots to the replacement seed, %d migration(s) in progress", status.ShootsMigrated, status.ShootsTotal, len(inFlight))
+	if bindErr != nil {
+		status.Message = bindErr.Error()
+	}
+
+	return numRemaining == 0 && len(inFlight) == 0, bindErr
+}
+
+// determineShoots returns the shoots which are still scheduled to the replaced seed (sorted by namespace and name) and
+// the keys of the shoots which are currently being migrated to the replacement seed.
+func (r *Reconciler) determineShoots(ctx context.Context, seedName, replacementSeedName string, migrating []string) ([]*gardencorev1beta1.Shoot, sets.Set[string], error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: seedName}); err != nil {
+		return nil, nil, fmt.Errorf("failed listing shoots scheduled to seed %q: %w", seedName, err)
+	}
+
+	remaining := make([]*gardencorev1beta1.Shoot, 0, len(shootList.Items))
+	for i := range shootList.Items {
+		remaining = append(remaining, &shootList.Items[i])
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		return client.ObjectKeyFromObject(remaining[i]).String() < client.ObjectKeyFromObject(remaining[j]).String()
+	})
+
+	// Shoots which are still running on the replaced seed but are already scheduled to another seed are being migrated
+	// away from the replaced seed.
+	migratingShootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, migratingShootList, client.MatchingFields{core.ShootStatusSeedName: seedName}); err != nil {
+		return nil, nil, fmt.Errorf("failed listing shoots running on seed %q: %w", seedName, err)
+	}
+
+	inFlight := sets.New[string]()
+	for _, shoot := range migratingShootList.Items {
+		if pointer.StringDeref(shoot.Spec.SeedName, "") != seedName {
+			inFlight.Insert(client.ObjectKeyFromObject(&shoot).String())
+		}
+	}
+
+	// Shoots which have been migrated by this controller are in flight until they have been restored on the replacement
+	// seed.
+	for _, key := range migrating {
+		if inFlight.Has(key) {
+			continue
+		}
+
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		shoot := &gardencorev1beta1.Shoot{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, shoot); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed reading shoot %s: %w", key, err)
+		}
+
+		if pointer.StringDeref(shoot.Spec.SeedName, "") == replacementSeedName && !restoredOnSeed(shoot, replacementSeedName) {
+			inFlight.Insert(key)
+		}
+	}
+
+	return remaining, inFlight, nil
+}
+
+func restoredOnSeed(shoot *gardencorev1beta1.Shoot, seedName string) bool {
+	if pointer.StringDeref(shoot.Status.SeedName, "") != seedName {
+		return false
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation == nil ||
+		lastOperation.Type != gardencorev1beta1.LastOperationTypeRestore ||
+		lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
+
+// checkReplacementSeed checks whether the replacement seed is ready to take over the shoots of the replaced seed.
+func (r *Reconciler) checkReplacementSeed(ctx context.Context, seedName, replacementSeedName string) error {
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: seedName}, seed); err != nil {
+		return fmt.Errorf("failed reading seed %q: %w", seedName, err)
+	}
+
+	replacementSeed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: replacementSeedName}, replacementSeed); err != nil {
+		return fmt.Errorf("failed reading replacement seed %q: %w", replacementSeedName, err)
+	}
+
+	if replacementSeed.DeletionTimestamp != nil {
+		return fmt.Errorf("replacement seed %q is being deleted", replacementSeedName)
+	}
+
+	if seed.Status.Gardener == nil {
+		return fmt.Errorf("seed %q has not reported its Gardener version yet", seedName)
+	}
+
+	return health.CheckSeedForMigration(replacementSeed, seed.Status.Gardener)
+}
+
+func (r *Reconciler) transition(managedSeed *seedmanagementv1alpha1.ManagedSeed, status *seedmanagementv1alpha1.ReplacementStatus, phase seedmanagementv1alpha1.ReplacementPhase, message string) {
+	status.Phase = phase
+	status.Message = message
+	status.LastTransitionTime = &metav1.Time{Time: r.Clock.Now()}
+
+	r.Recorder.Eventf(managedSeed, corev1.EventTypeNormal, "Replacement"+string(phase), message)
+}
@@ -0,0 +1,269 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedseedreplacement_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/managedseedreplacement"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		syncPeriod          = time.Minute
+		seedName            = "old"
+		replacementSeedName = "new"
+		shootNamespace      = "garden-foo"
+	)
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		managedSeed     *seedmanagementv1alpha1.ManagedSeed
+		seed            *gardencorev1beta1.Seed
+		replacementSeed *gardencorev1beta1.Seed
+		shoot1, shoot2  *gardencorev1beta1.Shoot
+	)
+
+	newReadySeed := func(name string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+			Status: gardencorev1beta1.SeedStatus{
+				ObservedGeneration: 1,
+				Gardener:           &gardencorev1beta1.Gardener{Version: "1.2.3"},
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+					{Type: gardencorev1beta1.SeedSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+	}
+
+	newShoot := func(name string) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: shootNamespace},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String(seedName)},
+			Status:     gardencorev1beta1.ShootStatus{SeedName: pointer.String(seedName)},
+		}
+	}
+
+	markRestored := func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.SeedName = pointer.String(replacementSeedName)
+		shoot.Status.SeedName = pointer.String(replacementSeedName)
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeRestore,
+			State: gardencorev1beta1.LastOperationStateSucceeded,
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		seed = newReadySeed(seedName)
+		replacementSeed = newReadySeed(replacementSeedName)
+		shoot1 = newShoot("shoot1")
+		shoot2 = newShoot("shoot2")
+
+		managedSeed = &seedmanagementv1alpha1.ManagedSeed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName, Namespace: "garden"},
+			Spec: seedmanagementv1alpha1.ManagedSeedSpec{
+				Replacement: &seedmanagementv1alpha1.Replacement{
+					SeedName:                replacementSeedName,
+					MaxConcurrentMigrations: pointer.Int32(1),
+				},
+			},
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedSeed)}
+	})
+
+	JustBeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(managedSeed, seed, replacementSeed, shoot1, shoot2).
+			WithStatusSubresource(&seedmanagementv1alpha1.ManagedSeed{}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{pointer.StringDeref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{pointer.StringDeref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// The fake client does not know the binding subresource of shoots, hence emulate it with a regular update.
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					Expect(subResourceName).To(Equal("binding"))
+					return c.Update(ctx, obj)
+				},
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Config:   config.ManagedSeedReplacementControllerConfiguration{SyncPeriod: metav1.Duration{Duration: syncPeriod}},
+			Clock:    fakeClock,
+			Recorder: record.NewFakeRecorder(32),
+		}
+	})
+
+	reconcileAndGetStatus := func() *seedmanagementv1alpha1.ReplacementStatus {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeClient.Get(ctx, request.NamespacedName, managedSeed)).To(Succeed())
+		return managedSeed.Status.Replacement
+	}
+
+	seedNameOf := func(shoot *gardencorev1beta1.Shoot) string {
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		return pointer.StringDeref(shoot.Spec.SeedName, "")
+	}
+
+	Context("without replacement", func() {
+		BeforeEach(func() {
+			managedSeed.Spec.Replacement = nil
+		})
+
+		It("should do nothing if the ManagedSeed has no replacement", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, request.NamespacedName, managedSeed)).To(Succeed())
+			Expect(managedSeed.Status.Replacement).To(BeNil())
+		})
+	})
+
+	Context("replacement seed not ready", func() {
+		BeforeEach(func() {
+			replacementSeed.Status.ObservedGeneration = 0
+		})
+
+		It("should stay pending while the replacement seed is not ready", func() {
+			status := reconcileAndGetStatus()
+			Expect(status.Phase).To(Equal(seedmanagementv1alpha1.ReplacementPending))
+			Expect(status.Message).To(ContainSubstring("observed generation outdated"))
+			Expect(status.LastTransitionTime).To(PointTo(Equal(metav1.Time{Time: fakeClock.Now()})))
+			Expect(seedNameOf(shoot1)).To(Equal(seedName))
+			Expect(seedNameOf(shoot2)).To(Equal(seedName))
+		})
+	})
+
+	Context("replacement seed with different Gardener version", func() {
+		BeforeEach(func() {
+			replacementSeed.Status.Gardener.Version = "1.2.2"
+		})
+
+		It("should stay pending while the replacement seed runs a different Gardener version", func() {
+			status := reconcileAndGetStatus()
+			Expect(status.Phase).To(Equal(seedmanagementv1alpha1.ReplacementPending))
+			Expect(status.Message).To(ContainSubstring("observing Gardener version not up to date"))
+		})
+	})
+
+	It("should migrate the shoots while respecting the maximum number of concurrent migrations", func() {
+		status := reconcileAndGetStatus()
+		Expect(status.Phase).To(Equal(seedmanagementv1alpha1.ReplacementMigrating))
+		Expect(status.ShootsTotal).To(Equal(int32(2)))
+		Expect(status.ShootsMigrated).To(Equal(int32(0)))
+		Expect(status.ShootsMigrating).To(ConsistOf(shootNamespace + "/shoot1"))
+		Expect(seedNameOf(shoot1)).To(Equal(replacementSeedName))
+		Expect(seedNameOf(shoot2)).To(Equal(seedName))
+
+		By("Waiting for the running migration")
+		status = reconcileAndGetStatus()
+		Expect(status.Phase).To(Equal(seedmanagementv1alpha1.ReplacementMigrating))
+		Expect(status.ShootsMigrating).To(ConsistOf(shootNamespace + "/shoot1"))
+		Expect(seedNameOf(shoot2)).To(Equal(seedName))
+
+		By("Migrating the next shoot once the first one was restored")
+		markRestored(shoot1)
+		Expect(fakeClient.Update(ctx, shoot1)).To(Succeed())
+
+		status = reconcileAndGetStatus()
+		Expect(status.Phase).To(Equal(seedmanagementv1alpha1.ReplacementMigrating))
+		Expect(status.ShootsMigrated).To(Equal(int32(1)))
+		Expect(status.ShootsMigrating).To(ConsistOf(shootNamespace + "/shoot2"))
+		Expect(seedNameOf(shoot2)).To(Equal(replacementSeedName))
+
+		By("Decommissioning the ManagedSeed once all shoots were restored")
+		markRestored(shoot2)
+		Expect(fakeClient.Update(ctx, shoot2)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, request.NamespacedName, managedSeed)).To(BeNotFoundError())
+	})
+
+	Context("multiple concurrent migrations", func() {
+		BeforeEach(func() {
+			managedSeed.Spec.Replacement.MaxConcurrentMigrations = pointer.Int32(2)
+		})
+
+		It("should migrate multiple shoots at the same time", func() {
+			status := reconcileAndGetStatus()
+			Expect(status.Phase).To(Equal(seedmanagementv1alpha1.ReplacementMigrating))
+			Expect(status.ShootsMigrating).To(ConsistOf(shootNamespace+"/shoot1", shootNamespace+"/shoot2"))
+			Expect(seedNameOf(shoot1)).To(Equal(replacementSeedName))
+			Expect(seedNameOf(shoot2)).To(Equal(replacementSeedName))
+		})
+	})
+
+	Context("verification", func() {
+		BeforeEach(func() {
+			markRestored(shoot1)
+			managedSeed.Status.Replacement = &seedmanagementv1alpha1.ReplacementStatus{
+				Phase:          seedmanagementv1alpha1.ReplacementVerifying,
+				ShootsTotal:    1,
+				ShootsMigrated: 1,
+			}
+		})
+
+		It("should continue the migration if shoots were scheduled to the replaced seed during verification", func() {
+			status := reconcileAndGetStatus()
+			Expect(status.Phase).To(Equal(seedmanagementv1alpha1.ReplacementMigrating))
+			Expect(seedNameOf(shoot2)).To(Equal(seedName))
+		})
+	})
+
+	Context("shoot in deletion", func() {
+		BeforeEach(func() {
+			shoot1.DeletionTimestamp = &metav1.Time{Time: fakeClock.Now()}
+			shoot1.Finalizers = []string{"gardener"}
+		})
+
+		It("should not bind shoots which are being deleted", func() {
+			status := reconcileAndGetStatus()
+			Expect(status.ShootsMigrating).To(ConsistOf(shootNamespace + "/shoot2"))
+			Expect(seedNameOf(shoot1)).To(Equal(seedName))
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,20 +34,32 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
-// Reconciler reconciles Projects, marks them as stale and auto-deletes them after a certain time if not in-use.
+const (
+	reasonProjectActive      = "ProjectActive"
+	reasonStaleCheckSkipped  = "StaleCheckSkipped"
+	reasonInactivityDetected = "InactivityDetected"
+	reasonOwnerNotified      = "OwnerNotified"
+	reasonArchived           = "Archived"
+)
+
+// Reconciler reconciles Projects, marks them as stale and executes the configured lifecycle policy, i.e. archives or
+// auto-deletes them after a certain time if not in-use.
 type Reconciler struct {
-	Client client.Client
-	Config config.ProjectControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   config.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
 }
 
-// Reconcile reconciles Projects, marks them as stale and auto-deletes them after a certain time if not in-use.
+// Reconcile reconciles Projects, marks them as stale and executes the configured lifecycle policy, i.e. archives or
+// auto-deletes them after a certain time if not in-use.
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
@@ -89,20 +102,20 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 
 	if skipStaleCheck {
 		log.Info("Namespace is marked to skip the stale check, marking Project as not stale")
-		return r.markProjectAsNotStale(ctx, project)
+		return r.markProjectAsNotStale(ctx, project, reasonStaleCheckSkipped, "The stale check is skipped for this project.")
 	}
 
 	// Skip projects that are not older than the configured minimum lifetime in days. This allows having Projects for a
 	// certain period of time until they are checked whether they got stale.
 	if project.CreationTimestamp.UTC().Add(time.Hour * 24 * time.Duration(*r.Config.MinimumLifetimeDays)).After(r.Clock.Now().UTC()) {
 		log.Info("Project is not older than the configured minimum lifetime, marking Project as not stale", "minimumLifetimeDays", *r.Config.MinimumLifetimeDays, "creationTimestamp", project.CreationTimestamp.UTC())
-		return r.markProjectAsNotStale(ctx, project)
+		return r.markProjectAsNotStale(ctx, project, reasonProjectActive, "Project was created recently.")
 	}
 
 	// Skip projects that have been used recently
 	if project.Status.LastActivityTimestamp != nil && project.Status.LastActivityTimestamp.UTC().Add(time.Hour*24*time.Duration(*r.Config.MinimumLifetimeDays)).After(r.Clock.Now().UTC()) {
 		log.Info("Project was used recently and it is not exceeding the configured minimum lifetime, marking Project as not stale", "minimumLifetimeDays", *r.Config.MinimumLifetimeDays, "lastActivityTimestamp", project.Status.LastActivityTimestamp.UTC())
-		return r.markProjectAsNotStale(ctx, project)
+		return r.markProjectAsNotStale(ctx, project, reasonProjectActive, "Project was used recently.")
 	}
 
 	for _, check := range []struct {
@@ -120,21 +133,23 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		}
 		if projectInUse {
 			log.Info("Project is in use by resource, marking Project as not stale", "resource", check.resource)
-			return r.markProjectAsNotStale(ctx, project)
+			return r.markProjectAsNotStale(ctx, project, reasonProjectActive, fmt.Sprintf("Project is in use by %s.", check.resource))
 		}
 	}
 
 	log.Info("Project is not in use by any resource, marking Project as stale")
-	if err := r.markProjectAsStale(ctx, project); err != nil {
+	policy := r.lifecyclePolicy()
+	stage, err := r.markProjectAsStale(ctx, project, policy)
+	if err != nil {
 		return err
 	}
 
-	log = log.WithValues("staleSinceTimestamp", (*project.Status.StaleSinceTimestamp).Time)
+	log = log.WithValues("staleSinceTimestamp", (*project.Status.StaleSinceTimestamp).Time, "stage", stage)
 	if project.Status.StaleAutoDeleteTimestamp != nil {
 		log = log.WithValues("staleAutoDeleteTimestamp", (*project.Status.StaleAutoDeleteTimestamp).Time)
 	}
 
-	if project.Status.StaleAutoDeleteTimestamp == nil || r.Clock.Now().UTC().Before(project.Status.StaleAutoDeleteTimestamp.UTC()) {
+	if policy.Action != config.ProjectLifecycleActionDelete || project.Status.StaleAutoDeleteTimestamp == nil || r.Clock.Now().UTC().Before(project.Status.StaleAutoDeleteTimestamp.UTC()) {
 		log.Info("Project is stale, but will not be deleted now")
 		return nil
 	}
@@ -225,39 +240,125 @@ func (r *Reconciler) relevantSecretBindingsInUse(ctx context.Context, isSecretBi
 	return r.secretBindingInUse(ctx, namespaceToSecretBindingNames)
 }
 
-func (r *Reconciler) markProjectAsNotStale(ctx context.Context, project *gardencorev1beta1.Project) error {
+func (r *Reconciler) markProjectAsNotStale(ctx context.Context, project *gardencorev1beta1.Project, reason, message string) error {
 	patch := client.MergeFrom(project.DeepCopy())
 	project.Status.StaleSinceTimestamp = nil
 	project.Status.StaleAutoDeleteTimestamp = nil
+
+	r.updateCondition(project, gardencorev1beta1.ProjectInactive, gardencorev1beta1.ConditionFalse, reason, message)
+	if condition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectArchived); condition != nil && condition.Status == gardencorev1beta1.ConditionTrue {
+		r.updateCondition(project, gardencorev1beta1.ProjectArchived, gardencorev1beta1.ConditionFalse, reason, "Project was reactivated.")
+		r.Recorder.Event(project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventUnarchived, "Project was reactivated, new Shoots can be created again")
+	}
+
 	return r.Client.Status().Patch(ctx, project, patch)
 }
 
-func (r *Reconciler) markProjectAsStale(ctx context.Context, project *gardencorev1beta1.Project) error {
+// markProjectAsStale marks the project as stale and moves it through the stages of the given lifecycle policy. It
+// returns the stage the project is in.
+func (r *Reconciler) markProjectAsStale(ctx context.Context, project *gardencorev1beta1.Project, policy config.ProjectLifecyclePolicy) (string, error) {
 	patch := client.MergeFrom(project.DeepCopy())
 
 	if project.Status.StaleSinceTimestamp == nil {
 		project.Status.StaleSinceTimestamp = &metav1.Time{Time: r.Clock.Now()}
 	}
 
-	if project.Status.StaleSinceTimestamp.UTC().Add(time.Hour * 24 * time.Duration(*r.Config.StaleGracePeriodDays)).After(r.Clock.Now().UTC()) {
-		// We reset the potentially set auto-delete timestamp here to allow changing the StaleExpirationTimeDays
-		// configuration value and correctly applying the changes to all Projects that had already been assigned
-		// such a timestamp.
+	var (
+		now              = r.Clock.Now().UTC()
+		staleSince       = project.Status.StaleSinceTimestamp.UTC()
+		notificationTime = staleSince.Add(time.Hour * 24 * time.Duration(policy.NotificationDays))
+		actionTime       = notificationTime.Add(time.Hour * 24 * time.Duration(policy.ApprovalWindowDays))
+		stage            = reasonInactivityDetected
+		message          = fmt.Sprintf("Project is not in use since %s.", staleSince.Format(time.RFC3339))
+		archived         = false
+	)
+
+	if notificationTime.After(now) {
+		// We reset the potentially set auto-delete timestamp here to allow changing the lifecycle policy and correctly
+		// applying the changes to all Projects that had already been assigned such a timestamp.
 		project.Status.StaleAutoDeleteTimestamp = nil
 	} else {
-		// If the project got stale we compute an auto delete timestamp only if the configured stale grace period is
-		// exceeded. Note that this might update the potentially already set auto-delete timestamp in case the
-		// StaleExpirationTimeDays configuration value was changed.
-		autoDeleteTimestamp := metav1.Time{Time: project.Status.StaleSinceTimestamp.Add(time.Hour * 24 * time.Duration(*r.Config.StaleExpirationTimeDays))}
-
-		// Don't allow to shorten the auto-delete timestamp as end-users might depend on the configured time. It may
-		// only be extended.
-		if project.Status.StaleAutoDeleteTimestamp == nil || autoDeleteTimestamp.After(project.Status.StaleAutoDeleteTimestamp.Time) {
-			project.Status.StaleAutoDeleteTimestamp = &autoDeleteTimestamp
+		stage = reasonOwnerNotified
+
+		switch policy.Action {
+		case config.ProjectLifecycleActionDelete:
+			// If the project got stale we compute an auto delete timestamp only if the notification period is exceeded.
+			// Note that this might update the potentially already set auto-delete timestamp in case the lifecycle policy
+			// was changed.
+			autoDeleteTimestamp := metav1.Time{Time: actionTime}
+
+			// Don't allow to shorten the auto-delete timestamp as end-users might depend on the configured time. It may
+			// only be extended.
+			if project.Status.StaleAutoDeleteTimestamp == nil || autoDeleteTimestamp.After(project.Status.StaleAutoDeleteTimestamp.Time) {
+				project.Status.StaleAutoDeleteTimestamp = &autoDeleteTimestamp
+			}
+			message = fmt.Sprintf("Project is not in use since %s and will be deleted at %s unless it is used again.", staleSince.Format(time.RFC3339), project.Status.StaleAutoDeleteTimestamp.UTC().Format(time.RFC3339))
+
+		case config.ProjectLifecycleActionArchive:
+			project.Status.StaleAutoDeleteTimestamp = nil
+			message = fmt.Sprintf("Project is not in use since %s and will be archived at %s unless it is used again.", staleSince.Format(time.RFC3339), actionTime.Format(time.RFC3339))
+			if !actionTime.After(now) {
+				stage, archived = reasonArchived, true
+				message = fmt.Sprintf("Project is not in use since %s and was archived, no new Shoots can be created in it.", staleSince.Format(time.RFC3339))
+			}
+
+		default:
+			project.Status.StaleAutoDeleteTimestamp = nil
+			message = fmt.Sprintf("Project is not in use since %s.", staleSince.Format(time.RFC3339))
 		}
 	}
 
-	return r.Client.Status().Patch(ctx, project, patch)
+	if r.updateCondition(project, gardencorev1beta1.ProjectInactive, gardencorev1beta1.ConditionTrue, stage, message) {
+		switch stage {
+		case reasonOwnerNotified:
+			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventInactive, "%s %s", ownerMessage(project), message)
+		case reasonArchived:
+			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventArchived, "%s %s", ownerMessage(project), message)
+		}
+	}
+
+	if archived {
+		r.updateCondition(project, gardencorev1beta1.ProjectArchived, gardencorev1beta1.ConditionTrue, reasonArchived, "Project was archived because it is not in use.")
+	} else if condition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectArchived); condition != nil && condition.Status == gardencorev1beta1.ConditionTrue {
+		// The lifecycle policy was changed, hence the project must no longer be archived.
+		r.updateCondition(project, gardencorev1beta1.ProjectArchived, gardencorev1beta1.ConditionFalse, stage, "Project is no longer archived because of a changed lifecycle policy.")
+	}
+
+	return stage, r.Client.Status().Patch(ctx, project, patch)
+}
+
+// updateCondition updates the condition of the given type in the project status if its status, reason, or message
+// changed. It returns true if the reason changed, i.e. the project entered a new stage.
+func (r *Reconciler) updateCondition(project *gardencorev1beta1.Project, conditionType gardencorev1beta1.ConditionType, status gardencorev1beta1.ConditionStatus, reason, message string) bool {
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, project.Status.Conditions, conditionType)
+	if condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return false
+	}
+
+	reasonChanged := condition.Reason != reason
+	project.Status.Conditions = v1beta1helper.MergeConditions(project.Status.Conditions, v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, status, reason, message))
+	return reasonChanged
+}
+
+// lifecyclePolicy returns the configured lifecycle policy. If it is not configured, the policy is derived from the
+// stale grace period and expiration time settings.
+func (r *Reconciler) lifecyclePolicy() config.ProjectLifecyclePolicy {
+	if r.Config.LifecyclePolicy != nil {
+		return *r.Config.LifecyclePolicy
+	}
+
+	return config.ProjectLifecyclePolicy{
+		NotificationDays:   *r.Config.StaleGracePeriodDays,
+		ApprovalWindowDays: max(*r.Config.StaleExpirationTimeDays-*r.Config.StaleGracePeriodDays, 0),
+		Action:             config.ProjectLifecycleActionDelete,
+	}
+}
+
+func ownerMessage(project *gardencorev1beta1.Project) string {
+	if project.Spec.Owner == nil {
+		return "Attention:"
+	}
+	return fmt.Sprintf("Attention %s:", project.Spec.Owner.Name)
 }
 
 func (r *Reconciler) secretBindingInUse(ctx context.Context, namespaceToSecretBindingNames map[string]sets.Set[string]) (bool, error) {
@@ -20,10 +20,13 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +41,7 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("Reconciler", func() {
@@ -73,7 +77,8 @@ var _ = Describe("Reconciler", func() {
 		cfg                   config.ProjectControllerConfiguration
 		request               reconcile.Request
 
-		reconciler reconcile.Reconciler
+		fakeRecorder *record.FakeRecorder
+		reconciler   reconcile.Reconciler
 	)
 
 	BeforeEach(func() {
@@ -116,10 +121,12 @@ var _ = Describe("Reconciler", func() {
 		}
 		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: project.Name}}
 
+		fakeRecorder = record.NewFakeRecorder(1)
 		reconciler = &Reconciler{
-			Client: k8sGardenRuntimeClient,
-			Config: cfg,
-			Clock:  fakeClock,
+			Client:   k8sGardenRuntimeClient,
+			Config:   cfg,
+			Clock:    fakeClock,
+			Recorder: fakeRecorder,
 		}
 
 		k8sGardenRuntimeClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(project.Name), gomock.AssignableToTypeOf(&gardencorev1beta1.Project{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Project, _ ...client.GetOption) error {
@@ -151,7 +158,7 @@ var _ = Describe("Reconciler", func() {
 
 			namespace.Annotations = map[string]string{v1beta1constants.ProjectSkipStaleCheck: "true"}
 
-			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "StaleCheckSkipped")
 
 			_, result := reconciler.Reconcile(ctx, request)
 			Expect(result).To(Succeed())
@@ -162,7 +169,7 @@ var _ = Describe("Reconciler", func() {
 
 			project.CreationTimestamp = metav1.Time{Time: time.Date(1, 1, minimumLifetimeDays-1, 0, 0, 0, 0, time.UTC)}
 
-			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "ProjectActive")
 
 			_, result := reconciler.Reconcile(ctx, request)
 			Expect(result).To(Succeed())
@@ -173,7 +180,7 @@ var _ = Describe("Reconciler", func() {
 
 			project.Status.LastActivityTimestamp = &metav1.Time{Time: time.Date(1, 1, minimumLifetimeDays-1, 0, 0, 0, 0, time.UTC)}
 
-			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "ProjectActive")
 
 			_, result := reconciler.Reconcile(ctx, request)
 			Expect(result).To(Succeed())
@@ -192,7 +199,7 @@ var _ = Describe("Reconciler", func() {
 						return nil
 					})
 
-					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "ProjectActive")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -205,7 +212,7 @@ var _ = Describe("Reconciler", func() {
 						return nil
 					})
 
-					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "ProjectActive")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -227,7 +234,7 @@ var _ = Describe("Reconciler", func() {
 						return nil
 					})
 
-					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "ProjectActive")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -253,7 +260,7 @@ var _ = Describe("Reconciler", func() {
 						return nil
 					})
 
-					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "ProjectActive")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -276,7 +283,7 @@ var _ = Describe("Reconciler", func() {
 						return nil
 					})
 
-					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "ProjectActive")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -303,7 +310,7 @@ var _ = Describe("Reconciler", func() {
 						return nil
 					})
 
-					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+					expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, "ProjectActive")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -326,7 +333,7 @@ var _ = Describe("Reconciler", func() {
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.InNamespace(namespaceName))
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
 
-					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, nil, nil, fakeClock)
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, nil, nil, fakeClock, "InactivityDetected")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -350,7 +357,7 @@ var _ = Describe("Reconciler", func() {
 					})
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
 
-					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, nil, nil, fakeClock)
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, nil, nil, fakeClock, "InactivityDetected")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -385,7 +392,7 @@ var _ = Describe("Reconciler", func() {
 					}).AnyTimes()
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
 
-					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, nil, nil, fakeClock)
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, nil, nil, fakeClock, "InactivityDetected")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -405,7 +412,7 @@ var _ = Describe("Reconciler", func() {
 					})
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.InNamespace(namespaceName))
 
-					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, nil, nil, fakeClock)
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, nil, nil, fakeClock, "InactivityDetected")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -417,7 +424,7 @@ var _ = Describe("Reconciler", func() {
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespaceName))
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
 
-					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, nil, nil, fakeClock)
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, nil, nil, fakeClock, "InactivityDetected")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -432,7 +439,7 @@ var _ = Describe("Reconciler", func() {
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespaceName))
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
 
-					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, nil, fakeClock)
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, &staleSinceTimestamp, nil, fakeClock, "InactivityDetected")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -450,7 +457,7 @@ var _ = Describe("Reconciler", func() {
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespaceName))
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
 
-					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock)
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock, "OwnerNotified")
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -470,48 +477,158 @@ var _ = Describe("Reconciler", func() {
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespaceName))
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
 
-					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock)
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock, "OwnerNotified")
 
 					defer test.WithVar(&gardenerutils.TimeNow, func() time.Time {
 						return time.Date(1, 1, minimumLifetimeDays+1, 1, 0, 0, 0, time.UTC)
 					})()
 
-					projectCopy := project.DeepCopy()
-					projectCopy.Annotations = map[string]string{
-						gardenerutils.ConfirmationDeletion: "true",
-						v1beta1constants.GardenerTimestamp: gardenerutils.TimeNow().UTC().Format(time.RFC3339Nano),
+					k8sGardenRuntimeClient.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.Project{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(obj.GetAnnotations()).To(Equal(map[string]string{
+							gardenerutils.ConfirmationDeletion: "true",
+							v1beta1constants.GardenerTimestamp: gardenerutils.TimeNow().UTC().Format(time.RFC3339Nano),
+						}))
+						return nil
+					})
+					k8sGardenRuntimeClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.Project{}))
+
+					_, result := reconciler.Reconcile(ctx, request)
+					Expect(result).To(Succeed())
+				})
+			})
+
+			Describe("lifecycle policy", func() {
+				var staleSinceTimestamp metav1.Time
+
+				BeforeEach(func() {
+					reconciler.(*Reconciler).Config.LifecyclePolicy = &config.ProjectLifecyclePolicy{
+						NotificationDays:   staleGracePeriodDays,
+						ApprovalWindowDays: staleExpirationTimeDays - staleGracePeriodDays,
+						Action:             config.ProjectLifecycleActionArchive,
 					}
-					k8sGardenRuntimeClient.EXPECT().Patch(gomock.Any(), projectCopy, gomock.Any())
-					k8sGardenRuntimeClient.EXPECT().Delete(gomock.Any(), projectCopy)
+					project.Spec.Owner = &rbacv1.Subject{Kind: rbacv1.UserKind, Name: "owner"}
+
+					staleSinceTimestamp = metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour * time.Duration(staleGracePeriodDays))}
+					project.Status.StaleSinceTimestamp = &staleSinceTimestamp
+
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialShootMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialBackupEntryMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
+				})
+
+				It("should notify the owner but not archive the project before the approval window is exceeded", func() {
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, &staleSinceTimestamp, nil, fakeClock, "OwnerNotified")
+
+					_, result := reconciler.Reconcile(ctx, request)
+					Expect(result).To(Succeed())
+					Expect(fakeRecorder.Events).To(Receive(HavePrefix("Warning ProjectInactive Attention owner: Project is not in use since")))
+				})
+
+				It("should archive the project because the approval window is exceeded", func() {
+					staleSinceTimestamp = metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour * time.Duration(staleExpirationTimeDays))}
+					project.Status.StaleSinceTimestamp = &staleSinceTimestamp
+
+					k8sGardenRuntimeClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.Project{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						project := obj.(*gardencorev1beta1.Project)
+						Expect(project.Status.StaleAutoDeleteTimestamp).To(BeNil())
+						Expect(project.Status.Conditions).To(ContainCondition(OfType(gardencorev1beta1.ProjectInactive), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("Archived")))
+						Expect(project.Status.Conditions).To(ContainCondition(OfType(gardencorev1beta1.ProjectArchived), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("Archived")))
+						return nil
+					})
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
+					Expect(fakeRecorder.Events).To(Receive(HavePrefix("Warning ProjectArchived Attention owner:")))
+				})
+
+				It("should neither archive nor delete the project if no action is configured", func() {
+					reconciler.(*Reconciler).Config.LifecyclePolicy.Action = config.ProjectLifecycleActionNone
+					staleSinceTimestamp = metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour * 3 * time.Duration(staleExpirationTimeDays))}
+					project.Status.StaleSinceTimestamp = &staleSinceTimestamp
+					project.Status.StaleAutoDeleteTimestamp = &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)}
+
+					k8sGardenRuntimeClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.Project{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						project := obj.(*gardencorev1beta1.Project)
+						Expect(project.Status.StaleAutoDeleteTimestamp).To(BeNil())
+						Expect(project.Status.Conditions).To(ContainCondition(OfType(gardencorev1beta1.ProjectInactive), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("OwnerNotified")))
+						Expect(project.Status.Conditions).NotTo(ContainCondition(OfType(gardencorev1beta1.ProjectArchived)))
+						return nil
+					})
+
+					_, result := reconciler.Reconcile(ctx, request)
+					Expect(result).To(Succeed())
+				})
+
+				It("should not emit an event again if the project already is in the same stage", func() {
+					project.Status.Conditions = []gardencorev1beta1.Condition{{
+						Type:    gardencorev1beta1.ProjectInactive,
+						Status:  gardencorev1beta1.ConditionTrue,
+						Reason:  "OwnerNotified",
+						Message: "outdated message",
+					}}
+
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, &staleSinceTimestamp, nil, fakeClock, "OwnerNotified")
+
+					_, result := reconciler.Reconcile(ctx, request)
+					Expect(result).To(Succeed())
+					Expect(fakeRecorder.Events).NotTo(Receive())
 				})
 			})
+
+			It("should unarchive the project if it is used again", func() {
+				project.Status.Conditions = []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.ProjectInactive, Status: gardencorev1beta1.ConditionTrue, Reason: "Archived"},
+					{Type: gardencorev1beta1.ProjectArchived, Status: gardencorev1beta1.ConditionTrue, Reason: "Archived"},
+				}
+
+				k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialShootMetaList, client.InNamespace(namespaceName), client.Limit(1)).DoAndReturn(func(ctx context.Context, list *metav1.PartialObjectMetadataList, opts ...client.ListOption) error {
+					(&metav1.PartialObjectMetadataList{Items: []metav1.PartialObjectMetadata{*partialObjectMetadata}}).DeepCopyInto(list)
+					return nil
+				})
+
+				k8sGardenRuntimeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.Project{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+					project := obj.(*gardencorev1beta1.Project)
+					Expect(project.Status.Conditions).To(ContainCondition(OfType(gardencorev1beta1.ProjectInactive), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("ProjectActive")))
+					Expect(project.Status.Conditions).To(ContainCondition(OfType(gardencorev1beta1.ProjectArchived), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("ProjectActive")))
+					return nil
+				})
+
+				_, result := reconciler.Reconcile(ctx, request)
+				Expect(result).To(Succeed())
+				Expect(fakeRecorder.Events).To(Receive(Equal("Normal ProjectUnarchived Project was reactivated, new Shoots can be created again")))
+			})
 		})
 	})
 })
 
-func expectNonStaleMarking(k8sGardenRuntimeClient *mockclient.MockClient, mockStatusWriter *mockclient.MockStatusWriter, project *gardencorev1beta1.Project) {
+func expectNonStaleMarking(k8sGardenRuntimeClient *mockclient.MockClient, mockStatusWriter *mockclient.MockStatusWriter, reason string) {
 	k8sGardenRuntimeClient.EXPECT().Status().Return(mockStatusWriter)
 
-	projectPatched := project.DeepCopy()
-	projectPatched.Status.StaleSinceTimestamp = nil
-	projectPatched.Status.StaleAutoDeleteTimestamp = nil
-
-	test.EXPECTStatusPatch(gomock.Any(), mockStatusWriter, projectPatched, project, types.MergePatchType)
+	mockStatusWriter.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.Project{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+		project := obj.(*gardencorev1beta1.Project)
+		Expect(project.Status.StaleSinceTimestamp).To(BeNil())
+		Expect(project.Status.StaleAutoDeleteTimestamp).To(BeNil())
+		Expect(project.Status.Conditions).To(ContainCondition(OfType(gardencorev1beta1.ProjectInactive), WithStatus(gardencorev1beta1.ConditionFalse), WithReason(reason)))
+		return nil
+	})
 }
 
-func expectStaleMarking(k8sGardenRuntimeClient *mockclient.MockClient, mockStatusWriter *mockclient.MockStatusWriter, project *gardencorev1beta1.Project, staleSinceTimestamp, staleAutoDeleteTimestamp *metav1.Time, fakeClock *testing.FakeClock) {
+func expectStaleMarking(k8sGardenRuntimeClient *mockclient.MockClient, mockStatusWriter *mockclient.MockStatusWriter, staleSinceTimestamp, staleAutoDeleteTimestamp *metav1.Time, fakeClock *testing.FakeClock, reason string) {
 	k8sGardenRuntimeClient.EXPECT().Status().Return(mockStatusWriter)
 
-	projectPatched := project.DeepCopy()
 	if staleSinceTimestamp == nil {
-		projectPatched.Status.StaleSinceTimestamp = &metav1.Time{Time: fakeClock.Now()}
-	} else {
-		projectPatched.Status.StaleSinceTimestamp = staleSinceTimestamp
+		staleSinceTimestamp = &metav1.Time{Time: fakeClock.Now()}
 	}
-	projectPatched.Status.StaleAutoDeleteTimestamp = staleAutoDeleteTimestamp
 
-	test.EXPECTStatusPatch(gomock.Any(), mockStatusWriter, projectPatched, project, types.MergePatchType)
+	mockStatusWriter.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.Project{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+		project := obj.(*gardencorev1beta1.Project)
+		Expect(project.Status.StaleSinceTimestamp).To(PointTo(Equal(*staleSinceTimestamp)))
+		Expect(project.Status.StaleAutoDeleteTimestamp).To(Equal(staleAutoDeleteTimestamp))
+		Expect(project.Status.Conditions).To(ContainCondition(OfType(gardencorev1beta1.ProjectInactive), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(reason)))
+		return nil
+	})
 }
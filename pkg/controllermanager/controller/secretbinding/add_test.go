@@ -0,0 +1,83 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbinding
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{SecretBindingName: pointer.String("secretbinding")},
+		}
+	})
+
+	Describe("#ShootSecretBindingNamePredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootSecretBindingNamePredicate()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for update events if the secretbinding name is unchanged", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Purpose = nil
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return true for update events if the secretbinding name has changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.SecretBindingName = pointer.String("other")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapShootToSecretBinding", func() {
+		It("should map the shoot to the referenced secretbinding", func() {
+			Expect(reconciler.MapShootToSecretBinding(context.TODO(), shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-foo", Name: "secretbinding"}},
+			))
+		})
+
+		It("should return nothing if the shoot does not reference a secretbinding", func() {
+			shoot.Spec.SecretBindingName = nil
+			Expect(reconciler.MapShootToSecretBinding(context.TODO(), shoot)).To(BeEmpty())
+		})
+	})
+})
@@ -17,6 +17,8 @@ package secretbinding
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +30,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
@@ -188,9 +191,47 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
+	if err := r.updateUsageAnnotations(ctx, secretBinding); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// updateUsageAnnotations maintains annotations on the SecretBinding which contain the number and the names of the
+// Shoots using it.
+func (r *Reconciler) updateUsageAnnotations(ctx context.Context, secretBinding *gardencorev1beta1.SecretBinding) error {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(secretBinding.Namespace), client.MatchingFields{core.ShootSecretBindingName: secretBinding.Name}); err != nil {
+		return fmt.Errorf("failed to list Shoots using SecretBinding: %w", err)
+	}
+
+	shootNames := make([]string, 0, len(shootList.Items))
+	for _, shoot := range shootList.Items {
+		shootNames = append(shootNames, shoot.Name)
+	}
+	sort.Strings(shootNames)
+
+	var (
+		shootCount = strconv.Itoa(len(shootNames))
+		shoots     = strings.Join(shootNames, ",")
+	)
+
+	if secretBinding.Annotations[v1beta1constants.AnnotationSecretBindingShootCount] == shootCount &&
+		secretBinding.Annotations[v1beta1constants.AnnotationSecretBindingShoots] == shoots {
+		return nil
+	}
+
+	patch := client.MergeFrom(secretBinding.DeepCopy())
+	metav1.SetMetaDataAnnotation(&secretBinding.ObjectMeta, v1beta1constants.AnnotationSecretBindingShootCount, shootCount)
+	metav1.SetMetaDataAnnotation(&secretBinding.ObjectMeta, v1beta1constants.AnnotationSecretBindingShoots, shoots)
+	if err := r.Client.Patch(ctx, secretBinding, patch); err != nil {
+		return fmt.Errorf("failed to update usage annotations of SecretBinding: %w", err)
+	}
+
+	return nil
+}
+
 // We may only release a secret if there is no other secretbinding that references it (maybe in a different namespace).
 func (r *Reconciler) mayReleaseSecret(ctx context.Context, secretBindingNamespace, secretBindingName, secretNamespace, secretName string) (bool, error) {
 	secretBindingList := &gardencorev1beta1.SecretBindingList{}
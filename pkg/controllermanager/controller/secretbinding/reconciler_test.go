@@ -27,6 +27,8 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 )
@@ -41,7 +43,10 @@ var _ = Describe("SecretBindingControl", func() {
 		testScheme := runtime.NewScheme()
 		Expect(kubernetes.AddGardenSchemeToScheme(testScheme)).To(Succeed())
 
-		fakeClient = fakeclient.NewClientBuilder().WithScheme(testScheme).Build()
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(testScheme).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSecretBindingName, indexer.ShootSecretBindingNameIndexerFunc).
+			Build()
 	})
 
 	Describe("#mayReleaseSecret", func() {
@@ -336,4 +341,67 @@ var _ = Describe("SecretBindingControl", func() {
 			Expect(quota2.ObjectMeta.Labels).To(BeEmpty())
 		})
 	})
+
+	Describe("Usage annotations", func() {
+		var (
+			secretBinding *gardencorev1beta1.SecretBinding
+			reconciler    reconcile.Reconciler
+			request       reconcile.Request
+		)
+
+		newShoot := func(name, secretBindingName string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SecretBindingName: &secretBindingName},
+			}
+		}
+
+		BeforeEach(func() {
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "garden-foo"}}
+			secretBinding = &gardencorev1beta1.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "secretbinding", Namespace: "garden-foo"},
+				SecretRef:  corev1.SecretReference{Name: secret.Name, Namespace: secret.Namespace},
+			}
+
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+			Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+
+			reconciler = &Reconciler{Client: fakeClient}
+			request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secretBinding)}
+		})
+
+		It("should annotate the secretbinding with the shoots using it", func() {
+			Expect(fakeClient.Create(ctx, newShoot("shoot2", secretBinding.Name))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newShoot("shoot1", secretBinding.Name))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newShoot("shoot3", "other"))).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secretBinding), secretBinding)).To(Succeed())
+			Expect(secretBinding.Annotations).To(And(
+				HaveKeyWithValue("secretbinding.gardener.cloud/shoot-count", "2"),
+				HaveKeyWithValue("secretbinding.gardener.cloud/shoots", "shoot1,shoot2"),
+			))
+		})
+
+		It("should reset the annotations when no shoot uses the secretbinding anymore", func() {
+			shoot := newShoot("shoot1", secretBinding.Name)
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secretBinding), secretBinding)).To(Succeed())
+			Expect(secretBinding.Annotations).To(And(
+				HaveKeyWithValue("secretbinding.gardener.cloud/shoot-count", "0"),
+				HaveKeyWithValue("secretbinding.gardener.cloud/shoots", ""),
+			))
+		})
+	})
 })
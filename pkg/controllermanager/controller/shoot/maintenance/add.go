@@ -15,23 +15,31 @@
 package maintenance
 
 import (
+	"context"
+
+	"github.com/go-logr/logr"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
 )
 
 // ControllerName is the name of this controller.
 const ControllerName = "shoot-maintenance"
 
 // AddToManager adds Reconciler to the given manager.
-func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
@@ -42,14 +50,31 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 	}
 
-	return builder.
+	c, err := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(r.ShootPredicate())).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: pointer.IntDeref(r.Config.ConcurrentSyncs, 0),
 		}).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.CloudProfile{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapCloudProfileToShoots), mapper.UpdateWithNew, c.GetLogger()),
+		r.CloudProfilePredicate(),
+	); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.Project{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapProjectToShoots), mapper.UpdateWithNew, c.GetLogger()),
+		r.ProjectPredicate(),
+	)
 }
 
 // ShootPredicate returns the predicates for the core.gardener.cloud/v1beta1.Shoot watch.
@@ -67,7 +92,94 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			}
 
 			return (hasMaintainNowAnnotation(shoot) && !hasMaintainNowAnnotation(oldShoot)) ||
-				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.TimeWindow, shoot.Spec.Maintenance.TimeWindow)
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.TimeWindow, shoot.Spec.Maintenance.TimeWindow) ||
+				// Changed vulnerability scan results might require updates of the machine image versions outside of the
+				// maintenance time window.
+				!apiequality.Semantic.DeepEqual(oldShoot.Status.VulnerabilityScanResults, shoot.Status.VulnerabilityScanResults)
+		},
+	}
+}
+
+// CloudProfilePredicate returns true for 'UPDATE' events of CloudProfiles whose machine image vulnerabilities changed.
+// This allows updating machine image versions affected by critical vulnerabilities outside of the maintenance time
+// window.
+func (r *Reconciler) CloudProfilePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			cloudProfile, ok := e.ObjectNew.(*gardencorev1beta1.CloudProfile)
+			if !ok {
+				return false
+			}
+
+			oldCloudProfile, ok := e.ObjectOld.(*gardencorev1beta1.CloudProfile)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(machineImageVulnerabilities(oldCloudProfile), machineImageVulnerabilities(cloudProfile))
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// ProjectPredicate returns true for 'UPDATE' events of Projects which opted in for critical vulnerability updates.
+func (r *Reconciler) ProjectPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+
+			return e.ObjectOld.GetAnnotations()[v1beta1constants.ProjectCriticalVulnerabilityUpdates] != e.ObjectNew.GetAnnotations()[v1beta1constants.ProjectCriticalVulnerabilityUpdates]
 		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// MapCloudProfileToShoots is a mapper.MapFunc for mapping a CloudProfile to all Shoots using it.
+func (r *Reconciler) MapCloudProfileToShoots(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := reader.List(ctx, shootList); err != nil {
+		log.Error(err, "Failed to list shoots")
+		return nil
+	}
+
+	return mapper.ObjectListToRequests(shootList, func(o client.Object) bool {
+		shoot, ok := o.(*gardencorev1beta1.Shoot)
+		return ok && shoot.Spec.CloudProfileName == obj.GetName()
+	})
+}
+
+// MapProjectToShoots is a mapper.MapFunc for mapping a Project to all Shoots in its namespace.
+func (r *Reconciler) MapProjectToShoots(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	project, ok := obj.(*gardencorev1beta1.Project)
+	if !ok || project.Spec.Namespace == nil {
+		return nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := reader.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		log.Error(err, "Failed to list shoots")
+		return nil
 	}
+
+	return mapper.ObjectListToRequests(shootList)
+}
+
+func machineImageVulnerabilities(cloudProfile *gardencorev1beta1.CloudProfile) map[string][]gardencorev1beta1.MachineImageVulnerability {
+	vulnerabilities := make(map[string][]gardencorev1beta1.MachineImageVulnerability)
+
+	for _, machineImage := range cloudProfile.Spec.MachineImages {
+		for _, version := range machineImage.Versions {
+			if len(version.Vulnerabilities) > 0 {
+				vulnerabilities[machineImage.Name+"/"+version.Version] = version.Vulnerabilities
+			}
+		}
+	}
+
+	return vulnerabilities
 }
@@ -92,6 +92,12 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Maintenance.TimeWindow.End = "456"
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			It("should return true because the vulnerability scan results changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.VulnerabilityScanResults = []gardencorev1beta1.VulnerabilityScanResult{{WorkerPool: "worker", MachineImageName: "gardenlinux", MachineImageVersion: "1312.2.0"}}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Delete", func() {
@@ -106,4 +112,63 @@ var _ = Describe("Add", func() {
 			})
 		})
 	})
+
+	Describe("CloudProfilePredicate", func() {
+		var (
+			p            predicate.Predicate
+			cloudProfile *gardencorev1beta1.CloudProfile
+		)
+
+		BeforeEach(func() {
+			p = reconciler.CloudProfilePredicate()
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name:     "gardenlinux",
+						Versions: []gardencorev1beta1.MachineImageVersion{{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}}},
+					}},
+				},
+			}
+		})
+
+		It("should return false for create, delete and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: cloudProfile})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: cloudProfile})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: cloudProfile})).To(BeFalse())
+		})
+
+		It("should return false because the vulnerabilities did not change", func() {
+			oldCloudProfile := cloudProfile.DeepCopy()
+			cloudProfile.Spec.MachineImages[0].Versions[0].Architectures = []string{"amd64"}
+			Expect(p.Update(event.UpdateEvent{ObjectNew: cloudProfile, ObjectOld: oldCloudProfile})).To(BeFalse())
+		})
+
+		It("should return true because the vulnerabilities changed", func() {
+			oldCloudProfile := cloudProfile.DeepCopy()
+			cloudProfile.Spec.MachineImages[0].Versions[0].Vulnerabilities = []gardencorev1beta1.MachineImageVulnerability{{ID: "CVE-2023-12345", Severity: "critical"}}
+			Expect(p.Update(event.UpdateEvent{ObjectNew: cloudProfile, ObjectOld: oldCloudProfile})).To(BeTrue())
+		})
+	})
+
+	Describe("ProjectPredicate", func() {
+		var (
+			p       predicate.Predicate
+			project *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ProjectPredicate()
+			project = &gardencorev1beta1.Project{}
+		})
+
+		It("should return false because the annotation did not change", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectNew: project, ObjectOld: project.DeepCopy()})).To(BeFalse())
+		})
+
+		It("should return true because the annotation changed", func() {
+			oldProject := project.DeepCopy()
+			metav1.SetMetaDataAnnotation(&project.ObjectMeta, "project.gardener.cloud/critical-vulnerability-updates", "true")
+			Expect(p.Update(event.UpdateEvent{ObjectNew: project, ObjectOld: oldProject})).To(BeTrue())
+		})
+	})
 })
@@ -27,6 +27,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
@@ -76,6 +78,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	requeueAfter, nextMaintenance := requeueAfterDuration(shoot)
 
 	if !mustMaintainNow(shoot, r.Clock) {
+		if err := r.reconcileCriticalVulnerabilityUpdates(ctx, log, shoot); err != nil {
+			return reconcile.Result{}, err
+		}
+
 		log.V(1).Info("Skipping Shoot because it doesn't need to be maintained now")
 		log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
@@ -122,17 +128,37 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	workerToKubernetesUpdate := make(map[string]updateResult)
 	workerToMachineImageUpdate := make(map[string]updateResult)
 
+	// Updates which roll the nodes are postponed as long as PodDisruptionBudgets block the drain of nodes, since they
+	// would not succeed anyway.
+	nodeDrainBlockedMessage := nodeDrainBlocked(shoot)
+
 	cloudProfile := &gardencorev1beta1.CloudProfile{}
 	if err = r.Client.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
 		return err
 	}
 
 	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
+		forceCriticalVulnerabilityUpdates, err := r.criticalVulnerabilityUpdatesRequired(ctx, shoot, cloudProfile)
+		if err != nil {
+			return err
+		}
+
+		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile, machineImageMaintenanceOptions{
+			forceCriticalVulnerabilityUpdates: forceCriticalVulnerabilityUpdates,
+			vulnerabilitySeverityThreshold:    r.vulnerabilitySeverityThreshold(),
+		})
 		if err != nil {
 			// continue execution to allow the kubernetes version update
 			log.Error(err, "Failed to maintain Shoot machine images")
 		}
+
+		if nodeDrainBlockedMessage != "" && !forceCriticalVulnerabilityUpdates && hasSuccessfulUpdate(workerToMachineImageUpdate) {
+			for i := range maintainedShoot.Spec.Provider.Workers {
+				maintainedShoot.Spec.Provider.Workers[i].Machine.Image = shoot.Spec.Provider.Workers[i].Machine.Image.DeepCopy()
+			}
+			workerToMachineImageUpdate = make(map[string]updateResult)
+			operations = append(operations, fmt.Sprintf("Postponing machine image updates to the next maintenance window because the drain of nodes is blocked: %s", nodeDrainBlockedMessage))
+		}
 	}
 
 	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) error {
@@ -144,6 +170,22 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		log.Error(err, "Failed to maintain Shoot kubernetes version")
 	}
 
+	if nodeDrainBlockedMessage != "" && !v1beta1helper.IsWorkerless(shoot) && kubernetesControlPlaneUpdate != nil && kubernetesControlPlaneUpdate.isSuccessful &&
+		isMinorVersionUpdate(shoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Kubernetes.Version) {
+		operations = append(operations, fmt.Sprintf("Postponing Kubernetes update to %q to the next maintenance window because the drain of nodes is blocked: %s", maintainedShoot.Spec.Kubernetes.Version, nodeDrainBlockedMessage))
+		maintainedShoot.Spec.Kubernetes.Version = shoot.Spec.Kubernetes.Version
+		kubernetesControlPlaneUpdate = nil
+	}
+
+	// Minor version updates are denied by the shoot admission plugin as long as the upgrade pre-flight checks report
+	// blocking findings. Hence, only this update is postponed so that the other maintenance operations are still applied.
+	if upgradePreflightBlockedMessage := upgradePreflightBlocked(shoot); upgradePreflightBlockedMessage != "" && kubernetesControlPlaneUpdate != nil && kubernetesControlPlaneUpdate.isSuccessful &&
+		isMinorVersionUpdate(shoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Kubernetes.Version) {
+		operations = append(operations, fmt.Sprintf("Postponing Kubernetes update to %q to the next maintenance window because the upgrade pre-flight checks reported blocking findings: %s", maintainedShoot.Spec.Kubernetes.Version, upgradePreflightBlockedMessage))
+		maintainedShoot.Spec.Kubernetes.Version = shoot.Spec.Kubernetes.Version
+		kubernetesControlPlaneUpdate = nil
+	}
+
 	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
 	if err != nil {
 		return err
@@ -209,6 +251,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
 		}
 
+		if oldVersion := shoot.Spec.Provider.Workers[i].Kubernetes.Version; nodeDrainBlockedMessage != "" && workerKubernetesUpdate != nil && workerKubernetesUpdate.isSuccessful &&
+			isMinorVersionUpdate(*oldVersion, *maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version) {
+			operations = append(operations, fmt.Sprintf("Postponing Kubernetes update of worker pool %q to %q to the next maintenance window because the drain of nodes is blocked: %s", pool.Name, *maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version, nodeDrainBlockedMessage))
+			maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version = pointer.String(*oldVersion)
+			continue
+		}
+
 		if workerKubernetesUpdate != nil {
 			result := updateResult{
 				reason: workerKubernetesUpdate.reason,
@@ -219,6 +268,25 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
+	if !v1beta1helper.IsWorkerless(shoot) && pointer.BoolDeref(maintainedShoot.Spec.Maintenance.AutoUpdate.DeprecatedTypes, false) {
+		reasonsForTypeMigration := maintainDeprecatedTypes(maintainedShoot, cloudProfile)
+		if nodeDrainBlockedMessage != "" && len(reasonsForTypeMigration) > 0 {
+			for i := range maintainedShoot.Spec.Provider.Workers {
+				maintainedShoot.Spec.Provider.Workers[i].Machine.Type = shoot.Spec.Provider.Workers[i].Machine.Type
+				maintainedShoot.Spec.Provider.Workers[i].Volume = shoot.Spec.Provider.Workers[i].Volume.DeepCopy()
+				maintainedShoot.Spec.Provider.Workers[i].DataVolumes = slices.Clone(shoot.Spec.Provider.Workers[i].DataVolumes)
+			}
+			operations = append(operations, fmt.Sprintf("Postponing migration of deprecated machine and volume types to the next maintenance window because the drain of nodes is blocked: %s", nodeDrainBlockedMessage))
+		} else {
+			operations = append(operations, reasonsForTypeMigration...)
+		}
+	}
+
+	if operation := maintainServiceAccountKeyRotation(maintainedShoot, r.Clock.Now()); operation != "" {
+		metav1.SetMetaDataAnnotation(&maintainedShoot.ObjectMeta, v1beta1constants.GardenerMaintenanceOperation, operation)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerMaintenanceOperation, operation)
+	}
+
 	operation := maintainOperation(maintainedShoot)
 	if operation != "" {
 		operations = append(operations, fmt.Sprintf("Added %q operation annotation", operation))
@@ -282,6 +350,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
+	maintenanceRecords := buildMaintenanceRecords(shoot, maintainedShoot, r.Clock.Now(), false, kubernetesControlPlaneUpdate, workerToKubernetesUpdate, workerToMachineImageUpdate)
+
 	// update shoot spec changes in maintenance call
 	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
 	_ = maintainOperation(shoot)
@@ -294,13 +364,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		return err
 	}
 
-	if shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing {
-		patch := client.MergeFrom(shoot.DeepCopy())
-		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
-
-		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-			return err
-		}
+	if err := r.completeMaintenance(ctx, shoot, maintenanceRecords); err != nil {
+		return err
 	}
 
 	// make sure to report (partial) maintenance failures
@@ -319,8 +384,113 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	return nil
 }
 
+// reconcileCriticalVulnerabilityUpdates updates the machine image versions of the Shoot's worker pools which are
+// affected by critical vulnerabilities outside of the maintenance time window if the Shoot's project opted in.
+func (r *Reconciler) reconcileCriticalVulnerabilityUpdates(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	if v1beta1helper.IsWorkerless(shoot) {
+		return nil
+	}
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := r.Client.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
+		return err
+	}
+
+	required, err := r.criticalVulnerabilityUpdatesRequired(ctx, shoot, cloudProfile)
+	if err != nil || !required {
+		return err
+	}
+
+	log.Info("Maintaining machine images affected by critical vulnerabilities outside of the maintenance time window")
+
+	maintainedShoot := shoot.DeepCopy()
+	workerToMachineImageUpdate, err := maintainMachineImages(log, maintainedShoot, cloudProfile, machineImageMaintenanceOptions{
+		forceCriticalVulnerabilityUpdates: true,
+		onlyCriticalVulnerabilityUpdates:  true,
+		vulnerabilitySeverityThreshold:    r.vulnerabilitySeverityThreshold(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to maintain machine images affected by critical vulnerabilities: %w", err)
+	}
+
+	if len(workerToMachineImageUpdate) == 0 {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	description, failureReason := buildMaintenanceMessages(nil, nil, workerToMachineImageUpdate)
+	shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
+		Description:   description,
+		TriggeredTime: metav1.Time{Time: r.Clock.Now()},
+		State:         gardencorev1beta1.LastOperationStateProcessing,
+		OutOfWindow:   pointer.Bool(true),
+	}
+
+	if failureReason != "" {
+		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+		shoot.Status.LastMaintenance.FailureReason = &failureReason
+	}
+
+	if err := r.Client.Update(ctx, maintainedShoot.DeepCopy(), &client.UpdateOptions{
+		DryRun: []string{metav1.DryRunAll},
+	}); err != nil {
+		shoot.Status.LastMaintenance.Description = "Maintenance failed"
+		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+		shoot.Status.LastMaintenance.FailureReason = pointer.String(fmt.Sprintf("Updates to the Shoot failed to be applied: %s", err.Error()))
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+
+		log.Info("Shoot maintenance of machine images affected by critical vulnerabilities failed", "reason", err)
+		return nil
+	}
+
+	if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	maintenanceRecords := buildMaintenanceRecords(shoot, maintainedShoot, r.Clock.Now(), true, nil, nil, workerToMachineImageUpdate)
+
+	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
+	// Spec changes of Shoots which confine the rollout to the maintenance time window must be rolled out explicitly.
+	if pointer.BoolDeref(shoot.Spec.Maintenance.ConfineSpecUpdateRollout, false) && shoot.Annotations[v1beta1constants.GardenerOperation] == "" {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	}
+
+	if err := r.Client.Update(ctx, shoot); err != nil {
+		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootMaintenanceFailed, err.Error())
+		return err
+	}
+
+	if err := r.completeMaintenance(ctx, shoot, maintenanceRecords); err != nil {
+		return err
+	}
+
+	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionVulnerabilityMaintenance, "Machine image")
+
+	log.Info("Shoot maintenance of machine images affected by critical vulnerabilities completed")
+	return nil
+}
+
 // buildMaintenanceMessages builds a combined message containing the performed maintenance operations over all worker pools. If the maintenance operation failed, the description
 // contains an indication for the failure and the reason the update was triggered. Details for failed maintenance operations are returned in the second return string.
+// completeMaintenance marks a processing maintenance as succeeded and adds the records of the performed changes to the
+// maintenance history of the Shoot after the maintained specification was applied.
+func (r *Reconciler) completeMaintenance(ctx context.Context, shoot *gardencorev1beta1.Shoot, maintenanceRecords []gardencorev1beta1.MaintenanceRecord) error {
+	processing := shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing
+	if !processing && len(maintenanceRecords) == 0 {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	if processing {
+		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
+	}
+	shoot.Status.MaintenanceHistory = appendMaintenanceRecords(shoot.Status.MaintenanceHistory, maintenanceRecords...)
+
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
 func buildMaintenanceMessages(kubernetesControlPlaneUpdate *updateResult, workerToKubernetesUpdate map[string]updateResult, workerToMachineImageUpdate map[string]updateResult) (string, string) {
 	countSuccessfulOperations := 0
 	countFailedOperations := 0
@@ -415,11 +585,69 @@ func maintainOperation(shoot *gardencorev1beta1.Shoot) string {
 	return operation
 }
 
+// maintainServiceAccountKeyRotation returns the operation for the automatic rotation of the service account signing key
+// if it is due, i.e., the rotation is started once the configured rotation period passed since the last completion and
+// completed once all tokens issued with the old signing key are expired.
+func maintainServiceAccountKeyRotation(shoot *gardencorev1beta1.Shoot, now time.Time) string {
+	kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+	if kubeAPIServer == nil || kubeAPIServer.ServiceAccountConfig == nil || kubeAPIServer.ServiceAccountConfig.SigningKeyRotationPeriod == nil {
+		return ""
+	}
+
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		return ""
+	}
+
+	if _, ok := shoot.Annotations[v1beta1constants.GardenerMaintenanceOperation]; ok {
+		return ""
+	}
+
+	var rotation *gardencorev1beta1.ServiceAccountKeyRotation
+	if shoot.Status.Credentials != nil && shoot.Status.Credentials.Rotation != nil {
+		rotation = shoot.Status.Credentials.Rotation.ServiceAccountKey
+	}
+
+	switch v1beta1helper.GetShootServiceAccountKeyRotationPhase(shoot.Status.Credentials) {
+	case "", gardencorev1beta1.RotationCompleted:
+		lastRotation := shoot.CreationTimestamp.Time
+		if rotation != nil && rotation.LastCompletionTime != nil {
+			lastRotation = rotation.LastCompletionTime.Time
+		}
+
+		if isShootReadyForRotationStart(shoot.Status.LastOperation) && !now.Before(lastRotation.Add(kubeAPIServer.ServiceAccountConfig.SigningKeyRotationPeriod.Duration)) {
+			return v1beta1constants.OperationRotateServiceAccountKeyStart
+		}
+
+	case gardencorev1beta1.RotationPrepared:
+		if rotation == nil || rotation.LastInitiationFinishedTime == nil || kubeAPIServer.ServiceAccountConfig.MaxTokenExpiration == nil {
+			return ""
+		}
+
+		// The old signing key must still be accepted until all tokens issued with it are expired.
+		if !now.Before(rotation.LastInitiationFinishedTime.Add(kubeAPIServer.ServiceAccountConfig.MaxTokenExpiration.Duration)) {
+			return v1beta1constants.OperationRotateServiceAccountKeyComplete
+		}
+	}
+
+	return ""
+}
+
+func isShootReadyForRotationStart(lastOperation *gardencorev1beta1.LastOperation) bool {
+	if lastOperation == nil {
+		return false
+	}
+	if (lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate || lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore) && lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
+		return true
+	}
+	return lastOperation.Type == gardencorev1beta1.LastOperationTypeReconcile
+}
+
 func maintainTasks(shoot *gardencorev1beta1.Shoot, config config.ShootMaintenanceControllerConfiguration) {
 	controllerutils.AddTasks(shoot.Annotations,
 		v1beta1constants.ShootTaskDeployInfrastructure,
 		v1beta1constants.ShootTaskDeployDNSRecordInternal,
 		v1beta1constants.ShootTaskDeployDNSRecordExternal,
+		v1beta1constants.ShootTaskDeployDNSRecordPrivate,
 		v1beta1constants.ShootTaskDeployDNSRecordIngress,
 	)
 
@@ -432,8 +660,20 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config config.ShootMaintenanc
 	}
 }
 
+// machineImageMaintenanceOptions controls how the machine images of a Shoot's worker pools are maintained.
+type machineImageMaintenanceOptions struct {
+	// forceCriticalVulnerabilityUpdates forces updates of machine image versions affected by critical vulnerabilities.
+	forceCriticalVulnerabilityUpdates bool
+	// onlyCriticalVulnerabilityUpdates restricts the maintenance to worker pools using machine image versions affected
+	// by critical vulnerabilities, i.e., neither automatic updates nor forceful updates of expired versions are performed.
+	onlyCriticalVulnerabilityUpdates bool
+	// vulnerabilitySeverityThreshold is the minimum severity of vulnerabilities reported by vulnerability scans of the
+	// worker pools which are treated like critical vulnerabilities. If it is nil, the scan results are not considered.
+	vulnerabilitySeverityThreshold *gardencorev1beta1.VulnerabilitySeverity
+}
+
 // maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
+func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, opts machineImageMaintenanceOptions) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
@@ -450,6 +690,18 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 			return nil, err
 		}
 
+		var criticalVulnerabilities []string
+		if opts.forceCriticalVulnerabilityUpdates {
+			criticalVulnerabilities = getCriticalVulnerabilities(machineImageFromCloudProfile, workerImage.Version)
+			criticalVulnerabilities = append(criticalVulnerabilities, getScannedVulnerabilities(shoot.Status.VulnerabilityScanResults, worker.Name, workerImage, opts.vulnerabilitySeverityThreshold)...)
+			if len(criticalVulnerabilities) > 0 {
+				criticalVulnerabilities = sets.List(sets.New(criticalVulnerabilities...))
+			}
+		}
+		if opts.onlyCriticalVulnerabilityUpdates && len(criticalVulnerabilities) == 0 {
+			continue
+		}
+
 		kubeletVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(controlPlaneVersion, worker.Kubernetes)
 		if err != nil {
 			return nil, err
@@ -458,9 +710,13 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 		filteredMachineImageVersionsFromCloudProfile := filterForArchitecture(&machineImageFromCloudProfile, worker.Machine.Architecture)
 		filteredMachineImageVersionsFromCloudProfile = filterForCRI(filteredMachineImageVersionsFromCloudProfile, worker.CRI)
 		filteredMachineImageVersionsFromCloudProfile = filterForKubeleteVersionConstraint(filteredMachineImageVersionsFromCloudProfile, kubeletVersion)
+		filteredMachineImageVersionsFromCloudProfile = filterForCriticalVulnerabilities(filteredMachineImageVersionsFromCloudProfile, workerImage.Version)
+		filteredMachineImageVersionsFromCloudProfile = pinToPatchStream(filteredMachineImageVersionsFromCloudProfile, worker.MachineImageUpdatePolicy)
+
+		autoUpdate := *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion && !opts.onlyCriticalVulnerabilityUpdates
 
 		// first check if the machine image version should be updated
-		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, autoUpdate, criticalVulnerabilities)
 		if !shouldBeUpdated {
 			continue
 		}
@@ -570,6 +826,55 @@ func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool,
 	return false, "", false, nil
 }
 
+// nodeDrainBlocked returns the message of the PodDisruptionBudgetsAllowNodeDrain constraint if it reports that the
+// drain of nodes is blocked. Otherwise, an empty string is returned.
+// nodeDrainBlocked returns the message of the PodDisruptionBudgetsAllowNodeDrain constraint if it reports that the
+// drain of nodes is blocked, otherwise it returns an empty string.
+func nodeDrainBlocked(shoot *gardencorev1beta1.Shoot) string {
+	constraint := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain)
+	if constraint == nil || (constraint.Status != gardencorev1beta1.ConditionFalse && constraint.Status != gardencorev1beta1.ConditionProgressing) {
+		return ""
+	}
+	return constraint.Message
+}
+
+// upgradePreflightBlocked returns the message of the 'UpgradePreflightChecksPassed' constraint if it reports blocking
+// findings and the checks are not skipped for the given shoot. Otherwise, it returns an empty string.
+func upgradePreflightBlocked(shoot *gardencorev1beta1.Shoot) string {
+	if shoot.Annotations[v1beta1constants.AnnotationShootSkipUpgradePreflightChecks] == "true" {
+		return ""
+	}
+
+	constraint := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootUpgradePreflightChecksPassed)
+	if constraint == nil || (constraint.Status != gardencorev1beta1.ConditionFalse && constraint.Status != gardencorev1beta1.ConditionProgressing) {
+		return ""
+	}
+	return constraint.Message
+}
+
+func hasSuccessfulUpdate(updateResults map[string]updateResult) bool {
+	for _, result := range updateResults {
+		if result.isSuccessful {
+			return true
+		}
+	}
+	return false
+}
+
+// isMinorVersionUpdate returns true if the minor version of the given versions differs. In contrast to patch version
+// updates, such updates roll the nodes of the respective worker pools.
+func isMinorVersionUpdate(oldVersion, newVersion string) bool {
+	oldSemver, err := semver.NewVersion(oldVersion)
+	if err != nil {
+		return false
+	}
+	newSemver, err := semver.NewVersion(newVersion)
+	if err != nil {
+		return false
+	}
+	return oldSemver.Major() != newSemver.Major() || oldSemver.Minor() != newSemver.Minor()
+}
+
 func mustMaintainNow(shoot *gardencorev1beta1.Shoot, clock clock.Clock) bool {
 	return hasMaintainNowAnnotation(shoot) || gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, clock)
 }
@@ -667,6 +972,147 @@ func filterForKubeleteVersionConstraint(machineImageFromCloudProfile *gardencore
 	return &filteredMachineImages
 }
 
+// filterForCriticalVulnerabilities removes all versions affected by critical vulnerabilities except for the given
+// current version, so that they are not considered as update targets.
+func filterForCriticalVulnerabilities(machineImageFromCloudProfile *gardencorev1beta1.MachineImage, currentVersion *string) *gardencorev1beta1.MachineImage {
+	filteredMachineImages := gardencorev1beta1.MachineImage{
+		Name:           machineImageFromCloudProfile.Name,
+		UpdateStrategy: machineImageFromCloudProfile.UpdateStrategy,
+		Versions:       []gardencorev1beta1.MachineImageVersion{},
+	}
+
+	for _, cloudProfileVersion := range machineImageFromCloudProfile.Versions {
+		if (currentVersion != nil && cloudProfileVersion.Version == *currentVersion) || !hasCriticalVulnerabilities(cloudProfileVersion) {
+			filteredMachineImages.Versions = append(filteredMachineImages.Versions, cloudProfileVersion)
+		}
+	}
+
+	return &filteredMachineImages
+}
+
+// pinToPatchStream overwrites the update strategy of the machine image with "patch" if the worker pool is pinned to
+// the patch stream of its current machine image version.
+func pinToPatchStream(machineImageFromCloudProfile *gardencorev1beta1.MachineImage, policy *gardencorev1beta1.MachineImageUpdatePolicy) *gardencorev1beta1.MachineImage {
+	if policy == nil || !pointer.BoolDeref(policy.PinPatchStream, false) {
+		return machineImageFromCloudProfile
+	}
+
+	updateStrategy := gardencorev1beta1.UpdateStrategyPatch
+	pinnedMachineImage := machineImageFromCloudProfile.DeepCopy()
+	pinnedMachineImage.UpdateStrategy = &updateStrategy
+	return pinnedMachineImage
+}
+
+func hasCriticalVulnerabilities(version gardencorev1beta1.MachineImageVersion) bool {
+	return slices.ContainsFunc(version.Vulnerabilities, func(vulnerability gardencorev1beta1.MachineImageVulnerability) bool {
+		return vulnerability.Severity == gardencorev1beta1.VulnerabilitySeverityCritical
+	})
+}
+
+// getCriticalVulnerabilities returns the IDs of the critical vulnerabilities affecting the given version of the
+// machine image.
+func getCriticalVulnerabilities(machineImage gardencorev1beta1.MachineImage, version *string) []string {
+	if version == nil {
+		return nil
+	}
+
+	var ids []string
+	for _, cloudProfileVersion := range machineImage.Versions {
+		if cloudProfileVersion.Version != *version {
+			continue
+		}
+
+		for _, vulnerability := range cloudProfileVersion.Vulnerabilities {
+			if vulnerability.Severity == gardencorev1beta1.VulnerabilitySeverityCritical {
+				ids = append(ids, vulnerability.ID)
+			}
+		}
+	}
+
+	return ids
+}
+
+// vulnerabilitySeverityRanks orders the vulnerability severities for comparing them with the configured threshold.
+var vulnerabilitySeverityRanks = map[gardencorev1beta1.VulnerabilitySeverity]int{
+	gardencorev1beta1.VulnerabilitySeverityLow:      1,
+	gardencorev1beta1.VulnerabilitySeverityMedium:   2,
+	gardencorev1beta1.VulnerabilitySeverityHigh:     3,
+	gardencorev1beta1.VulnerabilitySeverityCritical: 4,
+}
+
+// getScannedVulnerabilities returns the IDs of the vulnerabilities with at least the given severity which the
+// vulnerability scans of the given worker pool found in its current machine image version.
+func getScannedVulnerabilities(results []gardencorev1beta1.VulnerabilityScanResult, workerPool string, machineImage *gardencorev1beta1.ShootMachineImage, threshold *gardencorev1beta1.VulnerabilitySeverity) []string {
+	if threshold == nil || machineImage == nil || machineImage.Version == nil {
+		return nil
+	}
+
+	var ids []string
+	for _, result := range results {
+		// Results for other machine image versions are outdated, e.g., because the worker pool was already updated.
+		if result.WorkerPool != workerPool || result.MachineImageName != machineImage.Name || result.MachineImageVersion != *machineImage.Version {
+			continue
+		}
+
+		for _, vulnerability := range result.Vulnerabilities {
+			if rank, ok := vulnerabilitySeverityRanks[vulnerability.Severity]; ok && rank >= vulnerabilitySeverityRanks[*threshold] {
+				ids = append(ids, vulnerability.ID)
+			}
+		}
+	}
+
+	return ids
+}
+
+// usesMachineImageVersionsWithCriticalVulnerabilities returns true if at least one worker pool of the Shoot uses a
+// machine image version affected by critical vulnerabilities, or by vulnerabilities exceeding the given severity
+// threshold according to the vulnerability scans of the worker pool.
+func usesMachineImageVersionsWithCriticalVulnerabilities(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, threshold *gardencorev1beta1.VulnerabilitySeverity) bool {
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Machine.Image == nil {
+			continue
+		}
+
+		found, machineImage := v1beta1helper.DetermineMachineImageForName(cloudProfile, worker.Machine.Image.Name)
+		if found && len(getCriticalVulnerabilities(machineImage, worker.Machine.Image.Version)) > 0 {
+			return true
+		}
+
+		if len(getScannedVulnerabilities(shoot.Status.VulnerabilityScanResults, worker.Name, worker.Machine.Image, threshold)) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vulnerabilitySeverityThreshold returns the configured minimum severity of vulnerabilities reported by vulnerability
+// scans which require forceful updates of the machine image versions.
+func (r *Reconciler) vulnerabilitySeverityThreshold() *gardencorev1beta1.VulnerabilitySeverity {
+	if r.Config.VulnerabilitySeverityThreshold == nil {
+		return nil
+	}
+
+	threshold := gardencorev1beta1.VulnerabilitySeverity(*r.Config.VulnerabilitySeverityThreshold)
+	return &threshold
+}
+
+// criticalVulnerabilityUpdatesRequired returns true if the Shoot uses machine image versions affected by critical
+// vulnerabilities and its project opted in for forceful updates of such versions.
+func (r *Reconciler) criticalVulnerabilityUpdatesRequired(ctx context.Context, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (bool, error) {
+	if !usesMachineImageVersionsWithCriticalVulnerabilities(shoot, cloudProfile, r.vulnerabilitySeverityThreshold()) {
+		return false, nil
+	}
+
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		return false, fmt.Errorf("failed reading project of shoot: %w", err)
+	}
+
+	enabled, _ := strconv.ParseBool(project.Annotations[v1beta1constants.ProjectCriticalVulnerabilityUpdates])
+	return enabled, nil
+}
+
 func findCRIByName(wanted gardencorev1beta1.CRIName, cris []gardencorev1beta1.CRI) (gardencorev1beta1.CRI, bool) {
 	for _, cri := range cris {
 		if cri.Name == wanted {
@@ -706,7 +1152,7 @@ func determineMachineImage(cloudProfile *gardencorev1beta1.CloudProfile, shootMa
 	return machineImageFromCloudProfile, nil
 }
 
-func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, autoUpdate bool) (shouldBeUpdated bool, reason string, isExpired bool) {
+func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, autoUpdate bool, criticalVulnerabilities []string) (shouldBeUpdated bool, reason string, isExpired bool) {
 	versionExistsInCloudProfile, versionIndex := v1beta1helper.ShootMachineImageVersionExists(*machineImage, *shootMachineImage)
 
 	var updateReason string
@@ -715,6 +1161,11 @@ func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.Sho
 		return true, updateReason, true
 	}
 
+	if len(criticalVulnerabilities) > 0 {
+		updateReason = fmt.Sprintf("Machine image version is affected by critical vulnerabilities (%s) - force update required (image update strategy: %s)", strings.Join(criticalVulnerabilities, ", "), *machineImage.UpdateStrategy)
+		return true, updateReason, true
+	}
+
 	if ExpirationDateExpired(machineImage.Versions[versionIndex].ExpirationDate) {
 		updateReason = fmt.Sprintf("Machine image version expired - force update required (image update strategy: %s)", *machineImage.UpdateStrategy)
 		return true, updateReason, true
@@ -856,3 +1307,231 @@ func ensureSufficientMaxWorkers(shoot *gardencorev1beta1.Shoot, reason string) [
 
 	return reasonsForUpdate
 }
+
+// maintainDeprecatedTypes migrates the worker pools which use deprecated machine or volume types to the successors
+// suggested in the CloudProfile. Successors which are not usable, do not match the machine architecture, or are
+// unavailable in one of the pool's zones are not considered.
+func maintainDeprecatedTypes(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) []string {
+	var reasonsForUpdate []string
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if successor := machineTypeSuccessor(cloudProfile, shoot.Spec.Region, worker); successor != "" {
+			reasonsForUpdate = append(reasonsForUpdate, fmt.Sprintf("Machine type of worker-pool %q migrated from deprecated type %q to its successor %q", worker.Name, worker.Machine.Type, successor))
+			shoot.Spec.Provider.Workers[i].Machine.Type = successor
+		}
+
+		if worker.Volume != nil && worker.Volume.Type != nil {
+			if successor := volumeTypeSuccessor(cloudProfile, shoot.Spec.Region, worker.Zones, *worker.Volume.Type); successor != "" {
+				reasonsForUpdate = append(reasonsForUpdate, fmt.Sprintf("Volume type of worker-pool %q migrated from deprecated type %q to its successor %q", worker.Name, *worker.Volume.Type, successor))
+				shoot.Spec.Provider.Workers[i].Volume.Type = pointer.String(successor)
+			}
+		}
+
+		for j, dataVolume := range worker.DataVolumes {
+			if dataVolume.Type == nil {
+				continue
+			}
+
+			if successor := volumeTypeSuccessor(cloudProfile, shoot.Spec.Region, worker.Zones, *dataVolume.Type); successor != "" {
+				reasonsForUpdate = append(reasonsForUpdate, fmt.Sprintf("Type of data volume %q of worker-pool %q migrated from deprecated type %q to its successor %q", dataVolume.Name, worker.Name, *dataVolume.Type, successor))
+				shoot.Spec.Provider.Workers[i].DataVolumes[j].Type = pointer.String(successor)
+			}
+		}
+	}
+
+	return reasonsForUpdate
+}
+
+// machineTypeSuccessor returns the successor of the worker pool's machine type if it is deprecated and the successor
+// can be used by the pool. Otherwise, it returns an empty string.
+func machineTypeSuccessor(cloudProfile *gardencorev1beta1.CloudProfile, region string, worker gardencorev1beta1.Worker) string {
+	var successor string
+	for _, machineType := range cloudProfile.Spec.MachineTypes {
+		if machineType.Name == worker.Machine.Type && machineType.Deprecation != nil {
+			successor = pointer.StringDeref(machineType.Deprecation.Successor, "")
+		}
+	}
+	if successor == "" {
+		return ""
+	}
+
+	for _, machineType := range cloudProfile.Spec.MachineTypes {
+		if machineType.Name != successor {
+			continue
+		}
+
+		if !pointer.BoolDeref(machineType.Usable, true) ||
+			pointer.StringDeref(machineType.Architecture, v1beta1constants.ArchitectureAMD64) != pointer.StringDeref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64) ||
+			isUnavailableInZones(cloudProfile, region, worker.Zones, func(zone gardencorev1beta1.AvailabilityZone) []string { return zone.UnavailableMachineTypes }, successor) {
+			return ""
+		}
+		return successor
+	}
+
+	return ""
+}
+
+// volumeTypeSuccessor returns the successor of the given volume type if it is deprecated and the successor can be used
+// in the given zones. Otherwise, it returns an empty string.
+func volumeTypeSuccessor(cloudProfile *gardencorev1beta1.CloudProfile, region string, zones []string, volumeTypeName string) string {
+	var successor string
+	for _, volumeType := range cloudProfile.Spec.VolumeTypes {
+		if volumeType.Name == volumeTypeName && volumeType.Deprecation != nil {
+			successor = pointer.StringDeref(volumeType.Deprecation.Successor, "")
+		}
+	}
+	if successor == "" {
+		return ""
+	}
+
+	for _, volumeType := range cloudProfile.Spec.VolumeTypes {
+		if volumeType.Name != successor {
+			continue
+		}
+
+		if !pointer.BoolDeref(volumeType.Usable, true) ||
+			isUnavailableInZones(cloudProfile, region, zones, func(zone gardencorev1beta1.AvailabilityZone) []string { return zone.UnavailableVolumeTypes }, successor) {
+			return ""
+		}
+		return successor
+	}
+
+	return ""
+}
+
+func isUnavailableInZones(cloudProfile *gardencorev1beta1.CloudProfile, region string, zones []string, unavailableTypes func(gardencorev1beta1.AvailabilityZone) []string, typeName string) bool {
+	for _, r := range cloudProfile.Spec.Regions {
+		if r.Name != region {
+			continue
+		}
+
+		for _, zone := range r.Zones {
+			if slices.Contains(zones, zone.Name) && slices.Contains(unavailableTypes(zone), typeName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// maxMaintenanceHistoryLength is the maximum number of records kept in the maintenance history of a Shoot.
+const maxMaintenanceHistoryLength = 20
+
+// maintenanceRecorder builds the records for the maintenance history of a Shoot.
+type maintenanceRecorder struct {
+	now         time.Time
+	outOfWindow *bool
+	records     []gardencorev1beta1.MaintenanceRecord
+}
+
+func (m *maintenanceRecorder) record(fldPath *field.Path, oldValue, newValue *string, reason string) {
+	if (oldValue == nil && newValue == nil) || (oldValue != nil && newValue != nil && *oldValue == *newValue) {
+		return
+	}
+
+	m.records = append(m.records, gardencorev1beta1.MaintenanceRecord{
+		Time:        metav1.Time{Time: m.now},
+		Field:       fldPath.String(),
+		OldValue:    oldValue,
+		NewValue:    newValue,
+		Reason:      reason,
+		OutOfWindow: m.outOfWindow,
+	})
+}
+
+// buildMaintenanceRecords compares the specifications of the Shoot before and after the maintenance and returns a
+// record for each change which was performed, including the reason which triggered it.
+func buildMaintenanceRecords(
+	oldShoot, newShoot *gardencorev1beta1.Shoot,
+	now time.Time,
+	outOfWindow bool,
+	kubernetesControlPlaneUpdate *updateResult,
+	workerToKubernetesUpdate map[string]updateResult,
+	workerToMachineImageUpdate map[string]updateResult,
+) []gardencorev1beta1.MaintenanceRecord {
+	m := &maintenanceRecorder{now: now}
+	if outOfWindow {
+		m.outOfWindow = pointer.Bool(true)
+	}
+
+	var (
+		kubernetesPath = field.NewPath("spec", "kubernetes")
+		workersPath    = field.NewPath("spec", "provider", "workers")
+		newVersion     = newShoot.Spec.Kubernetes.Version
+	)
+
+	if kubernetesControlPlaneUpdate != nil && kubernetesControlPlaneUpdate.isSuccessful {
+		m.record(kubernetesPath.Child("version"), &oldShoot.Spec.Kubernetes.Version, &newVersion, kubernetesControlPlaneUpdate.reason)
+	}
+	m.record(kubernetesPath.Child("allowPrivilegedContainers"), boolToString(oldShoot.Spec.Kubernetes.AllowPrivilegedContainers), boolToString(newShoot.Spec.Kubernetes.AllowPrivilegedContainers),
+		fmt.Sprintf("allowPrivilegedContainers must be nil for Kubernetes version %q", newVersion))
+	m.record(kubernetesPath.Child("enableStaticTokenKubeconfig"), boolToString(oldShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig), boolToString(newShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig),
+		"The static token kubeconfig can no longer be enabled for Shoot clusters using Kubernetes version 1.27 and higher")
+	m.record(kubernetesPath.Child("kubeAPIServer", "admissionPlugins").Key("PodSecurityPolicy").Child("disabled"), podSecurityPolicyDisabled(oldShoot), podSecurityPolicyDisabled(newShoot),
+		fmt.Sprintf("PodSecurityPolicy Admission Controller must be disabled for Kubernetes version %q", newVersion))
+
+	for i, newWorker := range newShoot.Spec.Provider.Workers {
+		if i >= len(oldShoot.Spec.Provider.Workers) {
+			break
+		}
+
+		var (
+			oldWorker  = oldShoot.Spec.Provider.Workers[i]
+			workerPath = workersPath.Index(i)
+		)
+
+		if result, ok := workerToKubernetesUpdate[newWorker.Name]; ok && result.isSuccessful && oldWorker.Kubernetes != nil && newWorker.Kubernetes != nil {
+			m.record(workerPath.Child("kubernetes", "version"), oldWorker.Kubernetes.Version, newWorker.Kubernetes.Version, result.reason)
+		}
+		if result, ok := workerToMachineImageUpdate[newWorker.Name]; ok && result.isSuccessful && oldWorker.Machine.Image != nil && newWorker.Machine.Image != nil {
+			m.record(workerPath.Child("machine", "image", "version"), oldWorker.Machine.Image.Version, newWorker.Machine.Image.Version, result.reason)
+		}
+
+		m.record(workerPath.Child("maximum"), pointer.String(strconv.Itoa(int(oldWorker.Maximum))), pointer.String(strconv.Itoa(int(newWorker.Maximum))),
+			fmt.Sprintf("Maximum number of workers of a worker group must be greater or equal to its number of zones for Kubernetes version %q", newVersion))
+
+		deprecatedTypeReason := "Automatic migration of deprecated machine and volume types is configured"
+		m.record(workerPath.Child("machine", "type"), &oldWorker.Machine.Type, &newWorker.Machine.Type, deprecatedTypeReason)
+		if oldWorker.Volume != nil && newWorker.Volume != nil {
+			m.record(workerPath.Child("volume", "type"), oldWorker.Volume.Type, newWorker.Volume.Type, deprecatedTypeReason)
+		}
+		for j, newDataVolume := range newWorker.DataVolumes {
+			if j < len(oldWorker.DataVolumes) {
+				m.record(workerPath.Child("dataVolumes").Index(j).Child("type"), oldWorker.DataVolumes[j].Type, newDataVolume.Type, deprecatedTypeReason)
+			}
+		}
+	}
+
+	return m.records
+}
+
+// appendMaintenanceRecords appends the given records to the maintenance history and removes the oldest records if the
+// maximum length of the history is exceeded.
+func appendMaintenanceRecords(history []gardencorev1beta1.MaintenanceRecord, records ...gardencorev1beta1.MaintenanceRecord) []gardencorev1beta1.MaintenanceRecord {
+	history = append(history, records...)
+	if len(history) > maxMaintenanceHistoryLength {
+		history = history[len(history)-maxMaintenanceHistoryLength:]
+	}
+	return history
+}
+
+func podSecurityPolicyDisabled(shoot *gardencorev1beta1.Shoot) *string {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		return nil
+	}
+
+	for _, admissionPlugin := range shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins {
+		if admissionPlugin.Name == "PodSecurityPolicy" {
+			return boolToString(admissionPlugin.Disabled)
+		}
+	}
+	return nil
+}
+
+func boolToString(b *bool) *string {
+	if b == nil {
+		return nil
+	}
+	return pointer.String(strconv.FormatBool(*b))
+}
@@ -15,11 +15,13 @@
 package maintenance
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
@@ -139,7 +141,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			})
 
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available", func() {
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -175,7 +177,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				shoot.Spec.Provider.Workers[0].Machine.Architecture = pointer.String("arm64")
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
 			})
@@ -218,7 +220,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, otherWorker)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 
@@ -238,7 +240,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestForMinor)
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -248,7 +250,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = pointer.Bool(false)
 				cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &expirationDateInThePast
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -283,7 +285,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -299,7 +301,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -307,7 +309,173 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should not change version: already on highest version.", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &overallLatestVersion
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
+
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
+			})
+		})
+
+		Describe("Critical vulnerabilities", func() {
+			var criticalVulnerability = []gardencorev1beta1.MachineImageVulnerability{{ID: "CVE-2023-12345", Severity: gardencorev1beta1.VulnerabilitySeverityCritical}}
+
+			It("should not auto-update to a version affected by critical vulnerabilities", func() {
+				cloudProfile.Spec.MachineImages[0].Versions[1].Vulnerabilities = criticalVulnerability
+				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, gardencorev1beta1.MachineImageVersion{
+					ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.4.0"},
+					CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameDocker}},
+					Architectures:    []string{"amd64"},
+				})
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
+
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.4.0")
+			})
+
+			It("should auto-update to a version with non-critical vulnerabilities", func() {
+				cloudProfile.Spec.MachineImages[0].Versions[1].Vulnerabilities = []gardencorev1beta1.MachineImageVulnerability{{ID: "CVE-2023-12345", Severity: gardencorev1beta1.VulnerabilitySeverityHigh}}
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
+
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
+			})
+
+			It("should not update a version affected by critical vulnerabilities if forceful updates are not enabled", func() {
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = pointer.Bool(false)
+				cloudProfile.Spec.MachineImages[0].Versions[0].Vulnerabilities = criticalVulnerability
+
+				results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(BeEmpty())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
+			})
+
+			It("should forcefully update a version affected by critical vulnerabilities if forceful updates are enabled", func() {
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = pointer.Bool(false)
+				cloudProfile.Spec.MachineImages[0].Versions[0].Vulnerabilities = criticalVulnerability
+
+				results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{forceCriticalVulnerabilityUpdates: true})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(HaveKeyWithValue("cpu-worker", updateResult{
+					description:  `Updated machine image "CoreOs" from "1.0.0" to "1.5.3"`,
+					reason:       "Machine image version is affected by critical vulnerabilities (CVE-2023-12345) - force update required (image update strategy: major)",
+					isSuccessful: true,
+				}))
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
+			})
+
+			It("should only update versions affected by critical vulnerabilities when maintaining outside of the maintenance time window", func() {
+				results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{forceCriticalVulnerabilityUpdates: true, onlyCriticalVulnerabilityUpdates: true})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(BeEmpty())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
+			})
+
+			Context("vulnerability scan results", func() {
+				var threshold = gardencorev1beta1.VulnerabilitySeverityHigh
+
+				BeforeEach(func() {
+					shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = pointer.Bool(false)
+					shoot.Status.VulnerabilityScanResults = []gardencorev1beta1.VulnerabilityScanResult{{
+						WorkerPool:          "cpu-worker",
+						MachineImageName:    "CoreOs",
+						MachineImageVersion: shootCurrentImageVersion,
+						Vulnerabilities: []gardencorev1beta1.MachineImageVulnerability{
+							{ID: "CVE-2023-11111", Severity: gardencorev1beta1.VulnerabilitySeverityMedium},
+							{ID: "CVE-2023-22222", Severity: gardencorev1beta1.VulnerabilitySeverityHigh},
+						},
+					}}
+				})
+
+				It("should forcefully update a version with scanned vulnerabilities exceeding the severity threshold", func() {
+					results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{forceCriticalVulnerabilityUpdates: true, onlyCriticalVulnerabilityUpdates: true, vulnerabilitySeverityThreshold: &threshold})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(results).To(HaveKeyWithValue("cpu-worker", updateResult{
+						description:  `Updated machine image "CoreOs" from "1.0.0" to "1.5.3"`,
+						reason:       "Machine image version is affected by critical vulnerabilities (CVE-2023-22222) - force update required (image update strategy: major)",
+						isSuccessful: true,
+					}))
+					assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
+				})
+
+				It("should not update a version with scanned vulnerabilities below the severity threshold", func() {
+					threshold := gardencorev1beta1.VulnerabilitySeverityCritical
+
+					results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{forceCriticalVulnerabilityUpdates: true, vulnerabilitySeverityThreshold: &threshold})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(results).To(BeEmpty())
+					assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
+				})
+
+				It("should not consider the scan results if no severity threshold is configured", func() {
+					results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{forceCriticalVulnerabilityUpdates: true})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(results).To(BeEmpty())
+					assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
+				})
+
+				It("should not consider outdated scan results of other machine image versions", func() {
+					shoot.Status.VulnerabilityScanResults[0].MachineImageVersion = "0.9.0"
+
+					results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{forceCriticalVulnerabilityUpdates: true, vulnerabilitySeverityThreshold: &threshold})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(results).To(BeEmpty())
+					assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
+				})
+			})
+		})
+
+		Describe("Patch stream pinning", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.Workers[0].MachineImageUpdatePolicy = &gardencorev1beta1.MachineImageUpdatePolicy{PinPatchStream: pointer.Bool(true)}
+				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, gardencorev1beta1.MachineImageVersion{
+					ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.3"},
+					CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameDocker}},
+					Architectures:    []string{"amd64"},
+				})
+			})
+
+			It("should only auto-update to the latest patch version of the current minor version", func() {
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
+
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.3")
+			})
+
+			It("should not auto-update to a higher minor version", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = pointer.String("1.0.3")
+
+				results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(BeEmpty())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.3")
+			})
+
+			It("should forcefully update to the next higher minor version if the latest patch version is affected by critical vulnerabilities", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = pointer.String("1.0.3")
+				cloudProfile.Spec.MachineImages[0].Versions[2].Vulnerabilities = []gardencorev1beta1.MachineImageVulnerability{{ID: "CVE-2023-12345", Severity: gardencorev1beta1.VulnerabilitySeverityCritical}}
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{forceCriticalVulnerabilityUpdates: true})
+
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
+			})
+
+			It("should not be pinned if the policy does not pin the patch stream", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = pointer.String("1.0.3")
+				shoot.Spec.Provider.Workers[0].MachineImageUpdatePolicy.PinPatchStream = pointer.Bool(false)
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -342,7 +510,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -398,7 +566,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -441,7 +609,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchNextMinor)
@@ -477,7 +645,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewPatchVersionNplusTwoMinor.Version)
@@ -522,7 +690,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expiredPatchVersionNextMinor.Version)
@@ -556,7 +724,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMinor
 				expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 			})
@@ -571,7 +739,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -623,7 +791,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchCurrentMinor)
@@ -672,7 +840,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -722,7 +890,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -768,7 +936,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &latestVersionForCurrentMajor
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionNextMajor)
@@ -804,7 +972,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewVersionNplusTwoMajor.Version)
@@ -820,7 +988,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -856,7 +1024,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMajor
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForMajor)
@@ -866,7 +1034,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should treat workers with `cri: nil` like `cri.name: docker` and not update if `docker` is not explicitly supported by the machine image", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
 		})
@@ -875,7 +1043,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = pointer.Bool(false)
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
@@ -886,7 +1054,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers[0].CRI = &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -899,7 +1067,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-cri-config", Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: pointer.String("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -915,7 +1083,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update to the highest patch version of the same minor
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-containerruntime", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: pointer.String("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -930,7 +1098,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor-and-kata", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}, {Type: "kata-container"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: pointer.String("amd64")}})
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: pointer.String("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -943,7 +1111,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Kubernetes.Version = "1.26.0"
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -952,7 +1120,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].KubeletVersionConstraint = pointer.String("< 1.26")
 			shoot.Spec.Kubernetes.Version = "1.25.1"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 		})
@@ -965,7 +1133,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -978,7 +1146,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				Version: pointer.String("1.26.0"),
 			}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", cloudProfile.Spec.MachineImages[0].Versions[1].Version)
 		})
@@ -986,7 +1154,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.name) machine image defined", func() {
 			cloudProfile.Spec.MachineImages = cloudProfile.Spec.MachineImages[1:]
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, machineImageMaintenanceOptions{})
 
 			Expect(err).To(HaveOccurred())
 		})
@@ -1218,6 +1386,89 @@ var _ = Describe("Shoot Maintenance", func() {
 		})
 	})
 
+	Describe("#nodeDrainBlocked", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{}
+		})
+
+		It("should return an empty message if the constraint is not present", func() {
+			Expect(nodeDrainBlocked(shoot)).To(BeEmpty())
+		})
+
+		DescribeTable("should return the message of the constraint depending on its status",
+			func(status gardencorev1beta1.ConditionStatus, expectedMessage string) {
+				shoot.Status.Constraints = []gardencorev1beta1.Condition{{
+					Type:    gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain,
+					Status:  status,
+					Message: "foo",
+				}}
+
+				Expect(nodeDrainBlocked(shoot)).To(Equal(expectedMessage))
+			},
+
+			Entry("status True", gardencorev1beta1.ConditionTrue, ""),
+			Entry("status Unknown", gardencorev1beta1.ConditionUnknown, ""),
+			Entry("status False", gardencorev1beta1.ConditionFalse, "foo"),
+			Entry("status Progressing", gardencorev1beta1.ConditionProgressing, "foo"),
+		)
+	})
+
+	Describe("#upgradePreflightBlocked", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{}
+		})
+
+		It("should return an empty message if the constraint is not present", func() {
+			Expect(upgradePreflightBlocked(shoot)).To(BeEmpty())
+		})
+
+		It("should return an empty message if the checks are skipped", func() {
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/skip-upgrade-preflight-checks": "true"}
+			shoot.Status.Constraints = []gardencorev1beta1.Condition{{
+				Type:    gardencorev1beta1.ShootUpgradePreflightChecksPassed,
+				Status:  gardencorev1beta1.ConditionFalse,
+				Message: "foo",
+			}}
+
+			Expect(upgradePreflightBlocked(shoot)).To(BeEmpty())
+		})
+
+		DescribeTable("should return the message of the constraint depending on its status",
+			func(status gardencorev1beta1.ConditionStatus, expectedMessage string) {
+				shoot.Status.Constraints = []gardencorev1beta1.Condition{{
+					Type:    gardencorev1beta1.ShootUpgradePreflightChecksPassed,
+					Status:  status,
+					Message: "foo",
+				}}
+
+				Expect(upgradePreflightBlocked(shoot)).To(Equal(expectedMessage))
+			},
+
+			Entry("status True", gardencorev1beta1.ConditionTrue, ""),
+			Entry("status Unknown", gardencorev1beta1.ConditionUnknown, ""),
+			Entry("status False", gardencorev1beta1.ConditionFalse, "foo"),
+			Entry("status Progressing", gardencorev1beta1.ConditionProgressing, "foo"),
+		)
+	})
+
+	Describe("#isMinorVersionUpdate", func() {
+		It("should return false for patch version updates", func() {
+			Expect(isMinorVersionUpdate("1.27.3", "1.27.4")).To(BeFalse())
+		})
+
+		It("should return true for minor version updates", func() {
+			Expect(isMinorVersionUpdate("1.27.3", "1.28.0")).To(BeTrue())
+		})
+
+		It("should return false for invalid versions", func() {
+			Expect(isMinorVersionUpdate("foo", "1.28.0")).To(BeFalse())
+		})
+	})
+
 	Describe("#DisablePodSecurityPolicyAdmissionController", func() {
 		var (
 			shoot                             *gardencorev1beta1.Shoot
@@ -1322,6 +1573,289 @@ var _ = Describe("Shoot Maintenance", func() {
 			Expect(shoot.Spec.Provider.Workers[1].Maximum).To(Equal(int32(2)))
 		})
 	})
+
+	Describe("#maintainDeprecatedTypes", func() {
+		var (
+			shoot        *gardencorev1beta1.Shoot
+			cloudProfile *gardencorev1beta1.CloudProfile
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Region: "region",
+					Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+						{
+							Name:        "cpu-worker",
+							Machine:     gardencorev1beta1.Machine{Type: "m-old", Architecture: pointer.String("amd64")},
+							Volume:      &gardencorev1beta1.Volume{Type: pointer.String("v-old")},
+							DataVolumes: []gardencorev1beta1.DataVolume{{Name: "data", Type: pointer.String("v-old")}},
+							Zones:       []string{"zone-a"},
+						},
+					}},
+				},
+			}
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineTypes: []gardencorev1beta1.MachineType{
+						{Name: "m-old", Architecture: pointer.String("amd64"), Deprecation: &gardencorev1beta1.TypeDeprecation{Successor: pointer.String("m-new")}},
+						{Name: "m-new", Architecture: pointer.String("amd64")},
+					},
+					VolumeTypes: []gardencorev1beta1.VolumeType{
+						{Name: "v-old", Deprecation: &gardencorev1beta1.TypeDeprecation{Successor: pointer.String("v-new")}},
+						{Name: "v-new"},
+					},
+					Regions: []gardencorev1beta1.Region{{Name: "region", Zones: []gardencorev1beta1.AvailabilityZone{{Name: "zone-a"}, {Name: "zone-b"}}}},
+				},
+			}
+		})
+
+		It("should migrate deprecated machine and volume types to their successors", func() {
+			Expect(maintainDeprecatedTypes(shoot, cloudProfile)).To(HaveLen(3))
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("m-new"))
+			Expect(shoot.Spec.Provider.Workers[0].Volume.Type).To(PointTo(Equal("v-new")))
+			Expect(shoot.Spec.Provider.Workers[0].DataVolumes[0].Type).To(PointTo(Equal("v-new")))
+		})
+
+		It("should not migrate types without successor", func() {
+			cloudProfile.Spec.MachineTypes[0].Deprecation.Successor = nil
+			cloudProfile.Spec.VolumeTypes[0].Deprecation.Successor = nil
+
+			Expect(maintainDeprecatedTypes(shoot, cloudProfile)).To(BeEmpty())
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("m-old"))
+			Expect(shoot.Spec.Provider.Workers[0].Volume.Type).To(PointTo(Equal("v-old")))
+		})
+
+		It("should not migrate to successors which are not usable", func() {
+			cloudProfile.Spec.MachineTypes[1].Usable = pointer.Bool(false)
+			cloudProfile.Spec.VolumeTypes[1].Usable = pointer.Bool(false)
+
+			Expect(maintainDeprecatedTypes(shoot, cloudProfile)).To(BeEmpty())
+		})
+
+		It("should not migrate to a machine type with a different architecture", func() {
+			cloudProfile.Spec.MachineTypes[1].Architecture = pointer.String("arm64")
+
+			Expect(maintainDeprecatedTypes(shoot, cloudProfile)).To(HaveLen(2))
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("m-old"))
+		})
+
+		It("should not migrate to successors which are unavailable in a zone of the worker pool", func() {
+			cloudProfile.Spec.Regions[0].Zones[0].UnavailableMachineTypes = []string{"m-new"}
+			cloudProfile.Spec.Regions[0].Zones[0].UnavailableVolumeTypes = []string{"v-new"}
+
+			Expect(maintainDeprecatedTypes(shoot, cloudProfile)).To(BeEmpty())
+		})
+
+		It("should migrate to successors which are only unavailable in zones not used by the worker pool", func() {
+			cloudProfile.Spec.Regions[0].Zones[1].UnavailableMachineTypes = []string{"m-new"}
+
+			Expect(maintainDeprecatedTypes(shoot, cloudProfile)).To(HaveLen(3))
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("m-new"))
+		})
+	})
+
+	Describe("#maintainServiceAccountKeyRotation", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "shoot",
+					CreationTimestamp: metav1.Time{Time: now.Add(-100 * 24 * time.Hour)},
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+							ServiceAccountConfig: &gardencorev1beta1.ServiceAccountConfig{
+								ExtendTokenExpiration:    pointer.Bool(false),
+								MaxTokenExpiration:       &metav1.Duration{Duration: 30 * 24 * time.Hour},
+								SigningKeyRotationPeriod: &metav1.Duration{Duration: 90 * 24 * time.Hour},
+							},
+						},
+					},
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeReconcile,
+						State: gardencorev1beta1.LastOperationStateSucceeded,
+					},
+				},
+			}
+		})
+
+		It("should do nothing if no rotation period is configured", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig.SigningKeyRotationPeriod = nil
+
+			Expect(maintainServiceAccountKeyRotation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should start the rotation if the rotation period passed since the creation of the shoot", func() {
+			Expect(maintainServiceAccountKeyRotation(shoot, now)).To(Equal("rotate-serviceaccount-key-start"))
+		})
+
+		It("should not start the rotation if the rotation period did not pass since the last rotation", func() {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				ServiceAccountKey: &gardencorev1beta1.ServiceAccountKeyRotation{
+					Phase:              gardencorev1beta1.RotationCompleted,
+					LastCompletionTime: &metav1.Time{Time: now.Add(-10 * 24 * time.Hour)},
+				},
+			}}
+
+			Expect(maintainServiceAccountKeyRotation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should not start the rotation if the shoot is hibernated", func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: pointer.Bool(true)}
+
+			Expect(maintainServiceAccountKeyRotation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should not start the rotation if the shoot creation did not succeed yet", func() {
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeCreate,
+				State: gardencorev1beta1.LastOperationStateError,
+			}
+
+			Expect(maintainServiceAccountKeyRotation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should not overwrite another maintenance operation", func() {
+			shoot.Annotations = map[string]string{"maintenance.gardener.cloud/operation": "rotate-ca-start"}
+
+			Expect(maintainServiceAccountKeyRotation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should complete the rotation once all tokens issued with the old signing key are expired", func() {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				ServiceAccountKey: &gardencorev1beta1.ServiceAccountKeyRotation{
+					Phase:                      gardencorev1beta1.RotationPrepared,
+					LastInitiationFinishedTime: &metav1.Time{Time: now.Add(-31 * 24 * time.Hour)},
+				},
+			}}
+
+			Expect(maintainServiceAccountKeyRotation(shoot, now)).To(Equal("rotate-serviceaccount-key-complete"))
+		})
+
+		It("should not complete the rotation as long as tokens issued with the old signing key are valid", func() {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				ServiceAccountKey: &gardencorev1beta1.ServiceAccountKeyRotation{
+					Phase:                      gardencorev1beta1.RotationPrepared,
+					LastInitiationFinishedTime: &metav1.Time{Time: now.Add(-10 * 24 * time.Hour)},
+				},
+			}}
+
+			Expect(maintainServiceAccountKeyRotation(shoot, now)).To(BeEmpty())
+		})
+	})
+
+	Describe("#buildMaintenanceRecords", func() {
+		var oldShoot, newShoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			oldShoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						Version:                     "1.26.5",
+						EnableStaticTokenKubeconfig: pointer.Bool(true),
+					},
+					Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{
+						Name:    "cpu-worker",
+						Maximum: 1,
+						Machine: gardencorev1beta1.Machine{
+							Type:  "m5.large",
+							Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: pointer.String("1.0.0")},
+						},
+					}}},
+				},
+			}
+			newShoot = oldShoot.DeepCopy()
+		})
+
+		It("should not return any records if nothing was changed", func() {
+			Expect(buildMaintenanceRecords(oldShoot, newShoot, now, false, nil, nil, nil)).To(BeEmpty())
+		})
+
+		It("should return records for version updates with the reasons of the updates", func() {
+			newShoot.Spec.Kubernetes.Version = "1.27.3"
+			newShoot.Spec.Provider.Workers[0].Machine.Image.Version = pointer.String("1.1.0")
+
+			Expect(buildMaintenanceRecords(oldShoot, newShoot, now, false,
+				&updateResult{reason: "Kubernetes version expired - force update required", isSuccessful: true},
+				nil,
+				map[string]updateResult{"cpu-worker": {reason: "Automatic update of the machine image version is configured", isSuccessful: true}},
+			)).To(ConsistOf(
+				gardencorev1beta1.MaintenanceRecord{
+					Time:     metav1.Time{Time: now},
+					Field:    "spec.kubernetes.version",
+					OldValue: pointer.String("1.26.5"),
+					NewValue: pointer.String("1.27.3"),
+					Reason:   "Kubernetes version expired - force update required",
+				},
+				gardencorev1beta1.MaintenanceRecord{
+					Time:     metav1.Time{Time: now},
+					Field:    "spec.provider.workers[0].machine.image.version",
+					OldValue: pointer.String("1.0.0"),
+					NewValue: pointer.String("1.1.0"),
+					Reason:   "Automatic update of the machine image version is configured",
+				},
+			))
+		})
+
+		It("should return records for field migrations", func() {
+			newShoot.Spec.Kubernetes.Version = "1.27.3"
+			newShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = pointer.Bool(false)
+			newShoot.Spec.Provider.Workers[0].Maximum = 3
+			newShoot.Spec.Provider.Workers[0].Machine.Type = "m6i.large"
+
+			Expect(buildMaintenanceRecords(oldShoot, newShoot, now, false, nil, nil, nil)).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Field":    Equal("spec.kubernetes.enableStaticTokenKubeconfig"),
+					"OldValue": PointTo(Equal("true")),
+					"NewValue": PointTo(Equal("false")),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Field":    Equal("spec.provider.workers[0].maximum"),
+					"OldValue": PointTo(Equal("1")),
+					"NewValue": PointTo(Equal("3")),
+					"Reason":   ContainSubstring("greater or equal to its number of zones"),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Field":    Equal("spec.provider.workers[0].machine.type"),
+					"OldValue": PointTo(Equal("m5.large")),
+					"NewValue": PointTo(Equal("m6i.large")),
+					"Reason":   Equal("Automatic migration of deprecated machine and volume types is configured"),
+				}),
+			))
+		})
+
+		It("should not return records for failed version updates and mark out-of-window changes", func() {
+			newShoot.Spec.Provider.Workers[0].Machine.Image.Version = pointer.String("1.1.0")
+
+			Expect(buildMaintenanceRecords(oldShoot, newShoot, now, true, &updateResult{isSuccessful: false}, nil,
+				map[string]updateResult{"cpu-worker": {reason: "Machine image version is affected by critical vulnerabilities", isSuccessful: true}},
+			)).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Field":       Equal("spec.provider.workers[0].machine.image.version"),
+				"OutOfWindow": PointTo(BeTrue()),
+			})))
+		})
+	})
+
+	Describe("#appendMaintenanceRecords", func() {
+		It("should remove the oldest records if the maximum length is exceeded", func() {
+			var history []gardencorev1beta1.MaintenanceRecord
+			for i := 0; i < maxMaintenanceHistoryLength; i++ {
+				history = append(history, gardencorev1beta1.MaintenanceRecord{Field: fmt.Sprintf("old-%d", i)})
+			}
+
+			history = appendMaintenanceRecords(history, gardencorev1beta1.MaintenanceRecord{Field: "new-0"}, gardencorev1beta1.MaintenanceRecord{Field: "new-1"})
+
+			Expect(history).To(HaveLen(maxMaintenanceHistoryLength))
+			Expect(history[0].Field).To(Equal("old-2"))
+			Expect(history[maxMaintenanceHistoryLength-1].Field).To(Equal("new-1"))
+		})
+	})
 })
 
 func assertWorkerMachineImageVersion(worker *gardencorev1beta1.Worker, imageName string, imageVersion string) {
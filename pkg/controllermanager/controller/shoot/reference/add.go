@@ -101,6 +101,9 @@ func secretNamesForDNSProviders(shoot *gardencorev1beta1.Shoot) []string {
 		}
 		names = append(names, *provider.SecretName)
 	}
+	if shoot.Spec.DNS.Private != nil && shoot.Spec.DNS.Private.SecretName != nil {
+		names = append(names, *shoot.Spec.DNS.Private.SecretName)
+	}
 
 	return names
 }
@@ -58,6 +58,12 @@ const (
 	// alpha: v1.64.0
 	MutableShootSpecNetworkingNodes featuregate.Feature = "MutableShootSpecNetworkingNodes"
 
+	// ExpandableShootSpecNetworkingCIDRs allows expanding the fields `spec.networking.pods` and
+	// `spec.networking.services` to larger CIDRs containing the previous ones.
+	// owner: @ScheererJ @DockToFuture
+	// alpha: v1.87.0
+	ExpandableShootSpecNetworkingCIDRs featuregate.Feature = "ExpandableShootSpecNetworkingCIDRs"
+
 	// WorkerlessShoots allows creation of Shoot clusters with no worker pools.
 	// owner: @acumino @ary1992 @shafeeqes
 	// alpha: v1.70.0
@@ -142,6 +148,7 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	CoreDNSQueryRewriting:              {Default: false, PreRelease: featuregate.Alpha},
 	IPv6SingleStack:                    {Default: false, PreRelease: featuregate.Alpha},
 	MutableShootSpecNetworkingNodes:    {Default: false, PreRelease: featuregate.Alpha},
+	ExpandableShootSpecNetworkingCIDRs: {Default: false, PreRelease: featuregate.Alpha},
 	WorkerlessShoots:                   {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
 	ShootForceDeletion:                 {Default: false, PreRelease: featuregate.Alpha},
 	MachineControllerManagerDeployment: {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
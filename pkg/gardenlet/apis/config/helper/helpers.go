@@ -16,10 +16,12 @@ package helper
 
 import (
 	"fmt"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/pointer"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -109,6 +111,14 @@ func IsEventLoggingEnabled(c *config.GardenletConfiguration) bool {
 		*c.Logging.ShootEventLogging.Enabled
 }
 
+// GetLogForwardings returns the configurations for forwarding logs of the seed to external endpoints.
+func GetLogForwardings(c *config.GardenletConfiguration) []config.LogForwarding {
+	if c != nil && c.Logging != nil {
+		return c.Logging.Forwarding
+	}
+	return nil
+}
+
 // IsMonitoringEnabled returns true if the monitoring stack for shoot clusters is enabled. Default is enabled.
 func IsMonitoringEnabled(c *config.GardenletConfiguration) bool {
 	if c != nil && c.Monitoring != nil && c.Monitoring.Shoot != nil &&
@@ -118,6 +128,73 @@ func IsMonitoringEnabled(c *config.GardenletConfiguration) bool {
 	return true
 }
 
+// GetShootMonitoringSSOConfig returns the single sign-on configuration for the observability ingresses of shoots if
+// it is configured, otherwise it returns nil.
+func GetShootMonitoringSSOConfig(c *config.GardenletConfiguration) *config.ShootMonitoringSSOConfig {
+	if c != nil && c.Monitoring != nil && c.Monitoring.Shoot != nil {
+		return c.Monitoring.Shoot.SSO
+	}
+	return nil
+}
+
+// GetShootPrometheusAgentConfig returns the configuration for running the Prometheus of the shoots in agent mode if
+// the agent mode is enabled, otherwise it returns nil.
+func GetShootPrometheusAgentConfig(c *config.GardenletConfiguration) *config.ShootPrometheusAgentConfig {
+	if c != nil && c.Monitoring != nil && c.Monitoring.Shoot != nil && c.Monitoring.Shoot.Agent != nil && c.Monitoring.Shoot.Agent.Enabled {
+		return c.Monitoring.Shoot.Agent
+	}
+	return nil
+}
+
+// GetShootAlertForwardingWebhooks returns the ticketing webhooks to which the alerts of shoots with the given purpose
+// are forwarded.
+func GetShootAlertForwardingWebhooks(c *config.GardenletConfiguration, purpose gardencorev1beta1.ShootPurpose) []config.AlertForwardingWebhook {
+	if c == nil || c.Monitoring == nil || c.Monitoring.Shoot == nil || c.Monitoring.Shoot.AlertForwarding == nil {
+		return nil
+	}
+
+	var webhooks []config.AlertForwardingWebhook
+	for _, webhook := range c.Monitoring.Shoot.AlertForwarding.Webhooks {
+		if len(webhook.ShootPurposes) > 0 && !slices.Contains(webhook.ShootPurposes, gardencore.ShootPurpose(purpose)) {
+			continue
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks
+}
+
+// GetMonitoringCustomizations returns the landscape-specific customizations of the dashboards and rules of the
+// monitoring stacks if they are configured, otherwise it returns nil.
+func GetMonitoringCustomizations(c *config.GardenletConfiguration) *config.MonitoringCustomizations {
+	if c != nil && c.Monitoring != nil {
+		return c.Monitoring.Customizations
+	}
+	return nil
+}
+
+// IsAutonomyModeEnabled returns true if the autonomy mode is enabled, i.e. if gardenlet shall keep the shoots of its
+// seed healthy while the garden cluster is not reachable.
+func IsAutonomyModeEnabled(c *config.GardenletConfiguration) bool {
+	return c != nil && c.Autonomy != nil && c.Autonomy.Enabled
+}
+
+// IsContinuousProfilingEnabled returns true if the continuous profiling of components in the seed cluster is enabled.
+func IsContinuousProfilingEnabled(c *config.GardenletConfiguration) bool {
+	return c != nil && c.ContinuousProfiling != nil && c.ContinuousProfiling.Enabled
+}
+
+// IsDeprecatedAPIUsageReporterEnabled returns true if the usage of deprecated APIs in shoot clusters shall be reported.
+func IsDeprecatedAPIUsageReporterEnabled(c *config.GardenletConfiguration) bool {
+	return c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && pointer.BoolDeref(c.Controllers.ShootCare.DeprecatedAPIUsageReporterEnabled, false)
+}
+
+// IsShootMonitoringSharedGatewayEnabled returns true if the observability endpoints of shoots are served by the shared
+// gateway of the seed.
+func IsShootMonitoringSharedGatewayEnabled(c *config.GardenletConfiguration) bool {
+	sso := GetShootMonitoringSSOConfig(c)
+	return sso != nil && pointer.BoolDeref(sso.SharedGateway, false)
+}
+
 // GetManagedResourceProgressingThreshold returns ManagedResourceProgressingThreshold if set otherwise it returns nil.
 func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *metav1.Duration {
 	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && c.Controllers.ShootCare.ManagedResourceProgressingThreshold != nil {
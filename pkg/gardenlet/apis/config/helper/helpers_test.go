@@ -23,6 +23,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
@@ -211,6 +212,22 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	Describe("#GetLogForwardings", func() {
+		It("should return nil when the GardenletConfiguration is nil", func() {
+			Expect(GetLogForwardings(nil)).To(BeNil())
+		})
+
+		It("should return nil when the Logging configuration is nil", func() {
+			Expect(GetLogForwardings(&config.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should return the configured log forwardings", func() {
+			forwardings := []config.LogForwarding{{Name: "siem"}}
+
+			Expect(GetLogForwardings(&config.GardenletConfiguration{Logging: &config.Logging{Forwarding: forwardings}})).To(Equal(forwardings))
+		})
+	})
+
 	Describe("#EventLoggingConfiguration", func() {
 		It("should return false when the GardenletConfiguration is nil", func() {
 			Expect(IsEventLoggingEnabled(nil)).To(BeFalse())
@@ -276,6 +293,150 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	Describe("#GetShootMonitoringSSOConfig", func() {
+		It("should return nil when nothing is set", func() {
+			Expect(GetShootMonitoringSSOConfig(&config.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should return nil when Monitoring.Shoot is nil", func() {
+			Expect(GetShootMonitoringSSOConfig(&config.GardenletConfiguration{Monitoring: &config.MonitoringConfig{}})).To(BeNil())
+		})
+
+		It("should return the configured single sign-on configuration", func() {
+			sso := &config.ShootMonitoringSSOConfig{IssuerURL: "https://issuer.example.com"}
+			gardenletConfig := &config.GardenletConfiguration{
+				Monitoring: &config.MonitoringConfig{Shoot: &config.ShootMonitoringConfig{SSO: sso}},
+			}
+
+			Expect(GetShootMonitoringSSOConfig(gardenletConfig)).To(Equal(sso))
+		})
+	})
+
+	Describe("#GetShootPrometheusAgentConfig", func() {
+		It("should return nil when nothing is set", func() {
+			Expect(GetShootPrometheusAgentConfig(&config.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should return nil when the agent mode is disabled", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Monitoring: &config.MonitoringConfig{Shoot: &config.ShootMonitoringConfig{Agent: &config.ShootPrometheusAgentConfig{URL: "https://central-store.example.com"}}},
+			}
+
+			Expect(GetShootPrometheusAgentConfig(gardenletConfig)).To(BeNil())
+		})
+
+		It("should return the agent configuration when the agent mode is enabled", func() {
+			agent := &config.ShootPrometheusAgentConfig{Enabled: true, URL: "https://central-store.example.com"}
+			gardenletConfig := &config.GardenletConfiguration{
+				Monitoring: &config.MonitoringConfig{Shoot: &config.ShootMonitoringConfig{Agent: agent}},
+			}
+
+			Expect(GetShootPrometheusAgentConfig(gardenletConfig)).To(Equal(agent))
+		})
+	})
+
+	Describe("#IsShootMonitoringSharedGatewayEnabled", func() {
+		It("should return false when single sign-on is not configured", func() {
+			Expect(IsShootMonitoringSharedGatewayEnabled(&config.GardenletConfiguration{})).To(BeFalse())
+		})
+
+		It("should return false when the shared gateway is not enabled", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Monitoring: &config.MonitoringConfig{Shoot: &config.ShootMonitoringConfig{SSO: &config.ShootMonitoringSSOConfig{}}},
+			}
+
+			Expect(IsShootMonitoringSharedGatewayEnabled(gardenletConfig)).To(BeFalse())
+		})
+
+		It("should return true when the shared gateway is enabled", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Monitoring: &config.MonitoringConfig{Shoot: &config.ShootMonitoringConfig{SSO: &config.ShootMonitoringSSOConfig{SharedGateway: pointer.Bool(true)}}},
+			}
+
+			Expect(IsShootMonitoringSharedGatewayEnabled(gardenletConfig)).To(BeTrue())
+		})
+	})
+
+	Describe("#GetMonitoringCustomizations", func() {
+		It("should return nil when nothing is set", func() {
+			Expect(GetMonitoringCustomizations(&config.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should return the configured customizations", func() {
+			customizations := &config.MonitoringCustomizations{Dashboards: []config.MonitoringCustomization{{ID: "foo", Patch: "[]"}}}
+			gardenletConfig := &config.GardenletConfiguration{
+				Monitoring: &config.MonitoringConfig{Customizations: customizations},
+			}
+
+			Expect(GetMonitoringCustomizations(gardenletConfig)).To(Equal(customizations))
+		})
+	})
+
+	Describe("#GetShootAlertForwardingWebhooks", func() {
+		It("should return nil when nothing is set", func() {
+			Expect(GetShootAlertForwardingWebhooks(&config.GardenletConfiguration{}, gardencorev1beta1.ShootPurposeProduction)).To(BeNil())
+		})
+
+		It("should return the webhooks applying to the given shoot purpose", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Monitoring: &config.MonitoringConfig{Shoot: &config.ShootMonitoringConfig{AlertForwarding: &config.AlertForwardingConfig{
+					Webhooks: []config.AlertForwardingWebhook{
+						{Name: "all"},
+						{Name: "production", ShootPurposes: []gardencore.ShootPurpose{gardencore.ShootPurposeProduction}},
+						{Name: "evaluation", ShootPurposes: []gardencore.ShootPurpose{gardencore.ShootPurposeEvaluation}},
+					},
+				}}},
+			}
+
+			Expect(GetShootAlertForwardingWebhooks(gardenletConfig, gardencorev1beta1.ShootPurposeProduction)).To(Equal([]config.AlertForwardingWebhook{
+				{Name: "all"},
+				{Name: "production", ShootPurposes: []gardencore.ShootPurpose{gardencore.ShootPurposeProduction}},
+			}))
+		})
+	})
+
+	Describe("#IsAutonomyModeEnabled", func() {
+		It("should return false when nothing is set", func() {
+			Expect(IsAutonomyModeEnabled(&config.GardenletConfiguration{})).To(BeFalse())
+		})
+
+		It("should return false when the autonomy mode is disabled", func() {
+			Expect(IsAutonomyModeEnabled(&config.GardenletConfiguration{Autonomy: &config.AutonomyConfig{}})).To(BeFalse())
+		})
+
+		It("should return true when the autonomy mode is enabled", func() {
+			Expect(IsAutonomyModeEnabled(&config.GardenletConfiguration{Autonomy: &config.AutonomyConfig{Enabled: true}})).To(BeTrue())
+		})
+	})
+
+	Describe("#IsContinuousProfilingEnabled", func() {
+		It("should return false when nothing is set", func() {
+			Expect(IsContinuousProfilingEnabled(&config.GardenletConfiguration{})).To(BeFalse())
+		})
+
+		It("should return false when the continuous profiling is disabled", func() {
+			Expect(IsContinuousProfilingEnabled(&config.GardenletConfiguration{ContinuousProfiling: &config.ContinuousProfiling{}})).To(BeFalse())
+		})
+
+		It("should return true when the continuous profiling is enabled", func() {
+			Expect(IsContinuousProfilingEnabled(&config.GardenletConfiguration{ContinuousProfiling: &config.ContinuousProfiling{Enabled: true}})).To(BeTrue())
+		})
+	})
+
+	Describe("#IsDeprecatedAPIUsageReporterEnabled", func() {
+		It("should return false when nothing is set", func() {
+			Expect(IsDeprecatedAPIUsageReporterEnabled(&config.GardenletConfiguration{})).To(BeFalse())
+		})
+
+		It("should return false when the reporter is disabled", func() {
+			Expect(IsDeprecatedAPIUsageReporterEnabled(&config.GardenletConfiguration{Controllers: &config.GardenletControllerConfiguration{ShootCare: &config.ShootCareControllerConfiguration{DeprecatedAPIUsageReporterEnabled: pointer.Bool(false)}}})).To(BeFalse())
+		})
+
+		It("should return true when the reporter is enabled", func() {
+			Expect(IsDeprecatedAPIUsageReporterEnabled(&config.GardenletConfiguration{Controllers: &config.GardenletControllerConfiguration{ShootCare: &config.ShootCareControllerConfiguration{DeprecatedAPIUsageReporterEnabled: pointer.Bool(true)}}})).To(BeTrue())
+		})
+	})
+
 	Describe("#GetManagedResourceProgressingThreshold", func() {
 		It("should return nil the GardenletConfiguration is nil", func() {
 			Expect(GetManagedResourceProgressingThreshold(nil)).To(BeNil())
@@ -73,6 +73,13 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// Autonomy contains optional settings for the behaviour of gardenlet in case the garden cluster is not reachable.
+	Autonomy *AutonomyConfig
+	// ContinuousProfiling contains optional settings for the continuous profiling of components in the seed cluster.
+	ContinuousProfiling *ContinuousProfiling
+	// ImageVectorOverwrite contains optional settings for overwriting the images deployed by the gardenlet and the
+	// extensions to the seed cluster.
+	ImageVectorOverwrite *ImageVectorOverwrite
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -126,6 +133,35 @@ type SeedClientConnection struct {
 // for the proxy server to use when communicating with the shoot apiserver.
 type ShootClientConnection struct {
 	componentbaseconfig.ClientConnectionConfiguration
+	// AdaptiveRateLimiting configures the adaptation of the QPS of the shoot clients based on the observed latency and
+	// throttling responses of the shoot API servers.
+	AdaptiveRateLimiting *AdaptiveRateLimiting
+}
+
+// AdaptiveRateLimiting contains the configuration for the adaptive client-side rate limiting toward shoot API servers.
+type AdaptiveRateLimiting struct {
+	// Enabled controls whether the adaptive rate limiting is enabled. If enabled, the QPS of a shoot client is reduced
+	// when the shoot API server responds slowly or with 429 (Too Many Requests), and it is slowly increased again up to
+	// the configured QPS when the API server recovers.
+	Enabled bool
+	// MinQPS is the lower bound to which the QPS of a shoot client is reduced.
+	MinQPS *float32
+	// LatencyThreshold is the request latency above which the shoot API server is considered overloaded.
+	LatencyThreshold *metav1.Duration
+	// LoadShedding configures the deferral of non-critical controllers for shoots whose API server is saturated.
+	LoadShedding *LoadShedding
+}
+
+// LoadShedding contains the configuration for deferring non-critical controllers when a shoot API server is saturated.
+type LoadShedding struct {
+	// Enabled controls whether the load shedding is enabled.
+	Enabled bool
+	// SaturationWindow is the duration for which a shoot API server is considered saturated after it responded slowly
+	// or with 429 (Too Many Requests) the last time.
+	SaturationWindow *metav1.Duration
+	// DeferPeriod is the duration by which non-critical controllers defer their work for a shoot whose API server is
+	// saturated.
+	DeferPeriod *metav1.Duration
 }
 
 // GardenletControllerConfiguration defines the configuration of the controllers.
@@ -152,6 +188,14 @@ type GardenletControllerConfiguration struct {
 	ShootCare *ShootCareControllerConfiguration
 	// ShootState defines the configuration of the ShootState controller.
 	ShootState *ShootStateControllerConfiguration
+	// ShootNamespaceJanitor defines the configuration of the ShootNamespaceJanitor controller.
+	ShootNamespaceJanitor *ShootNamespaceJanitorControllerConfiguration
+	// ShootMetering defines the configuration of the ShootMetering controller. The controller is only enabled if this
+	// configuration is provided.
+	ShootMetering *ShootMeteringControllerConfiguration
+	// SeedIngressCertificate defines the configuration of the SeedIngressCertificate controller. The controller is only
+	// enabled if this configuration is provided.
+	SeedIngressCertificate *SeedIngressCertificateControllerConfiguration
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller.
 	NetworkPolicy *NetworkPolicyControllerConfiguration
 	// ManagedSeed defines the configuration of the ManagedSeed controller.
@@ -252,6 +296,39 @@ type ShootControllerConfiguration struct {
 	// DNSEntryTTLSeconds is the TTL in seconds that is being used for DNS entries when reconciling shoots.
 	// Default: 120s
 	DNSEntryTTLSeconds *int64
+	// Sharding defines the configuration for distributing the shoots of the seed across multiple gardenlet replicas.
+	Sharding *ShardingConfiguration
+	// Migration defines the configuration for migrations of shoot control planes from and to the seed.
+	Migration *ShootMigrationConfiguration
+}
+
+// ShootMigrationConfiguration defines the configuration for migrations of shoot control planes from and to the seed.
+type ShootMigrationConfiguration struct {
+	// MaxConcurrentIncoming is the maximum number of shoot control planes which are concurrently restored on the seed.
+	// If not set, the number is not limited.
+	MaxConcurrentIncoming *int
+	// MaxConcurrentOutgoing is the maximum number of shoot control planes which are concurrently migrated away from the
+	// seed. If not set, the number is not limited.
+	MaxConcurrentOutgoing *int
+	// PurposePriorities is the list of shoot purposes ordered by descending priority. If the number of concurrent
+	// migrations is limited, pending migrations of shoots with a purpose listed earlier are started first. Shoots with a
+	// purpose which is not listed have the lowest priority.
+	PurposePriorities []gardencore.ShootPurpose
+	// Paused specifies whether migrations which have not yet been started are held back, e.g., during a maintenance of
+	// the seed. Migrations which are already in progress are continued.
+	Paused bool
+}
+
+// ShardingConfiguration defines the configuration for sharding the shoot controllers across multiple gardenlet
+// replicas.
+type ShardingConfiguration struct {
+	// Enabled specifies whether the shoots are distributed across all running gardenlet replicas. If enabled, the
+	// shoot controllers run in all replicas (instead of only in the leader) and each replica only reconciles the shoots
+	// assigned to its shard.
+	Enabled bool
+	// LeaseDuration is the duration after which a shard whose lease has not been renewed is considered unavailable and
+	// its shoots are reassigned to the remaining shards.
+	LeaseDuration *metav1.Duration
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -276,6 +353,12 @@ type ShootCareControllerConfiguration struct {
 	// practices (https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#best-practices-and-warnings)
 	// is enabled.
 	WebhookRemediatorEnabled *bool
+	// DeprecatedAPIUsageReporterEnabled specifies whether the usage of deprecated APIs in shoot clusters shall be
+	// reported in the shoot clusters and in the Shoot resources.
+	DeprecatedAPIUsageReporterEnabled *bool
+	// BackupFreshnessThresholds defines the maximum ages of the latest etcd snapshots of shoots before their backups
+	// are considered outdated.
+	BackupFreshnessThresholds *BackupFreshnessThresholds
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -286,6 +369,15 @@ type SeedCareControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 	// ConditionThresholds defines the condition threshold per condition type.
 	ConditionThresholds []ConditionThreshold
+	// ConformanceChecks configures the conformance checks of the seed system components.
+	ConformanceChecks *SeedConformanceChecks
+}
+
+// SeedConformanceChecks defines the configuration of the conformance checks of the seed system components.
+type SeedConformanceChecks struct {
+	// Enabled specifies whether the conformance checks are performed.
+	// Defaults to true.
+	Enabled bool
 }
 
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
@@ -297,6 +389,126 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 }
 
+// ShootNamespaceJanitorControllerConfiguration defines the configuration of the ShootNamespaceJanitor controller.
+type ShootNamespaceJanitorControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often shoot namespaces in the seed are checked for being stale.
+	SyncPeriod *metav1.Duration
+	// StaleThreshold is the minimum age of a shoot namespace without a matching Shoot or BackupEntry before it is
+	// considered stale.
+	StaleThreshold *metav1.Duration
+}
+
+// ShootMeteringControllerConfiguration defines the configuration of the ShootMetering controller.
+type ShootMeteringControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often a usage report is generated and exported for each shoot. Each report covers
+	// the period since the previous report.
+	SyncPeriod *metav1.Duration
+	// CostCenterLabel is the key of the label on Project resources whose value is added as cost center to the usage
+	// reports of all shoots of the project.
+	CostCenterLabel *string
+	// Sink is the configuration of the sink the usage reports are exported to.
+	Sink ShootMeteringSink
+}
+
+// ShootMeteringSink is the configuration of the sink the usage reports are exported to. Exactly one sink must be
+// configured.
+type ShootMeteringSink struct {
+	// S3 configures the export of the usage reports as JSON objects to an S3 (compatible) bucket.
+	S3 *ShootMeteringS3Sink
+	// BigQuery configures the export of the usage reports as rows into a BigQuery table.
+	BigQuery *ShootMeteringBigQuerySink
+	// PrometheusRemoteWrite configures the export of the usage reports as samples to a Prometheus remote write endpoint.
+	PrometheusRemoteWrite *ShootMeteringPrometheusRemoteWriteSink
+}
+
+// ShootMeteringS3Sink is the configuration of an S3 sink for usage reports.
+type ShootMeteringS3Sink struct {
+	// Bucket is the name of the bucket.
+	Bucket string
+	// Region is the region of the bucket.
+	Region string
+	// Endpoint is the endpoint of the S3 API. If it is not set, the AWS endpoint for the region is used.
+	Endpoint *string
+	// Prefix is the prefix of the keys of the objects.
+	Prefix *string
+	// SecretRef is a reference to a secret in the seed cluster containing the credentials in the data keys
+	// `accessKeyID` and `secretAccessKey`.
+	SecretRef corev1.SecretReference
+}
+
+// ShootMeteringBigQuerySink is the configuration of a BigQuery sink for usage reports.
+type ShootMeteringBigQuerySink struct {
+	// Project is the ID of the GCP project containing the dataset.
+	Project string
+	// Dataset is the ID of the dataset containing the table.
+	Dataset string
+	// Table is the ID of the table the usage reports are inserted into.
+	Table string
+	// SecretRef is a reference to a secret in the seed cluster containing the service account key in the data key
+	// `serviceaccount.json`.
+	SecretRef corev1.SecretReference
+}
+
+// ShootMeteringPrometheusRemoteWriteSink is the configuration of a Prometheus remote write sink for usage reports.
+type ShootMeteringPrometheusRemoteWriteSink struct {
+	// URL is the URL of the remote write endpoint.
+	URL string
+	// SecretRef is a reference to a secret in the seed cluster containing either the data keys `username` and
+	// `password` for basic authentication or the data key `bearerToken` for token authentication.
+	SecretRef *corev1.SecretReference
+}
+
+// SeedIngressCertificateControllerConfiguration defines the configuration of the SeedIngressCertificate controller.
+type SeedIngressCertificateControllerConfiguration struct {
+	// SyncPeriod is the duration how often the wildcard certificate for the ingress domain of the seed is checked.
+	SyncPeriod *metav1.Duration
+	// RenewBefore is the duration before the expiration of the certificate when a new certificate is requested.
+	RenewBefore *metav1.Duration
+	// Issuers is the list of ACME issuers used for requesting the certificate. They are tried in the given order, i.e.,
+	// an issuer is only used if all previous issuers are rate-limited.
+	Issuers []ACMEIssuer
+}
+
+// ACMEIssuer is the configuration of an ACME issuer.
+type ACMEIssuer struct {
+	// Name is the name of the issuer.
+	Name string
+	// Server is the URL of the ACME directory of the issuer.
+	Server string
+	// Email is the email address used for registering the ACME account.
+	Email *string
+	// ExternalAccountBinding is the external account binding used for registering the ACME account. It is required by
+	// some issuers to associate the ACME account with an existing account of the issuer.
+	ExternalAccountBinding *ACMEExternalAccountBinding
+	// DNSChallengeProvider is the DNS provider used for solving the DNS-01 challenges. If it is not set, the DNS
+	// provider of the seed is used.
+	DNSChallengeProvider *ACMEDNSChallengeProvider
+}
+
+// ACMEExternalAccountBinding is the configuration of an external account binding for an ACME account.
+type ACMEExternalAccountBinding struct {
+	// KeyID is the key identifier of the external account.
+	KeyID string
+	// KeySecretRef is a reference to a secret in the seed cluster containing the HMAC key of the external account in
+	// the data key `hmacKey`. The key is expected to be base64url-encoded as it is provided by the issuers.
+	KeySecretRef corev1.SecretReference
+}
+
+// ACMEDNSChallengeProvider is the configuration of a DNS provider used for solving DNS-01 challenges.
+type ACMEDNSChallengeProvider struct {
+	// Type is the type of the DNS provider. The challenge records are published via DNSRecord extension resources of
+	// this type.
+	Type string
+	// SecretRef is a reference to a secret in the seed cluster containing the credentials of the DNS provider.
+	SecretRef corev1.SecretReference
+	// Zone is the DNS hosted zone of the challenge records.
+	Zone *string
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -308,6 +520,18 @@ type StaleExtensionHealthChecks struct {
 	Threshold *metav1.Duration
 }
 
+// BackupFreshnessThresholds defines the maximum ages of the latest etcd snapshots of shoots. If the latest snapshot of
+// the main etcd of a shoot is older, the `BackupReady` condition of the shoot is set to `False` and an alert is fired
+// in the shoot's Prometheus.
+type BackupFreshnessThresholds struct {
+	// FullSnapshot is the maximum age of the latest full snapshot.
+	// Defaults to 24h.
+	FullSnapshot *metav1.Duration
+	// DeltaSnapshot is the maximum age of the latest delta snapshot.
+	// Defaults to 15m.
+	DeltaSnapshot *metav1.Duration
+}
+
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
 type ConditionThreshold struct {
 	// Type is the type of the condition to define the threshold for.
@@ -324,8 +548,23 @@ type NetworkPolicyControllerConfiguration struct {
 	// AdditionalNamespaceSelectors is a list of label selectors for additional namespaces that should be considered by
 	// the controller.
 	AdditionalNamespaceSelectors []metav1.LabelSelector
+	// ExtensionsAccessToShootAPIServers configures whether the access of extensions to the kube-apiservers of shoot
+	// clusters is restricted to the shoots they manage. In `Report` mode, the restricting NetworkPolicies are maintained
+	// and the extensions which would lose access are reported, but all extensions can still access all kube-apiservers.
+	// In `Enforce` mode, the access is restricted.
+	ExtensionsAccessToShootAPIServers *ExtensionsAccessMode
 }
 
+// ExtensionsAccessMode is the mode for restricting the access of extensions to the kube-apiservers of shoot clusters.
+type ExtensionsAccessMode string
+
+const (
+	// ExtensionsAccessModeReport is the mode in which the restricted access is only reported.
+	ExtensionsAccessModeReport ExtensionsAccessMode = "Report"
+	// ExtensionsAccessModeEnforce is the mode in which the restricted access is enforced.
+	ExtensionsAccessModeEnforce ExtensionsAccessMode = "Enforce"
+)
+
 // ManagedSeedControllerConfiguration defines the configuration of the ManagedSeed controller.
 type ManagedSeedControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
@@ -404,6 +643,77 @@ type Logging struct {
 	ShootNodeLogging *ShootNodeLogging
 	// ShootEventLogging contains configurations for the shoot event logger.
 	ShootEventLogging *ShootEventLogging
+	// Forwarding contains configurations for forwarding selected logs of the seed to external endpoints, e.g., SIEM
+	// systems.
+	Forwarding []LogForwarding
+}
+
+// LogForwarding contains configuration for forwarding selected logs of the seed to an external endpoint.
+type LogForwarding struct {
+	// Name is the unique name of the log forwarding.
+	Name string
+	// Syslog contains the configuration for forwarding the logs to a syslog server via TLS.
+	Syslog *LogForwardingSyslog
+	// OTLP contains the configuration for forwarding the logs to an OpenTelemetry endpoint via OTLP/HTTP with TLS.
+	OTLP *LogForwardingOTLP
+	// Selector selects the logs which are forwarded.
+	Selector LogForwardingSelector
+	// Redaction contains the rules for redacting the forwarded log records.
+	Redaction *LogForwardingRedaction
+	// TLS contains the configuration for the TLS connection to the endpoint. If not set, the certificate of the endpoint
+	// is verified with the system CA bundle of fluent-bit and no client certificate is presented.
+	TLS *LogForwardingTLS
+}
+
+// LogForwardingTLS contains the configuration for the TLS connection to a log forwarding endpoint.
+type LogForwardingTLS struct {
+	// SecretName is the name of a secret in the `garden` namespace of the seed cluster. It contains the CA bundle for
+	// verifying the certificate of the endpoint in the data key `ca.crt`. If ClientCertificate is true, it also contains
+	// the client certificate and key in the data keys `tls.crt` and `tls.key`.
+	SecretName string
+	// ClientCertificate specifies whether the client certificate from the secret is presented to the endpoint.
+	ClientCertificate bool
+}
+
+// LogForwardingSyslog contains the configuration for forwarding logs to a syslog server.
+type LogForwardingSyslog struct {
+	// Host is the domain or IP address of the syslog server.
+	Host string
+	// Port is the TCP port of the syslog server.
+	Port *int32
+	// Format is the syslog protocol format, either 'rfc5424' or 'rfc3164'.
+	Format *string
+}
+
+// LogForwardingOTLP contains the configuration for forwarding logs to an OpenTelemetry endpoint.
+type LogForwardingOTLP struct {
+	// Host is the domain or IP address of the OpenTelemetry endpoint.
+	Host string
+	// Port is the TCP port of the OpenTelemetry endpoint.
+	Port *int32
+	// URI is the HTTP URI of the OpenTelemetry logs endpoint.
+	URI *string
+}
+
+// LogForwardingSelector selects the logs which are forwarded.
+type LogForwardingSelector struct {
+	// Projects is a list of project names. The logs of the shoot control planes of these projects are forwarded.
+	Projects []string
+	// Extensions specifies whether the logs of the extensions running in the seed are forwarded.
+	Extensions bool
+	// Namespaces is a list of further namespaces in the seed whose logs are forwarded, e.g., 'garden'.
+	Namespaces []string
+	// Components is a list of container names whose logs are forwarded, e.g., 'kube-apiserver'. If empty, the logs of
+	// all containers in the selected namespaces are forwarded.
+	Components []string
+}
+
+// LogForwardingRedaction contains the rules for redacting forwarded log records.
+type LogForwardingRedaction struct {
+	// RemoveKeys is a list of keys which are removed from the log records. Wildcards are supported, e.g., 'user*'.
+	RemoveKeys []string
+	// MaskKeys is a list of keys whose values are replaced with '[REDACTED]' in the log records.
+	MaskKeys []string
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -463,6 +773,20 @@ type ETCDConfig struct {
 	FeatureGates map[string]bool
 	// DeltaSnapshotRetentionPeriod defines the duration for which delta snapshots will be retained, excluding the latest snapshot set.
 	DeltaSnapshotRetentionPeriod *metav1.Duration
+	// LocalStorage contains configuration for placing the data of etcds on node-local storage of the seed.
+	LocalStorage *ETCDLocalStorage
+}
+
+// ETCDLocalStorage contains configuration for placing the data of etcds on node-local (e.g., NVMe-backed) storage of
+// the seed. Only etcds with backups are placed on local storage since their data can be restored when a node is lost.
+// All other etcds keep using the default (network) storage class of the seed.
+type ETCDLocalStorage struct {
+	// StorageClassName is the name of the storage class providing node-local volumes.
+	StorageClassName string
+	// NodeLossGracePeriod is the duration after which local volumes of etcds on a node which is gone or not ready are
+	// dropped so that they are rebuilt on another node.
+	// Defaults to 5 minutes.
+	NodeLossGracePeriod *metav1.Duration
 }
 
 // ETCDController contains config specific to ETCD controller
@@ -529,6 +853,28 @@ type LoadBalancerServiceConfig struct {
 type MonitoringConfig struct {
 	// Shoot is optional and contains settings for the shoot monitoring stack.
 	Shoot *ShootMonitoringConfig
+	// Customizations is optional and contains landscape-specific customizations of the dashboards and rules of the
+	// monitoring stacks which are applied when the monitoring components are rendered.
+	Customizations *MonitoringCustomizations
+}
+
+// MonitoringCustomizations contains landscape-specific customizations of the dashboards and rules of the monitoring
+// stacks.
+type MonitoringCustomizations struct {
+	// Dashboards is a list of customizations of the Plutono dashboards of the seed and shoot monitoring stacks. The ID
+	// of a customization is the `uid` of the dashboard.
+	Dashboards []MonitoringCustomization
+	// Rules is a list of customizations of the rule files of the shoot Prometheus. The ID of a customization is the
+	// name of the rule file, e.g. `kube-apiserver.rules.yaml`.
+	Rules []MonitoringCustomization
+}
+
+// MonitoringCustomization contains a patch for a dashboard or rule file.
+type MonitoringCustomization struct {
+	// ID identifies the customized dashboard or rule file.
+	ID string
+	// Patch is a JSON patch (RFC 6902) in JSON or YAML format which is applied to the dashboard or rule file.
+	Patch string
 }
 
 // ShootMonitoringConfig contains settings for the shoot monitoring stack.
@@ -540,6 +886,115 @@ type ShootMonitoringConfig struct {
 	RemoteWrite *RemoteWriteMonitoringConfig
 	// ExternalLabels is optional and sets additional external labels for the monitoring stack.
 	ExternalLabels map[string]string
+	// SSO is optional and contains settings for protecting the observability ingresses of shoots with OIDC-based
+	// single sign-on instead of basic authentication.
+	SSO *ShootMonitoringSSOConfig
+	// AlertOverrides is optional and contains rules for adjusting or dropping the alerts of the shoot monitoring stack
+	// before they are sent to the Alertmanagers.
+	AlertOverrides []AlertOverride
+	// AlertForwarding is optional and contains settings for enriching the alerts of the shoot monitoring stack with
+	// garden metadata and for forwarding them to ticketing systems.
+	AlertForwarding *AlertForwardingConfig
+	// Agent is optional and contains settings for running the Prometheus of the shoots in agent mode.
+	Agent *ShootPrometheusAgentConfig
+}
+
+// ShootPrometheusAgentConfig contains settings for running the Prometheus of the shoots in agent mode. In agent mode,
+// Prometheus only scrapes its targets and remote-writes the samples to a central store instead of storing them locally
+// and evaluating rules, which reduces its memory footprint significantly. The recording rules are handed over to the
+// central store.
+type ShootPrometheusAgentConfig struct {
+	// Enabled specifies whether the Prometheus of the shoots runs in agent mode.
+	Enabled bool
+	// URL is the remote write URL of the central per-seed or external store.
+	URL string
+	// TenantHeader is the name of the HTTP header which is sent with the remote write requests and contains the
+	// namespace of the shoot as tenant ID, e.g. `X-Scope-OrgID`. It is required if the central store is multi-tenant.
+	TenantHeader *string
+}
+
+// AlertForwardingConfig contains settings for enriching the alerts of the shoot monitoring stack with garden metadata
+// and for forwarding them to ticketing systems.
+type AlertForwardingConfig struct {
+	// Runbooks maps alert names to the URLs of their runbooks. The URL is added as `runbook_url` label to the alerts.
+	Runbooks map[string]string
+	// Webhooks is a list of ticketing webhooks to which the alerts are forwarded by the Alertmanager of the shoot. If
+	// at least one webhook applies to a shoot, its Alertmanager is deployed even if no email receivers are configured.
+	Webhooks []AlertForwardingWebhook
+}
+
+// AlertForwardingWebhook contains settings for a ticketing webhook to which alerts are forwarded.
+type AlertForwardingWebhook struct {
+	// Name is the name of the webhook.
+	Name string
+	// Format is the format of the notifications sent to the webhook.
+	Format AlertForwardingFormat
+	// SecretRef is a reference to a secret in the seed cluster containing the URL of the webhook in the data key `url`.
+	// Depending on the format, it contains the credentials in the data keys `username` and `password` (`Alertmanager`,
+	// `ServiceNow`) or `apiKey` (`Jira`).
+	SecretRef corev1.SecretReference
+	// Severities restricts the forwarded alerts to the given severities. If empty, alerts of all severities are
+	// forwarded.
+	Severities []string
+	// ShootPurposes restricts the webhook to shoots with one of the given purposes. If empty, the webhook applies to
+	// all shoots.
+	ShootPurposes []gardencore.ShootPurpose
+}
+
+// AlertForwardingFormat is the format of the notifications sent to a ticketing webhook.
+type AlertForwardingFormat string
+
+const (
+	// AlertForwardingFormatAlertmanager sends the notifications in the generic Alertmanager webhook format.
+	AlertForwardingFormatAlertmanager AlertForwardingFormat = "Alertmanager"
+	// AlertForwardingFormatJira sends the notifications to the alerts API of Jira Service Management.
+	AlertForwardingFormatJira AlertForwardingFormat = "Jira"
+	// AlertForwardingFormatServiceNow sends the notifications to the event management connector of ServiceNow.
+	AlertForwardingFormatServiceNow AlertForwardingFormat = "ServiceNow"
+)
+
+// AlertOverride contains a rule for adjusting or dropping an alert of the shoot monitoring stack, e.g., to override its
+// severity, to add ownership labels used for routing it, or to drop it if it is known to be noisy.
+type AlertOverride struct {
+	// AlertName is the name of the alert to which the rule applies.
+	AlertName string
+	// ShootPurposes restricts the rule to shoots with one of the given purposes. If empty, the rule applies to all shoots.
+	ShootPurposes []gardencore.ShootPurpose
+	// Severity overrides the severity of the alert.
+	Severity *string
+	// Labels are added to the alert, e.g., ownership labels like `team` or `escalation_policy` which can be used for
+	// routing the alert.
+	Labels map[string]string
+	// Drop specifies whether the alert is dropped instead of being sent to the Alertmanagers.
+	Drop bool
+}
+
+// ShootMonitoringSSOConfig contains settings for the OIDC-based single sign-on for the observability ingresses of
+// shoots. Access is granted to users which are members of one of the groups which are members of the shoot's project.
+type ShootMonitoringSSOConfig struct {
+	// IssuerURL is the URL of the OIDC issuer.
+	IssuerURL string
+	// ClientID is the ID of the OIDC client.
+	ClientID string
+	// ClientSecretRef is a reference to a secret in the seed cluster containing the secret of the OIDC client in the
+	// data key `clientSecret`.
+	ClientSecretRef corev1.SecretReference
+	// GroupsClaim is the name of the claim in the ID token containing the groups of the user.
+	// Defaults to `groups`.
+	GroupsClaim *string
+	// CookieRefresh is the interval after which the session cookie is refreshed by redeeming the refresh token at the
+	// OIDC issuer.
+	// Defaults to `1h`.
+	CookieRefresh *metav1.Duration
+	// BreakGlassBasicAuth specifies whether the basic authentication credentials of the observability ingresses are
+	// still accepted in addition to the single sign-on, e.g., for emergencies when the OIDC issuer is unavailable.
+	// Defaults to `true`.
+	BreakGlassBasicAuth *bool
+	// SharedGateway specifies whether the observability endpoints of all shoots are served by a shared gateway of the
+	// seed with path-based routing instead of dedicated ingress hosts per shoot. The gateway uses the wildcard
+	// certificate of the seed and a central auth proxy which authorizes the requests based on the project membership.
+	// Defaults to `false`.
+	SharedGateway *bool
 }
 
 // RemoteWriteMonitoringConfig contains settings for the remote write setting for monitoring stack.
@@ -561,3 +1016,37 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// AutonomyConfig contains settings for the autonomy mode of gardenlet. In autonomy mode, gardenlet keeps the existing
+// shoots of its seed healthy while the garden cluster is not reachable.
+type AutonomyConfig struct {
+	// Enabled controls whether gardenlet switches to the autonomy mode when the garden cluster is not reachable for
+	// longer than the GardenOutageThreshold.
+	Enabled bool
+	// GardenOutageThreshold is the duration for which the garden cluster must not be reachable before gardenlet switches
+	// to the autonomy mode.
+	GardenOutageThreshold *metav1.Duration
+}
+
+// ContinuousProfiling contains settings for the continuous profiling of components in the seed cluster. If enabled,
+// gardenlet deploys a profiling server into the seed cluster which periodically collects the pprof profiles of
+// gardenlet, gardener-resource-manager and all other pods opting in via annotations.
+type ContinuousProfiling struct {
+	// Enabled controls whether the continuous profiling is enabled.
+	Enabled bool
+	// ScrapeInterval is the interval in which the profiles are collected.
+	ScrapeInterval *metav1.Duration
+	// StorageSecretRef is a reference to a secret in the seed cluster containing the configuration of the object storage
+	// the profiles are shipped to. The secret must contain the key 'bucket.yaml' with an object storage configuration
+	// in the format understood by Parca (e.g., for S3). If not set, the profiles are only kept in the profiling server.
+	StorageSecretRef *corev1.SecretReference
+}
+
+// ImageVectorOverwrite contains settings for overwriting the images deployed by the gardenlet and the extensions to the
+// seed cluster.
+type ImageVectorOverwrite struct {
+	// ConfigMapName is the name of a ConfigMap in the garden namespace of the seed cluster. Its data key
+	// 'overwrites.yaml' contains the image vector overwrites for all images, for individual components, or for
+	// individual extensions.
+	ConfigMapName string
+}
@@ -23,6 +23,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/pointer"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
@@ -133,6 +134,26 @@ func SetDefaults_KubeconfigValidity(obj *KubeconfigValidity) {
 	}
 }
 
+// SetDefaults_AdaptiveRateLimiting sets defaults for the adaptive rate limiting of the shoot clients.
+func SetDefaults_AdaptiveRateLimiting(obj *AdaptiveRateLimiting) {
+	if obj.MinQPS == nil {
+		obj.MinQPS = pointer.Float32(5)
+	}
+	if obj.LatencyThreshold == nil {
+		obj.LatencyThreshold = &metav1.Duration{Duration: time.Second}
+	}
+}
+
+// SetDefaults_LoadShedding sets defaults for the load shedding of non-critical controllers.
+func SetDefaults_LoadShedding(obj *LoadShedding) {
+	if obj.SaturationWindow == nil {
+		obj.SaturationWindow = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.DeferPeriod == nil {
+		obj.DeferPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
 // SetDefaults_GardenletControllerConfiguration sets defaults for the controller objects.
 func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfiguration) {
 	if obj.BackupBucket == nil {
@@ -168,6 +189,9 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.ShootState == nil {
 		obj.ShootState = &ShootStateControllerConfiguration{}
 	}
+	if obj.ShootNamespaceJanitor == nil {
+		obj.ShootNamespaceJanitor = &ShootNamespaceJanitorControllerConfiguration{}
+	}
 	if obj.NetworkPolicy == nil {
 		obj.NetworkPolicy = &NetworkPolicyControllerConfiguration{}
 	}
@@ -243,6 +267,36 @@ func SetDefaults_ShootMonitoringConfig(obj *ShootMonitoringConfig) {
 	}
 }
 
+// SetDefaults_ShootMonitoringSSOConfig sets the defaults for the single sign-on of the shoot monitoring.
+func SetDefaults_ShootMonitoringSSOConfig(obj *ShootMonitoringSSOConfig) {
+	if obj.GroupsClaim == nil {
+		obj.GroupsClaim = pointer.String("groups")
+	}
+	if obj.CookieRefresh == nil {
+		obj.CookieRefresh = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.BreakGlassBasicAuth == nil {
+		obj.BreakGlassBasicAuth = pointer.Bool(true)
+	}
+	if obj.SharedGateway == nil {
+		obj.SharedGateway = pointer.Bool(false)
+	}
+}
+
+// SetDefaults_AutonomyConfig sets defaults for the autonomy mode configuration.
+func SetDefaults_AutonomyConfig(obj *AutonomyConfig) {
+	if obj.GardenOutageThreshold == nil {
+		obj.GardenOutageThreshold = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
+// SetDefaults_ContinuousProfiling sets defaults for the continuous profiling configuration.
+func SetDefaults_ContinuousProfiling(obj *ContinuousProfiling) {
+	if obj.ScrapeInterval == nil {
+		obj.ScrapeInterval = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
 // SetDefaults_BastionControllerConfiguration sets defaults for the backup bucket controller.
 func SetDefaults_BastionControllerConfiguration(obj *BastionControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -304,6 +358,10 @@ func SetDefaults_SeedCareControllerConfiguration(obj *SeedCareControllerConfigur
 		v := metav1.Duration{Duration: 30 * time.Second}
 		obj.SyncPeriod = &v
 	}
+
+	if obj.ConformanceChecks == nil {
+		obj.ConformanceChecks = &SeedConformanceChecks{Enabled: true}
+	}
 }
 
 // SetDefaults_ShootControllerConfiguration sets defaults for the shoot controller.
@@ -338,6 +396,26 @@ func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration)
 	}
 }
 
+// SetDefaults_ShardingConfiguration sets defaults for the sharding of the shoot controllers.
+func SetDefaults_ShardingConfiguration(obj *ShardingConfiguration) {
+	if obj.LeaseDuration == nil {
+		obj.LeaseDuration = &metav1.Duration{Duration: 15 * time.Second}
+	}
+}
+
+// SetDefaults_ShootMigrationConfiguration sets defaults for the migrations of shoot control planes.
+func SetDefaults_ShootMigrationConfiguration(obj *ShootMigrationConfiguration) {
+	if obj.PurposePriorities == nil {
+		obj.PurposePriorities = []gardencorev1beta1.ShootPurpose{
+			gardencorev1beta1.ShootPurposeProduction,
+			gardencorev1beta1.ShootPurposeInfrastructure,
+			gardencorev1beta1.ShootPurposeDevelopment,
+			gardencorev1beta1.ShootPurposeEvaluation,
+			gardencorev1beta1.ShootPurposeTesting,
+		}
+	}
+}
+
 // SetDefaults_ShootCareControllerConfiguration sets defaults for the shoot care controller.
 func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -354,6 +432,10 @@ func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfig
 		v := StaleExtensionHealthChecks{Enabled: true}
 		obj.StaleExtensionHealthChecks = &v
 	}
+
+	if obj.BackupFreshnessThresholds == nil {
+		obj.BackupFreshnessThresholds = &BackupFreshnessThresholds{}
+	}
 }
 
 // SetDefaults_StaleExtensionHealthChecks sets defaults for the stale extension health checks.
@@ -364,6 +446,16 @@ func SetDefaults_StaleExtensionHealthChecks(obj *StaleExtensionHealthChecks) {
 	}
 }
 
+// SetDefaults_BackupFreshnessThresholds sets defaults for the backup freshness thresholds.
+func SetDefaults_BackupFreshnessThresholds(obj *BackupFreshnessThresholds) {
+	if obj.FullSnapshot == nil {
+		obj.FullSnapshot = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+	if obj.DeltaSnapshot == nil {
+		obj.DeltaSnapshot = &metav1.Duration{Duration: 15 * time.Minute}
+	}
+}
+
 // SetDefaults_ShootStateControllerConfiguration sets defaults for the shoot secret controller.
 func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -374,12 +466,52 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	}
 }
 
+// SetDefaults_ShootNamespaceJanitorControllerConfiguration sets defaults for the shoot namespace janitor controller.
+func SetDefaults_ShootNamespaceJanitorControllerConfiguration(obj *ShootNamespaceJanitorControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = pointer.Int(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.StaleThreshold == nil {
+		obj.StaleThreshold = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+}
+
+// SetDefaults_ShootMeteringControllerConfiguration sets defaults for the shoot metering controller.
+func SetDefaults_ShootMeteringControllerConfiguration(obj *ShootMeteringControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = pointer.Int(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.CostCenterLabel == nil {
+		obj.CostCenterLabel = pointer.String(DefaultShootMeteringCostCenterLabel)
+	}
+}
+
+// SetDefaults_SeedIngressCertificateControllerConfiguration sets defaults for the seed ingress certificate controller.
+func SetDefaults_SeedIngressCertificateControllerConfiguration(obj *SeedIngressCertificateControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.RenewBefore == nil {
+		obj.RenewBefore = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+}
+
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
 func SetDefaults_NetworkPolicyControllerConfiguration(obj *NetworkPolicyControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
 		v := 5
 		obj.ConcurrentSyncs = &v
 	}
+	if obj.ExtensionsAccessToShootAPIServers == nil {
+		v := ExtensionsAccessModeReport
+		obj.ExtensionsAccessToShootAPIServers = &v
+	}
 }
 
 // SetDefaults_ManagedSeedControllerConfiguration sets defaults for the managed seed controller.
@@ -471,6 +603,26 @@ func SetDefaults_Logging(obj *Logging) {
 	}
 }
 
+// SetDefaults_LogForwardingSyslog sets defaults for the syslog log forwarding.
+func SetDefaults_LogForwardingSyslog(obj *LogForwardingSyslog) {
+	if obj.Port == nil {
+		obj.Port = pointer.Int32(6514)
+	}
+	if obj.Format == nil {
+		obj.Format = pointer.String("rfc5424")
+	}
+}
+
+// SetDefaults_LogForwardingOTLP sets defaults for the OTLP log forwarding.
+func SetDefaults_LogForwardingOTLP(obj *LogForwardingOTLP) {
+	if obj.Port == nil {
+		obj.Port = pointer.Int32(4318)
+	}
+	if obj.URI == nil {
+		obj.URI = pointer.String("/v1/logs")
+	}
+}
+
 // SetDefaults_ETCDConfig sets defaults for the ETCD.
 func SetDefaults_ETCDConfig(obj *ETCDConfig) {
 	if obj.ETCDController == nil {
@@ -501,3 +653,10 @@ func SetDefaults_ETCDConfig(obj *ETCDConfig) {
 		obj.BackupCompactionController.MetricsScrapeWaitDuration = &metav1.Duration{Duration: 60 * time.Second}
 	}
 }
+
+// SetDefaults_ETCDLocalStorage sets defaults for the local storage configuration of etcds.
+func SetDefaults_ETCDLocalStorage(obj *ETCDLocalStorage) {
+	if obj.NodeLossGracePeriod == nil {
+		obj.NodeLossGracePeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
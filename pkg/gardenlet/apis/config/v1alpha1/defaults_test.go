@@ -56,6 +56,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
+			Expect(obj.Controllers.ShootNamespaceJanitor).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
 			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
@@ -188,6 +189,20 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.Logging.ShootEventLogging.Enabled).To(PointTo(Equal(false)))
 			})
 
+			It("should correctly default the log forwarding endpoints", func() {
+				obj.Logging = &Logging{
+					Forwarding: []LogForwarding{
+						{Name: "syslog", Syslog: &LogForwardingSyslog{Host: "siem.example.com"}},
+						{Name: "otlp", OTLP: &LogForwardingOTLP{Host: "otlp.example.com"}},
+					},
+				}
+
+				SetObjectDefaults_GardenletConfiguration(obj)
+
+				Expect(obj.Logging.Forwarding[0].Syslog).To(Equal(&LogForwardingSyslog{Host: "siem.example.com", Port: pointer.Int32(6514), Format: pointer.String("rfc5424")}))
+				Expect(obj.Logging.Forwarding[1].OTLP).To(Equal(&LogForwardingOTLP{Host: "otlp.example.com", Port: pointer.Int32(4318), URI: pointer.String("/v1/logs")}))
+			})
+
 			It("should not overwrite custom settings", func() {
 				gardenValiStorage := resource.MustParse("10Gi")
 				expectedLogging := &Logging{
@@ -229,6 +244,15 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.ETCDConfig.BackupCompactionController.EnableBackupCompaction).To(PointTo(Equal(false)))
 				Expect(obj.ETCDConfig.BackupCompactionController.EventsThreshold).To(PointTo(Equal(int64(1000000))))
 				Expect(obj.ETCDConfig.BackupCompactionController.MetricsScrapeWaitDuration).To(PointTo(Equal(metav1.Duration{Duration: 60 * time.Second})))
+				Expect(obj.ETCDConfig.LocalStorage).To(BeNil())
+			})
+
+			It("should default the local storage configuration", func() {
+				obj.ETCDConfig = &ETCDConfig{LocalStorage: &ETCDLocalStorage{StorageClassName: "local-nvme"}}
+
+				SetObjectDefaults_GardenletConfiguration(obj)
+
+				Expect(obj.ETCDConfig.LocalStorage.NodeLossGracePeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
 			})
 		})
 	})
@@ -263,6 +287,39 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("#SetDefaults_AdaptiveRateLimiting", func() {
+		var obj *AdaptiveRateLimiting
+
+		BeforeEach(func() {
+			obj = &AdaptiveRateLimiting{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_AdaptiveRateLimiting(obj)
+
+			Expect(obj.Enabled).To(BeFalse())
+			Expect(obj.MinQPS).To(PointTo(Equal(float32(5))))
+			Expect(obj.LatencyThreshold).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+			Expect(obj.LoadShedding).To(BeNil())
+		})
+	})
+
+	Describe("#SetDefaults_LoadShedding", func() {
+		var obj *LoadShedding
+
+		BeforeEach(func() {
+			obj = &LoadShedding{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_LoadShedding(obj)
+
+			Expect(obj.Enabled).To(BeFalse())
+			Expect(obj.SaturationWindow).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.DeferPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+		})
+	})
+
 	Describe("#SetDefaults_ManagedSeedControllerConfiguration", func() {
 		var obj *ManagedSeedControllerConfiguration
 
@@ -308,6 +365,15 @@ var _ = Describe("Defaults", func() {
 			SetDefaults_SeedCareControllerConfiguration(obj)
 
 			Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+			Expect(obj.ConformanceChecks).To(PointTo(Equal(SeedConformanceChecks{Enabled: true})))
+		})
+
+		It("should not overwrite disabled conformance checks", func() {
+			obj.ConformanceChecks = &SeedConformanceChecks{Enabled: false}
+
+			SetDefaults_SeedCareControllerConfiguration(obj)
+
+			Expect(obj.ConformanceChecks).To(PointTo(Equal(SeedConformanceChecks{Enabled: false})))
 		})
 	})
 
@@ -330,6 +396,37 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("#SetDefaults_ShardingConfiguration", func() {
+		It("should default the lease duration", func() {
+			obj := &ShardingConfiguration{Enabled: true}
+
+			SetDefaults_ShardingConfiguration(obj)
+
+			Expect(obj.LeaseDuration).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Second})))
+		})
+	})
+
+	Describe("#SetDefaults_ShootMigrationConfiguration", func() {
+		It("should default the purpose priorities", func() {
+			obj := &ShootMigrationConfiguration{}
+
+			SetDefaults_ShootMigrationConfiguration(obj)
+
+			Expect(obj.MaxConcurrentIncoming).To(BeNil())
+			Expect(obj.MaxConcurrentOutgoing).To(BeNil())
+			Expect(obj.Paused).To(BeFalse())
+			Expect(obj.PurposePriorities).To(Equal([]gardencorev1beta1.ShootPurpose{"production", "infrastructure", "development", "evaluation", "testing"}))
+		})
+
+		It("should not overwrite the purpose priorities", func() {
+			obj := &ShootMigrationConfiguration{PurposePriorities: []gardencorev1beta1.ShootPurpose{"testing"}}
+
+			SetDefaults_ShootMigrationConfiguration(obj)
+
+			Expect(obj.PurposePriorities).To(Equal([]gardencorev1beta1.ShootPurpose{"testing"}))
+		})
+	})
+
 	Describe("#SetDefaults_ShootCareControllerConfiguration", func() {
 		var obj *ShootCareControllerConfiguration
 
@@ -343,6 +440,32 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.SyncPeriod).To(PointTo(Equal(DefaultControllerSyncPeriod)))
 			Expect(obj.ConcurrentSyncs).To(PointTo(Equal(DefaultControllerConcurrentSyncs)))
 			Expect(obj.StaleExtensionHealthChecks).To(PointTo(Equal(StaleExtensionHealthChecks{Enabled: true})))
+			Expect(obj.BackupFreshnessThresholds).To(PointTo(Equal(BackupFreshnessThresholds{})))
+		})
+	})
+
+	Describe("#SetDefaults_BackupFreshnessThresholds", func() {
+		var obj *BackupFreshnessThresholds
+
+		BeforeEach(func() {
+			obj = &BackupFreshnessThresholds{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_BackupFreshnessThresholds(obj)
+
+			Expect(obj.FullSnapshot).To(PointTo(Equal(metav1.Duration{Duration: 24 * time.Hour})))
+			Expect(obj.DeltaSnapshot).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Minute})))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.FullSnapshot = &metav1.Duration{Duration: time.Hour}
+			obj.DeltaSnapshot = &metav1.Duration{Duration: time.Minute}
+
+			SetDefaults_BackupFreshnessThresholds(obj)
+
+			Expect(obj.FullSnapshot).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.DeltaSnapshot).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
 		})
 	})
 
@@ -361,6 +484,109 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("#SetDefaults_ShootNamespaceJanitorControllerConfiguration", func() {
+		var obj *ShootNamespaceJanitorControllerConfiguration
+
+		BeforeEach(func() {
+			obj = &ShootNamespaceJanitorControllerConfiguration{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_ShootNamespaceJanitorControllerConfiguration(obj)
+
+			Expect(obj.ConcurrentSyncs).To(PointTo(Equal(DefaultControllerConcurrentSyncs)))
+			Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.StaleThreshold).To(PointTo(Equal(metav1.Duration{Duration: 24 * time.Hour})))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+			obj.StaleThreshold = &metav1.Duration{Duration: 2 * time.Hour}
+
+			SetDefaults_ShootNamespaceJanitorControllerConfiguration(obj)
+
+			Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.StaleThreshold).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Hour})))
+		})
+	})
+
+	Describe("#SetDefaults_ShootMeteringControllerConfiguration", func() {
+		var obj *ShootMeteringControllerConfiguration
+
+		BeforeEach(func() {
+			obj = &ShootMeteringControllerConfiguration{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_ShootMeteringControllerConfiguration(obj)
+
+			Expect(obj.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.CostCenterLabel).To(PointTo(Equal("metering.gardener.cloud/cost-center")))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.ConcurrentSyncs = pointer.Int(10)
+			obj.SyncPeriod = &metav1.Duration{Duration: 15 * time.Minute}
+			obj.CostCenterLabel = pointer.String("cost-center")
+
+			SetDefaults_ShootMeteringControllerConfiguration(obj)
+
+			Expect(obj.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Minute})))
+			Expect(obj.CostCenterLabel).To(PointTo(Equal("cost-center")))
+		})
+	})
+
+	Describe("#SetDefaults_SeedIngressCertificateControllerConfiguration", func() {
+		var obj *SeedIngressCertificateControllerConfiguration
+
+		BeforeEach(func() {
+			obj = &SeedIngressCertificateControllerConfiguration{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_SeedIngressCertificateControllerConfiguration(obj)
+
+			Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.RenewBefore).To(PointTo(Equal(metav1.Duration{Duration: 720 * time.Hour})))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+			obj.RenewBefore = &metav1.Duration{Duration: 48 * time.Hour}
+
+			SetDefaults_SeedIngressCertificateControllerConfiguration(obj)
+
+			Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.RenewBefore).To(PointTo(Equal(metav1.Duration{Duration: 48 * time.Hour})))
+		})
+	})
+
+	Describe("#SetDefaults_NetworkPolicyControllerConfiguration", func() {
+		var obj *NetworkPolicyControllerConfiguration
+
+		BeforeEach(func() {
+			obj = &NetworkPolicyControllerConfiguration{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_NetworkPolicyControllerConfiguration(obj)
+
+			Expect(obj.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.ExtensionsAccessToShootAPIServers).To(PointTo(Equal(ExtensionsAccessModeReport)))
+		})
+
+		It("should not overwrite already set values", func() {
+			mode := ExtensionsAccessModeEnforce
+			obj.ExtensionsAccessToShootAPIServers = &mode
+
+			SetDefaults_NetworkPolicyControllerConfiguration(obj)
+
+			Expect(obj.ExtensionsAccessToShootAPIServers).To(PointTo(Equal(ExtensionsAccessModeEnforce)))
+		})
+	})
+
 	Describe("#SetDefaults_BackupEntryControllerConfiguration", func() {
 		var obj *BackupEntryControllerConfiguration
 
@@ -419,6 +645,81 @@ var _ = Describe("Defaults", func() {
 			Expect(*obj.Enabled).To(BeTrue())
 		})
 	})
+
+	Describe("#SetDefaults_ShootMonitoringSSOConfig", func() {
+		var obj *ShootMonitoringSSOConfig
+
+		BeforeEach(func() {
+			obj = &ShootMonitoringSSOConfig{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_ShootMonitoringSSOConfig(obj)
+
+			Expect(obj.GroupsClaim).To(PointTo(Equal("groups")))
+			Expect(obj.CookieRefresh).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.BreakGlassBasicAuth).To(PointTo(BeTrue()))
+			Expect(obj.SharedGateway).To(PointTo(BeFalse()))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.GroupsClaim = pointer.String("roles")
+			obj.CookieRefresh = &metav1.Duration{Duration: 5 * time.Minute}
+			obj.BreakGlassBasicAuth = pointer.Bool(false)
+			obj.SharedGateway = pointer.Bool(true)
+
+			SetDefaults_ShootMonitoringSSOConfig(obj)
+
+			Expect(obj.GroupsClaim).To(PointTo(Equal("roles")))
+			Expect(obj.CookieRefresh).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.BreakGlassBasicAuth).To(PointTo(BeFalse()))
+			Expect(obj.SharedGateway).To(PointTo(BeTrue()))
+		})
+	})
+
+	Describe("#SetDefaults_AutonomyConfig", func() {
+		var obj *AutonomyConfig
+
+		BeforeEach(func() {
+			obj = &AutonomyConfig{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_AutonomyConfig(obj)
+
+			Expect(obj.GardenOutageThreshold).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.GardenOutageThreshold = &metav1.Duration{Duration: 15 * time.Minute}
+
+			SetDefaults_AutonomyConfig(obj)
+
+			Expect(obj.GardenOutageThreshold).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Minute})))
+		})
+	})
+
+	Describe("#SetDefaults_ContinuousProfiling", func() {
+		var obj *ContinuousProfiling
+
+		BeforeEach(func() {
+			obj = &ContinuousProfiling{}
+		})
+
+		It("should default the configuration", func() {
+			SetDefaults_ContinuousProfiling(obj)
+
+			Expect(obj.ScrapeInterval).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.ScrapeInterval = &metav1.Duration{Duration: 5 * time.Minute}
+
+			SetDefaults_ContinuousProfiling(obj)
+
+			Expect(obj.ScrapeInterval).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+		})
+	})
 })
 
 var _ = Describe("Constants", func() {
@@ -43,6 +43,86 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ACMEDNSChallengeProvider)(nil), (*config.ACMEDNSChallengeProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ACMEDNSChallengeProvider_To_config_ACMEDNSChallengeProvider(a.(*ACMEDNSChallengeProvider), b.(*config.ACMEDNSChallengeProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ACMEDNSChallengeProvider)(nil), (*ACMEDNSChallengeProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ACMEDNSChallengeProvider_To_v1alpha1_ACMEDNSChallengeProvider(a.(*config.ACMEDNSChallengeProvider), b.(*ACMEDNSChallengeProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEExternalAccountBinding)(nil), (*config.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding(a.(*ACMEExternalAccountBinding), b.(*config.ACMEExternalAccountBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ACMEExternalAccountBinding)(nil), (*ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(a.(*config.ACMEExternalAccountBinding), b.(*ACMEExternalAccountBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuer)(nil), (*config.ACMEIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ACMEIssuer_To_config_ACMEIssuer(a.(*ACMEIssuer), b.(*config.ACMEIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ACMEIssuer)(nil), (*ACMEIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ACMEIssuer_To_v1alpha1_ACMEIssuer(a.(*config.ACMEIssuer), b.(*ACMEIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AdaptiveRateLimiting)(nil), (*config.AdaptiveRateLimiting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting(a.(*AdaptiveRateLimiting), b.(*config.AdaptiveRateLimiting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AdaptiveRateLimiting)(nil), (*AdaptiveRateLimiting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting(a.(*config.AdaptiveRateLimiting), b.(*AdaptiveRateLimiting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertForwardingConfig)(nil), (*config.AlertForwardingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertForwardingConfig_To_config_AlertForwardingConfig(a.(*AlertForwardingConfig), b.(*config.AlertForwardingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AlertForwardingConfig)(nil), (*AlertForwardingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AlertForwardingConfig_To_v1alpha1_AlertForwardingConfig(a.(*config.AlertForwardingConfig), b.(*AlertForwardingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertForwardingWebhook)(nil), (*config.AlertForwardingWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertForwardingWebhook_To_config_AlertForwardingWebhook(a.(*AlertForwardingWebhook), b.(*config.AlertForwardingWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AlertForwardingWebhook)(nil), (*AlertForwardingWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AlertForwardingWebhook_To_v1alpha1_AlertForwardingWebhook(a.(*config.AlertForwardingWebhook), b.(*AlertForwardingWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertOverride)(nil), (*config.AlertOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertOverride_To_config_AlertOverride(a.(*AlertOverride), b.(*config.AlertOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AlertOverride)(nil), (*AlertOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AlertOverride_To_v1alpha1_AlertOverride(a.(*config.AlertOverride), b.(*AlertOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AutonomyConfig)(nil), (*config.AutonomyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AutonomyConfig_To_config_AutonomyConfig(a.(*AutonomyConfig), b.(*config.AutonomyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AutonomyConfig)(nil), (*AutonomyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AutonomyConfig_To_v1alpha1_AutonomyConfig(a.(*config.AutonomyConfig), b.(*AutonomyConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupBucketControllerConfiguration)(nil), (*config.BackupBucketControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BackupBucketControllerConfiguration_To_config_BackupBucketControllerConfiguration(a.(*BackupBucketControllerConfiguration), b.(*config.BackupBucketControllerConfiguration), scope)
 	}); err != nil {
@@ -73,6 +153,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupFreshnessThresholds)(nil), (*config.BackupFreshnessThresholds)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupFreshnessThresholds_To_config_BackupFreshnessThresholds(a.(*BackupFreshnessThresholds), b.(*config.BackupFreshnessThresholds), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BackupFreshnessThresholds)(nil), (*BackupFreshnessThresholds)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BackupFreshnessThresholds_To_v1alpha1_BackupFreshnessThresholds(a.(*config.BackupFreshnessThresholds), b.(*BackupFreshnessThresholds), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionControllerConfiguration)(nil), (*config.BastionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionControllerConfiguration_To_config_BastionControllerConfiguration(a.(*BastionControllerConfiguration), b.(*config.BastionControllerConfiguration), scope)
 	}); err != nil {
@@ -93,6 +183,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ContinuousProfiling)(nil), (*config.ContinuousProfiling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContinuousProfiling_To_config_ContinuousProfiling(a.(*ContinuousProfiling), b.(*config.ContinuousProfiling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ContinuousProfiling)(nil), (*ContinuousProfiling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ContinuousProfiling_To_v1alpha1_ContinuousProfiling(a.(*config.ContinuousProfiling), b.(*ContinuousProfiling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerInstallationCareControllerConfiguration)(nil), (*config.ControllerInstallationCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerInstallationCareControllerConfiguration_To_config_ControllerInstallationCareControllerConfiguration(a.(*ControllerInstallationCareControllerConfiguration), b.(*config.ControllerInstallationCareControllerConfiguration), scope)
 	}); err != nil {
@@ -163,6 +263,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCDLocalStorage)(nil), (*config.ETCDLocalStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDLocalStorage_To_config_ETCDLocalStorage(a.(*ETCDLocalStorage), b.(*config.ETCDLocalStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ETCDLocalStorage)(nil), (*ETCDLocalStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ETCDLocalStorage_To_v1alpha1_ETCDLocalStorage(a.(*config.ETCDLocalStorage), b.(*ETCDLocalStorage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExposureClassHandler)(nil), (*config.ExposureClassHandler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExposureClassHandler_To_config_ExposureClassHandler(a.(*ExposureClassHandler), b.(*config.ExposureClassHandler), scope)
 	}); err != nil {
@@ -213,6 +323,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageVectorOverwrite)(nil), (*config.ImageVectorOverwrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageVectorOverwrite_To_config_ImageVectorOverwrite(a.(*ImageVectorOverwrite), b.(*config.ImageVectorOverwrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImageVectorOverwrite)(nil), (*ImageVectorOverwrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImageVectorOverwrite_To_v1alpha1_ImageVectorOverwrite(a.(*config.ImageVectorOverwrite), b.(*ImageVectorOverwrite), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeconfigValidity)(nil), (*config.KubeconfigValidity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeconfigValidity_To_config_KubeconfigValidity(a.(*KubeconfigValidity), b.(*config.KubeconfigValidity), scope)
 	}); err != nil {
@@ -233,6 +353,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LoadShedding)(nil), (*config.LoadShedding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadShedding_To_config_LoadShedding(a.(*LoadShedding), b.(*config.LoadShedding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LoadShedding)(nil), (*LoadShedding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LoadShedding_To_v1alpha1_LoadShedding(a.(*config.LoadShedding), b.(*LoadShedding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogForwarding)(nil), (*config.LogForwarding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogForwarding_To_config_LogForwarding(a.(*LogForwarding), b.(*config.LogForwarding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LogForwarding)(nil), (*LogForwarding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LogForwarding_To_v1alpha1_LogForwarding(a.(*config.LogForwarding), b.(*LogForwarding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogForwardingOTLP)(nil), (*config.LogForwardingOTLP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogForwardingOTLP_To_config_LogForwardingOTLP(a.(*LogForwardingOTLP), b.(*config.LogForwardingOTLP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LogForwardingOTLP)(nil), (*LogForwardingOTLP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LogForwardingOTLP_To_v1alpha1_LogForwardingOTLP(a.(*config.LogForwardingOTLP), b.(*LogForwardingOTLP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogForwardingRedaction)(nil), (*config.LogForwardingRedaction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogForwardingRedaction_To_config_LogForwardingRedaction(a.(*LogForwardingRedaction), b.(*config.LogForwardingRedaction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LogForwardingRedaction)(nil), (*LogForwardingRedaction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LogForwardingRedaction_To_v1alpha1_LogForwardingRedaction(a.(*config.LogForwardingRedaction), b.(*LogForwardingRedaction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogForwardingSelector)(nil), (*config.LogForwardingSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogForwardingSelector_To_config_LogForwardingSelector(a.(*LogForwardingSelector), b.(*config.LogForwardingSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LogForwardingSelector)(nil), (*LogForwardingSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LogForwardingSelector_To_v1alpha1_LogForwardingSelector(a.(*config.LogForwardingSelector), b.(*LogForwardingSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogForwardingSyslog)(nil), (*config.LogForwardingSyslog)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogForwardingSyslog_To_config_LogForwardingSyslog(a.(*LogForwardingSyslog), b.(*config.LogForwardingSyslog), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LogForwardingSyslog)(nil), (*LogForwardingSyslog)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LogForwardingSyslog_To_v1alpha1_LogForwardingSyslog(a.(*config.LogForwardingSyslog), b.(*LogForwardingSyslog), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogForwardingTLS)(nil), (*config.LogForwardingTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogForwardingTLS_To_config_LogForwardingTLS(a.(*LogForwardingTLS), b.(*config.LogForwardingTLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LogForwardingTLS)(nil), (*LogForwardingTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LogForwardingTLS_To_v1alpha1_LogForwardingTLS(a.(*config.LogForwardingTLS), b.(*LogForwardingTLS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Logging)(nil), (*config.Logging)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Logging_To_config_Logging(a.(*Logging), b.(*config.Logging), scope)
 	}); err != nil {
@@ -263,6 +453,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MonitoringCustomization)(nil), (*config.MonitoringCustomization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MonitoringCustomization_To_config_MonitoringCustomization(a.(*MonitoringCustomization), b.(*config.MonitoringCustomization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MonitoringCustomization)(nil), (*MonitoringCustomization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MonitoringCustomization_To_v1alpha1_MonitoringCustomization(a.(*config.MonitoringCustomization), b.(*MonitoringCustomization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MonitoringCustomizations)(nil), (*config.MonitoringCustomizations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MonitoringCustomizations_To_config_MonitoringCustomizations(a.(*MonitoringCustomizations), b.(*config.MonitoringCustomizations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MonitoringCustomizations)(nil), (*MonitoringCustomizations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MonitoringCustomizations_To_v1alpha1_MonitoringCustomizations(a.(*config.MonitoringCustomizations), b.(*MonitoringCustomizations), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicyControllerConfiguration)(nil), (*config.NetworkPolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(a.(*NetworkPolicyControllerConfiguration), b.(*config.NetworkPolicyControllerConfiguration), scope)
 	}); err != nil {
@@ -353,6 +563,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedConformanceChecks)(nil), (*config.SeedConformanceChecks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedConformanceChecks_To_config_SeedConformanceChecks(a.(*SeedConformanceChecks), b.(*config.SeedConformanceChecks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedConformanceChecks)(nil), (*SeedConformanceChecks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedConformanceChecks_To_v1alpha1_SeedConformanceChecks(a.(*config.SeedConformanceChecks), b.(*SeedConformanceChecks), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedControllerConfiguration)(nil), (*config.SeedControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerConfiguration(a.(*SeedControllerConfiguration), b.(*config.SeedControllerConfiguration), scope)
 	}); err != nil {
@@ -363,6 +583,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedIngressCertificateControllerConfiguration)(nil), (*config.SeedIngressCertificateControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedIngressCertificateControllerConfiguration_To_config_SeedIngressCertificateControllerConfiguration(a.(*SeedIngressCertificateControllerConfiguration), b.(*config.SeedIngressCertificateControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedIngressCertificateControllerConfiguration)(nil), (*SeedIngressCertificateControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedIngressCertificateControllerConfiguration_To_v1alpha1_SeedIngressCertificateControllerConfiguration(a.(*config.SeedIngressCertificateControllerConfiguration), b.(*SeedIngressCertificateControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -383,6 +613,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShardingConfiguration)(nil), (*config.ShardingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(a.(*ShardingConfiguration), b.(*config.ShardingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShardingConfiguration)(nil), (*ShardingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(a.(*config.ShardingConfiguration), b.(*ShardingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCareControllerConfiguration)(nil), (*config.ShootCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(a.(*ShootCareControllerConfiguration), b.(*config.ShootCareControllerConfiguration), scope)
 	}); err != nil {
@@ -423,6 +663,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootMeteringBigQuerySink)(nil), (*config.ShootMeteringBigQuerySink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMeteringBigQuerySink_To_config_ShootMeteringBigQuerySink(a.(*ShootMeteringBigQuerySink), b.(*config.ShootMeteringBigQuerySink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootMeteringBigQuerySink)(nil), (*ShootMeteringBigQuerySink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootMeteringBigQuerySink_To_v1alpha1_ShootMeteringBigQuerySink(a.(*config.ShootMeteringBigQuerySink), b.(*ShootMeteringBigQuerySink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootMeteringControllerConfiguration)(nil), (*config.ShootMeteringControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMeteringControllerConfiguration_To_config_ShootMeteringControllerConfiguration(a.(*ShootMeteringControllerConfiguration), b.(*config.ShootMeteringControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootMeteringControllerConfiguration)(nil), (*ShootMeteringControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootMeteringControllerConfiguration_To_v1alpha1_ShootMeteringControllerConfiguration(a.(*config.ShootMeteringControllerConfiguration), b.(*ShootMeteringControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootMeteringPrometheusRemoteWriteSink)(nil), (*config.ShootMeteringPrometheusRemoteWriteSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMeteringPrometheusRemoteWriteSink_To_config_ShootMeteringPrometheusRemoteWriteSink(a.(*ShootMeteringPrometheusRemoteWriteSink), b.(*config.ShootMeteringPrometheusRemoteWriteSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootMeteringPrometheusRemoteWriteSink)(nil), (*ShootMeteringPrometheusRemoteWriteSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootMeteringPrometheusRemoteWriteSink_To_v1alpha1_ShootMeteringPrometheusRemoteWriteSink(a.(*config.ShootMeteringPrometheusRemoteWriteSink), b.(*ShootMeteringPrometheusRemoteWriteSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootMeteringS3Sink)(nil), (*config.ShootMeteringS3Sink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMeteringS3Sink_To_config_ShootMeteringS3Sink(a.(*ShootMeteringS3Sink), b.(*config.ShootMeteringS3Sink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootMeteringS3Sink)(nil), (*ShootMeteringS3Sink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootMeteringS3Sink_To_v1alpha1_ShootMeteringS3Sink(a.(*config.ShootMeteringS3Sink), b.(*ShootMeteringS3Sink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootMeteringSink)(nil), (*config.ShootMeteringSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMeteringSink_To_config_ShootMeteringSink(a.(*ShootMeteringSink), b.(*config.ShootMeteringSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootMeteringSink)(nil), (*ShootMeteringSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootMeteringSink_To_v1alpha1_ShootMeteringSink(a.(*config.ShootMeteringSink), b.(*ShootMeteringSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootMigrationConfiguration)(nil), (*config.ShootMigrationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMigrationConfiguration_To_config_ShootMigrationConfiguration(a.(*ShootMigrationConfiguration), b.(*config.ShootMigrationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootMigrationConfiguration)(nil), (*ShootMigrationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootMigrationConfiguration_To_v1alpha1_ShootMigrationConfiguration(a.(*config.ShootMigrationConfiguration), b.(*ShootMigrationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootMonitoringConfig)(nil), (*config.ShootMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootMonitoringConfig_To_config_ShootMonitoringConfig(a.(*ShootMonitoringConfig), b.(*config.ShootMonitoringConfig), scope)
 	}); err != nil {
@@ -433,6 +733,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootMonitoringSSOConfig)(nil), (*config.ShootMonitoringSSOConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMonitoringSSOConfig_To_config_ShootMonitoringSSOConfig(a.(*ShootMonitoringSSOConfig), b.(*config.ShootMonitoringSSOConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootMonitoringSSOConfig)(nil), (*ShootMonitoringSSOConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootMonitoringSSOConfig_To_v1alpha1_ShootMonitoringSSOConfig(a.(*config.ShootMonitoringSSOConfig), b.(*ShootMonitoringSSOConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootNamespaceJanitorControllerConfiguration)(nil), (*config.ShootNamespaceJanitorControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootNamespaceJanitorControllerConfiguration_To_config_ShootNamespaceJanitorControllerConfiguration(a.(*ShootNamespaceJanitorControllerConfiguration), b.(*config.ShootNamespaceJanitorControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootNamespaceJanitorControllerConfiguration)(nil), (*ShootNamespaceJanitorControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootNamespaceJanitorControllerConfiguration_To_v1alpha1_ShootNamespaceJanitorControllerConfiguration(a.(*config.ShootNamespaceJanitorControllerConfiguration), b.(*ShootNamespaceJanitorControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootNodeLogging)(nil), (*config.ShootNodeLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootNodeLogging_To_config_ShootNodeLogging(a.(*ShootNodeLogging), b.(*config.ShootNodeLogging), scope)
 	}); err != nil {
@@ -443,6 +763,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootPrometheusAgentConfig)(nil), (*config.ShootPrometheusAgentConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPrometheusAgentConfig_To_config_ShootPrometheusAgentConfig(a.(*ShootPrometheusAgentConfig), b.(*config.ShootPrometheusAgentConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootPrometheusAgentConfig)(nil), (*ShootPrometheusAgentConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootPrometheusAgentConfig_To_v1alpha1_ShootPrometheusAgentConfig(a.(*config.ShootPrometheusAgentConfig), b.(*ShootPrometheusAgentConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootStateControllerConfiguration)(nil), (*config.ShootStateControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateControllerConfiguration(a.(*ShootStateControllerConfiguration), b.(*config.ShootStateControllerConfiguration), scope)
 	}); err != nil {
@@ -496,6 +826,206 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_ACMEDNSChallengeProvider_To_config_ACMEDNSChallengeProvider(in *ACMEDNSChallengeProvider, out *config.ACMEDNSChallengeProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.SecretRef = in.SecretRef
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	return nil
+}
+
+// Convert_v1alpha1_ACMEDNSChallengeProvider_To_config_ACMEDNSChallengeProvider is an autogenerated conversion function.
+func Convert_v1alpha1_ACMEDNSChallengeProvider_To_config_ACMEDNSChallengeProvider(in *ACMEDNSChallengeProvider, out *config.ACMEDNSChallengeProvider, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ACMEDNSChallengeProvider_To_config_ACMEDNSChallengeProvider(in, out, s)
+}
+
+func autoConvert_config_ACMEDNSChallengeProvider_To_v1alpha1_ACMEDNSChallengeProvider(in *config.ACMEDNSChallengeProvider, out *ACMEDNSChallengeProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.SecretRef = in.SecretRef
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	return nil
+}
+
+// Convert_config_ACMEDNSChallengeProvider_To_v1alpha1_ACMEDNSChallengeProvider is an autogenerated conversion function.
+func Convert_config_ACMEDNSChallengeProvider_To_v1alpha1_ACMEDNSChallengeProvider(in *config.ACMEDNSChallengeProvider, out *ACMEDNSChallengeProvider, s conversion.Scope) error {
+	return autoConvert_config_ACMEDNSChallengeProvider_To_v1alpha1_ACMEDNSChallengeProvider(in, out, s)
+}
+
+func autoConvert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding(in *ACMEExternalAccountBinding, out *config.ACMEExternalAccountBinding, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.KeySecretRef = in.KeySecretRef
+	return nil
+}
+
+// Convert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding is an autogenerated conversion function.
+func Convert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding(in *ACMEExternalAccountBinding, out *config.ACMEExternalAccountBinding, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding(in, out, s)
+}
+
+func autoConvert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in *config.ACMEExternalAccountBinding, out *ACMEExternalAccountBinding, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.KeySecretRef = in.KeySecretRef
+	return nil
+}
+
+// Convert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding is an autogenerated conversion function.
+func Convert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in *config.ACMEExternalAccountBinding, out *ACMEExternalAccountBinding, s conversion.Scope) error {
+	return autoConvert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in, out, s)
+}
+
+func autoConvert_v1alpha1_ACMEIssuer_To_config_ACMEIssuer(in *ACMEIssuer, out *config.ACMEIssuer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Server = in.Server
+	out.Email = (*string)(unsafe.Pointer(in.Email))
+	out.ExternalAccountBinding = (*config.ACMEExternalAccountBinding)(unsafe.Pointer(in.ExternalAccountBinding))
+	out.DNSChallengeProvider = (*config.ACMEDNSChallengeProvider)(unsafe.Pointer(in.DNSChallengeProvider))
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuer_To_config_ACMEIssuer is an autogenerated conversion function.
+func Convert_v1alpha1_ACMEIssuer_To_config_ACMEIssuer(in *ACMEIssuer, out *config.ACMEIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ACMEIssuer_To_config_ACMEIssuer(in, out, s)
+}
+
+func autoConvert_config_ACMEIssuer_To_v1alpha1_ACMEIssuer(in *config.ACMEIssuer, out *ACMEIssuer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Server = in.Server
+	out.Email = (*string)(unsafe.Pointer(in.Email))
+	out.ExternalAccountBinding = (*ACMEExternalAccountBinding)(unsafe.Pointer(in.ExternalAccountBinding))
+	out.DNSChallengeProvider = (*ACMEDNSChallengeProvider)(unsafe.Pointer(in.DNSChallengeProvider))
+	return nil
+}
+
+// Convert_config_ACMEIssuer_To_v1alpha1_ACMEIssuer is an autogenerated conversion function.
+func Convert_config_ACMEIssuer_To_v1alpha1_ACMEIssuer(in *config.ACMEIssuer, out *ACMEIssuer, s conversion.Scope) error {
+	return autoConvert_config_ACMEIssuer_To_v1alpha1_ACMEIssuer(in, out, s)
+}
+
+func autoConvert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting(in *AdaptiveRateLimiting, out *config.AdaptiveRateLimiting, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MinQPS = (*float32)(unsafe.Pointer(in.MinQPS))
+	out.LatencyThreshold = (*v1.Duration)(unsafe.Pointer(in.LatencyThreshold))
+	out.LoadShedding = (*config.LoadShedding)(unsafe.Pointer(in.LoadShedding))
+	return nil
+}
+
+// Convert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting is an autogenerated conversion function.
+func Convert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting(in *AdaptiveRateLimiting, out *config.AdaptiveRateLimiting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting(in, out, s)
+}
+
+func autoConvert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting(in *config.AdaptiveRateLimiting, out *AdaptiveRateLimiting, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MinQPS = (*float32)(unsafe.Pointer(in.MinQPS))
+	out.LatencyThreshold = (*v1.Duration)(unsafe.Pointer(in.LatencyThreshold))
+	out.LoadShedding = (*LoadShedding)(unsafe.Pointer(in.LoadShedding))
+	return nil
+}
+
+// Convert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting is an autogenerated conversion function.
+func Convert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting(in *config.AdaptiveRateLimiting, out *AdaptiveRateLimiting, s conversion.Scope) error {
+	return autoConvert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting(in, out, s)
+}
+
+func autoConvert_v1alpha1_AlertForwardingConfig_To_config_AlertForwardingConfig(in *AlertForwardingConfig, out *config.AlertForwardingConfig, s conversion.Scope) error {
+	out.Runbooks = *(*map[string]string)(unsafe.Pointer(&in.Runbooks))
+	out.Webhooks = *(*[]config.AlertForwardingWebhook)(unsafe.Pointer(&in.Webhooks))
+	return nil
+}
+
+// Convert_v1alpha1_AlertForwardingConfig_To_config_AlertForwardingConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AlertForwardingConfig_To_config_AlertForwardingConfig(in *AlertForwardingConfig, out *config.AlertForwardingConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertForwardingConfig_To_config_AlertForwardingConfig(in, out, s)
+}
+
+func autoConvert_config_AlertForwardingConfig_To_v1alpha1_AlertForwardingConfig(in *config.AlertForwardingConfig, out *AlertForwardingConfig, s conversion.Scope) error {
+	out.Runbooks = *(*map[string]string)(unsafe.Pointer(&in.Runbooks))
+	out.Webhooks = *(*[]AlertForwardingWebhook)(unsafe.Pointer(&in.Webhooks))
+	return nil
+}
+
+// Convert_config_AlertForwardingConfig_To_v1alpha1_AlertForwardingConfig is an autogenerated conversion function.
+func Convert_config_AlertForwardingConfig_To_v1alpha1_AlertForwardingConfig(in *config.AlertForwardingConfig, out *AlertForwardingConfig, s conversion.Scope) error {
+	return autoConvert_config_AlertForwardingConfig_To_v1alpha1_AlertForwardingConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_AlertForwardingWebhook_To_config_AlertForwardingWebhook(in *AlertForwardingWebhook, out *config.AlertForwardingWebhook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Format = config.AlertForwardingFormat(in.Format)
+	out.SecretRef = in.SecretRef
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.ShootPurposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.ShootPurposes))
+	return nil
+}
+
+// Convert_v1alpha1_AlertForwardingWebhook_To_config_AlertForwardingWebhook is an autogenerated conversion function.
+func Convert_v1alpha1_AlertForwardingWebhook_To_config_AlertForwardingWebhook(in *AlertForwardingWebhook, out *config.AlertForwardingWebhook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertForwardingWebhook_To_config_AlertForwardingWebhook(in, out, s)
+}
+
+func autoConvert_config_AlertForwardingWebhook_To_v1alpha1_AlertForwardingWebhook(in *config.AlertForwardingWebhook, out *AlertForwardingWebhook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Format = AlertForwardingFormat(in.Format)
+	out.SecretRef = in.SecretRef
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.ShootPurposes = *(*[]v1beta1.ShootPurpose)(unsafe.Pointer(&in.ShootPurposes))
+	return nil
+}
+
+// Convert_config_AlertForwardingWebhook_To_v1alpha1_AlertForwardingWebhook is an autogenerated conversion function.
+func Convert_config_AlertForwardingWebhook_To_v1alpha1_AlertForwardingWebhook(in *config.AlertForwardingWebhook, out *AlertForwardingWebhook, s conversion.Scope) error {
+	return autoConvert_config_AlertForwardingWebhook_To_v1alpha1_AlertForwardingWebhook(in, out, s)
+}
+
+func autoConvert_v1alpha1_AlertOverride_To_config_AlertOverride(in *AlertOverride, out *config.AlertOverride, s conversion.Scope) error {
+	out.AlertName = in.AlertName
+	out.ShootPurposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.ShootPurposes))
+	out.Severity = (*string)(unsafe.Pointer(in.Severity))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Drop = in.Drop
+	return nil
+}
+
+// Convert_v1alpha1_AlertOverride_To_config_AlertOverride is an autogenerated conversion function.
+func Convert_v1alpha1_AlertOverride_To_config_AlertOverride(in *AlertOverride, out *config.AlertOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertOverride_To_config_AlertOverride(in, out, s)
+}
+
+func autoConvert_config_AlertOverride_To_v1alpha1_AlertOverride(in *config.AlertOverride, out *AlertOverride, s conversion.Scope) error {
+	out.AlertName = in.AlertName
+	out.ShootPurposes = *(*[]v1beta1.ShootPurpose)(unsafe.Pointer(&in.ShootPurposes))
+	out.Severity = (*string)(unsafe.Pointer(in.Severity))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Drop = in.Drop
+	return nil
+}
+
+// Convert_config_AlertOverride_To_v1alpha1_AlertOverride is an autogenerated conversion function.
+func Convert_config_AlertOverride_To_v1alpha1_AlertOverride(in *config.AlertOverride, out *AlertOverride, s conversion.Scope) error {
+	return autoConvert_config_AlertOverride_To_v1alpha1_AlertOverride(in, out, s)
+}
+
+func autoConvert_v1alpha1_AutonomyConfig_To_config_AutonomyConfig(in *AutonomyConfig, out *config.AutonomyConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.GardenOutageThreshold = (*v1.Duration)(unsafe.Pointer(in.GardenOutageThreshold))
+	return nil
+}
+
+// Convert_v1alpha1_AutonomyConfig_To_config_AutonomyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AutonomyConfig_To_config_AutonomyConfig(in *AutonomyConfig, out *config.AutonomyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AutonomyConfig_To_config_AutonomyConfig(in, out, s)
+}
+
+func autoConvert_config_AutonomyConfig_To_v1alpha1_AutonomyConfig(in *config.AutonomyConfig, out *AutonomyConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.GardenOutageThreshold = (*v1.Duration)(unsafe.Pointer(in.GardenOutageThreshold))
+	return nil
+}
+
+// Convert_config_AutonomyConfig_To_v1alpha1_AutonomyConfig is an autogenerated conversion function.
+func Convert_config_AutonomyConfig_To_v1alpha1_AutonomyConfig(in *config.AutonomyConfig, out *AutonomyConfig, s conversion.Scope) error {
+	return autoConvert_config_AutonomyConfig_To_v1alpha1_AutonomyConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_BackupBucketControllerConfiguration_To_config_BackupBucketControllerConfiguration(in *BackupBucketControllerConfiguration, out *config.BackupBucketControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -568,6 +1098,28 @@ func Convert_config_BackupEntryControllerConfiguration_To_v1alpha1_BackupEntryCo
 	return autoConvert_config_BackupEntryControllerConfiguration_To_v1alpha1_BackupEntryControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_BackupFreshnessThresholds_To_config_BackupFreshnessThresholds(in *BackupFreshnessThresholds, out *config.BackupFreshnessThresholds, s conversion.Scope) error {
+	out.FullSnapshot = (*v1.Duration)(unsafe.Pointer(in.FullSnapshot))
+	out.DeltaSnapshot = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshot))
+	return nil
+}
+
+// Convert_v1alpha1_BackupFreshnessThresholds_To_config_BackupFreshnessThresholds is an autogenerated conversion function.
+func Convert_v1alpha1_BackupFreshnessThresholds_To_config_BackupFreshnessThresholds(in *BackupFreshnessThresholds, out *config.BackupFreshnessThresholds, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupFreshnessThresholds_To_config_BackupFreshnessThresholds(in, out, s)
+}
+
+func autoConvert_config_BackupFreshnessThresholds_To_v1alpha1_BackupFreshnessThresholds(in *config.BackupFreshnessThresholds, out *BackupFreshnessThresholds, s conversion.Scope) error {
+	out.FullSnapshot = (*v1.Duration)(unsafe.Pointer(in.FullSnapshot))
+	out.DeltaSnapshot = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshot))
+	return nil
+}
+
+// Convert_config_BackupFreshnessThresholds_To_v1alpha1_BackupFreshnessThresholds is an autogenerated conversion function.
+func Convert_config_BackupFreshnessThresholds_To_v1alpha1_BackupFreshnessThresholds(in *config.BackupFreshnessThresholds, out *BackupFreshnessThresholds, s conversion.Scope) error {
+	return autoConvert_config_BackupFreshnessThresholds_To_v1alpha1_BackupFreshnessThresholds(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionControllerConfiguration_To_config_BastionControllerConfiguration(in *BastionControllerConfiguration, out *config.BastionControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -610,6 +1162,30 @@ func Convert_config_ConditionThreshold_To_v1alpha1_ConditionThreshold(in *config
 	return autoConvert_config_ConditionThreshold_To_v1alpha1_ConditionThreshold(in, out, s)
 }
 
+func autoConvert_v1alpha1_ContinuousProfiling_To_config_ContinuousProfiling(in *ContinuousProfiling, out *config.ContinuousProfiling, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ScrapeInterval = (*v1.Duration)(unsafe.Pointer(in.ScrapeInterval))
+	out.StorageSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.StorageSecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_ContinuousProfiling_To_config_ContinuousProfiling is an autogenerated conversion function.
+func Convert_v1alpha1_ContinuousProfiling_To_config_ContinuousProfiling(in *ContinuousProfiling, out *config.ContinuousProfiling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContinuousProfiling_To_config_ContinuousProfiling(in, out, s)
+}
+
+func autoConvert_config_ContinuousProfiling_To_v1alpha1_ContinuousProfiling(in *config.ContinuousProfiling, out *ContinuousProfiling, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ScrapeInterval = (*v1.Duration)(unsafe.Pointer(in.ScrapeInterval))
+	out.StorageSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.StorageSecretRef))
+	return nil
+}
+
+// Convert_config_ContinuousProfiling_To_v1alpha1_ContinuousProfiling is an autogenerated conversion function.
+func Convert_config_ContinuousProfiling_To_v1alpha1_ContinuousProfiling(in *config.ContinuousProfiling, out *ContinuousProfiling, s conversion.Scope) error {
+	return autoConvert_config_ContinuousProfiling_To_v1alpha1_ContinuousProfiling(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerInstallationCareControllerConfiguration_To_config_ControllerInstallationCareControllerConfiguration(in *ControllerInstallationCareControllerConfiguration, out *config.ControllerInstallationCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -721,6 +1297,7 @@ func autoConvert_v1alpha1_ETCDConfig_To_config_ETCDConfig(in *ETCDConfig, out *c
 	out.BackupLeaderElection = (*config.ETCDBackupLeaderElection)(unsafe.Pointer(in.BackupLeaderElection))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	out.DeltaSnapshotRetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotRetentionPeriod))
+	out.LocalStorage = (*config.ETCDLocalStorage)(unsafe.Pointer(in.LocalStorage))
 	return nil
 }
 
@@ -736,6 +1313,7 @@ func autoConvert_config_ETCDConfig_To_v1alpha1_ETCDConfig(in *config.ETCDConfig,
 	out.BackupLeaderElection = (*ETCDBackupLeaderElection)(unsafe.Pointer(in.BackupLeaderElection))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	out.DeltaSnapshotRetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotRetentionPeriod))
+	out.LocalStorage = (*ETCDLocalStorage)(unsafe.Pointer(in.LocalStorage))
 	return nil
 }
 
@@ -759,9 +1337,31 @@ func autoConvert_config_ETCDController_To_v1alpha1_ETCDController(in *config.ETC
 	return nil
 }
 
-// Convert_config_ETCDController_To_v1alpha1_ETCDController is an autogenerated conversion function.
-func Convert_config_ETCDController_To_v1alpha1_ETCDController(in *config.ETCDController, out *ETCDController, s conversion.Scope) error {
-	return autoConvert_config_ETCDController_To_v1alpha1_ETCDController(in, out, s)
+// Convert_config_ETCDController_To_v1alpha1_ETCDController is an autogenerated conversion function.
+func Convert_config_ETCDController_To_v1alpha1_ETCDController(in *config.ETCDController, out *ETCDController, s conversion.Scope) error {
+	return autoConvert_config_ETCDController_To_v1alpha1_ETCDController(in, out, s)
+}
+
+func autoConvert_v1alpha1_ETCDLocalStorage_To_config_ETCDLocalStorage(in *ETCDLocalStorage, out *config.ETCDLocalStorage, s conversion.Scope) error {
+	out.StorageClassName = in.StorageClassName
+	out.NodeLossGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeLossGracePeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDLocalStorage_To_config_ETCDLocalStorage is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDLocalStorage_To_config_ETCDLocalStorage(in *ETCDLocalStorage, out *config.ETCDLocalStorage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDLocalStorage_To_config_ETCDLocalStorage(in, out, s)
+}
+
+func autoConvert_config_ETCDLocalStorage_To_v1alpha1_ETCDLocalStorage(in *config.ETCDLocalStorage, out *ETCDLocalStorage, s conversion.Scope) error {
+	out.StorageClassName = in.StorageClassName
+	out.NodeLossGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeLossGracePeriod))
+	return nil
+}
+
+// Convert_config_ETCDLocalStorage_To_v1alpha1_ETCDLocalStorage is an autogenerated conversion function.
+func Convert_config_ETCDLocalStorage_To_v1alpha1_ETCDLocalStorage(in *config.ETCDLocalStorage, out *ETCDLocalStorage, s conversion.Scope) error {
+	return autoConvert_config_ETCDLocalStorage_To_v1alpha1_ETCDLocalStorage(in, out, s)
 }
 
 func autoConvert_v1alpha1_ExposureClassHandler_To_config_ExposureClassHandler(in *ExposureClassHandler, out *config.ExposureClassHandler, s conversion.Scope) error {
@@ -915,6 +1515,9 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.Autonomy = (*config.AutonomyConfig)(unsafe.Pointer(in.Autonomy))
+	out.ContinuousProfiling = (*config.ContinuousProfiling)(unsafe.Pointer(in.ContinuousProfiling))
+	out.ImageVectorOverwrite = (*config.ImageVectorOverwrite)(unsafe.Pointer(in.ImageVectorOverwrite))
 	return nil
 }
 
@@ -992,6 +1595,9 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.Autonomy = (*AutonomyConfig)(unsafe.Pointer(in.Autonomy))
+	out.ContinuousProfiling = (*ContinuousProfiling)(unsafe.Pointer(in.ContinuousProfiling))
+	out.ImageVectorOverwrite = (*ImageVectorOverwrite)(unsafe.Pointer(in.ImageVectorOverwrite))
 	return nil
 }
 
@@ -1012,6 +1618,9 @@ func autoConvert_v1alpha1_GardenletControllerConfiguration_To_config_GardenletCo
 	out.Shoot = (*config.ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*config.ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*config.ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootNamespaceJanitor = (*config.ShootNamespaceJanitorControllerConfiguration)(unsafe.Pointer(in.ShootNamespaceJanitor))
+	out.ShootMetering = (*config.ShootMeteringControllerConfiguration)(unsafe.Pointer(in.ShootMetering))
+	out.SeedIngressCertificate = (*config.SeedIngressCertificateControllerConfiguration)(unsafe.Pointer(in.SeedIngressCertificate))
 	out.NetworkPolicy = (*config.NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*config.ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*config.TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
@@ -1035,6 +1644,9 @@ func autoConvert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletCo
 	out.Shoot = (*ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootNamespaceJanitor = (*ShootNamespaceJanitorControllerConfiguration)(unsafe.Pointer(in.ShootNamespaceJanitor))
+	out.ShootMetering = (*ShootMeteringControllerConfiguration)(unsafe.Pointer(in.ShootMetering))
+	out.SeedIngressCertificate = (*SeedIngressCertificateControllerConfiguration)(unsafe.Pointer(in.SeedIngressCertificate))
 	out.NetworkPolicy = (*NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
@@ -1046,6 +1658,26 @@ func Convert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletContro
 	return autoConvert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImageVectorOverwrite_To_config_ImageVectorOverwrite(in *ImageVectorOverwrite, out *config.ImageVectorOverwrite, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_v1alpha1_ImageVectorOverwrite_To_config_ImageVectorOverwrite is an autogenerated conversion function.
+func Convert_v1alpha1_ImageVectorOverwrite_To_config_ImageVectorOverwrite(in *ImageVectorOverwrite, out *config.ImageVectorOverwrite, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageVectorOverwrite_To_config_ImageVectorOverwrite(in, out, s)
+}
+
+func autoConvert_config_ImageVectorOverwrite_To_v1alpha1_ImageVectorOverwrite(in *config.ImageVectorOverwrite, out *ImageVectorOverwrite, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_config_ImageVectorOverwrite_To_v1alpha1_ImageVectorOverwrite is an autogenerated conversion function.
+func Convert_config_ImageVectorOverwrite_To_v1alpha1_ImageVectorOverwrite(in *config.ImageVectorOverwrite, out *ImageVectorOverwrite, s conversion.Scope) error {
+	return autoConvert_config_ImageVectorOverwrite_To_v1alpha1_ImageVectorOverwrite(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeconfigValidity_To_config_KubeconfigValidity(in *KubeconfigValidity, out *config.KubeconfigValidity, s conversion.Scope) error {
 	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
 	out.AutoRotationJitterPercentageMin = (*int32)(unsafe.Pointer(in.AutoRotationJitterPercentageMin))
@@ -1090,11 +1722,188 @@ func Convert_config_LoadBalancerServiceConfig_To_v1alpha1_LoadBalancerServiceCon
 	return autoConvert_config_LoadBalancerServiceConfig_To_v1alpha1_LoadBalancerServiceConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_LoadShedding_To_config_LoadShedding(in *LoadShedding, out *config.LoadShedding, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SaturationWindow = (*v1.Duration)(unsafe.Pointer(in.SaturationWindow))
+	out.DeferPeriod = (*v1.Duration)(unsafe.Pointer(in.DeferPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_LoadShedding_To_config_LoadShedding is an autogenerated conversion function.
+func Convert_v1alpha1_LoadShedding_To_config_LoadShedding(in *LoadShedding, out *config.LoadShedding, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadShedding_To_config_LoadShedding(in, out, s)
+}
+
+func autoConvert_config_LoadShedding_To_v1alpha1_LoadShedding(in *config.LoadShedding, out *LoadShedding, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SaturationWindow = (*v1.Duration)(unsafe.Pointer(in.SaturationWindow))
+	out.DeferPeriod = (*v1.Duration)(unsafe.Pointer(in.DeferPeriod))
+	return nil
+}
+
+// Convert_config_LoadShedding_To_v1alpha1_LoadShedding is an autogenerated conversion function.
+func Convert_config_LoadShedding_To_v1alpha1_LoadShedding(in *config.LoadShedding, out *LoadShedding, s conversion.Scope) error {
+	return autoConvert_config_LoadShedding_To_v1alpha1_LoadShedding(in, out, s)
+}
+
+func autoConvert_v1alpha1_LogForwarding_To_config_LogForwarding(in *LogForwarding, out *config.LogForwarding, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Syslog = (*config.LogForwardingSyslog)(unsafe.Pointer(in.Syslog))
+	out.OTLP = (*config.LogForwardingOTLP)(unsafe.Pointer(in.OTLP))
+	if err := Convert_v1alpha1_LogForwardingSelector_To_config_LogForwardingSelector(&in.Selector, &out.Selector, s); err != nil {
+		return err
+	}
+	out.Redaction = (*config.LogForwardingRedaction)(unsafe.Pointer(in.Redaction))
+	out.TLS = (*config.LogForwardingTLS)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_v1alpha1_LogForwarding_To_config_LogForwarding is an autogenerated conversion function.
+func Convert_v1alpha1_LogForwarding_To_config_LogForwarding(in *LogForwarding, out *config.LogForwarding, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogForwarding_To_config_LogForwarding(in, out, s)
+}
+
+func autoConvert_config_LogForwarding_To_v1alpha1_LogForwarding(in *config.LogForwarding, out *LogForwarding, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Syslog = (*LogForwardingSyslog)(unsafe.Pointer(in.Syslog))
+	out.OTLP = (*LogForwardingOTLP)(unsafe.Pointer(in.OTLP))
+	if err := Convert_config_LogForwardingSelector_To_v1alpha1_LogForwardingSelector(&in.Selector, &out.Selector, s); err != nil {
+		return err
+	}
+	out.Redaction = (*LogForwardingRedaction)(unsafe.Pointer(in.Redaction))
+	out.TLS = (*LogForwardingTLS)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_config_LogForwarding_To_v1alpha1_LogForwarding is an autogenerated conversion function.
+func Convert_config_LogForwarding_To_v1alpha1_LogForwarding(in *config.LogForwarding, out *LogForwarding, s conversion.Scope) error {
+	return autoConvert_config_LogForwarding_To_v1alpha1_LogForwarding(in, out, s)
+}
+
+func autoConvert_v1alpha1_LogForwardingOTLP_To_config_LogForwardingOTLP(in *LogForwardingOTLP, out *config.LogForwardingOTLP, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.URI = (*string)(unsafe.Pointer(in.URI))
+	return nil
+}
+
+// Convert_v1alpha1_LogForwardingOTLP_To_config_LogForwardingOTLP is an autogenerated conversion function.
+func Convert_v1alpha1_LogForwardingOTLP_To_config_LogForwardingOTLP(in *LogForwardingOTLP, out *config.LogForwardingOTLP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogForwardingOTLP_To_config_LogForwardingOTLP(in, out, s)
+}
+
+func autoConvert_config_LogForwardingOTLP_To_v1alpha1_LogForwardingOTLP(in *config.LogForwardingOTLP, out *LogForwardingOTLP, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.URI = (*string)(unsafe.Pointer(in.URI))
+	return nil
+}
+
+// Convert_config_LogForwardingOTLP_To_v1alpha1_LogForwardingOTLP is an autogenerated conversion function.
+func Convert_config_LogForwardingOTLP_To_v1alpha1_LogForwardingOTLP(in *config.LogForwardingOTLP, out *LogForwardingOTLP, s conversion.Scope) error {
+	return autoConvert_config_LogForwardingOTLP_To_v1alpha1_LogForwardingOTLP(in, out, s)
+}
+
+func autoConvert_v1alpha1_LogForwardingRedaction_To_config_LogForwardingRedaction(in *LogForwardingRedaction, out *config.LogForwardingRedaction, s conversion.Scope) error {
+	out.RemoveKeys = *(*[]string)(unsafe.Pointer(&in.RemoveKeys))
+	out.MaskKeys = *(*[]string)(unsafe.Pointer(&in.MaskKeys))
+	return nil
+}
+
+// Convert_v1alpha1_LogForwardingRedaction_To_config_LogForwardingRedaction is an autogenerated conversion function.
+func Convert_v1alpha1_LogForwardingRedaction_To_config_LogForwardingRedaction(in *LogForwardingRedaction, out *config.LogForwardingRedaction, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogForwardingRedaction_To_config_LogForwardingRedaction(in, out, s)
+}
+
+func autoConvert_config_LogForwardingRedaction_To_v1alpha1_LogForwardingRedaction(in *config.LogForwardingRedaction, out *LogForwardingRedaction, s conversion.Scope) error {
+	out.RemoveKeys = *(*[]string)(unsafe.Pointer(&in.RemoveKeys))
+	out.MaskKeys = *(*[]string)(unsafe.Pointer(&in.MaskKeys))
+	return nil
+}
+
+// Convert_config_LogForwardingRedaction_To_v1alpha1_LogForwardingRedaction is an autogenerated conversion function.
+func Convert_config_LogForwardingRedaction_To_v1alpha1_LogForwardingRedaction(in *config.LogForwardingRedaction, out *LogForwardingRedaction, s conversion.Scope) error {
+	return autoConvert_config_LogForwardingRedaction_To_v1alpha1_LogForwardingRedaction(in, out, s)
+}
+
+func autoConvert_v1alpha1_LogForwardingSelector_To_config_LogForwardingSelector(in *LogForwardingSelector, out *config.LogForwardingSelector, s conversion.Scope) error {
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.Extensions = in.Extensions
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Components = *(*[]string)(unsafe.Pointer(&in.Components))
+	return nil
+}
+
+// Convert_v1alpha1_LogForwardingSelector_To_config_LogForwardingSelector is an autogenerated conversion function.
+func Convert_v1alpha1_LogForwardingSelector_To_config_LogForwardingSelector(in *LogForwardingSelector, out *config.LogForwardingSelector, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogForwardingSelector_To_config_LogForwardingSelector(in, out, s)
+}
+
+func autoConvert_config_LogForwardingSelector_To_v1alpha1_LogForwardingSelector(in *config.LogForwardingSelector, out *LogForwardingSelector, s conversion.Scope) error {
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.Extensions = in.Extensions
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Components = *(*[]string)(unsafe.Pointer(&in.Components))
+	return nil
+}
+
+// Convert_config_LogForwardingSelector_To_v1alpha1_LogForwardingSelector is an autogenerated conversion function.
+func Convert_config_LogForwardingSelector_To_v1alpha1_LogForwardingSelector(in *config.LogForwardingSelector, out *LogForwardingSelector, s conversion.Scope) error {
+	return autoConvert_config_LogForwardingSelector_To_v1alpha1_LogForwardingSelector(in, out, s)
+}
+
+func autoConvert_v1alpha1_LogForwardingSyslog_To_config_LogForwardingSyslog(in *LogForwardingSyslog, out *config.LogForwardingSyslog, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.Format = (*string)(unsafe.Pointer(in.Format))
+	return nil
+}
+
+// Convert_v1alpha1_LogForwardingSyslog_To_config_LogForwardingSyslog is an autogenerated conversion function.
+func Convert_v1alpha1_LogForwardingSyslog_To_config_LogForwardingSyslog(in *LogForwardingSyslog, out *config.LogForwardingSyslog, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogForwardingSyslog_To_config_LogForwardingSyslog(in, out, s)
+}
+
+func autoConvert_config_LogForwardingSyslog_To_v1alpha1_LogForwardingSyslog(in *config.LogForwardingSyslog, out *LogForwardingSyslog, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.Format = (*string)(unsafe.Pointer(in.Format))
+	return nil
+}
+
+// Convert_config_LogForwardingSyslog_To_v1alpha1_LogForwardingSyslog is an autogenerated conversion function.
+func Convert_config_LogForwardingSyslog_To_v1alpha1_LogForwardingSyslog(in *config.LogForwardingSyslog, out *LogForwardingSyslog, s conversion.Scope) error {
+	return autoConvert_config_LogForwardingSyslog_To_v1alpha1_LogForwardingSyslog(in, out, s)
+}
+
+func autoConvert_v1alpha1_LogForwardingTLS_To_config_LogForwardingTLS(in *LogForwardingTLS, out *config.LogForwardingTLS, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.ClientCertificate = in.ClientCertificate
+	return nil
+}
+
+// Convert_v1alpha1_LogForwardingTLS_To_config_LogForwardingTLS is an autogenerated conversion function.
+func Convert_v1alpha1_LogForwardingTLS_To_config_LogForwardingTLS(in *LogForwardingTLS, out *config.LogForwardingTLS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogForwardingTLS_To_config_LogForwardingTLS(in, out, s)
+}
+
+func autoConvert_config_LogForwardingTLS_To_v1alpha1_LogForwardingTLS(in *config.LogForwardingTLS, out *LogForwardingTLS, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.ClientCertificate = in.ClientCertificate
+	return nil
+}
+
+// Convert_config_LogForwardingTLS_To_v1alpha1_LogForwardingTLS is an autogenerated conversion function.
+func Convert_config_LogForwardingTLS_To_v1alpha1_LogForwardingTLS(in *config.LogForwardingTLS, out *LogForwardingTLS, s conversion.Scope) error {
+	return autoConvert_config_LogForwardingTLS_To_v1alpha1_LogForwardingTLS(in, out, s)
+}
+
 func autoConvert_v1alpha1_Logging_To_config_Logging(in *Logging, out *config.Logging, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Vali = (*config.Vali)(unsafe.Pointer(in.Vali))
 	out.ShootNodeLogging = (*config.ShootNodeLogging)(unsafe.Pointer(in.ShootNodeLogging))
 	out.ShootEventLogging = (*config.ShootEventLogging)(unsafe.Pointer(in.ShootEventLogging))
+	out.Forwarding = *(*[]config.LogForwarding)(unsafe.Pointer(&in.Forwarding))
 	return nil
 }
 
@@ -1108,6 +1917,7 @@ func autoConvert_config_Logging_To_v1alpha1_Logging(in *config.Logging, out *Log
 	out.Vali = (*Vali)(unsafe.Pointer(in.Vali))
 	out.ShootNodeLogging = (*ShootNodeLogging)(unsafe.Pointer(in.ShootNodeLogging))
 	out.ShootEventLogging = (*ShootEventLogging)(unsafe.Pointer(in.ShootEventLogging))
+	out.Forwarding = *(*[]LogForwarding)(unsafe.Pointer(&in.Forwarding))
 	return nil
 }
 
@@ -1146,6 +1956,7 @@ func Convert_config_ManagedSeedControllerConfiguration_To_v1alpha1_ManagedSeedCo
 
 func autoConvert_v1alpha1_MonitoringConfig_To_config_MonitoringConfig(in *MonitoringConfig, out *config.MonitoringConfig, s conversion.Scope) error {
 	out.Shoot = (*config.ShootMonitoringConfig)(unsafe.Pointer(in.Shoot))
+	out.Customizations = (*config.MonitoringCustomizations)(unsafe.Pointer(in.Customizations))
 	return nil
 }
 
@@ -1156,6 +1967,7 @@ func Convert_v1alpha1_MonitoringConfig_To_config_MonitoringConfig(in *Monitoring
 
 func autoConvert_config_MonitoringConfig_To_v1alpha1_MonitoringConfig(in *config.MonitoringConfig, out *MonitoringConfig, s conversion.Scope) error {
 	out.Shoot = (*ShootMonitoringConfig)(unsafe.Pointer(in.Shoot))
+	out.Customizations = (*MonitoringCustomizations)(unsafe.Pointer(in.Customizations))
 	return nil
 }
 
@@ -1164,9 +1976,54 @@ func Convert_config_MonitoringConfig_To_v1alpha1_MonitoringConfig(in *config.Mon
 	return autoConvert_config_MonitoringConfig_To_v1alpha1_MonitoringConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_MonitoringCustomization_To_config_MonitoringCustomization(in *MonitoringCustomization, out *config.MonitoringCustomization, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Patch = in.Patch
+	return nil
+}
+
+// Convert_v1alpha1_MonitoringCustomization_To_config_MonitoringCustomization is an autogenerated conversion function.
+func Convert_v1alpha1_MonitoringCustomization_To_config_MonitoringCustomization(in *MonitoringCustomization, out *config.MonitoringCustomization, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MonitoringCustomization_To_config_MonitoringCustomization(in, out, s)
+}
+
+func autoConvert_config_MonitoringCustomization_To_v1alpha1_MonitoringCustomization(in *config.MonitoringCustomization, out *MonitoringCustomization, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Patch = in.Patch
+	return nil
+}
+
+// Convert_config_MonitoringCustomization_To_v1alpha1_MonitoringCustomization is an autogenerated conversion function.
+func Convert_config_MonitoringCustomization_To_v1alpha1_MonitoringCustomization(in *config.MonitoringCustomization, out *MonitoringCustomization, s conversion.Scope) error {
+	return autoConvert_config_MonitoringCustomization_To_v1alpha1_MonitoringCustomization(in, out, s)
+}
+
+func autoConvert_v1alpha1_MonitoringCustomizations_To_config_MonitoringCustomizations(in *MonitoringCustomizations, out *config.MonitoringCustomizations, s conversion.Scope) error {
+	out.Dashboards = *(*[]config.MonitoringCustomization)(unsafe.Pointer(&in.Dashboards))
+	out.Rules = *(*[]config.MonitoringCustomization)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_MonitoringCustomizations_To_config_MonitoringCustomizations is an autogenerated conversion function.
+func Convert_v1alpha1_MonitoringCustomizations_To_config_MonitoringCustomizations(in *MonitoringCustomizations, out *config.MonitoringCustomizations, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MonitoringCustomizations_To_config_MonitoringCustomizations(in, out, s)
+}
+
+func autoConvert_config_MonitoringCustomizations_To_v1alpha1_MonitoringCustomizations(in *config.MonitoringCustomizations, out *MonitoringCustomizations, s conversion.Scope) error {
+	out.Dashboards = *(*[]MonitoringCustomization)(unsafe.Pointer(&in.Dashboards))
+	out.Rules = *(*[]MonitoringCustomization)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_config_MonitoringCustomizations_To_v1alpha1_MonitoringCustomizations is an autogenerated conversion function.
+func Convert_config_MonitoringCustomizations_To_v1alpha1_MonitoringCustomizations(in *config.MonitoringCustomizations, out *MonitoringCustomizations, s conversion.Scope) error {
+	return autoConvert_config_MonitoringCustomizations_To_v1alpha1_MonitoringCustomizations(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(in *NetworkPolicyControllerConfiguration, out *config.NetworkPolicyControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.AdditionalNamespaceSelectors = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.AdditionalNamespaceSelectors))
+	out.ExtensionsAccessToShootAPIServers = (*config.ExtensionsAccessMode)(unsafe.Pointer(in.ExtensionsAccessToShootAPIServers))
 	return nil
 }
 
@@ -1178,6 +2035,7 @@ func Convert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPoli
 func autoConvert_config_NetworkPolicyControllerConfiguration_To_v1alpha1_NetworkPolicyControllerConfiguration(in *config.NetworkPolicyControllerConfiguration, out *NetworkPolicyControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.AdditionalNamespaceSelectors = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.AdditionalNamespaceSelectors))
+	out.ExtensionsAccessToShootAPIServers = (*ExtensionsAccessMode)(unsafe.Pointer(in.ExtensionsAccessToShootAPIServers))
 	return nil
 }
 
@@ -1303,6 +2161,7 @@ func Convert_config_SNIIngress_To_v1alpha1_SNIIngress(in *config.SNIIngress, out
 func autoConvert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(in *SeedCareControllerConfiguration, out *config.SeedCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.ConformanceChecks = (*config.SeedConformanceChecks)(unsafe.Pointer(in.ConformanceChecks))
 	return nil
 }
 
@@ -1314,6 +2173,7 @@ func Convert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControll
 func autoConvert_config_SeedCareControllerConfiguration_To_v1alpha1_SeedCareControllerConfiguration(in *config.SeedCareControllerConfiguration, out *SeedCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.ConformanceChecks = (*SeedConformanceChecks)(unsafe.Pointer(in.ConformanceChecks))
 	return nil
 }
 
@@ -1370,6 +2230,26 @@ func Convert_config_SeedConfig_To_v1alpha1_SeedConfig(in *config.SeedConfig, out
 	return autoConvert_config_SeedConfig_To_v1alpha1_SeedConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedConformanceChecks_To_config_SeedConformanceChecks(in *SeedConformanceChecks, out *config.SeedConformanceChecks, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_SeedConformanceChecks_To_config_SeedConformanceChecks is an autogenerated conversion function.
+func Convert_v1alpha1_SeedConformanceChecks_To_config_SeedConformanceChecks(in *SeedConformanceChecks, out *config.SeedConformanceChecks, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedConformanceChecks_To_config_SeedConformanceChecks(in, out, s)
+}
+
+func autoConvert_config_SeedConformanceChecks_To_v1alpha1_SeedConformanceChecks(in *config.SeedConformanceChecks, out *SeedConformanceChecks, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_config_SeedConformanceChecks_To_v1alpha1_SeedConformanceChecks is an autogenerated conversion function.
+func Convert_config_SeedConformanceChecks_To_v1alpha1_SeedConformanceChecks(in *config.SeedConformanceChecks, out *SeedConformanceChecks, s conversion.Scope) error {
+	return autoConvert_config_SeedConformanceChecks_To_v1alpha1_SeedConformanceChecks(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerConfiguration(in *SeedControllerConfiguration, out *config.SeedControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.LeaseResyncSeconds = (*int32)(unsafe.Pointer(in.LeaseResyncSeconds))
@@ -1394,6 +2274,30 @@ func Convert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfig
 	return autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedIngressCertificateControllerConfiguration_To_config_SeedIngressCertificateControllerConfiguration(in *SeedIngressCertificateControllerConfiguration, out *config.SeedIngressCertificateControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Issuers = *(*[]config.ACMEIssuer)(unsafe.Pointer(&in.Issuers))
+	return nil
+}
+
+// Convert_v1alpha1_SeedIngressCertificateControllerConfiguration_To_config_SeedIngressCertificateControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedIngressCertificateControllerConfiguration_To_config_SeedIngressCertificateControllerConfiguration(in *SeedIngressCertificateControllerConfiguration, out *config.SeedIngressCertificateControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedIngressCertificateControllerConfiguration_To_config_SeedIngressCertificateControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedIngressCertificateControllerConfiguration_To_v1alpha1_SeedIngressCertificateControllerConfiguration(in *config.SeedIngressCertificateControllerConfiguration, out *SeedIngressCertificateControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Issuers = *(*[]ACMEIssuer)(unsafe.Pointer(&in.Issuers))
+	return nil
+}
+
+// Convert_config_SeedIngressCertificateControllerConfiguration_To_v1alpha1_SeedIngressCertificateControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedIngressCertificateControllerConfiguration_To_v1alpha1_SeedIngressCertificateControllerConfiguration(in *config.SeedIngressCertificateControllerConfiguration, out *SeedIngressCertificateControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedIngressCertificateControllerConfiguration_To_v1alpha1_SeedIngressCertificateControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -1438,6 +2342,28 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in *ShardingConfiguration, out *config.ShardingConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	return nil
+}
+
+// Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in *ShardingConfiguration, out *config.ShardingConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in *config.ShardingConfiguration, out *ShardingConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	return nil
+}
+
+// Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration is an autogenerated conversion function.
+func Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in *config.ShardingConfiguration, out *ShardingConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(in *ShootCareControllerConfiguration, out *config.ShootCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -1445,6 +2371,8 @@ func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.DeprecatedAPIUsageReporterEnabled = (*bool)(unsafe.Pointer(in.DeprecatedAPIUsageReporterEnabled))
+	out.BackupFreshnessThresholds = (*config.BackupFreshnessThresholds)(unsafe.Pointer(in.BackupFreshnessThresholds))
 	return nil
 }
 
@@ -1460,6 +2388,8 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.DeprecatedAPIUsageReporterEnabled = (*bool)(unsafe.Pointer(in.DeprecatedAPIUsageReporterEnabled))
+	out.BackupFreshnessThresholds = (*BackupFreshnessThresholds)(unsafe.Pointer(in.BackupFreshnessThresholds))
 	return nil
 }
 
@@ -1472,6 +2402,7 @@ func autoConvert_v1alpha1_ShootClientConnection_To_config_ShootClientConnection(
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
 	}
+	out.AdaptiveRateLimiting = (*config.AdaptiveRateLimiting)(unsafe.Pointer(in.AdaptiveRateLimiting))
 	return nil
 }
 
@@ -1484,6 +2415,7 @@ func autoConvert_config_ShootClientConnection_To_v1alpha1_ShootClientConnection(
 	if err := configv1alpha1.Convert_config_ClientConnectionConfiguration_To_v1alpha1_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
 	}
+	out.AdaptiveRateLimiting = (*AdaptiveRateLimiting)(unsafe.Pointer(in.AdaptiveRateLimiting))
 	return nil
 }
 
@@ -1500,6 +2432,8 @@ func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.DNSEntryTTLSeconds = (*int64)(unsafe.Pointer(in.DNSEntryTTLSeconds))
+	out.Sharding = (*config.ShardingConfiguration)(unsafe.Pointer(in.Sharding))
+	out.Migration = (*config.ShootMigrationConfiguration)(unsafe.Pointer(in.Migration))
 	return nil
 }
 
@@ -1516,6 +2450,8 @@ func autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.DNSEntryTTLSeconds = (*int64)(unsafe.Pointer(in.DNSEntryTTLSeconds))
+	out.Sharding = (*ShardingConfiguration)(unsafe.Pointer(in.Sharding))
+	out.Migration = (*ShootMigrationConfiguration)(unsafe.Pointer(in.Migration))
 	return nil
 }
 
@@ -1544,10 +2480,170 @@ func Convert_config_ShootEventLogging_To_v1alpha1_ShootEventLogging(in *config.S
 	return autoConvert_config_ShootEventLogging_To_v1alpha1_ShootEventLogging(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootMeteringBigQuerySink_To_config_ShootMeteringBigQuerySink(in *ShootMeteringBigQuerySink, out *config.ShootMeteringBigQuerySink, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Dataset = in.Dataset
+	out.Table = in.Table
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1alpha1_ShootMeteringBigQuerySink_To_config_ShootMeteringBigQuerySink is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMeteringBigQuerySink_To_config_ShootMeteringBigQuerySink(in *ShootMeteringBigQuerySink, out *config.ShootMeteringBigQuerySink, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMeteringBigQuerySink_To_config_ShootMeteringBigQuerySink(in, out, s)
+}
+
+func autoConvert_config_ShootMeteringBigQuerySink_To_v1alpha1_ShootMeteringBigQuerySink(in *config.ShootMeteringBigQuerySink, out *ShootMeteringBigQuerySink, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Dataset = in.Dataset
+	out.Table = in.Table
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_config_ShootMeteringBigQuerySink_To_v1alpha1_ShootMeteringBigQuerySink is an autogenerated conversion function.
+func Convert_config_ShootMeteringBigQuerySink_To_v1alpha1_ShootMeteringBigQuerySink(in *config.ShootMeteringBigQuerySink, out *ShootMeteringBigQuerySink, s conversion.Scope) error {
+	return autoConvert_config_ShootMeteringBigQuerySink_To_v1alpha1_ShootMeteringBigQuerySink(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootMeteringControllerConfiguration_To_config_ShootMeteringControllerConfiguration(in *ShootMeteringControllerConfiguration, out *config.ShootMeteringControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CostCenterLabel = (*string)(unsafe.Pointer(in.CostCenterLabel))
+	if err := Convert_v1alpha1_ShootMeteringSink_To_config_ShootMeteringSink(&in.Sink, &out.Sink, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootMeteringControllerConfiguration_To_config_ShootMeteringControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMeteringControllerConfiguration_To_config_ShootMeteringControllerConfiguration(in *ShootMeteringControllerConfiguration, out *config.ShootMeteringControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMeteringControllerConfiguration_To_config_ShootMeteringControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootMeteringControllerConfiguration_To_v1alpha1_ShootMeteringControllerConfiguration(in *config.ShootMeteringControllerConfiguration, out *ShootMeteringControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CostCenterLabel = (*string)(unsafe.Pointer(in.CostCenterLabel))
+	if err := Convert_config_ShootMeteringSink_To_v1alpha1_ShootMeteringSink(&in.Sink, &out.Sink, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ShootMeteringControllerConfiguration_To_v1alpha1_ShootMeteringControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootMeteringControllerConfiguration_To_v1alpha1_ShootMeteringControllerConfiguration(in *config.ShootMeteringControllerConfiguration, out *ShootMeteringControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootMeteringControllerConfiguration_To_v1alpha1_ShootMeteringControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootMeteringPrometheusRemoteWriteSink_To_config_ShootMeteringPrometheusRemoteWriteSink(in *ShootMeteringPrometheusRemoteWriteSink, out *config.ShootMeteringPrometheusRemoteWriteSink, s conversion.Scope) error {
+	out.URL = in.URL
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_ShootMeteringPrometheusRemoteWriteSink_To_config_ShootMeteringPrometheusRemoteWriteSink is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMeteringPrometheusRemoteWriteSink_To_config_ShootMeteringPrometheusRemoteWriteSink(in *ShootMeteringPrometheusRemoteWriteSink, out *config.ShootMeteringPrometheusRemoteWriteSink, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMeteringPrometheusRemoteWriteSink_To_config_ShootMeteringPrometheusRemoteWriteSink(in, out, s)
+}
+
+func autoConvert_config_ShootMeteringPrometheusRemoteWriteSink_To_v1alpha1_ShootMeteringPrometheusRemoteWriteSink(in *config.ShootMeteringPrometheusRemoteWriteSink, out *ShootMeteringPrometheusRemoteWriteSink, s conversion.Scope) error {
+	out.URL = in.URL
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_config_ShootMeteringPrometheusRemoteWriteSink_To_v1alpha1_ShootMeteringPrometheusRemoteWriteSink is an autogenerated conversion function.
+func Convert_config_ShootMeteringPrometheusRemoteWriteSink_To_v1alpha1_ShootMeteringPrometheusRemoteWriteSink(in *config.ShootMeteringPrometheusRemoteWriteSink, out *ShootMeteringPrometheusRemoteWriteSink, s conversion.Scope) error {
+	return autoConvert_config_ShootMeteringPrometheusRemoteWriteSink_To_v1alpha1_ShootMeteringPrometheusRemoteWriteSink(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootMeteringS3Sink_To_config_ShootMeteringS3Sink(in *ShootMeteringS3Sink, out *config.ShootMeteringS3Sink, s conversion.Scope) error {
+	out.Bucket = in.Bucket
+	out.Region = in.Region
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	out.Prefix = (*string)(unsafe.Pointer(in.Prefix))
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1alpha1_ShootMeteringS3Sink_To_config_ShootMeteringS3Sink is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMeteringS3Sink_To_config_ShootMeteringS3Sink(in *ShootMeteringS3Sink, out *config.ShootMeteringS3Sink, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMeteringS3Sink_To_config_ShootMeteringS3Sink(in, out, s)
+}
+
+func autoConvert_config_ShootMeteringS3Sink_To_v1alpha1_ShootMeteringS3Sink(in *config.ShootMeteringS3Sink, out *ShootMeteringS3Sink, s conversion.Scope) error {
+	out.Bucket = in.Bucket
+	out.Region = in.Region
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	out.Prefix = (*string)(unsafe.Pointer(in.Prefix))
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_config_ShootMeteringS3Sink_To_v1alpha1_ShootMeteringS3Sink is an autogenerated conversion function.
+func Convert_config_ShootMeteringS3Sink_To_v1alpha1_ShootMeteringS3Sink(in *config.ShootMeteringS3Sink, out *ShootMeteringS3Sink, s conversion.Scope) error {
+	return autoConvert_config_ShootMeteringS3Sink_To_v1alpha1_ShootMeteringS3Sink(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootMeteringSink_To_config_ShootMeteringSink(in *ShootMeteringSink, out *config.ShootMeteringSink, s conversion.Scope) error {
+	out.S3 = (*config.ShootMeteringS3Sink)(unsafe.Pointer(in.S3))
+	out.BigQuery = (*config.ShootMeteringBigQuerySink)(unsafe.Pointer(in.BigQuery))
+	out.PrometheusRemoteWrite = (*config.ShootMeteringPrometheusRemoteWriteSink)(unsafe.Pointer(in.PrometheusRemoteWrite))
+	return nil
+}
+
+// Convert_v1alpha1_ShootMeteringSink_To_config_ShootMeteringSink is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMeteringSink_To_config_ShootMeteringSink(in *ShootMeteringSink, out *config.ShootMeteringSink, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMeteringSink_To_config_ShootMeteringSink(in, out, s)
+}
+
+func autoConvert_config_ShootMeteringSink_To_v1alpha1_ShootMeteringSink(in *config.ShootMeteringSink, out *ShootMeteringSink, s conversion.Scope) error {
+	out.S3 = (*ShootMeteringS3Sink)(unsafe.Pointer(in.S3))
+	out.BigQuery = (*ShootMeteringBigQuerySink)(unsafe.Pointer(in.BigQuery))
+	out.PrometheusRemoteWrite = (*ShootMeteringPrometheusRemoteWriteSink)(unsafe.Pointer(in.PrometheusRemoteWrite))
+	return nil
+}
+
+// Convert_config_ShootMeteringSink_To_v1alpha1_ShootMeteringSink is an autogenerated conversion function.
+func Convert_config_ShootMeteringSink_To_v1alpha1_ShootMeteringSink(in *config.ShootMeteringSink, out *ShootMeteringSink, s conversion.Scope) error {
+	return autoConvert_config_ShootMeteringSink_To_v1alpha1_ShootMeteringSink(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootMigrationConfiguration_To_config_ShootMigrationConfiguration(in *ShootMigrationConfiguration, out *config.ShootMigrationConfiguration, s conversion.Scope) error {
+	out.MaxConcurrentIncoming = (*int)(unsafe.Pointer(in.MaxConcurrentIncoming))
+	out.MaxConcurrentOutgoing = (*int)(unsafe.Pointer(in.MaxConcurrentOutgoing))
+	out.PurposePriorities = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.PurposePriorities))
+	out.Paused = in.Paused
+	return nil
+}
+
+// Convert_v1alpha1_ShootMigrationConfiguration_To_config_ShootMigrationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMigrationConfiguration_To_config_ShootMigrationConfiguration(in *ShootMigrationConfiguration, out *config.ShootMigrationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMigrationConfiguration_To_config_ShootMigrationConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootMigrationConfiguration_To_v1alpha1_ShootMigrationConfiguration(in *config.ShootMigrationConfiguration, out *ShootMigrationConfiguration, s conversion.Scope) error {
+	out.MaxConcurrentIncoming = (*int)(unsafe.Pointer(in.MaxConcurrentIncoming))
+	out.MaxConcurrentOutgoing = (*int)(unsafe.Pointer(in.MaxConcurrentOutgoing))
+	out.PurposePriorities = *(*[]v1beta1.ShootPurpose)(unsafe.Pointer(&in.PurposePriorities))
+	out.Paused = in.Paused
+	return nil
+}
+
+// Convert_config_ShootMigrationConfiguration_To_v1alpha1_ShootMigrationConfiguration is an autogenerated conversion function.
+func Convert_config_ShootMigrationConfiguration_To_v1alpha1_ShootMigrationConfiguration(in *config.ShootMigrationConfiguration, out *ShootMigrationConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootMigrationConfiguration_To_v1alpha1_ShootMigrationConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootMonitoringConfig_To_config_ShootMonitoringConfig(in *ShootMonitoringConfig, out *config.ShootMonitoringConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.RemoteWrite = (*config.RemoteWriteMonitoringConfig)(unsafe.Pointer(in.RemoteWrite))
 	out.ExternalLabels = *(*map[string]string)(unsafe.Pointer(&in.ExternalLabels))
+	out.SSO = (*config.ShootMonitoringSSOConfig)(unsafe.Pointer(in.SSO))
+	out.AlertOverrides = *(*[]config.AlertOverride)(unsafe.Pointer(&in.AlertOverrides))
+	out.AlertForwarding = (*config.AlertForwardingConfig)(unsafe.Pointer(in.AlertForwarding))
+	out.Agent = (*config.ShootPrometheusAgentConfig)(unsafe.Pointer(in.Agent))
 	return nil
 }
 
@@ -1560,6 +2656,10 @@ func autoConvert_config_ShootMonitoringConfig_To_v1alpha1_ShootMonitoringConfig(
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.RemoteWrite = (*RemoteWriteMonitoringConfig)(unsafe.Pointer(in.RemoteWrite))
 	out.ExternalLabels = *(*map[string]string)(unsafe.Pointer(&in.ExternalLabels))
+	out.SSO = (*ShootMonitoringSSOConfig)(unsafe.Pointer(in.SSO))
+	out.AlertOverrides = *(*[]AlertOverride)(unsafe.Pointer(&in.AlertOverrides))
+	out.AlertForwarding = (*AlertForwardingConfig)(unsafe.Pointer(in.AlertForwarding))
+	out.Agent = (*ShootPrometheusAgentConfig)(unsafe.Pointer(in.Agent))
 	return nil
 }
 
@@ -1568,6 +2668,62 @@ func Convert_config_ShootMonitoringConfig_To_v1alpha1_ShootMonitoringConfig(in *
 	return autoConvert_config_ShootMonitoringConfig_To_v1alpha1_ShootMonitoringConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootMonitoringSSOConfig_To_config_ShootMonitoringSSOConfig(in *ShootMonitoringSSOConfig, out *config.ShootMonitoringSSOConfig, s conversion.Scope) error {
+	out.IssuerURL = in.IssuerURL
+	out.ClientID = in.ClientID
+	out.ClientSecretRef = in.ClientSecretRef
+	out.GroupsClaim = (*string)(unsafe.Pointer(in.GroupsClaim))
+	out.CookieRefresh = (*v1.Duration)(unsafe.Pointer(in.CookieRefresh))
+	out.BreakGlassBasicAuth = (*bool)(unsafe.Pointer(in.BreakGlassBasicAuth))
+	out.SharedGateway = (*bool)(unsafe.Pointer(in.SharedGateway))
+	return nil
+}
+
+// Convert_v1alpha1_ShootMonitoringSSOConfig_To_config_ShootMonitoringSSOConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMonitoringSSOConfig_To_config_ShootMonitoringSSOConfig(in *ShootMonitoringSSOConfig, out *config.ShootMonitoringSSOConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMonitoringSSOConfig_To_config_ShootMonitoringSSOConfig(in, out, s)
+}
+
+func autoConvert_config_ShootMonitoringSSOConfig_To_v1alpha1_ShootMonitoringSSOConfig(in *config.ShootMonitoringSSOConfig, out *ShootMonitoringSSOConfig, s conversion.Scope) error {
+	out.IssuerURL = in.IssuerURL
+	out.ClientID = in.ClientID
+	out.ClientSecretRef = in.ClientSecretRef
+	out.GroupsClaim = (*string)(unsafe.Pointer(in.GroupsClaim))
+	out.CookieRefresh = (*v1.Duration)(unsafe.Pointer(in.CookieRefresh))
+	out.BreakGlassBasicAuth = (*bool)(unsafe.Pointer(in.BreakGlassBasicAuth))
+	out.SharedGateway = (*bool)(unsafe.Pointer(in.SharedGateway))
+	return nil
+}
+
+// Convert_config_ShootMonitoringSSOConfig_To_v1alpha1_ShootMonitoringSSOConfig is an autogenerated conversion function.
+func Convert_config_ShootMonitoringSSOConfig_To_v1alpha1_ShootMonitoringSSOConfig(in *config.ShootMonitoringSSOConfig, out *ShootMonitoringSSOConfig, s conversion.Scope) error {
+	return autoConvert_config_ShootMonitoringSSOConfig_To_v1alpha1_ShootMonitoringSSOConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootNamespaceJanitorControllerConfiguration_To_config_ShootNamespaceJanitorControllerConfiguration(in *ShootNamespaceJanitorControllerConfiguration, out *config.ShootNamespaceJanitorControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.StaleThreshold = (*v1.Duration)(unsafe.Pointer(in.StaleThreshold))
+	return nil
+}
+
+// Convert_v1alpha1_ShootNamespaceJanitorControllerConfiguration_To_config_ShootNamespaceJanitorControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootNamespaceJanitorControllerConfiguration_To_config_ShootNamespaceJanitorControllerConfiguration(in *ShootNamespaceJanitorControllerConfiguration, out *config.ShootNamespaceJanitorControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootNamespaceJanitorControllerConfiguration_To_config_ShootNamespaceJanitorControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootNamespaceJanitorControllerConfiguration_To_v1alpha1_ShootNamespaceJanitorControllerConfiguration(in *config.ShootNamespaceJanitorControllerConfiguration, out *ShootNamespaceJanitorControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.StaleThreshold = (*v1.Duration)(unsafe.Pointer(in.StaleThreshold))
+	return nil
+}
+
+// Convert_config_ShootNamespaceJanitorControllerConfiguration_To_v1alpha1_ShootNamespaceJanitorControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootNamespaceJanitorControllerConfiguration_To_v1alpha1_ShootNamespaceJanitorControllerConfiguration(in *config.ShootNamespaceJanitorControllerConfiguration, out *ShootNamespaceJanitorControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootNamespaceJanitorControllerConfiguration_To_v1alpha1_ShootNamespaceJanitorControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootNodeLogging_To_config_ShootNodeLogging(in *ShootNodeLogging, out *config.ShootNodeLogging, s conversion.Scope) error {
 	out.ShootPurposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.ShootPurposes))
 	return nil
@@ -1588,6 +2744,30 @@ func Convert_config_ShootNodeLogging_To_v1alpha1_ShootNodeLogging(in *config.Sho
 	return autoConvert_config_ShootNodeLogging_To_v1alpha1_ShootNodeLogging(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootPrometheusAgentConfig_To_config_ShootPrometheusAgentConfig(in *ShootPrometheusAgentConfig, out *config.ShootPrometheusAgentConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.URL = in.URL
+	out.TenantHeader = (*string)(unsafe.Pointer(in.TenantHeader))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPrometheusAgentConfig_To_config_ShootPrometheusAgentConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPrometheusAgentConfig_To_config_ShootPrometheusAgentConfig(in *ShootPrometheusAgentConfig, out *config.ShootPrometheusAgentConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPrometheusAgentConfig_To_config_ShootPrometheusAgentConfig(in, out, s)
+}
+
+func autoConvert_config_ShootPrometheusAgentConfig_To_v1alpha1_ShootPrometheusAgentConfig(in *config.ShootPrometheusAgentConfig, out *ShootPrometheusAgentConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.URL = in.URL
+	out.TenantHeader = (*string)(unsafe.Pointer(in.TenantHeader))
+	return nil
+}
+
+// Convert_config_ShootPrometheusAgentConfig_To_v1alpha1_ShootPrometheusAgentConfig is an autogenerated conversion function.
+func Convert_config_ShootPrometheusAgentConfig_To_v1alpha1_ShootPrometheusAgentConfig(in *config.ShootPrometheusAgentConfig, out *ShootPrometheusAgentConfig, s conversion.Scope) error {
+	return autoConvert_config_ShootPrometheusAgentConfig_To_v1alpha1_ShootPrometheusAgentConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateControllerConfiguration(in *ShootStateControllerConfiguration, out *config.ShootStateControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
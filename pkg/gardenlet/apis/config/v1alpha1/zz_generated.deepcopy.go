@@ -29,6 +29,218 @@ import (
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEDNSChallengeProvider) DeepCopyInto(out *ACMEDNSChallengeProvider) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEDNSChallengeProvider.
+func (in *ACMEDNSChallengeProvider) DeepCopy() *ACMEDNSChallengeProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEDNSChallengeProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEExternalAccountBinding) DeepCopyInto(out *ACMEExternalAccountBinding) {
+	*out = *in
+	out.KeySecretRef = in.KeySecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEExternalAccountBinding.
+func (in *ACMEExternalAccountBinding) DeepCopy() *ACMEExternalAccountBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEExternalAccountBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuer) DeepCopyInto(out *ACMEIssuer) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalAccountBinding != nil {
+		in, out := &in.ExternalAccountBinding, &out.ExternalAccountBinding
+		*out = new(ACMEExternalAccountBinding)
+		**out = **in
+	}
+	if in.DNSChallengeProvider != nil {
+		in, out := &in.DNSChallengeProvider, &out.DNSChallengeProvider
+		*out = new(ACMEDNSChallengeProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuer.
+func (in *ACMEIssuer) DeepCopy() *ACMEIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveRateLimiting) DeepCopyInto(out *AdaptiveRateLimiting) {
+	*out = *in
+	if in.MinQPS != nil {
+		in, out := &in.MinQPS, &out.MinQPS
+		*out = new(float32)
+		**out = **in
+	}
+	if in.LatencyThreshold != nil {
+		in, out := &in.LatencyThreshold, &out.LatencyThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LoadShedding != nil {
+		in, out := &in.LoadShedding, &out.LoadShedding
+		*out = new(LoadShedding)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveRateLimiting.
+func (in *AdaptiveRateLimiting) DeepCopy() *AdaptiveRateLimiting {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveRateLimiting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertForwardingConfig) DeepCopyInto(out *AlertForwardingConfig) {
+	*out = *in
+	if in.Runbooks != nil {
+		in, out := &in.Runbooks, &out.Runbooks
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]AlertForwardingWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertForwardingConfig.
+func (in *AlertForwardingConfig) DeepCopy() *AlertForwardingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertForwardingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertForwardingWebhook) DeepCopyInto(out *AlertForwardingWebhook) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ShootPurposes != nil {
+		in, out := &in.ShootPurposes, &out.ShootPurposes
+		*out = make([]v1beta1.ShootPurpose, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertForwardingWebhook.
+func (in *AlertForwardingWebhook) DeepCopy() *AlertForwardingWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertForwardingWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertOverride) DeepCopyInto(out *AlertOverride) {
+	*out = *in
+	if in.ShootPurposes != nil {
+		in, out := &in.ShootPurposes, &out.ShootPurposes
+		*out = make([]v1beta1.ShootPurpose, len(*in))
+		copy(*out, *in)
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertOverride.
+func (in *AlertOverride) DeepCopy() *AlertOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutonomyConfig) DeepCopyInto(out *AutonomyConfig) {
+	*out = *in
+	if in.GardenOutageThreshold != nil {
+		in, out := &in.GardenOutageThreshold, &out.GardenOutageThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutonomyConfig.
+func (in *AutonomyConfig) DeepCopy() *AutonomyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutonomyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketControllerConfiguration) DeepCopyInto(out *BackupBucketControllerConfiguration) {
 	*out = *in
@@ -122,6 +334,32 @@ func (in *BackupEntryControllerConfiguration) DeepCopy() *BackupEntryControllerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupFreshnessThresholds) DeepCopyInto(out *BackupFreshnessThresholds) {
+	*out = *in
+	if in.FullSnapshot != nil {
+		in, out := &in.FullSnapshot, &out.FullSnapshot
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeltaSnapshot != nil {
+		in, out := &in.DeltaSnapshot, &out.DeltaSnapshot
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupFreshnessThresholds.
+func (in *BackupFreshnessThresholds) DeepCopy() *BackupFreshnessThresholds {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupFreshnessThresholds)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionControllerConfiguration) DeepCopyInto(out *BastionControllerConfiguration) {
 	*out = *in
@@ -160,6 +398,32 @@ func (in *ConditionThreshold) DeepCopy() *ConditionThreshold {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContinuousProfiling) DeepCopyInto(out *ContinuousProfiling) {
+	*out = *in
+	if in.ScrapeInterval != nil {
+		in, out := &in.ScrapeInterval, &out.ScrapeInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StorageSecretRef != nil {
+		in, out := &in.StorageSecretRef, &out.StorageSecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContinuousProfiling.
+func (in *ContinuousProfiling) DeepCopy() *ContinuousProfiling {
+	if in == nil {
+		return nil
+	}
+	out := new(ContinuousProfiling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerInstallationCareControllerConfiguration) DeepCopyInto(out *ControllerInstallationCareControllerConfiguration) {
 	*out = *in
@@ -310,6 +574,11 @@ func (in *ETCDConfig) DeepCopyInto(out *ETCDConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.LocalStorage != nil {
+		in, out := &in.LocalStorage, &out.LocalStorage
+		*out = new(ETCDLocalStorage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -344,6 +613,27 @@ func (in *ETCDController) DeepCopy() *ETCDController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDLocalStorage) DeepCopyInto(out *ETCDLocalStorage) {
+	*out = *in
+	if in.NodeLossGracePeriod != nil {
+		in, out := &in.NodeLossGracePeriod, &out.NodeLossGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDLocalStorage.
+func (in *ETCDLocalStorage) DeepCopy() *ETCDLocalStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDLocalStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExposureClassHandler) DeepCopyInto(out *ExposureClassHandler) {
 	*out = *in
@@ -446,7 +736,7 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 	if in.ShootClientConnection != nil {
 		in, out := &in.ShootClientConnection, &out.ShootClientConnection
 		*out = new(ShootClientConnection)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Controllers != nil {
 		in, out := &in.Controllers, &out.Controllers
@@ -513,6 +803,21 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autonomy != nil {
+		in, out := &in.Autonomy, &out.Autonomy
+		*out = new(AutonomyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContinuousProfiling != nil {
+		in, out := &in.ContinuousProfiling, &out.ContinuousProfiling
+		*out = new(ContinuousProfiling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageVectorOverwrite != nil {
+		in, out := &in.ImageVectorOverwrite, &out.ImageVectorOverwrite
+		*out = new(ImageVectorOverwrite)
+		**out = **in
+	}
 	return
 }
 
@@ -592,6 +897,21 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootStateControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootNamespaceJanitor != nil {
+		in, out := &in.ShootNamespaceJanitor, &out.ShootNamespaceJanitor
+		*out = new(ShootNamespaceJanitorControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootMetering != nil {
+		in, out := &in.ShootMetering, &out.ShootMetering
+		*out = new(ShootMeteringControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedIngressCertificate != nil {
+		in, out := &in.SeedIngressCertificate, &out.SeedIngressCertificate
+		*out = new(SeedIngressCertificateControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NetworkPolicy != nil {
 		in, out := &in.NetworkPolicy, &out.NetworkPolicy
 		*out = new(NetworkPolicyControllerConfiguration)
@@ -620,6 +940,22 @@ func (in *GardenletControllerConfiguration) DeepCopy() *GardenletControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVectorOverwrite) DeepCopyInto(out *ImageVectorOverwrite) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVectorOverwrite.
+func (in *ImageVectorOverwrite) DeepCopy() *ImageVectorOverwrite {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVectorOverwrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeconfigValidity) DeepCopyInto(out *KubeconfigValidity) {
 	*out = *in
@@ -675,48 +1011,243 @@ func (in *LoadBalancerServiceConfig) DeepCopy() *LoadBalancerServiceConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Logging) DeepCopyInto(out *Logging) {
+func (in *LoadShedding) DeepCopyInto(out *LoadShedding) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.SaturationWindow != nil {
+		in, out := &in.SaturationWindow, &out.SaturationWindow
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.Vali != nil {
-		in, out := &in.Vali, &out.Vali
-		*out = new(Vali)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ShootNodeLogging != nil {
-		in, out := &in.ShootNodeLogging, &out.ShootNodeLogging
-		*out = new(ShootNodeLogging)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ShootEventLogging != nil {
-		in, out := &in.ShootEventLogging, &out.ShootEventLogging
-		*out = new(ShootEventLogging)
-		(*in).DeepCopyInto(*out)
+	if in.DeferPeriod != nil {
+		in, out := &in.DeferPeriod, &out.DeferPeriod
+		*out = new(v1.Duration)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
-func (in *Logging) DeepCopy() *Logging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadShedding.
+func (in *LoadShedding) DeepCopy() *LoadShedding {
 	if in == nil {
 		return nil
 	}
-	out := new(Logging)
+	out := new(LoadShedding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedSeedControllerConfiguration) DeepCopyInto(out *ManagedSeedControllerConfiguration) {
+func (in *LogForwarding) DeepCopyInto(out *LogForwarding) {
 	*out = *in
-	if in.ConcurrentSyncs != nil {
-		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
-		*out = new(int)
-		**out = **in
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(LogForwardingSyslog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(LogForwardingOTLP)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(LogForwardingRedaction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(LogForwardingTLS)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwarding.
+func (in *LogForwarding) DeepCopy() *LogForwarding {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwarding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwardingOTLP) DeepCopyInto(out *LogForwardingOTLP) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwardingOTLP.
+func (in *LogForwardingOTLP) DeepCopy() *LogForwardingOTLP {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwardingOTLP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwardingRedaction) DeepCopyInto(out *LogForwardingRedaction) {
+	*out = *in
+	if in.RemoveKeys != nil {
+		in, out := &in.RemoveKeys, &out.RemoveKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaskKeys != nil {
+		in, out := &in.MaskKeys, &out.MaskKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwardingRedaction.
+func (in *LogForwardingRedaction) DeepCopy() *LogForwardingRedaction {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwardingRedaction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwardingSelector) DeepCopyInto(out *LogForwardingSelector) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwardingSelector.
+func (in *LogForwardingSelector) DeepCopy() *LogForwardingSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwardingSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwardingSyslog) DeepCopyInto(out *LogForwardingSyslog) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwardingSyslog.
+func (in *LogForwardingSyslog) DeepCopy() *LogForwardingSyslog {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwardingSyslog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwardingTLS) DeepCopyInto(out *LogForwardingTLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwardingTLS.
+func (in *LogForwardingTLS) DeepCopy() *LogForwardingTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwardingTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Vali != nil {
+		in, out := &in.Vali, &out.Vali
+		*out = new(Vali)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootNodeLogging != nil {
+		in, out := &in.ShootNodeLogging, &out.ShootNodeLogging
+		*out = new(ShootNodeLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootEventLogging != nil {
+		in, out := &in.ShootEventLogging, &out.ShootEventLogging
+		*out = new(ShootEventLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Forwarding != nil {
+		in, out := &in.Forwarding, &out.Forwarding
+		*out = make([]LogForwarding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSeedControllerConfiguration) DeepCopyInto(out *ManagedSeedControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
 	}
 	if in.SyncPeriod != nil {
 		in, out := &in.SyncPeriod, &out.SyncPeriod
@@ -759,6 +1290,11 @@ func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
 		*out = new(ShootMonitoringConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Customizations != nil {
+		in, out := &in.Customizations, &out.Customizations
+		*out = new(MonitoringCustomizations)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -772,6 +1308,48 @@ func (in *MonitoringConfig) DeepCopy() *MonitoringConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringCustomization) DeepCopyInto(out *MonitoringCustomization) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringCustomization.
+func (in *MonitoringCustomization) DeepCopy() *MonitoringCustomization {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringCustomization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringCustomizations) DeepCopyInto(out *MonitoringCustomizations) {
+	*out = *in
+	if in.Dashboards != nil {
+		in, out := &in.Dashboards, &out.Dashboards
+		*out = make([]MonitoringCustomization, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]MonitoringCustomization, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringCustomizations.
+func (in *MonitoringCustomizations) DeepCopy() *MonitoringCustomizations {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringCustomizations)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyControllerConfiguration) DeepCopyInto(out *NetworkPolicyControllerConfiguration) {
 	*out = *in
@@ -787,6 +1365,11 @@ func (in *NetworkPolicyControllerConfiguration) DeepCopyInto(out *NetworkPolicyC
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExtensionsAccessToShootAPIServers != nil {
+		in, out := &in.ExtensionsAccessToShootAPIServers, &out.ExtensionsAccessToShootAPIServers
+		*out = new(ExtensionsAccessMode)
+		**out = **in
+	}
 	return
 }
 
@@ -954,6 +1537,11 @@ func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerC
 		*out = make([]ConditionThreshold, len(*in))
 		copy(*out, *in)
 	}
+	if in.ConformanceChecks != nil {
+		in, out := &in.ConformanceChecks, &out.ConformanceChecks
+		*out = new(SeedConformanceChecks)
+		**out = **in
+	}
 	return
 }
 
@@ -1001,6 +1589,22 @@ func (in *SeedConfig) DeepCopy() *SeedConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedConformanceChecks) DeepCopyInto(out *SeedConformanceChecks) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedConformanceChecks.
+func (in *SeedConformanceChecks) DeepCopy() *SeedConformanceChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedConformanceChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfiguration) {
 	*out = *in
@@ -1032,6 +1636,39 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedIngressCertificateControllerConfiguration) DeepCopyInto(out *SeedIngressCertificateControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Issuers != nil {
+		in, out := &in.Issuers, &out.Issuers
+		*out = make([]ACMEIssuer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedIngressCertificateControllerConfiguration.
+func (in *SeedIngressCertificateControllerConfiguration) DeepCopy() *SeedIngressCertificateControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedIngressCertificateControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -1074,6 +1711,27 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardingConfiguration) DeepCopyInto(out *ShardingConfiguration) {
+	*out = *in
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingConfiguration.
+func (in *ShardingConfiguration) DeepCopy() *ShardingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
@@ -1107,6 +1765,16 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DeprecatedAPIUsageReporterEnabled != nil {
+		in, out := &in.DeprecatedAPIUsageReporterEnabled, &out.DeprecatedAPIUsageReporterEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BackupFreshnessThresholds != nil {
+		in, out := &in.BackupFreshnessThresholds, &out.BackupFreshnessThresholds
+		*out = new(BackupFreshnessThresholds)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1124,6 +1792,11 @@ func (in *ShootCareControllerConfiguration) DeepCopy() *ShootCareControllerConfi
 func (in *ShootClientConnection) DeepCopyInto(out *ShootClientConnection) {
 	*out = *in
 	out.ClientConnectionConfiguration = in.ClientConnectionConfiguration
+	if in.AdaptiveRateLimiting != nil {
+		in, out := &in.AdaptiveRateLimiting, &out.AdaptiveRateLimiting
+		*out = new(AdaptiveRateLimiting)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1175,6 +1848,16 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		*out = new(ShardingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(ShootMigrationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1209,6 +1892,165 @@ func (in *ShootEventLogging) DeepCopy() *ShootEventLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMeteringBigQuerySink) DeepCopyInto(out *ShootMeteringBigQuerySink) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMeteringBigQuerySink.
+func (in *ShootMeteringBigQuerySink) DeepCopy() *ShootMeteringBigQuerySink {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMeteringBigQuerySink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMeteringControllerConfiguration) DeepCopyInto(out *ShootMeteringControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CostCenterLabel != nil {
+		in, out := &in.CostCenterLabel, &out.CostCenterLabel
+		*out = new(string)
+		**out = **in
+	}
+	in.Sink.DeepCopyInto(&out.Sink)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMeteringControllerConfiguration.
+func (in *ShootMeteringControllerConfiguration) DeepCopy() *ShootMeteringControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMeteringControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMeteringPrometheusRemoteWriteSink) DeepCopyInto(out *ShootMeteringPrometheusRemoteWriteSink) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMeteringPrometheusRemoteWriteSink.
+func (in *ShootMeteringPrometheusRemoteWriteSink) DeepCopy() *ShootMeteringPrometheusRemoteWriteSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMeteringPrometheusRemoteWriteSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMeteringS3Sink) DeepCopyInto(out *ShootMeteringS3Sink) {
+	*out = *in
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMeteringS3Sink.
+func (in *ShootMeteringS3Sink) DeepCopy() *ShootMeteringS3Sink {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMeteringS3Sink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMeteringSink) DeepCopyInto(out *ShootMeteringSink) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ShootMeteringS3Sink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BigQuery != nil {
+		in, out := &in.BigQuery, &out.BigQuery
+		*out = new(ShootMeteringBigQuerySink)
+		**out = **in
+	}
+	if in.PrometheusRemoteWrite != nil {
+		in, out := &in.PrometheusRemoteWrite, &out.PrometheusRemoteWrite
+		*out = new(ShootMeteringPrometheusRemoteWriteSink)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMeteringSink.
+func (in *ShootMeteringSink) DeepCopy() *ShootMeteringSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMeteringSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMigrationConfiguration) DeepCopyInto(out *ShootMigrationConfiguration) {
+	*out = *in
+	if in.MaxConcurrentIncoming != nil {
+		in, out := &in.MaxConcurrentIncoming, &out.MaxConcurrentIncoming
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxConcurrentOutgoing != nil {
+		in, out := &in.MaxConcurrentOutgoing, &out.MaxConcurrentOutgoing
+		*out = new(int)
+		**out = **in
+	}
+	if in.PurposePriorities != nil {
+		in, out := &in.PurposePriorities, &out.PurposePriorities
+		*out = make([]v1beta1.ShootPurpose, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMigrationConfiguration.
+func (in *ShootMigrationConfiguration) DeepCopy() *ShootMigrationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMigrationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMonitoringConfig) DeepCopyInto(out *ShootMonitoringConfig) {
 	*out = *in
@@ -1229,6 +2071,28 @@ func (in *ShootMonitoringConfig) DeepCopyInto(out *ShootMonitoringConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.SSO != nil {
+		in, out := &in.SSO, &out.SSO
+		*out = new(ShootMonitoringSSOConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertOverrides != nil {
+		in, out := &in.AlertOverrides, &out.AlertOverrides
+		*out = make([]AlertOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AlertForwarding != nil {
+		in, out := &in.AlertForwarding, &out.AlertForwarding
+		*out = new(AlertForwardingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Agent != nil {
+		in, out := &in.Agent, &out.Agent
+		*out = new(ShootPrometheusAgentConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1242,6 +2106,74 @@ func (in *ShootMonitoringConfig) DeepCopy() *ShootMonitoringConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMonitoringSSOConfig) DeepCopyInto(out *ShootMonitoringSSOConfig) {
+	*out = *in
+	out.ClientSecretRef = in.ClientSecretRef
+	if in.GroupsClaim != nil {
+		in, out := &in.GroupsClaim, &out.GroupsClaim
+		*out = new(string)
+		**out = **in
+	}
+	if in.CookieRefresh != nil {
+		in, out := &in.CookieRefresh, &out.CookieRefresh
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BreakGlassBasicAuth != nil {
+		in, out := &in.BreakGlassBasicAuth, &out.BreakGlassBasicAuth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedGateway != nil {
+		in, out := &in.SharedGateway, &out.SharedGateway
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMonitoringSSOConfig.
+func (in *ShootMonitoringSSOConfig) DeepCopy() *ShootMonitoringSSOConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMonitoringSSOConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootNamespaceJanitorControllerConfiguration) DeepCopyInto(out *ShootNamespaceJanitorControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StaleThreshold != nil {
+		in, out := &in.StaleThreshold, &out.StaleThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootNamespaceJanitorControllerConfiguration.
+func (in *ShootNamespaceJanitorControllerConfiguration) DeepCopy() *ShootNamespaceJanitorControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootNamespaceJanitorControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootNodeLogging) DeepCopyInto(out *ShootNodeLogging) {
 	*out = *in
@@ -1263,6 +2195,27 @@ func (in *ShootNodeLogging) DeepCopy() *ShootNodeLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPrometheusAgentConfig) DeepCopyInto(out *ShootPrometheusAgentConfig) {
+	*out = *in
+	if in.TenantHeader != nil {
+		in, out := &in.TenantHeader, &out.TenantHeader
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPrometheusAgentConfig.
+func (in *ShootPrometheusAgentConfig) DeepCopy() *ShootPrometheusAgentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPrometheusAgentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControllerConfiguration) {
 	*out = *in
@@ -47,6 +47,12 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 	}
 	if in.ShootClientConnection != nil {
 		SetDefaults_ClientConnectionConfiguration(&in.ShootClientConnection.ClientConnectionConfiguration)
+		if in.ShootClientConnection.AdaptiveRateLimiting != nil {
+			SetDefaults_AdaptiveRateLimiting(in.ShootClientConnection.AdaptiveRateLimiting)
+			if in.ShootClientConnection.AdaptiveRateLimiting.LoadShedding != nil {
+				SetDefaults_LoadShedding(in.ShootClientConnection.AdaptiveRateLimiting.LoadShedding)
+			}
+		}
 	}
 	if in.Controllers != nil {
 		SetDefaults_GardenletControllerConfiguration(in.Controllers)
@@ -76,16 +82,34 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		}
 		if in.Controllers.Shoot != nil {
 			SetDefaults_ShootControllerConfiguration(in.Controllers.Shoot)
+			if in.Controllers.Shoot.Sharding != nil {
+				SetDefaults_ShardingConfiguration(in.Controllers.Shoot.Sharding)
+			}
+			if in.Controllers.Shoot.Migration != nil {
+				SetDefaults_ShootMigrationConfiguration(in.Controllers.Shoot.Migration)
+			}
 		}
 		if in.Controllers.ShootCare != nil {
 			SetDefaults_ShootCareControllerConfiguration(in.Controllers.ShootCare)
 			if in.Controllers.ShootCare.StaleExtensionHealthChecks != nil {
 				SetDefaults_StaleExtensionHealthChecks(in.Controllers.ShootCare.StaleExtensionHealthChecks)
 			}
+			if in.Controllers.ShootCare.BackupFreshnessThresholds != nil {
+				SetDefaults_BackupFreshnessThresholds(in.Controllers.ShootCare.BackupFreshnessThresholds)
+			}
 		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
+		if in.Controllers.ShootNamespaceJanitor != nil {
+			SetDefaults_ShootNamespaceJanitorControllerConfiguration(in.Controllers.ShootNamespaceJanitor)
+		}
+		if in.Controllers.ShootMetering != nil {
+			SetDefaults_ShootMeteringControllerConfiguration(in.Controllers.ShootMetering)
+		}
+		if in.Controllers.SeedIngressCertificate != nil {
+			SetDefaults_SeedIngressCertificateControllerConfiguration(in.Controllers.SeedIngressCertificate)
+		}
 		if in.Controllers.NetworkPolicy != nil {
 			SetDefaults_NetworkPolicyControllerConfiguration(in.Controllers.NetworkPolicy)
 		}
@@ -101,6 +125,15 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 	}
 	if in.Logging != nil {
 		SetDefaults_Logging(in.Logging)
+		for i := range in.Logging.Forwarding {
+			a := &in.Logging.Forwarding[i]
+			if a.Syslog != nil {
+				SetDefaults_LogForwardingSyslog(a.Syslog)
+			}
+			if a.OTLP != nil {
+				SetDefaults_LogForwardingOTLP(a.OTLP)
+			}
+		}
 	}
 	if in.SNI != nil {
 		SetDefaults_SNI(in.SNI)
@@ -110,6 +143,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 	}
 	if in.ETCDConfig != nil {
 		SetDefaults_ETCDConfig(in.ETCDConfig)
+		if in.ETCDConfig.LocalStorage != nil {
+			SetDefaults_ETCDLocalStorage(in.ETCDConfig.LocalStorage)
+		}
 	}
 	for i := range in.ExposureClassHandlers {
 		a := &in.ExposureClassHandlers[i]
@@ -124,6 +160,15 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		SetDefaults_MonitoringConfig(in.Monitoring)
 		if in.Monitoring.Shoot != nil {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
+			if in.Monitoring.Shoot.SSO != nil {
+				SetDefaults_ShootMonitoringSSOConfig(in.Monitoring.Shoot.SSO)
+			}
 		}
 	}
+	if in.Autonomy != nil {
+		SetDefaults_AutonomyConfig(in.Autonomy)
+	}
+	if in.ContinuousProfiling != nil {
+		SetDefaults_ContinuousProfiling(in.ContinuousProfiling)
+	}
 }
@@ -17,8 +17,13 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -28,6 +33,7 @@ import (
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
+	monitoringcustomization "github.com/gardener/gardener/pkg/component/monitoring/customization"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -56,6 +62,10 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		}
 	}
 
+	if cfg.ShootClientConnection != nil && cfg.ShootClientConnection.AdaptiveRateLimiting != nil {
+		allErrs = append(allErrs, validateAdaptiveRateLimiting(cfg.ShootClientConnection.AdaptiveRateLimiting, cfg.ShootClientConnection.QPS, field.NewPath("shootClientConnection", "adaptiveRateLimiting"))...)
+	}
+
 	if cfg.Controllers != nil {
 		if cfg.Controllers.BackupEntry != nil {
 			allErrs = append(allErrs, validateBackupEntryControllerConfiguration(cfg.Controllers.BackupEntry, fldPath.Child("controllers", "backupEntry"))...)
@@ -75,6 +85,12 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		if cfg.Controllers.NetworkPolicy != nil {
 			allErrs = append(allErrs, validateNetworkPolicyControllerConfiguration(cfg.Controllers.NetworkPolicy, fldPath.Child("controllers", "networkPolicy"))...)
 		}
+		if cfg.Controllers.ShootMetering != nil {
+			allErrs = append(allErrs, validateShootMeteringControllerConfiguration(cfg.Controllers.ShootMetering, fldPath.Child("controllers", "shootMetering"))...)
+		}
+		if cfg.Controllers.SeedIngressCertificate != nil {
+			allErrs = append(allErrs, validateSeedIngressCertificateControllerConfiguration(cfg.Controllers.SeedIngressCertificate, fldPath.Child("controllers", "seedIngressCertificate"))...)
+		}
 	}
 
 	if cfg.LogLevel != "" {
@@ -133,6 +149,28 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		}
 	}
 
+	if cfg.Logging != nil {
+		allErrs = append(allErrs, validateLogForwardings(cfg.Logging.Forwarding, fldPath.Child("logging", "forwarding"))...)
+	}
+
+	if cfg.Monitoring != nil && cfg.Monitoring.Shoot != nil {
+		if cfg.Monitoring.Shoot.SSO != nil {
+			allErrs = append(allErrs, validateShootMonitoringSSOConfig(cfg.Monitoring.Shoot.SSO, fldPath.Child("monitoring", "shoot", "sso"))...)
+		}
+		allErrs = append(allErrs, validateAlertOverrides(cfg.Monitoring.Shoot.AlertOverrides, fldPath.Child("monitoring", "shoot", "alertOverrides"))...)
+		if cfg.Monitoring.Shoot.AlertForwarding != nil {
+			allErrs = append(allErrs, validateAlertForwarding(cfg.Monitoring.Shoot.AlertForwarding, fldPath.Child("monitoring", "shoot", "alertForwarding"))...)
+		}
+		if cfg.Monitoring.Shoot.Agent != nil {
+			allErrs = append(allErrs, validateShootPrometheusAgentConfig(cfg.Monitoring.Shoot.Agent, fldPath.Child("monitoring", "shoot", "agent"))...)
+		}
+	}
+
+	if cfg.Monitoring != nil && cfg.Monitoring.Customizations != nil {
+		allErrs = append(allErrs, validateMonitoringCustomizations(cfg.Monitoring.Customizations.Dashboards, fldPath.Child("monitoring", "customizations", "dashboards"))...)
+		allErrs = append(allErrs, validateMonitoringCustomizations(cfg.Monitoring.Customizations.Rules, fldPath.Child("monitoring", "customizations", "rules"))...)
+	}
+
 	if nodeTolerationCfg := cfg.NodeToleration; nodeTolerationCfg != nil {
 		nodeTolerationConfigPath := fldPath.Child("nodeToleration")
 
@@ -140,6 +178,59 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(pointer.Int64Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if autonomyCfg := cfg.Autonomy; autonomyCfg != nil && autonomyCfg.GardenOutageThreshold != nil && autonomyCfg.GardenOutageThreshold.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("autonomy", "gardenOutageThreshold"), autonomyCfg.GardenOutageThreshold.Duration.String(), "garden outage threshold must be positive"))
+	}
+
+	if profilingCfg := cfg.ContinuousProfiling; profilingCfg != nil {
+		allErrs = append(allErrs, validateContinuousProfiling(profilingCfg, fldPath.Child("continuousProfiling"))...)
+	}
+
+	if imageVectorOverwriteCfg := cfg.ImageVectorOverwrite; imageVectorOverwriteCfg != nil && len(imageVectorOverwriteCfg.ConfigMapName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("imageVectorOverwrite", "configMapName"), "must provide the name of the ConfigMap containing the image vector overwrites"))
+	}
+
+	if cfg.ETCDConfig != nil && cfg.ETCDConfig.LocalStorage != nil {
+		allErrs = append(allErrs, validateETCDLocalStorage(cfg.ETCDConfig.LocalStorage, fldPath.Child("etcdConfig", "localStorage"))...)
+	}
+
+	return allErrs
+}
+
+func validateETCDLocalStorage(cfg *config.ETCDLocalStorage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cfg.StorageClassName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("storageClassName"), "must provide the name of the local storage class"))
+	} else {
+		for _, errorMessage := range validation.IsDNS1123Subdomain(cfg.StorageClassName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("storageClassName"), cfg.StorageClassName, errorMessage))
+		}
+	}
+
+	if cfg.NodeLossGracePeriod != nil && cfg.NodeLossGracePeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeLossGracePeriod"), cfg.NodeLossGracePeriod.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateContinuousProfiling(cfg *config.ContinuousProfiling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ScrapeInterval != nil && cfg.ScrapeInterval.Duration < 10*time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scrapeInterval"), cfg.ScrapeInterval.Duration.String(), "scrape interval must be at least 10s"))
+	}
+
+	if ref := cfg.StorageSecretRef; ref != nil {
+		if len(ref.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("storageSecretRef", "name"), "must provide a secret name"))
+		}
+		if len(ref.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("storageSecretRef", "namespace"), "must provide a secret namespace"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -184,6 +275,42 @@ func validateShootControllerConfiguration(cfg *config.ShootControllerConfigurati
 		}
 	}
 
+	// The lease duration is published in whole seconds in the Leases of the shards.
+	if cfg.Sharding != nil && cfg.Sharding.LeaseDuration != nil && cfg.Sharding.LeaseDuration.Duration < time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sharding", "leaseDuration"), cfg.Sharding.LeaseDuration.Duration.String(), "must be at least 1s"))
+	}
+
+	if cfg.Migration != nil {
+		allErrs = append(allErrs, validateShootMigrationConfiguration(cfg.Migration, fldPath.Child("migration"))...)
+	}
+
+	return allErrs
+}
+
+func validateShootMigrationConfiguration(cfg *config.ShootMigrationConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.MaxConcurrentIncoming != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.MaxConcurrentIncoming), fldPath.Child("maxConcurrentIncoming"))...)
+	}
+
+	if cfg.MaxConcurrentOutgoing != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.MaxConcurrentOutgoing), fldPath.Child("maxConcurrentOutgoing"))...)
+	}
+
+	purposes := sets.New[gardencore.ShootPurpose]()
+	for i, purpose := range cfg.PurposePriorities {
+		idxPath := fldPath.Child("purposePriorities").Index(i)
+
+		if !availableShootPurposes.Has(string(purpose)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, purpose, sets.List(availableShootPurposes)))
+		}
+		if purposes.Has(purpose) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, purpose))
+		}
+		purposes.Insert(purpose)
+	}
+
 	return allErrs
 }
 
@@ -210,6 +337,15 @@ func validateShootCareControllerConfiguration(cfg *config.ShootCareControllerCon
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ConditionThresholds[i].Duration.Duration), fldPath.Child("conditionThresholds").Index(i).Child("duration"))...)
 	}
 
+	if thresholds := cfg.BackupFreshnessThresholds; thresholds != nil {
+		if thresholds.FullSnapshot != nil && thresholds.FullSnapshot.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("backupFreshnessThresholds", "fullSnapshot"), thresholds.FullSnapshot.Duration.String(), "must be positive"))
+		}
+		if thresholds.DeltaSnapshot != nil && thresholds.DeltaSnapshot.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("backupFreshnessThresholds", "deltaSnapshot"), thresholds.DeltaSnapshot.Duration.String(), "must be positive"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -244,9 +380,148 @@ func validateNetworkPolicyControllerConfiguration(cfg *config.NetworkPolicyContr
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&labelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("additionalNamespaceSelectors").Index(i))...)
 	}
 
+	if cfg.ExtensionsAccessToShootAPIServers != nil && !availableExtensionsAccessModes.Has(string(*cfg.ExtensionsAccessToShootAPIServers)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("extensionsAccessToShootAPIServers"), *cfg.ExtensionsAccessToShootAPIServers, sets.List(availableExtensionsAccessModes)))
+	}
+
 	return allErrs
 }
 
+func validateSeedIngressCertificateControllerConfiguration(cfg *config.SeedIngressCertificateControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.RenewBefore != nil && cfg.RenewBefore.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("renewBefore"), cfg.RenewBefore.Duration.String(), "must be positive"))
+	}
+
+	if len(cfg.Issuers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("issuers"), "at least one issuer must be configured"))
+	}
+
+	issuerNames := sets.New[string]()
+	for i, issuer := range cfg.Issuers {
+		idxPath := fldPath.Child("issuers").Index(i)
+
+		for _, errorMessage := range validation.IsDNS1123Label(issuer.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), issuer.Name, errorMessage))
+		}
+		if issuerNames.Has(issuer.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), issuer.Name))
+		}
+		issuerNames.Insert(issuer.Name)
+
+		if u, err := url.Parse(issuer.Server); err != nil || u.Scheme != "https" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("server"), issuer.Server, "must be a valid https URL"))
+		}
+
+		if issuer.Email != nil {
+			if _, err := mail.ParseAddress(*issuer.Email); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("email"), *issuer.Email, err.Error()))
+			}
+		}
+
+		if eab := issuer.ExternalAccountBinding; eab != nil {
+			eabPath := idxPath.Child("externalAccountBinding")
+			if len(eab.KeyID) == 0 {
+				allErrs = append(allErrs, field.Required(eabPath.Child("keyID"), "must provide the key identifier of the external account"))
+			}
+			allErrs = append(allErrs, validateSecretReference(eab.KeySecretRef, eabPath.Child("keySecretRef"))...)
+		}
+
+		if provider := issuer.DNSChallengeProvider; provider != nil {
+			providerPath := idxPath.Child("dnsChallengeProvider")
+			if len(provider.Type) == 0 {
+				allErrs = append(allErrs, field.Required(providerPath.Child("type"), "must provide the type of the DNS provider"))
+			}
+			allErrs = append(allErrs, validateSecretReference(provider.SecretRef, providerPath.Child("secretRef"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootMeteringControllerConfiguration(cfg *config.ShootMeteringControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be at least 1m"))
+	}
+
+	if cfg.CostCenterLabel != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(*cfg.CostCenterLabel, fldPath.Child("costCenterLabel"))...)
+	}
+
+	sinkPath := fldPath.Child("sink")
+	numSinks := 0
+
+	if s3 := cfg.Sink.S3; s3 != nil {
+		numSinks++
+		s3Path := sinkPath.Child("s3")
+		if len(s3.Bucket) == 0 {
+			allErrs = append(allErrs, field.Required(s3Path.Child("bucket"), "must provide the name of the bucket"))
+		}
+		if len(s3.Region) == 0 {
+			allErrs = append(allErrs, field.Required(s3Path.Child("region"), "must provide the region of the bucket"))
+		}
+		if s3.Endpoint != nil {
+			if u, err := url.Parse(*s3.Endpoint); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(s3Path.Child("endpoint"), *s3.Endpoint, "must be a valid http(s) URL"))
+			}
+		}
+		allErrs = append(allErrs, validateSecretReference(s3.SecretRef, s3Path.Child("secretRef"))...)
+	}
+
+	if bigQuery := cfg.Sink.BigQuery; bigQuery != nil {
+		numSinks++
+		bigQueryPath := sinkPath.Child("bigQuery")
+		if len(bigQuery.Project) == 0 {
+			allErrs = append(allErrs, field.Required(bigQueryPath.Child("project"), "must provide the ID of the GCP project"))
+		}
+		if len(bigQuery.Dataset) == 0 {
+			allErrs = append(allErrs, field.Required(bigQueryPath.Child("dataset"), "must provide the ID of the dataset"))
+		}
+		if len(bigQuery.Table) == 0 {
+			allErrs = append(allErrs, field.Required(bigQueryPath.Child("table"), "must provide the ID of the table"))
+		}
+		allErrs = append(allErrs, validateSecretReference(bigQuery.SecretRef, bigQueryPath.Child("secretRef"))...)
+	}
+
+	if remoteWrite := cfg.Sink.PrometheusRemoteWrite; remoteWrite != nil {
+		numSinks++
+		remoteWritePath := sinkPath.Child("prometheusRemoteWrite")
+		if u, err := url.Parse(remoteWrite.URL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(remoteWritePath.Child("url"), remoteWrite.URL, "must be a valid http(s) URL"))
+		}
+		if remoteWrite.SecretRef != nil {
+			allErrs = append(allErrs, validateSecretReference(*remoteWrite.SecretRef, remoteWritePath.Child("secretRef"))...)
+		}
+	}
+
+	if numSinks != 1 {
+		allErrs = append(allErrs, field.Invalid(sinkPath, numSinks, "exactly one of s3, bigQuery or prometheusRemoteWrite must be configured"))
+	}
+
+	return allErrs
+}
+
+func validateSecretReference(ref corev1.SecretReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(ref.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must provide a name"))
+	}
+	if len(ref.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "must provide a namespace"))
+	}
+
+	return allErrs
+}
+
+var availableExtensionsAccessModes = sets.New(
+	string(config.ExtensionsAccessModeReport),
+	string(config.ExtensionsAccessModeEnforce),
+)
+
 var availableShootPurposes = sets.New(
 	string(gardencore.ShootPurposeEvaluation),
 	string(gardencore.ShootPurposeTesting),
@@ -280,3 +555,348 @@ func validateBastionControllerConfiguration(cfg *config.BastionControllerConfigu
 
 	return allErrs
 }
+
+var availableSyslogFormats = sets.New("rfc5424", "rfc3164")
+
+func validateLogForwardings(forwardings []config.LogForwarding, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, forwarding := range forwardings {
+		idxPath := fldPath.Index(i)
+
+		for _, errorMessage := range validation.IsDNS1123Label(forwarding.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), forwarding.Name, errorMessage))
+		}
+		if names.Has(forwarding.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), forwarding.Name))
+		}
+		names.Insert(forwarding.Name)
+
+		switch {
+		case forwarding.Syslog == nil && forwarding.OTLP == nil:
+			allErrs = append(allErrs, field.Required(idxPath, "either syslog or otlp must be set"))
+		case forwarding.Syslog != nil && forwarding.OTLP != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath, "syslog and otlp must not be set at the same time"))
+		}
+
+		if forwarding.Syslog != nil {
+			syslogPath := idxPath.Child("syslog")
+
+			allErrs = append(allErrs, validateLogForwardingEndpoint(forwarding.Syslog.Host, forwarding.Syslog.Port, syslogPath)...)
+			if forwarding.Syslog.Format != nil && !availableSyslogFormats.Has(*forwarding.Syslog.Format) {
+				allErrs = append(allErrs, field.NotSupported(syslogPath.Child("format"), *forwarding.Syslog.Format, sets.List(availableSyslogFormats)))
+			}
+		}
+
+		if forwarding.OTLP != nil {
+			otlpPath := idxPath.Child("otlp")
+
+			allErrs = append(allErrs, validateLogForwardingEndpoint(forwarding.OTLP.Host, forwarding.OTLP.Port, otlpPath)...)
+			if forwarding.OTLP.URI != nil && !strings.HasPrefix(*forwarding.OTLP.URI, "/") {
+				allErrs = append(allErrs, field.Invalid(otlpPath.Child("uri"), *forwarding.OTLP.URI, "uri must start with '/'"))
+			}
+		}
+
+		selectorPath := idxPath.Child("selector")
+		if len(forwarding.Selector.Projects) == 0 && len(forwarding.Selector.Namespaces) == 0 && !forwarding.Selector.Extensions {
+			allErrs = append(allErrs, field.Required(selectorPath, "at least one of projects, namespaces or extensions must be selected"))
+		}
+		for j, project := range forwarding.Selector.Projects {
+			for _, errorMessage := range validation.IsDNS1123Label(project) {
+				allErrs = append(allErrs, field.Invalid(selectorPath.Child("projects").Index(j), project, errorMessage))
+			}
+		}
+		for j, namespace := range forwarding.Selector.Namespaces {
+			for _, errorMessage := range validation.IsDNS1123Label(namespace) {
+				allErrs = append(allErrs, field.Invalid(selectorPath.Child("namespaces").Index(j), namespace, errorMessage))
+			}
+		}
+		for j, component := range forwarding.Selector.Components {
+			for _, errorMessage := range validation.IsDNS1123Label(component) {
+				allErrs = append(allErrs, field.Invalid(selectorPath.Child("components").Index(j), component, errorMessage))
+			}
+		}
+
+		if forwarding.TLS != nil {
+			for _, errorMessage := range validation.IsDNS1123Subdomain(forwarding.TLS.SecretName) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("tls", "secretName"), forwarding.TLS.SecretName, errorMessage))
+			}
+		}
+
+		if forwarding.Redaction != nil {
+			redactionPath := idxPath.Child("redaction")
+
+			for j, key := range forwarding.Redaction.RemoveKeys {
+				if strings.TrimSpace(key) != key || key == "" {
+					allErrs = append(allErrs, field.Invalid(redactionPath.Child("removeKeys").Index(j), key, "key must not be empty or contain leading or trailing whitespaces"))
+				}
+			}
+			for j, key := range forwarding.Redaction.MaskKeys {
+				if strings.TrimSpace(key) != key || key == "" {
+					allErrs = append(allErrs, field.Invalid(redactionPath.Child("maskKeys").Index(j), key, "key must not be empty or contain leading or trailing whitespaces"))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootMonitoringSSOConfig(cfg *config.ShootMonitoringSSOConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cfg.IssuerURL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("issuerURL"), "issuer URL must be set"))
+	} else if issuer, err := url.Parse(cfg.IssuerURL); err != nil || issuer.Scheme != "https" || issuer.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("issuerURL"), cfg.IssuerURL, "issuer URL must be a valid https URL"))
+	}
+
+	if len(cfg.ClientID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientID"), "client ID must be set"))
+	}
+
+	if len(cfg.ClientSecretRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientSecretRef", "name"), "secret name must be set"))
+	}
+	if len(cfg.ClientSecretRef.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientSecretRef", "namespace"), "secret namespace must be set"))
+	}
+
+	if cfg.GroupsClaim != nil && len(*cfg.GroupsClaim) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("groupsClaim"), *cfg.GroupsClaim, "groups claim must not be empty"))
+	}
+
+	if cfg.CookieRefresh != nil && cfg.CookieRefresh.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cookieRefresh"), cfg.CookieRefresh.Duration.String(), "cookie refresh interval must be positive"))
+	}
+
+	return allErrs
+}
+
+var httpHeaderNameRegex = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+func validateShootPrometheusAgentConfig(cfg *config.ShootPrometheusAgentConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !cfg.Enabled {
+		return allErrs
+	}
+
+	if len(cfg.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "remote write URL must be set when the agent mode is enabled"))
+	} else if remoteWriteURL, err := url.Parse(cfg.URL); err != nil || (remoteWriteURL.Scheme != "http" && remoteWriteURL.Scheme != "https") || remoteWriteURL.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), cfg.URL, "remote write URL must be a valid http or https URL"))
+	}
+
+	if cfg.TenantHeader != nil && !httpHeaderNameRegex.MatchString(*cfg.TenantHeader) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tenantHeader"), *cfg.TenantHeader, "tenant header must be a valid HTTP header name"))
+	}
+
+	return allErrs
+}
+
+var (
+	availableAlertSeverities = sets.New("blocker", "critical", "warning", "info")
+	reservedAlertLabels      = sets.New("alertname", "severity")
+	alertLabelNameRegex      = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+func validateAlertOverrides(overrides []config.AlertOverride, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, override := range overrides {
+		idxPath := fldPath.Index(i)
+
+		if len(override.AlertName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("alertName"), "alert name must be set"))
+		}
+
+		for j, purpose := range override.ShootPurposes {
+			if !availableShootPurposes.Has(string(purpose)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("shootPurposes").Index(j), purpose, sets.List(availableShootPurposes)))
+			}
+		}
+
+		if override.Drop {
+			if override.Severity != nil || len(override.Labels) > 0 {
+				allErrs = append(allErrs, field.Forbidden(idxPath, "severity and labels must not be set when the alert is dropped"))
+			}
+			continue
+		}
+
+		if override.Severity == nil && len(override.Labels) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "either severity or labels must be set when the alert is not dropped"))
+		}
+
+		if override.Severity != nil && !availableAlertSeverities.Has(*override.Severity) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("severity"), *override.Severity, sets.List(availableAlertSeverities)))
+		}
+
+		for key, value := range override.Labels {
+			labelPath := idxPath.Child("labels").Key(key)
+
+			if !alertLabelNameRegex.MatchString(key) || strings.HasPrefix(key, "__") {
+				allErrs = append(allErrs, field.Invalid(labelPath, key, "label name must be a valid Prometheus label name and must not start with '__'"))
+			} else if reservedAlertLabels.Has(key) {
+				allErrs = append(allErrs, field.Forbidden(labelPath, fmt.Sprintf("label must not be one of %v", sets.List(reservedAlertLabels))))
+			}
+			if len(value) == 0 {
+				allErrs = append(allErrs, field.Required(labelPath, "label value must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateMonitoringCustomizations(customizations []config.MonitoringCustomization, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	ids := sets.New[string]()
+	for i, customization := range customizations {
+		idxPath := fldPath.Index(i)
+
+		if len(customization.ID) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("id"), "id must be set"))
+		} else if ids.Has(customization.ID) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("id"), customization.ID))
+		}
+		ids.Insert(customization.ID)
+
+		if len(customization.Patch) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("patch"), "patch must be set"))
+			continue
+		}
+
+		patch, err := monitoringcustomization.DecodePatch(customization.Patch)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("patch"), customization.Patch, fmt.Sprintf("patch must be a valid JSON patch: %v", err)))
+			continue
+		}
+
+		for j, operation := range patch {
+			if kind := operation.Kind(); !availableJSONPatchOperations.Has(kind) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("patch").Index(j).Child("op"), kind, sets.List(availableJSONPatchOperations)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+var availableJSONPatchOperations = sets.New("add", "remove", "replace", "move", "copy", "test")
+
+var availableAlertForwardingFormats = sets.New(
+	string(config.AlertForwardingFormatAlertmanager),
+	string(config.AlertForwardingFormatJira),
+	string(config.AlertForwardingFormatServiceNow),
+)
+
+func validateAlertForwarding(cfg *config.AlertForwardingConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for alertName, runbookURL := range cfg.Runbooks {
+		runbookPath := fldPath.Child("runbooks").Key(alertName)
+
+		if len(alertName) == 0 {
+			allErrs = append(allErrs, field.Required(runbookPath, "alert name must be set"))
+		}
+		if runbook, err := url.Parse(runbookURL); err != nil || (runbook.Scheme != "http" && runbook.Scheme != "https") || runbook.Host == "" {
+			allErrs = append(allErrs, field.Invalid(runbookPath, runbookURL, "runbook URL must be a valid http or https URL"))
+		}
+	}
+
+	names := sets.New[string]()
+	for i, webhook := range cfg.Webhooks {
+		idxPath := fldPath.Child("webhooks").Index(i)
+
+		if len(webhook.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "webhook name must be set"))
+		} else {
+			for _, errorMessage := range validation.IsDNS1123Label(webhook.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), webhook.Name, errorMessage))
+			}
+			if names.Has(webhook.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), webhook.Name))
+			}
+			names.Insert(webhook.Name)
+		}
+
+		if !availableAlertForwardingFormats.Has(string(webhook.Format)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("format"), webhook.Format, sets.List(availableAlertForwardingFormats)))
+		}
+
+		if len(webhook.SecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("secretRef", "name"), "secret name must be set"))
+		}
+		if len(webhook.SecretRef.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("secretRef", "namespace"), "secret namespace must be set"))
+		}
+
+		for j, severity := range webhook.Severities {
+			if !availableAlertSeverities.Has(severity) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("severities").Index(j), severity, sets.List(availableAlertSeverities)))
+			}
+		}
+
+		for j, purpose := range webhook.ShootPurposes {
+			if !availableShootPurposes.Has(string(purpose)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("shootPurposes").Index(j), purpose, sets.List(availableShootPurposes)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateLogForwardingEndpoint(host string, port *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(host) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("host"), "host must be set"))
+	} else if net.ParseIP(host) == nil {
+		for _, errorMessage := range validation.IsDNS1123Subdomain(host) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("host"), host, errorMessage))
+		}
+	}
+
+	if port != nil {
+		for _, errorMessage := range validation.IsValidPortNum(int(*port)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), *port, errorMessage))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAdaptiveRateLimiting(cfg *config.AdaptiveRateLimiting, qps float32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if v := cfg.MinQPS; v != nil {
+		if *v <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minQPS"), *v, "must be greater than 0"))
+		} else if *v > qps {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minQPS"), *v, fmt.Sprintf("must not be greater than the client's QPS (%v)", qps)))
+		}
+	}
+	if v := cfg.LatencyThreshold; v != nil && v.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("latencyThreshold"), *v, "must be greater than 0"))
+	}
+
+	if cfg.LoadShedding != nil {
+		if cfg.LoadShedding.Enabled && !cfg.Enabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("loadShedding", "enabled"), "load shedding requires the adaptive rate limiting to be enabled"))
+		}
+		if v := cfg.LoadShedding.SaturationWindow; v != nil && v.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("loadShedding", "saturationWindow"), *v, "must be greater than 0"))
+		}
+		if v := cfg.LoadShedding.DeferPeriod; v != nil && v.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("loadShedding", "deferPeriod"), *v, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
@@ -20,10 +20,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	componentbaseconfig "k8s.io/component-base/config"
 	"k8s.io/utils/pointer"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
@@ -62,6 +64,10 @@ var _ = Describe("GardenletConfiguration", func() {
 					StaleExtensionHealthChecks:          &config.StaleExtensionHealthChecks{Threshold: &metav1.Duration{Duration: time.Hour}},
 					ManagedResourceProgressingThreshold: &metav1.Duration{Duration: time.Hour},
 					ConditionThresholds:                 []config.ConditionThreshold{{Duration: metav1.Duration{Duration: time.Hour}}},
+					BackupFreshnessThresholds: &config.BackupFreshnessThresholds{
+						FullSnapshot:  &metav1.Duration{Duration: 24 * time.Hour},
+						DeltaSnapshot: &metav1.Duration{Duration: 15 * time.Minute},
+					},
 				},
 				ManagedSeed: &config.ManagedSeedControllerConfiguration{
 					ConcurrentSyncs:  &concurrentSyncs,
@@ -216,6 +222,80 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("shoot client connection", func() {
+			Context("adaptive rate limiting", func() {
+				BeforeEach(func() {
+					cfg.ShootClientConnection = &config.ShootClientConnection{
+						ClientConnectionConfiguration: componentbaseconfig.ClientConnectionConfiguration{QPS: 50},
+					}
+				})
+
+				It("should allow valid configurations", func() {
+					cfg.ShootClientConnection.AdaptiveRateLimiting = &config.AdaptiveRateLimiting{
+						Enabled:          true,
+						MinQPS:           pointer.Float32(5),
+						LatencyThreshold: &metav1.Duration{Duration: time.Second},
+						LoadShedding: &config.LoadShedding{
+							Enabled:          true,
+							SaturationWindow: &metav1.Duration{Duration: time.Minute},
+							DeferPeriod:      &metav1.Duration{Duration: time.Minute},
+						},
+					}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+				})
+
+				It("should forbid invalid configurations", func() {
+					cfg.ShootClientConnection.AdaptiveRateLimiting = &config.AdaptiveRateLimiting{
+						MinQPS:           pointer.Float32(51),
+						LatencyThreshold: &metav1.Duration{},
+						LoadShedding: &config.LoadShedding{
+							Enabled:          true,
+							SaturationWindow: &metav1.Duration{Duration: -time.Second},
+							DeferPeriod:      &metav1.Duration{},
+						},
+					}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("shootClientConnection.adaptiveRateLimiting.minQPS"),
+							"Detail": ContainSubstring("must not be greater than the client's QPS"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("shootClientConnection.adaptiveRateLimiting.latencyThreshold"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("shootClientConnection.adaptiveRateLimiting.loadShedding.enabled"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("shootClientConnection.adaptiveRateLimiting.loadShedding.saturationWindow"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("shootClientConnection.adaptiveRateLimiting.loadShedding.deferPeriod"),
+						})),
+					))
+				})
+
+				It("should forbid a non-positive min QPS", func() {
+					cfg.ShootClientConnection.AdaptiveRateLimiting = &config.AdaptiveRateLimiting{
+						Enabled: true,
+						MinQPS:  pointer.Float32(0),
+					}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("shootClientConnection.adaptiveRateLimiting.minQPS"),
+						"Detail": Equal("must be greater than 0"),
+					}))))
+				})
+			})
+		})
+
 		Context("shoot controller", func() {
 			It("should forbid invalid configuration", func() {
 				invalidConcurrentSyncs := -1
@@ -268,6 +348,65 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("controllers.shoot.dnsEntryTTLSeconds"),
 				}))))
 			})
+
+			DescribeTable("sharding lease duration",
+				func(leaseDuration time.Duration, matcher gomegatypes.GomegaMatcher) {
+					cfg.Controllers.Shoot.Sharding = &config.ShardingConfiguration{Enabled: true, LeaseDuration: &metav1.Duration{Duration: leaseDuration}}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(matcher)
+				},
+
+				Entry("should forbid zero", time.Duration(0), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shoot.sharding.leaseDuration"),
+				})))),
+				Entry("should forbid durations below one second", 500*time.Millisecond, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("controllers.shoot.sharding.leaseDuration"),
+					"Detail": Equal("must be at least 1s"),
+				})))),
+				Entry("should allow one second", time.Second, BeEmpty()),
+			)
+
+			It("should allow valid migration configurations", func() {
+				cfg.Controllers.Shoot.Migration = &config.ShootMigrationConfiguration{
+					MaxConcurrentIncoming: pointer.Int(2),
+					MaxConcurrentOutgoing: pointer.Int(0),
+					PurposePriorities:     []gardencore.ShootPurpose{gardencore.ShootPurposeProduction, gardencore.ShootPurposeEvaluation},
+					Paused:                true,
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid migration configurations", func() {
+				cfg.Controllers.Shoot.Migration = &config.ShootMigrationConfiguration{
+					MaxConcurrentIncoming: pointer.Int(-1),
+					MaxConcurrentOutgoing: pointer.Int(-1),
+					PurposePriorities:     []gardencore.ShootPurpose{gardencore.ShootPurposeProduction, "does-not-exist", gardencore.ShootPurposeProduction},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.migration.maxConcurrentIncoming"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.migration.maxConcurrentOutgoing"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shoot.migration.purposePriorities[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shoot.migration.purposePriorities[2]"),
+					})),
+				))
+			})
 		})
 
 		Context("shootCare controller", func() {
@@ -279,6 +418,10 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.ShootCare.StaleExtensionHealthChecks = &config.StaleExtensionHealthChecks{Threshold: &metav1.Duration{Duration: -1}}
 				cfg.Controllers.ShootCare.ManagedResourceProgressingThreshold = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ShootCare.ConditionThresholds = []config.ConditionThreshold{{Duration: metav1.Duration{Duration: -1}}}
+				cfg.Controllers.ShootCare.BackupFreshnessThresholds = &config.BackupFreshnessThresholds{
+					FullSnapshot:  &metav1.Duration{Duration: -1},
+					DeltaSnapshot: &metav1.Duration{},
+				}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil, false)
 
@@ -303,6 +446,14 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.shootCare.conditionThresholds[0].duration"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.backupFreshnessThresholds.fullSnapshot"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.backupFreshnessThresholds.deltaSnapshot"),
+					})),
 				))
 			})
 		})
@@ -420,6 +571,268 @@ var _ = Describe("GardenletConfiguration", func() {
 					})),
 				))
 			})
+
+			It("should allow the supported modes for the access of extensions to shoot API servers", func() {
+				for _, mode := range []config.ExtensionsAccessMode{config.ExtensionsAccessModeReport, config.ExtensionsAccessModeEnforce} {
+					cfg.Controllers.NetworkPolicy.ExtensionsAccessToShootAPIServers = &mode
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+				}
+			})
+
+			It("should return errors because the mode for the access of extensions to shoot API servers is unsupported", func() {
+				mode := config.ExtensionsAccessMode("foo")
+				cfg.Controllers.NetworkPolicy.ExtensionsAccessToShootAPIServers = &mode
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.networkPolicy.extensionsAccessToShootAPIServers"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot metering controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.ShootMetering = &config.ShootMeteringControllerConfiguration{
+					SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+					CostCenterLabel: pointer.String("metering.gardener.cloud/cost-center"),
+					Sink: config.ShootMeteringSink{
+						S3: &config.ShootMeteringS3Sink{
+							Bucket:    "metering",
+							Region:    "eu-west-1",
+							SecretRef: corev1.SecretReference{Name: "metering", Namespace: "garden"},
+						},
+					},
+				}
+			})
+
+			It("should allow a valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should return errors because the sync period is too short or the cost center label is invalid", func() {
+				cfg.Controllers.ShootMetering.SyncPeriod = &metav1.Duration{Duration: time.Second}
+				cfg.Controllers.ShootMetering.CostCenterLabel = pointer.String("cost center")
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMetering.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMetering.costCenterLabel"),
+					})),
+				))
+			})
+
+			It("should return errors because no sink is configured", func() {
+				cfg.Controllers.ShootMetering.Sink = config.ShootMeteringSink{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMetering.sink"),
+					})),
+				))
+			})
+
+			It("should return errors because multiple sinks are configured", func() {
+				cfg.Controllers.ShootMetering.Sink.PrometheusRemoteWrite = &config.ShootMeteringPrometheusRemoteWriteSink{URL: "https://prometheus.example.com/api/v1/write"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMetering.sink"),
+					})),
+				))
+			})
+
+			It("should return errors because the S3 sink is incomplete", func() {
+				cfg.Controllers.ShootMetering.Sink.S3 = &config.ShootMeteringS3Sink{Endpoint: pointer.String("s3.example.com")}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMetering.sink.s3.bucket"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMetering.sink.s3.region"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMetering.sink.s3.endpoint"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMetering.sink.s3.secretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMetering.sink.s3.secretRef.namespace"),
+					})),
+				))
+			})
+
+			It("should return errors because the BigQuery sink is incomplete", func() {
+				cfg.Controllers.ShootMetering.Sink = config.ShootMeteringSink{
+					BigQuery: &config.ShootMeteringBigQuerySink{SecretRef: corev1.SecretReference{Name: "metering", Namespace: "garden"}},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMetering.sink.bigQuery.project"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMetering.sink.bigQuery.dataset"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMetering.sink.bigQuery.table"),
+					})),
+				))
+			})
+
+			It("should return errors because the Prometheus remote write sink is invalid", func() {
+				cfg.Controllers.ShootMetering.Sink = config.ShootMeteringSink{
+					PrometheusRemoteWrite: &config.ShootMeteringPrometheusRemoteWriteSink{
+						URL:       "prometheus/api/v1/write",
+						SecretRef: &corev1.SecretReference{Name: "metering"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMetering.sink.prometheusRemoteWrite.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMetering.sink.prometheusRemoteWrite.secretRef.namespace"),
+					})),
+				))
+			})
+		})
+
+		Context("seed ingress certificate controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.SeedIngressCertificate = &config.SeedIngressCertificateControllerConfiguration{
+					RenewBefore: &metav1.Duration{Duration: 720 * time.Hour},
+					Issuers: []config.ACMEIssuer{
+						{
+							Name:   "primary",
+							Server: "https://acme.example.com/directory",
+							Email:  pointer.String("ops@example.com"),
+							ExternalAccountBinding: &config.ACMEExternalAccountBinding{
+								KeyID:        "kid",
+								KeySecretRef: corev1.SecretReference{Name: "eab", Namespace: "garden"},
+							},
+						},
+						{
+							Name:   "fallback",
+							Server: "https://acme.example.org/directory",
+							DNSChallengeProvider: &config.ACMEDNSChallengeProvider{
+								Type:      "local",
+								SecretRef: corev1.SecretReference{Name: "dns", Namespace: "garden"},
+							},
+						},
+					},
+				}
+			})
+
+			It("should allow a valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should return errors because no issuer is configured", func() {
+				cfg.Controllers.SeedIngressCertificate.Issuers = nil
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedIngressCertificate.issuers"),
+					})),
+				))
+			})
+
+			It("should return errors because the renewal duration is not positive", func() {
+				cfg.Controllers.SeedIngressCertificate.RenewBefore = &metav1.Duration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedIngressCertificate.renewBefore"),
+					})),
+				))
+			})
+
+			It("should return errors because issuer names are invalid or duplicated", func() {
+				cfg.Controllers.SeedIngressCertificate.Issuers[0].Name = "Primary"
+				cfg.Controllers.SeedIngressCertificate.Issuers = append(cfg.Controllers.SeedIngressCertificate.Issuers, *cfg.Controllers.SeedIngressCertificate.Issuers[1].DeepCopy())
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[2].name"),
+					})),
+				))
+			})
+
+			It("should return errors because the server or email are invalid", func() {
+				cfg.Controllers.SeedIngressCertificate.Issuers[0].Server = "http://acme.example.com/directory"
+				cfg.Controllers.SeedIngressCertificate.Issuers[0].Email = pointer.String("foo")
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[0].server"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[0].email"),
+					})),
+				))
+			})
+
+			It("should return errors because the external account binding or DNS challenge provider are incomplete", func() {
+				cfg.Controllers.SeedIngressCertificate.Issuers[0].ExternalAccountBinding = &config.ACMEExternalAccountBinding{}
+				cfg.Controllers.SeedIngressCertificate.Issuers[1].DNSChallengeProvider = &config.ACMEDNSChallengeProvider{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[0].externalAccountBinding.keyID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[0].externalAccountBinding.keySecretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[0].externalAccountBinding.keySecretRef.namespace"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[1].dnsChallengeProvider.type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[1].dnsChallengeProvider.secretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedIngressCertificate.issuers[1].dnsChallengeProvider.secretRef.namespace"),
+					})),
+				))
+			})
 		})
 
 		Context("seed config", func() {
@@ -597,6 +1010,569 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("log forwarding", func() {
+			BeforeEach(func() {
+				cfg.Logging = &config.Logging{
+					Forwarding: []config.LogForwarding{
+						{
+							Name:   "siem",
+							Syslog: &config.LogForwardingSyslog{Host: "siem.example.com", Port: pointer.Int32(6514), Format: pointer.String("rfc5424")},
+							Selector: config.LogForwardingSelector{
+								Projects:   []string{"foo"},
+								Extensions: true,
+								Components: []string{"kube-apiserver"},
+							},
+							Redaction: &config.LogForwardingRedaction{
+								RemoveKeys: []string{"user*"},
+								MaskKeys:   []string{"token"},
+							},
+							TLS: &config.LogForwardingTLS{SecretName: "siem-tls", ClientCertificate: true},
+						},
+						{
+							Name:     "otlp",
+							OTLP:     &config.LogForwardingOTLP{Host: "10.0.0.1", Port: pointer.Int32(4318), URI: pointer.String("/v1/logs")},
+							Selector: config.LogForwardingSelector{Namespaces: []string{"garden"}},
+						},
+					},
+				}
+			})
+
+			It("should pass with valid log forwardings", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with duplicate or invalid names", func() {
+				cfg.Logging.Forwarding[0].Name = "Foo_Bar"
+				cfg.Logging.Forwarding = append(cfg.Logging.Forwarding, cfg.Logging.Forwarding[1])
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("logging.forwarding[2].name"),
+					})),
+				))
+			})
+
+			It("should fail when no or both endpoints are configured", func() {
+				cfg.Logging.Forwarding[0].OTLP = &config.LogForwardingOTLP{Host: "otlp.example.com"}
+				cfg.Logging.Forwarding[1].OTLP = nil
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("logging.forwarding[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("logging.forwarding[1]"),
+					})),
+				))
+			})
+
+			It("should fail with invalid endpoints", func() {
+				cfg.Logging.Forwarding[0].Syslog = &config.LogForwardingSyslog{Port: pointer.Int32(0), Format: pointer.String("foo")}
+				cfg.Logging.Forwarding[1].OTLP = &config.LogForwardingOTLP{Host: "foo_bar", URI: pointer.String("v1/logs")}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("logging.forwarding[0].syslog.host"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[0].syslog.port"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("logging.forwarding[0].syslog.format"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[1].otlp.host"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[1].otlp.uri"),
+					})),
+				))
+			})
+
+			It("should fail with invalid selectors and redaction rules", func() {
+				cfg.Logging.Forwarding[0].Selector.Projects = []string{"Foo"}
+				cfg.Logging.Forwarding[0].Selector.Components = []string{"kube_apiserver"}
+				cfg.Logging.Forwarding[0].Redaction.MaskKeys = []string{" token"}
+				cfg.Logging.Forwarding[1].Selector = config.LogForwardingSelector{Components: []string{"kube-apiserver"}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[0].selector.projects[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[0].selector.components[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[0].redaction.maskKeys[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("logging.forwarding[1].selector"),
+					})),
+				))
+			})
+
+			It("should fail with an invalid TLS secret name", func() {
+				cfg.Logging.Forwarding[0].TLS.SecretName = ""
+				cfg.Logging.Forwarding[1].TLS = &config.LogForwardingTLS{SecretName: "Foo_Bar"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[0].tls.secretName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.forwarding[1].tls.secretName"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot monitoring sso", func() {
+			BeforeEach(func() {
+				cfg.Monitoring = &config.MonitoringConfig{
+					Shoot: &config.ShootMonitoringConfig{
+						SSO: &config.ShootMonitoringSSOConfig{
+							IssuerURL:       "https://issuer.example.com",
+							ClientID:        "monitoring",
+							ClientSecretRef: corev1.SecretReference{Name: "monitoring-oidc", Namespace: "garden"},
+							GroupsClaim:     pointer.String("groups"),
+							CookieRefresh:   &metav1.Duration{Duration: time.Hour},
+						},
+					},
+				}
+			})
+
+			It("should pass with a valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if required fields are not set", func() {
+				cfg.Monitoring.Shoot.SSO = &config.ShootMonitoringSSOConfig{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.sso.issuerURL"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.sso.clientID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.sso.clientSecretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.sso.clientSecretRef.namespace"),
+					})),
+				))
+			})
+
+			It("should fail if the fields are invalid", func() {
+				cfg.Monitoring.Shoot.SSO.IssuerURL = "http://issuer.example.com"
+				cfg.Monitoring.Shoot.SSO.GroupsClaim = pointer.String("")
+				cfg.Monitoring.Shoot.SSO.CookieRefresh = &metav1.Duration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.sso.issuerURL"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.sso.groupsClaim"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.sso.cookieRefresh"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot monitoring alert overrides", func() {
+			BeforeEach(func() {
+				cfg.Monitoring = &config.MonitoringConfig{
+					Shoot: &config.ShootMonitoringConfig{
+						AlertOverrides: []config.AlertOverride{
+							{AlertName: "KubeletTooManyPods", ShootPurposes: []gardencore.ShootPurpose{"evaluation", "testing"}, Drop: true},
+							{AlertName: "ApiServerNotReachable", Severity: pointer.String("blocker"), Labels: map[string]string{"team": "core", "escalation_policy": "24x7"}},
+						},
+					},
+				}
+			})
+
+			It("should pass with a valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the overrides are invalid", func() {
+				cfg.Monitoring.Shoot.AlertOverrides = []config.AlertOverride{
+					{ShootPurposes: []gardencore.ShootPurpose{"foo"}, Drop: true, Severity: pointer.String("critical")},
+					{AlertName: "Foo"},
+					{AlertName: "Bar", Severity: pointer.String("page"), Labels: map[string]string{"__team": "core", "severity": "info", "team": ""}},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.alertOverrides[0].alertName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("monitoring.shoot.alertOverrides[0].shootPurposes[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("monitoring.shoot.alertOverrides[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.alertOverrides[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("monitoring.shoot.alertOverrides[2].severity"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.alertOverrides[2].labels[__team]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("monitoring.shoot.alertOverrides[2].labels[severity]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.alertOverrides[2].labels[team]"),
+					})),
+				))
+			})
+		})
+
+		Context("monitoring customizations", func() {
+			BeforeEach(func() {
+				cfg.Monitoring = &config.MonitoringConfig{
+					Customizations: &config.MonitoringCustomizations{
+						Dashboards: []config.MonitoringCustomization{
+							{ID: "kube-apiserver", Patch: `[{"op": "replace", "path": "/title", "value": "API Server"}]`},
+						},
+						Rules: []config.MonitoringCustomization{
+							{ID: "kube-apiserver.rules.yaml", Patch: "- op: remove\n  path: /groups/0/rules/0\n"},
+						},
+					},
+				}
+			})
+
+			It("should pass with a valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the customizations are invalid", func() {
+				cfg.Monitoring.Customizations.Dashboards = append(cfg.Monitoring.Customizations.Dashboards,
+					config.MonitoringCustomization{ID: "kube-apiserver", Patch: `[{"op": "merge", "path": "/title"}]`},
+					config.MonitoringCustomization{Patch: `{"op": "remove"}`},
+				)
+				cfg.Monitoring.Customizations.Rules = append(cfg.Monitoring.Customizations.Rules,
+					config.MonitoringCustomization{ID: "etcd.rules.yaml"},
+				)
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("monitoring.customizations.dashboards[1].id"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("monitoring.customizations.dashboards[1].patch[0].op"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.customizations.dashboards[2].id"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.customizations.dashboards[2].patch"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.customizations.rules[1].patch"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot monitoring alert forwarding", func() {
+			BeforeEach(func() {
+				cfg.Monitoring = &config.MonitoringConfig{
+					Shoot: &config.ShootMonitoringConfig{
+						AlertForwarding: &config.AlertForwardingConfig{
+							Runbooks: map[string]string{"ApiServerNotReachable": "https://runbooks.example.com/apiserver"},
+							Webhooks: []config.AlertForwardingWebhook{
+								{Name: "jira", Format: "Jira", SecretRef: corev1.SecretReference{Name: "jira", Namespace: "garden"}, Severities: []string{"blocker", "critical"}},
+								{Name: "servicenow", Format: "ServiceNow", SecretRef: corev1.SecretReference{Name: "servicenow", Namespace: "garden"}, ShootPurposes: []gardencore.ShootPurpose{"production"}},
+							},
+						},
+					},
+				}
+			})
+
+			It("should pass with a valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the runbooks are invalid", func() {
+				cfg.Monitoring.Shoot.AlertForwarding.Runbooks = map[string]string{"Foo": "runbooks.example.com", "": "https://runbooks.example.com"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.alertForwarding.runbooks[Foo]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.alertForwarding.runbooks[]"),
+					})),
+				))
+			})
+
+			It("should fail if the webhooks are invalid", func() {
+				cfg.Monitoring.Shoot.AlertForwarding.Webhooks = []config.AlertForwardingWebhook{
+					{Name: "Jira", Format: "Slack", Severities: []string{"page"}, ShootPurposes: []gardencore.ShootPurpose{"foo"}},
+					{Name: "servicenow", Format: "ServiceNow", SecretRef: corev1.SecretReference{Name: "servicenow", Namespace: "garden"}},
+					{Name: "servicenow", Format: "Alertmanager", SecretRef: corev1.SecretReference{Name: "webhook", Namespace: "garden"}},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.alertForwarding.webhooks[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("monitoring.shoot.alertForwarding.webhooks[0].format"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.alertForwarding.webhooks[0].secretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.alertForwarding.webhooks[0].secretRef.namespace"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("monitoring.shoot.alertForwarding.webhooks[0].severities[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("monitoring.shoot.alertForwarding.webhooks[0].shootPurposes[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("monitoring.shoot.alertForwarding.webhooks[2].name"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot monitoring agent mode", func() {
+			BeforeEach(func() {
+				cfg.Monitoring = &config.MonitoringConfig{
+					Shoot: &config.ShootMonitoringConfig{
+						Agent: &config.ShootPrometheusAgentConfig{
+							Enabled:      true,
+							URL:          "https://central-store.example.com/api/v1/push",
+							TenantHeader: pointer.String("X-Scope-OrgID"),
+						},
+					},
+				}
+			})
+
+			It("should pass with a valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should pass if the agent mode is disabled", func() {
+				cfg.Monitoring.Shoot.Agent = &config.ShootPrometheusAgentConfig{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the remote write URL is missing", func() {
+				cfg.Monitoring.Shoot.Agent.URL = ""
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("monitoring.shoot.agent.url"),
+					})),
+				))
+			})
+
+			It("should fail if the remote write URL and the tenant header are invalid", func() {
+				cfg.Monitoring.Shoot.Agent.URL = "ftp://central-store.example.com"
+				cfg.Monitoring.Shoot.Agent.TenantHeader = pointer.String("X-Scope OrgID")
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.agent.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.agent.tenantHeader"),
+					})),
+				))
+			})
+		})
+
+		Context("autonomy", func() {
+			It("should pass with a valid configuration", func() {
+				cfg.Autonomy = &config.AutonomyConfig{
+					Enabled:               true,
+					GardenOutageThreshold: &metav1.Duration{Duration: 5 * time.Minute},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the garden outage threshold is not positive", func() {
+				cfg.Autonomy = &config.AutonomyConfig{
+					Enabled:               true,
+					GardenOutageThreshold: &metav1.Duration{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("autonomy.gardenOutageThreshold"),
+					})),
+				))
+			})
+		})
+
+		Context("continuousProfiling", func() {
+			It("should pass with a valid configuration", func() {
+				cfg.ContinuousProfiling = &config.ContinuousProfiling{
+					Enabled:          true,
+					ScrapeInterval:   &metav1.Duration{Duration: time.Minute},
+					StorageSecretRef: &corev1.SecretReference{Name: "profiling-storage", Namespace: "garden"},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the scrape interval is too short", func() {
+				cfg.ContinuousProfiling = &config.ContinuousProfiling{
+					Enabled:        true,
+					ScrapeInterval: &metav1.Duration{Duration: time.Second},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("continuousProfiling.scrapeInterval"),
+					})),
+				))
+			})
+
+			It("should fail if the storage secret reference is incomplete", func() {
+				cfg.ContinuousProfiling = &config.ContinuousProfiling{
+					Enabled:          true,
+					StorageSecretRef: &corev1.SecretReference{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("continuousProfiling.storageSecretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("continuousProfiling.storageSecretRef.namespace"),
+					})),
+				))
+			})
+		})
+
+		Context("imageVectorOverwrite", func() {
+			It("should pass with a valid configuration", func() {
+				cfg.ImageVectorOverwrite = &config.ImageVectorOverwrite{ConfigMapName: "imagevector-overwrites"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the ConfigMap name is missing", func() {
+				cfg.ImageVectorOverwrite = &config.ImageVectorOverwrite{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("imageVectorOverwrite.configMapName"),
+					})),
+				))
+			})
+		})
+
+		Context("etcdConfig.localStorage", func() {
+			It("should pass with a valid configuration", func() {
+				cfg.ETCDConfig = &config.ETCDConfig{LocalStorage: &config.ETCDLocalStorage{
+					StorageClassName:    "local-nvme",
+					NodeLossGracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the storage class name is missing", func() {
+				cfg.ETCDConfig = &config.ETCDConfig{LocalStorage: &config.ETCDLocalStorage{}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("etcdConfig.localStorage.storageClassName"),
+					})),
+				))
+			})
+
+			It("should fail if the storage class name or the grace period are invalid", func() {
+				cfg.ETCDConfig = &config.ETCDConfig{LocalStorage: &config.ETCDLocalStorage{
+					StorageClassName:    "Local_NVMe",
+					NodeLossGracePeriod: &metav1.Duration{},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("etcdConfig.localStorage.storageClassName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("etcdConfig.localStorage.nodeLossGracePeriod"),
+					})),
+				))
+			})
+		})
+
 		Context("nodeToleration", func() {
 			It("should pass with unset toleration options", func() {
 				cfg.NodeToleration = nil
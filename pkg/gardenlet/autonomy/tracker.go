@@ -0,0 +1,101 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autonomy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+)
+
+// RequeueAfterGardenOutage is the duration after which reconciliations which were deferred because gardenlet operates
+// in autonomy mode are retried.
+const RequeueAfterGardenOutage = time.Minute
+
+// Tracker keeps track of the reachability of the garden cluster and determines whether gardenlet operates in autonomy
+// mode, i.e. whether the garden cluster has not been reachable for longer than the outage threshold. In autonomy mode,
+// gardenlet does not start new shoot reconciliations but keeps the existing shoots healthy based on the state in the
+// seed cluster and its caches. A nil Tracker means that the autonomy mode is disabled.
+type Tracker struct {
+	log       logr.Logger
+	clock     clock.PassiveClock
+	threshold time.Duration
+
+	lock                  sync.RWMutex
+	lastSuccessfulContact time.Time
+	autonomous            bool
+}
+
+// NewTracker returns a new Tracker which switches to autonomy mode when the garden cluster has not been reachable for
+// longer than the given threshold.
+func NewTracker(log logr.Logger, clock clock.PassiveClock, threshold time.Duration) *Tracker {
+	return &Tracker{
+		log:                   log,
+		clock:                 clock,
+		threshold:             threshold,
+		lastSuccessfulContact: clock.Now(),
+	}
+}
+
+// GardenReachable records that the garden cluster was reached successfully. If gardenlet operated in autonomy mode,
+// it leaves it.
+func (t *Tracker) GardenReachable() {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.lastSuccessfulContact = t.clock.Now()
+	if t.autonomous {
+		t.log.Info("Garden cluster is reachable again, leaving autonomy mode")
+		t.autonomous = false
+	}
+}
+
+// GardenUnreachable records that the garden cluster could not be reached. If the garden cluster has not been reachable
+// for longer than the threshold, gardenlet switches to autonomy mode.
+func (t *Tracker) GardenUnreachable() {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if outage := t.clock.Since(t.lastSuccessfulContact); !t.autonomous && outage > t.threshold {
+		t.log.Info("Garden cluster has not been reachable for longer than the threshold, switching to autonomy mode", "outage", outage.Round(time.Second), "threshold", t.threshold)
+		t.autonomous = true
+	}
+}
+
+// Enabled returns true if the autonomy mode is enabled.
+func (t *Tracker) Enabled() bool {
+	return t != nil
+}
+
+// IsAutonomous returns true if gardenlet currently operates in autonomy mode.
+func (t *Tracker) IsAutonomous() bool {
+	if t == nil {
+		return false
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.autonomous
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autonomy_test
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/gardenlet/autonomy"
+)
+
+var _ = Describe("Tracker", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		tracker   *Tracker
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		tracker = NewTracker(logr.Discard(), fakeClock, 5*time.Minute)
+	})
+
+	It("should not be autonomous initially", func() {
+		Expect(tracker.Enabled()).To(BeTrue())
+		Expect(tracker.IsAutonomous()).To(BeFalse())
+	})
+
+	It("should not switch to autonomy mode before the threshold is exceeded", func() {
+		fakeClock.Step(5 * time.Minute)
+		tracker.GardenUnreachable()
+
+		Expect(tracker.IsAutonomous()).To(BeFalse())
+	})
+
+	It("should switch to autonomy mode after the threshold is exceeded", func() {
+		fakeClock.Step(5*time.Minute + time.Second)
+		tracker.GardenUnreachable()
+
+		Expect(tracker.IsAutonomous()).To(BeTrue())
+	})
+
+	It("should measure the outage from the last successful contact", func() {
+		fakeClock.Step(4 * time.Minute)
+		tracker.GardenReachable()
+		fakeClock.Step(4 * time.Minute)
+		tracker.GardenUnreachable()
+
+		Expect(tracker.IsAutonomous()).To(BeFalse())
+	})
+
+	It("should leave the autonomy mode when the garden cluster is reachable again", func() {
+		fakeClock.Step(10 * time.Minute)
+		tracker.GardenUnreachable()
+		Expect(tracker.IsAutonomous()).To(BeTrue())
+
+		tracker.GardenReachable()
+		Expect(tracker.IsAutonomous()).To(BeFalse())
+	})
+
+	Context("nil tracker", func() {
+		It("should be disabled and never autonomous", func() {
+			var tracker *Tracker
+
+			tracker.GardenUnreachable()
+			tracker.GardenReachable()
+
+			Expect(tracker.Enabled()).To(BeFalse())
+			Expect(tracker.IsAutonomous()).To(BeFalse())
+		})
+	})
+})
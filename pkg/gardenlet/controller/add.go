@@ -26,16 +26,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener/imagevector"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
 	"github.com/gardener/gardener/pkg/controller/tokenrequestor"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
+	"github.com/gardener/gardener/pkg/gardenlet/autonomy"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupbucket"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupentry"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/gardenlet"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed"
@@ -53,6 +58,7 @@ func AddToManager(
 	gardenCluster cluster.Cluster,
 	seedCluster cluster.Cluster,
 	shootClientMap clientmap.ClientMap,
+	shootRateLimiters *ratelimiter.Registry,
 	cfg *config.GardenletConfiguration,
 	healthManager healthz.Manager,
 ) error {
@@ -80,6 +86,18 @@ func AddToManager(
 		return fmt.Errorf("failed creating seed clientset: %w", err)
 	}
 
+	overwrites, err := ReadImageVectorOverwrites(ctx, seedCluster.GetAPIReader(), cfg, imagevector.ImageVector())
+	if err != nil {
+		return fmt.Errorf("failed reading image vector overwrites: %w", err)
+	}
+	imagevector.Overwrite(overwrites.Images)
+
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return ReportEffectiveImageVector(ctx, seedCluster.GetClient(), imagevector.ImageVector(), overwrites)
+	})); err != nil {
+		return fmt.Errorf("failed adding runnable for reporting the effective image vector: %w", err)
+	}
+
 	if err := (&backupbucket.Reconciler{
 		Config:   *cfg.Controllers.BackupBucket,
 		SeedName: cfg.SeedConfig.Name,
@@ -100,10 +118,16 @@ func AddToManager(
 		return fmt.Errorf("failed adding Bastion controller: %w", err)
 	}
 
-	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity); err != nil {
+	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity, overwrites.ExtensionImageVectors()); err != nil {
 		return fmt.Errorf("failed adding ControllerInstallation controller: %w", err)
 	}
 
+	if err := (&gardenlet.Reconciler{
+		Config: *cfg,
+	}).AddToManager(mgr, gardenCluster, seedCluster, seedClientSet); err != nil {
+		return fmt.Errorf("failed adding Gardenlet controller: %w", err)
+	}
+
 	if err := (&managedseed.Reconciler{
 		Config:         *cfg,
 		ShootClientMap: shootClientMap,
@@ -115,11 +139,16 @@ func AddToManager(
 		return fmt.Errorf("failed adding NetworkPolicy controller: %w", err)
 	}
 
-	if err := seed.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager); err != nil {
+	var autonomyTracker *autonomy.Tracker
+	if gardenlethelper.IsAutonomyModeEnabled(cfg) {
+		autonomyTracker = autonomy.NewTracker(mgr.GetLogger().WithName("autonomy"), clock.RealClock{}, cfg.Autonomy.GardenOutageThreshold.Duration)
+	}
+
+	if err := seed.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager, autonomyTracker, overwrites.ComponentImageVectors()); err != nil {
 		return fmt.Errorf("failed adding Seed controller: %w", err)
 	}
 
-	if err := shoot.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, shootClientMap, *cfg, identity, gardenClusterIdentity); err != nil {
+	if err := shoot.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, shootClientMap, shootRateLimiters, *cfg, identity, gardenClusterIdentity, autonomyTracker); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
@@ -15,6 +15,7 @@
 package backupentry
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -43,6 +44,7 @@ import (
 	extensionsbackupentry "github.com/gardener/gardener/pkg/component/extensions/backupentry"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -196,6 +198,14 @@ func (r *Reconciler) reconcileBackupEntry(
 		}
 	}
 
+	encryptionKeyRotated, err := r.reconcileEncryption(gardenCtx, seedCtx, log, backupEntry)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if encryptionKeyRotated {
+		mustReconcileExtensionBackupEntry = true
+	}
+
 	extensionBackupEntrySpec := extensionsv1alpha1.BackupEntrySpec{
 		DefaultSpec: extensionsv1alpha1.DefaultSpec{
 			Type:           backupBucket.Spec.Provider.Type,
@@ -206,6 +216,7 @@ func (r *Reconciler) reconcileBackupEntry(
 			Name:      extensionSecret.Name,
 			Namespace: extensionSecret.Namespace,
 		},
+		EncryptionSecretRef:        r.extensionEncryptionSecretRef(backupEntry),
 		BucketName:                 backupEntry.Spec.BucketName,
 		BackupBucketProviderStatus: backupBucket.Status.ProviderStatus,
 	}
@@ -298,7 +309,7 @@ func (r *Reconciler) deleteBackupEntry(
 		return reconcile.Result{}, nil
 	}
 
-	gracePeriod := computeGracePeriod(*r.Config.DeletionGracePeriodHours, r.Config.DeletionGracePeriodShootPurposes, gardencore.ShootPurpose(backupEntry.Annotations[v1beta1constants.ShootPurpose]))
+	gracePeriod := gardenerutils.ComputeBackupEntryDeletionGracePeriod(*r.Config.DeletionGracePeriodHours, r.Config.DeletionGracePeriodShootPurposes, gardencore.ShootPurpose(backupEntry.Annotations[v1beta1constants.ShootPurpose]))
 	present, _ := strconv.ParseBool(backupEntry.ObjectMeta.Annotations[gardencorev1beta1.BackupEntryForceDeletion])
 	if present || r.Clock.Since(backupEntry.DeletionTimestamp.Local()) > gracePeriod {
 		operationType := v1beta1helper.ComputeOperationType(backupEntry.ObjectMeta, backupEntry.Status.LastOperation)
@@ -366,7 +377,7 @@ func (r *Reconciler) deleteBackupEntry(
 			return reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}, nil
 		}
 
-		if err := client.IgnoreNotFound(r.SeedClient.Delete(seedCtx, extensionSecret)); err != nil {
+		if err := kubernetesutils.DeleteObjects(seedCtx, r.SeedClient, extensionSecret, r.emptyEncryptionSecret(backupEntry)); err != nil {
 			return reconcile.Result{}, err
 		}
 
@@ -483,7 +494,7 @@ func (r *Reconciler) migrateBackupEntry(
 		}
 	}
 
-	if err := client.IgnoreNotFound(r.SeedClient.Delete(seedCtx, extensionSecret)); err != nil {
+	if err := kubernetesutils.DeleteObjects(seedCtx, r.SeedClient, extensionSecret, r.emptyEncryptionSecret(backupEntry)); err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -600,6 +611,27 @@ func (r *Reconciler) emptyExtensionSecret(backupEntry *gardencorev1beta1.BackupE
 	}
 }
 
+func (r *Reconciler) emptyEncryptionSecret(backupEntry *gardencorev1beta1.BackupEntry) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("entry-%s-encryption", backupEntry.Name),
+			Namespace: r.GardenNamespace,
+		},
+	}
+}
+
+func (r *Reconciler) extensionEncryptionSecretRef(backupEntry *gardencorev1beta1.BackupEntry) *corev1.SecretReference {
+	if backupEntry.Spec.Encryption == nil {
+		return nil
+	}
+
+	encryptionSecret := r.emptyEncryptionSecret(backupEntry)
+	return &corev1.SecretReference{
+		Name:      encryptionSecret.Name,
+		Namespace: encryptionSecret.Namespace,
+	}
+}
+
 func (r *Reconciler) newExtensionComponent(log logr.Logger, backupEntry *gardencorev1beta1.BackupEntry) extensionsbackupentry.Interface {
 	extensionSecret := r.emptyExtensionSecret(backupEntry)
 	return extensionsbackupentry.New(
@@ -613,6 +645,7 @@ func (r *Reconciler) newExtensionComponent(log logr.Logger, backupEntry *gardenc
 				Name:      extensionSecret.Name,
 				Namespace: extensionSecret.Namespace,
 			},
+			EncryptionSecretRef: r.extensionEncryptionSecretRef(backupEntry),
 		},
 		extensionsbackupentry.DefaultInterval,
 		extensionsbackupentry.DefaultSevereThreshold,
@@ -674,6 +707,66 @@ func (r *Reconciler) reconcileBackupEntryExtensionSecret(ctx context.Context, ex
 	return nil
 }
 
+// reconcileEncryption copies the customer-supplied encryption key referenced by the BackupEntry into the seed and
+// records its checksum in the BackupEntry status. When the key changed, the previously used key is kept in the seed
+// secret so that existing backups can still be decrypted. It returns whether the key was rotated.
+func (r *Reconciler) reconcileEncryption(gardenCtx context.Context, seedCtx context.Context, log logr.Logger, backupEntry *gardencorev1beta1.BackupEntry) (bool, error) {
+	if backupEntry.Spec.Encryption == nil {
+		return false, nil
+	}
+
+	keySecret := &corev1.Secret{}
+	if err := r.GardenClient.Get(gardenCtx, kubernetesutils.Key(backupEntry.Namespace, backupEntry.Spec.Encryption.SecretName), keySecret); err != nil {
+		return false, fmt.Errorf("could not get encryption key secret referred in backup entry: %w", err)
+	}
+
+	key := keySecret.Data[v1beta1constants.DataKeyBackupEncryptionKey]
+	if len(key) == 0 {
+		return false, fmt.Errorf("encryption key secret %s does not contain data key %q", client.ObjectKeyFromObject(keySecret), v1beta1constants.DataKeyBackupEncryptionKey)
+	}
+
+	var (
+		encryptionSecret = r.emptyEncryptionSecret(backupEntry)
+		rotated          bool
+	)
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(seedCtx, r.SeedClient, encryptionSecret, func() error {
+		if encryptionSecret.Data == nil {
+			encryptionSecret.Data = map[string][]byte{}
+		}
+
+		if currentKey, ok := encryptionSecret.Data[v1beta1constants.DataKeyBackupEncryptionKey]; ok && !bytes.Equal(currentKey, key) {
+			encryptionSecret.Data[v1beta1constants.DataKeyBackupPreviousEncryptionKey] = currentKey
+			rotated = true
+		}
+		encryptionSecret.Data[v1beta1constants.DataKeyBackupEncryptionKey] = key
+		return nil
+	}); err != nil {
+		return false, fmt.Errorf("could not reconcile encryption secret in seed: %w", err)
+	}
+
+	keyChecksum := utils.ComputeSHA256Hex(key)
+	if backupEntry.Status.Encryption != nil && backupEntry.Status.Encryption.KeyChecksum == keyChecksum {
+		return rotated, nil
+	}
+
+	patch := client.MergeFrom(backupEntry.DeepCopy())
+
+	if backupEntry.Status.Encryption == nil {
+		backupEntry.Status.Encryption = &gardencorev1beta1.BackupEntryEncryptionStatus{}
+	} else {
+		log.Info("Encryption key was rotated")
+		backupEntry.Status.Encryption.LastRotationTime = &metav1.Time{Time: r.Clock.Now().UTC()}
+	}
+	backupEntry.Status.Encryption.KeyChecksum = keyChecksum
+
+	if err := r.GardenClient.Status().Patch(gardenCtx, backupEntry, patch); err != nil {
+		return false, fmt.Errorf("could not update encryption status: %w", err)
+	}
+
+	return rotated, nil
+}
+
 // reconcileBackupEntryExtension deploys the BackupEntry extension resource in Seed with the required secret.
 func (r *Reconciler) reconcileBackupEntryExtension(gardenCtx context.Context, seedCtx context.Context, backupBucket *gardencorev1beta1.BackupBucket, backupEntry *gardencorev1beta1.BackupEntry, component extensionsbackupentry.Interface) error {
 	component.SetType(backupBucket.Spec.Provider.Type)
@@ -702,25 +795,6 @@ func isRestorePhase(backupEntry *gardencorev1beta1.BackupEntry) bool {
 	return backupEntry.Status.LastOperation != nil && backupEntry.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeRestore
 }
 
-func computeGracePeriod(deletionGracePeriodHours int, deletionGracePeriodShootPurposes []gardencore.ShootPurpose, shootPurpose gardencore.ShootPurpose) time.Duration {
-	// If no dedicated list of purposes is provided then the grace period applies for all purposes. If the shoot purpose
-	// is empty then it was not yet updated with the purpose annotation or the corresponding `Shoot` is already deleted
-	// from the system. In this case, for backwards-compatibility, the grace period applies as well.
-	if len(deletionGracePeriodShootPurposes) == 0 || len(shootPurpose) == 0 {
-		return time.Hour * time.Duration(deletionGracePeriodHours)
-	}
-
-	// Otherwise, the grace period only applies for the purposes in the list.
-	for _, p := range deletionGracePeriodShootPurposes {
-		if p == shootPurpose {
-			return time.Hour * time.Duration(deletionGracePeriodHours)
-		}
-	}
-
-	// If the shoot purpose was not found in the list then the grace period does not apply.
-	return 0
-}
-
 func removeGardenerOperationAnnotation(ctx context.Context, c client.Client, be *gardencorev1beta1.BackupEntry) error {
 	patch := client.MergeFrom(be.DeepCopy())
 	delete(be.GetAnnotations(), v1beta1constants.GardenerOperation)
@@ -39,6 +39,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/backupentry"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 const (
@@ -274,4 +275,87 @@ var _ = Describe("Controller", func() {
 		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
 		Expect(extensionBackupEntry.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
 	})
+
+	Context("encryption", func() {
+		var (
+			keySecret        *corev1.Secret
+			encryptionSecret *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			keySecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "encryption-key",
+					Namespace: testNamespaceName,
+				},
+				Data: map[string][]byte{
+					"key": []byte("new-key"),
+				},
+			}
+			Expect(gardenClient.Create(ctx, keySecret)).To(Succeed())
+
+			backupEntry.Spec.Encryption = &gardencorev1beta1.BackupEntryEncryption{SecretName: keySecret.Name}
+			Expect(gardenClient.Update(ctx, backupEntry)).To(Succeed())
+
+			encryptionSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "entry-" + backupEntry.Name + "-encryption",
+					Namespace: gardenNamespaceName,
+				},
+			}
+		})
+
+		It("should copy the encryption key into the seed and record its checksum", func() {
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(encryptionSecret), encryptionSecret)).To(Succeed())
+			Expect(encryptionSecret.Data).To(Equal(map[string][]byte{"key": []byte("new-key")}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
+			Expect(extensionBackupEntry.Spec.EncryptionSecretRef).To(Equal(&corev1.SecretReference{Name: encryptionSecret.Name, Namespace: encryptionSecret.Namespace}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+			Expect(backupEntry.Status.Encryption).To(Equal(&gardencorev1beta1.BackupEntryEncryptionStatus{
+				KeyChecksum: utils.ComputeSHA256Hex([]byte("new-key")),
+			}))
+		})
+
+		It("should keep the previous key and record the rotation if the key has changed", func() {
+			encryptionSecret.Data = map[string][]byte{"key": []byte("old-key")}
+			Expect(seedClient.Create(ctx, encryptionSecret)).To(Succeed())
+			Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
+			extensionBackupEntry.Spec.EncryptionSecretRef = &corev1.SecretReference{Name: encryptionSecret.Name, Namespace: encryptionSecret.Namespace}
+			Expect(seedClient.Create(ctx, extensionBackupEntry)).To(Succeed())
+
+			backupEntry.Status.Encryption = &gardencorev1beta1.BackupEntryEncryptionStatus{KeyChecksum: utils.ComputeSHA256Hex([]byte("old-key"))}
+			Expect(gardenClient.Status().Update(ctx, backupEntry)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(encryptionSecret), encryptionSecret)).To(Succeed())
+			Expect(encryptionSecret.Data).To(Equal(map[string][]byte{
+				"key":         []byte("new-key"),
+				"previousKey": []byte("old-key"),
+			}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
+			Expect(extensionBackupEntry.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+			Expect(backupEntry.Status.Encryption.KeyChecksum).To(Equal(utils.ComputeSHA256Hex([]byte("new-key"))))
+			Expect(backupEntry.Status.Encryption.LastRotationTime.Time).To(BeTemporally("==", fakeClock.Now().Truncate(time.Second)))
+		})
+
+		It("should fail if the key secret does not contain the key", func() {
+			keySecret.Data = map[string][]byte{"foo": []byte("bar")}
+			Expect(gardenClient.Update(ctx, keySecret)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring(`does not contain data key "key"`)))
+		})
+	})
 })
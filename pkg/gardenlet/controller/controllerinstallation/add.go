@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/controllerinstallation"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/required"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // AddToManager adds all ControllerInstallation controllers to the given manager.
@@ -39,6 +40,7 @@ func AddToManager(
 	cfg config.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	gardenClusterIdentity string,
+	extensionImageVectors imagevectorutils.ComponentImageVectors,
 ) error {
 	if err := (&care.Reconciler{
 		Config: *cfg.Controllers.ControllerInstallationCare,
@@ -51,6 +53,7 @@ func AddToManager(
 		Config:                cfg,
 		Identity:              identity,
 		GardenClusterIdentity: gardenClusterIdentity,
+		ExtensionImageVectors: extensionImageVectors,
 	}).AddToManager(ctx, mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
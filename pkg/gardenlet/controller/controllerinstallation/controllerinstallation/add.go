@@ -16,6 +16,7 @@ package controllerinstallation
 
 import (
 	"context"
+	"net/http"
 	"reflect"
 
 	"k8s.io/utils/clock"
@@ -32,6 +33,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // ControllerName is the name of this controller.
@@ -48,6 +50,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gard
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.HelmRegistry == nil {
+		r.HelmRegistry = oci.NewHelmRegistry(http.DefaultClient, r.Clock)
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
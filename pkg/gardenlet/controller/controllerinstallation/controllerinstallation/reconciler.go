@@ -57,8 +57,10 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -77,6 +79,10 @@ type Reconciler struct {
 	Clock                 clock.Clock
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
+	HelmRegistry          oci.Interface
+	// ExtensionImageVectors contains the image vector overwrites scoped to extensions, keyed by the name of their
+	// ControllerRegistration.
+	ExtensionImageVectors imagevectorutils.ComponentImageVectors
 }
 
 // Reconcile reconciles ControllerInstallations and deploys them into the seed cluster.
@@ -164,6 +170,8 @@ func (r *Reconciler) reconcile(
 		Chart []byte `json:"chart,omitempty"`
 		// Values is a map of values for the given chart.
 		Values map[string]interface{} `json:"values,omitempty"`
+		// OCIRepository references a Helm chart stored as OCI artifact which is pulled instead of using Chart.
+		OCIRepository *oci.HelmRepository `json:"ociRepository,omitempty"`
 	}
 
 	if err := json.Unmarshal(providerConfig.Raw, &helmDeployment); err != nil {
@@ -171,10 +179,43 @@ func (r *Reconciler) reconcile(
 		return reconcile.Result{}, err
 	}
 
+	chart := helmDeployment.Chart
+	if helmDeployment.OCIRepository != nil {
+		if len(helmDeployment.Chart) > 0 {
+			err := fmt.Errorf("chart and ociRepository must not be set at the same time")
+			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("chart Information is invalid: %+v", err))
+			return reconcile.Result{}, err
+		}
+
+		var err error
+		if chart, err = r.HelmRegistry.Pull(gardenCtx, helmDeployment.OCIRepository); err != nil {
+			if oci.IsVerificationError(err) {
+				conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartVerificationFailed", fmt.Sprintf("chart verification failed: %+v", err))
+			} else {
+				conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionUnknown, "ChartPullFailed", fmt.Sprintf("chart cannot be pulled: %+v", err))
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
 	namespace := getNamespaceForControllerInstallation(controllerInstallation)
 	if _, err := controllerutils.GetAndCreateOrMergePatch(seedCtx, r.SeedClientSet.Client(), namespace, func() error {
 		metav1.SetMetaDataLabel(&namespace.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleExtension)
 		metav1.SetMetaDataLabel(&namespace.ObjectMeta, v1beta1constants.LabelControllerRegistrationName, controllerRegistration.Name)
+
+		// The extension type labels are used to discover the extensions managing a shoot, e.g., for restricting their
+		// network access to the shoot's kube-apiserver.
+		for k := range namespace.Labels {
+			for _, prefix := range gardenerutils.ExtensionTypeLabelPrefixes {
+				if strings.HasPrefix(k, prefix) {
+					delete(namespace.Labels, k)
+				}
+			}
+		}
+		for k, v := range gardenerutils.ExtensionTypeLabelsForControllerRegistration(controllerRegistration) {
+			metav1.SetMetaDataLabel(&namespace.ObjectMeta, k, v)
+		}
+
 		metav1.SetMetaDataLabel(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigConsider, "true")
 		metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigZones, strings.Join(seed.Spec.Provider.Zones, ","))
 
@@ -251,7 +292,14 @@ func (r *Reconciler) reconcile(
 		},
 	}
 
-	release, err := r.SeedClientSet.ChartRenderer().RenderArchive(helmDeployment.Chart, controllerRegistration.Name, namespace.Name, utils.MergeMaps(helmDeployment.Values, gardenerValues))
+	values := utils.MergeMaps(helmDeployment.Values, gardenerValues)
+	// The image vector overwrite scoped to this extension in the gardenlet configuration takes precedence over the one
+	// potentially specified in the ControllerDeployment.
+	if imageVectorOverwrite, ok := r.ExtensionImageVectors[controllerRegistration.Name]; ok {
+		values["imageVectorOverwrite"] = imageVectorOverwrite
+	}
+
+	release, err := r.SeedClientSet.ChartRenderer().RenderArchive(chart, controllerRegistration.Name, namespace.Name, values)
 	if err != nil {
 		conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartCannotBeRendered", fmt.Sprintf("chart rendering process failed: %+v", err))
 		return reconcile.Result{}, err
@@ -0,0 +1,84 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenlet
+
+import (
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "gardenlet"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Cluster, seedCluster cluster.Cluster, seedClientSet kubernetes.Interface) error {
+	if r.GardenClient == nil {
+		r.GardenClient = gardenCluster.GetClient()
+	}
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+	if r.SeedChartApplier == nil {
+		r.SeedChartApplier = seedClientSet.ChartApplier()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.ValuesHelper == nil {
+		r.ValuesHelper = managedseed.NewValuesHelper(&r.Config)
+	}
+	if r.GardenNamespaceGarden == "" {
+		r.GardenNamespaceGarden = v1beta1constants.GardenNamespace
+	}
+	if r.GardenNamespaceTarget == "" {
+		r.GardenNamespaceTarget = v1beta1constants.GardenNamespace
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).
+		WatchesRawSource(
+			source.Kind(gardenCluster.GetCache(), &seedmanagementv1alpha1.Gardenlet{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(
+				r.GardenletPredicate(),
+				predicate.GenerationChangedPredicate{},
+			),
+		).
+		Complete(r)
+}
+
+// GardenletPredicate returns a predicate which only lets events pass for the Gardenlet resource of the seed this
+// gardenlet is responsible for.
+func (r *Reconciler) GardenletPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetNamespace() == r.GardenNamespaceGarden && obj.GetName() == r.Config.SeedConfig.Name
+	})
+}
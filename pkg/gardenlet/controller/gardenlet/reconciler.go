@@ -0,0 +1,293 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenlet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/charts"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
+	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+const (
+	// deploymentName is the name of the gardenlet Deployment in the seed cluster.
+	deploymentName = "gardenlet"
+	// lastSuccessfulValuesSecretName is the name of the secret in the seed cluster which contains the gardenlet chart
+	// values of the last successful rollout. They are used for rolling back failed upgrades.
+	lastSuccessfulValuesSecretName = "gardenlet-last-successful-values"
+	// dataKeyValues is the data key in the secret containing the gardenlet chart values.
+	dataKeyValues = "values.json"
+	// reasonProgressing is the condition reason used while the gardenlet Deployment is rolled out.
+	reasonProgressing = "Progressing"
+	// reasonReconciled is the condition reason used when the gardenlet Deployment was rolled out successfully.
+	reasonReconciled = "Reconciled"
+	// progressingRequeueAfter is the duration after which the rollout of the gardenlet Deployment is checked again.
+	progressingRequeueAfter = 10 * time.Second
+)
+
+// Reconciler reconciles the Gardenlet resource of the seed the gardenlet is responsible for. It deploys the gardenlet
+// chart with the desired values into the seed cluster, verifies the rollout, and rolls back to the last successful
+// values if the new gardenlet pods are crash-looping.
+type Reconciler struct {
+	GardenClient          client.Client
+	SeedClient            client.Client
+	SeedChartApplier      kubernetes.ChartApplier
+	Config                config.GardenletConfiguration
+	Clock                 clock.Clock
+	ValuesHelper          managedseed.ValuesHelper
+	GardenNamespaceGarden string
+	GardenNamespaceTarget string
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	gardenlet := &seedmanagementv1alpha1.Gardenlet{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, gardenlet); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// Gardenlets of managed seeds are deployed by the ManagedSeed controller of the parent gardenlet.
+	if err := r.GardenClient.Get(ctx, kubernetesutils.Key(r.GardenNamespaceGarden, gardenlet.Name), &seedmanagementv1alpha1.ManagedSeed{}); err == nil {
+		log.Info("Seed is managed by a ManagedSeed, skipping reconciliation")
+		return reconcile.Result{}, nil
+	} else if !apierrors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether seed is managed by a ManagedSeed: %w", err)
+	}
+
+	if gardenlet.Status.ObservedGeneration == gardenlet.Generation {
+		log.V(1).Info("Gardenlet resource was already reconciled, nothing to do")
+		return reconcile.Result{}, nil
+	}
+
+	values, err := r.computeValues(gardenlet)
+	if err != nil {
+		return reconcile.Result{}, r.updateStatusError(ctx, gardenlet, fmt.Errorf("failed computing gardenlet chart values: %w", err))
+	}
+
+	log.Info("Deploying gardenlet chart")
+	if err := r.SeedChartApplier.ApplyFromEmbeddedFS(ctx, charts.ChartGardenlet, charts.ChartPathGardenlet, r.GardenNamespaceTarget, "gardenlet", kubernetes.Values(values)); err != nil {
+		return reconcile.Result{}, r.updateStatusError(ctx, gardenlet, fmt.Errorf("failed deploying gardenlet chart: %w", err))
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := r.SeedClient.Get(ctx, kubernetesutils.Key(r.GardenNamespaceTarget, deploymentName), deployment); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading gardenlet deployment: %w", err)
+	}
+
+	crashLooping, err := r.hasCrashLoopingPods(ctx, deployment)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if crashLooping {
+		log.Info("New gardenlet pods are crash-looping, rolling back to last successful values")
+		if err := r.rollback(ctx); err != nil {
+			return reconcile.Result{}, r.updateStatusError(ctx, gardenlet, fmt.Errorf("failed rolling back gardenlet: %w", err))
+		}
+
+		// The observed generation is updated to prevent endless upgrade attempts. A retry can be triggered by changing
+		// the spec or by annotating the resource with `gardener.cloud/operation=reconcile`.
+		return reconcile.Result{}, r.updateStatus(ctx, gardenlet, gardencorev1beta1.ConditionFalse, seedmanagementv1alpha1.GardenletReasonRolledBack,
+			"New gardenlet pods were crash-looping, rolled back to the last successful configuration", gardenlet.Generation)
+	}
+
+	if progressing, reason := health.IsDeploymentProgressing(deployment); progressing {
+		log.Info("Gardenlet deployment is still being rolled out", "reason", reason)
+		if err := r.updateStatus(ctx, gardenlet, gardencorev1beta1.ConditionProgressing, reasonProgressing, reason, gardenlet.Status.ObservedGeneration); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: progressingRequeueAfter}, nil
+	}
+
+	if err := health.CheckDeployment(deployment); err != nil {
+		log.Info("Gardenlet deployment is unhealthy", "reason", err.Error())
+		if err := r.updateStatus(ctx, gardenlet, gardencorev1beta1.ConditionProgressing, reasonProgressing, err.Error(), gardenlet.Status.ObservedGeneration); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: progressingRequeueAfter}, nil
+	}
+
+	if err := r.storeLastSuccessfulValues(ctx, values); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Gardenlet deployment was rolled out successfully")
+	return reconcile.Result{}, r.updateStatus(ctx, gardenlet, gardencorev1beta1.ConditionTrue, reasonReconciled, "Gardenlet deployment was rolled out successfully", gardenlet.Generation)
+}
+
+func (r *Reconciler) computeValues(gardenlet *seedmanagementv1alpha1.Gardenlet) (map[string]interface{}, error) {
+	deployment, err := r.ValuesHelper.MergeGardenletDeployment(gardenlet.Spec.Deployment.DeepCopy(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gardenletConfig := &gardenletv1alpha1.GardenletConfiguration{}
+	if gardenlet.Spec.Config.Raw != nil || gardenlet.Spec.Config.Object != nil {
+		gardenletConfig, err = encoding.DecodeGardenletConfiguration(gardenlet.Spec.Config.DeepCopy(), false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	gardenletConfig, err = r.ValuesHelper.MergeGardenletConfiguration(gardenletConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// In contrast to gardenlets deployed for ManagedSeeds, the seed config of the running gardenlet is kept unless it
+	// is overwritten in the Gardenlet resource.
+	if gardenletConfig.SeedConfig == nil {
+		runningConfig, err := gardenlethelper.ConvertGardenletConfigurationExternal(&r.Config)
+		if err != nil {
+			return nil, err
+		}
+		gardenletConfig.SeedConfig = runningConfig.SeedConfig
+	}
+	if gardenletConfig.SeedConfig == nil {
+		gardenletConfig.SeedConfig = &gardenletv1alpha1.SeedConfig{}
+	}
+	gardenletConfig.SeedConfig.SeedTemplate.Name = gardenlet.Name
+
+	return r.ValuesHelper.GetGardenletChartValues(deployment, gardenletConfig, "")
+}
+
+func (r *Reconciler) hasCrashLoopingPods(ctx context.Context, deployment *appsv1.Deployment) (bool, error) {
+	if deployment.Spec.Selector == nil {
+		return false, nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.SeedClient.List(ctx, podList, client.InNamespace(deployment.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return false, fmt.Errorf("failed listing gardenlet pods: %w", err)
+	}
+
+	for _, pod := range podList.Items {
+		if health.IsPodReady(&pod) {
+			continue
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == "CrashLoopBackOff" {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (r *Reconciler) rollback(ctx context.Context) error {
+	values, err := r.lastSuccessfulValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.SeedChartApplier.ApplyFromEmbeddedFS(ctx, charts.ChartGardenlet, charts.ChartPathGardenlet, r.GardenNamespaceTarget, "gardenlet", kubernetes.Values(values))
+}
+
+// lastSuccessfulValues returns the chart values of the last successful rollout. If there was no successful rollout
+// yet, the values are computed from the configuration of the currently running gardenlet.
+func (r *Reconciler) lastSuccessfulValues(ctx context.Context) (map[string]interface{}, error) {
+	secret := &corev1.Secret{}
+	if err := r.SeedClient.Get(ctx, kubernetesutils.Key(r.GardenNamespaceTarget, lastSuccessfulValuesSecretName), secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed reading secret with last successful values: %w", err)
+		}
+
+		return r.computeValues(&seedmanagementv1alpha1.Gardenlet{ObjectMeta: metav1.ObjectMeta{Name: r.Config.SeedConfig.Name}})
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(secret.Data[dataKeyValues], &values); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling last successful values: %w", err)
+	}
+
+	return values, nil
+}
+
+func (r *Reconciler) storeLastSuccessfulValues(ctx context.Context, values map[string]interface{}) error {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("failed marshalling gardenlet chart values: %w", err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: lastSuccessfulValuesSecretName, Namespace: r.GardenNamespaceTarget}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.SeedClient, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{dataKeyValues: data}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed storing last successful values: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) updateStatus(
+	ctx context.Context,
+	gardenlet *seedmanagementv1alpha1.Gardenlet,
+	status gardencorev1beta1.ConditionStatus,
+	reason, message string,
+	observedGeneration int64,
+) error {
+	patch := client.StrategicMergeFrom(gardenlet.DeepCopy())
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, gardenlet.Status.Conditions, seedmanagementv1alpha1.GardenletReconciled)
+	condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, status, reason, message)
+	gardenlet.Status.Conditions = v1beta1helper.MergeConditions(gardenlet.Status.Conditions, condition)
+	gardenlet.Status.ObservedGeneration = observedGeneration
+
+	return r.GardenClient.Status().Patch(ctx, gardenlet, patch)
+}
+
+func (r *Reconciler) updateStatusError(ctx context.Context, gardenlet *seedmanagementv1alpha1.Gardenlet, err error) error {
+	if updateErr := r.updateStatus(ctx, gardenlet, gardencorev1beta1.ConditionFalse, gardencorev1beta1.EventReconcileError, err.Error(), gardenlet.Status.ObservedGeneration); updateErr != nil {
+		return fmt.Errorf("could not update status: %w (original error: %w)", updateErr, err)
+	}
+	return err
+}
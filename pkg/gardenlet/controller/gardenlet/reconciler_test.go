@@ -0,0 +1,216 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenlet_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/gardenlet"
+	mockmanagedseed "github.com/gardener/gardener/pkg/gardenlet/controller/managedseed/mock"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClock  = testclock.NewFakeClock(time.Now())
+		seedName   = "seed"
+		namespace  = "garden"
+		request    = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: seedName}}
+		oldValues  = map[string]interface{}{"image": "old"}
+		newValues  = map[string]interface{}{"image": "new"}
+		deployment *seedmanagementv1alpha1.GardenletDeployment
+
+		ctrl            *gomock.Controller
+		chartApplier    *mockkubernetes.MockChartApplier
+		valuesHelper    *mockmanagedseed.MockValuesHelper
+		gardenClient    client.Client
+		seedClient      client.Client
+		gardenlet       *seedmanagementv1alpha1.Gardenlet
+		gardenletDeploy *appsv1.Deployment
+		reconciler      *Reconciler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		chartApplier = mockkubernetes.NewMockChartApplier(ctrl)
+		valuesHelper = mockmanagedseed.NewMockValuesHelper(ctrl)
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&seedmanagementv1alpha1.Gardenlet{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		deployment = &seedmanagementv1alpha1.GardenletDeployment{Image: &seedmanagementv1alpha1.Image{Tag: pointer.String("v1.2.3")}}
+		gardenlet = &seedmanagementv1alpha1.Gardenlet{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName, Namespace: namespace, Generation: 2},
+			Spec:       seedmanagementv1alpha1.GardenletSpec{Deployment: *deployment},
+			Status:     seedmanagementv1alpha1.GardenletStatus{ObservedGeneration: 1},
+		}
+		Expect(gardenClient.Create(ctx, gardenlet)).To(Succeed())
+		gardenlet.Status.ObservedGeneration = 1
+		Expect(gardenClient.Status().Update(ctx, gardenlet)).To(Succeed())
+
+		gardenletDeploy = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardenlet", Namespace: namespace, Generation: 1},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: pointer.Int32(1),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "gardener", "role": "gardenlet"}},
+			},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           1,
+				UpdatedReplicas:    1,
+				AvailableReplicas:  1,
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "NewReplicaSetAvailable"},
+				},
+			},
+		}
+
+		reconciler = &Reconciler{
+			GardenClient:          gardenClient,
+			SeedClient:            seedClient,
+			SeedChartApplier:      chartApplier,
+			Config:                config.GardenletConfiguration{SeedConfig: &config.SeedConfig{SeedTemplate: gardencore.SeedTemplate{ObjectMeta: metav1.ObjectMeta{Name: seedName}}}},
+			Clock:                 fakeClock,
+			ValuesHelper:          valuesHelper,
+			GardenNamespaceGarden: namespace,
+			GardenNamespaceTarget: namespace,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectComputeValues := func(values map[string]interface{}) {
+		valuesHelper.EXPECT().MergeGardenletDeployment(gomock.Any(), nil).DoAndReturn(func(d *seedmanagementv1alpha1.GardenletDeployment, _ *gardencorev1beta1.Shoot) (*seedmanagementv1alpha1.GardenletDeployment, error) {
+			return d, nil
+		})
+		valuesHelper.EXPECT().MergeGardenletConfiguration(gomock.Any()).DoAndReturn(func(c *gardenletv1alpha1.GardenletConfiguration) (*gardenletv1alpha1.GardenletConfiguration, error) {
+			return c, nil
+		})
+		valuesHelper.EXPECT().GetGardenletChartValues(gomock.Any(), gomock.Any(), "").DoAndReturn(func(_ *seedmanagementv1alpha1.GardenletDeployment, c *gardenletv1alpha1.GardenletConfiguration, _ string) (map[string]interface{}, error) {
+			Expect(c.SeedConfig.Name).To(Equal(seedName))
+			return values, nil
+		})
+	}
+
+	expectApply := func(values map[string]interface{}) {
+		chartApplier.EXPECT().ApplyFromEmbeddedFS(gomock.Any(), gomock.Any(), gomock.Any(), namespace, "gardenlet", kubernetes.Values(values))
+	}
+
+	It("should do nothing if the object is gone", func() {
+		Expect(gardenClient.Delete(ctx, gardenlet)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the seed is a managed seed", func() {
+		Expect(gardenClient.Create(ctx, &seedmanagementv1alpha1.ManagedSeed{ObjectMeta: metav1.ObjectMeta{Name: seedName, Namespace: namespace}})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the generation was already observed", func() {
+		gardenlet.Status.ObservedGeneration = 2
+		Expect(gardenClient.Status().Update(ctx, gardenlet)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should deploy the gardenlet and report success if the deployment was rolled out", func() {
+		Expect(seedClient.Create(ctx, gardenletDeploy)).To(Succeed())
+		expectComputeValues(newValues)
+		expectApply(newValues)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(gardenlet), gardenlet)).To(Succeed())
+		Expect(gardenlet.Status.ObservedGeneration).To(Equal(int64(2)))
+		condition := v1beta1helper.GetCondition(gardenlet.Status.Conditions, seedmanagementv1alpha1.GardenletReconciled)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		secret := &corev1.Secret{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "gardenlet-last-successful-values"}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue("values.json", []byte(`{"image":"new"}`)))
+	})
+
+	It("should requeue if the deployment is still being rolled out", func() {
+		gardenletDeploy.Generation = 2
+		Expect(seedClient.Create(ctx, gardenletDeploy)).To(Succeed())
+		expectComputeValues(newValues)
+		expectApply(newValues)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(gardenlet), gardenlet)).To(Succeed())
+		Expect(gardenlet.Status.ObservedGeneration).To(Equal(int64(1)))
+		condition := v1beta1helper.GetCondition(gardenlet.Status.Conditions, seedmanagementv1alpha1.GardenletReconciled)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+	})
+
+	It("should roll back to the last successful values if the new pods are crash-looping", func() {
+		gardenletDeploy.Generation = 2
+		Expect(seedClient.Create(ctx, gardenletDeploy)).To(Succeed())
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardenlet-last-successful-values", Namespace: namespace},
+			Data:       map[string][]byte{"values.json": []byte(`{"image":"old"}`)},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardenlet-new", Namespace: namespace, Labels: map[string]string{"app": "gardener", "role": "gardenlet"}},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "gardenlet",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				}},
+			},
+		})).To(Succeed())
+		expectComputeValues(newValues)
+		expectApply(newValues)
+		expectApply(oldValues)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(gardenlet), gardenlet)).To(Succeed())
+		Expect(gardenlet.Status.ObservedGeneration).To(Equal(int64(2)))
+		condition := v1beta1helper.GetCondition(gardenlet.Status.Conditions, seedmanagementv1alpha1.GardenletReconciled)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(seedmanagementv1alpha1.GardenletReasonRolledBack))
+	})
+})
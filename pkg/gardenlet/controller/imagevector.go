@@ -0,0 +1,115 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component/etcd"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+const (
+	// DataKeyImageVectorOverwrites is the data key in the ConfigMap referenced in the gardenlet configuration which
+	// contains the image vector overwrites.
+	DataKeyImageVectorOverwrites = "overwrites.yaml"
+	// ConfigMapNameEffectiveImageVector is the name of the ConfigMap in the garden namespace of the seed cluster which
+	// reports the effective image vector and the scoped image vector overwrites of the gardenlet.
+	ConfigMapNameEffectiveImageVector = "gardenlet-effective-image-vector"
+	// DataKeyEffectiveImageVector is the data key in the ConfigMap reporting the effective image vector.
+	DataKeyEffectiveImageVector = "effective.yaml"
+)
+
+// knownImageVectorComponents is the set of components maintaining their own image vector which can be overwritten.
+var knownImageVectorComponents = sets.New(etcd.Druid)
+
+// ReadImageVectorOverwrites reads the image vector overwrites from the ConfigMap referenced in the gardenlet
+// configuration and validates them against the given image vector and the known components. The component-specific
+// overwrites from the file referenced by the deprecated IMAGEVECTOR_OVERWRITE_COMPONENTS environment variable are still
+// considered, however, the overwrites from the ConfigMap take precedence.
+func ReadImageVectorOverwrites(ctx context.Context, reader client.Reader, cfg *config.GardenletConfiguration, imageVector imagevectorutils.ImageVector) (*imagevectorutils.Overwrites, error) {
+	overwrites := &imagevectorutils.Overwrites{}
+
+	if cfg.ImageVectorOverwrite != nil {
+		configMap := &corev1.ConfigMap{}
+		if err := reader.Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: cfg.ImageVectorOverwrite.ConfigMapName}, configMap); err != nil {
+			return nil, fmt.Errorf("failed reading ConfigMap with image vector overwrites: %w", err)
+		}
+
+		var err error
+		overwrites, err = imagevectorutils.ReadOverwrites([]byte(configMap.Data[DataKeyImageVectorOverwrites]))
+		if err != nil {
+			return nil, fmt.Errorf("failed reading image vector overwrites from ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+		}
+
+		knownImageNames := sets.New[string]()
+		for _, imageSource := range imageVector {
+			knownImageNames.Insert(imageSource.Name)
+		}
+
+		if errs := imagevectorutils.ValidateOverwrites(overwrites, knownImageNames, knownImageVectorComponents, field.NewPath(DataKeyImageVectorOverwrites)); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid image vector overwrites in ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), errs.ToAggregate())
+		}
+	}
+
+	if path := os.Getenv(imagevectorutils.ComponentOverrideEnv); path != "" {
+		componentImageVectors, err := imagevectorutils.ReadComponentOverwriteFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading component-specific image vector override: %w", err)
+		}
+
+		configured := overwrites.ComponentImageVectors()
+		for _, name := range sets.List(sets.KeySet(componentImageVectors)) {
+			if _, ok := configured[name]; !ok {
+				overwrites.Components = append(overwrites.Components, imagevectorutils.ComponentImageVector{Name: name, ImageVectorOverwrite: componentImageVectors[name]})
+			}
+		}
+	}
+
+	return overwrites, nil
+}
+
+// ReportEffectiveImageVector writes the given effective image vector together with the component- and extension-scoped
+// overwrites to a ConfigMap in the garden namespace of the seed cluster, so that the images used on the seed can be
+// audited.
+func ReportEffectiveImageVector(ctx context.Context, c client.Client, imageVector imagevectorutils.ImageVector, overwrites *imagevectorutils.Overwrites) error {
+	effective, err := yaml.Marshal(&imagevectorutils.Overwrites{
+		Images:     imageVector,
+		Components: overwrites.Components,
+		Extensions: overwrites.Extensions,
+	})
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapNameEffectiveImageVector, Namespace: v1beta1constants.GardenNamespace}}
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, c, configMap, func() error {
+		configMap.Data = map[string]string{DataKeyEffectiveImageVector: string(effective)}
+		return nil
+	})
+	return err
+}
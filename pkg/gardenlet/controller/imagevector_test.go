@@ -0,0 +1,186 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("ImageVector", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+
+		imageVector imagevectorutils.ImageVector
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		imageVector = imagevectorutils.ImageVector{
+			{Name: "foo", Repository: "registry.example.com/foo", Tag: pointer.String("v1")},
+			{Name: "bar", Repository: "registry.example.com/bar", Tag: pointer.String("v1")},
+		}
+	})
+
+	Describe("#ReadImageVectorOverwrites", func() {
+		var cfg *config.GardenletConfiguration
+
+		BeforeEach(func() {
+			cfg = &config.GardenletConfiguration{
+				ImageVectorOverwrite: &config.ImageVectorOverwrite{ConfigMapName: "image-vector-overwrites"},
+			}
+		})
+
+		createConfigMap := func(data string) {
+			ExpectWithOffset(1, fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "image-vector-overwrites", Namespace: "garden"},
+				Data:       map[string]string{"overwrites.yaml": data},
+			})).To(Succeed())
+		}
+
+		It("should return empty overwrites if nothing is configured", func() {
+			overwrites, err := ReadImageVectorOverwrites(ctx, fakeClient, &config.GardenletConfiguration{}, imageVector)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(overwrites).To(Equal(&imagevectorutils.Overwrites{}))
+		})
+
+		It("should fail if the ConfigMap does not exist", func() {
+			_, err := ReadImageVectorOverwrites(ctx, fakeClient, cfg, imageVector)
+			Expect(err).To(MatchError(ContainSubstring("failed reading ConfigMap with image vector overwrites")))
+		})
+
+		It("should read the scoped overwrites", func() {
+			createConfigMap(`images:
+- name: foo
+  repository: registry.example.com/mirror/foo
+components:
+- name: etcd-druid
+  imageVectorOverwrite: |
+    images:
+    - name: etcd
+      repository: registry.example.com/etcd
+      tag: v3
+extensions:
+- name: provider-local
+  imageVectorOverwrite: |
+    images:
+    - name: machine-controller-manager-provider-local
+      repository: registry.example.com/mcm
+      tag: v2
+`)
+
+			overwrites, err := ReadImageVectorOverwrites(ctx, fakeClient, cfg, imageVector)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(overwrites.Images).To(ConsistOf(&imagevectorutils.ImageSource{Name: "foo", Repository: "registry.example.com/mirror/foo"}))
+			Expect(overwrites.ComponentImageVectors()).To(HaveKeyWithValue("etcd-druid", "images:\n- name: etcd\n  repository: registry.example.com/etcd\n  tag: v3\n"))
+			Expect(overwrites.ExtensionImageVectors()).To(HaveKeyWithValue("provider-local", "images:\n- name: machine-controller-manager-provider-local\n  repository: registry.example.com/mcm\n  tag: v2\n"))
+		})
+
+		It("should fail for unknown images and components", func() {
+			createConfigMap(`images:
+- name: unknown
+  repository: registry.example.com/unknown
+components:
+- name: unknown-component
+  imageVectorOverwrite: |
+    images: []
+`)
+
+			_, err := ReadImageVectorOverwrites(ctx, fakeClient, cfg, imageVector)
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`overwrites.yaml.images[0].name: Unsupported value: "unknown"`),
+				ContainSubstring(`overwrites.yaml.components[0].name: Unsupported value: "unknown-component"`),
+			)))
+		})
+
+		Context("deprecated environment variable", func() {
+			BeforeEach(func() {
+				path := filepath.Join(GinkgoT().TempDir(), "components.yaml")
+				Expect(os.WriteFile(path, []byte(`components:
+- name: etcd-druid
+  imageVectorOverwrite: |
+    images:
+    - name: etcd
+      repository: registry.example.com/etcd
+      tag: from-env
+`), 0600)).To(Succeed())
+				GinkgoT().Setenv(imagevectorutils.ComponentOverrideEnv, path)
+			})
+
+			It("should consider the component overwrites from the environment", func() {
+				overwrites, err := ReadImageVectorOverwrites(ctx, fakeClient, &config.GardenletConfiguration{}, imageVector)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(overwrites.ComponentImageVectors()).To(HaveKeyWithValue("etcd-druid", "images:\n- name: etcd\n  repository: registry.example.com/etcd\n  tag: from-env\n"))
+			})
+
+			It("should prefer the component overwrites from the ConfigMap", func() {
+				createConfigMap(`components:
+- name: etcd-druid
+  imageVectorOverwrite: |
+    images:
+    - name: etcd
+      repository: registry.example.com/etcd
+      tag: from-config
+`)
+
+				overwrites, err := ReadImageVectorOverwrites(ctx, fakeClient, cfg, imageVector)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(overwrites.Components).To(HaveLen(1))
+				Expect(overwrites.ComponentImageVectors()).To(HaveKeyWithValue("etcd-druid", "images:\n- name: etcd\n  repository: registry.example.com/etcd\n  tag: from-config\n"))
+			})
+		})
+	})
+
+	Describe("#ReportEffectiveImageVector", func() {
+		It("should write the effective image vector and the scoped overwrites", func() {
+			overwrites := &imagevectorutils.Overwrites{
+				Extensions: []imagevectorutils.ComponentImageVector{{Name: "provider-local", ImageVectorOverwrite: "images: []\n"}},
+			}
+
+			Expect(ReportEffectiveImageVector(ctx, fakeClient, imageVector, overwrites)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardenlet-effective-image-vector", Namespace: "garden"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("effective.yaml", `extensions:
+- imageVectorOverwrite: |
+    images: []
+  name: provider-local
+images:
+- name: foo
+  repository: registry.example.com/foo
+  tag: v1
+- name: bar
+  repository: registry.example.com/bar
+  tag: v1
+`))
+		})
+	})
+})
@@ -84,7 +84,7 @@ var _ = Describe("Actuator", func() {
 		secret        *corev1.Secret
 
 		seedTemplate *gardencorev1beta1.SeedTemplate
-		gardenlet    *seedmanagementv1alpha1.Gardenlet
+		gardenlet    *seedmanagementv1alpha1.GardenletConfig
 
 		gardenNamespace     *corev1.Namespace
 		backupSecret        *corev1.Secret
@@ -193,7 +193,7 @@ var _ = Describe("Actuator", func() {
 				Ingress: &gardencorev1beta1.Ingress{},
 			},
 		}
-		gardenlet = &seedmanagementv1alpha1.Gardenlet{
+		gardenlet = &seedmanagementv1alpha1.GardenletConfig{
 			Deployment: &seedmanagementv1alpha1.GardenletDeployment{
 				ReplicaCount:         pointer.Int32(1),
 				RevisionHistoryLimit: pointer.Int32(1),
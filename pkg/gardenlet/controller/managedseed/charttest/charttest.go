@@ -222,13 +222,7 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 			{
 				APIGroups: []string{""},
 				Resources: []string{"persistentvolumeclaims"},
-				Verbs:     []string{"get", "list", "watch", "patch", "update"},
-			},
-			{
-				APIGroups:     []string{""},
-				Resources:     []string{"persistentvolumeclaims"},
-				ResourceNames: []string{"alertmanager-db-alertmanager-0", "vali-vali-0", "loki-loki-0", "prometheus-db-prometheus-0"},
-				Verbs:         []string{"delete"},
+				Verbs:     []string{"get", "list", "watch", "patch", "update", "delete"},
 			},
 			{
 				APIGroups: []string{"admissionregistration.k8s.io"},
@@ -425,6 +419,11 @@ func getAPIServerSNIClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				ResourceNames: []string{"proxy-protocol-blackhole"},
 				Verbs:         []string{"get", "patch", "update"},
 			},
+			{
+				APIGroups: []string{"security.istio.io"},
+				Resources: []string{"authorizationpolicies"},
+				Verbs:     []string{"create", "get", "list", "watch", "patch", "update", "delete"},
+			},
 		},
 	}
 }
@@ -660,6 +659,8 @@ func ComputeExpectedGardenletConfiguration(
 		logFormatJson       = "json"
 		lockObjectName      = "gardenlet-leader-election"
 		lockObjectNamespace = "garden"
+
+		extensionsAccessModeReport = gardenletv1alpha1.ExtensionsAccessModeReport
 	)
 
 	config := gardenletv1alpha1.GardenletConfiguration{
@@ -775,6 +776,10 @@ func ComputeExpectedGardenletConfiguration(
 					},
 				},
 				WebhookRemediatorEnabled: pointer.Bool(false),
+				BackupFreshnessThresholds: &gardenletv1alpha1.BackupFreshnessThresholds{
+					FullSnapshot:  &metav1.Duration{Duration: 24 * time.Hour},
+					DeltaSnapshot: &metav1.Duration{Duration: 15 * time.Minute},
+				},
 			},
 			SeedCare: &gardenletv1alpha1.SeedCareControllerConfiguration{
 				SyncPeriod: &metav1.Duration{
@@ -788,11 +793,19 @@ func ComputeExpectedGardenletConfiguration(
 						},
 					},
 				},
+				ConformanceChecks: &gardenletv1alpha1.SeedConformanceChecks{
+					Enabled: true,
+				},
 			},
 			ShootState: &gardenletv1alpha1.ShootStateControllerConfiguration{
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
 			},
+			ShootNamespaceJanitor: &gardenletv1alpha1.ShootNamespaceJanitorControllerConfiguration{
+				ConcurrentSyncs: &twenty,
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+				StaleThreshold:  &metav1.Duration{Duration: 24 * time.Hour},
+			},
 			TokenRequestor: &gardenletv1alpha1.TokenRequestorControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
@@ -807,7 +820,8 @@ func ComputeExpectedGardenletConfiguration(
 				ConcurrentSyncs: &one,
 			},
 			NetworkPolicy: &gardenletv1alpha1.NetworkPolicyControllerConfiguration{
-				ConcurrentSyncs: &five,
+				ConcurrentSyncs:                   &five,
+				ExtensionsAccessToShootAPIServers: &extensionsAccessModeReport,
 			},
 		},
 		LeaderElection: &baseconfigv1alpha1.LeaderElectionConfiguration{
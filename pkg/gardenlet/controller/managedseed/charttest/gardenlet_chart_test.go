@@ -69,6 +69,7 @@ var (
 		"topology-spread-constraints.resources.gardener.cloud/skip":                   "true",
 		"networking.resources.gardener.cloud/to-all-shoots-etcd-main-client-tcp-8080": "allowed",
 		"networking.resources.gardener.cloud/to-all-shoots-kube-apiserver-tcp-443":    "allowed",
+		"networking.resources.gardener.cloud/to-all-shoots-prometheus-web-tcp-9090":   "allowed",
 	})
 )
 
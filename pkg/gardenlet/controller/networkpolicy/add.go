@@ -17,8 +17,10 @@ package networkpolicy
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -36,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils/mapper"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
 )
 
@@ -64,6 +67,7 @@ func AddToManager(
 	reconciler := &networkpolicy.Reconciler{
 		ConcurrentSyncs:              cfg.ConcurrentSyncs,
 		AdditionalNamespaceSelectors: cfg.AdditionalNamespaceSelectors,
+		ExtensionsAccess:             extensionsAccessConfig(cfg.ExtensionsAccessToShootAPIServers),
 		Resolver:                     resolver,
 		RuntimeNetworks: networkpolicy.RuntimeNetworkConfig{
 			IPFamilies: networks.IPFamilies,
@@ -110,7 +114,15 @@ func AddToManager(
 	}))
 }
 
-// ClusterPredicate is a predicate which returns 'true' when the network CIDRs of a shoot cluster change.
+func extensionsAccessConfig(mode *config.ExtensionsAccessMode) *networkpolicy.ExtensionsAccessConfig {
+	if mode == nil {
+		return nil
+	}
+	return &networkpolicy.ExtensionsAccessConfig{Enforce: *mode == config.ExtensionsAccessModeEnforce}
+}
+
+// ClusterPredicate is a predicate which returns 'true' when the network CIDRs or the extension types of a shoot
+// cluster change.
 func ClusterPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
@@ -132,6 +144,12 @@ func ClusterPredicate() predicate.Predicate {
 				return false
 			}
 
+			// the NetworkPolicy allowing the managing extensions to access the kube-apiserver depends on the extension
+			// type labels
+			if !apiequality.Semantic.DeepEqual(extensionTypeLabels(shoot.Labels), extensionTypeLabels(oldShoot.Labels)) {
+				return true
+			}
+
 			// if the shoot has no networking field, return false
 			if shoot.Spec.Networking == nil {
 				return false
@@ -151,3 +169,15 @@ func ClusterPredicate() predicate.Predicate {
 		GenericFunc: func(event.GenericEvent) bool { return false },
 	}
 }
+
+func extensionTypeLabels(labels map[string]string) map[string]string {
+	result := make(map[string]string)
+	for k, v := range labels {
+		for _, prefix := range gardenerutils.ExtensionTypeLabelPrefixes {
+			if strings.HasPrefix(k, prefix) {
+				result[k] = v
+			}
+		}
+	}
+	return result
+}
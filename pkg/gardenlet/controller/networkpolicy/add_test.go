@@ -153,6 +153,29 @@ var _ = Describe("Add", func() {
 			Expect(p.Delete(event.DeleteEvent{Object: cluster})).To(BeFalse())
 			Expect(p.Generic(event.GenericEvent{Object: cluster})).To(BeFalse())
 		})
+
+		It("should return true if the extension types are changed", func() {
+			shoot.Labels = map[string]string{"provider.extensions.gardener.cloud/foo": "true"}
+			cluster.Spec.Shoot.Raw = encode(shoot)
+			newCluster := cluster.DeepCopy()
+			shoot.Labels["extensions.extensions.gardener.cloud/bar"] = "true"
+			newCluster.Spec.Shoot.Raw = encode(shoot)
+
+			Expect(p.Create(event.CreateEvent{Object: cluster})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: newCluster, ObjectOld: cluster})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: cluster})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: cluster})).To(BeFalse())
+		})
+
+		It("should return false if only other labels are changed", func() {
+			shoot.Labels = map[string]string{"provider.extensions.gardener.cloud/foo": "true"}
+			cluster.Spec.Shoot.Raw = encode(shoot)
+			newCluster := cluster.DeepCopy()
+			shoot.Labels["foo"] = "bar"
+			newCluster.Spec.Shoot.Raw = encode(shoot)
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: newCluster, ObjectOld: cluster})).To(BeFalse())
+		})
 	})
 })
 
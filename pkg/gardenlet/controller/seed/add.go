@@ -17,7 +17,6 @@ package seed
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -26,7 +25,11 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
+	"github.com/gardener/gardener/pkg/gardenlet/autonomy"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/etcdlocalstorage"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/ingresscertificate"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/janitor"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/lease"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
 	"github.com/gardener/gardener/pkg/healthz"
@@ -43,19 +46,9 @@ func AddToManager(
 	cfg config.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	healthManager healthz.Manager,
+	autonomyTracker *autonomy.Tracker,
+	componentImageVectors imagevectorutils.ComponentImageVectors,
 ) error {
-	var (
-		componentImageVectors imagevectorutils.ComponentImageVectors
-		err                   error
-	)
-
-	if path := os.Getenv(imagevectorutils.ComponentOverrideEnv); path != "" {
-		componentImageVectors, err = imagevectorutils.ReadComponentOverwriteFile(path)
-		if err != nil {
-			return fmt.Errorf("failed reading component-specific image vector override: %w", err)
-		}
-	}
-
 	if err := (&care.Reconciler{
 		Config:         *cfg.Controllers.SeedCare,
 		SeedName:       cfg.SeedConfig.Name,
@@ -65,11 +58,36 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if cfg.ETCDConfig != nil && cfg.ETCDConfig.LocalStorage != nil {
+		if err := (&etcdlocalstorage.Reconciler{
+			Config: *cfg.ETCDConfig.LocalStorage,
+		}).AddToManager(ctx, mgr, seedCluster); err != nil {
+			return fmt.Errorf("failed adding etcd local storage reconciler: %w", err)
+		}
+	}
+
+	if cfg.Controllers.SeedIngressCertificate != nil {
+		if err := (&ingresscertificate.Reconciler{
+			Config:   *cfg.Controllers.SeedIngressCertificate,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding ingress certificate reconciler: %w", err)
+		}
+	}
+
+	if err := (&janitor.Reconciler{
+		Config:   *cfg.Controllers.ShootNamespaceJanitor,
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding janitor reconciler: %w", err)
+	}
+
 	if err := (&lease.Reconciler{
 		SeedRESTClient: seedClientSet.RESTClient(),
 		Config:         *cfg.Controllers.Seed,
 		HealthManager:  healthManager,
 		SeedName:       cfg.SeedConfig.Name,
+		Autonomy:       autonomyTracker,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding lease reconciler: %w", err)
 	}
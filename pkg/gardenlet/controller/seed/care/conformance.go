@@ -0,0 +1,217 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	kuberneteshealth "github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+const (
+	conformanceCheckIstioIngress           = "IstioIngressReachable"
+	conformanceCheckDNS                    = "DNSResolution"
+	conformanceCheckCRDs                   = "RequiredCRDsServed"
+	conformanceCheckResourceManagerWebhook = "ResourceManagerWebhooksHealthy"
+
+	conformanceCheckTimeout = 5 * time.Second
+)
+
+var (
+	// LookupHost resolves the given host. Exposed for testing.
+	LookupHost = net.DefaultResolver.LookupHost
+	// DialContext establishes a connection to the given address. Exposed for testing.
+	DialContext = (&net.Dialer{}).DialContext
+)
+
+// requiredCRDs are the names of the CustomResourceDefinitions which must be present and served in the seed cluster so
+// that shoots can be reconciled.
+var requiredCRDs = []string{
+	"backupbuckets.extensions.gardener.cloud",
+	"backupentries.extensions.gardener.cloud",
+	"clusters.extensions.gardener.cloud",
+	"containerruntimes.extensions.gardener.cloud",
+	"controlplanes.extensions.gardener.cloud",
+	"dnsrecords.extensions.gardener.cloud",
+	"etcds.druid.gardener.cloud",
+	"extensions.extensions.gardener.cloud",
+	"infrastructures.extensions.gardener.cloud",
+	"managedresources.resources.gardener.cloud",
+	"networks.extensions.gardener.cloud",
+	"operatingsystemconfigs.extensions.gardener.cloud",
+	"verticalpodautoscalers.autoscaling.k8s.io",
+	"workers.extensions.gardener.cloud",
+}
+
+// conformanceCheck is a single check of the seed conformance suite.
+type conformanceCheck struct {
+	name  string
+	check func(context.Context) error
+}
+
+// conformanceChecks returns the checks of the seed conformance suite.
+func (h *health) conformanceChecks() []conformanceCheck {
+	return []conformanceCheck{
+		{name: conformanceCheckIstioIngress, check: h.checkIstioIngressReachable},
+		{name: conformanceCheckDNS, check: h.checkDNSResolution},
+		{name: conformanceCheckCRDs, check: h.checkRequiredCRDsServed},
+		{name: conformanceCheckResourceManagerWebhook, check: h.checkResourceManagerWebhooksHealthy},
+	}
+}
+
+// runConformanceChecks executes all checks of the seed conformance suite and returns a description of each failed
+// check. The result is empty if all checks succeeded.
+func (h *health) runConformanceChecks(ctx context.Context) []string {
+	var failed []string
+
+	for _, c := range h.conformanceChecks() {
+		checkCtx, cancel := context.WithTimeout(ctx, conformanceCheckTimeout)
+		err := c.check(checkCtx)
+		cancel()
+
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", c.name, err))
+		}
+	}
+
+	return failed
+}
+
+// namespaceOrOverride returns the given namespace unless it is overridden (for testing purposes).
+func (h *health) namespaceOrOverride(namespace string) string {
+	return pointer.StringDeref(h.namespace, namespace)
+}
+
+// checkIstioIngressReachable checks that the load balancer of the istio ingress gateway has been provisioned and accepts
+// connections.
+func (h *health) checkIstioIngressReachable(ctx context.Context) error {
+	service := &corev1.Service{}
+	if err := h.seedClient.Get(ctx, kubernetesutils.Key(h.namespaceOrOverride(v1beta1constants.DefaultSNIIngressNamespace), v1beta1constants.DefaultSNIIngressServiceName), service); err != nil {
+		return err
+	}
+
+	if len(service.Spec.Ports) == 0 {
+		return fmt.Errorf("service %s does not expose any port", client.ObjectKeyFromObject(service))
+	}
+
+	var address string
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			address = ingress.IP
+			break
+		}
+		if ingress.Hostname != "" {
+			address = ingress.Hostname
+			break
+		}
+	}
+	if address == "" {
+		return fmt.Errorf("load balancer of service %s has not been provisioned yet", client.ObjectKeyFromObject(service))
+	}
+
+	conn, err := DialContext(ctx, "tcp", net.JoinHostPort(address, strconv.Itoa(int(service.Spec.Ports[0].Port))))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// checkDNSResolution checks that names below the ingress domain of the seed can be resolved.
+func (h *health) checkDNSResolution(ctx context.Context) error {
+	if h.seed.Spec.Ingress == nil {
+		return nil
+	}
+
+	host := "gardener-conformance-check." + h.seed.Spec.Ingress.Domain
+	addresses, err := LookupHost(ctx, host)
+	if err != nil {
+		return err
+	}
+	if len(addresses) == 0 {
+		return fmt.Errorf("no addresses found for %s", host)
+	}
+	return nil
+}
+
+// checkRequiredCRDsServed checks that all CustomResourceDefinitions required for reconciling shoots are present,
+// established and serve at least one version.
+func (h *health) checkRequiredCRDsServed(ctx context.Context) error {
+	var unhealthy []string
+
+	for _, name := range requiredCRDs {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := h.seedClient.Get(ctx, kubernetesutils.Key(name), crd); err != nil {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (%v)", name, err))
+			continue
+		}
+
+		if err := kuberneteshealth.CheckCustomResourceDefinition(crd); err != nil {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (%v)", name, err))
+			continue
+		}
+
+		served := false
+		for _, version := range crd.Spec.Versions {
+			if version.Served {
+				served = true
+				break
+			}
+		}
+		if !served {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (no version is served)", name))
+		}
+	}
+
+	if len(unhealthy) > 0 {
+		return fmt.Errorf("CustomResourceDefinitions are not healthy: %s", strings.Join(unhealthy, ", "))
+	}
+	return nil
+}
+
+// checkResourceManagerWebhooksHealthy checks that the webhooks of gardener-resource-manager are registered and that its
+// deployment serving them is healthy.
+func (h *health) checkResourceManagerWebhooksHealthy(ctx context.Context) error {
+	name := v1beta1constants.DeploymentNameGardenerResourceManager
+
+	mutatingWebhookConfiguration := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	if err := h.seedClient.Get(ctx, kubernetesutils.Key(name), mutatingWebhookConfiguration); err != nil {
+		return err
+	}
+
+	validatingWebhookConfiguration := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	if err := h.seedClient.Get(ctx, kubernetesutils.Key(name), validatingWebhookConfiguration); err != nil {
+		return err
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := h.seedClient.Get(ctx, kubernetesutils.Key(h.namespaceOrOverride(v1beta1constants.GardenNamespace), name), deployment); err != nil {
+		return err
+	}
+	return kuberneteshealth.CheckDeployment(deployment)
+}
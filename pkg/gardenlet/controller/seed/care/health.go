@@ -16,6 +16,7 @@ package care
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -56,15 +57,16 @@ var requiredManagedResourcesSeed = sets.New(
 
 // health contains information needed to execute health checks for a seed.
 type health struct {
-	seed                *gardencorev1beta1.Seed
-	seedClient          client.Client
-	clock               clock.Clock
-	namespace           *string
-	seedIsGarden        bool
-	loggingEnabled      bool
-	valiEnabled         bool
-	conditionThresholds map[gardencorev1beta1.ConditionType]time.Duration
-	healthChecker       *healthchecker.HealthChecker
+	seed                     *gardencorev1beta1.Seed
+	seedClient               client.Client
+	clock                    clock.Clock
+	namespace                *string
+	seedIsGarden             bool
+	loggingEnabled           bool
+	valiEnabled              bool
+	conformanceChecksEnabled bool
+	conditionThresholds      map[gardencorev1beta1.ConditionType]time.Duration
+	healthChecker            *healthchecker.HealthChecker
 }
 
 // NewHealth creates a new Health instance with the given parameters.
@@ -76,18 +78,20 @@ func NewHealth(
 	seedIsGarden bool,
 	loggingEnabled bool,
 	valiEnabled bool,
+	conformanceChecksEnabled bool,
 	conditionThresholds map[gardencorev1beta1.ConditionType]time.Duration,
 ) HealthCheck {
 	return &health{
-		seedClient:          seedClient,
-		seed:                seed,
-		clock:               clock,
-		namespace:           namespace,
-		seedIsGarden:        seedIsGarden,
-		loggingEnabled:      loggingEnabled,
-		valiEnabled:         valiEnabled,
-		conditionThresholds: conditionThresholds,
-		healthChecker:       healthchecker.NewHealthChecker(seedClient, clock, conditionThresholds, seed.Status.LastOperation),
+		seedClient:               seedClient,
+		seed:                     seed,
+		clock:                    clock,
+		namespace:                namespace,
+		seedIsGarden:             seedIsGarden,
+		loggingEnabled:           loggingEnabled,
+		valiEnabled:              valiEnabled,
+		conformanceChecksEnabled: conformanceChecksEnabled,
+		conditionThresholds:      conditionThresholds,
+		healthChecker:            healthchecker.NewHealthChecker(seedClient, clock, conditionThresholds, seed.Status.LastOperation),
 	}
 }
 
@@ -157,6 +161,13 @@ func (h *health) checkSystemComponents(
 		}
 	}
 
+	if h.conformanceChecksEnabled {
+		if failedChecks := h.runConformanceChecks(ctx); len(failedChecks) > 0 {
+			exitCondition := v1beta1helper.FailedCondition(h.clock, h.seed.Status.LastOperation, h.conditionThresholds, condition, "ConformanceChecksFailed", "Conformance checks of the seed system components failed: "+strings.Join(failedChecks, "; "))
+			return &exitCondition, nil
+		}
+	}
+
 	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy.")
 	return &c, nil
 }
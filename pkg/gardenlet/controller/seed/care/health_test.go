@@ -16,14 +16,21 @@ package care_test
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -129,7 +136,7 @@ var _ = Describe("Seed health", func() {
 			})
 
 			It("should set SeedSystemComponentsHealthy condition to true", func() {
-				healthCheck := NewHealth(seed, c, fakeClock, nil, false, true, true, nil)
+				healthCheck := NewHealth(seed, c, fakeClock, nil, false, true, true, false, nil)
 				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 					Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
 				})
@@ -152,7 +159,7 @@ var _ = Describe("Seed health", func() {
 			})
 
 			It("should set SeedSystemComponentsHealthy condition to true", func() {
-				healthCheck := NewHealth(seed, c, fakeClock, nil, true, false, false, nil)
+				healthCheck := NewHealth(seed, c, fakeClock, nil, true, false, false, false, nil)
 				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 					Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
 				})
@@ -163,11 +170,123 @@ var _ = Describe("Seed health", func() {
 			})
 		})
 
+		Context("When conformance checks are enabled", func() {
+			var conditions SeedConditions
+
+			JustBeforeEach(func() {
+				for _, name := range append(requiredManagedResources, optionalManagedResources...) {
+					Expect(c.Create(ctx, healthyManagedResource(name))).To(Succeed())
+				}
+			})
+
+			BeforeEach(func() {
+				seed.Spec.Ingress.Domain = "ingress.seed.example.com"
+				conditions = NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
+					Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
+				})
+
+				Expect(c.Create(ctx, &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "istio-ingressgateway", Namespace: "istio-ingress"},
+					Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "tcp", Port: 443}}},
+					Status:     corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}}},
+				})).To(Succeed())
+				Expect(c.Create(ctx, &admissionregistrationv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-resource-manager"}})).To(Succeed())
+				Expect(c.Create(ctx, &admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-resource-manager"}})).To(Succeed())
+				Expect(c.Create(ctx, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-resource-manager", Namespace: "garden", Generation: 1},
+					Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(1)},
+					Status: appsv1.DeploymentStatus{
+						ObservedGeneration: 1,
+						Replicas:           1,
+						UpdatedReplicas:    1,
+						AvailableReplicas:  1,
+						Conditions:         []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}},
+					},
+				})).To(Succeed())
+				for _, name := range []string{
+					"backupbuckets.extensions.gardener.cloud",
+					"backupentries.extensions.gardener.cloud",
+					"clusters.extensions.gardener.cloud",
+					"containerruntimes.extensions.gardener.cloud",
+					"controlplanes.extensions.gardener.cloud",
+					"dnsrecords.extensions.gardener.cloud",
+					"etcds.druid.gardener.cloud",
+					"extensions.extensions.gardener.cloud",
+					"infrastructures.extensions.gardener.cloud",
+					"managedresources.resources.gardener.cloud",
+					"networks.extensions.gardener.cloud",
+					"operatingsystemconfigs.extensions.gardener.cloud",
+					"verticalpodautoscalers.autoscaling.k8s.io",
+					"workers.extensions.gardener.cloud",
+				} {
+					Expect(c.Create(ctx, healthyCRD(name))).To(Succeed())
+				}
+
+				DeferCleanup(test.WithVars(
+					&LookupHost, func(_ context.Context, host string) ([]string, error) {
+						if host == "gardener-conformance-check.ingress.seed.example.com" {
+							return []string{"1.2.3.4"}, nil
+						}
+						return nil, fmt.Errorf("no such host %s", host)
+					},
+					&DialContext, func(_ context.Context, _, address string) (net.Conn, error) {
+						if address == "1.2.3.4:443" {
+							conn, _ := net.Pipe()
+							return conn, nil
+						}
+						return nil, fmt.Errorf("connection refused to %s", address)
+					},
+				))
+			})
+
+			It("should set SeedSystemComponentsHealthy condition to true if all conformance checks succeed", func() {
+				healthCheck := NewHealth(seed, c, fakeClock, nil, false, true, true, true, nil)
+
+				updatedConditions := healthCheck.Check(ctx, conditions)
+				Expect(updatedConditions).ToNot(BeEmpty())
+				Expect(updatedConditions[0]).To(beConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy."))
+			})
+
+			It("should set SeedSystemComponentsHealthy condition to false with the details of all failed conformance checks", func() {
+				seed.Spec.Ingress.Domain = "ingress.other.example.com"
+				Expect(c.Delete(ctx, healthyCRD("workers.extensions.gardener.cloud"))).To(Succeed())
+				Expect(c.Delete(ctx, &admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-resource-manager"}})).To(Succeed())
+
+				healthCheck := NewHealth(seed, c, fakeClock, nil, false, true, true, true, nil)
+
+				updatedConditions := healthCheck.Check(ctx, conditions)
+				Expect(updatedConditions).ToNot(BeEmpty())
+				Expect(updatedConditions[0]).To(And(
+					WithStatus(gardencorev1beta1.ConditionFalse),
+					WithReason("ConformanceChecksFailed"),
+					WithMessageSubstrings(
+						"DNSResolution: no such host gardener-conformance-check.ingress.other.example.com",
+						"RequiredCRDsServed: CustomResourceDefinitions are not healthy: workers.extensions.gardener.cloud",
+						"ResourceManagerWebhooksHealthy:",
+					),
+					Not(WithMessageSubstrings("IstioIngressReachable")),
+				))
+			})
+
+			It("should report an unreachable istio ingress gateway", func() {
+				service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "istio-ingressgateway", Namespace: "istio-ingress"}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				service.Spec.Ports[0].Port = 8443
+				Expect(c.Update(ctx, service)).To(Succeed())
+
+				healthCheck := NewHealth(seed, c, fakeClock, nil, false, true, true, true, nil)
+
+				updatedConditions := healthCheck.Check(ctx, conditions)
+				Expect(updatedConditions).ToNot(BeEmpty())
+				Expect(updatedConditions[0]).To(beConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionFalse, "ConformanceChecksFailed", "Conformance checks of the seed system components failed: IstioIngressReachable: connection refused to 1.2.3.4:8443"))
+			})
+		})
+
 		Context("When there are issues with seed managed resources", func() {
 			var (
 				tests = func(reason, message string) {
 					It("should set SeedSystemComponentsHealthy condition to False if there is no Progressing threshold duration mapping", func() {
-						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, nil)
+						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, false, nil)
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
 						})
@@ -182,7 +301,7 @@ var _ = Describe("Seed health", func() {
 						seedSystemComponentsHealthyCondition.Status = gardencorev1beta1.ConditionFalse
 						fakeClock.Step(30 * time.Second)
 
-						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
+						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, false, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
 						})
@@ -197,7 +316,7 @@ var _ = Describe("Seed health", func() {
 						seedSystemComponentsHealthyCondition.Status = gardencorev1beta1.ConditionTrue
 						fakeClock.Step(30 * time.Second)
 
-						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
+						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, false, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
 						})
@@ -212,7 +331,7 @@ var _ = Describe("Seed health", func() {
 						seedSystemComponentsHealthyCondition.Status = gardencorev1beta1.ConditionProgressing
 						fakeClock.Step(30 * time.Second)
 
-						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
+						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, false, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
 						})
@@ -227,7 +346,7 @@ var _ = Describe("Seed health", func() {
 						seedSystemComponentsHealthyCondition.Status = gardencorev1beta1.ConditionProgressing
 						fakeClock.Step(90 * time.Second)
 
-						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
+						healthCheck := NewHealth(seed, c, fakeClock, nil, false, false, false, false, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
 						})
@@ -374,6 +493,21 @@ func healthyManagedResource(name string) *resourcesv1alpha1.ManagedResource {
 		})
 }
 
+func healthyCRD(name string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1alpha1", Served: true, Storage: true}},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.NamesAccepted, Status: apiextensionsv1.ConditionTrue},
+				{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+			},
+		},
+	}
+}
+
 func notHealthyManagedResource(name string) *resourcesv1alpha1.ManagedResource {
 	return managedResource(
 		name,
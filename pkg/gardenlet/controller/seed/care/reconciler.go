@@ -91,6 +91,7 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		seedIsGarden,
 		r.LoggingEnabled,
 		r.ValiEnabled,
+		r.Config.ConformanceChecks != nil && r.Config.ConformanceChecks.Enabled,
 		r.conditionThresholdsToProgressingMapping(),
 	).Check(
 		ctx,
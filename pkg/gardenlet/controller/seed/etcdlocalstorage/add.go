@@ -0,0 +1,116 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdlocalstorage
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "etcd-local-storage"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, seedCluster cluster.Cluster) error {
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Recorder == nil {
+		r.Recorder = seedCluster.GetEventRecorderFor(ControllerName + "-controller")
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WatchesRawSource(
+			source.Kind(seedCluster.GetCache(), &corev1.Node{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(r.NodePredicate()),
+		).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	// Persistent volume claims are watched in addition so that volumes on nodes which were deleted while the gardenlet
+	// was not running are still detected.
+	return c.Watch(
+		source.Kind(seedCluster.GetCache(), &corev1.PersistentVolumeClaim{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapPersistentVolumeClaimToNode), mapper.UpdateWithNew, c.GetLogger()),
+		r.LocalVolumePredicate(),
+	)
+}
+
+// NodePredicate returns a predicate which returns true for created and deleted nodes and for nodes whose readiness
+// changed.
+func (r *Reconciler) NodePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, ok := e.ObjectOld.(*corev1.Node)
+			if !ok {
+				return false
+			}
+			newNode, ok := e.ObjectNew.(*corev1.Node)
+			if !ok {
+				return false
+			}
+			return (nodeLostSince(oldNode) == nil) != (nodeLostSince(newNode) == nil)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return true },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// LocalVolumePredicate returns a predicate which returns true for persistent volume claims of the local storage class
+// which were already bound to a node.
+func (r *Reconciler) LocalVolumePredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		pvc, ok := obj.(*corev1.PersistentVolumeClaim)
+		if !ok {
+			return false
+		}
+		nodeName := pvc.Annotations[AnnotationSelectedNode]
+		return nodeName != "" && r.isLocalVolumeOnNode(pvc, nodeName)
+	})
+}
+
+// MapPersistentVolumeClaimToNode is a mapper.MapFunc for mapping a persistent volume claim to the node hosting its
+// volume.
+func (r *Reconciler) MapPersistentVolumeClaimToNode(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	nodeName := obj.GetAnnotations()[AnnotationSelectedNode]
+	if nodeName == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: nodeName}}}
+}
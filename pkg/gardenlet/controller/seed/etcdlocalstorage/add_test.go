@@ -0,0 +1,110 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdlocalstorage_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/etcdlocalstorage"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{Config: config.ETCDLocalStorage{StorageClassName: "local-nvme"}}
+	})
+
+	Describe("#NodePredicate", func() {
+		var (
+			p                   predicate.Predicate
+			readyNode, lostNode *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = reconciler.NodePredicate()
+			readyNode = &corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}}}
+			lostNode = &corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionUnknown}}}}
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: readyNode})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: readyNode})).To(BeTrue())
+			Expect(p.Generic(event.GenericEvent{Object: readyNode})).To(BeFalse())
+		})
+
+		It("should return true if the readiness of the node changed", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: readyNode, ObjectNew: lostNode})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: lostNode, ObjectNew: readyNode})).To(BeTrue())
+		})
+
+		It("should return false if the readiness of the node did not change", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: readyNode, ObjectNew: readyNode})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: lostNode, ObjectNew: lostNode})).To(BeFalse())
+		})
+	})
+
+	Describe("#LocalVolumePredicate", func() {
+		var (
+			p   predicate.Predicate
+			pvc *corev1.PersistentVolumeClaim
+		)
+
+		BeforeEach(func() {
+			p = reconciler.LocalVolumePredicate()
+			pvc = &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"volume.kubernetes.io/selected-node": "node-1"}},
+				Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: pointer.String("local-nvme")},
+			}
+		})
+
+		It("should return true for bound volumes of the local storage class", func() {
+			Expect(p.Create(event.CreateEvent{Object: pvc})).To(BeTrue())
+		})
+
+		It("should return false for volumes of other storage classes", func() {
+			pvc.Spec.StorageClassName = pointer.String("default")
+			Expect(p.Create(event.CreateEvent{Object: pvc})).To(BeFalse())
+		})
+
+		It("should return false for volumes which are not yet bound to a node", func() {
+			delete(pvc.Annotations, "volume.kubernetes.io/selected-node")
+			Expect(p.Create(event.CreateEvent{Object: pvc})).To(BeFalse())
+		})
+	})
+
+	Describe("#MapPersistentVolumeClaimToNode", func() {
+		It("should map the persistent volume claim to its node", func() {
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"volume.kubernetes.io/selected-node": "node-1"}}}
+			Expect(reconciler.MapPersistentVolumeClaimToNode(context.TODO(), logr.Discard(), nil, pvc)).To(ConsistOf(reconcile.Request{NamespacedName: types.NamespacedName{Name: "node-1"}}))
+		})
+
+		It("should not map persistent volume claims without node", func() {
+			Expect(reconciler.MapPersistentVolumeClaimToNode(context.TODO(), logr.Discard(), nil, &corev1.PersistentVolumeClaim{})).To(BeEmpty())
+		})
+	})
+})
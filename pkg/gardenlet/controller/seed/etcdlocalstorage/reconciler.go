@@ -0,0 +1,156 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdlocalstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+const (
+	// AnnotationSelectedNode is the annotation set by the scheduler on persistent volume claims whose storage class
+	// delays the volume binding until the first consumer is scheduled. Its value is the name of the node the volume is
+	// provisioned on.
+	AnnotationSelectedNode = "volume.kubernetes.io/selected-node"
+
+	// EventLocalVolumeLost is the reason of the event which is emitted when a local etcd volume is dropped because the
+	// node hosting it is lost.
+	EventLocalVolumeLost = "LocalVolumeLost"
+)
+
+// Reconciler watches the nodes of the seed cluster. When a node hosting local etcd volumes is gone or not ready for
+// longer than the configured grace period, the volumes and the etcd pods using them are deleted. The etcd statefulset
+// recreates both on another node where the data is restored from the backup (or synchronized from the other members in
+// case of a multi-node etcd).
+type Reconciler struct {
+	SeedClient client.Client
+	Config     config.ETCDLocalStorage
+	Clock      clock.Clock
+	Recorder   record.EventRecorder
+}
+
+// Reconcile drops the local etcd volumes of lost nodes.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	node := &corev1.Node{}
+	if err := r.SeedClient.Get(ctx, request.NamespacedName, node); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+		}
+		log.Info("Node is gone, checking for local etcd volumes")
+	} else if lostSince := nodeLostSince(node); lostSince == nil {
+		log.V(1).Info("Node is ready, nothing to be done")
+		return reconcile.Result{}, nil
+	} else if remaining := r.Config.NodeLossGracePeriod.Duration - r.Clock.Since(*lostSince); remaining > 0 {
+		log.V(1).Info("Node is not ready, waiting for grace period before dropping local etcd volumes", "requeueAfter", remaining)
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := r.SeedClient.List(ctx, pvcList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing persistent volume claims: %w", err)
+	}
+
+	for _, pvc := range pvcList.Items {
+		if !r.isLocalVolumeOnNode(&pvc, request.Name) {
+			continue
+		}
+
+		if err := r.dropLocalVolume(ctx, &pvc, request.Name); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) isLocalVolumeOnNode(pvc *corev1.PersistentVolumeClaim, nodeName string) bool {
+	return pvc.Spec.StorageClassName != nil &&
+		*pvc.Spec.StorageClassName == r.Config.StorageClassName &&
+		pvc.Annotations[AnnotationSelectedNode] == nodeName
+}
+
+func (r *Reconciler) dropLocalVolume(ctx context.Context, pvc *corev1.PersistentVolumeClaim, nodeName string) error {
+	log := logf.FromContext(ctx).WithValues("persistentVolumeClaim", client.ObjectKeyFromObject(pvc))
+
+	if pvc.DeletionTimestamp == nil {
+		log.Info("Deleting local etcd volume of lost node")
+		r.Recorder.Eventf(pvc, corev1.EventTypeWarning, EventLocalVolumeLost, "Node %q hosting the local volume is lost, deleting volume so that it is rebuilt on another node", nodeName)
+
+		if err := r.SeedClient.Delete(ctx, pvc); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting persistent volume claim %s: %w", client.ObjectKeyFromObject(pvc), err)
+		}
+	}
+
+	// The pods on the lost node cannot be terminated gracefully since their kubelet does not respond anymore. They keep
+	// the persistent volume claim in use and prevent its deletion, hence they are deleted forcefully.
+	podList := &corev1.PodList{}
+	if err := r.SeedClient.List(ctx, podList, client.InNamespace(pvc.Namespace)); err != nil {
+		return fmt.Errorf("failed listing pods in namespace %s: %w", pvc.Namespace, err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName != nodeName || !usesPersistentVolumeClaim(&pod, pvc.Name) {
+			continue
+		}
+
+		log.Info("Deleting etcd pod using local volume of lost node", "pod", client.ObjectKeyFromObject(&pod))
+		if err := r.SeedClient.Delete(ctx, &pod, client.GracePeriodSeconds(0)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+		}
+	}
+
+	return nil
+}
+
+// nodeLostSince returns the time since when the given node is not ready. It returns nil if the node is ready or has
+// not yet reported its readiness.
+func nodeLostSince(node *corev1.Node) *time.Time {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != corev1.NodeReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			return nil
+		}
+		return &condition.LastTransitionTime.Time
+	}
+	return nil
+}
+
+func usesPersistentVolumeClaim(pod *corev1.Pod, claimName string) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName {
+			return true
+		}
+	}
+	return false
+}
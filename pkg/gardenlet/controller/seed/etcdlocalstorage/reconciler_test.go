@@ -0,0 +1,163 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdlocalstorage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/etcdlocalstorage"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		node       *corev1.Node
+		localPVC   *corev1.PersistentVolumeClaim
+		networkPVC *corev1.PersistentVolumeClaim
+		etcdPod    *corev1.Pod
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			SeedClient: fakeClient,
+			Config: config.ETCDLocalStorage{
+				StorageClassName:    "local-nvme",
+				NodeLossGracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			Clock:    fakeClock,
+			Recorder: &record.FakeRecorder{},
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+		localPVC = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "main-etcd-etcd-main-0",
+				Namespace:   "shoot--foo--bar",
+				Annotations: map[string]string{"volume.kubernetes.io/selected-node": node.Name},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{StorageClassName: pointer.String("local-nvme")},
+		}
+		networkPVC = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "events-etcd-etcd-events-0",
+				Namespace:   "shoot--foo--bar",
+				Annotations: map[string]string{"volume.kubernetes.io/selected-node": node.Name},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{StorageClassName: pointer.String("default")},
+		}
+		etcdPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "etcd-main-0", Namespace: "shoot--foo--bar"},
+			Spec: corev1.PodSpec{
+				NodeName:   node.Name,
+				Containers: []corev1.Container{{Name: "etcd"}},
+				Volumes: []corev1.Volume{{
+					Name:         "main-etcd",
+					VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: localPVC.Name}},
+				}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+
+		Expect(fakeClient.Create(ctx, localPVC)).To(Succeed())
+		Expect(fakeClient.Create(ctx, networkPVC)).To(Succeed())
+		Expect(fakeClient.Create(ctx, etcdPod)).To(Succeed())
+	})
+
+	setNodeReady := func(status corev1.ConditionStatus, since time.Time) {
+		node.Status.Conditions = []corev1.NodeCondition{{
+			Type:               corev1.NodeReady,
+			Status:             status,
+			LastTransitionTime: metav1.NewTime(since),
+		}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+	}
+
+	expectLocalVolumeKept := func() {
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(localPVC), localPVC)).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(etcdPod), etcdPod)).To(Succeed())
+	}
+
+	expectLocalVolumeDropped := func() {
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(localPVC), localPVC)).To(BeNotFoundError())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(etcdPod), etcdPod)).To(BeNotFoundError())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPVC), networkPVC)).To(Succeed())
+	}
+
+	It("should do nothing if the node is ready", func() {
+		setNodeReady(corev1.ConditionTrue, fakeClock.Now().Add(-time.Hour))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		expectLocalVolumeKept()
+	})
+
+	It("should requeue if the node is not ready for less than the grace period", func() {
+		setNodeReady(corev1.ConditionUnknown, fakeClock.Now().Add(-2*time.Minute))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 3 * time.Minute}))
+		expectLocalVolumeKept()
+	})
+
+	It("should drop the local volumes if the node is not ready for longer than the grace period", func() {
+		setNodeReady(corev1.ConditionFalse, fakeClock.Now().Add(-10*time.Minute))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		expectLocalVolumeDropped()
+	})
+
+	It("should drop the local volumes if the node is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		expectLocalVolumeDropped()
+	})
+
+	It("should not delete pods using the volume on other nodes", func() {
+		etcdPod.Spec.NodeName = "node-2"
+		Expect(fakeClient.Update(ctx, etcdPod)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(localPVC), localPVC)).To(BeNotFoundError())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(etcdPod), etcdPod)).To(Succeed())
+	})
+
+	It("should not touch local volumes on other nodes", func() {
+		localPVC.Annotations["volume.kubernetes.io/selected-node"] = "node-2"
+		Expect(fakeClient.Update(ctx, localPVC)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(localPVC), localPVC)).To(Succeed())
+	})
+})
@@ -0,0 +1,175 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingresscertificate
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/acme"
+)
+
+// ACMEClient is the subset of the functionality of an ACME client which is needed for requesting certificates.
+type ACMEClient interface {
+	// Register creates a new account with the issuer.
+	Register(ctx context.Context, account *acme.Account, prompt func(tosURL string) bool) (*acme.Account, error)
+	// AuthorizeOrder initiates the order of a new certificate.
+	AuthorizeOrder(ctx context.Context, id []acme.AuthzID, opt ...acme.OrderOption) (*acme.Order, error)
+	// GetAuthorization retrieves an authorization.
+	GetAuthorization(ctx context.Context, url string) (*acme.Authorization, error)
+	// Accept informs the issuer that the challenge is ready to be validated.
+	Accept(ctx context.Context, challenge *acme.Challenge) (*acme.Challenge, error)
+	// WaitAuthorization waits until an authorization is in a final state.
+	WaitAuthorization(ctx context.Context, url string) (*acme.Authorization, error)
+	// WaitOrder waits until an order is ready for finalization or in a final state.
+	WaitOrder(ctx context.Context, url string) (*acme.Order, error)
+	// CreateOrderCert finalizes an order and returns the DER-encoded certificate chain.
+	CreateOrderCert(ctx context.Context, url string, csr []byte, bundle bool) ([][]byte, string, error)
+	// DNS01ChallengeRecord returns the value of the TXT record for the given DNS-01 challenge token.
+	DNS01ChallengeRecord(token string) (string, error)
+}
+
+// NewACMEClient returns a new ACME client for the given account key and directory URL.
+func NewACMEClient(key crypto.Signer, directoryURL string) ACMEClient {
+	return &acme.Client{Key: key, DirectoryURL: directoryURL}
+}
+
+// ChallengeSolver publishes and removes the TXT records for DNS-01 challenges.
+type ChallengeSolver interface {
+	// Present publishes a TXT record with the given value for the given fully qualified domain name and waits until it
+	// is ready.
+	Present(ctx context.Context, fqdn, value string) error
+	// CleanUp removes the TXT record for the given fully qualified domain name.
+	CleanUp(ctx context.Context, fqdn string) error
+}
+
+const (
+	challengeTypeDNS01     = "dns-01"
+	challengeRecordPrefix  = "_acme-challenge."
+	certificateKeyBitSize  = 2048
+	pemBlockTypeCert       = "CERTIFICATE"
+	pemBlockTypePrivateKey = "RSA PRIVATE KEY"
+)
+
+// isRateLimited returns true if the given error was returned because the issuer is rate-limiting the requests.
+func isRateLimited(err error) bool {
+	var acmeErr *acme.Error
+	if !errors.As(err, &acmeErr) {
+		return false
+	}
+	_, ok := acme.RateLimit(acmeErr)
+	return ok
+}
+
+// requestCertificate requests a certificate for the given DNS names from the issuer behind the given ACME client. The
+// DNS-01 challenges are solved with the given solver. It returns the PEM-encoded certificate chain and private key.
+func requestCertificate(ctx context.Context, log logr.Logger, client ACMEClient, solver ChallengeSolver, dnsNames []string) ([]byte, []byte, error) {
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(dnsNames...))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating order: %w", err)
+	}
+
+	for _, authorizationURL := range order.AuthzURLs {
+		if err := solveAuthorization(ctx, log, client, solver, authorizationURL); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if order, err = client.WaitOrder(ctx, order.URI); err != nil {
+		return nil, nil, fmt.Errorf("failed waiting for order to become ready: %w", err)
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, certificateKeyBitSize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed generating private key: %w", err)
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: dnsNames[0]},
+		DNSNames: dnsNames,
+	}, privateKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating certificate signing request: %w", err)
+	}
+
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed finalizing order: %w", err)
+	}
+
+	var certificatePEM []byte
+	for _, der := range chain {
+		certificatePEM = append(certificatePEM, pem.EncodeToMemory(&pem.Block{Type: pemBlockTypeCert, Bytes: der})...)
+	}
+
+	return certificatePEM, pem.EncodeToMemory(&pem.Block{Type: pemBlockTypePrivateKey, Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}), nil
+}
+
+func solveAuthorization(ctx context.Context, log logr.Logger, client ACMEClient, solver ChallengeSolver, url string) error {
+	authorization, err := client.GetAuthorization(ctx, url)
+	if err != nil {
+		return fmt.Errorf("failed retrieving authorization: %w", err)
+	}
+
+	if authorization.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, c := range authorization.Challenges {
+		if c.Type == challengeTypeDNS01 {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("issuer does not offer a %s challenge for %q", challengeTypeDNS01, authorization.Identifier.Value)
+	}
+
+	value, err := client.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return fmt.Errorf("failed computing challenge record: %w", err)
+	}
+
+	fqdn := challengeRecordPrefix + authorization.Identifier.Value
+	log.Info("Presenting DNS-01 challenge", "fqdn", fqdn)
+	if err := solver.Present(ctx, fqdn, value); err != nil {
+		return fmt.Errorf("failed presenting challenge record %q: %w", fqdn, err)
+	}
+
+	defer func() {
+		if err := solver.CleanUp(ctx, fqdn); err != nil {
+			log.Error(err, "Failed cleaning up challenge record", "fqdn", fqdn)
+		}
+	}()
+
+	if _, err := client.Accept(ctx, challenge); err != nil {
+		return fmt.Errorf("failed accepting challenge: %w", err)
+	}
+
+	if _, err := client.WaitAuthorization(ctx, authorization.URI); err != nil {
+		return fmt.Errorf("authorization for %q failed: %w", authorization.Identifier.Value, err)
+	}
+
+	return nil
+}
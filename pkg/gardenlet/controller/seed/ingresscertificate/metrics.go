@@ -0,0 +1,60 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingresscertificate
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardenlet"
+	metricsSubsystem = "seed_ingress_certificate"
+
+	// ResultSuccess is the value of the 'result' label for successful certificate requests.
+	ResultSuccess = "success"
+	// ResultError is the value of the 'result' label for failed certificate requests which have not been rate-limited.
+	ResultError = "error"
+	// ResultRateLimited is the value of the 'result' label for certificate requests which have been rate-limited by the
+	// issuer.
+	ResultRateLimited = "rate_limited"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	metricExpirationTimestamp = factory.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "expiration_timestamp_seconds",
+			Help:      "Expiration time of the wildcard certificate for the ingress domain of the seed in seconds since epoch.",
+		},
+	)
+
+	metricRequestsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of certificate requests for the ingress domain of the seed per ACME issuer.",
+		},
+		[]string{
+			"issuer",
+			"result",
+		},
+	)
+)
@@ -0,0 +1,294 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingresscertificate
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// SecretName is the name of the secret in the garden namespace of the seed containing the managed wildcard
+	// certificate for the ingress domain of the seed.
+	SecretName = "seed-ingress-certificate"
+	// AnnotationIssuer is the annotation of the certificate secret containing the name of the issuer which issued the
+	// certificate.
+	AnnotationIssuer = "seed.gardener.cloud/ingress-certificate-issuer"
+
+	accountSecretNamePrefix = "seed-ingress-acme-account-"
+	dataKeyAccountKey       = "privateKey"
+	dataKeyHMACKey          = "hmacKey"
+	pemBlockTypeECKey       = "EC PRIVATE KEY"
+
+	// reconciliationTimeout is higher than the default since solving the DNS-01 challenges requires the challenge
+	// records to be published and validated by the issuer.
+	reconciliationTimeout = 10 * time.Minute
+)
+
+// Reconciler manages the wildcard certificate for the ingress domain of the seed. The certificate is requested from the
+// configured ACME issuers via DNS-01 challenges. The issuers are tried in the configured order, i.e., the next issuer is
+// only used if the previous one is rate-limiting the requests. If the operator provides a wildcard certificate
+// themselves, the reconciler does not interfere.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       config.SeedIngressCertificateControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+
+	// NewACMEClient creates a client for the ACME issuer with the given directory URL. Exposed for testing.
+	NewACMEClient func(key crypto.Signer, directoryURL string) ACMEClient
+	// NewChallengeSolver creates a solver for DNS-01 challenges with the given DNS provider. Exposed for testing.
+	NewChallengeSolver func(log logr.Logger, provider DNSChallengeProvider) ChallengeSolver
+}
+
+// Reconcile requests a new wildcard certificate for the ingress domain of the seed if the current one is about to
+// expire or does not match the ingress domain anymore.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, reconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if seed.Spec.Ingress == nil {
+		log.V(1).Info("Seed does not have an ingress domain, nothing to do")
+		return reconcile.Result{}, nil
+	}
+
+	wildcardSecret, err := gardenerutils.GetWildcardCertificate(ctx, r.SeedClient)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading wildcard certificate: %w", err)
+	}
+
+	if wildcardSecret != nil && wildcardSecret.Name != SecretName {
+		log.Info("Wildcard certificate is provided by the operator, skipping", "secret", client.ObjectKeyFromObject(wildcardSecret))
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	dnsName := "*." + seed.Spec.Ingress.Domain
+
+	if wildcardSecret != nil {
+		certificate, err := parseCertificate(wildcardSecret)
+		if err != nil {
+			log.Error(err, "Failed parsing managed wildcard certificate, requesting a new one")
+		} else {
+			metricExpirationTimestamp.Set(float64(certificate.NotAfter.Unix()))
+
+			renewAt := certificate.NotAfter.Add(-r.Config.RenewBefore.Duration)
+			if slices.Contains(certificate.DNSNames, dnsName) && r.Clock.Now().Before(renewAt) {
+				log.V(1).Info("Wildcard certificate is up-to-date", "notAfter", certificate.NotAfter)
+				return reconcile.Result{RequeueAfter: min(r.Config.SyncPeriod.Duration, renewAt.Sub(r.Clock.Now()))}, nil
+			}
+		}
+	}
+
+	log.Info("Requesting new wildcard certificate", "dnsName", dnsName)
+	issuerName, certificatePEM, privateKeyPEM, err := r.requestCertificateFromIssuers(ctx, log, seed, dnsName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretName, Namespace: v1beta1constants.GardenNamespace}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.SeedClient, secret, func() error {
+		metav1.SetMetaDataLabel(&secret.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleControlPlaneWildcardCert)
+		metav1.SetMetaDataAnnotation(&secret.ObjectMeta, AnnotationIssuer, issuerName)
+		secret.Type = corev1.SecretTypeTLS
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       certificatePEM,
+			corev1.TLSPrivateKeyKey: privateKeyPEM,
+		}
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed storing wildcard certificate: %w", err)
+	}
+
+	certificate, err := parseCertificate(secret)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed parsing issued wildcard certificate: %w", err)
+	}
+	metricExpirationTimestamp.Set(float64(certificate.NotAfter.Unix()))
+
+	log.Info("Stored new wildcard certificate", "issuer", issuerName, "notAfter", certificate.NotAfter)
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) requestCertificateFromIssuers(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed, dnsName string) (string, []byte, []byte, error) {
+	var rateLimitErrs []error
+
+	for _, issuer := range r.Config.Issuers {
+		log := log.WithValues("issuer", issuer.Name)
+
+		certificatePEM, privateKeyPEM, err := r.requestCertificateFromIssuer(ctx, log, seed, issuer, dnsName)
+		switch {
+		case err == nil:
+			metricRequestsTotal.WithLabelValues(issuer.Name, ResultSuccess).Inc()
+			return issuer.Name, certificatePEM, privateKeyPEM, nil
+
+		case isRateLimited(err):
+			metricRequestsTotal.WithLabelValues(issuer.Name, ResultRateLimited).Inc()
+			log.Info("Issuer is rate-limiting certificate requests, falling back to next issuer", "reason", err.Error())
+			rateLimitErrs = append(rateLimitErrs, fmt.Errorf("issuer %q: %w", issuer.Name, err))
+
+		default:
+			metricRequestsTotal.WithLabelValues(issuer.Name, ResultError).Inc()
+			return "", nil, nil, fmt.Errorf("failed requesting certificate from issuer %q: %w", issuer.Name, err)
+		}
+	}
+
+	return "", nil, nil, fmt.Errorf("all issuers are rate-limiting certificate requests: %w", errors.Join(rateLimitErrs...))
+}
+
+func (r *Reconciler) requestCertificateFromIssuer(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed, issuer config.ACMEIssuer, dnsName string) ([]byte, []byte, error) {
+	accountKey, err := r.getOrCreateAccountKey(ctx, issuer.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider, err := r.getDNSChallengeProvider(ctx, seed, issuer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account := &acme.Account{}
+	if issuer.Email != nil {
+		account.Contact = []string{"mailto:" + *issuer.Email}
+	}
+
+	if eab := issuer.ExternalAccountBinding; eab != nil {
+		secret, err := kubernetesutils.GetSecretByReference(ctx, r.SeedClient, &eab.KeySecretRef)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed reading external account binding secret: %w", err)
+		}
+
+		hmacKey, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(string(secret.Data[dataKeyHMACKey]), "="))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed decoding HMAC key of external account binding: %w", err)
+		}
+
+		account.ExternalAccountBinding = &acme.ExternalAccountBinding{KID: eab.KeyID, Key: hmacKey}
+	}
+
+	acmeClient := r.NewACMEClient(accountKey, issuer.Server)
+	if _, err := acmeClient.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, nil, fmt.Errorf("failed registering account: %w", err)
+	}
+
+	return requestCertificate(ctx, log, acmeClient, r.NewChallengeSolver(log, provider), []string{dnsName})
+}
+
+// getOrCreateAccountKey returns the private key of the ACME account for the given issuer. The key is generated and
+// stored in the garden namespace of the seed if it does not exist yet.
+func (r *Reconciler) getOrCreateAccountKey(ctx context.Context, issuerName string) (crypto.Signer, error) {
+	secret := &corev1.Secret{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: accountSecretNamePrefix + issuerName, Namespace: v1beta1constants.GardenNamespace}, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed reading account secret: %w", err)
+		}
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed generating account key: %w", err)
+		}
+
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed encoding account key: %w", err)
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: accountSecretNamePrefix + issuerName, Namespace: v1beta1constants.GardenNamespace},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{dataKeyAccountKey: pem.EncodeToMemory(&pem.Block{Type: pemBlockTypeECKey, Bytes: der})},
+		}
+		if err := r.SeedClient.Create(ctx, secret); err != nil {
+			return nil, fmt.Errorf("failed creating account secret: %w", err)
+		}
+
+		return key, nil
+	}
+
+	block, _ := pem.Decode(secret.Data[dataKeyAccountKey])
+	if block == nil {
+		return nil, fmt.Errorf("account secret %s does not contain a PEM-encoded private key", client.ObjectKeyFromObject(secret))
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// getDNSChallengeProvider returns the DNS provider for solving the DNS-01 challenges of the given issuer. If the issuer
+// does not configure a DNS provider, the DNS provider of the seed is used.
+func (r *Reconciler) getDNSChallengeProvider(ctx context.Context, seed *gardencorev1beta1.Seed, issuer config.ACMEIssuer) (DNSChallengeProvider, error) {
+	if p := issuer.DNSChallengeProvider; p != nil {
+		secret, err := kubernetesutils.GetSecretByReference(ctx, r.SeedClient, &p.SecretRef)
+		if err != nil {
+			return DNSChallengeProvider{}, fmt.Errorf("failed reading DNS challenge provider secret: %w", err)
+		}
+		return DNSChallengeProvider{Type: p.Type, SecretData: secret.Data, Zone: p.Zone}, nil
+	}
+
+	if seed.Spec.DNS.Provider == nil {
+		return DNSChallengeProvider{}, fmt.Errorf("issuer %q does not configure a DNS challenge provider and the seed does not have a DNS provider", issuer.Name)
+	}
+
+	secret, err := kubernetesutils.GetSecretByReference(ctx, r.GardenClient, &seed.Spec.DNS.Provider.SecretRef)
+	if err != nil {
+		return DNSChallengeProvider{}, fmt.Errorf("failed reading DNS provider secret of seed: %w", err)
+	}
+	return DNSChallengeProvider{Type: seed.Spec.DNS.Provider.Type, SecretData: secret.Data}, nil
+}
+
+func parseCertificate(secret *corev1.Secret) (*x509.Certificate, error) {
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil {
+		return nil, fmt.Errorf("secret %s does not contain a PEM-encoded certificate", client.ObjectKeyFromObject(secret))
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,409 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingresscertificate_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/ingresscertificate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+
+		seedName      = "seed"
+		ingressDomain = "ingress.seed.example.com"
+		syncPeriod    = time.Hour
+		renewBefore   = 30 * 24 * time.Hour
+
+		seed    *gardencorev1beta1.Seed
+		request reconcile.Request
+
+		acmeClients map[string]*fakeACMEClient
+		solvers     map[string]*fakeChallengeSolver
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		acmeClients = map[string]*fakeACMEClient{
+			"https://primary.example.com/directory":  {clock: fakeClock},
+			"https://fallback.example.com/directory": {clock: fakeClock},
+		}
+		solvers = map[string]*fakeChallengeSolver{}
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config: config.SeedIngressCertificateControllerConfiguration{
+				SyncPeriod:  &metav1.Duration{Duration: syncPeriod},
+				RenewBefore: &metav1.Duration{Duration: renewBefore},
+				Issuers: []config.ACMEIssuer{
+					{
+						Name:   "primary",
+						Server: "https://primary.example.com/directory",
+						Email:  pointer.String("ops@example.com"),
+						ExternalAccountBinding: &config.ACMEExternalAccountBinding{
+							KeyID:        "kid",
+							KeySecretRef: corev1.SecretReference{Name: "eab", Namespace: "garden"},
+						},
+					},
+					{
+						Name:   "fallback",
+						Server: "https://fallback.example.com/directory",
+						DNSChallengeProvider: &config.ACMEDNSChallengeProvider{
+							Type:      "other-dns",
+							SecretRef: corev1.SecretReference{Name: "other-dns", Namespace: "garden"},
+							Zone:      pointer.String("zone"),
+						},
+					},
+				},
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+			NewACMEClient: func(_ crypto.Signer, directoryURL string) ACMEClient {
+				return acmeClients[directoryURL]
+			},
+			NewChallengeSolver: func(_ logr.Logger, provider DNSChallengeProvider) ChallengeSolver {
+				solver := &fakeChallengeSolver{provider: provider, presented: map[string]string{}}
+				solvers[provider.Type] = solver
+				return solver
+			},
+		}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+			Spec: gardencorev1beta1.SeedSpec{
+				DNS: gardencorev1beta1.SeedDNS{
+					Provider: &gardencorev1beta1.SeedDNSProvider{
+						Type:      "seed-dns",
+						SecretRef: corev1.SecretReference{Name: "seed-dns", Namespace: "garden"},
+					},
+				},
+				Ingress: &gardencorev1beta1.Ingress{Domain: ingressDomain},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		Expect(gardenClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed-dns", Namespace: "garden"},
+			Data:       map[string][]byte{"credentials": []byte("seed")},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "eab", Namespace: "garden"},
+			Data:       map[string][]byte{"hmacKey": []byte(base64.RawURLEncoding.EncodeToString([]byte("hmac-key")))},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-dns", Namespace: "garden"},
+			Data:       map[string][]byte{"credentials": []byte("other")},
+		})).To(Succeed())
+	})
+
+	getCertificate := func() (*corev1.Secret, *x509.Certificate) {
+		secret := &corev1.Secret{}
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: "seed-ingress-certificate", Namespace: "garden"}, secret)).To(Succeed())
+
+		block, _ := pem.Decode(secret.Data["tls.crt"])
+		ExpectWithOffset(1, block).NotTo(BeNil())
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return secret, certificate
+	}
+
+	createManagedCertificate := func(dnsName string, notAfter time.Time) {
+		certificatePEM, privateKeyPEM := issueCertificate(dnsName, notAfter)
+		ExpectWithOffset(1, seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "seed-ingress-certificate",
+				Namespace: "garden",
+				Labels:    map[string]string{"gardener.cloud/role": "controlplane-cert"},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{"tls.crt": certificatePEM, "tls.key": privateKeyPEM},
+		})).To(Succeed())
+	}
+
+	It("should do nothing if the seed does not have an ingress domain", func() {
+		seed.Spec.Ingress = nil
+		Expect(gardenClient.Update(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(acmeClients["https://primary.example.com/directory"].orders).To(BeZero())
+	})
+
+	It("should not interfere with a wildcard certificate provided by the operator", func() {
+		Expect(seedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      "operator-cert",
+			Namespace: "garden",
+			Labels:    map[string]string{"gardener.cloud/role": "controlplane-cert"},
+		}})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(acmeClients["https://primary.example.com/directory"].orders).To(BeZero())
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "seed-ingress-certificate", Namespace: "garden"}, &corev1.Secret{})).To(BeNotFoundError())
+	})
+
+	It("should request a certificate from the first issuer if no certificate exists", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		secret, certificate := getCertificate()
+		Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+		Expect(secret.Annotations).To(HaveKeyWithValue("seed.gardener.cloud/ingress-certificate-issuer", "primary"))
+		Expect(secret.Data).To(HaveKey("tls.key"))
+		Expect(certificate.DNSNames).To(ConsistOf("*." + ingressDomain))
+
+		primary := acmeClients["https://primary.example.com/directory"]
+		Expect(primary.account.Contact).To(ConsistOf("mailto:ops@example.com"))
+		Expect(primary.account.ExternalAccountBinding).To(Equal(&acme.ExternalAccountBinding{KID: "kid", Key: []byte("hmac-key")}))
+		Expect(acmeClients["https://fallback.example.com/directory"].orders).To(BeZero())
+
+		Expect(solvers).To(HaveKey("seed-dns"))
+		Expect(solvers["seed-dns"].provider.SecretData).To(HaveKeyWithValue("credentials", []byte("seed")))
+		Expect(solvers["seed-dns"].presented).To(Equal(map[string]string{"_acme-challenge." + ingressDomain: "record-token"}))
+		Expect(solvers["seed-dns"].cleanedUp).To(ConsistOf("_acme-challenge." + ingressDomain))
+
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "seed-ingress-acme-account-primary", Namespace: "garden"}, &corev1.Secret{})).To(Succeed())
+	})
+
+	It("should reuse the existing account key", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		accountSecret := &corev1.Secret{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "seed-ingress-acme-account-primary", Namespace: "garden"}, accountSecret)).To(Succeed())
+
+		var keys []crypto.Signer
+		reconciler.NewACMEClient = func(key crypto.Signer, directoryURL string) ACMEClient {
+			keys = append(keys, key)
+			return acmeClients[directoryURL]
+		}
+		Expect(seedClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "seed-ingress-certificate", Namespace: "garden"}})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(keys).To(HaveLen(1))
+		block, _ := pem.Decode(accountSecret.Data["privateKey"])
+		Expect(block).NotTo(BeNil())
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys[0].Public()).To(Equal(key.Public()))
+	})
+
+	It("should not request a certificate if the existing one is still valid", func() {
+		notAfter := fakeClock.Now().Add(renewBefore + 30*time.Minute)
+		createManagedCertificate("*."+ingressDomain, notAfter)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+		Expect(acmeClients["https://primary.example.com/directory"].orders).To(BeZero())
+	})
+
+	It("should renew the certificate if it is about to expire", func() {
+		createManagedCertificate("*."+ingressDomain, fakeClock.Now().Add(renewBefore-time.Minute))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		_, certificate := getCertificate()
+		Expect(certificate.NotAfter).To(Equal(fakeClock.Now().Add(90 * 24 * time.Hour).UTC()))
+		Expect(acmeClients["https://primary.example.com/directory"].orders).To(Equal(1))
+	})
+
+	It("should renew the certificate if it does not match the ingress domain", func() {
+		createManagedCertificate("*.old."+ingressDomain, fakeClock.Now().Add(90*24*time.Hour))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		_, certificate := getCertificate()
+		Expect(certificate.DNSNames).To(ConsistOf("*." + ingressDomain))
+	})
+
+	It("should fall back to the next issuer if the first one is rate-limited", func() {
+		acmeClients["https://primary.example.com/directory"].orderErr = &acme.Error{StatusCode: 429, ProblemType: "urn:ietf:params:acme:error:rateLimited"}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		secret, _ := getCertificate()
+		Expect(secret.Annotations).To(HaveKeyWithValue("seed.gardener.cloud/ingress-certificate-issuer", "fallback"))
+		Expect(acmeClients["https://fallback.example.com/directory"].account.ExternalAccountBinding).To(BeNil())
+
+		Expect(solvers).To(HaveKey("other-dns"))
+		Expect(solvers["other-dns"].provider.Zone).To(PointTo(Equal("zone")))
+		Expect(solvers["other-dns"].provider.SecretData).To(HaveKeyWithValue("credentials", []byte("other")))
+	})
+
+	It("should not fall back to the next issuer if the first one fails for other reasons", func() {
+		acmeClients["https://primary.example.com/directory"].orderErr = &acme.Error{StatusCode: 403, ProblemType: "urn:ietf:params:acme:error:unauthorized"}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring(`failed requesting certificate from issuer "primary"`)))
+		Expect(acmeClients["https://fallback.example.com/directory"].orders).To(BeZero())
+	})
+
+	It("should return an error if all issuers are rate-limited", func() {
+		for _, c := range acmeClients {
+			c.orderErr = &acme.Error{StatusCode: 429, ProblemType: "urn:ietf:params:acme:error:rateLimited"}
+		}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("all issuers are rate-limiting certificate requests")))
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "seed-ingress-certificate", Namespace: "garden"}, &corev1.Secret{})).To(BeNotFoundError())
+	})
+})
+
+// fakeACMEClient is an ACME client which issues self-signed certificates valid for 90 days.
+type fakeACMEClient struct {
+	clock    *testclock.FakeClock
+	orderErr error
+
+	account *acme.Account
+	orders  int
+	domain  string
+}
+
+func (c *fakeACMEClient) Register(_ context.Context, account *acme.Account, _ func(string) bool) (*acme.Account, error) {
+	if c.account != nil {
+		return nil, acme.ErrAccountAlreadyExists
+	}
+	c.account = account
+	return account, nil
+}
+
+func (c *fakeACMEClient) AuthorizeOrder(_ context.Context, ids []acme.AuthzID, _ ...acme.OrderOption) (*acme.Order, error) {
+	if c.orderErr != nil {
+		return nil, c.orderErr
+	}
+	c.orders++
+	c.domain = strings.TrimPrefix(ids[0].Value, "*.")
+	return &acme.Order{URI: "order", AuthzURLs: []string{"authz"}, FinalizeURL: "finalize"}, nil
+}
+
+func (c *fakeACMEClient) GetAuthorization(_ context.Context, url string) (*acme.Authorization, error) {
+	return &acme.Authorization{
+		URI:        url,
+		Status:     acme.StatusPending,
+		Identifier: acme.AuthzID{Type: "dns", Value: c.domain},
+		Wildcard:   true,
+		Challenges: []*acme.Challenge{{Type: "http-01", Token: "http"}, {Type: "dns-01", Token: "token"}},
+	}, nil
+}
+
+func (c *fakeACMEClient) Accept(_ context.Context, challenge *acme.Challenge) (*acme.Challenge, error) {
+	return challenge, nil
+}
+
+func (c *fakeACMEClient) WaitAuthorization(_ context.Context, url string) (*acme.Authorization, error) {
+	return &acme.Authorization{URI: url, Status: acme.StatusValid}, nil
+}
+
+func (c *fakeACMEClient) WaitOrder(_ context.Context, url string) (*acme.Order, error) {
+	return &acme.Order{URI: url, Status: acme.StatusReady, FinalizeURL: "finalize"}, nil
+}
+
+func (c *fakeACMEClient) CreateOrderCert(_ context.Context, _ string, csrDER []byte, _ bool) ([][]byte, string, error) {
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return nil, "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		NotBefore:    c.clock.Now(),
+		NotAfter:     c.clock.Now().Add(90 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, csr.PublicKey, caKey)
+	return [][]byte{der}, "cert", err
+}
+
+func (c *fakeACMEClient) DNS01ChallengeRecord(token string) (string, error) {
+	return "record-" + token, nil
+}
+
+type fakeChallengeSolver struct {
+	provider  DNSChallengeProvider
+	presented map[string]string
+	cleanedUp []string
+}
+
+func (s *fakeChallengeSolver) Present(_ context.Context, fqdn, value string) error {
+	s.presented[fqdn] = value
+	return nil
+}
+
+func (s *fakeChallengeSolver) CleanUp(_ context.Context, fqdn string) error {
+	s.cleanedUp = append(s.cleanedUp, fqdn)
+	return nil
+}
+
+var caKey = func() *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+func issueCertificate(dnsName string, notAfter time.Time) ([]byte, []byte) {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, caKey.Public(), caKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	keyDER, err := x509.MarshalECPrivateKey(caKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
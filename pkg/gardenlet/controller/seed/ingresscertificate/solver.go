@@ -0,0 +1,105 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingresscertificate
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
+)
+
+const (
+	challengeRecordName = "seed-ingress-acme-challenge"
+	challengeRecordTTL  = 60
+)
+
+// DNSChallengeProvider is the DNS provider used for solving the DNS-01 challenges of an issuer.
+type DNSChallengeProvider struct {
+	// Type is the type of the DNS provider.
+	Type string
+	// SecretData are the credentials of the DNS provider.
+	SecretData map[string][]byte
+	// Zone is the DNS hosted zone of the challenge records.
+	Zone *string
+}
+
+// dnsRecordChallengeSolver solves DNS-01 challenges by publishing the challenge records via DNSRecord extension
+// resources in the garden namespace of the seed.
+type dnsRecordChallengeSolver struct {
+	log       logr.Logger
+	client    client.Client
+	namespace string
+	provider  DNSChallengeProvider
+}
+
+func (r *Reconciler) newDNSRecordChallengeSolver(log logr.Logger, provider DNSChallengeProvider) ChallengeSolver {
+	return &dnsRecordChallengeSolver{
+		log:       log,
+		client:    r.SeedClient,
+		namespace: v1beta1constants.GardenNamespace,
+		provider:  provider,
+	}
+}
+
+// Present implements ChallengeSolver.
+func (s *dnsRecordChallengeSolver) Present(ctx context.Context, fqdn, value string) error {
+	record := s.dnsRecord(fqdn, value)
+	if err := record.Deploy(ctx); err != nil {
+		return err
+	}
+	return record.Wait(ctx)
+}
+
+// CleanUp implements ChallengeSolver.
+func (s *dnsRecordChallengeSolver) CleanUp(ctx context.Context, fqdn string) error {
+	record := s.dnsRecord(fqdn, "")
+	if err := record.Destroy(ctx); err != nil {
+		return err
+	}
+	return record.WaitCleanup(ctx)
+}
+
+func (s *dnsRecordChallengeSolver) dnsRecord(fqdn, value string) dnsrecord.Interface {
+	values := &dnsrecord.Values{
+		Name:       challengeRecordName,
+		SecretName: challengeRecordName,
+		Namespace:  s.namespace,
+		SecretData: s.provider.SecretData,
+		DNSName:    fqdn,
+		RecordType: extensionsv1alpha1.DNSRecordTypeTXT,
+		Type:       s.provider.Type,
+		Zone:       s.provider.Zone,
+		TTL:        pointer.Int64(challengeRecordTTL),
+	}
+
+	if value != "" {
+		values.Values = []string{value}
+	}
+
+	return dnsrecord.New(
+		s.log,
+		s.client,
+		values,
+		dnsrecord.DefaultInterval,
+		dnsrecord.DefaultSevereThreshold,
+		dnsrecord.DefaultTimeout,
+	)
+}
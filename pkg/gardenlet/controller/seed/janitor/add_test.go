@@ -0,0 +1,56 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package janitor_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/janitor"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#ShootNamespacePredicate", func() {
+		var (
+			p         predicate.Predicate
+			namespace *corev1.Namespace
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).ShootNamespacePredicate()
+			namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"}}
+		})
+
+		It("should return false for namespaces without shoot role", func() {
+			Expect(p.Create(event.CreateEvent{Object: namespace})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: namespace})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: namespace})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: namespace})).To(BeFalse())
+		})
+
+		It("should return true for namespaces with shoot role", func() {
+			namespace.Labels = map[string]string{"gardener.cloud/role": "shoot"}
+
+			Expect(p.Create(event.CreateEvent{Object: namespace})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: namespace})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: namespace})).To(BeTrue())
+			Expect(p.Generic(event.GenericEvent{Object: namespace})).To(BeTrue())
+		})
+	})
+})
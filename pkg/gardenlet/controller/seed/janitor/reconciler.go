@@ -0,0 +1,181 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package janitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// LabelStaleShootNamespace is the label which is added to shoot namespaces in the seed which are considered stale.
+	LabelStaleShootNamespace = "seed.gardener.cloud/stale-shoot-namespace"
+
+	// EventStaleShootNamespace is the reason of the event which is emitted for stale shoot namespaces.
+	EventStaleShootNamespace = "StaleShootNamespace"
+	// EventDeletingStaleShootNamespace is the reason of the event which is emitted when a stale shoot namespace is
+	// deleted.
+	EventDeletingStaleShootNamespace = "DeletingStaleShootNamespace"
+)
+
+// Reconciler detects shoot namespaces in the seed which were left behind by failed shoot deletions or migrations.
+// Stale namespaces are reported via a label and an event. They are only deleted if an operator confirmed the deletion
+// by annotating the namespace with 'confirmation.gardener.cloud/deletion=true'.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       config.ShootNamespaceJanitorControllerConfiguration
+	Clock        clock.Clock
+	Recorder     record.EventRecorder
+	SeedName     string
+}
+
+// Reconcile checks whether a shoot namespace in the seed is stale and deletes it if the deletion was confirmed.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	namespace := &corev1.Namespace{}
+	if err := r.SeedClient.Get(ctx, request.NamespacedName, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if namespace.DeletionTimestamp != nil {
+		log.V(1).Info("Namespace is already being deleted, stop reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	if age := r.Clock.Since(namespace.CreationTimestamp.Time); age < r.Config.StaleThreshold.Duration {
+		requeueAfter := r.Config.StaleThreshold.Duration - age
+		log.V(1).Info("Namespace is younger than the stale threshold, checking again later", "requeueAfter", requeueAfter)
+		return reconcile.Result{RequeueAfter: requeueAfter}, r.removeStaleLabel(ctx, namespace)
+	}
+
+	reason, err := r.referencedBy(ctx, namespace.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if reason != "" {
+		log.V(1).Info("Namespace is not stale", "reason", reason)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.removeStaleLabel(ctx, namespace)
+	}
+
+	return r.handleStaleNamespace(ctx, log, namespace)
+}
+
+func (r *Reconciler) handleStaleNamespace(ctx context.Context, log logr.Logger, namespace *corev1.Namespace) (reconcile.Result, error) {
+	if namespace.Labels[LabelStaleShootNamespace] != "true" {
+		log.Info("Detected stale shoot namespace", "staleThreshold", r.Config.StaleThreshold.Duration)
+		r.Recorder.Eventf(namespace, corev1.EventTypeWarning, EventStaleShootNamespace, "Namespace is stale since neither a Shoot nor a BackupEntry references it. Annotate it with %s=true to confirm its deletion", gardenerutils.ConfirmationDeletion)
+
+		patch := client.MergeFrom(namespace.DeepCopy())
+		kubernetesutils.SetMetaDataLabel(namespace, LabelStaleShootNamespace, "true")
+		if err := r.SeedClient.Patch(ctx, namespace, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed labeling stale namespace: %w", err)
+		}
+	}
+
+	if err := gardenerutils.CheckIfDeletionIsConfirmed(namespace); err != nil {
+		log.Info("Not deleting stale shoot namespace since deletion is not confirmed", "reason", err.Error())
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	log.Info("Deleting stale shoot namespace since deletion was confirmed")
+	r.Recorder.Event(namespace, corev1.EventTypeNormal, EventDeletingStaleShootNamespace, "Deleting stale namespace since its deletion was confirmed")
+
+	if err := r.SeedClient.Delete(ctx, namespace); client.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("failed deleting stale namespace: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// referencedBy returns a description of the object which still references the shoot namespace with the given name. It
+// returns an empty string if the namespace is not referenced anymore.
+func (r *Reconciler) referencedBy(ctx context.Context, technicalID string) (string, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.GardenClient.List(ctx, shootList); err != nil {
+		return "", fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	for _, shoot := range shootList.Items {
+		if shoot.Status.TechnicalID != technicalID {
+			continue
+		}
+		if pointer.StringDeref(shoot.Spec.SeedName, "") == r.SeedName || pointer.StringDeref(shoot.Status.SeedName, "") == r.SeedName {
+			return fmt.Sprintf("Shoot %s", client.ObjectKeyFromObject(&shoot)), nil
+		}
+	}
+
+	backupEntryList := &gardencorev1beta1.BackupEntryList{}
+	if err := r.GardenClient.List(ctx, backupEntryList); err != nil {
+		return "", fmt.Errorf("failed listing backup entries: %w", err)
+	}
+
+	for _, backupEntry := range backupEntryList.Items {
+		if shootTechnicalID, _ := gardenerutils.ExtractShootDetailsFromBackupEntryName(backupEntry.Name); shootTechnicalID == technicalID {
+			return fmt.Sprintf("BackupEntry %s", client.ObjectKeyFromObject(&backupEntry)), nil
+		}
+	}
+
+	extensionBackupEntryList := &extensionsv1alpha1.BackupEntryList{}
+	if err := r.SeedClient.List(ctx, extensionBackupEntryList); err != nil {
+		return "", fmt.Errorf("failed listing extension backup entries: %w", err)
+	}
+
+	for _, backupEntry := range extensionBackupEntryList.Items {
+		if shootTechnicalID, _ := gardenerutils.ExtractShootDetailsFromBackupEntryName(backupEntry.Name); shootTechnicalID == technicalID {
+			return fmt.Sprintf("extension BackupEntry %s", backupEntry.Name), nil
+		}
+	}
+
+	return "", nil
+}
+
+func (r *Reconciler) removeStaleLabel(ctx context.Context, namespace *corev1.Namespace) error {
+	if _, ok := namespace.Labels[LabelStaleShootNamespace]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(namespace.DeepCopy())
+	delete(namespace.Labels, LabelStaleShootNamespace)
+	if err := r.SeedClient.Patch(ctx, namespace, patch); err != nil {
+		return fmt.Errorf("failed removing stale label from namespace: %w", err)
+	}
+	return nil
+}
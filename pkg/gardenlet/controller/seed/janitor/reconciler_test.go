@@ -0,0 +1,219 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package janitor_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/janitor"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		recorder     *record.FakeRecorder
+		reconciler   *Reconciler
+
+		seedName       = "seed"
+		technicalID    = "shoot--foo--bar"
+		syncPeriod     = time.Hour
+		staleThreshold = 24 * time.Hour
+
+		namespace *corev1.Namespace
+		request   reconcile.Request
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config: config.ShootNamespaceJanitorControllerConfiguration{
+				SyncPeriod:     &metav1.Duration{Duration: syncPeriod},
+				StaleThreshold: &metav1.Duration{Duration: staleThreshold},
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+			SeedName: seedName,
+		}
+
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              technicalID,
+				Labels:            map[string]string{"gardener.cloud/role": "shoot"},
+				CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-2 * staleThreshold)),
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(namespace)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(seedClient.Create(ctx, namespace)).To(Succeed())
+	})
+
+	It("should do nothing if the namespace is gone", func() {
+		Expect(seedClient.Delete(ctx, namespace)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	Context("namespace is younger than the stale threshold", func() {
+		BeforeEach(func() {
+			namespace.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+		})
+
+		It("should requeue when the threshold is reached", func() {
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: staleThreshold - time.Hour}))
+
+			Expect(seedClient.Get(ctx, request.NamespacedName, namespace)).To(Succeed())
+			Expect(namespace.Labels).NotTo(HaveKey(LabelStaleShootNamespace))
+		})
+	})
+
+	Context("namespace is still referenced", func() {
+		It("should not report the namespace if a shoot references it", func() {
+			Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String("other-seed")},
+				Status:     gardencorev1beta1.ShootStatus{TechnicalID: technicalID, SeedName: pointer.String(seedName)},
+			})).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedClient.Get(ctx, request.NamespacedName, namespace)).To(Succeed())
+			Expect(namespace.Labels).NotTo(HaveKey(LabelStaleShootNamespace))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not report the namespace if a backup entry references it", func() {
+			Expect(gardenClient.Create(ctx, &gardencorev1beta1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: technicalID + "--1234", Namespace: "garden-foo"},
+			})).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not report the namespace if an extension backup entry references it", func() {
+			Expect(seedClient.Create(ctx, &extensionsv1alpha1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "source-" + technicalID + "--1234"},
+			})).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		Context("namespace was reported as stale before", func() {
+			BeforeEach(func() {
+				namespace.Labels[LabelStaleShootNamespace] = "true"
+			})
+
+			It("should remove the stale label", func() {
+				Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+					Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String(seedName)},
+					Status:     gardencorev1beta1.ShootStatus{TechnicalID: technicalID},
+				})).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(seedClient.Get(ctx, request.NamespacedName, namespace)).To(Succeed())
+				Expect(namespace.Labels).NotTo(HaveKey(LabelStaleShootNamespace))
+			})
+		})
+	})
+
+	Context("namespace is stale", func() {
+		BeforeEach(func() {
+			Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String("other-seed")},
+				Status:     gardencorev1beta1.ShootStatus{TechnicalID: technicalID, SeedName: pointer.String("other-seed")},
+			})).To(Succeed())
+		})
+
+		It("should report but not delete the namespace if the deletion is not confirmed", func() {
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedClient.Get(ctx, request.NamespacedName, namespace)).To(Succeed())
+			Expect(namespace.Labels).To(HaveKeyWithValue(LabelStaleShootNamespace, "true"))
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventStaleShootNamespace)))
+		})
+
+		It("should not report the namespace again if it is already labeled", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		Context("deletion is confirmed", func() {
+			BeforeEach(func() {
+				namespace.Annotations = map[string]string{"confirmation.gardener.cloud/deletion": "true"}
+			})
+
+			It("should delete the namespace", func() {
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{}))
+
+				Expect(seedClient.Get(ctx, request.NamespacedName, namespace)).To(BeNotFoundError())
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventStaleShootNamespace)))
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventDeletingStaleShootNamespace)))
+			})
+		})
+	})
+})
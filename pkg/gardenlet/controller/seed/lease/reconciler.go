@@ -34,6 +34,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/autonomy"
 	"github.com/gardener/gardener/pkg/healthz"
 )
 
@@ -47,6 +48,8 @@ type Reconciler struct {
 	HealthManager  healthz.Manager
 	LeaseNamespace string
 	SeedName       string
+	// Autonomy tracks the reachability of the garden cluster. If it is nil then the autonomy mode is disabled.
+	Autonomy *autonomy.Tracker
 }
 
 // Reconcile reconciles Seed resources and updates the heartbeat Lease object in the garden cluster when the connection
@@ -72,10 +75,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if err := r.renewLeaseForSeed(ctx, seed); err != nil {
-		r.HealthManager.Set(false)
+		r.Autonomy.GardenUnreachable()
+		// In autonomy mode, an unreachable garden cluster must not render gardenlet unhealthy. Otherwise, gardenlet would
+		// be restarted and could not start up again as long as the garden cluster is not reachable.
+		r.HealthManager.Set(r.Autonomy.Enabled())
 		return reconcile.Result{}, err
 	}
 
+	r.Autonomy.GardenReachable()
 	r.HealthManager.Set(true)
 	return reconcile.Result{RequeueAfter: time.Duration(*r.Config.LeaseResyncSeconds) * time.Second}, r.maintainGardenletReadyCondition(ctx, seed)
 }
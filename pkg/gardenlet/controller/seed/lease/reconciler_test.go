@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	coordinationv1 "k8s.io/api/coordination/v1"
@@ -40,6 +41,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/autonomy"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/lease"
 	"github.com/gardener/gardener/pkg/healthz"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -49,6 +51,7 @@ var _ = Describe("LeaseReconciler", func() {
 	var (
 		ctx            context.Context
 		clock          clock.Clock
+		fakeClock      *testclock.FakeClock
 		gardenClient   client.Client
 		seedRESTClient *fakerestclient.RESTClient
 		healthManager  healthz.Manager
@@ -65,7 +68,8 @@ var _ = Describe("LeaseReconciler", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		clock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		clock = fakeClock
 
 		seed = &gardencorev1beta1.Seed{
 			ObjectMeta: metav1.ObjectMeta{
@@ -215,6 +219,47 @@ var _ = Describe("LeaseReconciler", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(healthManager.Get()).To(BeFalse())
 		})
+
+		Context("autonomy mode enabled", func() {
+			var tracker *autonomy.Tracker
+
+			JustBeforeEach(func() {
+				tracker = autonomy.NewTracker(logr.Discard(), fakeClock, time.Minute)
+				reconciler.Autonomy = tracker
+			})
+
+			It("should keep the health status and switch to autonomy mode after the threshold", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).To(HaveOccurred())
+				Expect(healthManager.Get()).To(BeTrue())
+				Expect(tracker.IsAutonomous()).To(BeFalse())
+
+				fakeClock.Step(2 * time.Minute)
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).To(HaveOccurred())
+				Expect(healthManager.Get()).To(BeTrue())
+				Expect(tracker.IsAutonomous()).To(BeTrue())
+			})
+
+			It("should leave the autonomy mode when the lease can be renewed again", func() {
+				fakeClock.Step(2 * time.Minute)
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).To(HaveOccurred())
+				Expect(tracker.IsAutonomous()).To(BeTrue())
+
+				reconciler.GardenClient = gardenClient.(failingLeaseClient).Client
+				renewTime := metav1.NewMicroTime(fakeClock.Now())
+				expectedLease.OwnerReferences = []metav1.OwnerReference{{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Seed", Name: seed.Name, UID: seed.UID}}
+				expectedLease.Spec = coordinationv1.LeaseSpec{HolderIdentity: pointer.String(seed.Name), LeaseDurationSeconds: pointer.Int32(2), RenewTime: &renewTime}
+				expectedLease.ResourceVersion = ""
+				expectedCondition = gardenletReadyCondition(fakeClock)
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 2 * time.Second}))
+				Expect(healthManager.Get()).To(BeTrue())
+				Expect(tracker.IsAutonomous()).To(BeFalse())
+			})
+		})
 	})
 
 	It("adds GardenletReady condition after renewing lease", func() {
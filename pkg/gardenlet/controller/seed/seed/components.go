@@ -16,6 +16,8 @@ package seed
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	proberapi "github.com/gardener/dependency-watchdog/api/prober"
@@ -26,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
@@ -53,8 +56,11 @@ import (
 	"github.com/gardener/gardener/pkg/component/machinecontrollermanager"
 	"github.com/gardener/gardener/pkg/component/metricsserver"
 	"github.com/gardener/gardener/pkg/component/monitoring"
+	"github.com/gardener/gardener/pkg/component/monitoring/authproxy"
+	"github.com/gardener/gardener/pkg/component/monitoring/gateway"
 	"github.com/gardener/gardener/pkg/component/nodeexporter"
 	"github.com/gardener/gardener/pkg/component/nodeproblemdetector"
+	"github.com/gardener/gardener/pkg/component/parca"
 	"github.com/gardener/gardener/pkg/component/plutono"
 	"github.com/gardener/gardener/pkg/component/seedsystem"
 	"github.com/gardener/gardener/pkg/component/shared"
@@ -62,6 +68,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/vpnseedserver"
 	"github.com/gardener/gardener/pkg/component/vpnshoot"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -266,6 +273,98 @@ func defaultVPNAuthzServer(
 	), nil
 }
 
+func defaultParca(
+	ctx context.Context,
+	c client.Client,
+	gardenNamespaceName string,
+	profilingConfig *config.ContinuousProfiling,
+) (
+	component.DeployWaiter,
+	error,
+) {
+	if profilingConfig == nil || !profilingConfig.Enabled {
+		return component.OpDestroyWithoutWait(parca.New(c, gardenNamespaceName, parca.Values{})), nil
+	}
+
+	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameParca)
+	if err != nil {
+		return nil, err
+	}
+
+	values := parca.Values{
+		Image:             image.String(),
+		PriorityClassName: v1beta1constants.PriorityClassNameSeedSystem600,
+	}
+
+	if profilingConfig.ScrapeInterval != nil {
+		values.ScrapeInterval = profilingConfig.ScrapeInterval.Duration
+	}
+
+	if ref := profilingConfig.StorageSecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, kubernetesutils.Key(ref.Namespace, ref.Name), secret); err != nil {
+			return nil, fmt.Errorf("failed reading continuous profiling storage secret %s: %w", client.ObjectKeyFromObject(secret), err)
+		}
+
+		storageConfig, ok := secret.Data[parca.DataKeyStorageConfig]
+		if !ok {
+			return nil, fmt.Errorf("continuous profiling storage secret %s does not contain key %q", client.ObjectKeyFromObject(secret), parca.DataKeyStorageConfig)
+		}
+		values.StorageConfig = storageConfig
+	}
+
+	return parca.New(c, gardenNamespaceName, values), nil
+}
+
+func defaultObservabilityGateway(
+	c client.Client,
+	gardenNamespaceName string,
+	secretsManager secretsmanager.Interface,
+	gardenletConfig *config.GardenletConfiguration,
+	host string,
+	wildcardCertName *string,
+) (
+	authProxy component.DeployWaiter,
+	observabilityGateway component.DeployWaiter,
+	err error,
+) {
+	if !gardenlethelper.IsShootMonitoringSharedGatewayEnabled(gardenletConfig) {
+		return component.OpDestroyWithoutWait(authproxy.New(c, gardenNamespaceName, nil, authproxy.Values{})),
+			component.OpDestroyWithoutWait(gateway.New(c, gardenNamespaceName, nil, gateway.Values{})),
+			nil
+	}
+
+	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameOauth2Proxy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sso := gardenlethelper.GetShootMonitoringSSOConfig(gardenletConfig)
+	values := authproxy.Values{
+		Image:             image.String(),
+		Replicas:          2,
+		PriorityClassName: v1beta1constants.PriorityClassNameSeedSystem600,
+		IssuerURL:         sso.IssuerURL,
+		ClientID:          sso.ClientID,
+		ClientSecretRef:   sso.ClientSecretRef,
+		GroupsClaim:       pointer.StringDeref(sso.GroupsClaim, "groups"),
+		CookieRefresh:     time.Hour,
+		// The routes of the shoots pass the groups which are members of their projects, see
+		// gateway.RoutesValues.AllowedGroups.
+		DelegateAuthorization: true,
+	}
+	if sso.CookieRefresh != nil {
+		values.CookieRefresh = sso.CookieRefresh.Duration
+	}
+
+	return authproxy.New(c, gardenNamespaceName, secretsManager, values),
+		gateway.New(c, gardenNamespaceName, secretsManager, gateway.Values{
+			Host:             host,
+			WildcardCertName: wildcardCertName,
+		}),
+		nil
+}
+
 func defaultSystem(
 	c client.Client,
 	seed *seedpkg.Seed,
@@ -345,8 +444,10 @@ func defaultVali(
 		component.ClusterTypeSeed,
 		1,
 		false,
+		false,
 		v1beta1constants.PriorityClassNameSeedSystem600,
 		storage,
+		nil,
 		"",
 		hvpaEnabled,
 		&hvpav1alpha1.MaintenanceTimeWindow{
@@ -372,6 +473,7 @@ func defaultPlutono(
 	ingressHot string,
 	authSecret string,
 	wildcardCertName *string,
+	customizations *config.MonitoringCustomizations,
 ) (
 	plutono.Interface,
 	error,
@@ -392,6 +494,7 @@ func defaultPlutono(
 		false,
 		false,
 		wildcardCertName,
+		customizations,
 	)
 }
 
@@ -44,7 +44,10 @@ import (
 	"github.com/gardener/gardener/pkg/component/logging/fluentoperator"
 	"github.com/gardener/gardener/pkg/component/logging/vali"
 	"github.com/gardener/gardener/pkg/component/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/component/monitoring/authproxy"
+	"github.com/gardener/gardener/pkg/component/monitoring/gateway"
 	"github.com/gardener/gardener/pkg/component/nginxingress"
+	"github.com/gardener/gardener/pkg/component/parca"
 	"github.com/gardener/gardener/pkg/component/plutono"
 	"github.com/gardener/gardener/pkg/component/resourcemanager"
 	"github.com/gardener/gardener/pkg/component/seedsystem"
@@ -182,6 +185,9 @@ func (r *Reconciler) runDeleteSeedFlow(
 		dwdProber                = dependencywatchdog.NewBootstrapper(seedClient, r.GardenNamespace, dependencywatchdog.BootstrapperValues{Role: dependencywatchdog.RoleProber})
 		systemResources          = seedsystem.New(seedClient, r.GardenNamespace, seedsystem.Values{})
 		vpnAuthzServer           = vpnauthzserver.New(seedClient, r.GardenNamespace, "")
+		parca                    = parca.New(seedClient, r.GardenNamespace, parca.Values{})
+		observabilityAuthProxy   = authproxy.New(seedClient, r.GardenNamespace, nil, authproxy.Values{})
+		observabilityGateway     = gateway.New(seedClient, r.GardenNamespace, nil, gateway.Values{})
 		istioCRDs                = istio.NewCRD(r.SeedClientSet.ChartApplier())
 		istio                    = istio.NewIstio(seedClient, r.SeedClientSet.ChartRenderer(), istio.Values{
 			Istiod: istio.IstiodValues{
@@ -238,6 +244,18 @@ func (r *Reconciler) runDeleteSeedFlow(
 			Name: "Destroy VPN authorization server",
 			Fn:   component.OpDestroyAndWait(vpnAuthzServer).Destroy,
 		})
+		destroyParca = g.Add(flow.Task{
+			Name: "Destroy continuous profiling server",
+			Fn:   component.OpDestroyAndWait(parca).Destroy,
+		})
+		destroyObservabilityAuthProxy = g.Add(flow.Task{
+			Name: "Destroy observability auth proxy",
+			Fn:   component.OpDestroyAndWait(observabilityAuthProxy).Destroy,
+		})
+		destroyObservabilityGateway = g.Add(flow.Task{
+			Name: "Destroy observability gateway",
+			Fn:   component.OpDestroyAndWait(observabilityGateway).Destroy,
+		})
 		destroyIstio = g.Add(flow.Task{
 			Name: "Destroy Istio",
 			Fn:   component.OpDestroyAndWait(istio).Destroy,
@@ -265,6 +283,9 @@ func (r *Reconciler) runDeleteSeedFlow(
 			destroyKubeAPIServerIngress,
 			destroyKubeAPIServerService,
 			destroyVPNAuthzServer,
+			destroyParca,
+			destroyObservabilityAuthProxy,
+			destroyObservabilityGateway,
 			destroyIstio,
 			destroyIstioCRDs,
 			destroyMachineControllerManagerCRDs,
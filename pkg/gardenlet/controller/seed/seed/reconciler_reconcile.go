@@ -52,6 +52,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/kubeapiserverexposure"
 	"github.com/gardener/gardener/pkg/component/logging/fluentoperator"
 	"github.com/gardener/gardener/pkg/component/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/component/monitoring/gateway"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/component/vpa"
 	"github.com/gardener/gardener/pkg/controllerutils"
@@ -299,6 +300,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 			v1beta1helper.SeedSettingTopologyAwareRoutingEnabled(seed.GetInfo().Spec.Settings),
 			additionalNetworkPolicyNamespaceSelectors,
 			seed.GetInfo().Spec.Provider.Zones,
+			gardenlethelper.IsContinuousProfilingEnabled(&r.Config),
 		)
 		if err != nil {
 			return err
@@ -388,6 +390,21 @@ func (r *Reconciler) runReconcileSeedFlow(
 	if err != nil {
 		return err
 	}
+	parca, err := defaultParca(ctx, seedClient, r.GardenNamespace, r.Config.ContinuousProfiling)
+	if err != nil {
+		return err
+	}
+	observabilityAuthProxy, observabilityGateway, err := defaultObservabilityGateway(
+		seedClient,
+		r.GardenNamespace,
+		secretsManager,
+		&r.Config,
+		seed.GetIngressFQDN(gateway.SubDomain),
+		wildCardSecretName,
+	)
+	if err != nil {
+		return err
+	}
 	monitoring, err := defaultMonitoring(
 		seedClient,
 		chartApplier,
@@ -442,6 +459,18 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Name: "Deploying VPN authorization server",
 			Fn:   vpnAuthzServer.Deploy,
 		})
+		_ = g.Add(flow.Task{
+			Name: "Deploying continuous profiling server",
+			Fn:   parca.Deploy,
+		})
+		_ = g.Add(flow.Task{
+			Name: "Deploying observability auth proxy",
+			Fn:   observabilityAuthProxy.Deploy,
+		})
+		_ = g.Add(flow.Task{
+			Name: "Deploying observability gateway",
+			Fn:   observabilityGateway.Deploy,
+		})
 		_ = g.Add(flow.Task{
 			Name: "Deploying monitoring components",
 			Fn:   monitoring.Deploy,
@@ -537,6 +566,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 			r.GardenNamespace,
 			loggingEnabled,
 			v1beta1constants.PriorityClassNameSeedSystem600,
+			gardenlethelper.GetLogForwardings(&r.Config),
 		)
 		if err != nil {
 			return err
@@ -560,6 +590,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 			seed.GetIngressFQDN("g-seed"),
 			globalMonitoringSecretSeed.Name,
 			wildCardSecretName,
+			gardenlethelper.GetMonitoringCustomizations(&r.Config),
 		)
 		if err != nil {
 			return err
@@ -29,8 +29,13 @@ import (
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/autonomy"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/metering"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/rollout"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/sharding"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
 )
@@ -43,9 +48,11 @@ func AddToManager(
 	seedCluster cluster.Cluster,
 	seedClientSet kubernetes.Interface,
 	shootClientMap clientmap.ClientMap,
+	shootRateLimiters *ratelimiter.Registry,
 	cfg config.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	gardenClusterIdentity string,
+	autonomyTracker *autonomy.Tracker,
 ) error {
 	var responsibleForUnmanagedSeed bool
 	if err := gardenCluster.GetAPIReader().Get(ctx, client.ObjectKey{Name: cfg.SeedConfig.Name, Namespace: v1beta1constants.GardenNamespace}, &seedmanagementv1alpha1.ManagedSeed{}); err != nil {
@@ -57,6 +64,24 @@ func AddToManager(
 	}
 	shootStateControllerEnabled := responsibleForUnmanagedSeed && pointer.IntDeref(cfg.Controllers.ShootState.ConcurrentSyncs, 0) > 0
 
+	var shard *sharding.Shard
+	if cfg.Controllers.Shoot.Sharding != nil && cfg.Controllers.Shoot.Sharding.Enabled {
+		shard = &sharding.Shard{
+			SeedClient:    seedCluster.GetClient(),
+			SeedAPIReader: seedCluster.GetAPIReader(),
+			GardenCache:   gardenCluster.GetCache(),
+			Namespace:     v1beta1constants.GardenNamespace,
+			Identity:      identity.ID,
+			SeedName:      cfg.SeedConfig.Name,
+			LeaseDuration: cfg.Controllers.Shoot.Sharding.LeaseDuration.Duration,
+			Log:           mgr.GetLogger().WithName("shoot-sharding"),
+		}
+
+		if err := mgr.Add(shard); err != nil {
+			return fmt.Errorf("failed adding shard: %w", err)
+		}
+	}
+
 	if err := (&shoot.Reconciler{
 		SeedClientSet:               seedClientSet,
 		ShootClientMap:              shootClientMap,
@@ -64,10 +89,20 @@ func AddToManager(
 		Identity:                    identity,
 		GardenClusterIdentity:       gardenClusterIdentity,
 		ShootStateControllerEnabled: shootStateControllerEnabled,
+		Shard:                       shard,
+		Autonomy:                    autonomyTracker,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
 
+	var loadShedding *care.LoadShedding
+	if cfg := cfg.ShootClientConnection.AdaptiveRateLimiting; shootRateLimiters != nil && cfg != nil && cfg.LoadShedding != nil && cfg.LoadShedding.Enabled {
+		loadShedding = &care.LoadShedding{
+			RateLimiters: shootRateLimiters,
+			DeferPeriod:  cfg.LoadShedding.DeferPeriod.Duration,
+		}
+	}
+
 	if err := (&care.Reconciler{
 		SeedClientSet:         seedClientSet,
 		ShootClientMap:        shootClientMap,
@@ -75,10 +110,27 @@ func AddToManager(
 		Identity:              identity,
 		GardenClusterIdentity: gardenClusterIdentity,
 		SeedName:              cfg.SeedConfig.Name,
+		Shard:                 shard,
+		LoadShedding:          loadShedding,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ShootMetering != nil {
+		if err := (&metering.Reconciler{
+			Config:   *cfg.Controllers.ShootMetering,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding metering reconciler: %w", err)
+		}
+	}
+
+	if err := (&rollout.Reconciler{
+		Config: *cfg.Controllers.Shoot,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding rollout reconciler: %w", err)
+	}
+
 	// If gardenlet is responsible for an unmanaged seed we want to add the state reconciler which performs periodic
 	// backups of shoot states (see GEP-22).
 	if shootStateControllerEnabled {
@@ -48,24 +48,42 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Recorder == nil {
+		r.Recorder = gardenCluster.GetEventRecorderFor(ControllerName + "-controller")
+	}
 
-	return builder.
+	options := controller.Options{
+		MaxConcurrentReconciles: pointer.IntDeref(r.Config.Controllers.ShootCare.ConcurrentSyncs, 0),
+		// if going into exponential backoff, wait at most the configured sync period
+		RateLimiter: workqueue.NewWithMaxWaitRateLimiter(workqueue.DefaultControllerRateLimiter(), r.Config.Controllers.ShootCare.SyncPeriod.Duration),
+	}
+	if r.Shard != nil {
+		// All gardenlet replicas check the health of the shoots assigned to their shard, hence the controller must not
+		// only run in the leader.
+		options.NeedLeaderElection = pointer.Bool(false)
+	}
+
+	b := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
-		WithOptions(controller.Options{
-			MaxConcurrentReconciles: pointer.IntDeref(r.Config.Controllers.ShootCare.ConcurrentSyncs, 0),
-			// if going into exponential backoff, wait at most the configured sync period
-			RateLimiter: workqueue.NewWithMaxWaitRateLimiter(workqueue.DefaultControllerRateLimiter(), r.Config.Controllers.ShootCare.SyncPeriod.Duration),
-		}).
+		WithOptions(options).
 		WatchesRawSource(
 			source.Kind(gardenCluster.GetCache(), &gardencorev1beta1.Shoot{}),
 			r.EventHandler(),
 			builder.WithPredicates(
 				predicateutils.SeedNamePredicate(r.SeedName, gardenerutils.GetShootSeedNames),
 				r.ShootPredicate(),
+				r.Shard.Predicate(),
 			),
-		).
-		Complete(r)
+		)
+
+	if r.Shard != nil {
+		// Shoots which are newly assigned to this shard have to be picked up since there is no regular watch event for
+		// them.
+		b = b.WatchesRawSource(&source.Channel{Source: r.Shard.Subscribe()}, r.EventHandler())
+	}
+
+	return b.Complete(r)
 }
 
 // RandomDurationWithMetaDuration is an alias for utils.RandomDurationWithMetaDuration.
@@ -101,6 +119,14 @@ func (r *Reconciler) EventHandler() handler.EventHandler {
 				Namespace: e.ObjectNew.GetNamespace(),
 			}})
 		},
+		GenericFunc: func(_ context.Context, e event.GenericEvent, q workqueue.RateLimitingInterface) {
+			// shoots newly assigned to this shard have been checked by their previous shard, hence spread their health
+			// checks across the sync period
+			q.AddAfter(reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      e.Object.GetName(),
+				Namespace: e.Object.GetNamespace(),
+			}}, RandomDurationWithMetaDuration(r.Config.Controllers.ShootCare.SyncPeriod))
+		},
 	}
 }
 
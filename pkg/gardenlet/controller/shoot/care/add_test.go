@@ -89,7 +89,12 @@ var _ = Describe("Add", func() {
 			hdlr.Delete(ctx, event.DeleteEvent{Object: shoot}, queue)
 		})
 
-		It("should not enqueue the object for Generic events", func() {
+		It("should enqueue the object for Generic events according to the calculated duration", func() {
+			DeferCleanup(test.WithVar(&RandomDurationWithMetaDuration, func(max *metav1.Duration) time.Duration {
+				return max.Duration
+			}))
+			queue.EXPECT().AddAfter(req, reconciler.Config.Controllers.ShootCare.SyncPeriod.Duration)
+
 			hdlr.Generic(ctx, event.GenericEvent{Object: shoot}, queue)
 		})
 	})
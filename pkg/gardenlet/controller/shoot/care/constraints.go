@@ -17,31 +17,43 @@ package care
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/resourcemanager"
 	"github.com/gardener/gardener/pkg/operation/botanist/matchers"
 	"github.com/gardener/gardener/pkg/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
 const (
@@ -79,6 +91,7 @@ type Constraint struct {
 	seedClient             client.Client
 	initializeShootClients ShootClientInit
 	shootClient            client.Client
+	shootRESTClient        rest.Interface
 
 	log   logr.Logger
 	clock clock.Clock
@@ -150,6 +163,7 @@ func (c *Constraint) constraintsChecks(
 		)
 	}
 	c.shootClient = shootClient.Client()
+	c.shootRESTClient = shootClient.RESTClient()
 
 	status, reason, message, errorCodes, err = c.CheckForProblematicWebhooks(ctx)
 	if err != nil {
@@ -167,10 +181,45 @@ func (c *Constraint) constraintsChecks(
 		constraints.crdsWithProblematicConversionWebhooks = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.crdsWithProblematicConversionWebhooks, status, reason, message)
 	}
 
-	return filterOptionalConstraints(
+	out := filterOptionalConstraints(
 		[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
 		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks},
 	)
+
+	if !c.shoot.IsWorkerless {
+		status, reason, message, err = c.CheckPodDisruptionBudgets(ctx)
+		if err != nil {
+			constraints.podDisruptionBudgetsAllowNodeDrain = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.podDisruptionBudgetsAllowNodeDrain, err)
+		} else {
+			constraints.podDisruptionBudgetsAllowNodeDrain = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.podDisruptionBudgetsAllowNodeDrain, status, reason, message)
+		}
+
+		// The constraint is only reported if there is something to report, i.e., also in case there are only workloads
+		// without PodDisruptionBudgets.
+		if constraints.podDisruptionBudgetsAllowNodeDrain.Status != gardencorev1beta1.ConditionTrue || constraints.podDisruptionBudgetsAllowNodeDrain.Reason == reasonWorkloadsWithoutPodDisruptionBudget {
+			out = append(out, constraints.podDisruptionBudgetsAllowNodeDrain)
+		}
+	}
+
+	// The upgrade pre-flight constraint is only reported as long as the CloudProfile offers an upgrade to the next
+	// minor version.
+	targetVersion := c.upgradeTargetVersion()
+	if targetVersion == nil {
+		return out
+	}
+
+	if c.upgradePreflightChecksDue(constraints.upgradePreflightChecksPassed, targetVersion) {
+		status, reason, message, err = c.CheckUpgradePreflight(ctx, targetVersion)
+		if err != nil {
+			constraints.upgradePreflightChecksPassed = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.upgradePreflightChecksPassed, err)
+		} else {
+			constraints.upgradePreflightChecksPassed = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.upgradePreflightChecksPassed, status, reason, message)
+		}
+		// The last update time is also bumped if the result did not change in order to throttle the next run.
+		constraints.upgradePreflightChecksPassed.LastUpdateTime = metav1.NewTime(c.clock.Now())
+	}
+
+	return append(out, constraints.upgradePreflightChecksPassed)
 }
 
 var (
@@ -280,6 +329,388 @@ func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx con
 		nil
 }
 
+const (
+	reasonUpgradePreflightBlockingFindings = "UpgradePreflightBlockingFindings"
+	reasonUpgradePreflightWarnings         = "UpgradePreflightWarnings"
+	reasonUpgradePreflightNoFindings       = "UpgradePreflightNoFindings"
+
+	// upgradePreflightChecksInterval is the minimum duration between two runs of the upgrade pre-flight checks. The
+	// checks fetch the metrics of the shoot's kube-apiserver and list several resources in the shoot, hence they are
+	// not run with every care sync.
+	upgradePreflightChecksInterval = 30 * time.Minute
+)
+
+// upgradeTargetVersion returns the latest version of the next Kubernetes minor version which is offered by the
+// CloudProfile and not yet expired, or nil if there is no such version.
+func (c *Constraint) upgradeTargetVersion() *semver.Version {
+	if c.shoot.KubernetesVersion == nil || c.shoot.CloudProfile == nil {
+		return nil
+	}
+
+	var targetVersion *semver.Version
+	for _, version := range c.shoot.CloudProfile.Spec.Kubernetes.Versions {
+		if version.ExpirationDate != nil && version.ExpirationDate.Time.Before(c.clock.Now()) {
+			continue
+		}
+
+		v, err := semver.NewVersion(version.Version)
+		if err != nil || v.Major() != c.shoot.KubernetesVersion.Major() || v.Minor() != c.shoot.KubernetesVersion.Minor()+1 {
+			continue
+		}
+
+		if targetVersion == nil || v.GreaterThan(targetVersion) {
+			targetVersion = v
+		}
+	}
+
+	return targetVersion
+}
+
+// upgradePreflightChecksDue returns true if the upgrade pre-flight checks have not been run successfully for the given
+// target version within the last upgradePreflightChecksInterval.
+func (c *Constraint) upgradePreflightChecksDue(constraint gardencorev1beta1.Condition, targetVersion *semver.Version) bool {
+	return constraint.Status == gardencorev1beta1.ConditionUnknown ||
+		!strings.Contains(constraint.Message, "Kubernetes "+minorVersion(targetVersion)) ||
+		c.clock.Since(constraint.LastUpdateTime.Time) >= upgradePreflightChecksInterval
+}
+
+func minorVersion(version *semver.Version) string {
+	return fmt.Sprintf("%d.%d", version.Major(), version.Minor())
+}
+
+// CheckUpgradePreflight runs the pre-flight checks for an upgrade of the shoot to the given Kubernetes version of the
+// next minor version. Findings which will break workload after the upgrade (e.g., usage of APIs which are removed in
+// the next minor version) are blocking, i.e., the constraint is reported as 'False' and minor version upgrades are
+// denied unless the shoot is annotated with 'shoot.gardener.cloud/skip-upgrade-preflight-checks=true'. Other findings
+// are reported as warnings only.
+func (c *Constraint) CheckUpgradePreflight(ctx context.Context, targetVersion *semver.Version) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	nextMinorVersion := minorVersion(targetVersion)
+
+	blockingFindings, err := c.findUsageOfAPIsRemovedInVersion(ctx, nextMinorVersion)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	blockingWebhookFindings, webhookWarnings, err := c.findWebhooksForAPIsRemovedInVersion(ctx, targetVersion)
+	if err != nil {
+		return "", "", "", err
+	}
+	blockingFindings = append(blockingFindings, blockingWebhookFindings...)
+	blockingFindings = append(blockingFindings, c.findMachineImagesNotSupportingVersion(targetVersion)...)
+
+	warnings, err := c.findPodDisruptionBudgetsNotAllowingDisruptions(ctx)
+	if err != nil {
+		return "", "", "", err
+	}
+	warnings = append(webhookWarnings, warnings...)
+
+	var messages []string
+	if len(blockingFindings) > 0 {
+		messages = append(messages, fmt.Sprintf("Blocking findings for upgrade to Kubernetes %s: %s.", nextMinorVersion, strings.Join(blockingFindings, "; ")))
+	}
+	if len(warnings) > 0 {
+		messages = append(messages, fmt.Sprintf("Warnings for upgrade to Kubernetes %s: %s.", nextMinorVersion, strings.Join(warnings, "; ")))
+	}
+
+	switch {
+	case len(blockingFindings) > 0:
+		return gardencorev1beta1.ConditionFalse, reasonUpgradePreflightBlockingFindings, strings.Join(messages, " "), nil
+	case len(warnings) > 0:
+		return gardencorev1beta1.ConditionTrue, reasonUpgradePreflightWarnings, strings.Join(messages, " "), nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		reasonUpgradePreflightNoFindings,
+		fmt.Sprintf("No findings for upgrade to Kubernetes %s.", nextMinorVersion),
+		nil
+}
+
+// findUsageOfAPIsRemovedInVersion evaluates the metrics of the shoot's kube-apiserver and returns the deprecated APIs
+// which have been requested and which are removed in the given (or an earlier) version.
+func (c *Constraint) findUsageOfAPIsRemovedInVersion(ctx context.Context, version string) ([]string, error) {
+	usages, err := FetchDeprecatedAPIUsages(ctx, c.shootRESTClient)
+	if err != nil {
+		return nil, err
+	}
+
+	removedAPIs := sets.New[string]()
+	for _, usage := range usages {
+		if usage.RemovedRelease == "" {
+			continue
+		}
+
+		removed, err := versionutils.CompareVersions(usage.RemovedRelease, "<=", version)
+		if err != nil {
+			return nil, fmt.Errorf("could not compare removed release %q of deprecated API: %w", usage.RemovedRelease, err)
+		}
+		if !removed {
+			continue
+		}
+
+		removedAPIs.Insert(fmt.Sprintf("%s is removed in %s but still in use", usage, usage.RemovedRelease))
+	}
+
+	return sets.List(removedAPIs), nil
+}
+
+// findWebhooksForAPIsRemovedInVersion returns the admission webhooks whose rules refer to APIs which are removed with
+// the upgrade to the given version. Webhooks with match policy 'Exact' are not called anymore for the respective
+// resources after the upgrade, hence they are blocking findings. Webhooks with match policy 'Equivalent' are still
+// called for requests to other versions of the resources, hence they are reported as warnings only.
+func (c *Constraint) findWebhooksForAPIsRemovedInVersion(ctx context.Context, targetVersion *semver.Version) ([]string, []string, error) {
+	removedAPIs := apisRemovedInVersion(c.shoot.KubernetesVersion, targetVersion)
+	if len(removedAPIs) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		blockingFindings = sets.New[string]()
+		warnings         = sets.New[string]()
+
+		check = func(kind, name string, matchPolicy *admissionregistrationv1.MatchPolicyType, rules []admissionregistrationv1.RuleWithOperations) {
+			for _, rule := range rules {
+				for _, gvr := range removedAPIsMatchingRule(removedAPIs, rule.Rule) {
+					if matchPolicy != nil && *matchPolicy == admissionregistrationv1.Exact {
+						blockingFindings.Insert(fmt.Sprintf("%s %s matches %s %s exactly which is removed in %s", kind, name, gvr.GroupVersion(), gvr.Resource, minorVersion(targetVersion)))
+					} else {
+						warnings.Insert(fmt.Sprintf("%s %s refers to %s %s which is removed in %s", kind, name, gvr.GroupVersion(), gvr.Resource, minorVersion(targetVersion)))
+					}
+				}
+			}
+		}
+	)
+
+	validatingWebhookConfigs := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := c.shootClient.List(ctx, validatingWebhookConfigs); err != nil {
+		return nil, nil, fmt.Errorf("could not list ValidatingWebhookConfigurations in the shoot: %w", err)
+	}
+	for _, config := range validatingWebhookConfigs.Items {
+		for _, webhook := range config.Webhooks {
+			check("ValidatingWebhookConfiguration", config.Name+"/"+webhook.Name, webhook.MatchPolicy, webhook.Rules)
+		}
+	}
+
+	mutatingWebhookConfigs := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := c.shootClient.List(ctx, mutatingWebhookConfigs); err != nil {
+		return nil, nil, fmt.Errorf("could not list MutatingWebhookConfigurations in the shoot: %w", err)
+	}
+	for _, config := range mutatingWebhookConfigs.Items {
+		for _, webhook := range config.Webhooks {
+			check("MutatingWebhookConfiguration", config.Name+"/"+webhook.Name, webhook.MatchPolicy, webhook.Rules)
+		}
+	}
+
+	return sets.List(blockingFindings), sets.List(warnings), nil
+}
+
+// apisRemovedInVersion returns the built-in APIs which are served by the current version but not anymore by the given
+// target version.
+func apisRemovedInVersion(currentVersion, targetVersion *semver.Version) sets.Set[schema.GroupVersionResource] {
+	removedAPIs := sets.New[schema.GroupVersionResource]()
+	for gvk, t := range kubernetes.ShootScheme.AllKnownTypes() {
+		obj, ok := reflect.New(t).Interface().(interface{ APILifecycleRemoved() (int, int) })
+		if !ok || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+
+		major, minor := obj.APILifecycleRemoved()
+		if uint64(major) != targetVersion.Major() || uint64(minor) <= currentVersion.Minor() || uint64(minor) > targetVersion.Minor() {
+			continue
+		}
+
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		removedAPIs.Insert(gvr)
+	}
+	return removedAPIs
+}
+
+// removedAPIsMatchingRule returns the given removed APIs which are explicitly matched by the given rule. Wildcards for
+// API groups and versions are not considered since such rules also match the successors of the removed APIs.
+func removedAPIsMatchingRule(removedAPIs sets.Set[schema.GroupVersionResource], rule admissionregistrationv1.Rule) []schema.GroupVersionResource {
+	var out []schema.GroupVersionResource
+	for _, gvr := range removedAPIs.UnsortedList() {
+		if !slices.Contains(rule.APIGroups, gvr.Group) || !slices.Contains(rule.APIVersions, gvr.Version) {
+			continue
+		}
+
+		for _, resource := range rule.Resources {
+			if resource = strings.Split(resource, "/")[0]; resource == "*" || resource == gvr.Resource {
+				out = append(out, gvr)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// findMachineImagesNotSupportingVersion returns the machine image versions of the worker pools which do not support
+// the given kubelet version according to the CloudProfile. Worker pools with an explicit Kubernetes version are not
+// considered since they are not updated together with the control plane.
+func (c *Constraint) findMachineImagesNotSupportingVersion(targetVersion *semver.Version) []string {
+	var findings []string
+	for _, worker := range c.shoot.GetInfo().Spec.Provider.Workers {
+		if worker.Machine.Image == nil || worker.Machine.Image.Version == nil || (worker.Kubernetes != nil && worker.Kubernetes.Version != nil) {
+			continue
+		}
+
+		machineImageVersion, ok := v1beta1helper.FindMachineImageVersion(c.shoot.CloudProfile.Spec.MachineImages, worker.Machine.Image.Name, *worker.Machine.Image.Version)
+		if !ok || machineImageVersion.KubeletVersionConstraint == nil {
+			continue
+		}
+
+		constraint, err := semver.NewConstraint(*machineImageVersion.KubeletVersionConstraint)
+		if err != nil || constraint.Check(targetVersion) {
+			continue
+		}
+
+		findings = append(findings, fmt.Sprintf("machine image %s@%s of worker pool %s does not support Kubernetes %s (supported kubelet versions: %s)",
+			worker.Machine.Image.Name, *worker.Machine.Image.Version, worker.Name, targetVersion, *machineImageVersion.KubeletVersionConstraint))
+	}
+	return findings
+}
+
+// findPodDisruptionBudgetsNotAllowingDisruptions returns the PodDisruptionBudgets which currently do not allow any
+// disruption. Such PodDisruptionBudgets prevent the nodes from being drained during the rolling update of the worker
+// pools.
+func (c *Constraint) findPodDisruptionBudgetsNotAllowingDisruptions(ctx context.Context) ([]string, error) {
+	pdbList := &policyv1.PodDisruptionBudgetList{}
+	if err := c.shootClient.List(ctx, pdbList); err != nil {
+		return nil, fmt.Errorf("could not list PodDisruptionBudgets in the shoot: %w", err)
+	}
+
+	var findings []string
+	for _, pdb := range pdbList.Items {
+		if pdb.Status.ExpectedPods > 0 && pdb.Status.DisruptionsAllowed == 0 {
+			findings = append(findings, fmt.Sprintf("PodDisruptionBudget %s does not allow any disruption and might block the drain of nodes", client.ObjectKeyFromObject(&pdb)))
+		}
+	}
+
+	return findings, nil
+}
+
+const (
+	reasonPodDisruptionBudgetsBlockNodeDrain     = "PodDisruptionBudgetsBlockNodeDrain"
+	reasonWorkloadsWithoutPodDisruptionBudget    = "WorkloadsWithoutPodDisruptionBudget"
+	reasonNoPodDisruptionBudgetMisconfigurations = "NoPodDisruptionBudgetMisconfigurations"
+)
+
+// CheckPodDisruptionBudgets checks the PodDisruptionBudgets in the shoot for misconfigurations. PodDisruptionBudgets
+// which never allow the eviction of any of their pods (e.g., 'maxUnavailable: 0' or 'minAvailable: 1' for a single
+// replica) block the drain of nodes forever, hence the constraint is reported as 'False' and maintenance operations
+// rolling the nodes are postponed. Workloads in the 'kube-system' namespace which are not covered by any
+// PodDisruptionBudget are reported as warnings only.
+func (c *Constraint) CheckPodDisruptionBudgets(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	pdbList := &policyv1.PodDisruptionBudgetList{}
+	if err := c.shootClient.List(ctx, pdbList); err != nil {
+		return "", "", "", fmt.Errorf("could not list PodDisruptionBudgets in the shoot: %w", err)
+	}
+
+	var blockingPDBs []string
+	for _, pdb := range pdbList.Items {
+		if podDisruptionBudgetBlocksNodeDrain(&pdb) {
+			blockingPDBs = append(blockingPDBs, fmt.Sprintf("%s never allows the eviction of any of its %d pod(s)", client.ObjectKeyFromObject(&pdb), pdb.Status.ExpectedPods))
+		}
+	}
+
+	workloadsWithoutPDB, err := c.findSystemWorkloadsWithoutPodDisruptionBudget(ctx, pdbList.Items)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var messages []string
+	if len(blockingPDBs) > 0 {
+		messages = append(messages, fmt.Sprintf("PodDisruptionBudgets blocking the drain of nodes: %s.", strings.Join(blockingPDBs, "; ")))
+	}
+	if len(workloadsWithoutPDB) > 0 {
+		messages = append(messages, fmt.Sprintf("Workloads in namespace %s without PodDisruptionBudget: %s.", metav1.NamespaceSystem, strings.Join(workloadsWithoutPDB, ", ")))
+	}
+
+	switch {
+	case len(blockingPDBs) > 0:
+		return gardencorev1beta1.ConditionFalse, reasonPodDisruptionBudgetsBlockNodeDrain, strings.Join(messages, " "), nil
+	case len(workloadsWithoutPDB) > 0:
+		return gardencorev1beta1.ConditionTrue, reasonWorkloadsWithoutPodDisruptionBudget, strings.Join(messages, " "), nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		reasonNoPodDisruptionBudgetMisconfigurations,
+		"No PodDisruptionBudgets block the drain of nodes.",
+		nil
+}
+
+// podDisruptionBudgetBlocksNodeDrain returns true if the given PodDisruptionBudget never allows the eviction of any of
+// its pods, independent of whether they are healthy or not.
+func podDisruptionBudgetBlocksNodeDrain(pdb *policyv1.PodDisruptionBudget) bool {
+	expectedPods := int(pdb.Status.ExpectedPods)
+	if expectedPods == 0 {
+		return false
+	}
+
+	if pdb.Spec.MaxUnavailable != nil {
+		maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MaxUnavailable, expectedPods, true)
+		return err == nil && maxUnavailable <= 0
+	}
+
+	if pdb.Spec.MinAvailable != nil {
+		minAvailable, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MinAvailable, expectedPods, true)
+		return err == nil && minAvailable >= expectedPods
+	}
+
+	return false
+}
+
+// findSystemWorkloadsWithoutPodDisruptionBudget returns the Deployments and StatefulSets in the 'kube-system' namespace
+// whose pods are not covered by any of the given PodDisruptionBudgets. Workloads managed by Gardener are not considered.
+func (c *Constraint) findSystemWorkloadsWithoutPodDisruptionBudget(ctx context.Context, pdbs []policyv1.PodDisruptionBudget) ([]string, error) {
+	var selectors []labels.Selector
+	for _, pdb := range pdbs {
+		if pdb.Namespace != metav1.NamespaceSystem || pdb.Spec.Selector == nil {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse selector of PodDisruptionBudget %s: %w", client.ObjectKeyFromObject(&pdb), err)
+		}
+		selectors = append(selectors, selector)
+	}
+
+	isCovered := func(podLabels map[string]string) bool {
+		for _, selector := range selectors {
+			if !selector.Empty() && selector.Matches(labels.Set(podLabels)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	notManagedByGardenerSelector := client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(notManagedByGardener)}
+
+	deploymentList := &appsv1.DeploymentList{}
+	if err := c.shootClient.List(ctx, deploymentList, client.InNamespace(metav1.NamespaceSystem), notManagedByGardenerSelector); err != nil {
+		return nil, fmt.Errorf("could not list Deployments in the shoot: %w", err)
+	}
+
+	statefulSetList := &appsv1.StatefulSetList{}
+	if err := c.shootClient.List(ctx, statefulSetList, client.InNamespace(metav1.NamespaceSystem), notManagedByGardenerSelector); err != nil {
+		return nil, fmt.Errorf("could not list StatefulSets in the shoot: %w", err)
+	}
+
+	var findings []string
+	for _, deployment := range deploymentList.Items {
+		if pointer.Int32Deref(deployment.Spec.Replicas, 1) > 0 && !isCovered(deployment.Spec.Template.Labels) {
+			findings = append(findings, "Deployment "+deployment.Name)
+		}
+	}
+	for _, statefulSet := range statefulSetList.Items {
+		if pointer.Int32Deref(statefulSet.Spec.Replicas, 1) > 0 && !isCovered(statefulSet.Spec.Template.Labels) {
+			findings = append(findings, "StatefulSet "+statefulSet.Name)
+		}
+	}
+
+	return findings, nil
+}
+
 // CheckForProblematicWebhooks checks the Shoot for problematic webhooks which could prevent shoot worker nodes from
 // joining the cluster.
 func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, []gardencorev1beta1.ErrorCode, error) {
@@ -435,6 +866,8 @@ type ShootConstraints struct {
 	maintenancePreconditionsSatisfied     gardencorev1beta1.Condition
 	caCertificateValiditiesAcceptable     gardencorev1beta1.Condition
 	crdsWithProblematicConversionWebhooks gardencorev1beta1.Condition
+	upgradePreflightChecksPassed          gardencorev1beta1.Condition
+	podDisruptionBudgetsAllowNodeDrain    gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot constraints as a slice.
@@ -444,6 +877,8 @@ func (g ShootConstraints) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.maintenancePreconditionsSatisfied,
 		g.caCertificateValiditiesAcceptable,
 		g.crdsWithProblematicConversionWebhooks,
+		g.upgradePreflightChecksPassed,
+		g.podDisruptionBudgetsAllowNodeDrain,
 	}
 }
 
@@ -454,6 +889,8 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 		g.maintenancePreconditionsSatisfied.Type,
 		g.caCertificateValiditiesAcceptable.Type,
 		g.crdsWithProblematicConversionWebhooks.Type,
+		g.upgradePreflightChecksPassed.Type,
+		g.podDisruptionBudgetsAllowNodeDrain.Type,
 	}
 }
 
@@ -461,9 +898,11 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 // All constraints are retrieved from the given 'shoot' or newly initialized.
 func NewShootConstraints(clock clock.Clock, shoot *gardencorev1beta1.Shoot) ShootConstraints {
 	return ShootConstraints{
-		hibernationPossible:                   v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootHibernationPossible),
-		maintenancePreconditionsSatisfied:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootMaintenancePreconditionsSatisfied),
-		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
-		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
+		hibernationPossible:                   v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootHibernationPossible),
+		maintenancePreconditionsSatisfied:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootMaintenancePreconditionsSatisfied),
+		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
+		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
+		upgradePreflightChecksPassed:          v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+		podDisruptionBudgetsAllowNodeDrain:    v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain),
 	}
 }
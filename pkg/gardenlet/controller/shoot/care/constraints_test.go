@@ -17,12 +17,17 @@ package care_test
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	appsv1beta1 "k8s.io/api/apps/v1beta1"
@@ -34,6 +39,7 @@ import (
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	policyv1 "k8s.io/api/policy/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	rbacv1alpha1 "k8s.io/api/rbac/v1alpha1"
@@ -45,10 +51,14 @@ import (
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	fakerestclient "k8s.io/client-go/rest/fake"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -574,6 +584,317 @@ var _ = Describe("Constraints", func() {
 					WithMessage(fmt.Sprintf("Some CRDs in your cluster have multiple stored versions present and have a conversion webhook configured: %s.", crd1.Name)),
 				))
 			})
+
+			It("should not check the upgrade pre-flight constraint when the Kubernetes version is unknown", func() {
+				Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+					OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+				))
+			})
+
+			Context("upgrade pre-flight checks", func() {
+				var (
+					fakeRESTClient *fakerestclient.RESTClient
+					operationShoot *shootpkg.Shoot
+
+					metricsResponse = func(metrics string) *http.Response {
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       io.NopCloser(strings.NewReader(metrics)),
+						}
+					}
+				)
+
+				BeforeEach(func() {
+					fakeRESTClient = &fakerestclient.RESTClient{
+						NegotiatedSerializer: serializer.NewCodecFactory(kubernetes.ShootScheme).WithoutConversion(),
+						Resp:                 metricsResponse(""),
+					}
+
+					operationShoot = &shootpkg.Shoot{
+						SeedNamespace:     seedNamespace,
+						KubernetesVersion: semver.MustParse("1.25.4"),
+						CloudProfile: &gardencorev1beta1.CloudProfile{
+							Spec: gardencorev1beta1.CloudProfileSpec{
+								Kubernetes: gardencorev1beta1.KubernetesSettings{
+									Versions: []gardencorev1beta1.ExpirableVersion{
+										{Version: "1.25.4"},
+										{Version: "1.26.1"},
+										{Version: "1.26.2", ExpirationDate: &metav1.Time{Time: now.Add(-time.Hour)}},
+										{Version: "1.27.0"},
+									},
+								},
+								MachineImages: []gardencorev1beta1.MachineImage{{
+									Name: "image",
+									Versions: []gardencorev1beta1.MachineImageVersion{
+										{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}, KubeletVersionConstraint: pointer.String("< 1.26")},
+										{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "2.0.0"}, KubeletVersionConstraint: pointer.String(">= 1.25")},
+									},
+								}},
+							},
+						},
+					}
+					operationShoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+					constraint = NewConstraint(
+						logr.Discard(),
+						operationShoot,
+						seedClient,
+						func() (kubernetes.Interface, bool, error) {
+							return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).WithRESTClient(fakeRESTClient).Build(), true, nil
+						},
+						clock,
+					)
+				})
+
+				It("should report the constraint when there are no findings", func() {
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+						WithStatus(gardencorev1beta1.ConditionTrue),
+						WithReason("UpgradePreflightNoFindings"),
+						WithMessage("No findings for upgrade to Kubernetes 1.26."),
+					))
+				})
+
+				It("should not run the checks when the CloudProfile does not offer the next minor version", func() {
+					fakeRESTClient.Err = fmt.Errorf("fake err")
+					operationShoot.CloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.25.4"}, {Version: "1.27.0"}}
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+					))
+				})
+
+				DescribeTable("should throttle the checks",
+					func(lastUpdateTime time.Time, message string, matcher gomegatypes.GomegaMatcher) {
+						fakeRESTClient.Err = fmt.Errorf("fake err")
+						constraints := NewShootConstraints(clock, &gardencorev1beta1.Shoot{
+							Status: gardencorev1beta1.ShootStatus{
+								Constraints: []gardencorev1beta1.Condition{{
+									Type:           gardencorev1beta1.ShootUpgradePreflightChecksPassed,
+									Status:         gardencorev1beta1.ConditionTrue,
+									Reason:         "UpgradePreflightNoFindings",
+									Message:        message,
+									LastUpdateTime: metav1.Time{Time: lastUpdateTime},
+								}},
+							},
+						})
+
+						Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+							OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+							matcher,
+						))
+					},
+
+					Entry("not run the checks again within the interval", now.Add(-10*time.Minute), "No findings for upgrade to Kubernetes 1.26.", WithStatus(gardencorev1beta1.ConditionTrue)),
+					Entry("run the checks again after the interval", now.Add(-30*time.Minute), "No findings for upgrade to Kubernetes 1.26.", WithStatus(gardencorev1beta1.ConditionUnknown)),
+					Entry("run the checks again for another target version", now.Add(-10*time.Minute), "No findings for upgrade to Kubernetes 1.25.", WithStatus(gardencorev1beta1.ConditionUnknown)),
+				)
+
+				It("should report APIs removed in the next minor version as blocking findings", func() {
+					fakeRESTClient.Resp = metricsResponse(`# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="autoscaling",removed_release="1.26",resource="horizontalpodautoscalers",subresource="status",version="v2beta2"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.29",resource="flowschemas",subresource="",version="v1beta2"} 1
+`)
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("UpgradePreflightBlockingFindings"),
+						WithMessage("Blocking findings for upgrade to Kubernetes 1.26: autoscaling/v2beta2 horizontalpodautoscalers/status is removed in 1.26 but still in use; policy/v1beta1 podsecuritypolicies is removed in 1.25 but still in use."),
+					))
+				})
+
+				It("should report webhooks for APIs removed in the next minor version", func() {
+					exact, equivalent := admissionregistrationv1.Exact, admissionregistrationv1.Equivalent
+					Expect(shootClient.Create(ctx, &admissionregistrationv1.ValidatingWebhookConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Name: "validating"},
+						Webhooks: []admissionregistrationv1.ValidatingWebhook{
+							{
+								Name:        "exact",
+								MatchPolicy: &exact,
+								Rules: []admissionregistrationv1.RuleWithOperations{{Rule: admissionregistrationv1.Rule{
+									APIGroups:   []string{"autoscaling"},
+									APIVersions: []string{"v2", "v2beta2"},
+									Resources:   []string{"horizontalpodautoscalers"},
+								}}},
+							},
+							{
+								Name:        "wildcard",
+								MatchPolicy: &exact,
+								Rules: []admissionregistrationv1.RuleWithOperations{{Rule: admissionregistrationv1.Rule{
+									APIGroups:   []string{"*"},
+									APIVersions: []string{"*"},
+									Resources:   []string{"*"},
+								}}},
+							},
+						},
+					})).To(Succeed())
+					Expect(shootClient.Create(ctx, &admissionregistrationv1.MutatingWebhookConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Name: "mutating"},
+						Webhooks: []admissionregistrationv1.MutatingWebhook{{
+							Name:        "equivalent",
+							MatchPolicy: &equivalent,
+							Rules: []admissionregistrationv1.RuleWithOperations{{Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{"flowcontrol.apiserver.k8s.io"},
+								APIVersions: []string{"v1beta1"},
+								Resources:   []string{"*/status"},
+							}}},
+						}},
+					})).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("UpgradePreflightBlockingFindings"),
+						WithMessage("Blocking findings for upgrade to Kubernetes 1.26: ValidatingWebhookConfiguration validating/exact matches autoscaling/v2beta2 horizontalpodautoscalers exactly which is removed in 1.26. "+
+							"Warnings for upgrade to Kubernetes 1.26: MutatingWebhookConfiguration mutating/equivalent refers to flowcontrol.apiserver.k8s.io/v1beta1 flowschemas which is removed in 1.26; "+
+							"MutatingWebhookConfiguration mutating/equivalent refers to flowcontrol.apiserver.k8s.io/v1beta1 prioritylevelconfigurations which is removed in 1.26."),
+					))
+				})
+
+				It("should report machine images not supporting the next minor version as blocking findings", func() {
+					operationShoot.SetInfo(&gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Provider: gardencorev1beta1.Provider{
+								Workers: []gardencorev1beta1.Worker{
+									{Name: "old", Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "image", Version: pointer.String("1.0.0")}}},
+									{Name: "new", Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "image", Version: pointer.String("2.0.0")}}},
+									{
+										Name:       "pinned",
+										Machine:    gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "image", Version: pointer.String("1.0.0")}},
+										Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: pointer.String("1.25.4")},
+									},
+								},
+							},
+						},
+					})
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("UpgradePreflightBlockingFindings"),
+						WithMessage("Blocking findings for upgrade to Kubernetes 1.26: machine image image@1.0.0 of worker pool old does not support Kubernetes 1.26.1 (supported kubelet versions: < 1.26)."),
+					))
+				})
+
+				It("should report PodDisruptionBudgets not allowing disruptions as warnings", func() {
+					pdb := &policyv1.PodDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{Name: "pdb", Namespace: "default"},
+						Status:     policyv1.PodDisruptionBudgetStatus{ExpectedPods: 1},
+					}
+					Expect(shootClient.Create(ctx, pdb)).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+						WithStatus(gardencorev1beta1.ConditionTrue),
+						WithReason("UpgradePreflightWarnings"),
+						WithMessage("Warnings for upgrade to Kubernetes 1.26: PodDisruptionBudget default/pdb does not allow any disruption and might block the drain of nodes."),
+					))
+				})
+
+				It("should report an unknown constraint when the metrics cannot be fetched", func() {
+					fakeRESTClient.Err = fmt.Errorf("fake err")
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+						WithStatus(gardencorev1beta1.ConditionUnknown),
+						WithMessage("could not fetch metrics of the shoot's kube-apiserver"),
+					))
+				})
+			})
+
+			Context("PodDisruptionBudget checks", func() {
+				intOrString := func(v intstr.IntOrString) *intstr.IntOrString { return &v }
+
+				newPDB := func(namespace, name string, expectedPods int32, minAvailable, maxUnavailable *intstr.IntOrString) *policyv1.PodDisruptionBudget {
+					return &policyv1.PodDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+						Spec: policyv1.PodDisruptionBudgetSpec{
+							MinAvailable:   minAvailable,
+							MaxUnavailable: maxUnavailable,
+							Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+						},
+						Status: policyv1.PodDisruptionBudgetStatus{ExpectedPods: expectedPods},
+					}
+				}
+
+				newDeployment := func(name string, labels map[string]string) *appsv1.Deployment {
+					return &appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kube-system", Labels: labels},
+						Spec: appsv1.DeploymentSpec{
+							Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": name}}},
+						},
+					}
+				}
+
+				It("should not keep the constraint when there are no misconfigurations", func() {
+					Expect(shootClient.Create(ctx, newPDB("default", "foo", 3, nil, intOrString(intstr.FromInt32(1))))).To(Succeed())
+					Expect(shootClient.Create(ctx, newPDB("default", "bar", 1, intOrString(intstr.FromInt32(0)), nil))).To(Succeed())
+					Expect(shootClient.Create(ctx, newPDB("default", "baz", 0, nil, intOrString(intstr.FromInt32(0))))).To(Succeed())
+					Expect(shootClient.Create(ctx, newPDB("kube-system", "system-workload", 2, nil, intOrString(intstr.FromInt32(1))))).To(Succeed())
+					Expect(shootClient.Create(ctx, newDeployment("system-workload", nil))).To(Succeed())
+					Expect(shootClient.Create(ctx, newDeployment("gardener-workload", map[string]string{"resources.gardener.cloud/managed-by": "gardener"}))).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain),
+					))
+				})
+
+				It("should report PodDisruptionBudgets never allowing any eviction as blocking", func() {
+					Expect(shootClient.Create(ctx, newPDB("default", "max-unavailable", 3, nil, intOrString(intstr.FromString("0%"))))).To(Succeed())
+					Expect(shootClient.Create(ctx, newPDB("default", "min-available", 1, intOrString(intstr.FromInt32(1)), nil))).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("PodDisruptionBudgetsBlockNodeDrain"),
+						WithMessage("PodDisruptionBudgets blocking the drain of nodes: default/max-unavailable never allows the eviction of any of its 3 pod(s); default/min-available never allows the eviction of any of its 1 pod(s)."),
+					))
+				})
+
+				It("should report workloads in the kube-system namespace without PodDisruptionBudget as warnings", func() {
+					Expect(shootClient.Create(ctx, newDeployment("foo", nil))).To(Succeed())
+					Expect(shootClient.Create(ctx, &appsv1.StatefulSet{
+						ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "kube-system"},
+						Spec: appsv1.StatefulSetSpec{
+							Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "bar"}}},
+						},
+					})).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain),
+						WithStatus(gardencorev1beta1.ConditionTrue),
+						WithReason("WorkloadsWithoutPodDisruptionBudget"),
+						WithMessage("Workloads in namespace kube-system without PodDisruptionBudget: Deployment foo, StatefulSet bar."),
+					))
+				})
+
+				It("should not check the constraint for workerless shoots", func() {
+					Expect(shootClient.Create(ctx, newPDB("default", "max-unavailable", 3, nil, intOrString(intstr.FromInt32(0))))).To(Succeed())
+
+					shoot := &shootpkg.Shoot{
+						SeedNamespace: seedNamespace,
+						IsWorkerless:  true,
+					}
+					shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+					constraint = NewConstraint(
+						logr.Discard(),
+						shoot,
+						seedClient,
+						func() (kubernetes.Interface, bool, error) {
+							return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+						},
+						clock,
+					)
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain),
+					))
+				})
+			})
 		})
 
 		Describe("#CheckIfCACertificateValiditiesAcceptable", func() {
@@ -655,6 +976,8 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -673,6 +996,8 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -686,6 +1011,8 @@ var _ = Describe("Constraints", func() {
 					OfType("MaintenancePreconditionsSatisfied"),
 					OfType("CACertificateValiditiesAcceptable"),
 					OfType("CRDsWithProblematicConversionWebhooks"),
+					OfType("UpgradePreflightChecksPassed"),
+					OfType("PodDisruptionBudgetsAllowNodeDrain"),
 				))
 			})
 		})
@@ -699,6 +1026,8 @@ var _ = Describe("Constraints", func() {
 					gardencorev1beta1.ConditionType("MaintenancePreconditionsSatisfied"),
 					gardencorev1beta1.ConditionType("CACertificateValiditiesAcceptable"),
 					gardencorev1beta1.ConditionType("CRDsWithProblematicConversionWebhooks"),
+					gardencorev1beta1.ConditionType("UpgradePreflightChecksPassed"),
+					gardencorev1beta1.ConditionType("PodDisruptionBudgetsAllowNodeDrain"),
 				))
 			})
 		})
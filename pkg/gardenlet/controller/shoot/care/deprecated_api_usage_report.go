@@ -0,0 +1,183 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/deprecatedapicallers"
+)
+
+const (
+	// DeprecatedAPIUsageConfigMapName is the name of the ConfigMap in the kube-system namespace of the shoot cluster
+	// which contains the report about the usage of deprecated APIs.
+	DeprecatedAPIUsageConfigMapName = "gardener-deprecated-api-usage"
+	// DeprecatedAPIUsageConfigMapDataKey is the key in the data of the ConfigMap containing the report.
+	DeprecatedAPIUsageConfigMapDataKey = "usages.yaml"
+)
+
+// DeprecatedAPIUsageReport contains required information for reporting the usage of deprecated APIs in shoot
+// clusters.
+type DeprecatedAPIUsageReport struct {
+	log                    logr.Logger
+	gardenClient           client.Client
+	seedClient             client.Client
+	initializeShootClients ShootClientInit
+	shoot                  *gardencorev1beta1.Shoot
+}
+
+// NewDeprecatedAPIUsageReport creates a new instance for reporting the usage of deprecated APIs.
+func NewDeprecatedAPIUsageReport(log logr.Logger, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, shootClientInit ShootClientInit) *DeprecatedAPIUsageReport {
+	return &DeprecatedAPIUsageReport{
+		log:                    log,
+		gardenClient:           gardenClient,
+		seedClient:             seedClient,
+		initializeShootClients: shootClientInit,
+		shoot:                  shoot,
+	}
+}
+
+// Report determines the deprecated APIs which have been requested from the shoot's kube-apiserver together with their
+// callers aggregated by gardener-resource-manager (if any) and publishes them in a ConfigMap in the kube-system
+// namespace of the shoot cluster as well as in an annotation on the Shoot resource.
+func (r *DeprecatedAPIUsageReport) Report(ctx context.Context) error {
+	shootClient, apiServerRunning, err := r.initializeShootClients()
+	if err != nil {
+		return err
+	}
+	if !apiServerRunning {
+		return nil
+	}
+
+	usages, err := FetchDeprecatedAPIUsages(ctx, shootClient.RESTClient())
+	if err != nil {
+		r.log.Error(err, "Failed to determine usage of deprecated APIs")
+		return err
+	}
+	if usages == nil {
+		usages = []DeprecatedAPIUsage{}
+	}
+
+	apiCallers, err := r.fetchDeprecatedAPICallers(ctx)
+	if err != nil {
+		// The usages are still published without the callers since they are only additional information.
+		r.log.Error(err, "Failed to determine callers of deprecated APIs")
+	}
+	AddDeprecatedAPICallers(usages, apiCallers)
+
+	report, err := yaml.Marshal(usages)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: DeprecatedAPIUsageConfigMapName, Namespace: metav1.NamespaceSystem}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, shootClient.Client(), configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleSystemComponent)
+		configMap.Data = map[string]string{DeprecatedAPIUsageConfigMapDataKey: string(report)}
+		return nil
+	}); err != nil {
+		r.log.Error(err, "Failed to publish usage of deprecated APIs in shoot cluster")
+		return fmt.Errorf("failed to publish usage of deprecated APIs in shoot cluster: %w", err)
+	}
+
+	// Work on a copy since the shoot object is shared with the other care tasks running in parallel.
+	shoot := r.shoot.DeepCopy()
+	patch := client.MergeFrom(shoot.DeepCopy())
+
+	if len(usages) == 0 {
+		delete(shoot.Annotations, v1beta1constants.AnnotationShootDeprecatedAPIUsage)
+	} else {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootDeprecatedAPIUsage, deprecatedAPIUsageSummary(usages))
+	}
+
+	if err := r.gardenClient.Patch(ctx, shoot, patch); err != nil {
+		r.log.Error(err, "Failed to publish usage of deprecated APIs in Shoot resource")
+		return fmt.Errorf("failed to publish usage of deprecated APIs in Shoot resource: %w", err)
+	}
+
+	return nil
+}
+
+func (r *DeprecatedAPIUsageReport) fetchDeprecatedAPICallers(ctx context.Context) ([]deprecatedapicallers.APICallers, error) {
+	if r.shoot.Status.TechnicalID == "" {
+		return nil, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.seedClient.Get(ctx, client.ObjectKey{Name: deprecatedapicallers.ConfigMapName, Namespace: r.shoot.Status.TechnicalID}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var apiCallers []deprecatedapicallers.APICallers
+	if err := yaml.Unmarshal([]byte(configMap.Data[deprecatedapicallers.ConfigMapDataKey]), &apiCallers); err != nil {
+		return nil, fmt.Errorf("failed to parse callers of deprecated APIs: %w", err)
+	}
+	return apiCallers, nil
+}
+
+// maxCallersInSummary is the maximum number of callers per deprecated API mentioned in the Shoot annotation.
+const maxCallersInSummary = 3
+
+func deprecatedAPIUsageSummary(usages []DeprecatedAPIUsage) string {
+	out := make([]string, 0, len(usages))
+	for _, usage := range usages {
+		var details []string
+		if usage.RemovedRelease != "" {
+			details = append(details, "removed in "+usage.RemovedRelease)
+		}
+		if len(usage.Callers) > 0 {
+			details = append(details, "called by "+callersSummary(usage.Callers))
+		}
+
+		if len(details) == 0 {
+			out = append(out, usage.String())
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s (%s)", usage, strings.Join(details, "; ")))
+	}
+	return strings.Join(out, ", ")
+}
+
+func callersSummary(callers []deprecatedapicallers.Caller) string {
+	out := make([]string, 0, maxCallersInSummary+1)
+	for i, caller := range callers {
+		if i == maxCallersInSummary {
+			out = append(out, fmt.Sprintf("+%d more", len(callers)-maxCallersInSummary))
+			break
+		}
+
+		if caller.ServiceAccount != "" {
+			out = append(out, caller.ServiceAccount)
+			continue
+		}
+		out = append(out, caller.Username)
+	}
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,220 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	fakerestclient "k8s.io/client-go/rest/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("DeprecatedAPIUsageReport", func() {
+	var (
+		ctx = context.Background()
+
+		gardenClient   client.Client
+		seedClient     client.Client
+		shootClient    client.Client
+		fakeRESTClient *fakerestclient.RESTClient
+
+		shoot *gardencorev1beta1.Shoot
+
+		reporter *DeprecatedAPIUsageReport
+
+		metricsResponse = func(metrics string) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(metrics)),
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		fakeRESTClient = &fakerestclient.RESTClient{
+			NegotiatedSerializer: serializer.NewCodecFactory(kubernetes.ShootScheme).WithoutConversion(),
+			Resp:                 metricsResponse(""),
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--project--shoot"},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		reporter = NewDeprecatedAPIUsageReport(logr.Discard(), gardenClient, seedClient, shoot, func() (kubernetes.Interface, bool, error) {
+			return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).WithRESTClient(fakeRESTClient).Build(), true, nil
+		})
+	})
+
+	Describe("#Report", func() {
+		It("should publish the usage of deprecated APIs", func() {
+			fakeRESTClient.Resp = metricsResponse(`# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="autoscaling",removed_release="1.26",resource="horizontalpodautoscalers",subresource="status",version="v2beta2"} 1
+apiserver_requested_deprecated_apis{group="",removed_release="",resource="componentstatuses",subresource="",version="v1"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.29",resource="flowschemas",subresource="",version="v1beta2"} 0
+`)
+
+			Expect(reporter.Report(ctx)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Name: "gardener-deprecated-api-usage", Namespace: "kube-system"}, configMap)).To(Succeed())
+			Expect(configMap.Labels).To(HaveKeyWithValue("gardener.cloud/role", "system-component"))
+			Expect(configMap.Data).To(HaveKeyWithValue("usages.yaml", `- group: autoscaling
+  removedRelease: "1.26"
+  resource: horizontalpodautoscalers
+  subresource: status
+  version: v2beta2
+- group: policy
+  removedRelease: "1.25"
+  resource: podsecuritypolicies
+  version: v1beta1
+- resource: componentstatuses
+  version: v1
+`))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/deprecated-api-usage",
+				"autoscaling/v2beta2 horizontalpodautoscalers/status (removed in 1.26), policy/v1beta1 podsecuritypolicies (removed in 1.25), v1 componentstatuses"))
+		})
+
+		It("should publish the callers of deprecated APIs", func() {
+			fakeRESTClient.Resp = metricsResponse(`# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="",removed_release="",resource="componentstatuses",subresource="",version="v1"} 1
+`)
+			Expect(seedClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "deprecated-api-callers", Namespace: "shoot--project--shoot"},
+				Data: map[string]string{"callers.yaml": `- group: policy
+  version: v1beta1
+  resource: podsecuritypolicies
+  callers:
+  - username: system:serviceaccount:kube-system:psp-controller
+    serviceAccount: kube-system/psp-controller
+    userAgent: psp-controller/v1.0.0
+    count: 5
+  - username: alice
+    userAgent: kubectl/v1.24.0
+    count: 2
+  - username: bob
+    count: 1
+  - username: carol
+    count: 1
+  - username: dave
+    count: 1
+- group: autoscaling
+  version: v2beta2
+  resource: horizontalpodautoscalers
+  callers:
+  - username: alice
+    count: 1
+`},
+			})).To(Succeed())
+
+			Expect(reporter.Report(ctx)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Name: "gardener-deprecated-api-usage", Namespace: "kube-system"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("usages.yaml", `- callers:
+  - count: 5
+    serviceAccount: kube-system/psp-controller
+    userAgent: psp-controller/v1.0.0
+    username: system:serviceaccount:kube-system:psp-controller
+  - count: 2
+    userAgent: kubectl/v1.24.0
+    username: alice
+  - count: 1
+    username: bob
+  - count: 1
+    username: carol
+  - count: 1
+    username: dave
+  group: policy
+  removedRelease: "1.25"
+  resource: podsecuritypolicies
+  version: v1beta1
+- resource: componentstatuses
+  version: v1
+`))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/deprecated-api-usage",
+				"policy/v1beta1 podsecuritypolicies (removed in 1.25; called by kube-system/psp-controller, alice, bob, +2 more), v1 componentstatuses"))
+		})
+
+		It("should publish the usage of deprecated APIs without callers when they cannot be parsed", func() {
+			fakeRESTClient.Resp = metricsResponse(`# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="",removed_release="",resource="componentstatuses",subresource="",version="v1"} 1
+`)
+			Expect(seedClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "deprecated-api-callers", Namespace: "shoot--project--shoot"},
+				Data:       map[string]string{"callers.yaml": "{"},
+			})).To(Succeed())
+
+			Expect(reporter.Report(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/deprecated-api-usage", "v1 componentstatuses"))
+		})
+
+		It("should remove the annotation when no deprecated APIs are used anymore", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/deprecated-api-usage", "policy/v1beta1 podsecuritypolicies (removed in 1.25)")
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reporter.Report(ctx)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Name: "gardener-deprecated-api-usage", Namespace: "kube-system"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("usages.yaml", "[]\n"))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/deprecated-api-usage"))
+		})
+
+		It("should do nothing when the shoot's kube-apiserver is not running", func() {
+			reporter = NewDeprecatedAPIUsageReport(logr.Discard(), gardenClient, seedClient, shoot, func() (kubernetes.Interface, bool, error) {
+				return nil, false, nil
+			})
+
+			Expect(reporter.Report(ctx)).To(Succeed())
+		})
+
+		It("should return an error when the metrics cannot be fetched", func() {
+			fakeRESTClient.Err = fmt.Errorf("fake err")
+
+			Expect(reporter.Report(ctx)).To(MatchError(ContainSubstring("could not fetch metrics of the shoot's kube-apiserver")))
+		})
+	})
+})
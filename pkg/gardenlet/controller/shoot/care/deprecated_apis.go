@@ -0,0 +1,124 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/deprecatedapicallers"
+)
+
+// metricRequestedDeprecatedAPIs is the name of the metric exposed by kube-apiserver for requests to deprecated APIs.
+const metricRequestedDeprecatedAPIs = "apiserver_requested_deprecated_apis"
+
+// DeprecatedAPIUsage describes a deprecated API which has been requested from a kube-apiserver.
+type DeprecatedAPIUsage struct {
+	// Group is the API group of the deprecated API.
+	Group string `json:"group,omitempty"`
+	// Version is the API version of the deprecated API.
+	Version string `json:"version"`
+	// Resource is the requested resource.
+	Resource string `json:"resource"`
+	// Subresource is the requested subresource, if any.
+	Subresource string `json:"subresource,omitempty"`
+	// RemovedRelease is the Kubernetes release in which the API is removed. It is empty if the removal is not yet
+	// planned.
+	RemovedRelease string `json:"removedRelease,omitempty"`
+	// Callers are the clients which issued the most requests to the deprecated API. They are only known if the
+	// shoot's kube-apiserver reports its audit events to gardener-resource-manager.
+	Callers []deprecatedapicallers.Caller `json:"callers,omitempty"`
+}
+
+// String returns a human-readable representation of the deprecated API, e.g. 'policy/v1beta1 podsecuritypolicies'.
+func (d DeprecatedAPIUsage) String() string {
+	resource := d.Resource
+	if d.Subresource != "" {
+		resource += "/" + d.Subresource
+	}
+	return fmt.Sprintf("%s %s", schema.GroupVersion{Group: d.Group, Version: d.Version}, resource)
+}
+
+// FetchDeprecatedAPIUsages evaluates the metrics of the kube-apiserver reachable via the given REST client and returns
+// the deprecated APIs which have been requested, sorted by their string representation. Note that the metrics are
+// reset when kube-apiserver restarts, hence only requests since its last start are considered. The metrics do not
+// reveal the clients which issued the requests, see AddDeprecatedAPICallers.
+func FetchDeprecatedAPIUsages(ctx context.Context, restClient rest.Interface) ([]DeprecatedAPIUsage, error) {
+	raw, err := restClient.Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch metrics of the shoot's kube-apiserver: %w", err)
+	}
+
+	metricFamilies, err := (&expfmt.TextParser{}).TextToMetricFamilies(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse metrics of the shoot's kube-apiserver: %w", err)
+	}
+
+	metricFamily, ok := metricFamilies[metricRequestedDeprecatedAPIs]
+	if !ok {
+		return nil, nil
+	}
+
+	var usages []DeprecatedAPIUsage
+	for _, metric := range metricFamily.GetMetric() {
+		if metric.GetGauge().GetValue() == 0 {
+			continue
+		}
+
+		metricLabels := make(map[string]string, len(metric.GetLabel()))
+		for _, label := range metric.GetLabel() {
+			metricLabels[label.GetName()] = label.GetValue()
+		}
+
+		usages = append(usages, DeprecatedAPIUsage{
+			Group:          metricLabels["group"],
+			Version:        metricLabels["version"],
+			Resource:       metricLabels["resource"],
+			Subresource:    metricLabels["subresource"],
+			RemovedRelease: metricLabels["removed_release"],
+		})
+	}
+
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].String() < usages[j].String()
+	})
+
+	return usages, nil
+}
+
+// AddDeprecatedAPICallers adds the given callers to the deprecated API usages with the same group, version, resource,
+// and subresource. Callers of APIs without a usage are ignored since the metrics are authoritative for the APIs which
+// have been requested since the last start of kube-apiserver.
+func AddDeprecatedAPICallers(usages []DeprecatedAPIUsage, apiCallers []deprecatedapicallers.APICallers) {
+	callers := make(map[deprecatedapicallers.API][]deprecatedapicallers.Caller, len(apiCallers))
+	for _, c := range apiCallers {
+		callers[c.API] = c.Callers
+	}
+
+	for i, usage := range usages {
+		usages[i].Callers = callers[deprecatedapicallers.API{
+			Group:       usage.Group,
+			Version:     usage.Version,
+			Resource:    usage.Resource,
+			Subresource: usage.Subresource,
+		}]
+	}
+}
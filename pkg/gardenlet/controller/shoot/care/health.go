@@ -17,8 +17,11 @@ package care
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
 	coordinationv1 "k8s.io/api/coordination/v1"
@@ -40,6 +43,8 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/extensions"
@@ -129,6 +134,27 @@ func (h *Health) Check(
 		lastErrors = h.shoot.GetInfo().Status.LastErrors
 	)
 
+	if v1beta1helper.ShootEnablesSSHAccess(h.shoot.GetInfo()) {
+		// SSH access has been enabled again, hence the SSHAccessDisabled condition is dropped.
+		conditions.sshAccessDisabled = nil
+	}
+
+	if !h.seedHasBackup() {
+		// Backups are not configured (anymore) for the seed, hence the BackupReady condition is dropped.
+		conditions.backupReady = nil
+	}
+
+	policyBundleManagedResources, policyBundlesErr := h.listPolicyBundleManagedResources(ctx)
+	if policyBundlesErr != nil {
+		h.log.Error(policyBundlesErr, "Error listing managed resources of policy bundles")
+	} else if len(policyBundleManagedResources) == 0 {
+		// No policy bundles are deployed (anymore) to the shoot, hence the PolicyBundlesApplied condition is dropped.
+		conditions.policyBundlesApplied = nil
+	} else if conditions.policyBundlesApplied == nil {
+		policyBundlesCondition := v1beta1helper.InitConditionWithClock(h.clock, gardencorev1beta1.ShootPolicyBundlesApplied)
+		conditions.policyBundlesApplied = &policyBundlesCondition
+	}
+
 	if h.shoot.HibernationEnabled || h.shoot.GetInfo().Status.IsHibernated {
 		updatedConditions := shootHibernatedConditions(h.clock, conditions.ConvertToSlice())
 		return PardonConditions(h.clock, updatedConditions, lastOp, lastErrors)
@@ -153,6 +179,24 @@ func (h *Health) Check(
 		},
 	}
 
+	if conditions.backupReady != nil {
+		taskFns = append(taskFns,
+			func(ctx context.Context) error {
+				newBackup, err := h.CheckBackupFreshness(ctx, *conditions.backupReady)
+				backupCondition := v1beta1helper.NewConditionOrError(h.clock, *conditions.backupReady, newBackup, err)
+				conditions.backupReady = &backupCondition
+				return nil
+			})
+	}
+
+	if conditions.policyBundlesApplied != nil && policyBundlesErr == nil {
+		taskFns = append(taskFns,
+			func(_ context.Context) error {
+				conditions.policyBundlesApplied = h.CheckPolicyBundles(*conditions.policyBundlesApplied, policyBundleManagedResources)
+				return nil
+			})
+	}
+
 	// Health checks with dependencies to the Kube-Apiserver.
 	shootClient, apiServerRunning, err := h.initializeShootClients()
 	if apiServerRunning && err == nil {
@@ -176,6 +220,15 @@ func (h *Health) Check(
 					return nil
 				})
 		}
+		if conditions.sshAccessDisabled != nil {
+			taskFns = append(taskFns,
+				func(ctx context.Context) error {
+					newSSHAccess, err := h.CheckSSHAccessDisabled(ctx, shootClient, *conditions.sshAccessDisabled)
+					sshAccessCondition := v1beta1helper.NewConditionOrError(h.clock, *conditions.sshAccessDisabled, newSSHAccess, err)
+					conditions.sshAccessDisabled = &sshAccessCondition
+					return nil
+				})
+		}
 	} else {
 		// Some health checks cannot be executed when the API server is not running.
 		// Maintain the affected conditions here.
@@ -191,6 +244,10 @@ func (h *Health) Check(
 			nodeCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.everyNodeReady, message)
 			conditions.everyNodeReady = &nodeCondition
 		}
+		if conditions.sshAccessDisabled != nil {
+			sshAccessCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.sshAccessDisabled, message)
+			conditions.sshAccessDisabled = &sshAccessCondition
+		}
 	}
 
 	// Execute all relevant health checks.
@@ -457,7 +514,8 @@ func (h *Health) checkSystemComponents(
 	}
 
 	for _, mr := range mrList.Items {
-		if mr.Spec.Class != nil {
+		// Policy bundles are not system components, their health is reflected in the PolicyBundlesApplied condition.
+		if mr.Spec.Class != nil || mr.Labels[v1beta1constants.LabelPolicyBundleName] != "" {
 			continue
 		}
 
@@ -491,7 +549,16 @@ func (h *Health) checkSystemComponents(
 		}
 	}
 
-	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy.")
+	message := "All system components are healthy."
+	if systemComponents := h.shoot.GetInfo().Spec.SystemComponents; systemComponents != nil && len(systemComponents.UserProvided) > 0 {
+		userProvided := make([]string, 0, len(systemComponents.UserProvided))
+		for _, component := range systemComponents.UserProvided {
+			userProvided = append(userProvided, string(component))
+		}
+		message += fmt.Sprintf(" The following system components are user-provided and not checked by Gardener: %s.", strings.Join(userProvided, ", "))
+	}
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "SystemComponentsRunning", message)
 	return &c, nil
 }
 
@@ -553,20 +620,7 @@ func (h *Health) CheckClusterNodes(
 		return nil, err
 	}
 
-	roleValue, oscOutdatedReason := v1beta1constants.GardenRoleCloudConfig, "CloudConfigOutdated"
-	if features.DefaultFeatureGate.Enabled(features.UseGardenerNodeAgent) {
-		oscOutdatedReason = "OperatingSystemConfigOutdated"
-
-		oscSecretsExist, err := kubernetesutils.ResourcesExist(ctx, shootClient.Client(), &corev1.SecretList{}, shootClient.Client().Scheme(), client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleOperatingSystemConfig})
-		if err != nil {
-			return nil, err
-		}
-		if oscSecretsExist {
-			roleValue = v1beta1constants.GardenRoleOperatingSystemConfig
-		}
-	}
-
-	workerPoolToCloudConfigSecretMeta, err := botanist.WorkerPoolToOperatingSystemConfigSecretMetaMap(ctx, shootClient.Client(), roleValue)
+	oscOutdatedReason, oscOutdatedMessage, err := h.checkOperatingSystemConfigsUpToDate(ctx, shootClient, workerPoolToNodes)
 	if err != nil {
 		return nil, err
 	}
@@ -589,8 +643,8 @@ func (h *Health) CheckClusterNodes(
 		}
 	}
 
-	if err := botanist.OperatingSystemConfigUpdatedForAllWorkerPools(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta); err != nil {
-		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, oscOutdatedReason, err.Error())
+	if oscOutdatedReason != "" {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, oscOutdatedReason, oscOutdatedMessage)
 		return &c, nil
 	}
 
@@ -666,6 +720,195 @@ func (h *Health) CheckClusterNodes(
 	return nil, nil
 }
 
+// checkOperatingSystemConfigsUpToDate checks whether the nodes of all worker pools run the latest operating system
+// configuration. If this is not the case, the reason and a message describing the outdated worker pools are returned.
+func (h *Health) checkOperatingSystemConfigsUpToDate(
+	ctx context.Context,
+	shootClient kubernetes.Interface,
+	workerPoolToNodes map[string][]corev1.Node,
+) (
+	string,
+	string,
+	error,
+) {
+	roleValue, oscOutdatedReason := v1beta1constants.GardenRoleCloudConfig, "CloudConfigOutdated"
+	if features.DefaultFeatureGate.Enabled(features.UseGardenerNodeAgent) {
+		oscOutdatedReason = "OperatingSystemConfigOutdated"
+
+		oscSecretsExist, err := kubernetesutils.ResourcesExist(ctx, shootClient.Client(), &corev1.SecretList{}, shootClient.Client().Scheme(), client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleOperatingSystemConfig})
+		if err != nil {
+			return "", "", err
+		}
+		if oscSecretsExist {
+			roleValue = v1beta1constants.GardenRoleOperatingSystemConfig
+		}
+	}
+
+	workerPoolToCloudConfigSecretMeta, err := botanist.WorkerPoolToOperatingSystemConfigSecretMetaMap(ctx, shootClient.Client(), roleValue)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := botanist.OperatingSystemConfigUpdatedForAllWorkerPools(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta); err != nil {
+		return oscOutdatedReason, err.Error(), nil
+	}
+
+	return "", "", nil
+}
+
+// CheckSSHAccessDisabled checks whether disabling the SSH access to the worker nodes has been fully enforced, i.e.,
+// that no Bastions exist anymore for the Shoot and that all nodes run the operating system configuration without the
+// SSH public keys.
+func (h *Health) CheckSSHAccessDisabled(
+	ctx context.Context,
+	shootClient kubernetes.Interface,
+	condition gardencorev1beta1.Condition,
+) (
+	*gardencorev1beta1.Condition,
+	error,
+) {
+	bastionList := &operationsv1alpha1.BastionList{}
+	if err := h.gardenClient.List(ctx, bastionList, client.InNamespace(h.shoot.GetInfo().Namespace), client.MatchingFields{operations.BastionShootName: h.shoot.GetInfo().Name}); err != nil {
+		return nil, err
+	}
+
+	if len(bastionList.Items) > 0 {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "BastionsExist", fmt.Sprintf("%d Bastion(s) still exist for the Shoot, they will be deleted during the next reconciliation.", len(bastionList.Items)))
+		return &c, nil
+	}
+
+	// The extension Bastions are read with their typed list since gardenlet already watches them for the Bastion
+	// controller, while a metadata-only list would start an additional informer.
+	extensionBastionList := &extensionsv1alpha1.BastionList{}
+	if err := h.seedClient.Client().List(ctx, extensionBastionList, client.InNamespace(h.shoot.SeedNamespace), client.Limit(1)); err != nil {
+		return nil, err
+	}
+
+	if len(extensionBastionList.Items) > 0 {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "BastionsExist", "Bastions still exist in the Shoot namespace of the Seed cluster, they will be deleted during the next reconciliation.")
+		return &c, nil
+	}
+
+	workerPoolToNodes, err := botanist.WorkerPoolToNodesMap(ctx, shootClient.Client())
+	if err != nil {
+		return nil, err
+	}
+
+	if oscOutdatedReason, oscOutdatedMessage, err := h.checkOperatingSystemConfigsUpToDate(ctx, shootClient, workerPoolToNodes); err != nil {
+		return nil, err
+	} else if oscOutdatedReason != "" {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, oscOutdatedReason, "SSH public keys have not been removed from all nodes yet: "+oscOutdatedMessage)
+		return &c, nil
+	}
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "SSHAccessDisabled", "SSH access to the worker nodes has been disabled and no Bastions exist.")
+	return &c, nil
+}
+
+func (h *Health) seedHasBackup() bool {
+	return h.gardenletConfiguration != nil && h.gardenletConfiguration.SeedConfig != nil && h.gardenletConfiguration.SeedConfig.Spec.Backup != nil
+}
+
+// CheckBackupFreshness checks whether the latest full and delta snapshots of the main etcd of the Shoot are younger
+// than the configured thresholds. The times of the latest snapshots are read from the snapshot leases maintained by
+// etcd-druid.
+func (h *Health) CheckBackupFreshness(
+	ctx context.Context,
+	condition gardencorev1beta1.Condition,
+) (
+	*gardencorev1beta1.Condition,
+	error,
+) {
+	var (
+		etcd                                          = &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}}
+		fullSnapshotThreshold, deltaSnapshotThreshold = backupFreshnessThresholds(h.gardenletConfiguration)
+		messages                                      []string
+	)
+
+	for _, snapshot := range []struct {
+		kind      string
+		leaseName string
+		threshold time.Duration
+	}{
+		{kind: "full", leaseName: etcd.GetFullSnapshotLeaseName(), threshold: fullSnapshotThreshold},
+		{kind: "delta", leaseName: etcd.GetDeltaSnapshotLeaseName(), threshold: deltaSnapshotThreshold},
+	} {
+		lease := &coordinationv1.Lease{}
+		if err := h.seedClient.Client().Get(ctx, client.ObjectKey{Namespace: h.shoot.SeedNamespace, Name: snapshot.leaseName}, lease); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			lease = nil
+		}
+
+		if lease == nil || lease.Spec.RenewTime == nil {
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "SnapshotMissing", fmt.Sprintf("No %s snapshot of the main etcd has been recorded yet.", snapshot.kind))
+			return &c, nil
+		}
+
+		if age := h.clock.Since(lease.Spec.RenewTime.Time); age > snapshot.threshold {
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "SnapshotOutdated", fmt.Sprintf("Latest %s snapshot of the main etcd was taken at %s which is longer ago than the threshold of %s.", snapshot.kind, lease.Spec.RenewTime.UTC().Format(time.RFC3339), snapshot.threshold))
+			return &c, nil
+		}
+
+		messages = append(messages, fmt.Sprintf("%s snapshot at %s", snapshot.kind, lease.Spec.RenewTime.UTC().Format(time.RFC3339)))
+	}
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "BackupUpToDate", fmt.Sprintf("Latest snapshots of the main etcd are up to date (%s).", strings.Join(messages, ", ")))
+	return &c, nil
+}
+
+func (h *Health) listPolicyBundleManagedResources(ctx context.Context) ([]resourcesv1alpha1.ManagedResource, error) {
+	mrList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := h.seedClient.Client().List(ctx, mrList, client.InNamespace(h.shoot.SeedNamespace), client.HasLabels{v1beta1constants.LabelPolicyBundleName}); err != nil {
+		return nil, err
+	}
+	return mrList.Items, nil
+}
+
+// CheckPolicyBundles checks whether the ManagedResources of the policy bundles deployed to the Shoot have been applied
+// successfully and are healthy. The message of the returned condition lists the names and versions of the bundles.
+func (h *Health) CheckPolicyBundles(
+	condition gardencorev1beta1.Condition,
+	managedResources []resourcesv1alpha1.ManagedResource,
+) *gardencorev1beta1.Condition {
+	var policyBundles []string
+
+	for _, mr := range managedResources {
+		policyBundle := fmt.Sprintf("%s@%s", mr.Labels[v1beta1constants.LabelPolicyBundleName], mr.Annotations[v1beta1constants.AnnotationPolicyBundleVersion])
+
+		if exitCondition := h.healthChecker.CheckManagedResource(condition, &mr, gardenlethelper.GetManagedResourceProgressingThreshold(h.gardenletConfiguration)); exitCondition != nil {
+			exitCondition.Message = fmt.Sprintf("Policy bundle %s: %s", policyBundle, exitCondition.Message)
+			return exitCondition
+		}
+
+		policyBundles = append(policyBundles, policyBundle)
+	}
+
+	sort.Strings(policyBundles)
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "PolicyBundlesApplied", fmt.Sprintf("All policy bundles have been applied (%s).", strings.Join(policyBundles, ", ")))
+	return &c
+}
+
+func backupFreshnessThresholds(config *gardenletconfig.GardenletConfiguration) (time.Duration, time.Duration) {
+	var (
+		fullSnapshot  = 24 * time.Hour
+		deltaSnapshot = 15 * time.Minute
+	)
+
+	if config != nil && config.Controllers != nil && config.Controllers.ShootCare != nil && config.Controllers.ShootCare.BackupFreshnessThresholds != nil {
+		if v := config.Controllers.ShootCare.BackupFreshnessThresholds.FullSnapshot; v != nil {
+			fullSnapshot = v.Duration
+		}
+		if v := config.Controllers.ShootCare.BackupFreshnessThresholds.DeltaSnapshot; v != nil {
+			deltaSnapshot = v.Duration
+		}
+	}
+
+	return fullSnapshot, deltaSnapshot
+}
+
 // CheckNodesScalingUp returns an error if nodes are being scaled up.
 func CheckNodesScalingUp(machineList *machinev1alpha1.MachineList, readyNodes, desiredMachines int) error {
 	if readyNodes == desiredMachines {
@@ -848,6 +1091,9 @@ type ShootConditions struct {
 	observabilityComponentsHealthy gardencorev1beta1.Condition
 	systemComponentsHealthy        gardencorev1beta1.Condition
 	everyNodeReady                 *gardencorev1beta1.Condition
+	sshAccessDisabled              *gardencorev1beta1.Condition
+	backupReady                    *gardencorev1beta1.Condition
+	policyBundlesApplied           *gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot conditions as a slice.
@@ -862,7 +1108,21 @@ func (s ShootConditions) ConvertToSlice() []gardencorev1beta1.Condition {
 		conditions = append(conditions, *s.everyNodeReady)
 	}
 
-	return append(conditions, s.systemComponentsHealthy)
+	conditions = append(conditions, s.systemComponentsHealthy)
+
+	if s.sshAccessDisabled != nil {
+		conditions = append(conditions, *s.sshAccessDisabled)
+	}
+
+	if s.backupReady != nil {
+		conditions = append(conditions, *s.backupReady)
+	}
+
+	if s.policyBundlesApplied != nil {
+		conditions = append(conditions, *s.policyBundlesApplied)
+	}
+
+	return conditions
 }
 
 // ConditionTypes returns all shoot condition types.
@@ -877,12 +1137,27 @@ func (s ShootConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
 		types = append(types, gardencorev1beta1.ShootEveryNodeReady)
 	}
 
-	return append(types, s.systemComponentsHealthy.Type)
+	types = append(types, s.systemComponentsHealthy.Type)
+
+	if s.sshAccessDisabled != nil {
+		types = append(types, gardencorev1beta1.ShootSSHAccessDisabled)
+	}
+
+	if s.backupReady != nil {
+		types = append(types, gardencorev1beta1.ShootBackupReady)
+	}
+
+	if s.policyBundlesApplied != nil {
+		types = append(types, gardencorev1beta1.ShootPolicyBundlesApplied)
+	}
+
+	return types
 }
 
 // NewShootConditions returns a new instance of ShootConditions.
-// All conditions are retrieved from the given 'shoot' or newly initialized.
-func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot) ShootConditions {
+// All conditions are retrieved from the given 'shoot' or newly initialized. The BackupReady condition is only
+// initialized if 'backupEnabled' is true, i.e., if the seed is configured with a backup.
+func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot, backupEnabled bool) ShootConditions {
 	shootConditions := ShootConditions{
 		apiServerAvailable:             v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerAvailable),
 		controlPlaneHealthy:            v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootControlPlaneHealthy),
@@ -895,5 +1170,26 @@ func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoot
 		shootConditions.everyNodeReady = &nodeCondition
 	}
 
+	// The SSHAccessDisabled condition is also initialized if it is still present in the status although SSH access has
+	// been enabled again, so that it gets removed from the status by the health check.
+	if !v1beta1helper.IsWorkerless(shoot) && (!v1beta1helper.ShootEnablesSSHAccess(shoot) || v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootSSHAccessDisabled) != nil) {
+		sshAccessCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootSSHAccessDisabled)
+		shootConditions.sshAccessDisabled = &sshAccessCondition
+	}
+
+	// The BackupReady condition is also initialized if it is still present in the status although the seed has no
+	// backup anymore, so that it gets removed from the status by the health check.
+	if backupEnabled || v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootBackupReady) != nil {
+		backupCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootBackupReady)
+		shootConditions.backupReady = &backupCondition
+	}
+
+	// The PolicyBundlesApplied condition is only initialized if it is already present in the status. The health check
+	// adds or removes it depending on whether policy bundles are deployed to the shoot.
+	if c := v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootPolicyBundlesApplied); c != nil {
+		policyBundlesCondition := *c
+		shootConditions.policyBundlesApplied = &policyBundlesCondition
+	}
+
 	return shootConditions
 }
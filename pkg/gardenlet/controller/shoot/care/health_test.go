@@ -28,6 +28,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -36,11 +37,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/features"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -718,6 +725,260 @@ var _ = Describe("health check", func() {
 		)
 	})
 
+	Describe("#CheckSSHAccessDisabled", func() {
+		var (
+			fakeGardenClient client.Client
+			fakeShootClient  client.Client
+			health           *Health
+
+			projectNamespace = "garden-foo"
+			shootName        = "bar"
+			workerPoolName   = "worker"
+			oscChecksum      = "foo"
+
+			node corev1.Node
+		)
+
+		BeforeEach(func() {
+			fakeGardenClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&operationsv1alpha1.Bastion{}, operations.BastionShootName, indexer.BastionShootNameIndexerFunc).
+				Build()
+			fakeShootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+			shootObj := &shootpkg.Shoot{
+				SeedNamespace:     seedNamespace,
+				KubernetesVersion: kubernetesVersion,
+			}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: projectNamespace},
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers:         []gardencorev1beta1.Worker{{Name: workerPoolName}},
+						WorkersSettings: &gardencorev1beta1.WorkersSettings{SSHAccess: &gardencorev1beta1.SSHAccess{Enabled: false}},
+					},
+				},
+			})
+
+			health = NewHealth(
+				logr.Discard(),
+				shootObj,
+				kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				fakeGardenClient,
+				nil,
+				fakeClock,
+				nil,
+				nil,
+			)
+
+			Expect(fakeShootClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        operatingsystemconfig.Key(workerPoolName, kubernetesVersion, nil),
+					Namespace:   metav1.NamespaceSystem,
+					Labels:      map[string]string{"gardener.cloud/role": "cloud-config", "worker.gardener.cloud/pool": workerPoolName},
+					Annotations: map[string]string{"checksum/data-script": oscChecksum},
+				},
+			})).To(Succeed())
+
+			node = newNode("node1", true, labels.Set{"worker.gardener.cloud/pool": workerPoolName, "worker.gardener.cloud/kubernetes-version": kubernetesVersion.Original()}, map[string]string{"checksum/cloud-config-data": oscChecksum}, kubernetesVersion.Original())
+		})
+
+		It("should return false if Bastions exist in the garden cluster", func() {
+			Expect(fakeGardenClient.Create(ctx, &operationsv1alpha1.Bastion{
+				ObjectMeta: metav1.ObjectMeta{Name: "bastion", Namespace: projectNamespace},
+				Spec:       operationsv1alpha1.BastionSpec{ShootRef: corev1.LocalObjectReference{Name: shootName}},
+			})).To(Succeed())
+
+			exitCondition, err := health.CheckSSHAccessDisabled(ctx, kubernetesfake.NewClientSetBuilder().WithClient(fakeShootClient).Build(), condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg("False", "BastionsExist", "1 Bastion(s) still exist for the Shoot, they will be deleted during the next reconciliation.")))
+		})
+
+		It("should return false if Bastions exist in the seed cluster", func() {
+			Expect(fakeClient.Create(ctx, &extensionsv1alpha1.Bastion{
+				ObjectMeta: metav1.ObjectMeta{Name: "bastion", Namespace: seedNamespace},
+			})).To(Succeed())
+
+			exitCondition, err := health.CheckSSHAccessDisabled(ctx, kubernetesfake.NewClientSetBuilder().WithClient(fakeShootClient).Build(), condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg("False", "BastionsExist", "Bastions still exist in the Shoot namespace of the Seed cluster, they will be deleted during the next reconciliation.")))
+		})
+
+		It("should return false if nodes still run an outdated operating system config", func() {
+			node.Annotations["checksum/cloud-config-data"] = "outdated"
+			Expect(fakeShootClient.Create(ctx, &node)).To(Succeed())
+
+			exitCondition, err := health.CheckSSHAccessDisabled(ctx, kubernetesfake.NewClientSetBuilder().WithClient(fakeShootClient).Build(), condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(And(
+				WithStatus("False"),
+				WithReason("CloudConfigOutdated"),
+				WithMessage("SSH public keys have not been removed from all nodes yet"),
+			)))
+		})
+
+		It("should return true if SSH access disabling has converged", func() {
+			Expect(fakeShootClient.Create(ctx, &node)).To(Succeed())
+
+			exitCondition, err := health.CheckSSHAccessDisabled(ctx, kubernetesfake.NewClientSetBuilder().WithClient(fakeShootClient).Build(), condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg("True", "SSHAccessDisabled", "SSH access to the worker nodes has been disabled and no Bastions exist.")))
+		})
+	})
+
+	Describe("#CheckBackupFreshness", func() {
+		var (
+			health *Health
+
+			fullSnapshotLease  *coordinationv1.Lease
+			deltaSnapshotLease *coordinationv1.Lease
+		)
+
+		BeforeEach(func() {
+			shootObj := &shootpkg.Shoot{SeedNamespace: seedNamespace}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{})
+
+			health = NewHealth(
+				logr.Discard(),
+				shootObj,
+				kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				nil,
+				nil,
+				fakeClock,
+				&gardenletconfig.GardenletConfiguration{
+					Controllers: &gardenletconfig.GardenletControllerConfiguration{
+						ShootCare: &gardenletconfig.ShootCareControllerConfiguration{
+							BackupFreshnessThresholds: &gardenletconfig.BackupFreshnessThresholds{
+								FullSnapshot:  &metav1.Duration{Duration: time.Hour},
+								DeltaSnapshot: &metav1.Duration{Duration: 5 * time.Minute},
+							},
+						},
+					},
+				},
+				nil,
+			)
+
+			fullSnapshotLease = &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main-full-snap", Namespace: seedNamespace},
+				Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: fakeClock.Now().Add(-30 * time.Minute)}},
+			}
+			deltaSnapshotLease = &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main-delta-snap", Namespace: seedNamespace},
+				Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: fakeClock.Now().Add(-time.Minute)}},
+			}
+		})
+
+		It("should return false if no full snapshot lease exists", func() {
+			Expect(fakeClient.Create(ctx, deltaSnapshotLease)).To(Succeed())
+
+			exitCondition, err := health.CheckBackupFreshness(ctx, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg("False", "SnapshotMissing", "No full snapshot of the main etcd has been recorded yet.")))
+		})
+
+		It("should return false if the delta snapshot lease was never renewed", func() {
+			deltaSnapshotLease.Spec.RenewTime = nil
+			Expect(fakeClient.Create(ctx, fullSnapshotLease)).To(Succeed())
+			Expect(fakeClient.Create(ctx, deltaSnapshotLease)).To(Succeed())
+
+			exitCondition, err := health.CheckBackupFreshness(ctx, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg("False", "SnapshotMissing", "No delta snapshot of the main etcd has been recorded yet.")))
+		})
+
+		It("should return false if the full snapshot is outdated", func() {
+			fullSnapshotLease.Spec.RenewTime = &metav1.MicroTime{Time: fakeClock.Now().Add(-2 * time.Hour)}
+			Expect(fakeClient.Create(ctx, fullSnapshotLease)).To(Succeed())
+			Expect(fakeClient.Create(ctx, deltaSnapshotLease)).To(Succeed())
+
+			exitCondition, err := health.CheckBackupFreshness(ctx, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(And(
+				WithStatus("False"),
+				WithReason("SnapshotOutdated"),
+				WithMessage("Latest full snapshot of the main etcd was taken at"),
+				WithMessage("which is longer ago than the threshold of 1h0m0s."),
+			)))
+		})
+
+		It("should return false if the delta snapshot is outdated", func() {
+			deltaSnapshotLease.Spec.RenewTime = &metav1.MicroTime{Time: fakeClock.Now().Add(-10 * time.Minute)}
+			Expect(fakeClient.Create(ctx, fullSnapshotLease)).To(Succeed())
+			Expect(fakeClient.Create(ctx, deltaSnapshotLease)).To(Succeed())
+
+			exitCondition, err := health.CheckBackupFreshness(ctx, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(And(
+				WithStatus("False"),
+				WithReason("SnapshotOutdated"),
+				WithMessage("Latest delta snapshot of the main etcd was taken at"),
+				WithMessage("which is longer ago than the threshold of 5m0s."),
+			)))
+		})
+
+		It("should return true if all snapshots are up to date", func() {
+			Expect(fakeClient.Create(ctx, fullSnapshotLease)).To(Succeed())
+			Expect(fakeClient.Create(ctx, deltaSnapshotLease)).To(Succeed())
+
+			exitCondition, err := health.CheckBackupFreshness(ctx, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(And(
+				WithStatus("True"),
+				WithReason("BackupUpToDate"),
+				WithMessage("Latest snapshots of the main etcd are up to date"),
+			)))
+		})
+	})
+
+	Describe("#CheckPolicyBundles", func() {
+		var (
+			health *Health
+
+			healthyConditions = []gardencorev1beta1.Condition{
+				{Type: "ResourcesApplied", Status: gardencorev1beta1.ConditionTrue},
+				{Type: "ResourcesHealthy", Status: gardencorev1beta1.ConditionTrue},
+				{Type: "ResourcesProgressing", Status: gardencorev1beta1.ConditionFalse},
+			}
+		)
+
+		policyBundleManagedResource := func(name, version string, conditions []gardencorev1beta1.Condition) resourcesv1alpha1.ManagedResource {
+			return resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "policy-bundle-" + name,
+					Namespace:   seedNamespace,
+					Labels:      map[string]string{"policy-bundle.gardener.cloud/name": name},
+					Annotations: map[string]string{"policy-bundle.gardener.cloud/version": version},
+				},
+				Status: resourcesv1alpha1.ManagedResourceStatus{Conditions: conditions},
+			}
+		}
+
+		BeforeEach(func() {
+			shootObj := &shootpkg.Shoot{SeedNamespace: seedNamespace}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{})
+
+			health = NewHealth(logr.Discard(), shootObj, kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(), nil, nil, fakeClock, nil, nil)
+		})
+
+		It("should return true if all policy bundles are applied and healthy", func() {
+			Expect(health.CheckPolicyBundles(condition, []resourcesv1alpha1.ManagedResource{
+				policyBundleManagedResource("restricted", "2.0.0", healthyConditions),
+				policyBundleManagedResource("baseline", "1.1.0", healthyConditions),
+			})).To(PointTo(beConditionWithStatusAndMsg("True", "PolicyBundlesApplied", "All policy bundles have been applied (baseline@1.1.0, restricted@2.0.0).")))
+		})
+
+		It("should return false if a policy bundle could not be applied", func() {
+			Expect(health.CheckPolicyBundles(condition, []resourcesv1alpha1.ManagedResource{
+				policyBundleManagedResource("baseline", "1.1.0", healthyConditions),
+				policyBundleManagedResource("restricted", "2.0.0", []gardencorev1beta1.Condition{
+					{Type: "ResourcesApplied", Status: gardencorev1beta1.ConditionFalse, Reason: "ApplyFailed", Message: "no matches for kind ClusterPolicy"},
+					{Type: "ResourcesHealthy", Status: gardencorev1beta1.ConditionTrue},
+					{Type: "ResourcesProgressing", Status: gardencorev1beta1.ConditionFalse},
+				}),
+			})).To(PointTo(beConditionWithStatusAndMsg("False", "ApplyFailed", "Policy bundle restricted@2.0.0: no matches for kind ClusterPolicy")))
+		})
+	})
+
 	Describe("#CheckNodesScalingUp", func() {
 		It("should return true if number of ready nodes equal number of desired machines", func() {
 			Expect(CheckNodesScalingUp(nil, 1, 1)).To(Succeed())
@@ -883,7 +1144,7 @@ var _ = Describe("health check", func() {
 							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
 						},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
@@ -895,16 +1156,93 @@ var _ = Describe("health check", func() {
 			})
 
 			It("should initialize all conditions for workerless shoot", func() {
-				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{})
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{}, false)
+
+				Expect(conditions.ConvertToSlice()).To(ConsistOf(
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+				))
+			})
+
+			It("should initialize all conditions for shoot with disabled SSH access", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers:         []gardencorev1beta1.Worker{{Name: "worker"}},
+							WorkersSettings: &gardencorev1beta1.WorkersSettings{SSHAccess: &gardencorev1beta1.SSHAccess{Enabled: false}},
+						},
+					},
+				}, false)
 
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
+			It("should keep the SSHAccessDisabled condition if SSH access has been enabled again", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+						},
+					},
+					Status: gardencorev1beta1.ShootStatus{
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: "SSHAccessDisabled"},
+						},
+					},
+				}, false)
+
+				Expect(conditions.ConvertToSlice()).To(ContainElement(OfType("SSHAccessDisabled")))
+				Expect(conditions.ConditionTypes()).To(ContainElement(gardencorev1beta1.ConditionType("SSHAccessDisabled")))
+			})
+
+			It("should initialize the BackupReady condition if backup is enabled", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{}, true)
+
+				Expect(conditions.ConvertToSlice()).To(ConsistOf(
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+				))
+				Expect(conditions.ConditionTypes()).To(ContainElement(gardencorev1beta1.ConditionType("BackupReady")))
+			})
+
+			It("should keep the BackupReady condition if backup has been disabled", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
+					Status: gardencorev1beta1.ShootStatus{
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: "BackupReady"},
+						},
+					},
+				}, false)
+
+				Expect(conditions.ConvertToSlice()).To(ContainElement(OfType("BackupReady")))
+				Expect(conditions.ConditionTypes()).To(ContainElement(gardencorev1beta1.ConditionType("BackupReady")))
+			})
+
+			It("should keep the PolicyBundlesApplied condition if it is present", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
+					Status: gardencorev1beta1.ShootStatus{
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: "PolicyBundlesApplied"},
+						},
+					},
+				}, false)
+
+				Expect(conditions.ConvertToSlice()).To(ContainElement(OfType("PolicyBundlesApplied")))
+				Expect(conditions.ConditionTypes()).To(ContainElement(gardencorev1beta1.ConditionType("PolicyBundlesApplied")))
+			})
+
 			It("should only initialize missing conditions", func() {
 				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
 					Status: gardencorev1beta1.ShootStatus{
@@ -913,7 +1251,7 @@ var _ = Describe("health check", func() {
 							{Type: "Foo"},
 						},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					OfType("APIServerAvailable"),
@@ -932,7 +1270,7 @@ var _ = Describe("health check", func() {
 							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
 						},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
 					OfType("APIServerAvailable"),
@@ -942,6 +1280,45 @@ var _ = Describe("health check", func() {
 					OfType("SystemComponentsHealthy"),
 				))
 			})
+
+			It("should return the expected conditions for shoot with disabled SSH access", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers:         []gardencorev1beta1.Worker{{Name: "worker"}},
+							WorkersSettings: &gardencorev1beta1.WorkersSettings{SSHAccess: &gardencorev1beta1.SSHAccess{Enabled: false}},
+						},
+					},
+				}, false)
+
+				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
+					OfType("APIServerAvailable"),
+					OfType("ControlPlaneHealthy"),
+					OfType("ObservabilityComponentsHealthy"),
+					OfType("EveryNodeReady"),
+					OfType("SystemComponentsHealthy"),
+					OfType("SSHAccessDisabled"),
+				))
+			})
+
+			It("should return the expected conditions for shoot with enabled backup", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+						},
+					},
+				}, true)
+
+				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
+					OfType("APIServerAvailable"),
+					OfType("ControlPlaneHealthy"),
+					OfType("ObservabilityComponentsHealthy"),
+					OfType("EveryNodeReady"),
+					OfType("SystemComponentsHealthy"),
+					OfType("BackupReady"),
+				))
+			})
 		})
 
 		Describe("#ConditionTypes", func() {
@@ -952,7 +1329,7 @@ var _ = Describe("health check", func() {
 							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
 						},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConditionTypes()).To(HaveExactElements(
 					gardencorev1beta1.ConditionType("APIServerAvailable"),
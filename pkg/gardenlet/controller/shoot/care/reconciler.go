@@ -22,6 +22,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,9 +33,12 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/sharding"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -51,22 +55,43 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewDeprecatedAPIUsageReporter is used to create a new instance reporting the usage of deprecated APIs.
+	NewDeprecatedAPIUsageReporter = defaultNewDeprecatedAPIUsageReporter
+	// NewServiceLevelObjectivesReporter is used to create a new instance reporting the service level objectives.
+	NewServiceLevelObjectivesReporter = defaultNewServiceLevelObjectivesReporter
+	// NewVulnerabilityScanReporter is used to create a new instance reporting the results of vulnerability scans.
+	NewVulnerabilityScanReporter = defaultNewVulnerabilityScanReporter
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
 type Reconciler struct {
 	GardenClient          client.Client
 	SeedClientSet         kubernetes.Interface
+	Recorder              record.EventRecorder
 	ShootClientMap        clientmap.ClientMap
 	Config                config.GardenletConfiguration
 	Clock                 clock.Clock
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	SeedName              string
+	// Shard is the shard of this gardenlet replica. If it is nil then sharding is disabled and all shoots of the seed
+	// are checked.
+	Shard *sharding.Shard
+	// LoadShedding configures the deferral of the care operations for shoots whose API server is saturated. If it is nil
+	// then the care operations are never deferred.
+	LoadShedding *LoadShedding
 
 	gardenSecrets map[string]*corev1.Secret
 }
 
+// LoadShedding contains the configuration for deferring the care operations for shoots whose API server is saturated.
+type LoadShedding struct {
+	// RateLimiters is the registry of the adaptive rate limiters of the shoot clients.
+	RateLimiters *ratelimiter.Registry
+	// DeferPeriod is the duration by which the care operations are deferred.
+	DeferPeriod time.Duration
+}
+
 // Reconcile executes care operations, e.g. health checks or garbage collection.
 func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
@@ -97,11 +122,30 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
+	// if shoot is assigned to another shard then don't requeue, the responsible shard takes care of it.
+	if !r.Shard.IsResponsible(shoot) {
+		return reconcile.Result{}, nil
+	}
+
+	// if shoot might still be processed by the shard it was assigned to before then wait until it has been released.
+	release, acquired := r.Shard.Acquire(shoot)
+	if !acquired {
+		return reconcile.Result{RequeueAfter: r.Shard.SyncPeriod()}, nil
+	}
+	defer release()
+
+	// The care operations are not critical for the shoot, hence they are deferred if the shoot API server is saturated
+	// to give it room to recover.
+	if r.LoadShedding != nil && r.LoadShedding.RateLimiters.Saturated(keys.ForShoot(shoot).Key()) {
+		log.Info("Shoot API server is saturated, deferring care operations", "requeueAfter", r.LoadShedding.DeferPeriod)
+		return reconcile.Result{RequeueAfter: r.LoadShedding.DeferPeriod}, nil
+	}
+
 	careCtx, cancel := controllerutils.GetChildReconciliationContext(ctx, r.Config.Controllers.ShootCare.SyncPeriod.Duration)
 	defer cancel()
 
 	// Initialize conditions based on the current status.
-	shootConditions := NewShootConditions(r.Clock, shoot)
+	shootConditions := NewShootConditions(r.Clock, shoot, r.Config.SeedConfig != nil && r.Config.SeedConfig.Spec.Backup != nil)
 
 	// Initialize constraints based on the current status.
 	shootConstraints := NewShootConstraints(r.Clock, shoot)
@@ -182,11 +226,45 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// Trigger webhook remediation
 		func(ctx context.Context) error {
 			if pointer.BoolDeref(r.Config.Controllers.ShootCare.WebhookRemediatorEnabled, false) {
-				_ = NewWebhookRemediator(log, shoot, initializeShootClients).Remediate(ctx)
+				_ = NewWebhookRemediator(log, shoot, initializeShootClients, r.Recorder).Remediate(ctx)
 				// errors during webhook remediation are only being logged and do not cause the care operation to fail
 			}
 			return nil
 		},
+		// Trigger deprecated API usage report
+		func(ctx context.Context) error {
+			if pointer.BoolDeref(r.Config.Controllers.ShootCare.DeprecatedAPIUsageReporterEnabled, false) {
+				_ = NewDeprecatedAPIUsageReporter(log, r.GardenClient, r.SeedClientSet.Client(), shoot, initializeShootClients).Report(ctx)
+				// errors during deprecated API usage reporting are only being logged and do not cause the care operation to fail
+			}
+			return nil
+		},
+		// Trigger service level objectives report
+		func(ctx context.Context) error {
+			if !gardenlethelper.IsMonitoringEnabled(&r.Config) || o.Shoot.Purpose == gardencorev1beta1.ShootPurposeTesting || o.Shoot.HibernationEnabled {
+				return nil
+			}
+
+			reporter, err := NewServiceLevelObjectivesReporter(log, r.GardenClient, shoot, r.Clock)
+			if err != nil {
+				log.Error(err, "Failed to create service level objectives reporter")
+				return nil
+			}
+
+			_ = reporter.Report(ctx)
+			// errors during service level objectives reporting are only being logged and do not cause the care operation to fail
+			return nil
+		},
+		// Trigger vulnerability scan report
+		func(ctx context.Context) error {
+			if v1beta1helper.IsWorkerless(shoot) {
+				return nil
+			}
+
+			_ = NewVulnerabilityScanReporter(log, r.GardenClient, r.SeedClientSet.Client(), shoot).Report(ctx)
+			// errors during vulnerability scan reporting are only being logged and do not cause the care operation to fail
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -17,6 +17,7 @@ package care_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -40,6 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
 	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	"github.com/gardener/gardener/pkg/operation"
@@ -197,6 +199,54 @@ var _ = Describe("Shoot Care Control", func() {
 			})
 		})
 
+		Context("when load shedding is enabled", func() {
+			var rateLimiters *ratelimiter.Registry
+
+			BeforeEach(func() {
+				rateLimiters = ratelimiter.NewRegistry(fakeClock, ratelimiter.Options{
+					QPS:              50,
+					Burst:            100,
+					MinQPS:           5,
+					LatencyThreshold: time.Second,
+					SaturationWindow: time.Minute,
+				})
+			})
+
+			JustBeforeEach(func() {
+				DeferCleanup(test.WithVar(&NewOperation, opFunc(nil, errors.New("operation must not be created"))))
+
+				reconciler = &Reconciler{
+					GardenClient:  gardenClient,
+					SeedClientSet: kubernetesfake.NewClientSet(),
+					Config:        gardenletConf,
+					Clock:         fakeClock,
+					SeedName:      seedName,
+					LoadShedding: &LoadShedding{
+						RateLimiters: rateLimiters,
+						DeferPeriod:  30 * time.Second,
+					},
+				}
+			})
+
+			It("should defer the care operations if the shoot API server is saturated", func() {
+				rateLimiters.For(shootNamespace+"/"+shootName).Observe(2*time.Second, http.StatusOK)
+
+				Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+				updatedShoot := &gardencorev1beta1.Shoot{}
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+				Expect(updatedShoot.Status.Conditions).To(BeEmpty())
+			})
+
+			It("should not defer the care operations if the saturation window has passed", func() {
+				rateLimiters.For(shootNamespace+"/"+shootName).Observe(0, http.StatusTooManyRequests)
+				fakeClock.Step(time.Minute)
+
+				_, err := reconciler.Reconcile(ctx, req)
+				Expect(err).To(MatchError("operation must not be created"))
+			})
+		})
+
 		Context("when health check setup is successful", func() {
 			var (
 				shootClientMap clientmap.ClientMap
@@ -220,6 +270,8 @@ var _ = Describe("Shoot Care Control", func() {
 				DeferCleanup(test.WithVars(
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
+					&NewServiceLevelObjectivesReporter, nopServiceLevelObjectivesReporterFunc(),
+					&NewVulnerabilityScanReporter, nopVulnerabilityScanReporterFunc(),
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
@@ -577,6 +629,26 @@ func nopGarbageCollectorFunc() NewGarbageCollectorFunc {
 	}
 }
 
+type nopServiceLevelObjectivesReporter struct{}
+
+func (n *nopServiceLevelObjectivesReporter) Report(_ context.Context) error { return nil }
+
+func nopServiceLevelObjectivesReporterFunc() NewServiceLevelObjectivesReporterFunc {
+	return func(_ logr.Logger, _ client.Client, _ *gardencorev1beta1.Shoot, _ clock.Clock) (ServiceLevelObjectivesReporter, error) {
+		return &nopServiceLevelObjectivesReporter{}, nil
+	}
+}
+
+type nopVulnerabilityScanReporter struct{}
+
+func (n *nopVulnerabilityScanReporter) Report(_ context.Context) error { return nil }
+
+func nopVulnerabilityScanReporterFunc() NewVulnerabilityScanReporterFunc {
+	return func(_ logr.Logger, _, _ client.Client, _ *gardencorev1beta1.Shoot) VulnerabilityScanReporter {
+		return &nopVulnerabilityScanReporter{}
+	}
+}
+
 func consistOfConditionsInUnknownStatus(message string, isWorkerless bool) types.GomegaMatcher {
 	var expectedLength = 4
 	matcher := And(
@@ -637,5 +709,15 @@ func consistOfConstraintsInUnknownStatus(message string) types.GomegaMatcher {
 			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
 			"Message": Equal(message),
 		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootUpgradePreflightChecksPassed),
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": Equal(message),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain),
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": Equal(message),
+		}),
 	)
 }
@@ -0,0 +1,146 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/component/kubeapiserver"
+)
+
+// PrometheusQuerier is used to query Prometheus.
+type PrometheusQuerier interface {
+	Query(ctx context.Context, query string, ts time.Time, opts ...promv1.Option) (model.Value, promv1.Warnings, error)
+}
+
+// ServiceLevelObjectivesReport contains required information for reporting the service level objectives of shoots.
+type ServiceLevelObjectivesReport struct {
+	log          logr.Logger
+	gardenClient client.Client
+	shoot        *gardencorev1beta1.Shoot
+	clock        clock.Clock
+	prometheus   PrometheusQuerier
+}
+
+// NewServiceLevelObjectivesReport creates a new instance for reporting the service level objectives of shoots.
+func NewServiceLevelObjectivesReport(log logr.Logger, gardenClient client.Client, shoot *gardencorev1beta1.Shoot, clock clock.Clock, prometheus PrometheusQuerier) *ServiceLevelObjectivesReport {
+	return &ServiceLevelObjectivesReport{
+		log:          log,
+		gardenClient: gardenClient,
+		shoot:        shoot,
+		clock:        clock,
+		prometheus:   prometheus,
+	}
+}
+
+// Report queries the SLIs and the remaining error budgets of the kube-apiserver's service level objectives from the
+// shoot's Prometheus and publishes them in the status of the Shoot resource.
+func (r *ServiceLevelObjectivesReport) Report(ctx context.Context) error {
+	now := r.clock.Now()
+
+	objectives := make([]gardencorev1beta1.ServiceLevelObjectiveStatus, 0, len(kubeapiserver.ServiceLevelObjectives))
+	for _, slo := range kubeapiserver.ServiceLevelObjectives {
+		current, err := r.queryPercentage(ctx, slo.SLIMetric, now)
+		if err != nil {
+			r.log.Error(err, "Failed to determine SLI", "serviceLevelObjective", slo.Name)
+			return err
+		}
+
+		errorBudgetRemaining, err := r.queryPercentage(ctx, slo.ErrorBudgetRemainingMetric, now)
+		if err != nil {
+			r.log.Error(err, "Failed to determine remaining error budget", "serviceLevelObjective", slo.Name)
+			return err
+		}
+
+		status := gardencorev1beta1.ServiceLevelObjectiveStatus{
+			Name:                 slo.Name,
+			Objective:            slo.Objective,
+			Window:               metav1.Duration{Duration: kubeapiserver.ServiceLevelObjectiveWindow},
+			Current:              current,
+			ErrorBudgetRemaining: errorBudgetRemaining,
+			LastUpdateTime:       metav1.NewTime(now),
+		}
+
+		// Only bump the last update time if something has changed to avoid needless updates of the Shoot resource.
+		if old := serviceLevelObjectiveStatus(r.shoot.Status.ServiceLevelObjectives, slo.Name); old != nil {
+			status.LastUpdateTime = old.LastUpdateTime
+			if !apiequality.Semantic.DeepEqual(*old, status) {
+				status.LastUpdateTime = metav1.NewTime(now)
+			}
+		}
+
+		objectives = append(objectives, status)
+	}
+
+	if apiequality.Semantic.DeepEqual(r.shoot.Status.ServiceLevelObjectives, objectives) {
+		return nil
+	}
+
+	// Work on a copy since the shoot object is shared with the other care tasks running in parallel.
+	shoot := r.shoot.DeepCopy()
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.ServiceLevelObjectives = objectives
+
+	if err := r.gardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		r.log.Error(err, "Failed to publish service level objectives in Shoot resource")
+		return fmt.Errorf("failed to publish service level objectives in Shoot resource: %w", err)
+	}
+
+	return nil
+}
+
+// queryPercentage queries the given ratio metric and returns it as percentage. It returns nil if there is no (valid)
+// value for the metric, e.g., because Prometheus has not yet collected enough data.
+func (r *ServiceLevelObjectivesReport) queryPercentage(ctx context.Context, metric string, now time.Time) (*string, error) {
+	value, _, err := r.prometheus.Query(ctx, metric, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying %s: %w", metric, err)
+	}
+
+	vector, ok := value.(model.Vector)
+	if !ok || len(vector) == 0 {
+		return nil, nil
+	}
+
+	ratio := float64(vector[0].Value)
+	if math.IsNaN(ratio) || math.IsInf(ratio, 0) {
+		return nil, nil
+	}
+
+	return pointer.String(strconv.FormatFloat(math.Round(ratio*100*1000)/1000, 'f', -1, 64)), nil
+}
+
+func serviceLevelObjectiveStatus(statuses []gardencorev1beta1.ServiceLevelObjectiveStatus, name string) *gardencorev1beta1.ServiceLevelObjectiveStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
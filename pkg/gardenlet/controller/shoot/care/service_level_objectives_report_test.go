@@ -0,0 +1,137 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("ServiceLevelObjectivesReport", func() {
+	var (
+		ctx = context.Background()
+
+		gardenClient client.Client
+		fakeClock    *testclock.FakeClock
+		prometheus   *fakePrometheusQuerier
+
+		shoot *gardencorev1beta1.Shoot
+
+		reporter *ServiceLevelObjectivesReport
+
+		window = metav1.Duration{Duration: 30 * 24 * time.Hour}
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		prometheus = &fakePrometheusQuerier{values: map[string]float64{
+			"shoot:apiserver_availability_slo:sli_30d":                    0.99987,
+			"shoot:apiserver_availability_slo:error_budget_remaining_30d": 0.87,
+			"shoot:apiserver_latency_slo:sli_30d":                         0.9912345,
+		}}
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"}}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		reporter = NewServiceLevelObjectivesReport(logr.Discard(), gardenClient, shoot, fakeClock, prometheus)
+	})
+
+	Describe("#Report", func() {
+		It("should publish the service level objectives in the Shoot status", func() {
+			Expect(reporter.Report(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.ServiceLevelObjectives).To(Equal([]gardencorev1beta1.ServiceLevelObjectiveStatus{
+				{
+					Name:                 "APIServerAvailability",
+					Objective:            "99.9",
+					Window:               window,
+					Current:              pointer.String("99.987"),
+					ErrorBudgetRemaining: pointer.String("87"),
+					LastUpdateTime:       metav1.NewTime(fakeClock.Now()),
+				},
+				{
+					Name:           "APIServerLatency",
+					Objective:      "99",
+					Window:         window,
+					Current:        pointer.String("99.123"),
+					LastUpdateTime: metav1.NewTime(fakeClock.Now()),
+				},
+			}))
+		})
+
+		It("should not bump the last update time if nothing has changed", func() {
+			Expect(reporter.Report(ctx)).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			lastUpdateTime := metav1.NewTime(fakeClock.Now())
+
+			fakeClock.Step(time.Minute)
+			prometheus.values["shoot:apiserver_latency_slo:sli_30d"] = 0.99
+
+			reporter = NewServiceLevelObjectivesReport(logr.Discard(), gardenClient, shoot, fakeClock, prometheus)
+			Expect(reporter.Report(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.ServiceLevelObjectives).To(HaveLen(2))
+			Expect(shoot.Status.ServiceLevelObjectives[0].LastUpdateTime).To(Equal(lastUpdateTime))
+			Expect(shoot.Status.ServiceLevelObjectives[1].Current).To(Equal(pointer.String("99")))
+			Expect(shoot.Status.ServiceLevelObjectives[1].LastUpdateTime).To(Equal(metav1.NewTime(fakeClock.Now())))
+		})
+
+		It("should fail if Prometheus cannot be queried", func() {
+			prometheus.err = fmt.Errorf("fake")
+
+			Expect(reporter.Report(ctx)).To(MatchError(ContainSubstring("fake")))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.ServiceLevelObjectives).To(BeEmpty())
+		})
+	})
+})
+
+type fakePrometheusQuerier struct {
+	values map[string]float64
+	err    error
+}
+
+func (f *fakePrometheusQuerier) Query(_ context.Context, query string, ts time.Time, _ ...promv1.Option) (model.Value, promv1.Warnings, error) {
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	value, ok := f.values[query]
+	if !ok {
+		return model.Vector{}, nil, nil
+	}
+
+	return model.Vector{{Value: model.SampleValue(value), Timestamp: model.TimeFromUnixNano(ts.UnixNano())}}, nil, nil
+}
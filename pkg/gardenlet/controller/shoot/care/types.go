@@ -16,11 +16,15 @@ package care
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -122,11 +126,11 @@ type WebhookRemediator interface {
 }
 
 // NewWebhookRemediatorFunc is a function used to create a new instance to perform webhook remediation.
-type NewWebhookRemediatorFunc func(op *operation.Operation, init ShootClientInit) WebhookRemediator
+type NewWebhookRemediatorFunc func(log logr.Logger, shoot *gardencorev1beta1.Shoot, init ShootClientInit, recorder record.EventRecorder) WebhookRemediator
 
 // defaultNewWebhookRemediator is the default function to create a new instance to perform webhook remediation.
-var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1.Shoot, init ShootClientInit) WebhookRemediator {
-	return NewWebhookRemediation(log, shoot, init)
+var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1.Shoot, init ShootClientInit, recorder record.EventRecorder) WebhookRemediator {
+	return NewWebhookRemediation(log, shoot, init, recorder)
 }
 
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
@@ -173,3 +177,52 @@ var defaultNewOperationFunc = func(
 		WithShootFromCluster(gardenClient, seedClientSet, shoot).
 		Build(ctx, gardenClient, seedClientSet, shootClientMap)
 }
+
+// DeprecatedAPIUsageReporter is an interface used to report the usage of deprecated APIs.
+type DeprecatedAPIUsageReporter interface {
+	Report(ctx context.Context) error
+}
+
+// NewDeprecatedAPIUsageReporterFunc is a function used to create a new instance to report the usage of deprecated APIs.
+type NewDeprecatedAPIUsageReporterFunc func(log logr.Logger, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, init ShootClientInit) DeprecatedAPIUsageReporter
+
+// defaultNewDeprecatedAPIUsageReporter is the default function to create a new instance to report the usage of
+// deprecated APIs.
+var defaultNewDeprecatedAPIUsageReporter = func(log logr.Logger, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, init ShootClientInit) DeprecatedAPIUsageReporter {
+	return NewDeprecatedAPIUsageReport(log, gardenClient, seedClient, shoot, init)
+}
+
+// ServiceLevelObjectivesReporter is an interface used to report the service level objectives of shoots.
+type ServiceLevelObjectivesReporter interface {
+	Report(ctx context.Context) error
+}
+
+// NewServiceLevelObjectivesReporterFunc is a function used to create a new instance to report the service level
+// objectives of shoots.
+type NewServiceLevelObjectivesReporterFunc func(log logr.Logger, gardenClient client.Client, shoot *gardencorev1beta1.Shoot, clock clock.Clock) (ServiceLevelObjectivesReporter, error)
+
+// defaultNewServiceLevelObjectivesReporter is the default function to create a new instance to report the service
+// level objectives of shoots. It queries the Prometheus running in the shoot's control plane namespace.
+var defaultNewServiceLevelObjectivesReporter = func(log logr.Logger, gardenClient client.Client, shoot *gardencorev1beta1.Shoot, clock clock.Clock) (ServiceLevelObjectivesReporter, error) {
+	prometheusClient, err := promapi.NewClient(promapi.Config{Address: fmt.Sprintf("http://prometheus-web.%s.svc", shoot.Status.TechnicalID)})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewServiceLevelObjectivesReport(log, gardenClient, shoot, clock, promv1.NewAPI(prometheusClient)), nil
+}
+
+// VulnerabilityScanReporter is an interface used to report the results of vulnerability scans of shoots.
+type VulnerabilityScanReporter interface {
+	Report(ctx context.Context) error
+}
+
+// NewVulnerabilityScanReporterFunc is a function used to create a new instance to report the results of vulnerability
+// scans of shoots.
+type NewVulnerabilityScanReporterFunc func(log logr.Logger, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot) VulnerabilityScanReporter
+
+// defaultNewVulnerabilityScanReporter is the default function to create a new instance to report the results of
+// vulnerability scans of shoots.
+var defaultNewVulnerabilityScanReporter = func(log logr.Logger, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot) VulnerabilityScanReporter {
+	return NewVulnerabilityScanReport(log, gardenClient, seedClient, shoot)
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// VulnerabilityScanReport contains required information for reporting the results of vulnerability scans of the
+// machine images used by the worker pools of shoots.
+type VulnerabilityScanReport struct {
+	log          logr.Logger
+	gardenClient client.Client
+	seedClient   client.Client
+	shoot        *gardencorev1beta1.Shoot
+}
+
+// NewVulnerabilityScanReport creates a new instance for reporting the results of vulnerability scans of shoots.
+func NewVulnerabilityScanReport(log logr.Logger, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot) *VulnerabilityScanReport {
+	return &VulnerabilityScanReport{
+		log:          log,
+		gardenClient: gardenClient,
+		seedClient:   seedClient,
+		shoot:        shoot,
+	}
+}
+
+// Report collects the results of vulnerability scans which extensions reported in the status of their Extension
+// resources and publishes them in the status of the Shoot resource. Results for worker pools which no longer exist are
+// dropped.
+func (r *VulnerabilityScanReport) Report(ctx context.Context) error {
+	extensionList := &extensionsv1alpha1.ExtensionList{}
+	if err := r.seedClient.List(ctx, extensionList, client.InNamespace(r.shoot.Status.TechnicalID)); err != nil {
+		r.log.Error(err, "Failed to list extensions")
+		return fmt.Errorf("failed to list extensions: %w", err)
+	}
+
+	workerPools := sets.New[string]()
+	for _, worker := range r.shoot.Spec.Provider.Workers {
+		workerPools.Insert(worker.Name)
+	}
+
+	var results []gardencorev1beta1.VulnerabilityScanResult
+	for _, extension := range extensionList.Items {
+		if extension.DeletionTimestamp != nil {
+			continue
+		}
+
+		for _, result := range extension.Status.VulnerabilityScanResults {
+			if workerPools.Has(result.WorkerPool) {
+				results = append(results, result)
+			}
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].WorkerPool < results[j].WorkerPool
+	})
+
+	if apiequality.Semantic.DeepEqual(r.shoot.Status.VulnerabilityScanResults, results) {
+		return nil
+	}
+
+	// Work on a copy since the shoot object is shared with the other care tasks running in parallel.
+	shoot := r.shoot.DeepCopy()
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.VulnerabilityScanResults = results
+
+	if err := r.gardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		r.log.Error(err, "Failed to publish vulnerability scan results in Shoot resource")
+		return fmt.Errorf("failed to publish vulnerability scan results in Shoot resource: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("VulnerabilityScanReport", func() {
+	var (
+		ctx = context.Background()
+
+		gardenClient client.Client
+		seedClient   client.Client
+
+		shoot *gardencorev1beta1.Shoot
+
+		reporter *VulnerabilityScanReport
+
+		scanTime = metav1.NewTime(time.Unix(1700000000, 0))
+
+		resultWorker1, resultWorker2, resultRemovedWorker gardencorev1beta1.VulnerabilityScanResult
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "worker-1"}, {Name: "worker-2"}},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{TechnicalID: "shoot--project--shoot"},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		resultWorker1 = gardencorev1beta1.VulnerabilityScanResult{
+			WorkerPool:          "worker-1",
+			MachineImageName:    "gardenlinux",
+			MachineImageVersion: "1312.2.0",
+			Vulnerabilities: []gardencorev1beta1.MachineImageVulnerability{
+				{ID: "CVE-2023-12345", Severity: gardencorev1beta1.VulnerabilitySeverityHigh},
+			},
+			ScanTime: scanTime,
+		}
+		resultWorker2 = gardencorev1beta1.VulnerabilityScanResult{
+			WorkerPool:          "worker-2",
+			MachineImageName:    "gardenlinux",
+			MachineImageVersion: "1312.3.0",
+			ScanTime:            scanTime,
+		}
+		resultRemovedWorker = gardencorev1beta1.VulnerabilityScanResult{
+			WorkerPool:          "worker-3",
+			MachineImageName:    "gardenlinux",
+			MachineImageVersion: "1312.2.0",
+			ScanTime:            scanTime,
+		}
+
+		reporter = NewVulnerabilityScanReport(logr.Discard(), gardenClient, seedClient, shoot)
+	})
+
+	createExtension := func(name, namespace string, results ...gardencorev1beta1.VulnerabilityScanResult) {
+		extension := &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status:     extensionsv1alpha1.ExtensionStatus{VulnerabilityScanResults: results},
+		}
+		ExpectWithOffset(1, seedClient.Create(ctx, extension)).To(Succeed())
+	}
+
+	Describe("#Report", func() {
+		It("should publish the vulnerability scan results of the existing worker pools in the Shoot status", func() {
+			createExtension("scanner", shoot.Status.TechnicalID, resultWorker2, resultRemovedWorker, resultWorker1)
+			createExtension("other", shoot.Status.TechnicalID)
+			createExtension("scanner", "shoot--project--other", resultWorker1)
+
+			Expect(reporter.Report(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.VulnerabilityScanResults).To(Equal([]gardencorev1beta1.VulnerabilityScanResult{resultWorker1, resultWorker2}))
+		})
+
+		It("should remove the vulnerability scan results if no extension reports them anymore", func() {
+			shoot.Status.VulnerabilityScanResults = []gardencorev1beta1.VulnerabilityScanResult{resultWorker1}
+			Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			Expect(reporter.Report(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.VulnerabilityScanResults).To(BeEmpty())
+		})
+	})
+})
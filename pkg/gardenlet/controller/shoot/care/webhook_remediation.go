@@ -16,15 +16,19 @@ package care
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/go-logr/logr"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,23 +40,35 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
+// EventWebhookRemediated is the reason of the events recorded for the Shoot when a webhook configuration in the shoot
+// cluster was remediated.
+const EventWebhookRemediated = "WebhookRemediated"
+
+// AnnotationRemediatedNamespaceSelectorRequirements is a constant for an annotation on a webhook configuration in the
+// shoot cluster which records the namespaceSelector requirements added by the remediation, keyed by webhook name.
+// They are only kept as long as the webhook would still act on system namespaces without them.
+const AnnotationRemediatedNamespaceSelectorRequirements = "remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements"
+
 // WebhookRemediation contains required information for shoot webhook remediation.
 type WebhookRemediation struct {
 	log                    logr.Logger
 	initializeShootClients ShootClientInit
 	shoot                  *gardencorev1beta1.Shoot
+	recorder               record.EventRecorder
 }
 
 // NewWebhookRemediation creates a new instance for webhook remediation.
-func NewWebhookRemediation(log logr.Logger, shoot *gardencorev1beta1.Shoot, shootClientInit ShootClientInit) *WebhookRemediation {
+func NewWebhookRemediation(log logr.Logger, shoot *gardencorev1beta1.Shoot, shootClientInit ShootClientInit, recorder record.EventRecorder) *WebhookRemediation {
 	return &WebhookRemediation{
 		log:                    log,
 		initializeShootClients: shootClientInit,
 		shoot:                  shoot,
+		recorder:               recorder,
 	}
 }
 
-// Remediate mutates shoot webhooks not following the best practices documented by Kubernetes.
+// Remediate mutates shoot webhooks not following the best practices documented by Kubernetes. Every modification is
+// recorded as an event for the Shoot.
 func (r *WebhookRemediation) Remediate(ctx context.Context) error {
 	shootClient, apiServerRunning, err := r.initializeShootClients()
 	if err != nil {
@@ -81,12 +97,21 @@ func (r *WebhookRemediation) Remediate(ctx context.Context) error {
 			mustPatch     bool
 			patch         = client.StrategicMergeFrom(webhookConfig.DeepCopy())
 			remediations  []string
+			restorations  []string
 			matchers      []webhookmatchers.WebhookConstraintMatcher
+
+			addedRequirements = r.addedNamespaceSelectorRequirements(webhookConfig)
 		)
 
 		for i, w := range webhookConfig.Webhooks {
 			remediate := newRemediator(r.log, "ValidatingWebhookConfiguration", webhookConfig.Name, w.Name, &remediations)
 
+			if namespaceSelector, ok := remediate.restoreNamespaceSelector(w.Rules, w.ObjectSelector, w.NamespaceSelector, w.FailurePolicy, addedRequirements, &restorations); ok {
+				mustPatch = true
+				webhookConfig.Webhooks[i].NamespaceSelector = namespaceSelector
+				w.NamespaceSelector = namespaceSelector
+			}
+
 			if mustRemediateTimeoutSecondsIfLeaseResource(w.Rules, w.ObjectSelector, w.NamespaceSelector, w.TimeoutSeconds) {
 				mustPatch = true
 				webhookConfig.Webhooks[i].TimeoutSeconds = remediate.timeoutSecondsToThree()
@@ -113,11 +138,15 @@ func (r *WebhookRemediation) Remediate(ctx context.Context) error {
 				objectSelector, namespaceSelector := remediate.selectors(matchers)
 				webhookConfig.Webhooks[i].ObjectSelector = extendSelector(webhookConfig.Webhooks[i].ObjectSelector, objectSelector...)
 				webhookConfig.Webhooks[i].NamespaceSelector = extendSelector(webhookConfig.Webhooks[i].NamespaceSelector, namespaceSelector...)
+				if len(namespaceSelector) > 0 {
+					addedRequirements[w.Name] = append(addedRequirements[w.Name], namespaceSelector...)
+				}
 			}
 		}
 
 		if mustPatch {
-			fns = append(fns, newPatchFunc(shootClient.Client(), webhookConfig, patch, remediations))
+			setAddedNamespaceSelectorRequirements(webhookConfig, addedRequirements)
+			fns = append(fns, r.newPatchFunc(shootClient.Client(), "ValidatingWebhookConfiguration", webhookConfig, patch, remediations, restorations))
 		}
 	}
 
@@ -132,12 +161,21 @@ func (r *WebhookRemediation) Remediate(ctx context.Context) error {
 			mustPatch     bool
 			patch         = client.StrategicMergeFrom(webhookConfig.DeepCopy())
 			remediations  []string
+			restorations  []string
 			matchers      []webhookmatchers.WebhookConstraintMatcher
+
+			addedRequirements = r.addedNamespaceSelectorRequirements(webhookConfig)
 		)
 
 		for i, w := range webhookConfig.Webhooks {
 			remediate := newRemediator(r.log, "MutatingWebhookConfiguration", webhookConfig.Name, w.Name, &remediations)
 
+			if namespaceSelector, ok := remediate.restoreNamespaceSelector(w.Rules, w.ObjectSelector, w.NamespaceSelector, w.FailurePolicy, addedRequirements, &restorations); ok {
+				mustPatch = true
+				webhookConfig.Webhooks[i].NamespaceSelector = namespaceSelector
+				w.NamespaceSelector = namespaceSelector
+			}
+
 			if mustRemediateTimeoutSecondsIfLeaseResource(w.Rules, w.ObjectSelector, w.NamespaceSelector, w.TimeoutSeconds) {
 				mustPatch = true
 				webhookConfig.Webhooks[i].TimeoutSeconds = remediate.timeoutSecondsToThree()
@@ -164,11 +202,15 @@ func (r *WebhookRemediation) Remediate(ctx context.Context) error {
 				objectSelector, namespaceSelector := remediate.selectors(matchers)
 				webhookConfig.Webhooks[i].ObjectSelector = extendSelector(webhookConfig.Webhooks[i].ObjectSelector, objectSelector...)
 				webhookConfig.Webhooks[i].NamespaceSelector = extendSelector(webhookConfig.Webhooks[i].NamespaceSelector, namespaceSelector...)
+				if len(namespaceSelector) > 0 {
+					addedRequirements[w.Name] = append(addedRequirements[w.Name], namespaceSelector...)
+				}
 			}
 		}
 
 		if mustPatch {
-			fns = append(fns, newPatchFunc(shootClient.Client(), webhookConfig, patch, remediations))
+			setAddedNamespaceSelectorRequirements(webhookConfig, addedRequirements)
+			fns = append(fns, r.newPatchFunc(shootClient.Client(), "MutatingWebhookConfiguration", webhookConfig, patch, remediations, restorations))
 		}
 	}
 
@@ -280,6 +322,32 @@ func (r *remediator) selectors(matchers []webhookmatchers.WebhookConstraintMatch
 	return
 }
 
+// restoreNamespaceSelector removes the namespaceSelector requirements previously added by the remediation as soon as
+// the webhook does not act on system namespaces anymore without them, e.g. because the user excluded them on their own
+// or changed the failurePolicy to Ignore. It returns the restored selector and whether it was changed.
+func (r *remediator) restoreNamespaceSelector(
+	rules []admissionregistrationv1.RuleWithOperations,
+	objectSelector, namespaceSelector *metav1.LabelSelector,
+	failurePolicy *admissionregistrationv1.FailurePolicyType,
+	addedRequirements map[string][]metav1.LabelSelectorRequirement,
+	restorations *[]string,
+) (*metav1.LabelSelector, bool) {
+	added, ok := addedRequirements[r.webhookName]
+	if !ok {
+		return nil, false
+	}
+
+	restored := removeRequirements(namespaceSelector, added)
+	if (failurePolicy == nil || *failurePolicy != admissionregistrationv1.Ignore) && len(getMatchingRules(rules, objectSelector, restored)) > 0 {
+		return nil, false
+	}
+
+	delete(addedRequirements, r.webhookName)
+	r.log.Info("Restoring", "fieldName", "namespaceSelector")
+	*restorations = append(*restorations, fmt.Sprintf("namespaceSelector of webhook %q was restored by removing %s", r.webhookName, added))
+	return restored, true
+}
+
 func (r *remediator) failurePolicy() *admissionregistrationv1.FailurePolicyType {
 	ignore := admissionregistrationv1.Ignore
 	r.reportf("failurePolicy", "set to %s", ignore)
@@ -291,7 +359,66 @@ func (r *remediator) reportf(fieldName string, messageFmt string, args ...interf
 	*r.remediations = append(*r.remediations, fmt.Sprintf("%s of webhook %q was %s", fieldName, r.webhookName, fmt.Sprintf(messageFmt, args...)))
 }
 
-func newPatchFunc(shootClient client.Client, webhookConfig client.Object, patch client.Patch, remediations []string) func(context.Context) error {
+func (r *WebhookRemediation) addedNamespaceSelectorRequirements(webhookConfig client.Object) map[string][]metav1.LabelSelectorRequirement {
+	addedRequirements := make(map[string][]metav1.LabelSelectorRequirement)
+
+	value, ok := webhookConfig.GetAnnotations()[AnnotationRemediatedNamespaceSelectorRequirements]
+	if !ok {
+		return addedRequirements
+	}
+
+	if err := json.Unmarshal([]byte(value), &addedRequirements); err != nil {
+		r.log.Error(err, "Failed to parse added namespaceSelector requirements, ignoring them", "webhookConfigName", webhookConfig.GetName())
+		return make(map[string][]metav1.LabelSelectorRequirement)
+	}
+
+	return addedRequirements
+}
+
+func setAddedNamespaceSelectorRequirements(webhookConfig client.Object, addedRequirements map[string][]metav1.LabelSelectorRequirement) {
+	annotations := webhookConfig.GetAnnotations()
+
+	if len(addedRequirements) == 0 {
+		delete(annotations, AnnotationRemediatedNamespaceSelectorRequirements)
+		webhookConfig.SetAnnotations(annotations)
+		return
+	}
+
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	// marshalling a map of label selector requirements cannot fail
+	value, _ := json.Marshal(addedRequirements)
+	annotations[AnnotationRemediatedNamespaceSelectorRequirements] = string(value)
+	webhookConfig.SetAnnotations(annotations)
+}
+
+func (r *WebhookRemediation) newPatchFunc(shootClient client.Client, webhookConfigKind string, webhookConfig client.Object, patch client.Patch, remediations, restorations []string) func(context.Context) error {
+	if len(remediations) > 0 {
+		setWarningAnnotation(webhookConfig, remediations)
+	} else if _, ok := webhookConfig.GetAnnotations()[AnnotationRemediatedNamespaceSelectorRequirements]; len(restorations) > 0 && !ok {
+		// All namespaceSelector exclusions added by Gardener have been restored, hence the warning is obsolete.
+		annotations := webhookConfig.GetAnnotations()
+		delete(annotations, v1beta1constants.GardenerWarning)
+		webhookConfig.SetAnnotations(annotations)
+	}
+
+	return func(ctx context.Context) error {
+		if err := shootClient.Patch(ctx, webhookConfig, patch); err != nil {
+			return err
+		}
+
+		for _, remediation := range remediations {
+			r.recorder.Eventf(r.shoot, corev1.EventTypeWarning, EventWebhookRemediated, "%s %q was modified: %s", webhookConfigKind, webhookConfig.GetName(), remediation)
+		}
+		for _, restoration := range restorations {
+			r.recorder.Eventf(r.shoot, corev1.EventTypeNormal, EventWebhookRemediated, "%s %q was modified: %s", webhookConfigKind, webhookConfig.GetName(), restoration)
+		}
+		return nil
+	}
+}
+
+func setWarningAnnotation(webhookConfig client.Object, remediations []string) {
 	annotations := webhookConfig.GetAnnotations()
 	if annotations == nil {
 		annotations = make(map[string]string, 1)
@@ -306,10 +433,6 @@ func newPatchFunc(shootClient client.Client, webhookConfig client.Object, patch
 		"The following modifications have been made:\n" +
 		strings.Join(addHyphenPrefix(remediations), "\n")
 	webhookConfig.SetAnnotations(annotations)
-
-	return func(ctx context.Context) error {
-		return shootClient.Patch(ctx, webhookConfig, patch)
-	}
 }
 
 func addHyphenPrefix(list []string) []string {
@@ -352,6 +475,27 @@ func removeDuplicateRequirements(requirements []metav1.LabelSelectorRequirement)
 	return out
 }
 
+func removeRequirements(selector *metav1.LabelSelector, requirements []metav1.LabelSelectorRequirement) *metav1.LabelSelector {
+	if selector == nil {
+		return nil
+	}
+
+	out := selector.DeepCopy()
+	out.MatchExpressions = nil
+
+outer:
+	for _, requirement := range selector.MatchExpressions {
+		for _, toRemove := range requirements {
+			if equality.Semantic.DeepEqual(requirement, toRemove) {
+				continue outer
+			}
+		}
+		out.MatchExpressions = append(out.MatchExpressions, requirement)
+	}
+
+	return out
+}
+
 func extendSelector(selector *metav1.LabelSelector, requirements ...metav1.LabelSelectorRequirement) *metav1.LabelSelector {
 	if selector == nil {
 		selector = &metav1.LabelSelector{}
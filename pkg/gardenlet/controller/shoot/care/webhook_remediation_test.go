@@ -16,6 +16,7 @@ package care_test
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -23,6 +24,7 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -43,7 +45,8 @@ var _ = Describe("WebhookRemediation", func() {
 		fakeKubernetesInterface kubernetes.Interface
 		shootClientInit         func() (kubernetes.Interface, bool, error)
 
-		shoot *gardencorev1beta1.Shoot
+		shoot    *gardencorev1beta1.Shoot
+		recorder *record.FakeRecorder
 
 		remediator *WebhookRemediation
 	)
@@ -56,8 +59,9 @@ var _ = Describe("WebhookRemediation", func() {
 		}
 
 		shoot = &gardencorev1beta1.Shoot{}
+		recorder = record.NewFakeRecorder(10)
 
-		remediator = NewWebhookRemediation(logr.Discard(), shoot, shootClientInit)
+		remediator = NewWebhookRemediation(logr.Discard(), shoot, shootClientInit, recorder)
 	})
 
 	Describe("#Remediate", func() {
@@ -137,6 +141,27 @@ var _ = Describe("WebhookRemediation", func() {
 			Expect(mutatingWebhookConfiguration.Annotations).NotTo(HaveKey("gardener.cloud/warning"))
 		})
 
+		It("should record an event for every modification", func() {
+			validatingWebhookConfiguration.Webhooks = []admissionregistrationv1.ValidatingWebhook{{
+				Name:           "some-webhook.example.com",
+				FailurePolicy:  &ignore,
+				TimeoutSeconds: pointer.Int32(30),
+			}}
+			mutatingWebhookConfiguration.Webhooks = []admissionregistrationv1.MutatingWebhook{{
+				Name:           "some-webhook.example.com",
+				FailurePolicy:  &ignore,
+				TimeoutSeconds: pointer.Int32(10),
+			}}
+
+			Expect(fakeClient.Create(ctx, validatingWebhookConfiguration)).To(Succeed())
+			Expect(fakeClient.Create(ctx, mutatingWebhookConfiguration)).To(Succeed())
+
+			Expect(remediator.Remediate(ctx)).To(Succeed())
+
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(Equal(`Warning WebhookRemediated ValidatingWebhookConfiguration "validating" was modified: timeoutSeconds of webhook "some-webhook.example.com" was set to 15`))
+		})
+
 		It("should succeed when all webhooks are properly configured", func() {
 			validatingWebhookConfiguration.Webhooks = []admissionregistrationv1.ValidatingWebhook{{
 				Name:           "some-webhook.example.com",
@@ -175,6 +200,111 @@ var _ = Describe("WebhookRemediation", func() {
 			Expect(validatingWebhookConfiguration.Annotations).NotTo(HaveKey("gardener.cloud/warning"))
 		})
 
+		Context("temporary namespaceSelector exclusion", func() {
+			var (
+				podsRule = admissionregistrationv1.RuleWithOperations{
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{""},
+						APIVersions: []string{"v1"},
+						Resources:   []string{"pods"},
+					},
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+				}
+				userRequirement   = metav1.LabelSelectorRequirement{Key: "kubernetes.io/metadata.name", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"kube-system"}}
+				addedRequirements = []metav1.LabelSelectorRequirement{
+					{Key: "gardener.cloud/purpose", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"kube-system"}},
+					{Key: "shoot.gardener.cloud/no-cleanup", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"true"}},
+				}
+				addedRequirementsAnnotation = `{"some-webhook.example.com":[{"key":"gardener.cloud/purpose","operator":"NotIn","values":["kube-system"]},{"key":"shoot.gardener.cloud/no-cleanup","operator":"NotIn","values":["true"]}]}`
+			)
+
+			It("should record the added namespaceSelector requirements", func() {
+				validatingWebhookConfiguration.Webhooks = []admissionregistrationv1.ValidatingWebhook{{
+					Name:           "some-webhook.example.com",
+					FailurePolicy:  &fail,
+					TimeoutSeconds: pointer.Int32(10),
+					Rules:          []admissionregistrationv1.RuleWithOperations{podsRule},
+				}}
+				Expect(fakeClient.Create(ctx, validatingWebhookConfiguration)).To(Succeed())
+
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(validatingWebhookConfiguration), validatingWebhookConfiguration)).To(Succeed())
+				Expect(validatingWebhookConfiguration.Annotations).To(HaveKey("remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements"))
+
+				var recorded map[string][]metav1.LabelSelectorRequirement
+				Expect(json.Unmarshal([]byte(validatingWebhookConfiguration.Annotations["remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements"]), &recorded)).To(Succeed())
+				Expect(recorded).To(HaveKeyWithValue("some-webhook.example.com", ConsistOf(validatingWebhookConfiguration.Webhooks[0].NamespaceSelector.MatchExpressions)))
+			})
+
+			It("should keep the added requirements as long as the webhook still acts on system namespaces", func() {
+				validatingWebhookConfiguration.Annotations = map[string]string{"remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements": addedRequirementsAnnotation}
+				validatingWebhookConfiguration.Webhooks = []admissionregistrationv1.ValidatingWebhook{{
+					Name:              "some-webhook.example.com",
+					FailurePolicy:     &fail,
+					TimeoutSeconds:    pointer.Int32(10),
+					Rules:             []admissionregistrationv1.RuleWithOperations{podsRule},
+					NamespaceSelector: &metav1.LabelSelector{MatchExpressions: addedRequirements},
+				}}
+				Expect(fakeClient.Create(ctx, validatingWebhookConfiguration)).To(Succeed())
+
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(validatingWebhookConfiguration), validatingWebhookConfiguration)).To(Succeed())
+				Expect(validatingWebhookConfiguration.Annotations).To(HaveKeyWithValue("remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements", addedRequirementsAnnotation))
+				Expect(validatingWebhookConfiguration.Webhooks[0].NamespaceSelector.MatchExpressions).To(Equal(addedRequirements))
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should remove the added requirements and the warning once the user excluded the system namespaces", func() {
+				validatingWebhookConfiguration.Annotations = map[string]string{
+					"remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements": addedRequirementsAnnotation,
+					"gardener.cloud/warning": "ATTENTION: This webhook configuration has been modified by Gardener",
+				}
+				validatingWebhookConfiguration.Webhooks = []admissionregistrationv1.ValidatingWebhook{{
+					Name:              "some-webhook.example.com",
+					FailurePolicy:     &fail,
+					TimeoutSeconds:    pointer.Int32(10),
+					Rules:             []admissionregistrationv1.RuleWithOperations{podsRule},
+					NamespaceSelector: &metav1.LabelSelector{MatchExpressions: append([]metav1.LabelSelectorRequirement{userRequirement}, addedRequirements...)},
+				}}
+				Expect(fakeClient.Create(ctx, validatingWebhookConfiguration)).To(Succeed())
+
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(validatingWebhookConfiguration), validatingWebhookConfiguration)).To(Succeed())
+				Expect(validatingWebhookConfiguration.Annotations).NotTo(HaveKey("remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements"))
+				Expect(validatingWebhookConfiguration.Annotations).NotTo(HaveKey("gardener.cloud/warning"))
+				Expect(validatingWebhookConfiguration.Webhooks[0].NamespaceSelector.MatchExpressions).To(ConsistOf(userRequirement))
+				Expect(recorder.Events).To(HaveLen(1))
+				Expect(<-recorder.Events).To(HavePrefix(`Normal WebhookRemediated ValidatingWebhookConfiguration "validating" was modified: namespaceSelector of webhook "some-webhook.example.com" was restored`))
+			})
+
+			It("should remove the added requirements and the warning once the failurePolicy was changed to Ignore", func() {
+				mutatingWebhookConfiguration.Annotations = map[string]string{
+					"remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements": addedRequirementsAnnotation,
+					"gardener.cloud/warning": "ATTENTION: This webhook configuration has been modified by Gardener",
+				}
+				mutatingWebhookConfiguration.Webhooks = []admissionregistrationv1.MutatingWebhook{{
+					Name:              "some-webhook.example.com",
+					FailurePolicy:     &ignore,
+					TimeoutSeconds:    pointer.Int32(10),
+					Rules:             []admissionregistrationv1.RuleWithOperations{podsRule},
+					NamespaceSelector: &metav1.LabelSelector{MatchExpressions: addedRequirements},
+				}}
+				Expect(fakeClient.Create(ctx, mutatingWebhookConfiguration)).To(Succeed())
+
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mutatingWebhookConfiguration), mutatingWebhookConfiguration)).To(Succeed())
+				Expect(mutatingWebhookConfiguration.Annotations).NotTo(HaveKey("remediation.webhook.shoot.gardener.cloud/namespace-selector-requirements"))
+				Expect(mutatingWebhookConfiguration.Annotations).NotTo(HaveKey("gardener.cloud/warning"))
+				Expect(mutatingWebhookConfiguration.Webhooks[0].NamespaceSelector.MatchExpressions).To(BeEmpty())
+				Expect(recorder.Events).To(HaveLen(1))
+				Expect(<-recorder.Events).To(HavePrefix(`Normal WebhookRemediated MutatingWebhookConfiguration "mutating" was modified: namespaceSelector of webhook "some-webhook.example.com" was restored`))
+			})
+		})
+
 		Context("remediate offensive webhooks", func() {
 			Context("validating", func() {
 				It("timeoutSeconds", func() {
@@ -0,0 +1,103 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "shoot-metering"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster, seedCluster cluster.Cluster) error {
+	if r.GardenClient == nil {
+		r.GardenClient = gardenCluster.GetClient()
+	}
+	if r.GardenAPIReader == nil {
+		r.GardenAPIReader = gardenCluster.GetAPIReader()
+	}
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.NewPrometheusQuerier == nil {
+		r.NewPrometheusQuerier = NewPrometheusQuerier
+	}
+	if r.NewSink == nil {
+		r.NewSink = NewSink
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: pointer.IntDeref(r.Config.ConcurrentSyncs, 0)}).
+		WatchesRawSource(
+			source.Kind(gardenCluster.GetCache(), &gardencorev1beta1.Shoot{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(
+				r.SeedNamePredicate(),
+				r.SeedNameChangedPredicate(),
+			),
+		).
+		Complete(r)
+}
+
+// SeedNamePredicate returns a predicate which returns true for shoots whose seed name in the spec matches the seed name
+// the reconciler is configured with.
+func (r *Reconciler) SeedNamePredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		shoot, ok := obj.(*gardencorev1beta1.Shoot)
+		if !ok {
+			return false
+		}
+
+		return pointer.StringDeref(shoot.Spec.SeedName, "") == r.SeedName
+	})
+}
+
+// SeedNameChangedPredicate returns a predicate which returns true for all events except updates - here it only returns
+// true when the seed name changed. The reports are generated periodically, hence other updates can be ignored.
+func (r *Reconciler) SeedNameChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return pointer.StringDeref(shoot.Spec.SeedName, "") != pointer.StringDeref(oldShoot.Spec.SeedName, "")
+		},
+	}
+}
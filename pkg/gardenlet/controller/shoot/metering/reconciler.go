@@ -0,0 +1,188 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// AnnotationLastReportTime is the annotation on the shoot namespace in the seed cluster which contains the end of the
+// period covered by the last exported usage report.
+const AnnotationLastReportTime = "metering.gardener.cloud/last-report-time"
+
+// RequeueWhenShootIsNotReadyForMetering is the duration for the requeueing when a shoot is not yet ready for metering.
+var RequeueWhenShootIsNotReadyForMetering = 10 * time.Minute
+
+// Reconciler periodically generates usage reports for the shoots of the seed and exports them to the configured sink.
+// Each report covers the period since the previous report, its end is persisted in the shoot namespace in the seed
+// cluster.
+type Reconciler struct {
+	GardenClient    client.Client
+	GardenAPIReader client.Reader
+	SeedClient      client.Client
+	Config          config.ShootMeteringControllerConfiguration
+	Clock           clock.Clock
+	SeedName        string
+
+	// NewPrometheusQuerier creates a querier for the Prometheus of the given shoot. Exposed for testing.
+	NewPrometheusQuerier func(shoot *gardencorev1beta1.Shoot) (PrometheusQuerier, error)
+	// NewSink creates the sink for the given configuration. Exposed for testing.
+	NewSink func(ctx context.Context, c client.Reader, cfg config.ShootMeteringSink) (Sink, error)
+}
+
+// Reconcile generates a usage report for the shoot and exports it if the sync period has passed since the last report.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || pointer.StringDeref(shoot.Spec.SeedName, "") != r.SeedName {
+		return reconcile.Result{}, nil
+	}
+
+	if !shootCreatedSuccessfully(shoot.Status) || shootInMigration(shoot.Status) {
+		log.Info("Requeuing because shoot was not yet successfully created or is currently in migration", "requeueAfter", RequeueWhenShootIsNotReadyForMetering)
+		return reconcile.Result{RequeueAfter: RequeueWhenShootIsNotReadyForMetering}, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: shoot.Status.TechnicalID}, namespace); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed getting shoot namespace %s in seed: %w", shoot.Status.TechnicalID, err)
+	}
+
+	now := r.Clock.Now().UTC()
+
+	v, ok := namespace.Annotations[AnnotationLastReportTime]
+	if !ok {
+		// There is no previous report, hence metering starts now and the first report is generated after the sync period.
+		log.Info("Starting metering of shoot")
+		if err := r.patchLastReportTime(ctx, namespace, now); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	lastReport, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed parsing timestamp %q on shoot namespace: %w", v, err)
+	}
+	lastReport = lastReport.UTC()
+
+	if nextReportDue := lastReport.Add(r.Config.SyncPeriod.Duration); now.Before(nextReportDue) {
+		log.V(1).Info("No need to generate usage report yet", "lastReport", lastReport, "nextReportDue", nextReportDue)
+		return reconcile.Result{RequeueAfter: nextReportDue.Sub(now)}, nil
+	}
+
+	report, err := r.generateReport(ctx, shoot, lastReport, now)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed generating usage report: %w", err)
+	}
+
+	sink, err := r.NewSink(ctx, r.SeedClient, r.Config.Sink)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed creating sink for usage reports: %w", err)
+	}
+
+	if err := sink.Export(ctx, report); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed exporting usage report: %w", err)
+	}
+	log.Info("Exported usage report", "periodStart", report.PeriodStart, "periodEnd", report.PeriodEnd)
+
+	if err := r.patchLastReportTime(ctx, namespace, now); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) generateReport(ctx context.Context, shoot *gardencorev1beta1.Shoot, periodStart, periodEnd time.Time) (*UsageReport, error) {
+	project, _, err := gardenerutils.ProjectAndNamespaceFromReader(ctx, r.GardenAPIReader, shoot.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting project for namespace %s: %w", shoot.Namespace, err)
+	}
+	if project == nil {
+		return nil, fmt.Errorf("namespace %s does not belong to a project", shoot.Namespace)
+	}
+
+	prometheus, err := r.NewPrometheusQuerier(shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating Prometheus client: %w", err)
+	}
+
+	usage, err := QueryUsage(ctx, prometheus, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsageReport{
+		Project:        project.Name,
+		CostCenter:     project.Labels[pointer.StringDeref(r.Config.CostCenterLabel, "")],
+		Seed:           r.SeedName,
+		ShootNamespace: shoot.Namespace,
+		ShootName:      shoot.Name,
+		ShootUID:       string(shoot.UID),
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		Usage:          *usage,
+	}, nil
+}
+
+func (r *Reconciler) patchLastReportTime(ctx context.Context, namespace *corev1.Namespace, t time.Time) error {
+	patch := client.MergeFrom(namespace.DeepCopy())
+	metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, AnnotationLastReportTime, t.Format(time.RFC3339))
+	if err := r.SeedClient.Patch(ctx, namespace, patch); err != nil {
+		return fmt.Errorf("failed patching last report time of shoot namespace %s: %w", namespace.Name, err)
+	}
+	return nil
+}
+
+func shootCreatedSuccessfully(status gardencorev1beta1.ShootStatus) bool {
+	return status.LastOperation != nil &&
+		((status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate && status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded) ||
+			status.LastOperation.Type != gardencorev1beta1.LastOperationTypeCreate)
+}
+
+func shootInMigration(status gardencorev1beta1.ShootStatus) bool {
+	return status.LastOperation != nil &&
+		((status.LastOperation.Type == gardencorev1beta1.LastOperationTypeMigrate) ||
+			(status.LastOperation.Type == gardencorev1beta1.LastOperationTypeRestore && status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded))
+}
@@ -0,0 +1,209 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/metering"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		sink         *fakeSink
+		reconciler   *Reconciler
+
+		seedName   = "seed"
+		syncPeriod = time.Hour
+
+		shoot          *gardencorev1beta1.Shoot
+		shootNamespace *corev1.Namespace
+		request        reconcile.Request
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+		sink = &fakeSink{}
+
+		reconciler = &Reconciler{
+			GardenClient:    gardenClient,
+			GardenAPIReader: gardenClient,
+			SeedClient:      seedClient,
+			Config: config.ShootMeteringControllerConfiguration{
+				SyncPeriod:      &metav1.Duration{Duration: syncPeriod},
+				CostCenterLabel: pointer.String("metering.gardener.cloud/cost-center"),
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+			NewPrometheusQuerier: func(*gardencorev1beta1.Shoot) (PrometheusQuerier, error) {
+				return &fakePrometheus{results: map[string]model.Value{
+					"kubelet_running_pods":    model.Vector{{Metric: model.Metric{"node_kubernetes_io_instance_type": "m5.large"}, Value: 3}},
+					"apiserver_request_total": model.Vector{{Value: 42}},
+				}}, nil
+			},
+			NewSink: func(context.Context, client.Reader, config.ShootMeteringSink) (Sink, error) {
+				return sink, nil
+			},
+		}
+
+		Expect(gardenClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "garden-dev",
+			Labels: map[string]string{v1beta1constants.ProjectName: "dev"},
+		}})).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "dev",
+				Labels: map[string]string{"metering.gardener.cloud/cost-center": "cc-4711"},
+			},
+			Spec: gardencorev1beta1.ProjectSpec{Namespace: pointer.String("garden-dev")},
+		})).To(Succeed())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev", UID: "uid"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: &seedName},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID: "shoot--dev--foo",
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		shootNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: shoot.Status.TechnicalID}}
+		Expect(seedClient.Create(ctx, shootNamespace)).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	setLastReportTime := func(t time.Time) {
+		metav1.SetMetaDataAnnotation(&shootNamespace.ObjectMeta, AnnotationLastReportTime, t.Format(time.RFC3339))
+		Expect(seedClient.Update(ctx, shootNamespace)).To(Succeed())
+	}
+
+	expectLastReportTime := func(t time.Time) {
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(shootNamespace), shootNamespace)).To(Succeed())
+		Expect(shootNamespace.Annotations).To(HaveKeyWithValue(AnnotationLastReportTime, t.Format(time.RFC3339)))
+	}
+
+	It("should stop reconciling if the shoot is gone", func() {
+		Expect(gardenClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should stop reconciling if the shoot is not managed by this seed", func() {
+		shoot.Spec.SeedName = pointer.String("other")
+		Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sink.reports).To(BeEmpty())
+	})
+
+	It("should requeue if the shoot was not yet created successfully", func() {
+		shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+		shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+		Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueWhenShootIsNotReadyForMetering}))
+		Expect(sink.reports).To(BeEmpty())
+	})
+
+	It("should start metering if there is no previous report", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(sink.reports).To(BeEmpty())
+		expectLastReportTime(fakeClock.Now())
+	})
+
+	It("should requeue if the next report is not yet due", func() {
+		setLastReportTime(fakeClock.Now().Add(-15 * time.Minute))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 45 * time.Minute}))
+		Expect(sink.reports).To(BeEmpty())
+	})
+
+	It("should export a report for the period since the last report", func() {
+		lastReport := fakeClock.Now().Add(-90 * time.Minute)
+		setLastReportTime(lastReport)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(sink.reports).To(ConsistOf(&UsageReport{
+			Project:        "dev",
+			CostCenter:     "cc-4711",
+			Seed:           seedName,
+			ShootNamespace: "garden-dev",
+			ShootName:      "foo",
+			ShootUID:       "uid",
+			PeriodStart:    lastReport,
+			PeriodEnd:      fakeClock.Now(),
+			Usage: Usage{
+				NodeHours:         []NodeHours{{MachineType: "m5.large", Hours: 3}},
+				APIServerRequests: 42,
+			},
+		}))
+		expectLastReportTime(fakeClock.Now())
+	})
+
+	It("should not update the last report time if the export fails", func() {
+		lastReport := fakeClock.Now().Add(-90 * time.Minute)
+		setLastReportTime(lastReport)
+		sink.err = fmt.Errorf("fake")
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("fake")))
+
+		expectLastReportTime(lastReport)
+	})
+})
+
+type fakeSink struct {
+	reports []*UsageReport
+	err     error
+}
+
+func (f *fakeSink) Export(_ context.Context, report *UsageReport) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.reports = append(f.reports, report)
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// The kubelets of the shoot are scraped with the labels of their nodes, hence the number of nodes per machine type
+	// can be determined by counting the series. The count is sampled once per minute, i.e., each sample accounts for
+	// 1/60 node hour.
+	queryNodeHours = `sum_over_time(count by (node_kubernetes_io_instance_type) (kubelet_running_pods{job="kube-kubelet",type="shoot"})[%s:1m]) / 60`
+	// queryAPIServerRequests determines the number of requests served by the kube-apiserver.
+	queryAPIServerRequests = `sum(increase(apiserver_request_total{job="kube-apiserver"}[%s]))`
+	// queryStorageGibibyteHours determines the storage requested by the persistent volume claims in the shoot. The sum
+	// is sampled once per minute, i.e., each sample accounts for 1/60 hour.
+	queryStorageGibibyteHours = `sum_over_time(sum(kube_persistentvolumeclaim_resource_requests_storage_bytes{job="kube-state-metrics",type="shoot"})[%s:1m]) / 60 / 1073741824`
+
+	labelInstanceType = "node_kubernetes_io_instance_type"
+	// unknownMachineType is used for nodes without the `node.kubernetes.io/instance-type` label.
+	unknownMachineType = "unknown"
+)
+
+// UsageReport is a usage report of a shoot for a certain period.
+type UsageReport struct {
+	// Project is the name of the project of the shoot.
+	Project string `json:"project"`
+	// CostCenter is the value of the cost center label of the project.
+	CostCenter string `json:"costCenter,omitempty"`
+	// Seed is the name of the seed the shoot is running on.
+	Seed string `json:"seed"`
+	// ShootNamespace is the namespace of the shoot in the garden cluster.
+	ShootNamespace string `json:"shootNamespace"`
+	// ShootName is the name of the shoot.
+	ShootName string `json:"shootName"`
+	// ShootUID is the UID of the shoot.
+	ShootUID string `json:"shootUID"`
+	// PeriodStart is the start of the period covered by the report.
+	PeriodStart time.Time `json:"periodStart"`
+	// PeriodEnd is the end of the period covered by the report.
+	PeriodEnd time.Time `json:"periodEnd"`
+
+	Usage `json:",inline"`
+}
+
+// Usage is the usage of a shoot in a certain period.
+type Usage struct {
+	// NodeHours are the node hours per machine type, sorted by machine type.
+	NodeHours []NodeHours `json:"nodeHours"`
+	// APIServerRequests is the number of requests served by the kube-apiserver.
+	APIServerRequests float64 `json:"apiServerRequests"`
+	// StorageGibibyteHours are the gibibyte hours of the storage requested by persistent volume claims in the shoot.
+	StorageGibibyteHours float64 `json:"storageGibibyteHours"`
+}
+
+// NodeHours are the node hours of a machine type.
+type NodeHours struct {
+	// MachineType is the machine type of the nodes.
+	MachineType string `json:"machineType"`
+	// Hours is the sum of the hours the nodes were running.
+	Hours float64 `json:"hours"`
+}
+
+// PrometheusQuerier is used to query Prometheus.
+type PrometheusQuerier interface {
+	Query(ctx context.Context, query string, ts time.Time, opts ...promv1.Option) (model.Value, promv1.Warnings, error)
+}
+
+// NewPrometheusQuerier returns a querier for the Prometheus running in the shoot's control plane namespace.
+func NewPrometheusQuerier(shoot *gardencorev1beta1.Shoot) (PrometheusQuerier, error) {
+	prometheusClient, err := promapi.NewClient(promapi.Config{Address: fmt.Sprintf("http://prometheus-web.%s.svc", shoot.Status.TechnicalID)})
+	if err != nil {
+		return nil, err
+	}
+
+	return promv1.NewAPI(prometheusClient), nil
+}
+
+// QueryUsage queries the usage of the shoot in the given period from its Prometheus.
+func QueryUsage(ctx context.Context, prometheus PrometheusQuerier, periodStart, periodEnd time.Time) (*Usage, error) {
+	window := model.Duration(periodEnd.Sub(periodStart).Round(time.Second)).String()
+
+	nodeHours, err := queryVector(ctx, prometheus, fmt.Sprintf(queryNodeHours, window), periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &Usage{NodeHours: []NodeHours{}}
+	for _, sample := range nodeHours {
+		machineType := string(sample.Metric[labelInstanceType])
+		if machineType == "" {
+			machineType = unknownMachineType
+		}
+		usage.NodeHours = append(usage.NodeHours, NodeHours{MachineType: machineType, Hours: round(float64(sample.Value))})
+	}
+	sort.Slice(usage.NodeHours, func(i, j int) bool { return usage.NodeHours[i].MachineType < usage.NodeHours[j].MachineType })
+
+	if usage.APIServerRequests, err = queryScalar(ctx, prometheus, fmt.Sprintf(queryAPIServerRequests, window), periodEnd); err != nil {
+		return nil, err
+	}
+	usage.APIServerRequests = math.Round(usage.APIServerRequests)
+
+	if usage.StorageGibibyteHours, err = queryScalar(ctx, prometheus, fmt.Sprintf(queryStorageGibibyteHours, window), periodEnd); err != nil {
+		return nil, err
+	}
+	usage.StorageGibibyteHours = round(usage.StorageGibibyteHours)
+
+	return usage, nil
+}
+
+func queryVector(ctx context.Context, prometheus PrometheusQuerier, query string, ts time.Time) (model.Vector, error) {
+	value, _, err := prometheus.Query(ctx, query, ts)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying %s: %w", query, err)
+	}
+
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type %s for query %s", value.Type(), query)
+	}
+
+	result := make(model.Vector, 0, len(vector))
+	for _, sample := range vector {
+		if !math.IsNaN(float64(sample.Value)) && !math.IsInf(float64(sample.Value), 0) {
+			result = append(result, sample)
+		}
+	}
+
+	return result, nil
+}
+
+// queryScalar returns the value of the first sample of the given query. It returns 0 if there is no (valid) sample,
+// e.g., because there was no usage in the period.
+func queryScalar(ctx context.Context, prometheus PrometheusQuerier, query string, ts time.Time) (float64, error) {
+	vector, err := queryVector(ctx, prometheus, query, ts)
+	if err != nil || len(vector) == 0 {
+		return 0, err
+	}
+
+	return float64(vector[0].Value), nil
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
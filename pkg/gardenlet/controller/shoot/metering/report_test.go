@@ -0,0 +1,114 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/metering"
+)
+
+var _ = Describe("Report", func() {
+	Describe("#QueryUsage", func() {
+		var (
+			ctx        = context.TODO()
+			prometheus *fakePrometheus
+
+			periodEnd   = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+			periodStart = periodEnd.Add(-time.Hour)
+		)
+
+		BeforeEach(func() {
+			prometheus = &fakePrometheus{results: map[string]model.Value{
+				"kubelet_running_pods": model.Vector{
+					{Metric: model.Metric{"node_kubernetes_io_instance_type": "m5.large"}, Value: 2.5},
+					{Metric: model.Metric{"node_kubernetes_io_instance_type": "c5.xlarge"}, Value: 1},
+					{Metric: model.Metric{}, Value: 0.33333333},
+				},
+				"apiserver_request_total":                                    model.Vector{{Value: 1234.4}},
+				"kube_persistentvolumeclaim_resource_requests_storage_bytes": model.Vector{{Value: 10.00001}},
+			}}
+		})
+
+		It("should query the usage in the period", func() {
+			Expect(QueryUsage(ctx, prometheus, periodStart, periodEnd)).To(Equal(&Usage{
+				NodeHours: []NodeHours{
+					{MachineType: "c5.xlarge", Hours: 1},
+					{MachineType: "m5.large", Hours: 2.5},
+					{MachineType: "unknown", Hours: 0.333},
+				},
+				APIServerRequests:    1234,
+				StorageGibibyteHours: 10,
+			}))
+
+			Expect(prometheus.queries).To(HaveLen(3))
+			for _, q := range prometheus.queries {
+				Expect(q.query).To(MatchRegexp(`\[1h(:1m)?\]`))
+				Expect(q.ts).To(Equal(periodEnd))
+			}
+		})
+
+		It("should report no usage if there are no (valid) samples", func() {
+			prometheus.results = map[string]model.Value{
+				"kubelet_running_pods":    model.Vector{{Value: model.SampleValue(math.NaN())}},
+				"apiserver_request_total": model.Vector{},
+			}
+
+			Expect(QueryUsage(ctx, prometheus, periodStart, periodEnd)).To(Equal(&Usage{NodeHours: []NodeHours{}}))
+		})
+
+		It("should return an error if a query fails", func() {
+			prometheus.err = fmt.Errorf("fake")
+
+			_, err := QueryUsage(ctx, prometheus, periodStart, periodEnd)
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+		})
+	})
+})
+
+type query struct {
+	query string
+	ts    time.Time
+}
+
+// fakePrometheus returns the result of the first entry whose key is contained in the query.
+type fakePrometheus struct {
+	results map[string]model.Value
+	err     error
+	queries []query
+}
+
+func (f *fakePrometheus) Query(_ context.Context, q string, ts time.Time, _ ...promv1.Option) (model.Value, promv1.Warnings, error) {
+	f.queries = append(f.queries, query{query: q, ts: ts})
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	for metric, result := range f.results {
+		if strings.Contains(q, metric) {
+			return result, nil, nil
+		}
+	}
+	return model.Vector{}, nil, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Sink exports usage reports.
+type Sink interface {
+	// Export exports the given usage report.
+	Export(ctx context.Context, report *UsageReport) error
+}
+
+// sinkTimeout is the timeout for the requests of the sinks.
+const sinkTimeout = time.Minute
+
+// NewSink creates the sink for the given configuration. The credentials are read from the referenced secrets in the
+// seed cluster.
+func NewSink(ctx context.Context, c client.Reader, cfg config.ShootMeteringSink) (Sink, error) {
+	httpClient := &http.Client{Timeout: sinkTimeout}
+
+	switch {
+	case cfg.S3 != nil:
+		secret, err := kubernetesutils.GetSecretByReference(ctx, c, &cfg.S3.SecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading secret for S3 sink: %w", err)
+		}
+		return NewS3Sink(httpClient, *cfg.S3, string(secret.Data[dataKeyAccessKeyID]), string(secret.Data[dataKeySecretAccessKey]))
+
+	case cfg.BigQuery != nil:
+		secret, err := kubernetesutils.GetSecretByReference(ctx, c, &cfg.BigQuery.SecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading secret for BigQuery sink: %w", err)
+		}
+		return NewBigQuerySink(ctx, httpClient, *cfg.BigQuery, secret.Data[dataKeyServiceAccountJSON])
+
+	case cfg.PrometheusRemoteWrite != nil:
+		var credentials map[string][]byte
+		if cfg.PrometheusRemoteWrite.SecretRef != nil {
+			secret, err := kubernetesutils.GetSecretByReference(ctx, c, cfg.PrometheusRemoteWrite.SecretRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed reading secret for Prometheus remote write sink: %w", err)
+			}
+			credentials = secret.Data
+		}
+		return NewPrometheusRemoteWriteSink(httpClient, *cfg.PrometheusRemoteWrite, credentials), nil
+	}
+
+	return nil, fmt.Errorf("no sink configured")
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+const (
+	dataKeyServiceAccountJSON = "serviceaccount.json"
+
+	bigQueryScope = "https://www.googleapis.com/auth/bigquery.insertdata"
+)
+
+// BigQueryEndpoint is the endpoint of the BigQuery API. Exposed for testing.
+var BigQueryEndpoint = "https://bigquery.googleapis.com/bigquery/v2"
+
+type bigQuerySink struct {
+	httpClient *http.Client
+	project    string
+	dataset    string
+	table      string
+}
+
+// NewBigQuerySink creates a sink which inserts the usage reports as rows into the configured BigQuery table via the
+// streaming API. The columns of the table must match the JSON representation of the reports.
+func NewBigQuerySink(ctx context.Context, httpClient *http.Client, cfg config.ShootMeteringBigQuerySink, serviceAccountJSON []byte) (Sink, error) {
+	if len(serviceAccountJSON) == 0 {
+		return nil, fmt.Errorf("secret for BigQuery sink must contain the data key %q", dataKeyServiceAccountJSON)
+	}
+
+	credentials, err := google.CredentialsFromJSON(context.WithValue(ctx, oauth2.HTTPClient, httpClient), serviceAccountJSON, bigQueryScope)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing service account for BigQuery sink: %w", err)
+	}
+
+	return &bigQuerySink{
+		httpClient: &http.Client{
+			Timeout:   httpClient.Timeout,
+			Transport: &oauth2.Transport{Source: credentials.TokenSource, Base: httpClient.Transport},
+		},
+		project: cfg.Project,
+		dataset: cfg.Dataset,
+		table:   cfg.Table,
+	}, nil
+}
+
+type bigQueryInsertAllRequest struct {
+	Rows []bigQueryRow `json:"rows"`
+}
+
+type bigQueryRow struct {
+	InsertID string       `json:"insertId"`
+	JSON     *UsageReport `json:"json"`
+}
+
+type bigQueryInsertAllResponse struct {
+	InsertErrors []struct {
+		Errors []struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"insertErrors"`
+}
+
+func (s *bigQuerySink) Export(ctx context.Context, report *UsageReport) error {
+	// The insert ID allows BigQuery to deduplicate the row if the report is exported again after a failure.
+	body, err := json.Marshal(bigQueryInsertAllRequest{Rows: []bigQueryRow{{
+		InsertID: fmt.Sprintf("%s-%d", report.ShootUID, report.PeriodEnd.Unix()),
+		JSON:     report,
+	}}})
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/projects/%s/datasets/%s/tables/%s/insertAll", BigQueryEndpoint, url.PathEscape(s.project), url.PathEscape(s.dataset), url.PathEscape(s.table))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed inserting usage report into BigQuery: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed inserting usage report into BigQuery: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	result := &bigQueryInsertAllResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed decoding response of BigQuery: %w", err)
+	}
+
+	var messages []string
+	for _, insertError := range result.InsertErrors {
+		for _, e := range insertError.Errors {
+			messages = append(messages, fmt.Sprintf("%s: %s", e.Reason, e.Message))
+		}
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("failed inserting usage report into BigQuery: %s", strings.Join(messages, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/klauspost/compress/snappy"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+const (
+	dataKeyUsername    = "username"
+	dataKeyPassword    = "password"
+	dataKeyBearerToken = "bearerToken"
+
+	metricNodeHours            = "gardener_shoot_metering_node_hours"
+	metricAPIServerRequests    = "gardener_shoot_metering_apiserver_requests"
+	metricStorageGibibyteHours = "gardener_shoot_metering_storage_gibibyte_hours"
+)
+
+type prometheusRemoteWriteSink struct {
+	httpClient  *http.Client
+	url         string
+	username    string
+	password    string
+	bearerToken string
+}
+
+// NewPrometheusRemoteWriteSink creates a sink which sends the usage reports as samples to the configured Prometheus
+// remote write endpoint. Each sample contains the usage of the respective report period and is timestamped with the end
+// of the period.
+func NewPrometheusRemoteWriteSink(httpClient *http.Client, cfg config.ShootMeteringPrometheusRemoteWriteSink, credentials map[string][]byte) Sink {
+	return &prometheusRemoteWriteSink{
+		httpClient:  httpClient,
+		url:         cfg.URL,
+		username:    string(credentials[dataKeyUsername]),
+		password:    string(credentials[dataKeyPassword]),
+		bearerToken: string(credentials[dataKeyBearerToken]),
+	}
+}
+
+type timeSeries struct {
+	labels map[string]string
+	value  float64
+}
+
+func (s *prometheusRemoteWriteSink) Export(ctx context.Context, report *UsageReport) error {
+	commonLabels := map[string]string{
+		"project":         report.Project,
+		"cost_center":     report.CostCenter,
+		"seed":            report.Seed,
+		"shoot_namespace": report.ShootNamespace,
+		"shoot_name":      report.ShootName,
+		"shoot_uid":       report.ShootUID,
+	}
+	withLabels := func(name string, additional map[string]string) map[string]string {
+		labels := map[string]string{"__name__": name}
+		for k, v := range commonLabels {
+			labels[k] = v
+		}
+		for k, v := range additional {
+			labels[k] = v
+		}
+		return labels
+	}
+
+	series := []timeSeries{
+		{labels: withLabels(metricAPIServerRequests, nil), value: report.APIServerRequests},
+		{labels: withLabels(metricStorageGibibyteHours, nil), value: report.StorageGibibyteHours},
+	}
+	for _, nodeHours := range report.NodeHours {
+		series = append(series, timeSeries{labels: withLabels(metricNodeHours, map[string]string{"machine_type": nodeHours.MachineType}), value: nodeHours.Hours})
+	}
+
+	body := snappy.Encode(nil, encodeWriteRequest(series, report.PeriodEnd.UnixMilli()))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	switch {
+	case s.bearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+s.bearerToken)
+	case s.username != "":
+		req.SetBasicAuth(s.username, s.password)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed sending usage report to Prometheus remote write endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed sending usage report to Prometheus remote write endpoint: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return nil
+}
+
+// encodeWriteRequest encodes the given time series as `prometheus.WriteRequest` protobuf message, see
+// https://github.com/prometheus/prometheus/blob/main/prompb/remote.proto.
+func encodeWriteRequest(series []timeSeries, timestamp int64) []byte {
+	var request []byte
+
+	for _, ts := range series {
+		names := make([]string, 0, len(ts.labels))
+		for name, value := range ts.labels {
+			// Empty labels are equivalent to missing labels in Prometheus.
+			if value != "" {
+				names = append(names, name)
+			}
+		}
+		// Remote write requires the labels to be sorted by name.
+		sort.Strings(names)
+
+		var timeSeries []byte
+		for _, name := range names {
+			var label []byte
+			label = protowire.AppendTag(label, 1, protowire.BytesType)
+			label = protowire.AppendString(label, name)
+			label = protowire.AppendTag(label, 2, protowire.BytesType)
+			label = protowire.AppendString(label, ts.labels[name])
+
+			timeSeries = protowire.AppendTag(timeSeries, 1, protowire.BytesType)
+			timeSeries = protowire.AppendBytes(timeSeries, label)
+		}
+
+		var sample []byte
+		sample = protowire.AppendTag(sample, 1, protowire.Fixed64Type)
+		sample = protowire.AppendFixed64(sample, math.Float64bits(ts.value))
+		sample = protowire.AppendTag(sample, 2, protowire.VarintType)
+		sample = protowire.AppendVarint(sample, uint64(timestamp))
+
+		timeSeries = protowire.AppendTag(timeSeries, 2, protowire.BytesType)
+		timeSeries = protowire.AppendBytes(timeSeries, sample)
+
+		request = protowire.AppendTag(request, 1, protowire.BytesType)
+		request = protowire.AppendBytes(request, timeSeries)
+	}
+
+	return request
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+const (
+	dataKeyAccessKeyID     = "accessKeyID"
+	dataKeySecretAccessKey = "secretAccessKey"
+
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3TimeFormat       = "20060102T150405Z"
+	s3DateFormat       = "20060102"
+)
+
+type s3Sink struct {
+	httpClient      *http.Client
+	endpoint        *url.URL
+	bucket          string
+	region          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	now             func() time.Time
+}
+
+// NewS3Sink creates a sink which uploads the usage reports as JSON objects to the configured S3 (compatible) bucket.
+// The objects are named `<prefix>/<project>/<shoot-name>/<period-end>-<shoot-uid>.json`.
+func NewS3Sink(httpClient *http.Client, cfg config.ShootMeteringS3Sink, accessKeyID, secretAccessKey string) (Sink, error) {
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, fmt.Errorf("secret for S3 sink must contain the data keys %q and %q", dataKeyAccessKeyID, dataKeySecretAccessKey)
+	}
+
+	endpoint, err := url.Parse(pointer.StringDeref(cfg.Endpoint, fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing S3 endpoint: %w", err)
+	}
+
+	return &s3Sink{
+		httpClient:      httpClient,
+		endpoint:        endpoint,
+		bucket:          cfg.Bucket,
+		region:          cfg.Region,
+		prefix:          pointer.StringDeref(cfg.Prefix, ""),
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		now:             time.Now,
+	}, nil
+}
+
+func (s *s3Sink) Export(ctx context.Context, report *UsageReport) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	// Use path-style requests since they are supported by all S3 compatible APIs.
+	key := path.Join(s.prefix, report.Project, report.ShootName, fmt.Sprintf("%s-%s.json", report.PeriodEnd.UTC().Format(s3TimeFormat), report.ShootUID))
+	u := *s.endpoint
+	u.Path = path.Join("/", u.Path, s.bucket, key)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	s.sign(req, body)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed uploading usage report to S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed uploading usage report to S3: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return nil
+}
+
+// sign signs the given request with AWS signature version 4, see
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html.
+func (s *s3Sink) sign(req *http.Request, body []byte) {
+	var (
+		now         = s.now().UTC()
+		payloadHash = sha256Hex(body)
+		scope       = strings.Join([]string{now.Format(s3DateFormat), s.region, "s3", "aws4_request"}, "/")
+	)
+
+	req.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	var (
+		signedHeaders    = "content-type;host;x-amz-content-sha256;x-amz-date"
+		canonicalHeaders = "content-type:" + req.Header.Get("Content-Type") + "\n" +
+			"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + now.Format(s3TimeFormat) + "\n"
+		canonicalRequest = strings.Join([]string{req.Method, req.URL.EscapedPath(), req.URL.RawQuery, canonicalHeaders, signedHeaders, payloadHash}, "\n")
+		stringToSign     = strings.Join([]string{s3SigningAlgorithm, now.Format(s3TimeFormat), scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+	)
+
+	signingKey := []byte("AWS4" + s.secretAccessKey)
+	for _, v := range []string{now.Format(s3DateFormat), s.region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, v)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, s.accessKeyID, scope, signedHeaders, hex.EncodeToString(hmacSHA256(signingKey, stringToSign))))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,247 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/metering"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Sink", func() {
+	var (
+		ctx = context.TODO()
+
+		server   *httptest.Server
+		requests []*http.Request
+		bodies   [][]byte
+		handler  http.HandlerFunc
+
+		report *UsageReport
+	)
+
+	BeforeEach(func() {
+		requests, bodies = nil, nil
+		handler = func(http.ResponseWriter, *http.Request) {}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			requests, bodies = append(requests, r), append(bodies, body)
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+
+		report = &UsageReport{
+			Project:        "dev",
+			CostCenter:     "cc-4711",
+			Seed:           "seed",
+			ShootNamespace: "garden-dev",
+			ShootName:      "foo",
+			ShootUID:       "uid",
+			PeriodStart:    time.Date(2023, 10, 1, 11, 0, 0, 0, time.UTC),
+			PeriodEnd:      time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+			Usage: Usage{
+				NodeHours:            []NodeHours{{MachineType: "m5.large", Hours: 3}},
+				APIServerRequests:    42,
+				StorageGibibyteHours: 10,
+			},
+		}
+	})
+
+	Describe("#NewSink", func() {
+		It("should fail if no sink is configured", func() {
+			_, err := NewSink(ctx, fakeclient.NewClientBuilder().Build(), config.ShootMeteringSink{})
+			Expect(err).To(MatchError("no sink configured"))
+		})
+
+		It("should fail if the referenced secret does not exist", func() {
+			_, err := NewSink(ctx, fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build(), config.ShootMeteringSink{
+				S3: &config.ShootMeteringS3Sink{SecretRef: corev1.SecretReference{Name: "metering", Namespace: "garden"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed reading secret for S3 sink")))
+		})
+
+		It("should fail if the secret does not contain the credentials", func() {
+			c := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "metering", Namespace: "garden"},
+			}).Build()
+
+			_, err := NewSink(ctx, c, config.ShootMeteringSink{
+				S3: &config.ShootMeteringS3Sink{SecretRef: corev1.SecretReference{Name: "metering", Namespace: "garden"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("must contain the data keys")))
+		})
+	})
+
+	Describe("S3", func() {
+		var sink Sink
+
+		BeforeEach(func() {
+			var err error
+			sink, err = NewS3Sink(server.Client(), config.ShootMeteringS3Sink{
+				Bucket:   "metering",
+				Region:   "eu-west-1",
+				Endpoint: pointer.String(server.URL),
+				Prefix:   pointer.String("reports"),
+			}, "access-key-id", "secret-access-key")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should upload the report", func() {
+			Expect(sink.Export(ctx, report)).To(Succeed())
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Method).To(Equal(http.MethodPut))
+			Expect(requests[0].URL.Path).To(Equal("/metering/reports/dev/foo/20231001T120000Z-uid.json"))
+			Expect(requests[0].Header.Get("Authorization")).To(MatchRegexp(`^AWS4-HMAC-SHA256 Credential=access-key-id/\d{8}/eu-west-1/s3/aws4_request, SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, Signature=[0-9a-f]{64}$`))
+
+			uploaded := &UsageReport{}
+			Expect(json.Unmarshal(bodies[0], uploaded)).To(Succeed())
+			Expect(uploaded).To(Equal(report))
+		})
+
+		It("should return an error if the upload fails", func() {
+			handler = func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("AccessDenied"))
+			}
+
+			Expect(sink.Export(ctx, report)).To(MatchError(ContainSubstring("403 Forbidden: AccessDenied")))
+		})
+	})
+
+	Describe("BigQuery", func() {
+		var sink Sink
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithVar(&BigQueryEndpoint, server.URL))
+
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			serviceAccount, err := json.Marshal(map[string]string{
+				"type":         "service_account",
+				"client_email": "metering@project.iam.gserviceaccount.com",
+				"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+				"token_uri":    server.URL + "/token",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/token" {
+					_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}
+
+			sink, err = NewBigQuerySink(ctx, server.Client(), config.ShootMeteringBigQuerySink{
+				Project: "project",
+				Dataset: "dataset",
+				Table:   "table",
+			}, serviceAccount)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should insert the report", func() {
+			Expect(sink.Export(ctx, report)).To(Succeed())
+
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[1].URL.Path).To(Equal("/projects/project/datasets/dataset/tables/table/insertAll"))
+			Expect(requests[1].Header.Get("Authorization")).To(Equal("Bearer token"))
+
+			inserted := &struct {
+				Rows []struct {
+					InsertID string       `json:"insertId"`
+					JSON     *UsageReport `json:"json"`
+				} `json:"rows"`
+			}{}
+			Expect(json.Unmarshal(bodies[1], inserted)).To(Succeed())
+			Expect(inserted.Rows).To(HaveLen(1))
+			Expect(inserted.Rows[0].InsertID).To(Equal("uid-1696161600"))
+			Expect(inserted.Rows[0].JSON).To(Equal(report))
+		})
+
+		It("should return an error if the row could not be inserted", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/token" {
+					_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"insertErrors":[{"index":0,"errors":[{"reason":"invalid","message":"no such field: foo"}]}]}`))
+			}
+
+			Expect(sink.Export(ctx, report)).To(MatchError(ContainSubstring("invalid: no such field: foo")))
+		})
+	})
+
+	Describe("Prometheus remote write", func() {
+		It("should send the report as samples", func() {
+			sink := NewPrometheusRemoteWriteSink(server.Client(), config.ShootMeteringPrometheusRemoteWriteSink{URL: server.URL + "/api/v1/write"}, map[string][]byte{"bearerToken": []byte("token")})
+
+			Expect(sink.Export(ctx, report)).To(Succeed())
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].URL.Path).To(Equal("/api/v1/write"))
+			Expect(requests[0].Header.Get("Authorization")).To(Equal("Bearer token"))
+			Expect(requests[0].Header.Get("Content-Encoding")).To(Equal("snappy"))
+
+			body, err := snappy.Decode(nil, bodies[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(And(
+				ContainSubstring("gardener_shoot_metering_node_hours"),
+				ContainSubstring("gardener_shoot_metering_apiserver_requests"),
+				ContainSubstring("gardener_shoot_metering_storage_gibibyte_hours"),
+				ContainSubstring("machine_type"),
+				ContainSubstring("m5.large"),
+				ContainSubstring("cc-4711"),
+			))
+		})
+
+		It("should use basic authentication", func() {
+			sink := NewPrometheusRemoteWriteSink(server.Client(), config.ShootMeteringPrometheusRemoteWriteSink{URL: server.URL}, map[string][]byte{"username": []byte("user"), "password": []byte("pass")})
+
+			Expect(sink.Export(ctx, report)).To(Succeed())
+
+			Expect(requests).To(HaveLen(1))
+			username, password, ok := requests[0].BasicAuth()
+			Expect(ok).To(BeTrue())
+			Expect(username).To(Equal("user"))
+			Expect(password).To(Equal("pass"))
+		})
+	})
+})
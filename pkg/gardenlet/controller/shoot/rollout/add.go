@@ -0,0 +1,75 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "shoot-rollout"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster, seedCluster cluster.Cluster) error {
+	if r.GardenClient == nil {
+		r.GardenClient = gardenCluster.GetClient()
+	}
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: pointer.IntDeref(r.Config.ConcurrentSyncs, 0)}).
+		WatchesRawSource(
+			source.Kind(seedCluster.GetCache(), &extensionsv1alpha1.Worker{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(r.RolloutProgressChangedPredicate()),
+		).
+		Complete(r)
+}
+
+// RolloutProgressChangedPredicate returns a predicate which returns true for all events except updates - here it only
+// returns true when the rollout progress of the machine deployments in the Worker status changed.
+func (r *Reconciler) RolloutProgressChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			worker, ok := e.ObjectNew.(*extensionsv1alpha1.Worker)
+			if !ok {
+				return false
+			}
+
+			oldWorker, ok := e.ObjectOld.(*extensionsv1alpha1.Worker)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(RolloutProgress(worker), RolloutProgress(oldWorker))
+		},
+		DeleteFunc: func(event.DeleteEvent) bool { return false },
+	}
+}
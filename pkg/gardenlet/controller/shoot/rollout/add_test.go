@@ -0,0 +1,72 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/rollout"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#RolloutProgressChangedPredicate", func() {
+		var (
+			p         predicate.Predicate
+			worker    *extensionsv1alpha1.Worker
+			oldWorker *extensionsv1alpha1.Worker
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).RolloutProgressChangedPredicate()
+
+			worker = &extensionsv1alpha1.Worker{
+				Status: extensionsv1alpha1.WorkerStatus{
+					MachineDeployments: []extensionsv1alpha1.MachineDeployment{{Name: "md", Pool: "pool"}},
+				},
+			}
+			oldWorker = worker.DeepCopy()
+		})
+
+		It("should return true for create and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: worker})).To(BeTrue())
+			Expect(p.Generic(event.GenericEvent{Object: worker})).To(BeTrue())
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: worker})).To(BeFalse())
+		})
+
+		It("should return false for update events when objects are no workers", func() {
+			Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: worker})).To(BeFalse())
+		})
+
+		It("should return false for update events when the rollout progress did not change", func() {
+			worker.Status.MachineDeployments[0].Minimum = 3
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldWorker, ObjectNew: worker})).To(BeFalse())
+		})
+
+		It("should return true for update events when the rollout progress changed", func() {
+			worker.Status.MachineDeployments[0].Progress = &extensionsv1alpha1.MachineDeploymentProgress{Desired: 3, Updated: 1, Ready: 2, Old: 2}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldWorker, ObjectNew: worker})).To(BeTrue())
+		})
+	})
+})
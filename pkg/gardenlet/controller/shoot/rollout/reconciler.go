@@ -0,0 +1,123 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// Reconciler reports the rollout progress of the worker pools of shoots in their status. The progress is aggregated
+// from the machine deployments in the Worker status, which is updated by the provider extensions while the machines are
+// rolled out.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       config.ShootControllerConfiguration
+}
+
+// Reconcile reports the rollout progress of the worker pools in the Shoot status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	worker := &extensionsv1alpha1.Worker{}
+	if err := r.SeedClient.Get(ctx, request.NamespacedName, worker); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	clusterShoot, err := extensions.GetShoot(ctx, r.SeedClient, worker.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading shoot from cluster resource: %w", err)
+	}
+	if clusterShoot == nil {
+		log.V(1).Info("Shoot is missing in cluster resource, stop reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKeyFromObject(clusterShoot), shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Shoot is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving shoot from store: %w", err)
+	}
+
+	rolloutProgress := RolloutProgress(worker)
+	if apiequality.Semantic.DeepEqual(shoot.Status.WorkerRolloutProgress, rolloutProgress) {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Updating rollout progress of worker pools", "shoot", client.ObjectKeyFromObject(shoot))
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.WorkerRolloutProgress = rolloutProgress
+	return reconcile.Result{}, r.GardenClient.Status().Patch(ctx, shoot, patch)
+}
+
+// RolloutProgress aggregates the rollout progress of the machine deployments in the given Worker status per worker
+// pool. Machine deployments which are not rolled out or which don't belong to a known worker pool are ignored. The
+// result is sorted by the names of the worker pools.
+func RolloutProgress(worker *extensionsv1alpha1.Worker) []gardencorev1beta1.WorkerPoolRolloutProgress {
+	poolToProgress := make(map[string]*gardencorev1beta1.WorkerPoolRolloutProgress)
+
+	for _, machineDeployment := range worker.Status.MachineDeployments {
+		if machineDeployment.Progress == nil || machineDeployment.Pool == "" {
+			continue
+		}
+
+		progress, ok := poolToProgress[machineDeployment.Pool]
+		if !ok {
+			progress = &gardencorev1beta1.WorkerPoolRolloutProgress{Name: machineDeployment.Pool}
+			poolToProgress[machineDeployment.Pool] = progress
+		}
+
+		progress.Desired += machineDeployment.Progress.Desired
+		progress.Updated += machineDeployment.Progress.Updated
+		progress.Ready += machineDeployment.Progress.Ready
+		progress.Old += machineDeployment.Progress.Old
+	}
+
+	if len(poolToProgress) == 0 {
+		return nil
+	}
+
+	result := make([]gardencorev1beta1.WorkerPoolRolloutProgress, 0, len(poolToProgress))
+	for _, progress := range poolToProgress {
+		result = append(result, *progress)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/rollout"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		reconciler   *Reconciler
+
+		shoot   *gardencorev1beta1.Shoot
+		cluster *extensionsv1alpha1.Cluster
+		worker  *extensionsv1alpha1.Worker
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient}
+
+		shoot = &gardencorev1beta1.Shoot{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+		}
+		cluster = &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--project--shoot"},
+			Spec: extensionsv1alpha1.ClusterSpec{
+				Shoot: runtime.RawExtension{Object: shoot},
+			},
+		}
+		worker = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: cluster.Name},
+			Status: extensionsv1alpha1.WorkerStatus{
+				MachineDeployments: []extensionsv1alpha1.MachineDeployment{
+					{Name: "md-b-z1", Pool: "b", Progress: &extensionsv1alpha1.MachineDeploymentProgress{Desired: 2, Updated: 1, Ready: 2, Old: 1}},
+					{Name: "md-a-z1", Pool: "a", Progress: &extensionsv1alpha1.MachineDeploymentProgress{Desired: 3, Updated: 1, Ready: 3, Old: 2}},
+					{Name: "md-a-z2", Pool: "a", Progress: &extensionsv1alpha1.MachineDeploymentProgress{Desired: 3, Updated: 2, Ready: 2, Old: 1}},
+					{Name: "md-c-z1", Pool: "c"},
+					{Name: "md-unknown", Progress: &extensionsv1alpha1.MachineDeploymentProgress{Desired: 1}},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(worker)}
+
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		Expect(seedClient.Create(ctx, cluster)).To(Succeed())
+	})
+
+	Describe("#RolloutProgress", func() {
+		It("should aggregate the progress per worker pool", func() {
+			Expect(RolloutProgress(worker)).To(Equal([]gardencorev1beta1.WorkerPoolRolloutProgress{
+				{Name: "a", Desired: 6, Updated: 3, Ready: 5, Old: 3},
+				{Name: "b", Desired: 2, Updated: 1, Ready: 2, Old: 1},
+			}))
+		})
+
+		It("should return nil if no machine deployment is rolled out", func() {
+			worker.Status.MachineDeployments = []extensionsv1alpha1.MachineDeployment{{Name: "md-a-z1", Pool: "a"}}
+
+			Expect(RolloutProgress(worker)).To(BeNil())
+		})
+	})
+
+	Describe("#Reconcile", func() {
+		It("should do nothing if the worker is gone", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.WorkerRolloutProgress).To(BeEmpty())
+		})
+
+		It("should do nothing if the shoot is gone", func() {
+			Expect(seedClient.Create(ctx, worker)).To(Succeed())
+			Expect(gardenClient.Delete(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+
+		It("should report the rollout progress in the shoot status", func() {
+			Expect(seedClient.Create(ctx, worker)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.WorkerRolloutProgress).To(Equal([]gardencorev1beta1.WorkerPoolRolloutProgress{
+				{Name: "a", Desired: 6, Updated: 3, Ready: 5, Old: 3},
+				{Name: "b", Desired: 2, Updated: 1, Ready: 2, Old: 1},
+			}))
+		})
+
+		It("should remove the rollout progress from the shoot status once the rollout is finished", func() {
+			shoot.Status.WorkerRolloutProgress = []gardencorev1beta1.WorkerPoolRolloutProgress{{Name: "a", Desired: 3, Updated: 1, Ready: 3, Old: 2}}
+			Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			worker.Status.MachineDeployments = []extensionsv1alpha1.MachineDeployment{{Name: "md-a-z1", Pool: "a"}}
+			Expect(seedClient.Create(ctx, worker)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.WorkerRolloutProgress).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,102 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// virtualNodesPerMember is the number of tokens which are placed on the ring for each member. Placing multiple tokens
+// per member results in a more even distribution of the keys across the members.
+const virtualNodesPerMember = 100
+
+// Ring is a consistent hash ring which assigns keys to a set of members. When a member joins or leaves the ring, only
+// the keys which were assigned to (or are now assigned to) this member move, all other keys keep their owner.
+type Ring struct {
+	members []string
+	tokens  []uint64
+	owners  map[uint64]string
+}
+
+// NewRing returns a new ring for the given members.
+func NewRing(members ...string) *Ring {
+	r := &Ring{
+		members: sets.List(sets.New(members...)),
+		owners:  make(map[uint64]string, len(members)*virtualNodesPerMember),
+	}
+
+	for _, member := range r.members {
+		for i := 0; i < virtualNodesPerMember; i++ {
+			token := hash(member + "-" + strconv.Itoa(i))
+			// In the unlikely case of a hash collision, the lexicographically smallest member wins in order to keep the
+			// assignment deterministic across all replicas computing the ring.
+			if _, ok := r.owners[token]; ok {
+				continue
+			}
+			r.owners[token] = member
+			r.tokens = append(r.tokens, token)
+		}
+	}
+
+	sort.Slice(r.tokens, func(i, j int) bool { return r.tokens[i] < r.tokens[j] })
+	return r
+}
+
+// Owner returns the member the given key is assigned to. It returns an empty string if the ring has no members.
+func (r *Ring) Owner(key string) string {
+	if r == nil || len(r.tokens) == 0 {
+		return ""
+	}
+
+	h := hash(key)
+	idx := sort.Search(len(r.tokens), func(i int) bool { return r.tokens[i] >= h })
+	if idx == len(r.tokens) {
+		idx = 0
+	}
+
+	return r.owners[r.tokens[idx]]
+}
+
+// Members returns the sorted list of members of the ring.
+func (r *Ring) Members() []string {
+	if r == nil {
+		return nil
+	}
+	return append([]string{}, r.members...)
+}
+
+// Equal returns true if both rings consist of the same members.
+func (r *Ring) Equal(other *Ring) bool {
+	a, b := r.Members(), other.Members()
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func hash(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/sharding"
+)
+
+var _ = Describe("Ring", func() {
+	keys := make([]string, 3000)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%d", i)
+	}
+
+	Describe("#Owner", func() {
+		It("should return an empty owner if the ring has no members", func() {
+			Expect(NewRing().Owner("foo")).To(BeEmpty())
+		})
+
+		It("should assign all keys to the only member", func() {
+			ring := NewRing("a")
+
+			for _, key := range keys {
+				Expect(ring.Owner(key)).To(Equal("a"))
+			}
+		})
+
+		It("should assign the keys independent of the order of the members", func() {
+			ring1, ring2 := NewRing("a", "b", "c"), NewRing("c", "a", "b", "a")
+
+			for _, key := range keys {
+				Expect(ring1.Owner(key)).To(Equal(ring2.Owner(key)))
+			}
+		})
+
+		It("should distribute the keys evenly across the members", func() {
+			ring := NewRing("a", "b", "c")
+
+			counts := map[string]int{}
+			for _, key := range keys {
+				counts[ring.Owner(key)]++
+			}
+
+			Expect(counts).To(HaveLen(3))
+			for member, count := range counts {
+				Expect(count).To(BeNumerically(">", len(keys)/5), "member %s owns too few keys", member)
+			}
+		})
+
+		It("should only move keys to a joining member", func() {
+			oldRing, newRing := NewRing("a", "b"), NewRing("a", "b", "c")
+
+			for _, key := range keys {
+				if owner := newRing.Owner(key); owner != "c" {
+					Expect(owner).To(Equal(oldRing.Owner(key)))
+				}
+			}
+		})
+	})
+
+	Describe("#Members", func() {
+		It("should return the sorted and de-duplicated members", func() {
+			Expect(NewRing("c", "a", "b", "a").Members()).To(Equal([]string{"a", "b", "c"}))
+		})
+	})
+
+	Describe("#Equal", func() {
+		It("should return true for rings with the same members", func() {
+			Expect(NewRing("a", "b").Equal(NewRing("b", "a"))).To(BeTrue())
+		})
+
+		It("should return false for rings with different members", func() {
+			Expect(NewRing("a", "b").Equal(NewRing("a"))).To(BeFalse())
+			Expect(NewRing("a", "b").Equal(NewRing("a", "c"))).To(BeFalse())
+		})
+	})
+})
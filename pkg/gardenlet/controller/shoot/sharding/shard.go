@@ -0,0 +1,399 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// LeaseNamePrefix is the prefix for the names of the Leases maintained by the shards.
+	LeaseNamePrefix = "gardenlet-shard-"
+	// AnnotationRingMembers is the annotation on the Lease of a shard which contains the comma-separated members of the
+	// ring as currently seen by the shard.
+	AnnotationRingMembers = "sharding.gardener.cloud/ring-members"
+	// AnnotationShootsInProgress is the annotation on the Lease of a shard which contains the comma-separated UIDs of
+	// the shoots currently processed by the shard.
+	AnnotationShootsInProgress = "sharding.gardener.cloud/shoots-in-progress"
+)
+
+// Shard maintains the membership of a gardenlet replica in the group of replicas which share the shoots of a seed.
+// Each replica periodically renews a Lease in the seed cluster. All replicas with a valid Lease are members of a
+// consistent hash ring over the shoot UIDs, and each replica is only responsible for the shoots assigned to it.
+// Since the replicas observe changes of the ring at different times, each replica publishes its view of the ring and
+// the shoots it is currently processing in its Lease. A replica only starts processing a shoot after all other replicas
+// have released it, see Acquire.
+type Shard struct {
+	// SeedClient is used for maintaining the Leases in the seed cluster.
+	SeedClient client.Client
+	// SeedAPIReader is used for reading the Leases in the seed cluster. It must not be backed by a cache since the
+	// handover of shoots relies on the current state of the Leases of all shards.
+	SeedAPIReader client.Reader
+	// GardenCache is used for listing the shoots which need to be enqueued when the ring changes.
+	GardenCache cache.Cache
+	// Namespace is the namespace in the seed cluster in which the Leases are maintained.
+	Namespace string
+	// Identity is the unique identity of the gardenlet replica.
+	Identity string
+	// SeedName is the name of the seed the gardenlet is responsible for.
+	SeedName string
+	// LeaseDuration is the duration after which a shard whose Lease has not been renewed is considered unavailable.
+	LeaseDuration time.Duration
+	// Clock is the clock.
+	Clock clock.Clock
+	// Log is the logger.
+	Log logr.Logger
+
+	lock        sync.RWMutex
+	ring        *Ring
+	renewTime   time.Time
+	peers       []peer
+	inProgress  map[string]int
+	subscribers []chan event.GenericEvent
+}
+
+// peer is the state of another shard with a valid Lease as published in its Lease.
+type peer struct {
+	identity   string
+	ring       *Ring
+	inProgress sets.Set[string]
+}
+
+// NeedLeaderElection returns false since all gardenlet replicas must take part in the sharding.
+func (s *Shard) NeedLeaderElection() bool {
+	return false
+}
+
+// Subscribe returns a channel on which events for shoots are sent which have been newly assigned to this shard. It
+// must be called before the shard is started.
+func (s *Shard) Subscribe() <-chan event.GenericEvent {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ch := make(chan event.GenericEvent)
+	s.subscribers = append(s.subscribers, ch)
+	return ch
+}
+
+// Start periodically renews the Lease of this shard and updates the ring until the context is cancelled. Afterwards,
+// the Lease is released so that the shoots of this shard are immediately reassigned to the remaining shards.
+func (s *Shard) Start(ctx context.Context) error {
+	if s.Clock == nil {
+		s.Clock = clock.RealClock{}
+	}
+
+	if !s.GardenCache.WaitForCacheSync(ctx) {
+		return fmt.Errorf("failed waiting for garden cache to sync")
+	}
+
+	s.Log.Info("Joining shard ring", "identity", s.Identity)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := s.Sync(ctx); err != nil {
+			s.Log.Error(err, "Failed syncing shard ring")
+		}
+	}, s.SyncPeriod())
+
+	s.Log.Info("Leaving shard ring", "identity", s.Identity)
+	releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.IgnoreNotFound(s.SeedClient.Delete(releaseCtx, s.lease())); err != nil {
+		return fmt.Errorf("failed releasing lease of shard: %w", err)
+	}
+	return nil
+}
+
+// LeaseName returns the name of the Lease of the shard with the given identity. The identity is hashed since it is
+// not necessarily a valid object name.
+func LeaseName(identity string) string {
+	return LeaseNamePrefix + utils.ComputeSHA256Hex([]byte(identity))[:16]
+}
+
+// SyncPeriod returns the period in which the Lease of this shard is renewed and the ring is recomputed.
+func (s *Shard) SyncPeriod() time.Duration {
+	return s.LeaseDuration / 3
+}
+
+// Sync renews the Lease of this shard and recomputes the ring based on all Leases which are still valid. If the
+// members of the ring changed then the new ring is published right away and the shoots newly assigned to this shard
+// are sent to the subscribers.
+func (s *Shard) Sync(ctx context.Context) error {
+	if err := s.renewLease(ctx); err != nil {
+		return fmt.Errorf("failed renewing lease of shard: %w", err)
+	}
+
+	members, peers, err := s.members(ctx)
+	if err != nil {
+		return fmt.Errorf("failed determining members of shard ring: %w", err)
+	}
+
+	newRing := NewRing(members...)
+
+	s.lock.Lock()
+	oldRing := s.ring
+	s.ring = newRing
+	s.peers = peers
+	s.lock.Unlock()
+
+	if oldRing != nil && oldRing.Equal(newRing) {
+		return nil
+	}
+
+	s.Log.Info("Members of shard ring changed", "members", newRing.Members())
+	// Other shards wait until this shard stops claiming the shoots it lost, hence the new ring is published immediately.
+	if err := s.renewLease(ctx); err != nil {
+		return fmt.Errorf("failed publishing new ring in lease of shard: %w", err)
+	}
+	return s.enqueueAcquiredShoots(ctx, oldRing, newRing)
+}
+
+// IsResponsible returns true if the given object is assigned to this shard. A nil shard is responsible for all
+// objects, i.e., sharding is disabled.
+func (s *Shard) IsResponsible(obj client.Object) bool {
+	if s == nil {
+		return true
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.ring.Owner(string(obj.GetUID())) == s.Identity
+}
+
+// Acquire marks the given object as being processed by this shard. It returns false if the object is not assigned to
+// this shard or if another shard might still be processing it, i.e., the other shard either still claims the object
+// in its published ring or has not yet released it. In this case, the caller must retry later. Otherwise, the returned
+// function must be called once the object has been processed. A nil shard acquires all objects.
+func (s *Shard) Acquire(obj client.Object) (release func(), acquired bool) {
+	if s == nil {
+		return func() {}, true
+	}
+
+	uid := string(obj.GetUID())
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ring.Owner(uid) != s.Identity {
+		return nil, false
+	}
+
+	// If this shard failed renewing its Lease, the other shards might already consider it gone and take over its
+	// shoots.
+	if !s.Clock.Now().Before(s.renewTime.Add(s.LeaseDuration)) {
+		return nil, false
+	}
+
+	for _, p := range s.peers {
+		if p.inProgress.Has(uid) || p.ring.Owner(uid) == p.identity {
+			return nil, false
+		}
+	}
+
+	if s.inProgress == nil {
+		s.inProgress = make(map[string]int)
+	}
+	s.inProgress[uid]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+
+			if s.inProgress[uid]--; s.inProgress[uid] <= 0 {
+				delete(s.inProgress, uid)
+			}
+		})
+	}, true
+}
+
+// Predicate returns a predicate which only lets events for objects pass which are assigned to this shard.
+func (s *Shard) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(s.IsResponsible)
+}
+
+func (s *Shard) lease() *coordinationv1.Lease {
+	return &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: LeaseName(s.Identity), Namespace: s.Namespace}}
+}
+
+func (s *Shard) renewLease(ctx context.Context) error {
+	// The in-progress shoots are only removed from the published annotation after they have been released, hence it is
+	// safe to determine them before the Lease is patched.
+	s.lock.RLock()
+	ringMembers := strings.Join(s.ring.Members(), ",")
+	inProgress := sets.List(sets.KeySet(s.inProgress))
+	s.lock.RUnlock()
+
+	var (
+		lease = s.lease()
+		now   = s.Clock.Now()
+	)
+
+	mutate := func() {
+		metav1.SetMetaDataLabel(&lease.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleGardenletShard)
+		metav1.SetMetaDataAnnotation(&lease.ObjectMeta, AnnotationRingMembers, ringMembers)
+		metav1.SetMetaDataAnnotation(&lease.ObjectMeta, AnnotationShootsInProgress, strings.Join(inProgress, ","))
+		lease.Spec.HolderIdentity = pointer.String(s.Identity)
+		lease.Spec.LeaseDurationSeconds = pointer.Int32(int32(s.LeaseDuration.Seconds()))
+		if lease.Spec.AcquireTime == nil {
+			lease.Spec.AcquireTime = &metav1.MicroTime{Time: now}
+		}
+		lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+	}
+
+	if err := s.SeedAPIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		mutate()
+		if err := s.SeedClient.Create(ctx, lease); err != nil {
+			return err
+		}
+	} else {
+		patch := client.MergeFromWithOptions(lease.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		mutate()
+		if err := s.SeedClient.Patch(ctx, lease, patch); err != nil {
+			return err
+		}
+	}
+
+	s.lock.Lock()
+	s.renewTime = now
+	s.lock.Unlock()
+	return nil
+}
+
+func (s *Shard) members(ctx context.Context) ([]string, []peer, error) {
+	leaseList := &coordinationv1.LeaseList{}
+	if err := s.SeedAPIReader.List(ctx, leaseList, client.InNamespace(s.Namespace), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleGardenletShard}); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		members = []string{s.Identity}
+		peers   []peer
+	)
+
+	for i := range leaseList.Items {
+		lease := &leaseList.Items[i]
+		if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+			continue
+		}
+
+		holderIdentity := *lease.Spec.HolderIdentity
+		if holderIdentity == s.Identity {
+			continue
+		}
+
+		expirationTime := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+		if !s.Clock.Now().Before(expirationTime) {
+			// Shards which were not shut down gracefully never release their Lease, hence it is cleaned up here.
+			if err := client.IgnoreNotFound(s.SeedClient.Delete(ctx, lease)); err != nil {
+				return nil, nil, fmt.Errorf("failed deleting expired lease %s: %w", client.ObjectKeyFromObject(lease), err)
+			}
+			continue
+		}
+
+		members = append(members, holderIdentity)
+		peers = append(peers, peer{
+			identity:   holderIdentity,
+			ring:       NewRing(splitList(lease.Annotations[AnnotationRingMembers])...),
+			inProgress: sets.New(splitList(lease.Annotations[AnnotationShootsInProgress])...),
+		})
+	}
+
+	return members, peers, nil
+}
+
+func (s *Shard) enqueueAcquiredShoots(ctx context.Context, oldRing, newRing *Ring) error {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := s.GardenCache.List(ctx, shootList); err != nil {
+		return fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var events []event.GenericEvent
+	for i := range shootList.Items {
+		shoot := &shootList.Items[i]
+		if !isShootOfSeed(shoot, s.SeedName) {
+			continue
+		}
+
+		uid := string(shoot.UID)
+		if newRing.Owner(uid) != s.Identity || (oldRing != nil && oldRing.Owner(uid) == s.Identity) {
+			continue
+		}
+
+		events = append(events, event.GenericEvent{Object: shoot})
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.lock.RLock()
+	subscribers := s.subscribers
+	s.lock.RUnlock()
+
+	// The events are sent asynchronously so that the renewal of the Lease is not blocked by slow subscribers.
+	go func() {
+		for _, ev := range events {
+			for _, ch := range subscribers {
+				select {
+				case ch <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func isShootOfSeed(shoot *gardencorev1beta1.Shoot, seedName string) bool {
+	specSeedName, statusSeedName := gardenerutils.GetShootSeedNames(shoot)
+	return pointer.StringDeref(specSeedName, "") == seedName || pointer.StringDeref(statusSeedName, "") == seedName
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,395 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/sharding"
+	mockcache "github.com/gardener/gardener/pkg/mock/controller-runtime/cache"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Shard", func() {
+	const (
+		identity      = "gardenlet-a"
+		otherIdentity = "gardenlet-b"
+		namespace     = "garden"
+		seedName      = "seed"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl         *gomock.Controller
+		gardenCache  *mockcache.MockCache
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+
+		shard  *Shard
+		events <-chan event.GenericEvent
+
+		shoots []*gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		gardenCache = mockcache.NewMockCache(ctrl)
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		gardenCache.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{})).DoAndReturn(
+			func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				return gardenClient.List(ctx, list, opts...)
+			},
+		).AnyTimes()
+
+		shoots = nil
+		for i := 0; i < 20; i++ {
+			shoot := &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("shoot-%d", i), Namespace: "garden-project", UID: types.UID(fmt.Sprintf("uid-%d", i))},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String(seedName)},
+			}
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+			shoots = append(shoots, shoot)
+		}
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-seed", Namespace: "garden-project", UID: "uid-other-seed"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String("other")},
+		})).To(Succeed())
+
+		shard = &Shard{
+			SeedClient:    seedClient,
+			SeedAPIReader: seedClient,
+			GardenCache:   gardenCache,
+			Namespace:     namespace,
+			Identity:      identity,
+			SeedName:      seedName,
+			LeaseDuration: 15 * time.Second,
+			Clock:         fakeClock,
+			Log:           logr.Discard(),
+		}
+		events = shard.Subscribe()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	createOtherLease := func(renewTime time.Time) {
+		ExpectWithOffset(1, seedClient.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      LeaseName(otherIdentity),
+				Namespace: namespace,
+				Labels:    map[string]string{"gardener.cloud/role": "gardenlet-shard"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       pointer.String(otherIdentity),
+				LeaseDurationSeconds: pointer.Int32(15),
+				RenewTime:            &metav1.MicroTime{Time: renewTime},
+			},
+		})).To(Succeed())
+	}
+
+	publishOtherState := func(ringMembers string, shootsInProgress ...*gardencorev1beta1.Shoot) {
+		lease := &coordinationv1.Lease{}
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: LeaseName(otherIdentity), Namespace: namespace}, lease)).To(Succeed())
+
+		var uids []string
+		for _, shoot := range shootsInProgress {
+			uids = append(uids, string(shoot.UID))
+		}
+
+		patch := client.MergeFrom(lease.DeepCopy())
+		metav1.SetMetaDataAnnotation(&lease.ObjectMeta, "sharding.gardener.cloud/ring-members", ringMembers)
+		metav1.SetMetaDataAnnotation(&lease.ObjectMeta, "sharding.gardener.cloud/shoots-in-progress", strings.Join(uids, ","))
+		lease.Spec.RenewTime = &metav1.MicroTime{Time: fakeClock.Now()}
+		ExpectWithOffset(1, seedClient.Patch(ctx, lease, patch)).To(Succeed())
+	}
+
+	publishedShootsInProgress := func() string {
+		lease := &coordinationv1.Lease{}
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: LeaseName(identity), Namespace: namespace}, lease)).To(Succeed())
+		return lease.Annotations["sharding.gardener.cloud/shoots-in-progress"]
+	}
+
+	receiveShootNames := func(count int) []string {
+		var names []string
+		for i := 0; i < count; i++ {
+			var ev event.GenericEvent
+			EventuallyWithOffset(1, events).Should(Receive(&ev))
+			names = append(names, ev.Object.GetName())
+		}
+		ConsistentlyWithOffset(1, events).ShouldNot(Receive())
+		return names
+	}
+
+	shootNamesOwnedBy := func(ring *Ring, member string) []string {
+		var names []string
+		for _, shoot := range shoots {
+			if ring.Owner(string(shoot.UID)) == member {
+				names = append(names, shoot.Name)
+			}
+		}
+		return names
+	}
+
+	Describe("#Sync", func() {
+		It("should create the lease and become responsible for all shoots if it is the only shard", func() {
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			lease := &coordinationv1.Lease{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Name: LeaseName(identity), Namespace: namespace}, lease)).To(Succeed())
+			Expect(lease.Labels).To(HaveKeyWithValue("gardener.cloud/role", "gardenlet-shard"))
+			Expect(lease.Spec.HolderIdentity).To(PointTo(Equal(identity)))
+			Expect(lease.Spec.LeaseDurationSeconds).To(PointTo(Equal(int32(15))))
+			Expect(lease.Spec.AcquireTime).NotTo(BeNil())
+			Expect(lease.Spec.RenewTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+			Expect(lease.Annotations).To(HaveKeyWithValue("sharding.gardener.cloud/ring-members", identity))
+
+			for _, shoot := range shoots {
+				Expect(shard.IsResponsible(shoot)).To(BeTrue())
+			}
+			Expect(receiveShootNames(len(shoots))).To(ConsistOf(shootNamesOwnedBy(NewRing(identity), identity)))
+		})
+
+		It("should read the leases only via the API reader", func() {
+			shard.SeedClient = interceptor.NewClient(seedClient.(client.WithWatch), interceptor.Funcs{
+				Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+					return fmt.Errorf("unexpected read via client")
+				},
+				List: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+					return fmt.Errorf("unexpected read via client")
+				},
+			})
+			createOtherLease(fakeClock.Now().Add(-20 * time.Second))
+
+			Expect(shard.Sync(ctx)).To(Succeed())
+			fakeClock.Step(5 * time.Second)
+			Expect(shard.Sync(ctx)).To(Succeed())
+			receiveShootNames(len(shoots))
+		})
+
+		It("should renew the lease", func() {
+			Expect(shard.Sync(ctx)).To(Succeed())
+			receiveShootNames(len(shoots))
+
+			fakeClock.Step(5 * time.Second)
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			lease := &coordinationv1.Lease{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Name: LeaseName(identity), Namespace: namespace}, lease)).To(Succeed())
+			Expect(lease.Spec.RenewTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+			Consistently(events).ShouldNot(Receive())
+		})
+
+		It("should share the shoots with other shards having a valid lease", func() {
+			createOtherLease(fakeClock.Now())
+			ring := NewRing(identity, otherIdentity)
+
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			for _, shoot := range shoots {
+				Expect(shard.IsResponsible(shoot)).To(Equal(ring.Owner(string(shoot.UID)) == identity))
+			}
+			owned := shootNamesOwnedBy(ring, identity)
+			Expect(owned).NotTo(BeEmpty())
+			Expect(owned).NotTo(HaveLen(len(shoots)))
+			Expect(receiveShootNames(len(owned))).To(ConsistOf(owned))
+		})
+
+		It("should ignore and clean up shards with an expired lease", func() {
+			createOtherLease(fakeClock.Now().Add(-20 * time.Second))
+
+			Expect(shard.Sync(ctx)).To(Succeed())
+			Expect(seedClient.Get(ctx, client.ObjectKey{Name: LeaseName(otherIdentity), Namespace: namespace}, &coordinationv1.Lease{})).To(BeNotFoundError())
+
+			for _, shoot := range shoots {
+				Expect(shard.IsResponsible(shoot)).To(BeTrue())
+			}
+			receiveShootNames(len(shoots))
+		})
+
+		It("should only send events for shoots taken over from a shard whose lease expired", func() {
+			createOtherLease(fakeClock.Now())
+			ring := NewRing(identity, otherIdentity)
+
+			Expect(shard.Sync(ctx)).To(Succeed())
+			receiveShootNames(len(shootNamesOwnedBy(ring, identity)))
+
+			fakeClock.Step(20 * time.Second)
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			for _, shoot := range shoots {
+				Expect(shard.IsResponsible(shoot)).To(BeTrue())
+			}
+			takenOver := shootNamesOwnedBy(ring, otherIdentity)
+			Expect(receiveShootNames(len(takenOver))).To(ConsistOf(takenOver))
+		})
+	})
+
+	Describe("#IsResponsible", func() {
+		It("should not be responsible for any shoot before the first sync", func() {
+			Expect(shard.IsResponsible(shoots[0])).To(BeFalse())
+		})
+
+		It("should be responsible for all shoots if sharding is disabled", func() {
+			var shard *Shard
+			Expect(shard.IsResponsible(shoots[0])).To(BeTrue())
+		})
+	})
+
+	Describe("#Acquire", func() {
+		It("should acquire all shoots if sharding is disabled", func() {
+			var shard *Shard
+			release, acquired := shard.Acquire(shoots[0])
+			Expect(acquired).To(BeTrue())
+			release()
+		})
+
+		It("should not acquire any shoot before the first sync", func() {
+			_, acquired := shard.Acquire(shoots[0])
+			Expect(acquired).To(BeFalse())
+		})
+
+		It("should publish the acquired shoots until they have been released", func() {
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			release1, acquired := shard.Acquire(shoots[0])
+			Expect(acquired).To(BeTrue())
+			release2, acquired := shard.Acquire(shoots[0])
+			Expect(acquired).To(BeTrue())
+
+			Expect(shard.Sync(ctx)).To(Succeed())
+			Expect(publishedShootsInProgress()).To(Equal(string(shoots[0].UID)))
+
+			release1()
+			release1()
+			Expect(shard.Sync(ctx)).To(Succeed())
+			Expect(publishedShootsInProgress()).To(Equal(string(shoots[0].UID)))
+
+			release2()
+			Expect(shard.Sync(ctx)).To(Succeed())
+			Expect(publishedShootsInProgress()).To(BeEmpty())
+		})
+
+		It("should not acquire shoots if the lease could not be renewed in time", func() {
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			fakeClock.Step(15 * time.Second)
+			_, acquired := shard.Acquire(shoots[0])
+			Expect(acquired).To(BeFalse())
+		})
+
+		It("should only acquire shoots after the previous shard released them when the ring changes", func() {
+			var (
+				oldRing = NewRing(otherIdentity)
+				newRing = NewRing(identity, otherIdentity)
+				// The shoots which move to this shard when it joins the ring, and one of them which is still being
+				// processed by the other shard.
+				movedShoots []*gardencorev1beta1.Shoot
+			)
+
+			for _, shoot := range shoots {
+				if newRing.Owner(string(shoot.UID)) == identity {
+					movedShoots = append(movedShoots, shoot)
+				}
+			}
+			Expect(movedShoots).NotTo(BeEmpty())
+			shootInProgress := movedShoots[0]
+
+			By("Join the ring while the other shard has not yet observed the change")
+			createOtherLease(fakeClock.Now())
+			publishOtherState(strings.Join(oldRing.Members(), ","), shootInProgress)
+			Expect(shard.Sync(ctx)).To(Succeed())
+			receiveShootNames(len(movedShoots))
+
+			for _, shoot := range movedShoots {
+				Expect(shard.IsResponsible(shoot)).To(BeTrue())
+				_, acquired := shard.Acquire(shoot)
+				Expect(acquired).To(BeFalse(), "shoot %s is still claimed by the other shard", shoot.Name)
+			}
+
+			By("Other shard observes the change but still processes one shoot")
+			fakeClock.Step(5 * time.Second)
+			publishOtherState(strings.Join(newRing.Members(), ","), shootInProgress)
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			for _, shoot := range movedShoots {
+				release, acquired := shard.Acquire(shoot)
+				Expect(acquired).To(Equal(shoot != shootInProgress), "unexpected acquisition of shoot %s", shoot.Name)
+				if acquired {
+					release()
+				}
+			}
+
+			By("Other shard releases the shoot")
+			fakeClock.Step(5 * time.Second)
+			publishOtherState(strings.Join(newRing.Members(), ","))
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			release, acquired := shard.Acquire(shootInProgress)
+			Expect(acquired).To(BeTrue())
+			release()
+
+			for _, shoot := range shoots {
+				if newRing.Owner(string(shoot.UID)) == otherIdentity {
+					_, acquired := shard.Acquire(shoot)
+					Expect(acquired).To(BeFalse(), "shoot %s is assigned to the other shard", shoot.Name)
+				}
+			}
+		})
+
+		It("should acquire shoots of a shard whose lease expired", func() {
+			createOtherLease(fakeClock.Now())
+			publishOtherState(otherIdentity, shoots...)
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			_, acquired := shard.Acquire(shoots[0])
+			Expect(acquired).To(BeFalse())
+
+			fakeClock.Step(20 * time.Second)
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			for _, shoot := range shoots {
+				release, acquired := shard.Acquire(shoot)
+				Expect(acquired).To(BeTrue())
+				release()
+			}
+		})
+	})
+})
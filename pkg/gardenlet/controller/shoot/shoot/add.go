@@ -22,6 +22,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -48,30 +49,47 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Recorder == nil {
 		r.Recorder = gardenCluster.GetEventRecorderFor(ControllerName + "-controller")
 	}
+	if r.SeedRecorder == nil {
+		r.SeedRecorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
 
 	// It's not possible to call builder.Build() without adding atleast one watch, and without this, we can't get the controller logger.
 	// Hence, we have to build up the controller manually.
-	c, err := controller.New(
-		ControllerName,
-		mgr,
-		controller.Options{
-			Reconciler:              r,
-			MaxConcurrentReconciles: pointer.IntDeref(r.Config.Controllers.Shoot.ConcurrentSyncs, 0),
-		},
-	)
+	options := controller.Options{
+		Reconciler:              r,
+		MaxConcurrentReconciles: pointer.IntDeref(r.Config.Controllers.Shoot.ConcurrentSyncs, 0),
+	}
+	if r.Shard != nil {
+		// All gardenlet replicas reconcile the shoots assigned to their shard, hence the controller must not only run in
+		// the leader.
+		options.NeedLeaderElection = pointer.Bool(false)
+	}
+
+	c, err := controller.New(ControllerName, mgr, options)
 	if err != nil {
 		return err
 	}
 
-	return c.Watch(
+	if err := c.Watch(
 		source.Kind(gardenCluster.GetCache(), &gardencorev1beta1.Shoot{}),
 		r.EventHandler(c.GetLogger()),
 		predicateutils.SeedNamePredicate(r.Config.SeedConfig.Name, gardenerutils.GetShootSeedNames),
 		&predicate.GenerationChangedPredicate{},
-	)
+		r.Shard.Predicate(),
+	); err != nil {
+		return err
+	}
+
+	if r.Shard != nil {
+		// Shoots which are newly assigned to this shard (e.g., because another gardenlet replica went away) have to be
+		// picked up since there is no regular watch event for them.
+		return c.Watch(&source.Channel{Source: r.Shard.Subscribe()}, r.EventHandler(c.GetLogger()))
+	}
+
+	return nil
 }
 
 // CalculateControllerInfos is exposed for testing
@@ -81,22 +99,7 @@ var CalculateControllerInfos = helper.CalculateControllerInfos
 func (r *Reconciler) EventHandler(log logr.Logger) handler.EventHandler {
 	return &handler.Funcs{
 		CreateFunc: func(_ context.Context, e event.CreateEvent, q workqueue.RateLimitingInterface) {
-			shoot, ok := e.Object.(*gardencorev1beta1.Shoot)
-			if !ok {
-				return
-			}
-
-			enqueueAfter := CalculateControllerInfos(shoot, r.Clock, *r.Config.Controllers.Shoot).EnqueueAfter
-			nextReconciliation := r.Clock.Now().UTC().Add(enqueueAfter)
-
-			log.Info("Scheduling next reconciliation for Shoot",
-				"namespace", shoot.Namespace, "name", shoot.Name,
-				"enqueueAfter", enqueueAfter, "nextReconciliation", nextReconciliation)
-
-			q.AddAfter(reconcile.Request{NamespacedName: types.NamespacedName{
-				Name:      e.Object.GetName(),
-				Namespace: e.Object.GetNamespace(),
-			}}, enqueueAfter)
+			r.scheduleNextReconciliation(log, e.Object, q)
 		},
 		UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
 			req := reconcile.Request{NamespacedName: types.NamespacedName{
@@ -112,5 +115,27 @@ func (r *Reconciler) EventHandler(log logr.Logger) handler.EventHandler {
 
 			q.Add(req)
 		},
+		GenericFunc: func(_ context.Context, e event.GenericEvent, q workqueue.RateLimitingInterface) {
+			r.scheduleNextReconciliation(log, e.Object, q)
+		},
+	}
+}
+
+func (r *Reconciler) scheduleNextReconciliation(log logr.Logger, obj client.Object, q workqueue.RateLimitingInterface) {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return
 	}
+
+	enqueueAfter := CalculateControllerInfos(shoot, r.Clock, *r.Config.Controllers.Shoot).EnqueueAfter
+	nextReconciliation := r.Clock.Now().UTC().Add(enqueueAfter)
+
+	log.Info("Scheduling next reconciliation for Shoot",
+		"namespace", shoot.Namespace, "name", shoot.Name,
+		"enqueueAfter", enqueueAfter, "nextReconciliation", nextReconciliation)
+
+	q.AddAfter(reconcile.Request{NamespacedName: types.NamespacedName{
+		Name:      shoot.Name,
+		Namespace: shoot.Namespace,
+	}}, enqueueAfter)
 }
@@ -108,7 +108,15 @@ var _ = Describe("Add", func() {
 			hdlr.Delete(ctx, event.DeleteEvent{Object: obj}, queue)
 		})
 
-		It("should not enqueue the object for Generic events", func() {
+		It("should enqueue the object for Generic events according to the calculated duration", func() {
+			duration := time.Minute
+			DeferCleanup(test.WithVar(&CalculateControllerInfos, func(*gardencorev1beta1.Shoot, clock.Clock, gardenletconfig.ShootControllerConfiguration) helper.ControllerInfos {
+				return helper.ControllerInfos{
+					EnqueueAfter: duration,
+				}
+			}))
+			queue.EXPECT().AddAfter(req, duration)
+
 			hdlr.Generic(ctx, event.GenericEvent{Object: obj}, queue)
 		})
 	})
@@ -0,0 +1,56 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/utils/clock"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+const (
+	// EncryptionConfigConditionReasonRewriting is the reason of the EncryptionConfigApplied condition while the objects
+	// of the modified resources are being rewritten.
+	EncryptionConfigConditionReasonRewriting = "ResourcesRewriting"
+	// EncryptionConfigConditionReasonRewriteBlocked is the reason of the EncryptionConfigApplied condition if rewriting
+	// the objects of the modified resources failed.
+	EncryptionConfigConditionReasonRewriteBlocked = "ResourcesRewriteBlocked"
+	// EncryptionConfigConditionReasonApplied is the reason of the EncryptionConfigApplied condition once all objects of
+	// the modified resources have been rewritten.
+	EncryptionConfigConditionReasonApplied = "EncryptionConfigApplied"
+)
+
+// SetEncryptionConfigCondition sets the condition reporting the state of applying a modified encryption configuration
+// of the kube-apiserver in the status of the given shoot.
+func SetEncryptionConfigCondition(clock clock.Clock, shoot *gardencorev1beta1.Shoot, status gardencorev1beta1.ConditionStatus, reason, message string) {
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootEncryptionConfigApplied)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, status, reason, message)
+	shoot.Status.Conditions = v1beta1helper.MergeConditions(shoot.Status.Conditions, condition)
+}
+
+// EncryptionConfigProgressMessage returns a message describing the progress of rewriting the objects of the given
+// resources after the encryption configuration of the kube-apiserver has been modified.
+func EncryptionConfigProgressMessage(resources []gardencorev1beta1.ReencryptedResource) string {
+	progress := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		progress = append(progress, fmt.Sprintf("%s (%d/%d)", resource.Resource, resource.Rewritten, resource.Total))
+	}
+
+	return "Rewriting objects of resources to apply the modified encryption configuration: " + strings.Join(progress, ", ")
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+)
+
+var _ = Describe("Encryption", func() {
+	Describe("#SetEncryptionConfigCondition", func() {
+		var (
+			fakeClock *testclock.FakeClock
+			shoot     *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+			shoot = &gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					Conditions: []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionTrue}},
+				},
+			}
+		})
+
+		It("should add the condition if it does not exist yet", func() {
+			SetEncryptionConfigCondition(fakeClock, shoot, gardencorev1beta1.ConditionProgressing, "ResourcesRewriting", "foo")
+
+			Expect(shoot.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(gardencorev1beta1.ShootAPIServerAvailable)}),
+				MatchFields(IgnoreExtras, Fields{
+					"Type":               Equal(gardencorev1beta1.ShootEncryptionConfigApplied),
+					"Status":             Equal(gardencorev1beta1.ConditionProgressing),
+					"Reason":             Equal("ResourcesRewriting"),
+					"Message":            Equal("foo"),
+					"LastTransitionTime": Equal(metav1.NewTime(fakeClock.Now())),
+				}),
+			))
+		})
+
+		It("should update the existing condition", func() {
+			SetEncryptionConfigCondition(fakeClock, shoot, gardencorev1beta1.ConditionProgressing, "ResourcesRewriting", "foo")
+			fakeClock.Step(time.Minute)
+			SetEncryptionConfigCondition(fakeClock, shoot, gardencorev1beta1.ConditionTrue, "EncryptionConfigApplied", "bar")
+
+			Expect(shoot.Status.Conditions).To(HaveLen(2))
+			Expect(shoot.Status.Conditions[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":               Equal(gardencorev1beta1.ShootEncryptionConfigApplied),
+				"Status":             Equal(gardencorev1beta1.ConditionTrue),
+				"Reason":             Equal("EncryptionConfigApplied"),
+				"Message":            Equal("bar"),
+				"LastTransitionTime": Equal(metav1.NewTime(fakeClock.Now())),
+			}))
+		})
+	})
+
+	Describe("#EncryptionConfigProgressMessage", func() {
+		It("should return the expected message", func() {
+			Expect(EncryptionConfigProgressMessage([]gardencorev1beta1.ReencryptedResource{
+				{Resource: "ConfigMap", Total: 10, Rewritten: 10},
+				{Resource: "Deployment.apps", Total: 5, Rewritten: 2},
+			})).To(Equal("Rewriting objects of resources to apply the modified encryption configuration: ConfigMap (10/10), Deployment.apps (2/5)"))
+		})
+	})
+})
@@ -0,0 +1,49 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"k8s.io/utils/clock"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+const (
+	// EtcdHighAvailabilityConditionReasonScalingUp is the reason of the EtcdHighAvailabilityApplied condition while the
+	// etcd clusters are being scaled up from a single member to three members.
+	EtcdHighAvailabilityConditionReasonScalingUp = "EtcdScalingUp"
+	// EtcdHighAvailabilityConditionReasonScaleUpBlocked is the reason of the EtcdHighAvailabilityApplied condition if
+	// the quorum of the scaled-up etcd clusters could not be verified.
+	EtcdHighAvailabilityConditionReasonScaleUpBlocked = "EtcdScaleUpBlocked"
+	// EtcdHighAvailabilityConditionReasonApplied is the reason of the EtcdHighAvailabilityApplied condition once all
+	// members of the scaled-up etcd clusters are ready and their quorum is protected.
+	EtcdHighAvailabilityConditionReasonApplied = "EtcdHighAvailabilityApplied"
+)
+
+// SetEtcdHighAvailabilityCondition sets the condition reporting the state of scaling up the etcd clusters of a Shoot
+// from a single member to a highly available cluster in the status of the given shoot.
+func SetEtcdHighAvailabilityCondition(clock clock.Clock, shoot *gardencorev1beta1.Shoot, status gardencorev1beta1.ConditionStatus, reason, message string) {
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootEtcdHighAvailabilityApplied)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, status, reason, message)
+	shoot.Status.Conditions = v1beta1helper.MergeConditions(shoot.Status.Conditions, condition)
+}
+
+// IsEtcdHighAvailabilityScaleUpPending returns true if the given shoot reports a started but not yet completed scale-up
+// of its etcd clusters.
+func IsEtcdHighAvailabilityScaleUpPending(shoot *gardencorev1beta1.Shoot) bool {
+	condition := v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootEtcdHighAvailabilityApplied)
+	return condition != nil && condition.Status != gardencorev1beta1.ConditionTrue
+}
@@ -0,0 +1,208 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+)
+
+// EventMigrationHeldBack is an event reason for shoots whose migration is held back because of the migration settings
+// of the seed.
+const EventMigrationHeldBack = "MigrationHeldBack"
+
+type migrationDirection string
+
+const (
+	// migrationIncoming is the direction of migrations restoring shoot control planes on the seed.
+	migrationIncoming migrationDirection = "incoming"
+	// migrationOutgoing is the direction of migrations moving shoot control planes away from the seed.
+	migrationOutgoing migrationDirection = "outgoing"
+)
+
+// migrationHeldBackRequeuePeriod is the period after which shoots whose migration is held back are reconciled again.
+var migrationHeldBackRequeuePeriod = 30 * time.Second
+
+// migrationAdmission keeps track of the migrations which were admitted by this gardenlet but which are not yet
+// reflected in the shoots' status.
+type migrationAdmission struct {
+	lock     sync.Mutex
+	admitted map[migrationDirection]sets.Set[string]
+}
+
+// holdBackMigration checks whether the migration of the given shoot in the given direction may be started according
+// to the migration settings of the seed. If the migration must be held back, a description of the reason is returned.
+// Migrations which were already started are never held back.
+func (r *Reconciler) holdBackMigration(ctx context.Context, shoot *gardencorev1beta1.Shoot, direction migrationDirection) (string, error) {
+	cfg := r.Config.Controllers.Shoot.Migration
+	if cfg == nil || r.isMigrationInProgress(shoot, direction) {
+		return "", nil
+	}
+
+	if cfg.Paused {
+		return fmt.Sprintf("Starting %s migrations is paused for seed %q", direction, r.Config.SeedConfig.Name), nil
+	}
+
+	maxConcurrent := cfg.MaxConcurrentOutgoing
+	if direction == migrationIncoming {
+		maxConcurrent = cfg.MaxConcurrentIncoming
+	}
+	if maxConcurrent == nil {
+		return "", nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.GardenClient.List(ctx, shootList); err != nil {
+		return "", fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	r.migrationAdmission.lock.Lock()
+	defer r.migrationAdmission.lock.Unlock()
+
+	if r.migrationAdmission.admitted == nil {
+		r.migrationAdmission.admitted = make(map[migrationDirection]sets.Set[string])
+	}
+	if r.migrationAdmission.admitted[direction] == nil {
+		r.migrationAdmission.admitted[direction] = sets.New[string]()
+	}
+
+	var (
+		admitted = r.migrationAdmission.admitted[direction]
+		key      = client.ObjectKeyFromObject(shoot).String()
+		priority = r.migrationPriority(shoot)
+		pending  = sets.New[string]()
+
+		inProgress, pendingWithHigherPriority int
+	)
+
+	for _, s := range shootList.Items {
+		k := client.ObjectKeyFromObject(&s).String()
+		if k == key {
+			continue
+		}
+
+		switch {
+		case r.isMigrationInProgress(&s, direction):
+			inProgress++
+		case r.isMigrationPending(&s, direction):
+			pending.Insert(k)
+			if admitted.Has(k) {
+				// The migration was admitted but the shoot's status does not reflect it yet.
+				inProgress++
+			} else if r.migrationPriority(&s) < priority {
+				pendingWithHigherPriority++
+			}
+		}
+	}
+
+	// Forget about admitted migrations which are either reflected in the shoots' status by now or no longer pending.
+	for k := range admitted {
+		if !pending.Has(k) {
+			admitted.Delete(k)
+		}
+	}
+
+	if available := *maxConcurrent - inProgress; available <= pendingWithHigherPriority {
+		if available <= 0 {
+			return fmt.Sprintf("Maximum number of concurrent %s migrations (%d) for seed %q is reached", direction, *maxConcurrent, r.Config.SeedConfig.Name), nil
+		}
+		return fmt.Sprintf("Migrations of %d shoot(s) with higher priority are pending for seed %q", pendingWithHigherPriority, r.Config.SeedConfig.Name), nil
+	}
+
+	admitted.Insert(key)
+	return "", nil
+}
+
+// isMigrationPending returns true if the migration of the given shoot in the given direction can be started by this
+// gardenlet but has not been started yet.
+func (r *Reconciler) isMigrationPending(shoot *gardencorev1beta1.Shoot, direction migrationDirection) bool {
+	if shoot.DeletionTimestamp != nil || r.isMigrationInProgress(shoot, direction) {
+		return false
+	}
+
+	seedName := r.Config.SeedConfig.Name
+	switch direction {
+	case migrationOutgoing:
+		return helper.ShouldPrepareShootForMigration(shoot) && *shoot.Status.SeedName == seedName
+	case migrationIncoming:
+		return shoot.Spec.SeedName != nil && *shoot.Spec.SeedName == seedName &&
+			helper.ComputeOperationType(shoot) == gardencorev1beta1.LastOperationTypeRestore
+	}
+	return false
+}
+
+// isMigrationInProgress returns true if the migration of the given shoot in the given direction has already been
+// started by this gardenlet and has not yet succeeded.
+func (r *Reconciler) isMigrationInProgress(shoot *gardencorev1beta1.Shoot, direction migrationDirection) bool {
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation == nil || lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
+		return false
+	}
+
+	seedName := r.Config.SeedConfig.Name
+	switch direction {
+	case migrationOutgoing:
+		return lastOperation.Type == gardencorev1beta1.LastOperationTypeMigrate &&
+			helper.ShouldPrepareShootForMigration(shoot) && *shoot.Status.SeedName == seedName
+	case migrationIncoming:
+		return lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore &&
+			shoot.Spec.SeedName != nil && *shoot.Spec.SeedName == seedName
+	}
+	return false
+}
+
+// migrationPriority returns the priority of the given shoot's migration based on its purpose. Lower values denote
+// higher priorities.
+func (r *Reconciler) migrationPriority(shoot *gardencorev1beta1.Shoot) int {
+	purpose := gardencorev1beta1.ShootPurposeEvaluation
+	if shoot.Spec.Purpose != nil {
+		purpose = *shoot.Spec.Purpose
+	}
+
+	priorities := r.Config.Controllers.Shoot.Migration.PurposePriorities
+	for i, p := range priorities {
+		if string(p) == string(purpose) {
+			return i
+		}
+	}
+	return len(priorities)
+}
+
+// checkMigrationHeldBack checks whether the migration of the given shoot must be held back. If so, it reports the reason
+// and returns a result for requeueing the shoot.
+func (r *Reconciler) checkMigrationHeldBack(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, direction migrationDirection) (reconcile.Result, bool, error) {
+	reason, err := r.holdBackMigration(ctx, shoot, direction)
+	if err != nil {
+		return reconcile.Result{}, false, fmt.Errorf("failed checking whether %s migration must be held back: %w", direction, err)
+	}
+	if reason == "" {
+		return reconcile.Result{}, false, nil
+	}
+
+	log.Info("Migration is held back", "direction", direction, "reason", reason, "requeueAfter", migrationHeldBackRequeuePeriod)
+	r.Recorder.Event(shoot, corev1.EventTypeNormal, EventMigrationHeldBack, reason)
+	return reconcile.Result{RequeueAfter: migrationHeldBackRequeuePeriod}, true, nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("Migration", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		gardenClient client.Client
+		recorder     *record.FakeRecorder
+		reconciler   *Reconciler
+
+		seedName      = "seed"
+		otherSeedName = "other-seed"
+
+		newOutgoingShoot func(name string, purpose gardencorev1beta1.ShootPurpose) *gardencorev1beta1.Shoot
+		newIncomingShoot func(name string, purpose gardencorev1beta1.ShootPurpose) *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			Recorder:     recorder,
+			Config: config.GardenletConfiguration{
+				SeedConfig: &config.SeedConfig{SeedTemplate: gardencore.SeedTemplate{ObjectMeta: metav1.ObjectMeta{Name: seedName}}},
+				Controllers: &config.GardenletControllerConfiguration{
+					Shoot: &config.ShootControllerConfiguration{
+						Migration: &config.ShootMigrationConfiguration{
+							PurposePriorities: []gardencore.ShootPurpose{gardencore.ShootPurposeProduction, gardencore.ShootPurposeEvaluation},
+						},
+					},
+				},
+			},
+		}
+
+		newOutgoingShoot = func(name string, purpose gardencorev1beta1.ShootPurpose) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String(otherSeedName), Purpose: &purpose},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName:      pointer.String(seedName),
+					LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded},
+				},
+			}
+		}
+
+		newIncomingShoot = func(name string, purpose gardencorev1beta1.ShootPurpose) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String(seedName), Purpose: &purpose},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName:      pointer.String(seedName),
+					LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateSucceeded},
+				},
+			}
+		}
+	})
+
+	Describe("#holdBackMigration", func() {
+		It("should not hold back migrations if no migration configuration is given", func() {
+			reconciler.Config.Controllers.Shoot.Migration = nil
+
+			Expect(reconciler.holdBackMigration(ctx, newOutgoingShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation), migrationOutgoing)).To(BeEmpty())
+		})
+
+		It("should not hold back migrations if the number of concurrent migrations is not limited", func() {
+			Expect(gardenClient.Create(ctx, newOutgoingShoot("other", gardencorev1beta1.ShootPurposeProduction))).To(Succeed())
+
+			Expect(reconciler.holdBackMigration(ctx, newOutgoingShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation), migrationOutgoing)).To(BeEmpty())
+		})
+
+		It("should hold back migrations which have not been started if migrations are paused", func() {
+			reconciler.Config.Controllers.Shoot.Migration.Paused = true
+
+			Expect(reconciler.holdBackMigration(ctx, newIncomingShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation), migrationIncoming)).To(Equal(`Starting incoming migrations is paused for seed "seed"`))
+		})
+
+		It("should not hold back migrations which are already in progress if migrations are paused", func() {
+			reconciler.Config.Controllers.Shoot.Migration.Paused = true
+
+			shoot := newOutgoingShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation)
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateError}
+
+			Expect(reconciler.holdBackMigration(ctx, shoot, migrationOutgoing)).To(BeEmpty())
+		})
+
+		It("should hold back migrations if the maximum number of concurrent migrations is reached", func() {
+			reconciler.Config.Controllers.Shoot.Migration.MaxConcurrentIncoming = pointer.Int(1)
+
+			inProgress := newIncomingShoot("in-progress", gardencorev1beta1.ShootPurposeEvaluation)
+			inProgress.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateProcessing}
+			Expect(gardenClient.Create(ctx, inProgress)).To(Succeed())
+
+			Expect(reconciler.holdBackMigration(ctx, newIncomingShoot("shoot", gardencorev1beta1.ShootPurposeProduction), migrationIncoming)).To(Equal(`Maximum number of concurrent incoming migrations (1) for seed "seed" is reached`))
+		})
+
+		It("should account for admitted migrations which are not yet reflected in the shoot status", func() {
+			reconciler.Config.Controllers.Shoot.Migration.MaxConcurrentOutgoing = pointer.Int(1)
+
+			first := newOutgoingShoot("first", gardencorev1beta1.ShootPurposeEvaluation)
+			second := newOutgoingShoot("second", gardencorev1beta1.ShootPurposeEvaluation)
+			Expect(gardenClient.Create(ctx, first)).To(Succeed())
+			Expect(gardenClient.Create(ctx, second)).To(Succeed())
+
+			Expect(reconciler.holdBackMigration(ctx, first, migrationOutgoing)).To(BeEmpty())
+			Expect(reconciler.holdBackMigration(ctx, second, migrationOutgoing)).To(Equal(`Maximum number of concurrent outgoing migrations (1) for seed "seed" is reached`))
+
+			By("Finish migration of first shoot")
+			first.Status.SeedName = first.Spec.SeedName
+			first.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateSucceeded}
+			Expect(gardenClient.Update(ctx, first)).To(Succeed())
+
+			Expect(reconciler.holdBackMigration(ctx, second, migrationOutgoing)).To(BeEmpty())
+		})
+
+		It("should hold back migrations if migrations of shoots with higher priority are pending", func() {
+			reconciler.Config.Controllers.Shoot.Migration.MaxConcurrentOutgoing = pointer.Int(2)
+
+			Expect(gardenClient.Create(ctx, newOutgoingShoot("production-1", gardencorev1beta1.ShootPurposeProduction))).To(Succeed())
+			Expect(gardenClient.Create(ctx, newOutgoingShoot("production-2", gardencorev1beta1.ShootPurposeProduction))).To(Succeed())
+
+			Expect(reconciler.holdBackMigration(ctx, newOutgoingShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation), migrationOutgoing)).To(Equal(`Migrations of 2 shoot(s) with higher priority are pending for seed "seed"`))
+		})
+
+		It("should not hold back migrations if enough migrations are available for the shoots with higher priority", func() {
+			reconciler.Config.Controllers.Shoot.Migration.MaxConcurrentOutgoing = pointer.Int(2)
+
+			Expect(gardenClient.Create(ctx, newOutgoingShoot("production", gardencorev1beta1.ShootPurposeProduction))).To(Succeed())
+			Expect(gardenClient.Create(ctx, newOutgoingShoot("testing", gardencorev1beta1.ShootPurposeTesting))).To(Succeed())
+
+			Expect(reconciler.holdBackMigration(ctx, newOutgoingShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation), migrationOutgoing)).To(BeEmpty())
+		})
+	})
+
+	Describe("#checkMigrationHeldBack", func() {
+		It("should requeue the shoot and report an event if the migration is held back", func() {
+			reconciler.Config.Controllers.Shoot.Migration.Paused = true
+
+			result, heldBack, err := reconciler.checkMigrationHeldBack(ctx, log, newOutgoingShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation), migrationOutgoing)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(heldBack).To(BeTrue())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: migrationHeldBackRequeuePeriod}))
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventMigrationHeldBack)))
+		})
+
+		It("should not requeue the shoot if the migration is not held back", func() {
+			result, heldBack, err := reconciler.checkMigrationHeldBack(ctx, log, newOutgoingShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation), migrationOutgoing)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(heldBack).To(BeFalse())
+			Expect(result).To(Equal(reconcile.Result{}))
+		})
+	})
+})
@@ -47,6 +47,8 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/autonomy"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/sharding"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
@@ -71,10 +73,18 @@ type Reconciler struct {
 	ShootClientMap              clientmap.ClientMap
 	Config                      config.GardenletConfiguration
 	Recorder                    record.EventRecorder
+	SeedRecorder                record.EventRecorder
 	Identity                    *gardencorev1beta1.Gardener
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	// Shard is the shard of this gardenlet replica. If it is nil then sharding is disabled and all shoots of the seed
+	// are reconciled.
+	Shard *sharding.Shard
+	// Autonomy tracks the reachability of the garden cluster. If it is nil then the autonomy mode is disabled.
+	Autonomy *autonomy.Tracker
+
+	migrationAdmission migrationAdmission
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -95,11 +105,34 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	if !r.Shard.IsResponsible(shoot) {
+		log.Info("Skipping because Shoot is assigned to another shard")
+		return reconcile.Result{}, nil
+	}
+
+	release, acquired := r.Shard.Acquire(shoot)
+	if !acquired {
+		log.Info("Waiting until Shoot has been released by its previous shard", "requeueAfter", r.Shard.SyncPeriod())
+		return reconcile.Result{RequeueAfter: r.Shard.SyncPeriod()}, nil
+	}
+	defer release()
+
+	// In autonomy mode, the garden cluster is not reachable, hence no operation can report its progress and result. The
+	// existing shoots are kept healthy by the components running in the seed cluster, and the deferred operations are
+	// performed once the garden cluster is reachable again.
+	if r.Autonomy.IsAutonomous() {
+		log.Info("Deferring operation because gardenlet operates in autonomy mode", "requeueAfter", autonomy.RequeueAfterGardenOutage)
+		return reconcile.Result{RequeueAfter: autonomy.RequeueAfterGardenOutage}, nil
+	}
+
 	if shoot.DeletionTimestamp != nil {
 		return r.deleteShoot(ctx, log, shoot)
 	}
 
 	if helper.ShouldPrepareShootForMigration(shoot) {
+		if result, heldBack, err := r.checkMigrationHeldBack(ctx, log, shoot, migrationOutgoing); heldBack || err != nil {
+			return result, err
+		}
 		return r.migrateShoot(ctx, log, shoot)
 	}
 
@@ -113,6 +146,12 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 	)
 	log = log.WithValues("operation", strings.ToLower(string(operationType)))
 
+	if isRestoring {
+		if result, heldBack, err := r.checkMigrationHeldBack(ctx, log, shoot, migrationIncoming); heldBack || err != nil {
+			return result, err
+		}
+	}
+
 	if !controllerutil.ContainsFinalizer(shoot, gardencorev1beta1.GardenerName) {
 		log.Info("Adding finalizer")
 		if err := controllerutils.AddFinalizers(ctx, r.GardenClient, shoot, gardencorev1beta1.GardenerName); err != nil {
@@ -387,6 +426,7 @@ func (r *Reconciler) initializeOperation(
 		WithGardenerInfo(r.Identity).
 		WithGardenClusterIdentity(r.GardenClusterIdentity).
 		WithSecrets(gardenSecrets).
+		WithSeedRecorder(r.SeedRecorder).
 		WithGarden(gardenObj).
 		WithSeed(seedObj).
 		WithShoot(shootObj).
@@ -566,7 +606,7 @@ func (r *Reconciler) updateShootStatusOperationStart(
 		LastUpdateTime: now,
 	}
 
-	var mustRemoveOperationAnnotation bool
+	var mustRemoveOperationAnnotation, mustCollectDiagnostics, mustValidateResilience, mustPlanInfrastructure, mustPreviewDeletion bool
 
 	switch shoot.Annotations[v1beta1constants.GardenerOperation] {
 	case v1beta1constants.OperationRotateCredentialsStart:
@@ -619,6 +659,22 @@ func (r *Reconciler) updateShootStatusOperationStart(
 	case v1beta1constants.OperationRotateETCDEncryptionKeyComplete:
 		mustRemoveOperationAnnotation = true
 		completeRotationETCDEncryptionKey(shoot, &now)
+
+	case v1beta1constants.ShootOperationCollectDiagnostics:
+		mustRemoveOperationAnnotation = true
+		mustCollectDiagnostics = true
+
+	case v1beta1constants.ShootOperationValidateResilience:
+		mustRemoveOperationAnnotation = true
+		mustValidateResilience = true
+
+	case v1beta1constants.ShootOperationPlan:
+		mustRemoveOperationAnnotation = true
+		mustPlanInfrastructure = true
+
+	case v1beta1constants.ShootOperationPreviewDeletion:
+		mustRemoveOperationAnnotation = true
+		mustPreviewDeletion = true
 	}
 
 	if err := r.GardenClient.Status().Update(ctx, shoot); err != nil {
@@ -628,6 +684,23 @@ func (r *Reconciler) updateShootStatusOperationStart(
 	if mustRemoveOperationAnnotation {
 		patch := client.MergeFrom(shoot.DeepCopy())
 		delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+		if mustCollectDiagnostics {
+			// The collection is performed at the end of the reconciliation flow which removes the task once it is done.
+			controllerutils.AddTasks(shoot.Annotations, v1beta1constants.ShootTaskCollectDiagnostics)
+		}
+		if mustValidateResilience {
+			// The validation is performed at the end of the reconciliation flow which removes the task once it is done.
+			controllerutils.AddTasks(shoot.Annotations, v1beta1constants.ShootTaskValidateResilience)
+		}
+		if mustPlanInfrastructure {
+			// The planning replaces the deployment of the infrastructure in the reconciliation flow which removes the
+			// task once it is done.
+			controllerutils.AddTasks(shoot.Annotations, v1beta1constants.ShootTaskPlanInfrastructure)
+		}
+		if mustPreviewDeletion {
+			// The preview is computed at the end of the reconciliation flow which removes the task once it is done.
+			controllerutils.AddTasks(shoot.Annotations, v1beta1constants.ShootTaskPreviewDeletion)
+		}
 		return r.GardenClient.Patch(ctx, shoot, patch)
 	}
 
@@ -994,6 +1067,7 @@ func startRotationETCDEncryptionKey(shoot *gardencorev1beta1.Shoot, now *metav1.
 		rotation.LastInitiationTime = now
 		rotation.LastInitiationFinishedTime = nil
 		rotation.LastCompletionTriggeredTime = nil
+		rotation.ReencryptedResources = nil
 	})
 }
 
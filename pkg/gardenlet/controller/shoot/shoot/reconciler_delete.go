@@ -143,13 +143,54 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
 	}
 
+	f := newDeleteShootFlowGraph(botanist, deleteShootFlowParameters{
+		kubeAPIServerDeploymentFound:         kubeAPIServerDeploymentFound,
+		kubeControllerManagerDeploymentFound: kubeControllerManagerDeploymentFound,
+		kubeAPIServerDeploymentReplicas:      kubeAPIServerDeploymentReplicas,
+		infrastructure:                       infrastructure,
+		controlPlaneDeploymentNeeded:         controlPlaneDeploymentNeeded,
+	}).Compile()
+
+	if err := f.Run(ctx, flow.Opts{
+		Log:              o.Logger,
+		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
+		ErrorCleaner:     o.CleanShootTaskError,
+		ErrorContext:     errorContext,
+	}); err != nil {
+		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
+	}
+
+	// ensure that shoot client is invalidated after it has been deleted
+	if err := o.ShootClientMap.InvalidateClient(keys.ForShoot(o.Shoot.GetInfo())); err != nil {
+		err = fmt.Errorf("failed to invalidate shoot client: %w", err)
+		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+	}
+
+	o.Logger.Info("Successfully deleted Shoot cluster")
+	return nil
+}
+
+// deleteShootFlowParameters contains the information about the current state of the shoot control plane which is
+// gathered before the deletion flow is built.
+type deleteShootFlowParameters struct {
+	kubeAPIServerDeploymentFound         bool
+	kubeControllerManagerDeploymentFound bool
+	kubeAPIServerDeploymentReplicas      int32
+	infrastructure                       *extensionsv1alpha1.Infrastructure
+	controlPlaneDeploymentNeeded         bool
+}
+
+// newDeleteShootFlowGraph returns the dependency graph of the shoot deletion flow. Each task only depends on the tasks
+// which really have to be completed before it can run, so that independent extension objects and components are
+// deleted concurrently. Every task is bound by its own timeout.
+func newDeleteShootFlowGraph(botanist *botanistpkg.Botanist, p deleteShootFlowParameters) *flow.Graph {
 	var (
 		defaultInterval         = 5 * time.Second
 		defaultTimeout          = 30 * time.Second
 		staticNodesCIDR         = botanist.Shoot.GetInfo().Spec.Networking != nil && botanist.Shoot.GetInfo().Spec.Networking.Nodes != nil
 		useDNS                  = botanist.ShootUsesDNS()
 		nonTerminatingNamespace = botanist.SeedNamespaceObject.UID != "" && botanist.SeedNamespaceObject.Status.Phase != corev1.NamespaceTerminating
-		cleanupShootResources   = nonTerminatingNamespace && kubeAPIServerDeploymentFound && (infrastructure != nil || o.Shoot.IsWorkerless)
+		cleanupShootResources   = nonTerminatingNamespace && p.kubeAPIServerDeploymentFound && (p.infrastructure != nil || botanist.Shoot.IsWorkerless)
 
 		g = flow.NewGraph("Shoot cluster deletion")
 
@@ -238,7 +279,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		deployControlPlane = g.Add(flow.Task{
 			Name:         "Deploying Shoot control plane",
 			Fn:           flow.TaskFn(botanist.DeployControlPlane).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       botanist.Shoot.IsWorkerless || !cleanupShootResources || !controlPlaneDeploymentNeeded,
+			SkipIf:       botanist.Shoot.IsWorkerless || !cleanupShootResources || !p.controlPlaneDeploymentNeeded,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, ensureShootClusterIdentity),
 		})
 		waitUntilControlPlaneReady = g.Add(flow.Task{
@@ -246,7 +287,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
 			}),
-			SkipIf:       botanist.Shoot.IsWorkerless || !cleanupShootResources || !controlPlaneDeploymentNeeded,
+			SkipIf:       botanist.Shoot.IsWorkerless || !cleanupShootResources || !p.controlPlaneDeploymentNeeded,
 			Dependencies: flow.NewTaskIDs(deployControlPlane),
 		})
 		deployKubeAPIServer = g.Add(flow.Task{
@@ -264,7 +305,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		scaleUpKubeAPIServer = g.Add(flow.Task{
 			Name:         "Scaling up Kubernetes API server",
 			Fn:           flow.TaskFn(botanist.ScaleKubeAPIServerToOne).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       !cleanupShootResources || kubeAPIServerDeploymentReplicas != 0,
+			SkipIf:       !cleanupShootResources || p.kubeAPIServerDeploymentReplicas != 0,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
@@ -326,13 +367,13 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		deployKubeControllerManager = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes controller manager",
 			Fn:           flow.TaskFn(botanist.DeployKubeControllerManager).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       !cleanupShootResources || !kubeControllerManagerDeploymentFound,
+			SkipIf:       !cleanupShootResources || !p.kubeControllerManagerDeploymentFound,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilControlPlaneReady, initializeShootClients),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Scaling up Kubernetes controller manager",
 			Fn:           botanist.ScaleKubeControllerManagerToOne,
-			SkipIf:       !cleanupShootResources || !kubeControllerManagerDeploymentFound,
+			SkipIf:       !cleanupShootResources || !p.kubeControllerManagerDeploymentFound,
 			Dependencies: flow.NewTaskIDs(deployKubeControllerManager),
 		})
 		deleteSeedMonitoring = g.Add(flow.Task{
@@ -358,7 +399,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		waitForControllersToBeActive = g.Add(flow.Task{
 			Name:         "Waiting until kube-controller-manager is active",
 			Fn:           flow.TaskFn(botanist.WaitForKubeControllerManagerToBeActive).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       !cleanupShootResources || !kubeControllerManagerDeploymentFound,
+			SkipIf:       !cleanupShootResources || !p.kubeControllerManagerDeploymentFound,
 			Dependencies: flow.NewTaskIDs(initializeShootClients, cleanupWebhooks, deployControlPlane, deployKubeControllerManager),
 		})
 		cleanExtendedAPIs = g.Add(flow.Task{
@@ -481,10 +522,12 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitCleanupBeforeKubeAPIServer,
 			Dependencies: flow.NewTaskIDs(deleteExtensionResourcesBeforeKubeAPIServer),
 		})
+		// Stale extension resources are not needed by any other component, hence they can be deleted right away (similar
+		// to the reconcile flow) instead of waiting for the shoot workers and managed resources to be deleted.
 		deleteStaleExtensionResources = g.Add(flow.Task{
 			Name:         "Deleting stale extension resources",
 			Fn:           flow.TaskFn(botanist.Shoot.Components.Extensions.Extension.DeleteStaleResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(cleanKubernetesResources, waitUntilManagedResourcesDeleted),
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
 		})
 		waitUntilStaleExtensionResourcesDeleted = g.Add(flow.Task{
 			Name:         "Waiting until all stale extension resources have been deleted",
@@ -552,13 +595,22 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			waitUntilStaleExtensionResourcesDeleted,
 			waitUntilContainerRuntimeResourcesDeleted,
 		)
+		// The shoot control plane (e.g., cloud-controller-manager, CSI controllers) is only required until all nodes,
+		// volumes and load balancers are gone. Hence, it is destroyed concurrently to the remaining extension resources
+		// (network, container runtime, extensions) which do not depend on it.
+		syncPointReadyForControlPlaneDeletion = flow.NewTaskIDs(
+			syncPointCleanedKubernetesResources,
+			waitUntilWorkerDeleted,
+			waitUntilManagedResourcesDeleted,
+			timeForInfrastructureResourceCleanup,
+		)
 		destroyControlPlane = g.Add(flow.Task{
 			Name: "Destroying shoot control plane",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ControlPlane.Destroy(ctx)
 			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       botanist.Shoot.IsWorkerless,
-			Dependencies: flow.NewTaskIDs(syncPointCleaned),
+			Dependencies: flow.NewTaskIDs(syncPointReadyForControlPlaneDeletion),
 		})
 		waitUntilControlPlaneDeleted = g.Add(flow.Task{
 			Name: "Waiting until shoot control plane has been destroyed",
@@ -605,6 +657,21 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Fn:           flow.TaskFn(botanist.Shoot.Components.ControlPlane.KubeAPIServerSNI.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerDeleted),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Destroying Kubernetes API server allowlist",
+			Fn:           flow.TaskFn(botanist.Shoot.Components.ControlPlane.KubeAPIServerAllowlist.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerDeleted),
+		})
+		destroyKubeAPIServerEndpoint = g.Add(flow.Task{
+			Name:         "Destroying Kubernetes API server endpoint with static IP",
+			Fn:           flow.TaskFn(botanist.Shoot.Components.ControlPlane.KubeAPIServerEndpoint.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerDeleted),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until Kubernetes API server endpoint with static IP has been deleted",
+			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServerEndpoint.WaitCleanup,
+			Dependencies: flow.NewTaskIDs(destroyKubeAPIServerEndpoint),
+		})
 		_ = g.Add(flow.Task{
 			Name:         "Destroying Kubernetes API server ingress with trusted certificate",
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServerIngress.Destroy,
@@ -666,11 +733,27 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			SkipIf:       !nonTerminatingNamespace,
 			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilKubeAPIServerDeleted),
 		})
+		destroyPrivateDomainDNSRecord = g.Add(flow.Task{
+			Name:         "Destroying private domain DNS record",
+			Fn:           botanist.DestroyPrivateDNSRecord,
+			SkipIf:       !nonTerminatingNamespace,
+			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilKubeAPIServerDeleted),
+		})
 		deletePlutono = g.Add(flow.Task{
 			Name:         "Deleting Plutono in Seed",
 			Fn:           flow.TaskFn(botanist.Shoot.Components.ControlPlane.Plutono.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(waitUntilInfrastructureDeleted),
 		})
+		deleteMonitoringAuthProxy = g.Add(flow.Task{
+			Name:         "Deleting observability auth proxy in Seed",
+			Fn:           flow.TaskFn(botanist.Shoot.Components.Monitoring.AuthProxy.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilInfrastructureDeleted),
+		})
+		deleteMonitoringGatewayRoutes = g.Add(flow.Task{
+			Name:         "Deleting routes of observability gateway in Seed",
+			Fn:           flow.TaskFn(botanist.Shoot.Components.Monitoring.GatewayRoutes.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilInfrastructureDeleted),
+		})
 		destroySeedLogging = g.Add(flow.Task{
 			Name:         "Deleting logging stack in Seed",
 			Fn:           flow.TaskFn(botanist.DestroySeedLogging).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -680,6 +763,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		syncPoint = flow.NewTaskIDs(
 			deleteSeedMonitoring,
 			deletePlutono,
+			deleteMonitoringAuthProxy,
+			deleteMonitoringGatewayRoutes,
 			destroySeedLogging,
 			waitUntilKubeAPIServerDeleted,
 			waitUntilControlPlaneDeleted,
@@ -688,6 +773,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			waitUntilExtensionResourcesDeleted,
 			destroyIngressDomainDNSRecord,
 			destroyExternalDomainDNSRecord,
+			destroyPrivateDomainDNSRecord,
 			waitUntilInfrastructureDeleted,
 		)
 
@@ -729,25 +815,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			},
 			Dependencies: flow.NewTaskIDs(deleteNamespace),
 		})
-
-		f = g.Compile()
 	)
 
-	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorCleaner:     o.CleanShootTaskError,
-		ErrorContext:     errorContext,
-	}); err != nil {
-		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
-	}
-
-	// ensure that shoot client is invalidated after it has been deleted
-	if err := o.ShootClientMap.InvalidateClient(keys.ForShoot(o.Shoot.GetInfo())); err != nil {
-		err = fmt.Errorf("failed to invalidate shoot client: %w", err)
-		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
-	}
-
-	o.Logger.Info("Successfully deleted Shoot cluster")
-	return nil
+	return g
 }
@@ -0,0 +1,177 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockextension "github.com/gardener/gardener/pkg/component/extensions/extension/mock"
+	mockkubeapiserver "github.com/gardener/gardener/pkg/component/kubeapiserver/mock"
+	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+	mockmonitoring "github.com/gardener/gardener/pkg/component/monitoring/mock"
+	mockplutono "github.com/gardener/gardener/pkg/component/plutono/mock"
+	mockresourcemanager "github.com/gardener/gardener/pkg/component/resourcemanager/mock"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	gardenpkg "github.com/gardener/gardener/pkg/operation/garden"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Delete flow", func() {
+	var (
+		ctrl *gomock.Controller
+		g    *flow.Graph
+
+		cleanupWebhooks                      = flow.TaskID("Cleaning up webhooks")
+		cleanExtendedAPIs                    = flow.TaskID("Cleaning extended API groups")
+		cleanKubernetesResources             = flow.TaskID("Cleaning Kubernetes resources")
+		deleteMetricsServer                  = flow.TaskID("Deleting metrics-server")
+		initializeShootClients               = flow.TaskID("Initializing connection to Shoot")
+		destroyNetwork                       = flow.TaskID("Destroying shoot network plugin")
+		waitUntilNetworkIsDestroyed          = flow.TaskID("Waiting until shoot network plugin has been destroyed")
+		waitUntilWorkerDeleted               = flow.TaskID("Waiting until shoot worker nodes have been terminated")
+		deleteAllOperatingSystemConfigs      = flow.TaskID("Deleting operating system config resources")
+		waitUntilManagedResourcesDeleted     = flow.TaskID("Waiting until managed resources have been deleted")
+		timeForInfrastructureResourceCleanup = flow.TaskID("Waiting until time for infrastructure resource cleanup has elapsed")
+		deleteStaleExtensionResources        = flow.TaskID("Deleting stale extension resources")
+		waitUntilStaleExtensionsDeleted      = flow.TaskID("Waiting until all stale extension resources have been deleted")
+		waitUntilExtensionsBeforeKAPIDeleted = flow.TaskID("Waiting until extension resources that should be handled before kube-apiserver have been deleted")
+		deleteContainerRuntimeResources      = flow.TaskID("Deleting container runtime resources")
+		waitUntilContainerRuntimeDeleted     = flow.TaskID("Waiting until stale container runtime resources are deleted")
+		destroyControlPlane                  = flow.TaskID("Destroying shoot control plane")
+		waitUntilControlPlaneDeleted         = flow.TaskID("Waiting until shoot control plane has been destroyed")
+		waitUntilShootManagedResourcesDelete = flow.TaskID("Waiting until shoot managed resources have been deleted")
+		deleteKubeAPIServer                  = flow.TaskID("Deleting Kubernetes API server")
+		deleteInfrastructure                 = flow.TaskID("Destroying shoot infrastructure")
+
+		allDependencies = func(id flow.TaskID) flow.TaskIDs {
+			out := flow.NewTaskIDs()
+			for queue := g.Dependencies(id).List(); len(queue) > 0; queue = queue[1:] {
+				if out.Has(queue[0]) {
+					continue
+				}
+				out.Insert(queue[0])
+				queue = append(queue, g.Dependencies(queue[0]).List()...)
+			}
+			return out
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		shoot := &shootpkg.Shoot{
+			Components: &shootpkg.Components{
+				ControlPlane: &shootpkg.ControlPlane{
+					KubeAPIServer:          mockkubeapiserver.NewMockInterface(ctrl),
+					KubeAPIServerAllowlist: mockcomponent.NewMockDeployer(ctrl),
+					KubeAPIServerEndpoint:  mockcomponent.NewMockDeployWaiter(ctrl),
+					KubeAPIServerIngress:   mockcomponent.NewMockDeployer(ctrl),
+					KubeAPIServerService:   mockcomponent.NewMockDeployWaiter(ctrl),
+					KubeAPIServerSNI:       mockcomponent.NewMockDeployWaiter(ctrl),
+					Plutono:                mockplutono.NewMockInterface(ctrl),
+					ResourceManager:        mockresourcemanager.NewMockInterface(ctrl),
+				},
+				Extensions: &shootpkg.Extensions{
+					Extension: mockextension.NewMockInterface(ctrl),
+				},
+				Monitoring: &shootpkg.Monitoring{
+					AuthProxy:     mockcomponent.NewMockDeployWaiter(ctrl),
+					GatewayRoutes: mockcomponent.NewMockDeployWaiter(ctrl),
+					Monitoring:    mockmonitoring.NewMockInterface(ctrl),
+				},
+				GardenerAccess: mockcomponent.NewMockDeployer(ctrl),
+			},
+		}
+		shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+		botanist := &botanistpkg.Botanist{Operation: &operation.Operation{
+			Garden:              &gardenpkg.Garden{},
+			Shoot:               shoot,
+			SeedNamespaceObject: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{UID: "uid"}},
+		}}
+
+		g = newDeleteShootFlowGraph(botanist, deleteShootFlowParameters{
+			kubeAPIServerDeploymentFound:         true,
+			kubeControllerManagerDeploymentFound: true,
+			infrastructure:                       &extensionsv1alpha1.Infrastructure{},
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should destroy the control plane once the shoot nodes and resources are gone", func() {
+		Expect(g.Dependencies(destroyControlPlane)).To(Equal(flow.NewTaskIDs(
+			cleanupWebhooks,
+			cleanExtendedAPIs,
+			cleanKubernetesResources,
+			deleteMetricsServer,
+			waitUntilWorkerDeleted,
+			waitUntilManagedResourcesDeleted,
+			timeForInfrastructureResourceCleanup,
+		)))
+	})
+
+	It("should destroy the control plane concurrently to the remaining extension resources", func() {
+		dependencies := allDependencies(destroyControlPlane)
+
+		for _, id := range []flow.TaskID{
+			destroyNetwork,
+			waitUntilNetworkIsDestroyed,
+			deleteAllOperatingSystemConfigs,
+			deleteStaleExtensionResources,
+			waitUntilStaleExtensionsDeleted,
+			waitUntilExtensionsBeforeKAPIDeleted,
+			deleteContainerRuntimeResources,
+			waitUntilContainerRuntimeDeleted,
+		} {
+			Expect(dependencies.Has(id)).To(BeFalse(), "%q must not be a dependency of %q", id, destroyControlPlane)
+			Expect(allDependencies(id).Has(destroyControlPlane)).To(BeFalse(), "%q must not depend on %q", id, destroyControlPlane)
+		}
+	})
+
+	It("should delete stale extension resources right after the shoot clients were initialized", func() {
+		Expect(g.Dependencies(deleteStaleExtensionResources)).To(Equal(flow.NewTaskIDs(initializeShootClients)))
+	})
+
+	It("should wait for the shoot managed resources after the control plane was destroyed", func() {
+		Expect(g.Dependencies(waitUntilShootManagedResourcesDelete)).To(Equal(flow.NewTaskIDs(waitUntilControlPlaneDeleted)))
+	})
+
+	It("should only delete kube-apiserver and infrastructure once all extension resources are gone", func() {
+		for _, id := range []flow.TaskID{deleteKubeAPIServer, deleteInfrastructure} {
+			Expect(allDependencies(id)).To(HaveKey(waitUntilControlPlaneDeleted), "%q must depend on %q", id, waitUntilControlPlaneDeleted)
+			for _, dependency := range []flow.TaskID{
+				waitUntilNetworkIsDestroyed,
+				deleteAllOperatingSystemConfigs,
+				waitUntilStaleExtensionsDeleted,
+				waitUntilExtensionsBeforeKAPIDeleted,
+				waitUntilContainerRuntimeDeleted,
+			} {
+				Expect(allDependencies(id)).To(HaveKey(dependency), "%q must depend on %q", id, dependency)
+			}
+		}
+		Expect(allDependencies(deleteKubeAPIServer)).To(HaveKey(waitUntilShootManagedResourcesDelete))
+	})
+})
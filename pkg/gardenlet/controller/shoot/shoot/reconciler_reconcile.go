@@ -53,10 +53,12 @@ import (
 func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Operation, operationType gardencorev1beta1.LastOperationType) *v1beta1helper.WrappedLastErrors {
 	// We create the botanists (which will do the actual work).
 	var (
-		botanist                *botanistpkg.Botanist
-		err                     error
-		isCopyOfBackupsRequired bool
-		tasksWithErrors         []string
+		botanist                      *botanistpkg.Botanist
+		err                           error
+		isCopyOfBackupsRequired       bool
+		isEtcdScaleUpRequired         bool
+		isNetworkCIDRExpansionPending bool
+		tasksWithErrors               []string
 
 		isRestoring   = operationType == gardencorev1beta1.LastOperationTypeRestore
 		skipReadiness = metav1.HasAnnotation(o.Shoot.GetInfo().ObjectMeta, v1beta1constants.AnnotationShootSkipReadiness)
@@ -92,6 +94,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			isCopyOfBackupsRequired, err = botanist.IsCopyOfBackupsRequired(ctx)
 			return err
 		}),
+		errors.ToExecute("Check if scale-up of etcd is required", func() error {
+			isEtcdScaleUpRequired, err = botanist.IsEtcdScaleUpRequired(ctx)
+			return err
+		}),
+		errors.ToExecute("Check if expansion of pod or service network is pending", func() error {
+			isNetworkCIDRExpansionPending, err = botanist.IsNetworkCIDRExpansionPending(ctx)
+			return err
+		}),
 	)
 	if err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
@@ -108,10 +118,17 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		useDNS                          = botanist.ShootUsesDNS()
 		generation                      = o.Shoot.GetInfo().Generation
 		requestControlPlanePodsRestart  = controllerutils.HasTask(o.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskRestartControlPlanePods)
-		kubeProxyEnabled                = v1beta1helper.KubeProxyEnabled(o.Shoot.GetInfo().Spec.Kubernetes.KubeProxy)
+		requestDiagnosticsCollection    = controllerutils.HasTask(o.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskCollectDiagnostics)
+		requestResilienceValidation     = controllerutils.HasTask(o.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskValidateResilience)
+		requestDeletionPreview          = controllerutils.HasTask(o.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskPreviewDeletion)
+		requestInfrastructurePlan       = controllerutils.HasTask(o.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskPlanInfrastructure) && !botanist.IsRestorePhase()
+		kubeProxyEnabled                = v1beta1helper.KubeProxyEnabled(o.Shoot.GetInfo().Spec.Kubernetes.KubeProxy) && !v1beta1helper.IsSystemComponentUserProvided(o.Shoot.GetInfo().Spec.SystemComponents, gardencorev1beta1.UserProvidedSystemComponentKubeProxy)
+		metricsServerUserProvided       = v1beta1helper.IsSystemComponentUserProvided(o.Shoot.GetInfo().Spec.SystemComponents, gardencorev1beta1.UserProvidedSystemComponentMetricsServer)
 		shootControlPlaneLoggingEnabled = botanist.Shoot.IsShootControlPlaneLoggingEnabled(botanist.Config)
 		deployKubeAPIServerTaskTimeout  = defaultTimeout
 		shootSSHAccessEnabled           = v1beta1helper.ShootEnablesSSHAccess(o.Shoot.GetInfo())
+		kubeAPIServerStaticIPRequested  = botanist.KubeAPIServerStaticIPRequested()
+		etcdScaleUpPending              = !o.Shoot.HibernationEnabled && (isEtcdScaleUpRequired || helper.IsEtcdHighAvailabilityScaleUpPending(o.Shoot.GetInfo()))
 	)
 
 	// During the 'Preparing' phase of different rotation operations, components are deployed twice. Also, the
@@ -196,12 +213,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
 		})
 		_ = g.Add(flow.Task{
-			Name: "Deploying external domain DNS record",
+			Name: "Deploying private domain DNS record",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				if err := botanist.DeployOrDestroyExternalDNSRecord(ctx); err != nil {
+				if err := botanist.DeployOrDestroyPrivateDNSRecord(ctx); err != nil {
 					return err
 				}
-				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskDeployDNSRecordExternal)
+				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskDeployDNSRecordPrivate)
 			}),
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
@@ -209,7 +226,18 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deployInfrastructure = g.Add(flow.Task{
 			Name:         "Deploying Shoot infrastructure",
 			Fn:           flow.TaskFn(botanist.DeployInfrastructure).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       o.Shoot.IsWorkerless,
+			SkipIf:       o.Shoot.IsWorkerless || requestInfrastructurePlan,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, deployReferencedResources),
+		})
+		planInfrastructure = g.Add(flow.Task{
+			Name: "Planning Shoot infrastructure changes",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := botanist.PlanInfrastructure(ctx); err != nil {
+					return err
+				}
+				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskPlanInfrastructure)
+			}),
+			SkipIf:       o.Shoot.IsWorkerless || !requestInfrastructurePlan,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, deployReferencedResources),
 		})
 		waitUntilInfrastructureReady = g.Add(flow.Task{
@@ -222,8 +250,41 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				}
 				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskDeployInfrastructure)
 			}),
-			SkipIf:       o.Shoot.IsWorkerless,
-			Dependencies: flow.NewTaskIDs(deployInfrastructure),
+			// When a plan was requested, the changes were not applied and the status was already extracted while
+			// planning. The deployment task is kept so that the changes are applied with the next reconciliation.
+			SkipIf:       o.Shoot.IsWorkerless || requestInfrastructurePlan,
+			Dependencies: flow.NewTaskIDs(deployInfrastructure, planInfrastructure),
+		})
+		deployKubeAPIServerAllowlist = g.Add(flow.Task{
+			Name:         "Deploying Kubernetes API server allowlist in the Seed cluster",
+			Fn:           flow.TaskFn(botanist.DeployKubeAPIServerAllowlist).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServerService, waitUntilInfrastructureReady),
+		})
+		deployKubeAPIServerEndpoint = g.Add(flow.Task{
+			Name:         "Deploying Kubernetes API server endpoint with static IP in the Seed cluster",
+			Fn:           flow.TaskFn(botanist.DeployKubeAPIServerEndpoint).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServerService, waitUntilInfrastructureReady),
+		})
+		waitUntilKubeAPIServerEndpointIsReady = g.Add(flow.Task{
+			Name:         "Waiting until Kubernetes API server endpoint with static IP in the Seed cluster has reported readiness",
+			Fn:           botanist.WaitUntilKubeAPIServerEndpointIsReady,
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServerEndpoint, waitUntilKubeAPIServerServiceIsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Updating Kubernetes API server endpoint status of the Shoot",
+			Fn:           botanist.UpdateKubeAPIServerEndpointStatus,
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServerAllowlist, waitUntilKubeAPIServerEndpointIsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Deploying external domain DNS record",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := botanist.DeployOrDestroyExternalDNSRecord(ctx); err != nil {
+					return err
+				}
+				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskDeployDNSRecordExternal)
+			}),
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady).InsertIf(kubeAPIServerStaticIPRequested, waitUntilKubeAPIServerEndpointIsReady),
 		})
 		deploySourceBackupEntry = g.Add(flow.Task{
 			Name:   "Deploying source backup entry",
@@ -266,10 +327,28 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       !isCopyOfBackupsRequired,
 			Dependencies: flow.NewTaskIDs(waitUntilEtcdBackupsCopied),
 		})
+		prepareEtcdScaleUp = g.Add(flow.Task{
+			Name: "Preparing scale-up of main and events etcd to highly available clusters",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+					helper.SetEtcdHighAvailabilityCondition(r.Clock, shoot, gardencorev1beta1.ConditionProgressing, helper.EtcdHighAvailabilityConditionReasonScalingUp, "Scaling up etcd clusters from a single member to three members")
+					return nil
+				}); err != nil {
+					return err
+				}
+
+				if !allowBackup || !isEtcdScaleUpRequired {
+					return nil
+				}
+				return r.reportEtcdScaleUpError(ctx, o, botanist.SnapshotEtcd(ctx))
+			}).RetryUntilTimeout(defaultInterval, 5*time.Minute),
+			SkipIf:       !etcdScaleUpPending,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
 		deployETCD = g.Add(flow.Task{
 			Name:         "Deploying main and events etcd",
 			Fn:           flow.TaskFn(botanist.DeployEtcd).RetryUntilTimeout(defaultInterval, helper.GetEtcdDeployTimeout(o.Shoot, defaultTimeout)),
-			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilEtcdBackupsCopied),
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilEtcdBackupsCopied, prepareEtcdScaleUp),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Destroying source backup entry",
@@ -283,6 +362,21 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Verifying quorum of scaled-up main and events etcd",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := botanist.VerifyEtcdQuorum(ctx); err != nil {
+					return r.reportEtcdScaleUpError(ctx, o, err)
+				}
+
+				return o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+					helper.SetEtcdHighAvailabilityCondition(r.Clock, shoot, gardencorev1beta1.ConditionTrue, helper.EtcdHighAvailabilityConditionReasonApplied, "All members of the etcd clusters are ready and their quorum is protected")
+					return nil
+				})
+			}).RetryUntilTimeout(defaultInterval, 5*time.Minute),
+			SkipIf:       !etcdScaleUpPending,
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdReady),
+		})
 		deployExtensionResourcesBeforeKAPI = g.Add(flow.Task{
 			Name:         "Deploying extension resources before kube-apiserver",
 			Fn:           flow.TaskFn(botanist.DeployExtensionsBeforeKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -298,13 +392,15 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deployKubeAPIServer = g.Add(flow.Task{
 			Name: "Deploying Kubernetes API server",
 			Fn:   flow.TaskFn(botanist.DeployKubeAPIServer).RetryUntilTimeout(defaultInterval, deployKubeAPIServerTaskTimeout),
+			// When the pod or service network was expanded, the infrastructure has to be reconciled before
+			// kube-apiserver and kube-controller-manager pick up the new CIDRs.
 			Dependencies: flow.NewTaskIDs(
 				initializeSecretsManagement,
 				deployETCD,
 				waitUntilEtcdReady,
 				waitUntilKubeAPIServerServiceIsReady,
 				waitUntilExtensionResourcesBeforeKAPIReady,
-			).InsertIf(!staticNodesCIDR, waitUntilInfrastructureReady),
+			).InsertIf(!staticNodesCIDR || isNetworkCIDRExpansionPending, waitUntilInfrastructureReady),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server rolled out",
@@ -413,7 +509,29 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		rewriteResourcesAddLabel = g.Add(flow.Task{
 			Name: "Labeling resources after modification of encryption config or to encrypt them with new ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, o.Logger, o.ShootClientSet, o.SecretsManager, o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources, gardenerutils.DefaultGVKsForEncryption())
+				var reportProgress secretsrotation.ProgressReporter
+				if v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) == gardencorev1beta1.RotationPreparing {
+					reportProgress = func(ctx context.Context, resources []gardencorev1beta1.ReencryptedResource) error {
+						return o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+							v1beta1helper.MutateShootETCDEncryptionKeyRotation(shoot, func(rotation *gardencorev1beta1.ETCDEncryptionKeyRotation) {
+								rotation.ReencryptedResources = resources
+							})
+							return nil
+						})
+					}
+				} else {
+					reportProgress = func(ctx context.Context, resources []gardencorev1beta1.ReencryptedResource) error {
+						return o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+							helper.SetEncryptionConfigCondition(r.Clock, shoot, gardencorev1beta1.ConditionProgressing, helper.EncryptionConfigConditionReasonRewriting, helper.EncryptionConfigProgressMessage(resources))
+							return nil
+						})
+					}
+				}
+
+				if err := secretsrotation.RewriteEncryptedDataAddLabel(ctx, o.Logger, o.ShootClientSet, o.SecretsManager, o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources, gardenerutils.DefaultGVKsForEncryption(), reportProgress); err != nil {
+					return r.reportEncryptionConfigRewriteError(ctx, o, err)
+				}
+				return nil
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
 			SkipIf: v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing &&
 				apiequality.Semantic.DeepEqual(o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources),
@@ -433,7 +551,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name: "Removing label from resources after modification of encryption config or rotation of ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				if err := secretsrotation.RewriteEncryptedDataRemoveLabel(ctx, o.Logger, o.SeedClientSet.Client(), o.ShootClientSet, o.Shoot.SeedNamespace, v1beta1constants.DeploymentNameKubeAPIServer, o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources, gardenerutils.DefaultGVKsForEncryption()); err != nil {
-					return err
+					return r.reportEncryptionConfigRewriteError(ctx, o, err)
 				}
 
 				if !apiequality.Semantic.DeepEqual(o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources) {
@@ -444,6 +562,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 						}
 
 						shoot.Status.EncryptedResources = encryptedResources
+						helper.SetEncryptionConfigCondition(r.Clock, shoot, gardencorev1beta1.ConditionTrue, helper.EncryptionConfigConditionReasonApplied, "All objects of the resources in the encryption configuration have been rewritten")
 						return nil
 					}); err != nil {
 						return err
@@ -502,6 +621,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       v1beta1helper.GetShootServiceAccountKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationCompleting,
 			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilKubeControllerManagerReady),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Publishing discovery documents of the managed service account issuer",
+			Fn:           flow.TaskFn(botanist.SyncServiceAccountIssuerDiscovery).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
 		deleteBastions = g.Add(flow.Task{
 			Name:         "Deleting Bastions",
 			Fn:           botanist.DeleteBastions,
@@ -550,10 +675,11 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deleteStaleOperatingSystemConfigResources),
 		})
 		deployNetwork = g.Add(flow.Task{
-			Name:         "Deploying shoot network plugin",
-			Fn:           flow.TaskFn(botanist.DeployNetwork).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       o.Shoot.IsWorkerless,
-			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilGardenerResourceManagerReady, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady),
+			Name:   "Deploying shoot network plugin",
+			Fn:     flow.TaskFn(botanist.DeployNetwork).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf: o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilGardenerResourceManagerReady, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady).
+				InsertIf(isNetworkCIDRExpansionPending, waitUntilInfrastructureReady, deployKubeControllerManager),
 		})
 		waitUntilNetworkIsReady = g.Add(flow.Task{
 			Name: "Waiting until shoot network plugin has been reconciled",
@@ -571,6 +697,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, ensureShootClusterIdentity, waitUntilOperatingSystemConfigReady),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Deploying shoot trust bundle",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.DeployTrustBundle(ctx)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, ensureShootClusterIdentity, waitUntilOperatingSystemConfigReady),
+		})
 		deployShootSystemResources = g.Add(flow.Task{
 			Name:         "Deploying shoot system resources",
 			Fn:           flow.TaskFn(botanist.DeployShootSystem).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -602,9 +736,17 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.SystemComponents.MetricsServer.Deploy(ctx)
 			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || metricsServerUserProvided,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Deleting metrics-server system component since it is user-provided",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.Shoot.Components.SystemComponents.MetricsServer.Destroy(ctx)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || !metricsServerUserProvided,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, waitUntilShootNamespacesReady),
+		})
 		deployVPNShoot = g.Add(flow.Task{
 			Name: "Deploying vpn-shoot system component",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
@@ -669,6 +811,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying policy bundles",
+			Fn:           flow.TaskFn(botanist.DeployPolicyBundles).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, waitUntilShootNamespacesReady),
+		})
 		deployKubernetesDashboard = g.Add(flow.Task{
 			Name:         "Deploying addon Kubernetes Dashboard",
 			Fn:           flow.TaskFn(botanist.DeployKubernetesDashboard).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -848,6 +996,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       !o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(hibernateControlPlane),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Destroying private domain DNS record if hibernated",
+			Fn:           botanist.DestroyPrivateDNSRecord,
+			SkipIf:       !o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(hibernateControlPlane),
+		})
 		deleteStaleExtensionResources = g.Add(flow.Task{
 			Name:         "Deleting stale extension resources",
 			Fn:           flow.TaskFn(botanist.Shoot.Components.Extensions.Extension.DeleteStaleResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -900,6 +1054,39 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       !requestControlPlanePodsRestart,
 			Dependencies: flow.NewTaskIDs(deployKubeControllerManager, deployControlPlane, deployControlPlaneExposure),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Collecting diagnostic bundle of control plane components",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := botanist.CollectDiagnosticBundle(ctx); err != nil {
+					return err
+				}
+				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskCollectDiagnostics)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !requestDiagnosticsCollection,
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, deployKubeControllerManager, deployKubeScheduler, deployControlPlane, deployControlPlaneExposure),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Validating resilience against disruptions of control plane components",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := botanist.ValidateResilience(ctx); err != nil {
+					return err
+				}
+				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskValidateResilience)
+			}),
+			SkipIf:       !requestResilienceValidation || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, waitUntilEtcdReady, waitUntilTunnelConnectionExists, waitUntilWorkerReady),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Previewing the deletion of the Shoot",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := botanist.PreviewDeletion(ctx); err != nil {
+					return err
+				}
+				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskPreviewDeletion)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !requestDeletionPreview,
+			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilWorkerReady),
+		})
 	)
 
 	f := g.Compile()
@@ -987,3 +1174,40 @@ func removeTaskAnnotation(ctx context.Context, o *operation.Operation, generatio
 		return nil
 	})
 }
+
+// reportEtcdScaleUpError reports the given error which occurred while scaling up the etcd clusters of the shoot to
+// highly available clusters in the EtcdHighAvailabilityApplied condition of the shoot.
+func (r *Reconciler) reportEtcdScaleUpError(ctx context.Context, o *operation.Operation, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if updateErr := o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+		helper.SetEtcdHighAvailabilityCondition(r.Clock, shoot, gardencorev1beta1.ConditionFalse, helper.EtcdHighAvailabilityConditionReasonScaleUpBlocked,
+			fmt.Sprintf("Scaling up etcd clusters to three members is blocked: %v", err))
+		return nil
+	}); updateErr != nil {
+		return fmt.Errorf("%w (failed reporting error in shoot status: %w)", err, updateErr)
+	}
+
+	return err
+}
+
+// reportEncryptionConfigRewriteError reports the given error which occurred while rewriting the objects of the
+// resources of a modified encryption configuration in the EncryptionConfigApplied condition of the shoot. Errors during
+// the rotation of the ETCD encryption key are not reported since the rotation has its own status.
+func (r *Reconciler) reportEncryptionConfigRewriteError(ctx context.Context, o *operation.Operation, err error) error {
+	if apiequality.Semantic.DeepEqual(o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources) {
+		return err
+	}
+
+	if updateErr := o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+		helper.SetEncryptionConfigCondition(r.Clock, shoot, gardencorev1beta1.ConditionFalse, helper.EncryptionConfigConditionReasonRewriteBlocked,
+			fmt.Sprintf("Rewriting objects of resources to apply the modified encryption configuration is blocked: %v", err))
+		return nil
+	}); updateErr != nil {
+		return fmt.Errorf("%w (failed reporting error in shoot status: %w)", err, updateErr)
+	}
+
+	return err
+}
@@ -85,6 +85,19 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version
+	// Rollout contains settings for the staged rollout of operating system config changes to the nodes of the worker
+	// pool. If it is not set, changes are applied to all nodes at the same time.
+	Rollout *OperatingSystemConfigRolloutConfig
+}
+
+// OperatingSystemConfigRolloutConfig contains settings for the staged rollout of operating system config changes.
+type OperatingSystemConfigRolloutConfig struct {
+	// CanaryNodes is the number of nodes of the worker pool to which changes are applied first. Changes are only
+	// applied to the remaining nodes once all canary nodes have been verified to be healthy.
+	CanaryNodes int32
+	// HealthTimeout is the duration within which the canary nodes must become healthy after the changes have been
+	// applied. If a canary node does not become healthy in time, the rollout is halted. It is defaulted to 10m.
+	HealthTimeout *metav1.Duration
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
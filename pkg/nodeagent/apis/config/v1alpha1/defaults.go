@@ -49,6 +49,13 @@ func SetDefaults_OperatingSystemConfigControllerConfig(obj *OperatingSystemConfi
 	}
 }
 
+// SetDefaults_OperatingSystemConfigRolloutConfig sets defaults for the OperatingSystemConfigRolloutConfig object.
+func SetDefaults_OperatingSystemConfigRolloutConfig(obj *OperatingSystemConfigRolloutConfig) {
+	if obj.HealthTimeout == nil {
+		obj.HealthTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_TokenControllerConfig sets defaults for the TokenControllerConfig object.
 func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	if obj.SyncPeriod == nil {
@@ -78,6 +78,24 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 					Expect(obj.SyncJitterPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
 				})
+
+				Describe("Rollout", func() {
+					It("should default the object", func() {
+						obj := &OperatingSystemConfigRolloutConfig{CanaryNodes: 1}
+
+						SetDefaults_OperatingSystemConfigRolloutConfig(obj)
+
+						Expect(obj.HealthTimeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					})
+
+					It("should not overwrite existing values", func() {
+						obj := &OperatingSystemConfigRolloutConfig{CanaryNodes: 1, HealthTimeout: &metav1.Duration{Duration: time.Minute}}
+
+						SetDefaults_OperatingSystemConfigRolloutConfig(obj)
+
+						Expect(obj.HealthTimeout).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					})
+				})
 			})
 
 			Describe("Token controller", func() {
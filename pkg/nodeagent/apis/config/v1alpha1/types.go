@@ -53,6 +53,19 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+	// AnnotationKeyCanaryAppliedOperatingSystemConfigTime is a constant for an annotation key on a Node describing the
+	// time when the last operating system configuration has been applied to it as canary node of a staged rollout.
+	AnnotationKeyCanaryAppliedOperatingSystemConfigTime = "node-agent.gardener.cloud/canary-applied-at"
+	// AnnotationKeyChecksumVerifiedOperatingSystemConfig is a constant for an annotation key on a canary Node describing
+	// the checksum of the last operating system configuration which has been verified to be healthy.
+	AnnotationKeyChecksumVerifiedOperatingSystemConfig = "node-agent.gardener.cloud/canary-verified-checksum"
+	// AnnotationKeyChecksumFailedOperatingSystemConfig is a constant for an annotation key on a canary Node describing
+	// the checksum of the last operating system configuration for which the health verification has failed.
+	AnnotationKeyChecksumFailedOperatingSystemConfig = "node-agent.gardener.cloud/canary-failed-checksum"
+
+	// NodeConditionTypeOperatingSystemConfigRollout is a constant for the type of the Node condition reporting the
+	// state of the staged rollout of the operating system configuration.
+	NodeConditionTypeOperatingSystemConfigRollout = "OperatingSystemConfigRollout"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -126,6 +139,21 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
+	// Rollout contains settings for the staged rollout of operating system config changes to the nodes of the worker
+	// pool. If it is not set, changes are applied to all nodes at the same time.
+	// +optional
+	Rollout *OperatingSystemConfigRolloutConfig `json:"rollout,omitempty"`
+}
+
+// OperatingSystemConfigRolloutConfig contains settings for the staged rollout of operating system config changes.
+type OperatingSystemConfigRolloutConfig struct {
+	// CanaryNodes is the number of nodes of the worker pool to which changes are applied first. Changes are only
+	// applied to the remaining nodes once all canary nodes have been verified to be healthy.
+	CanaryNodes int32 `json:"canaryNodes"`
+	// HealthTimeout is the duration within which the canary nodes must become healthy after the changes have been
+	// applied. If a canary node does not become healthy in time, the rollout is halted. It is defaulted to 10m.
+	// +optional
+	HealthTimeout *metav1.Duration `json:"healthTimeout,omitempty"`
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
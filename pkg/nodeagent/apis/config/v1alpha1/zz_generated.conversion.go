@@ -90,6 +90,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemConfigRolloutConfig)(nil), (*config.OperatingSystemConfigRolloutConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig(a.(*OperatingSystemConfigRolloutConfig), b.(*config.OperatingSystemConfigRolloutConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OperatingSystemConfigRolloutConfig)(nil), (*OperatingSystemConfigRolloutConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig(a.(*config.OperatingSystemConfigRolloutConfig), b.(*OperatingSystemConfigRolloutConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -278,6 +288,7 @@ func autoConvert_v1alpha1_OperatingSystemConfigControllerConfig_To_config_Operat
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.SecretName = in.SecretName
 	out.KubernetesVersion = (*v3.Version)(unsafe.Pointer(in.KubernetesVersion))
+	out.Rollout = (*config.OperatingSystemConfigRolloutConfig)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -291,6 +302,7 @@ func autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_Operat
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.SecretName = in.SecretName
 	out.KubernetesVersion = (*v3.Version)(unsafe.Pointer(in.KubernetesVersion))
+	out.Rollout = (*OperatingSystemConfigRolloutConfig)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -299,6 +311,28 @@ func Convert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingS
 	return autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingSystemConfigControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig(in *OperatingSystemConfigRolloutConfig, out *config.OperatingSystemConfigRolloutConfig, s conversion.Scope) error {
+	out.CanaryNodes = in.CanaryNodes
+	out.HealthTimeout = (*v1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig(in *OperatingSystemConfigRolloutConfig, out *config.OperatingSystemConfigRolloutConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig(in, out, s)
+}
+
+func autoConvert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig(in *config.OperatingSystemConfigRolloutConfig, out *OperatingSystemConfigRolloutConfig, s conversion.Scope) error {
+	out.CanaryNodes = in.CanaryNodes
+	out.HealthTimeout = (*v1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	return nil
+}
+
+// Convert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig is an autogenerated conversion function.
+func Convert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig(in *config.OperatingSystemConfigRolloutConfig, out *OperatingSystemConfigRolloutConfig, s conversion.Scope) error {
+	return autoConvert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -38,5 +38,8 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ClientConnectionConfiguration(&in.ClientConnection)
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
+	if in.Controllers.OperatingSystemConfig.Rollout != nil {
+		SetDefaults_OperatingSystemConfigRolloutConfig(in.Controllers.OperatingSystemConfig.Rollout)
+	}
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
 }
@@ -75,6 +75,16 @@ func validateOperatingSystemConfigControllerConfiguration(conf config.OperatingS
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesVersion"), conf.KubernetesVersion, err.Error()))
 	}
 
+	if conf.Rollout != nil {
+		if conf.Rollout.CanaryNodes < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rollout", "canaryNodes"), conf.Rollout.CanaryNodes, "must be at least 1"))
+		}
+
+		if conf.Rollout.HealthTimeout == nil || conf.Rollout.HealthTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rollout", "healthTimeout"), conf.Rollout.HealthTimeout, "must be positive"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -87,6 +87,30 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 				})),
 			))
 		})
+
+		It("should pass because the rollout config is valid", func() {
+			config.Controllers.OperatingSystemConfig.Rollout = &OperatingSystemConfigRolloutConfig{
+				CanaryNodes:   1,
+				HealthTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the rollout config is invalid", func() {
+			config.Controllers.OperatingSystemConfig.Rollout = &OperatingSystemConfigRolloutConfig{}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.rollout.canaryNodes"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.rollout.healthTimeout"),
+				})),
+			))
+		})
 	})
 
 	Context("Token Controller", func() {
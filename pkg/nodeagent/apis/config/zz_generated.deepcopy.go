@@ -152,6 +152,11 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopyInto(out *OperatingSyst
 		*out = new(v3.Version)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(OperatingSystemConfigRolloutConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -165,6 +170,27 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopy() *OperatingSystemConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemConfigRolloutConfig) DeepCopyInto(out *OperatingSystemConfigRolloutConfig) {
+	*out = *in
+	if in.HealthTimeout != nil {
+		in, out := &in.HealthTimeout, &out.HealthTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemConfigRolloutConfig.
+func (in *OperatingSystemConfigRolloutConfig) DeepCopy() *OperatingSystemConfigRolloutConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemConfigRolloutConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
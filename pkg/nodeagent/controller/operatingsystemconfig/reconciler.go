@@ -32,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -56,12 +57,14 @@ const lastAppliedOperatingSystemConfigFilePath = nodeagentv1alpha1.BaseDir + "/l
 // node.
 type Reconciler struct {
 	Client        client.Client
+	APIReader     client.Reader
 	Config        config.OperatingSystemConfigControllerConfig
 	Recorder      record.EventRecorder
 	DBus          dbus.DBus
 	FS            afero.Afero
 	Extractor     registry.Extractor
 	CancelContext context.CancelFunc
+	Clock         clock.Clock
 	HostName      string
 	NodeName      string
 }
@@ -99,10 +102,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if node != nil && node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
+		if r.canaryVerificationPending(node, oscChecksum) {
+			log.Info("Configuration on this canary node is up to date, verifying its health")
+			return r.verifyCanary(ctx, log, node, oscChecksum)
+		}
+
 		log.Info("Configuration on this node is up to date, nothing to be done")
 		return reconcile.Result{}, nil
 	}
 
+	var canary bool
+	if r.rolloutEnabled(node) {
+		var requeueAfter time.Duration
+		canary, requeueAfter, err = r.checkRolloutGate(ctx, log, node, oscChecksum)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed checking rollout state of operating system config: %w", err)
+		}
+		if requeueAfter > 0 {
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+	}
+
 	log.Info("Applying new or changed files")
 	if err := r.applyChangedFiles(ctx, log, oscChanges.files.changed); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed applying changed files: %w", err)
@@ -169,7 +189,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
+
+	if r.Config.Rollout == nil {
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
+	}
+
+	if !canary {
+		delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyCanaryAppliedOperatingSystemConfigTime)
+		if err := r.Client.Patch(ctx, node, patch); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.setRolloutCondition(ctx, node.Name, corev1.ConditionTrue, conditionReasonApplied, "Operating system config has been applied")
+	}
+
+	log.Info("Operating system config has been applied to this canary node, verifying its health")
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyCanaryAppliedOperatingSystemConfigTime, r.Clock.Now().UTC().Format(time.RFC3339))
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: canaryHealthCheckInterval}, r.setRolloutCondition(ctx, node.Name, corev1.ConditionUnknown, conditionReasonCanaryVerificationPending, "Operating system config has been applied to this canary node, verifying its health")
 }
 
 func (r *Reconciler) getNode(ctx context.Context) (*metav1.PartialObjectMetadata, error) {
@@ -214,25 +252,30 @@ func (r *Reconciler) applyChangedFiles(ctx context.Context, log logr.Logger, fil
 
 		switch {
 		case file.Content.Inline != nil:
-			if err := r.FS.MkdirAll(filepath.Dir(file.Path), fs.ModeDir); err != nil {
-				return fmt.Errorf("unable to create directory %q: %w", file.Path, err)
-			}
-
 			data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
 			if err != nil {
 				return fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
 			}
 
-			tmpFilePath := filepath.Join(tmpDir, filepath.Base(file.Path))
-			if err := r.FS.WriteFile(tmpFilePath, data, permissions); err != nil {
-				return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
+			if err := r.writeFile(tmpDir, file.Path, data, permissions); err != nil {
+				return err
 			}
 
-			if err := r.FS.Rename(tmpFilePath, file.Path); err != nil {
-				return fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, file.Path, err)
+			log.Info("Successfully applied new or changed file", "path", file.Path)
+
+		case file.Content.SecretRef != nil:
+			// The referenced secrets are not part of the cache (it only contains the OperatingSystemConfig secret),
+			// hence we read them directly from the API server.
+			secret := &corev1.Secret{}
+			if err := r.APIReader.Get(ctx, client.ObjectKey{Name: file.Content.SecretRef.Name, Namespace: metav1.NamespaceSystem}, secret); err != nil {
+				return fmt.Errorf("unable to read secret %q referenced by file %q: %w", file.Content.SecretRef.Name, file.Path, err)
 			}
 
-			log.Info("Successfully applied new or changed file", "path", file.Path)
+			if err := r.writeFile(tmpDir, file.Path, secret.Data[file.Content.SecretRef.DataKey], permissions); err != nil {
+				return err
+			}
+
+			log.Info("Successfully applied new or changed file from secret", "path", file.Path, "secretName", file.Content.SecretRef.Name)
 
 		case file.Content.ImageRef != nil:
 			if err := r.Extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, file.Content.ImageRef.FilePathInImage, file.Path, permissions); err != nil {
@@ -246,6 +289,23 @@ func (r *Reconciler) applyChangedFiles(ctx context.Context, log logr.Logger, fil
 	return nil
 }
 
+func (r *Reconciler) writeFile(tmpDir, filePath string, data []byte, permissions fs.FileMode) error {
+	if err := r.FS.MkdirAll(filepath.Dir(filePath), fs.ModeDir); err != nil {
+		return fmt.Errorf("unable to create directory %q: %w", filePath, err)
+	}
+
+	tmpFilePath := filepath.Join(tmpDir, filepath.Base(filePath))
+	if err := r.FS.WriteFile(tmpFilePath, data, permissions); err != nil {
+		return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
+	}
+
+	if err := r.FS.Rename(tmpFilePath, filePath); err != nil {
+		return fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, filePath, err)
+	}
+
+	return nil
+}
+
 func (r *Reconciler) removeDeletedFiles(log logr.Logger, files []extensionsv1alpha1.File) error {
 	for _, file := range files {
 		if err := r.FS.Remove(file.Path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
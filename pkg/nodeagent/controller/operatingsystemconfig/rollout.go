@@ -0,0 +1,253 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+const (
+	// rolloutWaitInterval is the interval in which nodes waiting for the canary nodes re-check the rollout state.
+	rolloutWaitInterval = 30 * time.Second
+	// canaryHealthCheckInterval is the interval in which canary nodes re-check their health after the operating system
+	// config has been applied.
+	canaryHealthCheckInterval = 10 * time.Second
+
+	conditionReasonApplied                   = "Applied"
+	conditionReasonCanaryVerificationPending = "CanaryVerificationPending"
+	conditionReasonCanaryHealthy             = "CanaryHealthy"
+	conditionReasonCanaryHealthCheckFailed   = "CanaryHealthCheckFailed"
+	conditionReasonWaitingForCanaries        = "WaitingForCanaries"
+	conditionReasonRolloutHalted             = "RolloutHalted"
+)
+
+// rolloutEnabled returns whether the operating system config must be rolled out in stages to the given node. This is
+// only the case if a rollout is configured and the node has already been provisioned with an operating system config
+// before, i.e., new nodes always apply the operating system config immediately.
+func (r *Reconciler) rolloutEnabled(node *metav1.PartialObjectMetadata) bool {
+	return r.Config.Rollout != nil &&
+		node != nil &&
+		node.Labels[v1beta1constants.LabelWorkerPool] != "" &&
+		node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] != ""
+}
+
+// canaryNodes returns the canary nodes of the given worker pool. These are the oldest nodes of the pool which are not
+// being deleted, so that they are stable as long as the pool is not scaled down.
+func (r *Reconciler) canaryNodes(ctx context.Context, pool string) ([]metav1.PartialObjectMetadata, error) {
+	nodeList := &metav1.PartialObjectMetadataList{}
+	nodeList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NodeList"))
+	if err := r.Client.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPool: pool}); err != nil {
+		return nil, fmt.Errorf("failed listing nodes of worker pool %q: %w", pool, err)
+	}
+
+	nodes := slices.DeleteFunc(nodeList.Items, func(node metav1.PartialObjectMetadata) bool {
+		return node.DeletionTimestamp != nil
+	})
+	slices.SortFunc(nodes, func(a, b metav1.PartialObjectMetadata) int {
+		if c := a.CreationTimestamp.Time.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	if canaryNodes := int(r.Config.Rollout.CanaryNodes); len(nodes) > canaryNodes {
+		nodes = nodes[:canaryNodes]
+	}
+
+	return nodes, nil
+}
+
+// checkRolloutGate checks whether the operating system config with the given checksum may be applied to the given
+// node. Canary nodes may always apply it. All other nodes have to wait until all canary nodes have been verified to be
+// healthy with this operating system config. If the verification of any canary node has failed, the rollout is halted.
+// It returns whether the node is a canary node and the duration after which the gate must be checked again if the
+// operating system config must not be applied yet.
+func (r *Reconciler) checkRolloutGate(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, oscChecksum string) (bool, time.Duration, error) {
+	canaries, err := r.canaryNodes(ctx, node.Labels[v1beta1constants.LabelWorkerPool])
+	if err != nil {
+		return false, 0, err
+	}
+
+	if slices.ContainsFunc(canaries, func(canary metav1.PartialObjectMetadata) bool { return canary.Name == node.Name }) {
+		return true, 0, nil
+	}
+
+	var pendingCanaries []string
+	for _, canary := range canaries {
+		switch oscChecksum {
+		case canary.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumFailedOperatingSystemConfig]:
+			message := fmt.Sprintf("Rollout of operating system config has been halted because canary node %q did not become healthy", canary.Name)
+			log.Info("Rollout of operating system config has been halted, canary node did not become healthy", "canaryNodeName", canary.Name)
+			r.Recorder.Event(node, corev1.EventTypeWarning, "OSCRolloutHalted", message)
+			return false, r.Config.SyncPeriod.Duration, r.setRolloutCondition(ctx, node.Name, corev1.ConditionFalse, conditionReasonRolloutHalted, message)
+
+		case canary.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumVerifiedOperatingSystemConfig]:
+			continue
+
+		default:
+			pendingCanaries = append(pendingCanaries, canary.Name)
+		}
+	}
+
+	if len(pendingCanaries) > 0 {
+		log.Info("Waiting for canary nodes to be verified healthy before applying operating system config, requeuing", "canaryNodeNames", pendingCanaries)
+		return false, rolloutWaitInterval, r.setRolloutCondition(ctx, node.Name, corev1.ConditionUnknown, conditionReasonWaitingForCanaries,
+			fmt.Sprintf("Waiting for canary nodes %s to be verified healthy", strings.Join(pendingCanaries, ", ")))
+	}
+
+	return false, 0, nil
+}
+
+// canaryVerificationPending returns whether the operating system config with the given checksum has been applied to
+// the given node as canary node and its health has not been verified yet.
+func (r *Reconciler) canaryVerificationPending(node *metav1.PartialObjectMetadata, oscChecksum string) bool {
+	return r.Config.Rollout != nil &&
+		node.Annotations[nodeagentv1alpha1.AnnotationKeyCanaryAppliedOperatingSystemConfigTime] != "" &&
+		node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumVerifiedOperatingSystemConfig] != oscChecksum &&
+		node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumFailedOperatingSystemConfig] != oscChecksum
+}
+
+// verifyCanary verifies the health of the given canary node after the operating system config with the given checksum
+// has been applied. The result is recorded in annotations on the node so that the other nodes of the worker pool can
+// proceed with (or halt) the rollout.
+func (r *Reconciler) verifyCanary(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, oscChecksum string) (reconcile.Result, error) {
+	appliedAt, err := time.Parse(time.RFC3339, node.Annotations[nodeagentv1alpha1.AnnotationKeyCanaryAppliedOperatingSystemConfigTime])
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed parsing annotation %s: %w", nodeagentv1alpha1.AnnotationKeyCanaryAppliedOperatingSystemConfigTime, err)
+	}
+
+	unhealthyReason, err := r.checkCanaryHealth(ctx, node.Name)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking health of canary node: %w", err)
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+
+	if unhealthyReason == "" {
+		log.Info("Canary node is healthy, operating system config can be applied to the remaining nodes of the worker pool")
+		r.Recorder.Event(node, corev1.EventTypeNormal, "OSCCanaryHealthy", "Canary node is healthy after applying the operating system config")
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumVerifiedOperatingSystemConfig, oscChecksum)
+		if err := r.Client.Patch(ctx, node, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed marking canary node as verified: %w", err)
+		}
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.setRolloutCondition(ctx, node.Name, corev1.ConditionTrue, conditionReasonCanaryHealthy, "Canary node is healthy after applying the operating system config")
+	}
+
+	if timeout := r.Config.Rollout.HealthTimeout.Duration; r.Clock.Since(appliedAt) < timeout {
+		log.Info("Canary node is not healthy yet, requeuing", "reason", unhealthyReason)
+		return reconcile.Result{RequeueAfter: canaryHealthCheckInterval}, nil
+	}
+
+	message := fmt.Sprintf("Canary node did not become healthy within %s after applying the operating system config: %s", r.Config.Rollout.HealthTimeout.Duration, unhealthyReason)
+	log.Info("Canary node did not become healthy in time, halting rollout of operating system config", "reason", unhealthyReason)
+	r.Recorder.Event(node, corev1.EventTypeWarning, "OSCCanaryHealthCheckFailed", message)
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumFailedOperatingSystemConfig, oscChecksum)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed marking canary node as failed: %w", err)
+	}
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.setRolloutCondition(ctx, node.Name, corev1.ConditionFalse, conditionReasonCanaryHealthCheckFailed, message)
+}
+
+// checkCanaryHealth checks whether the node with the given name is ready and all pods managed by DaemonSets in the
+// kube-system namespace are running and ready on it. It returns the reason if the node is not healthy. The node and
+// pods are not part of the cache, hence they are read directly from the API server.
+func (r *Reconciler) checkCanaryHealth(ctx context.Context, nodeName string) (string, error) {
+	node := &corev1.Node{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return "", fmt.Errorf("failed reading node %q: %w", nodeName, err)
+	}
+
+	if err := health.CheckNode(node); err != nil {
+		return err.Error(), nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.APIReader.List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), client.MatchingFields{indexer.PodNodeName: nodeName}); err != nil {
+		return "", fmt.Errorf("failed listing pods on node %q: %w", nodeName, err)
+	}
+
+	var unhealthyPods []string
+	for _, pod := range podList.Items {
+		if !slices.ContainsFunc(pod.OwnerReferences, func(ref metav1.OwnerReference) bool { return ref.Kind == "DaemonSet" }) {
+			continue
+		}
+
+		if pod.Status.Phase != corev1.PodRunning || !health.IsPodReady(&pod) {
+			unhealthyPods = append(unhealthyPods, pod.Name)
+		}
+	}
+
+	if len(unhealthyPods) > 0 {
+		slices.Sort(unhealthyPods)
+		return fmt.Sprintf("DaemonSet pods are not running and ready: %s", strings.Join(unhealthyPods, ", ")), nil
+	}
+
+	return "", nil
+}
+
+// setRolloutCondition sets the condition reporting the state of the rollout of the operating system config on the
+// node with the given name.
+func (r *Reconciler) setRolloutCondition(ctx context.Context, nodeName string, status corev1.ConditionStatus, reason, message string) error {
+	node := &corev1.Node{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return fmt.Errorf("failed reading node %q: %w", nodeName, err)
+	}
+
+	var (
+		patch     = client.StrategicMergeFrom(node.DeepCopy())
+		now       = metav1.NewTime(r.Clock.Now())
+		condition = corev1.NodeCondition{
+			Type:               nodeagentv1alpha1.NodeConditionTypeOperatingSystemConfigRollout,
+			Status:             status,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+			Reason:             reason,
+			Message:            message,
+		}
+	)
+
+	if i := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool { return c.Type == condition.Type }); i >= 0 {
+		existing := node.Status.Conditions[i]
+		if existing.Status == status && existing.Reason == reason && existing.Message == message {
+			return nil
+		}
+		if existing.Status == status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		node.Status.Conditions[i] = condition
+	} else {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	}
+
+	if err := r.Client.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching condition %s of node %q: %w", condition.Type, nodeName, err)
+	}
+	return nil
+}
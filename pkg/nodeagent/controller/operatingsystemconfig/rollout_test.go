@@ -0,0 +1,265 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Rollout", func() {
+	const (
+		pool        = "pool"
+		oscChecksum = "new-checksum"
+	)
+
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		oscSecret *corev1.Secret
+		canary    *corev1.Node
+		other     *corev1.Node
+	)
+
+	newNode := func(name string, creationTimestamp time.Time) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.Time{Time: creationTimestamp},
+				Labels:            map[string]string{"worker.gardener.cloud/pool": pool},
+				Annotations:       map[string]string{"checksum/cloud-config-data": "old-checksum"},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	reconcileNode := func(node *corev1.Node) reconcile.Result {
+		reconciler.NodeName = node.Name
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(oscSecret)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		return result
+	}
+
+	rolloutCondition := func(node *corev1.Node) *corev1.NodeCondition {
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == "OperatingSystemConfigRollout" {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			WithStatusSubresource(&corev1.Node{}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			APIReader: fakeClient,
+			Config: config.OperatingSystemConfigControllerConfig{
+				SyncPeriod:        &metav1.Duration{Duration: time.Hour},
+				KubernetesVersion: semver.MustParse("1.27.3"),
+				Rollout: &config.OperatingSystemConfigRolloutConfig{
+					CanaryNodes:   1,
+					HealthTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+			Recorder: record.NewFakeRecorder(100),
+			DBus:     fakedbus.New(),
+			FS:       afero.Afero{Fs: afero.NewMemMapFs()},
+			Clock:    fakeClock,
+		}
+
+		oscSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "osc-secret",
+				Namespace:   "kube-system",
+				Annotations: map[string]string{"checksum/data-script": oscChecksum},
+			},
+			Data: map[string][]byte{"osc.yaml": []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+`)},
+		}
+		Expect(fakeClient.Create(ctx, oscSecret)).To(Succeed())
+
+		canary = newNode("canary", fakeClock.Now().Add(-2*time.Hour))
+		other = newNode("other", fakeClock.Now().Add(-time.Hour))
+		Expect(fakeClient.Create(ctx, canary)).To(Succeed())
+		Expect(fakeClient.Create(ctx, other)).To(Succeed())
+	})
+
+	Describe("canary nodes", func() {
+		It("should apply the config and mark the node for verification", func() {
+			Expect(reconcileNode(canary)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+			Expect(canary.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", oscChecksum))
+			Expect(canary.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/canary-applied-at", fakeClock.Now().Format(time.RFC3339)))
+			Expect(rolloutCondition(canary)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionUnknown),
+				"Reason": Equal("CanaryVerificationPending"),
+			})))
+		})
+
+		It("should mark the canary node as verified once it is healthy", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "kube-proxy-abc",
+					Namespace:       "kube-system",
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "kube-proxy"}},
+				},
+				Spec: corev1.PodSpec{NodeName: canary.Name},
+				Status: corev1.PodStatus{
+					Phase:      corev1.PodRunning,
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			})).To(Succeed())
+
+			reconcileNode(canary)
+			Expect(reconcileNode(canary)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(canary.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/canary-verified-checksum", oscChecksum))
+			Expect(rolloutCondition(canary)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionTrue),
+				"Reason": Equal("CanaryHealthy"),
+			})))
+		})
+
+		It("should wait for unhealthy DaemonSet pods until the health timeout has passed", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "kube-proxy-abc",
+					Namespace:       "kube-system",
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "kube-proxy"}},
+				},
+				Spec:   corev1.PodSpec{NodeName: canary.Name},
+				Status: corev1.PodStatus{Phase: corev1.PodPending},
+			})).To(Succeed())
+
+			reconcileNode(canary)
+			Expect(reconcileNode(canary)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+			Expect(canary.Annotations).NotTo(HaveKey("node-agent.gardener.cloud/canary-verified-checksum"))
+			Expect(canary.Annotations).NotTo(HaveKey("node-agent.gardener.cloud/canary-failed-checksum"))
+
+			fakeClock.Step(5 * time.Minute)
+			Expect(reconcileNode(canary)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(canary.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/canary-failed-checksum", oscChecksum))
+			Expect(rolloutCondition(canary)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(corev1.ConditionFalse),
+				"Reason":  Equal("CanaryHealthCheckFailed"),
+				"Message": ContainSubstring("kube-proxy-abc"),
+			})))
+		})
+
+		It("should fail the verification if the node does not become ready in time", func() {
+			canary.Status.Conditions[0].Status = corev1.ConditionFalse
+			Expect(fakeClient.Status().Update(ctx, canary)).To(Succeed())
+
+			reconcileNode(canary)
+			fakeClock.Step(10 * time.Minute)
+			reconcileNode(canary)
+
+			Expect(canary.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/canary-failed-checksum", oscChecksum))
+		})
+	})
+
+	Describe("other nodes", func() {
+		It("should wait until the canary nodes have been verified", func() {
+			Expect(reconcileNode(other)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+			Expect(other.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", "old-checksum"))
+			Expect(rolloutCondition(other)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(corev1.ConditionUnknown),
+				"Reason":  Equal("WaitingForCanaries"),
+				"Message": ContainSubstring("canary"),
+			})))
+		})
+
+		It("should apply the config once the canary nodes have been verified", func() {
+			metav1.SetMetaDataAnnotation(&canary.ObjectMeta, "node-agent.gardener.cloud/canary-verified-checksum", oscChecksum)
+			Expect(fakeClient.Update(ctx, canary)).To(Succeed())
+
+			Expect(reconcileNode(other)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(other.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", oscChecksum))
+			Expect(rolloutCondition(other)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionTrue),
+				"Reason": Equal("Applied"),
+			})))
+		})
+
+		It("should halt the rollout if the verification of a canary node has failed", func() {
+			metav1.SetMetaDataAnnotation(&canary.ObjectMeta, "node-agent.gardener.cloud/canary-failed-checksum", oscChecksum)
+			Expect(fakeClient.Update(ctx, canary)).To(Succeed())
+
+			Expect(reconcileNode(other)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(other.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", "old-checksum"))
+			Expect(rolloutCondition(other)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionFalse),
+				"Reason": Equal("RolloutHalted"),
+			})))
+		})
+
+		It("should immediately apply the config to new nodes", func() {
+			delete(other.Annotations, "checksum/cloud-config-data")
+			Expect(fakeClient.Update(ctx, other)).To(Succeed())
+
+			Expect(reconcileNode(other)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(other.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", oscChecksum))
+		})
+
+		It("should ignore canary nodes of other worker pools", func() {
+			otherPoolNode := newNode("other-pool", fakeClock.Now().Add(-3*time.Hour))
+			otherPoolNode.Labels["worker.gardener.cloud/pool"] = "other-pool"
+			Expect(fakeClient.Create(ctx, otherPoolNode)).To(Succeed())
+
+			Expect(reconcileNode(canary)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+			Expect(canary.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", oscChecksum))
+		})
+	})
+})
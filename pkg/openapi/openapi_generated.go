@@ -39,11 +39,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequest":         schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequest(ref),
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequestSpec":     schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequestStatus":   schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerEndpoint":                          schema_pkg_apis_core_v1beta1_APIServerEndpoint(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerEndpointStatus":                    schema_pkg_apis_core_v1beta1_APIServerEndpointStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging":                           schema_pkg_apis_core_v1beta1_APIServerLogging(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerPriorityAndFairness":               schema_pkg_apis_core_v1beta1_APIServerPriorityAndFairness(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests":                          schema_pkg_apis_core_v1beta1_APIServerRequests(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerStaticIP":                          schema_pkg_apis_core_v1beta1_APIServerStaticIP(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addon":                                      schema_pkg_apis_core_v1beta1_Addon(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons":                                     schema_pkg_apis_core_v1beta1_Addons(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin":                            schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionWarning":                           schema_pkg_apis_core_v1beta1_AdmissionWarning(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting":                                   schema_pkg_apis_core_v1beta1_Alerting(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig":                                schema_pkg_apis_core_v1beta1_AuditConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy":                                schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
@@ -54,6 +59,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketSpec":                           schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketStatus":                         schema_pkg_apis_core_v1beta1_BackupBucketStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntry":                                schema_pkg_apis_core_v1beta1_BackupEntry(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryEncryption":                      schema_pkg_apis_core_v1beta1_BackupEntryEncryption(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryEncryptionStatus":                schema_pkg_apis_core_v1beta1_BackupEntryEncryptionStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryList":                            schema_pkg_apis_core_v1beta1_BackupEntryList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntrySpec":                            schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryStatus":                          schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref),
@@ -66,6 +73,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition":                                  schema_pkg_apis_core_v1beta1_Condition(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime":                           schema_pkg_apis_core_v1beta1_ContainerRuntime(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane":                               schema_pkg_apis_core_v1beta1_ControlPlane(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneVPN":                            schema_pkg_apis_core_v1beta1_ControlPlaneVPN(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeployment":                       schema_pkg_apis_core_v1beta1_ControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeploymentList":                   schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallation":                     schema_pkg_apis_core_v1beta1_ControllerInstallation(ref),
@@ -80,7 +88,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceLifecycle":                schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS":                                    schema_pkg_apis_core_v1beta1_CoreDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling":                         schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewrite":                             schema_pkg_apis_core_v1beta1_CoreDNSRewrite(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting":                           schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSZone":                                schema_pkg_apis_core_v1beta1_CoreDNSZone(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS":                                        schema_pkg_apis_core_v1beta1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSIncludeExclude":                          schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSProvider":                                schema_pkg_apis_core_v1beta1_DNSProvider(ref),
@@ -101,6 +111,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                        schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                           schema_pkg_apis_core_v1beta1_HighAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":              schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InfrastructurePlan":                         schema_pkg_apis_core_v1beta1_InfrastructurePlan(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InfrastructurePlannedChange":                schema_pkg_apis_core_v1beta1_InfrastructurePlannedChange(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress":                                    schema_pkg_apis_core_v1beta1_Ingress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.IngressController":                          schema_pkg_apis_core_v1beta1_IngressController(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecret":                             schema_pkg_apis_core_v1beta1_InternalSecret(ref),
@@ -109,6 +121,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig":                schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig":                            schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig":                        schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerProfile":                       schema_pkg_apis_core_v1beta1_KubeSchedulerProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerScorePlugin":                   schema_pkg_apis_core_v1beta1_KubeSchedulerScorePlugin(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig":                              schema_pkg_apis_core_v1beta1_KubeletConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEviction":                      schema_pkg_apis_core_v1beta1_KubeletConfigEviction(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim":        schema_pkg_apis_core_v1beta1_KubeletConfigEvictionMinimumReclaim(ref),
@@ -121,14 +135,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError":                                  schema_pkg_apis_core_v1beta1_LastError(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance":                            schema_pkg_apis_core_v1beta1_LastMaintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation":                              schema_pkg_apis_core_v1beta1_LastOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Logging":                                    schema_pkg_apis_core_v1beta1_Logging(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingQueryAPI":                            schema_pkg_apis_core_v1beta1_LoggingQueryAPI(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine":                                    schema_pkg_apis_core_v1beta1_Machine(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineCapabilities":                        schema_pkg_apis_core_v1beta1_MachineCapabilities(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings":           schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage":                               schema_pkg_apis_core_v1beta1_MachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpdatePolicy":                   schema_pkg_apis_core_v1beta1_MachineImageUpdatePolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersion":                        schema_pkg_apis_core_v1beta1_MachineImageVersion(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVulnerability":                  schema_pkg_apis_core_v1beta1_MachineImageVulnerability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineRequirements":                        schema_pkg_apis_core_v1beta1_MachineRequirements(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType":                                schema_pkg_apis_core_v1beta1_MachineType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage":                         schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance":                                schema_pkg_apis_core_v1beta1_Maintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                      schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRecord":                          schema_pkg_apis_core_v1beta1_MaintenanceRecord(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                      schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                    schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                 schema_pkg_apis_core_v1beta1_Monitoring(ref),
@@ -139,6 +160,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig":                                 schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                      schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":          schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OperatingSystemConfigRollout":               schema_pkg_apis_core_v1beta1_OperatingSystemConfigRollout(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PrivateDNS":                                 schema_pkg_apis_core_v1beta1_PrivateDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                    schema_pkg_apis_core_v1beta1_Project(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                              schema_pkg_apis_core_v1beta1_ProjectMember(ref),
@@ -149,6 +172,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota":                                      schema_pkg_apis_core_v1beta1_Quota(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                                  schema_pkg_apis_core_v1beta1_QuotaList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec":                                  schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ReencryptedResource":                        schema_pkg_apis_core_v1beta1_ReencryptedResource(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                     schema_pkg_apis_core_v1beta1_Region(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData":                               schema_pkg_apis_core_v1beta1_ResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize":                     schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
@@ -164,6 +188,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks":                               schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider":                               schema_pkg_apis_core_v1beta1_SeedProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector":                               schema_pkg_apis_core_v1beta1_SeedSelector(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingControlPlanePriority":            schema_pkg_apis_core_v1beta1_SeedSettingControlPlanePriority(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingControlPlanePriorityTier":        schema_pkg_apis_core_v1beta1_SeedSettingControlPlanePriorityTier(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog":              schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber":        schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder":        schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref),
@@ -183,10 +209,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolumeProvider":                         schema_pkg_apis_core_v1beta1_SeedVolumeProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig":                       schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation":                  schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceLevelObjectiveStatus":                schema_pkg_apis_core_v1beta1_ServiceLevelObjectiveStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot":                                      schema_pkg_apis_core_v1beta1_Shoot(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress":                     schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials":                           schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation":                   schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootDeletionPreview":                       schema_pkg_apis_core_v1beta1_ShootDeletionPreview(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation":                    schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootList":                                  schema_pkg_apis_core_v1beta1_ShootList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage":                          schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
@@ -198,15 +226,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec":                             schema_pkg_apis_core_v1beta1_ShootStateSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus":                                schema_pkg_apis_core_v1beta1_ShootStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate":                              schema_pkg_apis_core_v1beta1_ShootTemplate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StuckMachinePolicy":                         schema_pkg_apis_core_v1beta1_StuckMachinePolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents":                           schema_pkg_apis_core_v1beta1_SystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration":                                 schema_pkg_apis_core_v1beta1_Toleration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.TrustedCABundle":                            schema_pkg_apis_core_v1beta1_TrustedCABundle(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeDeprecation":                            schema_pkg_apis_core_v1beta1_TypeDeprecation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VPNHighThroughput":                          schema_pkg_apis_core_v1beta1_VPNHighThroughput(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler":                      schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume":                                     schema_pkg_apis_core_v1beta1_Volume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                                 schema_pkg_apis_core_v1beta1_VolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumesDeletionPreview":                     schema_pkg_apis_core_v1beta1_VolumesDeletionPreview(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VulnerabilityScanResult":                    schema_pkg_apis_core_v1beta1_VulnerabilityScanResult(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                            schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                     schema_pkg_apis_core_v1beta1_Worker(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                           schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolDeletionPreview":                  schema_pkg_apis_core_v1beta1_WorkerPoolDeletionPreview(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolRolloutProgress":                  schema_pkg_apis_core_v1beta1_WorkerPoolRolloutProgress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerTopology":                             schema_pkg_apis_core_v1beta1_WorkerTopology(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerZoneRebalancing":                      schema_pkg_apis_core_v1beta1_WorkerZoneRebalancing(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                            schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                             schema_pkg_apis_operations_v1alpha1_Bastion(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy":                schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
@@ -214,7 +252,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec":                         schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus":                       schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet":                       schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletConfig":                 schema_pkg_apis_seedmanagement_v1alpha1_GardenletConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment":             schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletList":                   schema_pkg_apis_seedmanagement_v1alpha1_GardenletList(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSpec":                   schema_pkg_apis_seedmanagement_v1alpha1_GardenletSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletStatus":                 schema_pkg_apis_seedmanagement_v1alpha1_GardenletStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image":                           schema_pkg_apis_seedmanagement_v1alpha1_Image(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeed":                     schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeed(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedList":                 schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref),
@@ -226,6 +268,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedStatus":               schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate":             schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedTemplate(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica":                  schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Replacement":                     schema_pkg_apis_seedmanagement_v1alpha1_Replacement(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ReplacementStatus":               schema_pkg_apis_seedmanagement_v1alpha1_ReplacementStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RollingUpdateStrategy":           schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot":                           schema_pkg_apis_seedmanagement_v1alpha1_Shoot(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy":                  schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref),
@@ -774,6 +818,77 @@ func schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestStatus(ref c
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_APIServerEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerEndpoint contains settings for the endpoint of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowedSourceRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedSourceRanges is a list of CIDRs which are allowed to access the kube-apiserver. If it is set, all other clients are denied, except for the worker nodes of the Shoot whose egress CIDRs are always allowed. See https://github.com/gardener/gardener/blob/master/docs/usage/shoot_api_server_endpoint.md for more details.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"staticIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaticIP requests a dedicated load balancer with a static IP address for the kube-apiserver. It is only supported if the provider extension of the Seed supports it.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerStaticIP"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerStaticIP"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_APIServerEndpointStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerEndpointStatus contains the current state of the kube-apiserver endpoint of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"staticIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaticIP is the static IP address which was allocated for the kube-apiserver endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowedSourceRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedSourceRanges is the list of CIDRs which are currently allowed to access the kube-apiserver, i.e., the configured ranges and the egress CIDRs of the worker nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_APIServerLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -801,6 +916,34 @@ func schema_pkg_apis_core_v1beta1_APIServerLogging(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_APIServerPriorityAndFairness(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerPriorityAndFairness contains configuration for the API Priority and Fairness (APF) objects which are managed by Gardener in the shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"presets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Presets is a list of APF presets which are deployed to the shoot cluster. Each preset consists of a set of FlowSchemas and PriorityLevelConfigurations which are maintained by Gardener for the Kubernetes version of the shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_APIServerRequests(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -822,6 +965,41 @@ func schema_pkg_apis_core_v1beta1_APIServerRequests(ref common.ReferenceCallback
 							Format:      "int32",
 						},
 					},
+					"priorityAndFairness": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityAndFairness contains configuration for the API Priority and Fairness (APF) objects which are managed by Gardener in the shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerPriorityAndFairness"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerPriorityAndFairness"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_APIServerStaticIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerStaticIP contains settings for the static IP address of the kube-apiserver endpoint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address is an IP address which was reserved upfront in the infrastructure account of the Seed. If it is not set, a static IP address is allocated by the provider extension.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ptrRecord": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PTRRecord is the domain name of the reverse DNS (PTR) record which is configured for the static IP address.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -921,6 +1099,44 @@ func schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AdmissionWarning(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionWarning is a warning which was issued by an admission plugin of the gardener-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"plugin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugin is the name of the admission plugin which issued the warning.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief, machine-readable explanation of the warning, e.g. `KubernetesVersionExpiring`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message containing details about the warning and how to address it.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"plugin", "reason", "message"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Alerting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1315,6 +1531,58 @@ func schema_pkg_apis_core_v1beta1_BackupEntry(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_BackupEntryEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupEntryEncryption contains the configuration for encrypting the backups with a customer-supplied key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a secret in the namespace of the BackupEntry which contains the encryption key in its `key` data key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"secretName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_BackupEntryEncryptionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupEntryEncryptionStatus contains the status of the encryption of the backups with a customer-supplied key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyChecksum is the checksum of the encryption key which is currently used for new backups.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRotationTime is the time when the encryption key was rotated the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"keyChecksum"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_BackupEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1388,10 +1656,18 @@ func schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption contains the configuration for encrypting the backups with a customer-supplied key.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryEncryption"),
+						},
+					},
 				},
 				Required: []string{"bucketName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryEncryption"},
 	}
 }
 
@@ -1434,11 +1710,17 @@ func schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption contains the status of the encryption of the backups with a customer-supplied key.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryEncryptionStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryEncryptionStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1972,11 +2254,38 @@ func schema_pkg_apis_core_v1beta1_ControlPlane(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability"),
 						},
 					},
+					"vpn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VPN contains the settings for the VPN connection between the control plane and the data plane of the shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneVPN"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneVPN", "github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControlPlaneVPN(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneVPN contains the settings for the VPN connection between the control plane and the data plane of a shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"highThroughput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HighThroughput enables the high-throughput mode in which multiple parallel VPN tunnels are established between the control plane and the data plane, and the traffic is load-balanced across them.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VPNHighThroughput"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.VPNHighThroughput"},
 	}
 }
 
@@ -2550,11 +2859,39 @@ func schema_pkg_apis_core_v1beta1_CoreDNS(ref common.ReferenceCallback) common.O
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting"),
 						},
 					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones contains the settings of additional DNS zones served by the Core DNS components, e.g., stub domains whose requests are forwarded to dedicated upstream DNS servers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSZone"),
+									},
+								},
+							},
+						},
+					},
+					"rewrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rewrites contains rules for rewriting the names of DNS requests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewrite"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewrite", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSZone"},
 	}
 }
 
@@ -2580,40 +2917,115 @@ func schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref common.ReferenceCallbac
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_CoreDNSRewrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CoreDNSRewriting contains the setting related to rewriting requests, which are obviously incorrect due to the unnecessary application of the search path.",
+				Description: "CoreDNSRewrite contains a rule for rewriting the names of DNS requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"commonSuffixes": {
+					"match": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CommonSuffixes are expected to be the suffix of a fully qualified domain name. Each suffix should contain at least one or two dots ('.') to prevent accidental clashes.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Match is the type of the match of the name. Supported values are `exact`, `prefix`, `suffix`, and `substring`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DNS holds information about the provider, the hosted zone id and the domain.",
-				Type:        []string{"object"},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the name (or the part of the name, depending on the match type) which is rewritten.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "To is the replacement for the matched name (or part of the name).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"match", "from", "to"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSRewriting contains the setting related to rewriting requests, which are obviously incorrect due to the unnecessary application of the search path.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"commonSuffixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CommonSuffixes are expected to be the suffix of a fully qualified domain name. Each suffix should contain at least one or two dots ('.') to prevent accidental clashes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNSZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSZone contains the settings of an additional DNS zone served by the Core DNS components.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the zone, e.g., `corp.example.com`. It must neither be the cluster domain nor one of its sub-domains.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"upstreams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upstreams are the DNS servers to which requests for the zone are forwarded. Each entry must be an IP address, optionally followed by a port, e.g., `10.0.0.10` or `10.0.0.10:5353`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "upstreams"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNS holds information about the provider, the hosted zone id and the domain.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"domain": {
 						SchemaProps: spec.SchemaProps{
@@ -2642,11 +3054,17 @@ func schema_pkg_apis_core_v1beta1_DNS(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"private": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Private contains information about an additional private DNS zone (e.g., a private hosted zone or a corporate DNS) in which the kube-apiserver of the Shoot cluster shall be resolvable. This is useful for clusters which are only reachable from private networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.PrivateDNS"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSProvider"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.PrivateDNS"},
 	}
 }
 
@@ -2846,12 +3264,26 @@ func schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref common.Reference
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"reencryptedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReencryptedResources contains the progress of rewriting the encrypted resources so that they become encrypted with the new ETCD encryption key. It is reset when a new rotation is initiated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ReencryptedResource"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"phase"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ReencryptedResource", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -3413,6 +3845,96 @@ func schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_InfrastructurePlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InfrastructurePlan is a preview of the changes a reconciliation would perform on the provider infrastructure.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the plan was computed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"supported": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Supported indicates whether the provider extension supports computing a plan. If it is false, no changes are reported.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"changes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes is the list of changes which would be performed on the provider infrastructure.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.InfrastructurePlannedChange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"lastUpdateTime", "supported"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.InfrastructurePlannedChange", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_InfrastructurePlannedChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InfrastructurePlannedChange is a single change which would be performed on a resource of the provider infrastructure.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action which would be performed on the resource, i.e. `Create`, `Update`, `Replace`, or `Delete`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceType is the provider-specific type of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the provider-specific name or identifier of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a human-readable explanation why the change is necessary.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"action", "resourceType", "name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Ingress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3749,11 +4271,17 @@ func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallba
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig"),
 						},
 					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint contains settings for the endpoint of the kube-apiserver which is exposed by the Seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerEndpoint"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerEndpoint", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -3893,7 +4421,98 @@ func schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"profiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profiles are additional scheduling profiles which are configured for the kube-scheduler. Pods are scheduled with such a profile if their `.spec.schedulerName` is set to the name of the profile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerProfile"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerProfile"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeSchedulerProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeSchedulerProfile contains the configuration of an additional scheduling profile of the kube-scheduler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedulerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerName is the name of the profile. It must not be `default-scheduler` or `bin-packing-scheduler`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"binPacking": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BinPacking enables the bin-packing scoring strategy for this profile, i.e., Nodes with the most allocated resources are preferred (similar to the \"bin-packing\" scheduling profile).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"scorePlugins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScorePlugins configures the weights of score plugins of this profile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerScorePlugin"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"schedulerName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerScorePlugin"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeSchedulerScorePlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeSchedulerScorePlugin configures the weight of a score plugin of the kube-scheduler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the score plugin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the weight of the score plugin. It must be in the range from 1 to 100.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"name", "weight"},
 			},
 		},
 	}
@@ -4086,6 +4705,12 @@ func schema_pkg_apis_core_v1beta1_KubeletConfig(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration"),
 						},
 					},
+					"imageMinimumGCAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageMinimumGCAge is the minimum age for an unused image before it is garbage collected. Default: 2m",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
@@ -4531,6 +5156,13 @@ func schema_pkg_apis_core_v1beta1_LastMaintenance(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"outOfWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutOfWindow indicates whether the maintenance was performed outside of the maintenance time window, e.g., because machine image versions affected by critical vulnerabilities had to be updated.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"description", "triggeredTime", "state"},
 			},
@@ -4595,6 +5227,61 @@ func schema_pkg_apis_core_v1beta1_LastOperation(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_Logging(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Logging contains information about the logging configuration for the shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention is the duration for which the control plane logs of the shoot are retained in the seed. It must be a multiple of 24h. Defaults to 360h (15 days).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"storageQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageQuota is the size of the volume storing the control plane logs of the shoot in the seed. When the volume runs full, the oldest logs are deleted before the retention period has passed. Defaults to 30Gi. Once set, it cannot be decreased.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"queryAPI": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryAPI contains information about the authenticated query API for the control plane logs of the shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingQueryAPI"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingQueryAPI", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_LoggingQueryAPI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoggingQueryAPI contains information about the authenticated query API for the control plane logs of the shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls whether the query API is exposed. Requests are authenticated and authorized by the shoot's kube-apiserver, hence only users with access to the shoot cluster can query its logs.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4623,12 +5310,45 @@ func schema_pkg_apis_core_v1beta1_Machine(ref common.ReferenceCallback) common.O
 							Format:      "",
 						},
 					},
+					"requirements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requirements are the capabilities the machines of this worker pool require. They are validated against the capabilities of the machine type and machine image version in the referenced CloudProfile.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineRequirements"),
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineRequirements", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MachineCapabilities(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineCapabilities contains the capabilities of a machine type or machine image version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"confidentialComputing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfidentialComputing states whether confidential computing (e.g., memory encryption of the virtual machine) is supported.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nestedVirtualization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NestedVirtualization states whether nested virtualization is supported.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -4679,11 +5399,17 @@ func schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref common.Re
 							},
 						},
 					},
+					"stuckMachinePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StuckMachinePolicy contains the policy for machines of this worker pool which repeatedly fail to be provisioned.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.StuckMachinePolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.StuckMachinePolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -4738,6 +5464,26 @@ func schema_pkg_apis_core_v1beta1_MachineImage(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MachineImageUpdatePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineImageUpdatePolicy contains the policy for automatic updates of the machine image version of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pinPatchStream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PinPatchStream pins the worker pool to the patch stream (i.e., the major and minor version) of its current machine image version. Automatic updates are only performed to the latest patch version of the current minor version, regardless of the update strategy configured in the CloudProfile. Expired versions or versions affected by critical vulnerabilities are still forcefully updated to the next higher minor version if no suitable patch version is available.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MachineImageVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4802,12 +5548,96 @@ func schema_pkg_apis_core_v1beta1_MachineImageVersion(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"vulnerabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vulnerabilities is a list of known vulnerabilities (e.g., CVEs) affecting the machine image in this version. Versions affected by critical vulnerabilities are not considered for automatic updates. Shoots using such versions are forcefully updated if their project opted in for critical vulnerability updates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVulnerability"),
+									},
+								},
+							},
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the capabilities supported by the machine image in this version. Worker pools requiring a capability can only use machine image versions supporting it.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineCapabilities"),
+						},
+					},
 				},
 				Required: []string{"version"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineCapabilities", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVulnerability", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MachineImageVulnerability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineImageVulnerability contains information about a vulnerability affecting a machine image version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the identifier of the vulnerability, e.g., `CVE-2023-12345`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"severity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severity is the severity of the vulnerability. Possible values are `critical`, `high`, `medium`, and `low`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "severity"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MachineRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineRequirements contains the capabilities required by the machines of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"gpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GPU states whether the machine type must have GPUs.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"confidentialComputing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfidentialComputing states whether the machine type and the machine image version must support confidential computing.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nestedVirtualization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NestedVirtualization states whether the machine type must support nested virtualization.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -4867,12 +5697,62 @@ func schema_pkg_apis_core_v1beta1_MachineType(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"gpuResourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GPUResourceName is the name of the extended resource under which nodes of this machine type advertise their GPUs (e.g., `nvidia.com/gpu`). It is used by the cluster-autoscaler when scaling worker pools from zero. Defaults to `gpu` if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"extendedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtendedResources are additional extended resources which nodes of this machine type advertise. They are used by the cluster-autoscaler when scaling worker pools from zero.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"nodeLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeLabels are labels which nodes of this machine type carry (e.g., the GPU model). They are used by the cluster-autoscaler when scaling worker pools from zero.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deprecation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecation marks the machine type as deprecated. New worker pools using it are warned about, existing worker pools may be migrated to the successor during the maintenance time window.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeDeprecation"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the capabilities of this machine type. Worker pools requiring a capability can only use machine types offering it.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineCapabilities"),
+						},
+					},
 				},
 				Required: []string{"cpu", "gpu", "memory", "name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineCapabilities", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeDeprecation", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -4976,6 +5856,13 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"deprecatedTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeprecatedTypes indicates whether deprecated machine and volume types of worker pools may be automatically replaced by their successors suggested in the cloud profile (default: false).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"kubernetesVersion"},
 			},
@@ -4983,6 +5870,66 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MaintenanceRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceRecord describes a change which was performed on the Shoot by the maintenance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time when the change was performed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"field": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Field is the path of the changed field, e.g. `spec.kubernetes.version`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oldValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OldValue is the value of the field before the change.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"newValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewValue is the value of the field after the change.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason describes why the change was performed, e.g., the update policy which triggered it.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outOfWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutOfWindow indicates whether the change was performed outside of the maintenance time window.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"time", "field", "reason"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5107,7 +6054,7 @@ func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) commo
 					},
 					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network. This field is immutable.",
+							Description: "Pods is the CIDR of the pod network. This field is immutable unless the feature gate ExpandableShootSpecNetworkingCIDRs is enabled, which allows expanding it to a larger CIDR containing the previous one.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5121,7 +6068,7 @@ func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) commo
 					},
 					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network. This field is immutable.",
+							Description: "Services is the CIDR of the service network. This field is immutable unless the feature gate ExpandableShootSpecNetworkingCIDRs is enabled, which allows expanding it to a larger CIDR containing the previous one.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5417,21 +6364,95 @@ func schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref common.R
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_OperatingSystemConfigRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Project holds certain properties about a Gardener project.",
+				Description: "OperatingSystemConfigRollout contains settings for the staged rollout of operating system config changes to the nodes of a worker pool. Changes are first applied to the canary nodes. They are only propagated to the remaining nodes once all canary nodes have been verified to be healthy. If a canary node does not become healthy within the health timeout, the rollout is halted.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"canaryNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CanaryNodes is the number of nodes of the worker pool to which changes are applied first.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"apiVersion": {
+					"healthTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthTimeout is the duration within which the canary nodes must become healthy after the changes have been applied. Defaults to `10m`.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"canaryNodes"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_PrivateDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrivateDNS contains information about a private DNS zone for the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"domain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domain is the private domain of the Shoot cluster. The kube-apiserver is served under `api.<domain>`, and this name is added to its serving certificate. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the DNS provider type managing the private zone. If set to `unmanaged`, no DNS record is created by Gardener and the record has to be maintained by the user.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a secret in the project namespace containing credentials for the DNS provider. It is required unless the type is `unmanaged`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the identifier of the private hosted zone. If not specified, the DNS provider determines the zone based on the domain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"domain", "type"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Project holds certain properties about a Gardener project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
 							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
@@ -5692,11 +6713,31 @@ func schema_pkg_apis_core_v1beta1_ProjectStatus(ref common.ReferenceCallback) co
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of the project's lifecycle.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5956,6 +6997,44 @@ func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ReencryptedResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReencryptedResource contains the progress of rewriting the objects of an encrypted resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is the kind and group of the resource, e.g. 'Secret' or 'Deployment.apps'.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the number of objects of the resource which must be encrypted with the new key.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"rewritten": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rewritten is the number of objects of the resource which are already encrypted with the new key.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"resource", "total", "rewritten"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6643,6 +7722,85 @@ func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedSettingControlPlanePriority(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingControlPlanePriority controls the priority classes of shoot control plane components in the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tiers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tiers is a list of priority tiers which are assigned to shoots based on their purpose. Shoots whose purpose is not listed in any tier use the default priority classes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingControlPlanePriorityTier"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingControlPlanePriorityTier"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedSettingControlPlanePriorityTier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingControlPlanePriorityTier describes the priority classes of shoot control plane components for a set of shoot purposes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"purposes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Purposes is the list of shoot purposes this tier applies to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"etcd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCD is the name of the priority class used for the etcd pods of the shoots.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubeAPIServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeAPIServer is the name of the priority class used for the kube-apiserver pods of the shoots.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubeControllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeControllerManager is the name of the priority class used for the kube-controller-manager pods of the shoots.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"purposes"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7019,11 +8177,17 @@ func schema_pkg_apis_core_v1beta1_SeedSettings(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting"),
 						},
 					},
+					"controlPlanePriority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlanePriority controls the priority classes of shoot control plane components in the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingControlPlanePriority"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingControlPlanePriority", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
 	}
 }
 
@@ -7377,6 +8541,12 @@ func schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref common.ReferenceCallb
 							},
 						},
 					},
+					"signingKeyRotationPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SigningKeyRotationPeriod is the period after which the service account signing key is rotated automatically during the maintenance time window. The previous signing key is still accepted for verifying tokens until all tokens issued with it are expired, i.e., the rotation is completed automatically after MaxTokenExpiration passed. Requires MaxTokenExpiration to be set and ExtendTokenExpiration to be disabled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
@@ -7433,6 +8603,66 @@ func schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ServiceLevelObjectiveStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceLevelObjectiveStatus contains the current state of a service level objective of the Shoot's control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the service level objective, e.g. `APIServerAvailability`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"objective": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Objective is the targeted percentage of good requests within the window, e.g. `99.9`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the time window in which the service level objective is evaluated.",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"current": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current is the percentage of good requests within the window, e.g. `99.95`. It is not set if it could not be determined.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorBudgetRemaining": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorBudgetRemaining is the percentage of the error budget which remains within the window, e.g. `50`. It is negative if the error budget is exhausted. It is not set if it could not be determined.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the last time the status was updated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "objective", "window", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7585,34 +8815,98 @@ func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceC
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ShootDeletionPreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootKubeconfigRotation contains information about the kubeconfig credential rotation.",
+				Description: "ShootDeletionPreview contains information about the resources which would be destroyed or retained when the Shoot is deleted.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"lastInitiationTime": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.",
+							Description: "LastUpdateTime is the time when the preview was computed.",
+							Default:     map[string]interface{}{},
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"lastCompletionTime": {
+					"workerPools": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastCompletionTime is the most recent time when the kubeconfig credential rotation was successfully completed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "WorkerPools contains the number of nodes per worker pool which would be deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolDeletionPreview"),
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+					"volumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes contains the number of persistent volumes which would be deleted or retained. It is not set if the volumes could not be determined, e.g., because the Shoot is hibernated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumesDeletionPreview"),
+						},
+					},
+					"dnsRecords": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSRecords is the list of DNS names whose records would be deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"backupRetention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupRetention is the period for which the etcd backups are retained after the Shoot was deleted. It is not set if the Shoot has no backups.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumesDeletionPreview", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolDeletionPreview", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootKubeconfigRotation contains information about the kubeconfig credential rotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the kubeconfig credential rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -7820,6 +9114,12 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance"),
 						},
 					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logging contains information about custom logging configurations for the shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Logging"),
+						},
+					},
 					"monitoring": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Monitoring contains information about custom monitoring configurations for the shoot.",
@@ -7928,12 +9228,32 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"trustedCABundles": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TrustedCABundles contains additional CA bundles (e.g., corporate CAs) which are added to the trust stores of all nodes and published in the `gardener-trusted-ca-bundles` ConfigMap in all namespaces of the Shoot cluster. In order to rotate a CA, add a bundle with the new CA first and remove the bundle with the old CA once all clients trust the new CA.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.TrustedCABundle"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"cloudProfileName", "kubernetes", "provider", "region"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Logging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration", "github.com/gardener/gardener/pkg/apis/core/v1beta1.TrustedCABundle"},
 	}
 }
 
@@ -8278,12 +9598,100 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"serviceLevelObjectives": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceLevelObjectives contains the current state of the service level objectives of the Shoot's control plane.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceLevelObjectiveStatus"),
+									},
+								},
+							},
+						},
+					},
+					"admissionWarnings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdmissionWarnings contains the warnings which were issued by the admission plugins of the gardener-apiserver for the last create or update request of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionWarning"),
+									},
+								},
+							},
+						},
+					},
+					"apiServerEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIServerEndpoint contains the current state of the kube-apiserver endpoint of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerEndpointStatus"),
+						},
+					},
+					"vulnerabilityScanResults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VulnerabilityScanResults contains the results of the latest vulnerability scans of the machine images used by the worker pools of the Shoot. They are reported by extensions scanning the nodes for vulnerabilities.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VulnerabilityScanResult"),
+									},
+								},
+							},
+						},
+					},
+					"maintenanceHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceHistory contains the most recent changes which were performed on the Shoot by the maintenance, e.g., automatic version updates or migrations of deprecated fields. The oldest records are removed once the maximum number of records is reached.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRecord"),
+									},
+								},
+							},
+						},
+					},
+					"infrastructurePlan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InfrastructurePlan contains the changes a reconciliation of the provider infrastructure would perform. It is computed when the Shoot is annotated with `gardener.cloud/operation=plan`.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.InfrastructurePlan"),
+						},
+					},
+					"deletionPreview": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPreview contains information about the resources which would be destroyed or retained when the Shoot is deleted. It is computed when the Shoot is annotated with `gardener.cloud/operation=preview-deletion`.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootDeletionPreview"),
+						},
+					},
+					"workerRolloutProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerRolloutProgress contains the progress of the worker pools whose machines are currently rolled out. It is updated continuously during the rollout and removed once it has finished.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolRolloutProgress"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerEndpointStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionWarning", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.InfrastructurePlan", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRecord", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceLevelObjectiveStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootDeletionPreview", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VulnerabilityScanResult", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolRolloutProgress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8316,6 +9724,33 @@ func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_StuckMachinePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StuckMachinePolicy contains the policy for machines of a worker pool which repeatedly fail to be provisioned.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action which is taken once the number of failed machines of a machine deployment of the worker pool reaches the `failureThreshold`. Possible values are `KeepRetrying` (failed machines are replaced over and over again) and `PausePool` (the machine deployment is paused and the `MachineDeploymentsPaused` condition is reported on the Worker until the configuration of the worker pool is changed). Defaults to `KeepRetrying`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureThreshold is the number of failed machines of a machine deployment after which the action is taken. Defaults to `3`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8335,6 +9770,21 @@ func schema_pkg_apis_core_v1beta1_SystemComponents(ref common.ReferenceCallback)
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS"),
 						},
 					},
+					"userProvided": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserProvided is a list of system components which are provided by the user instead of Gardener. Gardener does neither deploy nor health-check these components, and removes them in case they were deployed before.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -8372,6 +9822,83 @@ func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_TrustedCABundle(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TrustedCABundle is a named bundle of additional trusted CA certificates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the bundle. The bundle is published with key `<name>.pem` in the ConfigMap.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle contains the PEM-encoded CA certificates.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "caBundle"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_TypeDeprecation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TypeDeprecation marks a machine or volume type as deprecated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"successor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Successor is the name of the type which shall be used instead of the deprecated type. It must refer to a non-deprecated type of the same kind in the cloud profile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VPNHighThroughput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VPNHighThroughput contains the settings for the high-throughput mode of the VPN.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minTunnels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinTunnels is the minimum number of parallel VPN tunnels. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxTunnels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxTunnels is the maximum number of parallel VPN tunnels. The number of tunnels is scaled automatically between MinTunnels and MaxTunnels based on the observed VPN throughput. Defaults to MinTunnels.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8433,6 +9960,32 @@ func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCall
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"targetCPUPercentile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetCPUPercentile is the usage percentile that will be used as a base for the CPU target recommendation (default: 0.9).",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"targetMemoryPercentile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetMemoryPercentile is the usage percentile that will be used as a base for the memory target recommendation (default: 0.9).",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"cpuHistogramDecayHalfLife": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUHistogramDecayHalfLife is the amount of time it takes a historical CPU usage sample to lose half of its weight (default: 24h0m0s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"memoryHistogramDecayHalfLife": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryHistogramDecayHalfLife is the amount of time it takes a historical memory usage sample to lose half of its weight (default: 24h0m0s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"enabled"},
 			},
@@ -8521,12 +10074,109 @@ func schema_pkg_apis_core_v1beta1_VolumeType(ref common.ReferenceCallback) commo
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"deprecation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecation marks the volume type as deprecated. New worker pools using it are warned about, existing worker pools may be migrated to the successor during the maintenance time window.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeDeprecation"),
+						},
+					},
 				},
 				Required: []string{"class", "name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeDeprecation", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VolumesDeletionPreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumesDeletionPreview contains the number of persistent volumes which would be deleted or retained.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deleted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deleted is the number of persistent volumes which would be deleted together with their backing disks.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retained": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retained is the number of persistent volumes whose backing disks would be retained in the infrastructure account due to their `Retain` reclaim policy.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"deleted", "retained"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VulnerabilityScanResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VulnerabilityScanResult contains the result of a vulnerability scan of the machine image used by a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workerPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPool is the name of the scanned worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineImageName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImageName is the name of the machine image used by the worker pool at the time of the scan.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineImageVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImageVersion is the version of the machine image used by the worker pool at the time of the scan.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vulnerabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vulnerabilities is the list of vulnerabilities found by the scan.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVulnerability"),
+									},
+								},
+							},
+						},
+					},
+					"scanTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScanTime is the time at which the scan was performed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"workerPool", "machineImageName", "machineImageVersion", "scanTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVulnerability", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8757,12 +10407,42 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							},
 						},
 					},
+					"machineImageUpdatePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImageUpdatePolicy contains the policy for automatic updates of the machine image version of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpdatePolicy"),
+						},
+					},
+					"topology": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topology contains settings for the topology labels which are added to the nodes of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerTopology"),
+						},
+					},
+					"maxNodeProvisionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxNodeProvisionTime is the maximum duration the provisioning of a machine of this worker pool may take before the machine is declared failed and replaced by the machine-controller-manager. It must not be set together with `machineControllerManager.machineCreationTimeout`.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"operatingSystemConfigRollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperatingSystemConfigRollout contains settings for the staged rollout of operating system config changes to the nodes of this worker pool. This is only considered if the gardener-node-agent is used.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.OperatingSystemConfigRollout"),
+						},
+					},
+					"zoneRebalancing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneRebalancing contains settings for the automatic rebalancing of the machines of this worker pool to its other zones in case a zone repeatedly has insufficient capacity. If not set, machines are not rebalanced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerZoneRebalancing"),
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpdatePolicy", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OperatingSystemConfigRollout", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerTopology", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerZoneRebalancing", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -8794,25 +10474,171 @@ func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_WorkerPoolDeletionPreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolDeletionPreview contains the number of nodes of a worker pool which would be deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of nodes of the worker pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "nodes"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerPoolRolloutProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolRolloutProgress contains the progress of a worker pool whose machines are rolled out.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Desired is the number of desired machines.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Updated is the number of machines which already run the current configuration of the worker pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is the number of machines which are ready.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"old": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Old is the number of machines which still run an outdated configuration of the worker pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "desired", "updated", "ready", "old"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerSystemComponents contains configuration for system components related to this worker pool",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow determines whether the pool should be allowed to host system components or not (defaults to true)",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"only": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Only determines whether the pool is dedicated to system components. If true, the nodes of this pool are tainted with `worker.gardener.cloud/system-components-only=true:NoSchedule` so that only Gardener-managed system components (which tolerate this taint) are scheduled onto them. Requires `allow` to be true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"allow"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerTopology(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerTopology contains settings for the topology labels which are added to the nodes of a worker pool. The labels are also added to the node template used by the cluster-autoscaler when scaling the worker pool from zero, so that pods and nodes can be spread predictably across zones regardless of the infrastructure provider. If the worker pool uses exactly one zone, the nodes are additionally labeled with `topology.kubernetes.io/zone`.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zoneGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneGroup is the name of a group of zones the nodes of this worker pool belong to. It is added to the nodes with the `topology.gardener.cloud/zone-group` label.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dedicatedToProject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DedicatedToProject controls whether the nodes of this worker pool are labeled with `topology.gardener.cloud/dedicated-to-project=<project-name>`.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerZoneRebalancing(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkerSystemComponents contains configuration for system components related to this worker pool",
+				Description: "WorkerZoneRebalancing contains settings for the automatic rebalancing of the machines of a worker pool to its other zones in case a zone repeatedly has insufficient capacity. Once the number of machines of a zone which failed due to insufficient capacity reaches the failure threshold, the desired machines of the zone are shifted to the other zones of the worker pool. The shift is reverted after the recovery period in order to check whether the zone has capacity again.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"allow": {
+					"failureThreshold": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allow determines whether the pool should be allowed to host system components or not (defaults to true)",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "FailureThreshold is the number of machines of a zone which failed due to insufficient capacity after which the desired machines of the zone are shifted to the other zones of the worker pool. Defaults to `3`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"recoveryPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecoveryPeriod is the duration after which the shift is reverted. Defaults to `30m`.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"allow"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -9085,7 +10911,57 @@ func schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref common.ReferenceCallb
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Gardenlet specifies gardenlet deployment parameters and the GardenletConfiguration used to configure gardenlet.",
+				Description: "Gardenlet represents the desired deployment of a gardenlet which is responsible for a Seed that is not managed by a ManagedSeed. The gardenlet running in the seed cluster watches this resource and upgrades itself accordingly.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the Gardenlet.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Gardenlet.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSpec", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_GardenletConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GardenletConfig specifies gardenlet deployment parameters and the GardenletConfiguration used to configure gardenlet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"deployment": {
@@ -9252,6 +11128,128 @@ func schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref common.Refe
 	}
 }
 
+func schema_pkg_apis_seedmanagement_v1alpha1_GardenletList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GardenletList is a list of Gardenlet objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Gardenlets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_GardenletSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GardenletSpec is the specification of a Gardenlet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployment specifies certain gardenlet deployment parameters, such as the number of replicas, the image, etc.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment"),
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is the GardenletConfiguration used to configure gardenlet. It is merged with the configuration of the currently running gardenlet.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_GardenletStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GardenletStatus is the status of a Gardenlet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a Gardenlet's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Gardenlet. It corresponds to the Gardenlet's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"},
+	}
+}
+
 func schema_pkg_apis_seedmanagement_v1alpha1_Image(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9668,14 +11666,20 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSpec(ref common.Referenc
 					"gardenlet": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Gardenlet specifies that the ManagedSeed controller should deploy a gardenlet into the cluster with the given deployment parameters and GardenletConfiguration.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletConfig"),
+						},
+					},
+					"replacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replacement specifies that the seed registered by this ManagedSeed shall be replaced by another seed. All shoots are migrated to the replacement seed before this ManagedSeed is deleted.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Replacement"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletConfig", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Replacement", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot"},
 	}
 }
 
@@ -9713,11 +11717,17 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedStatus(ref common.Refere
 							Format:      "int64",
 						},
 					},
+					"replacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replacement contains information about the progress of the replacement of the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ReplacementStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ReplacementStatus"},
 	}
 }
 
@@ -9796,6 +11806,101 @@ func schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_seedmanagement_v1alpha1_Replacement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Replacement specifies the replacement of the seed registered by a ManagedSeed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"seedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedName is the name of the seed replacing the seed registered by this ManagedSeed. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxConcurrentMigrations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentMigrations is the maximum number of shoots which are migrated to the replacement seed at the same time. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"seedName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_ReplacementStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplacementStatus contains information about the progress of the replacement of the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the replacement.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains details about the current phase.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootsTotal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootsTotal is the number of shoots which were scheduled to the seed when the migration started.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"shootsMigrated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootsMigrated is the number of shoots which have been migrated to the replacement seed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"shootsMigrating": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootsMigrating is the list of names of shoots (in the format `<namespace>/<name>`) which are currently migrated to the replacement seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the phase transitioned.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
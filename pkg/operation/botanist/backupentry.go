@@ -42,12 +42,23 @@ func (b *Botanist) DefaultCoreBackupEntry() corebackupentry.Interface {
 			OwnerReference: ownerRef,
 			SeedName:       b.Shoot.GetInfo().Spec.SeedName,
 			BucketName:     string(b.Seed.GetInfo().UID),
+
+			EncryptionSecretName: b.backupEncryptionSecretName(),
 		},
 		corebackupentry.DefaultInterval,
 		corebackupentry.DefaultTimeout,
 	)
 }
 
+// backupEncryptionSecretName returns the name of the secret containing the customer-supplied backup encryption key as
+// referenced by the shoot annotation, or nil if the annotation is not set.
+func (b *Botanist) backupEncryptionSecretName() *string {
+	if secretName, ok := b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootBackupEncryptionKeySecretName]; ok && secretName != "" {
+		return &secretName
+	}
+	return nil
+}
+
 // DeployBackupEntry deploys the BackupEntry resource in the Garden cluster and triggers the restore operation in case
 // the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployBackupEntry(ctx context.Context) error {
@@ -72,6 +83,8 @@ func (b *Botanist) SourceBackupEntry() corebackupentry.Interface {
 			ShootPurpose:   b.Shoot.GetInfo().Spec.Purpose,
 			OwnerReference: ownerRef,
 			SeedName:       b.Shoot.GetInfo().Spec.SeedName,
+
+			EncryptionSecretName: b.backupEncryptionSecretName(),
 		},
 		corebackupentry.DefaultInterval,
 		corebackupentry.DefaultTimeout,
@@ -79,8 +92,19 @@ func (b *Botanist) SourceBackupEntry() corebackupentry.Interface {
 }
 
 // DeploySourceBackupEntry deploys the source BackupEntry and sets its bucketName to be equal to the bucketName of the shoot's original
-// BackupEntry if the source BackupEntry doesn't already exist.
+// BackupEntry if the source BackupEntry doesn't already exist. When the shoot is being cloned, the bucketName is set to
+// the bucketName of the BackupEntry of the shoot to clone from.
 func (b *Botanist) DeploySourceBackupEntry(ctx context.Context) error {
+	if b.IsCloningPhase() {
+		cloneSourceBackupEntry, err := b.GetCloneSourceBackupEntry(ctx)
+		if err != nil {
+			return err
+		}
+
+		b.Shoot.Components.SourceBackupEntry.SetBucketName(cloneSourceBackupEntry.Spec.BucketName)
+		return b.Shoot.Components.SourceBackupEntry.Deploy(ctx)
+	}
+
 	bucketName := b.Shoot.Components.BackupEntry.GetActualBucketName()
 	if _, err := b.Shoot.Components.SourceBackupEntry.Get(ctx); err == nil {
 		bucketName = b.Shoot.Components.SourceBackupEntry.GetActualBucketName()
@@ -93,9 +117,9 @@ func (b *Botanist) DeploySourceBackupEntry(ctx context.Context) error {
 }
 
 // DestroySourceBackupEntry destroys the source BackupEntry. It returns nil if the
-// Seed backup is not enabled or the Shoot is not in restore phase.
+// Seed backup is not enabled or the Shoot is neither in restore nor in cloning phase.
 func (b *Botanist) DestroySourceBackupEntry(ctx context.Context) error {
-	if b.Seed.GetInfo().Spec.Backup == nil || !b.IsRestorePhase() {
+	if b.Seed.GetInfo().Spec.Backup == nil || (!b.IsRestorePhase() && !b.IsCloningPhase()) {
 		return nil
 	}
 
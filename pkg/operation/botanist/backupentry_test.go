@@ -114,6 +114,26 @@ var _ = Describe("BackupEntry", func() {
 			Expect(botanist.DestroySourceBackupEntry(ctx)).To(Succeed())
 		})
 
+		It("should set force-deletion annotation and destroy the SourceBackupEntry component when Shoot is in cloning phase", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bar",
+					Namespace:   "foo",
+					Annotations: map[string]string{"shoot.gardener.cloud/clone-from": "source"},
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type: gardencorev1beta1.LastOperationTypeCreate,
+					},
+				},
+			})
+
+			sourceBackupEntry.EXPECT().SetForceDeletionAnnotation(ctx)
+			sourceBackupEntry.EXPECT().Destroy(ctx)
+
+			Expect(botanist.DestroySourceBackupEntry(ctx)).To(Succeed())
+		})
+
 		It("should set force-deletion annotation and destroy the SourceBackupEntry component", func() {
 			sourceBackupEntry.EXPECT().SetForceDeletionAnnotation(ctx)
 			sourceBackupEntry.EXPECT().Destroy(ctx)
@@ -16,13 +16,30 @@ package botanist
 
 import (
 	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/extensions"
 )
 
-// DeleteBastions deletes all bastions from the Shoot namespace in the Seed.
+// DeleteBastions deletes all bastions referencing the Shoot from the project namespace in the Garden cluster and all
+// bastions from the Shoot namespace in the Seed.
 func (b *Botanist) DeleteBastions(ctx context.Context) error {
+	bastionList := &operationsv1alpha1.BastionList{}
+	if err := b.GardenClient.List(ctx, bastionList, client.InNamespace(b.Shoot.GetInfo().Namespace), client.MatchingFields{operations.BastionShootName: b.Shoot.GetInfo().Name}); err != nil {
+		return fmt.Errorf("failed listing Bastions in the garden cluster: %w", err)
+	}
+
+	for _, bastion := range bastionList.Items {
+		if err := b.GardenClient.Delete(ctx, bastion.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting Bastion %s: %w", client.ObjectKeyFromObject(&bastion), err)
+		}
+	}
+
 	return extensions.DeleteExtensionObjects(
 		ctx,
 		b.SeedClientSet.Client(),
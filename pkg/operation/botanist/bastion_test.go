@@ -24,7 +24,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/operation"
@@ -35,21 +39,35 @@ import (
 var _ = Describe("Bastions", func() {
 	var (
 		fakeClient         client.Client
+		fakeGardenClient   client.Client
 		botanist           *Botanist
 		namespace          *corev1.Namespace
 		ctx                = context.TODO()
 		bastion1, bastion2 *extensionsv1alpha1.Bastion
+
+		projectNamespace               = "garden-foo"
+		shootName                      = "bar"
+		gardenBastion1, gardenBastion2 *operationsv1alpha1.Bastion
+		gardenBastionForOtherShoot     *operationsv1alpha1.Bastion
 	)
 
 	BeforeEach(func() {
 		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeGardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&operationsv1alpha1.Bastion{}, operations.BastionShootName, indexer.BastionShootNameIndexerFunc).
+			Build()
 		botanist = &Botanist{Operation: &operation.Operation{}}
 		k8sSeedClient := kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build()
 		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
 		botanist.SeedClientSet = k8sSeedClient
+		botanist.GardenClient = fakeGardenClient
 		botanist.Shoot = &shootpkg.Shoot{
 			SeedNamespace: namespace.Name,
 		}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: projectNamespace},
+		})
 
 		bastion1 = &extensionsv1alpha1.Bastion{
 			ObjectMeta: metav1.ObjectMeta{Name: "bastion1", Namespace: namespace.Name},
@@ -57,6 +75,19 @@ var _ = Describe("Bastions", func() {
 		bastion2 = &extensionsv1alpha1.Bastion{
 			ObjectMeta: metav1.ObjectMeta{Name: "bastion2", Namespace: namespace.Name},
 		}
+
+		gardenBastion1 = &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{Name: "bastion1", Namespace: projectNamespace},
+			Spec:       operationsv1alpha1.BastionSpec{ShootRef: corev1.LocalObjectReference{Name: shootName}},
+		}
+		gardenBastion2 = &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{Name: "bastion2", Namespace: projectNamespace},
+			Spec:       operationsv1alpha1.BastionSpec{ShootRef: corev1.LocalObjectReference{Name: shootName}},
+		}
+		gardenBastionForOtherShoot = &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{Name: "bastion3", Namespace: projectNamespace},
+			Spec:       operationsv1alpha1.BastionSpec{ShootRef: corev1.LocalObjectReference{Name: "other"}},
+		}
 	})
 
 	Describe("#DeleteBastions", func() {
@@ -71,5 +102,18 @@ var _ = Describe("Bastions", func() {
 			Expect(fakeClient.List(ctx, bastionList, client.InNamespace(namespace.Name))).To(Succeed())
 			Expect(bastionList.Items).To(BeEmpty())
 		})
+
+		It("should delete all bastions referencing the shoot in the garden cluster", func() {
+			Expect(fakeGardenClient.Create(ctx, gardenBastion1)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, gardenBastion2)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, gardenBastionForOtherShoot)).To(Succeed())
+
+			Expect(botanist.DeleteBastions(ctx)).To(Succeed())
+
+			bastionList := &operationsv1alpha1.BastionList{}
+			Expect(fakeGardenClient.List(ctx, bastionList, client.InNamespace(projectNamespace))).To(Succeed())
+			Expect(bastionList.Items).To(HaveLen(1))
+			Expect(bastionList.Items[0].Name).To(Equal(gardenBastionForOtherShoot.Name))
+		})
 	})
 })
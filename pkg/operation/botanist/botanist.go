@@ -74,15 +74,21 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		secretsmanager.Config{
 			CASecretAutoRotation: false,
 			SecretNamesToTimes:   b.lastSecretRotationStartTimes(),
+			EventRecorder:        b.SeedRecorder,
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if o.Shoot.VPNHighThroughput != nil {
+		o.Shoot.VPNHighAvailabilityNumberOfSeedServers = b.ComputeVPNTunnels(ctx)
+	}
+
 	// extension components
 	o.Shoot.Components.Extensions.ExternalDNSRecord = b.DefaultExternalDNSRecord()
 	o.Shoot.Components.Extensions.InternalDNSRecord = b.DefaultInternalDNSRecord()
+	o.Shoot.Components.Extensions.PrivateDNSRecord = b.DefaultPrivateDNSRecord()
 	o.Shoot.Components.Extensions.IngressDNSRecord = b.DefaultIngressDNSRecord()
 
 	o.Shoot.Components.Extensions.Extension, err = b.DefaultExtension(ctx)
@@ -115,6 +121,8 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 	o.Shoot.Components.ControlPlane.KubeAPIServerIngress = b.DefaultKubeAPIServerIngress()
 	o.Shoot.Components.ControlPlane.KubeAPIServerService = b.DefaultKubeAPIServerService()
 	o.Shoot.Components.ControlPlane.KubeAPIServerSNI = b.DefaultKubeAPIServerSNI()
+	o.Shoot.Components.ControlPlane.KubeAPIServerAllowlist = b.DefaultKubeAPIServerAllowlist()
+	o.Shoot.Components.ControlPlane.KubeAPIServerEndpoint = b.DefaultKubeAPIServerEndpoint()
 	o.Shoot.Components.ControlPlane.KubeAPIServer, err = b.DefaultKubeAPIServer(ctx)
 	if err != nil {
 		return nil, err
@@ -162,6 +170,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 	o.Shoot.Components.SystemComponents.Resources = b.DefaultShootSystem()
 	o.Shoot.Components.SystemComponents.Namespaces = b.DefaultShootNamespaces()
 	o.Shoot.Components.SystemComponents.ClusterIdentity = b.DefaultClusterIdentity()
+	o.Shoot.Components.SystemComponents.TrustBundle = b.DefaultTrustBundle()
 
 	if !o.Shoot.IsWorkerless {
 		o.Shoot.Components.SystemComponents.APIServerProxy, err = b.DefaultAPIServerProxy()
@@ -211,6 +220,11 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 	o.Shoot.Components.GardenerAccess = b.DefaultGardenerAccess()
 
 	// Monitoring
+	o.Shoot.Components.Monitoring.AuthProxy, err = b.DefaultMonitoringAuthProxy()
+	if err != nil {
+		return nil, err
+	}
+	o.Shoot.Components.Monitoring.GatewayRoutes = b.DefaultMonitoringGatewayRoutes()
 	o.Shoot.Components.Monitoring.Monitoring, err = b.DefaultMonitoring()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,53 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// IsCloningPhase returns true when the shoot is being created as a clone of another shoot, i.e., its etcd is supposed
+// to be restored from the latest backup of the shoot referenced in the 'shoot.gardener.cloud/clone-from' annotation.
+func (b *Botanist) IsCloningPhase() bool {
+	return b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootCloneFrom] != "" &&
+		v1beta1helper.ShootHasOperationType(b.Shoot.GetInfo().Status.LastOperation, gardencorev1beta1.LastOperationTypeCreate)
+}
+
+// GetCloneSourceBackupEntry returns the BackupEntry of the shoot which is referenced as source for cloning.
+func (b *Botanist) GetCloneSourceBackupEntry(ctx context.Context) (*gardencorev1beta1.BackupEntry, error) {
+	sourceShoot := &gardencorev1beta1.Shoot{}
+	if err := b.GardenClient.Get(ctx, kubernetesutils.Key(b.Shoot.GetInfo().Namespace, b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootCloneFrom]), sourceShoot); err != nil {
+		return nil, fmt.Errorf("failed reading shoot to clone from: %w", err)
+	}
+
+	backupEntryName, err := gardenerutils.GenerateBackupEntryName(sourceShoot.Status.TechnicalID, sourceShoot.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	backupEntry := &gardencorev1beta1.BackupEntry{}
+	if err := b.GardenClient.Get(ctx, kubernetesutils.Key(sourceShoot.Namespace, backupEntryName), backupEntry); err != nil {
+		return nil, fmt.Errorf("failed reading backup entry of shoot to clone from: %w", err)
+	}
+
+	return backupEntry, nil
+}
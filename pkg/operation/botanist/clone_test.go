@@ -0,0 +1,143 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	mockbackupentry "github.com/gardener/gardener/pkg/component/backupentry/mock"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+)
+
+var _ = Describe("Clone", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  = context.TODO()
+
+		gardenClient      client.Client
+		sourceBackupEntry *mockbackupentry.MockInterface
+		botanist          *Botanist
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		sourceBackupEntry = mockbackupentry.NewMockInterface(ctrl)
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				GardenClient: gardenClient,
+				Shoot: &shootpkg.Shoot{
+					Components: &shootpkg.Components{
+						SourceBackupEntry: sourceBackupEntry,
+					},
+				},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "clone",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{"shoot.gardener.cloud/clone-from": "bar"},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type: gardencorev1beta1.LastOperationTypeCreate,
+				},
+			},
+		}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#IsCloningPhase", func() {
+		It("should return true when the shoot is created as a clone", func() {
+			Expect(botanist.IsCloningPhase()).To(BeTrue())
+		})
+
+		It("should return false when the shoot does not reference a shoot to clone from", func() {
+			shoot.Annotations = nil
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.IsCloningPhase()).To(BeFalse())
+		})
+
+		It("should return false when the shoot is not being created", func() {
+			shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.IsCloningPhase()).To(BeFalse())
+		})
+	})
+
+	Context("with shoot to clone from", func() {
+		var cloneSourceBackupEntry *gardencorev1beta1.BackupEntry
+
+		BeforeEach(func() {
+			Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo", UID: "1234"},
+				Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--foo--bar"},
+			})).To(Succeed())
+
+			cloneSourceBackupEntry = &gardencorev1beta1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--1234", Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.BackupEntrySpec{BucketName: "source-bucket"},
+			}
+			Expect(gardenClient.Create(ctx, cloneSourceBackupEntry)).To(Succeed())
+		})
+
+		Describe("#GetCloneSourceBackupEntry", func() {
+			It("should return the BackupEntry of the shoot to clone from", func() {
+				backupEntry, err := botanist.GetCloneSourceBackupEntry(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(backupEntry.Name).To(Equal(cloneSourceBackupEntry.Name))
+				Expect(backupEntry.Spec.BucketName).To(Equal("source-bucket"))
+			})
+
+			It("should fail when the BackupEntry of the shoot to clone from does not exist", func() {
+				Expect(gardenClient.Delete(ctx, cloneSourceBackupEntry)).To(Succeed())
+
+				_, err := botanist.GetCloneSourceBackupEntry(ctx)
+				Expect(err).To(MatchError(ContainSubstring("failed reading backup entry of shoot to clone from")))
+			})
+		})
+
+		Describe("#DeploySourceBackupEntry", func() {
+			It("should deploy the source BackupEntry pointing to the bucket of the shoot to clone from", func() {
+				sourceBackupEntry.EXPECT().SetBucketName("source-bucket")
+				sourceBackupEntry.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeploySourceBackupEntry(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/clusterautoscaler"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // DefaultClusterAutoscaler returns a deployer for the cluster-autoscaler.
@@ -37,7 +38,7 @@ func (b *Botanist) DefaultClusterAutoscaler() (clusterautoscaler.Interface, erro
 	return clusterautoscaler.New(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
-		b.SecretsManager,
+		secretsmanager.WithConsumer(b.SecretsManager, "cluster-autoscaler"),
 		image.String(),
 		b.Shoot.GetReplicas(1),
 		b.Shoot.GetInfo().Spec.Kubernetes.ClusterAutoscaler,
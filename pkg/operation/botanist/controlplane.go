@@ -148,6 +148,10 @@ func (b *Botanist) HibernateControlPlane(ctx context.Context) error {
 		return err
 	}
 
+	if err := b.Shoot.Components.ControlPlane.KubeAPIServerAllowlist.Destroy(ctx); err != nil {
+		return err
+	}
+
 	if err := client.IgnoreNotFound(b.ScaleETCDToZero(ctx)); err != nil {
 		return err
 	}
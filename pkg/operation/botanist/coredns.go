@@ -53,6 +53,11 @@ func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
 		SearchPathRewriteCommonSuffixes: getCommonSuffixesForRewriting(b.Shoot.GetInfo().Spec.SystemComponents),
 	}
 
+	if systemComponents := b.Shoot.GetInfo().Spec.SystemComponents; systemComponents != nil && systemComponents.CoreDNS != nil {
+		values.Zones = systemComponents.CoreDNS.Zones
+		values.Rewrites = systemComponents.CoreDNS.Rewrites
+	}
+
 	if b.ShootUsesDNS() {
 		values.APIServerHost = pointer.String(b.outOfClusterAPIServerFQDN())
 	}
@@ -0,0 +1,123 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// PreviewDeletion computes which resources would be destroyed or retained when the Shoot is deleted and publishes the
+// result in the Shoot status.
+func (b *Botanist) PreviewDeletion(ctx context.Context) error {
+	preview := &gardencorev1beta1.ShootDeletionPreview{LastUpdateTime: metav1.Now()}
+
+	if !b.Shoot.IsWorkerless && !b.Shoot.HibernationEnabled {
+		workerPools, err := b.previewWorkerPoolDeletion(ctx)
+		if err != nil {
+			return err
+		}
+		preview.WorkerPools = workerPools
+
+		volumes, err := b.previewVolumesDeletion(ctx)
+		if err != nil {
+			return err
+		}
+		preview.Volumes = volumes
+	}
+
+	dnsRecordList := &extensionsv1alpha1.DNSRecordList{}
+	if err := b.SeedClientSet.Client().List(ctx, dnsRecordList, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+		return fmt.Errorf("failed listing DNSRecords: %w", err)
+	}
+	for _, dnsRecord := range dnsRecordList.Items {
+		if dnsRecord.DeletionTimestamp == nil {
+			preview.DNSRecords = append(preview.DNSRecords, dnsRecord.Spec.Name)
+		}
+	}
+	sort.Strings(preview.DNSRecords)
+
+	if b.Seed.GetInfo().Spec.Backup != nil {
+		retention := metav1.Duration{}
+		if cfg := b.Config; cfg != nil && cfg.Controllers != nil && cfg.Controllers.BackupEntry != nil && cfg.Controllers.BackupEntry.DeletionGracePeriodHours != nil {
+			retention.Duration = gardenerutils.ComputeBackupEntryDeletionGracePeriod(
+				*cfg.Controllers.BackupEntry.DeletionGracePeriodHours,
+				cfg.Controllers.BackupEntry.DeletionGracePeriodShootPurposes,
+				core.ShootPurpose(b.Shoot.Purpose),
+			)
+		}
+		preview.BackupRetention = &retention
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.DeletionPreview = preview
+		return nil
+	})
+}
+
+func (b *Botanist) previewWorkerPoolDeletion(ctx context.Context) ([]gardencorev1beta1.WorkerPoolDeletionPreview, error) {
+	nodeList := &metav1.PartialObjectMetadataList{}
+	nodeList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NodeList"))
+	if err := b.ShootClientSet.Client().List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	nodesPerPool := make(map[string]int32, len(b.Shoot.GetInfo().Spec.Provider.Workers))
+	for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+		nodesPerPool[worker.Name] = 0
+	}
+	for _, node := range nodeList.Items {
+		if pool, ok := node.Labels[v1beta1constants.LabelWorkerPool]; ok {
+			nodesPerPool[pool]++
+		}
+	}
+
+	workerPools := make([]gardencorev1beta1.WorkerPoolDeletionPreview, 0, len(nodesPerPool))
+	for pool, nodes := range nodesPerPool {
+		workerPools = append(workerPools, gardencorev1beta1.WorkerPoolDeletionPreview{Name: pool, Nodes: nodes})
+	}
+	sort.Slice(workerPools, func(i, j int) bool { return workerPools[i].Name < workerPools[j].Name })
+
+	return workerPools, nil
+}
+
+func (b *Botanist) previewVolumesDeletion(ctx context.Context) (*gardencorev1beta1.VolumesDeletionPreview, error) {
+	persistentVolumeList := &corev1.PersistentVolumeList{}
+	if err := b.ShootClientSet.Client().List(ctx, persistentVolumeList); err != nil {
+		return nil, fmt.Errorf("failed listing persistent volumes: %w", err)
+	}
+
+	volumes := &gardencorev1beta1.VolumesDeletionPreview{}
+	for _, pv := range persistentVolumeList.Items {
+		if pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+			volumes.Retained++
+		} else {
+			volumes.Deleted++
+		}
+	}
+
+	return volumes, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+)
+
+var _ = Describe("DeletionPreview", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		gardenClient client.Client
+		seedClient   client.Client
+		shootClient  client.Client
+
+		shoot    *gardencorev1beta1.Shoot
+		botanist *Botanist
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "pool-a"}, {Name: "pool-b"}},
+				},
+			},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			GardenClient:   gardenClient,
+			SeedClientSet:  kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+			ShootClientSet: kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(),
+			Config: &config.GardenletConfiguration{
+				Controllers: &config.GardenletControllerConfiguration{
+					BackupEntry: &config.BackupEntryControllerConfiguration{
+						DeletionGracePeriodHours:         pointer.Int(24),
+						DeletionGracePeriodShootPurposes: []core.ShootPurpose{core.ShootPurposeProduction},
+					},
+				},
+			},
+			Seed: &seedpkg.Seed{},
+			Shoot: &shootpkg.Shoot{
+				SeedNamespace: namespace,
+				Purpose:       gardencorev1beta1.ShootPurposeProduction,
+			},
+		}}
+		botanist.Seed.SetInfo(&gardencorev1beta1.Seed{
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup: &gardencorev1beta1.SeedBackup{Provider: "local"},
+			},
+		})
+		botanist.Shoot.SetInfo(shoot)
+
+		for _, node := range []*corev1.Node{
+			{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"worker.gardener.cloud/pool": "pool-a"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{"worker.gardener.cloud/pool": "pool-a"}}},
+		} {
+			Expect(shootClient.Create(ctx, node)).To(Succeed())
+		}
+
+		for _, pv := range []*corev1.PersistentVolume{
+			{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}, Spec: corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "pv-2"}, Spec: corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "pv-3"}, Spec: corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain}},
+		} {
+			Expect(shootClient.Create(ctx, pv)).To(Succeed())
+		}
+
+		for _, dnsRecord := range []*extensionsv1alpha1.DNSRecord{
+			{ObjectMeta: metav1.ObjectMeta{Name: "bar-internal", Namespace: namespace}, Spec: extensionsv1alpha1.DNSRecordSpec{Name: "api.bar.foo.internal.example.com"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "bar-external", Namespace: namespace}, Spec: extensionsv1alpha1.DNSRecordSpec{Name: "api.bar.foo.example.com"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"}, Spec: extensionsv1alpha1.DNSRecordSpec{Name: "api.other.example.com"}},
+		} {
+			Expect(seedClient.Create(ctx, dnsRecord)).To(Succeed())
+		}
+	})
+
+	Describe("#PreviewDeletion", func() {
+		It("should publish the deletion preview in the shoot status", func() {
+			Expect(botanist.PreviewDeletion(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			preview := shoot.Status.DeletionPreview
+			Expect(preview).NotTo(BeNil())
+			Expect(preview.WorkerPools).To(Equal([]gardencorev1beta1.WorkerPoolDeletionPreview{
+				{Name: "pool-a", Nodes: 2},
+				{Name: "pool-b", Nodes: 0},
+			}))
+			Expect(preview.Volumes).To(Equal(&gardencorev1beta1.VolumesDeletionPreview{Deleted: 2, Retained: 1}))
+			Expect(preview.DNSRecords).To(Equal([]string{"api.bar.foo.example.com", "api.bar.foo.internal.example.com"}))
+			Expect(preview.BackupRetention).To(Equal(&metav1.Duration{Duration: 24 * time.Hour}))
+		})
+
+		It("should not report a backup retention if the grace period does not apply to the shoot purpose", func() {
+			botanist.Shoot.Purpose = gardencorev1beta1.ShootPurposeEvaluation
+
+			Expect(botanist.PreviewDeletion(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.DeletionPreview.BackupRetention).To(Equal(&metav1.Duration{}))
+		})
+
+		It("should not report backups if the seed has no backup configured", func() {
+			botanist.Seed.SetInfo(&gardencorev1beta1.Seed{})
+
+			Expect(botanist.PreviewDeletion(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.DeletionPreview.BackupRetention).To(BeNil())
+		})
+
+		It("should not report nodes and volumes if the shoot is hibernated", func() {
+			botanist.Shoot.HibernationEnabled = true
+
+			Expect(botanist.PreviewDeletion(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.DeletionPreview.WorkerPools).To(BeEmpty())
+			Expect(shoot.Status.DeletionPreview.Volumes).To(BeNil())
+			Expect(shoot.Status.DeletionPreview.DNSRecords).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// ConfigMapNameDiagnosticBundle is the name of the ConfigMap in the shoot namespace in the seed which contains the
+// diagnostic bundle, i.e., the effective configuration of the control plane components.
+const ConfigMapNameDiagnosticBundle = "diagnostic-bundle"
+
+// CollectDiagnosticBundle collects the effective configuration of the control plane components and stores it in the
+// diagnostic bundle ConfigMap.
+func (b *Botanist) CollectDiagnosticBundle(ctx context.Context) error {
+	exporters := []component.ConfigExporter{
+		b.Shoot.Components.ControlPlane.KubeAPIServer,
+		b.Shoot.Components.ControlPlane.KubeControllerManager,
+	}
+	if !b.Shoot.IsWorkerless {
+		exporters = append(exporters, b.Shoot.Components.ControlPlane.KubeScheduler)
+	}
+
+	data := make(map[string]string, len(exporters))
+	for _, exporter := range exporters {
+		snapshot, err := exporter.Snapshot(ctx)
+		if err != nil {
+			return fmt.Errorf("failed taking snapshot of control plane component configuration: %w", err)
+		}
+
+		exported, err := snapshot.Export()
+		if err != nil {
+			return fmt.Errorf("failed exporting configuration of %s: %w", snapshot.Component, err)
+		}
+		data[snapshot.Component+".yaml"] = string(exported)
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapNameDiagnosticBundle, Namespace: b.Shoot.SeedNamespace}}
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), configMap, func() error {
+		configMap.Data = data
+		return nil
+	})
+	return err
+}
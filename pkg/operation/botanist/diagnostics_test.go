@@ -0,0 +1,121 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component"
+	mockkubeapiserver "github.com/gardener/gardener/pkg/component/kubeapiserver/mock"
+	mockkubecontrollermanager "github.com/gardener/gardener/pkg/component/kubecontrollermanager/mock"
+	mockkubescheduler "github.com/gardener/gardener/pkg/component/kubescheduler/mock"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+)
+
+var _ = Describe("Diagnostics", func() {
+	var (
+		ctrl                  *gomock.Controller
+		kubeAPIServer         *mockkubeapiserver.MockInterface
+		kubeControllerManager *mockkubecontrollermanager.MockInterface
+		kubeScheduler         *mockkubescheduler.MockInterface
+
+		ctx        = context.TODO()
+		fakeErr    = fmt.Errorf("fake err")
+		namespace  = "shoot--foo--bar"
+		seedClient client.Client
+
+		botanist *Botanist
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubeAPIServer = mockkubeapiserver.NewMockInterface(ctrl)
+		kubeControllerManager = mockkubecontrollermanager.NewMockInterface(ctrl)
+		kubeScheduler = mockkubescheduler.NewMockInterface(ctrl)
+
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Shoot: &shootpkg.Shoot{
+				SeedNamespace: namespace,
+				Components: &shootpkg.Components{
+					ControlPlane: &shootpkg.ControlPlane{
+						KubeAPIServer:         kubeAPIServer,
+						KubeControllerManager: kubeControllerManager,
+						KubeScheduler:         kubeScheduler,
+					},
+				},
+			},
+		}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#CollectDiagnosticBundle", func() {
+		It("should store the snapshots of the control plane components in the diagnostic bundle", func() {
+			kubeAPIServer.EXPECT().Snapshot(ctx).Return(&component.ConfigSnapshot{Component: "kube-apiserver", Flags: []string{"--foo=bar"}}, nil)
+			kubeControllerManager.EXPECT().Snapshot(ctx).Return(&component.ConfigSnapshot{Component: "kube-controller-manager"}, nil)
+			kubeScheduler.EXPECT().Snapshot(ctx).Return(&component.ConfigSnapshot{Component: "kube-scheduler"}, nil)
+
+			Expect(botanist.CollectDiagnosticBundle(ctx)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "diagnostic-bundle"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{
+				"kube-apiserver.yaml": `component: kube-apiserver
+flags:
+- --foo=bar
+`,
+				"kube-controller-manager.yaml": "component: kube-controller-manager\n",
+				"kube-scheduler.yaml":          "component: kube-scheduler\n",
+			}))
+		})
+
+		It("should not collect the kube-scheduler configuration for workerless shoots", func() {
+			botanist.Shoot.IsWorkerless = true
+
+			kubeAPIServer.EXPECT().Snapshot(ctx).Return(&component.ConfigSnapshot{Component: "kube-apiserver"}, nil)
+			kubeControllerManager.EXPECT().Snapshot(ctx).Return(&component.ConfigSnapshot{Component: "kube-controller-manager"}, nil)
+
+			Expect(botanist.CollectDiagnosticBundle(ctx)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "diagnostic-bundle", Namespace: namespace}}
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveLen(2))
+		})
+
+		It("should fail if a snapshot cannot be taken", func() {
+			kubeAPIServer.EXPECT().Snapshot(ctx).Return(nil, fakeErr)
+
+			Expect(botanist.CollectDiagnosticBundle(ctx)).To(MatchError(ContainSubstring(fakeErr.Error())))
+		})
+	})
+})
@@ -38,6 +38,12 @@ func (b *Botanist) NeedsInternalDNS() bool {
 		b.Garden.InternalDomain.Provider != "unmanaged"
 }
 
+// NeedsPrivateDNS returns true if the Shoot cluster needs private DNS.
+func (b *Botanist) NeedsPrivateDNS() bool {
+	return b.Shoot.PrivateDomain != nil &&
+		b.Shoot.PrivateDomain.Provider != "unmanaged"
+}
+
 func (b *Botanist) newDNSComponentsTargetingAPIServerAddress() {
 	if b.NeedsInternalDNS() {
 		b.Shoot.Components.Extensions.InternalDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(b.APIServerAddress))
@@ -48,4 +54,9 @@ func (b *Botanist) newDNSComponentsTargetingAPIServerAddress() {
 		b.Shoot.Components.Extensions.ExternalDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(b.APIServerAddress))
 		b.Shoot.Components.Extensions.ExternalDNSRecord.SetValues([]string{b.APIServerAddress})
 	}
+
+	if b.NeedsPrivateDNS() {
+		b.Shoot.Components.Extensions.PrivateDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(b.APIServerAddress))
+		b.Shoot.Components.Extensions.PrivateDNSRecord.SetValues([]string{b.APIServerAddress})
+	}
 }
@@ -158,6 +158,23 @@ var _ = Describe("dns", func() {
 		})
 	})
 
+	Context("NeedsPrivateDNS", func() {
+		It("should be false when the private domain is nil", func() {
+			b.Shoot.PrivateDomain = nil
+			Expect(b.NeedsPrivateDNS()).To(BeFalse())
+		})
+
+		It("should be false when the private domain provider is unmanaged", func() {
+			b.Shoot.PrivateDomain = &gardenerutils.Domain{Provider: "unmanaged"}
+			Expect(b.NeedsPrivateDNS()).To(BeFalse())
+		})
+
+		It("should be true when the private domain provider is not unmanaged", func() {
+			b.Shoot.PrivateDomain = &gardenerutils.Domain{Provider: "some-provider"}
+			Expect(b.NeedsPrivateDNS()).To(BeTrue())
+		})
+	})
+
 	Context("ShootUsesDNS", func() {
 		BeforeEach(func() {
 			gardenletfeatures.RegisterFeatureGates()
@@ -178,16 +195,19 @@ var _ = Describe("dns", func() {
 			ctrl              *gomock.Controller
 			externalDNSRecord *mockdnsrecord.MockInterface
 			internalDNSRecord *mockdnsrecord.MockInterface
+			privateDNSRecord  *mockdnsrecord.MockInterface
 		)
 
 		BeforeEach(func() {
 			ctrl = gomock.NewController(GinkgoT())
 			externalDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
 			internalDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
+			privateDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
 
 			b.APIServerAddress = "1.2.3.4"
 			b.Shoot.Components.Extensions.ExternalDNSRecord = externalDNSRecord
 			b.Shoot.Components.Extensions.InternalDNSRecord = internalDNSRecord
+			b.Shoot.Components.Extensions.PrivateDNSRecord = privateDNSRecord
 		})
 
 		AfterEach(func() {
@@ -209,5 +229,14 @@ var _ = Describe("dns", func() {
 
 			b.newDNSComponentsTargetingAPIServerAddress()
 		})
+
+		It("sets the private DNSRecord", func() {
+			b.Shoot.PrivateDomain = &gardenerutils.Domain{Domain: "private", Provider: "valid-provider"}
+
+			privateDNSRecord.EXPECT().SetRecordType(extensionsv1alpha1.DNSRecordTypeA)
+			privateDNSRecord.EXPECT().SetValues([]string{"1.2.3.4"})
+
+			b.newDNSComponentsTargetingAPIServerAddress()
+		})
 	})
 })
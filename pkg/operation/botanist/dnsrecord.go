@@ -85,6 +85,35 @@ func (b *Botanist) DefaultInternalDNSRecord() extensionsdnsrecord.Interface {
 	)
 }
 
+// DefaultPrivateDNSRecord creates the default deployer for the private DNSRecord resource.
+func (b *Botanist) DefaultPrivateDNSRecord() extensionsdnsrecord.Interface {
+	values := &extensionsdnsrecord.Values{
+		Name:              b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordPrivateName,
+		SecretName:        DNSRecordSecretPrefix + "-" + b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordPrivateName,
+		Namespace:         b.Shoot.SeedNamespace,
+		TTL:               b.Config.Controllers.Shoot.DNSEntryTTLSeconds,
+		AnnotateOperation: controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskDeployDNSRecordPrivate) || b.IsRestorePhase(),
+	}
+
+	if b.NeedsPrivateDNS() {
+		values.Type = b.Shoot.PrivateDomain.Provider
+		if b.Shoot.PrivateDomain.Zone != "" {
+			values.Zone = &b.Shoot.PrivateDomain.Zone
+		}
+		values.SecretData = b.Shoot.PrivateDomain.SecretData
+		values.DNSName = gardenerutils.GetAPIServerDomain(b.Shoot.PrivateDomain.Domain)
+	}
+
+	return extensionsdnsrecord.New(
+		b.Logger,
+		b.SeedClientSet.Client(),
+		values,
+		extensionsdnsrecord.DefaultInterval,
+		extensionsdnsrecord.DefaultSevereThreshold,
+		extensionsdnsrecord.DefaultTimeout,
+	)
+}
+
 // DeployOrDestroyExternalDNSRecord deploys, restores, or destroys the external DNSRecord and waits for the operation to complete.
 func (b *Botanist) DeployOrDestroyExternalDNSRecord(ctx context.Context) error {
 	if b.NeedsExternalDNS() {
@@ -101,6 +130,14 @@ func (b *Botanist) DeployOrDestroyInternalDNSRecord(ctx context.Context) error {
 	return b.DestroyInternalDNSRecord(ctx)
 }
 
+// DeployOrDestroyPrivateDNSRecord deploys, restores, or destroys the private DNSRecord and waits for the operation to complete.
+func (b *Botanist) DeployOrDestroyPrivateDNSRecord(ctx context.Context) error {
+	if b.NeedsPrivateDNS() {
+		return b.deployPrivateDNSRecord(ctx)
+	}
+	return b.DestroyPrivateDNSRecord(ctx)
+}
+
 // deployExternalDNSRecord deploys or restores the external DNSRecord and waits for the operation to complete.
 func (b *Botanist) deployExternalDNSRecord(ctx context.Context) error {
 	if err := b.deployOrRestoreDNSRecord(ctx, b.Shoot.Components.Extensions.ExternalDNSRecord); err != nil {
@@ -117,6 +154,14 @@ func (b *Botanist) deployInternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.InternalDNSRecord.Wait(ctx)
 }
 
+// deployPrivateDNSRecord deploys or restores the private DNSRecord and waits for the operation to complete.
+func (b *Botanist) deployPrivateDNSRecord(ctx context.Context) error {
+	if err := b.deployOrRestoreDNSRecord(ctx, b.Shoot.Components.Extensions.PrivateDNSRecord); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.PrivateDNSRecord.Wait(ctx)
+}
+
 // DestroyExternalDNSRecord destroys the external DNSRecord and waits for the operation to complete.
 func (b *Botanist) DestroyExternalDNSRecord(ctx context.Context) error {
 	if err := b.Shoot.Components.Extensions.ExternalDNSRecord.Destroy(ctx); err != nil {
@@ -133,6 +178,14 @@ func (b *Botanist) DestroyInternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.InternalDNSRecord.WaitCleanup(ctx)
 }
 
+// DestroyPrivateDNSRecord destroys the private DNSRecord and waits for the operation to complete.
+func (b *Botanist) DestroyPrivateDNSRecord(ctx context.Context) error {
+	if err := b.Shoot.Components.Extensions.PrivateDNSRecord.Destroy(ctx); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.PrivateDNSRecord.WaitCleanup(ctx)
+}
+
 // MigrateExternalDNSRecord migrates the external DNSRecord and waits for the operation to complete.
 func (b *Botanist) MigrateExternalDNSRecord(ctx context.Context) error {
 	if err := b.Shoot.Components.Extensions.ExternalDNSRecord.Migrate(ctx); err != nil {
@@ -149,6 +202,14 @@ func (b *Botanist) MigrateInternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.InternalDNSRecord.WaitMigrate(ctx)
 }
 
+// MigratePrivateDNSRecord migrates the private DNSRecord and waits for the operation to complete.
+func (b *Botanist) MigratePrivateDNSRecord(ctx context.Context) error {
+	if err := b.Shoot.Components.Extensions.PrivateDNSRecord.Migrate(ctx); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.PrivateDNSRecord.WaitMigrate(ctx)
+}
+
 func (b *Botanist) deployOrRestoreDNSRecord(ctx context.Context, dnsRecord component.DeployMigrateWaiter) error {
 	if b.IsRestorePhase() {
 		return dnsRecord.Restore(ctx, b.Shoot.GetShootState())
@@ -64,6 +64,10 @@ const (
 	internalProvider = "internal-provider"
 	internalZone     = "internal-zone"
 
+	privateDomain   = "foo.bar.corp.internal"
+	privateProvider = "private-provider"
+	privateZone     = "private-zone"
+
 	address       = "1.2.3.4"
 	ttl     int64 = 300
 )
@@ -79,6 +83,7 @@ var _ = Describe("dnsrecord", func() {
 
 		externalDNSRecord *mockdnsrecord.MockInterface
 		internalDNSRecord *mockdnsrecord.MockInterface
+		privateDNSRecord  *mockdnsrecord.MockInterface
 
 		b *Botanist
 
@@ -102,6 +107,7 @@ var _ = Describe("dnsrecord", func() {
 
 		externalDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
 		internalDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
+		privateDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
 
 		cleanup = test.WithVar(&dnsrecord.TimeNow, func() time.Time { return now })
 	})
@@ -128,10 +134,19 @@ var _ = Describe("dnsrecord", func() {
 						},
 					},
 					InternalClusterDomain: internalDomain,
+					PrivateDomain: &gardenerutils.Domain{
+						Domain:   privateDomain,
+						Provider: privateProvider,
+						Zone:     privateZone,
+						SecretData: map[string][]byte{
+							"private-foo": []byte("private-bar"),
+						},
+					},
 					Components: &shoot.Components{
 						Extensions: &shoot.Extensions{
 							ExternalDNSRecord: externalDNSRecord,
 							InternalDNSRecord: internalDNSRecord,
+							PrivateDNSRecord:  privateDNSRecord,
 						},
 					},
 				},
@@ -406,6 +421,52 @@ var _ = Describe("dnsrecord", func() {
 		})
 	})
 
+	Describe("#DefaultPrivateDNSRecord", func() {
+		It("should create a component with correct values", func() {
+			r := b.DefaultPrivateDNSRecord()
+			r.SetRecordType(extensionsv1alpha1.DNSRecordTypeA)
+			r.SetValues([]string{address})
+
+			actual := r.GetValues()
+			Expect(actual).To(DeepEqual(&dnsrecord.Values{
+				Name:       b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordPrivateName,
+				SecretName: DNSRecordSecretPrefix + "-" + b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordPrivateName,
+				Namespace:  seedNamespace,
+				TTL:        pointer.Int64(ttl),
+				Type:       privateProvider,
+				Zone:       pointer.String(privateZone),
+				SecretData: map[string][]byte{
+					"private-foo": []byte("private-bar"),
+				},
+				DNSName:           "api." + privateDomain,
+				RecordType:        extensionsv1alpha1.DNSRecordTypeA,
+				Values:            []string{address},
+				AnnotateOperation: false,
+			}))
+		})
+
+		DescribeTable("should set AnnotateOperation value to true",
+			func(mutateShootFn func()) {
+				mutateShootFn()
+
+				c := b.DefaultPrivateDNSRecord()
+
+				Expect(c.GetValues().AnnotateOperation).To(BeTrue())
+			},
+
+			Entry("task annotation present", func() {
+				shoot := b.Shoot.GetInfo()
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/tasks", "deployDNSRecordPrivate")
+				b.Shoot.SetInfo(shoot)
+			}),
+			Entry("restore phase", func() {
+				shoot := b.Shoot.GetInfo()
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore}
+				b.Shoot.SetInfo(shoot)
+			}),
+		)
+	})
+
 	Describe("#DeployOrDestroyExternalDNSRecord", func() {
 		Context("deploy", func() {
 			It("should call Deploy and Wait and succeed if they succeeded", func() {
@@ -522,6 +583,36 @@ var _ = Describe("dnsrecord", func() {
 		})
 	})
 
+	Describe("#DeployOrDestroyPrivateDNSRecord", func() {
+		It("should call Deploy and Wait and succeed if they succeeded", func() {
+			privateDNSRecord.EXPECT().Deploy(ctx)
+			privateDNSRecord.EXPECT().Wait(ctx)
+			Expect(b.DeployOrDestroyPrivateDNSRecord(ctx)).To(Succeed())
+		})
+
+		It("should call Deploy and fail if it failed", func() {
+			privateDNSRecord.EXPECT().Deploy(ctx).Return(testErr)
+			Expect(b.DeployOrDestroyPrivateDNSRecord(ctx)).To(MatchError(testErr))
+		})
+
+		Context("destroy (Shoot PrivateDomain is set to nil)", func() {
+			JustBeforeEach(func() {
+				b.Shoot.PrivateDomain = nil
+			})
+
+			It("should call Destroy and WaitCleanup and succeed if they succeeded", func() {
+				privateDNSRecord.EXPECT().Destroy(ctx)
+				privateDNSRecord.EXPECT().WaitCleanup(ctx)
+				Expect(b.DeployOrDestroyPrivateDNSRecord(ctx)).To(Succeed())
+			})
+
+			It("should call Destroy and fail if it failed", func() {
+				privateDNSRecord.EXPECT().Destroy(ctx).Return(testErr)
+				Expect(b.DeployOrDestroyPrivateDNSRecord(ctx)).To(MatchError(testErr))
+			})
+		})
+	})
+
 	Describe("#DestroyExternalDNSRecord", func() {
 		It("should call Destroy and WaitCleanup and succeed if they succeeded", func() {
 			externalDNSRecord.EXPECT().Destroy(ctx)
@@ -573,4 +664,17 @@ var _ = Describe("dnsrecord", func() {
 			Expect(b.MigrateInternalDNSRecord(ctx)).To(MatchError(testErr))
 		})
 	})
+
+	Describe("#MigratePrivateDNSRecord", func() {
+		It("should call Migrate and WaitMigrate and succeed if they succeeded", func() {
+			privateDNSRecord.EXPECT().Migrate(ctx)
+			privateDNSRecord.EXPECT().WaitMigrate(ctx)
+			Expect(b.MigratePrivateDNSRecord(ctx)).To(Succeed())
+		})
+
+		It("should call Migrate and fail if it failed", func() {
+			privateDNSRecord.EXPECT().Migrate(ctx).Return(testErr)
+			Expect(b.MigratePrivateDNSRecord(ctx)).To(MatchError(testErr))
+		})
+	})
 })
@@ -16,12 +16,17 @@ package botanist
 
 import (
 	"context"
+	"fmt"
 
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -33,7 +38,9 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
 
@@ -52,22 +59,34 @@ func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, e
 		replicas = pointer.Int32(getEtcdReplicas(b.Shoot.GetInfo()))
 	}
 
+	var (
+		priorityClassName            = pointer.StringDeref(b.controlPlanePriorityTier().ETCD, v1beta1constants.PriorityClassNameShootControlPlane500)
+		deferPriorityClassNameChange = !gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(b.Shoot.GetInfo(), clock.RealClock{})
+		localStorageClassName        *string
+	)
+
+	if b.Config != nil && b.Config.ETCDConfig != nil && b.Config.ETCDConfig.LocalStorage != nil {
+		localStorageClassName = &b.Config.ETCDConfig.LocalStorage.StorageClassName
+	}
+
 	e := NewEtcd(
 		b.Logger,
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
-		b.SecretsManager,
+		secretsmanager.WithConsumer(b.SecretsManager, "etcd"),
 		etcd.Values{
-			Role:                        role,
-			Class:                       class,
-			Replicas:                    replicas,
-			StorageCapacity:             b.Seed.GetValidVolumeSize("10Gi"),
-			DefragmentationSchedule:     &defragmentationSchedule,
-			CARotationPhase:             v1beta1helper.GetShootCARotationPhase(b.Shoot.GetInfo().Status.Credentials),
-			RuntimeKubernetesVersion:    b.Seed.KubernetesVersion,
-			PriorityClassName:           v1beta1constants.PriorityClassNameShootControlPlane500,
-			HighAvailabilityEnabled:     v1beta1helper.IsHAControlPlaneConfigured(b.Shoot.GetInfo()),
-			TopologyAwareRoutingEnabled: b.Shoot.TopologyAwareRoutingEnabled,
+			Role:                         role,
+			Class:                        class,
+			Replicas:                     replicas,
+			StorageCapacity:              b.Seed.GetValidVolumeSize("10Gi"),
+			DefragmentationSchedule:      &defragmentationSchedule,
+			CARotationPhase:              v1beta1helper.GetShootCARotationPhase(b.Shoot.GetInfo().Status.Credentials),
+			RuntimeKubernetesVersion:     b.Seed.KubernetesVersion,
+			PriorityClassName:            priorityClassName,
+			HighAvailabilityEnabled:      v1beta1helper.IsHAControlPlaneConfigured(b.Shoot.GetInfo()),
+			TopologyAwareRoutingEnabled:  b.Shoot.TopologyAwareRoutingEnabled,
+			DeferPriorityClassNameChange: deferPriorityClassNameChange,
+			LocalStorageClassName:        localStorageClassName,
 		},
 	)
 
@@ -109,22 +128,30 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 		}
 
 		var (
-			backupLeaderElection         *config.ETCDBackupLeaderElection
-			deltaSnapshotRetentionPeriod *metav1.Duration
+			backupLeaderElection            *config.ETCDBackupLeaderElection
+			deltaSnapshotRetentionPeriod    *metav1.Duration
+			fullSnapshotFreshnessThreshold  *metav1.Duration
+			deltaSnapshotFreshnessThreshold *metav1.Duration
 		)
 		if b.Config != nil && b.Config.ETCDConfig != nil {
 			backupLeaderElection = b.Config.ETCDConfig.BackupLeaderElection
 			deltaSnapshotRetentionPeriod = b.Config.ETCDConfig.DeltaSnapshotRetentionPeriod
 		}
+		if b.Config != nil && b.Config.Controllers != nil && b.Config.Controllers.ShootCare != nil && b.Config.Controllers.ShootCare.BackupFreshnessThresholds != nil {
+			fullSnapshotFreshnessThreshold = b.Config.Controllers.ShootCare.BackupFreshnessThresholds.FullSnapshot
+			deltaSnapshotFreshnessThreshold = b.Config.Controllers.ShootCare.BackupFreshnessThresholds.DeltaSnapshot
+		}
 
 		b.Shoot.Components.ControlPlane.EtcdMain.SetBackupConfig(&etcd.BackupConfig{
-			Provider:                     b.Seed.GetInfo().Spec.Backup.Provider,
-			SecretRefName:                v1beta1constants.BackupSecretName,
-			Prefix:                       b.Shoot.BackupEntryName,
-			Container:                    string(secret.Data[v1beta1constants.DataKeyBackupBucketName]),
-			FullSnapshotSchedule:         snapshotSchedule,
-			LeaderElection:               backupLeaderElection,
-			DeltaSnapshotRetentionPeriod: deltaSnapshotRetentionPeriod,
+			Provider:                        b.Seed.GetInfo().Spec.Backup.Provider,
+			SecretRefName:                   v1beta1constants.BackupSecretName,
+			Prefix:                          b.Shoot.BackupEntryName,
+			Container:                       string(secret.Data[v1beta1constants.DataKeyBackupBucketName]),
+			FullSnapshotSchedule:            snapshotSchedule,
+			LeaderElection:                  backupLeaderElection,
+			DeltaSnapshotRetentionPeriod:    deltaSnapshotRetentionPeriod,
+			FullSnapshotFreshnessThreshold:  fullSnapshotFreshnessThreshold,
+			DeltaSnapshotFreshnessThreshold: deltaSnapshotFreshnessThreshold,
 		})
 	}
 
@@ -154,6 +181,68 @@ func (b *Botanist) WaitUntilEtcdsReady(ctx context.Context) error {
 	)(ctx)
 }
 
+// IsEtcdScaleUpRequired returns true if the main etcd still runs as a single-member cluster although the Shoot requests
+// a highly available control plane, i.e., if the etcd clusters need to be scaled up to three members.
+func (b *Botanist) IsEtcdScaleUpRequired(ctx context.Context) (bool, error) {
+	if b.Shoot.HibernationEnabled || !v1beta1helper.IsHAControlPlaneConfigured(b.Shoot.GetInfo()) {
+		return false, nil
+	}
+
+	etcdMain, err := b.Shoot.Components.ControlPlane.EtcdMain.Get(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return etcdMain.Spec.Replicas == 1, nil
+}
+
+// VerifyEtcdQuorum verifies that all members of the etcd main and events clusters are ready and that their
+// PodDisruptionBudgets protect the quorum of the clusters.
+func (b *Botanist) VerifyEtcdQuorum(ctx context.Context) error {
+	return flow.Parallel(
+		b.verifyEtcdQuorum(b.Shoot.Components.ControlPlane.EtcdMain),
+		b.verifyEtcdQuorum(b.Shoot.Components.ControlPlane.EtcdEvents),
+	)(ctx)
+}
+
+func (b *Botanist) verifyEtcdQuorum(e etcd.Interface) flow.TaskFn {
+	return func(ctx context.Context) error {
+		etcdObj, err := e.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		var readyMembers int32
+		for _, member := range etcdObj.Status.Members {
+			if member.Status == druidv1alpha1.EtcdMemberStatusReady {
+				readyMembers++
+			}
+		}
+
+		if readyMembers < etcdObj.Spec.Replicas {
+			return fmt.Errorf("only %d/%d members of etcd %q are ready", readyMembers, etcdObj.Spec.Replicas, etcdObj.Name)
+		}
+
+		if etcdObj.Spec.Replicas <= 1 {
+			return nil
+		}
+
+		pdb := &policyv1.PodDisruptionBudget{}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(etcdObj), pdb); err != nil {
+			return fmt.Errorf("failed reading PodDisruptionBudget of etcd %q: %w", etcdObj.Name, err)
+		}
+
+		if quorum := int(etcdObj.Spec.Replicas)/2 + 1; pdb.Spec.MinAvailable == nil || pdb.Spec.MinAvailable.IntValue() != quorum {
+			return fmt.Errorf("PodDisruptionBudget of etcd %q does not protect the quorum of %d members yet", etcdObj.Name, quorum)
+		}
+
+		return nil
+	}
+}
+
 // DestroyEtcd destroys the etcd main and events.
 func (b *Botanist) DestroyEtcd(ctx context.Context) error {
 	return flow.Parallel(
@@ -29,6 +29,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -52,6 +53,7 @@ import (
 	. "github.com/gardener/gardener/pkg/operation/botanist"
 	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
@@ -146,7 +148,7 @@ var _ = Describe("Etcd", func() {
 							expectedClient:                  Equal(c),
 							expectedLogger:                  BeAssignableToTypeOf(logr.Logger{}),
 							expectedNamespace:               Equal(namespace),
-							expectedSecretsManager:          Equal(sm),
+							expectedSecretsManager:          Equal(secretsmanager.WithConsumer(sm, "etcd")),
 							expectedRole:                    Equal(role),
 							expectedClass:                   Equal(class),
 							expectedReplicas:                PointTo(Equal(int32(1))),
@@ -158,6 +160,7 @@ var _ = Describe("Etcd", func() {
 								ScaleDownUpdateMode:   pointer.String(computeUpdateMode(class, purpose)),
 							}),
 							expectedHighAvailabilityEnabled: Equal(v1beta1helper.IsHAControlPlaneConfigured(botanist.Shoot.GetInfo())),
+							expectedPriorityClassName:       Equal("gardener-system-500"),
 						}
 
 						oldNewEtcd := NewEtcd
@@ -172,6 +175,110 @@ var _ = Describe("Etcd", func() {
 			}
 		})
 
+		Context("control plane priority tiers", func() {
+			BeforeEach(func() {
+				botanist.Seed.SetInfo(&gardencorev1beta1.Seed{
+					Spec: gardencorev1beta1.SeedSpec{
+						Settings: &gardencorev1beta1.SeedSettings{
+							ControlPlanePriority: &gardencorev1beta1.SeedSettingControlPlanePriority{
+								Tiers: []gardencorev1beta1.SeedSettingControlPlanePriorityTier{
+									{
+										Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation},
+										ETCD:     pointer.String("gardener-system-100"),
+									},
+									{
+										Purposes:      []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeProduction},
+										KubeAPIServer: pointer.String("gardener-system-400"),
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			test := func(purpose gardencorev1beta1.ShootPurpose, expectedPriorityClassName string) {
+				botanist.Shoot.Purpose = purpose
+
+				validator := &newEtcdValidator{
+					expectedClient:                  Equal(c),
+					expectedLogger:                  BeAssignableToTypeOf(logr.Logger{}),
+					expectedNamespace:               Equal(namespace),
+					expectedSecretsManager:          Equal(secretsmanager.WithConsumer(sm, "etcd")),
+					expectedRole:                    Equal(role),
+					expectedClass:                   Equal(class),
+					expectedReplicas:                PointTo(Equal(int32(1))),
+					expectedStorageCapacity:         Equal("10Gi"),
+					expectedDefragmentationSchedule: Not(BeNil()),
+					expectedHVPAConfig:              Not(BeNil()),
+					expectedHighAvailabilityEnabled: BeFalse(),
+					expectedPriorityClassName:       Equal(expectedPriorityClassName),
+				}
+
+				oldNewEtcd := NewEtcd
+				defer func() { NewEtcd = oldNewEtcd }()
+				NewEtcd = validator.NewEtcd
+
+				etcd, err := botanist.DefaultEtcd(role, class)
+				Expect(etcd).NotTo(BeNil())
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			It("should use the priority class of the tier matching the shoot purpose", func() {
+				test(gardencorev1beta1.ShootPurposeEvaluation, "gardener-system-100")
+			})
+
+			It("should use the default priority class if the matching tier does not specify one for etcd", func() {
+				test(gardencorev1beta1.ShootPurposeProduction, "gardener-system-500")
+			})
+
+			It("should use the default priority class if no tier matches the shoot purpose", func() {
+				test(gardencorev1beta1.ShootPurposeDevelopment, "gardener-system-500")
+			})
+		})
+
+		Context("local storage", func() {
+			test := func(expectedLocalStorageClassName gomegatypes.GomegaMatcher) {
+				validator := &newEtcdValidator{
+					expectedClient:                  Equal(c),
+					expectedLogger:                  BeAssignableToTypeOf(logr.Logger{}),
+					expectedNamespace:               Equal(namespace),
+					expectedSecretsManager:          Equal(secretsmanager.WithConsumer(sm, "etcd")),
+					expectedRole:                    Equal(role),
+					expectedClass:                   Equal(class),
+					expectedReplicas:                PointTo(Equal(int32(1))),
+					expectedStorageCapacity:         Equal("10Gi"),
+					expectedDefragmentationSchedule: Not(BeNil()),
+					expectedHVPAConfig:              Not(BeNil()),
+					expectedHighAvailabilityEnabled: BeFalse(),
+					expectedPriorityClassName:       Equal("gardener-system-500"),
+					expectedLocalStorageClassName:   expectedLocalStorageClassName,
+				}
+
+				oldNewEtcd := NewEtcd
+				defer func() { NewEtcd = oldNewEtcd }()
+				NewEtcd = validator.NewEtcd
+
+				etcd, err := botanist.DefaultEtcd(role, class)
+				Expect(etcd).NotTo(BeNil())
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			It("should not configure local storage if it is not configured for the seed", func() {
+				test(BeNil())
+			})
+
+			It("should configure the local storage class of the seed", func() {
+				botanist.Config = &gardenletconfig.GardenletConfiguration{
+					ETCDConfig: &gardenletconfig.ETCDConfig{
+						LocalStorage: &gardenletconfig.ETCDLocalStorage{StorageClassName: "local-nvme"},
+					},
+				}
+
+				test(PointTo(Equal("local-nvme")))
+			})
+		})
+
 		Context("no HVPAShootedSeed feature gate", func() {
 			hvpaForShootedSeedEnabled := false
 
@@ -186,7 +293,7 @@ var _ = Describe("Etcd", func() {
 					expectedClient:                  Equal(c),
 					expectedLogger:                  BeAssignableToTypeOf(logr.Logger{}),
 					expectedNamespace:               Equal(namespace),
-					expectedSecretsManager:          Equal(sm),
+					expectedSecretsManager:          Equal(secretsmanager.WithConsumer(sm, "etcd")),
 					expectedRole:                    Equal(role),
 					expectedClass:                   Equal(class),
 					expectedReplicas:                PointTo(Equal(int32(1))),
@@ -198,6 +305,7 @@ var _ = Describe("Etcd", func() {
 						ScaleDownUpdateMode:   pointer.String(hvpav1alpha1.UpdateModeMaintenanceWindow),
 					}),
 					expectedHighAvailabilityEnabled: Equal(v1beta1helper.IsHAControlPlaneConfigured(botanist.Shoot.GetInfo())),
+					expectedPriorityClassName:       Equal("gardener-system-500"),
 				}
 
 				oldNewEtcd := NewEtcd
@@ -218,7 +326,7 @@ var _ = Describe("Etcd", func() {
 					expectedClient:                  Equal(c),
 					expectedLogger:                  BeAssignableToTypeOf(logr.Logger{}),
 					expectedNamespace:               Equal(namespace),
-					expectedSecretsManager:          Equal(sm),
+					expectedSecretsManager:          Equal(secretsmanager.WithConsumer(sm, "etcd")),
 					expectedRole:                    Equal(role),
 					expectedClass:                   Equal(class),
 					expectedReplicas:                PointTo(Equal(int32(1))),
@@ -230,6 +338,7 @@ var _ = Describe("Etcd", func() {
 						ScaleDownUpdateMode:   pointer.String(hvpav1alpha1.UpdateModeMaintenanceWindow),
 					}),
 					expectedHighAvailabilityEnabled: Equal(v1beta1helper.IsHAControlPlaneConfigured(botanist.Shoot.GetInfo())),
+					expectedPriorityClassName:       Equal("gardener-system-500"),
 				}
 
 				oldNewEtcd := NewEtcd
@@ -351,12 +460,14 @@ var _ = Describe("Etcd", func() {
 				}
 				expectSetBackupConfig = func() {
 					etcdMain.EXPECT().SetBackupConfig(&etcd.BackupConfig{
-						Provider:             backupProvider,
-						SecretRefName:        "etcd-backup",
-						Prefix:               namespace + "--" + string(shootUID),
-						Container:            bucketName,
-						FullSnapshotSchedule: "1 12 * * *",
-						LeaderElection:       backupLeaderElectionConfig,
+						Provider:                        backupProvider,
+						SecretRefName:                   "etcd-backup",
+						Prefix:                          namespace + "--" + string(shootUID),
+						Container:                       bucketName,
+						FullSnapshotSchedule:            "1 12 * * *",
+						LeaderElection:                  backupLeaderElectionConfig,
+						FullSnapshotFreshnessThreshold:  &metav1.Duration{Duration: 12 * time.Hour},
+						DeltaSnapshotFreshnessThreshold: &metav1.Duration{Duration: 30 * time.Minute},
 					})
 				}
 			)
@@ -369,6 +480,14 @@ var _ = Describe("Etcd", func() {
 					ETCDConfig: &gardenletconfig.ETCDConfig{
 						BackupLeaderElection: backupLeaderElectionConfig,
 					},
+					Controllers: &gardenletconfig.GardenletControllerConfiguration{
+						ShootCare: &gardenletconfig.ShootCareControllerConfiguration{
+							BackupFreshnessThresholds: &gardenletconfig.BackupFreshnessThresholds{
+								FullSnapshot:  &metav1.Duration{Duration: 12 * time.Hour},
+								DeltaSnapshot: &metav1.Duration{Duration: 30 * time.Minute},
+							},
+						},
+					},
 				}
 			})
 
@@ -492,6 +611,153 @@ var _ = Describe("Etcd", func() {
 		})
 	})
 
+	Describe("#IsEtcdScaleUpRequired", func() {
+		var etcdMain *mocketcd.MockInterface
+
+		BeforeEach(func() {
+			etcdMain = mocketcd.NewMockInterface(ctrl)
+
+			botanist.Shoot = &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					ControlPlane: &shootpkg.ControlPlane{
+						EtcdMain: etcdMain,
+					},
+				},
+			}
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					ControlPlane: &gardencorev1beta1.ControlPlane{
+						HighAvailability: &gardencorev1beta1.HighAvailability{
+							FailureTolerance: gardencorev1beta1.FailureTolerance{
+								Type: gardencorev1beta1.FailureToleranceTypeZone,
+							},
+						},
+					},
+				},
+			})
+		})
+
+		It("should return false if the control plane is not highly available", func() {
+			botanist.Shoot.GetInfo().Spec.ControlPlane = nil
+
+			Expect(botanist.IsEtcdScaleUpRequired(ctx)).To(BeFalse())
+		})
+
+		It("should return false if the shoot is hibernated", func() {
+			botanist.Shoot.HibernationEnabled = true
+
+			Expect(botanist.IsEtcdScaleUpRequired(ctx)).To(BeFalse())
+		})
+
+		It("should return false if the etcd does not exist yet", func() {
+			etcdMain.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "etcd-main"))
+
+			Expect(botanist.IsEtcdScaleUpRequired(ctx)).To(BeFalse())
+		})
+
+		It("should return an error if the etcd cannot be read", func() {
+			etcdMain.EXPECT().Get(ctx).Return(nil, fakeErr)
+
+			isRequired, err := botanist.IsEtcdScaleUpRequired(ctx)
+			Expect(err).To(MatchError(fakeErr))
+			Expect(isRequired).To(BeFalse())
+		})
+
+		It("should return false if the etcd is already highly available", func() {
+			etcdMain.EXPECT().Get(ctx).Return(&druidv1alpha1.Etcd{Spec: druidv1alpha1.EtcdSpec{Replicas: 3}}, nil)
+
+			Expect(botanist.IsEtcdScaleUpRequired(ctx)).To(BeFalse())
+		})
+
+		It("should return true if the etcd still runs with a single member", func() {
+			etcdMain.EXPECT().Get(ctx).Return(&druidv1alpha1.Etcd{Spec: druidv1alpha1.EtcdSpec{Replicas: 1}}, nil)
+
+			Expect(botanist.IsEtcdScaleUpRequired(ctx)).To(BeTrue())
+		})
+	})
+
+	Describe("#VerifyEtcdQuorum", func() {
+		var (
+			etcdMain, etcdEvents *mocketcd.MockInterface
+
+			newEtcd = func(name string, replicas int32, readyMembers int) *druidv1alpha1.Etcd {
+				obj := &druidv1alpha1.Etcd{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec:       druidv1alpha1.EtcdSpec{Replicas: replicas},
+				}
+				for i := 0; i < readyMembers; i++ {
+					obj.Status.Members = append(obj.Status.Members, druidv1alpha1.EtcdMemberStatus{
+						Name:   fmt.Sprintf("%s-%d", name, i),
+						Status: druidv1alpha1.EtcdMemberStatusReady,
+					})
+				}
+				return obj
+			}
+			newPDB = func(name string, minAvailable int32) *policyv1.PodDisruptionBudget {
+				return &policyv1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec:       policyv1.PodDisruptionBudgetSpec{MinAvailable: utils.IntStrPtrFromInt32(minAvailable)},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			etcdMain, etcdEvents = mocketcd.NewMockInterface(ctrl), mocketcd.NewMockInterface(ctrl)
+
+			botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build()
+			botanist.Shoot = &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					ControlPlane: &shootpkg.ControlPlane{
+						EtcdMain:   etcdMain,
+						EtcdEvents: etcdEvents,
+					},
+				},
+			}
+		})
+
+		It("should succeed if all members are ready and the quorum is protected", func() {
+			Expect(fakeClient.Create(ctx, newPDB("etcd-main", 2))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newPDB("etcd-events", 2))).To(Succeed())
+			etcdMain.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-main", 3, 3), nil)
+			etcdEvents.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-events", 3, 3), nil)
+
+			Expect(botanist.VerifyEtcdQuorum(ctx)).To(Succeed())
+		})
+
+		It("should fail if not all members are ready", func() {
+			Expect(fakeClient.Create(ctx, newPDB("etcd-main", 2))).To(Succeed())
+			etcdMain.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-main", 3, 3), nil)
+			etcdEvents.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-events", 3, 2), nil)
+
+			Expect(botanist.VerifyEtcdQuorum(ctx)).To(MatchError(ContainSubstring(`only 2/3 members of etcd "etcd-events" are ready`)))
+		})
+
+		It("should fail if the PodDisruptionBudget does not protect the quorum yet", func() {
+			Expect(fakeClient.Create(ctx, newPDB("etcd-main", 0))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newPDB("etcd-events", 2))).To(Succeed())
+			etcdMain.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-main", 3, 3), nil)
+			etcdEvents.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-events", 3, 3), nil)
+
+			Expect(botanist.VerifyEtcdQuorum(ctx)).To(MatchError(ContainSubstring(`PodDisruptionBudget of etcd "etcd-main" does not protect the quorum of 2 members yet`)))
+		})
+
+		It("should fail if the PodDisruptionBudget does not exist", func() {
+			Expect(fakeClient.Create(ctx, newPDB("etcd-events", 2))).To(Succeed())
+			etcdMain.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-main", 3, 3), nil)
+			etcdEvents.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-events", 3, 3), nil)
+
+			Expect(botanist.VerifyEtcdQuorum(ctx)).To(MatchError(ContainSubstring(`failed reading PodDisruptionBudget of etcd "etcd-main"`)))
+		})
+
+		It("should fail if the etcd cannot be read", func() {
+			Expect(fakeClient.Create(ctx, newPDB("etcd-main", 2))).To(Succeed())
+			etcdMain.EXPECT().Get(gomock.Any()).Return(newEtcd("etcd-main", 3, 3), nil)
+			etcdEvents.EXPECT().Get(gomock.Any()).Return(nil, fakeErr)
+
+			Expect(botanist.VerifyEtcdQuorum(ctx)).To(MatchError(ContainSubstring(fakeErr.Error())))
+		})
+	})
+
 	Describe("#DestroyEtcd", func() {
 		var (
 			etcdMain, etcdEvents *mocketcd.MockInterface
@@ -553,6 +819,8 @@ type newEtcdValidator struct {
 	expectedDefragmentationSchedule gomegatypes.GomegaMatcher
 	expectedHVPAConfig              gomegatypes.GomegaMatcher
 	expectedHighAvailabilityEnabled gomegatypes.GomegaMatcher
+	expectedPriorityClassName       gomegatypes.GomegaMatcher
+	expectedLocalStorageClassName   gomegatypes.GomegaMatcher
 }
 
 func (v *newEtcdValidator) NewEtcd(
@@ -572,6 +840,10 @@ func (v *newEtcdValidator) NewEtcd(
 	Expect(values.StorageCapacity).To(v.expectedStorageCapacity)
 	Expect(values.DefragmentationSchedule).To(v.expectedDefragmentationSchedule)
 	Expect(values.HighAvailabilityEnabled).To(v.expectedHighAvailabilityEnabled)
+	Expect(values.PriorityClassName).To(v.expectedPriorityClassName)
+	if v.expectedLocalStorageClassName != nil {
+		Expect(values.LocalStorageClassName).To(v.expectedLocalStorageClassName)
+	}
 
 	return v
 }
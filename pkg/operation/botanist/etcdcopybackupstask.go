@@ -33,16 +33,22 @@ var NewEtcdCopyBackupsTask = etcdcopybackupstask.New
 
 // DefaultEtcdCopyBackupsTask creates the default deployer for the EtcdCopyBackupsTask resource.
 func (b *Botanist) DefaultEtcdCopyBackupsTask() etcdcopybackupstask.Interface {
+	var waitForFinalSnapshot *druidv1alpha1.WaitForFinalSnapshotSpec
+	// The etcd of the shoot to clone from keeps running, hence there is no final snapshot to wait for.
+	if !b.IsCloningPhase() {
+		waitForFinalSnapshot = &druidv1alpha1.WaitForFinalSnapshotSpec{
+			Enabled: true,
+			Timeout: &metav1.Duration{Duration: etcdcopybackupstask.DefaultTimeout},
+		}
+	}
+
 	return NewEtcdCopyBackupsTask(
 		b.Logger,
 		b.SeedClientSet.Client(),
 		&etcdcopybackupstask.Values{
-			Name:      b.Shoot.GetInfo().Name,
-			Namespace: b.Shoot.SeedNamespace,
-			WaitForFinalSnapshot: &druidv1alpha1.WaitForFinalSnapshotSpec{
-				Enabled: true,
-				Timeout: &metav1.Duration{Duration: etcdcopybackupstask.DefaultTimeout},
-			},
+			Name:                 b.Shoot.GetInfo().Name,
+			Namespace:            b.Shoot.SeedNamespace,
+			WaitForFinalSnapshot: waitForFinalSnapshot,
 		},
 		etcdcopybackupstask.DefaultInterval,
 		etcdcopybackupstask.DefaultSevereThreshold,
@@ -74,6 +80,16 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 		return err
 	}
 
+	// When cloning, the backups are copied from the folder of the shoot to clone from.
+	sourcePrefix := b.Shoot.BackupEntryName
+	if b.IsCloningPhase() {
+		cloneSourceBackupEntry, err := b.GetCloneSourceBackupEntry(ctx)
+		if err != nil {
+			return err
+		}
+		sourcePrefix = cloneSourceBackupEntry.Name
+	}
+
 	sourceProvider := druidv1alpha1.StorageProvider(sourceBackupEntry.Spec.Type)
 	provider := druidv1alpha1.StorageProvider(b.Seed.GetInfo().Spec.Backup.Provider)
 	sourceContainer := string(sourceSecret.Data[v1beta1constants.DataKeyBackupBucketName])
@@ -82,7 +98,7 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetSourceStore(druidv1alpha1.StoreSpec{
 		Provider:  &sourceProvider,
 		SecretRef: &corev1.SecretReference{Name: sourceSecret.Name},
-		Prefix:    fmt.Sprintf("%s/etcd-%s", b.Shoot.BackupEntryName, v1beta1constants.ETCDRoleMain),
+		Prefix:    fmt.Sprintf("%s/etcd-%s", sourcePrefix, v1beta1constants.ETCDRoleMain),
 		Container: &sourceContainer,
 	})
 	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetTargetStore(druidv1alpha1.StoreSpec{
@@ -125,6 +125,30 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 			etcdCopyBackupsTask := botanist.DefaultEtcdCopyBackupsTask()
 			Expect(etcdCopyBackupsTask).NotTo(BeNil())
 		})
+
+		It("should not wait for a final snapshot when the shoot is being cloned", func() {
+			shoot := botanist.Shoot.GetInfo()
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/clone-from": "source"}
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}
+			botanist.Shoot.SetInfo(shoot)
+
+			validator := &newEtcdCopyBackupsTaskValidator{
+				expectedClient: Equal(c),
+				expectedLogger: BeAssignableToTypeOf(logr.Logger{}),
+				expectedValues: Equal(&etcdcopybackupstask.Values{
+					Name:      botanist.Shoot.GetInfo().Name,
+					Namespace: botanist.Shoot.SeedNamespace,
+				}),
+				expectedWaitInterval:       Equal(etcdcopybackupstask.DefaultInterval),
+				expectedWaitSevereTreshold: Equal(etcdcopybackupstask.DefaultSevereThreshold),
+				expectedWaitTimeout:        Equal(etcdcopybackupstask.DefaultTimeout),
+			}
+
+			defer test.WithVars(&NewEtcdCopyBackupsTask, validator.NewEtcdCopyBackupsTask)()
+
+			etcdCopyBackupsTask := botanist.DefaultEtcdCopyBackupsTask()
+			Expect(etcdCopyBackupsTask).NotTo(BeNil())
+		})
 	})
 
 	Describe("#DeployEtcdCopyBackupsTask", func() {
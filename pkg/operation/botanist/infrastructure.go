@@ -18,9 +18,12 @@ import (
 	"context"
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/infrastructure"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
@@ -49,12 +52,8 @@ func (b *Botanist) DefaultInfrastructure() infrastructure.Interface {
 // DeployInfrastructure deploys the Infrastructure custom resource and triggers the restore operation in case
 // the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployInfrastructure(ctx context.Context) error {
-	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
-		sshKeypairSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
-		if !found {
-			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHKeyPair)
-		}
-		b.Shoot.Components.Extensions.Infrastructure.SetSSHPublicKey(sshKeypairSecret.Data[secrets.DataKeySSHAuthorizedKeys])
+	if err := b.setInfrastructureSSHPublicKey(); err != nil {
+		return err
 	}
 
 	if b.IsRestorePhase() {
@@ -64,6 +63,58 @@ func (b *Botanist) DeployInfrastructure(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.Infrastructure.Deploy(ctx)
 }
 
+// PlanInfrastructure requests the extension controller to compute the changes a reconciliation of the Infrastructure
+// custom resource would perform without applying them. The result is published in the Shoot status.
+func (b *Botanist) PlanInfrastructure(ctx context.Context) error {
+	if err := b.setInfrastructureSSHPublicKey(); err != nil {
+		return err
+	}
+
+	plan, err := b.Shoot.Components.Extensions.Infrastructure.Plan(ctx)
+	if err != nil {
+		return err
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.InfrastructurePlan = convertInfrastructurePlan(plan)
+		return nil
+	})
+}
+
+func (b *Botanist) setInfrastructureSSHPublicKey() error {
+	if !v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
+		return nil
+	}
+
+	sshKeypairSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHKeyPair)
+	}
+	b.Shoot.Components.Extensions.Infrastructure.SetSSHPublicKey(sshKeypairSecret.Data[secrets.DataKeySSHAuthorizedKeys])
+	return nil
+}
+
+func convertInfrastructurePlan(plan *extensionsv1alpha1.InfrastructurePlan) *gardencorev1beta1.InfrastructurePlan {
+	if plan == nil {
+		// The extension controller does not support planning.
+		return &gardencorev1beta1.InfrastructurePlan{LastUpdateTime: metav1.Now()}
+	}
+
+	out := &gardencorev1beta1.InfrastructurePlan{
+		LastUpdateTime: plan.LastUpdateTime,
+		Supported:      true,
+	}
+	for _, change := range plan.Changes {
+		out.Changes = append(out.Changes, gardencorev1beta1.InfrastructurePlannedChange{
+			Action:       string(change.Action),
+			ResourceType: change.ResourceType,
+			Name:         change.Name,
+			Reason:       change.Reason,
+		})
+	}
+	return out
+}
+
 // WaitForInfrastructure waits until the infrastructure reconciliation has finished and extracts the provider status
 // out of it.
 func (b *Botanist) WaitForInfrastructure(ctx context.Context) error {
@@ -29,6 +29,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
 	mockinfrastructure "github.com/gardener/gardener/pkg/component/extensions/infrastructure/mock"
@@ -134,6 +135,67 @@ var _ = Describe("Infrastructure", func() {
 		})
 	})
 
+	Describe("#PlanInfrastructure", func() {
+		var (
+			gardenClient client.Client
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+			botanist.GardenClient = gardenClient
+
+			shoot = botanist.Shoot.GetInfo()
+			shoot.ObjectMeta = metav1.ObjectMeta{Name: "name", Namespace: "garden-foo"}
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+			botanist.Shoot.SetInfo(shoot)
+
+			infrastructure.EXPECT().SetSSHPublicKey(gomock.Any())
+		})
+
+		It("should publish the computed plan in the shoot status", func() {
+			now := metav1.Now()
+			infrastructure.EXPECT().Plan(ctx).Return(&extensionsv1alpha1.InfrastructurePlan{
+				LastUpdateTime: now,
+				Changes: []extensionsv1alpha1.InfrastructurePlannedChange{
+					{Action: extensionsv1alpha1.InfrastructurePlannedActionReplace, ResourceType: "subnet", Name: "nodes", Reason: pointer.String("cidr changed")},
+					{Action: extensionsv1alpha1.InfrastructurePlannedActionCreate, ResourceType: "nat-gateway", Name: "zone-b"},
+				},
+			}, nil)
+
+			Expect(botanist.PlanInfrastructure(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.InfrastructurePlan).NotTo(BeNil())
+			Expect(shoot.Status.InfrastructurePlan.Supported).To(BeTrue())
+			Expect(shoot.Status.InfrastructurePlan.LastUpdateTime.Unix()).To(Equal(now.Unix()))
+			Expect(shoot.Status.InfrastructurePlan.Changes).To(Equal([]gardencorev1beta1.InfrastructurePlannedChange{
+				{Action: "Replace", ResourceType: "subnet", Name: "nodes", Reason: pointer.String("cidr changed")},
+				{Action: "Create", ResourceType: "nat-gateway", Name: "zone-b"},
+			}))
+		})
+
+		It("should mark the plan as not supported if the extension did not compute a plan", func() {
+			infrastructure.EXPECT().Plan(ctx)
+
+			Expect(botanist.PlanInfrastructure(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.InfrastructurePlan).NotTo(BeNil())
+			Expect(shoot.Status.InfrastructurePlan.Supported).To(BeFalse())
+			Expect(shoot.Status.InfrastructurePlan.Changes).To(BeEmpty())
+		})
+
+		It("should return the error during planning", func() {
+			infrastructure.EXPECT().Plan(ctx).Return(nil, fakeErr)
+
+			Expect(botanist.PlanInfrastructure(ctx)).To(MatchError(fakeErr))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.InfrastructurePlan).To(BeNil())
+		})
+	})
+
 	Describe("#WaitForInfrastructure", func() {
 		var (
 			gardenClient  *mockclient.MockClient
@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -32,9 +33,11 @@ import (
 	"github.com/gardener/gardener/pkg/component/kubeapiserver"
 	"github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/features"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // DefaultKubeAPIServer returns a deployer for the kube-apiserver.
@@ -67,6 +70,7 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		vpnConfig.HighAvailabilityEnabled = b.Shoot.VPNHighAvailabilityEnabled
 		vpnConfig.HighAvailabilityNumberOfSeedServers = b.Shoot.VPNHighAvailabilityNumberOfSeedServers
 		vpnConfig.HighAvailabilityNumberOfShootClients = b.Shoot.VPNHighAvailabilityNumberOfShootClients
+		vpnConfig.HighThroughputEnabled = b.Shoot.VPNHighThroughput != nil
 	}
 
 	return shared.NewKubeAPIServer(
@@ -77,19 +81,20 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		b.Shoot.GetInfo().ObjectMeta,
 		b.Seed.KubernetesVersion,
 		b.Shoot.KubernetesVersion,
-		b.SecretsManager,
+		secretsmanager.WithConsumer(b.SecretsManager, "kube-apiserver"),
 		"",
 		b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer,
 		b.computeKubeAPIServerAutoscalingConfig(),
 		services,
 		vpnConfig,
-		v1beta1constants.PriorityClassNameShootControlPlane500,
+		pointer.StringDeref(b.controlPlanePriorityTier().KubeAPIServer, v1beta1constants.PriorityClassNameShootControlPlane500),
 		b.Shoot.IsWorkerless,
 		b.Shoot.GetInfo().Spec.Kubernetes.EnableStaticTokenKubeconfig,
 		nil,
 		nil,
 		nil,
 		nil,
+		gardenlethelper.IsDeprecatedAPIUsageReporterEnabled(b.Config),
 		features.DefaultFeatureGate.Enabled(features.APIServerFastRollout),
 	)
 }
@@ -209,6 +214,10 @@ func (b *Botanist) computeKubeAPIServerServerCertificateConfig() kubeapiserver.S
 		dnsNames = append(dnsNames, *(b.Shoot.GetInfo().Spec.DNS.Domain), gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain))
 	}
 
+	if b.Shoot.PrivateDomain != nil {
+		dnsNames = append(dnsNames, gardenerutils.GetAPIServerDomain(b.Shoot.PrivateDomain.Domain))
+	}
+
 	return kubeapiserver.ServerCertificateConfig{
 		ExtraIPAddresses: ipAddresses,
 		ExtraDNSNames:    dnsNames,
@@ -235,12 +244,17 @@ func (b *Botanist) computeKubeAPIServerSNIConfig() kubeapiserver.SNIConfig {
 func (b *Botanist) DeployKubeAPIServer(ctx context.Context) error {
 	externalServer := b.Shoot.ComputeOutOfClusterAPIServerAddress(false)
 
+	apiServerConfig, err := b.computeKubeAPIServerConfig()
+	if err != nil {
+		return err
+	}
+
 	if err := shared.DeployKubeAPIServer(
 		ctx,
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
 		b.Shoot.Components.ControlPlane.KubeAPIServer,
-		b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer,
+		apiServerConfig,
 		b.computeKubeAPIServerServerCertificateConfig(),
 		b.computeKubeAPIServerSNIConfig(),
 		b.Shoot.ComputeOutOfClusterAPIServerAddress(true),
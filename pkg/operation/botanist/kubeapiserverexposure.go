@@ -16,13 +16,17 @@ package botanist
 
 import (
 	"context"
+	"slices"
 
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/kubeapiserverexposure"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -36,6 +40,7 @@ func (b *Botanist) DefaultKubeAPIServerService() component.DeployWaiter {
 			AnnotationsFunc:             func() map[string]string { return b.IstioLoadBalancerAnnotations() },
 			TopologyAwareRoutingEnabled: b.Shoot.TopologyAwareRoutingEnabled,
 			RuntimeKubernetesVersion:    b.Seed.KubernetesVersion,
+			ExtensionsAccessRestricted:  b.extensionsAccessToKubeAPIServerRestricted(),
 		},
 		func() client.ObjectKey {
 			return client.ObjectKey{Name: b.IstioServiceName(), Namespace: b.IstioNamespace()}
@@ -50,6 +55,17 @@ func (b *Botanist) DefaultKubeAPIServerService() component.DeployWaiter {
 	)
 }
 
+// extensionsAccessToKubeAPIServerRestricted returns true if the access of extensions to the kube-apiserver is
+// restricted to the extensions managing the shoot.
+func (b *Botanist) extensionsAccessToKubeAPIServerRestricted() bool {
+	if b.Config == nil || b.Config.Controllers == nil || b.Config.Controllers.NetworkPolicy == nil {
+		return false
+	}
+
+	mode := b.Config.Controllers.NetworkPolicy.ExtensionsAccessToShootAPIServers
+	return mode != nil && *mode == config.ExtensionsAccessModeEnforce
+}
+
 // ShootUsesDNS returns true if the shoot uses internal and external DNS.
 func (b *Botanist) ShootUsesDNS() bool {
 	return b.NeedsInternalDNS() && b.NeedsExternalDNS()
@@ -78,7 +94,22 @@ func (b *Botanist) DeployKubeAPIServerSNI(ctx context.Context) error {
 	return b.Shoot.Components.ControlPlane.KubeAPIServerSNI.Deploy(ctx)
 }
 
+// kubeAPIServerSNIHosts returns the hosts under which the kube-apiserver is exposed via the istio ingress gateway.
+func (b *Botanist) kubeAPIServerSNIHosts() []string {
+	var hosts []string
+	if b.Shoot.ExternalClusterDomain != nil {
+		hosts = append(hosts, gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain))
+	}
+	hosts = append(hosts, gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain))
+	if b.Shoot.PrivateDomain != nil {
+		hosts = append(hosts, gardenerutils.GetAPIServerDomain(b.Shoot.PrivateDomain.Domain))
+	}
+	return hosts
+}
+
 func (b *Botanist) setAPIServerServiceClusterIP(clusterIP string) {
+	hosts := b.kubeAPIServerSNIHosts()
+
 	b.APIServerClusterIP = clusterIP
 	b.Shoot.Components.ControlPlane.KubeAPIServerSNI = kubeapiserverexposure.NewSNI(
 		b.SeedClientSet.Client(),
@@ -87,10 +118,7 @@ func (b *Botanist) setAPIServerServiceClusterIP(clusterIP string) {
 		b.Shoot.SeedNamespace,
 		func() *kubeapiserverexposure.SNIValues {
 			return &kubeapiserverexposure.SNIValues{
-				Hosts: []string{
-					gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain),
-					gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain),
-				},
+				Hosts: hosts,
 				APIServerProxy: &kubeapiserverexposure.APIServerProxy{
 					APIServerClusterIP: clusterIP,
 					NamespaceUID:       b.SeedNamespaceObject.UID,
@@ -104,6 +132,125 @@ func (b *Botanist) setAPIServerServiceClusterIP(clusterIP string) {
 	)
 }
 
+// kubeAPIServerEndpoint returns the endpoint settings of the kube-apiserver configured in the Shoot specification.
+func (b *Botanist) kubeAPIServerEndpoint() *gardencorev1beta1.APIServerEndpoint {
+	if kubeAPIServer := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		return kubeAPIServer.Endpoint
+	}
+	return nil
+}
+
+// kubeAPIServerAllowedSourceRanges returns the CIDRs which are allowed to access the kube-apiserver, i.e., the
+// configured ranges and the egress CIDRs of the worker nodes. It returns nil if the access is not restricted.
+func (b *Botanist) kubeAPIServerAllowedSourceRanges() []string {
+	endpoint := b.kubeAPIServerEndpoint()
+	if endpoint == nil || len(endpoint.AllowedSourceRanges) == 0 {
+		return nil
+	}
+
+	allowedSourceRanges := append([]string{}, endpoint.AllowedSourceRanges...)
+	if !b.Shoot.IsWorkerless {
+		for _, egressCIDR := range b.Shoot.Components.Extensions.Infrastructure.EgressCIDRs() {
+			if !slices.Contains(allowedSourceRanges, egressCIDR) {
+				allowedSourceRanges = append(allowedSourceRanges, egressCIDR)
+			}
+		}
+	}
+	return allowedSourceRanges
+}
+
+// DefaultKubeAPIServerAllowlist returns a deployer for the allowlist of the kube-apiserver.
+func (b *Botanist) DefaultKubeAPIServerAllowlist() component.Deployer {
+	return kubeapiserverexposure.NewAllowlist(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		func() *kubeapiserverexposure.AllowlistValues {
+			return &kubeapiserverexposure.AllowlistValues{
+				Hosts:               b.kubeAPIServerSNIHosts(),
+				AllowedSourceRanges: b.kubeAPIServerAllowedSourceRanges(),
+				IstioIngressGateway: kubeapiserverexposure.IstioIngressGateway{
+					Namespace: b.IstioNamespace(),
+					Labels:    b.IstioLabels(),
+				},
+			}
+		},
+	)
+}
+
+// DefaultKubeAPIServerEndpoint returns a deployer for the dedicated load balancer of the kube-apiserver endpoint which
+// is used if a static IP address is requested.
+func (b *Botanist) DefaultKubeAPIServerEndpoint() component.DeployWaiter {
+	return kubeapiserverexposure.NewLoadBalancer(
+		b.Logger,
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		func() *kubeapiserverexposure.LoadBalancerValues {
+			values := &kubeapiserverexposure.LoadBalancerValues{AllowedSourceRanges: b.kubeAPIServerAllowedSourceRanges()}
+			if endpoint := b.kubeAPIServerEndpoint(); endpoint != nil && endpoint.StaticIP != nil {
+				values.StaticIPAddress = endpoint.StaticIP.Address
+				values.PTRRecord = endpoint.StaticIP.PTRRecord
+			}
+			return values
+		},
+		nil,
+		func(address string) {
+			b.APIServerStaticIP = address
+			if b.NeedsExternalDNS() {
+				b.Shoot.Components.Extensions.ExternalDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(address))
+				b.Shoot.Components.Extensions.ExternalDNSRecord.SetValues([]string{address})
+			}
+		},
+	)
+}
+
+// KubeAPIServerStaticIPRequested returns true if a static IP address is requested for the kube-apiserver endpoint.
+func (b *Botanist) KubeAPIServerStaticIPRequested() bool {
+	endpoint := b.kubeAPIServerEndpoint()
+	return endpoint != nil && endpoint.StaticIP != nil
+}
+
+// DeployKubeAPIServerAllowlist deploys the allowlist of the kube-apiserver. It must be called after the infrastructure
+// has been reconciled since the egress CIDRs of the worker nodes are always allowed.
+func (b *Botanist) DeployKubeAPIServerAllowlist(ctx context.Context) error {
+	return b.Shoot.Components.ControlPlane.KubeAPIServerAllowlist.Deploy(ctx)
+}
+
+// DeployKubeAPIServerEndpoint deploys the dedicated load balancer of the kube-apiserver endpoint if a static IP address
+// is requested, otherwise it destroys it.
+func (b *Botanist) DeployKubeAPIServerEndpoint(ctx context.Context) error {
+	if !b.KubeAPIServerStaticIPRequested() {
+		return b.Shoot.Components.ControlPlane.KubeAPIServerEndpoint.Destroy(ctx)
+	}
+	return b.Shoot.Components.ControlPlane.KubeAPIServerEndpoint.Deploy(ctx)
+}
+
+// WaitUntilKubeAPIServerEndpointIsReady waits until the dedicated load balancer of the kube-apiserver endpoint is
+// ready if a static IP address is requested, otherwise it waits until it is deleted.
+func (b *Botanist) WaitUntilKubeAPIServerEndpointIsReady(ctx context.Context) error {
+	if !b.KubeAPIServerStaticIPRequested() {
+		return b.Shoot.Components.ControlPlane.KubeAPIServerEndpoint.WaitCleanup(ctx)
+	}
+	return b.Shoot.Components.ControlPlane.KubeAPIServerEndpoint.Wait(ctx)
+}
+
+// UpdateKubeAPIServerEndpointStatus reports the allocated static IP address and the applied allowlist of the
+// kube-apiserver endpoint in the Shoot status.
+func (b *Botanist) UpdateKubeAPIServerEndpointStatus(ctx context.Context) error {
+	var status *gardencorev1beta1.APIServerEndpointStatus
+
+	if allowedSourceRanges := b.kubeAPIServerAllowedSourceRanges(); len(allowedSourceRanges) > 0 || b.APIServerStaticIP != "" {
+		status = &gardencorev1beta1.APIServerEndpointStatus{AllowedSourceRanges: allowedSourceRanges}
+		if b.APIServerStaticIP != "" {
+			status.StaticIP = pointer.String(b.APIServerStaticIP)
+		}
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.APIServerEndpoint = status
+		return nil
+	})
+}
+
 // DefaultKubeAPIServerIngress returns a deployer for the kube-apiserver ingress.
 func (b *Botanist) DefaultKubeAPIServerIngress() component.Deployer {
 	return kubeapiserverexposure.NewIngress(
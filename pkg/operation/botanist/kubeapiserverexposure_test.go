@@ -25,11 +25,13 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	mockinfrastructure "github.com/gardener/gardener/pkg/component/extensions/infrastructure/mock"
 	gardenletfeatures "github.com/gardener/gardener/pkg/gardenlet/features"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/garden"
@@ -139,4 +141,70 @@ var _ = Describe("KubeAPIServerExposure", func() {
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(ingress), ingress)).To(BeNotFoundError())
 		})
 	})
+
+	Describe("#KubeAPIServerEndpoint", func() {
+		var (
+			infrastructure *mockinfrastructure.MockInterface
+			service        *corev1.Service
+		)
+
+		BeforeEach(func() {
+			infrastructure = mockinfrastructure.NewMockInterface(ctrl)
+			botanist.Shoot.Components = &shoot.Components{
+				ControlPlane: &shoot.ControlPlane{},
+				Extensions:   &shoot.Extensions{Infrastructure: infrastructure},
+			}
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+							Endpoint: &gardencorev1beta1.APIServerEndpoint{
+								AllowedSourceRanges: []string{"10.0.0.0/8", "1.2.3.4/32"},
+								StaticIP:            &gardencorev1beta1.APIServerStaticIP{Address: pointer.String("5.6.7.8")},
+							},
+						},
+					},
+				},
+			})
+			botanist.Shoot.Components.ControlPlane.KubeAPIServerEndpoint = botanist.DefaultKubeAPIServerEndpoint()
+
+			service = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "kube-apiserver-endpoint",
+					Namespace: namespace,
+				},
+			}
+		})
+
+		It("should allow the configured ranges and the egress CIDRs of the worker nodes", func() {
+			infrastructure.EXPECT().EgressCIDRs().Return([]string{"1.2.3.4/32", "9.9.9.9/32"})
+
+			Expect(botanist.kubeAPIServerAllowedSourceRanges()).To(Equal([]string{"10.0.0.0/8", "1.2.3.4/32", "9.9.9.9/32"}))
+		})
+
+		It("should not restrict the access if no ranges are configured", func() {
+			botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer.Endpoint.AllowedSourceRanges = nil
+
+			Expect(botanist.kubeAPIServerAllowedSourceRanges()).To(BeNil())
+		})
+
+		It("should deploy the load balancer if a static IP address is requested", func() {
+			infrastructure.EXPECT().EgressCIDRs().Return([]string{"9.9.9.9/32"})
+
+			Expect(botanist.DeployKubeAPIServerEndpoint(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue("endpoint.kube-apiserver.gardener.cloud/static-ip", "5.6.7.8"))
+			Expect(service.Spec.LoadBalancerSourceRanges).To(Equal([]string{"10.0.0.0/8", "1.2.3.4/32", "9.9.9.9/32"}))
+		})
+
+		It("should delete the load balancer if no static IP address is requested", func() {
+			Expect(c.Create(ctx, service)).To(Succeed())
+			botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer.Endpoint.StaticIP = nil
+
+			Expect(botanist.DeployKubeAPIServerEndpoint(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(service), service)).To(BeNotFoundError())
+		})
+	})
 })
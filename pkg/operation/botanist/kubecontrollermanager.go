@@ -20,6 +20,7 @@ import (
 
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -27,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/features"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // DefaultKubeControllerManager returns a deployer for the kube-controller-manager.
@@ -53,10 +55,10 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		b.Shoot.SeedNamespace,
 		b.Seed.KubernetesVersion,
 		b.Shoot.KubernetesVersion,
-		b.SecretsManager,
+		secretsmanager.WithConsumer(b.SecretsManager, "kube-controller-manager"),
 		"",
 		b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager,
-		v1beta1constants.PriorityClassNameShootControlPlane300,
+		pointer.StringDeref(b.controlPlanePriorityTier().KubeControllerManager, v1beta1constants.PriorityClassNameShootControlPlane300),
 		b.Shoot.IsWorkerless,
 		&kubecontrollermanager.HVPAConfig{
 			Enabled:             hvpaEnabled,
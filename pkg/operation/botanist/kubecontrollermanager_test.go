@@ -72,6 +72,7 @@ var _ = Describe("KubeControllerManager", func() {
 			botanist.Seed = &seedpkg.Seed{
 				KubernetesVersion: semver.MustParse("1.25.0"),
 			}
+			botanist.Seed.SetInfo(&gardencorev1beta1.Seed{})
 			botanist.Shoot = &shootpkg.Shoot{
 				KubernetesVersion: semver.MustParse("1.25.0"),
 				Networks:          &shootpkg.Networks{},
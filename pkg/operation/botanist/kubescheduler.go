@@ -18,6 +18,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component/kubescheduler"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // DefaultKubeScheduler returns a deployer for the kube-scheduler.
@@ -30,7 +31,7 @@ func (b *Botanist) DefaultKubeScheduler() (kubescheduler.Interface, error) {
 	return kubescheduler.New(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
-		b.SecretsManager,
+		secretsmanager.WithConsumer(b.SecretsManager, "kube-scheduler"),
 		b.Shoot.KubernetesVersion,
 		image.String(),
 		b.Shoot.GetReplicas(1),
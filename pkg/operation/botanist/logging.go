@@ -16,9 +16,11 @@ package botanist
 
 import (
 	"context"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/gardener/imagevector"
@@ -123,6 +125,15 @@ func (b *Botanist) isShootNodeLoggingEnabled() bool {
 	return false
 }
 
+func (b *Botanist) isShootLoggingQueryAPIEnabled() bool {
+	if b.Shoot == nil || !b.Shoot.IsShootControlPlaneLoggingEnabled(b.Config) || !gardenlethelper.IsValiEnabled(b.Config) {
+		return false
+	}
+
+	logging := b.Shoot.GetInfo().Spec.Logging
+	return logging != nil && logging.QueryAPI != nil && logging.QueryAPI.Enabled
+}
+
 func (b *Botanist) isShootEventLoggerEnabled() bool {
 	return b.Shoot != nil && b.Shoot.IsShootControlPlaneLoggingEnabled(b.Config) && gardenlethelper.IsEventLoggingEnabled(b.Config)
 }
@@ -152,6 +163,18 @@ func (b *Botanist) DefaultVali() (vali.Interface, error) {
 		hvpaEnabled = features.DefaultFeatureGate.Enabled(features.HVPAForShootedSeed)
 	}
 
+	var (
+		storage         *resource.Quantity
+		retentionPeriod *time.Duration
+	)
+
+	if logging := b.Shoot.GetInfo().Spec.Logging; logging != nil {
+		storage = logging.StorageQuota
+		if logging.Retention != nil {
+			retentionPeriod = &logging.Retention.Duration
+		}
+	}
+
 	return shared.NewVali(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
@@ -159,8 +182,10 @@ func (b *Botanist) DefaultVali() (vali.Interface, error) {
 		component.ClusterTypeShoot,
 		b.Shoot.GetReplicas(1),
 		b.isShootNodeLoggingEnabled(),
+		b.isShootLoggingQueryAPIEnabled(),
 		v1beta1constants.PriorityClassNameShootControlPlane100,
-		nil,
+		storage,
+		retentionPeriod,
 		b.ComputeValiHost(),
 		hvpaEnabled,
 		nil,
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/machinecontrollermanager"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // DefaultMachineControllerManager returns a deployer for the machine-controller-manager.
@@ -70,7 +71,7 @@ func (b *Botanist) DefaultMachineControllerManager(ctx context.Context) (machine
 	return machinecontrollermanager.New(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
-		b.SecretsManager,
+		secretsmanager.WithConsumer(b.SecretsManager, "machine-controller-manager"),
 		machinecontrollermanager.Values{
 			Image:                    image.String(),
 			Replicas:                 replicas,
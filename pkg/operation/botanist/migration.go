@@ -79,9 +79,9 @@ func (b *Botanist) runParallelTaskForEachComponent(ctx context.Context, componen
 	return flow.Parallel(fns...)(ctx)
 }
 
-// IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds.
+// IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds or from the shoot to clone from.
 func (b *Botanist) IsCopyOfBackupsRequired(ctx context.Context) (bool, error) {
-	if b.Seed.GetInfo().Spec.Backup == nil || !b.IsRestorePhase() {
+	if b.Seed.GetInfo().Spec.Backup == nil || (!b.IsRestorePhase() && !b.IsCloningPhase()) {
 		return false, nil
 	}
 
@@ -92,6 +92,13 @@ func (b *Botanist) IsCopyOfBackupsRequired(ctx context.Context) (bool, error) {
 		return false, nil
 	}
 
+	// When cloning, the backups of the source shoot are always copied as long as the etcd-main Etcd resource does not
+	// exist yet. The source BackupEntry points to the bucket of the source shoot and not to the shoot's original
+	// BackupEntry, hence the checks below do not apply.
+	if b.IsCloningPhase() {
+		return true, nil
+	}
+
 	backupEntry, err := b.Shoot.Components.BackupEntry.Get(ctx)
 	if err != nil {
 		return false, fmt.Errorf("error while retrieving BackupEntry: %w", err)
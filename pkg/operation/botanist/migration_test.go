@@ -271,6 +271,27 @@ var _ = Describe("migration", func() {
 
 		})
 
+		Context("Shoot is being cloned", func() {
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Annotations = map[string]string{"shoot.gardener.cloud/clone-from": "source"}
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+			})
+
+			It("should return false if etcd main resource has been deployed", func() {
+				etcdMain.EXPECT().Get(ctx)
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeFalse())
+			})
+
+			It("should return true if etcd main resource has not been deployed yet", func() {
+				etcdMain.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "etcd-main"))
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeTrue())
+			})
+		})
+
 		Context("Last operation is restore and etcd main does not exist", func() {
 			BeforeEach(func() {
 				etcdMain.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "etcd-main"))
@@ -16,14 +16,20 @@ package botanist
 
 import (
 	"context"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/monitoring"
+	"github.com/gardener/gardener/pkg/component/monitoring/authproxy"
+	"github.com/gardener/gardener/pkg/component/monitoring/gateway"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -52,9 +58,17 @@ func (b *Botanist) DefaultMonitoring() (monitoring.Interface, error) {
 		alertingSecrets = append(alertingSecrets, b.LoadSecret(key))
 	}
 
+	var projectOwner string
+	if b.Garden.Project != nil && b.Garden.Project.Spec.Owner != nil {
+		projectOwner = b.Garden.Project.Spec.Owner.Name
+	}
+
 	values := monitoring.Values{
+		AlertForwardingWebhooks:      gardenlethelper.GetShootAlertForwardingWebhooks(b.Config, b.Shoot.Purpose),
 		AlertingSecrets:              alertingSecrets,
 		AlertmanagerEnabled:          b.Shoot.WantsAlertmanager,
+		AuthProxyEnabled:             gardenlethelper.GetShootMonitoringSSOConfig(b.Config) != nil,
+		GatewayHost:                  b.ComputeMonitoringGatewayHost(),
 		APIServerDomain:              gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain),
 		APIServerHost:                b.SeedClientSet.RESTConfig().Host,
 		Config:                       b.Config.Monitoring,
@@ -71,9 +85,13 @@ func (b *Botanist) DefaultMonitoring() (monitoring.Interface, error) {
 		MonitoringConfig:             b.Shoot.GetInfo().Spec.Monitoring,
 		NodeLocalDNSEnabled:          b.Shoot.NodeLocalDNSEnabled,
 		ProjectName:                  b.Garden.Project.Name,
+		ProjectOwner:                 projectOwner,
+		PrometheusAgent:              gardenlethelper.GetShootPrometheusAgentConfig(b.Config),
 		Replicas:                     b.Shoot.GetReplicas(1),
 		RuntimeProviderType:          b.Seed.GetInfo().Spec.Provider.Type,
 		RuntimeRegion:                b.Seed.GetInfo().Spec.Provider.Region,
+		SeedName:                     b.Seed.GetInfo().Name,
+		ShootPurpose:                 b.Shoot.Purpose,
 		StorageCapacityAlertmanager:  b.Seed.GetValidVolumeSize("1Gi"),
 		TargetName:                   b.Shoot.GetInfo().Name,
 		TargetProviderType:           b.Shoot.GetInfo().Spec.Provider.Type,
@@ -105,6 +123,86 @@ func (b *Botanist) DefaultMonitoring() (monitoring.Interface, error) {
 	), nil
 }
 
+// DefaultMonitoringAuthProxy creates a new deployer for the auth proxy protecting the observability ingresses with
+// single sign-on.
+func (b *Botanist) DefaultMonitoringAuthProxy() (component.DeployWaiter, error) {
+	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameOauth2Proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	values := authproxy.Values{
+		Image:             image.String(),
+		Replicas:          b.Shoot.GetReplicas(1),
+		PriorityClassName: v1beta1constants.PriorityClassNameShootControlPlane100,
+	}
+
+	if sso := gardenlethelper.GetShootMonitoringSSOConfig(b.Config); sso != nil {
+		values.IssuerURL = sso.IssuerURL
+		values.ClientID = sso.ClientID
+		values.ClientSecretRef = sso.ClientSecretRef
+		values.GroupsClaim = pointer.StringDeref(sso.GroupsClaim, "groups")
+		values.CookieRefresh = time.Hour
+		if sso.CookieRefresh != nil {
+			values.CookieRefresh = sso.CookieRefresh.Duration
+		}
+		values.BreakGlassBasicAuth = pointer.BoolDeref(sso.BreakGlassBasicAuth, true)
+		values.AllowedGroups = projectMemberGroups(b.Garden.Project)
+	}
+
+	return authproxy.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		b.SecretsManager,
+		values,
+	), nil
+}
+
+// DefaultMonitoringGatewayRoutes creates a new deployer for the routes of the shared observability gateway of the seed
+// to the observability endpoints of the shoot.
+func (b *Botanist) DefaultMonitoringGatewayRoutes() component.DeployWaiter {
+	routes := []gateway.Route{
+		{Name: "plutono", ServiceName: "plutono", Port: 3000},
+		{Name: "prometheus", ServiceName: "prometheus-web", Port: 80},
+	}
+	if b.Shoot.WantsAlertmanager {
+		routes = append(routes, gateway.Route{Name: "alertmanager", ServiceName: "alertmanager-client", Port: 9093})
+	}
+
+	return gateway.NewRoutes(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		gateway.RoutesValues{
+			Host:               b.Seed.GetIngressFQDN(gateway.SubDomain),
+			AuthProxyNamespace: v1beta1constants.GardenNamespace,
+			AllowedGroups:      projectMemberGroups(b.Garden.Project),
+			Routes:             routes,
+		},
+	)
+}
+
+// ComputeMonitoringGatewayHost returns the host of the shared observability gateway of the seed if it serves the
+// observability endpoints of the shoot, otherwise it returns an empty string.
+func (b *Botanist) ComputeMonitoringGatewayHost() string {
+	if !gardenlethelper.IsShootMonitoringSharedGatewayEnabled(b.Config) {
+		return ""
+	}
+	return b.Seed.GetIngressFQDN(gateway.SubDomain)
+}
+
+// projectMemberGroups returns the sorted names of all groups which are members of the given project.
+func projectMemberGroups(project *gardencorev1beta1.Project) []string {
+	groups := sets.New[string]()
+	if project != nil {
+		for _, member := range project.Spec.Members {
+			if member.Kind == rbacv1.GroupKind {
+				groups.Insert(member.Name)
+			}
+		}
+	}
+	return sets.List(groups)
+}
+
 // DeployMonitoring installs the Helm release "seed-monitoring" in the Seed clusters. It comprises components
 // to monitor the Shoot cluster whose control plane runs in the Seed cluster.
 func (b *Botanist) DeployMonitoring(ctx context.Context) error {
@@ -17,6 +17,9 @@ package botanist
 import (
 	"context"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/extensions/network"
@@ -56,3 +59,23 @@ func (b *Botanist) DeployNetwork(ctx context.Context) error {
 
 	return b.Shoot.Components.Extensions.Network.Deploy(ctx)
 }
+
+// IsNetworkCIDRExpansionPending returns true if the pod or service network of the Shoot was expanded, i.e., if the CIDRs
+// of the existing Network resource differ from the ones in the Shoot specification. In this case, the infrastructure has
+// to be reconciled before the control plane components and the network plugin pick up the new CIDRs.
+func (b *Botanist) IsNetworkCIDRExpansionPending(ctx context.Context) (bool, error) {
+	if b.Shoot.IsWorkerless {
+		return false, nil
+	}
+
+	network := &extensionsv1alpha1.Network{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: b.Shoot.GetInfo().Name}, network); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return (b.Shoot.Networks.Pods != nil && network.Spec.PodCIDR != b.Shoot.Networks.Pods.String()) ||
+		(b.Shoot.Networks.Services != nil && network.Spec.ServiceCIDR != b.Shoot.Networks.Services.String()), nil
+}
@@ -17,12 +17,19 @@ package botanist_test
 import (
 	"context"
 	"fmt"
+	"net"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
 	"github.com/gardener/gardener/pkg/operation"
 	. "github.com/gardener/gardener/pkg/operation/botanist"
@@ -95,4 +102,60 @@ var _ = Describe("Network", func() {
 			})
 		})
 	})
+
+	Describe("#IsNetworkCIDRExpansionPending", func() {
+		var (
+			seedClient client.Client
+			network    *extensionsv1alpha1.Network
+		)
+
+		BeforeEach(func() {
+			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build()
+
+			_, podCIDR, _ := net.ParseCIDR("100.96.0.0/11")
+			_, serviceCIDR, _ := net.ParseCIDR("100.64.0.0/13")
+			botanist.Shoot.SeedNamespace = "shoot--foo--bar"
+			botanist.Shoot.Networks = &shootpkg.Networks{Pods: podCIDR, Services: serviceCIDR}
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar"}})
+
+			network = &extensionsv1alpha1.Network{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "shoot--foo--bar"},
+				Spec: extensionsv1alpha1.NetworkSpec{
+					PodCIDR:     "100.96.0.0/11",
+					ServiceCIDR: "100.64.0.0/13",
+				},
+			}
+		})
+
+		It("should return false if the shoot is workerless", func() {
+			botanist.Shoot.IsWorkerless = true
+
+			Expect(botanist.IsNetworkCIDRExpansionPending(ctx)).To(BeFalse())
+		})
+
+		It("should return false if the network does not exist yet", func() {
+			Expect(botanist.IsNetworkCIDRExpansionPending(ctx)).To(BeFalse())
+		})
+
+		It("should return false if the network CIDRs are unchanged", func() {
+			Expect(seedClient.Create(ctx, network)).To(Succeed())
+
+			Expect(botanist.IsNetworkCIDRExpansionPending(ctx)).To(BeFalse())
+		})
+
+		It("should return true if the pod network was expanded", func() {
+			network.Spec.PodCIDR = "100.96.0.0/12"
+			Expect(seedClient.Create(ctx, network)).To(Succeed())
+
+			Expect(botanist.IsNetworkCIDRExpansionPending(ctx)).To(BeTrue())
+		})
+
+		It("should return true if the service network was expanded", func() {
+			network.Spec.ServiceCIDR = "100.64.0.0/14"
+			Expect(seedClient.Create(ctx, network)).To(Succeed())
+
+			Expect(botanist.IsNetworkCIDRExpansionPending(ctx)).To(BeTrue())
+		})
+	})
 })
@@ -99,6 +99,7 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 				ValitailEnabled:     valitailEnabled,
 				ValiIngressHostName: valiIngressHost,
 				NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+				ProjectName:         b.Garden.Project.Name,
 				SyncJitterPeriod:    b.Shoot.OSCSyncJitterPeriod,
 			},
 		},
@@ -151,6 +152,10 @@ func (b *Botanist) getOperatingSystemConfigCABundle(clusterCABundle []byte) *str
 		caBundle = fmt.Sprintf("%s\n%s", caBundle, clusterCABundle)
 	}
 
+	for _, trustedCABundle := range b.Shoot.GetInfo().Spec.TrustedCABundles {
+		caBundle = fmt.Sprintf("%s\n%s", caBundle, trustedCABundle.CABundle)
+	}
+
 	if caBundle == "" {
 		return nil
 	}
@@ -193,7 +198,7 @@ func (b *Botanist) deployManagedResourceForOperatingSystemConfig(
 	ctx context.Context,
 	managedResourceName string,
 	dataKeySecretNamePrefix string,
-	generateSecretDataForWorkerFunc func(context.Context, gardencorev1beta1.Worker, operatingsystemconfig.Data) (string, map[string][]byte, error),
+	generateSecretDataForWorkerFunc func(context.Context, gardencorev1beta1.Worker, operatingsystemconfig.Data) ([]string, map[string][]byte, error),
 	dataKeyRBACResources string,
 	generateRBACResourcesDataFunc func([]string) (map[string][]byte, error),
 ) error {
@@ -217,12 +222,12 @@ func (b *Botanist) deployManagedResourceForOperatingSystemConfig(
 			return fmt.Errorf("did not find osc data for worker pool %q", worker.Name)
 		}
 
-		secretName, data, err := generateSecretDataForWorkerFunc(ctx, worker, oscData.Original)
+		workerSecretNames, data, err := generateSecretDataForWorkerFunc(ctx, worker, oscData.Original)
 		if err != nil {
 			return err
 		}
 
-		secretNames = append(secretNames, secretName)
+		secretNames = append(secretNames, workerSecretNames...)
 		managedResourceSecretNameToData[dataKeySecretNamePrefix+worker.Name] = data
 	}
 
@@ -283,18 +288,18 @@ func (b *Botanist) generateCloudConfigExecutorResourcesForWorker(
 	worker gardencorev1beta1.Worker,
 	oscDataOriginal operatingsystemconfig.Data,
 ) (
-	string,
+	[]string,
 	map[string][]byte,
 	error,
 ) {
 	kubernetesVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(b.Shoot.KubernetesVersion, worker.Kubernetes)
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 
 	hyperkubeImage, err := imagevector.ImageVector().FindImage(imagevector.ImageNameHyperkube, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()))
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 
 	var (
@@ -325,15 +330,15 @@ func (b *Botanist) generateCloudConfigExecutorResourcesForWorker(
 		oscDataOriginal.Files,
 	)
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 
 	resources, err := registry.AddAllAndSerialize(executor.Secret(secretName, metav1.NamespaceSystem, worker.Name, executorScript))
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 
-	return secretName, resources, nil
+	return []string{secretName}, resources, nil
 }
 
 // GardenerNodeAgentManagedResourceName is a constant for the name of a ManagedResource in the seed cluster in the shoot
@@ -342,6 +347,7 @@ const GardenerNodeAgentManagedResourceName = "shoot-gardener-node-agent"
 
 // DeployManagedResourceForGardenerNodeAgent creates the ManagedResource that contains:
 // - A secret containing the raw original OperatingSystemConfig for each worker pool.
+// - Secrets containing the content of files which are referenced via secrets in the OperatingSystemConfigs.
 // - A secret containing some shared RBAC resources for downloading the OSC secrets + bootstrapping the node.
 func (b *Botanist) DeployManagedResourceForGardenerNodeAgent(ctx context.Context) error {
 	return b.deployManagedResourceForOperatingSystemConfig(
@@ -357,27 +363,37 @@ func (b *Botanist) generateOperatingSystemConfigSecretForWorker(
 	worker gardencorev1beta1.Worker,
 	oscDataOriginal operatingsystemconfig.Data,
 ) (
-	string,
+	[]string,
 	map[string][]byte,
 	error,
 ) {
 	kubernetesVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(b.Shoot.KubernetesVersion, worker.Kubernetes)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed computing the effective Kubernetes version for pool %q: %w", worker.Name, err)
+		return nil, nil, fmt.Errorf("failed computing the effective Kubernetes version for pool %q: %w", worker.Name, err)
 	}
 	secretName := operatingsystemconfig.Key(worker.Name, kubernetesVersion, worker.CRI)
 
-	oscSecret, err := NodeAgentOSCSecretFn(ctx, b.SeedClientSet.Client(), oscDataOriginal.Object, secretName, worker.Name)
+	oscSecret, fileContentSecrets, err := NodeAgentOSCSecretFn(ctx, b.SeedClientSet.Client(), oscDataOriginal.Object, secretName, worker.Name)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed computing the OperatingSystemConfig secret for gardener-node-agent for pool %q: %w", worker.Name, err)
+		return nil, nil, fmt.Errorf("failed computing the OperatingSystemConfig secret for gardener-node-agent for pool %q: %w", worker.Name, err)
+	}
+
+	var (
+		secretNames = []string{oscSecret.Name}
+		objects     = []client.Object{oscSecret}
+	)
+
+	for _, fileContentSecret := range fileContentSecrets {
+		secretNames = append(secretNames, fileContentSecret.Name)
+		objects = append(objects, fileContentSecret)
 	}
 
 	resources, err := managedresources.
 		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
-		AddAllAndSerialize(oscSecret)
+		AddAllAndSerialize(objects...)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed adding gardener-node-agent secret for pool %q to the registry and serializing it: %w", worker.Name, err)
+		return nil, nil, fmt.Errorf("failed adding gardener-node-agent secrets for pool %q to the registry and serializing them: %w", worker.Name, err)
 	}
 
-	return oscSecret.Name, resources, nil
+	return secretNames, resources, nil
 }
@@ -151,6 +151,20 @@ var _ = Describe("operatingsystemconfig", func() {
 				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
 			})
 
+			It("should deploy successfully (CloudProfile CA and trusted CA bundles of the shoot)", func() {
+				botanist.Shoot.CloudProfile.Spec.CABundle = &caCloudProfile
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.TrustedCABundles = []gardencorev1beta1.TrustedCABundle{
+					{Name: "corporate-ca", CABundle: "corporate-ca-bundle"},
+					{Name: "corporate-ca-new", CABundle: "corporate-ca-new-bundle"},
+				}
+				botanist.Shoot.SetInfo(shoot)
+				operatingSystemConfig.EXPECT().SetCABundle(pointer.String(caCloudProfile + "\ncorporate-ca-bundle\ncorporate-ca-new-bundle"))
+
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+
 			It("should deploy successfully shoot logging components with non testing purpose", func() {
 				botanist.Shoot.Purpose = "development"
 				botanist.Config = &config.GardenletConfiguration{
@@ -572,8 +586,8 @@ metadata:
 				})
 
 				It("should fail because the secret data generation function fails", func() {
-					DeferCleanup(test.WithVar(&NodeAgentOSCSecretFn, func(context.Context, client.Client, *extensionsv1alpha1.OperatingSystemConfig, string, string) (*corev1.Secret, error) {
-						return nil, fakeErr
+					DeferCleanup(test.WithVar(&NodeAgentOSCSecretFn, func(context.Context, client.Client, *extensionsv1alpha1.OperatingSystemConfig, string, string) (*corev1.Secret, []*corev1.Secret, error) {
+						return nil, nil, fakeErr
 					}))
 
 					Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(MatchError(fakeErr))
@@ -603,7 +617,7 @@ metadata:
 					By("Execute DeployManagedResourceForGardenerNodeAgent function")
 					Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(Succeed())
 
-					expectedOSCSecretWorker1, err := NodeAgentOSCSecretFn(ctx, fakeClient, workerNameToOperatingSystemConfigMaps[worker1Name].Original.Object, worker1Key, worker1Name)
+					expectedOSCSecretWorker1, _, err := NodeAgentOSCSecretFn(ctx, fakeClient, workerNameToOperatingSystemConfigMaps[worker1Name].Original.Object, worker1Key, worker1Name)
 					Expect(err).NotTo(HaveOccurred())
 					expectedOSCSecretWorker1Raw, err := runtime.Encode(codec, expectedOSCSecretWorker1)
 					Expect(err).NotTo(HaveOccurred())
@@ -623,7 +637,7 @@ metadata:
 					}
 					utilruntime.Must(kubernetesutils.MakeUnique(expectedMRSecretWorker1))
 
-					expectedOSCSecretWorker2, err := NodeAgentOSCSecretFn(ctx, fakeClient, workerNameToOperatingSystemConfigMaps[worker2Name].Original.Object, worker2Key, worker2Name)
+					expectedOSCSecretWorker2, _, err := NodeAgentOSCSecretFn(ctx, fakeClient, workerNameToOperatingSystemConfigMaps[worker2Name].Original.Object, worker2Key, worker2Name)
 					Expect(err).NotTo(HaveOccurred())
 					expectedOSCSecretWorker2Raw, err := runtime.Encode(codec, expectedOSCSecretWorker2)
 					Expect(err).NotTo(HaveOccurred())
@@ -24,8 +24,10 @@ import (
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/monitoring/gateway"
 	"github.com/gardener/gardener/pkg/component/plutono"
 	"github.com/gardener/gardener/pkg/component/shared"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/operation/common"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -49,6 +51,7 @@ func (b *Botanist) DefaultPlutono() (plutono.Interface, error) {
 		b.Shoot.VPNHighAvailabilityEnabled,
 		b.Shoot.WantsVerticalPodAutoscaler,
 		nil,
+		gardenlethelper.GetMonitoringCustomizations(b.Config),
 	)
 }
 
@@ -63,6 +66,14 @@ func (b *Botanist) DeployPlutono(ctx context.Context) error {
 			return err
 		}
 
+		if err := b.Shoot.Components.Monitoring.AuthProxy.Destroy(ctx); err != nil {
+			return err
+		}
+
+		if err := b.Shoot.Components.Monitoring.GatewayRoutes.Destroy(ctx); err != nil {
+			return err
+		}
+
 		secretName := gardenerutils.ComputeShootProjectSecretName(b.Shoot.GetInfo().Name, gardenerutils.ShootProjectSecretSuffixMonitoring)
 		return kubernetesutils.DeleteObject(ctx, b.GardenClient, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: b.Shoot.GetInfo().Namespace}})
 	}
@@ -72,20 +83,61 @@ func (b *Botanist) DeployPlutono(ctx context.Context) error {
 		return err
 	}
 
+	var (
+		sso         = gardenlethelper.GetShootMonitoringSSOConfig(b.Config)
+		gatewayHost = b.ComputeMonitoringGatewayHost()
+		plutonoURL  = "https://" + b.ComputePlutonoHost()
+	)
+
+	if gatewayHost != "" {
+		plutonoURL = gateway.URL(gatewayHost, b.Shoot.SeedNamespace, "plutono")
+	}
+
+	b.Shoot.Components.ControlPlane.Plutono.SetAuthProxyEnabled(sso != nil)
+	if gatewayHost != "" {
+		b.Shoot.Components.ControlPlane.Plutono.SetGatewayURL(plutonoURL)
+	}
+
 	if err := b.Shoot.Components.ControlPlane.Plutono.Deploy(ctx); err != nil {
 		return err
 	}
 
+	// The auth proxy uses the credentials of the observability ingress users for the break-glass basic authentication,
+	// hence it is deployed after plutono which generates them. If the shared gateway of the seed is used, the requests
+	// are authenticated by its auth proxy instead.
+	if sso != nil && gatewayHost == "" {
+		if err := b.Shoot.Components.Monitoring.AuthProxy.Deploy(ctx); err != nil {
+			return err
+		}
+	} else if err := b.Shoot.Components.Monitoring.AuthProxy.Destroy(ctx); err != nil {
+		return err
+	}
+
+	if gatewayHost != "" {
+		if err := b.Shoot.Components.Monitoring.GatewayRoutes.Deploy(ctx); err != nil {
+			return err
+		}
+	} else if err := b.Shoot.Components.Monitoring.GatewayRoutes.Destroy(ctx); err != nil {
+		return err
+	}
+
 	credentialsSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameObservabilityIngressUsers)
 	if !found {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameObservabilityIngressUsers)
 	}
 
+	// The credentials are only handed out to the project members if they are accepted by the observability ingresses.
+	// The shared gateway of the seed does not support the break-glass basic authentication.
+	data := credentialsSecret.Data
+	if sso != nil && (!pointer.BoolDeref(sso.BreakGlassBasicAuth, true) || gatewayHost != "") {
+		data = nil
+	}
+
 	return b.syncShootCredentialToGarden(
 		ctx,
 		gardenerutils.ShootProjectSecretSuffixMonitoring,
 		map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleMonitoring},
-		map[string]string{"url": "https://" + b.ComputePlutonoHost()},
-		credentialsSecret.Data,
+		map[string]string{"url": plutonoURL},
+		data,
 	)
 }
@@ -31,6 +31,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
 	mockplutono "github.com/gardener/gardener/pkg/component/plutono/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/operation"
@@ -54,7 +55,9 @@ var _ = Describe("Plutono", func() {
 
 		sm secretsmanager.Interface
 
-		mockPlutono *mockplutono.MockInterface
+		mockPlutono   *mockplutono.MockInterface
+		mockAuthProxy *mockcomponent.MockDeployWaiter
+		mockRoutes    *mockcomponent.MockDeployWaiter
 
 		botanist *Botanist
 
@@ -91,6 +94,8 @@ var _ = Describe("Plutono", func() {
 		})).To(Succeed())
 
 		mockPlutono = mockplutono.NewMockInterface(ctrl)
+		mockAuthProxy = mockcomponent.NewMockDeployWaiter(ctrl)
+		mockRoutes = mockcomponent.NewMockDeployWaiter(ctrl)
 
 		botanist = &Botanist{
 			Operation: &operation.Operation{
@@ -113,6 +118,10 @@ var _ = Describe("Plutono", func() {
 						ControlPlane: &shootpkg.ControlPlane{
 							Plutono: mockPlutono,
 						},
+						Monitoring: &shootpkg.Monitoring{
+							AuthProxy:     mockAuthProxy,
+							GatewayRoutes: mockRoutes,
+						},
 					},
 				},
 			},
@@ -145,7 +154,10 @@ var _ = Describe("Plutono", func() {
 	Describe("#DeployPlutono", func() {
 		It("should successfully deploy plutono sync the ingress credentials for the users observability to the garden project namespace", func() {
 			Expect(gardenClient.Get(ctx, kubernetesutils.Key(projectNamespace, shootName+".monitoring"), &corev1.Secret{})).To(BeNotFoundError())
+			mockPlutono.EXPECT().SetAuthProxyEnabled(false)
 			mockPlutono.EXPECT().Deploy(ctx)
+			mockAuthProxy.EXPECT().Destroy(ctx)
+			mockRoutes.EXPECT().Destroy(ctx)
 			Expect(botanist.DeployPlutono(ctx)).To(Succeed())
 
 			secret := &corev1.Secret{}
@@ -157,16 +169,79 @@ var _ = Describe("Plutono", func() {
 
 		It("should cleanup the secrets when shoot purpose is changed", func() {
 			Expect(gardenClient.Get(ctx, kubernetesutils.Key(projectNamespace, shootName+".monitoring"), &corev1.Secret{})).To(BeNotFoundError())
+			mockPlutono.EXPECT().SetAuthProxyEnabled(false)
 			mockPlutono.EXPECT().Deploy(ctx)
+			mockAuthProxy.EXPECT().Destroy(ctx)
+			mockRoutes.EXPECT().Destroy(ctx)
 			Expect(botanist.DeployPlutono(ctx)).To(Succeed())
 			Expect(gardenClient.Get(ctx, kubernetesutils.Key(projectNamespace, shootName+".monitoring"), &corev1.Secret{})).To(Succeed())
 			Expect(*botanist.Shoot.GetInfo().Spec.Purpose).To(Equal(shootPurposeEvaluation))
 
 			botanist.Shoot.Purpose = shootPurposeTesting
 			mockPlutono.EXPECT().Destroy(ctx)
+			mockAuthProxy.EXPECT().Destroy(ctx)
+			mockRoutes.EXPECT().Destroy(ctx)
 			Expect(botanist.DeployPlutono(ctx)).To(Succeed())
 
 			Expect(gardenClient.Get(ctx, kubernetesutils.Key(projectNamespace, shootName+".monitoring"), &corev1.Secret{})).To(BeNotFoundError())
 		})
+
+		Context("with single sign-on", func() {
+			BeforeEach(func() {
+				botanist.Config.Monitoring = &config.MonitoringConfig{
+					Shoot: &config.ShootMonitoringConfig{
+						SSO: &config.ShootMonitoringSSOConfig{BreakGlassBasicAuth: pointer.Bool(true)},
+					},
+				}
+			})
+
+			It("should deploy the auth proxy and sync the break-glass credentials", func() {
+				mockPlutono.EXPECT().SetAuthProxyEnabled(true)
+				mockPlutono.EXPECT().Deploy(ctx)
+				mockAuthProxy.EXPECT().Deploy(ctx)
+				mockRoutes.EXPECT().Destroy(ctx)
+				Expect(botanist.DeployPlutono(ctx)).To(Succeed())
+
+				secret := &corev1.Secret{}
+				Expect(gardenClient.Get(ctx, kubernetesutils.Key(projectNamespace, shootName+".monitoring"), secret)).To(Succeed())
+				Expect(secret.Data).To(And(HaveKey("username"), HaveKey("password"), HaveKey("auth")))
+			})
+
+			It("should not sync the credentials if break-glass basic authentication is disabled", func() {
+				botanist.Config.Monitoring.Shoot.SSO.BreakGlassBasicAuth = pointer.Bool(false)
+
+				mockPlutono.EXPECT().SetAuthProxyEnabled(true)
+				mockPlutono.EXPECT().Deploy(ctx)
+				mockAuthProxy.EXPECT().Deploy(ctx)
+				mockRoutes.EXPECT().Destroy(ctx)
+				Expect(botanist.DeployPlutono(ctx)).To(Succeed())
+
+				secret := &corev1.Secret{}
+				Expect(gardenClient.Get(ctx, kubernetesutils.Key(projectNamespace, shootName+".monitoring"), secret)).To(Succeed())
+				Expect(secret.Annotations).To(HaveKeyWithValue("url", "https://gu-foo--bar."))
+				Expect(secret.Data).To(BeEmpty())
+			})
+
+			Context("with shared gateway", func() {
+				BeforeEach(func() {
+					botanist.Config.Monitoring.Shoot.SSO.SharedGateway = pointer.Bool(true)
+					botanist.Seed.GetInfo().Spec.Ingress = &gardencorev1beta1.Ingress{Domain: "seed.example.com"}
+				})
+
+				It("should deploy the routes instead of the auth proxy and only sync the URL", func() {
+					mockPlutono.EXPECT().SetAuthProxyEnabled(true)
+					mockPlutono.EXPECT().SetGatewayURL("https://observability.seed.example.com/shoot--foo--bar/plutono/")
+					mockPlutono.EXPECT().Deploy(ctx)
+					mockAuthProxy.EXPECT().Destroy(ctx)
+					mockRoutes.EXPECT().Deploy(ctx)
+					Expect(botanist.DeployPlutono(ctx)).To(Succeed())
+
+					secret := &corev1.Secret{}
+					Expect(gardenClient.Get(ctx, kubernetesutils.Key(projectNamespace, shootName+".monitoring"), secret)).To(Succeed())
+					Expect(secret.Annotations).To(HaveKeyWithValue("url", "https://observability.seed.example.com/shoot--foo--bar/plutono/"))
+					Expect(secret.Data).To(BeEmpty())
+				})
+			})
+		})
 	})
 })
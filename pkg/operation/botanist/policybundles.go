@@ -0,0 +1,98 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// ManagedResourceNamePrefixPolicyBundle is the prefix for the names of the ManagedResources deploying policy bundles to
+// the shoot cluster.
+const ManagedResourceNamePrefixPolicyBundle = "policy-bundle-"
+
+// DeployPolicyBundles deploys the policy bundles which apply to the shoot via ManagedResources. The deployed version of
+// each bundle is either pinned via annotation on the Shoot or the latest available version. ManagedResources of policy
+// bundles which no longer apply to the shoot are deleted.
+func (b *Botanist) DeployPolicyBundles(ctx context.Context) error {
+	secrets := make(map[string]*corev1.Secret)
+	for _, key := range b.GetSecretKeysOfRole(v1beta1constants.GardenRolePolicyBundle) {
+		secrets[key] = b.LoadSecret(key)
+	}
+
+	policyBundles, err := gardenerutils.GetPolicyBundles(secrets)
+	if err != nil {
+		return err
+	}
+
+	policyBundles, err = gardenerutils.ComputePolicyBundlesForShoot(policyBundles, b.Garden.Project.Name, b.Shoot.GetInfo().Annotations)
+	if err != nil {
+		return err
+	}
+
+	wantedManagedResourceNames := sets.New[string]()
+	for _, policyBundle := range policyBundles {
+		var (
+			managedResourceName = ManagedResourceNamePrefixPolicyBundle + policyBundle.Name
+			labels              = map[string]string{
+				managedresources.LabelKeyOrigin:        managedresources.LabelValueGardener,
+				v1beta1constants.LabelPolicyBundleName: policyBundle.Name,
+			}
+			annotations = map[string]string{v1beta1constants.AnnotationPolicyBundleVersion: policyBundle.Version}
+
+			secretName, secret = managedresources.NewSecret(b.SeedClientSet.Client(), b.Shoot.SeedNamespace, managedResourceName, policyBundle.Data, true)
+		)
+
+		managedResource := managedresources.NewForShoot(b.SeedClientSet.Client(), b.Shoot.SeedNamespace, managedResourceName, managedresources.LabelValueGardener, false).
+			WithLabels(labels).
+			WithAnnotations(annotations).
+			WithSecretRef(secretName)
+
+		if err := secret.Reconcile(ctx); err != nil {
+			return fmt.Errorf("could not create or update secret of managed resource for policy bundle %q: %w", policyBundle.Name, err)
+		}
+		if err := managedResource.Reconcile(ctx); err != nil {
+			return fmt.Errorf("could not create or update managed resource for policy bundle %q: %w", policyBundle.Name, err)
+		}
+
+		wantedManagedResourceNames.Insert(managedResourceName)
+	}
+
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := b.SeedClientSet.Client().List(ctx, managedResourceList, client.InNamespace(b.Shoot.SeedNamespace), client.HasLabels{v1beta1constants.LabelPolicyBundleName}); err != nil {
+		return err
+	}
+
+	for _, managedResource := range managedResourceList.Items {
+		if wantedManagedResourceNames.Has(managedResource.Name) {
+			continue
+		}
+
+		if err := managedresources.Delete(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, managedResource.Name, true); err != nil {
+			return fmt.Errorf("could not delete managed resource for policy bundle %q: %w", managedResource.Labels[v1beta1constants.LabelPolicyBundleName], err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/garden"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("PolicyBundles", func() {
+	var (
+		ctx = context.TODO()
+
+		seedClient client.Client
+		botanist   *Botanist
+
+		namespace = "shoot--foo--bar"
+	)
+
+	policyBundleSecret := func(name, version, projects string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      map[string]string{"gardener.cloud/role": "policy-bundle", "policy-bundle.gardener.cloud/name": name},
+				Annotations: map[string]string{"policy-bundle.gardener.cloud/version": version, "policy-bundle.gardener.cloud/projects": projects},
+			},
+			Data: map[string][]byte{"policies.yaml": []byte(name + "@" + version)},
+		}
+	}
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+				Garden: &garden.Garden{
+					Project: &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+				},
+				Shoot: &shootpkg.Shoot{SeedNamespace: namespace},
+			},
+		}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}})
+
+		botanist.StoreSecret("policy-bundle-baseline-1.0.0", policyBundleSecret("baseline", "1.0.0", ""))
+		botanist.StoreSecret("policy-bundle-baseline-1.1.0", policyBundleSecret("baseline", "1.1.0", ""))
+		botanist.StoreSecret("policy-bundle-restricted-1.0.0", policyBundleSecret("restricted", "1.0.0", "other"))
+	})
+
+	Describe("#DeployPolicyBundles", func() {
+		It("should deploy the latest versions of the applicable policy bundles", func() {
+			Expect(botanist.DeployPolicyBundles(ctx)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "policy-bundle-baseline"}, managedResource)).To(Succeed())
+			Expect(managedResource.Labels).To(HaveKeyWithValue("policy-bundle.gardener.cloud/name", "baseline"))
+			Expect(managedResource.Labels).To(HaveKeyWithValue("origin", "gardener"))
+			Expect(managedResource.Annotations).To(HaveKeyWithValue("policy-bundle.gardener.cloud/version", "1.1.0"))
+			Expect(managedResource.Spec.Class).To(BeNil())
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			secret := &corev1.Secret{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{"policies.yaml": []byte("baseline@1.1.0")}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "policy-bundle-restricted"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+
+		It("should deploy the pinned version of a policy bundle", func() {
+			botanist.Shoot.GetInfo().Annotations = map[string]string{"policy-bundle.shoot.gardener.cloud/baseline": "1.0.0"}
+
+			Expect(botanist.DeployPolicyBundles(ctx)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "policy-bundle-baseline"}, managedResource)).To(Succeed())
+			Expect(managedResource.Annotations).To(HaveKeyWithValue("policy-bundle.gardener.cloud/version", "1.0.0"))
+		})
+
+		It("should fail if the pinned version is not available", func() {
+			botanist.Shoot.GetInfo().Annotations = map[string]string{"policy-bundle.shoot.gardener.cloud/baseline": "2.0.0"}
+
+			Expect(botanist.DeployPolicyBundles(ctx)).To(MatchError(ContainSubstring("pinned but not available")))
+		})
+
+		It("should delete managed resources of policy bundles which no longer apply", func() {
+			staleManagedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{
+				Name:      "policy-bundle-removed",
+				Namespace: namespace,
+				Labels:    map[string]string{"policy-bundle.gardener.cloud/name": "removed"},
+			}}
+			Expect(seedClient.Create(ctx, staleManagedResource)).To(Succeed())
+
+			Expect(botanist.DeployPolicyBundles(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(staleManagedResource), staleManagedResource)).To(BeNotFoundError())
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "policy-bundle-baseline"}, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,30 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// controlPlanePriorityTier returns the control plane priority tier configured in the seed settings for the purpose of
+// the shoot. Priority classes which are not specified by the tier (or all if no tier applies) are unset, i.e., the
+// components fall back to their default priority classes.
+func (b *Botanist) controlPlanePriorityTier() gardencorev1beta1.SeedSettingControlPlanePriorityTier {
+	if tier := v1beta1helper.SeedSettingControlPlanePriorityTierForPurpose(b.Seed.GetInfo().Spec.Settings, b.Shoot.Purpose); tier != nil {
+		return *tier
+	}
+	return gardencorev1beta1.SeedSettingControlPlanePriorityTier{}
+}
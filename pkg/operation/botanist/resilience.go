@@ -0,0 +1,367 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component/etcd"
+	"github.com/gardener/gardener/pkg/component/kubeapiserver"
+	"github.com/gardener/gardener/pkg/component/vpnseedserver"
+	"github.com/gardener/gardener/pkg/component/vpnshoot"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	// ConfigMapNameResilienceReport is the name of the ConfigMap in the shoot namespace in the seed which contains the
+	// report of the last resilience validation.
+	ConfigMapNameResilienceReport = "resilience-report"
+	// DataKeyResilienceReport is the key in the data of the resilience report ConfigMap which contains the report.
+	DataKeyResilienceReport = "report.yaml"
+)
+
+var (
+	// ResilienceProbeInterval is the interval in which the availability of the shoot is probed while a disruption is
+	// injected. Exposed for testing.
+	ResilienceProbeInterval = time.Second
+	// ResilienceRecoveryTimeout is the maximum duration the shoot may take to recover from a single disruption. Exposed
+	// for testing.
+	ResilienceRecoveryTimeout = 10 * time.Minute
+)
+
+// ResilienceReport is the result of a resilience validation of a shoot.
+type ResilienceReport struct {
+	// StartTime is the time when the resilience validation was started.
+	StartTime metav1.Time `json:"startTime"`
+	// Disruptions are the results of the injected disruptions in the order they were performed.
+	Disruptions []DisruptionResult `json:"disruptions"`
+}
+
+// DisruptionResult is the result of a single disruption injected during a resilience validation.
+type DisruptionResult struct {
+	// Name is the name of the disruption.
+	Name string `json:"name"`
+	// Description describes what was disrupted.
+	Description string `json:"description"`
+	// RecoveryDuration is the duration from injecting the disruption until the affected components and all probes were
+	// healthy again.
+	RecoveryDuration metav1.Duration `json:"recoveryDuration"`
+	// Downtimes contains the accumulated duration in which the respective probe failed.
+	Downtimes map[string]metav1.Duration `json:"downtimes"`
+	// Error is set if the disruption could not be injected or the shoot did not recover in time.
+	Error string `json:"error,omitempty"`
+}
+
+type disruption struct {
+	name        string
+	description string
+	// inject injects the disruption and waits until the affected components have recovered.
+	inject func(ctx context.Context) error
+}
+
+// ValidateResilience deliberately disrupts the VPN components, the kube-apiserver and one etcd member of the shoot one
+// after another while probing the availability of the shoot. The results are stored in the resilience report
+// ConfigMap. Failing disruptions do not result in an error, instead they are part of the report and the remaining
+// disruptions are skipped to not further degrade an unhealthy shoot.
+func (b *Botanist) ValidateResilience(ctx context.Context) error {
+	report := &ResilienceReport{StartTime: metav1.Now()}
+
+	for _, d := range b.resilienceDisruptions() {
+		b.Logger.Info("Injecting disruption for resilience validation", "disruption", d.name)
+
+		result := measureDisruption(ctx, b.resilienceProbes(), d)
+		report.Disruptions = append(report.Disruptions, result)
+
+		if result.Error != "" {
+			b.Logger.Info("Shoot did not recover from disruption, skipping remaining disruptions", "disruption", d.name, "error", result.Error)
+			break
+		}
+	}
+
+	data, err := yaml.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed marshalling resilience report: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapNameResilienceReport, Namespace: b.Shoot.SeedNamespace}}
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), configMap, func() error {
+		configMap.Data = map[string]string{DataKeyResilienceReport: string(data)}
+		return nil
+	})
+	return err
+}
+
+func (b *Botanist) resilienceProbes() map[string]func(context.Context) error {
+	probes := map[string]func(context.Context) error{
+		// A non-cached read of a namespace requires both the kube-apiserver and etcd to be available.
+		v1beta1constants.DeploymentNameKubeAPIServer: func(ctx context.Context) error {
+			return b.ShootClientSet.APIReader().Get(ctx, client.ObjectKey{Name: metav1.NamespaceSystem}, &corev1.Namespace{})
+		},
+	}
+
+	if !b.Shoot.IsWorkerless {
+		probes["vpn"] = func(ctx context.Context) error {
+			done, err := CheckTunnelConnection(ctx, logr.Discard(), b.ShootClientSet, vpnshoot.LabelValue)
+			if err == nil && !done {
+				err = fmt.Errorf("tunnel connection has not been established")
+			}
+			return err
+		}
+	}
+
+	return probes
+}
+
+func (b *Botanist) resilienceDisruptions() []disruption {
+	var disruptions []disruption
+
+	if !b.Shoot.IsWorkerless {
+		disruptions = append(disruptions, disruption{
+			name:        "vpn",
+			description: "Restart all pods of vpn-seed-server in the seed and vpn-shoot in the shoot",
+			inject: flow.Parallel(
+				func(ctx context.Context) error {
+					return restartPodsAndWaitUntilRecovered(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, map[string]string{v1beta1constants.LabelApp: vpnseedserver.DeploymentName}, allPods)
+				},
+				func(ctx context.Context) error {
+					return restartPodsAndWaitUntilRecovered(ctx, b.ShootClientSet.Client(), metav1.NamespaceSystem, map[string]string{v1beta1constants.LabelApp: vpnshoot.LabelValue}, allPods)
+				},
+			),
+		})
+	}
+
+	return append(disruptions,
+		disruption{
+			name:        v1beta1constants.DeploymentNameKubeAPIServer,
+			description: "Roll all kube-apiserver pods one after another",
+			inject: func(ctx context.Context) error {
+				podList := &corev1.PodList{}
+				if err := b.SeedClientSet.Client().List(ctx, podList, client.InNamespace(b.Shoot.SeedNamespace), client.MatchingLabels(kubeapiserver.GetLabels())); err != nil {
+					return err
+				}
+
+				for _, pod := range podList.Items {
+					if err := restartPodsAndWaitUntilRecovered(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, kubeapiserver.GetLabels(), podWithUID(pod.UID)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		disruption{
+			name:        v1beta1constants.ETCDMain,
+			description: "Kill one member of etcd-main",
+			inject: func(ctx context.Context) error {
+				return restartPodsAndWaitUntilRecovered(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, map[string]string{
+					v1beta1constants.LabelApp:  etcd.LabelAppValue,
+					v1beta1constants.LabelRole: v1beta1constants.ETCDRoleMain,
+				}, lastPod)
+			},
+		},
+	)
+}
+
+// measureDisruption injects the given disruption while continuously running the given probes. It returns when the
+// disruption has been injected, the affected components have recovered, and all probes succeeded again.
+func measureDisruption(ctx context.Context, probes map[string]func(context.Context) error, d disruption) DisruptionResult {
+	var (
+		result = DisruptionResult{
+			Name:        d.name,
+			Description: d.description,
+			Downtimes:   make(map[string]metav1.Duration, len(probes)),
+		}
+
+		start              = time.Now()
+		probeCtx, cancel   = context.WithCancel(ctx)
+		wg                 sync.WaitGroup
+		mutex              sync.Mutex
+		lastSuccessfulRuns = make(map[string]time.Time, len(probes))
+	)
+
+	for name, probe := range probes {
+		name, probe := name, probe
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			downtime := runProbe(probeCtx, probe, func(t time.Time) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				lastSuccessfulRuns[name] = t
+			})
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			result.Downtimes[name] = metav1.Duration{Duration: downtime}
+		}()
+	}
+
+	err := d.inject(ctx)
+	if err == nil {
+		recovered := time.Now()
+		err = retry.UntilTimeout(ctx, ResilienceProbeInterval, ResilienceRecoveryTimeout, func(context.Context) (bool, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			for name := range probes {
+				if !lastSuccessfulRuns[name].After(recovered) {
+					return retry.MinorError(fmt.Errorf("probe %s did not succeed yet", name))
+				}
+			}
+			return retry.Ok()
+		})
+	}
+
+	result.RecoveryDuration = metav1.Duration{Duration: time.Since(start)}
+	cancel()
+	wg.Wait()
+
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// runProbe runs the given probe in the resilience probe interval until the context is cancelled and returns the
+// accumulated duration in which the probe failed.
+func runProbe(ctx context.Context, probe func(context.Context) error, onSuccess func(time.Time)) time.Duration {
+	var (
+		downtime     time.Duration
+		failingSince *time.Time
+		ticker       = time.NewTicker(ResilienceProbeInterval)
+	)
+	defer ticker.Stop()
+
+	for {
+		timeoutCtx, cancel := context.WithTimeout(ctx, ResilienceProbeInterval)
+		err := probe(timeoutCtx)
+		cancel()
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		now := time.Now()
+		if err != nil {
+			if failingSince == nil {
+				failingSince = &now
+			}
+		} else {
+			if failingSince != nil {
+				downtime += now.Sub(*failingSince)
+				failingSince = nil
+			}
+			onSuccess(now)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+			continue
+		}
+		break
+	}
+
+	if failingSince != nil {
+		downtime += time.Since(*failingSince)
+	}
+	return downtime
+}
+
+// restartPodsAndWaitUntilRecovered deletes the pods with the given labels selected by the given function and waits
+// until they have been replaced and at least as many pods as before are ready.
+func restartPodsAndWaitUntilRecovered(ctx context.Context, c client.Client, namespace string, labels map[string]string, selectPods func([]corev1.Pod) []corev1.Pod) error {
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabels(labels)); err != nil {
+		return err
+	}
+
+	pods := selectPods(podList.Items)
+	if len(pods) == 0 {
+		return fmt.Errorf("no pods found in namespace %s with labels %v", namespace, labels)
+	}
+
+	deleted := sets.New[types.UID]()
+	for _, pod := range pods {
+		if err := c.Delete(ctx, pod.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+		}
+		deleted.Insert(pod.UID)
+	}
+
+	expectedReadyPods := len(podList.Items)
+	return retry.UntilTimeout(ctx, ResilienceProbeInterval, ResilienceRecoveryTimeout, func(ctx context.Context) (bool, error) {
+		podList := &corev1.PodList{}
+		if err := c.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabels(labels)); err != nil {
+			return retry.MinorError(err)
+		}
+
+		var readyPods int
+		for _, pod := range podList.Items {
+			if deleted.Has(pod.UID) {
+				return retry.MinorError(fmt.Errorf("pod %s has not been replaced yet", client.ObjectKeyFromObject(&pod)))
+			}
+			if health.IsPodReady(&pod) {
+				readyPods++
+			}
+		}
+
+		if readyPods < expectedReadyPods {
+			return retry.MinorError(fmt.Errorf("only %d/%d pods in namespace %s with labels %v are ready", readyPods, expectedReadyPods, namespace, labels))
+		}
+		return retry.Ok()
+	})
+}
+
+func allPods(pods []corev1.Pod) []corev1.Pod {
+	return pods
+}
+
+func lastPod(pods []corev1.Pod) []corev1.Pod {
+	if len(pods) == 0 {
+		return nil
+	}
+
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	return pods[len(pods)-1:]
+}
+
+func podWithUID(uid types.UID) func([]corev1.Pod) []corev1.Pod {
+	return func(pods []corev1.Pod) []corev1.Pod {
+		for _, pod := range pods {
+			if pod.UID == uid {
+				return []corev1.Pod{pod}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Resilience", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		seedClient  client.Client
+		shootClient client.Client
+		botanist    *Botanist
+
+		apiServerLabels = map[string]string{"app": "kubernetes", "role": "apiserver", "gardener.cloud/role": "controlplane"}
+		etcdLabels      = map[string]string{"app": "etcd-statefulset", "role": "main", "gardener.cloud/role": "controlplane"}
+
+		newPod = func(name string, labels map[string]string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels, UID: types.UID(name)},
+				Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+			}
+		}
+
+		// recreatePods simulates the controllers which replace deleted pods.
+		recreatePods = interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if err := c.Delete(ctx, obj, opts...); err != nil {
+					return err
+				}
+
+				pod := obj.(*corev1.Pod)
+				replacement := newPod(pod.Name, pod.Labels)
+				replacement.UID = pod.UID + "-replaced"
+				return c.Create(ctx, replacement)
+			},
+		}
+
+		getReport = func() *ResilienceReport {
+			configMap := &corev1.ConfigMap{}
+			ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "resilience-report"}, configMap)).To(Succeed())
+
+			report := &ResilienceReport{}
+			ExpectWithOffset(1, yaml.Unmarshal([]byte(configMap.Data["report.yaml"]), report)).To(Succeed())
+			return report
+		}
+	)
+
+	BeforeEach(func() {
+		DeferCleanup(test.WithVars(
+			&ResilienceProbeInterval, 10*time.Millisecond,
+			&ResilienceRecoveryTimeout, 200*time.Millisecond,
+		))
+
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+		).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Logger:         logr.Discard(),
+			ShootClientSet: kubernetesfake.NewClientSetBuilder().WithClient(shootClient).WithAPIReader(shootClient).Build(),
+			Shoot: &shootpkg.Shoot{
+				SeedNamespace: namespace,
+				IsWorkerless:  true,
+			},
+		}}
+	})
+
+	Describe("#ValidateResilience", func() {
+		buildSeedClient := func(funcs interceptor.Funcs) {
+			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+				newPod("kube-apiserver-1", apiServerLabels),
+				newPod("kube-apiserver-2", apiServerLabels),
+				newPod("etcd-main-0", etcdLabels),
+				newPod("etcd-main-1", etcdLabels),
+				newPod("etcd-main-2", etcdLabels),
+			).WithInterceptorFuncs(funcs).Build()
+			botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build()
+		}
+
+		It("should roll the kube-apiserver pods, kill one etcd member and publish the report", func() {
+			buildSeedClient(recreatePods)
+
+			Expect(botanist.ValidateResilience(ctx)).To(Succeed())
+
+			podList := &corev1.PodList{}
+			Expect(seedClient.List(ctx, podList, client.InNamespace(namespace))).To(Succeed())
+			uids := make(map[string]types.UID, len(podList.Items))
+			for _, pod := range podList.Items {
+				uids[pod.Name] = pod.UID
+			}
+			Expect(uids).To(Equal(map[string]types.UID{
+				"kube-apiserver-1": "kube-apiserver-1-replaced",
+				"kube-apiserver-2": "kube-apiserver-2-replaced",
+				"etcd-main-0":      "etcd-main-0",
+				"etcd-main-1":      "etcd-main-1",
+				"etcd-main-2":      "etcd-main-2-replaced",
+			}))
+
+			report := getReport()
+			Expect(report.Disruptions).To(HaveLen(2))
+			Expect(report.Disruptions[0].Name).To(Equal("kube-apiserver"))
+			Expect(report.Disruptions[1].Name).To(Equal("etcd-main"))
+			for _, result := range report.Disruptions {
+				Expect(result.Error).To(BeEmpty())
+				Expect(result.Downtimes).To(Equal(map[string]metav1.Duration{"kube-apiserver": {}}))
+			}
+		})
+
+		It("should report the downtime if the probes fail", func() {
+			buildSeedClient(recreatePods)
+			botanist.ShootClientSet = kubernetesfake.NewClientSetBuilder().WithAPIReader(fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()).Build()
+
+			Expect(botanist.ValidateResilience(ctx)).To(Succeed())
+
+			report := getReport()
+			Expect(report.Disruptions).To(HaveLen(1))
+			Expect(report.Disruptions[0].Error).To(ContainSubstring("probe kube-apiserver did not succeed yet"))
+			Expect(report.Disruptions[0].Downtimes["kube-apiserver"].Duration).To(BeNumerically(">", 0))
+		})
+
+		It("should skip the remaining disruptions if the shoot does not recover", func() {
+			buildSeedClient(interceptor.Funcs{})
+
+			Expect(botanist.ValidateResilience(ctx)).To(Succeed())
+
+			report := getReport()
+			Expect(report.Disruptions).To(HaveLen(1))
+			Expect(report.Disruptions[0].Name).To(Equal("kube-apiserver"))
+			Expect(report.Disruptions[0].Error).To(ContainSubstring("only 1/2 pods"))
+		})
+	})
+})
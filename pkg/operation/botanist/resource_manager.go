@@ -26,9 +26,11 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/resourcemanager"
 	"github.com/gardener/gardener/pkg/component/shared"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/logger"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // DefaultResourceManager returns an instance of Gardener Resource Manager with defaults configured for being deployed in a Shoot namespace
@@ -45,10 +47,12 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		defaultUnreachableTolerationSeconds = nodeToleration.DefaultUnreachableTolerationSeconds
 	}
 
+	podSecurityContextDefaultingMode := b.Shoot.GetInfo().Annotations[v1beta1constants.ShootAlphaPodSecurityContextDefaulting]
+
 	return shared.NewTargetGardenerResourceManager(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
-		b.SecretsManager,
+		secretsmanager.WithConsumer(b.SecretsManager, "gardener-resource-manager"),
 		b.Seed.GetInfo().Status.ClusterIdentity,
 		defaultNotReadyTolerationSeconds,
 		defaultUnreachableTolerationSeconds,
@@ -56,6 +60,8 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		logger.InfoLevel, logger.FormatJSON,
 		"",
 		true,
+		podSecurityContextDefaultingMode != "",
+		podSecurityContextDefaultingMode == v1beta1constants.ShootPodSecurityContextDefaultingDryRun,
 		v1beta1constants.PriorityClassNameShootControlPlane400,
 		v1beta1helper.ShootSchedulingProfile(b.Shoot.GetInfo()),
 		v1beta1constants.SecretNameCACluster,
@@ -63,7 +69,9 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		b.Shoot.TopologyAwareRoutingEnabled,
 		pointer.String(b.Shoot.ComputeOutOfClusterAPIServerAddress(true)),
 		b.Shoot.IsWorkerless,
+		gardenlethelper.IsDeprecatedAPIUsageReporterEnabled(b.Config),
 		[]string{metav1.NamespaceSystem, v1beta1constants.KubernetesDashboardNamespace},
+		gardenlethelper.IsContinuousProfilingEnabled(b.Config),
 	)
 }
 
@@ -72,7 +80,7 @@ func (b *Botanist) DeployGardenerResourceManager(ctx context.Context) error {
 	return shared.DeployGardenerResourceManager(
 		ctx,
 		b.SeedClientSet.Client(),
-		b.SecretsManager,
+		secretsmanager.WithConsumer(b.SecretsManager, "gardener-resource-manager"),
 		b.Shoot.Components.ControlPlane.ResourceManager,
 		b.Shoot.SeedNamespace,
 		func(ctx context.Context) (int32, error) {
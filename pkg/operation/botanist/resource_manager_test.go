@@ -103,6 +103,23 @@ var _ = Describe("ResourceManager", func() {
 			Expect(resourceManager.GetValues().DefaultNotReadyToleration).To(Equal(notReadyTolerationSeconds))
 			Expect(resourceManager.GetValues().DefaultUnreachableToleration).To(Equal(unreachableTolerationSeconds))
 		})
+
+		DescribeTable("should consider the pod security context defaulting mode",
+			func(mode string, expectedEnabled, expectedDryRun bool) {
+				if mode != "" {
+					botanist.Shoot.GetInfo().Annotations = map[string]string{"alpha.security.shoot.gardener.cloud/pod-security-context-defaulting": mode}
+				}
+
+				resourceManager, err := botanist.DefaultResourceManager()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resourceManager.GetValues().PodSecurityContextDefaultingEnabled).To(Equal(expectedEnabled))
+				Expect(resourceManager.GetValues().PodSecurityContextDefaultingDryRun).To(Equal(expectedDryRun))
+			},
+
+			Entry("no mode", "", false, false),
+			Entry("enforce", "enforce", true, false),
+			Entry("dry-run", "dry-run", true, true),
+		)
 	})
 
 	Describe("#DeployGardenerResourceManager", func() {
@@ -0,0 +1,132 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// DataKeyOpenIDConfig is the key in the data of the service account issuer secret in the project namespace
+	// containing the OpenID discovery document of the shoot's managed service account issuer.
+	DataKeyOpenIDConfig = "openid-config"
+	// DataKeyJWKS is the key in the data of the service account issuer secret in the project namespace containing the
+	// JSON Web Key Set with the public keys for verifying service account tokens of the shoot.
+	DataKeyJWKS = "jwks"
+)
+
+// ManagedServiceAccountIssuer returns the service account issuer of the shoot in case it is managed by Gardener. It is
+// located under the hostname configured in the shoot service account issuer secret of the garden cluster.
+func (b *Botanist) ManagedServiceAccountIssuer() (string, error) {
+	secret := b.LoadSecret(v1beta1constants.GardenRoleShootServiceAccountIssuer)
+	if secret == nil {
+		return "", fmt.Errorf("shoot requests a managed service account issuer but no secret with role %q is configured in the garden cluster", v1beta1constants.GardenRoleShootServiceAccountIssuer)
+	}
+
+	return fmt.Sprintf("https://%s/projects/%s/shoots/%s/issuer", secret.Data[gardenerutils.DataKeyServiceAccountIssuerHostname], b.Garden.Project.Name, b.Shoot.GetInfo().UID), nil
+}
+
+// computeKubeAPIServerConfig returns the kube-apiserver configuration of the shoot. If the shoot uses a managed service
+// account issuer, the issuer is overwritten while a previously configured issuer is still accepted.
+func (b *Botanist) computeKubeAPIServerConfig() (*gardencorev1beta1.KubeAPIServerConfig, error) {
+	apiServerConfig := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer
+	if !v1beta1helper.ShootUsesManagedServiceAccountIssuer(b.Shoot.GetInfo()) {
+		return apiServerConfig, nil
+	}
+
+	issuer, err := b.ManagedServiceAccountIssuer()
+	if err != nil {
+		return nil, err
+	}
+
+	apiServerConfig = apiServerConfig.DeepCopy()
+	if apiServerConfig == nil {
+		apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{}
+	}
+	if apiServerConfig.ServiceAccountConfig == nil {
+		apiServerConfig.ServiceAccountConfig = &gardencorev1beta1.ServiceAccountConfig{}
+	}
+
+	if oldIssuer := apiServerConfig.ServiceAccountConfig.Issuer; oldIssuer != nil && *oldIssuer != issuer && !utils.ValueExists(*oldIssuer, apiServerConfig.ServiceAccountConfig.AcceptedIssuers) {
+		apiServerConfig.ServiceAccountConfig.AcceptedIssuers = append(apiServerConfig.ServiceAccountConfig.AcceptedIssuers, *oldIssuer)
+	}
+	apiServerConfig.ServiceAccountConfig.Issuer = &issuer
+
+	return apiServerConfig, nil
+}
+
+// SyncServiceAccountIssuerDiscovery publishes the OpenID discovery document and the JSON Web Key Set of the shoot's
+// managed service account issuer in a secret in the project namespace. Serving the documents under the issuer URL is
+// up to a discovery server operated for the configured hostname, which is not part of Gardener. The secret is removed if
+// the shoot does not use a managed issuer.
+func (b *Botanist) SyncServiceAccountIssuerDiscovery(ctx context.Context) error {
+	if !v1beta1helper.ShootUsesManagedServiceAccountIssuer(b.Shoot.GetInfo()) {
+		secretName := gardenerutils.ComputeShootProjectSecretName(b.Shoot.GetInfo().Name, gardenerutils.ShootProjectSecretSuffixServiceAccountIssuer)
+		return kubernetesutils.DeleteObject(ctx, b.GardenClient, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: b.Shoot.GetInfo().Namespace}})
+	}
+
+	issuer, err := b.ManagedServiceAccountIssuer()
+	if err != nil {
+		return err
+	}
+
+	rawOpenIDConfig, err := b.ShootClientSet.RESTClient().Get().AbsPath("/.well-known/openid-configuration").DoRaw(ctx)
+	if err != nil {
+		return fmt.Errorf("failed fetching OpenID discovery document of the shoot's kube-apiserver: %w", err)
+	}
+
+	// The JSON Web Key Set contains the public keys of all signing keys which are still accepted, i.e., during a
+	// service account key rotation it contains both the old and the new key.
+	jwks, err := b.ShootClientSet.RESTClient().Get().AbsPath("/openid/v1/jwks").DoRaw(ctx)
+	if err != nil {
+		return fmt.Errorf("failed fetching JSON Web Key Set of the shoot's kube-apiserver: %w", err)
+	}
+
+	// The kube-apiserver of the shoot is not publicly accessible for relying parties, hence the key set is expected next
+	// to the discovery document.
+	openIDConfig := map[string]interface{}{}
+	if err := json.Unmarshal(rawOpenIDConfig, &openIDConfig); err != nil {
+		return fmt.Errorf("failed parsing OpenID discovery document of the shoot's kube-apiserver: %w", err)
+	}
+	openIDConfig["jwks_uri"] = issuer + "/jwks"
+
+	rawOpenIDConfig, err = json.Marshal(openIDConfig)
+	if err != nil {
+		return err
+	}
+
+	return b.syncShootCredentialToGarden(
+		ctx,
+		gardenerutils.ShootProjectSecretSuffixServiceAccountIssuer,
+		map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleServiceAccountIssuer},
+		map[string]string{"issuer": issuer},
+		map[string][]byte{
+			DataKeyOpenIDConfig: rawOpenIDConfig,
+			DataKeyJWKS:         jwks,
+		},
+	)
+}
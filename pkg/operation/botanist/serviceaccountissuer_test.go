@@ -0,0 +1,126 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	fakerestclient "k8s.io/client-go/rest/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/garden"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ServiceAccountIssuer", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		botanist     *Botanist
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		fakeRESTClient := &fakerestclient.RESTClient{
+			NegotiatedSerializer: serializer.NewCodecFactory(kubernetes.ShootScheme).WithoutConversion(),
+			Client: fakerestclient.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				body := `{"keys":[{"kid":"old"},{"kid":"new"}]}`
+				if req.URL.Path == "/.well-known/openid-configuration" {
+					body = `{"issuer":"https://issuer.example.com/projects/foo/shoots/1234/issuer","jwks_uri":"https://api.bar.foo.example.com/openid/v1/jwks"}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+			}),
+		}
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				GardenClient:   gardenClient,
+				ShootClientSet: kubernetesfake.NewClientSetBuilder().WithRESTClient(fakeRESTClient).Build(),
+				Garden: &garden.Garden{
+					Project: &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+				},
+				Shoot: &shootpkg.Shoot{},
+			},
+		}
+		botanist.StoreSecret("shoot-service-account-issuer", &corev1.Secret{Data: map[string][]byte{"hostname": []byte("issuer.example.com")}})
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				UID:         "1234",
+				Annotations: map[string]string{"authentication.gardener.cloud/issuer": "managed"},
+			},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#ManagedServiceAccountIssuer", func() {
+		It("should return the managed service account issuer", func() {
+			Expect(botanist.ManagedServiceAccountIssuer()).To(Equal("https://issuer.example.com/projects/foo/shoots/1234/issuer"))
+		})
+
+		It("should fail if no hostname is configured", func() {
+			botanist.DeleteSecret("shoot-service-account-issuer")
+
+			_, err := botanist.ManagedServiceAccountIssuer()
+			Expect(err).To(MatchError(ContainSubstring("no secret with role \"shoot-service-account-issuer\" is configured")))
+		})
+	})
+
+	Describe("#SyncServiceAccountIssuerDiscovery", func() {
+		It("should publish the discovery document and the key set", func() {
+			Expect(botanist.SyncServiceAccountIssuerDiscovery(ctx)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "bar.service-account-issuer", Namespace: "garden-foo"}, secret)).To(Succeed())
+			Expect(secret.Labels).To(HaveKeyWithValue("gardener.cloud/role", "service-account-issuer"))
+			Expect(secret.Annotations).To(HaveKeyWithValue("issuer", "https://issuer.example.com/projects/foo/shoots/1234/issuer"))
+			Expect(secret.Data).To(HaveKeyWithValue("openid-config", []byte(`{"issuer":"https://issuer.example.com/projects/foo/shoots/1234/issuer","jwks_uri":"https://issuer.example.com/projects/foo/shoots/1234/issuer/jwks"}`)))
+			Expect(secret.Data).To(HaveKeyWithValue("jwks", []byte(`{"keys":[{"kid":"old"},{"kid":"new"}]}`)))
+		})
+
+		It("should delete the published documents if the shoot does not use a managed issuer", func() {
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bar.service-account-issuer", Namespace: "garden-foo"}}
+			Expect(gardenClient.Create(ctx, secret)).To(Succeed())
+
+			shoot.Annotations = nil
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.SyncServiceAccountIssuerDiscovery(ctx)).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
+		})
+	})
+})
@@ -0,0 +1,48 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/component/trustbundle"
+)
+
+// DefaultTrustBundle returns a deployer for the trust bundle which is published in the shoot.
+func (b *Botanist) DefaultTrustBundle() trustbundle.Interface {
+	return trustbundle.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		b.SecretsManager,
+		trustbundle.Values{
+			SeedName:              b.Seed.GetInfo().Name,
+			SeedClusterIdentity:   pointer.StringDeref(b.Seed.GetInfo().Status.ClusterIdentity, ""),
+			GardenClusterIdentity: b.GardenClusterIdentity,
+			APIServerURL:          "https://" + b.Shoot.ComputeOutOfClusterAPIServerAddress(true),
+			TrustedCABundles:      b.Shoot.GetInfo().Spec.TrustedCABundles,
+		},
+	)
+}
+
+// DeployTrustBundle deploys the trust bundle which is published in the shoot.
+func (b *Botanist) DeployTrustBundle(ctx context.Context) error {
+	if v := b.Shoot.GetInfo().Status.ClusterIdentity; v != nil {
+		b.Shoot.Components.SystemComponents.TrustBundle.SetClusterIdentity(*v)
+	}
+
+	return b.Shoot.Components.SystemComponents.TrustBundle.Deploy(ctx)
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	mocktrustbundle "github.com/gardener/gardener/pkg/component/trustbundle/mock"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+)
+
+var _ = Describe("TrustBundle", func() {
+	var (
+		ctrl        *gomock.Controller
+		trustBundle *mocktrustbundle.MockInterface
+		botanist    *Botanist
+
+		ctx     = context.TODO()
+		fakeErr = fmt.Errorf("fake")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		trustBundle = mocktrustbundle.NewMockInterface(ctrl)
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Shoot: &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					SystemComponents: &shootpkg.SystemComponents{
+						TrustBundle: trustBundle,
+					},
+				},
+			},
+		}}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DeployTrustBundle", func() {
+		It("should set the cluster identity and deploy successfully", func() {
+			botanist.Shoot.GetInfo().Status.ClusterIdentity = pointer.String("shoot-identity")
+
+			gomock.InOrder(
+				trustBundle.EXPECT().SetClusterIdentity("shoot-identity"),
+				trustBundle.EXPECT().Deploy(ctx),
+			)
+			Expect(botanist.DeployTrustBundle(ctx)).To(Succeed())
+		})
+
+		It("should not set the cluster identity if it is not yet known", func() {
+			trustBundle.EXPECT().Deploy(ctx)
+			Expect(botanist.DeployTrustBundle(ctx)).To(Succeed())
+		})
+
+		It("should return the error during deployment", func() {
+			trustBundle.EXPECT().Deploy(ctx).Return(fakeErr)
+			Expect(botanist.DeployTrustBundle(ctx)).To(MatchError(fakeErr))
+		})
+	})
+})
@@ -65,6 +65,10 @@ func (b *Botanist) DefaultVerticalPodAutoscaler() (vpa.Interface, error) {
 	if vpaConfig := b.Shoot.GetInfo().Spec.Kubernetes.VerticalPodAutoscaler; vpaConfig != nil {
 		valuesRecommender.Interval = vpaConfig.RecommenderInterval
 		valuesRecommender.RecommendationMarginFraction = vpaConfig.RecommendationMarginFraction
+		valuesRecommender.TargetCPUPercentile = vpaConfig.TargetCPUPercentile
+		valuesRecommender.TargetMemoryPercentile = vpaConfig.TargetMemoryPercentile
+		valuesRecommender.CPUHistogramDecayHalfLife = vpaConfig.CPUHistogramDecayHalfLife
+		valuesRecommender.MemoryHistogramDecayHalfLife = vpaConfig.MemoryHistogramDecayHalfLife
 
 		valuesUpdater.EvictAfterOOMThreshold = vpaConfig.EvictAfterOOMThreshold
 		valuesUpdater.EvictionRateBurst = vpaConfig.EvictionRateBurst
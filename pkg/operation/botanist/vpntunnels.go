@@ -0,0 +1,135 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/component/vpnseedserver"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// VPNTunnelTargetThroughput is the targeted throughput of a single VPN tunnel in bytes per second. In the
+	// high-throughput mode, the number of tunnels is scaled such that no tunnel exceeds this throughput.
+	VPNTunnelTargetThroughput = 40 * 1024 * 1024
+	// vpnTunnelScaleDownUtilization is the utilization of the remaining tunnels below which the number of tunnels is
+	// reduced. It prevents flapping between two numbers of tunnels.
+	vpnTunnelScaleDownUtilization = 0.8
+
+	queryVPNThroughput = `shoot:container_network_transmit_bytes_total_vpn:sum + shoot:container_network_receive_bytes_total_vpn:sum`
+)
+
+// PrometheusQuerier is used to query Prometheus.
+type PrometheusQuerier interface {
+	Query(ctx context.Context, query string, ts time.Time, opts ...promv1.Option) (model.Value, promv1.Warnings, error)
+}
+
+// NewPrometheusQuerier returns a querier for the Prometheus running in the given control plane namespace. Exposed for
+// testing.
+var NewPrometheusQuerier = func(namespace string) (PrometheusQuerier, error) {
+	prometheusClient, err := promapi.NewClient(promapi.Config{Address: fmt.Sprintf("http://prometheus-web.%s.svc", namespace)})
+	if err != nil {
+		return nil, err
+	}
+	return promv1.NewAPI(prometheusClient), nil
+}
+
+// ComputeVPNTunnels computes the number of parallel VPN tunnels for shoots using the VPN high-throughput mode. It
+// starts from the number of currently running VPN seed servers and scales it based on the VPN throughput observed by
+// the shoot's Prometheus. If the throughput cannot be determined, the current number of tunnels is kept.
+func (b *Botanist) ComputeVPNTunnels(ctx context.Context) int {
+	var (
+		highThroughput = b.Shoot.VPNHighThroughput
+		minTunnels     = int(pointer.Int32Deref(highThroughput.MinTunnels, gardencorev1beta1.DefaultVPNHighThroughputMinTunnels))
+		maxTunnels     = int(pointer.Int32Deref(highThroughput.MaxTunnels, int32(minTunnels)))
+		currentTunnels = minTunnels
+	)
+
+	statefulSet := &appsv1.StatefulSet{}
+	if err := b.SeedClientSet.Client().Get(ctx, kubernetesutils.Key(b.Shoot.SeedNamespace, vpnseedserver.DeploymentName), statefulSet); err != nil {
+		if !apierrors.IsNotFound(err) {
+			b.Logger.Error(err, "Failed reading the VPN seed server, starting with the minimum number of tunnels")
+		}
+	} else if replicas := pointer.Int32Deref(statefulSet.Spec.Replicas, 0); replicas > 0 {
+		currentTunnels = int(replicas)
+	}
+
+	if minTunnels == maxTunnels || b.Shoot.HibernationEnabled {
+		return DesiredVPNTunnels(currentTunnels, minTunnels, maxTunnels, -1)
+	}
+
+	throughput, err := b.queryVPNThroughput(ctx)
+	if err != nil {
+		b.Logger.Info("Could not determine VPN throughput, keeping current number of tunnels", "tunnels", currentTunnels, "reason", err.Error())
+		return DesiredVPNTunnels(currentTunnels, minTunnels, maxTunnels, -1)
+	}
+
+	desiredTunnels := DesiredVPNTunnels(currentTunnels, minTunnels, maxTunnels, throughput)
+	if desiredTunnels != currentTunnels {
+		b.Logger.Info("Scaling VPN tunnels", "from", currentTunnels, "to", desiredTunnels, "throughputBytesPerSecond", throughput)
+	}
+	return desiredTunnels
+}
+
+func (b *Botanist) queryVPNThroughput(ctx context.Context) (float64, error) {
+	prometheus, err := NewPrometheusQuerier(b.Shoot.SeedNamespace)
+	if err != nil {
+		return 0, fmt.Errorf("failed creating Prometheus client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	value, _, err := prometheus.Query(ctx, queryVPNThroughput, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed querying VPN throughput: %w", err)
+	}
+
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return 0, fmt.Errorf("unexpected result type %s for VPN throughput query", value.Type())
+	}
+	if len(vector) == 0 {
+		return 0, fmt.Errorf("no VPN throughput data available")
+	}
+	return float64(vector[0].Value), nil
+}
+
+// DesiredVPNTunnels returns the number of VPN tunnels needed to transport the given throughput (in bytes per second)
+// without exceeding the VPNTunnelTargetThroughput per tunnel, bounded by the given minimum and maximum. The number of
+// tunnels is only reduced if the remaining tunnels would be utilized to less than 80%. A negative throughput means that
+// it is unknown, in which case the current number of tunnels is kept within the bounds.
+func DesiredVPNTunnels(current, minTunnels, maxTunnels int, throughput float64) int {
+	desired := current
+	if throughput >= 0 {
+		desired = int(math.Ceil(throughput / VPNTunnelTargetThroughput))
+		if desired < current && throughput > float64(current-1)*VPNTunnelTargetThroughput*vpnTunnelScaleDownUtilization {
+			desired = current
+		}
+	}
+	return min(max(desired, minTunnels), maxTunnels)
+}
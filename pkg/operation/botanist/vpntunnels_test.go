@@ -0,0 +1,150 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/vpnseedserver"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("VPNTunnels", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		botanist   *Botanist
+		querier    *fakePrometheusQuerier
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		querier = &fakePrometheusQuerier{}
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Logger:        logr.Discard(),
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			Shoot: &shootpkg.Shoot{
+				SeedNamespace: namespace,
+				VPNHighThroughput: &gardencorev1beta1.VPNHighThroughput{
+					MinTunnels: pointer.Int32(2),
+					MaxTunnels: pointer.Int32(6),
+				},
+			},
+		}}
+
+		DeferCleanup(test.WithVar(&NewPrometheusQuerier, func(ns string) (PrometheusQuerier, error) {
+			Expect(ns).To(Equal(namespace))
+			return querier, nil
+		}))
+	})
+
+	createStatefulSet := func(replicas int32) {
+		ExpectWithOffset(1, fakeClient.Create(ctx, &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: vpnseedserver.DeploymentName, Namespace: namespace},
+			Spec:       appsv1.StatefulSetSpec{Replicas: pointer.Int32(replicas)},
+		})).To(Succeed())
+	}
+
+	Describe("#ComputeVPNTunnels", func() {
+		It("should scale up based on the observed throughput", func() {
+			createStatefulSet(2)
+			querier.throughput = 3.5 * VPNTunnelTargetThroughput
+
+			Expect(botanist.ComputeVPNTunnels(ctx)).To(Equal(4))
+			Expect(querier.query).To(ContainSubstring("shoot:container_network_transmit_bytes_total_vpn:sum"))
+		})
+
+		It("should start with the minimum number of tunnels if the VPN seed server does not exist yet", func() {
+			querier.err = fmt.Errorf("fake")
+
+			Expect(botanist.ComputeVPNTunnels(ctx)).To(Equal(2))
+		})
+
+		It("should keep the current number of tunnels if the throughput cannot be determined", func() {
+			createStatefulSet(5)
+			querier.err = fmt.Errorf("fake")
+
+			Expect(botanist.ComputeVPNTunnels(ctx)).To(Equal(5))
+		})
+
+		It("should not query Prometheus if the shoot is hibernated", func() {
+			createStatefulSet(0)
+			botanist.Shoot.HibernationEnabled = true
+
+			Expect(botanist.ComputeVPNTunnels(ctx)).To(Equal(2))
+			Expect(querier.query).To(BeEmpty())
+		})
+
+		It("should use the fixed number of tunnels if minimum and maximum are equal", func() {
+			createStatefulSet(2)
+			botanist.Shoot.VPNHighThroughput.MinTunnels = pointer.Int32(3)
+			botanist.Shoot.VPNHighThroughput.MaxTunnels = pointer.Int32(3)
+
+			Expect(botanist.ComputeVPNTunnels(ctx)).To(Equal(3))
+			Expect(querier.query).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("#DesiredVPNTunnels",
+		func(current int, throughput float64, expected int) {
+			Expect(DesiredVPNTunnels(current, 2, 6, throughput)).To(Equal(expected))
+		},
+
+		Entry("unknown throughput", 3, -1.0, 3),
+		Entry("unknown throughput below minimum", 1, -1.0, 2),
+		Entry("no throughput", 3, 0.0, 2),
+		Entry("scale up", 2, 2.5*VPNTunnelTargetThroughput, 3),
+		Entry("scale up to maximum", 2, 10.0*VPNTunnelTargetThroughput, 6),
+		Entry("scale down", 5, 2.0*VPNTunnelTargetThroughput, 2),
+		Entry("no scale down if remaining tunnels would be highly utilized", 4, 2.5*VPNTunnelTargetThroughput, 4),
+		Entry("scale down if remaining tunnels are moderately utilized", 4, 2.2*VPNTunnelTargetThroughput, 3),
+	)
+})
+
+type fakePrometheusQuerier struct {
+	throughput float64
+	err        error
+	query      string
+}
+
+func (f *fakePrometheusQuerier) Query(_ context.Context, query string, ts time.Time, _ ...promv1.Option) (model.Value, promv1.Warnings, error) {
+	f.query = query
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	return model.Vector{{Value: model.SampleValue(f.throughput), Timestamp: model.TimeFromUnix(ts.Unix())}}, nil, nil
+}
@@ -57,6 +57,7 @@ func (b *Botanist) DefaultWorker() worker.Interface {
 			KubernetesVersion:   b.Shoot.KubernetesVersion,
 			MachineTypes:        b.Shoot.CloudProfile.Spec.MachineTypes,
 			NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+			ProjectName:         b.Garden.Project.Name,
 		},
 		worker.DefaultInterval,
 		worker.DefaultSevereThreshold,
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -119,6 +120,12 @@ func (b *Builder) WithLogger(log logr.Logger) *Builder {
 	return b
 }
 
+// WithSeedRecorder sets the event recorder for the seed cluster at the Builder.
+func (b *Builder) WithSeedRecorder(recorder record.EventRecorder) *Builder {
+	b.seedRecorder = recorder
+	return b
+}
+
 // WithSecrets sets the secretsFunc attribute at the Builder.
 func (b *Builder) WithSecrets(secrets map[string]*corev1.Secret) *Builder {
 	b.secretsFunc = func() (map[string]*corev1.Secret, error) { return secrets, nil }
@@ -190,6 +197,7 @@ func (b *Builder) Build(
 	operation := &Operation{
 		GardenClient:   gardenClient,
 		SeedClientSet:  seedClientSet,
+		SeedRecorder:   b.seedRecorder,
 		ShootClientMap: shootClientMap,
 	}
 
@@ -251,6 +259,11 @@ func (b *Builder) Build(
 	}
 	operation.Shoot = shoot
 
+	// The Alertmanager of the shoot is also required for forwarding its alerts to ticketing systems.
+	if !shoot.IgnoreAlerts && len(helper.GetShootAlertForwardingWebhooks(config, shoot.Purpose)) > 0 {
+		operation.Shoot.WantsAlertmanager = true
+	}
+
 	// Get the ManagedSeed object for this shoot, if it exists.
 	// Also read the managed seed API server settings from the managed-seed-api-server annotation.
 	operation.ManagedSeed, err = kubernetesutils.GetManagedSeedWithReader(ctx, gardenClient, shoot.GetInfo().Namespace, shoot.GetInfo().Name)
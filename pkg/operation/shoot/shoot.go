@@ -24,6 +24,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -204,6 +205,13 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	}
 	shoot.ExternalDomain = externalDomain
 
+	// Determine information about the private domain for shoot cluster.
+	privateDomain, err := gardenerutils.ConstructPrivateDomain(ctx, c, shootObject)
+	if err != nil {
+		return nil, err
+	}
+	shoot.PrivateDomain = privateDomain
+
 	// Store the Kubernetes version in the format <major>.<minor> on the Shoot object.
 	kubernetesVersion, err := semver.NewVersion(shootObject.Spec.Kubernetes.Version)
 	if err != nil {
@@ -226,6 +234,15 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	shoot.VPNHighAvailabilityNumberOfSeedServers = vpnseedserver.HighAvailabilityReplicaCount
 	shoot.VPNHighAvailabilityNumberOfShootClients = vpnseedserver.HighAvailabilityReplicaCount
 
+	if controlPlane := shootObject.Spec.ControlPlane; controlPlane != nil && controlPlane.VPN != nil && controlPlane.VPN.HighThroughput != nil {
+		// The high-throughput mode builds on top of the highly available VPN topology: each VPN seed server provides a
+		// separate tunnel, and the traffic is load-balanced across all of them. The actual number of tunnels is computed
+		// by the botanist based on the observed throughput.
+		shoot.VPNHighAvailabilityEnabled = true
+		shoot.VPNHighThroughput = controlPlane.VPN.HighThroughput
+		shoot.VPNHighAvailabilityNumberOfSeedServers = int(pointer.Int32Deref(controlPlane.VPN.HighThroughput.MinTunnels, gardencorev1beta1.DefaultVPNHighThroughputMinTunnels))
+	}
+
 	needsClusterAutoscaler, err := v1beta1helper.ShootWantsClusterAutoscaler(shootObject)
 	if err != nil {
 		return nil, err
@@ -405,6 +422,7 @@ func (s *Shoot) GetDNSRecordComponentsForMigration() []component.DeployMigrateWa
 		s.Components.Extensions.IngressDNSRecord,
 		s.Components.Extensions.ExternalDNSRecord,
 		s.Components.Extensions.InternalDNSRecord,
+		s.Components.Extensions.PrivateDNSRecord,
 	}
 }
 
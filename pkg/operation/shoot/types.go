@@ -55,6 +55,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/plutono"
 	"github.com/gardener/gardener/pkg/component/resourcemanager"
 	"github.com/gardener/gardener/pkg/component/shootsystem"
+	"github.com/gardener/gardener/pkg/component/trustbundle"
 	"github.com/gardener/gardener/pkg/component/vpa"
 	"github.com/gardener/gardener/pkg/component/vpnseedserver"
 	"github.com/gardener/gardener/pkg/component/vpnshoot"
@@ -91,6 +92,7 @@ type Shoot struct {
 	InternalClusterDomain string
 	ExternalClusterDomain *string
 	ExternalDomain        *gardenerutils.Domain
+	PrivateDomain         *gardenerutils.Domain
 
 	Purpose                                 gardencorev1beta1.ShootPurpose
 	IsWorkerless                            bool
@@ -102,6 +104,7 @@ type Shoot struct {
 	VPNHighAvailabilityEnabled              bool
 	VPNHighAvailabilityNumberOfSeedServers  int
 	VPNHighAvailabilityNumberOfShootClients int
+	VPNHighThroughput                       *gardencorev1beta1.VPNHighThroughput
 	NodeLocalDNSEnabled                     bool
 	PSPDisabled                             bool
 	TopologyAwareRoutingEnabled             bool
@@ -139,6 +142,8 @@ type ControlPlane struct {
 	KubeAPIServerIngress     component.Deployer
 	KubeAPIServerService     component.DeployWaiter
 	KubeAPIServerSNI         component.DeployWaiter
+	KubeAPIServerAllowlist   component.Deployer
+	KubeAPIServerEndpoint    component.DeployWaiter
 	KubeAPIServer            kubeapiserver.Interface
 	KubeScheduler            kubescheduler.Interface
 	KubeControllerManager    kubecontrollermanager.Interface
@@ -157,6 +162,7 @@ type Extensions struct {
 	ControlPlaneExposure  controlplane.Interface
 	ExternalDNSRecord     dnsrecord.Interface
 	InternalDNSRecord     dnsrecord.Interface
+	PrivateDNSRecord      dnsrecord.Interface
 	IngressDNSRecord      dnsrecord.Interface
 	Extension             extension.Interface
 	Infrastructure        infrastructure.Interface
@@ -178,12 +184,15 @@ type SystemComponents struct {
 	NodeProblemDetector component.DeployWaiter
 	NodeExporter        nodeexporter.Interface
 	Resources           shootsystem.Interface
+	TrustBundle         trustbundle.Interface
 	VPNShoot            vpnshoot.Interface
 }
 
 // Monitoring contains references to monitoring deployers.
 type Monitoring struct {
-	Monitoring monitoring.Interface
+	AuthProxy     component.DeployWaiter
+	GatewayRoutes component.DeployWaiter
+	Monitoring    monitoring.Interface
 }
 
 // Logging contains references to logging deployers.
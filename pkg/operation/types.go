@@ -20,6 +20,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -44,6 +45,7 @@ type Builder struct {
 	secretsFunc               func() (map[string]*corev1.Secret, error)
 	seedFunc                  func(context.Context) (*seed.Seed, error)
 	shootFunc                 func(context.Context, client.Reader, *garden.Garden, *seed.Seed) (*shoot.Shoot, error)
+	seedRecorder              record.EventRecorder
 }
 
 // Operation contains all data required to perform an operation on a Shoot cluster.
@@ -63,10 +65,12 @@ type Operation struct {
 	ManagedSeedAPIServer  *v1beta1helper.ManagedSeedAPIServer
 	GardenClient          client.Client
 	SeedClientSet         kubernetes.Interface
+	SeedRecorder          record.EventRecorder
 	ShootClientMap        clientmap.ClientMap
 	ShootClientSet        kubernetes.Interface
 	APIServerAddress      string
 	APIServerClusterIP    string
+	APIServerStaticIP     string
 	SeedNamespaceObject   *corev1.Namespace
 
 	// ControlPlaneWildcardCert is a wildcard tls certificate which is issued for the seed's ingress domain.
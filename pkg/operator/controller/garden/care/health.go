@@ -16,6 +16,7 @@ package care
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -29,6 +30,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/operator/v1alpha1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/etcd"
@@ -135,21 +137,14 @@ func NewHealth(
 
 // Check conducts the health checks on all the given conditions.
 func (h *health) Check(ctx context.Context, conditions GardenConditions) []gardencorev1beta1.Condition {
+	standby := helper.IsVirtualGardenStandby(h.garden)
+
 	taskFns := []flow.TaskFn{
-		func(ctx context.Context) error {
-			conditions.virtualGardenAPIServerAvailable = h.checkAPIServerAvailability(ctx, conditions.virtualGardenAPIServerAvailable)
-			return nil
-		},
 		func(ctx context.Context) error {
 			newRuntimeComponentsCondition, err := h.checkRuntimeComponents(ctx, conditions.runtimeComponentsHealthy)
 			conditions.runtimeComponentsHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.runtimeComponentsHealthy, newRuntimeComponentsCondition, err)
 			return nil
 		},
-		func(ctx context.Context) error {
-			newVirtualComponentsCondition, err := h.checkVirtualComponents(ctx, conditions.virtualComponentsHealthy)
-			conditions.virtualComponentsHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.virtualComponentsHealthy, newVirtualComponentsCondition, err)
-			return nil
-		},
 		func(ctx context.Context) error {
 			newObservabilityCondition, err := h.checkObservabilityComponents(ctx, conditions.observabilityComponentsHealthy)
 			conditions.observabilityComponentsHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.observabilityComponentsHealthy, newObservabilityCondition, err)
@@ -157,6 +152,35 @@ func (h *health) Check(ctx context.Context, conditions GardenConditions) []garde
 		},
 	}
 
+	if standby {
+		// The virtual garden does not run in a standby garden, hence, its health is not checked.
+		for _, condition := range []*gardencorev1beta1.Condition{&conditions.virtualGardenAPIServerAvailable, &conditions.virtualComponentsHealthy} {
+			*condition = v1beta1helper.UpdatedConditionWithClock(h.clock, *condition, gardencorev1beta1.ConditionTrue, "ConditionNotChecked", "Garden is in standby mode, the virtual garden is not running.")
+		}
+	} else {
+		taskFns = append(taskFns,
+			func(ctx context.Context) error {
+				conditions.virtualGardenAPIServerAvailable = h.checkAPIServerAvailability(ctx, conditions.virtualGardenAPIServerAvailable)
+				return nil
+			},
+			func(ctx context.Context) error {
+				newVirtualComponentsCondition, err := h.checkVirtualComponents(ctx, conditions.virtualComponentsHealthy)
+				conditions.virtualComponentsHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.virtualComponentsHealthy, newVirtualComponentsCondition, err)
+				return nil
+			},
+		)
+	}
+
+	if conditions.virtualGardenReplicationHealthy != nil {
+		if standby {
+			replicationCondition := h.checkReplication(*conditions.virtualGardenReplicationHealthy)
+			conditions.virtualGardenReplicationHealthy = &replicationCondition
+		} else {
+			// The garden is no longer a standby garden, hence, the condition is removed.
+			conditions.virtualGardenReplicationHealthy = nil
+		}
+	}
+
 	_ = flow.Parallel(taskFns...)(ctx)
 
 	return conditions.ConvertToSlice()
@@ -236,7 +260,30 @@ func (h *health) checkManagedResources(
 
 // checkObservabilityComponents checks whether the  observability components of the virtual garden control plane (Prometheus, Vali, Plutono..) are healthy.
 func (h *health) checkObservabilityComponents(ctx context.Context, condition gardencorev1beta1.Condition) (*gardencorev1beta1.Condition, error) {
-	return h.checkManagedResources(ctx, condition, sets.List(requiredObservabilityManagedResources), "ObservabilityComponentsRunning", "All observability components are healthy.")
+	managedResources := requiredObservabilityManagedResources.Clone()
+	if helper.IsVirtualGardenStandby(h.garden) {
+		managedResources.Delete(gardenermetricsexporter.ManagedResourceNameRuntime, gardenermetricsexporter.ManagedResourceNameVirtual)
+	}
+
+	return h.checkManagedResources(ctx, condition, sets.List(managedResources), "ObservabilityComponentsRunning", "All observability components are healthy.")
+}
+
+// checkReplication checks whether the etcd backups of the primary virtual garden were copied recently enough.
+func (h *health) checkReplication(condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	status := h.garden.Status.Replication
+	if status == nil || status.LastReplicationTime == nil {
+		return v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionProgressing, "ReplicationPending", "The etcd backups of the primary virtual garden have not been replicated yet.")
+	}
+
+	var (
+		maxLag = helper.GetReplicationMaxLag(h.garden.Spec.VirtualCluster.Replication)
+		lag    = h.clock.Now().Sub(status.LastReplicationTime.Time).Round(time.Second)
+	)
+
+	if lag > maxLag {
+		return v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionFalse, "ReplicationLagging", fmt.Sprintf("The etcd backups of the primary virtual garden were last replicated %s ago, which exceeds the maximum lag of %s.", lag, maxLag))
+	}
+	return v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "ReplicationHealthy", fmt.Sprintf("The etcd backups of the primary virtual garden were last replicated %s ago.", lag))
 }
 
 func (h *health) isVPAEnabled() bool {
@@ -251,35 +298,57 @@ type GardenConditions struct {
 	runtimeComponentsHealthy        gardencorev1beta1.Condition
 	virtualComponentsHealthy        gardencorev1beta1.Condition
 	observabilityComponentsHealthy  gardencorev1beta1.Condition
+	virtualGardenReplicationHealthy *gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the garden conditions as a slice.
 func (g GardenConditions) ConvertToSlice() []gardencorev1beta1.Condition {
-	return []gardencorev1beta1.Condition{
+	conditions := []gardencorev1beta1.Condition{
 		g.virtualGardenAPIServerAvailable,
 		g.runtimeComponentsHealthy,
 		g.virtualComponentsHealthy,
 		g.observabilityComponentsHealthy,
 	}
+
+	if g.virtualGardenReplicationHealthy != nil {
+		conditions = append(conditions, *g.virtualGardenReplicationHealthy)
+	}
+
+	return conditions
 }
 
 // ConditionTypes returns all garden condition types.
 func (g GardenConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
-	return []gardencorev1beta1.ConditionType{
+	types := []gardencorev1beta1.ConditionType{
 		g.virtualGardenAPIServerAvailable.Type,
 		g.runtimeComponentsHealthy.Type,
 		g.virtualComponentsHealthy.Type,
 		g.observabilityComponentsHealthy.Type,
 	}
+
+	if g.virtualGardenReplicationHealthy != nil {
+		types = append(types, operatorv1alpha1.VirtualGardenReplicationHealthy)
+	}
+
+	return types
 }
 
 // NewGardenConditions returns a new instance of GardenConditions.
-// All conditions are retrieved from the given 'status' or newly initialized.
-func NewGardenConditions(clock clock.Clock, status operatorv1alpha1.GardenStatus) GardenConditions {
-	return GardenConditions{
-		virtualGardenAPIServerAvailable: v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.VirtualGardenAPIServerAvailable),
-		runtimeComponentsHealthy:        v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.RuntimeComponentsHealthy),
-		virtualComponentsHealthy:        v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.VirtualComponentsHealthy),
-		observabilityComponentsHealthy:  v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.ObservabilityComponentsHealthy),
+// All conditions are retrieved from the given 'garden' or newly initialized.
+func NewGardenConditions(clock clock.Clock, garden *operatorv1alpha1.Garden) GardenConditions {
+	gardenConditions := GardenConditions{
+		virtualGardenAPIServerAvailable: v1beta1helper.GetOrInitConditionWithClock(clock, garden.Status.Conditions, operatorv1alpha1.VirtualGardenAPIServerAvailable),
+		runtimeComponentsHealthy:        v1beta1helper.GetOrInitConditionWithClock(clock, garden.Status.Conditions, operatorv1alpha1.RuntimeComponentsHealthy),
+		virtualComponentsHealthy:        v1beta1helper.GetOrInitConditionWithClock(clock, garden.Status.Conditions, operatorv1alpha1.VirtualComponentsHealthy),
+		observabilityComponentsHealthy:  v1beta1helper.GetOrInitConditionWithClock(clock, garden.Status.Conditions, operatorv1alpha1.ObservabilityComponentsHealthy),
 	}
+
+	// The VirtualGardenReplicationHealthy condition is also initialized if it is still present in the status although
+	// the garden was promoted, so that it gets removed from the status by the health check.
+	if helper.IsVirtualGardenStandby(garden) || v1beta1helper.GetCondition(garden.Status.Conditions, operatorv1alpha1.VirtualGardenReplicationHealthy) != nil {
+		replicationCondition := v1beta1helper.GetOrInitConditionWithClock(clock, garden.Status.Conditions, operatorv1alpha1.VirtualGardenReplicationHealthy)
+		gardenConditions.virtualGardenReplicationHealthy = &replicationCondition
+	}
+
+	return gardenConditions
 }
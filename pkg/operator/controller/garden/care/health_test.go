@@ -176,7 +176,7 @@ var _ = Describe("Garden health", func() {
 			},
 		}
 
-		gardenConditions = NewGardenConditions(fakeClock, garden.Status)
+		gardenConditions = NewGardenConditions(fakeClock, garden)
 	})
 
 	Describe("#Check", func() {
@@ -676,10 +676,46 @@ var _ = Describe("Garden health", func() {
 		})
 	})
 
+	Context("when the garden is in standby mode", func() {
+		BeforeEach(func() {
+			garden = standbyGarden()
+
+			for _, name := range gardenManagedResources {
+				Expect(runtimeClient.Create(ctx, healthyManagedResource(name))).To(Succeed())
+			}
+		})
+
+		It("should not check the virtual garden and report that the replication is pending", func() {
+			updatedConditions := NewHealth(garden, runtimeClient, gardenClientSet, fakeClock, nil, gardenNamespace).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainElements(
+				And(OfType(operatorv1alpha1.VirtualGardenAPIServerAvailable), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("ConditionNotChecked")),
+				And(OfType(operatorv1alpha1.VirtualComponentsHealthy), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("ConditionNotChecked")),
+				And(OfType(operatorv1alpha1.VirtualGardenReplicationHealthy), WithStatus(gardencorev1beta1.ConditionProgressing), WithReason("ReplicationPending")),
+			))
+		})
+
+		It("should report a healthy replication if the backups were copied recently", func() {
+			garden.Status.Replication = &operatorv1alpha1.ReplicationStatus{LastReplicationTime: &metav1.Time{Time: fakeClock.Now().Add(-5 * time.Minute)}}
+
+			updatedConditions := NewHealth(garden, runtimeClient, gardenClientSet, fakeClock, nil, gardenNamespace).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainCondition(OfType(operatorv1alpha1.VirtualGardenReplicationHealthy), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("ReplicationHealthy")))
+		})
+
+		It("should report a lagging replication if the backups were not copied within the max lag", func() {
+			garden.Status.Replication = &operatorv1alpha1.ReplicationStatus{LastReplicationTime: &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)}}
+
+			updatedConditions := NewHealth(garden, runtimeClient, gardenClientSet, fakeClock, nil, gardenNamespace).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainCondition(OfType(operatorv1alpha1.VirtualGardenReplicationHealthy), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("ReplicationLagging"), WithMessage("exceeds the maximum lag of 30m0s")))
+		})
+	})
+
 	Describe("GardenConditions", func() {
 		Describe("#NewGardenConditions", func() {
 			It("should initialize all conditions", func() {
-				conditions := NewGardenConditions(fakeClock, operatorv1alpha1.GardenStatus{})
+				conditions := NewGardenConditions(fakeClock, &operatorv1alpha1.Garden{})
 
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
@@ -690,12 +726,12 @@ var _ = Describe("Garden health", func() {
 			})
 
 			It("should only initialize missing conditions", func() {
-				conditions := NewGardenConditions(fakeClock, operatorv1alpha1.GardenStatus{
+				conditions := NewGardenConditions(fakeClock, &operatorv1alpha1.Garden{Status: operatorv1alpha1.GardenStatus{
 					Conditions: []gardencorev1beta1.Condition{
 						{Type: "VirtualGardenAPIServerAvailable"},
 						{Type: "Foo"},
 					},
-				})
+				}})
 
 				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
 					OfType("VirtualGardenAPIServerAvailable"),
@@ -704,11 +740,31 @@ var _ = Describe("Garden health", func() {
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
+
+			It("should initialize the replication condition for a standby garden", func() {
+				conditions := NewGardenConditions(fakeClock, standbyGarden())
+
+				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
+					OfType("VirtualGardenAPIServerAvailable"),
+					OfType("RuntimeComponentsHealthy"),
+					OfType("VirtualComponentsHealthy"),
+					OfType("ObservabilityComponentsHealthy"),
+					OfType("VirtualGardenReplicationHealthy"),
+				))
+			})
+
+			It("should keep the replication condition if it is still present after the garden was promoted", func() {
+				conditions := NewGardenConditions(fakeClock, &operatorv1alpha1.Garden{Status: operatorv1alpha1.GardenStatus{
+					Conditions: []gardencorev1beta1.Condition{{Type: "VirtualGardenReplicationHealthy"}},
+				}})
+
+				Expect(conditions.ConditionTypes()).To(ContainElement(gardencorev1beta1.ConditionType("VirtualGardenReplicationHealthy")))
+			})
 		})
 
 		Describe("#ConvertToSlice", func() {
 			It("should return the expected conditions", func() {
-				conditions := NewGardenConditions(fakeClock, operatorv1alpha1.GardenStatus{})
+				conditions := NewGardenConditions(fakeClock, &operatorv1alpha1.Garden{})
 
 				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
 					OfType("VirtualGardenAPIServerAvailable"),
@@ -721,7 +777,7 @@ var _ = Describe("Garden health", func() {
 
 		Describe("#ConditionTypes", func() {
 			It("should return the expected condition types", func() {
-				conditions := NewGardenConditions(fakeClock, operatorv1alpha1.GardenStatus{})
+				conditions := NewGardenConditions(fakeClock, &operatorv1alpha1.Garden{})
 
 				Expect(conditions.ConditionTypes()).To(HaveExactElements(
 					gardencorev1beta1.ConditionType("VirtualGardenAPIServerAvailable"),
@@ -734,6 +790,20 @@ var _ = Describe("Garden health", func() {
 	})
 })
 
+func standbyGarden() *operatorv1alpha1.Garden {
+	return &operatorv1alpha1.Garden{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: operatorv1alpha1.GardenSpec{
+			VirtualCluster: operatorv1alpha1.VirtualCluster{
+				Replication: &operatorv1alpha1.Replication{
+					Role:   operatorv1alpha1.ReplicationRoleStandby,
+					Source: &operatorv1alpha1.Backup{BucketName: "primary-bucket"},
+				},
+			},
+		},
+	}
+}
+
 func beConditionWithStatusReasonAndMessage(status gardencorev1beta1.ConditionStatus, reason, message string) types.GomegaMatcher {
 	return And(WithStatus(status), WithReason(reason), WithMessage(message))
 }
@@ -72,7 +72,7 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 	log.V(1).Info("Starting garden care")
 
 	// Initialize conditions based on the current status.
-	gardenConditions := NewGardenConditions(r.Clock, garden.Status)
+	gardenConditions := NewGardenConditions(r.Clock, garden)
 
 	gardenClientSet, err := r.GardenClientMap.GetClient(reconcileCtx, keys.ForGarden(garden))
 	if err != nil {
@@ -49,6 +49,7 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/apiserver"
 	"github.com/gardener/gardener/pkg/component/etcd"
+	"github.com/gardener/gardener/pkg/component/etcdcopybackupstask"
 	"github.com/gardener/gardener/pkg/component/gardeneraccess"
 	"github.com/gardener/gardener/pkg/component/gardeneradmissioncontroller"
 	"github.com/gardener/gardener/pkg/component/gardenerapiserver"
@@ -99,6 +100,7 @@ type components struct {
 
 	etcdMain                             etcd.Interface
 	etcdEvents                           etcd.Interface
+	etcdCopyBackupsTask                  etcdcopybackupstask.Interface
 	kubeAPIServerService                 component.DeployWaiter
 	kubeAPIServerSNI                     component.Deployer
 	kubeAPIServer                        kubeapiserver.Interface
@@ -180,6 +182,7 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.etcdCopyBackupsTask = r.newEtcdCopyBackupsTask(log)
 	c.kubeAPIServerService, err = r.newKubeAPIServerService(log, garden, c.istio.GetValues().IngressGateway)
 	if err != nil {
 		return
@@ -303,6 +306,7 @@ func (r *Reconciler) newGardenerResourceManager(garden *operatorv1alpha1.Garden,
 		helper.TopologyAwareRoutingEnabled(garden.Spec.RuntimeCluster.Settings),
 		r.Config.Controllers.NetworkPolicy.AdditionalNamespaceSelectors,
 		garden.Spec.RuntimeCluster.Provider.Zones,
+		false,
 	)
 }
 
@@ -318,6 +322,7 @@ func (r *Reconciler) newVirtualGardenGardenerResourceManager(secretsManager secr
 		r.Config.LogLevel, r.Config.LogFormat,
 		namePrefix,
 		false,
+		false, false,
 		v1beta1constants.PriorityClassNameGardenSystem400,
 		nil,
 		operatorv1alpha1.SecretNameCARuntime,
@@ -325,7 +330,9 @@ func (r *Reconciler) newVirtualGardenGardenerResourceManager(secretsManager secr
 		false,
 		nil,
 		true,
+		false,
 		[]string{v1beta1constants.GardenNamespace, metav1.NamespaceSystem},
+		false,
 	)
 }
 
@@ -382,6 +389,7 @@ func (r *Reconciler) newEtcd(
 		defragmentationScheduleFormat string
 		storageClassName              *string
 		storageCapacity               string
+		resources                     componentResources
 	)
 
 	switch role {
@@ -389,10 +397,14 @@ func (r *Reconciler) newEtcd(
 		hvpaScaleDownUpdateMode = pointer.String(hvpav1alpha1.UpdateModeOff)
 		defragmentationScheduleFormat = "%d %d * * *" // defrag main etcd daily in the maintenance window
 		storageCapacity = "25Gi"
-		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Main != nil && etcd.Main.Storage != nil {
-			storageClassName = etcd.Main.Storage.ClassName
-			if etcd.Main.Storage.Capacity != nil {
-				storageCapacity = etcd.Main.Storage.Capacity.String()
+		resources = computeComponentResources(v1beta1constants.ETCDMain, nil)
+		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Main != nil {
+			resources = computeComponentResources(v1beta1constants.ETCDMain, etcd.Main.Resources)
+			if etcd.Main.Storage != nil {
+				storageClassName = etcd.Main.Storage.ClassName
+				if etcd.Main.Storage.Capacity != nil {
+					storageCapacity = etcd.Main.Storage.Capacity.String()
+				}
 			}
 		}
 
@@ -400,10 +412,14 @@ func (r *Reconciler) newEtcd(
 		hvpaScaleDownUpdateMode = pointer.String(hvpav1alpha1.UpdateModeMaintenanceWindow)
 		defragmentationScheduleFormat = "%d %d */3 * *"
 		storageCapacity = "10Gi"
-		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Events != nil && etcd.Events.Storage != nil {
-			storageClassName = etcd.Events.Storage.ClassName
-			if etcd.Events.Storage.Capacity != nil {
-				storageCapacity = etcd.Events.Storage.Capacity.String()
+		resources = computeComponentResources(v1beta1constants.ETCDEvents, nil)
+		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Events != nil {
+			resources = computeComponentResources(v1beta1constants.ETCDEvents, etcd.Events.Resources)
+			if etcd.Events.Storage != nil {
+				storageClassName = etcd.Events.Storage.ClassName
+				if etcd.Events.Storage.Capacity != nil {
+					storageCapacity = etcd.Events.Storage.Capacity.String()
+				}
 			}
 		}
 	}
@@ -446,14 +462,31 @@ func (r *Reconciler) newEtcd(
 				Enabled:               hvpaEnabled(),
 				MaintenanceTimeWindow: garden.Spec.VirtualCluster.Maintenance.TimeWindow,
 				ScaleDownUpdateMode:   hvpaScaleDownUpdateMode,
+				MinAllowed:            resources.minAllowed,
+				MaxAllowed:            resources.maxAllowed,
 			},
 			PriorityClassName:           v1beta1constants.PriorityClassNameGardenSystem500,
 			HighAvailabilityEnabled:     highAvailabilityEnabled,
 			TopologyAwareRoutingEnabled: helper.TopologyAwareRoutingEnabled(garden.Spec.RuntimeCluster.Settings),
+			ResourceRequests:            resources.requests,
 		},
 	), nil
 }
 
+func (r *Reconciler) newEtcdCopyBackupsTask(log logr.Logger) etcdcopybackupstask.Interface {
+	return etcdcopybackupstask.New(
+		log,
+		r.RuntimeClientSet.Client(),
+		&etcdcopybackupstask.Values{
+			Name:      namePrefix + v1beta1constants.ETCDMain,
+			Namespace: r.GardenNamespace,
+		},
+		etcdcopybackupstask.DefaultInterval,
+		etcdcopybackupstask.DefaultSevereThreshold,
+		etcdcopybackupstask.DefaultTimeout,
+	)
+}
+
 func (r *Reconciler) newKubeAPIServerService(log logr.Logger, garden *operatorv1alpha1.Garden, ingressGatewayValues []istio.IngressGatewayValues) (component.DeployWaiter, error) {
 	if len(ingressGatewayValues) != 1 {
 		return nil, fmt.Errorf("exactly one Istio Ingress Gateway is required for the SNI config")
@@ -572,6 +605,7 @@ func (r *Reconciler) newKubeAPIServer(
 		authenticationWebhookConfig,
 		authorizationWebhookConfig,
 		resourcesToStoreInETCDEvents,
+		false,
 		true,
 	)
 }
@@ -822,6 +856,7 @@ func (r *Reconciler) newPlutono(secretsManager secretsmanager.Interface, ingress
 		false,
 		false,
 		wildcardCertName,
+		nil,
 	)
 }
 
@@ -864,10 +899,12 @@ func (r *Reconciler) newGardenerAPIServer(ctx context.Context, garden *operatorv
 		err                error
 		apiServerConfig    *operatorv1alpha1.GardenerAPIServerConfig
 		auditWebhookConfig *apiserver.AuditWebhook
+		resources          = computeComponentResources(gardenerapiserver.DeploymentName, nil)
 	)
 
 	if apiServer := garden.Spec.VirtualCluster.Gardener.APIServer; apiServer != nil {
 		apiServerConfig = apiServer
+		resources = computeComponentResources(gardenerapiserver.DeploymentName, apiServer.Resources)
 
 		auditWebhookConfig, err = r.computeAPIServerAuditWebhookConfig(ctx, apiServer.AuditWebhook)
 		if err != nil {
@@ -875,6 +912,9 @@ func (r *Reconciler) newGardenerAPIServer(ctx context.Context, garden *operatorv
 		}
 	}
 
+	autoscalingConfig := defaultAPIServerAutoscalingConfig(garden)
+	autoscalingConfig.APIServerResources.Requests = mergeResourceLists(autoscalingConfig.APIServerResources.Requests, resources.requests)
+
 	return sharedcomponent.NewGardenerAPIServer(
 		ctx,
 		r.RuntimeClientSet.Client(),
@@ -883,7 +923,9 @@ func (r *Reconciler) newGardenerAPIServer(ctx context.Context, garden *operatorv
 		r.RuntimeVersion,
 		secretsManager,
 		apiServerConfig,
-		defaultAPIServerAutoscalingConfig(garden),
+		autoscalingConfig,
+		resources.minAllowed,
+		resources.maxAllowed,
 		auditWebhookConfig,
 		helper.TopologyAwareRoutingEnabled(garden.Spec.RuntimeCluster.Settings),
 		garden.Spec.VirtualCluster.Gardener.ClusterIdentity,
@@ -927,7 +969,10 @@ func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garde
 		LogLevel: logger.InfoLevel,
 	}
 
+	resources := computeComponentResources(gardenercontrollermanager.DeploymentName, nil)
+
 	if config := garden.Spec.VirtualCluster.Gardener.ControllerManager; config != nil {
+		resources = computeComponentResources(gardenercontrollermanager.DeploymentName, config.Resources)
 		values.FeatureGates = config.FeatureGates
 		if config.LogLevel != nil {
 			values.LogLevel = *config.LogLevel
@@ -941,6 +986,10 @@ func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garde
 		}
 	}
 
+	values.ResourceRequests = resources.requests
+	values.MinAllowed = resources.minAllowed
+	values.MaxAllowed = resources.maxAllowed
+
 	return gardenercontrollermanager.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, values), nil
 }
 
@@ -981,6 +1030,7 @@ func (r *Reconciler) newFluentBit() (component.DeployWaiter, error) {
 		r.GardenNamespace,
 		true,
 		v1beta1constants.PriorityClassNameGardenSystem100,
+		nil,
 	)
 }
 
@@ -1003,8 +1053,10 @@ func (r *Reconciler) newVali(garden *operatorv1alpha1.Garden) (vali.Interface, e
 		component.ClusterTypeSeed,
 		1,
 		false,
+		false,
 		v1beta1constants.PriorityClassNameGardenSystem100,
 		nil,
+		nil,
 		"",
 		hvpaEnabled(),
 		&hvpav1alpha1.MaintenanceTimeWindow{
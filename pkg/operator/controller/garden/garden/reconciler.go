@@ -120,7 +120,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return result, nil
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.Controllers.Garden.SyncPeriod.Duration}, r.updateStatusOperationSuccess(ctx, garden, operationType)
+	requeueAfter := r.Config.Controllers.Garden.SyncPeriod.Duration
+	// A standby garden must replicate the etcd backups of the primary virtual garden regularly.
+	if interval := helper.GetReplicationInterval(garden.Spec.VirtualCluster.Replication); helper.IsVirtualGardenStandby(garden) && interval < requeueAfter {
+		requeueAfter = interval
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, r.updateStatusOperationSuccess(ctx, garden, operationType)
 }
 
 func (r *Reconciler) ensureAtMostOneGardenExists(ctx context.Context) error {
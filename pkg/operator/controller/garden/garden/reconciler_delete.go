@@ -138,6 +138,10 @@ func (r *Reconciler) delete(
 			),
 			Dependencies: flow.NewTaskIDs(destroyKubeAPIServer),
 		})
+		destroyEtcdCopyBackupsTask = g.Add(flow.Task{
+			Name: "Destroying copy etcd backups task resource",
+			Fn:   component.OpDestroyAndWait(c.etcdCopyBackupsTask).Destroy,
+		})
 		cleanupGenericTokenKubeconfig = g.Add(flow.Task{
 			Name:         "Cleaning up generic token kubeconfig",
 			Fn:           func(ctx context.Context) error { return r.cleanupGenericTokenKubeconfig(ctx, secretsManager) },
@@ -157,6 +161,7 @@ func (r *Reconciler) delete(
 			destroyKubeAPIServerService,
 			destroyKubeAPIServer,
 			destroyEtcd,
+			destroyEtcdCopyBackupsTask,
 			invalidateClient,
 		)
 
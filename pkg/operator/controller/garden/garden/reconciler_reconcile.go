@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -40,6 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/etcd"
+	"github.com/gardener/gardener/pkg/component/etcdcopybackupstask"
 	"github.com/gardener/gardener/pkg/component/gardenerapiserver"
 	"github.com/gardener/gardener/pkg/component/kubeapiserver"
 	"github.com/gardener/gardener/pkg/component/resourcemanager"
@@ -117,6 +119,9 @@ func (r *Reconciler) reconcile(
 
 	var (
 		allowBackup             = garden.Spec.VirtualCluster.ETCD != nil && garden.Spec.VirtualCluster.ETCD.Main != nil && garden.Spec.VirtualCluster.ETCD.Main.Backup != nil
+		standby                 = helper.IsVirtualGardenStandby(garden)
+		promotionPending        = helper.IsVirtualGardenPromotionPending(garden)
+		replicateEtcdBackups    = standby || promotionPending
 		virtualClusterClientSet kubernetes.Interface
 		virtualClusterClient    client.Client
 		defaultEncryptedGVKs    = append(gardenerutils.DefaultGardenerGVKsForEncryption(), gardenerutils.DefaultGVKsForEncryption()...)
@@ -221,14 +226,46 @@ func (r *Reconciler) reconcile(
 			deployVali,
 		)
 
+		copyEtcdBackups = g.Add(flow.Task{
+			Name:         "Copying etcd backups of primary virtual garden",
+			Fn:           r.deployEtcdCopyBackupsTaskFunc(garden, c.etcdCopyBackupsTask),
+			SkipIf:       !replicateEtcdBackups,
+			Dependencies: flow.NewTaskIDs(syncPointSystemComponents),
+		})
+		waitUntilEtcdBackupsCopied = g.Add(flow.Task{
+			Name:         "Waiting until etcd backups of primary virtual garden are copied",
+			Fn:           c.etcdCopyBackupsTask.Wait,
+			SkipIf:       !replicateEtcdBackups,
+			Dependencies: flow.NewTaskIDs(copyEtcdBackups),
+		})
+		destroyEtcdCopyBackupsTask = g.Add(flow.Task{
+			Name:         "Destroying copy etcd backups task resource",
+			Fn:           component.OpDestroyAndWait(c.etcdCopyBackupsTask).Destroy,
+			SkipIf:       !replicateEtcdBackups,
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdBackupsCopied),
+		})
+		updateLastReplicationTime = g.Add(flow.Task{
+			Name: "Updating last replication time in garden status",
+			Fn: func(ctx context.Context) error {
+				now := metav1.NewTime(r.Clock.Now().UTC())
+				return r.patchReplicationStatus(ctx, garden, func(status *operatorv1alpha1.ReplicationStatus) {
+					status.LastReplicationTime = &now
+				})
+			},
+			SkipIf:       !replicateEtcdBackups,
+			Dependencies: flow.NewTaskIDs(destroyEtcdCopyBackupsTask),
+		})
+
 		deployEtcds = g.Add(flow.Task{
 			Name:         "Deploying main and events ETCDs of virtual garden",
 			Fn:           r.deployEtcdsFunc(garden, c.etcdMain, c.etcdEvents),
-			Dependencies: flow.NewTaskIDs(syncPointSystemComponents),
+			SkipIf:       standby,
+			Dependencies: flow.NewTaskIDs(syncPointSystemComponents, updateLastReplicationTime),
 		})
 		waitUntilEtcdsReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event ETCDs report readiness",
 			Fn:           flow.Parallel(c.etcdMain.Wait, c.etcdEvents.Wait),
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(deployEtcds),
 		})
 		deployKubeAPIServerService = g.Add(flow.Task{
@@ -244,11 +281,13 @@ func (r *Reconciler) reconcile(
 		deployKubeAPIServer = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes API Server",
 			Fn:           r.deployKubeAPIServerFunc(garden, c.kubeAPIServer),
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(waitUntilEtcdsReady),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server rolled out",
 			Fn:           c.kubeAPIServer.Wait,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
 		})
 		deployKubeControllerManager = g.Add(flow.Task{
@@ -258,53 +297,63 @@ func (r *Reconciler) reconcile(
 				c.kubeControllerManager.SetRuntimeConfig(c.kubeAPIServer.GetValues().RuntimeConfig)
 				return component.OpWait(c.kubeControllerManager).Deploy(ctx)
 			},
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady),
 		})
 		deployVirtualGardenGardenerResourceManager = g.Add(flow.Task{
 			Name:         "Deploying gardener-resource-manager for virtual garden",
 			Fn:           r.deployVirtualGardenGardenerResourceManager(secretsManager, c.virtualGardenGardenerResourceManager),
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady),
 		})
 		waitUntilVirtualGardenGardenerResourceManagerIsReady = g.Add(flow.Task{
 			Name:         "Waiting until gardener-resource-manager for virtual garden rolled out",
 			Fn:           c.virtualGardenGardenerResourceManager.Wait,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(deployVirtualGardenGardenerResourceManager),
 		})
 
 		deployGardenerAPIServer = g.Add(flow.Task{
 			Name:         "Deploying Gardener API Server",
 			Fn:           r.deployGardenerAPIServerFunc(garden, c.gardenerAPIServer),
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(waitUntilEtcdsReady, waitUntilKubeAPIServerIsReady, waitUntilVirtualGardenGardenerResourceManagerIsReady),
 		})
 		waitUntilGardenerAPIServerReady = g.Add(flow.Task{
 			Name:         "Waiting until Gardener API server rolled out",
 			Fn:           c.gardenerAPIServer.Wait,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(deployGardenerAPIServer),
 		})
 		deployGardenerAdmissionController = g.Add(flow.Task{
 			Name:         "Deploying Gardener Admission Controller",
 			Fn:           component.OpWait(c.gardenerAdmissionController).Deploy,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerAPIServerReady),
 		})
 		deployGardenerControllerManager = g.Add(flow.Task{
 			Name:         "Deploying Gardener Controller Manager",
 			Fn:           component.OpWait(c.gardenerControllerManager).Deploy,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerAPIServerReady),
 		})
 		deployGardenerScheduler = g.Add(flow.Task{
 			Name:         "Deploying Gardener Scheduler",
 			Fn:           component.OpWait(c.gardenerScheduler).Deploy,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerAPIServerReady),
 		})
 
 		_ = g.Add(flow.Task{
 			Name:         "Deploying virtual system resources",
 			Fn:           c.virtualSystem.Deploy,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(deployVirtualGardenGardenerResourceManager),
 		})
 		deployVirtualGardenGardenerAccess = g.Add(flow.Task{
 			Name:         "Deploying resources for gardener-operator access to virtual garden",
 			Fn:           component.OpWait(c.virtualGardenGardenerAccess).Deploy,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(waitUntilVirtualGardenGardenerResourceManagerIsReady),
 		})
 		renewVirtualClusterAccess = g.Add(flow.Task{
@@ -315,7 +364,7 @@ func (r *Reconciler) reconcile(
 					client.MatchingLabels{resourcesv1alpha1.ResourceManagerClass: resourcesv1alpha1.ResourceManagerClassShoot},
 				)
 			}).RetryUntilTimeout(5*time.Second, 30*time.Second),
-			SkipIf:       helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
+			SkipIf:       standby || helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
 			Dependencies: flow.NewTaskIDs(deployKubeControllerManager, deployVirtualGardenGardenerAccess, deployGardenerAPIServer, deployGardenerAdmissionController, deployGardenerControllerManager, deployGardenerScheduler),
 		})
 		initializeVirtualClusterClient = g.Add(flow.Task{
@@ -329,6 +378,7 @@ func (r *Reconciler) reconcile(
 				return nil
 			}).
 				RetryUntilTimeout(time.Second, 30*time.Second),
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServerService, deployVirtualGardenGardenerAccess, renewVirtualClusterAccess),
 		})
 		// Renew seed secrets tasks must run sequentially. They all use "gardener.cloud/operation" annotation of the seeds and there can be only one annotation at the same time.
@@ -337,7 +387,7 @@ func (r *Reconciler) reconcile(
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return secretsrotation.RenewGardenSecretsInAllSeeds(ctx, log, virtualClusterClient, v1beta1constants.SeedOperationRenewGardenAccessSecrets)
 			}).RetryUntilTimeout(5*time.Second, 30*time.Second),
-			SkipIf:       helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
+			SkipIf:       standby || (helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing && !promotionPending),
 			Dependencies: flow.NewTaskIDs(initializeVirtualClusterClient),
 		})
 		checkIfGardenAccessSecretsRenewalCompletedInAllSeeds = g.Add(flow.Task{
@@ -345,7 +395,7 @@ func (r *Reconciler) reconcile(
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return secretsrotation.CheckIfGardenSecretsRenewalCompletedInAllSeeds(ctx, virtualClusterClient, v1beta1constants.SeedOperationRenewGardenAccessSecrets)
 			}).RetryUntilTimeout(5*time.Second, 2*time.Minute),
-			SkipIf:       helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
+			SkipIf:       standby || (helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing && !promotionPending),
 			Dependencies: flow.NewTaskIDs(renewGardenAccessSecretsInAllSeeds),
 		})
 		renewGardenletKubeconfigInAllSeeds = g.Add(flow.Task{
@@ -353,24 +403,36 @@ func (r *Reconciler) reconcile(
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return secretsrotation.RenewGardenSecretsInAllSeeds(ctx, log, virtualClusterClient, v1beta1constants.GardenerOperationRenewKubeconfig)
 			}).RetryUntilTimeout(5*time.Second, 30*time.Second),
-			SkipIf:       helper.GetCARotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
+			SkipIf:       standby || (helper.GetCARotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing && !promotionPending),
 			Dependencies: flow.NewTaskIDs(checkIfGardenAccessSecretsRenewalCompletedInAllSeeds),
 		})
-		_ = g.Add(flow.Task{
+		checkIfGardenletKubeconfigRenewalCompletedInAllSeeds = g.Add(flow.Task{
 			Name: "Check if all seeds finished the renewal of their gardenlet kubeconfig",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return secretsrotation.CheckIfGardenSecretsRenewalCompletedInAllSeeds(ctx, virtualClusterClient, v1beta1constants.GardenerOperationRenewKubeconfig)
 			}).RetryUntilTimeout(5*time.Second, 2*time.Minute),
-			SkipIf:       helper.GetCARotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
+			SkipIf:       standby || (helper.GetCARotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing && !promotionPending),
 			Dependencies: flow.NewTaskIDs(renewGardenletKubeconfigInAllSeeds),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Marking garden as promoted after gardenlets were re-pointed to the virtual garden",
+			Fn: func(ctx context.Context) error {
+				now := metav1.NewTime(r.Clock.Now().UTC())
+				return r.patchReplicationStatus(ctx, garden, func(status *operatorv1alpha1.ReplicationStatus) {
+					status.PromotionTime = &now
+				})
+			},
+			SkipIf:       !promotionPending,
+			Dependencies: flow.NewTaskIDs(checkIfGardenletKubeconfigRenewalCompletedInAllSeeds, waitUntilGardenerAPIServerReady),
+		})
 		rewriteResourcesAddLabel = g.Add(flow.Task{
 			Name: "Labeling encrypted resources after modification of encryption config or to re-encrypt them with new ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, log, virtualClusterClientSet, secretsManager, resourcesToEncrypt, encryptedResources, defaultEncryptedGVKs)
+				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, log, virtualClusterClientSet, secretsManager, resourcesToEncrypt, encryptedResources, defaultEncryptedGVKs, nil)
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
-			SkipIf: helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing &&
-				apiequality.Semantic.DeepEqual(resourcesToEncrypt, encryptedResources),
+			SkipIf: standby ||
+				(helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing &&
+					apiequality.Semantic.DeepEqual(resourcesToEncrypt, encryptedResources)),
 			Dependencies: flow.NewTaskIDs(initializeVirtualClusterClient, waitUntilGardenerAPIServerReady),
 		})
 		snapshotETCD = g.Add(flow.Task{
@@ -378,7 +440,7 @@ func (r *Reconciler) reconcile(
 			Fn: func(ctx context.Context) error {
 				return secretsrotation.SnapshotETCDAfterRewritingEncryptedData(ctx, r.RuntimeClientSet.Client(), r.snapshotETCDFunc(secretsManager, c.etcdMain), r.GardenNamespace, namePrefix+v1beta1constants.DeploymentNameKubeAPIServer)
 			},
-			SkipIf: !allowBackup ||
+			SkipIf: standby || !allowBackup ||
 				(helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing &&
 					apiequality.Semantic.DeepEqual(resourcesToEncrypt, encryptedResources)),
 			Dependencies: flow.NewTaskIDs(rewriteResourcesAddLabel),
@@ -402,8 +464,9 @@ func (r *Reconciler) reconcile(
 
 				return nil
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
-			SkipIf: helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationCompleting &&
-				apiequality.Semantic.DeepEqual(resourcesToEncrypt, encryptedResources),
+			SkipIf: standby ||
+				(helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationCompleting &&
+					apiequality.Semantic.DeepEqual(resourcesToEncrypt, encryptedResources)),
 			Dependencies: flow.NewTaskIDs(initializeVirtualClusterClient, waitUntilGardenerAPIServerReady, snapshotETCD),
 		})
 
@@ -415,6 +478,7 @@ func (r *Reconciler) reconcile(
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Gardener Metrics Exporter",
 			Fn:           c.gardenerMetricsExporter.Deploy,
+			SkipIf:       standby,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, waitUntilKubeAPIServerIsReady, waitUntilGardenerAPIServerReady),
 		})
 		_ = g.Add(flow.Task{
@@ -461,11 +525,7 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 				backupLeaderElection = r.Config.Controllers.Garden.ETCDConfig.BackupLeaderElection
 			}
 
-			container, prefix := etcdConfig.Main.Backup.BucketName, "virtual-garden-etcd-main"
-			if idx := strings.Index(etcdConfig.Main.Backup.BucketName, "/"); idx != -1 {
-				container = etcdConfig.Main.Backup.BucketName[:idx]
-				prefix = fmt.Sprintf("%s/%s", strings.TrimSuffix(etcdConfig.Main.Backup.BucketName[idx+1:], "/"), prefix)
-			}
+			container, prefix := etcdBackupContainerAndPrefix(etcdConfig.Main.Backup.BucketName)
 
 			etcdMain.SetBackupConfig(&etcd.BackupConfig{
 				Provider:             etcdConfig.Main.Backup.Provider,
@@ -493,6 +553,57 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 	}
 }
 
+// etcdBackupContainerAndPrefix returns the container and the prefix in which the backups of the main etcd of the
+// virtual garden are stored. The bucket name may contain a folder which is prepended to the prefix.
+func etcdBackupContainerAndPrefix(bucketName string) (string, string) {
+	container, prefix := bucketName, "virtual-garden-etcd-main"
+	if idx := strings.Index(bucketName, "/"); idx != -1 {
+		container = bucketName[:idx]
+		prefix = fmt.Sprintf("%s/%s", strings.TrimSuffix(bucketName[idx+1:], "/"), prefix)
+	}
+	return container, prefix
+}
+
+func etcdBackupStoreSpec(backup *operatorv1alpha1.Backup) druidv1alpha1.StoreSpec {
+	var (
+		provider          = druidv1alpha1.StorageProvider(backup.Provider)
+		container, prefix = etcdBackupContainerAndPrefix(backup.BucketName)
+	)
+
+	return druidv1alpha1.StoreSpec{
+		Provider:  &provider,
+		SecretRef: &corev1.SecretReference{Name: backup.SecretRef.Name},
+		Prefix:    fmt.Sprintf("%s/etcd-%s", prefix, v1beta1constants.ETCDRoleMain),
+		Container: &container,
+	}
+}
+
+func (r *Reconciler) deployEtcdCopyBackupsTaskFunc(garden *operatorv1alpha1.Garden, etcdCopyBackupsTask etcdcopybackupstask.Interface) flow.TaskFn {
+	return func(ctx context.Context) error {
+		// EtcdCopyBackupsTasks are not updated but re-created for every replication run.
+		if err := component.OpDestroyAndWait(etcdCopyBackupsTask).Destroy(ctx); err != nil {
+			return err
+		}
+
+		etcdCopyBackupsTask.SetSourceStore(etcdBackupStoreSpec(garden.Spec.VirtualCluster.Replication.Source))
+		etcdCopyBackupsTask.SetTargetStore(etcdBackupStoreSpec(garden.Spec.VirtualCluster.ETCD.Main.Backup))
+		return etcdCopyBackupsTask.Deploy(ctx)
+	}
+}
+
+func (r *Reconciler) patchReplicationStatus(ctx context.Context, garden *operatorv1alpha1.Garden, mutate func(*operatorv1alpha1.ReplicationStatus)) error {
+	patch := client.MergeFrom(garden.DeepCopy())
+	if garden.Status.Replication == nil {
+		garden.Status.Replication = &operatorv1alpha1.ReplicationStatus{}
+	}
+	mutate(garden.Status.Replication)
+
+	if err := r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch); err != nil {
+		return fmt.Errorf("error patching replication status of Garden: %w", err)
+	}
+	return nil
+}
+
 func (r *Reconciler) deployKubeAPIServerFunc(garden *operatorv1alpha1.Garden, kubeAPIServer kubeapiserver.Interface) flow.TaskFn {
 	return func(ctx context.Context) error {
 		var (
@@ -0,0 +1,126 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garden
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/operator/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/component/gardenerapiserver"
+	"github.com/gardener/gardener/pkg/component/gardenercontrollermanager"
+)
+
+// componentResources contains the resource requests and the bounds for the vertical pod autoscaler of a component.
+// Empty lists mean that the component defaults are used.
+type componentResources struct {
+	requests   corev1.ResourceList
+	minAllowed corev1.ResourceList
+	maxAllowed corev1.ResourceList
+}
+
+// resourceProfiles contains the sizing of the virtual garden components for the supported resource profiles. The
+// 'Medium' profile is not listed since it corresponds to the defaults of the components.
+var resourceProfiles = map[string]map[operatorv1alpha1.ResourceProfile]componentResources{
+	v1beta1constants.ETCDMain: {
+		operatorv1alpha1.ResourceProfileSmall: {
+			requests:   resourceList("200m", "500M"),
+			maxAllowed: resourceList("2", "8G"),
+		},
+		operatorv1alpha1.ResourceProfileLarge: {
+			requests:   resourceList("1", "4G"),
+			minAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2G")},
+			maxAllowed: resourceList("8", "60G"),
+		},
+	},
+	v1beta1constants.ETCDEvents: {
+		operatorv1alpha1.ResourceProfileSmall: {
+			requests:   resourceList("100m", "300M"),
+			maxAllowed: resourceList("1", "4G"),
+		},
+		operatorv1alpha1.ResourceProfileLarge: {
+			requests:   resourceList("500m", "2G"),
+			minAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1G")},
+			maxAllowed: resourceList("4", "30G"),
+		},
+	},
+	gardenerapiserver.DeploymentName: {
+		operatorv1alpha1.ResourceProfileSmall: {
+			requests:   resourceList("300m", "256Mi"),
+			maxAllowed: resourceList("2", "8G"),
+		},
+		operatorv1alpha1.ResourceProfileLarge: {
+			requests:   resourceList("1", "2Gi"),
+			minAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			maxAllowed: resourceList("8", "50G"),
+		},
+	},
+	gardenercontrollermanager.DeploymentName: {
+		operatorv1alpha1.ResourceProfileSmall: {
+			requests:   resourceList("250m", "256Mi"),
+			maxAllowed: resourceList("1", "2Gi"),
+		},
+		operatorv1alpha1.ResourceProfileLarge: {
+			requests:   resourceList("2", "2Gi"),
+			minAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			maxAllowed: resourceList("4", "16Gi"),
+		},
+	},
+}
+
+// computeComponentResources returns the resource requests and the bounds for the vertical pod autoscaler of the given
+// component based on the configured resource profile. Explicitly configured bounds take precedence over the profile.
+func computeComponentResources(component string, resources *operatorv1alpha1.ComponentResources) componentResources {
+	profile := resourceProfiles[component][helper.GetResourceProfile(resources)]
+
+	result := componentResources{
+		requests:   mergeResourceLists(profile.requests),
+		minAllowed: mergeResourceLists(profile.minAllowed),
+		maxAllowed: mergeResourceLists(profile.maxAllowed),
+	}
+
+	if resources != nil {
+		result.minAllowed = mergeResourceLists(result.minAllowed, resources.MinAllowed)
+		result.maxAllowed = mergeResourceLists(result.maxAllowed, resources.MaxAllowed)
+	}
+
+	return result
+}
+
+func resourceList(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+// mergeResourceLists merges the given lists into a new list. Later lists take precedence. It returns nil if all lists
+// are empty.
+func mergeResourceLists(lists ...corev1.ResourceList) corev1.ResourceList {
+	var out corev1.ResourceList
+
+	for _, list := range lists {
+		for name, quantity := range list {
+			if out == nil {
+				out = corev1.ResourceList{}
+			}
+			out[name] = quantity.DeepCopy()
+		}
+	}
+
+	return out
+}
@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
 	"github.com/gardener/gardener/pkg/provider-local/local"
@@ -47,10 +48,11 @@ type AddOptions struct {
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts backupoptions.AddOptions) error {
 	return backupbucket.Add(ctx, mgr, backupbucket.AddArgs{
-		Actuator:          newActuator(mgr, opts.BackupBucketPath),
-		ControllerOptions: opts.Controller,
-		Predicates:        backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
-		Type:              local.Type,
+		Actuator:            newActuator(mgr, opts.BackupBucketPath),
+		ActuatorMiddlewares: extensionscontroller.DefaultActuatorMiddlewares(0),
+		ControllerOptions:   opts.Controller,
+		Predicates:          backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
+		Type:                local.Type,
 	})
 }
 
@@ -19,6 +19,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
@@ -35,10 +36,11 @@ var DefaultAddOptions = backupoptions.AddOptions{}
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts backupoptions.AddOptions) error {
 	return backupentry.Add(ctx, mgr, backupentry.AddArgs{
-		Actuator:          genericactuator.NewActuator(mgr, newActuator(mgr, opts.ContainerMountPath, opts.BackupBucketPath)),
-		ControllerOptions: opts.Controller,
-		Predicates:        backupentry.DefaultPredicates(opts.IgnoreOperationAnnotation),
-		Type:              local.Type,
+		Actuator:            genericactuator.NewActuator(mgr, newActuator(mgr, opts.ContainerMountPath, opts.BackupBucketPath)),
+		ActuatorMiddlewares: extensionscontroller.DefaultActuatorMiddlewares(0),
+		ControllerOptions:   opts.Controller,
+		Predicates:          backupentry.DefaultPredicates(opts.IgnoreOperationAnnotation),
+		Type:                local.Type,
 	})
 }
 
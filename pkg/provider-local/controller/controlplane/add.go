@@ -56,10 +56,11 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	}
 
 	return controlplane.Add(ctx, mgr, controlplane.AddArgs{
-		Actuator:          genericActuator,
-		ControllerOptions: opts.Controller,
-		Predicates:        controlplane.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
-		Type:              local.Type,
+		Actuator:            genericActuator,
+		ActuatorMiddlewares: extensionscontroller.DefaultActuatorMiddlewares(0),
+		ControllerOptions:   opts.Controller,
+		Predicates:          controlplane.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
+		Type:                local.Type,
 	})
 }
 
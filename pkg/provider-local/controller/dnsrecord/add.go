@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
@@ -41,10 +42,11 @@ type AddOptions struct {
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return dnsrecord.Add(ctx, mgr, dnsrecord.AddArgs{
-		Actuator:          NewActuator(mgr),
-		ControllerOptions: opts.Controller,
-		Predicates:        dnsrecord.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
-		Type:              local.Type,
+		Actuator:            NewActuator(mgr),
+		ActuatorMiddlewares: extensionscontroller.DefaultActuatorMiddlewares(0),
+		ControllerOptions:   opts.Controller,
+		Predicates:          dnsrecord.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
+		Type:                local.Type,
 	})
 }
 
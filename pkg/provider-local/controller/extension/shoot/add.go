@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/extension"
 )
 
@@ -48,13 +49,14 @@ type AddOptions struct {
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return extension.Add(ctx, mgr, extension.AddArgs{
-		Actuator:          NewActuator(mgr),
-		ControllerOptions: opts.Controller,
-		Name:              ApplicationName,
-		FinalizerSuffix:   Type,
-		Resync:            60 * time.Minute,
-		Predicates:        extension.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
-		Type:              Type,
+		Actuator:            NewActuator(mgr),
+		ActuatorMiddlewares: extensionscontroller.DefaultActuatorMiddlewares(0),
+		ControllerOptions:   opts.Controller,
+		Name:                ApplicationName,
+		FinalizerSuffix:     Type,
+		Resync:              60 * time.Minute,
+		Predicates:          extension.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
+		Type:                Type,
 	})
 }
 
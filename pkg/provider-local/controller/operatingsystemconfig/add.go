@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
@@ -41,10 +42,11 @@ type AddOptions struct {
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return operatingsystemconfig.Add(mgr, operatingsystemconfig.AddArgs{
-		Actuator:          NewActuator(mgr, opts.UseGardenerNodeAgent),
-		Predicates:        operatingsystemconfig.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
-		Types:             []string{local.Type},
-		ControllerOptions: opts.Controller,
+		Actuator:            NewActuator(mgr, opts.UseGardenerNodeAgent),
+		ActuatorMiddlewares: extensionscontroller.DefaultActuatorMiddlewares(0),
+		Predicates:          operatingsystemconfig.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
+		Types:               []string{local.Type},
+		ControllerOptions:   opts.Controller,
 	})
 }
 
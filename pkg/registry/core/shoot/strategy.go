@@ -62,14 +62,17 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 	shoot := obj.(*core.Shoot)
 
 	shoot.Generation = 1
-	shoot.Status = core.ShootStatus{}
+	// the admission warnings are maintained by the mutating admission plugins
+	shoot.Status = core.ShootStatus{AdmissionWarnings: shoot.Status.AdmissionWarnings}
 }
 
 func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
 	newShoot := obj.(*core.Shoot)
 	oldShoot := old.(*core.Shoot)
 
-	newShoot.Status = oldShoot.Status               // can only be changed by shoots/status subresource
+	admissionWarnings := newShoot.Status.AdmissionWarnings // maintained by the mutating admission plugins
+	newShoot.Status = oldShoot.Status                      // can only be changed by shoots/status subresource
+	newShoot.Status.AdmissionWarnings = admissionWarnings
 	newShoot.Spec.SeedName = oldShoot.Spec.SeedName // can only be changed by shoots/binding subresource
 
 	if mustIncreaseGeneration(oldShoot, newShoot) {
@@ -120,9 +123,12 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 				v1beta1constants.OperationRotateETCDEncryptionKeyStart,
 				v1beta1constants.OperationRotateETCDEncryptionKeyComplete,
 				v1beta1constants.ShootOperationRotateKubeconfigCredentials,
-				v1beta1constants.OperationRotateObservabilityCredentials:
+				v1beta1constants.OperationRotateObservabilityCredentials,
+				v1beta1constants.ShootOperationCollectDiagnostics,
+				v1beta1constants.ShootOperationValidateResilience,
+				v1beta1constants.ShootOperationPreviewDeletion:
 				// We don't want to remove the annotation so that the gardenlet can pick it up and perform
-				// the rotation. It has to remove the annotation after it is done.
+				// the operation. It has to remove the annotation after it is done.
 				mustIncrease, mustRemoveOperationAnnotation = true, false
 
 			case v1beta1constants.ShootOperationRotateSSHKeypair:
@@ -132,6 +138,14 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 				} else {
 					mustIncrease, mustRemoveOperationAnnotation = true, false
 				}
+
+			case v1beta1constants.ShootOperationPlan:
+				if gardencorehelper.IsWorkerless(newShoot) {
+					// Workerless Shoots don't have an infrastructure, hence there is nothing to plan, just remove the annotation
+					mustIncrease, mustRemoveOperationAnnotation = false, true
+				} else {
+					mustIncrease, mustRemoveOperationAnnotation = true, false
+				}
 			}
 		}
 
@@ -286,10 +300,11 @@ func ToSelectableFields(shoot *core.Shoot) fields.Set {
 	// amount of allocations needed to create the fields.Set. If you add any
 	// field here or the number of object-meta related fields changes, this should
 	// be adjusted.
-	shootSpecificFieldsSet := make(fields.Set, 5)
+	shootSpecificFieldsSet := make(fields.Set, 6)
 	shootSpecificFieldsSet[core.ShootSeedName] = getSeedName(shoot)
 	shootSpecificFieldsSet[core.ShootStatusSeedName] = getStatusSeedName(shoot)
 	shootSpecificFieldsSet[core.ShootCloudProfileName] = shoot.Spec.CloudProfileName
+	shootSpecificFieldsSet[core.ShootSecretBindingName] = getSecretBindingName(shoot)
 	return generic.AddObjectMetaFieldsSet(shootSpecificFieldsSet, &shoot.ObjectMeta, true)
 }
 
@@ -322,6 +337,13 @@ func SeedNameTriggerFunc(obj runtime.Object) string {
 	return getSeedName(shoot)
 }
 
+func getSecretBindingName(shoot *core.Shoot) string {
+	if shoot.Spec.SecretBindingName == nil {
+		return ""
+	}
+	return *shoot.Spec.SecretBindingName
+}
+
 func getSeedName(shoot *core.Shoot) string {
 	if shoot.Spec.SeedName == nil {
 		return ""
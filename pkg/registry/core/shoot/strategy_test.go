@@ -64,6 +64,21 @@ var _ = Describe("Strategy", func() {
 	})
 
 	Describe("#PrepareForUpdate", func() {
+		It("should only keep the admission warnings of the new status", func() {
+			oldShoot := &core.Shoot{Status: core.ShootStatus{TechnicalID: "foo"}}
+			newShoot := &core.Shoot{Status: core.ShootStatus{
+				TechnicalID:       "bar",
+				AdmissionWarnings: []core.AdmissionWarning{{Plugin: "ShootValidator", Reason: "Foo", Message: "bar"}},
+			}}
+
+			shootregistry.NewStrategy(0).PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+			Expect(newShoot.Status).To(Equal(core.ShootStatus{
+				TechnicalID:       "foo",
+				AdmissionWarnings: []core.AdmissionWarning{{Plugin: "ShootValidator", Reason: "Foo", Message: "bar"}},
+			}))
+		})
+
 		Context("seedName change", func() {
 			var (
 				oldShoot *core.Shoot
@@ -378,6 +393,36 @@ var _ = Describe("Strategy", func() {
 					true,
 					true,
 				),
+				Entry("collect-diagnostics",
+					v1beta1constants.ShootOperationCollectDiagnostics,
+					nil,
+					true,
+					true,
+				),
+				Entry("validate-resilience",
+					v1beta1constants.ShootOperationValidateResilience,
+					nil,
+					true,
+					true,
+				),
+				Entry("preview-deletion",
+					v1beta1constants.ShootOperationPreviewDeletion,
+					nil,
+					true,
+					true,
+				),
+				Entry("plan",
+					v1beta1constants.ShootOperationPlan,
+					nil,
+					true,
+					true,
+				),
+				Entry("plan (workerless)",
+					v1beta1constants.ShootOperationPlan,
+					func(s *core.Shoot) { s.Spec.Provider.Workers = nil },
+					false,
+					false,
+				),
 
 				Entry("rotate-etcd-encryption-key-start",
 					v1beta1constants.OperationRotateETCDEncryptionKeyStart,
@@ -505,13 +550,15 @@ var _ = Describe("ToSelectableFields", func() {
 	It("should return correct fields", func() {
 		result := shootregistry.ToSelectableFields(newShoot("foo"))
 
-		Expect(result).To(HaveLen(5))
+		Expect(result).To(HaveLen(6))
 		Expect(result.Has(core.ShootSeedName)).To(BeTrue())
 		Expect(result.Get(core.ShootSeedName)).To(Equal("foo"))
 		Expect(result.Has(core.ShootCloudProfileName)).To(BeTrue())
 		Expect(result.Get(core.ShootCloudProfileName)).To(Equal("baz"))
 		Expect(result.Has(core.ShootStatusSeedName)).To(BeTrue())
 		Expect(result.Get(core.ShootStatusSeedName)).To(Equal("foo"))
+		Expect(result.Has(core.ShootSecretBindingName)).To(BeTrue())
+		Expect(result.Get(core.ShootSecretBindingName)).To(Equal("qux"))
 	})
 })
 
@@ -559,8 +606,9 @@ func newShoot(seedName string) *core.Shoot {
 			Labels:    map[string]string{"foo": "bar"},
 		},
 		Spec: core.ShootSpec{
-			CloudProfileName: "baz",
-			SeedName:         &seedName,
+			CloudProfileName:  "baz",
+			SecretBindingName: pointer.String("qux"),
+			SeedName:          &seedName,
 		},
 		Status: core.ShootStatus{
 			SeedName: &seedName,
@@ -0,0 +1,188 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenlet
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/validation"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Strategy defines the strategy for storing gardenlets.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy defines the storage strategy for Gardenlets.
+func NewStrategy() Strategy {
+	return Strategy{api.Scheme, names.SimpleNameGenerator}
+}
+
+// NamespaceScoped returns true if the object must be within a namespace.
+func (Strategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate mutates some fields in the object before it's created.
+func (s Strategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	gardenlet := obj.(*seedmanagement.Gardenlet)
+
+	gardenlet.Generation = 1
+	gardenlet.Status = seedmanagement.GardenletStatus{}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (s Strategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newGardenlet := obj.(*seedmanagement.Gardenlet)
+	oldGardenlet := old.(*seedmanagement.Gardenlet)
+	newGardenlet.Status = oldGardenlet.Status
+
+	if mustIncreaseGeneration(oldGardenlet, newGardenlet) {
+		newGardenlet.Generation = oldGardenlet.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldGardenlet, newGardenlet *seedmanagement.Gardenlet) bool {
+	// The spec changed
+	if !apiequality.Semantic.DeepEqual(oldGardenlet.Spec, newGardenlet.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set
+	if oldGardenlet.DeletionTimestamp == nil && newGardenlet.DeletionTimestamp != nil {
+		return true
+	}
+
+	// The operation annotation was added with value "reconcile"
+	if kubernetesutils.HasMetaDataAnnotation(&newGardenlet.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile) {
+		delete(newGardenlet.Annotations, v1beta1constants.GardenerOperation)
+		return true
+	}
+
+	return false
+}
+
+// Validate validates the given object.
+func (Strategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	gardenlet := obj.(*seedmanagement.Gardenlet)
+	return validation.ValidateGardenlet(gardenlet)
+}
+
+// Canonicalize allows an object to be mutated into a canonical form. This
+// ensures that code that operates on these objects can rely on the common
+// form for things like comparison.  Canonicalize is invoked after
+// validation has succeeded but before the object has been persisted.
+// This method may mutate the object.
+func (Strategy) Canonicalize(_ runtime.Object) {
+}
+
+// AllowCreateOnUpdate returns true if the object can be created by a PUT.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when
+// there is no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// ValidateUpdate validates the update on the given old and new object.
+func (Strategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldGardenlet, newGardenlet := oldObj.(*seedmanagement.Gardenlet), newObj.(*seedmanagement.Gardenlet)
+	return validation.ValidateGardenletUpdate(newGardenlet, oldGardenlet)
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (Strategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (Strategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+// StatusStrategy defines the strategy for storing gardenlet statuses.
+type StatusStrategy struct {
+	Strategy
+}
+
+// NewStatusStrategy defines the storage strategy for the status subresource of Gardenlets.
+func NewStatusStrategy() StatusStrategy {
+	return StatusStrategy{NewStrategy()}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (s StatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newGardenlet := obj.(*seedmanagement.Gardenlet)
+	oldGardenlet := old.(*seedmanagement.Gardenlet)
+	newGardenlet.Spec = oldGardenlet.Spec
+}
+
+// ValidateUpdate validates the update on the given old and new object.
+func (StatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateGardenletStatusUpdate(obj.(*seedmanagement.Gardenlet), old.(*seedmanagement.Gardenlet))
+}
+
+// MatchGardenlet returns a generic matcher for a given label and field selector.
+func MatchGardenlet(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	gardenlet, ok := obj.(*seedmanagement.Gardenlet)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a Gardenlet")
+	}
+	return labels.Set(gardenlet.ObjectMeta.Labels), ToSelectableFields(gardenlet), nil
+}
+
+// ToSelectableFields returns a field set that represents the object.
+func ToSelectableFields(gardenlet *seedmanagement.Gardenlet) fields.Set {
+	// The purpose of allocation with a given number of elements is to reduce
+	// amount of allocations needed to create the fields.Set. If you add any
+	// field here or the number of object-meta related fields changes, this should
+	// be adjusted.
+	fieldsSet := make(fields.Set, 2)
+	return generic.AddObjectMetaFieldsSet(fieldsSet, &gardenlet.ObjectMeta, true)
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenlet_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+	. "github.com/gardener/gardener/pkg/registry/seedmanagement/gardenlet"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx      = context.TODO()
+		strategy = Strategy{}
+	)
+
+	Describe("#PrepareForUpdate", func() {
+		var oldGardenlet, newGardenlet *seedmanagement.Gardenlet
+
+		BeforeEach(func() {
+			oldGardenlet = &seedmanagement.Gardenlet{}
+			newGardenlet = &seedmanagement.Gardenlet{}
+		})
+
+		It("should increase the generation if the spec has changed", func() {
+			newGardenlet.Spec.Deployment.ReplicaCount = pointer.Int32(1)
+
+			strategy.PrepareForUpdate(ctx, newGardenlet, oldGardenlet)
+			Expect(newGardenlet.Generation).To(Equal(oldGardenlet.Generation + 1))
+		})
+
+		It("should increase the generation if the deletion timestamp is set", func() {
+			deletionTimestamp := metav1.Now()
+			newGardenlet.DeletionTimestamp = &deletionTimestamp
+
+			strategy.PrepareForUpdate(ctx, newGardenlet, oldGardenlet)
+			Expect(newGardenlet.Generation).To(Equal(oldGardenlet.Generation + 1))
+		})
+
+		It("should increase the generation if the operation annotation with value reconcile was added", func() {
+			newGardenlet.Annotations = map[string]string{
+				v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile,
+			}
+
+			strategy.PrepareForUpdate(ctx, newGardenlet, oldGardenlet)
+			Expect(newGardenlet.Generation).To(Equal(oldGardenlet.Generation + 1))
+			Expect(newGardenlet.Annotations).To(BeEmpty())
+		})
+
+		It("should not increase the generation if neither the spec has changed nor the deletion timestamp is set", func() {
+			strategy.PrepareForUpdate(ctx, newGardenlet, oldGardenlet)
+			Expect(newGardenlet.Generation).To(Equal(oldGardenlet.Generation))
+		})
+	})
+})
+
+var _ = Describe("ToSelectableFields", func() {
+	It("should return correct fields", func() {
+		result := ToSelectableFields(newGardenlet())
+
+		Expect(result).To(HaveLen(2))
+	})
+})
+
+var _ = Describe("GetAttrs", func() {
+	It("should return error when object is not Gardenlet", func() {
+		_, _, err := GetAttrs(&core.Seed{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return correct result", func() {
+		ls, _, err := GetAttrs(newGardenlet())
+
+		Expect(ls).To(HaveLen(1))
+		Expect(ls.Get("foo")).To(Equal("bar"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+var _ = Describe("MatchGardenlet", func() {
+	It("should return correct predicate", func() {
+		ls, _ := labels.Parse("app=test")
+
+		result := MatchGardenlet(ls, nil)
+
+		Expect(result.Label).To(Equal(ls))
+	})
+})
+
+func newGardenlet() *seedmanagement.Gardenlet {
+	return &seedmanagement.Gardenlet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"foo": "bar"},
+		},
+		Spec: seedmanagement.GardenletSpec{},
+	}
+}
@@ -114,6 +114,8 @@ type ResourceManagerControllerConfiguration struct {
 	TokenInvalidator TokenInvalidatorControllerConfig
 	// TokenRequestor is the configuration for the token-requestor controller.
 	TokenRequestor TokenRequestorControllerConfig
+	// TrustedCABundle is the configuration for the trusted-ca-bundle controller.
+	TrustedCABundle TrustedCABundleControllerConfig
 }
 
 // KubeletCSRApproverControllerConfig is the configuration for the kubelet-csr-approver controller.
@@ -202,6 +204,14 @@ type TokenRequestorControllerConfig struct {
 	ConcurrentSyncs *int
 }
 
+// TrustedCABundleControllerConfig is the configuration for the trusted-ca-bundle controller.
+type TrustedCABundleControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	ConcurrentSyncs *int
+}
+
 // NodeControllerConfig is the configuration for the node controller.
 type NodeControllerConfig struct {
 	// Enabled defines whether this controller is enabled.
@@ -216,6 +226,8 @@ type NodeControllerConfig struct {
 type ResourceManagerWebhookConfiguration struct {
 	// CRDDeletionProtection is the configuration for the crd-deletion-protection webhook.
 	CRDDeletionProtection CRDDeletionProtection
+	// DeprecatedAPICallers is the configuration for the deprecated-api-callers webhook.
+	DeprecatedAPICallers DeprecatedAPICallersWebhookConfig
 	// EndpointSliceHints is the configuration for the endpoint-slice-hints webhook.
 	EndpointSliceHints EndpointSliceHintsWebhookConfig
 	// ExtensionValidation is the configuration for the extension-validation webhook.
@@ -226,6 +238,8 @@ type ResourceManagerWebhookConfiguration struct {
 	KubernetesServiceHost KubernetesServiceHostWebhookConfig
 	// PodSchedulerName is the configuration for the pod-scheduler-name webhook.
 	PodSchedulerName PodSchedulerNameWebhookConfig
+	// PodSecurityContext is the configuration for the pod-security-context webhook.
+	PodSecurityContext PodSecurityContextWebhookConfig
 	// PodTopologySpreadConstraints is the configuration for the pod-topology-spread-constraints webhook.
 	PodTopologySpreadConstraints PodTopologySpreadConstraintsWebhookConfig
 	// ProjectedTokenMount is the configuration for the projected-token-mount webhook.
@@ -244,6 +258,14 @@ type CRDDeletionProtection struct {
 	Enabled bool
 }
 
+// DeprecatedAPICallersWebhookConfig is the configuration for the deprecated-api-callers webhook.
+type DeprecatedAPICallersWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool
+	// Namespace is the namespace in the source cluster in which the callers of deprecated APIs are stored.
+	Namespace string
+}
+
 // EndpointSliceHintsWebhookConfig is the configuration for the endpoint-slice-hints webhook.
 type EndpointSliceHintsWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
@@ -296,6 +318,14 @@ type PodSchedulerNameWebhookConfig struct {
 	SchedulerName *string
 }
 
+// PodSecurityContextWebhookConfig is the configuration for the pod-security-context webhook.
+type PodSecurityContextWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool
+	// DryRun defines whether the webhook only reports the changes it would apply to pods instead of applying them.
+	DryRun bool
+}
+
 // PodTopologySpreadConstraintsWebhookConfig is the configuration for the pod-topology-spread-constraints webhook.
 type PodTopologySpreadConstraintsWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
@@ -173,6 +173,13 @@ func SetDefaults_TokenRequestorControllerConfig(obj *TokenRequestorControllerCon
 	}
 }
 
+// SetDefaults_TrustedCABundleControllerConfig sets defaults for the TrustedCABundleControllerConfig object.
+func SetDefaults_TrustedCABundleControllerConfig(obj *TrustedCABundleControllerConfig) {
+	if obj.Enabled && obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = pointer.Int(5)
+	}
+}
+
 // SetDefaults_NodeControllerConfig sets defaults for the NodeControllerConfig object.
 func SetDefaults_NodeControllerConfig(obj *NodeControllerConfig) {
 	if obj.Enabled {
@@ -407,6 +407,37 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("#SetDefaults_TrustedCABundleControllerConfig", func() {
+		It("should not default the object because disabled", func() {
+			obj := &TrustedCABundleControllerConfig{}
+
+			SetDefaults_TrustedCABundleControllerConfig(obj)
+
+			Expect(obj.ConcurrentSyncs).To(BeNil())
+		})
+
+		It("should default the object because enabled", func() {
+			obj := &TrustedCABundleControllerConfig{
+				Enabled: true,
+			}
+
+			SetDefaults_TrustedCABundleControllerConfig(obj)
+
+			Expect(obj.ConcurrentSyncs).To(PointTo(Equal(5)))
+		})
+
+		It("should not overwrite existing values", func() {
+			obj := &TrustedCABundleControllerConfig{
+				Enabled:         true,
+				ConcurrentSyncs: pointer.Int(2),
+			}
+
+			SetDefaults_TrustedCABundleControllerConfig(obj)
+
+			Expect(obj.ConcurrentSyncs).To(PointTo(Equal(2)))
+		})
+	})
+
 	Describe("#SetDefaults_NodeControllerConfig", func() {
 		It("should not default the object because disabled", func() {
 			obj := &NodeControllerConfig{}
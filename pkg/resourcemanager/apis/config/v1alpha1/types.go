@@ -123,6 +123,8 @@ type ResourceManagerControllerConfiguration struct {
 	TokenInvalidator TokenInvalidatorControllerConfig `json:"tokenInvalidator"`
 	// TokenRequestor is the configuration for the token-requestor controller.
 	TokenRequestor TokenRequestorControllerConfig `json:"tokenRequestor"`
+	// TrustedCABundle is the configuration for the trusted-ca-bundle controller.
+	TrustedCABundle TrustedCABundleControllerConfig `json:"trustedCABundle"`
 }
 
 // KubeletCSRApproverControllerConfig is the configuration for the kubelet-csr-approver controller.
@@ -225,6 +227,15 @@ type TokenRequestorControllerConfig struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// TrustedCABundleControllerConfig is the configuration for the trusted-ca-bundle controller.
+type TrustedCABundleControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // NodeControllerConfig is the configuration for the node controller.
 type NodeControllerConfig struct {
 	// Enabled defines whether this controller is enabled.
@@ -241,6 +252,8 @@ type NodeControllerConfig struct {
 type ResourceManagerWebhookConfiguration struct {
 	// CRDDeletionProtection is the configuration for the crd-deletion-protection webhook.
 	CRDDeletionProtection CRDDeletionProtection `json:"crdDeletionProtection"`
+	// DeprecatedAPICallers is the configuration for the deprecated-api-callers webhook.
+	DeprecatedAPICallers DeprecatedAPICallersWebhookConfig `json:"deprecatedAPICallers"`
 	// EndpointSliceHints is the configuration for the endpoint-slice-hints webhook.
 	EndpointSliceHints EndpointSliceHintsWebhookConfig `json:"endpointSliceHints"`
 	// ExtensionValidation is the configuration for the extension-validation webhook.
@@ -253,6 +266,8 @@ type ResourceManagerWebhookConfiguration struct {
 	SystemComponentsConfig SystemComponentsConfigWebhookConfig `json:"systemComponentsConfig"`
 	// PodSchedulerName is the configuration for the pod-scheduler-name webhook.
 	PodSchedulerName PodSchedulerNameWebhookConfig `json:"podSchedulerName"`
+	// PodSecurityContext is the configuration for the pod-security-context webhook.
+	PodSecurityContext PodSecurityContextWebhookConfig `json:"podSecurityContext"`
 	// PodTopologySpreadConstraints is the configuration for the pod-topology-spread-constraints webhook.
 	PodTopologySpreadConstraints PodTopologySpreadConstraintsWebhookConfig `json:"podTopologySpreadConstraints"`
 	// ProjectedTokenMount is the configuration for the projected-token-mount webhook.
@@ -269,6 +284,15 @@ type CRDDeletionProtection struct {
 	Enabled bool `json:"enabled"`
 }
 
+// DeprecatedAPICallersWebhookConfig is the configuration for the deprecated-api-callers webhook.
+type DeprecatedAPICallersWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// Namespace is the namespace in the source cluster in which the callers of deprecated APIs are stored.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
 // EndpointSliceHintsWebhookConfig is the configuration for the endpoint-slice-hints webhook.
 type EndpointSliceHintsWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
@@ -327,6 +351,15 @@ type PodSchedulerNameWebhookConfig struct {
 	SchedulerName *string `json:"schedulerName,omitempty"`
 }
 
+// PodSecurityContextWebhookConfig is the configuration for the pod-security-context webhook.
+type PodSecurityContextWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// DryRun defines whether the webhook only reports the changes it would apply to pods instead of applying them.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
 // PodTopologySpreadConstraintsWebhookConfig is the configuration for the pod-topology-spread-constraints webhook.
 type PodTopologySpreadConstraintsWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
@@ -60,6 +60,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeprecatedAPICallersWebhookConfig)(nil), (*config.DeprecatedAPICallersWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeprecatedAPICallersWebhookConfig_To_config_DeprecatedAPICallersWebhookConfig(a.(*DeprecatedAPICallersWebhookConfig), b.(*config.DeprecatedAPICallersWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DeprecatedAPICallersWebhookConfig)(nil), (*DeprecatedAPICallersWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DeprecatedAPICallersWebhookConfig_To_v1alpha1_DeprecatedAPICallersWebhookConfig(a.(*config.DeprecatedAPICallersWebhookConfig), b.(*DeprecatedAPICallersWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EndpointSliceHintsWebhookConfig)(nil), (*config.EndpointSliceHintsWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EndpointSliceHintsWebhookConfig_To_config_EndpointSliceHintsWebhookConfig(a.(*EndpointSliceHintsWebhookConfig), b.(*config.EndpointSliceHintsWebhookConfig), scope)
 	}); err != nil {
@@ -190,6 +200,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodSecurityContextWebhookConfig)(nil), (*config.PodSecurityContextWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodSecurityContextWebhookConfig_To_config_PodSecurityContextWebhookConfig(a.(*PodSecurityContextWebhookConfig), b.(*config.PodSecurityContextWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PodSecurityContextWebhookConfig)(nil), (*PodSecurityContextWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PodSecurityContextWebhookConfig_To_v1alpha1_PodSecurityContextWebhookConfig(a.(*config.PodSecurityContextWebhookConfig), b.(*PodSecurityContextWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodTopologySpreadConstraintsWebhookConfig)(nil), (*config.PodTopologySpreadConstraintsWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodTopologySpreadConstraintsWebhookConfig_To_config_PodTopologySpreadConstraintsWebhookConfig(a.(*PodTopologySpreadConstraintsWebhookConfig), b.(*config.PodTopologySpreadConstraintsWebhookConfig), scope)
 	}); err != nil {
@@ -330,6 +350,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TrustedCABundleControllerConfig)(nil), (*config.TrustedCABundleControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrustedCABundleControllerConfig_To_config_TrustedCABundleControllerConfig(a.(*TrustedCABundleControllerConfig), b.(*config.TrustedCABundleControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TrustedCABundleControllerConfig)(nil), (*TrustedCABundleControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TrustedCABundleControllerConfig_To_v1alpha1_TrustedCABundleControllerConfig(a.(*config.TrustedCABundleControllerConfig), b.(*TrustedCABundleControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -381,6 +411,28 @@ func Convert_config_ClientConnection_To_v1alpha1_ClientConnection(in *config.Cli
 	return autoConvert_config_ClientConnection_To_v1alpha1_ClientConnection(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeprecatedAPICallersWebhookConfig_To_config_DeprecatedAPICallersWebhookConfig(in *DeprecatedAPICallersWebhookConfig, out *config.DeprecatedAPICallersWebhookConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha1_DeprecatedAPICallersWebhookConfig_To_config_DeprecatedAPICallersWebhookConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DeprecatedAPICallersWebhookConfig_To_config_DeprecatedAPICallersWebhookConfig(in *DeprecatedAPICallersWebhookConfig, out *config.DeprecatedAPICallersWebhookConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeprecatedAPICallersWebhookConfig_To_config_DeprecatedAPICallersWebhookConfig(in, out, s)
+}
+
+func autoConvert_config_DeprecatedAPICallersWebhookConfig_To_v1alpha1_DeprecatedAPICallersWebhookConfig(in *config.DeprecatedAPICallersWebhookConfig, out *DeprecatedAPICallersWebhookConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_config_DeprecatedAPICallersWebhookConfig_To_v1alpha1_DeprecatedAPICallersWebhookConfig is an autogenerated conversion function.
+func Convert_config_DeprecatedAPICallersWebhookConfig_To_v1alpha1_DeprecatedAPICallersWebhookConfig(in *config.DeprecatedAPICallersWebhookConfig, out *DeprecatedAPICallersWebhookConfig, s conversion.Scope) error {
+	return autoConvert_config_DeprecatedAPICallersWebhookConfig_To_v1alpha1_DeprecatedAPICallersWebhookConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_EndpointSliceHintsWebhookConfig_To_config_EndpointSliceHintsWebhookConfig(in *EndpointSliceHintsWebhookConfig, out *config.EndpointSliceHintsWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -685,6 +737,28 @@ func Convert_config_PodSchedulerNameWebhookConfig_To_v1alpha1_PodSchedulerNameWe
 	return autoConvert_config_PodSchedulerNameWebhookConfig_To_v1alpha1_PodSchedulerNameWebhookConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_PodSecurityContextWebhookConfig_To_config_PodSecurityContextWebhookConfig(in *PodSecurityContextWebhookConfig, out *config.PodSecurityContextWebhookConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_v1alpha1_PodSecurityContextWebhookConfig_To_config_PodSecurityContextWebhookConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PodSecurityContextWebhookConfig_To_config_PodSecurityContextWebhookConfig(in *PodSecurityContextWebhookConfig, out *config.PodSecurityContextWebhookConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodSecurityContextWebhookConfig_To_config_PodSecurityContextWebhookConfig(in, out, s)
+}
+
+func autoConvert_config_PodSecurityContextWebhookConfig_To_v1alpha1_PodSecurityContextWebhookConfig(in *config.PodSecurityContextWebhookConfig, out *PodSecurityContextWebhookConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_config_PodSecurityContextWebhookConfig_To_v1alpha1_PodSecurityContextWebhookConfig is an autogenerated conversion function.
+func Convert_config_PodSecurityContextWebhookConfig_To_v1alpha1_PodSecurityContextWebhookConfig(in *config.PodSecurityContextWebhookConfig, out *PodSecurityContextWebhookConfig, s conversion.Scope) error {
+	return autoConvert_config_PodSecurityContextWebhookConfig_To_v1alpha1_PodSecurityContextWebhookConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodTopologySpreadConstraintsWebhookConfig_To_config_PodTopologySpreadConstraintsWebhookConfig(in *PodTopologySpreadConstraintsWebhookConfig, out *config.PodTopologySpreadConstraintsWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -845,6 +919,9 @@ func autoConvert_v1alpha1_ResourceManagerControllerConfiguration_To_config_Resou
 	if err := Convert_v1alpha1_TokenRequestorControllerConfig_To_config_TokenRequestorControllerConfig(&in.TokenRequestor, &out.TokenRequestor, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_TrustedCABundleControllerConfig_To_config_TrustedCABundleControllerConfig(&in.TrustedCABundle, &out.TrustedCABundle, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -883,6 +960,9 @@ func autoConvert_config_ResourceManagerControllerConfiguration_To_v1alpha1_Resou
 	if err := Convert_config_TokenRequestorControllerConfig_To_v1alpha1_TokenRequestorControllerConfig(&in.TokenRequestor, &out.TokenRequestor, s); err != nil {
 		return err
 	}
+	if err := Convert_config_TrustedCABundleControllerConfig_To_v1alpha1_TrustedCABundleControllerConfig(&in.TrustedCABundle, &out.TrustedCABundle, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -895,6 +975,9 @@ func autoConvert_v1alpha1_ResourceManagerWebhookConfiguration_To_config_Resource
 	if err := Convert_v1alpha1_CRDDeletionProtection_To_config_CRDDeletionProtection(&in.CRDDeletionProtection, &out.CRDDeletionProtection, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_DeprecatedAPICallersWebhookConfig_To_config_DeprecatedAPICallersWebhookConfig(&in.DeprecatedAPICallers, &out.DeprecatedAPICallers, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_EndpointSliceHintsWebhookConfig_To_config_EndpointSliceHintsWebhookConfig(&in.EndpointSliceHints, &out.EndpointSliceHints, s); err != nil {
 		return err
 	}
@@ -913,6 +996,9 @@ func autoConvert_v1alpha1_ResourceManagerWebhookConfiguration_To_config_Resource
 	if err := Convert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(&in.PodSchedulerName, &out.PodSchedulerName, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_PodSecurityContextWebhookConfig_To_config_PodSecurityContextWebhookConfig(&in.PodSecurityContext, &out.PodSecurityContext, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_PodTopologySpreadConstraintsWebhookConfig_To_config_PodTopologySpreadConstraintsWebhookConfig(&in.PodTopologySpreadConstraints, &out.PodTopologySpreadConstraints, s); err != nil {
 		return err
 	}
@@ -937,6 +1023,9 @@ func autoConvert_config_ResourceManagerWebhookConfiguration_To_v1alpha1_Resource
 	if err := Convert_config_CRDDeletionProtection_To_v1alpha1_CRDDeletionProtection(&in.CRDDeletionProtection, &out.CRDDeletionProtection, s); err != nil {
 		return err
 	}
+	if err := Convert_config_DeprecatedAPICallersWebhookConfig_To_v1alpha1_DeprecatedAPICallersWebhookConfig(&in.DeprecatedAPICallers, &out.DeprecatedAPICallers, s); err != nil {
+		return err
+	}
 	if err := Convert_config_EndpointSliceHintsWebhookConfig_To_v1alpha1_EndpointSliceHintsWebhookConfig(&in.EndpointSliceHints, &out.EndpointSliceHints, s); err != nil {
 		return err
 	}
@@ -952,6 +1041,9 @@ func autoConvert_config_ResourceManagerWebhookConfiguration_To_v1alpha1_Resource
 	if err := Convert_config_PodSchedulerNameWebhookConfig_To_v1alpha1_PodSchedulerNameWebhookConfig(&in.PodSchedulerName, &out.PodSchedulerName, s); err != nil {
 		return err
 	}
+	if err := Convert_config_PodSecurityContextWebhookConfig_To_v1alpha1_PodSecurityContextWebhookConfig(&in.PodSecurityContext, &out.PodSecurityContext, s); err != nil {
+		return err
+	}
 	if err := Convert_config_PodTopologySpreadConstraintsWebhookConfig_To_v1alpha1_PodTopologySpreadConstraintsWebhookConfig(&in.PodTopologySpreadConstraints, &out.PodTopologySpreadConstraints, s); err != nil {
 		return err
 	}
@@ -1174,3 +1266,25 @@ func autoConvert_config_TokenRequestorControllerConfig_To_v1alpha1_TokenRequesto
 func Convert_config_TokenRequestorControllerConfig_To_v1alpha1_TokenRequestorControllerConfig(in *config.TokenRequestorControllerConfig, out *TokenRequestorControllerConfig, s conversion.Scope) error {
 	return autoConvert_config_TokenRequestorControllerConfig_To_v1alpha1_TokenRequestorControllerConfig(in, out, s)
 }
+
+func autoConvert_v1alpha1_TrustedCABundleControllerConfig_To_config_TrustedCABundleControllerConfig(in *TrustedCABundleControllerConfig, out *config.TrustedCABundleControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_TrustedCABundleControllerConfig_To_config_TrustedCABundleControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TrustedCABundleControllerConfig_To_config_TrustedCABundleControllerConfig(in *TrustedCABundleControllerConfig, out *config.TrustedCABundleControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrustedCABundleControllerConfig_To_config_TrustedCABundleControllerConfig(in, out, s)
+}
+
+func autoConvert_config_TrustedCABundleControllerConfig_To_v1alpha1_TrustedCABundleControllerConfig(in *config.TrustedCABundleControllerConfig, out *TrustedCABundleControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_TrustedCABundleControllerConfig_To_v1alpha1_TrustedCABundleControllerConfig is an autogenerated conversion function.
+func Convert_config_TrustedCABundleControllerConfig_To_v1alpha1_TrustedCABundleControllerConfig(in *config.TrustedCABundleControllerConfig, out *TrustedCABundleControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_TrustedCABundleControllerConfig_To_v1alpha1_TrustedCABundleControllerConfig(in, out, s)
+}
@@ -55,6 +55,7 @@ func SetObjectDefaults_ResourceManagerConfiguration(in *ResourceManagerConfigura
 	SetDefaults_SecretControllerConfig(&in.Controllers.Secret)
 	SetDefaults_TokenInvalidatorControllerConfig(&in.Controllers.TokenInvalidator)
 	SetDefaults_TokenRequestorControllerConfig(&in.Controllers.TokenRequestor)
+	SetDefaults_TrustedCABundleControllerConfig(&in.Controllers.TrustedCABundle)
 	SetDefaults_PodSchedulerNameWebhookConfig(&in.Webhooks.PodSchedulerName)
 	SetDefaults_ProjectedTokenMountWebhookConfig(&in.Webhooks.ProjectedTokenMount)
 }
@@ -134,6 +134,7 @@ func validateManagedResourceControllerConfiguration(conf config.ManagedResourceC
 func validateResourceManagerWebhookConfiguration(conf config.ResourceManagerWebhookConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	allErrs = append(allErrs, validateDeprecatedAPICallersWebhookConfiguration(conf.DeprecatedAPICallers, fldPath.Child("deprecatedAPICallers"))...)
 	allErrs = append(allErrs, validatePodSchedulerNameWebhookConfiguration(conf.PodSchedulerName, fldPath.Child("podSchedulerName"))...)
 	allErrs = append(allErrs, validateProjectedTokenMountWebhookConfiguration(conf.ProjectedTokenMount, fldPath.Child("projectedTokenMount"))...)
 	allErrs = append(allErrs, validateHighAvailabilityConfigWebhookConfiguration(conf.HighAvailabilityConfig, fldPath.Child("highAvailabilityConfig"))...)
@@ -142,6 +143,16 @@ func validateResourceManagerWebhookConfiguration(conf config.ResourceManagerWebh
 	return allErrs
 }
 
+func validateDeprecatedAPICallersWebhookConfiguration(conf config.DeprecatedAPICallersWebhookConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.Enabled && len(conf.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "must specify namespace when webhook is enabled"))
+	}
+
+	return allErrs
+}
+
 func validatePodSchedulerNameWebhookConfiguration(conf config.PodSchedulerNameWebhookConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
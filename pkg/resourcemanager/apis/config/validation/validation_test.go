@@ -324,6 +324,26 @@ var _ = Describe("Validation", func() {
 		})
 
 		Context("webhook configuration", func() {
+			Context("deprecated api callers", func() {
+				It("should return errors when namespace is empty", func() {
+					conf.Webhooks.DeprecatedAPICallers.Enabled = true
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("webhooks.deprecatedAPICallers.namespace"),
+						})),
+					))
+				})
+
+				It("should succeed when namespace is set", func() {
+					conf.Webhooks.DeprecatedAPICallers.Enabled = true
+					conf.Webhooks.DeprecatedAPICallers.Namespace = "shoot--foo--bar"
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+			})
+
 			Context("pod scheduler name", func() {
 				It("should return errors when scheduler name is nil", func() {
 					conf.Webhooks.PodSchedulerName.Enabled = true
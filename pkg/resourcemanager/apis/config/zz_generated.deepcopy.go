@@ -71,6 +71,22 @@ func (in *ClientConnection) DeepCopy() *ClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeprecatedAPICallersWebhookConfig) DeepCopyInto(out *DeprecatedAPICallersWebhookConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeprecatedAPICallersWebhookConfig.
+func (in *DeprecatedAPICallersWebhookConfig) DeepCopy() *DeprecatedAPICallersWebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DeprecatedAPICallersWebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointSliceHintsWebhookConfig) DeepCopyInto(out *EndpointSliceHintsWebhookConfig) {
 	*out = *in
@@ -364,6 +380,22 @@ func (in *PodSchedulerNameWebhookConfig) DeepCopy() *PodSchedulerNameWebhookConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityContextWebhookConfig) DeepCopyInto(out *PodSecurityContextWebhookConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityContextWebhookConfig.
+func (in *PodSecurityContextWebhookConfig) DeepCopy() *PodSecurityContextWebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityContextWebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodTopologySpreadConstraintsWebhookConfig) DeepCopyInto(out *PodTopologySpreadConstraintsWebhookConfig) {
 	*out = *in
@@ -463,6 +495,7 @@ func (in *ResourceManagerControllerConfiguration) DeepCopyInto(out *ResourceMana
 	in.Secret.DeepCopyInto(&out.Secret)
 	in.TokenInvalidator.DeepCopyInto(&out.TokenInvalidator)
 	in.TokenRequestor.DeepCopyInto(&out.TokenRequestor)
+	in.TrustedCABundle.DeepCopyInto(&out.TrustedCABundle)
 	return
 }
 
@@ -480,11 +513,13 @@ func (in *ResourceManagerControllerConfiguration) DeepCopy() *ResourceManagerCon
 func (in *ResourceManagerWebhookConfiguration) DeepCopyInto(out *ResourceManagerWebhookConfiguration) {
 	*out = *in
 	out.CRDDeletionProtection = in.CRDDeletionProtection
+	out.DeprecatedAPICallers = in.DeprecatedAPICallers
 	out.EndpointSliceHints = in.EndpointSliceHints
 	out.ExtensionValidation = in.ExtensionValidation
 	in.HighAvailabilityConfig.DeepCopyInto(&out.HighAvailabilityConfig)
 	out.KubernetesServiceHost = in.KubernetesServiceHost
 	in.PodSchedulerName.DeepCopyInto(&out.PodSchedulerName)
+	out.PodSecurityContext = in.PodSecurityContext
 	out.PodTopologySpreadConstraints = in.PodTopologySpreadConstraints
 	in.ProjectedTokenMount.DeepCopyInto(&out.ProjectedTokenMount)
 	out.SeccompProfile = in.SeccompProfile
@@ -693,3 +728,24 @@ func (in *TokenRequestorControllerConfig) DeepCopy() *TokenRequestorControllerCo
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedCABundleControllerConfig) DeepCopyInto(out *TrustedCABundleControllerConfig) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedCABundleControllerConfig.
+func (in *TrustedCABundleControllerConfig) DeepCopy() *TrustedCABundleControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedCABundleControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/secret"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/tokeninvalidator"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/trustedcabundle"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
@@ -125,6 +126,14 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		}
 	}
 
+	if cfg.Controllers.TrustedCABundle.Enabled {
+		if err := (&trustedcabundle.Reconciler{
+			Config: cfg.Controllers.TrustedCABundle,
+		}).AddToManager(ctx, mgr, targetCluster); err != nil {
+			return fmt.Errorf("failed adding trusted CA bundle controller: %w", err)
+		}
+	}
+
 	if cfg.Controllers.Node.Enabled {
 		if err := (&node.Reconciler{
 			Config: cfg.Controllers.Node,
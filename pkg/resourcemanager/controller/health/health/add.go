@@ -17,9 +17,11 @@ package health
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
@@ -86,11 +88,15 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 	}
 
 	lock := sync.RWMutex{}
-	watchedObjectGVKs := make(map[schema.GroupVersionKind]struct{})
+	watchedObjectGVKs := make(map[watchKey]struct{})
 	r.ensureWatchForGVK = func(gvk schema.GroupVersionKind, obj client.Object) error {
+		// The same GVK might be watched with different object types, e.g. metadata-only and unstructured if only some
+		// ManagedResources define a custom health check for it.
+		key := watchKey{gvk: gvk, objectType: reflect.TypeOf(obj)}
+
 		// fast-check: have we already added watch for this GVK?
 		lock.RLock()
-		if _, ok := watchedObjectGVKs[gvk]; ok {
+		if _, ok := watchedObjectGVKs[key]; ok {
 			lock.RUnlock()
 			return nil
 		}
@@ -100,12 +106,13 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 		// the watch and the second one should return now.
 		lock.Lock()
 		defer lock.Unlock()
-		if _, ok := watchedObjectGVKs[gvk]; ok {
+		if _, ok := watchedObjectGVKs[key]; ok {
 			return nil
 		}
 
 		_, metadataOnly := obj.(*metav1.PartialObjectMetadata)
-		c.GetLogger().Info("Adding new watch for GroupVersionKind", "groupVersionKind", gvk, "metadataOnly", metadataOnly)
+		_, isUnstructured := obj.(*unstructured.Unstructured)
+		c.GetLogger().Info("Adding new watch for GroupVersionKind", "groupVersionKind", gvk, "metadataOnly", metadataOnly, "unstructured", isUnstructured)
 
 		if err := c.Watch(
 			source.Kind(targetCluster.GetCache(), obj),
@@ -115,13 +122,18 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
 		}
 
-		watchedObjectGVKs[gvk] = struct{}{}
+		watchedObjectGVKs[key] = struct{}{}
 		return nil
 	}
 
 	return nil
 }
 
+type watchKey struct {
+	gvk        schema.GroupVersionKind
+	objectType reflect.Type
+}
+
 // EnqueueCreateAndUpdate returns an event handler which only enqueues create and update events.
 func (r *Reconciler) EnqueueCreateAndUpdate() handler.EventHandler {
 	return &handler.Funcs{
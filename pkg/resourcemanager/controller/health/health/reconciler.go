@@ -23,6 +23,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/clock"
@@ -115,9 +116,11 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			objectGVK = ref.GroupVersionKind()
 			objectKey = client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
 			objectLog = log.WithValues("object", objectKey, "objectGVK", objectGVK)
+
+			customHealthCheck = utils.CustomHealthCheckFor(mr, objectGVK.GroupKind())
 		)
 
-		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK)
+		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK, customHealthCheck != nil)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
 		}
@@ -150,7 +153,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 		}
 
-		if checked, err := utils.CheckHealth(obj); err != nil {
+		if checked, err := checkHealth(obj, customHealthCheck); err != nil {
 			var (
 				reason  = ref.Kind + "Unhealthy"
 				message = fmt.Sprintf("%s %q is unhealthy: %v", ref.Kind, objectKey.String(), err)
@@ -196,7 +199,29 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
-func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind) (client.Object, error) {
+// checkHealth checks whether the given object is healthy. If the ManagedResource defines a custom health check for the
+// object's kind, it takes precedence over the built-in health checks.
+func checkHealth(obj client.Object, customHealthCheck *resourcesv1alpha1.HealthCheck) (bool, error) {
+	if customHealthCheck == nil {
+		return utils.CheckHealth(obj)
+	}
+
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, nil
+	}
+
+	return utils.CheckHealthWithExpression(obj.(*unstructured.Unstructured).UnstructuredContent(), customHealthCheck)
+}
+
+func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind, customHealthCheck bool) (client.Object, error) {
+	// If the ManagedResource defines a custom health check for the GVK, the CEL expression needs access to the entire
+	// object. Use an unstructured object, so that this works independent of whether the GVK is registered in the scheme.
+	if customHealthCheck {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		return obj, nil
+	}
+
 	// Create a typed object if GVK is registered in scheme. This object will be fully watched in the target cluster.
 	// If we don't know the GVK, we definitely don't have a dedicated health check for it.
 	// I.e., we only care about whether the object is present or not.
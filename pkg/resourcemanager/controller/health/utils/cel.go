@@ -0,0 +1,108 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// celCostLimit limits the runtime cost of evaluating a single health check expression, see
+// https://github.com/google/cel-spec/blob/master/doc/langdef.md#cost-estimation.
+const celCostLimit = 1000000
+
+var (
+	celEnv      *cel.Env
+	celPrograms sync.Map
+)
+
+func init() {
+	var err error
+	celEnv, err = cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// CustomHealthCheckFor returns the health check of the given ManagedResource for objects of the given GroupKind. It
+// returns nil if there is no such health check.
+func CustomHealthCheckFor(mr *resourcesv1alpha1.ManagedResource, groupKind schema.GroupKind) *resourcesv1alpha1.HealthCheck {
+	for i, healthCheck := range mr.Spec.HealthChecks {
+		if healthCheck.Group == groupKind.Group && healthCheck.Kind == groupKind.Kind {
+			return &mr.Spec.HealthChecks[i]
+		}
+	}
+	return nil
+}
+
+// CheckHealthWithExpression checks whether the given object content is healthy according to the CEL expression of the
+// given health check.
+// It returns a bool indicating whether the object was actually checked and an error if the health check failed.
+func CheckHealthWithExpression(content map[string]interface{}, healthCheck *resourcesv1alpha1.HealthCheck) (bool, error) {
+	program, err := compileExpression(healthCheck.Expression)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := program.Eval(map[string]interface{}{"self": content})
+	if err != nil {
+		return false, fmt.Errorf("failed evaluating expression %q: %w", healthCheck.Expression, err)
+	}
+
+	healthy, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q evaluated to %T instead of bool", healthCheck.Expression, out.Value())
+	}
+
+	if !healthy {
+		if healthCheck.Message != "" {
+			return true, errors.New(healthCheck.Message)
+		}
+		return true, fmt.Errorf("expression %q evaluated to false", healthCheck.Expression)
+	}
+
+	return true, nil
+}
+
+// compileExpression compiles the given CEL expression. Programs are cached since the same expressions are evaluated
+// in every health check run.
+func compileExpression(expression string) (cel.Program, error) {
+	if program, ok := celPrograms.Load(expression); ok {
+		return program.(cel.Program), nil
+	}
+
+	ast, issues := celEnv.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed compiling expression %q: %w", expression, issues.Err())
+	}
+
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return nil, fmt.Errorf("expression %q must evaluate to bool but has type %s", expression, outputType)
+	}
+
+	program, err := celEnv.Program(ast, cel.CostLimit(celCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating program for expression %q: %w", expression, err)
+	}
+
+	celPrograms.Store(expression, program)
+	return program, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+)
+
+var _ = Describe("CEL", func() {
+	Describe("#CustomHealthCheckFor", func() {
+		var mr *resourcesv1alpha1.ManagedResource
+
+		BeforeEach(func() {
+			mr = &resourcesv1alpha1.ManagedResource{
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					HealthChecks: []resourcesv1alpha1.HealthCheck{
+						{Group: "extensions.gardener.cloud", Kind: "Foo", Expression: "true"},
+						{Kind: "ConfigMap", Expression: "false"},
+					},
+				},
+			}
+		})
+
+		It("should return the health check for the given GroupKind", func() {
+			Expect(CustomHealthCheckFor(mr, schema.GroupKind{Group: "extensions.gardener.cloud", Kind: "Foo"})).To(Equal(&mr.Spec.HealthChecks[0]))
+			Expect(CustomHealthCheckFor(mr, schema.GroupKind{Kind: "ConfigMap"})).To(Equal(&mr.Spec.HealthChecks[1]))
+		})
+
+		It("should return nil if there is no health check for the given GroupKind", func() {
+			Expect(CustomHealthCheckFor(mr, schema.GroupKind{Group: "other.gardener.cloud", Kind: "Foo"})).To(BeNil())
+			Expect(CustomHealthCheckFor(mr, schema.GroupKind{Kind: "Secret"})).To(BeNil())
+		})
+	})
+
+	Describe("#CheckHealthWithExpression", func() {
+		var (
+			content     map[string]interface{}
+			healthCheck *resourcesv1alpha1.HealthCheck
+		)
+
+		BeforeEach(func() {
+			content = map[string]interface{}{
+				"apiVersion": "extensions.gardener.cloud/v1alpha1",
+				"kind":       "Foo",
+				"status": map[string]interface{}{
+					"phase":    "Ready",
+					"replicas": int64(3),
+				},
+			}
+			healthCheck = &resourcesv1alpha1.HealthCheck{Kind: "Foo", Expression: "self.status.phase == 'Ready' && self.status.replicas >= 3"}
+		})
+
+		It("should succeed if the expression evaluates to true", func() {
+			checked, err := CheckHealthWithExpression(content, healthCheck)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail with a default message if the expression evaluates to false", func() {
+			content["status"].(map[string]interface{})["phase"] = "Pending"
+
+			checked, err := CheckHealthWithExpression(content, healthCheck)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("evaluated to false")))
+		})
+
+		It("should fail with the configured message if the expression evaluates to false", func() {
+			content["status"].(map[string]interface{})["phase"] = "Pending"
+			healthCheck.Message = "Foo is not ready yet"
+
+			checked, err := CheckHealthWithExpression(content, healthCheck)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("Foo is not ready yet"))
+		})
+
+		It("should support checking for the presence of fields", func() {
+			healthCheck.Expression = "has(self.status.observedGeneration)"
+
+			checked, err := CheckHealthWithExpression(content, healthCheck)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the expression cannot be compiled", func() {
+			healthCheck.Expression = "self.status.phase =="
+
+			checked, err := CheckHealthWithExpression(content, healthCheck)
+			Expect(checked).To(BeFalse())
+			Expect(err).To(MatchError(ContainSubstring("failed compiling expression")))
+		})
+
+		It("should return an error if the expression does not evaluate to bool", func() {
+			healthCheck.Expression = "'foo'"
+
+			checked, err := CheckHealthWithExpression(content, healthCheck)
+			Expect(checked).To(BeFalse())
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to bool")))
+		})
+
+		It("should return an error if a dynamically typed expression does not evaluate to bool", func() {
+			healthCheck.Expression = "self.status.phase"
+
+			checked, err := CheckHealthWithExpression(content, healthCheck)
+			Expect(checked).To(BeFalse())
+			Expect(err).To(MatchError(ContainSubstring("instead of bool")))
+		})
+
+		It("should return an error if the expression cannot be evaluated", func() {
+			healthCheck.Expression = "self.spec.foo == 'bar'"
+
+			checked, err := CheckHealthWithExpression(content, healthCheck)
+			Expect(checked).To(BeFalse())
+			Expect(err).To(MatchError(ContainSubstring("failed evaluating expression")))
+		})
+	})
+})
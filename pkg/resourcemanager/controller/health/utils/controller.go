@@ -18,6 +18,8 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -43,6 +45,12 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 				return true
 			}
 
+			if _, ok := e.ObjectNew.(*unstructured.Unstructured); ok {
+				// unstructured objects are only watched for custom health checks, the result of which cannot be determined
+				// without the health check of the respective ManagedResource, hence enqueue on spec or status changes
+				return unstructuredSpecOrStatusChanged(e.ObjectOld, e.ObjectNew)
+			}
+
 			var oldHealthy, newHealthy bool
 			checked, oldErr := CheckHealth(e.ObjectOld)
 			if !checked {
@@ -70,6 +78,19 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 	}
 }
 
+func unstructuredSpecOrStatusChanged(oldObj, newObj client.Object) bool {
+	if oldObj.GetGeneration() != newObj.GetGeneration() {
+		return true
+	}
+
+	oldUnstructured, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return true
+	}
+
+	return !apiequality.Semantic.DeepEqual(oldUnstructured.Object["status"], newObj.(*unstructured.Unstructured).Object["status"])
+}
+
 // MapToOriginManagedResource is a mapper.MapFunc for resources to their origin ManagedResource.
 func MapToOriginManagedResource(clusterID string) mapper.MapFunc {
 	return func(_ context.Context, log logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
@@ -23,6 +23,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -161,6 +162,52 @@ var _ = Describe("HealthStatusChanged", func() {
 		})
 	})
 
+	Context("unstructured events", func() {
+		var (
+			obj *unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			obj = &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "extensions.gardener.cloud/v1alpha1",
+				"kind":       "Foo",
+				"status":     map[string]interface{}{"phase": "Pending"},
+			}}
+			obj.SetResourceVersion("1")
+			obj.SetGeneration(1)
+		})
+
+		It("should return true for Create", func() {
+			Expect(p.Create(event.CreateEvent{Object: obj})).To(BeTrue())
+		})
+
+		It("should return true for cache resyncs", func() {
+			objOld := obj.DeepCopy()
+			Expect(p.Update(event.UpdateEvent{ObjectOld: objOld, ObjectNew: obj})).To(BeTrue())
+		})
+
+		It("should return true for Update, if the generation has changed", func() {
+			objOld := obj.DeepCopy()
+			obj.SetResourceVersion("2")
+			obj.SetGeneration(2)
+			Expect(p.Update(event.UpdateEvent{ObjectOld: objOld, ObjectNew: obj})).To(BeTrue())
+		})
+
+		It("should return true for Update, if the status has changed", func() {
+			objOld := obj.DeepCopy()
+			obj.SetResourceVersion("2")
+			Expect(unstructured.SetNestedField(obj.Object, "Ready", "status", "phase")).To(Succeed())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: objOld, ObjectNew: obj})).To(BeTrue())
+		})
+
+		It("should ignore Update, if neither the generation nor the status has changed", func() {
+			objOld := obj.DeepCopy()
+			obj.SetResourceVersion("2")
+			obj.SetLabels(map[string]string{"foo": "bar"})
+			Expect(p.Update(event.UpdateEvent{ObjectOld: objOld, ObjectNew: obj})).To(BeFalse())
+		})
+	})
+
 	Describe("#MapToOriginManagedResource", func() {
 		var (
 			ctx = context.TODO()
@@ -0,0 +1,102 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustedcabundle
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "trusted-ca-bundle"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, targetCluster cluster.Cluster) error {
+	if r.TargetReader == nil {
+		r.TargetReader = targetCluster.GetAPIReader()
+	}
+	if r.TargetClient == nil {
+		r.TargetClient = targetCluster.GetClient()
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: pointer.IntDeref(r.Config.ConcurrentSyncs, 0),
+		}).
+		WatchesRawSource(
+			source.Kind(targetCluster.GetCache(), &corev1.Namespace{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(predicateutils.ForEventTypes(predicateutils.Create)),
+		).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	// Only the metadata of the config maps is cached, the reconciler reads the data directly from the API server.
+	configMap := &metav1.PartialObjectMetadata{}
+	configMap.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+
+	return c.Watch(
+		source.Kind(targetCluster.GetCache(), configMap),
+		mapper.EnqueueRequestsFrom(ctx, targetCluster.GetCache(), mapper.MapFunc(r.MapConfigMapToNamespaces), mapper.UpdateWithNew, c.GetLogger()),
+		r.ConfigMapPredicate(),
+	)
+}
+
+// ConfigMapPredicate returns the predicate for config maps containing the trusted CA bundles.
+func (r *Reconciler) ConfigMapPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetName() == v1beta1constants.ConfigMapNameTrustedCABundles
+	})
+}
+
+// MapConfigMapToNamespaces maps the source config map in the kube-system namespace to all namespaces and copies of it
+// to their namespace.
+func (r *Reconciler) MapConfigMapToNamespaces(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	if obj.GetNamespace() != metav1.NamespaceSystem {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetNamespace()}}}
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := reader.List(ctx, namespaceList); err != nil {
+		log.Error(err, "Failed to list namespaces")
+		return nil
+	}
+
+	return mapper.ObjectListToRequests(namespaceList, func(o client.Object) bool {
+		return o.GetName() != metav1.NamespaceSystem
+	})
+}
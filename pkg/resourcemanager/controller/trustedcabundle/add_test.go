@@ -0,0 +1,80 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustedcabundle_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/trustedcabundle"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#ConfigMapPredicate", func() {
+		It("should return true for the trusted CA bundles config map", func() {
+			Expect(reconciler.ConfigMapPredicate().Create(event.CreateEvent{Object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-trusted-ca-bundles", Namespace: "foo"}}})).To(BeTrue())
+		})
+
+		It("should return false for other config maps", func() {
+			Expect(reconciler.ConfigMapPredicate().Create(event.CreateEvent{Object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo"}}})).To(BeFalse())
+		})
+	})
+
+	Describe("#MapConfigMapToNamespaces", func() {
+		var (
+			ctx        = context.TODO()
+			log        = logr.Discard()
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+			).Build()
+		})
+
+		It("should map a copy to its namespace", func() {
+			Expect(reconciler.MapConfigMapToNamespaces(ctx, log, fakeClient, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-trusted-ca-bundles", Namespace: "foo"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "foo"}},
+			))
+		})
+
+		It("should map the source to all other namespaces", func() {
+			Expect(reconciler.MapConfigMapToNamespaces(ctx, log, fakeClient, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-trusted-ca-bundles", Namespace: "kube-system"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "foo"}},
+			))
+		})
+	})
+})
@@ -0,0 +1,116 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustedcabundle
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+// Reconciler copies the config map containing the trusted CA bundles from the kube-system namespace into all other
+// namespaces, so that pods can mount it.
+type Reconciler struct {
+	TargetClient client.Client
+	TargetReader client.Reader
+	Config       config.TrustedCABundleControllerConfig
+}
+
+// Reconcile copies the config map containing the trusted CA bundles into the requested namespace.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	if request.Name == metav1.NamespaceSystem {
+		log.V(1).Info("Namespace contains the source of the trusted CA bundles, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.TargetClient.Get(ctx, request.NamespacedName, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if namespace.DeletionTimestamp != nil {
+		log.V(1).Info("Namespace is in deletion, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	sourceConfigMap := &corev1.ConfigMap{}
+	if err := r.TargetReader.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: v1beta1constants.ConfigMapNameTrustedCABundles}, sourceConfigMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("could not fetch source ConfigMap: %w", err)
+		}
+		sourceConfigMap = nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.TargetReader.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: v1beta1constants.ConfigMapNameTrustedCABundles}, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("could not fetch ConfigMap: %w", err)
+		}
+
+		if sourceConfigMap == nil {
+			return reconcile.Result{}, nil
+		}
+
+		log.Info("Creating ConfigMap with trusted CA bundles")
+		return reconcile.Result{}, client.IgnoreAlreadyExists(r.TargetClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      v1beta1constants.ConfigMapNameTrustedCABundles,
+				Namespace: namespace.Name,
+				Labels:    map[string]string{resourcesv1alpha1.ResourceManagerPurpose: resourcesv1alpha1.LabelPurposeTrustedCABundle},
+			},
+			Data: sourceConfigMap.Data,
+		}))
+	}
+
+	if configMap.Labels[resourcesv1alpha1.ResourceManagerPurpose] != resourcesv1alpha1.LabelPurposeTrustedCABundle {
+		log.Info("ConfigMap is not managed by this controller, skipping", "configMap", client.ObjectKeyFromObject(configMap))
+		return reconcile.Result{}, nil
+	}
+
+	if sourceConfigMap == nil {
+		log.Info("Deleting ConfigMap with trusted CA bundles since the source ConfigMap is gone")
+		return reconcile.Result{}, client.IgnoreNotFound(r.TargetClient.Delete(ctx, configMap))
+	}
+
+	if apiequality.Semantic.DeepEqual(configMap.Data, sourceConfigMap.Data) {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Updating ConfigMap with trusted CA bundles")
+	patch := client.MergeFromWithOptions(configMap.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	configMap.Data = sourceConfigMap.Data
+	return reconcile.Result{}, r.TargetClient.Patch(ctx, configMap, patch)
+}
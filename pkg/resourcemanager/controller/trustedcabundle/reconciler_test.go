@@ -0,0 +1,130 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustedcabundle_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/trustedcabundle"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		ctrl       reconcile.Reconciler
+		request    reconcile.Request
+
+		namespace       *corev1.Namespace
+		sourceConfigMap *corev1.ConfigMap
+		configMap       *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		ctrl = &Reconciler{
+			TargetClient: fakeClient,
+			TargetReader: fakeClient,
+		}
+
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+		sourceConfigMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardener-trusted-ca-bundles", Namespace: "kube-system"},
+			Data:       map[string]string{"corporate-ca.pem": "corporate", "ca-bundle.crt": "corporate"},
+		}
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-trusted-ca-bundles", Namespace: namespace.Name}}
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: namespace.Name}}
+	})
+
+	It("should do nothing if the namespace is gone", func() {
+		Expect(fakeClient.Create(ctx, sourceConfigMap)).To(Succeed())
+
+		Expect(ctrl.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+	})
+
+	It("should do nothing if the source config map does not exist", func() {
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		Expect(ctrl.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+	})
+
+	It("should not reconcile the kube-system namespace", func() {
+		Expect(fakeClient.Create(ctx, sourceConfigMap)).To(Succeed())
+
+		Expect(ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "kube-system"}})).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(sourceConfigMap), sourceConfigMap)).To(Succeed())
+		Expect(sourceConfigMap.Labels).To(BeEmpty())
+	})
+
+	Context("source config map exists", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+			Expect(fakeClient.Create(ctx, sourceConfigMap)).To(Succeed())
+		})
+
+		It("should create the copy of the source config map", func() {
+			Expect(ctrl.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Labels).To(Equal(map[string]string{"resources.gardener.cloud/purpose": "trusted-ca-bundle"}))
+			Expect(configMap.Data).To(Equal(sourceConfigMap.Data))
+		})
+
+		It("should update the copy of the source config map", func() {
+			configMap.Labels = map[string]string{"resources.gardener.cloud/purpose": "trusted-ca-bundle"}
+			configMap.Data = map[string]string{"old-ca.pem": "old", "ca-bundle.crt": "old"}
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(ctrl.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(sourceConfigMap.Data))
+		})
+
+		It("should not touch config maps which are not managed by the controller", func() {
+			configMap.Data = map[string]string{"foo": "bar"}
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(ctrl.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"foo": "bar"}))
+		})
+	})
+
+	It("should delete the copy if the source config map is gone", func() {
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+		configMap.Labels = map[string]string{"resources.gardener.cloud/purpose": "trusted-ca-bundle"}
+		Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+		Expect(ctrl.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+	})
+})
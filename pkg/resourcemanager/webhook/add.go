@@ -23,11 +23,13 @@ import (
 
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/crddeletionprotection"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/deprecatedapicallers"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/endpointslicehints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/extensionvalidation"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/kubernetesservicehost"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podsecuritycontext"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopologyspreadconstraints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/projectedtokenmount"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/seccompprofile"
@@ -47,6 +49,16 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 		}
 	}
 
+	if cfg.Webhooks.DeprecatedAPICallers.Enabled {
+		if err := (&deprecatedapicallers.Handler{
+			Logger:       mgr.GetLogger().WithName("webhook").WithName(deprecatedapicallers.HandlerName),
+			SourceClient: sourceCluster.GetClient(),
+			Namespace:    cfg.Webhooks.DeprecatedAPICallers.Namespace,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding %s webhook handler: %w", deprecatedapicallers.HandlerName, err)
+		}
+	}
+
 	if cfg.Webhooks.EndpointSliceHints.Enabled {
 		if err := (&endpointslicehints.Handler{
 			Logger: mgr.GetLogger().WithName("webhook").WithName(endpointslicehints.HandlerName),
@@ -101,6 +113,15 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 		}
 	}
 
+	if cfg.Webhooks.PodSecurityContext.Enabled {
+		if err := (&podsecuritycontext.Handler{
+			Logger: mgr.GetLogger().WithName("webhook").WithName(podsecuritycontext.HandlerName),
+			DryRun: cfg.Webhooks.PodSecurityContext.DryRun,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding %s webhook handler: %w", podsecuritycontext.HandlerName, err)
+		}
+	}
+
 	if cfg.Webhooks.PodTopologySpreadConstraints.Enabled {
 		if err := (&podtopologyspreadconstraints.Handler{
 			Logger: mgr.GetLogger().WithName("webhook").WithName(podtopologyspreadconstraints.HandlerName),
@@ -0,0 +1,311 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deprecatedapicallers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap in the watched namespace of the source cluster which contains the
+	// callers of deprecated APIs.
+	ConfigMapName = "deprecated-api-callers"
+	// ConfigMapDataKey is the key in the data of the ConfigMap containing the callers.
+	ConfigMapDataKey = "callers.yaml"
+	// MaxCallersPerAPI is the maximum number of callers which are recorded per deprecated API. Only the callers which
+	// issued the most requests are kept.
+	MaxCallersPerAPI = 10
+
+	// annotationDeprecated is the annotation kube-apiserver adds to audit events of requests to deprecated APIs.
+	annotationDeprecated = "k8s.io/deprecated"
+	// maxRequestBodyBytes limits the size of the audit event batches read from kube-apiserver.
+	maxRequestBodyBytes = 10 * 1024 * 1024
+	defaultSyncPeriod   = time.Minute
+)
+
+// API identifies a requested API.
+type API struct {
+	// Group is the API group.
+	Group string `json:"group,omitempty"`
+	// Version is the API version.
+	Version string `json:"version"`
+	// Resource is the requested resource.
+	Resource string `json:"resource"`
+	// Subresource is the requested subresource, if any.
+	Subresource string `json:"subresource,omitempty"`
+}
+
+// Caller describes a client which requested a deprecated API.
+type Caller struct {
+	// Username is the name of the user which issued the requests.
+	Username string `json:"username"`
+	// ServiceAccount is the service account (`<namespace>/<name>`) which issued the requests, if any.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// UserAgent is the user agent of the client.
+	UserAgent string `json:"userAgent,omitempty"`
+	// Count is the number of requests.
+	Count int64 `json:"count"`
+}
+
+// APICallers contains the callers of a deprecated API.
+type APICallers struct {
+	API `json:",inline"`
+	// Callers are the callers which issued the most requests to the API, sorted by the number of requests.
+	Callers []Caller `json:"callers"`
+}
+
+type callerKey struct {
+	username  string
+	userAgent string
+}
+
+// Handler receives audit events from kube-apiserver via its audit webhook backend and aggregates the callers of
+// deprecated APIs. The aggregated callers are periodically persisted in a ConfigMap in the source cluster.
+type Handler struct {
+	Logger       logr.Logger
+	SourceClient client.Client
+	Namespace    string
+	// SyncPeriod is the period in which the aggregated callers are persisted. Defaults to one minute.
+	SyncPeriod time.Duration
+
+	lock    sync.Mutex
+	pending map[API]map[callerKey]int64
+}
+
+// ServeHTTP reads a batch of audit events and records the callers of deprecated APIs.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	eventList := &auditv1.EventList{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)).Decode(eventList); err != nil {
+		h.Logger.Error(err, "Failed decoding audit events")
+		http.Error(w, fmt.Sprintf("failed decoding audit events: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	h.record(eventList.Items)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) record(events []auditv1.Event) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for _, event := range events {
+		// Only completed requests are counted since kube-apiserver emits multiple events for long-running requests.
+		if event.Stage != auditv1.StageResponseComplete || event.ObjectRef == nil || event.Annotations[annotationDeprecated] != "true" {
+			continue
+		}
+
+		api := API{
+			Group:       event.ObjectRef.APIGroup,
+			Version:     event.ObjectRef.APIVersion,
+			Resource:    event.ObjectRef.Resource,
+			Subresource: event.ObjectRef.Subresource,
+		}
+
+		if h.pending == nil {
+			h.pending = make(map[API]map[callerKey]int64)
+		}
+		if h.pending[api] == nil {
+			h.pending[api] = make(map[callerKey]int64)
+		}
+		h.pending[api][callerKey{username: event.User.Username, userAgent: event.UserAgent}]++
+	}
+}
+
+// NeedLeaderElection returns false since every replica has to persist the callers it recorded.
+func (h *Handler) NeedLeaderElection() bool {
+	return false
+}
+
+// Start periodically persists the recorded callers until the given context is cancelled.
+func (h *Handler) Start(ctx context.Context) error {
+	syncPeriod := h.SyncPeriod
+	if syncPeriod == 0 {
+		syncPeriod = defaultSyncPeriod
+	}
+
+	ticker := time.NewTicker(syncPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := h.Sync(ctx); err != nil {
+				h.Logger.Error(err, "Failed persisting callers of deprecated APIs")
+			}
+		}
+	}
+}
+
+// Sync adds the callers recorded since the last sync to the ConfigMap in the source cluster. If this fails, the
+// recorded callers are kept for the next sync.
+func (h *Handler) Sync(ctx context.Context) error {
+	h.lock.Lock()
+	pending := h.pending
+	h.pending = nil
+	h.lock.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := h.persist(ctx, pending); err != nil {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+
+		if h.pending == nil {
+			h.pending = make(map[API]map[callerKey]int64)
+		}
+		for api, callers := range pending {
+			if h.pending[api] == nil {
+				h.pending[api] = make(map[callerKey]int64)
+			}
+			for key, count := range callers {
+				h.pending[api][key] += count
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) persist(ctx context.Context, pending map[API]map[callerKey]int64) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: h.Namespace}}
+		if err := h.SourceClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			data, err := yaml.Marshal(merge(nil, pending))
+			if err != nil {
+				return err
+			}
+
+			configMap.Data = map[string]string{ConfigMapDataKey: string(data)}
+			return h.SourceClient.Create(ctx, configMap)
+		}
+
+		var existing []APICallers
+		if err := yaml.Unmarshal([]byte(configMap.Data[ConfigMapDataKey]), &existing); err != nil {
+			h.Logger.Error(err, "Failed parsing persisted callers of deprecated APIs, overwriting them", "configMap", client.ObjectKeyFromObject(configMap))
+			existing = nil
+		}
+
+		data, err := yaml.Marshal(merge(existing, pending))
+		if err != nil {
+			return err
+		}
+
+		patch := client.MergeFromWithOptions(configMap.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		configMap.Data = map[string]string{ConfigMapDataKey: string(data)}
+		return h.SourceClient.Patch(ctx, configMap, patch)
+	})
+}
+
+// merge adds the given request counts per API and caller to the existing callers. The result is sorted by API, and
+// only the MaxCallersPerAPI callers with the most requests are kept per API.
+func merge(existing []APICallers, pending map[API]map[callerKey]int64) []APICallers {
+	callersByAPI := make(map[API]map[callerKey]int64, len(existing)+len(pending))
+	add := func(api API, key callerKey, count int64) {
+		if callersByAPI[api] == nil {
+			callersByAPI[api] = make(map[callerKey]int64)
+		}
+		callersByAPI[api][key] += count
+	}
+
+	for _, apiCallers := range existing {
+		for _, caller := range apiCallers.Callers {
+			add(apiCallers.API, callerKey{username: caller.Username, userAgent: caller.UserAgent}, caller.Count)
+		}
+	}
+	for api, callers := range pending {
+		for key, count := range callers {
+			add(api, key, count)
+		}
+	}
+
+	out := make([]APICallers, 0, len(callersByAPI))
+	for api, callers := range callersByAPI {
+		apiCallers := APICallers{API: api}
+		for key, count := range callers {
+			apiCallers.Callers = append(apiCallers.Callers, newCaller(key, count))
+		}
+
+		sort.Slice(apiCallers.Callers, func(i, j int) bool {
+			a, b := apiCallers.Callers[i], apiCallers.Callers[j]
+			if a.Count != b.Count {
+				return a.Count > b.Count
+			}
+			if a.Username != b.Username {
+				return a.Username < b.Username
+			}
+			return a.UserAgent < b.UserAgent
+		})
+		if len(apiCallers.Callers) > MaxCallersPerAPI {
+			apiCallers.Callers = apiCallers.Callers[:MaxCallersPerAPI]
+		}
+
+		out = append(out, apiCallers)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i].API, out[j].API
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		return a.Subresource < b.Subresource
+	})
+
+	return out
+}
+
+func newCaller(key callerKey, count int64) Caller {
+	caller := Caller{Username: key.username, UserAgent: key.userAgent, Count: count}
+	if namespace, name, err := serviceaccount.SplitUsername(key.username); err == nil {
+		caller.ServiceAccount = namespace + "/" + name
+	}
+	return caller
+}
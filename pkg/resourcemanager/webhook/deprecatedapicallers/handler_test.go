@@ -0,0 +1,217 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deprecatedapicallers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/deprecatedapicallers"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		fakeClient client.Client
+		handler    *Handler
+
+		psp = API{Group: "policy", Version: "v1beta1", Resource: "podsecuritypolicies"}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		handler = &Handler{
+			Logger:       logr.Discard(),
+			SourceClient: fakeClient,
+			Namespace:    namespace,
+		}
+	})
+
+	newEvent := func(api API, username, userAgent string) auditv1.Event {
+		return auditv1.Event{
+			Stage:     auditv1.StageResponseComplete,
+			User:      authenticationv1.UserInfo{Username: username},
+			UserAgent: userAgent,
+			ObjectRef: &auditv1.ObjectReference{
+				APIGroup:    api.Group,
+				APIVersion:  api.Version,
+				Resource:    api.Resource,
+				Subresource: api.Subresource,
+			},
+			Annotations: map[string]string{"k8s.io/deprecated": "true"},
+		}
+	}
+
+	send := func(events ...auditv1.Event) *httptest.ResponseRecorder {
+		body, err := json.Marshal(&auditv1.EventList{Items: events})
+		Expect(err).NotTo(HaveOccurred())
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, WebhookPath, bytes.NewReader(body)))
+		return recorder
+	}
+
+	readCallers := func() []APICallers {
+		configMap := &corev1.ConfigMap{}
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ConfigMapName}, configMap)).To(Succeed())
+
+		var callers []APICallers
+		ExpectWithOffset(1, yaml.Unmarshal([]byte(configMap.Data[ConfigMapDataKey]), &callers)).To(Succeed())
+		return callers
+	}
+
+	Describe("#ServeHTTP", func() {
+		It("should reject requests with other methods than POST", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, WebhookPath, nil))
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+
+		It("should reject requests with an invalid body", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, WebhookPath, bytes.NewReader([]byte("{"))))
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should accept audit events", func() {
+			Expect(send(newEvent(psp, "alice", "kubectl/v1.24.0")).Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	Describe("#Sync", func() {
+		It("should not create the ConfigMap if no callers were recorded", func() {
+			Expect(handler.Sync(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ConfigMapName}, &corev1.ConfigMap{})).To(BeNotFoundError())
+		})
+
+		It("should aggregate the callers per API and ignore unrelated events", func() {
+			cronJobs := API{Group: "batch", Version: "v1beta1", Resource: "cronjobs"}
+			scale := API{Group: "apps", Version: "v1beta1", Resource: "deployments", Subresource: "scale"}
+
+			notDeprecated := newEvent(API{Group: "batch", Version: "v1", Resource: "cronjobs"}, "alice", "kubectl/v1.24.0")
+			notDeprecated.Annotations = nil
+			notCompleted := newEvent(psp, "alice", "kubectl/v1.24.0")
+			notCompleted.Stage = auditv1.StageResponseStarted
+			withoutObjectRef := newEvent(psp, "alice", "kubectl/v1.24.0")
+			withoutObjectRef.ObjectRef = nil
+
+			send(
+				newEvent(psp, "alice", "kubectl/v1.24.0"),
+				newEvent(psp, "system:serviceaccount:kube-system:psp-controller", "psp-controller/v0.1.0"),
+				newEvent(psp, "system:serviceaccount:kube-system:psp-controller", "psp-controller/v0.1.0"),
+				newEvent(cronJobs, "bob", "helm/v3.12.0"),
+				newEvent(scale, "system:serviceaccount:default:autoscaler", "autoscaler/v1.0.0"),
+				notDeprecated,
+				notCompleted,
+				withoutObjectRef,
+			)
+			send(newEvent(psp, "alice", "kubectl/v1.24.0"))
+
+			Expect(handler.Sync(ctx)).To(Succeed())
+
+			Expect(readCallers()).To(Equal([]APICallers{
+				{
+					API:     scale,
+					Callers: []Caller{{Username: "system:serviceaccount:default:autoscaler", ServiceAccount: "default/autoscaler", UserAgent: "autoscaler/v1.0.0", Count: 1}},
+				},
+				{
+					API:     cronJobs,
+					Callers: []Caller{{Username: "bob", UserAgent: "helm/v3.12.0", Count: 1}},
+				},
+				{
+					API: psp,
+					Callers: []Caller{
+						{Username: "alice", UserAgent: "kubectl/v1.24.0", Count: 2},
+						{Username: "system:serviceaccount:kube-system:psp-controller", ServiceAccount: "kube-system/psp-controller", UserAgent: "psp-controller/v0.1.0", Count: 2},
+					},
+				},
+			}))
+		})
+
+		It("should add the recorded callers to the persisted ones", func() {
+			send(newEvent(psp, "alice", "kubectl/v1.24.0"), newEvent(psp, "bob", "kubectl/v1.25.0"))
+			Expect(handler.Sync(ctx)).To(Succeed())
+
+			send(newEvent(psp, "bob", "kubectl/v1.25.0"), newEvent(psp, "bob", "kubectl/v1.25.0"))
+			Expect(handler.Sync(ctx)).To(Succeed())
+
+			Expect(readCallers()).To(Equal([]APICallers{{
+				API: psp,
+				Callers: []Caller{
+					{Username: "bob", UserAgent: "kubectl/v1.25.0", Count: 3},
+					{Username: "alice", UserAgent: "kubectl/v1.24.0", Count: 1},
+				},
+			}}))
+		})
+
+		It("should only keep the callers with the most requests", func() {
+			var events []auditv1.Event
+			for i := 0; i < MaxCallersPerAPI+2; i++ {
+				for j := 0; j <= i; j++ {
+					events = append(events, newEvent(psp, fmt.Sprintf("user-%02d", i), "kubectl/v1.24.0"))
+				}
+			}
+			send(events...)
+
+			Expect(handler.Sync(ctx)).To(Succeed())
+
+			callers := readCallers()
+			Expect(callers).To(HaveLen(1))
+			Expect(callers[0].Callers).To(HaveLen(MaxCallersPerAPI))
+			Expect(callers[0].Callers[0]).To(Equal(Caller{Username: fmt.Sprintf("user-%02d", MaxCallersPerAPI+1), UserAgent: "kubectl/v1.24.0", Count: MaxCallersPerAPI + 2}))
+			Expect(callers[0].Callers[MaxCallersPerAPI-1].Username).To(Equal("user-02"))
+		})
+
+		It("should keep the recorded callers if they cannot be persisted", func() {
+			fakeErr := fmt.Errorf("fake err")
+			handler.SourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+					return fakeErr
+				},
+			}).Build()
+
+			send(newEvent(psp, "alice", "kubectl/v1.24.0"))
+			Expect(handler.Sync(ctx)).To(MatchError(fakeErr))
+
+			handler.SourceClient = fakeClient
+			send(newEvent(psp, "alice", "kubectl/v1.24.0"))
+			Expect(handler.Sync(ctx)).To(Succeed())
+
+			Expect(readCallers()).To(Equal([]APICallers{{
+				API:     psp,
+				Callers: []Caller{{Username: "alice", UserAgent: "kubectl/v1.24.0", Count: 2}},
+			}}))
+		})
+	})
+})
@@ -0,0 +1,171 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podsecuritycontext
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// RuleSeccompProfile is the name of the rule defaulting the seccomp profile of the pod to `RuntimeDefault`.
+	RuleSeccompProfile = "seccomp-profile"
+	// RuleAppArmorProfile is the name of the rule defaulting the AppArmor profile of the containers to
+	// `runtime/default`.
+	RuleAppArmorProfile = "apparmor-profile"
+	// RuleDropCapabilities is the name of the rule dropping all capabilities of the containers.
+	RuleDropCapabilities = "drop-capabilities"
+	// RuleRunAsNonRoot is the name of the rule requiring the containers of the pod to run as non-root user.
+	RuleRunAsNonRoot = "run-as-non-root"
+)
+
+// Handler defaults the security context of pods.
+type Handler struct {
+	Logger logr.Logger
+	// DryRun defines whether the changes are only reported in the pod's annotations instead of being applied.
+	DryRun bool
+}
+
+// Default defaults the security context of the pod.
+func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return fmt.Errorf("expected *corev1.Pod but got %T", obj)
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	log := h.Logger.WithValues("pod", kubernetesutils.ObjectKeyForCreateWebhooks(pod, req))
+
+	skippedRules := sets.New[string]()
+	for _, rule := range strings.Split(pod.Annotations[resourcesv1alpha1.PodSecurityContextSkipRules], ",") {
+		skippedRules.Insert(strings.TrimSpace(rule))
+	}
+
+	if !h.DryRun {
+		if changes := defaultSecurityContext(pod, skippedRules); len(changes) > 0 {
+			log.Info("Mutating pod with default security context", "changes", changes)
+		}
+		return nil
+	}
+
+	if changes := defaultSecurityContext(pod.DeepCopy(), skippedRules); len(changes) > 0 {
+		log.Info("Pod would be mutated with default security context (dry-run)", "changes", changes)
+		metav1.SetMetaDataAnnotation(&pod.ObjectMeta, resourcesv1alpha1.PodSecurityContextDryRunChanges, strings.Join(changes, ","))
+	}
+
+	return nil
+}
+
+// defaultSecurityContext applies all rules which are not skipped to the given pod and returns the list of changes.
+func defaultSecurityContext(pod *corev1.Pod, skippedRules sets.Set[string]) []string {
+	var changes []string
+
+	if !skippedRules.Has(RuleSeccompProfile) && (pod.Spec.SecurityContext == nil || pod.Spec.SecurityContext.SeccompProfile == nil) {
+		if pod.Spec.SecurityContext == nil {
+			pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+		}
+		pod.Spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+		changes = append(changes, RuleSeccompProfile)
+	}
+
+	if !skippedRules.Has(RuleRunAsNonRoot) && mayRunAsNonRoot(pod) {
+		if pod.Spec.SecurityContext == nil {
+			pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+		}
+		pod.Spec.SecurityContext.RunAsNonRoot = pointer.Bool(true)
+		changes = append(changes, RuleRunAsNonRoot)
+	}
+
+	for _, container := range allContainers(pod) {
+		// Privileged containers are not confined anyway, hence there is no point in defaulting their security context.
+		if isPrivileged(container) {
+			continue
+		}
+
+		annotationKey := corev1.AppArmorBetaContainerAnnotationKeyPrefix + container.Name
+		if !skippedRules.Has(RuleAppArmorProfile) && !metav1.HasAnnotation(pod.ObjectMeta, annotationKey) {
+			metav1.SetMetaDataAnnotation(&pod.ObjectMeta, annotationKey, corev1.AppArmorBetaProfileRuntimeDefault)
+			changes = append(changes, RuleAppArmorProfile+"/"+container.Name)
+		}
+
+		if !skippedRules.Has(RuleDropCapabilities) && (container.SecurityContext == nil || container.SecurityContext.Capabilities == nil || len(container.SecurityContext.Capabilities.Drop) == 0) {
+			if container.SecurityContext == nil {
+				container.SecurityContext = &corev1.SecurityContext{}
+			}
+			if container.SecurityContext.Capabilities == nil {
+				container.SecurityContext.Capabilities = &corev1.Capabilities{}
+			}
+			container.SecurityContext.Capabilities.Drop = []corev1.Capability{"ALL"}
+			changes = append(changes, RuleDropCapabilities+"/"+container.Name)
+		}
+	}
+
+	return changes
+}
+
+// mayRunAsNonRoot returns true if the pod does not specify whether it runs as non-root user and none of its containers
+// obviously requires to run as root user.
+func mayRunAsNonRoot(pod *corev1.Pod) bool {
+	if securityContext := pod.Spec.SecurityContext; securityContext != nil &&
+		(securityContext.RunAsNonRoot != nil || pointer.Int64Deref(securityContext.RunAsUser, -1) == 0) {
+		return false
+	}
+
+	for _, container := range allContainers(pod) {
+		if isPrivileged(container) {
+			return false
+		}
+
+		if securityContext := container.SecurityContext; securityContext != nil &&
+			(securityContext.RunAsNonRoot != nil ||
+				pointer.Int64Deref(securityContext.RunAsUser, -1) == 0 ||
+				(securityContext.Capabilities != nil && len(securityContext.Capabilities.Add) > 0)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func allContainers(pod *corev1.Pod) []*corev1.Container {
+	containers := make([]*corev1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for i := range pod.Spec.InitContainers {
+		containers = append(containers, &pod.Spec.InitContainers[i])
+	}
+	for i := range pod.Spec.Containers {
+		containers = append(containers, &pod.Spec.Containers[i])
+	}
+	return containers
+}
+
+func isPrivileged(container *corev1.Container) bool {
+	return container.SecurityContext != nil && pointer.BoolDeref(container.SecurityContext.Privileged, false)
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podsecuritycontext_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/gardener/gardener/pkg/logger"
+	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/podsecuritycontext"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx     = context.TODO()
+		log     logr.Logger
+		handler *Handler
+
+		pod *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		ctx = admission.NewContextWithRequest(ctx, admission.Request{})
+		log = logger.MustNewZapLogger(logger.InfoLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
+		handler = &Handler{Logger: log}
+
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "kube-system"},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init"}},
+				Containers:     []corev1.Container{{Name: "app"}},
+			},
+		}
+	})
+
+	Describe("#Default", func() {
+		It("should default the security context", func() {
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+
+			Expect(pod.Spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				RunAsNonRoot:   pointer.Bool(true),
+			}))
+			Expect(pod.Annotations).To(Equal(map[string]string{
+				"container.apparmor.security.beta.kubernetes.io/init": "runtime/default",
+				"container.apparmor.security.beta.kubernetes.io/app":  "runtime/default",
+			}))
+			for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+				Expect(container.SecurityContext).To(Equal(&corev1.SecurityContext{
+					Capabilities: &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+				}))
+			}
+		})
+
+		It("should not overwrite explicitly specified values", func() {
+			pod.Annotations = map[string]string{"container.apparmor.security.beta.kubernetes.io/app": "unconfined"}
+			pod.Spec.SecurityContext = &corev1.PodSecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined},
+				RunAsNonRoot:   pointer.Bool(false),
+			}
+			pod.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{Drop: []corev1.Capability{"NET_RAW"}},
+			}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+
+			Expect(pod.Spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined},
+				RunAsNonRoot:   pointer.Bool(false),
+			}))
+			Expect(pod.Annotations).To(HaveKeyWithValue("container.apparmor.security.beta.kubernetes.io/app", "unconfined"))
+			Expect(pod.Spec.Containers[0].SecurityContext.Capabilities.Drop).To(ConsistOf(corev1.Capability("NET_RAW")))
+		})
+
+		It("should not confine privileged containers and not require a non-root user", func() {
+			pod.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{Privileged: pointer.Bool(true)}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+
+			Expect(pod.Spec.SecurityContext.RunAsNonRoot).To(BeNil())
+			Expect(pod.Annotations).NotTo(HaveKey("container.apparmor.security.beta.kubernetes.io/app"))
+			Expect(pod.Spec.Containers[0].SecurityContext).To(Equal(&corev1.SecurityContext{Privileged: pointer.Bool(true)}))
+			Expect(pod.Spec.InitContainers[0].SecurityContext.Capabilities.Drop).To(ConsistOf(corev1.Capability("ALL")))
+		})
+
+		It("should not require a non-root user if a container runs as root or adds capabilities", func() {
+			pod.Spec.InitContainers[0].SecurityContext = &corev1.SecurityContext{RunAsUser: pointer.Int64(0)}
+			pod.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+			}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+
+			Expect(pod.Spec.SecurityContext.RunAsNonRoot).To(BeNil())
+			Expect(pod.Spec.Containers[0].SecurityContext.Capabilities).To(Equal(&corev1.Capabilities{
+				Add:  []corev1.Capability{"NET_ADMIN"},
+				Drop: []corev1.Capability{"ALL"},
+			}))
+		})
+
+		It("should not apply the skipped rules", func() {
+			pod.Annotations = map[string]string{"pod-security-context.resources.gardener.cloud/skip-rules": "run-as-non-root, drop-capabilities,apparmor-profile"}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+
+			Expect(pod.Spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			}))
+			Expect(pod.Annotations).To(HaveLen(1))
+			Expect(pod.Spec.Containers[0].SecurityContext).To(BeNil())
+		})
+
+		It("should only report the changes in dry-run mode", func() {
+			handler.DryRun = true
+			pod.Spec.InitContainers = nil
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+
+			Expect(pod.Spec.SecurityContext).To(BeNil())
+			Expect(pod.Spec.Containers[0].SecurityContext).To(BeNil())
+			Expect(pod.Annotations).To(Equal(map[string]string{
+				"pod-security-context.resources.gardener.cloud/dry-run-changes": "seccomp-profile,run-as-non-root,apparmor-profile/app,drop-capabilities/app",
+			}))
+		})
+
+		It("should not report anything in dry-run mode if there are no changes", func() {
+			handler.DryRun = true
+			pod.Annotations = map[string]string{"pod-security-context.resources.gardener.cloud/skip-rules": "seccomp-profile,run-as-non-root,apparmor-profile,drop-capabilities"}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+
+			Expect(pod.Annotations).To(HaveLen(1))
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -130,52 +131,74 @@ func (r *Reconciler) determineSeed(
 	*gardencorev1beta1.Seed,
 	error,
 ) {
-	seedList := &gardencorev1beta1.SeedList{}
-	if err := r.Client.List(ctx, seedList); err != nil {
+	in, err := r.getSchedulingInput(ctx, log, shoot)
+	if err != nil {
 		return nil, err
 	}
-	shootList := &gardencorev1beta1.ShootList{}
-	if err := r.Client.List(ctx, shootList); err != nil {
+
+	filteredSeeds, err := filterUsableSeeds(in.seeds)
+	if err != nil {
 		return nil, err
 	}
-	cloudProfile := &gardencorev1beta1.CloudProfile{}
-	if err := r.Client.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
+	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, in.cloudProfile.Spec.SeedSelector, "CloudProfile")
+	if err != nil {
 		return nil, err
 	}
-	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
+	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
 	if err != nil {
 		return nil, err
 	}
-
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
+	filteredSeeds, err = filterSeedsMatchingProviders(in.cloudProfile, shoot, filteredSeeds)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
+	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
+	filteredSeeds, err = filterCandidates(shoot, in.shoots, filteredSeeds)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
+	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, in.regionConfig)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
-	if err != nil {
+	return getSeedWithLeastShootsDeployed(filteredSeeds, in.shoots)
+}
+
+// schedulingInput contains the objects which are considered when determining a seed for a shoot.
+type schedulingInput struct {
+	seeds        []gardencorev1beta1.Seed
+	shoots       []gardencorev1beta1.Shoot
+	cloudProfile *gardencorev1beta1.CloudProfile
+	regionConfig *corev1.ConfigMap
+}
+
+func (r *Reconciler) getSchedulingInput(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*schedulingInput, error) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList.Items, filteredSeeds)
-	if err != nil {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := r.Client.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
+		return nil, err
+	}
+	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
 	if err != nil {
 		return nil, err
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList.Items)
+
+	return &schedulingInput{
+		seeds:        seedList.Items,
+		shoots:       shootList.Items,
+		cloudProfile: cloudProfile,
+		regionConfig: regionConfig,
+	}, nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -184,30 +207,65 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 		return nil, err
 	}
 
-	var regionConfig *corev1.ConfigMap
+	var regionConfig, defaultRegionConfig *corev1.ConfigMap
 	for _, regionConf := range regionConfigList.Items {
-		profileNames := strings.Split(regionConf.Annotations[v1beta1constants.AnnotationSchedulingCloudProfiles], ",")
-		for _, name := range profileNames {
-			if name != cloudProfile.Name {
-				continue
-			}
+		profileNames := sets.New(strings.Split(regionConf.Annotations[v1beta1constants.AnnotationSchedulingCloudProfiles], ",")...)
+
+		// A region config which explicitly lists the cloudprofile is preferred over a default region config, even if it is
+		// a default region config at the same time.
+		switch {
+		case profileNames.Has(cloudProfile.Name):
 			if regionConfig == nil {
 				regionConfig = regionConf.DeepCopy()
 			} else {
 				log.Info("Duplicate scheduler region config found", "configMap", client.ObjectKeyFromObject(&regionConf), "cloudProfileName", cloudProfile.Name, "chosenConfigMap", client.ObjectKeyFromObject(regionConfig))
 			}
-			break
+		case profileNames.Has(v1beta1constants.SchedulingCloudProfilesAll):
+			if defaultRegionConfig == nil {
+				defaultRegionConfig = regionConf.DeepCopy()
+			} else {
+				log.Info("Duplicate default scheduler region config found", "configMap", client.ObjectKeyFromObject(&regionConf), "chosenConfigMap", client.ObjectKeyFromObject(defaultRegionConfig))
+			}
 		}
 	}
 
-	if regionConfig == nil {
+	switch {
+	case regionConfig == nil && defaultRegionConfig == nil:
 		log.Info("No region config found", "cloudProfileName", cloudProfile.Name)
+	case regionConfig == nil:
+		regionConfig = defaultRegionConfig
+	case defaultRegionConfig != nil:
+		// The region config for the cloudprofile overrides the distances of the default region config per region.
+		for region, distances := range defaultRegionConfig.Data {
+			if _, ok := regionConfig.Data[region]; ok {
+				continue
+			}
+			if regionConfig.Data == nil {
+				regionConfig.Data = make(map[string]string)
+			}
+			regionConfig.Data[region] = distances
+		}
 	}
+
 	return regionConfig, nil
 }
 
 func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
+	return seedUsabilityError(seed) == nil
+}
+
+// seedUsabilityError returns an error describing why the given seed cannot be used for scheduling, or nil if it is
+// usable.
+func seedUsabilityError(seed *gardencorev1beta1.Seed) error {
+	switch {
+	case seed.DeletionTimestamp != nil:
+		return fmt.Errorf("seed is being deleted")
+	case !seed.Spec.Settings.Scheduling.Visible:
+		return fmt.Errorf("seed is not visible for scheduling")
+	case !verifySeedReadiness(seed):
+		return fmt.Errorf("seed is not ready")
+	}
+	return nil
 }
 
 func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
@@ -316,20 +374,8 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []gardencorev1be
 	)
 
 	for _, seed := range seedList {
-		if shoot.Spec.Networking != nil {
-			if disjointed, err := networksAreDisjointed(&seed, shoot); !disjointed {
-				candidateErrors[seed.Name] = err
-				continue
-			}
-		}
-
-		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
-			candidateErrors[seed.Name] = fmt.Errorf("shoot does not tolerate the seed's taints")
-			continue
-		}
-
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
-			candidateErrors[seed.Name] = fmt.Errorf("seed does not have available capacity for shoots")
+		if _, err := candidateError(&seed, shoot, seedUsage); err != nil {
+			candidateErrors[seed.Name] = err
 			continue
 		}
 
@@ -342,6 +388,26 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []gardencorev1be
 	return candidates, nil
 }
 
+// candidateError returns the name of the failed check and an error if the given seed is not eligible for the shoot
+// because of its networks, taints or capacity.
+func candidateError(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, seedUsage map[string]int) (string, error) {
+	if shoot.Spec.Networking != nil {
+		if disjointed, err := networksAreDisjointed(seed, shoot); !disjointed {
+			return SimulationFilterNetworks, err
+		}
+	}
+
+	if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+		return SimulationFilterTaints, fmt.Errorf("shoot does not tolerate the seed's taints")
+	}
+
+	if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
+		return SimulationFilterCapacity, fmt.Errorf("seed does not have available capacity for shoots")
+	}
+
+	return "", nil
+}
+
 // getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
 func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
 	var (
@@ -409,28 +475,31 @@ func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gard
 }
 
 func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
-	var candidates []gardencorev1beta1.Seed
+	var (
+		candidates  []gardencorev1beta1.Seed
+		shootRegion = schedulingRegion(shoot)
+	)
 
-	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
-		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shoot.Spec.Region)
+	if regionConfig == nil || regionConfig.Data[shootRegion] == "" {
+		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shootRegion)
 		return candidates, nil
 	}
 
 	regionConfigData := make(map[string]int)
-	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
-		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[shootRegion]), &regionConfigData); err != nil {
+		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shootRegion, err)
 	}
 
 	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
-	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
-		regionConfigData[shoot.Spec.Region] = 0
+	if _, ok := regionConfigData[shootRegion]; !ok {
+		regionConfigData[shootRegion] = 0
 	}
 
 	minDistance := math.MaxInt32
 	for _, seed := range seeds {
 		dist, ok := regionConfigData[seed.Spec.Provider.Region]
 		if !ok {
-			log.Info("Seed region not available in scheduler region ConfigMap for shoot region", "seedName", seed.Name, "shootRegion", shoot.Spec.Region, "seedRegion", seed.Spec.Provider.Region)
+			log.Info("Seed region not available in scheduler region ConfigMap for shoot region", "seedName", seed.Name, "shootRegion", shootRegion, "seedRegion", seed.Spec.Provider.Region)
 			continue
 		}
 
@@ -451,7 +520,7 @@ func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed
 func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
 	var (
 		minDistance   = 1000
-		shootRegion   = shoot.Spec.Region
+		shootRegion   = schedulingRegion(shoot)
 		shootProvider = shoot.Spec.Provider.Type
 		candidates    []gardencorev1beta1.Seed
 	)
@@ -477,6 +546,15 @@ func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencor
 	return candidates
 }
 
+// schedulingRegion returns the region which is used to determine the distance of the shoot to the seeds. This is the
+// region of the cluster's users if specified, otherwise the shoot's region.
+func schedulingRegion(shoot *gardencorev1beta1.Shoot) string {
+	if userRegion := shoot.Annotations[v1beta1constants.AnnotationSchedulingUserRegion]; userRegion != "" {
+		return userRegion
+	}
+	return shoot.Spec.Region
+}
+
 func networksAreDisjointed(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) (bool, error) {
 	var (
 		shootPodsNetwork     = shoot.Spec.Networking.Pods
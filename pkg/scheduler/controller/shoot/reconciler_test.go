@@ -468,6 +468,105 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(shoot.Spec.Region).NotTo(Equal(bestSeed.Spec.Provider.Region))
 		})
 
+		Context("with region config", func() {
+			var (
+				secondSeed, thirdSeed gardencorev1beta1.Seed
+
+				newRegionConfig = func(name, cloudProfiles string, data map[string]string) *corev1.ConfigMap {
+					return &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        name,
+							Namespace:   "garden",
+							Labels:      map[string]string{"scheduling.gardener.cloud/purpose": "region-config"},
+							Annotations: map[string]string{"scheduling.gardener.cloud/cloudprofiles": cloudProfiles},
+						},
+						Data: data,
+					}
+				}
+			)
+
+			BeforeEach(func() {
+				seed.Spec.Provider.Region = "eu-west-1"
+
+				secondSeed = seedBase
+				secondSeed.Name = "seed-2"
+				secondSeed.Spec.Provider.Region = "us-east-1"
+
+				thirdSeed = seedBase
+				thirdSeed.Name = "seed-3"
+				thirdSeed.Spec.Provider.Region = "ap-south-1"
+
+				shoot.Spec.Region = "eu-west-1"
+
+				Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, &thirdSeed)).To(Succeed())
+			})
+
+			It("should use the default region config if there is none for the cloudprofile", func() {
+				Expect(fakeGardenClient.Create(ctx, newRegionConfig("default", "*", map[string]string{
+					"eu-west-1": "eu-west-1: 30\nus-east-1: 20\nap-south-1: 100",
+				}))).To(Succeed())
+
+				bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+			})
+
+			It("should prefer the region config of the cloudprofile over the default region config", func() {
+				Expect(fakeGardenClient.Create(ctx, newRegionConfig("default", "*", map[string]string{
+					"eu-west-1": "eu-west-1: 30\nus-east-1: 20\nap-south-1: 100",
+				}))).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, newRegionConfig("override", cloudProfileName, map[string]string{
+					"eu-west-1": "eu-west-1: 30\nus-east-1: 40\nap-south-1: 10",
+				}))).To(Succeed())
+
+				bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bestSeed.Name).To(Equal(thirdSeed.Name))
+			})
+
+			It("should use the default region config for regions not covered by the region config of the cloudprofile", func() {
+				Expect(fakeGardenClient.Create(ctx, newRegionConfig("default", "*", map[string]string{
+					"eu-west-1": "eu-west-1: 30\nus-east-1: 20\nap-south-1: 100",
+				}))).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, newRegionConfig("override", cloudProfileName, map[string]string{
+					"ap-south-1": "eu-west-1: 100\nus-east-1: 200",
+				}))).To(Succeed())
+
+				bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+			})
+
+			It("should prefer a region config listing the cloudprofile next to the wildcard over the default region config", func() {
+				Expect(fakeGardenClient.Create(ctx, newRegionConfig("a-default", "*", map[string]string{
+					"eu-west-1": "eu-west-1: 30\nus-east-1: 20\nap-south-1: 100",
+				}))).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, newRegionConfig("b-override", "*,"+cloudProfileName, map[string]string{
+					"eu-west-1": "eu-west-1: 30\nus-east-1: 40\nap-south-1: 10",
+				}))).To(Succeed())
+
+				bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bestSeed.Name).To(Equal(thirdSeed.Name))
+			})
+
+			It("should use the user region of the shoot to determine the distance", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "scheduling.gardener.cloud/user-region", "ap-south-1")
+
+				Expect(fakeGardenClient.Create(ctx, newRegionConfig("default", cloudProfileName, map[string]string{
+					"eu-west-1":  "eu-west-1: 30\nus-east-1: 20\nap-south-1: 100",
+					"ap-south-1": "eu-west-1: 100\nus-east-1: 200",
+				}))).To(Succeed())
+
+				bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bestSeed.Name).To(Equal(thirdSeed.Name))
+			})
+		})
+
 		It("should pick candidate with least shoots deployed", func() {
 			secondSeed := seedBase
 			secondSeed.Name = "seed-2"
@@ -0,0 +1,216 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// Names of the filters which are reported in the result of a scheduling simulation.
+const (
+	// SimulationFilterUsable is the filter rejecting seeds which are deleting, invisible or not ready.
+	SimulationFilterUsable = "Usable"
+	// SimulationFilterCloudProfileSeedSelector is the filter rejecting seeds not matching the seed selector of the
+	// CloudProfile.
+	SimulationFilterCloudProfileSeedSelector = "CloudProfileSeedSelector"
+	// SimulationFilterShootSeedSelector is the filter rejecting seeds not matching the seed selector of the Shoot.
+	SimulationFilterShootSeedSelector = "ShootSeedSelector"
+	// SimulationFilterProvider is the filter rejecting seeds with a provider type not matching the Shoot.
+	SimulationFilterProvider = "Provider"
+	// SimulationFilterZones is the filter rejecting seeds with less than three zones for shoots with failure tolerance
+	// type 'zone'.
+	SimulationFilterZones = "Zones"
+	// SimulationFilterNetworks is the filter rejecting seeds whose networks overlap with the networks of the Shoot.
+	SimulationFilterNetworks = "Networks"
+	// SimulationFilterTaints is the filter rejecting seeds with taints not tolerated by the Shoot.
+	SimulationFilterTaints = "Taints"
+	// SimulationFilterCapacity is the filter rejecting seeds without available capacity for shoots.
+	SimulationFilterCapacity = "Capacity"
+	// SimulationFilterStrategy is the filter rejecting seeds which are not selected by the candidate determination
+	// strategy.
+	SimulationFilterStrategy = "Strategy"
+)
+
+// SimulationResult is the result of simulating the scheduling of a shoot.
+type SimulationResult struct {
+	// Strategy is the candidate determination strategy which was applied.
+	Strategy config.CandidateDeterminationStrategy `json:"strategy"`
+	// Seed is the name of the seed the shoot would be scheduled to. It is empty if no seed is eligible.
+	Seed string `json:"seed,omitempty"`
+	// Candidates are the seeds eligible for the shoot, ranked by preference.
+	Candidates []SimulationCandidate `json:"candidates,omitempty"`
+	// Rejected are the seeds which are not eligible for the shoot.
+	Rejected []SimulationRejection `json:"rejected,omitempty"`
+	// Error describes why no seed could be determined for the shoot.
+	Error string `json:"error,omitempty"`
+}
+
+// SimulationCandidate is a seed eligible for the shoot.
+type SimulationCandidate struct {
+	// Seed is the name of the seed.
+	Seed string `json:"seed"`
+	// Rank is the position of the seed in the ranking, starting with 1 for the seed the shoot would be scheduled to.
+	Rank int `json:"rank"`
+	// Shoots is the number of shoots already scheduled to the seed. Seeds with fewer shoots are preferred.
+	Shoots int `json:"shoots"`
+	// Region is the region of the seed.
+	Region string `json:"region"`
+}
+
+// SimulationRejection is a seed which is not eligible for the shoot.
+type SimulationRejection struct {
+	// Seed is the name of the seed.
+	Seed string `json:"seed"`
+	// Filter is the name of the filter which rejected the seed.
+	Filter string `json:"filter"`
+	// Reason explains why the seed was rejected.
+	Reason string `json:"reason"`
+}
+
+// Simulate determines the seeds the given shoot could be scheduled to without binding the shoot. It applies the same
+// filters and strategy as the reconciler and explains for every seed why it was rejected or how it is ranked. The shoot
+// does not need to exist.
+func (r *Reconciler) Simulate(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*SimulationResult, error) {
+	in, err := r.getSchedulingInput(ctx, log, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return simulate(log, shoot, in, r.Config.Strategy), nil
+}
+
+func simulate(log logr.Logger, shoot *gardencorev1beta1.Shoot, in *schedulingInput, strategy config.CandidateDeterminationStrategy) *SimulationResult {
+	var (
+		result    = &SimulationResult{Strategy: strategy}
+		seedUsage = v1beta1helper.CalculateSeedUsage(in.shoots)
+		eligible  []gardencorev1beta1.Seed
+	)
+
+	for _, seed := range in.seeds {
+		if filter, err := seedRejection(&seed, shoot, in.cloudProfile, seedUsage); err != nil {
+			result.Rejected = append(result.Rejected, SimulationRejection{Seed: seed.Name, Filter: filter, Reason: err.Error()})
+			continue
+		}
+		eligible = append(eligible, seed)
+	}
+
+	if len(eligible) == 0 {
+		result.Error = fmt.Sprintf("none of the %d seeds is eligible for scheduling", len(in.seeds))
+		return result
+	}
+
+	candidates, err := applyStrategy(log, shoot, eligible, strategy, in.regionConfig)
+	if err != nil {
+		for _, seed := range eligible {
+			result.Rejected = append(result.Rejected, SimulationRejection{Seed: seed.Name, Filter: SimulationFilterStrategy, Reason: err.Error()})
+		}
+		result.Error = err.Error()
+		return result
+	}
+
+	candidateNames := make(map[string]struct{}, len(candidates))
+	for _, seed := range candidates {
+		candidateNames[seed.Name] = struct{}{}
+	}
+	for _, seed := range eligible {
+		if _, ok := candidateNames[seed.Name]; !ok {
+			result.Rejected = append(result.Rejected, SimulationRejection{
+				Seed:   seed.Name,
+				Filter: SimulationFilterStrategy,
+				Reason: fmt.Sprintf("seed in region %q is not among the candidates determined by strategy %q", seed.Spec.Provider.Region, strategyFor(shoot, strategy)),
+			})
+		}
+	}
+
+	// The reconciler chooses the first seed with the least number of shoots, hence a stable sort keeps the ranking
+	// consistent with the actual scheduling decision.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return seedUsage[candidates[i].Name] < seedUsage[candidates[j].Name]
+	})
+
+	for i, seed := range candidates {
+		result.Candidates = append(result.Candidates, SimulationCandidate{
+			Seed:   seed.Name,
+			Rank:   i + 1,
+			Shoots: seedUsage[seed.Name],
+			Region: seed.Spec.Provider.Region,
+		})
+	}
+	result.Seed = result.Candidates[0].Seed
+
+	return result
+}
+
+// seedRejection returns the name of the filter and an error if the given seed is not eligible for the shoot.
+func seedRejection(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, seedUsage map[string]int) (string, error) {
+	if err := seedUsabilityError(seed); err != nil {
+		return SimulationFilterUsable, err
+	}
+
+	if err := matchSeedSelector(seed, cloudProfile.Spec.SeedSelector); err != nil {
+		return SimulationFilterCloudProfileSeedSelector, err
+	}
+
+	if err := matchSeedSelector(seed, shoot.Spec.SeedSelector); err != nil {
+		return SimulationFilterShootSeedSelector, err
+	}
+
+	var possibleProviders []string
+	if cloudProfile.Spec.SeedSelector != nil {
+		possibleProviders = cloudProfile.Spec.SeedSelector.ProviderTypes
+	}
+	if !matchProvider(seed.Spec.Provider.Type, shoot.Spec.Provider.Type, possibleProviders) {
+		return SimulationFilterProvider, fmt.Errorf("seed provider %q does not match shoot provider %q", seed.Spec.Provider.Type, shoot.Spec.Provider.Type)
+	}
+
+	if v1beta1helper.IsMultiZonalShootControlPlane(shoot) && len(seed.Spec.Provider.Zones) < 3 {
+		return SimulationFilterZones, fmt.Errorf("seed has %d zone(s) but at least 3 are required for a shoot control plane with failure tolerance type 'zone'", len(seed.Spec.Provider.Zones))
+	}
+
+	return candidateError(seed, shoot, seedUsage)
+}
+
+func matchSeedSelector(seed *gardencorev1beta1.Seed, seedSelector *gardencorev1beta1.SeedSelector) error {
+	if seedSelector == nil {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&seedSelector.LabelSelector)
+	if err != nil {
+		return fmt.Errorf("label selector conversion failed: %v for seedSelector: %w", seedSelector.LabelSelector, err)
+	}
+	if !selector.Matches(labels.Set(seed.Labels)) {
+		return fmt.Errorf("seed labels do not match selector '%s'", selector.String())
+	}
+	return nil
+}
+
+// strategyFor returns a description of the strategy which is applied for the given shoot.
+func strategyFor(shoot *gardencorev1beta1.Shoot, strategy config.CandidateDeterminationStrategy) string {
+	if shoot.Spec.Purpose != nil && *shoot.Spec.Purpose == gardencorev1beta1.ShootPurposeTesting {
+		return "SameProvider (purpose testing)"
+	}
+	return string(strategy)
+}
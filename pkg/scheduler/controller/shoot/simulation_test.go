@@ -0,0 +1,188 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Simulation", func() {
+	var (
+		ctx        = context.Background()
+		log        = logr.Discard()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+
+		newSeed func(name, region string) *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: &config.ShootSchedulerConfiguration{Strategy: config.SameRegion},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: cloudProfile.Name,
+				Region:           "europe",
+				Provider:         gardencorev1beta1.Provider{Type: "foo", Workers: []gardencorev1beta1.Worker{{Name: "worker"}}},
+				Networking: &gardencorev1beta1.Networking{
+					Nodes:    pointer.String("10.40.0.0/16"),
+					Pods:     pointer.String("10.50.0.0/16"),
+					Services: pointer.String("10.60.0.0/16"),
+				},
+			},
+		}
+
+		newSeed = func(name, region string) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: region},
+					Networks: gardencorev1beta1.SeedNetworks{
+						Nodes:    pointer.String("10.10.0.0/16"),
+						Pods:     "10.20.0.0/16",
+						Services: "10.30.0.0/16",
+					},
+					Settings: &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}},
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+	})
+
+	Describe("#Simulate", func() {
+		It("should rank the candidates and explain the rejected seeds", func() {
+			seedBusy := newSeed("seed-busy", "europe")
+			seedIdle := newSeed("seed-idle", "europe")
+			seedOtherRegion := newSeed("seed-other-region", "asia")
+			seedOtherProvider := newSeed("seed-other-provider", "europe")
+			seedOtherProvider.Spec.Provider.Type = "bar"
+			seedInvisible := newSeed("seed-invisible", "europe")
+			seedInvisible.Spec.Settings.Scheduling.Visible = false
+			seedTainted := newSeed("seed-tainted", "europe")
+			seedTainted.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+			seedOverlapping := newSeed("seed-overlapping", "europe")
+			seedOverlapping.Spec.Networks.Pods = "10.50.0.0/16"
+
+			for _, seed := range []*gardencorev1beta1.Seed{seedBusy, seedIdle, seedOtherRegion, seedOtherProvider, seedInvisible, seedTainted, seedOverlapping} {
+				Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+			}
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String(seedBusy.Name)},
+			})).To(Succeed())
+
+			result, err := reconciler.Simulate(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Strategy).To(Equal(config.SameRegion))
+			Expect(result.Seed).To(Equal("seed-idle"))
+			Expect(result.Error).To(BeEmpty())
+			Expect(result.Candidates).To(Equal([]SimulationCandidate{
+				{Seed: "seed-idle", Rank: 1, Shoots: 0, Region: "europe"},
+				{Seed: "seed-busy", Rank: 2, Shoots: 1, Region: "europe"},
+			}))
+
+			filters := map[string]string{}
+			for _, rejection := range result.Rejected {
+				Expect(rejection.Reason).NotTo(BeEmpty())
+				filters[rejection.Seed] = rejection.Filter
+			}
+			Expect(filters).To(Equal(map[string]string{
+				"seed-other-region":   SimulationFilterStrategy,
+				"seed-other-provider": SimulationFilterProvider,
+				"seed-invisible":      SimulationFilterUsable,
+				"seed-tainted":        SimulationFilterTaints,
+				"seed-overlapping":    SimulationFilterNetworks,
+			}))
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(result.Seed))
+		})
+
+		It("should report the seeds not matching the seed selectors", func() {
+			seed := newSeed("seed", "europe")
+			seed.Labels = map[string]string{"foo": "bar"}
+			Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+			shoot.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "baz"}}}
+
+			result, err := reconciler.Simulate(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Seed).To(BeEmpty())
+			Expect(result.Candidates).To(BeEmpty())
+			Expect(result.Error).To(Equal("none of the 1 seeds is eligible for scheduling"))
+			Expect(result.Rejected).To(ConsistOf(SimulationRejection{
+				Seed:   "seed",
+				Filter: SimulationFilterShootSeedSelector,
+				Reason: "seed labels do not match selector 'foo=baz'",
+			}))
+		})
+
+		It("should report the seeds with too few zones for shoots with failure tolerance type 'zone'", func() {
+			seed := newSeed("seed", "europe")
+			seed.Spec.Provider.Zones = []string{"a", "b"}
+			Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+			shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{HighAvailability: &gardencorev1beta1.HighAvailability{FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone}}}
+
+			result, err := reconciler.Simulate(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Seed).To(BeEmpty())
+			Expect(result.Rejected).To(ConsistOf(SimulationRejection{
+				Seed:   "seed",
+				Filter: SimulationFilterZones,
+				Reason: "seed has 2 zone(s) but at least 3 are required for a shoot control plane with failure tolerance type 'zone'",
+			}))
+		})
+
+		It("should return an error if the cloud profile does not exist", func() {
+			shoot.Spec.CloudProfileName = "does-not-exist"
+
+			result, err := reconciler.Simulate(ctx, log, shoot)
+			Expect(err).To(BeNotFoundError())
+			Expect(result).To(BeNil())
+		})
+	})
+})
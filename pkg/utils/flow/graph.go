@@ -82,6 +82,16 @@ func (g *Graph) Add(task Task) TaskID {
 	return id
 }
 
+// Dependencies returns the direct dependencies of the Task with the given id. It returns nil if the graph does not
+// contain such a Task.
+func (g *Graph) Dependencies(id TaskID) TaskIDs {
+	spec, ok := g.tasks[id]
+	if !ok {
+		return nil
+	}
+	return spec.Dependencies.Copy()
+}
+
 // Compile compiles the graph into an executable Flow.
 func (g *Graph) Compile() *Flow {
 	nodes := make(nodes, len(g.tasks))
@@ -38,4 +38,22 @@ var _ = Describe("Graph", func() {
 			}).To(Panic())
 		})
 	})
+
+	Describe("#Dependencies", func() {
+		It("should return the direct dependencies of the task", func() {
+			graph := flow.NewGraph("foo")
+
+			x := graph.Add(flow.Task{Name: "x"})
+			y := graph.Add(flow.Task{Name: "y", Dependencies: flow.NewTaskIDs(x)})
+			z := graph.Add(flow.Task{Name: "z", Dependencies: flow.NewTaskIDs(x, y)})
+
+			Expect(graph.Dependencies(x)).To(BeEmpty())
+			Expect(graph.Dependencies(y)).To(Equal(flow.NewTaskIDs(x)))
+			Expect(graph.Dependencies(z)).To(Equal(flow.NewTaskIDs(x, y)))
+		})
+
+		It("should return nil for an unknown task", func() {
+			Expect(flow.NewGraph("foo").Dependencies(flow.TaskID("x"))).To(BeNil())
+		})
+	})
 })
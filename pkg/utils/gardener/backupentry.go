@@ -17,10 +17,12 @@ package gardener
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
@@ -59,3 +61,24 @@ func GetBackupEntrySeedNames(obj client.Object) (*string, *string) {
 	}
 	return backupEntry.Spec.SeedName, backupEntry.Status.SeedName
 }
+
+// ComputeBackupEntryDeletionGracePeriod computes the period after which a BackupEntry of a Shoot with the given purpose
+// is deleted after its deletion timestamp was set.
+func ComputeBackupEntryDeletionGracePeriod(deletionGracePeriodHours int, deletionGracePeriodShootPurposes []core.ShootPurpose, shootPurpose core.ShootPurpose) time.Duration {
+	// If no dedicated list of purposes is provided then the grace period applies for all purposes. If the shoot purpose
+	// is empty then it was not yet updated with the purpose annotation or the corresponding `Shoot` is already deleted
+	// from the system. In this case, for backwards-compatibility, the grace period applies as well.
+	if len(deletionGracePeriodShootPurposes) == 0 || len(shootPurpose) == 0 {
+		return time.Hour * time.Duration(deletionGracePeriodHours)
+	}
+
+	// Otherwise, the grace period only applies for the purposes in the list.
+	for _, p := range deletionGracePeriodShootPurposes {
+		if p == shootPurpose {
+			return time.Hour * time.Duration(deletionGracePeriodHours)
+		}
+	}
+
+	// If the shoot purpose was not found in the list then the grace period does not apply.
+	return 0
+}
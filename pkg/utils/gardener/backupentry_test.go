@@ -15,12 +15,15 @@
 package gardener_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -85,4 +88,15 @@ var _ = Describe("BackupEntry", func() {
 			Expect(statusSeedName).To(Equal(pointer.String("status")))
 		})
 	})
+
+	DescribeTable("#ComputeBackupEntryDeletionGracePeriod",
+		func(purposes []core.ShootPurpose, purpose core.ShootPurpose, expected time.Duration) {
+			Expect(ComputeBackupEntryDeletionGracePeriod(24, purposes, purpose)).To(Equal(expected))
+		},
+
+		Entry("no purposes configured", nil, core.ShootPurposeEvaluation, 24*time.Hour),
+		Entry("shoot purpose unknown", []core.ShootPurpose{core.ShootPurposeProduction}, core.ShootPurpose(""), 24*time.Hour),
+		Entry("shoot purpose is configured", []core.ShootPurpose{core.ShootPurposeProduction}, core.ShootPurposeProduction, 24*time.Hour),
+		Entry("shoot purpose is not configured", []core.ShootPurpose{core.ShootPurposeProduction}, core.ShootPurposeEvaluation, time.Duration(0)),
+	)
 })
@@ -18,9 +18,54 @@ import (
 	"fmt"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
+// ExtensionTypeLabelPrefixes are the prefixes of the labels describing which extension types are used by an object.
+var ExtensionTypeLabelPrefixes = []string{
+	v1beta1constants.LabelExtensionExtensionTypePrefix,
+	v1beta1constants.LabelExtensionProviderTypePrefix,
+	v1beta1constants.LabelExtensionDNSRecordTypePrefix,
+	v1beta1constants.LabelExtensionNetworkingTypePrefix,
+	v1beta1constants.LabelExtensionOperatingSystemConfigTypePrefix,
+	v1beta1constants.LabelExtensionContainerRuntimeTypePrefix,
+}
+
 // NamespaceNameForControllerInstallation returns the name of the namespace that will be used for the extension controller in the seed.
 func NamespaceNameForControllerInstallation(controllerInstallation *gardencorev1beta1.ControllerInstallation) string {
 	return fmt.Sprintf("extension-%s", controllerInstallation.Name)
 }
+
+// ExtensionTypeLabelsForControllerRegistration returns the labels describing which extension types the given
+// ControllerRegistration is responsible for. The label keys equal those which are added to shoots using these extension
+// types, hence, they can be used to discover the extensions managing a shoot.
+func ExtensionTypeLabelsForControllerRegistration(controllerRegistration *gardencorev1beta1.ControllerRegistration) map[string]string {
+	labels := make(map[string]string)
+
+	for _, resource := range controllerRegistration.Spec.Resources {
+		var prefix string
+
+		switch resource.Kind {
+		case extensionsv1alpha1.ExtensionResource:
+			prefix = v1beta1constants.LabelExtensionExtensionTypePrefix
+		case extensionsv1alpha1.InfrastructureResource, extensionsv1alpha1.ControlPlaneResource, extensionsv1alpha1.WorkerResource,
+			extensionsv1alpha1.BackupBucketResource, extensionsv1alpha1.BackupEntryResource, extensionsv1alpha1.BastionResource:
+			prefix = v1beta1constants.LabelExtensionProviderTypePrefix
+		case extensionsv1alpha1.DNSRecordResource:
+			prefix = v1beta1constants.LabelExtensionDNSRecordTypePrefix
+		case extensionsv1alpha1.NetworkResource:
+			prefix = v1beta1constants.LabelExtensionNetworkingTypePrefix
+		case extensionsv1alpha1.OperatingSystemConfigResource:
+			prefix = v1beta1constants.LabelExtensionOperatingSystemConfigTypePrefix
+		case extensionsv1alpha1.ContainerRuntimeResource:
+			prefix = v1beta1constants.LabelExtensionContainerRuntimeTypePrefix
+		default:
+			continue
+		}
+
+		labels[prefix+resource.Type] = "true"
+	}
+
+	return labels
+}
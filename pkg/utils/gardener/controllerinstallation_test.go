@@ -34,4 +34,39 @@ var _ = Describe("ControllerInstallation", func() {
 			Expect(NamespaceNameForControllerInstallation(controllerInstallation)).To(Equal("extension-foo"))
 		})
 	})
+
+	Describe("#ExtensionTypeLabelsForControllerRegistration", func() {
+		It("should return the labels for all kinds and types of the ControllerRegistration", func() {
+			controllerRegistration := &gardencorev1beta1.ControllerRegistration{
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Resources: []gardencorev1beta1.ControllerResource{
+						{Kind: "Infrastructure", Type: "foo"},
+						{Kind: "ControlPlane", Type: "foo"},
+						{Kind: "Worker", Type: "foo"},
+						{Kind: "BackupBucket", Type: "bar"},
+						{Kind: "Extension", Type: "baz"},
+						{Kind: "Network", Type: "cni"},
+						{Kind: "DNSRecord", Type: "dns"},
+						{Kind: "OperatingSystemConfig", Type: "os"},
+						{Kind: "ContainerRuntime", Type: "cr"},
+						{Kind: "Unknown", Type: "unknown"},
+					},
+				},
+			}
+
+			Expect(ExtensionTypeLabelsForControllerRegistration(controllerRegistration)).To(Equal(map[string]string{
+				"provider.extensions.gardener.cloud/foo":             "true",
+				"provider.extensions.gardener.cloud/bar":             "true",
+				"extensions.extensions.gardener.cloud/baz":           "true",
+				"networking.extensions.gardener.cloud/cni":           "true",
+				"dnsrecord.extensions.gardener.cloud/dns":            "true",
+				"operatingsystemconfig.extensions.gardener.cloud/os": "true",
+				"containerruntime.extensions.gardener.cloud/cr":      "true",
+			}))
+		})
+
+		It("should return empty labels if the ControllerRegistration has no resources", func() {
+			Expect(ExtensionTypeLabelsForControllerRegistration(&gardencorev1beta1.ControllerRegistration{})).To(BeEmpty())
+		})
+	})
 })
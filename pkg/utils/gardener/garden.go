@@ -103,6 +103,10 @@ func DomainIsDefaultDomain(domain string, defaultDomains []*Domain) *Domain {
 	return nil
 }
 
+// DataKeyServiceAccountIssuerHostname is the key in the data of the shoot service account issuer secret containing the
+// hostname under which the managed service account issuers of shoot clusters are served.
+const DataKeyServiceAccountIssuerHostname = "hostname"
+
 var gardenRoleReq = utils.MustNewRequirement(v1beta1constants.GardenRole, selection.Exists)
 
 // ReadGardenSecrets reads the Kubernetes Secrets from the Garden cluster which are independent of Shoot clusters.
@@ -124,6 +128,7 @@ func ReadGardenSecrets(
 		numberOfOpenVPNDiffieHellmanSecrets = 0
 		numberOfAlertingSecrets             = 0
 		numberOfGlobalMonitoringSecrets     = 0
+		numberOfServiceAccountIssuerSecrets = 0
 	)
 
 	secretList := &corev1.SecretList{}
@@ -203,6 +208,32 @@ func ReadGardenSecrets(
 			secretsMap[v1beta1constants.GardenRoleGlobalShootRemoteWriteMonitoring] = &monitoringSecret
 			logInfo = append(logInfo, fmt.Sprintf("monitoring basic auth secret %q", secret.Name))
 		}
+
+		// Retrieving the secret containing the hostname under which the managed service account issuers of shoot
+		// clusters are served with a label indicating the Garden role shoot-service-account-issuer.
+		if secret.Labels[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleShootServiceAccountIssuer {
+			serviceAccountIssuer := secret
+			if len(secret.Data[DataKeyServiceAccountIssuerHostname]) == 0 {
+				return nil, fmt.Errorf("cannot use shoot service account issuer secret '%s' as it does not contain key '%s'", secret.Name, DataKeyServiceAccountIssuerHostname)
+			}
+			secretsMap[v1beta1constants.GardenRoleShootServiceAccountIssuer] = &serviceAccountIssuer
+			logInfo = append(logInfo, fmt.Sprintf("shoot service account issuer secret %q", secret.Name))
+			numberOfServiceAccountIssuerSecrets++
+		}
+
+		// Retrieving policy bundles which shall be distributed to the shoot clusters based on all secrets in the Garden
+		// namespace which have a label indicating the Garden role policy-bundle.
+		if secret.Labels[v1beta1constants.GardenRole] == v1beta1constants.GardenRolePolicyBundle {
+			policyBundle, err := constructPolicyBundleFromSecret(&secret)
+			if err != nil {
+				log.Error(err, "Error getting information out of policy bundle secret", "secret", client.ObjectKeyFromObject(&secret))
+				continue
+			}
+
+			policyBundleSecret := secret
+			secretsMap[fmt.Sprintf("%s-%s-%s", v1beta1constants.GardenRolePolicyBundle, policyBundle.Name, policyBundle.Version)] = &policyBundleSecret
+			logInfo = append(logInfo, fmt.Sprintf("policy bundle secret %q for bundle %q in version %q", secret.Name, policyBundle.Name, policyBundle.Version))
+		}
 	}
 
 	// For each Shoot we create a LoadBalancer(LB) pointing to the API server of the Shoot. Because the technical address
@@ -236,6 +267,10 @@ func ReadGardenSecrets(
 		return nil, fmt.Errorf("can only accept at most one global monitoring secret, but found %d", numberOfGlobalMonitoringSecrets)
 	}
 
+	if numberOfServiceAccountIssuerSecrets > 1 {
+		return nil, fmt.Errorf("can only accept at most one shoot service account issuer secret, but found %d", numberOfServiceAccountIssuerSecrets)
+	}
+
 	log.Info("Found secrets", "namespace", namespace, "secrets", logInfo)
 	return secretsMap, nil
 }
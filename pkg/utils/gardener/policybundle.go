@@ -0,0 +1,153 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardener
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// PolicyBundle contains information about a policy bundle configured in the garden cluster.
+type PolicyBundle struct {
+	// Name is the name of the policy bundle.
+	Name string
+	// Version is the version of the policy bundle.
+	Version string
+	// Projects is the list of project names the policy bundle is restricted to. If empty, the policy bundle applies to
+	// the shoots of all projects.
+	Projects []string
+	// Data contains the manifests of the policy bundle.
+	Data map[string][]byte
+}
+
+// AppliesToProject returns true if the policy bundle shall be distributed to the shoots of the given project.
+func (p *PolicyBundle) AppliesToProject(projectName string) bool {
+	if len(p.Projects) == 0 {
+		return true
+	}
+
+	for _, project := range p.Projects {
+		if project == projectName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetPolicyBundles finds all the policy bundle secrets within the given map and returns a list of objects that contains
+// all relevant information about the policy bundles. The list is sorted by name and version.
+func GetPolicyBundles(secrets map[string]*corev1.Secret) ([]*PolicyBundle, error) {
+	var policyBundles []*PolicyBundle
+
+	for key, secret := range secrets {
+		if strings.HasPrefix(key, v1beta1constants.GardenRolePolicyBundle) {
+			policyBundle, err := constructPolicyBundleFromSecret(secret)
+			if err != nil {
+				return nil, fmt.Errorf("error getting information out of policy bundle secret: %w", err)
+			}
+			policyBundles = append(policyBundles, policyBundle)
+		}
+	}
+
+	sort.Slice(policyBundles, func(i, j int) bool {
+		if policyBundles[i].Name != policyBundles[j].Name {
+			return policyBundles[i].Name < policyBundles[j].Name
+		}
+		return semver.MustParse(policyBundles[i].Version).LessThan(semver.MustParse(policyBundles[j].Version))
+	})
+
+	return policyBundles, nil
+}
+
+// ComputePolicyBundlesForShoot returns the policy bundles which shall be deployed to a shoot of the given project. For
+// every applicable policy bundle, the version pinned via the shoot annotations is selected. If no version is pinned, the
+// latest version is selected. An error is returned if a pinned version does not exist.
+func ComputePolicyBundlesForShoot(policyBundles []*PolicyBundle, projectName string, shootAnnotations map[string]string) ([]*PolicyBundle, error) {
+	var (
+		names    []string
+		versions = make(map[string]map[string]*PolicyBundle)
+		latest   = make(map[string]*PolicyBundle)
+		result   []*PolicyBundle
+	)
+
+	for _, policyBundle := range policyBundles {
+		if !policyBundle.AppliesToProject(projectName) {
+			continue
+		}
+
+		if _, ok := versions[policyBundle.Name]; !ok {
+			names = append(names, policyBundle.Name)
+			versions[policyBundle.Name] = make(map[string]*PolicyBundle)
+		}
+		versions[policyBundle.Name][policyBundle.Version] = policyBundle
+
+		if current, ok := latest[policyBundle.Name]; !ok || semver.MustParse(current.Version).LessThan(semver.MustParse(policyBundle.Version)) {
+			latest[policyBundle.Name] = policyBundle
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		pinnedVersion, ok := shootAnnotations[v1beta1constants.AnnotationShootPolicyBundleVersionPrefix+name]
+		if !ok {
+			result = append(result, latest[name])
+			continue
+		}
+
+		policyBundle, ok := versions[name][pinnedVersion]
+		if !ok {
+			return nil, fmt.Errorf("version %q of policy bundle %q is pinned but not available", pinnedVersion, name)
+		}
+		result = append(result, policyBundle)
+	}
+
+	return result, nil
+}
+
+func constructPolicyBundleFromSecret(secret *corev1.Secret) (*PolicyBundle, error) {
+	name := secret.Labels[v1beta1constants.LabelPolicyBundleName]
+	if len(name) == 0 {
+		return nil, fmt.Errorf("missing label %q", v1beta1constants.LabelPolicyBundleName)
+	}
+
+	version := secret.Annotations[v1beta1constants.AnnotationPolicyBundleVersion]
+	if _, err := semver.NewVersion(version); err != nil {
+		return nil, fmt.Errorf("invalid version %q in annotation %q: %w", version, v1beta1constants.AnnotationPolicyBundleVersion, err)
+	}
+
+	var projects []string
+	if v := secret.Annotations[v1beta1constants.AnnotationPolicyBundleProjects]; len(v) > 0 {
+		for _, project := range strings.Split(v, ",") {
+			if project = strings.TrimSpace(project); len(project) > 0 {
+				projects = append(projects, project)
+			}
+		}
+	}
+
+	return &PolicyBundle{
+		Name:     name,
+		Version:  version,
+		Projects: projects,
+		Data:     secret.Data,
+	}, nil
+}
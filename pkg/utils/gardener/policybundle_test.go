@@ -0,0 +1,116 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("PolicyBundle", func() {
+	policyBundleSecret := func(name, version, projects string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRolePolicyBundle, v1beta1constants.LabelPolicyBundleName: name},
+				Annotations: map[string]string{v1beta1constants.AnnotationPolicyBundleVersion: version},
+			},
+			Data: map[string][]byte{"policies.yaml": []byte(name + "@" + version)},
+		}
+		if projects != "" {
+			secret.Annotations[v1beta1constants.AnnotationPolicyBundleProjects] = projects
+		}
+		return secret
+	}
+
+	Describe("#GetPolicyBundles", func() {
+		It("should return all policy bundles sorted by name and version", func() {
+			secrets := map[string]*corev1.Secret{
+				"policy-bundle-foo-1.10.0": policyBundleSecret("foo", "1.10.0", ""),
+				"policy-bundle-foo-1.2.0":  policyBundleSecret("foo", "1.2.0", ""),
+				"policy-bundle-bar-0.1.0":  policyBundleSecret("bar", "0.1.0", "dev, prod"),
+				"internal-domain":          {},
+			}
+
+			policyBundles, err := GetPolicyBundles(secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policyBundles).To(Equal([]*PolicyBundle{
+				{Name: "bar", Version: "0.1.0", Projects: []string{"dev", "prod"}, Data: map[string][]byte{"policies.yaml": []byte("bar@0.1.0")}},
+				{Name: "foo", Version: "1.2.0", Data: map[string][]byte{"policies.yaml": []byte("foo@1.2.0")}},
+				{Name: "foo", Version: "1.10.0", Data: map[string][]byte{"policies.yaml": []byte("foo@1.10.0")}},
+			}))
+		})
+
+		It("should return an error if the version is invalid", func() {
+			secrets := map[string]*corev1.Secret{
+				"policy-bundle-foo-latest": policyBundleSecret("foo", "latest", ""),
+			}
+
+			_, err := GetPolicyBundles(secrets)
+			Expect(err).To(MatchError(ContainSubstring("invalid version")))
+		})
+
+		It("should return an error if the name is missing", func() {
+			secrets := map[string]*corev1.Secret{
+				"policy-bundle--1.0.0": policyBundleSecret("", "1.0.0", ""),
+			}
+
+			_, err := GetPolicyBundles(secrets)
+			Expect(err).To(MatchError(ContainSubstring("missing label")))
+		})
+	})
+
+	DescribeTable("#AppliesToProject",
+		func(projects []string, matcher OmegaMatcher) {
+			Expect((&PolicyBundle{Projects: projects}).AppliesToProject("dev")).To(matcher)
+		},
+
+		Entry("no restriction", nil, BeTrue()),
+		Entry("project in list", []string{"prod", "dev"}, BeTrue()),
+		Entry("project not in list", []string{"prod"}, BeFalse()),
+	)
+
+	Describe("#ComputePolicyBundlesForShoot", func() {
+		var (
+			foo1   = &PolicyBundle{Name: "foo", Version: "1.0.0"}
+			foo2   = &PolicyBundle{Name: "foo", Version: "2.0.0"}
+			bar1   = &PolicyBundle{Name: "bar", Version: "1.0.0", Projects: []string{"prod"}}
+			baz1   = &PolicyBundle{Name: "baz", Version: "1.0.0", Projects: []string{"dev"}}
+			all    = []*PolicyBundle{foo2, bar1, foo1, baz1}
+			prefix = v1beta1constants.AnnotationShootPolicyBundleVersionPrefix
+		)
+
+		It("should select the latest versions of the applicable policy bundles", func() {
+			Expect(ComputePolicyBundlesForShoot(all, "dev", nil)).To(Equal([]*PolicyBundle{baz1, foo2}))
+		})
+
+		It("should select the pinned versions", func() {
+			Expect(ComputePolicyBundlesForShoot(all, "prod", map[string]string{prefix + "foo": "1.0.0"})).To(Equal([]*PolicyBundle{bar1, foo1}))
+		})
+
+		It("should ignore pinned versions of policy bundles which do not apply", func() {
+			Expect(ComputePolicyBundlesForShoot(all, "dev", map[string]string{prefix + "bar": "1.0.0"})).To(Equal([]*PolicyBundle{baz1, foo2}))
+		})
+
+		It("should return an error if the pinned version does not exist", func() {
+			_, err := ComputePolicyBundlesForShoot(all, "dev", map[string]string{prefix + "foo": "3.0.0"})
+			Expect(err).To(MatchError(ContainSubstring(`version "3.0.0" of policy bundle "foo" is pinned but not available`)))
+		})
+	})
+})
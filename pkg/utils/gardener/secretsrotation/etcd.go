@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/time/rate"
@@ -33,6 +34,7 @@ import (
 	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
@@ -41,11 +43,17 @@ import (
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
+// ProgressReporter is called with the progress of rewriting the encrypted data. The resources are sorted by name.
+type ProgressReporter func(ctx context.Context, resources []gardencorev1beta1.ReencryptedResource) error
+
 // RewriteEncryptedDataAddLabel patches all encrypted data in all namespaces in the target clusters and adds a label
 // whose value is the name of the current ETCD encryption key secret. This function is useful for the ETCD encryption
 // key secret rotation which requires all encrypted data to be rewritten to ETCD so that they become encrypted with the
 // new key. After it's done, it snapshots ETCD so that we can restore backups in case we lose the cluster before the
 // next incremental snapshot has been taken.
+// Objects which already carry the label have been rewritten before, hence an interrupted run resumes where it stopped.
+// If a ProgressReporter is given, it is called before the objects are rewritten and whenever all objects of a resource
+// have been rewritten.
 func RewriteEncryptedDataAddLabel(
 	ctx context.Context,
 	log logr.Logger,
@@ -54,6 +62,7 @@ func RewriteEncryptedDataAddLabel(
 	resourcesToEncrypt []string,
 	encryptedResources []string,
 	defaultGVKs []schema.GroupVersionKind,
+	reportProgress ProgressReporter,
 ) error {
 	encryptedGVKs, message, err := GetResourcesForRewrite(clientSet.Kubernetes().Discovery(), resourcesToEncrypt, encryptedResources, defaultGVKs)
 	if err != nil {
@@ -74,6 +83,7 @@ func RewriteEncryptedDataAddLabel(
 			metav1.SetMetaDataLabel(objectMeta, labelKeyRotationKeyName, etcdEncryptionKeySecret.Name)
 		},
 		message+" (Add label)",
+		reportProgress,
 		encryptedGVKs...,
 	)
 }
@@ -107,6 +117,7 @@ func RewriteEncryptedDataRemoveLabel(
 			delete(objectMeta.Labels, labelKeyRotationKeyName)
 		},
 		message+" (Remove label)",
+		nil,
 		encryptedGVKs...,
 	); err != nil {
 		return err
@@ -124,24 +135,38 @@ func rewriteEncryptedData(
 	requirement labels.Requirement,
 	mutateObjectMeta func(*metav1.ObjectMeta),
 	message string,
+	reportProgress ProgressReporter,
 	gvks ...schema.GroupVersionKind,
 ) error {
 	var (
-		limiter = rate.NewLimiter(rate.Limit(rotationQPS), rotationQPS)
-		taskFns []flow.TaskFn
+		limiter  = rate.NewLimiter(rate.Limit(rotationQPS), rotationQPS)
+		progress = newRewriteProgress(reportProgress)
+		taskFns  []flow.TaskFn
 	)
 
 	for _, gvk := range gvks {
 		objList := &metav1.PartialObjectMetadataList{}
 		objList.SetGroupVersionKind(gvk)
 
-		if err := c.List(ctx, objList, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(requirement)}); err != nil {
+		// All objects are listed (instead of only those matching the requirement) so that the total number of objects
+		// can be reported.
+		if err := c.List(ctx, objList); err != nil {
 			return err
 		}
 
-		log.Info(message, "gvk", gvk, "number", len(objList.Items)) //nolint:logcheck
+		var objectsToRewrite []metav1.PartialObjectMetadata
+		for _, obj := range objList.Items {
+			if requirement.Matches(labels.Set(obj.Labels)) {
+				objectsToRewrite = append(objectsToRewrite, obj)
+			}
+		}
+
+		log.Info(message, "gvk", gvk, "number", len(objectsToRewrite)) //nolint:logcheck
+
+		resource := gvk.GroupKind().String()
+		progress.add(resource, len(objList.Items), len(objList.Items)-len(objectsToRewrite))
 
-		for _, o := range objList.Items {
+		for _, o := range objectsToRewrite {
 			obj := o
 
 			taskFns = append(taskFns, func(ctx context.Context) error {
@@ -156,14 +181,88 @@ func rewriteEncryptedData(
 					return err
 				}
 
-				return c.Patch(ctx, &obj, patch)
+				if err := c.Patch(ctx, &obj, patch); err != nil {
+					return err
+				}
+
+				return progress.rewritten(ctx, resource)
 			})
 		}
 	}
 
+	if err := progress.report(ctx); err != nil {
+		return err
+	}
+
 	return flow.Parallel(taskFns...)(ctx)
 }
 
+// rewriteProgress tracks the number of rewritten objects per resource and reports it via a ProgressReporter.
+type rewriteProgress struct {
+	reportProgress ProgressReporter
+
+	lock      sync.Mutex
+	resources map[string]*gardencorev1beta1.ReencryptedResource
+
+	// reportLock serializes the calls of the ProgressReporter.
+	reportLock sync.Mutex
+}
+
+func newRewriteProgress(reportProgress ProgressReporter) *rewriteProgress {
+	return &rewriteProgress{
+		reportProgress: reportProgress,
+		resources:      make(map[string]*gardencorev1beta1.ReencryptedResource),
+	}
+}
+
+func (p *rewriteProgress) add(resource string, total, rewritten int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.resources[resource] = &gardencorev1beta1.ReencryptedResource{
+		Resource:  resource,
+		Total:     int32(total),
+		Rewritten: int32(rewritten),
+	}
+}
+
+// rewritten increments the number of rewritten objects of the given resource. The progress is reported once all
+// objects of the resource have been rewritten.
+func (p *rewriteProgress) rewritten(ctx context.Context, resource string) error {
+	p.lock.Lock()
+	r := p.resources[resource]
+	r.Rewritten++
+	done := r.Rewritten == r.Total
+	p.lock.Unlock()
+
+	if !done {
+		return nil
+	}
+	return p.report(ctx)
+}
+
+func (p *rewriteProgress) report(ctx context.Context) error {
+	if p.reportProgress == nil {
+		return nil
+	}
+
+	p.reportLock.Lock()
+	defer p.reportLock.Unlock()
+
+	p.lock.Lock()
+	resources := make([]gardencorev1beta1.ReencryptedResource, 0, len(p.resources))
+	for _, r := range p.resources {
+		resources = append(resources, *r)
+	}
+	p.lock.Unlock()
+
+	slices.SortFunc(resources, func(a, b gardencorev1beta1.ReencryptedResource) int {
+		return strings.Compare(a.Resource, b.Resource)
+	})
+
+	return p.reportProgress(ctx, resources)
+}
+
 // SnapshotETCDAfterRewritingEncryptedData performs a full snapshot on ETCD after the encrypted data (like secrets) have
 // been rewritten as part of the ETCD encryption secret rotation. It adds an annotation to the API server deployment
 // after it's done so that it does not take another snapshot again after it succeeded once.
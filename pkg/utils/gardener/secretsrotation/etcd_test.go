@@ -16,6 +16,8 @@ package secretsrotation_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -30,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/client/kubernetes/test"
@@ -180,7 +183,7 @@ var _ = Describe("ETCD", func() {
 
 				defaultGVKs := []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("Secret")}
 
-				Expect(RewriteEncryptedDataAddLabel(ctx, logger, fakeTargetInterface, fakeSecretsManager, resources, resources, defaultGVKs)).To(Succeed())
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, fakeTargetInterface, fakeSecretsManager, resources, resources, defaultGVKs, nil)).To(Succeed())
 
 				for _, obj := range []client.Object{
 					secret1, secret2, secret3,
@@ -207,6 +210,55 @@ var _ = Describe("ETCD", func() {
 				Expect(endpointSlice2.ResourceVersion).To(Equal(endpointSlice2ResourceVersion))
 
 			})
+
+			It("should report the progress of rewriting the resources", func() {
+				Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+
+				resources := []string{
+					corev1.Resource("secrets").String(),
+					corev1.Resource("configmaps").String(),
+					appsv1.Resource("deployments").String(),
+				}
+
+				defaultGVKs := []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("Secret")}
+
+				var (
+					lock    sync.Mutex
+					reports [][]gardencorev1beta1.ReencryptedResource
+				)
+
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, fakeTargetInterface, fakeSecretsManager, resources, resources, defaultGVKs, func(_ context.Context, resources []gardencorev1beta1.ReencryptedResource) error {
+					lock.Lock()
+					defer lock.Unlock()
+					reports = append(reports, resources)
+					return nil
+				})).To(Succeed())
+
+				Expect(reports).To(HaveLen(4))
+				Expect(reports[0]).To(Equal([]gardencorev1beta1.ReencryptedResource{
+					{Resource: "ConfigMap", Total: 2, Rewritten: 1},
+					{Resource: "Deployment.apps", Total: 3, Rewritten: 1},
+					{Resource: "Secret", Total: 3, Rewritten: 1},
+				}))
+				Expect(reports[3]).To(Equal([]gardencorev1beta1.ReencryptedResource{
+					{Resource: "ConfigMap", Total: 2, Rewritten: 2},
+					{Resource: "Deployment.apps", Total: 3, Rewritten: 3},
+					{Resource: "Secret", Total: 3, Rewritten: 3},
+				}))
+			})
+
+			It("should return the error of the progress reporter", func() {
+				Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+
+				resources := []string{corev1.Resource("secrets").String()}
+
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, fakeTargetInterface, fakeSecretsManager, resources, resources, nil, func(_ context.Context, _ []gardencorev1beta1.ReencryptedResource) error {
+					return fmt.Errorf("fake")
+				})).To(MatchError("fake"))
+
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+				Expect(secret1.ResourceVersion).To(Equal(secret1ResourceVersion))
+			})
 		})
 
 		Describe("#SnapshotETCDAfterRewritingEncryptedData", func() {
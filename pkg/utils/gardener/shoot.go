@@ -169,7 +169,7 @@ func GetShootNameFromOwnerReferences(objectMeta metav1.Object) string {
 }
 
 // NodeLabelsForWorkerPool returns a combined map of all user-specified and gardener-managed node labels.
-func NodeLabelsForWorkerPool(workerPool gardencorev1beta1.Worker, nodeLocalDNSEnabled bool) map[string]string {
+func NodeLabelsForWorkerPool(workerPool gardencorev1beta1.Worker, nodeLocalDNSEnabled bool, projectName string) map[string]string {
 	// copy worker pool labels map
 	labels := utils.MergeStringMaps(workerPool.Labels)
 	if labels == nil {
@@ -199,9 +199,53 @@ func NodeLabelsForWorkerPool(workerPool gardencorev1beta1.Worker, nodeLocalDNSEn
 		}
 	}
 
+	for key, value := range TopologyLabelsForWorkerPool(workerPool, projectName) {
+		labels[key] = value
+	}
+
+	return labels
+}
+
+// TopologyLabelsForWorkerPool returns the topology labels for the nodes of the given worker pool. The zone label is only
+// added if the worker pool uses exactly one zone, since otherwise the zone of a node is not known in advance.
+func TopologyLabelsForWorkerPool(workerPool gardencorev1beta1.Worker, projectName string) map[string]string {
+	if workerPool.Topology == nil {
+		return nil
+	}
+
+	labels := map[string]string{}
+
+	if len(workerPool.Zones) == 1 {
+		labels[corev1.LabelTopologyZone] = workerPool.Zones[0]
+	}
+	if workerPool.Topology.ZoneGroup != nil {
+		labels[v1beta1constants.LabelTopologyZoneGroup] = *workerPool.Topology.ZoneGroup
+	}
+	if pointer.BoolDeref(workerPool.Topology.DedicatedToProject, false) && projectName != "" {
+		labels[v1beta1constants.LabelTopologyDedicatedToProject] = projectName
+	}
+
 	return labels
 }
 
+// NodeTaintsForWorkerPool returns a combined list of all user-specified and gardener-managed node taints.
+func NodeTaintsForWorkerPool(workerPool gardencorev1beta1.Worker) []corev1.Taint {
+	taints := append([]corev1.Taint{}, workerPool.Taints...)
+
+	if v1beta1helper.SystemComponentsOnly(&workerPool) {
+		taints = append(taints, corev1.Taint{
+			Key:    v1beta1constants.TaintWorkerPoolSystemComponentsOnly,
+			Value:  "true",
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+	}
+
+	if len(taints) == 0 {
+		return nil
+	}
+	return taints
+}
+
 const (
 	// ShootProjectSecretSuffixKubeconfig is a constant for a shoot project secret with suffix 'kubeconfig'.
 	ShootProjectSecretSuffixKubeconfig = "kubeconfig"
@@ -215,6 +259,9 @@ const (
 	ShootProjectSecretSuffixOldSSHKeypair = v1beta1constants.SecretNameSSHKeyPair + ".old"
 	// ShootProjectSecretSuffixMonitoring is a constant for a shoot project secret with suffix 'monitoring'.
 	ShootProjectSecretSuffixMonitoring = "monitoring"
+	// ShootProjectSecretSuffixServiceAccountIssuer is a constant for a shoot project secret with suffix
+	// 'service-account-issuer'.
+	ShootProjectSecretSuffixServiceAccountIssuer = "service-account-issuer"
 )
 
 // GetShootProjectSecretSuffixes returns the list of shoot-related project secret suffixes.
@@ -225,6 +272,7 @@ func GetShootProjectSecretSuffixes() []string {
 		ShootProjectSecretSuffixSSHKeypair,
 		ShootProjectSecretSuffixOldSSHKeypair,
 		ShootProjectSecretSuffixMonitoring,
+		ShootProjectSecretSuffixServiceAccountIssuer,
 	}
 }
 
@@ -478,7 +526,8 @@ func GetShootSeedNames(obj client.Object) (*string, *string) {
 }
 
 // ExtractSystemComponentsTolerations returns tolerations that are required to schedule shoot system components
-// on the given workers. Tolerations are only considered for workers which have `SystemComponents.Allow: true`.
+// on the given workers. Tolerations are only considered for workers which have `SystemComponents.Allow: true`. This
+// includes the toleration for the gardener-managed taint of workers which have `SystemComponents.Only: true`.
 func ExtractSystemComponentsTolerations(workers []gardencorev1beta1.Worker) []corev1.Toleration {
 	var (
 		tolerations = sets.New[corev1.Toleration]()
@@ -490,7 +539,7 @@ func ExtractSystemComponentsTolerations(workers []gardencorev1beta1.Worker) []co
 
 	for _, worker := range workers {
 		if v1beta1helper.SystemComponentsAllowed(&worker) {
-			for _, taint := range worker.Taints {
+			for _, taint := range NodeTaintsForWorkerPool(worker) {
 				toleration := kubernetesutils.TolerationForTaint(taint)
 				tolerations.Insert(comparableTolerations.Transform(toleration))
 			}
@@ -594,6 +643,31 @@ func ConstructExternalDomain(ctx context.Context, c client.Reader, shoot *garden
 	return externalDomain, nil
 }
 
+// ConstructPrivateDomain constructs an object containing all relevant information of the private domain that shall be
+// used for a shoot cluster - based on the configuration of the Shoot cluster (private DNS provider type, secret and zone).
+func ConstructPrivateDomain(ctx context.Context, c client.Reader, shoot *gardencorev1beta1.Shoot) (*Domain, error) {
+	if shoot.Spec.DNS == nil || shoot.Spec.DNS.Private == nil {
+		return nil, nil
+	}
+
+	private := shoot.Spec.DNS.Private
+	privateDomain := &Domain{
+		Domain:   private.Domain,
+		Provider: private.Type,
+		Zone:     pointer.StringDeref(private.Zone, ""),
+	}
+
+	if private.SecretName != nil {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, kubernetesutils.Key(shoot.Namespace, *private.SecretName), secret); err != nil {
+			return nil, fmt.Errorf("could not get private dns provider secret %q: %w", *private.SecretName, err)
+		}
+		privateDomain.SecretData = secret.Data
+	}
+
+	return privateDomain, nil
+}
+
 // ComputeRequiredExtensionsForShoot computes the extension kind/type combinations that are required for the
 // shoot reconciliation flow.
 func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, controllerRegistrationList *gardencorev1beta1.ControllerRegistrationList, internalDomain, externalDomain *Domain) sets.Set[string] {
@@ -649,6 +723,10 @@ func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gar
 		}
 	}
 
+	if shoot.Spec.DNS != nil && shoot.Spec.DNS.Private != nil && shoot.Spec.DNS.Private.Type != core.DNSUnmanaged {
+		requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.DNSRecordResource, shoot.Spec.DNS.Private.Type))
+	}
+
 	if internalDomain != nil && internalDomain.Provider != core.DNSUnmanaged {
 		requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.DNSRecordResource, internalDomain.Provider))
 	}
@@ -297,7 +297,7 @@ var _ = Describe("Shoot", func() {
 		})
 
 		It("should maintain the common labels", func() {
-			Expect(NodeLabelsForWorkerPool(workerPool, false)).To(And(
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "")).To(And(
 				HaveKeyWithValue("node.kubernetes.io/role", "node"),
 				HaveKeyWithValue("kubernetes.io/arch", "arm64"),
 				HaveKeyWithValue("networking.gardener.cloud/node-local-dns-enabled", "false"),
@@ -312,7 +312,7 @@ var _ = Describe("Shoot", func() {
 				"test": "foo",
 				"bar":  "baz",
 			}
-			Expect(NodeLabelsForWorkerPool(workerPool, false)).To(And(
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "")).To(And(
 				HaveKeyWithValue("test", "foo"),
 				HaveKeyWithValue("bar", "baz"),
 			))
@@ -320,16 +320,16 @@ var _ = Describe("Shoot", func() {
 
 		It("should not add system components label if they are not allowed", func() {
 			workerPool.SystemComponents.Allow = false
-			Expect(NodeLabelsForWorkerPool(workerPool, false)).NotTo(
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "")).NotTo(
 				HaveKey("worker.gardener.cloud/system-components"),
 			)
 		})
 
 		It("should correctly handle the node-local-dns label", func() {
-			Expect(NodeLabelsForWorkerPool(workerPool, false)).To(
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "")).To(
 				HaveKeyWithValue("networking.gardener.cloud/node-local-dns-enabled", "false"),
 			)
-			Expect(NodeLabelsForWorkerPool(workerPool, true)).To(
+			Expect(NodeLabelsForWorkerPool(workerPool, true, "")).To(
 				HaveKeyWithValue("networking.gardener.cloud/node-local-dns-enabled", "true"),
 			)
 		})
@@ -346,17 +346,101 @@ var _ = Describe("Shoot", func() {
 					},
 				},
 			}
-			Expect(NodeLabelsForWorkerPool(workerPool, false)).To(And(
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "")).To(And(
 				HaveKeyWithValue("worker.gardener.cloud/cri-name", "containerd"),
 				HaveKeyWithValue("containerruntime.worker.gardener.cloud/gvisor", "true"),
 				HaveKeyWithValue("containerruntime.worker.gardener.cloud/kata", "true"),
 			))
 		})
+
+		It("should add the topology labels", func() {
+			workerPool.Zones = []string{"zone-a"}
+			workerPool.Topology = &gardencorev1beta1.WorkerTopology{
+				ZoneGroup:          pointer.String("group-a"),
+				DedicatedToProject: pointer.Bool(true),
+			}
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "dev")).To(And(
+				HaveKeyWithValue("topology.kubernetes.io/zone", "zone-a"),
+				HaveKeyWithValue("topology.gardener.cloud/zone-group", "group-a"),
+				HaveKeyWithValue("topology.gardener.cloud/dedicated-to-project", "dev"),
+			))
+		})
+	})
+
+	Describe("#TopologyLabelsForWorkerPool", func() {
+		var workerPool gardencorev1beta1.Worker
+
+		BeforeEach(func() {
+			workerPool = gardencorev1beta1.Worker{
+				Name:  "worker",
+				Zones: []string{"zone-a"},
+			}
+		})
+
+		It("should return nil if no topology is configured", func() {
+			Expect(TopologyLabelsForWorkerPool(workerPool, "dev")).To(BeNil())
+		})
+
+		It("should only add the zone label if the worker pool uses exactly one zone", func() {
+			workerPool.Topology = &gardencorev1beta1.WorkerTopology{}
+			Expect(TopologyLabelsForWorkerPool(workerPool, "dev")).To(Equal(map[string]string{
+				"topology.kubernetes.io/zone": "zone-a",
+			}))
+
+			workerPool.Zones = append(workerPool.Zones, "zone-b")
+			Expect(TopologyLabelsForWorkerPool(workerPool, "dev")).To(BeEmpty())
+		})
+
+		It("should add the zone group label", func() {
+			workerPool.Topology = &gardencorev1beta1.WorkerTopology{ZoneGroup: pointer.String("group-a")}
+			Expect(TopologyLabelsForWorkerPool(workerPool, "dev")).To(Equal(map[string]string{
+				"topology.kubernetes.io/zone":        "zone-a",
+				"topology.gardener.cloud/zone-group": "group-a",
+			}))
+		})
+
+		It("should add the dedicated-to-project label only if enabled", func() {
+			workerPool.Topology = &gardencorev1beta1.WorkerTopology{DedicatedToProject: pointer.Bool(false)}
+			Expect(TopologyLabelsForWorkerPool(workerPool, "dev")).NotTo(HaveKey("topology.gardener.cloud/dedicated-to-project"))
+
+			workerPool.Topology.DedicatedToProject = pointer.Bool(true)
+			Expect(TopologyLabelsForWorkerPool(workerPool, "dev")).To(HaveKeyWithValue("topology.gardener.cloud/dedicated-to-project", "dev"))
+		})
+	})
+
+	Describe("#NodeTaintsForWorkerPool", func() {
+		var workerPool gardencorev1beta1.Worker
+
+		BeforeEach(func() {
+			workerPool = gardencorev1beta1.Worker{
+				Name:   "worker",
+				Taints: []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoExecute}},
+			}
+		})
+
+		It("should return nil if no taints are configured", func() {
+			workerPool.Taints = nil
+			Expect(NodeTaintsForWorkerPool(workerPool)).To(BeNil())
+		})
+
+		It("should only return the user-specified taints", func() {
+			workerPool.SystemComponents = &gardencorev1beta1.WorkerSystemComponents{Allow: true}
+			Expect(NodeTaintsForWorkerPool(workerPool)).To(Equal(workerPool.Taints))
+		})
+
+		It("should add the system-components-only taint", func() {
+			workerPool.SystemComponents = &gardencorev1beta1.WorkerSystemComponents{Allow: true, Only: pointer.Bool(true)}
+			Expect(NodeTaintsForWorkerPool(workerPool)).To(Equal([]corev1.Taint{
+				{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoExecute},
+				{Key: "worker.gardener.cloud/system-components-only", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+			}))
+			Expect(workerPool.Taints).To(HaveLen(1))
+		})
 	})
 
 	Describe("#GetShootProjectSecretSuffixes", func() {
 		It("should return the expected list", func() {
-			Expect(GetShootProjectSecretSuffixes()).To(ConsistOf("kubeconfig", "ca-cluster", "ssh-keypair", "ssh-keypair.old", "monitoring"))
+			Expect(GetShootProjectSecretSuffixes()).To(ConsistOf("kubeconfig", "ca-cluster", "ssh-keypair", "ssh-keypair.old", "monitoring", "service-account-issuer"))
 		})
 	})
 
@@ -386,6 +470,7 @@ var _ = Describe("Shoot", func() {
 		Entry("ssh-keypair suffix", "bar.ssh-keypair", "bar", true),
 		Entry("ssh-keypair.old suffix", "bar.ssh-keypair.old", "bar", true),
 		Entry("monitoring suffix", "baz.monitoring", "baz", true),
+		Entry("service-account-issuer suffix", "foo.service-account-issuer", "foo", true),
 	)
 
 	DescribeTable("#IsShootProjectInternalSecret",
@@ -797,6 +882,22 @@ var _ = Describe("Shoot", func() {
 			}))
 		})
 
+		It("should return the toleration for workers dedicated to system components", func() {
+			Expect(ExtractSystemComponentsTolerations([]gardencorev1beta1.Worker{
+				{
+					SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true, Only: pointer.Bool(true)},
+				},
+				{
+					SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true},
+				},
+			})).To(ConsistOf(corev1.Toleration{
+				Key:      "worker.gardener.cloud/system-components-only",
+				Operator: corev1.TolerationOpEqual,
+				Value:    "true",
+				Effect:   corev1.TaintEffectNoSchedule,
+			}))
+		})
+
 		It("should return tolerations when taints are defined multiple times for system worker group", func() {
 			Expect(ExtractSystemComponentsTolerations([]gardencorev1beta1.Worker{
 				{
@@ -1012,6 +1113,62 @@ var _ = Describe("Shoot", func() {
 		})
 	})
 
+	Describe("#ConstructPrivateDomain", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			shoot      *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Spec: gardencorev1beta1.ShootSpec{
+					DNS: &gardencorev1beta1.DNS{
+						Private: &gardencorev1beta1.PrivateDNS{
+							Domain:     "shoot.corp.internal",
+							Type:       "my-dns-provider",
+							SecretName: pointer.String("private-dns"),
+							Zone:       pointer.String("private-zone"),
+						},
+					},
+				},
+			}
+		})
+
+		It("returns nil because no private domain is used", func() {
+			shoot.Spec.DNS.Private = nil
+
+			privateDomain, err := ConstructPrivateDomain(ctx, fakeClient, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(privateDomain).To(BeNil())
+		})
+
+		It("returns the private domain with the referenced secret", func() {
+			dnsSecretData := map[string][]byte{"foo": []byte("bar")}
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "private-dns", Namespace: "default"},
+				Data:       dnsSecretData,
+			})).To(Succeed())
+
+			privateDomain, err := ConstructPrivateDomain(ctx, fakeClient, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(privateDomain).To(Equal(&Domain{
+				Domain:     "shoot.corp.internal",
+				Provider:   "my-dns-provider",
+				Zone:       "private-zone",
+				SecretData: dnsSecretData,
+			}))
+		})
+
+		It("fails if the referenced secret does not exist", func() {
+			privateDomain, err := ConstructPrivateDomain(ctx, fakeClient, shoot)
+			Expect(err).To(MatchError(ContainSubstring("could not get private dns provider secret")))
+			Expect(privateDomain).To(BeNil())
+		})
+	})
+
 	Describe("#ComputeRequiredExtensionsForShoot", func() {
 		const (
 			backupProvider       = "backupprovider"
@@ -1138,6 +1295,22 @@ var _ = Describe("Shoot", func() {
 			)))
 		})
 
+		It("should compute the correct list of required extensions (private DNS)", func() {
+			seed.Spec.Backup = nil
+			shoot.Spec.Provider.Workers = nil
+			shoot.Spec.DNS.Private = &gardencorev1beta1.PrivateDNS{Type: "privatedns"}
+
+			result := ComputeRequiredExtensionsForShoot(shoot, seed, controllerRegistrationList, internalDomain, externalDomain)
+
+			Expect(result).To(BeEquivalentTo(sets.New(
+				ExtensionsID(extensionsv1alpha1.ControlPlaneResource, seedProvider),
+				ExtensionsID(extensionsv1alpha1.ExtensionResource, extensionType1),
+				ExtensionsID(extensionsv1alpha1.DNSRecordResource, dnsProviderType1),
+				ExtensionsID(extensionsv1alpha1.DNSRecordResource, dnsProviderType2),
+				ExtensionsID(extensionsv1alpha1.DNSRecordResource, "privatedns"),
+			)))
+		})
+
 		It("should compute the correct list of required extensions (no seed backup)", func() {
 			seed.Spec.Backup = nil
 
@@ -0,0 +1,68 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagevector
+
+import (
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ReadOverwrites reads Overwrites from the given buffer.
+func ReadOverwrites(buf []byte) (*Overwrites, error) {
+	overwrites := &Overwrites{}
+	if err := yaml.Unmarshal(buf, overwrites); err != nil {
+		return nil, err
+	}
+
+	if errs := ValidateOverwrites(overwrites, nil, nil, nil); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return overwrites, nil
+}
+
+// ReadOverwritesFile reads Overwrites from the file with the given name.
+func ReadOverwritesFile(name string) (*Overwrites, error) {
+	buf, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadOverwrites(buf)
+}
+
+// ComponentImageVectors returns the component-scoped overwrites as ComponentImageVectors.
+func (o *Overwrites) ComponentImageVectors() ComponentImageVectors {
+	return toComponentImageVectors(o.Components)
+}
+
+// ExtensionImageVectors returns the extension-scoped overwrites as ComponentImageVectors, i.e., the keys are the names
+// of the ControllerRegistrations of the extensions.
+func (o *Overwrites) ExtensionImageVectors() ComponentImageVectors {
+	return toComponentImageVectors(o.Extensions)
+}
+
+func toComponentImageVectors(componentImageVectors []ComponentImageVector) ComponentImageVectors {
+	if len(componentImageVectors) == 0 {
+		return nil
+	}
+
+	out := make(ComponentImageVectors, len(componentImageVectors))
+	for _, componentImageVector := range componentImageVectors {
+		out[componentImageVector.Name] = componentImageVector.ImageVectorOverwrite
+	}
+	return out
+}
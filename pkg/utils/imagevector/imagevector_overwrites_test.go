@@ -0,0 +1,124 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagevector_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("Overwrites", func() {
+	var (
+		overwritesYAML = `
+images:
+- name: foo
+  repository: example.com/foo
+  tag: v1
+components:
+- name: etcd-druid
+  imageVectorOverwrite: "images: []"
+extensions:
+- name: provider-local
+  imageVectorOverwrite: "images: []"
+`
+		overwrites = &Overwrites{
+			Images:     ImageVector{{Name: "foo", Repository: "example.com/foo", Tag: pointer.String("v1")}},
+			Components: []ComponentImageVector{{Name: "etcd-druid", ImageVectorOverwrite: "images: []"}},
+			Extensions: []ComponentImageVector{{Name: "provider-local", ImageVectorOverwrite: "images: []"}},
+		}
+	)
+
+	Describe("#ReadOverwrites", func() {
+		It("should successfully read the overwrites", func() {
+			Expect(ReadOverwrites([]byte(overwritesYAML))).To(Equal(overwrites))
+		})
+
+		It("should fail if the overwrites are invalid", func() {
+			_, err := ReadOverwrites([]byte(`
+extensions:
+- name: provider-local
+  imageVectorOverwrite: "images: []"
+- name: provider-local
+  imageVectorOverwrite: "images: []"
+`))
+			Expect(err).To(MatchError(ContainSubstring(`extensions[1].name: Duplicate value: "provider-local"`)))
+		})
+	})
+
+	Describe("#ReadOverwritesFile", func() {
+		It("should successfully read the file and close it", func() {
+			tmpFile, cleanup := withTempFile("overwrites", []byte(overwritesYAML))
+			defer cleanup()
+
+			Expect(ReadOverwritesFile(tmpFile.Name())).To(Equal(overwrites))
+		})
+	})
+
+	Describe("#ComponentImageVectors", func() {
+		It("should return the component-scoped overwrites", func() {
+			Expect(overwrites.ComponentImageVectors()).To(Equal(ComponentImageVectors{"etcd-druid": "images: []"}))
+		})
+
+		It("should return nil if there are no component-scoped overwrites", func() {
+			Expect((&Overwrites{}).ComponentImageVectors()).To(BeNil())
+		})
+	})
+
+	Describe("#ExtensionImageVectors", func() {
+		It("should return the extension-scoped overwrites", func() {
+			Expect(overwrites.ExtensionImageVectors()).To(Equal(ComponentImageVectors{"provider-local": "images: []"}))
+		})
+	})
+
+	Describe("#ValidateOverwrites", func() {
+		It("should allow overwrites for known images and components", func() {
+			Expect(ValidateOverwrites(overwrites, sets.New("foo", "bar"), sets.New("etcd-druid"), field.NewPath("overwrites"))).To(BeEmpty())
+		})
+
+		It("should forbid overwrites for unknown images and components", func() {
+			Expect(ValidateOverwrites(overwrites, sets.New("bar"), sets.New("other"), field.NewPath("overwrites"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("overwrites.images[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("overwrites.components[0].name"),
+				})),
+			))
+		})
+
+		It("should forbid invalid scoped overwrites", func() {
+			Expect(ValidateOverwrites(&Overwrites{
+				Extensions: []ComponentImageVector{{Name: "", ImageVectorOverwrite: "images: [{name: foo}]"}},
+			}, nil, nil, field.NewPath("overwrites"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("overwrites.extensions[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("overwrites.extensions[0].imageVectorOverwrite"),
+				})),
+			))
+		})
+	})
+})
@@ -16,6 +16,7 @@ package imagevector
 
 import (
 	"github.com/Masterminds/semver/v3"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -45,6 +46,51 @@ func ValidateComponentImageVectors(componentImageVectors ComponentImageVectors,
 	return allErrs
 }
 
+// ValidateOverwrites validates the given Overwrites. If knownImageNames is non-nil, the global image overwrites must
+// refer to known image names. Similarly, if knownComponentNames is non-nil, the component-scoped overwrites must refer
+// to known components.
+func ValidateOverwrites(overwrites *Overwrites, knownImageNames, knownComponentNames sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateImageVector(overwrites.Images, fldPath.Child("images"))...)
+	if knownImageNames != nil {
+		for i, imageSource := range overwrites.Images {
+			if imageSource.Name != "" && !knownImageNames.Has(imageSource.Name) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("images").Index(i).Child("name"), imageSource.Name, sets.List(knownImageNames)))
+			}
+		}
+	}
+
+	allErrs = append(allErrs, validateScopedImageVectors(overwrites.Components, knownComponentNames, fldPath.Child("components"))...)
+	allErrs = append(allErrs, validateScopedImageVectors(overwrites.Extensions, nil, fldPath.Child("extensions"))...)
+
+	return allErrs
+}
+
+func validateScopedImageVectors(componentImageVectors []ComponentImageVector, knownNames sets.Set[string], fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, componentImageVector := range componentImageVectors {
+		idxPath := fldPath.Index(i)
+
+		allErrs = append(allErrs, validateComponentImageVector(&componentImageVector, idxPath)...)
+
+		if names.Has(componentImageVector.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), componentImageVector.Name))
+		}
+		names.Insert(componentImageVector.Name)
+
+		if knownNames != nil && componentImageVector.Name != "" && !knownNames.Has(componentImageVector.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), componentImageVector.Name, sets.List(knownNames)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateImageSource(imageSource *ImageSource, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
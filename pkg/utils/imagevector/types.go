@@ -50,6 +50,17 @@ type ComponentImageVector struct {
 // ComponentImageVectors maps a component with a given name (key) to the image vector overwrite content (value).
 type ComponentImageVectors map[string]string
 
+// Overwrites contains image vector overwrites which are either applied to the images of all components deployed by
+// Gardener, or scoped to individual components or extensions.
+type Overwrites struct {
+	// Images are overwrites for the global image vector.
+	Images ImageVector `json:"images,omitempty" yaml:"images,omitempty"`
+	// Components are overwrites for components which maintain their own image vector (e.g., etcd-druid).
+	Components []ComponentImageVector `json:"components,omitempty" yaml:"components,omitempty"`
+	// Extensions are overwrites for extensions. The name is the name of the ControllerRegistration of the extension.
+	Extensions []ComponentImageVector `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+}
+
 // FindOptions are options that can be supplied during either `FindImage` or `FindImages`.
 type FindOptions struct {
 	RuntimeVersion *string
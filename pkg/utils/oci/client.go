@@ -0,0 +1,241 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const maxManifestSize = 4 << 20
+
+var errNotFound = errors.New("not found")
+
+// repositoryClient is a minimal client for pulling content of a single repository via the OCI distribution API.
+// It supports anonymous access as well as anonymous bearer tokens handed out by the registry's token service.
+type repositoryClient struct {
+	httpClient *http.Client
+	ref        reference
+	token      string
+}
+
+func (c *repositoryClient) fetchManifest(ctx context.Context, reference string, into interface{}) (string, error) {
+	data, err := c.fetch(ctx, "manifests", reference, maxManifestSize, ocispecv1.MediaTypeImageManifest, ocispecv1.MediaTypeImageIndex)
+	if err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal(data, into); err != nil {
+		return "", fmt.Errorf("failed to decode manifest %s: %w", reference, err)
+	}
+
+	return digestOf(data), nil
+}
+
+func (c *repositoryClient) fetchReferrers(ctx context.Context, digest, artifactType string) (*ocispecv1.Index, error) {
+	index := &ocispecv1.Index{}
+
+	data, err := c.fetch(ctx, "referrers", digest+"?artifactType="+url.QueryEscape(artifactType), maxManifestSize, ocispecv1.MediaTypeImageIndex)
+	if err != nil {
+		if !errors.Is(err, errNotFound) {
+			return nil, err
+		}
+
+		// The registry does not support the referrers API, fall back to the referrers tag schema.
+		if _, err := c.fetchManifest(ctx, strings.Replace(digest, ":", "-", 1), index); err != nil {
+			if errors.Is(err, errNotFound) {
+				return index, nil
+			}
+			return nil, err
+		}
+	} else if err := json.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("failed to decode referrers of %s: %w", digest, err)
+	}
+
+	var manifests []ocispecv1.Descriptor
+	for _, manifest := range index.Manifests {
+		if manifest.ArtifactType == artifactType {
+			manifests = append(manifests, manifest)
+		}
+	}
+	index.Manifests = manifests
+
+	return index, nil
+}
+
+func (c *repositoryClient) fetchBlob(ctx context.Context, descriptor ocispecv1.Descriptor, limit int64) ([]byte, error) {
+	if descriptor.Size > limit {
+		return nil, fmt.Errorf("blob %s exceeds the maximum size of %d bytes", descriptor.Digest, limit)
+	}
+
+	data, err := c.fetch(ctx, "blobs", string(descriptor.Digest), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if digest := digestOf(data); digest != string(descriptor.Digest) {
+		return nil, fmt.Errorf("digest %s of blob does not match expected digest %s", digest, descriptor.Digest)
+	}
+
+	return data, nil
+}
+
+func (c *repositoryClient) fetch(ctx context.Context, kind, reference string, limit int64, accept ...string) ([]byte, error) {
+	u := fmt.Sprintf("https://%s/v2/%s/%s/%s", c.ref.registry, c.ref.repository, kind, reference)
+
+	resp, err := c.get(ctx, u, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		drainAndClose(resp)
+
+		if c.token, err = c.fetchToken(ctx, challenge); err != nil {
+			return nil, fmt.Errorf("failed to authenticate to registry %s: %w", c.ref.registry, err)
+		}
+
+		if resp, err = c.get(ctx, u, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer drainAndClose(resp)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s %s of %s: %w", kind, reference, c.ref.repository, errNotFound)
+	default:
+		return nil, fmt.Errorf("unexpected status code %d when fetching %s %s of %s", resp.StatusCode, kind, reference, c.ref.repository)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading %s %s of %s: %w", kind, reference, c.ref.repository, err)
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%s %s of %s exceeds the maximum size of %d bytes", kind, reference, c.ref.repository, limit)
+	}
+
+	return data, nil
+}
+
+func (c *repositoryClient) get(ctx context.Context, u string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+func (c *repositoryClient) fetchToken(ctx context.Context, challenge string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") {
+		return "", fmt.Errorf("unsupported authentication scheme %q, only anonymous bearer tokens are supported", scheme)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid realm %q in authentication challenge", params["realm"])
+	}
+
+	query := realm.Query()
+	if service, ok := params["service"]; ok {
+		query.Set("service", service)
+	}
+	if scope, ok := params["scope"]; ok {
+		query.Set("scope", scope)
+	} else {
+		query.Set("scope", fmt.Sprintf("repository:%s:pull", c.ref.repository))
+	}
+	realm.RawQuery = query.Encode()
+
+	resp, err := c.get(ctx, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	defer drainAndClose(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d when fetching token", resp.StatusCode)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxManifestSize)).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("token response does not contain a token")
+}
+
+// parseChallenge parses a WWW-Authenticate header value like `Bearer realm="https://auth.example.com/token",service="registry.example.com"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				break
+			}
+			params[key], rest = value[1:end+1], value[end+2:]
+		} else {
+			params[key], rest, _ = strings.Cut(value, ",")
+			params[key] = strings.TrimSpace(params[key])
+		}
+
+		rest = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+	}
+
+	return scheme, params
+}
+
+func drainAndClose(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxManifestSize))
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	cosignMediaTypeSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	cosignAnnotationSignature    = "dev.cosignproject.cosign/signature"
+	cosignSignatureTagSuffix     = ".sig"
+	maxSignaturePayloadSize      = 1 << 20
+)
+
+// cosignPayload is the simple signing payload which is signed by cosign.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verifyCosign verifies that the manifest with the given digest has at least one cosign signature created with the
+// private key belonging to the configured public key.
+func verifyCosign(ctx context.Context, c *repositoryClient, manifestDigest string, config *CosignVerification) error {
+	publicKey, err := parsePublicKey(config.PublicKey)
+	if err != nil {
+		return &VerificationError{Reason: "invalid cosign public key", Err: err}
+	}
+
+	signatures := &ocispecv1.Manifest{}
+	if _, err := c.fetchManifest(ctx, strings.Replace(manifestDigest, ":", "-", 1)+cosignSignatureTagSuffix, signatures); err != nil {
+		if errors.Is(err, errNotFound) {
+			return &VerificationError{Reason: "no cosign signature found", Err: err}
+		}
+		return err
+	}
+
+	var result error
+	for _, layer := range signatures.Layers {
+		if layer.MediaType != cosignMediaTypeSimpleSigning {
+			continue
+		}
+
+		if err := verifyCosignLayer(ctx, c, layer, manifestDigest, publicKey); err != nil {
+			var verificationErr *VerificationError
+			if !errors.As(err, &verificationErr) {
+				return err
+			}
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		return nil
+	}
+
+	return &VerificationError{Reason: "no valid cosign signature found", Err: result}
+}
+
+func verifyCosignLayer(ctx context.Context, c *repositoryClient, layer ocispecv1.Descriptor, manifestDigest string, publicKey crypto.PublicKey) error {
+	signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignAnnotationSignature])
+	if err != nil || len(signature) == 0 {
+		return &VerificationError{Reason: fmt.Sprintf("signature of layer %s is missing or malformed", layer.Digest), Err: err}
+	}
+
+	payload, err := c.fetchBlob(ctx, layer, maxSignaturePayloadSize)
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignature(publicKey, payload, signature); err != nil {
+		return &VerificationError{Reason: fmt.Sprintf("signature of layer %s is invalid", layer.Digest), Err: err}
+	}
+
+	p := &cosignPayload{}
+	if err := json.Unmarshal(payload, p); err != nil {
+		return &VerificationError{Reason: fmt.Sprintf("payload of layer %s cannot be decoded", layer.Digest), Err: err}
+	}
+	if p.Critical.Image.DockerManifestDigest != manifestDigest {
+		return &VerificationError{Reason: fmt.Sprintf("signature of layer %s was created for digest %q", layer.Digest, p.Critical.Image.DockerManifestDigest)}
+	}
+
+	return nil
+}
+
+func parsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// verifySignature verifies the signature of the payload like cosign does, i.e. by hashing it with SHA-256 for ECDSA
+// and RSA (PKCS #1 v1.5) keys and without pre-hashing for Ed25519 keys.
+func verifySignature(publicKey crypto.PublicKey, payload, signature []byte) error {
+	digest := sha256.Sum256(payload)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, payload, signature) {
+			return fmt.Errorf("invalid Ed25519 signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	return nil
+}
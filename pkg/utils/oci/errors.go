@@ -0,0 +1,45 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"errors"
+)
+
+// VerificationError is returned when an artifact was pulled successfully but does not satisfy the configured digest
+// or signature requirements.
+type VerificationError struct {
+	// Reason describes why the verification failed.
+	Reason string
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *VerificationError) Error() string {
+	if e.Err == nil {
+		return "verification failed: " + e.Reason
+	}
+	return "verification failed: " + e.Reason + ": " + e.Err.Error()
+}
+
+func (e *VerificationError) Unwrap() error {
+	return e.Err
+}
+
+// IsVerificationError returns true if the given error or one of the errors it wraps is a *VerificationError.
+func IsVerificationError(err error) bool {
+	var verificationErr *VerificationError
+	return errors.As(err, &verificationErr)
+}
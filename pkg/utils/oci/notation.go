@@ -0,0 +1,244 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	notationArtifactTypeSignature = "application/vnd.cncf.notary.signature"
+	notationMediaTypeJWS          = "application/jose+json"
+	notationContentTypePayload    = "application/vnd.cncf.notary.payload.v1+json"
+	notationSigningSchemeX509     = "notary.x509"
+)
+
+var notationKnownCriticalHeaders = map[string]struct{}{
+	"io.cncf.notary.signingScheme":        {},
+	"io.cncf.notary.expiry":               {},
+	"io.cncf.notary.authenticSigningTime": {},
+}
+
+type notationEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		CertificateChain [][]byte `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+type notationProtectedHeader struct {
+	Algorithm     string     `json:"alg"`
+	ContentType   string     `json:"cty"`
+	Critical      []string   `json:"crit"`
+	SigningScheme string     `json:"io.cncf.notary.signingScheme"`
+	Expiry        *time.Time `json:"io.cncf.notary.expiry,omitempty"`
+}
+
+type notationPayload struct {
+	TargetArtifact ocispecv1.Descriptor `json:"targetArtifact"`
+}
+
+// verifyNotation verifies that the manifest with the given digest has at least one notation signature (JWS envelope,
+// `notary.x509` signing scheme) whose certificate chain is rooted in one of the configured trusted certificates.
+func verifyNotation(ctx context.Context, c *repositoryClient, manifestDigest string, config *NotationVerification, now time.Time) error {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(config.TrustedCertificates)) {
+		return &VerificationError{Reason: "no valid notation trusted certificate found"}
+	}
+
+	referrers, err := c.fetchReferrers(ctx, manifestDigest, notationArtifactTypeSignature)
+	if err != nil {
+		return err
+	}
+
+	var result error
+	for _, descriptor := range referrers.Manifests {
+		if err := verifyNotationSignature(ctx, c, descriptor, manifestDigest, roots, now); err != nil {
+			var verificationErr *VerificationError
+			if !errors.As(err, &verificationErr) {
+				return err
+			}
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		return nil
+	}
+
+	if result == nil {
+		return &VerificationError{Reason: "no notation signature found"}
+	}
+	return &VerificationError{Reason: "no valid notation signature found", Err: result}
+}
+
+func verifyNotationSignature(ctx context.Context, c *repositoryClient, descriptor ocispecv1.Descriptor, manifestDigest string, roots *x509.CertPool, now time.Time) error {
+	manifest := &ocispecv1.Manifest{}
+	if _, err := c.fetchManifest(ctx, string(descriptor.Digest), manifest); err != nil {
+		return err
+	}
+
+	if len(manifest.Layers) != 1 || manifest.Layers[0].MediaType != notationMediaTypeJWS {
+		return &VerificationError{Reason: fmt.Sprintf("signature %s does not have exactly one layer of media type %s", descriptor.Digest, notationMediaTypeJWS)}
+	}
+
+	data, err := c.fetchBlob(ctx, manifest.Layers[0], maxSignaturePayloadSize)
+	if err != nil {
+		return err
+	}
+
+	payload, err := verifyNotationEnvelope(data, roots, now)
+	if err != nil {
+		return &VerificationError{Reason: fmt.Sprintf("signature %s is invalid", descriptor.Digest), Err: err}
+	}
+
+	if string(payload.TargetArtifact.Digest) != manifestDigest {
+		return &VerificationError{Reason: fmt.Sprintf("signature %s was created for digest %q", descriptor.Digest, payload.TargetArtifact.Digest)}
+	}
+
+	return nil
+}
+
+func verifyNotationEnvelope(data []byte, roots *x509.CertPool, now time.Time) (*notationPayload, error) {
+	envelope := &notationEnvelope{}
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, fmt.Errorf("failed to decode envelope: %w", err)
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(envelope.Protected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode protected header: %w", err)
+	}
+	header := &notationProtectedHeader{}
+	if err := json.Unmarshal(rawHeader, header); err != nil {
+		return nil, fmt.Errorf("failed to decode protected header: %w", err)
+	}
+
+	if header.ContentType != notationContentTypePayload {
+		return nil, fmt.Errorf("unsupported content type %q", header.ContentType)
+	}
+	if header.SigningScheme != notationSigningSchemeX509 {
+		return nil, fmt.Errorf("unsupported signing scheme %q", header.SigningScheme)
+	}
+	for _, critical := range header.Critical {
+		if _, ok := notationKnownCriticalHeaders[critical]; !ok {
+			return nil, fmt.Errorf("unsupported critical header %q", critical)
+		}
+	}
+	if header.Expiry != nil && now.After(*header.Expiry) {
+		return nil, fmt.Errorf("signature expired at %s", header.Expiry)
+	}
+
+	if len(envelope.Header.CertificateChain) == 0 {
+		return nil, fmt.Errorf("certificate chain is empty")
+	}
+	var certificates []*x509.Certificate
+	for _, raw := range envelope.Header.CertificateChain {
+		certificate, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate chain: %w", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	if _, err := certificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return nil, fmt.Errorf("certificate chain is not trusted: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(envelope.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if err := verifyJWSSignature(header.Algorithm, certificates[0].PublicKey, []byte(envelope.Protected+"."+envelope.Payload), signature); err != nil {
+		return nil, err
+	}
+
+	rawPayload, err := base64.RawURLEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+	payload := &notationPayload{}
+	if err := json.Unmarshal(rawPayload, payload); err != nil {
+		return nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	return payload, nil
+}
+
+func verifyJWSSignature(algorithm string, publicKey crypto.PublicKey, signingInput, signature []byte) error {
+	var hash crypto.Hash
+	switch algorithm {
+	case "PS256", "ES256":
+		hash = crypto.SHA256
+	case "PS384", "ES384":
+		hash = crypto.SHA384
+	case "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", algorithm)
+	}
+
+	h := hash.New()
+	h.Write(signingInput)
+	digest := h.Sum(nil)
+
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if algorithm[0] != 'P' {
+			return fmt.Errorf("signature algorithm %q does not match RSA key", algorithm)
+		}
+		if err := rsa.VerifyPSS(key, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}); err != nil {
+			return fmt.Errorf("invalid signature: %w", err)
+		}
+	case *ecdsa.PublicKey:
+		if algorithm[0] != 'E' || len(signature)%2 != 0 {
+			return fmt.Errorf("signature algorithm %q does not match ECDSA key", algorithm)
+		}
+		var (
+			r = new(big.Int).SetBytes(signature[:len(signature)/2])
+			s = new(big.Int).SetBytes(signature[len(signature)/2:])
+		)
+		if !ecdsa.Verify(key, digest, r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var digestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+type reference struct {
+	registry   string
+	repository string
+	tag        string
+	digest     string
+}
+
+func parseReference(ref string) (reference, error) {
+	var (
+		out  reference
+		rest = strings.TrimPrefix(ref, "oci://")
+	)
+
+	if i := strings.Index(rest, "@"); i >= 0 {
+		out.digest = rest[i+1:]
+		rest = rest[:i]
+
+		if err := validateDigest(out.digest); err != nil {
+			return reference{}, fmt.Errorf("invalid reference %q: %w", ref, err)
+		}
+	}
+
+	i := strings.Index(rest, "/")
+	if i <= 0 {
+		return reference{}, fmt.Errorf("invalid reference %q: must contain a registry host and a repository", ref)
+	}
+	out.registry, rest = rest[:i], rest[i+1:]
+
+	if j := strings.LastIndex(rest, ":"); j >= 0 {
+		out.tag, rest = rest[j+1:], rest[:j]
+		if out.tag == "" {
+			return reference{}, fmt.Errorf("invalid reference %q: tag must not be empty", ref)
+		}
+	}
+	out.repository = rest
+
+	if out.repository == "" {
+		return reference{}, fmt.Errorf("invalid reference %q: must contain a registry host and a repository", ref)
+	}
+	if out.tag == "" && out.digest == "" {
+		return reference{}, fmt.Errorf("invalid reference %q: must contain a tag or a digest", ref)
+	}
+
+	return out, nil
+}
+
+// manifestReference returns the tag or digest which is used for fetching the manifest. The digest takes precedence.
+func (r reference) manifestReference() string {
+	if r.digest != "" {
+		return r.digest
+	}
+	return r.tag
+}
+
+func (r reference) String() string {
+	out := r.registry + "/" + r.repository
+	if r.tag != "" {
+		out += ":" + r.tag
+	}
+	if r.digest != "" {
+		out += "@" + r.digest
+	}
+	return out
+}
+
+func validateDigest(digest string) error {
+	if !digestRegex.MatchString(digest) {
+		return fmt.Errorf("digest %q is not a valid sha256 digest", digest)
+	}
+	return nil
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// MediaTypeHelmConfig is the media type of the config of Helm chart artifacts.
+	MediaTypeHelmConfig = "application/vnd.cncf.helm.config.v1+json"
+	// MediaTypeHelmChartContent is the media type of the layer containing the chart archive of Helm chart artifacts.
+	MediaTypeHelmChartContent = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	maxChartSize = 50 << 20
+	cacheSize    = 50
+)
+
+// Interface is an interface for pulling Helm charts from OCI registries.
+type Interface interface {
+	// Pull pulls the Helm chart archive from the given repository. The digest pinning and signature verifications
+	// configured in the repository are enforced before the chart is returned. If they are not satisfied, a
+	// *VerificationError is returned.
+	Pull(ctx context.Context, repository *HelmRepository) ([]byte, error)
+}
+
+type registry struct {
+	httpClient *http.Client
+	clock      clock.PassiveClock
+	cache      *lru.Cache
+}
+
+// NewHelmRegistry returns a new Interface for pulling Helm charts from OCI registries. Pulled charts are cached by
+// their digest.
+func NewHelmRegistry(httpClient *http.Client, clock clock.PassiveClock) Interface {
+	return &registry{
+		httpClient: httpClient,
+		clock:      clock,
+		cache:      lru.New(cacheSize),
+	}
+}
+
+func (r *registry) Pull(ctx context.Context, repository *HelmRepository) ([]byte, error) {
+	ref, err := parseReference(repository.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if repository.Digest != nil {
+		if err := validateDigest(*repository.Digest); err != nil {
+			return nil, err
+		}
+		if ref.digest != "" && ref.digest != *repository.Digest {
+			return nil, &VerificationError{Reason: fmt.Sprintf("digest %s of reference %s does not match pinned digest %s", ref.digest, ref, *repository.Digest)}
+		}
+	}
+
+	c := &repositoryClient{httpClient: r.httpClient, ref: ref}
+
+	manifest := &ocispecv1.Manifest{}
+	manifestDigest, err := c.fetchManifest(ctx, ref.manifestReference(), manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching manifest of %s: %w", ref, err)
+	}
+
+	if ref.digest != "" && manifestDigest != ref.digest {
+		return nil, &VerificationError{Reason: fmt.Sprintf("manifest of %s has unexpected digest %s", ref, manifestDigest)}
+	}
+	if repository.Digest != nil && manifestDigest != *repository.Digest {
+		return nil, &VerificationError{Reason: fmt.Sprintf("manifest of %s has digest %s which does not match pinned digest %s", ref, manifestDigest, *repository.Digest)}
+	}
+
+	if manifest.Config.MediaType != MediaTypeHelmConfig {
+		return nil, fmt.Errorf("artifact %s is not a Helm chart, config has media type %q", ref, manifest.Config.MediaType)
+	}
+
+	var chartLayer *ocispecv1.Descriptor
+	for i, layer := range manifest.Layers {
+		if layer.MediaType == MediaTypeHelmChartContent {
+			chartLayer = &manifest.Layers[i]
+			break
+		}
+	}
+	if chartLayer == nil {
+		return nil, fmt.Errorf("artifact %s does not contain a layer with media type %q", ref, MediaTypeHelmChartContent)
+	}
+
+	if verification := repository.Verification; verification != nil {
+		if verification.Cosign != nil {
+			if err := verifyCosign(ctx, c, manifestDigest, verification.Cosign); err != nil {
+				return nil, fmt.Errorf("failed verifying cosign signature of %s: %w", ref, err)
+			}
+		}
+		if verification.Notation != nil {
+			if err := verifyNotation(ctx, c, manifestDigest, verification.Notation, r.clock.Now()); err != nil {
+				return nil, fmt.Errorf("failed verifying notation signature of %s: %w", ref, err)
+			}
+		}
+	}
+
+	if chart, ok := r.cache.Get(string(chartLayer.Digest)); ok {
+		return chart.([]byte), nil
+	}
+
+	chart, err := c.fetchBlob(ctx, *chartLayer, maxChartSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching chart of %s: %w", ref, err)
+	}
+	r.cache.Add(string(chartLayer.Digest), chart)
+
+	return chart, nil
+}
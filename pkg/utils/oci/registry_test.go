@@ -0,0 +1,446 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/pkg/utils/oci"
+)
+
+var _ = Describe("Registry", func() {
+	var (
+		ctx   = context.Background()
+		clock = testclock.NewFakePassiveClock(time.Now())
+
+		fake     *fakeRegistry
+		server   *httptest.Server
+		registry Interface
+
+		chart          = []byte("chart-archive")
+		manifestDigest string
+		repository     *HelmRepository
+	)
+
+	BeforeEach(func() {
+		fake = newFakeRegistry()
+		server = httptest.NewTLSServer(fake)
+		DeferCleanup(server.Close)
+
+		registry = NewHelmRegistry(server.Client(), clock)
+
+		manifestDigest = fake.addManifest("1.0.0", ocispecv1.Manifest{
+			Config: fake.addBlob(MediaTypeHelmConfig, []byte("{}")),
+			Layers: []ocispecv1.Descriptor{fake.addBlob(MediaTypeHelmChartContent, chart)},
+		})
+
+		repository = &HelmRepository{Ref: fmt.Sprintf("oci://%s/charts/foo:1.0.0", strings.TrimPrefix(server.URL, "https://"))}
+	})
+
+	Describe("#Pull", func() {
+		It("should pull the chart by tag", func() {
+			Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+		})
+
+		It("should pull the chart by digest", func() {
+			repository.Ref = strings.Replace(repository.Ref, ":1.0.0", "@"+manifestDigest, 1)
+
+			Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+		})
+
+		It("should fetch an anonymous token if the registry requires it", func() {
+			fake.requireToken = true
+
+			Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+			Expect(fake.tokenRequests).To(Equal(1))
+		})
+
+		It("should serve the chart from the cache when it was pulled before", func() {
+			Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+			Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+
+			Expect(fake.blobRequests).To(Equal(1))
+		})
+
+		It("should fail if the reference does not contain a tag or digest", func() {
+			repository.Ref = strings.TrimSuffix(repository.Ref, ":1.0.0")
+
+			_, err := registry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("must contain a tag or a digest")))
+		})
+
+		It("should fail if the tag does not exist", func() {
+			repository.Ref = strings.Replace(repository.Ref, ":1.0.0", ":2.0.0", 1)
+
+			_, err := registry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("failed fetching manifest")))
+			Expect(IsVerificationError(err)).To(BeFalse())
+		})
+
+		It("should fail if the artifact is not a Helm chart", func() {
+			fake.addManifest("1.0.0", ocispecv1.Manifest{
+				Config: fake.addBlob("application/vnd.oci.image.config.v1+json", []byte("{}")),
+				Layers: []ocispecv1.Descriptor{fake.addBlob(MediaTypeHelmChartContent, chart)},
+			})
+
+			_, err := registry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("is not a Helm chart")))
+		})
+
+		It("should fail if the chart layer does not match its digest", func() {
+			layer := fake.addBlob(MediaTypeHelmChartContent, chart)
+			fake.blobs[string(layer.Digest)] = []byte("tampered")
+			fake.addManifest("1.0.0", ocispecv1.Manifest{
+				Config: fake.addBlob(MediaTypeHelmConfig, []byte("{}")),
+				Layers: []ocispecv1.Descriptor{layer},
+			})
+
+			_, err := registry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("does not match expected digest")))
+		})
+
+		Context("digest pinning", func() {
+			It("should pull the chart if the pinned digest matches", func() {
+				repository.Digest = &manifestDigest
+
+				Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+			})
+
+			It("should fail if the pinned digest does not match", func() {
+				repository.Digest = pointer.String(digestOf([]byte("other")))
+
+				_, err := registry.Pull(ctx, repository)
+				Expect(IsVerificationError(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("does not match pinned digest")))
+			})
+
+			It("should fail if the pinned digest is invalid", func() {
+				repository.Digest = pointer.String("foo")
+
+				_, err := registry.Pull(ctx, repository)
+				Expect(err).To(MatchError(ContainSubstring("is not a valid sha256 digest")))
+			})
+		})
+
+		Context("cosign verification", func() {
+			var privateKey *ecdsa.PrivateKey
+
+			BeforeEach(func() {
+				var err error
+				privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+
+				repository.Verification = &Verification{Cosign: &CosignVerification{PublicKey: encodePublicKey(&privateKey.PublicKey)}}
+			})
+
+			It("should pull the chart if it has a valid signature", func() {
+				fake.addCosignSignature(manifestDigest, manifestDigest, privateKey)
+
+				Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+			})
+
+			It("should fail if the chart is not signed", func() {
+				_, err := registry.Pull(ctx, repository)
+				Expect(IsVerificationError(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("no cosign signature found")))
+			})
+
+			It("should fail if the chart is signed with another key", func() {
+				otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				fake.addCosignSignature(manifestDigest, manifestDigest, otherKey)
+
+				_, err = registry.Pull(ctx, repository)
+				Expect(IsVerificationError(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("no valid cosign signature found")))
+			})
+
+			It("should fail if the signature was created for another artifact", func() {
+				fake.addCosignSignature(manifestDigest, digestOf([]byte("other")), privateKey)
+
+				_, err := registry.Pull(ctx, repository)
+				Expect(IsVerificationError(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("was created for digest")))
+			})
+		})
+
+		Context("notation verification", func() {
+			var (
+				rootCertificate *x509.Certificate
+				rootKey         *ecdsa.PrivateKey
+			)
+
+			BeforeEach(func() {
+				rootCertificate, rootKey = newCertificate(nil, nil, true)
+
+				repository.Verification = &Verification{Notation: &NotationVerification{
+					TrustedCertificates: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootCertificate.Raw})),
+				}}
+			})
+
+			It("should pull the chart if it has a valid signature", func() {
+				fake.addNotationSignature(manifestDigest, manifestDigest, rootCertificate, rootKey, true)
+
+				Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+			})
+
+			It("should pull the chart if the registry does not support the referrers API", func() {
+				fake.addNotationSignature(manifestDigest, manifestDigest, rootCertificate, rootKey, false)
+
+				Expect(registry.Pull(ctx, repository)).To(Equal(chart))
+			})
+
+			It("should fail if the chart is not signed", func() {
+				_, err := registry.Pull(ctx, repository)
+				Expect(IsVerificationError(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("no notation signature found")))
+			})
+
+			It("should fail if the signing certificate is not trusted", func() {
+				otherRootCertificate, otherRootKey := newCertificate(nil, nil, true)
+				fake.addNotationSignature(manifestDigest, manifestDigest, otherRootCertificate, otherRootKey, true)
+
+				_, err := registry.Pull(ctx, repository)
+				Expect(IsVerificationError(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("certificate chain is not trusted")))
+			})
+
+			It("should fail if the signature was created for another artifact", func() {
+				otherDigest := digestOf([]byte("other"))
+				fake.addNotationSignature(manifestDigest, otherDigest, rootCertificate, rootKey, true)
+
+				_, err := registry.Pull(ctx, repository)
+				Expect(IsVerificationError(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("was created for digest")))
+			})
+		})
+	})
+})
+
+type fakeRegistry struct {
+	lock sync.Mutex
+
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	referrers map[string][]byte
+
+	requireToken  bool
+	tokenRequests int
+	blobRequests  int
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		manifests: make(map[string][]byte),
+		blobs:     make(map[string][]byte),
+		referrers: make(map[string][]byte),
+	}
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.URL.Path == "/token" {
+		f.tokenRequests++
+		_, _ = w.Write([]byte(`{"token":"anonymous"}`))
+		return
+	}
+
+	if f.requireToken && r.Header.Get("Authorization") != "Bearer anonymous" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="https://%s/token",service="fake"`, r.Host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var (
+		data []byte
+		ok   bool
+	)
+
+	switch kind, reference, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v2/charts/foo/"), "/"); kind {
+	case "manifests":
+		data, ok = f.manifests[reference]
+	case "blobs":
+		f.blobRequests++
+		data, ok = f.blobs[reference]
+	case "referrers":
+		data, ok = f.referrers[reference]
+	}
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+func (f *fakeRegistry) addBlob(mediaType string, data []byte) ocispecv1.Descriptor {
+	d := digestOf(data)
+	f.blobs[d] = data
+	return ocispecv1.Descriptor{MediaType: mediaType, Digest: digest.Digest(d), Size: int64(len(data))}
+}
+
+func (f *fakeRegistry) addManifest(tag string, manifest ocispecv1.Manifest) string {
+	manifest.SchemaVersion = 2
+	manifest.MediaType = ocispecv1.MediaTypeImageManifest
+
+	data, err := json.Marshal(manifest)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	d := digestOf(data)
+	f.manifests[d] = data
+	if tag != "" {
+		f.manifests[tag] = data
+	}
+	return d
+}
+
+func (f *fakeRegistry) addCosignSignature(manifestDigest, signedDigest string, privateKey *ecdsa.PrivateKey) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"charts/foo"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, signedDigest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, privateKey, hash[:])
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	layer := f.addBlob("application/vnd.dev.cosign.simplesigning.v1+json", payload)
+	layer.Annotations = map[string]string{"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(signature)}
+
+	f.addManifest(strings.Replace(manifestDigest, ":", "-", 1)+".sig", ocispecv1.Manifest{
+		Config: f.addBlob("application/vnd.oci.image.config.v1+json", []byte("{}")),
+		Layers: []ocispecv1.Descriptor{layer},
+	})
+}
+
+func (f *fakeRegistry) addNotationSignature(manifestDigest, signedDigest string, rootCertificate *x509.Certificate, rootKey *ecdsa.PrivateKey, referrersAPI bool) {
+	leafCertificate, leafKey := newCertificate(rootCertificate, rootKey, false)
+
+	protected := encodeSegment(map[string]interface{}{
+		"alg":                          "ES256",
+		"cty":                          "application/vnd.cncf.notary.payload.v1+json",
+		"crit":                         []string{"io.cncf.notary.signingScheme"},
+		"io.cncf.notary.signingScheme": "notary.x509",
+		"io.cncf.notary.signingTime":   time.Now().Format(time.RFC3339),
+	})
+	payload := encodeSegment(map[string]interface{}{
+		"targetArtifact": ocispecv1.Descriptor{MediaType: ocispecv1.MediaTypeImageManifest, Digest: digest.Digest(signedDigest)},
+	})
+
+	hash := sha256.Sum256([]byte(protected + "." + payload))
+	r, s, err := ecdsa.Sign(rand.Reader, leafKey, hash[:])
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	signature := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+
+	envelope, err := json.Marshal(map[string]interface{}{
+		"payload":   payload,
+		"protected": protected,
+		"header":    map[string]interface{}{"x5c": [][]byte{leafCertificate.Raw}},
+		"signature": base64.RawURLEncoding.EncodeToString(signature),
+	})
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	signatureDigest := f.addManifest("", ocispecv1.Manifest{
+		ArtifactType: "application/vnd.cncf.notary.signature",
+		Config:       f.addBlob("application/vnd.oci.empty.v1+json", []byte("{}")),
+		Layers:       []ocispecv1.Descriptor{f.addBlob("application/jose+json", envelope)},
+		Subject:      &ocispecv1.Descriptor{MediaType: ocispecv1.MediaTypeImageManifest, Digest: digest.Digest(manifestDigest)},
+	})
+
+	index, err := json.Marshal(ocispecv1.Index{
+		MediaType: ocispecv1.MediaTypeImageIndex,
+		Manifests: []ocispecv1.Descriptor{{
+			MediaType:    ocispecv1.MediaTypeImageManifest,
+			ArtifactType: "application/vnd.cncf.notary.signature",
+			Digest:       digest.Digest(signatureDigest),
+		}},
+	})
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	if referrersAPI {
+		f.referrers[manifestDigest] = index
+	} else {
+		f.manifests[strings.Replace(manifestDigest, ":", "-", 1)] = index
+	}
+}
+
+func newCertificate(parent *x509.Certificate, parentKey crypto.Signer, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "leaf"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		BasicConstraintsValid: true,
+	}
+	if isCA {
+		template.Subject.CommonName = "root"
+		template.IsCA = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		template.ExtKeyUsage = nil
+		parent, parentKey = template, key
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	certificate, err := x509.ParseCertificate(raw)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	return certificate, key
+}
+
+func encodePublicKey(key crypto.PublicKey) string {
+	raw, err := x509.MarshalPKIXPublicKey(key)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: raw}))
+}
+
+func encodeSegment(v interface{}) string {
+	data, err := json.Marshal(v)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
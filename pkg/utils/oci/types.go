@@ -0,0 +1,50 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+// HelmRepository points to a Helm chart which is stored as OCI artifact in a registry.
+type HelmRepository struct {
+	// Ref is the full reference of the chart artifact including the registry host and a tag and/or digest, e.g.
+	// `registry.example.com/charts/provider-foo:v1.0.0`. An optional `oci://` prefix is ignored.
+	Ref string `json:"ref"`
+	// Digest pins the digest of the chart artifact's manifest, e.g. `sha256:...`. If set, the chart is only accepted if
+	// the manifest which Ref resolves to has exactly this digest.
+	Digest *string `json:"digest,omitempty"`
+	// Verification configures the verification of the chart artifact's signatures. If set, the chart is only accepted
+	// if all configured verifications succeed.
+	Verification *Verification `json:"verification,omitempty"`
+}
+
+// Verification contains the configuration for verifying signatures of OCI artifacts.
+type Verification struct {
+	// Cosign configures the verification of signatures created with cosign.
+	Cosign *CosignVerification `json:"cosign,omitempty"`
+	// Notation configures the verification of signatures created with notation.
+	Notation *NotationVerification `json:"notation,omitempty"`
+}
+
+// CosignVerification contains the configuration for verifying key-based cosign signatures.
+type CosignVerification struct {
+	// PublicKey is the PEM-encoded public key the artifact must be signed with. ECDSA, RSA and Ed25519 keys are
+	// supported.
+	PublicKey string `json:"publicKey"`
+}
+
+// NotationVerification contains the configuration for verifying notation signatures.
+type NotationVerification struct {
+	// TrustedCertificates is a PEM-encoded bundle of root certificates. The artifact must be signed with a certificate
+	// that chains up to one of these certificates.
+	TrustedCertificates string `json:"trustedCertificates"`
+}
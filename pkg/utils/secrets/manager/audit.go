@@ -0,0 +1,155 @@
+// Copyright 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// EventReasonSecretGenerated is the reason of the event which is recorded when a secret was generated for the first
+	// time.
+	EventReasonSecretGenerated = "SecretGenerated"
+	// EventReasonSecretRotated is the reason of the event which is recorded when a secret was rotated, i.e., a new secret
+	// was generated for a configuration which already had a secret.
+	EventReasonSecretRotated = "SecretRotated"
+	// EventReasonSecretDeleted is the reason of the event which is recorded when a no longer required secret was
+	// deleted.
+	EventReasonSecretDeleted = "SecretDeleted"
+
+	triggerInitialGeneration = "initial generation"
+	triggerRotationInitiated = "rotation initiated"
+	triggerAutomaticRenewal  = "automatic renewal before expiration"
+	triggerConfigChanged     = "configuration changed"
+	triggerSigningCAChanged  = "signing CA changed"
+	triggerMetadataChanged   = "secret metadata changed"
+	triggerStale             = "no longer required"
+)
+
+// WithConsumer returns a secrets manager which attributes all Generate and Get calls to the given consumer, e.g., the
+// name of the component using the secrets. The consumer is recorded in the audit log of the secrets manager.
+func WithConsumer(m Interface, consumer string) Interface {
+	return &consumerManager{Interface: m, consumer: consumer}
+}
+
+type consumerManager struct {
+	Interface
+	consumer string
+}
+
+// consumptionRecorder is implemented by secrets managers which record the consumption of secrets in their audit log.
+type consumptionRecorder interface {
+	recordConsumption(secret *corev1.Secret, consumer string)
+}
+
+func (c *consumerManager) Generate(ctx context.Context, config secretsutils.ConfigInterface, opts ...GenerateOption) (*corev1.Secret, error) {
+	return c.Interface.Generate(ctx, config, append(opts, requestedBy(c.consumer))...)
+}
+
+func (c *consumerManager) Get(name string, opts ...GetOption) (*corev1.Secret, bool) {
+	secret, found := c.Interface.Get(name, opts...)
+	if found {
+		c.recordConsumption(secret, c.consumer)
+	}
+	return secret, found
+}
+
+func (c *consumerManager) recordConsumption(secret *corev1.Secret, consumer string) {
+	if recorder, ok := c.Interface.(consumptionRecorder); ok {
+		recorder.recordConsumption(secret, consumer)
+	}
+}
+
+// recordConsumption writes an audit log entry when the given secret is consumed by the given consumer for the first
+// time during the lifetime of this secrets manager instance.
+func (m *manager) recordConsumption(secret *corev1.Secret, consumer string) {
+	if consumer == "" {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	key := secret.Name + "/" + consumer
+	if m.consumptions.Has(key) {
+		return
+	}
+	m.consumptions.Insert(key)
+
+	m.auditLogger.Info("Secret consumed", "event", "Consumed", "configName", secret.Labels[LabelKeyName], "secretName", secret.Name, "consumer", consumer)
+}
+
+// recordGeneration writes an audit log entry and records an event for the given newly created secret. If there is a
+// predecessor secret for the same configuration then the generation is a rotation and its trigger is determined by
+// comparing the labels of both secrets.
+func (m *manager) recordGeneration(configName string, secret, predecessor *corev1.Secret, consumer string) {
+	if predecessor == nil {
+		m.auditLogger.Info("Secret generated", "event", "Generated", "configName", configName, "secretName", secret.Name, "trigger", triggerInitialGeneration, "requestedBy", consumer)
+		m.recordEvent(secret, EventReasonSecretGenerated, fmt.Sprintf("Generated secret for config %q (requested by: %s)", configName, consumerOrUnknown(consumer)))
+		return
+	}
+
+	trigger := m.rotationTrigger(configName, secret, predecessor)
+	m.auditLogger.Info("Secret rotated", "event", "Rotated", "configName", configName, "secretName", secret.Name, "oldSecretName", predecessor.Name, "trigger", trigger, "requestedBy", consumer)
+	m.recordEvent(secret, EventReasonSecretRotated, fmt.Sprintf("Rotated secret for config %q, previous secret: %s, trigger: %s (requested by: %s)", configName, predecessor.Name, trigger, consumerOrUnknown(consumer)))
+}
+
+// recordDeletion writes an audit log entry and records an event for the given deleted secret.
+func (m *manager) recordDeletion(secret *corev1.Secret) {
+	m.auditLogger.Info("Secret deleted", "event", "Deleted", "configName", secret.Labels[LabelKeyName], "secretName", secret.Name, "trigger", triggerStale)
+	m.recordEvent(secret, EventReasonSecretDeleted, fmt.Sprintf("Deleted secret for config %q since it is %s", secret.Labels[LabelKeyName], triggerStale))
+}
+
+func (m *manager) recordEvent(secret *corev1.Secret, reason, message string) {
+	if m.eventRecorder == nil {
+		return
+	}
+	m.eventRecorder.Event(secret, corev1.EventTypeNormal, reason, message)
+}
+
+func (m *manager) rotationTrigger(configName string, secret, predecessor *corev1.Secret) string {
+	switch {
+	case secret.Labels[LabelKeyLastRotationInitiationTime] != predecessor.Labels[LabelKeyLastRotationInitiationTime]:
+		if m.autoRenewals.Has(configName) {
+			return triggerAutomaticRenewal
+		}
+		return triggerRotationInitiated
+	case secret.Labels[LabelKeyChecksumConfig] != predecessor.Labels[LabelKeyChecksumConfig]:
+		return triggerConfigChanged
+	case secret.Labels[LabelKeyChecksumSigningCA] != predecessor.Labels[LabelKeyChecksumSigningCA]:
+		return triggerSigningCAChanged
+	default:
+		return triggerMetadataChanged
+	}
+}
+
+func consumerOrUnknown(consumer string) string {
+	if consumer == "" {
+		return "unknown"
+	}
+	return consumer
+}
+
+func requestedBy(consumer string) GenerateOption {
+	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
+		options.requestedBy = consumer
+		return nil
+	}
+}
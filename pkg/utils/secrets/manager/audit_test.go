@@ -0,0 +1,135 @@
+// Copyright 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/logger"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Audit", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+		name      = "config"
+
+		fakeClient client.Client
+		fakeClock  = testclock.NewFakeClock(time.Time{})
+		logBuffer  *gbytes.Buffer
+		log        logr.Logger
+		recorder   *record.FakeRecorder
+		config     *secretsutils.BasicAuthSecretConfig
+
+		newManager = func(secretNamesToTimes map[string]time.Time) Interface {
+			mgr, err := New(ctx, log, fakeClock, fakeClient, namespace, identity, Config{SecretNamesToTimes: secretNamesToTimes, EventRecorder: recorder})
+			Expect(err).NotTo(HaveOccurred())
+			return mgr
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		logBuffer = gbytes.NewBuffer()
+		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(io.MultiWriter(GinkgoWriter, logBuffer)))
+		recorder = record.NewFakeRecorder(10)
+
+		config = &secretsutils.BasicAuthSecretConfig{
+			Name:           name,
+			Format:         secretsutils.BasicAuthFormatNormal,
+			Username:       "foo",
+			PasswordLength: 3,
+		}
+	})
+
+	It("should audit the initial generation of a secret", func() {
+		secret, err := WithConsumer(newManager(nil), "kube-apiserver").Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(logBuffer).Should(gbytes.Say(`"logger":"audit".+"msg":"Secret generated".+"event":"Generated","configName":"config","secretName":"` + secret.Name + `","trigger":"initial generation","requestedBy":"kube-apiserver"`))
+		Eventually(logBuffer).Should(gbytes.Say(`"msg":"Secret consumed".+"secretName":"` + secret.Name + `","consumer":"kube-apiserver"`))
+		Expect(recorder.Events).To(Receive(Equal(`Normal SecretGenerated Generated secret for config "config" (requested by: kube-apiserver)`)))
+	})
+
+	It("should audit the rotation of a secret when the rotation was initiated", func() {
+		oldSecret, err := newManager(nil).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(Receive())
+
+		secret, err := newManager(map[string]time.Time{name: time.Now()}).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(logBuffer).Should(gbytes.Say(`"msg":"Secret rotated".+"secretName":"` + secret.Name + `","oldSecretName":"` + oldSecret.Name + `","trigger":"rotation initiated","requestedBy":""`))
+		Expect(recorder.Events).To(Receive(Equal(`Normal SecretRotated Rotated secret for config "config", previous secret: ` + oldSecret.Name + `, trigger: rotation initiated (requested by: unknown)`)))
+	})
+
+	It("should audit the rotation of a secret when its configuration changed", func() {
+		oldSecret, err := newManager(nil).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		config.Username = "bar"
+		secret, err := newManager(nil).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(logBuffer).Should(gbytes.Say(`"msg":"Secret rotated".+"secretName":"` + secret.Name + `","oldSecretName":"` + oldSecret.Name + `","trigger":"configuration changed"`))
+	})
+
+	It("should audit the consumption of a secret only once per consumer", func() {
+		mgr := newManager(nil)
+		secret, err := mgr.Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		consumer := WithConsumer(mgr, "kube-controller-manager")
+		_, found := consumer.Get(name)
+		Expect(found).To(BeTrue())
+		_, found = consumer.Get(name)
+		Expect(found).To(BeTrue())
+
+		Eventually(logBuffer).Should(gbytes.Say(`"msg":"Secret consumed".+"secretName":"` + secret.Name + `","consumer":"kube-controller-manager"`))
+		Consistently(logBuffer).ShouldNot(gbytes.Say(`"msg":"Secret consumed"`))
+	})
+
+	It("should audit the deletion of stale secrets", func() {
+		oldSecret, err := newManager(nil).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(Receive())
+
+		config.Username = "bar"
+		mgr := newManager(nil)
+		_, err = mgr.Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(Receive())
+
+		Expect(mgr.Cleanup(ctx)).To(Succeed())
+
+		Eventually(logBuffer).Should(gbytes.Say(`"msg":"Secret deleted".+"secretName":"` + oldSecret.Name + `","trigger":"no longer required"`))
+		Expect(recorder.Events).To(Receive(Equal(`Normal SecretDeleted Deleted secret for config "config" since it is no longer required`)))
+	})
+})
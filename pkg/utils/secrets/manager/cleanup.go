@@ -47,7 +47,12 @@ func (m *manager) Cleanup(ctx context.Context) error {
 
 		fns = append(fns, func(ctx context.Context) error {
 			m.logger.Info("Deleting stale secret", "namespace", secret.Namespace, "name", secret.Name)
-			return client.IgnoreNotFound(m.client.Delete(ctx, &secret))
+			if err := m.client.Delete(ctx, &secret); err != nil {
+				return client.IgnoreNotFound(err)
+			}
+
+			m.recordDeletion(&secret)
+			return nil
 		})
 	}
 
@@ -64,7 +64,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 			return nil, fmt.Errorf("failed reading secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
 
-		secret, err = m.generateAndCreate(ctx, config, objectMeta)
+		secret, err = m.generateAndCreate(ctx, config, objectMeta, options.requestedBy)
 		if err != nil {
 			return nil, fmt.Errorf("failed generating and creating new secret %s for config %s: %w", client.ObjectKey{Name: objectMeta.Name, Namespace: objectMeta.Namespace}, config.GetName(), err)
 		}
@@ -78,6 +78,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 		if err := m.addToStore(config.GetName(), secret, current); err != nil {
 			return nil, fmt.Errorf("failed adding current secret %s for config %s to internal store: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
+		m.recordConsumption(secret, options.requestedBy)
 
 		if ignore, err := m.shouldIgnoreOldSecrets(desiredLabels[LabelKeyIssuedAtTime], options); err != nil {
 			return nil, fmt.Errorf("failed checking whether old secrets should be ignored for config %s: %w", config.GetName(), err)
@@ -99,7 +100,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	return secret, nil
 }
 
-func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta, requestedBy string) (*corev1.Secret, error) {
 	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
 	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
 		certConfig.CommonName = objectMeta.Name
@@ -115,6 +116,11 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		return nil, fmt.Errorf("failed taking over data from existing secret when needed: %w", err)
 	}
 
+	predecessor, err := m.newestSecret(ctx, config.GetName(), objectMeta.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed determining predecessor secret: %w", err)
+	}
+
 	secret := Secret(objectMeta, dataMap)
 	if err := m.client.Create(ctx, secret); err != nil {
 		if !apierrors.IsAlreadyExists(err) {
@@ -124,12 +130,40 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		if err := m.client.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
 			return nil, fmt.Errorf("failed reading existing secret: %w", err)
 		}
+		return secret, nil
 	}
 
 	m.logger.Info("Generated new secret", "configName", config.GetName(), "secretName", secret.Name)
+	m.recordGeneration(config.GetName(), secret, predecessor, requestedBy)
 	return secret, nil
 }
 
+// newestSecret returns the most recently created secret for the given config name except the secret with the given
+// name. It returns nil if there is no such secret.
+func (m *manager) newestSecret(ctx context.Context, configName, exceptSecretName string) (*corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := m.client.List(ctx, secretList, client.InNamespace(m.namespace), client.MatchingLabels{
+		LabelKeyName:            configName,
+		LabelKeyManagedBy:       LabelValueSecretsManager,
+		LabelKeyManagerIdentity: m.identity,
+	}); err != nil {
+		return nil, err
+	}
+
+	var newest *corev1.Secret
+	for _, secret := range secretList.Items {
+		if secret.Name == exceptSecretName {
+			continue
+		}
+
+		if newest == nil || newest.CreationTimestamp.Time.Before(secret.CreationTimestamp.Time) {
+			newest = secret.DeepCopy()
+		}
+	}
+
+	return newest, nil
+}
+
 func (m *manager) keepExistingSecretsIfNeeded(ctx context.Context, configName string, newData map[string][]byte) (map[string][]byte, error) {
 	existingSecrets := &corev1.SecretList{}
 	if err := m.client.List(ctx, existingSecrets, client.InNamespace(m.namespace), client.MatchingLabels{LabelKeyUseDataForName: configName}); err != nil {
@@ -367,6 +401,7 @@ type GenerateOptions struct {
 
 	signingCAChecksum *string
 	isBundleSecret    bool
+	requestedBy       string
 }
 
 type rotationStrategy string
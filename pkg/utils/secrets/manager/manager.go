@@ -24,6 +24,8 @@ import (
 	"github.com/mitchellh/hashstructure/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -79,10 +81,17 @@ type (
 		clock                       clock.Clock
 		store                       secretStore
 		logger                      logr.Logger
+		auditLogger                 logr.Logger
+		eventRecorder               record.EventRecorder
 		client                      client.Client
 		namespace                   string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		// autoRenewals contains the names of the secrets which are automatically renewed because they are about to
+		// expire.
+		autoRenewals sets.Set[string]
+		// consumptions contains the secret names and consumers for which a consumption was already recorded.
+		consumptions sets.Set[string]
 	}
 
 	nameToUnixTime map[string]string
@@ -106,6 +115,9 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// EventRecorder is used to record events for the generation, rotation, and deletion of secrets. The events are
+		// recorded for the respective secrets. If it is nil then no events are recorded.
+		EventRecorder record.EventRecorder
 	}
 )
 
@@ -136,10 +148,14 @@ func New(
 		store:                       make(secretStore),
 		clock:                       clock,
 		logger:                      logger.WithValues("namespace", namespace),
+		auditLogger:                 logger.WithName("audit").WithValues("namespace", namespace, "managerIdentity", identity),
+		eventRecorder:               rotation.EventRecorder,
 		client:                      c,
 		namespace:                   namespace,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		autoRenewals:                sets.New[string](),
+		consumptions:                sets.New[string](),
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -189,11 +205,15 @@ func (m *manager) initialize(ctx context.Context, rotation Config) error {
 		if mustRenew {
 			m.logger.Info("Preparing secret for automatic renewal", "secret", secret.Name, "issuedAt", secret.Labels[LabelKeyIssuedAtTime], "validUntil", secret.Labels[LabelKeyValidUntilTime])
 			m.lastRotationInitiationTimes[name] = unixTime(m.clock.Now())
+			m.autoRenewals.Insert(name)
 		}
 	}
 
 	// If the user has provided last rotation initiation times then use those.
 	for name, time := range rotation.SecretNamesToTimes {
+		if m.lastRotationInitiationTimes[name] != unixTime(time) {
+			m.autoRenewals.Delete(name)
+		}
 		m.lastRotationInitiationTimes[name] = unixTime(time)
 	}
 
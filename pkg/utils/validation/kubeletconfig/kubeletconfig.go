@@ -0,0 +1,83 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeletconfig
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// fieldVersionRanges contains the version ranges for the fields of the kubelet configuration which are not supported by
+// all Kubernetes versions supported by Gardener. Fields which are not listed are supported by all versions.
+// The kubelet refuses to start with a configuration it does not support for its version, hence nodes would fail to
+// register if such fields were passed on.
+// To maintain this list for each new Kubernetes version:
+//   - Check the changes of the `KubeletConfiguration` type in https://raw.githubusercontent.com/kubernetes/kubernetes/release-${version}/staging/src/k8s.io/kubelet/config/v1beta1/types.go.
+//   - Add fields which are exposed in `core.KubeletConfig` and were added or removed in <new-version> to the map.
+var fieldVersionRanges = map[string]*FieldVersionRange{
+	"seccompDefault": {
+		VersionRange: versionutils.VersionRange{AddedInVersion: "1.25"},
+		isSet:        func(c core.KubeletConfig) bool { return c.SeccompDefault != nil },
+	},
+}
+
+// FieldVersionRange represents the version range of a kubelet configuration field of type [AddedInVersion, RemovedInVersion).
+type FieldVersionRange struct {
+	versionutils.VersionRange
+	isSet func(core.KubeletConfig) bool
+}
+
+// IsFieldSupported returns true if the given kubelet configuration field is supported for the given Kubernetes version.
+// Fields which are not known to be version-specific are supported by all versions.
+func IsFieldSupported(fieldName, version string) (bool, error) {
+	vr := fieldVersionRanges[fieldName]
+	if vr == nil {
+		return true, nil
+	}
+
+	return vr.Contains(version)
+}
+
+// ValidateFields validates that all fields set in the given kubelet configuration are supported by the given Kubernetes
+// version.
+func ValidateFields(kubeletConfig core.KubeletConfig, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fieldNames := make([]string, 0, len(fieldVersionRanges))
+	for fieldName := range fieldVersionRanges {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		if !fieldVersionRanges[fieldName].isSet(kubeletConfig) {
+			continue
+		}
+
+		supported, err := IsFieldSupported(fieldName, version)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(fieldName), version, err.Error()))
+		} else if !supported {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(fieldName), fmt.Sprintf("not supported in Kubernetes version %s", version)))
+		}
+	}
+
+	return allErrs
+}
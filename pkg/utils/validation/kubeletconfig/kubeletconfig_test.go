@@ -0,0 +1,65 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeletconfig_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/utils/validation/kubeletconfig"
+)
+
+var _ = Describe("kubeletconfig", func() {
+	DescribeTable("#IsFieldSupported",
+		func(fieldName, version string, supported, success bool) {
+			result, err := IsFieldSupported(fieldName, version)
+			if success {
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(result).To(Equal(supported))
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("seccompDefault is supported in 1.25.0", "seccompDefault", "1.25.0", true, true),      // AddedInVersion: 1.25
+		Entry("seccompDefault is not supported in 1.24.8", "seccompDefault", "1.24.8", false, true), // AddedInVersion: 1.25
+		Entry("maxPods is supported in 1.24.8", "maxPods", "1.24.8", true, true),                    // not version-specific
+		Entry("seccompDefault with invalid version", "seccompDefault", "foo", false, false),
+	)
+
+	DescribeTable("#ValidateFields",
+		func(kubeletConfig core.KubeletConfig, version string, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateFields(kubeletConfig, version, field.NewPath("kubelet"))).To(matcher)
+		},
+
+		Entry("empty config", core.KubeletConfig{}, "1.24.8", BeEmpty()),
+		Entry("fields which are not version-specific", core.KubeletConfig{MaxPods: pointer.Int32(110)}, "1.24.8", BeEmpty()),
+		Entry("supported field", core.KubeletConfig{SeccompDefault: pointer.Bool(true)}, "1.25.0", BeEmpty()),
+		Entry("unsupported field", core.KubeletConfig{SeccompDefault: pointer.Bool(true)}, "1.24.8", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(field.ErrorTypeForbidden),
+			"Field":  Equal("kubelet.seccompDefault"),
+			"Detail": Equal("not supported in Kubernetes version 1.24.8"),
+		})))),
+		Entry("invalid version", core.KubeletConfig{SeccompDefault: pointer.Bool(false)}, "foo", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("kubelet.seccompDefault"),
+		})))),
+	)
+})
@@ -235,6 +235,9 @@ func addMetaDataLabelsShoot(shoot *core.Shoot, controllerRegistrations []*core.C
 			}
 			metav1.SetMetaDataLabel(&shoot.ObjectMeta, v1beta1constants.LabelExtensionDNSRecordTypePrefix+*provider.Type, "true")
 		}
+		if private := shoot.Spec.DNS.Private; private != nil && private.Type != core.DNSUnmanaged {
+			metav1.SetMetaDataLabel(&shoot.ObjectMeta, v1beta1constants.LabelExtensionDNSRecordTypePrefix+private.Type, "true")
+		}
 	}
 	metav1.SetMetaDataLabel(&shoot.ObjectMeta, v1beta1constants.LabelExtensionProviderTypePrefix+shoot.Spec.Provider.Type, "true")
 	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Type != nil {
@@ -299,6 +299,10 @@ func (e *ExtensionValidator) validateShoot(kindToTypesMap map[string]sets.Set[st
 				requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.DNSRecordResource, *provider.Type, fmt.Sprintf("%s extension type: %s", message, field.NewPath("spec", "dns", "providers").Index(i).Child("type"))})
 			}
 		}
+
+		if private := spec.DNS.Private; private != nil && private.Type != core.DNSUnmanaged {
+			requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.DNSRecordResource, private.Type, fmt.Sprintf("%s extension type: %s", message, field.NewPath("spec", "dns", "private", "type"))})
+		}
 	}
 
 	for i, extension := range spec.Extensions {
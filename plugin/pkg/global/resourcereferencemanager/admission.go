@@ -246,12 +246,21 @@ func (r *ReferenceManager) Admit(ctx context.Context, a admission.Attributes, _
 		operation = a.GetOperation()
 	)
 
-	if operation == admission.Delete && a.GetKind().GroupKind() != core.Kind("BackupBucket") {
+	if operation == admission.Delete && a.GetKind().GroupKind() != core.Kind("BackupBucket") && a.GetKind().GroupKind() != core.Kind("SecretBinding") {
 		return nil
 	}
 
 	switch a.GetKind().GroupKind() {
 	case core.Kind("SecretBinding"):
+		if operation == admission.Delete {
+			// DELETECOLLECTION requests (with empty names) are not validated, the SecretBinding controller still protects
+			// SecretBindings which are in use by Shoots via its finalizer.
+			if a.GetName() == "" {
+				return nil
+			}
+			return r.validateSecretBindingDeletion(ctx, a)
+		}
+
 		binding, ok := a.GetObject().(*core.SecretBinding)
 		if !ok {
 			return apierrors.NewBadRequest("could not convert resource into SecretBinding object")
@@ -688,6 +697,11 @@ func (r *ReferenceManager) ensureShootReferences(ctx context.Context, attributes
 				return fmt.Errorf("failed to reference DNS provider secret %w", err)
 			}
 		}
+		if private := shoot.Spec.DNS.Private; private != nil && private.SecretName != nil {
+			if err := r.lookupSecret(ctx, shoot.Namespace, *private.SecretName); err != nil {
+				return fmt.Errorf("failed to reference private DNS provider secret %w", err)
+			}
+		}
 	}
 
 	return nil
@@ -758,6 +772,26 @@ func (r *ReferenceManager) validateBackupBucketDeletion(ctx context.Context, a a
 	return nil
 }
 
+func (r *ReferenceManager) validateSecretBindingDeletion(ctx context.Context, a admission.Attributes) error {
+	shootList, err := r.gardenCoreClient.Core().Shoots(a.GetNamespace()).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{core.ShootSecretBindingName: a.GetName()}).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	associatedShoots := make([]string, 0, len(shootList.Items))
+	for _, shoot := range shootList.Items {
+		associatedShoots = append(associatedShoots, shoot.Name)
+	}
+
+	if len(associatedShoots) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("cannot delete SecretBinding because Shoots are still using it, shootNames: %s", strings.Join(associatedShoots, ",")))
+	}
+
+	return nil
+}
+
 type getFn func(context.Context, string, string) (runtime.Object, error)
 
 func lookupResource(ctx context.Context, namespace, name string, get getFn, fallbackGet getFn) error {
@@ -350,6 +350,38 @@ var _ = Describe("resourcereferencemanager", func() {
 		})
 
 		Context("tests for SecretBinding objects", func() {
+			It("should accept the deletion because no shoot is using the secretbinding", func() {
+				gardenCoreClient.AddReactor("list", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+					Expect(action.(testing.ListAction).GetListRestrictions().Fields.String()).To(Equal("spec.secretBindingName=" + secretBinding.Name))
+					return true, &core.ShootList{}, nil
+				})
+
+				attrs := admission.NewAttributesRecord(nil, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, defaultUserInfo)
+
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should reject the deletion because shoots are still using the secretbinding", func() {
+				shoot2 := shoot.DeepCopy()
+				shoot2.Name = "shoot2"
+
+				gardenCoreClient.AddReactor("list", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, &core.ShootList{Items: []core.Shoot{shoot, *shoot2}}, nil
+				})
+
+				attrs := admission.NewAttributesRecord(nil, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("cannot delete SecretBinding because Shoots are still using it, shootNames: %s,shoot2", shoot.Name)))
+			})
+
+			It("should accept DELETECOLLECTION requests", func() {
+				attrs := admission.NewAttributesRecord(nil, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, "", core.Resource("secretbindings").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, defaultUserInfo)
+
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
 			It("should accept because all referenced objects have been found (secret found in cache)", func() {
 				Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)).To(Succeed())
 				Expect(gardenCoreInformerFactory.Core().InternalVersion().Quotas().Informer().GetStore().Add(&quota)).To(Succeed())
@@ -322,7 +322,7 @@ var _ = Describe("ManagedSeed", func() {
 			)
 
 			BeforeEach(func() {
-				managedSeed.Spec.Gardenlet = &seedmanagement.Gardenlet{
+				managedSeed.Spec.Gardenlet = &seedmanagement.GardenletConfig{
 					Config: &gardenletv1alpha1.GardenletConfiguration{
 						TypeMeta: metav1.TypeMeta{
 							APIVersion: gardenletv1alpha1.SchemeGroupVersion.String(),
@@ -348,7 +348,7 @@ var _ = Describe("ManagedSeed", func() {
 				err := admissionHandler.Admit(context.TODO(), getManagedSeedAttributes(managedSeed), nil)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(managedSeed.Spec.Gardenlet).To(Equal(&seedmanagement.Gardenlet{
+				Expect(managedSeed.Spec.Gardenlet).To(Equal(&seedmanagement.GardenletConfig{
 					Config: &gardenletv1alpha1.GardenletConfiguration{
 						TypeMeta: metav1.TypeMeta{
 							APIVersion: gardenletv1alpha1.SchemeGroupVersion.String(),
@@ -365,7 +365,7 @@ var _ = Describe("ManagedSeed", func() {
 			})
 
 			It("should fail if config could not be converted to GardenletConfiguration", func() {
-				managedSeed.Spec.Gardenlet = &seedmanagement.Gardenlet{
+				managedSeed.Spec.Gardenlet = &seedmanagement.GardenletConfig{
 					Config: &corev1.Pod{
 						TypeMeta: metav1.TypeMeta{
 							APIVersion: corev1.SchemeGroupVersion.String(),
@@ -489,7 +489,7 @@ var _ = Describe("ManagedSeed", func() {
 						Enabled: true,
 					}
 
-					managedSeed.Spec.Gardenlet = &seedmanagement.Gardenlet{
+					managedSeed.Spec.Gardenlet = &seedmanagement.GardenletConfig{
 						Config: &gardenletv1alpha1.GardenletConfiguration{
 							TypeMeta: metav1.TypeMeta{
 								APIVersion: gardenletv1alpha1.SchemeGroupVersion.String(),
@@ -547,7 +547,7 @@ var _ = Describe("ManagedSeed", func() {
 						Enabled: true,
 					}
 
-					managedSeed.Spec.Gardenlet = &seedmanagement.Gardenlet{
+					managedSeed.Spec.Gardenlet = &seedmanagement.GardenletConfig{
 						Config: &gardenletv1alpha1.GardenletConfiguration{
 							TypeMeta: metav1.TypeMeta{
 								APIVersion: gardenletv1alpha1.SchemeGroupVersion.String(),
@@ -589,7 +589,7 @@ var _ = Describe("ManagedSeed", func() {
 							},
 						},
 					}
-					managedSeed.Spec.Gardenlet = &seedmanagement.Gardenlet{Config: gardenletConfig}
+					managedSeed.Spec.Gardenlet = &seedmanagement.GardenletConfig{Config: gardenletConfig}
 					newManagedSeed = managedSeed.DeepCopy()
 
 					Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(secret)).To(Succeed())
@@ -68,6 +68,8 @@ const (
 	PluginNameShootValidator = "ShootValidator"
 	// PluginNameShootVPAEnabledByDefault is the name of the ShootVPAEnabledByDefault admission plugin.
 	PluginNameShootVPAEnabledByDefault = "ShootVPAEnabledByDefault"
+	// PluginNameShootWorkerMetadataRestriction is the name of the ShootWorkerMetadataRestriction admission plugin.
+	PluginNameShootWorkerMetadataRestriction = "ShootWorkerMetadataRestriction"
 )
 
 // AllPluginNames returns the names of all plugins.
@@ -83,6 +85,7 @@ func AllPluginNames() []string {
 		PluginNameShootManagedSeed,                  // ShootManagedSeed
 		PluginNameShootNodeLocalDNSEnabledByDefault, // ShootNodeLocalDNSEnabledByDefault
 		PluginNameShootDNSRewriting,                 // ShootDNSRewriting
+		PluginNameShootWorkerMetadataRestriction,    // ShootWorkerMetadataRestriction
 		PluginNameShootQuotaValidator,               // ShootQuotaValidator
 		PluginNameShootValidator,                    // ShootValidator
 		PluginNameSeedValidator,                     // SeedValidator
@@ -117,7 +117,7 @@ var _ = Describe("ManagedSeed", func() {
 					Shoot: &seedmanagementv1alpha1.Shoot{
 						Name: name,
 					},
-					Gardenlet: &seedmanagementv1alpha1.Gardenlet{
+					Gardenlet: &seedmanagementv1alpha1.GardenletConfig{
 						Config: runtime.RawExtension{
 							Object: gardenletConfig,
 						},
@@ -202,7 +202,7 @@ var _ = Describe("ManagedSeed", func() {
 			})
 
 			It("should forbid Shoot update if the seedTemplate is not specified", func() {
-				managedSeed.Spec.Gardenlet = &seedmanagementv1alpha1.Gardenlet{
+				managedSeed.Spec.Gardenlet = &seedmanagementv1alpha1.GardenletConfig{
 					Config: runtime.RawExtension{
 						Object: &gardenletv1alpha1.GardenletConfiguration{},
 					},
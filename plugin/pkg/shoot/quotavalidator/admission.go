@@ -37,8 +37,13 @@ import (
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
 	timeutils "github.com/gardener/gardener/pkg/utils/time"
 	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 )
 
+// quotaWarningThreshold is the share of a quota limit from which on warnings are issued for Shoots allocating resources
+// of the quota.
+const quotaWarningThreshold = 0.8
+
 var (
 	quotaMetricNames = [6]corev1.ResourceName{
 		core.QuotaMetricCPU,
@@ -121,23 +126,65 @@ func (q *QuotaValidator) ValidateInitialization() error {
 	return nil
 }
 
-var _ admission.ValidationInterface = &QuotaValidator{}
+var (
+	_ admission.MutationInterface   = &QuotaValidator{}
+	_ admission.ValidationInterface = &QuotaValidator{}
+)
 
-// Validate checks that the requested Shoot resources do not exceed the quota limits.
-func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
-	// Wait until the caches have been synced
-	if q.readyFunc == nil {
-		q.AssignReadyFunc(func() bool {
-			for _, readyFunc := range readyFuncs {
-				if !readyFunc() {
-					return false
-				}
+// Admit warns if the requested Shoot resources are nearing the quota limits.
+func (q *QuotaValidator) Admit(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := q.waitForReady(a); err != nil {
+		return err
+	}
+
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// Pass if the shoot is intended to get deleted
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var warnings []core.AdmissionWarning
+
+	if shoot.Spec.SecretBindingName != nil {
+		secretBinding, err := q.secretBindingLister.SecretBindings(shoot.Namespace).Get(*shoot.Spec.SecretBindingName)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+
+		for _, quotaRef := range secretBinding.Quotas {
+			quota, err := q.quotaLister.Quotas(quotaRef.Namespace).Get(quotaRef.Name)
+			if err != nil {
+				return apierrors.NewInternalError(err)
 			}
-			return true
-		})
+
+			quotaWarnings, err := q.quotaNearingLimitWarnings(*shoot, *quota)
+			if err != nil {
+				return apierrors.NewInternalError(err)
+			}
+			warnings = append(warnings, quotaWarnings...)
+		}
 	}
-	if !q.WaitForReady() {
-		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+
+	admissionutils.AddShootWarnings(ctx, shoot, plugin.PluginNameShootQuotaValidator, warnings...)
+	return nil
+}
+
+// Validate checks that the requested Shoot resources do not exceed the quota limits.
+func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := q.waitForReady(a); err != nil {
+		return err
 	}
 
 	// Ignore all kinds other than Shoot
@@ -239,12 +286,26 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota core.Quota) (*[]corev1.ResourceName, error) {
-	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
-	if err != nil {
-		return nil, err
+func (q *QuotaValidator) waitForReady(a admission.Attributes) error {
+	// Wait until the caches have been synced
+	if q.readyFunc == nil {
+		q.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !q.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
 	}
-	requiredResources, err := q.determineRequiredResources(allocatedResources, shoot)
+	return nil
+}
+
+func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota core.Quota) (*[]corev1.ResourceName, error) {
+	requiredResources, err := q.requiredResources(quota, shoot)
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +325,44 @@ func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota core.Quota) (*[
 	return nil, nil
 }
 
+func (q *QuotaValidator) quotaNearingLimitWarnings(shoot core.Shoot, quota core.Quota) ([]core.AdmissionWarning, error) {
+	requiredResources, err := q.requiredResources(quota, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []core.AdmissionWarning
+	for _, metric := range quotaMetricNames {
+		limit, ok := quota.Spec.Metrics[metric]
+		if !ok || limit.IsZero() {
+			continue
+		}
+
+		required := requiredResources[metric]
+		// Exceeded limits are rejected by the validation.
+		if !hasSufficientQuota(limit, required) {
+			continue
+		}
+
+		if share := required.AsApproximateFloat64() / limit.AsApproximateFloat64(); share >= quotaWarningThreshold {
+			warnings = append(warnings, core.AdmissionWarning{
+				Reason:  admissionutils.WarningReasonQuotaNearingLimit,
+				Message: fmt.Sprintf("%d%% of the %s limit of quota %s/%s are allocated (%s of %s), further requests exceeding the limit will be rejected", int(share*100), metric, quota.Namespace, quota.Name, required.String(), limit.String()),
+			})
+		}
+	}
+
+	return warnings, nil
+}
+
+func (q *QuotaValidator) requiredResources(quota core.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
+	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
+	if err != nil {
+		return nil, err
+	}
+	return q.determineRequiredResources(allocatedResources, shoot)
+}
+
 func (q *QuotaValidator) determineAllocatedResources(quota core.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
 	shoots, err := q.findShootsReferQuota(quota, shoot)
 	if err != nil {
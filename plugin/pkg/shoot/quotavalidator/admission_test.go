@@ -312,6 +312,35 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for warnings about quotas nearing their limits", func() {
+			It("should record warnings for the metrics nearing the limits", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+				Expect(shoot.Status.AdmissionWarnings).To(ConsistOf(
+					core.AdmissionWarning{Plugin: "ShootQuotaValidator", Reason: "QuotaNearingLimit", Message: "100% of the cpu limit of quota trial/project-quota are allocated (2 of 2), further requests exceeding the limit will be rejected"},
+					core.AdmissionWarning{Plugin: "ShootQuotaValidator", Reason: "QuotaNearingLimit", Message: "100% of the memory limit of quota trial/project-quota are allocated (5Gi of 5Gi), further requests exceeding the limit will be rejected"},
+					core.AdmissionWarning{Plugin: "ShootQuotaValidator", Reason: "QuotaNearingLimit", Message: "100% of the storage.standard limit of quota trial/project-quota are allocated (30Gi of 30Gi), further requests exceeding the limit will be rejected"},
+					core.AdmissionWarning{Plugin: "ShootQuotaValidator", Reason: "QuotaNearingLimit", Message: "100% of the loadbalancer limit of quota trial/project-quota are allocated (2 of 2), further requests exceeding the limit will be rejected"},
+				))
+			})
+
+			It("should remove previously recorded warnings if no limit is nearly reached", func() {
+				secretBinding.Quotas = []corev1.ObjectReference{{Namespace: trialNamespace, Name: "secret-quota"}}
+				Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				shoot.Status.AdmissionWarnings = []core.AdmissionWarning{
+					{Plugin: "ShootQuotaValidator", Reason: "QuotaNearingLimit", Message: "foo"},
+					{Plugin: "ShootValidator", Reason: "DeprecatedField", Message: "bar"},
+				}
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+				Expect(shoot.Status.AdmissionWarnings).To(ConsistOf(
+					core.AdmissionWarning{Plugin: "ShootValidator", Reason: "DeprecatedField", Message: "bar"},
+				))
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
@@ -282,6 +282,12 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	if err := validationContext.validateShootHibernation(a); err != nil {
 		return err
 	}
+	if err := validationContext.validateUpgradePreflightChecks(a); err != nil {
+		return err
+	}
+	if err := validationContext.validateCloning(ctx, a, v.authorizer, v.shootLister, v.seedLister); err != nil {
+		return err
+	}
 	if allErrs = validationContext.ensureMachineImages(); len(allErrs) > 0 {
 		return admission.NewForbidden(a, fmt.Errorf("%+v", allErrs))
 	}
@@ -292,6 +298,7 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	allErrs = append(allErrs, validationContext.validateShootNetworks(a, helper.IsWorkerless(shoot))...)
 	allErrs = append(allErrs, validationContext.validateKubernetes(a)...)
 	allErrs = append(allErrs, validationContext.validateRegion()...)
+	allErrs = append(allErrs, validationContext.validateSchedulingUserRegion()...)
 	allErrs = append(allErrs, validationContext.validateProvider(a)...)
 	allErrs = append(allErrs, validationContext.validateAdmissionPlugins(a, v.secretLister)...)
 
@@ -308,6 +315,10 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 		return admission.NewForbidden(a, fmt.Errorf("%+v", allErrs))
 	}
 
+	if a.GetOperation() != admission.Delete && a.GetSubresource() == "" {
+		admissionutils.AddShootWarnings(ctx, shoot, plugin.PluginNameShootValidator, validationContext.admissionWarnings(time.Now())...)
+	}
+
 	return nil
 }
 
@@ -352,6 +363,10 @@ func (c *validationContext) validateProjectMembership(a admission.Attributes) er
 		return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' in project '%s' that is already marked for deletion", c.shoot.Name, c.project.Name))
 	}
 
+	if condition := helper.GetCondition(c.project.Status.Conditions, core.ProjectArchived); condition != nil && condition.Status == core.ConditionTrue {
+		return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' in project '%s' that is archived because of its inactivity, use the project again to reactivate it", c.shoot.Name, c.project.Name))
+	}
+
 	return nil
 }
 
@@ -585,6 +600,111 @@ func (c *validationContext) validateShootHibernation(a admission.Attributes) err
 	return nil
 }
 
+func (c *validationContext) validateUpgradePreflightChecks(a admission.Attributes) error {
+	// Prevent Shoots from getting upgraded to the next Kubernetes minor version in case the upgrade pre-flight checks
+	// reported blocking findings (e.g., usage of APIs which will be removed). The checks can be skipped explicitly.
+	if a.GetOperation() != admission.Update || c.shoot.Spec.Kubernetes.Version == c.oldShoot.Spec.Kubernetes.Version {
+		return nil
+	}
+
+	if c.shoot.Annotations[v1beta1constants.AnnotationShootSkipUpgradePreflightChecks] == "true" {
+		return nil
+	}
+
+	oldVersion, err := semver.NewVersion(c.oldShoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil
+	}
+	newVersion, err := semver.NewVersion(c.shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil
+	}
+
+	if newVersion.Major() == oldVersion.Major() && newVersion.Minor() <= oldVersion.Minor() {
+		return nil
+	}
+
+	if preflightConstraint := helper.GetCondition(c.shoot.Status.Constraints, core.ShootUpgradePreflightChecksPassed); preflightConstraint != nil {
+		// A constraint in 'Progressing' status indicates that it is 'False' but not yet reported as such (pardoned).
+		if preflightConstraint.Status == core.ConditionFalse || preflightConstraint.Status == core.ConditionProgressing {
+			err := fmt.Errorf("'%s' constraint is '%s': %s (annotate the shoot with '%s=true' to skip the checks)",
+				core.ShootUpgradePreflightChecksPassed, preflightConstraint.Status, preflightConstraint.Message, v1beta1constants.AnnotationShootSkipUpgradePreflightChecks)
+			return admission.NewForbidden(a, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *validationContext) validateCloning(ctx context.Context, a admission.Attributes, auth authorizer.Authorizer, shootLister gardencorelisters.ShootLister, seedLister gardencorelisters.SeedLister) error {
+	cloneFrom := c.shoot.Annotations[v1beta1constants.AnnotationShootCloneFrom]
+	if cloneFrom == "" || a.GetOperation() == admission.Delete {
+		return nil
+	}
+
+	if a.GetOperation() == admission.Update && cloneFrom != c.oldShoot.Annotations[v1beta1constants.AnnotationShootCloneFrom] {
+		return admission.NewForbidden(a, fmt.Errorf("annotation %q can only be set when creating the shoot", v1beta1constants.AnnotationShootCloneFrom))
+	}
+
+	// The etcd backups are copied to the backup bucket of the seed the clone is scheduled to.
+	if c.seed != nil && c.seed.Spec.Backup == nil && (a.GetOperation() == admission.Create || c.oldShoot.Spec.SeedName == nil) {
+		return admission.NewForbidden(a, fmt.Errorf("cannot clone shoot because backup is not configured for seed %q", c.seed.Name))
+	}
+
+	if a.GetOperation() != admission.Create {
+		return nil
+	}
+
+	sourceShoot, err := shootLister.Shoots(c.shoot.Namespace).Get(cloneFrom)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("shoot %q to clone from does not exist in namespace %q", cloneFrom, c.shoot.Namespace))
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get shoot %q to clone from: %w", cloneFrom, err))
+	}
+
+	if sourceShoot.DeletionTimestamp != nil {
+		return admission.NewForbidden(a, fmt.Errorf("cannot clone shoot %q because it is being deleted", cloneFrom))
+	}
+	if sourceShoot.Spec.SeedName == nil || sourceShoot.Status.TechnicalID == "" {
+		return admission.NewForbidden(a, fmt.Errorf("cannot clone shoot %q because it has not been created yet", cloneFrom))
+	}
+
+	sourceSeed, err := seedLister.Get(*sourceShoot.Spec.SeedName)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not find seed %q of shoot to clone from: %w", *sourceShoot.Spec.SeedName, err))
+	}
+	if sourceSeed.Spec.Backup == nil {
+		return admission.NewForbidden(a, fmt.Errorf("cannot clone shoot %q because backup is not configured for its seed %q", cloneFrom, sourceSeed.Name))
+	}
+
+	if downgrade, err := versionutils.CompareVersions(c.shoot.Spec.Kubernetes.Version, "<", sourceShoot.Spec.Kubernetes.Version); err == nil && downgrade {
+		return admission.NewForbidden(a, fmt.Errorf("kubernetes version %s must not be lower than the version %s of shoot %q to clone from", c.shoot.Spec.Kubernetes.Version, sourceShoot.Spec.Kubernetes.Version, cloneFrom))
+	}
+
+	// Cloning a shoot exposes all data stored in its etcd, hence the user must be allowed to request admin
+	// credentials for the shoot to clone from.
+	userInfo := a.GetUserInfo()
+	decision, _, err := auth.Authorize(ctx, authorizer.AttributesRecord{
+		User:            userInfo,
+		APIGroup:        a.GetResource().Group,
+		Resource:        a.GetResource().Resource,
+		Subresource:     "adminkubeconfig",
+		Namespace:       sourceShoot.Namespace,
+		Name:            sourceShoot.Name,
+		Verb:            "create",
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return err
+	}
+	if decision != authorizer.DecisionAllow {
+		return admission.NewForbidden(a, fmt.Errorf("user %q is not allowed to clone shoot %q because it cannot request admin credentials for it", userInfo.GetName(), cloneFrom))
+	}
+
+	return nil
+}
+
 func (c *validationContext) ensureMachineImages() field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -727,8 +847,8 @@ func (c *validationContext) validateShootNetworks(a admission.Attributes, worker
 			workerless,
 		)...)
 
-		// validate network disjointedness with seed networks if shoot is being (re)scheduled
-		if !apiequality.Semantic.DeepEqual(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName) {
+		// validate network disjointedness with seed networks if shoot is being (re)scheduled or its networks are changed
+		if !apiequality.Semantic.DeepEqual(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName) || shootNetworksChanged(c.oldShoot.Spec.Networking, c.shoot.Spec.Networking) {
 			allErrs = append(allErrs, cidrvalidation.ValidateNetworkDisjointedness(
 				path,
 				c.shoot.Spec.Networking.Nodes,
@@ -745,6 +865,16 @@ func (c *validationContext) validateShootNetworks(a admission.Attributes, worker
 	return allErrs
 }
 
+func shootNetworksChanged(oldNetworking, newNetworking *core.Networking) bool {
+	if oldNetworking == nil || newNetworking == nil {
+		return oldNetworking != newNetworking
+	}
+
+	return !apiequality.Semantic.DeepEqual(oldNetworking.Nodes, newNetworking.Nodes) ||
+		!apiequality.Semantic.DeepEqual(oldNetworking.Pods, newNetworking.Pods) ||
+		!apiequality.Semantic.DeepEqual(oldNetworking.Services, newNetworking.Services)
+}
+
 func (c *validationContext) validateKubernetes(a admission.Attributes) field.ErrorList {
 	var (
 		allErrs field.ErrorList
@@ -915,6 +1045,7 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 				if err := validateKubeletVersionConstraint(c.cloudProfile.Spec.MachineImages, worker, kubeletVersion, idxPath); err != nil {
 					allErrs = append(allErrs, err)
 				}
+				allErrs = append(allErrs, validateMachineRequirements(c.cloudProfile.Spec.MachineTypes, c.cloudProfile.Spec.MachineImages, worker.Machine, oldWorker.Machine, idxPath.Child("machine"))...)
 			}
 		}
 		isVolumePresentInCloudprofile, availableInAllZones, isUsableVolume, supportedVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.VolumeTypes, worker.Volume, oldWorker.Volume, c.cloudProfile.Spec.Regions, c.shoot.Spec.Region, worker.Zones)
@@ -1115,6 +1246,160 @@ func (c *validationContext) validateDNSDomainUniqueness(shootLister gardencoreli
 	return allErrs, nil
 }
 
+// versionExpirationWarningPeriod is the period before the expiration date of a Kubernetes or machine image version in
+// which warnings are issued for Shoots using the version.
+const versionExpirationWarningPeriod = 30 * 24 * time.Hour
+
+// admissionWarnings returns warnings about the usage of deprecated fields, of versions which expire soon, and about
+// the new usage of deprecated machine or volume types.
+func (c *validationContext) admissionWarnings(now time.Time) []core.AdmissionWarning {
+	var warnings []core.AdmissionWarning
+
+	if c.shoot.Spec.DNS != nil {
+		for i, provider := range c.shoot.Spec.DNS.Providers {
+			idxPath := field.NewPath("spec", "dns", "providers").Index(i)
+
+			if provider.Domains != nil {
+				warnings = append(warnings, deprecatedFieldWarning(idxPath.Child("domains")))
+			}
+			if provider.Zones != nil {
+				warnings = append(warnings, deprecatedFieldWarning(idxPath.Child("zones")))
+			}
+		}
+	}
+
+	if expirationDate := expirationDateWithinWarningPeriod(c.cloudProfile.Spec.Kubernetes.Versions, c.shoot.Spec.Kubernetes.Version, now); expirationDate != nil {
+		warnings = append(warnings, core.AdmissionWarning{
+			Reason:  admissionutils.WarningReasonKubernetesVersionExpiring,
+			Message: fmt.Sprintf("Kubernetes version %s expires on %s, consider updating to a newer version before it is forcefully updated during the maintenance time window", c.shoot.Spec.Kubernetes.Version, expirationDate.Format(time.DateOnly)),
+		})
+	}
+
+	for _, worker := range c.shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			if expirationDate := expirationDateWithinWarningPeriod(c.cloudProfile.Spec.Kubernetes.Versions, *worker.Kubernetes.Version, now); expirationDate != nil {
+				warnings = append(warnings, core.AdmissionWarning{
+					Reason:  admissionutils.WarningReasonKubernetesVersionExpiring,
+					Message: fmt.Sprintf("Kubernetes version %s of worker pool %q expires on %s, consider updating to a newer version before it is forcefully updated during the maintenance time window", *worker.Kubernetes.Version, worker.Name, expirationDate.Format(time.DateOnly)),
+				})
+			}
+		}
+
+		if image := worker.Machine.Image; image != nil && image.Version != "" {
+			for _, machineImage := range c.cloudProfile.Spec.MachineImages {
+				if machineImage.Name != image.Name {
+					continue
+				}
+
+				var versions []core.ExpirableVersion
+				for _, version := range machineImage.Versions {
+					versions = append(versions, version.ExpirableVersion)
+				}
+
+				if expirationDate := expirationDateWithinWarningPeriod(versions, image.Version, now); expirationDate != nil {
+					warnings = append(warnings, core.AdmissionWarning{
+						Reason:  admissionutils.WarningReasonMachineImageVersionExpiring,
+						Message: fmt.Sprintf("version %s of machine image %q of worker pool %q expires on %s, consider updating to a newer version before it is forcefully updated during the maintenance time window", image.Version, image.Name, worker.Name, expirationDate.Format(time.DateOnly)),
+					})
+				}
+			}
+		}
+
+		oldWorker := helper.FindWorkerByName(c.oldShoot.Spec.Provider.Workers, worker.Name)
+
+		if oldWorker == nil || oldWorker.Machine.Type != worker.Machine.Type {
+			if deprecation := machineTypeDeprecation(c.cloudProfile.Spec.MachineTypes, worker.Machine.Type); deprecation != nil {
+				warnings = append(warnings, core.AdmissionWarning{
+					Reason:  admissionutils.WarningReasonDeprecatedMachineType,
+					Message: fmt.Sprintf("machine type %q of worker pool %q is deprecated, %s", worker.Machine.Type, worker.Name, deprecatedTypeSuggestion(deprecation)),
+				})
+			}
+		}
+
+		oldVolumeTypes := sets.New[string]()
+		if oldWorker != nil {
+			oldVolumeTypes.Insert(workerVolumeTypes(*oldWorker)...)
+		}
+		for _, volumeType := range workerVolumeTypes(worker) {
+			if oldVolumeTypes.Has(volumeType) {
+				continue
+			}
+
+			if deprecation := volumeTypeDeprecation(c.cloudProfile.Spec.VolumeTypes, volumeType); deprecation != nil {
+				warnings = append(warnings, core.AdmissionWarning{
+					Reason:  admissionutils.WarningReasonDeprecatedVolumeType,
+					Message: fmt.Sprintf("volume type %q of worker pool %q is deprecated, %s", volumeType, worker.Name, deprecatedTypeSuggestion(deprecation)),
+				})
+			}
+		}
+	}
+
+	return warnings
+}
+
+func machineTypeDeprecation(machineTypes []core.MachineType, name string) *core.TypeDeprecation {
+	for _, machineType := range machineTypes {
+		if machineType.Name == name {
+			return machineType.Deprecation
+		}
+	}
+	return nil
+}
+
+func volumeTypeDeprecation(volumeTypes []core.VolumeType, name string) *core.TypeDeprecation {
+	for _, volumeType := range volumeTypes {
+		if volumeType.Name == name {
+			return volumeType.Deprecation
+		}
+	}
+	return nil
+}
+
+// workerVolumeTypes returns the volume types of the root and data volumes of the given worker pool.
+func workerVolumeTypes(worker core.Worker) []string {
+	var volumeTypes []string
+	if worker.Volume != nil && worker.Volume.Type != nil {
+		volumeTypes = append(volumeTypes, *worker.Volume.Type)
+	}
+	for _, dataVolume := range worker.DataVolumes {
+		if dataVolume.Type != nil {
+			volumeTypes = append(volumeTypes, *dataVolume.Type)
+		}
+	}
+	return volumeTypes
+}
+
+func deprecatedTypeSuggestion(deprecation *core.TypeDeprecation) string {
+	if deprecation.Successor == nil {
+		return "consider switching to another type as it might be removed from the CloudProfile in the future"
+	}
+	return fmt.Sprintf("consider switching to its successor %q as it might be removed from the CloudProfile in the future", *deprecation.Successor)
+}
+
+// deprecatedFieldWarning returns a warning about the usage of the deprecated field at the given path.
+func deprecatedFieldWarning(fldPath *field.Path) core.AdmissionWarning {
+	return core.AdmissionWarning{
+		Reason:  admissionutils.WarningReasonDeprecatedField,
+		Message: fmt.Sprintf("field %s is deprecated and will be removed in a future release, consider removing it from the Shoot specification", fldPath),
+	}
+}
+
+// expirationDateWithinWarningPeriod returns the expiration date of the given version if it is within the
+// versionExpirationWarningPeriod.
+func expirationDateWithinWarningPeriod(versions []core.ExpirableVersion, version string, now time.Time) *time.Time {
+	for _, v := range versions {
+		if v.Version != version || v.ExpirationDate == nil {
+			continue
+		}
+
+		if expirationDate := v.ExpirationDate.Time.UTC(); expirationDate.Before(now.UTC().Add(versionExpirationWarningPeriod)) {
+			return &expirationDate
+		}
+	}
+
+	return nil
+}
+
 // hasDomainIntersection checks if domainA is a suffix of domainB or domainB is a suffix of domainA.
 func hasDomainIntersection(domainA, domainB string) bool {
 	if domainA == domainB {
@@ -1398,6 +1683,33 @@ func (c *validationContext) validateRegion() field.ErrorList {
 	return field.ErrorList{field.NotSupported(fldPath, region, validValues)}
 }
 
+func (c *validationContext) validateSchedulingUserRegion() field.ErrorList {
+	var (
+		fldPath     = field.NewPath("metadata", "annotations").Key(v1beta1constants.AnnotationSchedulingUserRegion)
+		validValues []string
+	)
+
+	userRegion, ok := c.shoot.Annotations[v1beta1constants.AnnotationSchedulingUserRegion]
+	if !ok {
+		return nil
+	}
+	if oldUserRegion, ok := c.oldShoot.Annotations[v1beta1constants.AnnotationSchedulingUserRegion]; ok && userRegion == oldUserRegion {
+		return nil
+	}
+	if userRegion == "" {
+		return field.ErrorList{field.Required(fldPath, "user region must not be empty")}
+	}
+
+	for _, r := range c.cloudProfile.Spec.Regions {
+		validValues = append(validValues, r.Name)
+		if r.Name == userRegion {
+			return nil
+		}
+	}
+
+	return field.ErrorList{field.NotSupported(fldPath, userRegion, validValues)}
+}
+
 func validateZones(constraints []core.Region, region, oldRegion string, worker, oldWorker core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if region == oldRegion && reflect.DeepEqual(worker.Zones, oldWorker.Zones) {
@@ -1630,6 +1942,35 @@ func validateKubeletVersionConstraint(constraints []core.MachineImage, worker co
 	return nil
 }
 
+func validateMachineRequirements(machineTypes []core.MachineType, machineImages []core.MachineImage, machine, oldMachine core.Machine, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if machine.Requirements == nil || apiequality.Semantic.DeepEqual(machine, oldMachine) {
+		return allErrs
+	}
+
+	for _, machineType := range machineTypes {
+		if machineType.Name != machine.Type {
+			continue
+		}
+
+		if unsatisfied := helper.UnsatisfiedMachineTypeRequirements(machine.Requirements, machineType); len(unsatisfied) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), machine.Type, fmt.Sprintf("machine type %q does not satisfy the requirements %v of the worker pool", machine.Type, unsatisfied)))
+		}
+		break
+	}
+
+	if machine.Image != nil {
+		if machineImageVersion, ok := helper.FindMachineImageVersion(machineImages, machine.Image.Name, machine.Image.Version); ok {
+			if unsatisfied := helper.UnsatisfiedMachineImageVersionRequirements(machine.Requirements, machineImageVersion); len(unsatisfied) > 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("image"), machine.Image, fmt.Sprintf("machine image version '%s:%s' does not satisfy the requirements %v of the worker pool", machine.Image.Name, machine.Image.Version, unsatisfied)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func ensureMachineImage(oldWorkers []core.Worker, worker core.Worker, images []core.MachineImage, fldPath *field.Path) (*core.ShootMachineImage, *field.Error) {
 	// General approach with machine image defaulting in this code: Try to keep the machine image
 	// from the old shoot object to not accidentally update it to the default machine image.
@@ -1677,6 +2018,7 @@ func addDNSRecordDeploymentTasks(shoot *core.Shoot) {
 	addDeploymentTasks(shoot,
 		v1beta1constants.ShootTaskDeployDNSRecordInternal,
 		v1beta1constants.ShootTaskDeployDNSRecordExternal,
+		v1beta1constants.ShootTaskDeployDNSRecordPrivate,
 		v1beta1constants.ShootTaskDeployDNSRecordIngress,
 	)
 }
@@ -345,6 +345,21 @@ var _ = Describe("validator", func() {
 				Expect(err.Error()).To(ContainSubstring("already marked for deletion"))
 			})
 
+			It("should reject create operations on Shoot resources in archived projects", func() {
+				project.Status.Conditions = []core.Condition{{Type: core.ProjectArchived, Status: core.ConditionTrue}}
+
+				Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("that is archived because of its inactivity"))
+			})
+
 			It("should reject Shoot resources with not fulfilling the length constraints", func() {
 				tooLongName := "too-long-namespace"
 				project.ObjectMeta = metav1.ObjectMeta{
@@ -543,6 +558,187 @@ var _ = Describe("validator", func() {
 			)
 		})
 
+		Context("upgrade pre-flight checks", func() {
+			var (
+				oldShoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, core.ExpirableVersion{Version: "1.7.0"})
+
+				shoot = *shootBase.DeepCopy()
+				oldShoot = shoot.DeepCopy()
+				shoot.Spec.Kubernetes.Version = "1.7.0"
+
+				Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+			})
+
+			DescribeTable("should allow/deny upgrading the Shoot according to UpgradePreflightChecksPassed constraint",
+				func(constraints []core.Condition, skip bool, match types.GomegaMatcher) {
+					shoot.Status.Constraints = constraints
+					if skip {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/skip-upgrade-preflight-checks", "true")
+					}
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+					Expect(err).To(match)
+				},
+				Entry("should allow if set to True", []core.Condition{
+					{
+						Type:    core.ShootUpgradePreflightChecksPassed,
+						Status:  core.ConditionTrue,
+						Message: "foo",
+					},
+				}, false, Not(HaveOccurred())),
+				Entry("should deny if set to False", []core.Condition{
+					{
+						Type:    core.ShootUpgradePreflightChecksPassed,
+						Status:  core.ConditionFalse,
+						Message: "foo",
+					},
+				}, false, And(HaveOccurred(), MatchError(ContainSubstring("foo")))),
+				Entry("should deny if set to Progressing", []core.Condition{
+					{
+						Type:    core.ShootUpgradePreflightChecksPassed,
+						Status:  core.ConditionProgressing,
+						Message: "foo",
+					},
+				}, false, And(HaveOccurred(), MatchError(ContainSubstring("foo")))),
+				Entry("should allow if set to Unknown", []core.Condition{
+					{
+						Type:    core.ShootUpgradePreflightChecksPassed,
+						Status:  core.ConditionUnknown,
+						Message: "foo",
+					},
+				}, false, Not(HaveOccurred())),
+				Entry("should allow if set to False but checks are skipped", []core.Condition{
+					{
+						Type:    core.ShootUpgradePreflightChecksPassed,
+						Status:  core.ConditionFalse,
+						Message: "foo",
+					},
+				}, true, Not(HaveOccurred())),
+				Entry("should allow if unset", []core.Condition{}, false, Not(HaveOccurred())),
+			)
+		})
+
+		Context("cloning", func() {
+			var sourceShoot *core.Shoot
+
+			BeforeEach(func() {
+				sourceShoot = shootBase.DeepCopy()
+				sourceShoot.Name = "source"
+				sourceShoot.Spec.DNS.Domain = pointer.String(fmt.Sprintf("source.%s", baseDomain))
+				sourceShoot.Status.TechnicalID = "shoot--my-project--source"
+
+				shoot = *shootBase.DeepCopy()
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/clone-from", sourceShoot.Name)
+
+				Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+			})
+
+			It("should allow cloning a shoot", func() {
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should deny cloning a shoot which does not exist", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("shoot %q to clone from does not exist", sourceShoot.Name)))
+			})
+
+			It("should deny cloning a shoot which has not been created yet", func() {
+				sourceShoot.Status.TechnicalID = ""
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("has not been created yet")))
+			})
+
+			It("should deny cloning a shoot whose seed has no backup configured", func() {
+				seedWithoutBackup := seedBase.DeepCopy()
+				seedWithoutBackup.Name = "seed-without-backup"
+				seedWithoutBackup.Spec.Backup = nil
+				Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(seedWithoutBackup)).To(Succeed())
+
+				sourceShoot.Spec.SeedName = &seedWithoutBackup.Name
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("backup is not configured for its seed %q", seedWithoutBackup.Name)))
+			})
+
+			It("should deny cloning a shoot with a lower Kubernetes version", func() {
+				sourceShoot.Spec.Kubernetes.Version = "1.7.0"
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("must not be lower than the version 1.7.0")))
+			})
+
+			It("should deny cloning a shoot if the user is not allowed to request admin credentials for it", func() {
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+
+				auth = mockauthorizer.NewMockAuthorizer(ctrl)
+				auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+				auth.EXPECT().Authorize(ctx, authorizer.AttributesRecord{
+					User:            userInfo,
+					APIGroup:        "core.gardener.cloud",
+					Resource:        "shoots",
+					Subresource:     "adminkubeconfig",
+					Namespace:       sourceShoot.Namespace,
+					Name:            sourceShoot.Name,
+					Verb:            "create",
+					ResourceRequest: true,
+				}).Return(authorizer.DecisionDeny, "", nil)
+				admissionHandler.SetAuthorizer(auth)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("user %q is not allowed to clone shoot %q", userInfo.Name, sourceShoot.Name)))
+			})
+
+			It("should deny adding the annotation to an existing shoot", func() {
+				oldShoot := shootBase.DeepCopy()
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("can only be set when creating the shoot")))
+			})
+
+			It("should allow removing the annotation from an existing shoot", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Annotations = nil
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+		})
+
 		Context("shoot maintenance checks", func() {
 			var (
 				oldShoot           *core.Shoot
@@ -904,6 +1100,7 @@ var _ = Describe("validator", func() {
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployInfrastructure")).To(BeTrue())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordInternal")).To(BeTrue())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordExternal")).To(BeTrue())
+				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordPrivate")).To(BeTrue())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordIngress")).To(BeTrue())
 			})
 
@@ -922,6 +1119,7 @@ var _ = Describe("validator", func() {
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployInfrastructure")).To(BeTrue())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordInternal")).To(BeTrue())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordExternal")).To(BeTrue())
+				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordPrivate")).To(BeTrue())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordIngress")).To(BeTrue())
 			})
 
@@ -960,6 +1158,7 @@ var _ = Describe("validator", func() {
 				Expect(err).To(Not(HaveOccurred()))
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordInternal")).To(BeTrue())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordExternal")).To(BeTrue())
+				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordPrivate")).To(BeTrue())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordIngress")).To(BeTrue())
 			})
 
@@ -993,6 +1192,7 @@ var _ = Describe("validator", func() {
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployInfrastructure")).To(BeFalse())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordInternal")).To(BeFalse())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordExternal")).To(BeFalse())
+				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordPrivate")).To(BeFalse())
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployDNSRecordIngress")).To(BeFalse())
 			})
 		})
@@ -1028,6 +1228,45 @@ var _ = Describe("validator", func() {
 				Expect(err.Error()).To(ContainSubstring("Unsupported value: \"does-not-exist\": supported values: \"europe\", \"asia\""))
 			})
 
+			It("should pass update for user region which is a region of the cloud profile", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "scheduling.gardener.cloud/user-region", "asia")
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(Not(HaveOccurred()))
+			})
+
+			It("should pass update for non existing user region in cloud profile because user region is unchanged", func() {
+				metav1.SetMetaDataAnnotation(&oldShoot.ObjectMeta, "scheduling.gardener.cloud/user-region", "does-not-exist")
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "scheduling.gardener.cloud/user-region", "does-not-exist")
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(Not(HaveOccurred()))
+			})
+
+			It("should reject update because user region is empty", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "scheduling.gardener.cloud/user-region", "")
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("metadata.annotations[scheduling.gardener.cloud/user-region]: Required value: user region must not be empty"))
+			})
+
+			It("should reject update because user region is unknown", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "scheduling.gardener.cloud/user-region", "does-not-exist")
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("metadata.annotations[scheduling.gardener.cloud/user-region]: Unsupported value: \"does-not-exist\": supported values: \"europe\", \"asia\""))
+			})
+
 			It("should pass update for non existing zone in cloud profile because shoot worker zone is unchanged", func() {
 				cloudProfile.Spec.Regions[0].Zones = []core.AvailabilityZone{{Name: "not-available"}}
 
@@ -1665,6 +1904,7 @@ var _ = Describe("validator", func() {
 				It("update should pass because validation of network disjointedness should not be executed", func() {
 					// set shoot pod cidr to overlap with vpn pod cidr
 					shoot.Spec.Networking.Pods = pointer.String(v1beta1constants.DefaultVPNRange)
+					oldShoot.Spec.Networking.Pods = shoot.Spec.Networking.Pods
 					oldShoot.Spec.SeedName = shoot.Spec.SeedName
 
 					Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
@@ -1691,6 +1931,22 @@ var _ = Describe("validator", func() {
 					Expect(err).To(BeForbiddenError())
 				})
 
+				It("update should fail because validation of network disjointedness is executed for changed networks", func() {
+					// expand shoot service cidr to overlap with seed service cidr
+					oldShoot.Spec.SeedName = shoot.Spec.SeedName
+					shoot.Spec.Networking.Services = &seedServicesCIDR
+
+					Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("spec.networking.services")))
+				})
+
 				It("delete should pass because validation of network disjointedness should not be executed", func() {
 					// set shoot pod cidr to overlap with vpn pod cidr
 					shoot.Spec.Networking.Pods = pointer.String(v1beta1constants.DefaultVPNRange)
@@ -2319,6 +2575,83 @@ var _ = Describe("validator", func() {
 				})
 			})
 
+			Context("admission warnings", func() {
+				var expiringVersion core.ExpirableVersion
+
+				BeforeEach(func() {
+					expiringVersion = core.ExpirableVersion{Version: "1.26.8", ExpirationDate: &metav1.Time{Time: time.Now().Add(10 * 24 * time.Hour)}}
+
+					cloudProfile.Spec.Kubernetes.Versions = []core.ExpirableVersion{
+						{Version: "1.27.3"},
+						expiringVersion,
+						{Version: "1.26.7", ExpirationDate: &metav1.Time{Time: time.Now().Add(60 * 24 * time.Hour)}},
+					}
+
+					Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				})
+
+				It("should not add warnings if neither deprecated fields nor expiring versions are used", func() {
+					shoot.Spec.Kubernetes.Version = "1.26.7"
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+					Expect(shoot.Status.AdmissionWarnings).To(BeEmpty())
+				})
+
+				It("should add warnings for deprecated fields and expiring kubernetes versions", func() {
+					shoot.Spec.Kubernetes.Version = expiringVersion.Version
+					shoot.Spec.Provider.Workers[0].Kubernetes = &core.WorkerKubernetes{Version: &expiringVersion.Version}
+					shoot.Spec.DNS.Providers[0].Domains = &core.DNSIncludeExclude{Include: []string{"example.com"}}
+					shoot.Status.AdmissionWarnings = []core.AdmissionWarning{
+						{Plugin: "ShootValidator", Reason: "Outdated", Message: "outdated"},
+						{Plugin: "ShootQuotaValidator", Reason: "QuotaNearingLimit", Message: "quota"},
+					}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+					expirationDate := expiringVersion.ExpirationDate.UTC().Format(time.DateOnly)
+					Expect(shoot.Status.AdmissionWarnings).To(ConsistOf(
+						core.AdmissionWarning{Plugin: "ShootQuotaValidator", Reason: "QuotaNearingLimit", Message: "quota"},
+						core.AdmissionWarning{Plugin: "ShootValidator", Reason: "DeprecatedField", Message: "field spec.dns.providers[0].domains is deprecated and will be removed in a future release, consider removing it from the Shoot specification"},
+						core.AdmissionWarning{Plugin: "ShootValidator", Reason: "KubernetesVersionExpiring", Message: "Kubernetes version 1.26.8 expires on " + expirationDate + ", consider updating to a newer version before it is forcefully updated during the maintenance time window"},
+						core.AdmissionWarning{Plugin: "ShootValidator", Reason: "KubernetesVersionExpiring", Message: "Kubernetes version 1.26.8 of worker pool \"" + shoot.Spec.Provider.Workers[0].Name + "\" expires on " + expirationDate + ", consider updating to a newer version before it is forcefully updated during the maintenance time window"},
+					))
+				})
+
+				Context("deprecated machine and volume types", func() {
+					BeforeEach(func() {
+						shoot.Spec.Kubernetes.Version = "1.26.7"
+						cloudProfile.Spec.MachineTypes[0].Deprecation = &core.TypeDeprecation{Successor: pointer.String("machine-type-2")}
+						cloudProfile.Spec.VolumeTypes[0].Deprecation = &core.TypeDeprecation{}
+					})
+
+					It("should add warnings for new usages of deprecated types", func() {
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+						workerName := shoot.Spec.Provider.Workers[0].Name
+						Expect(shoot.Status.AdmissionWarnings).To(ConsistOf(
+							core.AdmissionWarning{Plugin: "ShootValidator", Reason: "DeprecatedMachineType", Message: "machine type \"machine-type-1\" of worker pool \"" + workerName + "\" is deprecated, consider switching to its successor \"machine-type-2\" as it might be removed from the CloudProfile in the future"},
+							core.AdmissionWarning{Plugin: "ShootValidator", Reason: "DeprecatedVolumeType", Message: "volume type \"volume-type-1\" of worker pool \"" + workerName + "\" is deprecated, consider switching to another type as it might be removed from the CloudProfile in the future"},
+						))
+					})
+
+					It("should not add warnings for deprecated types which were already used", func() {
+						oldShoot := shoot.DeepCopy()
+
+						attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+						Expect(shoot.Status.AdmissionWarnings).To(BeEmpty())
+					})
+				})
+			})
+
 			Context("kubernetes enableStaticTokenKubeconfig defaulting", func() {
 				BeforeEach(func() {
 					shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = nil
@@ -3201,6 +3534,63 @@ var _ = Describe("validator", func() {
 						Expect(err.Error()).To(ContainSubstring("machine image 'constraint-image-name@1.2.3' does not support kubelet version '1.25.0', supported kubelet versions by this machine image version: '>= 1.26'"))
 					})
 
+					Context("machine requirements", func() {
+						BeforeEach(func() {
+							cloudProfile.Spec.MachineImages = append(cloudProfile.Spec.MachineImages,
+								core.MachineImage{
+									Name: "capability-image-name",
+									Versions: []core.MachineImageVersion{
+										{
+											ExpirableVersion: core.ExpirableVersion{
+												Version: "1.2.3",
+											},
+											Architectures: []string{"amd64"},
+										},
+									},
+								},
+							)
+
+							shoot.Spec.Provider.Workers = []core.Worker{
+								{
+									Machine: core.Machine{
+										Type: "machine-type-1",
+										Image: &core.ShootMachineImage{
+											Name:    "capability-image-name",
+											Version: "1.2.3",
+										},
+										Architecture: pointer.String("amd64"),
+										Requirements: &core.MachineRequirements{
+											ConfidentialComputing: pointer.Bool(true),
+											NestedVirtualization:  pointer.Bool(true),
+										},
+									},
+								},
+							}
+						})
+
+						It("should reject due to a machine type and machine image version not satisfying the requirements", func() {
+							attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+							err := admissionHandler.Admit(ctx, attrs, nil)
+
+							Expect(err).To(BeForbiddenError())
+							Expect(err.Error()).To(ContainSubstring("machine type \"machine-type-1\" does not satisfy the requirements [confidentialComputing nestedVirtualization] of the worker pool"))
+							Expect(err.Error()).To(ContainSubstring("machine image version 'capability-image-name:1.2.3' does not satisfy the requirements [confidentialComputing] of the worker pool"))
+						})
+
+						It("should allow a machine type and machine image version satisfying the requirements", func() {
+							capabilities := &core.MachineCapabilities{ConfidentialComputing: pointer.Bool(true), NestedVirtualization: pointer.Bool(true)}
+							for i, machineType := range cloudProfile.Spec.MachineTypes {
+								if machineType.Name == "machine-type-1" {
+									cloudProfile.Spec.MachineTypes[i].Capabilities = capabilities
+								}
+							}
+							cloudProfile.Spec.MachineImages[len(cloudProfile.Spec.MachineImages)-1].Versions[0].Capabilities = capabilities
+
+							attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+							Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+						})
+					})
+
 					It("should default version to latest non-preview version as shoot does not specify one", func() {
 						shoot.Spec.Provider.Workers[0].Machine.Image = nil
 						shoot.Spec.Provider.Workers[1].Machine.Image = nil
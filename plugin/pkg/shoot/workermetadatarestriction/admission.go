@@ -0,0 +1,247 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workermetadatarestriction
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction"
+	"github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction/validation"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootWorkerMetadataRestriction, func(config io.Reader) (admission.Interface, error) {
+		cfg, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(cfg); err != nil {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		return New(cfg), nil
+	})
+}
+
+// WorkerMetadataRestriction contains required information to process admission requests.
+type WorkerMetadataRestriction struct {
+	*admission.Handler
+	config *shootworkermetadatarestriction.Configuration
+}
+
+var (
+	_ admission.MutationInterface   = &WorkerMetadataRestriction{}
+	_ admission.ValidationInterface = &WorkerMetadataRestriction{}
+)
+
+// New creates a new ShootWorkerMetadataRestriction admission plugin.
+func New(config *shootworkermetadatarestriction.Configuration) *WorkerMetadataRestriction {
+	return &WorkerMetadataRestriction{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		config:  config,
+	}
+}
+
+// Admit returns warnings for all labels, annotations, and taints of worker pools violating the configured restrictions
+// if the plugin runs in `Warn` mode.
+func (w *WorkerMetadataRestriction) Admit(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if a.GetKind().GroupKind() != core.Kind("Shoot") || a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// Pass if the shoot is intended to get deleted
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var warnings []core.AdmissionWarning
+	if w.config.Mode == shootworkermetadatarestriction.ModeWarn {
+		for _, err := range w.validateWorkers(shoot, nil) {
+			warnings = append(warnings, core.AdmissionWarning{
+				Reason:  admissionutils.WarningReasonRestrictedWorkerMetadata,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	admissionutils.AddShootWarnings(ctx, shoot, plugin.PluginNameShootWorkerMetadataRestriction, warnings...)
+	return nil
+}
+
+// Validate rejects labels, annotations, and taints of worker pools violating the configured restrictions if the plugin
+// runs in `Enforce` mode. Only violations introduced by the request are considered, i.e., existing Shoots are not
+// blocked when the restrictions are tightened.
+func (w *WorkerMetadataRestriction) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if w.config.Mode != shootworkermetadatarestriction.ModeEnforce {
+		return nil
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") || a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// Pass if the shoot is intended to get deleted
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var oldShoot *core.Shoot
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok = a.GetOldObject().(*core.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+	}
+
+	if errList := w.validateWorkers(shoot, oldShoot); len(errList) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("worker pools violate the metadata restrictions: %+v", errList))
+	}
+
+	return nil
+}
+
+// validateWorkers validates the labels, annotations, and taints of the worker pools of the given Shoot. If an old Shoot
+// is given, only keys which were added to a worker pool and counts which were increased are validated.
+func (w *WorkerMetadataRestriction) validateWorkers(shoot, oldShoot *core.Shoot) field.ErrorList {
+	var (
+		allErrs    field.ErrorList
+		fldPath    = field.NewPath("spec", "provider", "workers")
+		oldWorkers = make(map[string]core.Worker)
+	)
+
+	if oldShoot != nil {
+		for _, worker := range oldShoot.Spec.Provider.Workers {
+			oldWorkers[worker.Name] = worker
+		}
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		var (
+			idxPath   = fldPath.Index(i)
+			oldWorker = oldWorkers[worker.Name]
+		)
+
+		allErrs = append(allErrs, validateMap(w.config.Labels, worker.Labels, oldWorker.Labels, idxPath.Child("labels"))...)
+		allErrs = append(allErrs, validateMap(w.config.Annotations, worker.Annotations, oldWorker.Annotations, idxPath.Child("annotations"))...)
+		allErrs = append(allErrs, validateTaints(w.config.Taints, worker, oldWorker, idxPath.Child("taints"))...)
+	}
+
+	return allErrs
+}
+
+func validateMap(restriction *shootworkermetadatarestriction.MetadataRestriction, m, oldM map[string]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if restriction == nil {
+		return allErrs
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := oldM[key]; !ok && isReserved(restriction, key) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), reservedMessage(restriction)))
+		}
+	}
+
+	allErrs = append(allErrs, validateCount(restriction, len(m), len(oldM), fldPath)...)
+
+	return allErrs
+}
+
+func validateTaints(restriction *shootworkermetadatarestriction.MetadataRestriction, worker, oldWorker core.Worker, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if restriction == nil {
+		return allErrs
+	}
+
+	oldKeys := sets.New[string]()
+	for _, taint := range oldWorker.Taints {
+		oldKeys.Insert(taint.Key)
+	}
+
+	for i, taint := range worker.Taints {
+		if !oldKeys.Has(taint.Key) && isReserved(restriction, taint.Key) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("key"), reservedMessage(restriction)))
+		}
+	}
+
+	allErrs = append(allErrs, validateCount(restriction, len(worker.Taints), len(oldWorker.Taints), fldPath)...)
+
+	return allErrs
+}
+
+func validateCount(restriction *shootworkermetadatarestriction.MetadataRestriction, count, oldCount int, fldPath *field.Path) field.ErrorList {
+	if restriction.MaxCount == nil || count <= int(*restriction.MaxCount) || count <= oldCount {
+		return nil
+	}
+
+	return field.ErrorList{field.TooMany(fldPath, count, int(*restriction.MaxCount))}
+}
+
+// isReserved returns true if the prefix of the given key equals one of the reserved prefixes or is a subdomain of it,
+// unless the key is explicitly allowed.
+func isReserved(restriction *shootworkermetadatarestriction.MetadataRestriction, key string) bool {
+	if slices.Contains(restriction.AllowedKeys, key) {
+		return false
+	}
+
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return false
+	}
+
+	for _, reservedPrefix := range restriction.ReservedPrefixes {
+		if prefix == reservedPrefix || strings.HasSuffix(prefix, "."+reservedPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func reservedMessage(restriction *shootworkermetadatarestriction.MetadataRestriction) string {
+	return fmt.Sprintf("key must not use one of the reserved prefixes %v", restriction.ReservedPrefixes)
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workermetadatarestriction_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction"
+	"github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction"
+)
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (r *fakeWarningRecorder) AddWarning(_, text string) {
+	r.warnings = append(r.warnings, text)
+}
+
+var _ = Describe("ShootWorkerMetadataRestriction", func() {
+	var (
+		ctx      context.Context
+		recorder *fakeWarningRecorder
+		config   *shootworkermetadatarestriction.Configuration
+		userInfo *user.DefaultInfo
+
+		shoot *core.Shoot
+	)
+
+	BeforeEach(func() {
+		recorder = &fakeWarningRecorder{}
+		ctx = warning.WithWarningRecorder(context.Background(), recorder)
+		config = &shootworkermetadatarestriction.Configuration{
+			Mode: shootworkermetadatarestriction.ModeWarn,
+			Labels: &shootworkermetadatarestriction.MetadataRestriction{
+				ReservedPrefixes: []string{"kubernetes.io"},
+				AllowedKeys:      []string{"node.kubernetes.io/role"},
+				MaxCount:         pointer.Int32(2),
+			},
+			Annotations: &shootworkermetadatarestriction.MetadataRestriction{
+				ReservedPrefixes: []string{"gardener.cloud"},
+			},
+			Taints: &shootworkermetadatarestriction.MetadataRestriction{
+				ReservedPrefixes: []string{"node.kubernetes.io"},
+				MaxCount:         pointer.Int32(1),
+			},
+		}
+		userInfo = &user.DefaultInfo{Name: "foo"}
+
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: core.ShootSpec{
+				Provider: core.Provider{
+					Workers: []core.Worker{{
+						Name:        "worker",
+						Labels:      map[string]string{"foo": "bar", "node.kubernetes.io/role": "worker"},
+						Annotations: map[string]string{"foo": "bar"},
+						Taints:      []corev1.Taint{{Key: "foo", Effect: corev1.TaintEffectNoSchedule}},
+					}},
+				},
+			},
+		}
+	})
+
+	createAttributes := func(obj runtime.Object) admission.Attributes {
+		return admission.NewAttributesRecord(obj, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+	}
+
+	updateAttributes := func(obj, oldObj runtime.Object) admission.Attributes {
+		return admission.NewAttributesRecord(obj, oldObj, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+	}
+
+	violate := func(shoot *core.Shoot) {
+		shoot.Spec.Provider.Workers[0].Labels["kubernetes.io/arch"] = "amd64"
+		shoot.Spec.Provider.Workers[0].Annotations["worker.gardener.cloud/foo"] = "bar"
+		shoot.Spec.Provider.Workers[0].Taints = append(shoot.Spec.Provider.Workers[0].Taints, corev1.Taint{Key: "node.kubernetes.io/unschedulable", Effect: corev1.TaintEffectNoSchedule})
+	}
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootWorkerMetadataRestriction"))
+		})
+	})
+
+	Describe("#Handles", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
+			plugin := New(config)
+
+			Expect(plugin.Handles(admission.Create)).To(BeTrue())
+			Expect(plugin.Handles(admission.Update)).To(BeTrue())
+			Expect(plugin.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(plugin.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#Admit", func() {
+		It("should ignore resources other than Shoot", func() {
+			project := &core.Project{}
+			attrs := admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+			Expect(New(config).Admit(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should fail if the object is not a Shoot", func() {
+			Expect(New(config).Admit(ctx, createAttributes(&core.Project{}), nil)).To(BeBadRequestError())
+		})
+
+		It("should not add warnings if the worker pools comply with the restrictions", func() {
+			Expect(New(config).Admit(ctx, createAttributes(shoot), nil)).To(Succeed())
+
+			Expect(recorder.warnings).To(BeEmpty())
+			Expect(shoot.Status.AdmissionWarnings).To(BeEmpty())
+		})
+
+		It("should add warnings for all violations in Warn mode", func() {
+			violate(shoot)
+			oldShoot := shoot.DeepCopy()
+
+			Expect(New(config).Admit(ctx, updateAttributes(shoot, oldShoot), nil)).To(Succeed())
+
+			Expect(recorder.warnings).To(ConsistOf(
+				"RestrictedWorkerMetadata: spec.provider.workers[0].labels[kubernetes.io/arch]: Forbidden: key must not use one of the reserved prefixes [kubernetes.io]",
+				"RestrictedWorkerMetadata: spec.provider.workers[0].labels: Too many: 3: must have at most 2 items",
+				"RestrictedWorkerMetadata: spec.provider.workers[0].annotations[worker.gardener.cloud/foo]: Forbidden: key must not use one of the reserved prefixes [gardener.cloud]",
+				"RestrictedWorkerMetadata: spec.provider.workers[0].taints[1].key: Forbidden: key must not use one of the reserved prefixes [node.kubernetes.io]",
+				"RestrictedWorkerMetadata: spec.provider.workers[0].taints: Too many: 2: must have at most 1 items",
+			))
+			Expect(shoot.Status.AdmissionWarnings).To(HaveLen(5))
+			Expect(shoot.Status.AdmissionWarnings[0].Plugin).To(Equal("ShootWorkerMetadataRestriction"))
+		})
+
+		It("should not add warnings in Enforce mode", func() {
+			config.Mode = shootworkermetadatarestriction.ModeEnforce
+			violate(shoot)
+
+			Expect(New(config).Admit(ctx, createAttributes(shoot), nil)).To(Succeed())
+
+			Expect(recorder.warnings).To(BeEmpty())
+		})
+	})
+
+	Describe("#Validate", func() {
+		BeforeEach(func() {
+			config.Mode = shootworkermetadatarestriction.ModeEnforce
+		})
+
+		It("should not reject violations in Warn mode", func() {
+			config.Mode = shootworkermetadatarestriction.ModeWarn
+			violate(shoot)
+
+			Expect(New(config).Validate(ctx, createAttributes(shoot), nil)).To(Succeed())
+		})
+
+		It("should allow worker pools complying with the restrictions", func() {
+			Expect(New(config).Validate(ctx, createAttributes(shoot), nil)).To(Succeed())
+		})
+
+		It("should reject violations on creation", func() {
+			violate(shoot)
+
+			err := New(config).Validate(ctx, createAttributes(shoot), nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(And(
+				ContainSubstring("spec.provider.workers[0].labels[kubernetes.io/arch]"),
+				ContainSubstring("spec.provider.workers[0].annotations[worker.gardener.cloud/foo]"),
+				ContainSubstring("spec.provider.workers[0].taints[1].key"),
+			)))
+		})
+
+		It("should allow existing violations on update", func() {
+			violate(shoot)
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Provider.Workers[0].Labels["foo"] = "baz"
+
+			Expect(New(config).Validate(ctx, updateAttributes(shoot, oldShoot), nil)).To(Succeed())
+		})
+
+		It("should reject violations introduced by an update", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Provider.Workers[0].Labels["node-role.kubernetes.io/control-plane"] = ""
+
+			err := New(config).Validate(ctx, updateAttributes(shoot, oldShoot), nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(And(
+				ContainSubstring("spec.provider.workers[0].labels[node-role.kubernetes.io/control-plane]"),
+				ContainSubstring("spec.provider.workers[0].labels: Too many"),
+			)))
+		})
+
+		It("should reject violations in new worker pools", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, core.Worker{
+				Name:   "new",
+				Taints: []corev1.Taint{{Key: "node.kubernetes.io/foo", Effect: corev1.TaintEffectNoExecute}},
+			})
+
+			err := New(config).Validate(ctx, updateAttributes(shoot, oldShoot), nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring("spec.provider.workers[1].taints[0].key")))
+		})
+
+		It("should ignore Shoots in deletion", func() {
+			violate(shoot)
+			shoot.DeletionTimestamp = &metav1.Time{}
+
+			Expect(New(config).Validate(ctx, createAttributes(shoot), nil)).To(Succeed())
+		})
+	})
+})
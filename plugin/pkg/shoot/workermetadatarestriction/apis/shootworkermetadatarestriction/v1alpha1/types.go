@@ -0,0 +1,63 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootWorkerMetadataRestriction admission controller.
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+	// Mode determines how violations of the restrictions are handled. In `Warn` mode, the request is admitted and the
+	// violations are returned as warnings. In `Enforce` mode, the request is rejected. Defaults to `Warn`.
+	// +optional
+	Mode Mode `json:"mode,omitempty"`
+	// Labels restricts the labels of the worker pools.
+	// +optional
+	Labels *MetadataRestriction `json:"labels,omitempty"`
+	// Annotations restricts the annotations of the worker pools.
+	// +optional
+	Annotations *MetadataRestriction `json:"annotations,omitempty"`
+	// Taints restricts the taints of the worker pools.
+	// +optional
+	Taints *MetadataRestriction `json:"taints,omitempty"`
+}
+
+// Mode is a type alias for the mode of the ShootWorkerMetadataRestriction admission controller.
+type Mode string
+
+const (
+	// ModeWarn admits requests violating the restrictions but returns warnings.
+	ModeWarn Mode = "Warn"
+	// ModeEnforce rejects requests violating the restrictions.
+	ModeEnforce Mode = "Enforce"
+)
+
+// MetadataRestriction restricts the keys of the labels, annotations, or taints of worker pools.
+type MetadataRestriction struct {
+	// ReservedPrefixes is a list of key prefixes which must not be used, e.g. `kubernetes.io`. A key is considered
+	// reserved if its prefix (the part before the `/`) equals one of the reserved prefixes or is a subdomain of it.
+	// +optional
+	ReservedPrefixes []string `json:"reservedPrefixes,omitempty"`
+	// AllowedKeys is a list of keys which may be used although they have a reserved prefix.
+	// +optional
+	AllowedKeys []string `json:"allowedKeys,omitempty"`
+	// MaxCount is the maximum number of entries per worker pool.
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+}
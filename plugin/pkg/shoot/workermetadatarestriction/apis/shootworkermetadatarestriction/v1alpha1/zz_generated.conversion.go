@@ -0,0 +1,110 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	shootworkermetadatarestriction "github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*shootworkermetadatarestriction.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Configuration_To_shootworkermetadatarestriction_Configuration(a.(*Configuration), b.(*shootworkermetadatarestriction.Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*shootworkermetadatarestriction.Configuration)(nil), (*Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_shootworkermetadatarestriction_Configuration_To_v1alpha1_Configuration(a.(*shootworkermetadatarestriction.Configuration), b.(*Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetadataRestriction)(nil), (*shootworkermetadatarestriction.MetadataRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetadataRestriction_To_shootworkermetadatarestriction_MetadataRestriction(a.(*MetadataRestriction), b.(*shootworkermetadatarestriction.MetadataRestriction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*shootworkermetadatarestriction.MetadataRestriction)(nil), (*MetadataRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_shootworkermetadatarestriction_MetadataRestriction_To_v1alpha1_MetadataRestriction(a.(*shootworkermetadatarestriction.MetadataRestriction), b.(*MetadataRestriction), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Configuration_To_shootworkermetadatarestriction_Configuration(in *Configuration, out *shootworkermetadatarestriction.Configuration, s conversion.Scope) error {
+	out.Mode = shootworkermetadatarestriction.Mode(in.Mode)
+	out.Labels = (*shootworkermetadatarestriction.MetadataRestriction)(unsafe.Pointer(in.Labels))
+	out.Annotations = (*shootworkermetadatarestriction.MetadataRestriction)(unsafe.Pointer(in.Annotations))
+	out.Taints = (*shootworkermetadatarestriction.MetadataRestriction)(unsafe.Pointer(in.Taints))
+	return nil
+}
+
+// Convert_v1alpha1_Configuration_To_shootworkermetadatarestriction_Configuration is an autogenerated conversion function.
+func Convert_v1alpha1_Configuration_To_shootworkermetadatarestriction_Configuration(in *Configuration, out *shootworkermetadatarestriction.Configuration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Configuration_To_shootworkermetadatarestriction_Configuration(in, out, s)
+}
+
+func autoConvert_shootworkermetadatarestriction_Configuration_To_v1alpha1_Configuration(in *shootworkermetadatarestriction.Configuration, out *Configuration, s conversion.Scope) error {
+	out.Mode = Mode(in.Mode)
+	out.Labels = (*MetadataRestriction)(unsafe.Pointer(in.Labels))
+	out.Annotations = (*MetadataRestriction)(unsafe.Pointer(in.Annotations))
+	out.Taints = (*MetadataRestriction)(unsafe.Pointer(in.Taints))
+	return nil
+}
+
+// Convert_shootworkermetadatarestriction_Configuration_To_v1alpha1_Configuration is an autogenerated conversion function.
+func Convert_shootworkermetadatarestriction_Configuration_To_v1alpha1_Configuration(in *shootworkermetadatarestriction.Configuration, out *Configuration, s conversion.Scope) error {
+	return autoConvert_shootworkermetadatarestriction_Configuration_To_v1alpha1_Configuration(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetadataRestriction_To_shootworkermetadatarestriction_MetadataRestriction(in *MetadataRestriction, out *shootworkermetadatarestriction.MetadataRestriction, s conversion.Scope) error {
+	out.ReservedPrefixes = *(*[]string)(unsafe.Pointer(&in.ReservedPrefixes))
+	out.AllowedKeys = *(*[]string)(unsafe.Pointer(&in.AllowedKeys))
+	out.MaxCount = (*int32)(unsafe.Pointer(in.MaxCount))
+	return nil
+}
+
+// Convert_v1alpha1_MetadataRestriction_To_shootworkermetadatarestriction_MetadataRestriction is an autogenerated conversion function.
+func Convert_v1alpha1_MetadataRestriction_To_shootworkermetadatarestriction_MetadataRestriction(in *MetadataRestriction, out *shootworkermetadatarestriction.MetadataRestriction, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetadataRestriction_To_shootworkermetadatarestriction_MetadataRestriction(in, out, s)
+}
+
+func autoConvert_shootworkermetadatarestriction_MetadataRestriction_To_v1alpha1_MetadataRestriction(in *shootworkermetadatarestriction.MetadataRestriction, out *MetadataRestriction, s conversion.Scope) error {
+	out.ReservedPrefixes = *(*[]string)(unsafe.Pointer(&in.ReservedPrefixes))
+	out.AllowedKeys = *(*[]string)(unsafe.Pointer(&in.AllowedKeys))
+	out.MaxCount = (*int32)(unsafe.Pointer(in.MaxCount))
+	return nil
+}
+
+// Convert_shootworkermetadatarestriction_MetadataRestriction_To_v1alpha1_MetadataRestriction is an autogenerated conversion function.
+func Convert_shootworkermetadatarestriction_MetadataRestriction_To_v1alpha1_MetadataRestriction(in *shootworkermetadatarestriction.MetadataRestriction, out *MetadataRestriction, s conversion.Scope) error {
+	return autoConvert_shootworkermetadatarestriction_MetadataRestriction_To_v1alpha1_MetadataRestriction(in, out, s)
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction"
+)
+
+var availableModes = sets.New(
+	string(shootworkermetadatarestriction.ModeWarn),
+	string(shootworkermetadatarestriction.ModeEnforce),
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *shootworkermetadatarestriction.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if config == nil {
+		return allErrs
+	}
+
+	if !availableModes.Has(string(config.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("mode"), config.Mode, sets.List(availableModes)))
+	}
+
+	allErrs = append(allErrs, validateMetadataRestriction(config.Labels, field.NewPath("labels"))...)
+	allErrs = append(allErrs, validateMetadataRestriction(config.Annotations, field.NewPath("annotations"))...)
+	allErrs = append(allErrs, validateMetadataRestriction(config.Taints, field.NewPath("taints"))...)
+
+	return allErrs
+}
+
+func validateMetadataRestriction(restriction *shootworkermetadatarestriction.MetadataRestriction, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if restriction == nil {
+		return allErrs
+	}
+
+	for i, prefix := range restriction.ReservedPrefixes {
+		for _, msg := range validation.IsDNS1123Subdomain(prefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("reservedPrefixes").Index(i), prefix, msg))
+		}
+	}
+
+	for i, key := range restriction.AllowedKeys {
+		for _, msg := range validation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedKeys").Index(i), key, msg))
+		}
+	}
+
+	if restriction.MaxCount != nil && *restriction.MaxCount < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxCount"), *restriction.MaxCount, "must not be negative"))
+	}
+
+	return allErrs
+}
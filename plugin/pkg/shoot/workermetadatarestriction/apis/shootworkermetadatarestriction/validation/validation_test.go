@@ -0,0 +1,90 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/workermetadatarestriction/apis/shootworkermetadatarestriction/validation"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#ValidateConfiguration", func() {
+		var config *shootworkermetadatarestriction.Configuration
+
+		BeforeEach(func() {
+			config = &shootworkermetadatarestriction.Configuration{
+				Mode: shootworkermetadatarestriction.ModeWarn,
+			}
+		})
+
+		It("should allow configuration without restrictions", func() {
+			Expect(ValidateConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should allow valid restrictions", func() {
+			config.Mode = shootworkermetadatarestriction.ModeEnforce
+			config.Labels = &shootworkermetadatarestriction.MetadataRestriction{
+				ReservedPrefixes: []string{"kubernetes.io", "gardener.cloud"},
+				AllowedKeys:      []string{"node.kubernetes.io/role"},
+				MaxCount:         pointer.Int32(10),
+			}
+			config.Taints = &shootworkermetadatarestriction.MetadataRestriction{
+				MaxCount: pointer.Int32(0),
+			}
+
+			Expect(ValidateConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported modes", func() {
+			config.Mode = "Foo"
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("mode"),
+				})),
+			))
+		})
+
+		It("should forbid invalid restrictions", func() {
+			config.Annotations = &shootworkermetadatarestriction.MetadataRestriction{
+				ReservedPrefixes: []string{"Foo_Bar"},
+				AllowedKeys:      []string{"foo/bar/baz"},
+				MaxCount:         pointer.Int32(-1),
+			}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("annotations.reservedPrefixes[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("annotations.allowedKeys[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("annotations.maxCount"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apiserver/pkg/warning"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+const (
+	// WarningReasonDeprecatedField is the reason for warnings about the usage of deprecated fields.
+	WarningReasonDeprecatedField = "DeprecatedField"
+	// WarningReasonDeprecatedMachineType is the reason for warnings about the usage of deprecated machine types.
+	WarningReasonDeprecatedMachineType = "DeprecatedMachineType"
+	// WarningReasonDeprecatedVolumeType is the reason for warnings about the usage of deprecated volume types.
+	WarningReasonDeprecatedVolumeType = "DeprecatedVolumeType"
+	// WarningReasonKubernetesVersionExpiring is the reason for warnings about Kubernetes versions which expire soon.
+	WarningReasonKubernetesVersionExpiring = "KubernetesVersionExpiring"
+	// WarningReasonMachineImageVersionExpiring is the reason for warnings about machine image versions which expire
+	// soon.
+	WarningReasonMachineImageVersionExpiring = "MachineImageVersionExpiring"
+	// WarningReasonRestrictedWorkerMetadata is the reason for warnings about labels, annotations, or taints of worker
+	// pools violating the restrictions configured in the ShootWorkerMetadataRestriction admission plugin.
+	WarningReasonRestrictedWorkerMetadata = "RestrictedWorkerMetadata"
+	// WarningReasonQuotaNearingLimit is the reason for warnings about quotas whose limits are almost reached.
+	WarningReasonQuotaNearingLimit = "QuotaNearingLimit"
+)
+
+// AddShootWarnings delivers the given warnings to the client as standard API warnings (i.e., `Warning` response
+// headers) and records them in the `.status.admissionWarnings` of the given Shoot. The warnings previously recorded for
+// the given plugin are replaced, i.e., calling this function without warnings removes them.
+// It must only be called by mutating admission plugins since validating admission plugins must not modify the object.
+func AddShootWarnings(ctx context.Context, shoot *core.Shoot, plugin string, warnings ...core.AdmissionWarning) {
+	var admissionWarnings []core.AdmissionWarning
+	for _, w := range shoot.Status.AdmissionWarnings {
+		if w.Plugin != plugin {
+			admissionWarnings = append(admissionWarnings, w)
+		}
+	}
+
+	for _, w := range warnings {
+		w.Plugin = plugin
+		warning.AddWarning(ctx, "", fmt.Sprintf("%s: %s", w.Reason, w.Message))
+		admissionWarnings = append(admissionWarnings, w)
+	}
+
+	shoot.Status.AdmissionWarnings = admissionWarnings
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apiserver/pkg/warning"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (r *fakeWarningRecorder) AddWarning(_, text string) {
+	r.warnings = append(r.warnings, text)
+}
+
+var _ = Describe("Warnings", func() {
+	Describe("#AddShootWarnings", func() {
+		var (
+			ctx      context.Context
+			recorder *fakeWarningRecorder
+			shoot    *core.Shoot
+		)
+
+		BeforeEach(func() {
+			recorder = &fakeWarningRecorder{}
+			ctx = warning.WithWarningRecorder(context.TODO(), recorder)
+			shoot = &core.Shoot{
+				Status: core.ShootStatus{
+					AdmissionWarnings: []core.AdmissionWarning{
+						{Plugin: "foo", Reason: "Old", Message: "old warning of foo"},
+						{Plugin: "bar", Reason: "Old", Message: "old warning of bar"},
+					},
+				},
+			}
+		})
+
+		It("should deliver the warnings and replace the warnings previously recorded for the plugin", func() {
+			AddShootWarnings(ctx, shoot, "foo",
+				core.AdmissionWarning{Reason: "New", Message: "first"},
+				core.AdmissionWarning{Reason: "New", Message: "second"},
+			)
+
+			Expect(recorder.warnings).To(ConsistOf("New: first", "New: second"))
+			Expect(shoot.Status.AdmissionWarnings).To(ConsistOf(
+				core.AdmissionWarning{Plugin: "bar", Reason: "Old", Message: "old warning of bar"},
+				core.AdmissionWarning{Plugin: "foo", Reason: "New", Message: "first"},
+				core.AdmissionWarning{Plugin: "foo", Reason: "New", Message: "second"},
+			))
+		})
+
+		It("should remove the warnings previously recorded for the plugin if there are no warnings", func() {
+			AddShootWarnings(ctx, shoot, "foo")
+
+			Expect(recorder.warnings).To(BeEmpty())
+			Expect(shoot.Status.AdmissionWarnings).To(ConsistOf(
+				core.AdmissionWarning{Plugin: "bar", Reason: "Old", Message: "old warning of bar"},
+			))
+		})
+
+		It("should unset the admission warnings if no warnings are left", func() {
+			shoot.Status.AdmissionWarnings = shoot.Status.AdmissionWarnings[:1]
+
+			AddShootWarnings(ctx, shoot, "foo")
+
+			Expect(shoot.Status.AdmissionWarnings).To(BeNil())
+		})
+	})
+})
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	secretbindingcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
@@ -113,6 +114,9 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	mgrClient = mgr.GetClient()
 
+	By("Setup field indexes")
+	Expect(indexer.AddShootSecretBindingName(ctx, mgr.GetFieldIndexer())).To(Succeed())
+
 	By("Register controller")
 	Expect((&secretbindingcontroller.Reconciler{
 		Config: config.SecretBindingControllerConfiguration{
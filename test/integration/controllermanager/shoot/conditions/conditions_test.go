@@ -90,7 +90,7 @@ var _ = Describe("Shoot Conditions controller tests", func() {
 				Shoot: &seedmanagementv1alpha1.Shoot{
 					Name: shoot.Name,
 				},
-				Gardenlet: &seedmanagementv1alpha1.Gardenlet{},
+				Gardenlet: &seedmanagementv1alpha1.GardenletConfig{},
 			},
 		}
 
@@ -126,7 +126,7 @@ var _ = BeforeSuite(func() {
 		},
 		Clock:    fakeClock,
 		Recorder: mgr.GetEventRecorderFor("shoot-maintenance-controller"),
-	}).AddToManager(mgr)).To(Succeed())
+	}).AddToManager(ctx, mgr)).To(Succeed())
 
 	By("Start manager")
 	mgrContext, mgrCancel := context.WithCancel(ctx)
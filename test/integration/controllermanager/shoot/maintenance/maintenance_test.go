@@ -427,6 +427,7 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 			ContainElement("deployInfrastructure"),
 			ContainElement("deployDNSRecordInternal"),
 			ContainElement("deployDNSRecordExternal"),
+			ContainElement("deployDNSRecordPrivate"),
 			ContainElement("deployDNSRecordIngress"),
 		))
 	})
@@ -578,6 +579,49 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 					g.Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring("Reason: Automatic update of the machine image version is configured (image update strategy: major)"))
 					g.Expect(shoot.Status.LastMaintenance.TriggeredTime).To(Equal(metav1.Time{Time: fakeClock.Now()}))
 					g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+					g.Expect(shoot.Status.MaintenanceHistory).To(ContainElement(gardencorev1beta1.MaintenanceRecord{
+						Time:     metav1.Time{Time: fakeClock.Now()},
+						Field:    "spec.provider.workers[0].machine.image.version",
+						OldValue: &highestPatchSameMinorAMD64,
+						NewValue: &overallLatestVersionAMD64,
+						Reason:   "Automatic update of the machine image version is configured (image update strategy: major)",
+					}))
+				}).Should(Succeed())
+			})
+
+			It("postpone the auto update because PodDisruptionBudgets block the drain of nodes (update strategy: major)", func() {
+				By("Set autoupdate=true and Shoot's machine version to be the latest in the minor in the CloudProfile")
+				cloneShoot := &gardencorev1beta1.Shoot{}
+				Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), cloneShoot)).ToNot(HaveOccurred())
+				patch := client.StrategicMergeFrom(cloneShoot.DeepCopy())
+
+				cloneShoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestPatchSameMinorAMD64
+				cloneShoot.Spec.Provider.Workers[1].Machine.Image.Version = &highestPatchSameMinorARM
+				cloneShoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = pointer.Bool(true)
+				Expect(testClient.Patch(ctx, cloneShoot, patch)).ToNot(HaveOccurred())
+
+				By("Report PodDisruptionBudgets blocking the drain of nodes")
+				Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				patch = client.MergeFrom(shoot.DeepCopy())
+				shoot.Status.Constraints = []gardencorev1beta1.Condition{{
+					Type:               gardencorev1beta1.ShootPodDisruptionBudgetsAllowNodeDrain,
+					Status:             gardencorev1beta1.ConditionFalse,
+					LastTransitionTime: metav1.Now(),
+					LastUpdateTime:     metav1.Now(),
+					Reason:             "PodDisruptionBudgetsBlockNodeDrain",
+					Message:            "PodDisruptionBudgets blocking the drain of nodes: default/foo never allows the eviction of any of its 1 pod(s).",
+				}}
+				Expect(testClient.Status().Patch(ctx, shoot, patch)).To(Succeed())
+
+				Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+				Eventually(func(g Gomega) {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					g.Expect(*shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(gardencorev1beta1.ShootMachineImage{Name: shoot.Spec.Provider.Workers[0].Machine.Image.Name, Version: &highestPatchSameMinorAMD64}))
+					g.Expect(*shoot.Spec.Provider.Workers[1].Machine.Image).To(Equal(gardencorev1beta1.ShootMachineImage{Name: shoot.Spec.Provider.Workers[1].Machine.Image.Name, Version: &highestPatchSameMinorARM}))
+					g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+					g.Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring("Postponing machine image updates to the next maintenance window because the drain of nodes is blocked: PodDisruptionBudgets blocking the drain of nodes: default/foo never allows the eviction of any of its 1 pod(s)."))
+					g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
 				}).Should(Succeed())
 			})
 
@@ -1049,6 +1093,42 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 					return shoot.Spec.Kubernetes.Version
 				}).Should(Equal(testKubernetesVersionHighestPatchConsecutiveMinor.Version))
 			})
+
+			It("Kubernetes version should not be updated: postpone force update of minor version because the upgrade pre-flight checks reported blocking findings", func() {
+				// set the shoots Kubernetes version to be the highest patch version of the minor version
+				patch := client.MergeFrom(shoot.DeepCopy())
+				shoot.Spec.Kubernetes.Version = testKubernetesVersionHighestPatchLowMinor.Version
+				Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+				By("Report blocking findings of the upgrade pre-flight checks")
+				patch = client.MergeFrom(shoot.DeepCopy())
+				shoot.Status.Constraints = []gardencorev1beta1.Condition{{
+					Type:               gardencorev1beta1.ShootUpgradePreflightChecksPassed,
+					Status:             gardencorev1beta1.ConditionFalse,
+					LastTransitionTime: metav1.Now(),
+					LastUpdateTime:     metav1.Now(),
+					Reason:             "UpgradePreflightBlockingFindings",
+					Message:            "Blocking findings for upgrade to Kubernetes 0.1: policy/v1beta1 podsecuritypolicies is removed in 0.1 but still in use.",
+				}}
+				Expect(testClient.Status().Patch(ctx, shoot, patch)).To(Succeed())
+
+				By("Expire Shoot's kubernetes version in the CloudProfile")
+				Expect(patchCloudProfileForKubernetesVersionMaintenance(ctx, testClient, shoot.Spec.CloudProfileName, testKubernetesVersionHighestPatchLowMinor.Version, &expirationDateInThePast, &deprecatedClassification)).To(Succeed())
+
+				By("Wait until manager has observed the CloudProfile update")
+				waitKubernetesVersionToBeExpiredInCloudProfile(shoot.Spec.CloudProfileName, testKubernetesVersionHighestPatchLowMinor.Version, &expirationDateInThePast)
+
+				Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+				Eventually(func(g Gomega) string {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+					g.Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring("Postponing Kubernetes update to \"0.1.5\" to the next maintenance window because the upgrade pre-flight checks reported blocking findings: Blocking findings for upgrade to Kubernetes 0.1: policy/v1beta1 podsecuritypolicies is removed in 0.1 but still in use."))
+					g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+					g.Expect(shoot.Status.LastMaintenance.TriggeredTime).To(Equal(metav1.Time{Time: fakeClock.Now()}))
+					return shoot.Spec.Kubernetes.Version
+				}).Should(Equal(testKubernetesVersionHighestPatchLowMinor.Version))
+			})
 		})
 
 		Describe("Worker Pool Kubernetes version maintenance tests", func() {
@@ -51,6 +51,9 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 				GenerateName: "registration-",
 				Labels:       map[string]string{testID: testRunID},
 			},
+			Spec: gardencorev1beta1.ControllerRegistrationSpec{
+				Resources: []gardencorev1beta1.ControllerResource{{Kind: "Extension", Type: "test-extension"}},
+			},
 		}
 		controllerDeployment = &gardencorev1beta1.ControllerDeployment{
 			ObjectMeta: metav1.ObjectMeta{
@@ -166,6 +169,7 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 				g.Expect(namespace.Labels).To(And(
 					HaveKeyWithValue("gardener.cloud/role", "extension"),
 					HaveKeyWithValue("controllerregistration.core.gardener.cloud/name", controllerRegistration.Name),
+					HaveKeyWithValue("extensions.extensions.gardener.cloud/test-extension", "true"),
 					HaveKeyWithValue("pod-security.kubernetes.io/enforce", "privileged"),
 					HaveKeyWithValue("high-availability-config.resources.gardener.cloud/consider", "true"),
 				))
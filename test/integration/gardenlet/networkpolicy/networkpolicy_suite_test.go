@@ -163,14 +163,16 @@ var _ = BeforeSuite(func() {
 	By("Register controller")
 	DeferCleanup(test.WithVar(&networkpolicy.SeedIsGardenCheckInterval, 500*time.Millisecond))
 	testContext, testCancel = context.WithCancel(ctx)
+	extensionsAccessModeReport := config.ExtensionsAccessModeReport
 	Expect(networkpolicy.AddToManager(
 		ctx,
 		mgr,
 		testCancel,
 		mgr,
 		config.NetworkPolicyControllerConfiguration{
-			ConcurrentSyncs:              pointer.Int(5),
-			AdditionalNamespaceSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"custom": "namespace"}}},
+			ConcurrentSyncs:                   pointer.Int(5),
+			AdditionalNamespaceSelectors:      []metav1.LabelSelector{{MatchLabels: map[string]string{"custom": "namespace"}}},
+			ExtensionsAccessToShootAPIServers: &extensionsAccessModeReport,
 		},
 		gardencore.SeedNetworks{
 			IPFamilies: []gardencore.IPFamily{gardencore.IPFamilyIPv4},
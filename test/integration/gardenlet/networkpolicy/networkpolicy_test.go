@@ -31,6 +31,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	networkpolicycontroller "github.com/gardener/gardener/pkg/controller/networkpolicy"
 	networkpolicyhelper "github.com/gardener/gardener/pkg/controller/networkpolicy/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -89,8 +90,9 @@ var _ = Describe("NetworkPolicy controller tests", func() {
 			ObjectMeta: metav1.ObjectMeta{
 				GenerateName: "extension-",
 				Labels: map[string]string{
-					v1beta1constants.GardenRole: v1beta1constants.GardenRoleExtension,
-					testID:                      testRunID,
+					v1beta1constants.GardenRole:                v1beta1constants.GardenRoleExtension,
+					"provider.extensions.gardener.cloud/local": "true",
+					testID: testRunID,
 				},
 			},
 		}
@@ -114,6 +116,9 @@ var _ = Describe("NetworkPolicy controller tests", func() {
 				CloudProfile: runtime.RawExtension{Raw: []byte("{}")},
 				Seed:         runtime.RawExtension{Raw: []byte("{}")},
 				Shoot: runtime.RawExtension{Object: &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"provider.extensions.gardener.cloud/local": "true"},
+					},
 					Spec: gardencorev1beta1.ShootSpec{
 						Networking: &gardencorev1beta1.Networking{
 							Pods:     pointer.String("10.150.0.0/16"),
@@ -492,6 +497,48 @@ var _ = Describe("NetworkPolicy controller tests", func() {
 		})
 	})
 
+	Describe("allow-from-managing-extensions-to-kube-apiserver", func() {
+		defaultTests(testAttributes{
+			networkPolicyName: "allow-from-managing-extensions-to-kube-apiserver",
+			expectedNetworkPolicySpec: func(string) networkingv1.NetworkPolicySpec {
+				return networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelApp: v1beta1constants.LabelKubernetes, v1beta1constants.LabelRole: v1beta1constants.LabelAPIServer}},
+					PolicyTypes: []networkingv1.PolicyType{"Ingress"},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{
+						From: []networkingv1.NetworkPolicyPeer{{
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{
+								v1beta1constants.GardenRole:                v1beta1constants.GardenRoleExtension,
+								"provider.extensions.gardener.cloud/local": "true",
+							}},
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-all-shoots-kube-apiserver-tcp-443": "allowed"}},
+						}},
+						Ports: []networkingv1.NetworkPolicyPort{{Protocol: utils.ProtocolPtr(corev1.ProtocolTCP), Port: utils.IntStrPtrFromInt32(443)}},
+					}},
+				}
+			},
+			inShootNamespaces: true,
+		})
+
+		It("should not annotate the network policy with extension namespaces without access", func() {
+			Eventually(func(g Gomega) map[string]string {
+				networkPolicy := &networkingv1.NetworkPolicy{}
+				g.Expect(testClient.Get(ctx, client.ObjectKey{Namespace: shootNamespace.Name, Name: "allow-from-managing-extensions-to-kube-apiserver"}, networkPolicy)).To(Succeed())
+				return networkPolicy.Annotations
+			}).Should(And(
+				HaveKey(v1beta1constants.GardenerDescription),
+				Not(HaveKey(networkpolicycontroller.AnnotationExtensionNamespacesWithoutAccess)),
+			))
+		})
+	})
+
+	Describe("allow-to-shoot-kube-apiservers", func() {
+		It("should not create the network policy when the access of extensions is not enforced", func() {
+			Consistently(func() error {
+				return testClient.Get(ctx, client.ObjectKey{Namespace: extensionNamespace.Name, Name: "allow-to-shoot-kube-apiservers"}, &networkingv1.NetworkPolicy{})
+			}).Should(BeNotFoundError())
+		})
+	})
+
 	Describe("allow-to-blocked-cidrs", func() {
 		var (
 			networkPolicyName         = "allow-to-blocked-cidrs"
@@ -54,9 +54,11 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		hostName = "test-hostname"
 		node     *corev1.Node
 
-		file1, file2, file3, file4, file5, file6, file7                                          extensionsv1alpha1.File
+		file1, file2, file3, file4, file5, file6, file7, file8                                   extensionsv1alpha1.File
 		gnaUnit, unit1, unit2, unit3, unit4, unit5, unit5DropInsOnly, unit6, unit7, unit8, unit9 extensionsv1alpha1.Unit
 
+		fileContentSecret *corev1.Secret
+
 		operatingSystemConfig *extensionsv1alpha1.OperatingSystemConfig
 		oscRaw                []byte
 		oscSecret             *corev1.Secret
@@ -168,6 +170,19 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 			Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "", Data: "file7"}},
 			Permissions: pointer.Int32(0750),
 		}
+		fileContentSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testRunID + "-file8",
+				Namespace: metav1.NamespaceSystem,
+				Labels:    map[string]string{testID: testRunID},
+			},
+			Data: map[string][]byte{"content": []byte("file8")},
+		}
+		file8 = extensionsv1alpha1.File{
+			Path:        "/eighth/file",
+			Content:     extensionsv1alpha1.FileContent{SecretRef: &extensionsv1alpha1.FileContentSecretRef{Name: fileContentSecret.Name, DataKey: "content"}},
+			Permissions: pointer.Int32(0600),
+		}
 
 		gnaUnit = extensionsv1alpha1.Unit{
 			Name:    "gardener-node-agent.service",
@@ -261,7 +276,7 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 
 		operatingSystemConfig = &extensionsv1alpha1.OperatingSystemConfig{
 			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
-				Files: []extensionsv1alpha1.File{file1, file3, file5},
+				Files: []extensionsv1alpha1.File{file1, file3, file5, file8},
 				Units: []extensionsv1alpha1.Unit{unit1, unit2, unit5, unit5DropInsOnly, unit6, unit7},
 			},
 			Status: extensionsv1alpha1.OperatingSystemConfigStatus{
@@ -285,6 +300,12 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 	})
 
 	BeforeEach(func() {
+		By("Create Secret containing the content of a file")
+		Expect(testClient.Create(ctx, fileContentSecret)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(testClient.Delete(ctx, fileContentSecret)).To(Succeed())
+		})
+
 		By("Create Secret containing the operating system config")
 		Expect(testClient.Create(ctx, oscSecret)).To(Succeed())
 		DeferCleanup(func() {
@@ -325,6 +346,7 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		test.AssertFileOnDisk(fakeFS, file5.Path, "file5", 0750)
 		test.AssertFileOnDisk(fakeFS, file6.Path, "file6", 0750)
 		test.AssertFileOnDisk(fakeFS, file7.Path, "file7", 0750)
+		test.AssertFileOnDisk(fakeFS, file8.Path, "file8", 0600)
 		test.AssertFileOnDisk(fakeFS, "/etc/systemd/system/"+unit1.Name, "#unit1", 0600)
 		test.AssertFileOnDisk(fakeFS, "/etc/systemd/system/"+unit1.Name+".d/"+unit1.DropIns[0].Name, "#unit1drop", 0600)
 		test.AssertFileOnDisk(fakeFS, "/etc/systemd/system/"+unit2.Name, "#unit2", 0600)
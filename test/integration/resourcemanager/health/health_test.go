@@ -314,6 +314,82 @@ var _ = Describe("Health controller tests", func() {
 				)
 			})
 		})
+
+		Context("with custom health check", func() {
+			var configMap *corev1.ConfigMap
+
+			BeforeEach(func() {
+				managedResource.Spec.HealthChecks = []resourcesv1alpha1.HealthCheck{{
+					Kind:       "ConfigMap",
+					Expression: "has(self.data) && self.data.ready == 'true'",
+					Message:    "ConfigMap is not ready",
+				}}
+			})
+
+			JustBeforeEach(func() {
+				By("Create ConfigMap test resource")
+				configMap = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      managedResource.Name,
+						Namespace: testNamespace.Name,
+					},
+					Data: map[string]string{"ready": "false"},
+				}
+				Expect(testClient.Create(ctx, configMap)).To(Succeed())
+
+				DeferCleanup(func() {
+					By("Delete ConfigMap test resource")
+					Expect(testClient.Delete(ctx, configMap)).To(Or(Succeed(), BeNotFoundError()))
+				})
+
+				By("Add resources to ManagedResource status")
+				patch := client.MergeFrom(managedResource.DeepCopy())
+				managedResource.Status.Resources = []resourcesv1alpha1.ObjectReference{{
+					ObjectReference: corev1.ObjectReference{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Namespace:  configMap.Namespace,
+						Name:       configMap.Name,
+					},
+				}}
+				Expect(testClient.Status().Patch(ctx, managedResource, patch)).To(Succeed())
+			})
+
+			It("sets ManagedResource to unhealthy as the expression evaluates to false", func() {
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesHealthy), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("ConfigMapUnhealthy"), WithMessageSubstrings("ConfigMap is not ready")),
+				)
+			})
+
+			It("sets ManagedResource to healthy as the expression evaluates to true", func() {
+				patch := client.MergeFrom(configMap.DeepCopy())
+				configMap.Data["ready"] = "true"
+				Expect(testClient.Patch(ctx, configMap, patch)).To(Succeed())
+
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesHealthy), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("ResourcesHealthy")),
+				)
+			})
+
+			It("sets ManagedResource to unhealthy as the expression cannot be compiled", func() {
+				patch := client.MergeFrom(managedResource.DeepCopy())
+				managedResource.Spec.HealthChecks[0].Expression = "self.data.ready =="
+				Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesHealthy), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("HealthCheckError")),
+				)
+			})
+		})
 	})
 
 	Describe("Progressing Reconciler", func() {